@@ -0,0 +1,295 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package admissionfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeAdmitter struct {
+	AdmitDesiredLRPStub        func(context.Context, lager.Logger, *models.DesiredLRP) (*models.DesiredLRP, error)
+	admitDesiredLRPMutex       sync.RWMutex
+	admitDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}
+	admitDesiredLRPReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	admitDesiredLRPReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	AdmitDesiredLRPUpdateStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error)
+	admitDesiredLRPUpdateMutex       sync.RWMutex
+	admitDesiredLRPUpdateArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}
+	admitDesiredLRPUpdateReturns struct {
+		result1 *models.DesiredLRPUpdate
+		result2 error
+	}
+	admitDesiredLRPUpdateReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPUpdate
+		result2 error
+	}
+	AdmitTaskStub        func(context.Context, lager.Logger, string, string, *models.TaskDefinition) (*models.TaskDefinition, error)
+	admitTaskMutex       sync.RWMutex
+	admitTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}
+	admitTaskReturns struct {
+		result1 *models.TaskDefinition
+		result2 error
+	}
+	admitTaskReturnsOnCall map[int]struct {
+		result1 *models.TaskDefinition
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) (*models.DesiredLRP, error) {
+	fake.admitDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.admitDesiredLRPReturnsOnCall[len(fake.admitDesiredLRPArgsForCall)]
+	fake.admitDesiredLRPArgsForCall = append(fake.admitDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}{arg1, arg2, arg3})
+	stub := fake.AdmitDesiredLRPStub
+	fakeReturns := fake.admitDesiredLRPReturns
+	fake.recordInvocation("AdmitDesiredLRP", []interface{}{arg1, arg2, arg3})
+	fake.admitDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPCallCount() int {
+	fake.admitDesiredLRPMutex.RLock()
+	defer fake.admitDesiredLRPMutex.RUnlock()
+	return len(fake.admitDesiredLRPArgsForCall)
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPCalls(stub func(context.Context, lager.Logger, *models.DesiredLRP) (*models.DesiredLRP, error)) {
+	fake.admitDesiredLRPMutex.Lock()
+	defer fake.admitDesiredLRPMutex.Unlock()
+	fake.AdmitDesiredLRPStub = stub
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, *models.DesiredLRP) {
+	fake.admitDesiredLRPMutex.RLock()
+	defer fake.admitDesiredLRPMutex.RUnlock()
+	argsForCall := fake.admitDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.admitDesiredLRPMutex.Lock()
+	defer fake.admitDesiredLRPMutex.Unlock()
+	fake.AdmitDesiredLRPStub = nil
+	fake.admitDesiredLRPReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.admitDesiredLRPMutex.Lock()
+	defer fake.admitDesiredLRPMutex.Unlock()
+	fake.AdmitDesiredLRPStub = nil
+	if fake.admitDesiredLRPReturnsOnCall == nil {
+		fake.admitDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.admitDesiredLRPReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdate(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error) {
+	fake.admitDesiredLRPUpdateMutex.Lock()
+	ret, specificReturn := fake.admitDesiredLRPUpdateReturnsOnCall[len(fake.admitDesiredLRPUpdateArgsForCall)]
+	fake.admitDesiredLRPUpdateArgsForCall = append(fake.admitDesiredLRPUpdateArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.AdmitDesiredLRPUpdateStub
+	fakeReturns := fake.admitDesiredLRPUpdateReturns
+	fake.recordInvocation("AdmitDesiredLRPUpdate", []interface{}{arg1, arg2, arg3, arg4})
+	fake.admitDesiredLRPUpdateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdateCallCount() int {
+	fake.admitDesiredLRPUpdateMutex.RLock()
+	defer fake.admitDesiredLRPUpdateMutex.RUnlock()
+	return len(fake.admitDesiredLRPUpdateArgsForCall)
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdateCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error)) {
+	fake.admitDesiredLRPUpdateMutex.Lock()
+	defer fake.admitDesiredLRPUpdateMutex.Unlock()
+	fake.AdmitDesiredLRPUpdateStub = stub
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdateArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPUpdate) {
+	fake.admitDesiredLRPUpdateMutex.RLock()
+	defer fake.admitDesiredLRPUpdateMutex.RUnlock()
+	argsForCall := fake.admitDesiredLRPUpdateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdateReturns(result1 *models.DesiredLRPUpdate, result2 error) {
+	fake.admitDesiredLRPUpdateMutex.Lock()
+	defer fake.admitDesiredLRPUpdateMutex.Unlock()
+	fake.AdmitDesiredLRPUpdateStub = nil
+	fake.admitDesiredLRPUpdateReturns = struct {
+		result1 *models.DesiredLRPUpdate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitDesiredLRPUpdateReturnsOnCall(i int, result1 *models.DesiredLRPUpdate, result2 error) {
+	fake.admitDesiredLRPUpdateMutex.Lock()
+	defer fake.admitDesiredLRPUpdateMutex.Unlock()
+	fake.AdmitDesiredLRPUpdateStub = nil
+	if fake.admitDesiredLRPUpdateReturnsOnCall == nil {
+		fake.admitDesiredLRPUpdateReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPUpdate
+			result2 error
+		})
+	}
+	fake.admitDesiredLRPUpdateReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPUpdate
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 *models.TaskDefinition) (*models.TaskDefinition, error) {
+	fake.admitTaskMutex.Lock()
+	ret, specificReturn := fake.admitTaskReturnsOnCall[len(fake.admitTaskArgsForCall)]
+	fake.admitTaskArgsForCall = append(fake.admitTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.AdmitTaskStub
+	fakeReturns := fake.admitTaskReturns
+	fake.recordInvocation("AdmitTask", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.admitTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAdmitter) AdmitTaskCallCount() int {
+	fake.admitTaskMutex.RLock()
+	defer fake.admitTaskMutex.RUnlock()
+	return len(fake.admitTaskArgsForCall)
+}
+
+func (fake *FakeAdmitter) AdmitTaskCalls(stub func(context.Context, lager.Logger, string, string, *models.TaskDefinition) (*models.TaskDefinition, error)) {
+	fake.admitTaskMutex.Lock()
+	defer fake.admitTaskMutex.Unlock()
+	fake.AdmitTaskStub = stub
+}
+
+func (fake *FakeAdmitter) AdmitTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, *models.TaskDefinition) {
+	fake.admitTaskMutex.RLock()
+	defer fake.admitTaskMutex.RUnlock()
+	argsForCall := fake.admitTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeAdmitter) AdmitTaskReturns(result1 *models.TaskDefinition, result2 error) {
+	fake.admitTaskMutex.Lock()
+	defer fake.admitTaskMutex.Unlock()
+	fake.AdmitTaskStub = nil
+	fake.admitTaskReturns = struct {
+		result1 *models.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) AdmitTaskReturnsOnCall(i int, result1 *models.TaskDefinition, result2 error) {
+	fake.admitTaskMutex.Lock()
+	defer fake.admitTaskMutex.Unlock()
+	fake.AdmitTaskStub = nil
+	if fake.admitTaskReturnsOnCall == nil {
+		fake.admitTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskDefinition
+			result2 error
+		})
+	}
+	fake.admitTaskReturnsOnCall[i] = struct {
+		result1 *models.TaskDefinition
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAdmitter) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.admitDesiredLRPMutex.RLock()
+	defer fake.admitDesiredLRPMutex.RUnlock()
+	fake.admitDesiredLRPUpdateMutex.RLock()
+	defer fake.admitDesiredLRPUpdateMutex.RUnlock()
+	fake.admitTaskMutex.RLock()
+	defer fake.admitTaskMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAdmitter) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ admission.Admitter = new(FakeAdmitter)
@@ -0,0 +1,293 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate . Admitter
+
+// Admitter decides whether desired LRPs and tasks may be created or updated.
+// It returns what is admitted, which may have been modified.
+type Admitter interface {
+	AdmitDesiredLRP(ctx context.Context, logger lager.Logger, desiredLRP *models.DesiredLRP) (*models.DesiredLRP, error)
+	AdmitDesiredLRPUpdate(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error)
+	AdmitTask(ctx context.Context, logger lager.Logger, taskGuid, domain string, taskDefinition *models.TaskDefinition) (*models.TaskDefinition, error)
+}
+
+// Chain admits what every one of its webhooks allows. The mutating webhooks
+// are called first, followed by the validating webhooks, each in the order
+// they were given. Every webhook reviews the object as patched by the
+// webhooks before it.
+type Chain struct {
+	webhooks []*Webhook
+}
+
+func NewChain(webhooks ...*Webhook) *Chain {
+	ordered := []*Webhook{}
+	for _, webhook := range webhooks {
+		if webhook.Mutating() {
+			ordered = append(ordered, webhook)
+		}
+	}
+	for _, webhook := range webhooks {
+		if !webhook.Mutating() {
+			ordered = append(ordered, webhook)
+		}
+	}
+
+	return &Chain{webhooks: ordered}
+}
+
+// NewChainFromConfig returns a chain of the configured webhooks. Without any
+// webhooks everything is admitted unchanged.
+func NewChainFromConfig(configs []WebhookConfig) (*Chain, error) {
+	webhooks := []*Webhook{}
+	names := map[string]bool{}
+	for _, config := range configs {
+		webhook, err := NewWebhookFromConfig(config)
+		if err != nil {
+			return nil, err
+		}
+		if names[config.Name] {
+			return nil, fmt.Errorf("admission webhook %s is configured twice", config.Name)
+		}
+		names[config.Name] = true
+		webhooks = append(webhooks, webhook)
+	}
+
+	return NewChain(webhooks...), nil
+}
+
+func (c *Chain) AdmitDesiredLRP(ctx context.Context, logger lager.Logger, desiredLRP *models.DesiredLRP) (*models.DesiredLRP, error) {
+	logger = logger.Session("admit-desired-lrp", lager.Data{"process_guid": desiredLRP.ProcessGuid})
+
+	review := &Review{
+		Kind:        KindDesiredLRP,
+		Operation:   OperationCreate,
+		Domain:      desiredLRP.Domain,
+		ProcessGuid: desiredLRP.ProcessGuid,
+		DesiredLRP:  desiredLRP,
+	}
+
+	patched, err := c.review(ctx, logger, review, func(patch json.RawMessage) error {
+		patchedLRP := &models.DesiredLRP{}
+		err := mergePatch(review.DesiredLRP, patch, patchedLRP)
+		if err != nil {
+			return err
+		}
+		if patchedLRP.ProcessGuid != review.ProcessGuid || patchedLRP.Domain != review.Domain {
+			return errors.New("patch changes the process guid or domain")
+		}
+		review.DesiredLRP = patchedLRP
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if patched {
+		err = review.DesiredLRP.Validate()
+		if err != nil {
+			return nil, invalidPatchError(err)
+		}
+	}
+
+	return review.DesiredLRP, nil
+}
+
+func (c *Chain) AdmitDesiredLRPUpdate(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error) {
+	logger = logger.Session("admit-desired-lrp-update", lager.Data{"process_guid": processGuid})
+
+	review := &Review{
+		Kind:             KindDesiredLRP,
+		Operation:        OperationUpdate,
+		ProcessGuid:      processGuid,
+		DesiredLRPUpdate: update,
+	}
+
+	patched, err := c.review(ctx, logger, review, func(patch json.RawMessage) error {
+		patchedUpdate := &models.DesiredLRPUpdate{}
+		err := mergePatch(review.DesiredLRPUpdate, patch, patchedUpdate)
+		if err != nil {
+			return err
+		}
+		review.DesiredLRPUpdate = patchedUpdate
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if patched {
+		err = review.DesiredLRPUpdate.Validate()
+		if err != nil {
+			return nil, invalidPatchError(err)
+		}
+	}
+
+	return review.DesiredLRPUpdate, nil
+}
+
+func (c *Chain) AdmitTask(ctx context.Context, logger lager.Logger, taskGuid, domain string, taskDefinition *models.TaskDefinition) (*models.TaskDefinition, error) {
+	logger = logger.Session("admit-task", lager.Data{"task_guid": taskGuid})
+
+	review := &Review{
+		Kind:           KindTask,
+		Operation:      OperationCreate,
+		Domain:         domain,
+		TaskGuid:       taskGuid,
+		TaskDefinition: taskDefinition,
+	}
+
+	patched, err := c.review(ctx, logger, review, func(patch json.RawMessage) error {
+		patchedDefinition := &models.TaskDefinition{}
+		err := mergePatch(review.TaskDefinition, patch, patchedDefinition)
+		if err != nil {
+			return err
+		}
+		review.TaskDefinition = patchedDefinition
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if patched {
+		err = review.TaskDefinition.Validate()
+		if err != nil {
+			return nil, invalidPatchError(err)
+		}
+	}
+
+	return review.TaskDefinition, nil
+}
+
+// review sends the review to every webhook of its kind and applies the
+// patches of the mutating webhooks. It returns whether anything was patched.
+func (c *Chain) review(ctx context.Context, logger lager.Logger, review *Review, applyPatch func(json.RawMessage) error) (bool, error) {
+	patched := false
+
+	for _, webhook := range c.webhooks {
+		if !webhook.Reviews(review.Kind) {
+			continue
+		}
+
+		logger := logger.WithData(lager.Data{"webhook": webhook.Name()})
+
+		response, err := webhook.Review(ctx, review)
+		if err == nil && !response.Allowed {
+			reason := response.Reason
+			if reason == "" {
+				reason = "no reason given"
+			}
+			logger.Info("denied", lager.Data{"reason": reason})
+			return patched, models.NewAdmissionDeniedError(webhook.Name(), reason)
+		}
+
+		if err == nil && hasPatch(response.Patch) {
+			if webhook.Mutating() {
+				err = applyPatch(response.Patch)
+			} else {
+				err = errors.New("validating webhook returned a patch")
+			}
+			if err == nil {
+				logger.Info("patched")
+				patched = true
+			}
+		}
+
+		if err != nil {
+			if webhook.IgnoresFailures() {
+				logger.Error("webhook-failed-ignoring", err)
+				continue
+			}
+			logger.Error("webhook-failed", err)
+			return patched, models.NewError(models.Error_AdmissionDenied, fmt.Sprintf("admission webhook %s failed: %s", webhook.Name(), err))
+		}
+	}
+
+	return patched, nil
+}
+
+func invalidPatchError(err error) error {
+	return models.NewError(models.Error_InvalidRequest, fmt.Sprintf("invalid after admission: %s", err))
+}
+
+func hasPatch(patch json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(patch)
+	return len(trimmed) > 0 && !bytes.Equal(trimmed, []byte("null"))
+}
+
+// mergePatch applies the JSON merge patch to the JSON representation of the
+// original and decodes the result into patched.
+func mergePatch(original interface{}, patch json.RawMessage, patched interface{}) error {
+	originalJSON, err := json.Marshal(original)
+	if err != nil {
+		return err
+	}
+
+	document, err := decodeJSON(originalJSON)
+	if err != nil {
+		return err
+	}
+
+	patchDocument, err := decodeJSON(patch)
+	if err != nil {
+		return fmt.Errorf("invalid patch: %s", err)
+	}
+
+	patchedJSON, err := json.Marshal(mergeValue(document, patchDocument))
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(patchedJSON, patched)
+	if err != nil {
+		return fmt.Errorf("invalid patch: %s", err)
+	}
+
+	return nil
+}
+
+// mergeValue implements the MergePatch function of RFC 7396.
+func mergeValue(target, patch interface{}) interface{} {
+	patchObject, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]interface{})
+	if !ok {
+		targetObject = map[string]interface{}{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergeValue(targetObject[key], value)
+		}
+	}
+
+	return targetObject
+}
+
+// decodeJSON keeps numbers as json.Number so that 64 bit integers survive
+// the round trip.
+func decodeJSON(data []byte) (interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value interface{}
+	err := decoder.Decode(&value)
+	return value, err
+}
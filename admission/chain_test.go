@@ -0,0 +1,275 @@
+package admission_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/durationjson"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Chain", func() {
+	var (
+		logger *lagertest.TestLogger
+		server *ghttp.Server
+		ctx    context.Context
+
+		desiredLRP *models.DesiredLRP
+	)
+
+	newWebhook := func(name, webhookType, path string) *admission.Webhook {
+		return admission.NewWebhook(admission.WebhookConfig{
+			Name: name,
+			URL:  server.URL() + path,
+			Type: webhookType,
+		}, server.HTTPTestServer.Client())
+	}
+
+	respondWith := func(response admission.Response) http.HandlerFunc {
+		return ghttp.RespondWithJSONEncoded(http.StatusOK, response)
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		server = ghttp.NewTLSServer()
+		ctx = context.Background()
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	Context("without webhooks", func() {
+		It("admits everything unchanged", func() {
+			chain := admission.NewChain()
+
+			admittedLRP, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedLRP).To(BeIdenticalTo(desiredLRP))
+		})
+	})
+
+	Describe("AdmitDesiredLRP", func() {
+		It("sends a review of the desired lrp", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/validate"),
+				ghttp.VerifyContentType("application/json"),
+				func(w http.ResponseWriter, req *http.Request) {
+					body, err := io.ReadAll(req.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					review := admission.Review{}
+					Expect(json.Unmarshal(body, &review)).To(Succeed())
+					Expect(review.Kind).To(Equal(admission.KindDesiredLRP))
+					Expect(review.Operation).To(Equal(admission.OperationCreate))
+					Expect(review.Domain).To(Equal(desiredLRP.Domain))
+					Expect(review.ProcessGuid).To(Equal("some-guid"))
+					Expect(review.DesiredLRP.RootFs).To(Equal(desiredLRP.RootFs))
+				},
+				respondWith(admission.Response{Allowed: true}),
+			))
+
+			chain := admission.NewChain(newWebhook("policy", admission.WebhookTypeValidating, "/validate"))
+			admittedLRP, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedLRP).To(Equal(desiredLRP))
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("denies desired lrps denied by a webhook", func() {
+			server.AppendHandlers(respondWith(admission.Response{Allowed: false, Reason: "privileged containers are not allowed"}))
+
+			chain := admission.NewChain(newWebhook("policy", admission.WebhookTypeValidating, "/validate"))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).To(Equal(models.NewAdmissionDeniedError("policy", "privileged containers are not allowed")))
+			Expect(models.ConvertError(err).Message).To(Equal("denied by admission webhook policy: privileged containers are not allowed"))
+		})
+
+		It("applies the patches of mutating webhooks", func() {
+			server.AppendHandlers(
+				respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"log_rate_limit": {"bytes_per_second": 1024}, "annotation": null}`)}),
+			)
+
+			desiredLRP.Annotation = "some-annotation"
+			chain := admission.NewChain(newWebhook("defaults", admission.WebhookTypeMutating, "/mutate"))
+			admittedLRP, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedLRP.LogRateLimit).To(Equal(&models.LogRateLimit{BytesPerSecond: 1024}))
+			Expect(admittedLRP.Annotation).To(BeEmpty())
+			Expect(admittedLRP.RootFs).To(Equal(desiredLRP.RootFs))
+			Expect(admittedLRP.Action).To(Equal(desiredLRP.Action))
+		})
+
+		It("calls the mutating webhooks before the validating webhooks, with the patched desired lrp", func() {
+			server.RouteToHandler("POST", "/mutate", respondWith(admission.Response{
+				Allowed: true,
+				Patch:   json.RawMessage(`{"log_rate_limit": {"bytes_per_second": 1024}}`),
+			}))
+			server.RouteToHandler("POST", "/validate", func(w http.ResponseWriter, req *http.Request) {
+				review := admission.Review{}
+				Expect(json.NewDecoder(req.Body).Decode(&review)).To(Succeed())
+				Expect(review.DesiredLRP.LogRateLimit).NotTo(BeNil())
+				respondWith(admission.Response{Allowed: true})(w, req)
+			})
+
+			chain := admission.NewChain(
+				newWebhook("policy", admission.WebhookTypeValidating, "/validate"),
+				newWebhook("defaults", admission.WebhookTypeMutating, "/mutate"),
+			)
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+
+			requests := server.ReceivedRequests()
+			Expect(requests).To(HaveLen(2))
+			Expect(requests[0].URL.Path).To(Equal("/mutate"))
+			Expect(requests[1].URL.Path).To(Equal("/validate"))
+		})
+
+		It("fails when a validating webhook returns a patch", func() {
+			server.AppendHandlers(respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"instances": 2}`)}))
+
+			chain := admission.NewChain(newWebhook("policy", admission.WebhookTypeValidating, "/validate"))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+			Expect(err.Error()).To(ContainSubstring("validating webhook returned a patch"))
+		})
+
+		It("fails when a patch changes the process guid", func() {
+			server.AppendHandlers(respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"process_guid": "other-guid"}`)}))
+
+			chain := admission.NewChain(newWebhook("defaults", admission.WebhookTypeMutating, "/mutate"))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+		})
+
+		It("rejects desired lrps that are invalid once patched", func() {
+			server.AppendHandlers(respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"instances": -1}`)}))
+
+			chain := admission.NewChain(newWebhook("defaults", admission.WebhookTypeMutating, "/mutate"))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidRequest))
+		})
+
+		It("skips webhooks of other kinds", func() {
+			webhook := admission.NewWebhook(admission.WebhookConfig{
+				Name:  "tasks-only",
+				URL:   server.URL() + "/validate",
+				Type:  admission.WebhookTypeValidating,
+				Kinds: []string{admission.KindTask},
+			}, server.HTTPTestServer.Client())
+
+			_, err := admission.NewChain(webhook).AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Describe("failure policies", func() {
+		var config admission.WebhookConfig
+
+		BeforeEach(func() {
+			config = admission.WebhookConfig{
+				Name: "policy",
+				URL:  server.URL() + "/validate",
+				Type: admission.WebhookTypeValidating,
+			}
+			server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+		})
+
+		It("denies requests when the webhook fails", func() {
+			chain := admission.NewChain(admission.NewWebhook(config, server.HTTPTestServer.Client()))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+			Expect(err.Error()).To(ContainSubstring("admission webhook policy failed: unexpected status code 500"))
+		})
+
+		It("admits requests when failures of the webhook are ignored", func() {
+			config.FailurePolicy = admission.FailurePolicyIgnore
+			chain := admission.NewChain(admission.NewWebhook(config, server.HTTPTestServer.Client()))
+			admittedLRP, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedLRP).To(Equal(desiredLRP))
+		})
+
+		It("fails when the webhook does not respond within its timeout", func() {
+			server.SetHandler(0, func(w http.ResponseWriter, req *http.Request) {
+				time.Sleep(200 * time.Millisecond)
+			})
+			config.Timeout = durationjson.Duration(20 * time.Millisecond)
+
+			chain := admission.NewChain(admission.NewWebhook(config, server.HTTPTestServer.Client()))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+		})
+
+		It("fails when the webhook is not trusted", func() {
+			chain := admission.NewChain(admission.NewWebhook(config, &http.Client{}))
+			_, err := chain.AdmitDesiredLRP(ctx, logger, desiredLRP)
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Describe("AdmitDesiredLRPUpdate", func() {
+		It("reviews and patches the update", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				func(w http.ResponseWriter, req *http.Request) {
+					review := admission.Review{}
+					Expect(json.NewDecoder(req.Body).Decode(&review)).To(Succeed())
+					Expect(review.Kind).To(Equal(admission.KindDesiredLRP))
+					Expect(review.Operation).To(Equal(admission.OperationUpdate))
+					Expect(review.ProcessGuid).To(Equal("some-guid"))
+					Expect(review.DesiredLRPUpdate.GetInstances()).To(BeEquivalentTo(10))
+				},
+				respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"instances": 4}`)}),
+			))
+
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(10)
+			update.SetAnnotation("some-annotation")
+
+			chain := admission.NewChain(newWebhook("limits", admission.WebhookTypeMutating, "/mutate"))
+			admittedUpdate, err := chain.AdmitDesiredLRPUpdate(ctx, logger, "some-guid", update)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedUpdate.GetInstances()).To(BeEquivalentTo(4))
+			Expect(admittedUpdate.GetAnnotation()).To(Equal("some-annotation"))
+		})
+	})
+
+	Describe("AdmitTask", func() {
+		It("reviews and patches the task definition", func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				func(w http.ResponseWriter, req *http.Request) {
+					review := admission.Review{}
+					Expect(json.NewDecoder(req.Body).Decode(&review)).To(Succeed())
+					Expect(review.Kind).To(Equal(admission.KindTask))
+					Expect(review.Operation).To(Equal(admission.OperationCreate))
+					Expect(review.TaskGuid).To(Equal("some-task"))
+					Expect(review.Domain).To(Equal("some-domain"))
+					Expect(review.TaskDefinition).NotTo(BeNil())
+				},
+				respondWith(admission.Response{Allowed: true, Patch: json.RawMessage(`{"privileged": false}`)}),
+			))
+
+			taskDefinition := model_helpers.NewValidTaskDefinition()
+			taskDefinition.Privileged = true
+
+			chain := admission.NewChain(newWebhook("unprivileged", admission.WebhookTypeMutating, "/mutate"))
+			admittedDefinition, err := chain.AdmitTask(ctx, logger, "some-task", "some-domain", taskDefinition)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(admittedDefinition.Privileged).To(BeFalse())
+			Expect(admittedDefinition.RootFs).To(Equal(taskDefinition.RootFs))
+		})
+	})
+})
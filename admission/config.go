@@ -0,0 +1,80 @@
+package admission
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"code.cloudfoundry.org/durationjson"
+)
+
+const (
+	DEFAULT_WEBHOOK_TIMEOUT = 10 * time.Second
+
+	WebhookTypeValidating = "validating"
+	WebhookTypeMutating   = "mutating"
+
+	FailurePolicyFail   = "fail"
+	FailurePolicyIgnore = "ignore"
+
+	KindDesiredLRP = "desired_lrp"
+	KindTask       = "task"
+)
+
+// WebhookConfig configures an admission webhook. Webhooks are called over
+// mutual TLS, presenting the client certificate and trusting only the given
+// CA.
+type WebhookConfig struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+	// Type is either "validating" or "mutating". Only mutating webhooks may
+	// patch what they admit.
+	Type string `json:"type"`
+	// FailurePolicy is either "fail", the default, to deny requests when the
+	// webhook cannot be reached or responds with an error, or "ignore" to
+	// admit them.
+	FailurePolicy string                `json:"failure_policy,omitempty"`
+	Timeout       durationjson.Duration `json:"timeout,omitempty"`
+	// Kinds restricts the webhook to "desired_lrp" or "task" reviews. The
+	// webhook reviews both if it is empty.
+	Kinds          []string `json:"kinds,omitempty"`
+	CACertFile     string   `json:"ca_cert_file"`
+	ClientCertFile string   `json:"client_cert_file"`
+	ClientKeyFile  string   `json:"client_key_file"`
+}
+
+func (c WebhookConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("admission webhook without a name")
+	}
+
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return fmt.Errorf("admission webhook %s: url must be an https URL", c.Name)
+	}
+
+	if c.Type != WebhookTypeValidating && c.Type != WebhookTypeMutating {
+		return fmt.Errorf("admission webhook %s: type must be %q or %q", c.Name, WebhookTypeValidating, WebhookTypeMutating)
+	}
+
+	if c.FailurePolicy != "" && c.FailurePolicy != FailurePolicyFail && c.FailurePolicy != FailurePolicyIgnore {
+		return fmt.Errorf("admission webhook %s: failure_policy must be %q or %q", c.Name, FailurePolicyFail, FailurePolicyIgnore)
+	}
+
+	if c.Timeout < 0 {
+		return fmt.Errorf("admission webhook %s: timeout must not be negative", c.Name)
+	}
+
+	for _, kind := range c.Kinds {
+		if kind != KindDesiredLRP && kind != KindTask {
+			return fmt.Errorf("admission webhook %s: unknown kind %q", c.Name, kind)
+		}
+	}
+
+	if c.CACertFile == "" || c.ClientCertFile == "" || c.ClientKeyFile == "" {
+		return fmt.Errorf("admission webhook %s: ca_cert_file, client_cert_file and client_key_file are required", c.Name)
+	}
+
+	return nil
+}
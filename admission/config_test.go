@@ -0,0 +1,73 @@
+package admission_test
+
+import (
+	"code.cloudfoundry.org/bbs/admission"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookConfig", func() {
+	var config admission.WebhookConfig
+
+	BeforeEach(func() {
+		config = admission.WebhookConfig{
+			Name:           "policy",
+			URL:            "https://policy.example.com/admit",
+			Type:           admission.WebhookTypeValidating,
+			CACertFile:     "ca.crt",
+			ClientCertFile: "client.crt",
+			ClientKeyFile:  "client.key",
+		}
+	})
+
+	It("accepts a valid config", func() {
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("requires a name", func() {
+		config.Name = ""
+		Expect(config.Validate()).To(MatchError(ContainSubstring("without a name")))
+	})
+
+	It("requires an https url", func() {
+		config.URL = "http://policy.example.com/admit"
+		Expect(config.Validate()).To(MatchError(ContainSubstring("https URL")))
+	})
+
+	It("requires a known type", func() {
+		config.Type = "auditing"
+		Expect(config.Validate()).To(MatchError(ContainSubstring("type must be")))
+	})
+
+	It("requires a known failure policy", func() {
+		config.FailurePolicy = admission.FailurePolicyIgnore
+		Expect(config.Validate()).To(Succeed())
+
+		config.FailurePolicy = "retry"
+		Expect(config.Validate()).To(MatchError(ContainSubstring("failure_policy must be")))
+	})
+
+	It("requires known kinds", func() {
+		config.Kinds = []string{admission.KindTask, "actual_lrp"}
+		Expect(config.Validate()).To(MatchError(ContainSubstring(`unknown kind "actual_lrp"`)))
+	})
+
+	It("requires mutual TLS", func() {
+		config.ClientKeyFile = ""
+		Expect(config.Validate()).To(MatchError(ContainSubstring("client_key_file are required")))
+	})
+
+	Describe("NewChainFromConfig", func() {
+		It("succeeds without webhooks", func() {
+			chain, err := admission.NewChainFromConfig(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(chain).NotTo(BeNil())
+		})
+
+		It("rejects invalid configs", func() {
+			config.URL = ""
+			_, err := admission.NewChainFromConfig([]admission.WebhookConfig{config})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,150 @@
+package admission
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/durationjson"
+	"code.cloudfoundry.org/tlsconfig"
+)
+
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+
+	// maxResponseSize bounds the responses read from webhooks.
+	maxResponseSize = 1024 * 1024
+)
+
+// Review is POSTed as JSON to every webhook. Exactly one of DesiredLRP,
+// DesiredLRPUpdate and TaskDefinition is set.
+type Review struct {
+	Kind             string                   `json:"kind"`
+	Operation        string                   `json:"operation"`
+	Domain           string                   `json:"domain,omitempty"`
+	ProcessGuid      string                   `json:"process_guid,omitempty"`
+	TaskGuid         string                   `json:"task_guid,omitempty"`
+	DesiredLRP       *models.DesiredLRP       `json:"desired_lrp,omitempty"`
+	DesiredLRPUpdate *models.DesiredLRPUpdate `json:"desired_lrp_update,omitempty"`
+	TaskDefinition   *models.TaskDefinition   `json:"task_definition,omitempty"`
+}
+
+// Response is the answer of a webhook to a Review. Patch is a JSON merge
+// patch (RFC 7396) of the reviewed object.
+type Response struct {
+	Allowed bool            `json:"allowed"`
+	Reason  string          `json:"reason,omitempty"`
+	Patch   json.RawMessage `json:"patch,omitempty"`
+}
+
+type Webhook struct {
+	config     WebhookConfig
+	httpClient *http.Client
+}
+
+// NewWebhook returns a webhook that sends its reviews with the given client.
+func NewWebhook(config WebhookConfig, httpClient *http.Client) *Webhook {
+	if config.Timeout <= 0 {
+		config.Timeout = durationjson.Duration(DEFAULT_WEBHOOK_TIMEOUT)
+	}
+	if config.FailurePolicy == "" {
+		config.FailurePolicy = FailurePolicyFail
+	}
+
+	return &Webhook{
+		config:     config,
+		httpClient: httpClient,
+	}
+}
+
+// NewWebhookFromConfig validates the config and returns a webhook that
+// sends its reviews over mutual TLS.
+func NewWebhookFromConfig(config WebhookConfig) (*Webhook, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig, err := tlsconfig.Build(
+		tlsconfig.WithInternalServiceDefaults(),
+		tlsconfig.WithIdentityFromFile(config.ClientCertFile, config.ClientKeyFile),
+	).Client(tlsconfig.WithAuthorityFromFile(config.CACertFile))
+	if err != nil {
+		return nil, fmt.Errorf("admission webhook %s: %s", config.Name, err)
+	}
+
+	return NewWebhook(config, cfhttp.NewClient(cfhttp.WithTLSConfig(tlsConfig))), nil
+}
+
+func (w *Webhook) Name() string {
+	return w.config.Name
+}
+
+func (w *Webhook) Mutating() bool {
+	return w.config.Type == WebhookTypeMutating
+}
+
+func (w *Webhook) IgnoresFailures() bool {
+	return w.config.FailurePolicy == FailurePolicyIgnore
+}
+
+func (w *Webhook) Reviews(kind string) bool {
+	if len(w.config.Kinds) == 0 {
+		return true
+	}
+	for _, k := range w.config.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Review sends the review to the webhook and returns its response. An error
+// is returned if the webhook cannot be reached within its timeout or does
+// not respond with a '200 OK' and a Response.
+func (w *Webhook) Review(ctx context.Context, review *Review) (*Response, error) {
+	payload, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Duration(w.config.Timeout))
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(ctx, "POST", w.config.URL, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := w.httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", response.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, maxResponseSize))
+	if err != nil {
+		return nil, err
+	}
+
+	reviewResponse := &Response{}
+	err = json.Unmarshal(body, reviewResponse)
+	if err != nil {
+		return nil, fmt.Errorf("invalid response: %s", err)
+	}
+
+	return reviewResponse, nil
+}
@@ -0,0 +1,28 @@
+package audit
+
+import "context"
+
+// Call is the API call on whose behalf resources are changed. Changes made
+// while handling a call are recorded in the audit log together with it.
+type Call struct {
+	Route               string
+	TraceID             string
+	CommonName          string
+	OrganizationalUnits []string
+	RemoteAddr          string
+}
+
+type callContextKey struct{}
+
+// NewContext returns a context carrying the call, so that the changes made
+// with it are audited.
+func NewContext(ctx context.Context, call Call) context.Context {
+	return context.WithValue(ctx, callContextKey{}, call)
+}
+
+// FromContext returns the call the context carries. Changes made with a
+// context without a call, such as those of convergence, are not audited.
+func FromContext(ctx context.Context) (Call, bool) {
+	call, ok := ctx.Value(callContextKey{}).(Call)
+	return call, ok
+}
@@ -0,0 +1,24 @@
+package audit_test
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/audit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Call", func() {
+	It("is carried by the context", func() {
+		call := audit.Call{Route: "DesireTask", TraceID: "trace-id", CommonName: "cc"}
+
+		carried, ok := audit.FromContext(audit.NewContext(context.Background(), call))
+		Expect(ok).To(BeTrue())
+		Expect(carried).To(Equal(call))
+	})
+
+	It("is not carried by other contexts", func() {
+		_, ok := audit.FromContext(context.Background())
+		Expect(ok).To(BeFalse())
+	})
+})
@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// Redacted replaces the values of credentials in the changes.
+const Redacted = `"[REDACTED]"`
+
+var redactedFields = map[string]bool{
+	"image_password": true,
+}
+
+// Diff returns the changes between the JSON encodings of before and after.
+// Objects are compared field by field, identified by their dotted path, and
+// any other value, including an array, as a whole. A nil before or after has
+// no fields, so creations and removals list every field of the resource.
+func Diff(before, after interface{}) ([]*models.AuditFieldChange, error) {
+	beforeFields, err := flatten(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := flatten(after)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := make([]string, 0, len(beforeFields)+len(afterFields))
+	for field := range beforeFields {
+		fields = append(fields, field)
+	}
+	for field := range afterFields {
+		if _, ok := beforeFields[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := []*models.AuditFieldChange{}
+	for _, field := range fields {
+		beforeValue, afterValue := beforeFields[field], afterFields[field]
+		if beforeValue == afterValue {
+			continue
+		}
+
+		if redactedFields[field[strings.LastIndex(field, ".")+1:]] {
+			beforeValue, afterValue = redact(beforeValue), redact(afterValue)
+		}
+		changes = append(changes, &models.AuditFieldChange{
+			Field:  field,
+			Before: beforeValue,
+			After:  afterValue,
+		})
+	}
+
+	return changes, nil
+}
+
+func flatten(resource interface{}) (map[string]string, error) {
+	fields := map[string]string{}
+	if resource == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	err = decoder.Decode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == nil {
+		return fields, nil
+	}
+	return fields, flattenValue(fields, "", value)
+}
+
+func flattenValue(fields map[string]string, path string, value interface{}) error {
+	if object, ok := value.(map[string]interface{}); ok {
+		for key, fieldValue := range object {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			err := flattenValue(fields, fieldPath, fieldValue)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	fields[path] = string(data)
+	return nil
+}
+
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return Redacted
+}
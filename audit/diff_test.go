@@ -0,0 +1,83 @@
+package audit_test
+
+import (
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Diff", func() {
+	var before, after *models.Task
+
+	BeforeEach(func() {
+		before = &models.Task{
+			TaskGuid: "task-guid",
+			Domain:   "some-domain",
+			State:    models.Task_Pending,
+			TaskDefinition: &models.TaskDefinition{
+				MemoryMb:      128,
+				PlacementTags: []string{"a"},
+				ImagePassword: "secret",
+			},
+		}
+		after = &models.Task{
+			TaskGuid: "task-guid",
+			Domain:   "some-domain",
+			State:    models.Task_Running,
+			CellId:   "cell-id",
+			TaskDefinition: &models.TaskDefinition{
+				MemoryMb:      128,
+				PlacementTags: []string{"a", "b"},
+				ImagePassword: "other-secret",
+			},
+		}
+	})
+
+	It("returns the changed fields by their path, in order", func() {
+		changes, err := audit.Diff(before, after)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(changes).To(ConsistOf(
+			&models.AuditFieldChange{Field: "cell_id", Before: `""`, After: `"cell-id"`},
+			&models.AuditFieldChange{Field: "image_password", Before: audit.Redacted, After: audit.Redacted},
+			&models.AuditFieldChange{Field: "placement_tags", Before: `["a"]`, After: `["a","b"]`},
+			&models.AuditFieldChange{Field: "state", Before: `"Pending"`, After: `"Running"`},
+		))
+		Expect(changes[0].Field).To(Equal("cell_id"))
+		Expect(changes[3].Field).To(Equal("state"))
+	})
+
+	It("compares nested objects field by field", func() {
+		beforeLRP := &models.ActualLRP{ActualLRPNetInfo: models.ActualLRPNetInfo{Address: "1.2.3.4", InstanceAddress: "10.0.0.1"}}
+		afterLRP := &models.ActualLRP{ActualLRPNetInfo: models.ActualLRPNetInfo{Address: "1.2.3.4", InstanceAddress: "10.0.0.2"}}
+		beforeLRP.SetRoutable(true)
+		afterLRP.SetRoutable(true)
+
+		changes, err := audit.Diff(beforeLRP, afterLRP)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ConsistOf(
+			&models.AuditFieldChange{Field: "instance_address", Before: `"10.0.0.1"`, After: `"10.0.0.2"`},
+		))
+	})
+
+	It("lists every field of created and removed resources", func() {
+		changes, err := audit.Diff(nil, after)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(&models.AuditFieldChange{Field: "task_guid", After: `"task-guid"`}))
+		for _, change := range changes {
+			Expect(change.Before).To(BeEmpty())
+		}
+
+		var removed *models.Task
+		changes, err = audit.Diff(before, removed)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(ContainElement(&models.AuditFieldChange{Field: "task_guid", Before: `"task-guid"`}))
+	})
+
+	It("returns no changes for equal resources", func() {
+		changes, err := audit.Diff(before, before)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+})
@@ -0,0 +1,148 @@
+package authorization
+
+import (
+	"crypto/tls"
+	"path"
+	"sort"
+)
+
+// Identity is what the rules of an authorizer match against: the subject of
+// the client certificate.
+type Identity struct {
+	CommonName          string
+	OrganizationalUnits []string
+}
+
+// IdentityFromTLS returns the identity of the leaf certificate the client
+// presented. The identity is empty if there is none.
+func IdentityFromTLS(state *tls.ConnectionState) Identity {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return Identity{}
+	}
+
+	cert := state.PeerCertificates[0]
+	return Identity{
+		CommonName:          cert.Subject.CommonName,
+		OrganizationalUnits: cert.Subject.OrganizationalUnit,
+	}
+}
+
+// Matches returns whether the identity matches the patterns, which use the
+// syntax of path.Match. The organizational unit pattern matches when any of
+// the organizational units of the identity matches, and an empty pattern
+// matches any identity.
+func (i Identity) Matches(commonName, organizationalUnit string) bool {
+	if commonName != "" {
+		matched, _ := path.Match(commonName, i.CommonName)
+		if !matched {
+			return false
+		}
+	}
+
+	if organizationalUnit != "" {
+		for _, ou := range i.OrganizationalUnits {
+			matched, _ := path.Match(organizationalUnit, ou)
+			if matched {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
+
+// Grant is what the roles of an identity allow on a route. A route may be
+// granted for every domain or only for some of them.
+type Grant struct {
+	// Roles lists the roles of the identity that allow the route.
+	Roles []string
+
+	unscoped bool
+	domains  map[string]bool
+}
+
+// Unscoped returns whether the route is granted regardless of the domain the
+// request acts on.
+func (g Grant) Unscoped() bool {
+	return g.unscoped
+}
+
+// Scoped returns whether the route is granted only for some domains.
+func (g Grant) Scoped() bool {
+	return !g.unscoped && len(g.domains) > 0
+}
+
+// AllowsDomain returns whether the route is granted for requests acting on
+// the domain.
+func (g Grant) AllowsDomain(domain string) bool {
+	return g.unscoped || (domain != "" && g.domains[domain])
+}
+
+type Authorizer struct {
+	rules []Rule
+}
+
+func NewAuthorizer(rules []Rule) (*Authorizer, error) {
+	for _, rule := range rules {
+		err := rule.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Authorizer{rules: rules}, nil
+}
+
+// Roles returns the roles of every rule matching the identity.
+func (a *Authorizer) Roles(identity Identity) []string {
+	roles := map[string]bool{}
+	for _, rule := range a.rules {
+		if rule.matches(identity) {
+			for _, role := range rule.Roles {
+				roles[role] = true
+			}
+		}
+	}
+
+	return sortedKeys(roles)
+}
+
+// Grant returns what the rules matching the identity allow on the route. The
+// grant is the union of the grants of every matching rule.
+func (a *Authorizer) Grant(identity Identity, route string) Grant {
+	grant := Grant{domains: map[string]bool{}}
+	roles := map[string]bool{}
+
+	for _, rule := range a.rules {
+		if !rule.matches(identity) {
+			continue
+		}
+
+		for _, role := range rule.Roles {
+			if !RoleAllows(role, route) {
+				continue
+			}
+
+			roles[role] = true
+			if len(rule.Domains) == 0 {
+				grant.unscoped = true
+			}
+			for _, domain := range rule.Domains {
+				grant.domains[domain] = true
+			}
+		}
+	}
+
+	grant.Roles = sortedKeys(roles)
+	return grant
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,137 @@
+package authorization_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authorizer", func() {
+	var (
+		rules      []authorization.Rule
+		authorizer *authorization.Authorizer
+	)
+
+	BeforeEach(func() {
+		rules = []authorization.Rule{
+			{CommonName: "cc-uploader", Roles: []string{authorization.RoleScheduler}},
+			{OrganizationalUnit: "cell:*", Roles: []string{authorization.RoleCell}},
+			{CommonName: "tenant-*", Roles: []string{authorization.RoleScheduler, authorization.RoleReadOnly}, Domains: []string{"tenant-a"}},
+			{CommonName: "tenant-b", Roles: []string{authorization.RoleScheduler}, Domains: []string{"tenant-b"}},
+			{CommonName: "operator", Roles: []string{authorization.RoleAdmin}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		authorizer, err = authorization.NewAuthorizer(rules)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects invalid rules", func() {
+		rules = append(rules, authorization.Rule{CommonName: "nobody"})
+		_, err := authorization.NewAuthorizer(rules)
+		Expect(err).To(MatchError(ContainSubstring("no roles")))
+	})
+
+	It("grants the routes of the roles of matching rules", func() {
+		identity := authorization.Identity{CommonName: "cc-uploader"}
+		grant := authorizer.Grant(identity, bbs.DesireTaskRoute_r2)
+		Expect(grant.Unscoped()).To(BeTrue())
+		Expect(grant.Roles).To(ConsistOf(authorization.RoleScheduler))
+		Expect(grant.AllowsDomain("any-domain")).To(BeTrue())
+	})
+
+	It("does not grant routes outside of the roles", func() {
+		identity := authorization.Identity{CommonName: "cc-uploader"}
+		grant := authorizer.Grant(identity, bbs.StartTaskRoute_r0)
+		Expect(grant.Unscoped()).To(BeFalse())
+		Expect(grant.Scoped()).To(BeFalse())
+		Expect(grant.Roles).To(BeEmpty())
+	})
+
+	It("matches any of the organizational units", func() {
+		identity := authorization.Identity{CommonName: "cell-z1-0", OrganizationalUnits: []string{"app:none", "cell:z1"}}
+		Expect(authorizer.Grant(identity, bbs.StartActualLRPRoute_r1).Unscoped()).To(BeTrue())
+		Expect(authorizer.Grant(identity, bbs.RemoveDesiredLRPRoute_r0).Unscoped()).To(BeFalse())
+	})
+
+	It("grants nothing to identities without matching rules", func() {
+		Expect(authorizer.Roles(authorization.Identity{})).To(BeEmpty())
+		Expect(authorizer.Grant(authorization.Identity{}, bbs.PingRoute_r0).Roles).To(BeEmpty())
+	})
+
+	It("grants every route to admins", func() {
+		identity := authorization.Identity{CommonName: "operator"}
+		for _, route := range bbs.Routes {
+			Expect(authorizer.Grant(identity, route.Name).Unscoped()).To(BeTrue(), route.Name)
+		}
+	})
+
+	It("grants ping to every role", func() {
+		for _, role := range []string{authorization.RoleReadOnly, authorization.RoleCell, authorization.RoleScheduler} {
+			authorizer, err := authorization.NewAuthorizer([]authorization.Rule{{CommonName: "client", Roles: []string{role}}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authorizer.Grant(authorization.Identity{CommonName: "client"}, bbs.PingRoute_r0).Unscoped()).To(BeTrue(), role)
+		}
+	})
+
+	Context("when the roles are scoped to domains", func() {
+		It("grants the routes only for those domains", func() {
+			grant := authorizer.Grant(authorization.Identity{CommonName: "tenant-a"}, bbs.DesireDesiredLRPRoute_r2)
+			Expect(grant.Unscoped()).To(BeFalse())
+			Expect(grant.Scoped()).To(BeTrue())
+			Expect(grant.AllowsDomain("tenant-a")).To(BeTrue())
+			Expect(grant.AllowsDomain("tenant-b")).To(BeFalse())
+			Expect(grant.AllowsDomain("")).To(BeFalse())
+		})
+
+		It("grants the union of the domains of every matching rule", func() {
+			grant := authorizer.Grant(authorization.Identity{CommonName: "tenant-b"}, bbs.DesireDesiredLRPRoute_r2)
+			Expect(grant.AllowsDomain("tenant-a")).To(BeTrue())
+			Expect(grant.AllowsDomain("tenant-b")).To(BeTrue())
+			Expect(grant.AllowsDomain("tenant-c")).To(BeFalse())
+		})
+
+		It("only scopes the routes of the scoped rules", func() {
+			grant := authorizer.Grant(authorization.Identity{CommonName: "tenant-b"}, bbs.TasksRoute_r3)
+			Expect(grant.Roles).To(ConsistOf(authorization.RoleReadOnly))
+			Expect(grant.AllowsDomain("tenant-a")).To(BeTrue())
+			Expect(grant.AllowsDomain("tenant-b")).To(BeFalse())
+		})
+	})
+
+	Describe("Roles", func() {
+		It("returns the roles of every matching rule", func() {
+			Expect(authorizer.Roles(authorization.Identity{CommonName: "tenant-b"})).To(Equal([]string{
+				authorization.RoleReadOnly,
+				authorization.RoleScheduler,
+			}))
+		})
+	})
+
+	Describe("IdentityFromTLS", func() {
+		It("returns the subject of the leaf certificate", func() {
+			state := &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{Subject: pkix.Name{CommonName: "cell-z1-0", OrganizationalUnit: []string{"cell:z1"}}},
+					{Subject: pkix.Name{CommonName: "intermediate"}},
+				},
+			}
+			Expect(authorization.IdentityFromTLS(state)).To(Equal(authorization.Identity{
+				CommonName:          "cell-z1-0",
+				OrganizationalUnits: []string{"cell:z1"},
+			}))
+		})
+
+		It("returns an empty identity without a certificate", func() {
+			Expect(authorization.IdentityFromTLS(nil)).To(Equal(authorization.Identity{}))
+			Expect(authorization.IdentityFromTLS(&tls.ConnectionState{})).To(Equal(authorization.Identity{}))
+		})
+	})
+})
@@ -0,0 +1,80 @@
+package authorization
+
+import (
+	"errors"
+	"fmt"
+	"path"
+)
+
+const (
+	// RoleReadOnly may call the routes that list and get resources, including
+	// the event streams.
+	RoleReadOnly = "read-only"
+	// RoleCell may call the routes the rep uses to report the lifecycle of
+	// actual LRPs and tasks.
+	RoleCell = "cell"
+	// RoleScheduler may call the routes that desire, update and remove
+	// desired LRPs, tasks and domains.
+	RoleScheduler = "scheduler"
+	// RoleAdmin may call every route.
+	RoleAdmin = "admin"
+)
+
+// Config maps client certificate identities to roles. Unless it is enabled
+// every client with a certificate signed by the CA may call every route.
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Rules   []Rule `json:"rules,omitempty"`
+}
+
+// Rule grants its roles to the clients whose certificate matches its
+// patterns. Patterns use the syntax of path.Match. OrganizationalUnit matches
+// when any of the organizational units of the certificate matches, and an
+// empty pattern matches any certificate.
+type Rule struct {
+	CommonName         string   `json:"common_name,omitempty"`
+	OrganizationalUnit string   `json:"organizational_unit,omitempty"`
+	Roles              []string `json:"roles"`
+	// Domains scopes the roles to the requests acting on these domains. The
+	// roles are not scoped if it is empty.
+	Domains []string `json:"domains,omitempty"`
+}
+
+func (r Rule) Validate() error {
+	if r.CommonName == "" && r.OrganizationalUnit == "" {
+		return errors.New("authorization rule without a common_name or organizational_unit")
+	}
+
+	for _, pattern := range []string{r.CommonName, r.OrganizationalUnit} {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("authorization rule: invalid pattern %q", pattern)
+		}
+	}
+
+	if len(r.Roles) == 0 {
+		return fmt.Errorf("authorization rule %s: no roles", r)
+	}
+
+	for _, role := range r.Roles {
+		if !knownRole(role) {
+			return fmt.Errorf("authorization rule %s: unknown role %q", r, role)
+		}
+	}
+
+	for _, domain := range r.Domains {
+		if domain == "" {
+			return fmt.Errorf("authorization rule %s: empty domain", r)
+		}
+	}
+
+	return nil
+}
+
+func (r Rule) String() string {
+	return fmt.Sprintf("{common_name: %q, organizational_unit: %q}", r.CommonName, r.OrganizationalUnit)
+}
+
+func (r Rule) matches(identity Identity) bool {
+	return identity.Matches(r.CommonName, r.OrganizationalUnit)
+}
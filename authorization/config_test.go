@@ -0,0 +1,53 @@
+package authorization_test
+
+import (
+	"code.cloudfoundry.org/bbs/authorization"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rule", func() {
+	var rule authorization.Rule
+
+	BeforeEach(func() {
+		rule = authorization.Rule{
+			CommonName: "cc-*",
+			Roles:      []string{authorization.RoleScheduler},
+		}
+	})
+
+	It("accepts a valid rule", func() {
+		Expect(rule.Validate()).To(Succeed())
+	})
+
+	It("accepts a rule matching only an organizational unit", func() {
+		rule.CommonName = ""
+		rule.OrganizationalUnit = "app:*"
+		Expect(rule.Validate()).To(Succeed())
+	})
+
+	It("requires a pattern", func() {
+		rule.CommonName = ""
+		Expect(rule.Validate()).To(MatchError(ContainSubstring("without a common_name or organizational_unit")))
+	})
+
+	It("rejects invalid patterns", func() {
+		rule.CommonName = "cc-["
+		Expect(rule.Validate()).To(MatchError(ContainSubstring("invalid pattern")))
+	})
+
+	It("requires roles", func() {
+		rule.Roles = nil
+		Expect(rule.Validate()).To(MatchError(ContainSubstring("no roles")))
+	})
+
+	It("rejects unknown roles", func() {
+		rule.Roles = []string{"superuser"}
+		Expect(rule.Validate()).To(MatchError(ContainSubstring(`unknown role "superuser"`)))
+	})
+
+	It("rejects empty domains", func() {
+		rule.Domains = []string{""}
+		Expect(rule.Validate()).To(MatchError(ContainSubstring("empty domain")))
+	})
+})
@@ -0,0 +1 @@
+package authorization // import "code.cloudfoundry.org/bbs/authorization"
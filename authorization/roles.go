@@ -0,0 +1,117 @@
+package authorization
+
+import "code.cloudfoundry.org/bbs"
+
+// roleRoutes lists the routes each role may call. The admin role may call
+// every route and is not listed.
+var roleRoutes = map[string]map[string]bool{
+	RoleReadOnly: routeSet(
+		bbs.PingRoute_r0,
+		bbs.DomainsRoute_r0,
+		bbs.DomainQuotasRoute_r0,
+		bbs.DomainUsageRoute_r0,
+		bbs.ActualLRPsRoute_r0,
+		bbs.ActualLRPsByProcessGuidsRoute_r0,
+		bbs.ActualLRPHistoryRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.ActualLRPGroupsRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.ActualLRPGroupsByProcessGuidRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0,
+		bbs.DesiredLRPsRoute_r3,
+		bbs.DesiredLRPByProcessGuidRoute_r3,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.DesiredLRPsRoute_r2,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.DesiredLRPByProcessGuidRoute_r2,
+		bbs.DesiredLRPSchedulingInfosRoute_r0,
+		bbs.DesiredLRPSchedulingInfoByProcessGuid_r0,
+		bbs.DesiredLRPRoutingInfosRoute_r0,
+		bbs.DeploymentByProcessGuidRoute_r0,
+		bbs.TasksRoute_r3,
+		bbs.TaskByGuidRoute_r3,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.TasksRoute_r2,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.TaskByGuidRoute_r2,
+		bbs.ScheduledTasksRoute_r0,
+		bbs.TaskCallbacksRoute_r0,
+		bbs.AuditRecordsRoute_r0,
+		bbs.OverloadStatusRoute_r0,
+		bbs.CrashStormBreakersRoute_r0,
+		bbs.LRPGroupEventStreamRoute_r1,
+		bbs.TaskEventStreamRoute_r1,
+		bbs.LRPInstanceEventStreamRoute_r1,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.EventStreamRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.TaskEventStreamRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.LrpInstanceEventStreamRoute_r0,
+		bbs.CellsRoute_r0,
+		bbs.CellDrainsRoute_r0,
+	),
+
+	RoleCell: routeSet(
+		bbs.PingRoute_r0,
+		bbs.ClaimActualLRPRoute_r0,
+		bbs.StartActualLRPRoute_r1,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.StartActualLRPRoute_r0,
+		bbs.CrashActualLRPRoute_r0,
+		bbs.FailActualLRPRoute_r0,
+		bbs.RemoveActualLRPRoute_r0,
+		bbs.RemoveEvacuatingActualLRPRoute_r0,
+		bbs.EvacuateClaimedActualLRPRoute_r0,
+		bbs.EvacuateCrashedActualLRPRoute_r0,
+		bbs.EvacuateStoppedActualLRPRoute_r0,
+		bbs.EvacuateRunningActualLRPRoute_r1,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.EvacuateRunningActualLRPRoute_r0,
+		bbs.StartTaskRoute_r0,
+		//lint:ignore SA1019 - authorizing deprecated routes until they are removed
+		bbs.FailTaskRoute_r0,
+		bbs.RejectTaskRoute_r0,
+		bbs.CompleteTaskRoute_r0,
+	),
+
+	RoleScheduler: routeSet(
+		bbs.PingRoute_r0,
+		bbs.UpsertDomainRoute_r0,
+		bbs.DesireDesiredLRPRoute_r2,
+		bbs.UpdateDesiredLRPRoute_r0,
+		bbs.RemoveDesiredLRPRoute_r0,
+		bbs.RetireActualLRPRoute_r0,
+		bbs.StartDeploymentRoute_r0,
+		bbs.PauseDeploymentRoute_r0,
+		bbs.ResumeDeploymentRoute_r0,
+		bbs.RollbackDeploymentRoute_r0,
+		bbs.DesireTaskRoute_r2,
+		bbs.CancelTaskRoute_r0,
+		bbs.ResolvingTaskRoute_r0,
+		bbs.DeleteTaskRoute_r0,
+		bbs.DesireScheduledTaskRoute_r0,
+		bbs.UpdateScheduledTaskRoute_r0,
+		bbs.SuspendScheduledTaskRoute_r0,
+		bbs.DeleteScheduledTaskRoute_r0,
+	),
+}
+
+func routeSet(routes ...string) map[string]bool {
+	set := map[string]bool{}
+	for _, route := range routes {
+		set[route] = true
+	}
+	return set
+}
+
+func knownRole(role string) bool {
+	_, ok := roleRoutes[role]
+	return ok || role == RoleAdmin
+}
+
+// RoleAllows returns whether the role may call the route.
+func RoleAllows(role, route string) bool {
+	return role == RoleAdmin || roleRoutes[role][route]
+}
@@ -2,6 +2,7 @@ package bbs
 
 import (
 	"bytes"
+	"context"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -10,6 +11,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/bbs/events"
@@ -21,11 +23,14 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/tedsuo/rata"
 	"github.com/vito/go-sse/sse"
+	"google.golang.org/grpc"
 )
 
 const (
 	ContentTypeHeader    = "Content-Type"
 	XCfRouterErrorHeader = "X-Cf-Routererror"
+	RetryAfterHeader     = "Retry-After"
+	IdempotencyKeyHeader = "Idempotency-Key"
 	ProtoContentType     = "application/x-protobuf"
 	KeepContainer        = true
 	DeleteContainer      = false
@@ -45,7 +50,8 @@ var EndpointNotFoundErr = models.NewError(models.Error_InvalidResponse, fmt.Spri
 The InternalClient interface exposes all available endpoints of the BBS server,
 including private endpoints which should be used exclusively by internal Diego
 components. To interact with the BBS from outside of Diego, the Client
-should be used instead.
+should be used instead. The InternalContextClient exposes the same calls
+taking a context.Context.
 */
 type InternalClient interface {
 	Client
@@ -85,6 +91,18 @@ type Client interface {
 
 	// Lists all Cells
 	Cells(logger lager.Logger, traceID string) ([]*models.CellPresence, error)
+
+	// Cordons the cell, so that no new work is placed on it
+	CordonCell(logger lager.Logger, traceID string, cellId string) error
+
+	// Uncordons the cell, so that work may be placed on it again
+	UncordonCell(logger lager.Logger, traceID string, cellId string) error
+
+	// Cordons the cell and asks its rep to evacuate it
+	DrainCell(logger lager.Logger, traceID string, cellId string) (*models.CellDrain, error)
+
+	// Lists the drains of the cells
+	CellDrains(logger lager.Logger, traceID string) ([]*models.CellDrain, error)
 }
 
 /*
@@ -103,6 +121,9 @@ type ExternalTaskClient interface {
 	// List all Tasks that match filter
 	TasksWithFilter(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, error)
 
+	// Lists a single page of Tasks that match filter, along with the token of the next page
+	TasksPage(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, string, error)
+
 	// Lists all Tasks of the given domain
 	TasksByDomain(logger lager.Logger, traceID string, domain string) ([]*models.Task, error)
 
@@ -120,6 +141,39 @@ type ExternalTaskClient interface {
 
 	// Deletes a completed task with the given guid
 	DeleteTask(logger lager.Logger, traceID string, taskGuid string) error
+
+	// Lists all ScheduledTasks, or those of the given domain
+	ScheduledTasks(logger lager.Logger, traceID string, domain string) ([]*models.ScheduledTask, error)
+
+	// Creates a ScheduledTask that runs its task definition according to its cron expression
+	DesireScheduledTask(logger lager.Logger, traceID string, schedule *models.ScheduledTask) (*models.ScheduledTask, error)
+
+	// Updates the ScheduledTask with the given schedule guid
+	UpdateScheduledTask(logger lager.Logger, traceID string, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+
+	// Suspends or resumes the ScheduledTask with the given schedule guid
+	SuspendScheduledTask(logger lager.Logger, traceID string, scheduleGuid string, suspended bool) (*models.ScheduledTask, error)
+
+	// Deletes the ScheduledTask with the given schedule guid, leaving the tasks it already ran
+	DeleteScheduledTask(logger lager.Logger, traceID string, scheduleGuid string) error
+
+	// Lists the completion callbacks waiting to be delivered, or only the dead-lettered ones
+	TaskCallbacks(logger lager.Logger, traceID string, deadLettered bool) ([]*models.TaskCallback, error)
+
+	// Retries the completion callback of the task with the given guid from its first attempt
+	ReplayTaskCallback(logger lager.Logger, traceID string, taskGuid string) (*models.TaskCallback, error)
+
+	// Lists a single page of the audit records that match filter, oldest first, along with the token of the next page
+	AuditRecordsPage(logger lager.Logger, traceID string, filter models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+
+	// Returns the level at which the BBS sheds requests while the database is overloaded
+	OverloadStatus(logger lager.Logger, traceID string) (*models.OverloadStatus, error)
+
+	// Lists the open crash storm breakers, which pause the crash restarts of the ActualLRPs in their scope
+	CrashStormBreakers(logger lager.Logger, traceID string) ([]*models.CrashStormBreaker, error)
+
+	// Closes the open crash storm breaker of the scope and key, resuming the crash restarts it paused
+	ResetCrashStormBreaker(logger lager.Logger, traceID string, scope models.CrashStormBreaker_Scope, key string) (*models.CrashStormBreaker, error)
 }
 
 /*
@@ -131,6 +185,18 @@ type ExternalDomainClient interface {
 
 	// Creates a domain or bumps the ttl on an existing domain
 	UpsertDomain(logger lager.Logger, traceID string, domain string, ttl time.Duration) error
+
+	// Lists the quotas of all domains that have one
+	DomainQuotas(logger lager.Logger, traceID string) ([]*models.DomainQuota, error)
+
+	// Creates or replaces the quota of the domain
+	SetDomainQuota(logger lager.Logger, traceID string, quota *models.DomainQuota) (*models.DomainQuota, error)
+
+	// Removes the quota of the domain, leaving its resources unlimited
+	RemoveDomainQuota(logger lager.Logger, traceID string, domain string) error
+
+	// Returns the resources used by the domain, along with its quota if it has one
+	DomainUsage(logger lager.Logger, traceID string, domain string) (*models.DomainUsage, *models.DomainQuota, error)
 }
 
 /*
@@ -140,9 +206,15 @@ type ExternalActualLRPClient interface {
 	// Returns all ActualLRPs matching the given ActualLRPFilter
 	ActualLRPs(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 
+	// Returns a single page of ActualLRPs matching the given ActualLRPFilter, along with the token of the next page
+	ActualLRPsPage(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+
 	// Returns all ActualLRPs matching the given process GUIDs
 	ActualLRPsByProcessGuids(logger lager.Logger, traceID string, processGuids []string) ([]*models.ActualLRP, error)
 
+	// Returns the transitions of the instances at the given index of the process, oldest first
+	ActualLRPHistory(logger lager.Logger, traceID string, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error)
+
 	// Returns all ActualLRPGroups matching the given ActualLRPFilter
 	//lint:ignore SA1019 - deprecated function returning deprecated data
 	// Deprecated: use ActualLRPs instead
@@ -169,6 +241,9 @@ type ExternalDesiredLRPClient interface {
 	// Lists all DesiredLRPs that match the given DesiredLRPFilter
 	DesiredLRPs(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
 
+	// Lists a single page of DesiredLRPs that match the given DesiredLRPFilter, along with the token of the next page
+	DesiredLRPsPage(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+
 	// Returns the DesiredLRP with the given process guid
 	DesiredLRPByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.DesiredLRP, error)
 
@@ -189,6 +264,21 @@ type ExternalDesiredLRPClient interface {
 
 	// Removes the DesiredLRP matching the given process guid
 	RemoveDesiredLRP(logger lager.Logger, traceID string, processGuid string) error
+
+	// Starts replacing the run info of the DesiredLRP matching the given process guid, a batch of instances at a time
+	StartDeployment(logger lager.Logger, traceID string, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error)
+
+	// Returns the latest Deployment of the DesiredLRP matching the given process guid
+	DeploymentByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error)
+
+	// Stops the Deployment matching the given process guid from replacing further instances
+	PauseDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error)
+
+	// Lets a paused Deployment matching the given process guid replace instances again
+	ResumeDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error)
+
+	// Restores the run info the Deployment matching the given process guid replaced
+	RollbackDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error)
 }
 
 /*
@@ -205,6 +295,12 @@ type ExternalEventClient interface {
 	SubscribeToEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error)
 
 	SubscribeToInstanceEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error)
+
+	// The filtered subscriptions only deliver events matching every non-empty
+	// field of the filter. The filter is applied by the BBS, so unwanted events
+	// are never sent to the client.
+	SubscribeToInstanceEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+	SubscribeToTaskEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
 }
 
 type ClientConfig struct {
@@ -247,23 +343,11 @@ func NewSecureSkipVerifyClient(url, certFile, keyFile string, clientSessionCache
 }
 
 func NewClientWithConfig(cfg ClientConfig) (InternalClient, error) {
-	if cfg.Retries == 0 {
-		cfg.Retries = DefaultRetryCount
-	}
-
-	if cfg.RetryInterval == 0 {
-		cfg.RetryInterval = time.Second
-	}
-
-	if cfg.InsecureSkipVerify {
-		cfg.CAFile = ""
-	}
-
-	if cfg.IsTLS {
-		return newSecureClient(cfg)
-	} else {
-		return newClient(cfg), nil
+	contextClient, err := NewContextClient(cfg)
+	if err != nil {
+		return nil, err
 	}
+	return &traceIDClient{client: contextClient}, nil
 }
 
 func newClient(cfg ClientConfig) *client {
@@ -275,7 +359,7 @@ func newClient(cfg ClientConfig) *client {
 		retryInterval:       cfg.RetryInterval,
 	}
 }
-func newSecureClient(cfg ClientConfig) (InternalClient, error) {
+func newSecureClient(cfg ClientConfig) (InternalContextClient, error) {
 	bbsURL, err := url.Parse(cfg.URL)
 	if err != nil {
 		return nil, err
@@ -284,21 +368,10 @@ func newSecureClient(cfg ClientConfig) (InternalClient, error) {
 		return nil, errors.New("Expected https URL")
 	}
 
-	var clientOpts []tlsconfig.ClientOption
-	if !cfg.InsecureSkipVerify {
-		clientOpts = append(clientOpts, tlsconfig.WithAuthorityFromFile(cfg.CAFile))
-	}
-
-	tlsConfig, err := tlsconfig.Build(
-		tlsconfig.WithInternalServiceDefaults(),
-		tlsconfig.WithIdentityFromFile(cfg.CertFile, cfg.KeyFile),
-	).Client(clientOpts...)
+	tlsConfig, err := clientTLSConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
-	tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(cfg.ClientSessionCacheSize)
-
-	tlsConfig.InsecureSkipVerify = cfg.InsecureSkipVerify
 
 	httpClient := cfhttp.NewClient(
 		cfhttp.WithRequestTimeout(cfg.RequestTimeout),
@@ -320,136 +393,245 @@ func newSecureClient(cfg ClientConfig) (InternalClient, error) {
 	}, nil
 }
 
+func clientTLSConfig(cfg ClientConfig) (*tls.Config, error) {
+	var clientOpts []tlsconfig.ClientOption
+	if !cfg.InsecureSkipVerify {
+		clientOpts = append(clientOpts, tlsconfig.WithAuthorityFromFile(cfg.CAFile))
+	}
+
+	tlsConfig, err := tlsconfig.Build(
+		tlsconfig.WithInternalServiceDefaults(),
+		tlsconfig.WithIdentityFromFile(cfg.CertFile, cfg.KeyFile),
+	).Client(clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig.ClientSessionCache = tls.NewLRUClientSessionCache(cfg.ClientSessionCacheSize)
+
+	tlsConfig.InsecureSkipVerify = cfg.InsecureSkipVerify
+
+	return tlsConfig, nil
+}
+
 type client struct {
 	httpClient          *http.Client
 	streamingHTTPClient *http.Client
 	reqGen              *rata.RequestGenerator
 	requestRetryCount   int
 	retryInterval       time.Duration
+
+	// grpcConn is set instead of the HTTP clients when the client speaks
+	// the gRPC API. See NewGRPCClient.
+	grpcConn       *grpc.ClientConn
+	requestTimeout time.Duration
 }
 
-func (c *client) Ping(logger lager.Logger, traceID string) bool {
+func (c *client) Ping(ctx context.Context, logger lager.Logger) bool {
 	response := models.PingResponse{}
-	err := c.doRequest(logger, traceID, PingRoute_r0, nil, nil, nil, &response)
+	err := c.doRequest(ctx, logger, PingRoute_r0, nil, nil, &models.PingRequest{}, &response)
 	if err != nil {
 		return false
 	}
 	return response.Available
 }
 
-func (c *client) Domains(logger lager.Logger, traceID string) ([]string, error) {
+func (c *client) Domains(ctx context.Context, logger lager.Logger) ([]string, error) {
 	response := models.DomainsResponse{}
-	err := c.doRequest(logger, traceID, DomainsRoute_r0, nil, nil, nil, &response)
+	err := c.doRequest(ctx, logger, DomainsRoute_r0, nil, nil, &models.DomainsRequest{}, &response)
 	if err != nil {
 		return nil, err
 	}
-	return response.Domains, response.Error.ToError()
+	return response.Domains, responseError(DomainsRoute_r0, response.Error)
 }
 
-func (c *client) UpsertDomain(logger lager.Logger, traceID string, domain string, ttl time.Duration) error {
+func (c *client) UpsertDomain(ctx context.Context, logger lager.Logger, domain string, ttl time.Duration) error {
 	request := models.UpsertDomainRequest{
 		Domain: domain,
 		Ttl:    uint32(ttl.Seconds()),
 	}
 	response := models.UpsertDomainResponse{}
-	err := c.doRequest(logger, traceID, UpsertDomainRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, UpsertDomainRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(UpsertDomainRoute_r0, response.Error)
 }
 
-func (c *client) ActualLRPs(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+func (c *client) DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error) {
+	response := models.DomainQuotasResponse{}
+	err := c.doRequest(ctx, logger, DomainQuotasRoute_r0, nil, nil, &models.DomainQuotasRequest{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.DomainQuotas, responseError(DomainQuotasRoute_r0, response.Error)
+}
+
+func (c *client) SetDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) (*models.DomainQuota, error) {
+	request := models.SetDomainQuotaRequest{
+		DomainQuota: quota,
+	}
+	response := models.DomainQuotaResponse{}
+	err := c.doRequest(ctx, logger, SetDomainQuotaRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.DomainQuota, responseError(SetDomainQuotaRoute_r0, response.Error)
+}
+
+func (c *client) RemoveDomainQuota(ctx context.Context, logger lager.Logger, domain string) error {
+	request := models.RemoveDomainQuotaRequest{
+		Domain: domain,
+	}
+	response := models.DomainQuotaLifecycleResponse{}
+	err := c.doRequest(ctx, logger, RemoveDomainQuotaRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return responseError(RemoveDomainQuotaRoute_r0, response.Error)
+}
+
+func (c *client) DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, *models.DomainQuota, error) {
+	request := models.DomainUsageRequest{
+		Domain: domain,
+	}
+	response := models.DomainUsageResponse{}
+	err := c.doRequest(ctx, logger, DomainUsageRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, nil, err
+	}
+	return response.Usage, response.DomainQuota, responseError(DomainUsageRoute_r0, response.Error)
+}
+
+func (c *client) ActualLRPs(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	actualLRPs, _, err := c.ActualLRPsPage(ctx, logger, filter)
+	return actualLRPs, err
+}
+
+func (c *client) ActualLRPsPage(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	response, err := c.actualLRPs(ctx, logger, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.ActualLrps, response.NextPageToken, responseError(ActualLRPsRoute_r0, response.Error)
+}
+
+func (c *client) ActualLRPsWithResourceVersion(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error) {
+	response, err := c.actualLRPs(ctx, logger, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return response.ActualLrps, response.ResourceVersion, responseError(ActualLRPsRoute_r0, response.Error)
+}
+
+func (c *client) actualLRPs(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) (*models.ActualLRPsResponse, error) {
 	request := models.ActualLRPsRequest{
 		Domain:      filter.Domain,
 		CellId:      filter.CellID,
 		ProcessGuid: filter.ProcessGuid,
+		PageSize:    filter.PageSize,
+		PageToken:   filter.PageToken,
 	}
 	if filter.Index != nil {
 		request.SetIndex(*filter.Index)
 	}
 	response := models.ActualLRPsResponse{}
-	err := c.doRequest(logger, traceID, ActualLRPsRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ActualLRPsRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.ActualLrps, response.Error.ToError()
+	return &response, nil
 }
 
-func (c *client) ActualLRPsByProcessGuids(logger lager.Logger, traceID string, processGuids []string) ([]*models.ActualLRP, error) {
+func (c *client) ActualLRPsByProcessGuids(ctx context.Context, logger lager.Logger, processGuids []string) ([]*models.ActualLRP, error) {
 	request := models.ActualLRPsByProcessGuidsRequest{
 		ProcessGuids: processGuids,
 	}
 	response := models.ActualLRPsByProcessGuidsResponse{}
-	err := c.doRequest(logger, traceID, ActualLRPsByProcessGuidsRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ActualLRPsByProcessGuidsRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.ActualLrps, response.Error.ToError()
+	return response.ActualLrps, responseError(ActualLRPsByProcessGuidsRoute_r0, response.Error)
+}
+
+func (c *client) ActualLRPHistory(ctx context.Context, logger lager.Logger, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error) {
+	request := models.ActualLRPHistoryRequest{
+		ProcessGuid: processGuid,
+		Index:       index,
+	}
+	response := models.ActualLRPHistoryResponse{}
+	err := c.doRequest(ctx, logger, ActualLRPHistoryRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Records, responseError(ActualLRPHistoryRoute_r0, response.Error)
 }
 
 // Deprecated: use ActualLRPs instead
-func (c *client) ActualLRPGroups(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+func (c *client) ActualLRPGroups(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
 	request := models.ActualLRPGroupsRequest{
 		Domain: filter.Domain,
 		CellId: filter.CellID,
 	}
 	response := models.ActualLRPGroupsResponse{}
-	err := c.doRequest(logger, traceID, ActualLRPGroupsRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ActualLRPGroupsRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.ActualLrpGroups, response.Error.ToError()
+	return response.ActualLrpGroups, responseError(ActualLRPGroupsRoute_r0, response.Error)
 }
 
 // Deprecated: use ActaulLRPs instead
-func (c *client) ActualLRPGroupsByProcessGuid(logger lager.Logger, traceID string, processGuid string) ([]*models.ActualLRPGroup, error) {
+func (c *client) ActualLRPGroupsByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error) {
 	request := models.ActualLRPGroupsByProcessGuidRequest{
 		ProcessGuid: processGuid,
 	}
 	response := models.ActualLRPGroupsResponse{}
-	err := c.doRequest(logger, traceID, ActualLRPGroupsByProcessGuidRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ActualLRPGroupsByProcessGuidRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.ActualLrpGroups, response.Error.ToError()
+	return response.ActualLrpGroups, responseError(ActualLRPGroupsByProcessGuidRoute_r0, response.Error)
 }
 
 // Deprecated: use ActaulLRPs instead
-func (c *client) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, traceID string, processGuid string, index int) (*models.ActualLRPGroup, error) {
+func (c *client) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, logger lager.Logger, processGuid string, index int) (*models.ActualLRPGroup, error) {
 	request := models.ActualLRPGroupByProcessGuidAndIndexRequest{
 		ProcessGuid: processGuid,
 		Index:       int32(index),
 	}
 	response := models.ActualLRPGroupResponse{}
-	err := c.doRequest(logger, traceID, ActualLRPGroupByProcessGuidAndIndexRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ActualLRPGroupByProcessGuidAndIndexRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.ActualLrpGroup, response.Error.ToError()
+	return response.ActualLrpGroup, responseError(ActualLRPGroupByProcessGuidAndIndexRoute_r0, response.Error)
 }
 
-func (c *client) ClaimActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+func (c *client) ClaimActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.ClaimActualLRPRequest{
 		ProcessGuid:          key.ProcessGuid,
 		Index:                key.Index,
 		ActualLrpInstanceKey: instanceKey,
 	}
 	response := models.ActualLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, ClaimActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, ClaimActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(ClaimActualLRPRoute_r0, response.Error)
 }
 
-func (c *client) StartActualLRP(logger lager.Logger,
-	traceID string,
+func (c *client) StartActualLRP(ctx context.Context,
+	logger lager.Logger,
 	key *models.ActualLRPKey,
 	instanceKey *models.ActualLRPInstanceKey,
 	netInfo *models.ActualLRPNetInfo,
@@ -468,9 +650,11 @@ func (c *client) StartActualLRP(logger lager.Logger,
 		AvailabilityZone:        availabilityZone,
 	}
 	request.SetRoutable(routable)
-	err := c.doRequest(logger, traceID, StartActualLRPRoute_r1, nil, nil, request, &response)
-	if err != nil && err == EndpointNotFoundErr {
-		err = c.doRequest(logger, traceID, StartActualLRPRoute_r0, nil, nil, &models.StartActualLRPRequest{
+	route := StartActualLRPRoute_r1
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
+	if err != nil && requestCause(err) == EndpointNotFoundErr {
+		route = StartActualLRPRoute_r0
+		err = c.doRequest(ctx, logger, route, nil, nil, &models.StartActualLRPRequest{
 			ActualLrpKey:         key,
 			ActualLrpInstanceKey: instanceKey,
 			ActualLrpNetInfo:     netInfo,
@@ -480,52 +664,52 @@ func (c *client) StartActualLRP(logger lager.Logger,
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(route, response.Error)
 }
 
-func (c *client) CrashActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
+func (c *client) CrashActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
 	request := models.CrashActualLRPRequest{
 		ActualLrpKey:         key,
 		ActualLrpInstanceKey: instanceKey,
 		ErrorMessage:         errorMessage,
 	}
 	response := models.ActualLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, CrashActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, CrashActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 
 	}
-	return response.Error.ToError()
+	return responseError(CrashActualLRPRoute_r0, response.Error)
 }
 
-func (c *client) FailActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, errorMessage string) error {
+func (c *client) FailActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, errorMessage string) error {
 	request := models.FailActualLRPRequest{
 		ActualLrpKey: key,
 		ErrorMessage: errorMessage,
 	}
 	response := models.ActualLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, FailActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, FailActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 
 	}
-	return response.Error.ToError()
+	return responseError(FailActualLRPRoute_r0, response.Error)
 }
 
-func (c *client) RetireActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey) error {
+func (c *client) RetireActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error {
 	request := models.RetireActualLRPRequest{
 		ActualLrpKey: key,
 	}
 	response := models.ActualLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, RetireActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, RetireActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 
 	}
-	return response.Error.ToError()
+	return responseError(RetireActualLRPRoute_r0, response.Error)
 }
 
-func (c *client) RemoveActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+func (c *client) RemoveActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.RemoveActualLRPRequest{
 		ProcessGuid:          key.ProcessGuid,
 		Index:                key.Index,
@@ -533,37 +717,37 @@ func (c *client) RemoveActualLRP(logger lager.Logger, traceID string, key *model
 	}
 
 	response := models.ActualLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, RemoveActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, RemoveActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(RemoveActualLRPRoute_r0, response.Error)
 }
 
-func (c *client) EvacuateClaimedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
-	return c.doEvacRequest(logger, traceID, EvacuateClaimedActualLRPRoute_r0, KeepContainer, &models.EvacuateClaimedActualLRPRequest{
+func (c *client) EvacuateClaimedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
+	return c.doEvacRequest(ctx, logger, EvacuateClaimedActualLRPRoute_r0, KeepContainer, &models.EvacuateClaimedActualLRPRequest{
 		ActualLrpKey:         key,
 		ActualLrpInstanceKey: instanceKey,
 	})
 }
 
-func (c *client) EvacuateCrashedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) (bool, error) {
-	return c.doEvacRequest(logger, traceID, EvacuateCrashedActualLRPRoute_r0, DeleteContainer, &models.EvacuateCrashedActualLRPRequest{
+func (c *client) EvacuateCrashedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) (bool, error) {
+	return c.doEvacRequest(ctx, logger, EvacuateCrashedActualLRPRoute_r0, DeleteContainer, &models.EvacuateCrashedActualLRPRequest{
 		ActualLrpKey:         key,
 		ActualLrpInstanceKey: instanceKey,
 		ErrorMessage:         errorMessage,
 	})
 }
 
-func (c *client) EvacuateStoppedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
-	return c.doEvacRequest(logger, traceID, EvacuateStoppedActualLRPRoute_r0, DeleteContainer, &models.EvacuateStoppedActualLRPRequest{
+func (c *client) EvacuateStoppedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
+	return c.doEvacRequest(ctx, logger, EvacuateStoppedActualLRPRoute_r0, DeleteContainer, &models.EvacuateStoppedActualLRPRequest{
 		ActualLrpKey:         key,
 		ActualLrpInstanceKey: instanceKey,
 	})
 }
 
-func (c *client) EvacuateRunningActualLRP(logger lager.Logger,
-	traceID string,
+func (c *client) EvacuateRunningActualLRP(ctx context.Context,
+	logger lager.Logger,
 	key *models.ActualLRPKey,
 	instanceKey *models.ActualLRPInstanceKey,
 	netInfo *models.ActualLRPNetInfo,
@@ -581,9 +765,9 @@ func (c *client) EvacuateRunningActualLRP(logger lager.Logger,
 		AvailabilityZone:        availabilityZone,
 	}
 	request.SetRoutable(routable)
-	keepContainer, err := c.doEvacRequest(logger, traceID, EvacuateRunningActualLRPRoute_r1, KeepContainer, request)
-	if err != nil && err == EndpointNotFoundErr {
-		keepContainer, err = c.doEvacRequest(logger, traceID, EvacuateRunningActualLRPRoute_r0, KeepContainer, &models.EvacuateRunningActualLRPRequest{
+	keepContainer, err := c.doEvacRequest(ctx, logger, EvacuateRunningActualLRPRoute_r1, KeepContainer, request)
+	if err != nil && requestCause(err) == EndpointNotFoundErr {
+		keepContainer, err = c.doEvacRequest(ctx, logger, EvacuateRunningActualLRPRoute_r0, KeepContainer, &models.EvacuateRunningActualLRPRequest{
 			ActualLrpKey:         key,
 			ActualLrpInstanceKey: instanceKey,
 			ActualLrpNetInfo:     netInfo,
@@ -593,250 +777,483 @@ func (c *client) EvacuateRunningActualLRP(logger lager.Logger,
 	return keepContainer, err
 }
 
-func (c *client) RemoveEvacuatingActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+func (c *client) RemoveEvacuatingActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
 	request := models.RemoveEvacuatingActualLRPRequest{
 		ActualLrpKey:         key,
 		ActualLrpInstanceKey: instanceKey,
 	}
 
 	response := models.RemoveEvacuatingActualLRPResponse{}
-	err := c.doRequest(logger, traceID, RemoveEvacuatingActualLRPRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, RemoveEvacuatingActualLRPRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return err
 	}
 
-	return response.Error.ToError()
+	return responseError(RemoveEvacuatingActualLRPRoute_r0, response.Error)
+}
+
+func (c *client) DesiredLRPs(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, _, err := c.DesiredLRPsPage(ctx, logger, filter)
+	return desiredLRPs, err
+}
+
+func (c *client) DesiredLRPsPage(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	response, err := c.desiredLRPs(ctx, logger, filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return response.DesiredLrps, response.NextPageToken, responseError(DesiredLRPsRoute_r3, response.Error)
+}
+
+func (c *client) DesiredLRPsWithResourceVersion(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error) {
+	response, err := c.desiredLRPs(ctx, logger, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return response.DesiredLrps, response.ResourceVersion, responseError(DesiredLRPsRoute_r3, response.Error)
 }
 
-func (c *client) DesiredLRPs(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+func (c *client) desiredLRPs(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) (*models.DesiredLRPsResponse, error) {
 	request := models.DesiredLRPsRequest(filter)
 	response := models.DesiredLRPsResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPsRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, DesiredLRPsRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.DesiredLrps, response.Error.ToError()
+	return &response, nil
 }
 
-func (c *client) DesiredLRPByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.DesiredLRP, error) {
+func (c *client) DesiredLRPByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRP, error) {
 	request := models.DesiredLRPByProcessGuidRequest{
 		ProcessGuid: processGuid,
 	}
 	response := models.DesiredLRPResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPByProcessGuidRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, DesiredLRPByProcessGuidRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.DesiredLrp, response.Error.ToError()
+	return response.DesiredLrp, responseError(DesiredLRPByProcessGuidRoute_r3, response.Error)
 }
 
-func (c *client) DesiredLRPSchedulingInfos(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+func (c *client) DesiredLRPSchedulingInfos(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	schedulingInfos, _, err := c.DesiredLRPSchedulingInfosWithResourceVersion(ctx, logger, filter)
+	return schedulingInfos, err
+}
+
+func (c *client) DesiredLRPSchedulingInfosWithResourceVersion(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error) {
 	request := models.DesiredLRPsRequest(filter)
 	response := models.DesiredLRPSchedulingInfosResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPSchedulingInfosRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, DesiredLRPSchedulingInfosRoute_r0, nil, nil, &request, &response)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
-	return response.DesiredLrpSchedulingInfos, response.Error.ToError()
+	return response.DesiredLrpSchedulingInfos, response.ResourceVersion, responseError(DesiredLRPSchedulingInfosRoute_r0, response.Error)
 }
 
-func (c *client) DesiredLRPSchedulingInfoByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.DesiredLRPSchedulingInfo, error) {
+func (c *client) DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRPSchedulingInfo, error) {
 	request := models.DesiredLRPByProcessGuidRequest{
 		ProcessGuid: processGuid,
 	}
 	response := models.DesiredLRPSchedulingInfoByProcessGuidResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPSchedulingInfoByProcessGuid_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, DesiredLRPSchedulingInfoByProcessGuid_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.DesiredLrpSchedulingInfo, response.Error.ToError()
+	return response.DesiredLrpSchedulingInfo, responseError(DesiredLRPSchedulingInfoByProcessGuid_r0, response.Error)
 }
 
-func (c *client) DesiredLRPRoutingInfos(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+func (c *client) DesiredLRPRoutingInfos(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
 	request := models.DesiredLRPsRequest(filter)
 	response := models.DesiredLRPsResponse{}
-	err := c.doRequest(logger, traceID, DesiredLRPRoutingInfosRoute_r0, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, DesiredLRPRoutingInfosRoute_r0, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.DesiredLrps, response.Error.ToError()
+	return response.DesiredLrps, responseError(DesiredLRPRoutingInfosRoute_r0, response.Error)
 }
 
-func (c *client) doDesiredLRPLifecycleRequest(logger lager.Logger, traceID string, route string, request proto.Message) error {
+func (c *client) doDesiredLRPLifecycleRequest(ctx context.Context, logger lager.Logger, route string, request proto.Message) error {
 	response := models.DesiredLRPLifecycleResponse{}
-	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(route, response.Error)
 }
 
-func (c *client) DesireLRP(logger lager.Logger, traceID string, desiredLRP *models.DesiredLRP) error {
+func (c *client) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP *models.DesiredLRP) error {
 	request := models.DesireLRPRequest{
 		DesiredLrp: desiredLRP,
 	}
-	return c.doDesiredLRPLifecycleRequest(logger, traceID, DesireDesiredLRPRoute_r2, &request)
+	return c.doDesiredLRPLifecycleRequest(ctx, logger, DesireDesiredLRPRoute_r2, &request)
 }
 
-func (c *client) UpdateDesiredLRP(logger lager.Logger, traceID string, processGuid string, update *models.DesiredLRPUpdate) error {
+func (c *client) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error {
 	request := models.UpdateDesiredLRPRequest{
 		ProcessGuid: processGuid,
 		Update:      update,
 	}
-	return c.doDesiredLRPLifecycleRequest(logger, traceID, UpdateDesiredLRPRoute_r0, &request)
+	return c.doDesiredLRPLifecycleRequest(ctx, logger, UpdateDesiredLRPRoute_r0, &request)
 }
 
-func (c *client) RemoveDesiredLRP(logger lager.Logger, traceID string, processGuid string) error {
+func (c *client) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string) error {
 	request := models.RemoveDesiredLRPRequest{
 		ProcessGuid: processGuid,
 	}
-	return c.doDesiredLRPLifecycleRequest(logger, traceID, RemoveDesiredLRPRoute_r0, &request)
+	return c.doDesiredLRPLifecycleRequest(ctx, logger, RemoveDesiredLRPRoute_r0, &request)
+}
+
+func (c *client) doDeploymentRequest(ctx context.Context, logger lager.Logger, route string, request proto.Message) (*models.Deployment, error) {
+	response := models.DeploymentResponse{}
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Deployment, responseError(route, response.Error)
+}
+
+func (c *client) StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error) {
+	request := models.StartDeploymentRequest{
+		ProcessGuid:    processGuid,
+		RunInfo:        runInfo,
+		MaxSurge:       maxSurge,
+		MaxUnavailable: maxUnavailable,
+	}
+	return c.doDeploymentRequest(ctx, logger, StartDeploymentRoute_r0, &request)
+}
+
+func (c *client) DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	request := models.DeploymentByProcessGuidRequest{
+		ProcessGuid: processGuid,
+	}
+	return c.doDeploymentRequest(ctx, logger, DeploymentByProcessGuidRoute_r0, &request)
+}
+
+func (c *client) PauseDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	request := models.PauseDeploymentRequest{
+		ProcessGuid: processGuid,
+	}
+	return c.doDeploymentRequest(ctx, logger, PauseDeploymentRoute_r0, &request)
 }
 
-func (c *client) Tasks(logger lager.Logger, traceID string) ([]*models.Task, error) {
+func (c *client) ResumeDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	request := models.ResumeDeploymentRequest{
+		ProcessGuid: processGuid,
+	}
+	return c.doDeploymentRequest(ctx, logger, ResumeDeploymentRoute_r0, &request)
+}
+
+func (c *client) RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	request := models.RollbackDeploymentRequest{
+		ProcessGuid: processGuid,
+	}
+	return c.doDeploymentRequest(ctx, logger, RollbackDeploymentRoute_r0, &request)
+}
+
+func (c *client) Tasks(ctx context.Context, logger lager.Logger) ([]*models.Task, error) {
 	request := models.TasksRequest{}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, TasksRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Tasks, response.Error.ToError()
+	return response.Tasks, responseError(TasksRoute_r3, response.Error)
 }
 
-func (c *client) TasksWithFilter(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, error) {
+func (c *client) TasksWithFilter(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	tasks, _, err := c.TasksPage(ctx, logger, filter)
+	return tasks, err
+}
+
+func (c *client) TasksPage(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, string, error) {
+	response, err := c.tasks(ctx, logger, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.Tasks, response.NextPageToken, responseError(TasksRoute_r3, response.Error)
+}
+
+func (c *client) TasksWithResourceVersion(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, uint64, error) {
+	response, err := c.tasks(ctx, logger, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	return response.Tasks, response.ResourceVersion, responseError(TasksRoute_r3, response.Error)
+}
+
+func (c *client) tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) (*models.TasksResponse, error) {
 	request := models.TasksRequest{
-		Domain: filter.Domain,
-		CellId: filter.CellID,
+		Domain:        filter.Domain,
+		CellId:        filter.CellID,
+		PageSize:      filter.PageSize,
+		PageToken:     filter.PageToken,
+		LabelSelector: filter.LabelSelector,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, TasksRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
-	return response.Tasks, response.Error.ToError()
+	return &response, nil
 }
 
-func (c *client) TasksByDomain(logger lager.Logger, traceID string, domain string) ([]*models.Task, error) {
+func (c *client) TasksByDomain(ctx context.Context, logger lager.Logger, domain string) ([]*models.Task, error) {
 	request := models.TasksRequest{
 		Domain: domain,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, TasksRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Tasks, response.Error.ToError()
+	return response.Tasks, responseError(TasksRoute_r3, response.Error)
 }
 
-func (c *client) TasksByCellID(logger lager.Logger, traceID string, cellId string) ([]*models.Task, error) {
+func (c *client) TasksByCellID(ctx context.Context, logger lager.Logger, cellId string) ([]*models.Task, error) {
 	request := models.TasksRequest{
 		CellId: cellId,
 	}
 	response := models.TasksResponse{}
-	err := c.doRequest(logger, traceID, TasksRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, TasksRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Tasks, response.Error.ToError()
+	return response.Tasks, responseError(TasksRoute_r3, response.Error)
 }
 
-func (c *client) TaskByGuid(logger lager.Logger, traceID string, taskGuid string) (*models.Task, error) {
+func (c *client) TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error) {
 	request := models.TaskByGuidRequest{
 		TaskGuid: taskGuid,
 	}
 	response := models.TaskResponse{}
-	err := c.doRequest(logger, traceID, TaskByGuidRoute_r3, nil, nil, &request, &response)
+	err := c.doRequest(ctx, logger, TaskByGuidRoute_r3, nil, nil, &request, &response)
 	if err != nil {
 		return nil, err
 	}
 
-	return response.Task, response.Error.ToError()
+	return response.Task, responseError(TaskByGuidRoute_r3, response.Error)
 }
 
-func (c *client) doTaskLifecycleRequest(logger lager.Logger, traceID string, route string, request proto.Message) error {
+func (c *client) doTaskLifecycleRequest(ctx context.Context, logger lager.Logger, route string, request proto.Message) error {
 	response := models.TaskLifecycleResponse{}
-	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
 	if err != nil {
 		return err
 	}
-	return response.Error.ToError()
+	return responseError(route, response.Error)
 }
 
-func (c *client) DesireTask(logger lager.Logger, traceID string, taskGuid, domain string, taskDef *models.TaskDefinition) error {
+func (c *client) DesireTask(ctx context.Context, logger lager.Logger, taskGuid, domain string, taskDef *models.TaskDefinition) error {
 	route := DesireTaskRoute_r2
 	request := models.DesireTaskRequest{
 		TaskGuid:       taskGuid,
 		Domain:         domain,
 		TaskDefinition: taskDef,
 	}
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) StartTask(logger lager.Logger, traceID string, taskGuid string, cellId string) (bool, error) {
+func (c *client) StartTask(ctx context.Context, logger lager.Logger, taskGuid string, cellId string) (bool, error) {
 	request := &models.StartTaskRequest{
 		TaskGuid: taskGuid,
 		CellId:   cellId,
 	}
 	response := &models.StartTaskResponse{}
-	err := c.doRequest(logger, traceID, StartTaskRoute_r0, nil, nil, request, response)
+	err := c.doRequest(ctx, logger, StartTaskRoute_r0, nil, nil, request, response)
 	if err != nil {
 		return false, err
 	}
-	return response.ShouldStart, response.Error.ToError()
+	return response.ShouldStart, responseError(StartTaskRoute_r0, response.Error)
 }
 
-func (c *client) CancelTask(logger lager.Logger, traceID string, taskGuid string) error {
+func (c *client) CancelTask(ctx context.Context, logger lager.Logger, taskGuid string) error {
 	request := models.TaskGuidRequest{
 		TaskGuid: taskGuid,
 	}
 	route := CancelTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) ResolvingTask(logger lager.Logger, traceID string, taskGuid string) error {
+func (c *client) ResolvingTask(ctx context.Context, logger lager.Logger, taskGuid string) error {
 	request := models.TaskGuidRequest{
 		TaskGuid: taskGuid,
 	}
 	route := ResolvingTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) DeleteTask(logger lager.Logger, traceID string, taskGuid string) error {
+func (c *client) DeleteTask(ctx context.Context, logger lager.Logger, taskGuid string) error {
 	request := models.TaskGuidRequest{
 		TaskGuid: taskGuid,
 	}
 	route := DeleteTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
+}
+
+func (c *client) ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error) {
+	request := models.ScheduledTasksRequest{
+		Domain: domain,
+	}
+	response := models.ScheduledTasksResponse{}
+	err := c.doRequest(ctx, logger, ScheduledTasksRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.ScheduledTasks, responseError(ScheduledTasksRoute_r0, response.Error)
+}
+
+func (c *client) doScheduledTaskRequest(ctx context.Context, logger lager.Logger, route string, request proto.Message) (*models.ScheduledTask, error) {
+	response := models.ScheduledTaskResponse{}
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.ScheduledTask, responseError(route, response.Error)
+}
+
+func (c *client) DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error) {
+	request := models.DesireScheduledTaskRequest{
+		ScheduleGuid:      schedule.ScheduleGuid,
+		Domain:            schedule.Domain,
+		CronExpression:    schedule.CronExpression,
+		TimeZone:          schedule.TimeZone,
+		ConcurrencyPolicy: schedule.ConcurrencyPolicy,
+		HistoryLimit:      schedule.HistoryLimit,
+		TaskDefinition:    schedule.TaskDefinition,
+	}
+	return c.doScheduledTaskRequest(ctx, logger, DesireScheduledTaskRoute_r0, &request)
+}
+
+func (c *client) UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	request := models.UpdateScheduledTaskRequest{
+		ScheduleGuid: scheduleGuid,
+		Update:       update,
+	}
+	return c.doScheduledTaskRequest(ctx, logger, UpdateScheduledTaskRoute_r0, &request)
+}
+
+func (c *client) SuspendScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error) {
+	request := models.SuspendScheduledTaskRequest{
+		ScheduleGuid: scheduleGuid,
+		Suspended:    suspended,
+	}
+	return c.doScheduledTaskRequest(ctx, logger, SuspendScheduledTaskRoute_r0, &request)
+}
+
+func (c *client) DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error {
+	request := models.DeleteScheduledTaskRequest{
+		ScheduleGuid: scheduleGuid,
+	}
+	response := models.ScheduledTaskLifecycleResponse{}
+	err := c.doRequest(ctx, logger, DeleteScheduledTaskRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return responseError(DeleteScheduledTaskRoute_r0, response.Error)
+}
+
+func (c *client) TaskCallbacks(ctx context.Context, logger lager.Logger, deadLettered bool) ([]*models.TaskCallback, error) {
+	request := models.TaskCallbacksRequest{
+		DeadLettered: deadLettered,
+	}
+	response := models.TaskCallbacksResponse{}
+	err := c.doRequest(ctx, logger, TaskCallbacksRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.TaskCallbacks, responseError(TaskCallbacksRoute_r0, response.Error)
+}
+
+func (c *client) ReplayTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) (*models.TaskCallback, error) {
+	request := models.ReplayTaskCallbackRequest{
+		TaskGuid: taskGuid,
+	}
+	response := models.ReplayTaskCallbackResponse{}
+	err := c.doRequest(ctx, logger, ReplayTaskCallbackRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.TaskCallback, responseError(ReplayTaskCallbackRoute_r0, response.Error)
+}
+
+func (c *client) AuditRecordsPage(ctx context.Context, logger lager.Logger, filter models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	request := models.AuditRecordsRequest{
+		Guid:      filter.Guid,
+		Actor:     filter.Actor,
+		Since:     filter.Since,
+		Until:     filter.Until,
+		PageSize:  filter.PageSize,
+		PageToken: filter.PageToken,
+	}
+	response := models.AuditRecordsResponse{}
+	err := c.doRequest(ctx, logger, AuditRecordsRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, "", err
+	}
+	return response.AuditRecords, response.NextPageToken, responseError(AuditRecordsRoute_r0, response.Error)
+}
+
+func (c *client) OverloadStatus(ctx context.Context, logger lager.Logger) (*models.OverloadStatus, error) {
+	response := models.OverloadStatusResponse{}
+	err := c.doRequest(ctx, logger, OverloadStatusRoute_r0, nil, nil, &models.OverloadStatusRequest{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Status, responseError(OverloadStatusRoute_r0, response.Error)
+}
+
+func (c *client) CrashStormBreakers(ctx context.Context, logger lager.Logger) ([]*models.CrashStormBreaker, error) {
+	response := models.CrashStormBreakersResponse{}
+	err := c.doRequest(ctx, logger, CrashStormBreakersRoute_r0, nil, nil, &models.CrashStormBreakersRequest{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Breakers, responseError(CrashStormBreakersRoute_r0, response.Error)
+}
+
+func (c *client) ResetCrashStormBreaker(ctx context.Context, logger lager.Logger, scope models.CrashStormBreaker_Scope, key string) (*models.CrashStormBreaker, error) {
+	request := models.ResetCrashStormBreakerRequest{
+		Scope: scope,
+		Key:   key,
+	}
+	response := models.ResetCrashStormBreakerResponse{}
+	err := c.doRequest(ctx, logger, ResetCrashStormBreakerRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Breaker, responseError(ResetCrashStormBreakerRoute_r0, response.Error)
 }
 
 // Deprecated: use CancelTask instead
-func (c *client) FailTask(logger lager.Logger, traceID string, taskGuid string, failureReason string) error {
+func (c *client) FailTask(ctx context.Context, logger lager.Logger, taskGuid string, failureReason string) error {
 	request := models.FailTaskRequest{
 		TaskGuid:      taskGuid,
 		FailureReason: failureReason,
 	}
 	route := FailTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) RejectTask(logger lager.Logger, traceID string, taskGuid string, rejectionReason string) error {
+func (c *client) RejectTask(ctx context.Context, logger lager.Logger, taskGuid string, rejectionReason string) error {
 	request := models.RejectTaskRequest{
 		TaskGuid:        taskGuid,
 		RejectionReason: rejectionReason,
 	}
 	route := RejectTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) CompleteTask(logger lager.Logger, traceID string, taskGuid string, cellId string, failed bool, failureReason, result string) error {
+func (c *client) CompleteTask(ctx context.Context, logger lager.Logger, taskGuid string, cellId string, failed bool, failureReason, result string) error {
 	request := models.CompleteTaskRequest{
 		TaskGuid:      taskGuid,
 		CellId:        cellId,
@@ -845,74 +1262,131 @@ func (c *client) CompleteTask(logger lager.Logger, traceID string, taskGuid stri
 		Result:        result,
 	}
 	route := CompleteTaskRoute_r0
-	return c.doTaskLifecycleRequest(logger, traceID, route, &request)
+	return c.doTaskLifecycleRequest(ctx, logger, route, &request)
 }
 
-func (c *client) subscribeToEvents(route string, cellId string) (events.EventSource, error) {
-	request := models.EventsByCellId{
-		CellId: cellId,
+func (c *client) subscribeToEvents(ctx context.Context, route string, filter models.EventFilter) (events.EventSource, error) {
+	if c.grpcConn != nil {
+		return c.subscribeToGRPCEvents(ctx, route, filter)
 	}
-	messageBody, err := proto.Marshal(&request)
+
+	messageBody, err := proto.Marshal(models.NewEventsByCellId(filter))
 	if err != nil {
 		return nil, err
 	}
 
-	sseConfig := sse.Config{
-		Client: c.streamingHTTPClient,
-		RetryParams: sse.RetryParams{
-			RetryInterval: c.retryInterval,
-			MaxRetries:    uint16(c.requestRetryCount),
-		},
-		RequestCreator: func() *http.Request {
-			request, err := c.reqGen.CreateRequest(route, nil, bytes.NewReader(messageBody))
-			if err != nil {
-				panic(err) // totally shouldn't happen
-			}
+	connect := func(lastEventID string) (events.RawEventSource, error) {
+		request, err := c.reqGen.CreateRequest(route, nil, bytes.NewReader(messageBody))
+		if err != nil {
+			return nil, err
+		}
+		request = request.WithContext(ctx)
 
-			return request
-		},
-	}
+		if lastEventID != "" {
+			request.Header.Set("Last-Event-ID", lastEventID)
+		}
 
-	eventSource, err := sseConfig.Connect()
-	if err != nil {
-		return nil, err
+		response, err := c.streamingHTTPClient.Do(request)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode != http.StatusOK {
+			response.Body.Close()
+			return nil, sse.BadResponseError{Response: response}
+		}
+
+		return sse.NewReadCloser(response.Body), nil
 	}
 
-	return events.NewEventSource(eventSource), nil
+	return events.NewResumableEventSource(connect, c.retryInterval, uint16(c.requestRetryCount))
 }
 
 // Deprecated: use SubscribeToInstanceEvents instead
-func (c *client) SubscribeToEvents(logger lager.Logger) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPGroupEventStreamRoute_r1, "")
+func (c *client) SubscribeToEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, LRPGroupEventStreamRoute_r1, models.EventFilter{})
 }
 
-func (c *client) SubscribeToInstanceEvents(logger lager.Logger) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r1, "")
+func (c *client) SubscribeToInstanceEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, LRPInstanceEventStreamRoute_r1, models.EventFilter{})
 }
 
-func (c *client) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
-	return c.subscribeToEvents(TaskEventStreamRoute_r1, "")
+func (c *client) SubscribeToTaskEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, TaskEventStreamRoute_r1, models.EventFilter{})
 }
 
 // Deprecated: use SubscribeToInstanceEventsByCellID instead
-func (c *client) SubscribeToEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPGroupEventStreamRoute_r1, cellId)
+func (c *client) SubscribeToEventsByCellID(ctx context.Context, logger lager.Logger, cellId string) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, LRPGroupEventStreamRoute_r1, models.EventFilter{CellID: cellId})
 }
 
-func (c *client) SubscribeToInstanceEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error) {
-	return c.subscribeToEvents(LRPInstanceEventStreamRoute_r1, cellId)
+func (c *client) SubscribeToInstanceEventsByCellID(ctx context.Context, logger lager.Logger, cellId string) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, LRPInstanceEventStreamRoute_r1, models.EventFilter{CellID: cellId})
 }
 
-func (c *client) Cells(logger lager.Logger, traceID string) ([]*models.CellPresence, error) {
+func (c *client) SubscribeToInstanceEventsWithFilter(ctx context.Context, logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, LRPInstanceEventStreamRoute_r1, filter)
+}
+
+func (c *client) SubscribeToTaskEventsWithFilter(ctx context.Context, logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.subscribeToEvents(ctx, TaskEventStreamRoute_r1, filter)
+}
+
+func (c *client) Cells(ctx context.Context, logger lager.Logger) ([]*models.CellPresence, error) {
 	response := models.CellsResponse{}
-	err := c.doRequest(logger, traceID, CellsRoute_r0, nil, nil, nil, &response)
+	err := c.doRequest(ctx, logger, CellsRoute_r0, nil, nil, &models.CellsRequest{}, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Cells, responseError(CellsRoute_r0, response.Error)
+}
+
+func (c *client) CordonCell(ctx context.Context, logger lager.Logger, cellId string) error {
+	request := models.CordonCellRequest{
+		CellId: cellId,
+	}
+	response := models.CordonCellResponse{}
+	err := c.doRequest(ctx, logger, CordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return responseError(CordonCellRoute_r0, response.Error)
+}
+
+func (c *client) UncordonCell(ctx context.Context, logger lager.Logger, cellId string) error {
+	request := models.UncordonCellRequest{
+		CellId: cellId,
+	}
+	response := models.UncordonCellResponse{}
+	err := c.doRequest(ctx, logger, UncordonCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return err
+	}
+	return responseError(UncordonCellRoute_r0, response.Error)
+}
+
+func (c *client) DrainCell(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error) {
+	request := models.DrainCellRequest{
+		CellId: cellId,
+	}
+	response := models.DrainCellResponse{}
+	err := c.doRequest(ctx, logger, DrainCellRoute_r0, nil, nil, &request, &response)
+	if err != nil {
+		return nil, err
+	}
+	return response.Drain, responseError(DrainCellRoute_r0, response.Error)
+}
+
+func (c *client) CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error) {
+	response := models.CellDrainsResponse{}
+	err := c.doRequest(ctx, logger, CellDrainsRoute_r0, nil, nil, &models.CellDrainsRequest{}, &response)
 	if err != nil {
 		return nil, err
 	}
-	return response.Cells, response.Error.ToError()
+	return response.Drains, responseError(CellDrainsRoute_r0, response.Error)
 }
 
-func (c *client) createRequest(traceID string, requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
+func (c *client) createRequest(ctx context.Context, requestName string, params rata.Params, queryParams url.Values, message proto.Message) (*http.Request, error) {
 	var messageBody []byte
 	var err error
 	if message != nil {
@@ -926,35 +1400,60 @@ func (c *client) createRequest(traceID string, requestName string, params rata.P
 	if err != nil {
 		return nil, err
 	}
+	request = request.WithContext(ctx)
 
 	request.URL.RawQuery = queryParams.Encode()
 	request.ContentLength = int64(len(messageBody))
 	request.Header.Set("Content-Type", ProtoContentType)
-	request.Header.Set(trace.RequestIdHeader, traceID)
+	request.Header.Set(trace.RequestIdHeader, trace.RequestIdFromContext(ctx))
+	trace.InjectHeader(ctx, request.Header)
+	if key := IdempotencyKeyFromContext(ctx); key != "" {
+		request.Header.Set(IdempotencyKeyHeader, key)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		request.Header.Set(RequestTimeoutHeader, strconv.FormatInt(time.Until(deadline).Milliseconds(), 10))
+	}
 	return request, nil
 }
 
-func (c *client) doEvacRequest(logger lager.Logger, traceID string, route string, defaultKeepContainer bool, request proto.Message) (bool, error) {
+func (c *client) doEvacRequest(ctx context.Context, logger lager.Logger, route string, defaultKeepContainer bool, request proto.Message) (bool, error) {
 	var response models.EvacuationResponse
-	err := c.doRequest(logger, traceID, route, nil, nil, request, &response)
+	err := c.doRequest(ctx, logger, route, nil, nil, request, &response)
 	if err != nil {
 		return defaultKeepContainer, err
 	}
 
-	return response.KeepContainer, response.Error.ToError()
+	return response.KeepContainer, responseError(route, response.Error)
 }
 
-func (c *client) doRequest(logger lager.Logger, traceID string, requestName string, params rata.Params, queryParams url.Values, requestBody, responseBody proto.Message) error {
+// doRequest calls the route within a client span, whose W3C trace context is
+// sent along with the call.
+func (c *client) doRequest(ctx context.Context, logger lager.Logger, requestName string, params rata.Params, queryParams url.Values, requestBody, responseBody proto.Message) error {
+	ctx, span := trace.StartClientSpan(ctx, requestName)
+
+	var err error
+	if c.grpcConn != nil {
+		err = c.doGRPCRequest(ctx, logger, requestName, requestBody, responseBody)
+	} else {
+		err = c.doHTTPRequest(ctx, logger, requestName, params, queryParams, requestBody, responseBody)
+	}
+
+	trace.EndSpan(span, err)
+	return err
+}
+
+func (c *client) doHTTPRequest(ctx context.Context, logger lager.Logger, requestName string, params rata.Params, queryParams url.Values, requestBody, responseBody proto.Message) error {
 	logger = logger.Session("do-request")
+	ctx = withCallIdempotencyKey(ctx)
 	var err error
 	var request *http.Request
 
 	for attempts := 0; attempts < c.requestRetryCount; attempts++ {
 		logger.Debug("creating-request", lager.Data{"attempt": attempts + 1, "request_name": requestName})
-		request, err = c.createRequest(traceID, requestName, params, queryParams, requestBody)
+		request, err = c.createRequest(ctx, requestName, params, queryParams, requestBody)
 		if err != nil {
 			logger.Error("failed-creating-request", err)
-			return err
+			return &RequestError{Route: requestName, Err: err}
 		}
 
 		logger.Debug("doing-request", lager.Data{"attempt": attempts + 1, "request_path": request.URL.Path})
@@ -965,18 +1464,52 @@ func (c *client) doRequest(logger lager.Logger, traceID string, requestName stri
 
 		if err != nil {
 			logger.Error("failed-doing-request", err)
+			if ctx.Err() != nil {
+				return &RequestError{Route: requestName, Err: ctx.Err()}
+			}
 			if netErr, ok := err.(net.Error); ok {
 				if netErr.Timeout() {
 					err = models.NewError(models.Error_Timeout, err.Error())
 				}
 			}
-			time.Sleep(500 * time.Millisecond)
+			if attempts+1 < c.requestRetryCount {
+				if sleepErr := sleep(ctx, retryDelay(err)); sleepErr != nil {
+					return &RequestError{Route: requestName, Err: sleepErr}
+				}
+			}
 		} else {
 			logger.Debug("complete", lager.Data{"request_path": request.URL.Path, "duration_in_ns": finish - start})
 			break
 		}
 	}
-	return err
+	if err != nil {
+		return &RequestError{Route: requestName, Err: err}
+	}
+	return nil
+}
+
+// sleep waits for the delay to pass, returning the error of the context
+// instead when it is done first.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// retryDelay is how long to wait before retrying a failed request: as long as
+// the BBS asks throttled or shed clients to, and at least half a second.
+func retryDelay(err error) time.Duration {
+	delay := 500 * time.Millisecond
+	if modelErr, ok := err.(*models.Error); ok && modelErr.Retryable() && modelErr.RetryAfter() > delay {
+		return modelErr.RetryAfter()
+	}
+	return delay
 }
 
 func (c *client) do(request *http.Request, responseObject proto.Message) error {
@@ -1028,6 +1561,29 @@ func handleNonProtoResponse(response *http.Response) error {
 		return EndpointNotFoundErr
 	}
 
+	if response.StatusCode == 403 {
+		return models.ErrForbidden
+	}
+
+	if response.StatusCode == 429 {
+		seconds, _ := strconv.Atoi(response.Header.Get(RetryAfterHeader))
+		return models.NewThrottledError(time.Duration(seconds) * time.Second)
+	}
+
+	if response.StatusCode == 409 && response.Header.Get(RetryAfterHeader) != "" {
+		seconds, _ := strconv.Atoi(response.Header.Get(RetryAfterHeader))
+		return models.NewIdempotencyKeyInUseError(time.Duration(seconds) * time.Second)
+	}
+
+	if response.StatusCode == 422 {
+		return models.ErrIdempotencyKeyMismatch
+	}
+
+	if response.StatusCode == 503 && response.Header.Get(RetryAfterHeader) != "" {
+		seconds, _ := strconv.Atoi(response.Header.Get(RetryAfterHeader))
+		return models.NewOverloadedError(time.Duration(seconds) * time.Second)
+	}
+
 	if response.StatusCode > 299 {
 		return models.NewError(models.Error_InvalidResponse, fmt.Sprintf(InvalidResponseMessage, response.StatusCode))
 	}
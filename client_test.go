@@ -1,12 +1,14 @@
 package bbs_test
 
 import (
+	"bytes"
 	"context"
 	"net/http"
 	"path"
 	"time"
 
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/lager/v3"
@@ -127,12 +129,12 @@ var _ = Describe("Client", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("POST", "/v1/actual_lrps/start.r1"),
 						ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
-						ghttp.RespondWith(http.StatusForbidden, nil),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
 					),
 				)
 
 				err := internalClient.StartActualLRP(logger, "some-trace-id", &models.ActualLRPKey{}, &models.ActualLRPInstanceKey{}, &models.ActualLRPNetInfo{}, []*models.ActualLRPInternalRoute{}, map[string]string{}, false, "")
-				Expect(err).To(MatchError("Invalid Response with status code: 403"))
+				Expect(err).To(MatchError("Invalid Response with status code: 500"))
 			})
 
 			It("Still returns an error if the fallback call fails", func() {
@@ -145,12 +147,12 @@ var _ = Describe("Client", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("POST", "/v1/actual_lrps/start"),
 						ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
-						ghttp.RespondWith(http.StatusForbidden, nil),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
 					),
 				)
 
 				err := internalClient.StartActualLRP(logger, "some-trace-id", &models.ActualLRPKey{}, &models.ActualLRPInstanceKey{}, &models.ActualLRPNetInfo{}, []*models.ActualLRPInternalRoute{}, map[string]string{}, false, "")
-				Expect(err).To(MatchError("Invalid Response with status code: 403"))
+				Expect(err).To(MatchError("Invalid Response with status code: 500"))
 			})
 		})
 
@@ -223,12 +225,12 @@ var _ = Describe("Client", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("POST", "/v1/actual_lrps/evacuate_running.r1"),
 						ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
-						ghttp.RespondWith(http.StatusForbidden, nil),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
 					),
 				)
 
 				_, err := internalClient.EvacuateRunningActualLRP(logger, "some-trace-id", &models.ActualLRPKey{}, &models.ActualLRPInstanceKey{}, &models.ActualLRPNetInfo{}, []*models.ActualLRPInternalRoute{}, map[string]string{}, false, "")
-				Expect(err).To(MatchError("Invalid Response with status code: 403"))
+				Expect(err).To(MatchError("Invalid Response with status code: 500"))
 			})
 
 			It("Still returns an error if the fallback call fails", func() {
@@ -241,12 +243,12 @@ var _ = Describe("Client", func() {
 					ghttp.CombineHandlers(
 						ghttp.VerifyRequest("POST", "/v1/actual_lrps/evacuate_running"),
 						ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
-						ghttp.RespondWith(http.StatusForbidden, nil),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
 					),
 				)
 
 				_, err := internalClient.EvacuateRunningActualLRP(logger, "some-trace-id", &models.ActualLRPKey{}, &models.ActualLRPInstanceKey{}, &models.ActualLRPNetInfo{}, []*models.ActualLRPInternalRoute{}, map[string]string{}, false, "")
-				Expect(err).To(MatchError("Invalid Response with status code: 403"))
+				Expect(err).To(MatchError("Invalid Response with status code: 500"))
 			})
 		})
 	})
@@ -418,6 +420,128 @@ var _ = Describe("Client", func() {
 
 	})
 
+	Context("when the server responds with a 403", func() {
+		JustBeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/delete"),
+					ghttp.RespondWith(http.StatusForbidden, nil),
+				),
+			)
+		})
+
+		It("returns a forbidden error", func() {
+			err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+			Expect(err).To(Equal(models.ErrForbidden))
+		})
+	})
+
+	Context("when the server responds with a 429", func() {
+		JustBeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/delete"),
+					ghttp.RespondWith(http.StatusTooManyRequests, nil, http.Header{"Retry-After": []string{"1"}}),
+				),
+			)
+		})
+
+		It("returns a throttled error with the retry hint", func() {
+			err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+			Expect(err).To(Equal(models.NewThrottledError(time.Second)))
+		})
+	})
+
+	Context("when the server sheds the request", func() {
+		JustBeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/delete"),
+					ghttp.RespondWith(http.StatusServiceUnavailable, nil, http.Header{"Retry-After": []string{"5"}}),
+				),
+			)
+		})
+
+		It("returns an overloaded error with the retry hint", func() {
+			err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+			Expect(err).To(Equal(models.NewOverloadedError(5 * time.Second)))
+		})
+	})
+
+	Context("when the idempotency key of the request is in use", func() {
+		JustBeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/delete"),
+					ghttp.RespondWith(http.StatusConflict, nil, http.Header{"Retry-After": []string{"1"}}),
+				),
+			)
+		})
+
+		It("returns an idempotency key in use error with the retry hint", func() {
+			err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+			Expect(err).To(Equal(models.NewIdempotencyKeyInUseError(time.Second)))
+		})
+	})
+
+	Context("when the idempotency key of the request was used for another call", func() {
+		JustBeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/delete"),
+					ghttp.RespondWith(http.StatusUnprocessableEntity, nil),
+				),
+			)
+		})
+
+		It("returns an idempotency key mismatch error", func() {
+			err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+			Expect(err).To(Equal(models.ErrIdempotencyKeyMismatch))
+		})
+	})
+
+	Context("idempotency keys", func() {
+		var keys []string
+
+		recordKey := func(w http.ResponseWriter, r *http.Request) {
+			keys = append(keys, r.Header.Get(bbs.IdempotencyKeyHeader))
+		}
+
+		BeforeEach(func() {
+			keys = nil
+			cfg.Retries = 2
+		})
+
+		It("sends the same random key on every attempt of a call", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(recordKey, ghttp.RespondWith(http.StatusInternalServerError, nil)),
+				ghttp.CombineHandlers(recordKey, ghttp.RespondWithProto(200, &models.TaskLifecycleResponse{})),
+				ghttp.CombineHandlers(recordKey, ghttp.RespondWithProto(200, &models.TaskLifecycleResponse{})),
+			)
+
+			Expect(client.DeleteTask(logger, "some-trace-id", "task-guid")).To(Succeed())
+			Expect(client.DeleteTask(logger, "some-trace-id", "task-guid")).To(Succeed())
+
+			Expect(keys).To(HaveLen(3))
+			Expect(keys[0]).NotTo(BeEmpty())
+			Expect(keys[1]).To(Equal(keys[0]))
+			Expect(keys[2]).NotTo(Equal(keys[0]))
+		})
+
+		It("sends the key of the context when it carries one", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(recordKey, ghttp.RespondWithProto(200, &models.TaskLifecycleResponse{})),
+			)
+
+			contextClient, err := bbs.NewContextClient(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			ctx := bbs.WithIdempotencyKey(context.Background(), "some-key")
+			Expect(contextClient.DeleteTask(ctx, logger, "task-guid")).To(Succeed())
+			Expect(keys).To(Equal([]string{"some-key"}))
+		})
+	})
+
 	Context("ActualLRPsByProcessGuids", func() {
 		var (
 			processGuids []string
@@ -561,6 +685,354 @@ var _ = Describe("Client", func() {
 		})
 	})
 
+	Describe("Deployments", func() {
+		var deployment *models.Deployment
+
+		BeforeEach(func() {
+			deployment = &models.Deployment{
+				DeploymentGuid: "deployment-guid",
+				ProcessGuid:    "process-guid",
+				State:          models.Deployment_InProgress,
+			}
+		})
+
+		It("starts a deployment", func() {
+			runInfo := &models.DesiredLRPRunInfo{DesiredLRPKey: models.NewDesiredLRPKey("process-guid", "domain", "log-guid")}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/deployments/start"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.StartDeploymentRequest{
+						ProcessGuid:    "process-guid",
+						RunInfo:        runInfo,
+						MaxSurge:       1,
+						MaxUnavailable: 2,
+					}),
+					ghttp.RespondWithProto(200, &models.DeploymentResponse{Deployment: deployment}),
+				),
+			)
+
+			result, err := client.StartDeployment(logger, "some-trace-id", "process-guid", runInfo, 1, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(deployment))
+		})
+
+		It("returns the error in the response", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/deployments/rollback"),
+					ghttp.VerifyProtoRepresenting(&models.RollbackDeploymentRequest{ProcessGuid: "process-guid"}),
+					ghttp.RespondWithProto(200, &models.DeploymentResponse{Error: models.ErrResourceNotFound}),
+				),
+			)
+
+			_, err := client.RollbackDeployment(logger, "some-trace-id", "process-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("ScheduledTasks", func() {
+		var schedule *models.ScheduledTask
+
+		BeforeEach(func() {
+			schedule = &models.ScheduledTask{
+				ScheduleGuid:      "nightly",
+				Domain:            "some-domain",
+				CronExpression:    "0 2 * * *",
+				TimeZone:          "Europe/Berlin",
+				ConcurrencyPolicy: models.ScheduledTask_Forbid,
+				TaskDefinition:    &models.TaskDefinition{RootFs: "some-rootfs"},
+			}
+		})
+
+		It("desires a scheduled task", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/scheduled_tasks/desire"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.DesireScheduledTaskRequest{
+						ScheduleGuid:      "nightly",
+						Domain:            "some-domain",
+						CronExpression:    "0 2 * * *",
+						TimeZone:          "Europe/Berlin",
+						ConcurrencyPolicy: models.ScheduledTask_Forbid,
+						TaskDefinition:    schedule.TaskDefinition,
+					}),
+					ghttp.RespondWithProto(200, &models.ScheduledTaskResponse{ScheduledTask: schedule}),
+				),
+			)
+
+			result, err := client.DesireScheduledTask(logger, "some-trace-id", schedule)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(schedule))
+		})
+
+		It("returns the error in the response", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/scheduled_tasks/delete"),
+					ghttp.VerifyProtoRepresenting(&models.DeleteScheduledTaskRequest{ScheduleGuid: "nightly"}),
+					ghttp.RespondWithProto(200, &models.ScheduledTaskLifecycleResponse{Error: models.ErrResourceNotFound}),
+				),
+			)
+
+			err := client.DeleteScheduledTask(logger, "some-trace-id", "nightly")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("TaskCallbacks", func() {
+		var callback *models.TaskCallback
+
+		BeforeEach(func() {
+			callback = &models.TaskCallback{
+				TaskGuid:       "some-task",
+				State:          models.TaskCallback_DeadLettered,
+				Attempts:       10,
+				LastStatusCode: 503,
+			}
+		})
+
+		It("lists the dead-lettered callbacks", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/task_callbacks/list"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.TaskCallbacksRequest{DeadLettered: true}),
+					ghttp.RespondWithProto(200, &models.TaskCallbacksResponse{TaskCallbacks: []*models.TaskCallback{callback}}),
+				),
+			)
+
+			callbacks, err := client.TaskCallbacks(logger, "some-trace-id", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callbacks).To(Equal([]*models.TaskCallback{callback}))
+		})
+
+		It("replays a callback", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/task_callbacks/replay"),
+					ghttp.VerifyProtoRepresenting(&models.ReplayTaskCallbackRequest{TaskGuid: "some-task"}),
+					ghttp.RespondWithProto(200, &models.ReplayTaskCallbackResponse{Error: models.ErrResourceNotFound}),
+				),
+			)
+
+			_, err := client.ReplayTaskCallback(logger, "some-trace-id", "some-task")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("AuditRecordsPage", func() {
+		It("lists a page of the audit records matching the filter", func() {
+			record := &models.AuditRecord{Id: 7, Kind: models.AuditRecord_Task, Action: models.AuditRecord_Create, TaskGuid: "some-task"}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/audit_records/list"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.AuditRecordsRequest{Guid: "some-task", PageSize: 1, PageToken: "some-token"}),
+					ghttp.RespondWithProto(200, &models.AuditRecordsResponse{
+						AuditRecords:  []*models.AuditRecord{record},
+						NextPageToken: "next-token",
+					}),
+				),
+			)
+
+			records, nextPageToken, err := client.AuditRecordsPage(logger, "some-trace-id", models.AuditRecordFilter{
+				Guid:      "some-task",
+				PageSize:  1,
+				PageToken: "some-token",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]*models.AuditRecord{record}))
+			Expect(nextPageToken).To(Equal("next-token"))
+		})
+	})
+
+	Describe("ActualLRPHistory", func() {
+		It("returns the history of the instances at the index of the process", func() {
+			record := &models.ActualLRPHistoryRecord{Id: 3, ProcessGuid: "some-process", Index: 2, Transition: models.ActualLRPHistoryRecord_Started}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/actual_lrps/history"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.ActualLRPHistoryRequest{ProcessGuid: "some-process", Index: 2}),
+					ghttp.RespondWithProto(200, &models.ActualLRPHistoryResponse{
+						Records: []*models.ActualLRPHistoryRecord{record},
+					}),
+				),
+			)
+
+			records, err := client.ActualLRPHistory(logger, "some-trace-id", "some-process", 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]*models.ActualLRPHistoryRecord{record}))
+		})
+	})
+
+	Describe("OverloadStatus", func() {
+		It("returns the shedding level of the BBS", func() {
+			overloadStatus := &models.OverloadStatus{Level: 1, LevelName: "listings", QueryLatency: int64(time.Second)}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/overload/status"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.RespondWithProto(200, &models.OverloadStatusResponse{Status: overloadStatus}),
+				),
+			)
+
+			status, err := client.OverloadStatus(logger, "some-trace-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(status).To(Equal(overloadStatus))
+		})
+	})
+
+	Describe("CrashStormBreakers", func() {
+		It("returns the open crash storm breakers", func() {
+			breaker := &models.CrashStormBreaker{Scope: models.CrashStormBreaker_Domain, Key: "some-domain", CrashCount: 250}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/crash_storm_breakers/list"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.RespondWithProto(200, &models.CrashStormBreakersResponse{Breakers: []*models.CrashStormBreaker{breaker}}),
+				),
+			)
+
+			breakers, err := client.CrashStormBreakers(logger, "some-trace-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(breakers).To(Equal([]*models.CrashStormBreaker{breaker}))
+		})
+	})
+
+	Describe("ResetCrashStormBreaker", func() {
+		It("resets the crash storm breaker of the scope", func() {
+			breaker := &models.CrashStormBreaker{Scope: models.CrashStormBreaker_Domain, Key: "some-domain", ClosedAt: 1}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/crash_storm_breakers/reset"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.ResetCrashStormBreakerRequest{Scope: models.CrashStormBreaker_Domain, Key: "some-domain"}),
+					ghttp.RespondWithProto(200, &models.ResetCrashStormBreakerResponse{Breaker: breaker}),
+				),
+			)
+
+			reset, err := client.ResetCrashStormBreaker(logger, "some-trace-id", models.CrashStormBreaker_Domain, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reset).To(Equal(breaker))
+		})
+	})
+
+	Describe("CordonCell", func() {
+		It("cordons the cell", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/cells/cordon"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.CordonCellRequest{CellId: "some-cell"}),
+					ghttp.RespondWithProto(200, &models.CordonCellResponse{}),
+				),
+			)
+
+			Expect(client.CordonCell(logger, "some-trace-id", "some-cell")).To(Succeed())
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		It("uncordons the cell", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/cells/uncordon"),
+					ghttp.VerifyProtoRepresenting(&models.UncordonCellRequest{CellId: "some-cell"}),
+					ghttp.RespondWithProto(200, &models.UncordonCellResponse{}),
+				),
+			)
+
+			Expect(client.UncordonCell(logger, "some-trace-id", "some-cell")).To(Succeed())
+		})
+	})
+
+	Describe("DrainCell", func() {
+		It("drains the cell", func() {
+			drain := &models.CellDrain{CellId: "some-cell", State: models.CellDrain_Draining, InitialInstances: 3, RemainingInstances: 3}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/cells/drain"),
+					ghttp.VerifyProtoRepresenting(&models.DrainCellRequest{CellId: "some-cell"}),
+					ghttp.RespondWithProto(200, &models.DrainCellResponse{Drain: drain}),
+				),
+			)
+
+			started, err := client.DrainCell(logger, "some-trace-id", "some-cell")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(Equal(drain))
+		})
+
+		It("returns the error of the response", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/cells/drain"),
+					ghttp.RespondWithProto(200, &models.DrainCellResponse{Error: models.ErrResourceNotFound}),
+				),
+			)
+
+			_, err := client.DrainCell(logger, "some-trace-id", "some-cell")
+			Expect(err).To(MatchError(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("CellDrains", func() {
+		It("returns the drains of the cells", func() {
+			drain := &models.CellDrain{CellId: "some-cell", State: models.CellDrain_Drained, InitialInstances: 3}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/cells/drains/list"),
+					ghttp.RespondWithProto(200, &models.CellDrainsResponse{Drains: []*models.CellDrain{drain}}),
+				),
+			)
+
+			drains, err := client.CellDrains(logger, "some-trace-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drains).To(Equal([]*models.CellDrain{drain}))
+		})
+	})
+
+	Describe("DomainQuotas", func() {
+		var quota *models.DomainQuota
+
+		BeforeEach(func() {
+			quota = &models.DomainQuota{Domain: "some-domain", MemoryMb: 1024, Instances: 4}
+		})
+
+		It("sets a quota", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/domain_quotas/set"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.VerifyProtoRepresenting(&models.SetDomainQuotaRequest{DomainQuota: quota}),
+					ghttp.RespondWithProto(200, &models.DomainQuotaResponse{DomainQuota: quota}),
+				),
+			)
+
+			setQuota, err := client.SetDomainQuota(logger, "some-trace-id", quota)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setQuota).To(Equal(quota))
+		})
+
+		It("fetches the usage of a domain", func() {
+			usage := &models.DomainUsage{Domain: "some-domain", MemoryMb: 512, Instances: 2}
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/domain_quotas/usage"),
+					ghttp.VerifyProtoRepresenting(&models.DomainUsageRequest{Domain: "some-domain"}),
+					ghttp.RespondWithProto(200, &models.DomainUsageResponse{Usage: usage, DomainQuota: quota}),
+				),
+			)
+
+			actualUsage, actualQuota, err := client.DomainUsage(logger, "some-trace-id", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualUsage).To(Equal(usage))
+			Expect(actualQuota).To(Equal(quota))
+		})
+	})
+
 	Context("when subscribing to an event stream that fails", func() {
 		JustBeforeEach(func() {
 			bbsServer.HTTPTestServer.Listener.Close()
@@ -578,6 +1050,77 @@ var _ = Describe("Client", func() {
 		})
 
 	})
+	Context("when an event stream is interrupted", func() {
+		var taskEvent *models.TaskRemovedEvent
+
+		BeforeEach(func() {
+			taskEvent = models.NewTaskRemovedEvent(&models.Task{TaskGuid: "task-guid"})
+			sseEvent, err := events.NewEventWithID("5", taskEvent)
+			Expect(err).NotTo(HaveOccurred())
+			body := new(bytes.Buffer)
+			Expect(sseEvent.Write(body)).To(Succeed())
+
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/events/tasks.r1"),
+					ghttp.RespondWith(200, body.String()),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/events/tasks.r1"),
+					ghttp.VerifyHeader(http.Header{"Last-Event-ID": []string{"5"}}),
+					ghttp.RespondWith(200, body.String()),
+				),
+			)
+		})
+
+		It("reconnects and resumes after the last event it received", func() {
+			eventSource, err := client.SubscribeToTaskEvents(logger)
+			Expect(err).NotTo(HaveOccurred())
+			defer eventSource.Close()
+
+			Expect(eventSource.Next()).To(Equal(taskEvent))
+			Expect(eventSource.Next()).To(Equal(taskEvent))
+			Expect(bbsServer.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+
+	Context("when subscribing with a filter", func() {
+		var filter models.EventFilter
+
+		BeforeEach(func() {
+			filter = models.EventFilter{
+				Domain:     "some-domain",
+				TaskGuids:  []string{"task-guid"},
+				EventTypes: []string{models.EventTypeTaskRemoved},
+			}
+
+			taskEvent := models.NewTaskRemovedEvent(&models.Task{TaskGuid: "task-guid", Domain: "some-domain"})
+			sseEvent, err := events.NewEventWithID("5", taskEvent)
+			Expect(err).NotTo(HaveOccurred())
+			body := new(bytes.Buffer)
+			Expect(sseEvent.Write(body)).To(Succeed())
+
+			expectedBody, err := models.NewEventsByCellId(filter).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/events/tasks.r1"),
+					ghttp.VerifyBody(expectedBody),
+					ghttp.RespondWith(200, body.String()),
+				),
+			)
+		})
+
+		It("sends the filter to the BBS", func() {
+			eventSource, err := client.SubscribeToTaskEventsWithFilter(logger, filter)
+			Expect(err).NotTo(HaveOccurred())
+			defer eventSource.Close()
+
+			Expect(bbsServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Context("when an http URL is provided to the secure client", func() {
 		It("creating the client returns an error", func() {
 			_, err := bbs.NewClient(bbsServer.URL(), "", "", "", 1, 1)
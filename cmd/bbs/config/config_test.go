@@ -4,9 +4,15 @@ import (
 	"os"
 	"time"
 
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/authorization"
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/crashstorm"
 	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/bbs/ratelimit"
 	"code.cloudfoundry.org/bbs/test_helpers"
+	"code.cloudfoundry.org/bbs/trace"
 	"code.cloudfoundry.org/debugserver"
 	loggingclient "code.cloudfoundry.org/diego-logging-client"
 	"code.cloudfoundry.org/durationjson"
@@ -23,13 +29,34 @@ var _ = Describe("BBSConfig", func() {
 	BeforeEach(func() {
 		configData = `{
 			"access_log_path": "/var/vcap/sys/log/bbs/access.log",
+			"actual_lrp_history_limit": 50,
 			"active_key_label": "label",
+			"admission_webhooks": [{
+				"name": "policy",
+				"url": "https://policy.service.cf.internal:8443/admit",
+				"type": "validating",
+				"failure_policy": "ignore",
+				"timeout": "2s",
+				"kinds": ["task"],
+				"ca_cert_file": "/var/vcap/jobs/bbs/config/policy.ca",
+				"client_cert_file": "/var/vcap/jobs/bbs/config/policy.crt",
+				"client_key_file": "/var/vcap/jobs/bbs/config/policy.key"
+			}],
 			"advertise_url": "bbs.service.cf.internal",
 			"auctioneer_address": "https://auctioneer.service.cf.internal:9016",
 			"auctioneer_ca_cert": "/var/vcap/jobs/bbs/config/auctioneer.ca",
 			"auctioneer_client_cert": "/var/vcap/jobs/bbs/config/auctioneer.crt",
 			"auctioneer_client_key": "/var/vcap/jobs/bbs/config/auctioneer.key",
 			"auctioneer_require_tls": true,
+			"audit_record_retention": "168h",
+			"authorization": {
+				"enabled": true,
+				"rules": [{
+					"organizational_unit": "app:*",
+					"roles": ["scheduler", "read-only"],
+					"domains": ["cf-apps"]
+				}]
+			},
 			"uuid": "bosh-boshy-bosh-bosh",
 			"ca_file": "/var/vcap/jobs/bbs/config/ca.crt",
 			"cell_registrations_locket_enabled": true,
@@ -41,11 +68,14 @@ var _ = Describe("BBSConfig", func() {
 			"database_driver": "postgres",
 			"debug_address": "127.0.0.1:17017",
 			"debug_lrp_start_heartbeats":true,
+			"deployment_progress_interval": "10s",
 			"desired_lrp_creation_timeout": "1m0s",
 			"encryption_keys": {"label": "key"},
 			"expire_completed_task_duration": "2m0s",
 			"expire_pending_task_duration": "30m0s",
+			"grpc_listen_address": "0.0.0.0:8891",
 			"health_address": "127.0.0.1:8890",
+			"idempotency_key_window": "2h",
 			"key_file": "/var/vcap/jobs/bbs/config/bbs.key",
 			"kick_task_duration": "30s",
 			"listen_address": "0.0.0.0:8889",
@@ -73,18 +103,61 @@ var _ = Describe("BBSConfig", func() {
 			"db_connection_timeout": "30s",
 			"db_read_timeout": "600s",
 			"db_write_timeout": "600s",
+			"rate_limiting": {
+				"enabled": true,
+				"max_in_flight": 100,
+				"priority_max_in_flight": 50,
+				"queue_timeout": "2s",
+				"rules": [{
+					"common_name": "route_emitter",
+					"route_classes": ["read"],
+					"requests_per_second": 5,
+					"burst": 10,
+					"max_in_flight": 2
+				}]
+			},
+			"overload": {
+				"enabled": true,
+				"poll_interval": "10s",
+				"max_pool_wait": "100ms",
+				"max_query_latency": "1s"
+			},
+			"crash_storm": {
+				"enabled": true,
+				"window": "2m",
+				"cooldown": "10m",
+				"global_threshold": 500,
+				"domain_threshold": 100,
+				"process_threshold": 20
+			},
+			"tracing": {
+				"exporter": "otlp",
+				"otlp_endpoint": "otel-collector:4317",
+				"sample_ratio": 0.5
+			},
+			"rep_admin_port": 1800,
 			"rep_ca_cert": "/var/vcap/jobs/bbs/config/rep.ca",
 			"rep_client_cert": "/var/vcap/jobs/bbs/config/rep.crt",
 			"rep_client_key": "/var/vcap/jobs/bbs/config/rep.key",
 			"rep_client_session_cache_size": 10,
 			"report_interval": "1m0s",
 			"require_ssl": true,
+			"schedule_tasks_interval": "2s",
 			"session_name": "bbs-session",
 			"sql_ca_cert_file": "/var/vcap/jobs/bbs/config/sql.ca",
 			"sql_enable_identity_verification": true,
+			"task_callback_dispatch_interval": "10s",
+			"task_callback_initial_backoff": "2s",
+			"task_callback_max_attempts": 8,
+			"task_callback_max_backoff": "10m",
+			"task_callback_signing_secret": "callback-secret",
 			"task_callback_workers": 1000,
 			"update_workers": 1000,
 			"max_task_retries": 3,
+			"prometheus_listen_address": "127.0.0.1:9090",
+			"prune_interval": "5m",
+			"event_log_size": 2048,
+			"event_log_in_database": true,
 			"advanced_metrics": {
 				"enabled": true,
 				"route_config": {
@@ -116,16 +189,37 @@ var _ = Describe("BBSConfig", func() {
 		Expect(err).NotTo(HaveOccurred())
 
 		config := config.BBSConfig{
-			AccessLogPath:        "/var/vcap/sys/log/bbs/access.log",
+			AccessLogPath:         "/var/vcap/sys/log/bbs/access.log",
+			ActualLRPHistoryLimit: 50,
+			AdmissionWebhooks: []admission.WebhookConfig{{
+				Name:           "policy",
+				URL:            "https://policy.service.cf.internal:8443/admit",
+				Type:           admission.WebhookTypeValidating,
+				FailurePolicy:  admission.FailurePolicyIgnore,
+				Timeout:        durationjson.Duration(2 * time.Second),
+				Kinds:          []string{admission.KindTask},
+				CACertFile:     "/var/vcap/jobs/bbs/config/policy.ca",
+				ClientCertFile: "/var/vcap/jobs/bbs/config/policy.crt",
+				ClientKeyFile:  "/var/vcap/jobs/bbs/config/policy.key",
+			}},
 			AdvertiseURL:         "bbs.service.cf.internal",
 			AuctioneerAddress:    "https://auctioneer.service.cf.internal:9016",
 			AuctioneerCACert:     "/var/vcap/jobs/bbs/config/auctioneer.ca",
 			AuctioneerClientCert: "/var/vcap/jobs/bbs/config/auctioneer.crt",
 			AuctioneerClientKey:  "/var/vcap/jobs/bbs/config/auctioneer.key",
 			AuctioneerRequireTLS: true,
-			UUID:                 "bosh-boshy-bosh-bosh",
-			CaFile:               "/var/vcap/jobs/bbs/config/ca.crt",
-			CertFile:             "/var/vcap/jobs/bbs/config/bbs.crt",
+			AuditRecordRetention: durationjson.Duration(168 * time.Hour),
+			Authorization: authorization.Config{
+				Enabled: true,
+				Rules: []authorization.Rule{{
+					OrganizationalUnit: "app:*",
+					Roles:              []string{authorization.RoleScheduler, authorization.RoleReadOnly},
+					Domains:            []string{"cf-apps"},
+				}},
+			},
+			UUID:     "bosh-boshy-bosh-bosh",
+			CaFile:   "/var/vcap/jobs/bbs/config/ca.crt",
+			CertFile: "/var/vcap/jobs/bbs/config/bbs.crt",
 			ClientLocketConfig: locket.ClientLocketConfig{
 				LocketAddress:        "127.0.0.1:18018",
 				LocketCACertFile:     "locket-ca-cert",
@@ -139,8 +233,9 @@ var _ = Describe("BBSConfig", func() {
 			DebugServerConfig: debugserver.DebugServerConfig{
 				DebugAddress: "127.0.0.1:17017",
 			},
-			DebugLRPStartHeartbeats:   true,
-			DesiredLRPCreationTimeout: durationjson.Duration(1 * time.Minute),
+			DebugLRPStartHeartbeats:    true,
+			DeploymentProgressInterval: durationjson.Duration(10 * time.Second),
+			DesiredLRPCreationTimeout:  durationjson.Duration(1 * time.Minute),
 			EncryptionConfig: encryption.EncryptionConfig{
 				ActiveKeyLabel: "label",
 				EncryptionKeys: map[string]string{
@@ -149,7 +244,9 @@ var _ = Describe("BBSConfig", func() {
 			},
 			ExpireCompletedTaskDuration: durationjson.Duration(2 * time.Minute),
 			ExpirePendingTaskDuration:   durationjson.Duration(30 * time.Minute),
+			GRPCListenAddress:           "0.0.0.0:8891",
 			HealthAddress:               "127.0.0.1:8890",
+			IdempotencyKeyWindow:        durationjson.Duration(2 * time.Hour),
 			KeyFile:                     "/var/vcap/jobs/bbs/config/bbs.key",
 			KickTaskDuration:            durationjson.Duration(30 * time.Second),
 			LagerConfig: lagerflags.LagerConfig{
@@ -175,18 +272,61 @@ var _ = Describe("BBSConfig", func() {
 			DBConnectionTimeout:           durationjson.Duration(30 * time.Second),
 			DBReadTimeout:                 durationjson.Duration(600 * time.Second),
 			DBWriteTimeout:                durationjson.Duration(600 * time.Second),
+			RateLimiting: ratelimit.Config{
+				Enabled:             true,
+				MaxInFlight:         100,
+				PriorityMaxInFlight: 50,
+				QueueTimeout:        durationjson.Duration(2 * time.Second),
+				Rules: []ratelimit.Rule{{
+					CommonName:        "route_emitter",
+					RouteClasses:      []string{ratelimit.ClassRead},
+					RequestsPerSecond: 5,
+					Burst:             10,
+					MaxInFlight:       2,
+				}},
+			},
+			Overload: overload.Config{
+				Enabled:         true,
+				PollInterval:    durationjson.Duration(10 * time.Second),
+				MaxPoolWait:     durationjson.Duration(100 * time.Millisecond),
+				MaxQueryLatency: durationjson.Duration(time.Second),
+			},
+			CrashStorm: crashstorm.Config{
+				Enabled:          true,
+				Window:           durationjson.Duration(2 * time.Minute),
+				Cooldown:         durationjson.Duration(10 * time.Minute),
+				GlobalThreshold:  500,
+				DomainThreshold:  100,
+				ProcessThreshold: 20,
+			},
+			Tracing: trace.Config{
+				Exporter:     trace.ExporterOTLP,
+				OTLPEndpoint: "otel-collector:4317",
+				SampleRatio:  0.5,
+			},
+			RepAdminPort:                  1800,
 			RepCACert:                     "/var/vcap/jobs/bbs/config/rep.ca",
 			RepClientCert:                 "/var/vcap/jobs/bbs/config/rep.crt",
 			RepClientKey:                  "/var/vcap/jobs/bbs/config/rep.key",
 			RepClientSessionCacheSize:     10,
 			ReportInterval:                durationjson.Duration(1 * time.Minute),
 			RequireSSL:                    true,
+			ScheduleTasksInterval:         durationjson.Duration(2 * time.Second),
 			SQLCACertFile:                 "/var/vcap/jobs/bbs/config/sql.ca",
 			SQLEnableIdentityVerification: true,
 			SessionName:                   "bbs-session",
+			TaskCallbackDispatchInterval:  durationjson.Duration(10 * time.Second),
+			TaskCallbackInitialBackoff:    durationjson.Duration(2 * time.Second),
+			TaskCallbackMaxAttempts:       8,
+			TaskCallbackMaxBackoff:        durationjson.Duration(10 * time.Minute),
+			TaskCallbackSigningSecret:     "callback-secret",
 			TaskCallbackWorkers:           1000,
 			UpdateWorkers:                 1000,
 			MaxTaskRetries:                3,
+			PrometheusListenAddress:       "127.0.0.1:9090",
+			PruneInterval:                 durationjson.Duration(5 * time.Minute),
+			EventLogSize:                  2048,
+			EventLogInDatabase:            true,
 			AdvancedMetricsConfig: config.AdvancedMetrics{
 				Enabled: true,
 				RouteConfig: config.RouteConfiguration{
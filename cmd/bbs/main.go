@@ -12,13 +12,18 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/authorization"
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/bbs/crashstorm"
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/migrations"
 	"code.cloudfoundry.org/bbs/db/sqldb"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
+	"code.cloudfoundry.org/bbs/deployer"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/encryptor"
 	"code.cloudfoundry.org/bbs/events"
@@ -26,8 +31,13 @@ import (
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/bbs/repadmin"
+	"code.cloudfoundry.org/bbs/scheduler"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/trace"
 	cfhttp "code.cloudfoundry.org/cfhttp/v2"
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/debugserver"
@@ -75,6 +85,18 @@ func main() {
 		os.Exit(1)
 	}
 
+	prometheusSink := metrics.NewPrometheusSink(sqldb.ConvergenceLRPPhaseDurationMetric)
+	var requestLatencyObserver metrics.RequestLatencyObserver
+	if bbsConfig.PrometheusListenAddress != "" {
+		metronClient = metrics.NewFanOutIngressClient(metronClient, prometheusSink)
+		requestLatencyObserver = prometheusSink
+	}
+
+	tracerProvider, err := trace.NewTracerProvider(context.Background(), bbsConfig.Tracing)
+	if err != nil {
+		logger.Fatal("failed-to-initialize-tracing", err)
+	}
+
 	clock := clock.NewClock()
 
 	_, portString, err := net.SplitHostPort(bbsConfig.HealthAddress)
@@ -163,10 +185,10 @@ func main() {
 
 	dbHealthCheckRunner := NewDBHealthCheckRunner(logger, sqlDB, clock, bbsConfig.HealthCheckFailureThreshold, time.Duration(bbsConfig.HealthCheckTimeout), time.Duration(bbsConfig.HealthCheckInterval), migrationsDone)
 
-	desiredHub := events.NewHub(logger)
-	actualHub := events.NewHub(logger)
-	actualLRPInstanceHub := events.NewHub(logger)
-	taskHub := events.NewHub(logger)
+	desiredHub := newHub(logger, sqlDB, &bbsConfig, "desired_lrps")
+	actualHub := newHub(logger, sqlDB, &bbsConfig, "actual_lrp_groups")
+	actualLRPInstanceHub := newHub(logger, sqlDB, &bbsConfig, "actual_lrps")
+	taskHub := newHub(logger, sqlDB, &bbsConfig, "tasks")
 
 	repTLSConfig := &rep.TLSConfig{
 		RequireTLS:      true,
@@ -184,8 +206,31 @@ func main() {
 		logger.Fatal("new-rep-client-factory-failed", err)
 	}
 
+	repAdminClient := repadmin.NewClient(httpClient, bbsConfig.RepAdminPort)
+
 	auctioneerClient := initializeAuctioneerClient(logger, &bbsConfig)
 
+	admitter, err := admission.NewChainFromConfig(bbsConfig.AdmissionWebhooks)
+	if err != nil {
+		logger.Fatal("invalid-admission-webhooks", err)
+	}
+
+	var authorizer *authorization.Authorizer
+	if bbsConfig.Authorization.Enabled {
+		authorizer, err = authorization.NewAuthorizer(bbsConfig.Authorization.Rules)
+		if err != nil {
+			logger.Fatal("invalid-authorization-rules", err)
+		}
+	}
+
+	var limiter *ratelimit.Limiter
+	if bbsConfig.RateLimiting.Enabled {
+		limiter, err = ratelimit.NewLimiter(clock, bbsConfig.RateLimiting)
+		if err != nil {
+			logger.Fatal("invalid-rate-limiting-config", err)
+		}
+	}
+
 	exitChan := make(chan struct{})
 
 	var accessLogger lager.Logger
@@ -211,11 +256,28 @@ func main() {
 		tlsConfig.RootCAs = tlsConfig.ClientCAs
 	}
 
+	callbackOutbox := taskworkpool.NewCallbackOutbox(sqlDB, clock,
+		taskworkpool.CallbackPolicy{
+			MaxAttempts:    bbsConfig.TaskCallbackMaxAttempts,
+			InitialBackoff: time.Duration(bbsConfig.TaskCallbackInitialBackoff),
+			MaxBackoff:     time.Duration(bbsConfig.TaskCallbackMaxBackoff),
+			SigningSecret:  bbsConfig.TaskCallbackSigningSecret,
+		},
+		tlsConfig,
+		time.Duration(bbsConfig.CommunicationTimeout))
+
+	callbackDispatchInterval := time.Duration(bbsConfig.TaskCallbackDispatchInterval)
+	if callbackDispatchInterval <= 0 {
+		callbackDispatchInterval = taskworkpool.DEFAULT_CB_DISPATCH_INTERVAL
+	}
+
 	cbWorkPool := taskworkpool.New(logger,
 		bbsConfig.TaskCallbackWorkers,
 		taskworkpool.HandleCompletedTask,
-		tlsConfig,
-		time.Duration(bbsConfig.CommunicationTimeout))
+		callbackOutbox,
+		sqlDB,
+		taskHub,
+		callbackDispatchInterval)
 
 	locks := []grouper.Member{}
 
@@ -264,11 +326,29 @@ func main() {
 
 	fileDescriptorPath := fmt.Sprintf("/proc/%d/fd", os.Getpid())
 	fileDescriptorMetronNotifier := metrics.NewFileDescriptorMetronNotifier(logger, fileDescriptorTicker, metronClient, fileDescriptorPath)
-	requestStatMetronNotifier := metrics.NewRequestStatMetronNotifier(logger, requestStatsTicker, metronClient, bbsConfig.AdvancedMetricsConfig)
+	requestStatMetronNotifier := metrics.NewRequestStatMetronNotifier(logger, requestStatsTicker, metronClient, bbsConfig.AdvancedMetricsConfig, requestLatencyObserver)
 	lockHeldMetronNotifier := lockheldmetrics.NewLockHeldMetronNotifier(logger, locksHeldTicker, metronClient)
 	taskStatMetronNotifier := metrics.NewTaskStatMetronNotifier(logger, clock, metronClient)
 	dbStatMetronNotifier := metrics.NewDBStatMetronNotifier(logger, clock, monitoredDB, metronClient, queryMonitor)
 
+	idempotencyKeyWindow := time.Duration(bbsConfig.IdempotencyKeyWindow)
+	if idempotencyKeyWindow <= 0 {
+		idempotencyKeyWindow = converger.DEFAULT_IDEMPOTENCY_KEY_WINDOW
+	}
+
+	var overloadController *overload.Controller
+	if bbsConfig.Overload.Enabled {
+		overloadController, err = overload.NewController(logger, clock, bbsConfig.Overload, monitoredDB, queryMonitor, metronClient)
+		if err != nil {
+			logger.Fatal("invalid-overload-config", err)
+		}
+	}
+
+	crashStormDetector, err := crashstorm.NewDetector(logger, clock, bbsConfig.CrashStorm, actualLRPInstanceHub, metronClient)
+	if err != nil {
+		logger.Fatal("invalid-crash-storm-config", err)
+	}
+
 	handler := handlers.New(
 		logger,
 		accessLogger,
@@ -286,6 +366,13 @@ func main() {
 		serviceClient,
 		auctioneerClient,
 		repClientFactory,
+		repAdminClient,
+		admitter,
+		authorizer,
+		limiter,
+		overloadController,
+		crashStormDetector,
+		idempotencyKeyWindow,
 		taskStatMetronNotifier,
 		migrationsDone,
 		exitChan,
@@ -299,11 +386,13 @@ func main() {
 		sqlDB,
 		sqlDB,
 		sqlDB,
+		sqlDB,
 		auctioneerClient,
 		serviceClient,
 		repClientFactory,
 		actualHub,
 		actualLRPInstanceHub,
+		crashStormDetector,
 	)
 
 	lrpStatMetronNotifier := metrics.NewLRPStatMetronNotifier(logger, clock, metronClient)
@@ -322,10 +411,12 @@ func main() {
 		actualLRPController,
 		bbsConfig.ConvergenceWorkers,
 		lrpStatMetronNotifier,
+		crashStormDetector,
 	)
 
 	taskController := controllers.NewTaskController(
 		sqlDB,
+		admitter,
 		cbWorkPool,
 		auctioneerClient,
 		serviceClient,
@@ -335,18 +426,64 @@ func main() {
 		bbsConfig.MaxTaskRetries,
 	)
 
+	scheduledTaskController := controllers.NewScheduledTaskController(clock, sqlDB, sqlDB, taskController)
+
+	cellController := controllers.NewCellController(sqlDB, serviceClient, repAdminClient, actualLRPInstanceHub)
+
+	auditRecordRetention := time.Duration(bbsConfig.AuditRecordRetention)
+	if auditRecordRetention <= 0 {
+		auditRecordRetention = converger.DEFAULT_AUDIT_RECORD_RETENTION
+	}
+
+	actualLRPHistoryLimit := bbsConfig.ActualLRPHistoryLimit
+	if actualLRPHistoryLimit <= 0 {
+		actualLRPHistoryLimit = converger.DEFAULT_ACTUAL_LRP_HISTORY_LIMIT
+	}
+
 	convergerProcess := converger.New(
 		logger,
 		clock,
 		lrpConvergenceController,
 		taskController,
+		sqlDB,
+		cellController,
 		serviceClient,
 		time.Duration(bbsConfig.ConvergeRepeatInterval),
 		time.Duration(bbsConfig.KickTaskDuration),
 		time.Duration(bbsConfig.ExpirePendingTaskDuration),
 		time.Duration(bbsConfig.ExpireCompletedTaskDuration),
+		actualLRPHistoryLimit,
+	)
+
+	pruneInterval := time.Duration(bbsConfig.PruneInterval)
+	if pruneInterval <= 0 {
+		pruneInterval = converger.DEFAULT_PRUNE_INTERVAL
+	}
+	prunerProcess := converger.NewPruner(logger, clock, sqlDB, sqlDB, pruneInterval, auditRecordRetention, idempotencyKeyWindow)
+
+	deploymentController := controllers.NewDeploymentController(
+		sqlDB,
+		sqlDB,
+		sqlDB,
+		auctioneerClient,
+		actualLRPController,
+		desiredHub,
+		actualHub,
+		actualLRPInstanceHub,
 	)
 
+	deploymentProgressInterval := time.Duration(bbsConfig.DeploymentProgressInterval)
+	if deploymentProgressInterval <= 0 {
+		deploymentProgressInterval = deployer.DEFAULT_PROGRESS_INTERVAL
+	}
+	deployerProcess := deployer.New(logger, clock, deploymentController, deploymentProgressInterval)
+
+	scheduleTasksInterval := time.Duration(bbsConfig.ScheduleTasksInterval)
+	if scheduleTasksInterval <= 0 {
+		scheduleTasksInterval = scheduler.DEFAULT_SCHEDULE_INTERVAL
+	}
+	schedulerProcess := scheduler.New(logger, clock, scheduledTaskController, scheduleTasksInterval)
+
 	var server ifrit.Runner
 	if tlsConfig != nil {
 		server = http_server.NewTLSServer(bbsConfig.ListenAddress, handler, tlsConfig)
@@ -370,11 +507,56 @@ func main() {
 		{Name: "bbs-election-metrics", Runner: bbsElectionMetronNotifier},
 		{Name: "periodic-metrics", Runner: requestStatMetronNotifier},
 		{Name: "converger", Runner: convergerProcess},
+		{Name: "pruner", Runner: prunerProcess},
+		{Name: "deployer", Runner: deployerProcess},
+		{Name: "scheduler", Runner: schedulerProcess},
 		{Name: "lrp-stat-metron-notifier", Runner: lrpStatMetronNotifier},
 		{Name: "task-stat-metron-notifier", Runner: taskStatMetronNotifier},
 		{Name: "db-stat-metron-notifier", Runner: dbStatMetronNotifier},
 	}
 
+	if overloadController != nil {
+		members = append(members, grouper.Member{Name: "overload-controller", Runner: overloadController})
+	}
+
+	if bbsConfig.GRPCListenAddress != "" {
+		grpcServer := handlers.NewGRPCServer(
+			logger,
+			accessLogger,
+			bbsConfig.UpdateWorkers,
+			bbsConfig.MaxTaskRetries,
+			bbsConfig.AdvancedMetricsConfig,
+			requestStatMetronNotifier,
+			sqlDB,
+			desiredHub,
+			actualHub,
+			actualLRPInstanceHub,
+			taskHub,
+			cbWorkPool,
+			serviceClient,
+			auctioneerClient,
+			repClientFactory,
+			repAdminClient,
+			admitter,
+			authorizer,
+			limiter,
+			overloadController,
+			crashStormDetector,
+			idempotencyKeyWindow,
+			taskStatMetronNotifier,
+			migrationsDone,
+			exitChan,
+			metronClient,
+		)
+		members = append(members, grouper.Member{Name: "grpc-server", Runner: handlers.NewGRPCRunner(bbsConfig.GRPCListenAddress, tlsConfig, grpcServer)})
+	}
+
+	if bbsConfig.PrometheusListenAddress != "" {
+		metricsMux := http.NewServeMux()
+		metricsMux.Handle("/metrics", prometheusSink.Handler())
+		members = append(members, grouper.Member{Name: "prometheus-server", Runner: http_server.New(bbsConfig.PrometheusListenAddress, metricsMux)})
+	}
+
 	if bbsConfig.EnableDBHealthCheck {
 		members = append(grouper.Members{{Name: "db-healthcheck", Runner: dbHealthCheckRunner}}, members...)
 	}
@@ -398,6 +580,12 @@ func main() {
 	logger.Info("started")
 
 	err = <-monitor.Wait()
+	if tracerProvider != nil {
+		shutdownErr := tracerProvider.Shutdown(context.Background())
+		if shutdownErr != nil {
+			logger.Error("failed-to-flush-spans", shutdownErr)
+		}
+	}
 	if sqlConn != nil {
 		closeErr := sqlConn.Close()
 		if closeErr != nil {
@@ -416,6 +604,18 @@ func healthCheckHandler(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusOK)
 }
 
+func newHub(logger lager.Logger, bbsDB db.DB, bbsConfig *config.BBSConfig, stream string) events.Hub {
+	size := bbsConfig.EventLogSize
+	if size <= 0 {
+		size = events.DEFAULT_EVENT_LOG_SIZE
+	}
+
+	if bbsConfig.EventLogInDatabase {
+		return events.NewVersionedHub(logger, events.NewDBEventLog(logger, bbsDB, stream, size), bbsDB)
+	}
+	return events.NewVersionedHub(logger, events.NewRingEventLog(size), bbsDB)
+}
+
 func hubMaintainer(logger lager.Logger, desiredHub, actualHub, taskHub events.Hub) ifrit.RunFunc {
 	return func(signals <-chan os.Signal, ready chan<- struct{}) error {
 		logger := logger.Session("hub-maintainer")
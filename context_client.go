@@ -0,0 +1,676 @@
+package bbs
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// RequestTimeoutHeader carries the number of milliseconds left until the
+// deadline of the context of a call, after which the BBS stops serving it.
+const RequestTimeoutHeader = "X-Request-Timeout"
+
+//counterfeiter:generate -o fake_bbs/fake_internal_context_client.go . InternalContextClient
+//counterfeiter:generate -o fake_bbs/fake_context_client.go . ContextClient
+
+/*
+The InternalContextClient interface is the InternalClient whose calls take a
+context.Context instead of a trace ID. It exposes all available endpoints of
+the BBS server, including private endpoints which should be used exclusively
+by internal Diego components. To interact with the BBS from outside of Diego,
+the ContextClient should be used instead.
+*/
+type InternalContextClient interface {
+	ContextClient
+
+	ClaimActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error
+	StartActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, internalRoutes []*models.ActualLRPInternalRoute, metricTags map[string]string, routable bool, availabilityZone string) error
+	CrashActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error
+	FailActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, errorMessage string) error
+	RemoveActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error
+
+	EvacuateClaimedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error)
+	EvacuateRunningActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, internalRoutes []*models.ActualLRPInternalRoute, metricTags map[string]string, routable bool, availabilityZone string) (bool, error)
+	EvacuateStoppedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error)
+	EvacuateCrashedActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) (bool, error)
+	RemoveEvacuatingActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error
+
+	StartTask(ctx context.Context, logger lager.Logger, taskGuid string, cellID string) (bool, error)
+	FailTask(ctx context.Context, logger lager.Logger, taskGuid, failureReason string) error
+	RejectTask(ctx context.Context, logger lager.Logger, taskGuid, failureReason string) error
+	CompleteTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string, failed bool, failureReason, result string) error
+}
+
+/*
+The ContextClient is the Client whose calls take a context.Context instead of
+a trace ID. The trace ID of a call is the request id of its context (see
+trace.WithRequestId), and the BBS stops serving the call once its context is
+done or its deadline has passed. Failed calls return a *RequestError.
+*/
+type ContextClient interface {
+	ExternalTaskContextClient
+	ExternalDomainContextClient
+	ExternalActualLRPContextClient
+	ExternalDesiredLRPContextClient
+	ExternalEventContextClient
+
+	// Returns true if the BBS server is reachable
+	Ping(ctx context.Context, logger lager.Logger) bool
+
+	// Lists all Cells
+	Cells(ctx context.Context, logger lager.Logger) ([]*models.CellPresence, error)
+
+	// Cordons the cell, so that no new work is placed on it
+	CordonCell(ctx context.Context, logger lager.Logger, cellId string) error
+
+	// Uncordons the cell, so that work may be placed on it again
+	UncordonCell(ctx context.Context, logger lager.Logger, cellId string) error
+
+	// Cordons the cell and asks its rep to evacuate it
+	DrainCell(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error)
+
+	// Lists the drains of the cells
+	CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error)
+}
+
+/*
+The ExternalTaskContextClient is used to access Diego's ability to run one-off tasks.
+More information about this API can be found in the bbs docs:
+
+https://code.cloudfoundry.org/bbs/tree/master/doc/tasks.md
+*/
+type ExternalTaskContextClient interface {
+	// Creates a Task from the given TaskDefinition
+	DesireTask(ctx context.Context, logger lager.Logger, guid string, domain string, def *models.TaskDefinition) error
+
+	// Lists all Tasks
+	Tasks(ctx context.Context, logger lager.Logger) ([]*models.Task, error)
+
+	// List all Tasks that match filter
+	TasksWithFilter(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+
+	// Lists a single page of Tasks that match filter, along with the token of the next page
+	TasksPage(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, string, error)
+
+	// Lists all Tasks that match filter, along with the resource version they were read at
+	TasksWithResourceVersion(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, uint64, error)
+
+	// Lists all Tasks of the given domain
+	TasksByDomain(ctx context.Context, logger lager.Logger, domain string) ([]*models.Task, error)
+
+	// Lists all Tasks on the given cell
+	TasksByCellID(ctx context.Context, logger lager.Logger, cellId string) ([]*models.Task, error)
+
+	// Returns the Task with the given guid
+	TaskByGuid(ctx context.Context, logger lager.Logger, guid string) (*models.Task, error)
+
+	// Cancels the Task with the given task guid
+	CancelTask(ctx context.Context, logger lager.Logger, taskGuid string) error
+
+	// Resolves a Task with the given guid
+	ResolvingTask(ctx context.Context, logger lager.Logger, taskGuid string) error
+
+	// Deletes a completed task with the given guid
+	DeleteTask(ctx context.Context, logger lager.Logger, taskGuid string) error
+
+	// Lists all ScheduledTasks, or those of the given domain
+	ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error)
+
+	// Creates a ScheduledTask that runs its task definition according to its cron expression
+	DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error)
+
+	// Updates the ScheduledTask with the given schedule guid
+	UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+
+	// Suspends or resumes the ScheduledTask with the given schedule guid
+	SuspendScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error)
+
+	// Deletes the ScheduledTask with the given schedule guid, leaving the tasks it already ran
+	DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error
+
+	// Lists the completion callbacks waiting to be delivered, or only the dead-lettered ones
+	TaskCallbacks(ctx context.Context, logger lager.Logger, deadLettered bool) ([]*models.TaskCallback, error)
+
+	// Retries the completion callback of the task with the given guid from its first attempt
+	ReplayTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) (*models.TaskCallback, error)
+
+	// Lists a single page of the audit records that match filter, oldest first, along with the token of the next page
+	AuditRecordsPage(ctx context.Context, logger lager.Logger, filter models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+
+	// Returns the level at which the BBS sheds requests while the database is overloaded
+	OverloadStatus(ctx context.Context, logger lager.Logger) (*models.OverloadStatus, error)
+
+	// Lists the open crash storm breakers, which pause the crash restarts of the ActualLRPs in their scope
+	CrashStormBreakers(ctx context.Context, logger lager.Logger) ([]*models.CrashStormBreaker, error)
+
+	// Closes the open crash storm breaker of the scope and key, resuming the crash restarts it paused
+	ResetCrashStormBreaker(ctx context.Context, logger lager.Logger, scope models.CrashStormBreaker_Scope, key string) (*models.CrashStormBreaker, error)
+}
+
+/*
+The ExternalDomainContextClient is used to access and update Diego's domains.
+*/
+type ExternalDomainContextClient interface {
+	// Lists the active domains
+	Domains(ctx context.Context, logger lager.Logger) ([]string, error)
+
+	// Creates a domain or bumps the ttl on an existing domain
+	UpsertDomain(ctx context.Context, logger lager.Logger, domain string, ttl time.Duration) error
+
+	// Lists the quotas of all domains that have one
+	DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error)
+
+	// Creates or replaces the quota of the domain
+	SetDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) (*models.DomainQuota, error)
+
+	// Removes the quota of the domain, leaving its resources unlimited
+	RemoveDomainQuota(ctx context.Context, logger lager.Logger, domain string) error
+
+	// Returns the resources used by the domain, along with its quota if it has one
+	DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, *models.DomainQuota, error)
+}
+
+/*
+The ExternalActualLRPContextClient is used to access and retire Actual LRPs
+*/
+type ExternalActualLRPContextClient interface {
+	// Returns all ActualLRPs matching the given ActualLRPFilter
+	ActualLRPs(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
+
+	// Returns a single page of ActualLRPs matching the given ActualLRPFilter, along with the token of the next page
+	ActualLRPsPage(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+
+	// Returns all ActualLRPs matching the given ActualLRPFilter, along with the resource version they were read at
+	ActualLRPsWithResourceVersion(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error)
+
+	// Returns all ActualLRPs matching the given process GUIDs
+	ActualLRPsByProcessGuids(ctx context.Context, logger lager.Logger, processGuids []string) ([]*models.ActualLRP, error)
+
+	// Returns the transitions of the instances at the given index of the process, oldest first
+	ActualLRPHistory(ctx context.Context, logger lager.Logger, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error)
+
+	// Returns all ActualLRPGroups matching the given ActualLRPFilter
+	//lint:ignore SA1019 - deprecated function returning deprecated data
+	// Deprecated: use ActualLRPs instead
+	ActualLRPGroups(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
+
+	// Returns all ActualLRPGroups that have the given process guid
+	//lint:ignore SA1019 - deprecated function returning deprecated data
+	// Deprecated: use ActualLRPs instead
+	ActualLRPGroupsByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) ([]*models.ActualLRPGroup, error)
+
+	// Returns the ActualLRPGroup with the given process guid and instance index
+	//lint:ignore SA1019 - deprecated function returning deprecated data
+	// Deprecated: use ActualLRPs instead
+	ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, logger lager.Logger, processGuid string, index int) (*models.ActualLRPGroup, error)
+
+	// Shuts down the ActualLRP matching the given ActualLRPKey, but does not modify the desired state
+	RetireActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error
+}
+
+/*
+The ExternalDesiredLRPContextClient is used to access and manipulate Desired LRPs.
+*/
+type ExternalDesiredLRPContextClient interface {
+	// Lists all DesiredLRPs that match the given DesiredLRPFilter
+	DesiredLRPs(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+
+	// Lists a single page of DesiredLRPs that match the given DesiredLRPFilter, along with the token of the next page
+	DesiredLRPsPage(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+
+	// Lists all DesiredLRPs that match the given DesiredLRPFilter, along with the resource version they were read at
+	DesiredLRPsWithResourceVersion(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error)
+
+	// Returns the DesiredLRP with the given process guid
+	DesiredLRPByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRP, error)
+
+	// Returns all DesiredLRPSchedulingInfos that match the given DesiredLRPFilter
+	DesiredLRPSchedulingInfos(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
+
+	// Returns all DesiredLRPSchedulingInfos that match the given DesiredLRPFilter, along with the resource version they were read at
+	DesiredLRPSchedulingInfosWithResourceVersion(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error)
+
+	//Returns the DesiredLRPSchedulingInfo that matches the given process guid
+	DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRPSchedulingInfo, error)
+
+	// Returns all DesiredLRPRoutingInfos that match the given DesiredLRPFilter
+	DesiredLRPRoutingInfos(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+
+	// Creates the given DesiredLRP and its corresponding ActualLRPs
+	DesireLRP(context.Context, lager.Logger, *models.DesiredLRP) error
+
+	// Updates the DesiredLRP matching the given process guid
+	UpdateDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string, update *models.DesiredLRPUpdate) error
+
+	// Removes the DesiredLRP matching the given process guid
+	RemoveDesiredLRP(ctx context.Context, logger lager.Logger, processGuid string) error
+
+	// Starts replacing the run info of the DesiredLRP matching the given process guid, a batch of instances at a time
+	StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error)
+
+	// Returns the latest Deployment of the DesiredLRP matching the given process guid
+	DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+
+	// Stops the Deployment matching the given process guid from replacing further instances
+	PauseDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+
+	// Lets a paused Deployment matching the given process guid replace instances again
+	ResumeDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+
+	// Restores the run info the Deployment matching the given process guid replaced
+	RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+}
+
+/*
+The ExternalEventContextClient is used to subscribe to groups of Events.
+*/
+type ExternalEventContextClient interface {
+	// Deprecated: use SubscribeToInstanceEvents instead
+	SubscribeToEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error)
+
+	SubscribeToInstanceEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error)
+	SubscribeToTaskEvents(ctx context.Context, logger lager.Logger) (events.EventSource, error)
+
+	// Deprecated: use SubscribeToInstanceEventsByCellID instead
+	SubscribeToEventsByCellID(ctx context.Context, logger lager.Logger, cellId string) (events.EventSource, error)
+
+	SubscribeToInstanceEventsByCellID(ctx context.Context, logger lager.Logger, cellId string) (events.EventSource, error)
+
+	// The filtered subscriptions only deliver events matching every non-empty
+	// field of the filter. The filter is applied by the BBS, so unwanted events
+	// are never sent to the client.
+	SubscribeToInstanceEventsWithFilter(ctx context.Context, logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+	SubscribeToTaskEventsWithFilter(ctx context.Context, logger lager.Logger, filter models.EventFilter) (events.EventSource, error)
+}
+
+// NewContextClient returns a ContextClient of the BBS HTTP API, set up like
+// the client returned by NewClientWithConfig.
+func NewContextClient(cfg ClientConfig) (InternalContextClient, error) {
+	if cfg.Retries == 0 {
+		cfg.Retries = DefaultRetryCount
+	}
+
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = time.Second
+	}
+
+	if cfg.InsecureSkipVerify {
+		cfg.CAFile = ""
+	}
+
+	if cfg.IsTLS {
+		return newSecureClient(cfg)
+	} else {
+		return newClient(cfg), nil
+	}
+}
+
+// traceIDClient is the InternalClient over an InternalContextClient. It makes
+// every call with a context carrying its trace ID, and returns the errors
+// wrapped by RequestError as they are.
+type traceIDClient struct {
+	client InternalContextClient
+}
+
+func traceContext(traceID string) context.Context {
+	return trace.WithRequestId(context.Background(), traceID)
+}
+
+func (c *traceIDClient) ClaimActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+	return requestCause(c.client.ClaimActualLRP(traceContext(traceID), logger, key, instanceKey))
+}
+
+func (c *traceIDClient) StartActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, internalRoutes []*models.ActualLRPInternalRoute, metricTags map[string]string, routable bool, availabilityZone string) error {
+	return requestCause(c.client.StartActualLRP(traceContext(traceID), logger, key, instanceKey, netInfo, internalRoutes, metricTags, routable, availabilityZone))
+}
+
+func (c *traceIDClient) CrashActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
+	return requestCause(c.client.CrashActualLRP(traceContext(traceID), logger, key, instanceKey, errorMessage))
+}
+
+func (c *traceIDClient) FailActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, errorMessage string) error {
+	return requestCause(c.client.FailActualLRP(traceContext(traceID), logger, key, errorMessage))
+}
+
+func (c *traceIDClient) RemoveActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+	return requestCause(c.client.RemoveActualLRP(traceContext(traceID), logger, key, instanceKey))
+}
+
+func (c *traceIDClient) EvacuateClaimedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
+	keepContainer, err := c.client.EvacuateClaimedActualLRP(traceContext(traceID), logger, key, instanceKey)
+	return keepContainer, requestCause(err)
+}
+
+func (c *traceIDClient) EvacuateRunningActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, netInfo *models.ActualLRPNetInfo, internalRoutes []*models.ActualLRPInternalRoute, metricTags map[string]string, routable bool, availabilityZone string) (bool, error) {
+	keepContainer, err := c.client.EvacuateRunningActualLRP(traceContext(traceID), logger, key, instanceKey, netInfo, internalRoutes, metricTags, routable, availabilityZone)
+	return keepContainer, requestCause(err)
+}
+
+func (c *traceIDClient) EvacuateStoppedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) (bool, error) {
+	keepContainer, err := c.client.EvacuateStoppedActualLRP(traceContext(traceID), logger, key, instanceKey)
+	return keepContainer, requestCause(err)
+}
+
+func (c *traceIDClient) EvacuateCrashedActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey, errorMessage string) (bool, error) {
+	keepContainer, err := c.client.EvacuateCrashedActualLRP(traceContext(traceID), logger, key, instanceKey, errorMessage)
+	return keepContainer, requestCause(err)
+}
+
+func (c *traceIDClient) RemoveEvacuatingActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey, instanceKey *models.ActualLRPInstanceKey) error {
+	return requestCause(c.client.RemoveEvacuatingActualLRP(traceContext(traceID), logger, key, instanceKey))
+}
+
+func (c *traceIDClient) StartTask(logger lager.Logger, traceID string, taskGuid string, cellID string) (bool, error) {
+	shouldStart, err := c.client.StartTask(traceContext(traceID), logger, taskGuid, cellID)
+	return shouldStart, requestCause(err)
+}
+
+func (c *traceIDClient) FailTask(logger lager.Logger, traceID string, taskGuid, failureReason string) error {
+	return requestCause(c.client.FailTask(traceContext(traceID), logger, taskGuid, failureReason))
+}
+
+func (c *traceIDClient) RejectTask(logger lager.Logger, traceID string, taskGuid, failureReason string) error {
+	return requestCause(c.client.RejectTask(traceContext(traceID), logger, taskGuid, failureReason))
+}
+
+func (c *traceIDClient) CompleteTask(logger lager.Logger, traceID string, taskGuid, cellId string, failed bool, failureReason, result string) error {
+	return requestCause(c.client.CompleteTask(traceContext(traceID), logger, taskGuid, cellId, failed, failureReason, result))
+}
+
+func (c *traceIDClient) Ping(logger lager.Logger, traceID string) bool {
+	return c.client.Ping(traceContext(traceID), logger)
+}
+
+func (c *traceIDClient) Cells(logger lager.Logger, traceID string) ([]*models.CellPresence, error) {
+	cells, err := c.client.Cells(traceContext(traceID), logger)
+	return cells, requestCause(err)
+}
+
+func (c *traceIDClient) CordonCell(logger lager.Logger, traceID string, cellId string) error {
+	return requestCause(c.client.CordonCell(traceContext(traceID), logger, cellId))
+}
+
+func (c *traceIDClient) UncordonCell(logger lager.Logger, traceID string, cellId string) error {
+	return requestCause(c.client.UncordonCell(traceContext(traceID), logger, cellId))
+}
+
+func (c *traceIDClient) DrainCell(logger lager.Logger, traceID string, cellId string) (*models.CellDrain, error) {
+	drain, err := c.client.DrainCell(traceContext(traceID), logger, cellId)
+	return drain, requestCause(err)
+}
+
+func (c *traceIDClient) CellDrains(logger lager.Logger, traceID string) ([]*models.CellDrain, error) {
+	drains, err := c.client.CellDrains(traceContext(traceID), logger)
+	return drains, requestCause(err)
+}
+
+func (c *traceIDClient) DesireTask(logger lager.Logger, traceID string, guid string, domain string, def *models.TaskDefinition) error {
+	return requestCause(c.client.DesireTask(traceContext(traceID), logger, guid, domain, def))
+}
+
+func (c *traceIDClient) Tasks(logger lager.Logger, traceID string) ([]*models.Task, error) {
+	tasks, err := c.client.Tasks(traceContext(traceID), logger)
+	return tasks, requestCause(err)
+}
+
+func (c *traceIDClient) TasksWithFilter(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, error) {
+	tasks, err := c.client.TasksWithFilter(traceContext(traceID), logger, filter)
+	return tasks, requestCause(err)
+}
+
+func (c *traceIDClient) TasksPage(logger lager.Logger, traceID string, filter models.TaskFilter) ([]*models.Task, string, error) {
+	tasks, nextPageToken, err := c.client.TasksPage(traceContext(traceID), logger, filter)
+	return tasks, nextPageToken, requestCause(err)
+}
+
+func (c *traceIDClient) TasksByDomain(logger lager.Logger, traceID string, domain string) ([]*models.Task, error) {
+	tasks, err := c.client.TasksByDomain(traceContext(traceID), logger, domain)
+	return tasks, requestCause(err)
+}
+
+func (c *traceIDClient) TasksByCellID(logger lager.Logger, traceID string, cellId string) ([]*models.Task, error) {
+	tasks, err := c.client.TasksByCellID(traceContext(traceID), logger, cellId)
+	return tasks, requestCause(err)
+}
+
+func (c *traceIDClient) TaskByGuid(logger lager.Logger, traceID string, guid string) (*models.Task, error) {
+	task, err := c.client.TaskByGuid(traceContext(traceID), logger, guid)
+	return task, requestCause(err)
+}
+
+func (c *traceIDClient) CancelTask(logger lager.Logger, traceID string, taskGuid string) error {
+	return requestCause(c.client.CancelTask(traceContext(traceID), logger, taskGuid))
+}
+
+func (c *traceIDClient) ResolvingTask(logger lager.Logger, traceID string, taskGuid string) error {
+	return requestCause(c.client.ResolvingTask(traceContext(traceID), logger, taskGuid))
+}
+
+func (c *traceIDClient) DeleteTask(logger lager.Logger, traceID string, taskGuid string) error {
+	return requestCause(c.client.DeleteTask(traceContext(traceID), logger, taskGuid))
+}
+
+func (c *traceIDClient) ScheduledTasks(logger lager.Logger, traceID string, domain string) ([]*models.ScheduledTask, error) {
+	scheduledTasks, err := c.client.ScheduledTasks(traceContext(traceID), logger, domain)
+	return scheduledTasks, requestCause(err)
+}
+
+func (c *traceIDClient) DesireScheduledTask(logger lager.Logger, traceID string, schedule *models.ScheduledTask) (*models.ScheduledTask, error) {
+	scheduledTask, err := c.client.DesireScheduledTask(traceContext(traceID), logger, schedule)
+	return scheduledTask, requestCause(err)
+}
+
+func (c *traceIDClient) UpdateScheduledTask(logger lager.Logger, traceID string, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	scheduledTask, err := c.client.UpdateScheduledTask(traceContext(traceID), logger, scheduleGuid, update)
+	return scheduledTask, requestCause(err)
+}
+
+func (c *traceIDClient) SuspendScheduledTask(logger lager.Logger, traceID string, scheduleGuid string, suspended bool) (*models.ScheduledTask, error) {
+	scheduledTask, err := c.client.SuspendScheduledTask(traceContext(traceID), logger, scheduleGuid, suspended)
+	return scheduledTask, requestCause(err)
+}
+
+func (c *traceIDClient) DeleteScheduledTask(logger lager.Logger, traceID string, scheduleGuid string) error {
+	return requestCause(c.client.DeleteScheduledTask(traceContext(traceID), logger, scheduleGuid))
+}
+
+func (c *traceIDClient) TaskCallbacks(logger lager.Logger, traceID string, deadLettered bool) ([]*models.TaskCallback, error) {
+	callbacks, err := c.client.TaskCallbacks(traceContext(traceID), logger, deadLettered)
+	return callbacks, requestCause(err)
+}
+
+func (c *traceIDClient) ReplayTaskCallback(logger lager.Logger, traceID string, taskGuid string) (*models.TaskCallback, error) {
+	callback, err := c.client.ReplayTaskCallback(traceContext(traceID), logger, taskGuid)
+	return callback, requestCause(err)
+}
+
+func (c *traceIDClient) AuditRecordsPage(logger lager.Logger, traceID string, filter models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	records, nextPageToken, err := c.client.AuditRecordsPage(traceContext(traceID), logger, filter)
+	return records, nextPageToken, requestCause(err)
+}
+
+func (c *traceIDClient) OverloadStatus(logger lager.Logger, traceID string) (*models.OverloadStatus, error) {
+	status, err := c.client.OverloadStatus(traceContext(traceID), logger)
+	return status, requestCause(err)
+}
+
+func (c *traceIDClient) CrashStormBreakers(logger lager.Logger, traceID string) ([]*models.CrashStormBreaker, error) {
+	breakers, err := c.client.CrashStormBreakers(traceContext(traceID), logger)
+	return breakers, requestCause(err)
+}
+
+func (c *traceIDClient) ResetCrashStormBreaker(logger lager.Logger, traceID string, scope models.CrashStormBreaker_Scope, key string) (*models.CrashStormBreaker, error) {
+	breaker, err := c.client.ResetCrashStormBreaker(traceContext(traceID), logger, scope, key)
+	return breaker, requestCause(err)
+}
+
+func (c *traceIDClient) Domains(logger lager.Logger, traceID string) ([]string, error) {
+	domains, err := c.client.Domains(traceContext(traceID), logger)
+	return domains, requestCause(err)
+}
+
+func (c *traceIDClient) UpsertDomain(logger lager.Logger, traceID string, domain string, ttl time.Duration) error {
+	return requestCause(c.client.UpsertDomain(traceContext(traceID), logger, domain, ttl))
+}
+
+func (c *traceIDClient) DomainQuotas(logger lager.Logger, traceID string) ([]*models.DomainQuota, error) {
+	quotas, err := c.client.DomainQuotas(traceContext(traceID), logger)
+	return quotas, requestCause(err)
+}
+
+func (c *traceIDClient) SetDomainQuota(logger lager.Logger, traceID string, quota *models.DomainQuota) (*models.DomainQuota, error) {
+	domainQuota, err := c.client.SetDomainQuota(traceContext(traceID), logger, quota)
+	return domainQuota, requestCause(err)
+}
+
+func (c *traceIDClient) RemoveDomainQuota(logger lager.Logger, traceID string, domain string) error {
+	return requestCause(c.client.RemoveDomainQuota(traceContext(traceID), logger, domain))
+}
+
+func (c *traceIDClient) DomainUsage(logger lager.Logger, traceID string, domain string) (*models.DomainUsage, *models.DomainQuota, error) {
+	usage, domainQuota, err := c.client.DomainUsage(traceContext(traceID), logger, domain)
+	return usage, domainQuota, requestCause(err)
+}
+
+func (c *traceIDClient) ActualLRPs(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	actualLRPs, err := c.client.ActualLRPs(traceContext(traceID), logger, filter)
+	return actualLRPs, requestCause(err)
+}
+
+func (c *traceIDClient) ActualLRPsPage(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	actualLRPs, nextPageToken, err := c.client.ActualLRPsPage(traceContext(traceID), logger, filter)
+	return actualLRPs, nextPageToken, requestCause(err)
+}
+
+func (c *traceIDClient) ActualLRPsByProcessGuids(logger lager.Logger, traceID string, processGuids []string) ([]*models.ActualLRP, error) {
+	actualLRPs, err := c.client.ActualLRPsByProcessGuids(traceContext(traceID), logger, processGuids)
+	return actualLRPs, requestCause(err)
+}
+
+func (c *traceIDClient) ActualLRPHistory(logger lager.Logger, traceID string, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error) {
+	records, err := c.client.ActualLRPHistory(traceContext(traceID), logger, processGuid, index)
+	return records, requestCause(err)
+}
+
+// Deprecated: use ActualLRPs instead
+func (c *traceIDClient) ActualLRPGroups(logger lager.Logger, traceID string, filter models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	groups, err := c.client.ActualLRPGroups(traceContext(traceID), logger, filter)
+	return groups, requestCause(err)
+}
+
+// Deprecated: use ActualLRPs instead
+func (c *traceIDClient) ActualLRPGroupsByProcessGuid(logger lager.Logger, traceID string, processGuid string) ([]*models.ActualLRPGroup, error) {
+	groups, err := c.client.ActualLRPGroupsByProcessGuid(traceContext(traceID), logger, processGuid)
+	return groups, requestCause(err)
+}
+
+// Deprecated: use ActualLRPs instead
+func (c *traceIDClient) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logger, traceID string, processGuid string, index int) (*models.ActualLRPGroup, error) {
+	group, err := c.client.ActualLRPGroupByProcessGuidAndIndex(traceContext(traceID), logger, processGuid, index)
+	return group, requestCause(err)
+}
+
+func (c *traceIDClient) RetireActualLRP(logger lager.Logger, traceID string, key *models.ActualLRPKey) error {
+	return requestCause(c.client.RetireActualLRP(traceContext(traceID), logger, key))
+}
+
+func (c *traceIDClient) DesiredLRPs(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, err := c.client.DesiredLRPs(traceContext(traceID), logger, filter)
+	return desiredLRPs, requestCause(err)
+}
+
+func (c *traceIDClient) DesiredLRPsPage(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	desiredLRPs, nextPageToken, err := c.client.DesiredLRPsPage(traceContext(traceID), logger, filter)
+	return desiredLRPs, nextPageToken, requestCause(err)
+}
+
+func (c *traceIDClient) DesiredLRPByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.DesiredLRP, error) {
+	desiredLRP, err := c.client.DesiredLRPByProcessGuid(traceContext(traceID), logger, processGuid)
+	return desiredLRP, requestCause(err)
+}
+
+func (c *traceIDClient) DesiredLRPSchedulingInfos(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	schedulingInfos, err := c.client.DesiredLRPSchedulingInfos(traceContext(traceID), logger, filter)
+	return schedulingInfos, requestCause(err)
+}
+
+func (c *traceIDClient) DesiredLRPSchedulingInfoByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.DesiredLRPSchedulingInfo, error) {
+	schedulingInfo, err := c.client.DesiredLRPSchedulingInfoByProcessGuid(traceContext(traceID), logger, processGuid)
+	return schedulingInfo, requestCause(err)
+}
+
+func (c *traceIDClient) DesiredLRPRoutingInfos(logger lager.Logger, traceID string, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, err := c.client.DesiredLRPRoutingInfos(traceContext(traceID), logger, filter)
+	return desiredLRPs, requestCause(err)
+}
+
+func (c *traceIDClient) DesireLRP(logger lager.Logger, traceID string, desiredLRP *models.DesiredLRP) error {
+	return requestCause(c.client.DesireLRP(traceContext(traceID), logger, desiredLRP))
+}
+
+func (c *traceIDClient) UpdateDesiredLRP(logger lager.Logger, traceID string, processGuid string, update *models.DesiredLRPUpdate) error {
+	return requestCause(c.client.UpdateDesiredLRP(traceContext(traceID), logger, processGuid, update))
+}
+
+func (c *traceIDClient) RemoveDesiredLRP(logger lager.Logger, traceID string, processGuid string) error {
+	return requestCause(c.client.RemoveDesiredLRP(traceContext(traceID), logger, processGuid))
+}
+
+func (c *traceIDClient) StartDeployment(logger lager.Logger, traceID string, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error) {
+	deployment, err := c.client.StartDeployment(traceContext(traceID), logger, processGuid, runInfo, maxSurge, maxUnavailable)
+	return deployment, requestCause(err)
+}
+
+func (c *traceIDClient) DeploymentByProcessGuid(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error) {
+	deployment, err := c.client.DeploymentByProcessGuid(traceContext(traceID), logger, processGuid)
+	return deployment, requestCause(err)
+}
+
+func (c *traceIDClient) PauseDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error) {
+	deployment, err := c.client.PauseDeployment(traceContext(traceID), logger, processGuid)
+	return deployment, requestCause(err)
+}
+
+func (c *traceIDClient) ResumeDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error) {
+	deployment, err := c.client.ResumeDeployment(traceContext(traceID), logger, processGuid)
+	return deployment, requestCause(err)
+}
+
+func (c *traceIDClient) RollbackDeployment(logger lager.Logger, traceID string, processGuid string) (*models.Deployment, error) {
+	deployment, err := c.client.RollbackDeployment(traceContext(traceID), logger, processGuid)
+	return deployment, requestCause(err)
+}
+
+// Deprecated: use SubscribeToInstanceEvents instead
+func (c *traceIDClient) SubscribeToEvents(logger lager.Logger) (events.EventSource, error) {
+	return c.client.SubscribeToEvents(context.Background(), logger)
+}
+
+func (c *traceIDClient) SubscribeToInstanceEvents(logger lager.Logger) (events.EventSource, error) {
+	return c.client.SubscribeToInstanceEvents(context.Background(), logger)
+}
+
+func (c *traceIDClient) SubscribeToTaskEvents(logger lager.Logger) (events.EventSource, error) {
+	return c.client.SubscribeToTaskEvents(context.Background(), logger)
+}
+
+// Deprecated: use SubscribeToInstanceEventsByCellID instead
+func (c *traceIDClient) SubscribeToEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error) {
+	return c.client.SubscribeToEventsByCellID(context.Background(), logger, cellId)
+}
+
+func (c *traceIDClient) SubscribeToInstanceEventsByCellID(logger lager.Logger, cellId string) (events.EventSource, error) {
+	return c.client.SubscribeToInstanceEventsByCellID(context.Background(), logger, cellId)
+}
+
+func (c *traceIDClient) SubscribeToInstanceEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.client.SubscribeToInstanceEventsWithFilter(context.Background(), logger, filter)
+}
+
+func (c *traceIDClient) SubscribeToTaskEventsWithFilter(logger lager.Logger, filter models.EventFilter) (events.EventSource, error) {
+	return c.client.SubscribeToTaskEventsWithFilter(context.Background(), logger, filter)
+}
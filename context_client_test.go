@@ -0,0 +1,277 @@
+package bbs_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ContextClient", func() {
+	var (
+		bbsServer *ghttp.Server
+		client    bbs.InternalContextClient
+		cfg       bbs.ClientConfig
+		logger    lager.Logger
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		bbsServer = ghttp.NewServer()
+		cfg = bbs.ClientConfig{
+			URL:           bbsServer.URL(),
+			Retries:       1,
+			RetryInterval: time.Millisecond,
+		}
+
+		logger = lagertest.NewTestLogger("bbs-client")
+		ctx = trace.WithRequestId(context.Background(), "some-trace-id")
+	})
+
+	AfterEach(func() {
+		bbsServer.CloseClientConnections()
+		bbsServer.Close()
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		client, err = bbs.NewContextClient(cfg)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("sends the trace ID of the context", func() {
+		bbsServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+				ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+				ghttp.RespondWithProto(200, &models.TasksResponse{}),
+			),
+		)
+
+		_, err := client.Tasks(ctx, logger)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when tracing is set up", func() {
+		var recorder *tracetest.SpanRecorder
+
+		BeforeEach(func() {
+			recorder = tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		})
+
+		AfterEach(func() {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		})
+
+		It("calls the route within a client span, sending its trace context", func() {
+			var traceparent string
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					func(w http.ResponseWriter, req *http.Request) {
+						traceparent = req.Header.Get(trace.TraceparentHeader)
+					},
+					ghttp.RespondWithProto(200, &models.TasksResponse{}),
+				),
+			)
+
+			ctx, parent := trace.StartSpan(ctx, "caller")
+			_, err := client.Tasks(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			parent.End()
+
+			Expect(recorder.Ended()).To(HaveLen(2))
+			span := recorder.Ended()[0]
+			Expect(span.Name()).To(Equal(bbs.TasksRoute_r3))
+			Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+			Expect(traceparent).To(ContainSubstring(span.SpanContext().SpanID().String()))
+		})
+	})
+
+	Context("when the context has a deadline", func() {
+		var cancel context.CancelFunc
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithTimeout(ctx, 10*time.Second)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		It("sends the time left until the deadline", func() {
+			var timeout time.Duration
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					func(w http.ResponseWriter, req *http.Request) {
+						milliseconds, err := strconv.Atoi(req.Header.Get(bbs.RequestTimeoutHeader))
+						Expect(err).NotTo(HaveOccurred())
+						timeout = time.Duration(milliseconds) * time.Millisecond
+					},
+					ghttp.RespondWithProto(200, &models.TasksResponse{}),
+				),
+			)
+
+			_, err := client.Tasks(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(timeout).To(BeNumerically("~", 10*time.Second, time.Second))
+		})
+	})
+
+	Context("when the server responds with an error", func() {
+		BeforeEach(func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/get_by_task_guid.r3"),
+					ghttp.RespondWithProto(200, &models.TaskResponse{Error: models.ErrResourceNotFound}),
+				),
+			)
+		})
+
+		It("returns a request error wrapping it", func() {
+			_, err := client.TaskByGuid(ctx, logger, "task-guid")
+			Expect(errors.Is(err, models.ErrResourceNotFound)).To(BeTrue())
+
+			var requestErr *bbs.RequestError
+			Expect(errors.As(err, &requestErr)).To(BeTrue())
+			Expect(requestErr.Route).To(Equal(bbs.TaskByGuidRoute_r3))
+		})
+	})
+
+	Context("when the server does not respond", func() {
+		var blockCh chan struct{}
+
+		BeforeEach(func() {
+			blockCh = make(chan struct{})
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					func(w http.ResponseWriter, req *http.Request) {
+						<-blockCh
+					},
+				),
+			)
+		})
+
+		AfterEach(func() {
+			close(blockCh)
+		})
+
+		It("returns the error of the context once it is cancelled", func() {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithCancel(ctx)
+			time.AfterFunc(20*time.Millisecond, cancel)
+
+			_, err := client.Tasks(ctx, logger)
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+	})
+
+	Context("when the context is done while waiting to retry", func() {
+		BeforeEach(func() {
+			cfg.Retries = 3
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					ghttp.RespondWith(500, nil),
+				),
+			)
+		})
+
+		It("stops retrying and returns the error of the context", func() {
+			timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+
+			_, err := client.Tasks(timeoutCtx, logger)
+			Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+			Expect(bbsServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("listing with the resource version", func() {
+		It("returns the resource version of the actual LRPs", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/actual_lrps/list"),
+					ghttp.RespondWithProto(200, &models.ActualLRPsResponse{
+						ActualLrps:      []*models.ActualLRP{{ActualLRPKey: models.NewActualLRPKey("process-guid", 0, "domain")}},
+						ResourceVersion: 42,
+					}),
+				),
+			)
+
+			actualLRPs, resourceVersion, err := client.ActualLRPsWithResourceVersion(ctx, logger, models.ActualLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPs).To(HaveLen(1))
+			Expect(resourceVersion).To(BeEquivalentTo(42))
+		})
+
+		It("returns the resource version of the desired LRPs", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/desired_lrps/list.r3"),
+					ghttp.RespondWithProto(200, &models.DesiredLRPsResponse{ResourceVersion: 42}),
+				),
+			)
+
+			_, resourceVersion, err := client.DesiredLRPsWithResourceVersion(ctx, logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceVersion).To(BeEquivalentTo(42))
+		})
+
+		It("returns the resource version of the desired LRP scheduling infos", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/desired_lrp_scheduling_infos/list"),
+					ghttp.RespondWithProto(200, &models.DesiredLRPSchedulingInfosResponse{ResourceVersion: 42}),
+				),
+			)
+
+			_, resourceVersion, err := client.DesiredLRPSchedulingInfosWithResourceVersion(ctx, logger, models.DesiredLRPFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceVersion).To(BeEquivalentTo(42))
+		})
+
+		It("returns the resource version of the tasks", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					ghttp.RespondWithProto(200, &models.TasksResponse{ResourceVersion: 42}),
+				),
+			)
+
+			_, resourceVersion, err := client.TasksWithResourceVersion(ctx, logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceVersion).To(BeEquivalentTo(42))
+		})
+
+		It("returns the error of the response", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					ghttp.RespondWithProto(200, &models.TasksResponse{Error: models.ErrUnknownError}),
+				),
+			)
+
+			_, _, err := client.TasksWithResourceVersion(ctx, logger, models.TaskFilter{})
+			Expect(errors.Is(err, models.ErrUnknownError)).To(BeTrue())
+		})
+	})
+})
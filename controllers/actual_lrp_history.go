@@ -0,0 +1,25 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// recordActualLRPTransition records the transition in the history of the
+// instance, with the trace id of the request making it. The history is only
+// used for debugging, so failing to record it does not fail the transition.
+func recordActualLRPTransition(ctx context.Context, logger lager.Logger, historyDB db.ActualLRPHistoryDB, record *models.ActualLRPHistoryRecord) {
+	record.TraceId = trace.RequestIdFromContext(ctx)
+	err := historyDB.RecordActualLRPTransition(ctx, logger, record)
+	if err != nil {
+		logger.Error("failed-recording-actual-lrp-transition", err, lager.Data{
+			"process_guid": record.ProcessGuid,
+			"index":        record.Index,
+			"transition":   record.Transition,
+		})
+	}
+}
@@ -19,11 +19,13 @@ type ActualLRPLifecycleController struct {
 	suspectDB            db.SuspectDB
 	evacuationDB         db.EvacuationDB
 	desiredLRPDB         db.DesiredLRPDB
+	historyDB            db.ActualLRPHistoryDB
 	auctioneerClient     auctioneer.Client
 	serviceClient        serviceclient.ServiceClient
 	repClientFactory     rep.ClientFactory
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
+	crashStormDetector   CrashStormDetector
 }
 
 func NewActualLRPLifecycleController(
@@ -31,22 +33,26 @@ func NewActualLRPLifecycleController(
 	suspectDB db.SuspectDB,
 	evacuationDB db.EvacuationDB,
 	desiredLRPDB db.DesiredLRPDB,
+	historyDB db.ActualLRPHistoryDB,
 	auctioneerClient auctioneer.Client,
 	serviceClient serviceclient.ServiceClient,
 	repClientFactory rep.ClientFactory,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
+	crashStormDetector CrashStormDetector,
 ) *ActualLRPLifecycleController {
 	return &ActualLRPLifecycleController{
 		db:                   db,
 		suspectDB:            suspectDB,
 		evacuationDB:         evacuationDB,
 		desiredLRPDB:         desiredLRPDB,
+		historyDB:            historyDB,
 		auctioneerClient:     auctioneerClient,
 		serviceClient:        serviceClient,
 		repClientFactory:     repClientFactory,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
+		crashStormDetector:   crashStormDetector,
 	}
 }
 
@@ -69,9 +75,14 @@ func lookupLRPInSlice(lrps []*models.ActualLRP, key *models.ActualLRPInstanceKey
 }
 
 func (h *ActualLRPLifecycleController) ClaimActualLRP(ctx context.Context, logger lager.Logger, processGUID string, index int32, actualLRPInstanceKey *models.ActualLRPInstanceKey) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.ClaimActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: processGUID, Index: &index})
@@ -95,6 +106,11 @@ func (h *ActualLRPLifecycleController) ClaimActualLRP(ctx context.Context, logge
 		return err
 	}
 
+	if after != nil && (before == nil || before.State != after.State || before.ActualLRPInstanceKey != after.ActualLRPInstanceKey) {
+		record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Claimed, &after.ActualLRPKey, actualLRPInstanceKey, after, "")
+		recordActualLRPTransition(ctx, logger, h.historyDB, record)
+	}
+
 	newLRPs := eventCalculator.RecordChange(before, after, lrps)
 	go eventCalculator.EmitEvents(trace.RequestIdFromContext(ctx), lrps, newLRPs)
 
@@ -111,9 +127,14 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context,
 	routable bool,
 	availabilityZone string,
 ) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.StartActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRPKey.ProcessGuid, Index: &actualLRPKey.Index})
@@ -140,6 +161,11 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context,
 	if err != nil {
 		return err
 	}
+
+	if !isCurrentlyRunning {
+		record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Started, actualLRPKey, actualLRPInstanceKey, after, "")
+		recordActualLRPTransition(ctx, logger, h.historyDB, record)
+	}
 	newLRPs := eventCalculator.RecordChange(before, after, lrps)
 
 	defer func() {
@@ -172,6 +198,10 @@ func (h *ActualLRPLifecycleController) StartActualLRP(ctx context.Context,
 }
 
 func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.CrashActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRPKey.ProcessGuid, Index: &actualLRPKey.Index})
 	if err != nil {
 		return err
@@ -180,6 +210,7 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	lrp := lookupLRPInSlice(lrps, actualLRPInstanceKey)
@@ -192,6 +223,8 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 
 		afterLRPs := eventCalculator.RecordChange(suspectLRP, nil, lrps)
 		logger.Info("removing-suspect-lrp", lager.Data{"ig": suspectLRP.InstanceGuid})
+		record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Removed, actualLRPKey, actualLRPInstanceKey, nil, errorMessage)
+		recordActualLRPTransition(ctx, logger, h.historyDB, record)
 		go eventCalculator.EmitEvents(traceId, lrps, afterLRPs)
 
 		return nil
@@ -202,13 +235,25 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 		return err
 	}
 
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Crashed, actualLRPKey, actualLRPInstanceKey, after, errorMessage)
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
+
 	afterLRPs := eventCalculator.RecordChange(before, after, lrps)
 	go eventCalculator.EmitCrashEvents(traceId, lrps, afterLRPs)
 
+	h.crashStormDetector.RecordCrash(traceId, actualLRPKey)
+
 	if !shouldRestart {
 		return nil
 	}
 
+	if h.crashStormDetector.RestartsPaused(actualLRPKey) {
+		// the instance stays unclaimed and is auctioned by the convergence once
+		// the crash storm breaker closes
+		logger.Info("skipping-restart-during-crash-storm", lager.Data{"process_guid": actualLRPKey.ProcessGuid, "index": actualLRPKey.Index})
+		return nil
+	}
+
 	schedInfo, err := h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, actualLRPKey.ProcessGuid)
 	if err != nil {
 		logger.Error("failed-fetching-desired-lrp", err)
@@ -217,7 +262,9 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 
 	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo, int(actualLRPKey.Index))
 	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
+	_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestLRPAuctions")
 	err = h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&startRequest})
+	trace.EndSpan(auctioneerSpan, err)
 	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedInfo.ProcessGuid, "index": int(actualLRPKey.Index)})
 	if err != nil {
 		logger.Error("failed-requesting-auction", err)
@@ -226,6 +273,10 @@ func (h *ActualLRPLifecycleController) CrashActualLRP(ctx context.Context, logge
 }
 
 func (h *ActualLRPLifecycleController) FailActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, errorMessage string) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.FailActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	lrps, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: key.ProcessGuid, Index: &key.Index})
 	if err != nil {
 		return err
@@ -236,9 +287,15 @@ func (h *ActualLRPLifecycleController) FailActualLRP(ctx context.Context, logger
 		return err
 	}
 
+	if err == nil {
+		record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Failed, key, nil, after, errorMessage)
+		recordActualLRPTransition(ctx, logger, h.historyDB, record)
+	}
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	newLRPs := eventCalculator.RecordChange(before, after, lrps)
@@ -248,6 +305,10 @@ func (h *ActualLRPLifecycleController) FailActualLRP(ctx context.Context, logger
 }
 
 func (h *ActualLRPLifecycleController) RemoveActualLRP(ctx context.Context, logger lager.Logger, processGUID string, index int32, instanceKey *models.ActualLRPInstanceKey) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.RemoveActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	beforeLRPs, err := h.db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: processGUID, Index: &index})
 	if err != nil {
 		return err
@@ -263,9 +324,13 @@ func (h *ActualLRPLifecycleController) RemoveActualLRP(ctx context.Context, logg
 		return err
 	}
 
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Removed, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey, nil, "")
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	newLRPs := eventCalculator.RecordChange(lrp, nil, beforeLRPs)
@@ -275,6 +340,10 @@ func (h *ActualLRPLifecycleController) RemoveActualLRP(ctx context.Context, logg
 }
 
 func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey) error {
+	ctx, span := trace.StartSpan(ctx, "ActualLRPLifecycleController.RetireActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	var err error
 	var cell *models.CellPresence
 
@@ -288,6 +357,7 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	lrp := findWithPresence(lrps, models.ActualLRP_Ordinary)
@@ -310,6 +380,8 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 		err = h.db.RemoveActualLRP(ctx, logger, lrp.ProcessGuid, lrp.Index, &lrp.ActualLRPInstanceKey)
 		if err == nil {
 			recordChange()
+			record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Removed, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey, nil, "retired")
+			recordActualLRPTransition(ctx, logger, h.historyDB, record)
 		}
 		return err
 	}
@@ -334,7 +406,9 @@ func (h *ActualLRPLifecycleController) RetireActualLRP(ctx context.Context, logg
 			if err != nil {
 				return err
 			}
+			_, repSpan := trace.StartClientSpan(ctx, "rep.StopLRPInstance")
 			err = client.StopLRPInstance(logger, lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+			trace.EndSpan(repSpan, err)
 		}
 
 		if err == nil {
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
 	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
@@ -26,14 +27,16 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 	const traceId = "some-trace-id"
 
 	var (
-		logger               *lagertest.TestLogger
-		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
-		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
-		fakeEvacuationDB     *dbfakes.FakeEvacuationDB
-		fakeSuspectDB        *dbfakes.FakeSuspectDB
-		fakeAuctioneerClient *auctioneerfakes.FakeClient
-		actualHub            *eventfakes.FakeHub
-		actualLRPInstanceHub *eventfakes.FakeHub
+		logger                 *lagertest.TestLogger
+		fakeActualLRPDB        *dbfakes.FakeActualLRPDB
+		fakeDesiredLRPDB       *dbfakes.FakeDesiredLRPDB
+		fakeEvacuationDB       *dbfakes.FakeEvacuationDB
+		fakeSuspectDB          *dbfakes.FakeSuspectDB
+		fakeActualLRPHistoryDB *dbfakes.FakeActualLRPHistoryDB
+		fakeAuctioneerClient   *auctioneerfakes.FakeClient
+		actualHub              *eventfakes.FakeHub
+		actualLRPInstanceHub   *eventfakes.FakeHub
+		fakeCrashStormDetector *fakes.FakeCrashStormDetector
 
 		controller *controllers.ActualLRPLifecycleController
 		err        error
@@ -61,6 +64,7 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 		fakeSuspectDB = new(dbfakes.FakeSuspectDB)
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeEvacuationDB = new(dbfakes.FakeEvacuationDB)
+		fakeActualLRPHistoryDB = new(dbfakes.FakeActualLRPHistoryDB)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		logger = lagertest.NewTestLogger("test")
 
@@ -71,16 +75,19 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
+		fakeCrashStormDetector = new(fakes.FakeCrashStormDetector)
 		controller = controllers.NewActualLRPLifecycleController(
 			fakeActualLRPDB,
 			fakeSuspectDB,
 			fakeEvacuationDB,
 			fakeDesiredLRPDB,
+			fakeActualLRPHistoryDB,
 			fakeAuctioneerClient,
 			fakeServiceClient,
 			fakeRepClientFactory,
 			actualHub,
 			actualLRPInstanceHub,
+			fakeCrashStormDetector,
 		)
 
 		beforeInstanceKey = models.NewActualLRPInstanceKey(
@@ -143,6 +150,36 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 			Expect(fakeActualLRPDB.ClaimActualLRPCallCount()).To(Equal(1))
 		})
 
+		It("records the claim in the history of the instance", func() {
+			err = controller.ClaimActualLRP(context.WithValue(ctx, trace.RequestIdHeaderCtxKey, traceId), logger, processGuid, index, &afterInstanceKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+			_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+			Expect(record).To(Equal(&models.ActualLRPHistoryRecord{
+				ProcessGuid:  processGuid,
+				Index:        index,
+				InstanceGuid: afterInstanceKey.InstanceGuid,
+				CellId:       afterInstanceKey.CellId,
+				Transition:   models.ActualLRPHistoryRecord_Claimed,
+				State:        models.ActualLRPStateClaimed,
+				TraceId:      traceId,
+			}))
+		})
+
+		Context("when recording the history fails", func() {
+			JustBeforeEach(func() {
+				fakeActualLRPHistoryDB.RecordActualLRPTransitionReturns(errors.New("boom"))
+			})
+
+			It("logs the failure and claims the actual lrp", func() {
+				err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(logger).To(gbytes.Say("failed-recording-actual-lrp-transition"))
+				Eventually(actualHub.EmitCallCount).Should(Equal(1))
+			})
+		})
+
 		It("emits a LRP group change to the hub", func() {
 			err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
 			Eventually(actualHub.EmitCallCount).Should(Equal(1))
@@ -177,6 +214,11 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
 				Consistently(actualHub.EmitCallCount).Should(BeZero())
 			})
+
+			It("does not record a transition", func() {
+				err = controller.ClaimActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(BeZero())
+			})
 		})
 
 		Context("when there is a running Suspect LRP", func() {
@@ -430,6 +472,17 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				Expect(availabilityZoneArgument).To(Equal(availabilityZone))
 			})
 
+			It("records the start in the history of the instance", func() {
+				err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+				_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+				Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Started))
+				Expect(record.InstanceGuid).To(Equal(afterInstanceKey.InstanceGuid))
+				Expect(record.State).To(Equal(models.ActualLRPStateRunning))
+			})
+
 			Context("when a non-ResourceNotFound error occurs while fetching the lrp", func() {
 				JustBeforeEach(func() {
 					fakeActualLRPDB.ActualLRPsReturns(nil, errors.New("BOOM!!!"))
@@ -602,6 +655,11 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 
 					Consistently(actualHub.EmitCallCount).Should(Equal(0))
 				})
+
+				It("does not record a transition", func() {
+					err = controller.StartActualLRP(ctx, logger, &actualLRPKey, &afterInstanceKey, &netInfo, internalRoutes, metricTags, routable, availabilityZone)
+					Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(BeZero())
+				})
 			})
 		})
 
@@ -681,6 +739,24 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 			Expect(actualErrorMessage).To(Equal(errorMessage))
 		})
 
+		It("records the crash in the history of the instance", func() {
+			err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+			_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+			Expect(record).To(Equal(&models.ActualLRPHistoryRecord{
+				ProcessGuid:  processGuid,
+				Index:        index,
+				InstanceGuid: beforeInstanceKey.InstanceGuid,
+				CellId:       beforeInstanceKey.CellId,
+				Transition:   models.ActualLRPHistoryRecord_Crashed,
+				State:        models.ActualLRPStateUnclaimed,
+				Reason:       errorMessage,
+				CrashCount:   1,
+			}))
+		})
+
 		It("emits both crashed and change events to the hub", func() {
 			err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
 			Expect(err).NotTo(HaveOccurred())
@@ -708,7 +784,32 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				}))
 		})
 
+		It("records the crash with the crash storm detector", func() {
+			err = controller.CrashActualLRP(context.WithValue(ctx, trace.RequestIdHeaderCtxKey, traceId), logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeCrashStormDetector.RecordCrashCallCount()).To(Equal(1))
+			actualTraceId, key := fakeCrashStormDetector.RecordCrashArgsForCall(0)
+			Expect(actualTraceId).To(Equal(traceId))
+			Expect(key).To(Equal(&actualLRPKey))
+		})
+
 		Describe("restarting the instance", func() {
+			Context("when a crash storm breaker pauses the restarts of the instance", func() {
+				BeforeEach(func() {
+					fakeCrashStormDetector.RestartsPausedReturns(true)
+				})
+
+				It("does not request an auction", func() {
+					err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeCrashStormDetector.RestartsPausedCallCount()).To(Equal(1))
+					Expect(fakeCrashStormDetector.RestartsPausedArgsForCall(0)).To(Equal(&actualLRPKey))
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when the actual LRP should be restarted", func() {
 				It("request an auction", func() {
 					err = controller.CrashActualLRP(context.WithValue(ctx, trace.RequestIdHeaderCtxKey, traceId), logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
@@ -878,6 +979,14 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				Expect(lrpKey.Index).To(BeEquivalentTo(index))
 			})
 
+			It("records the removal of the Suspect LRP", func() {
+				err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+				_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+				Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Removed))
+				Expect(record.Reason).To(Equal(errorMessage))
+			})
+
 			It("emits an ActualLRPRemovedEvent", func() {
 				err = controller.CrashActualLRP(ctx, logger, &actualLRPKey, &beforeInstanceKey, errorMessage)
 				Eventually(actualHub.EmitCallCount).Should(Equal(1))
@@ -1101,6 +1210,15 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("records the failure in the history of the instance", func() {
+				err = controller.FailActualLRP(ctx, logger, &actualLRPKey, errorMessage)
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+				_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+				Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Failed))
+				Expect(record.Reason).To(Equal(errorMessage))
+				Expect(record.InstanceGuid).To(BeEmpty())
+			})
+
 			It("emits a change event to the hub", func() {
 				err = controller.FailActualLRP(ctx, logger, &actualLRPKey, errorMessage)
 				Eventually(actualHub.EmitCallCount).Should(Equal(1))
@@ -1237,6 +1355,14 @@ var _ = Describe("ActualLRP Lifecycle Controller", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			It("records the removal in the history of the instance", func() {
+				err = controller.RemoveActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+				_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+				Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Removed))
+				Expect(record.InstanceGuid).To(Equal(actualLRP.InstanceGuid))
+			})
+
 			It("emits a removed event to the hub", func() {
 				controller.RemoveActualLRP(ctx, logger, processGuid, index, &afterInstanceKey)
 				Eventually(actualHub.EmitCallCount).Should(Equal(1))
@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/repadmin"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type CellController struct {
+	cellDB               db.CellDB
+	serviceClient        serviceclient.ServiceClient
+	repAdminClient       repadmin.Client
+	actualLRPInstanceHub events.Hub
+}
+
+func NewCellController(
+	cellDB db.CellDB,
+	serviceClient serviceclient.ServiceClient,
+	repAdminClient repadmin.Client,
+	actualLRPInstanceHub events.Hub,
+) *CellController {
+	return &CellController{
+		cellDB:               cellDB,
+		serviceClient:        serviceClient,
+		repAdminClient:       repAdminClient,
+		actualLRPInstanceHub: actualLRPInstanceHub,
+	}
+}
+
+func (c *CellController) CordonedCellIds(ctx context.Context, logger lager.Logger) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "CellController.CordonedCellIds")
+	defer span.End()
+
+	return c.cellDB.CordonedCellIds(ctx, logger)
+}
+
+func (c *CellController) CordonCell(ctx context.Context, logger lager.Logger, cellId string) error {
+	ctx, span := trace.StartSpan(ctx, "CellController.CordonCell")
+	defer span.End()
+
+	return c.cellDB.SetCellCordoned(ctx, logger.Session("cordon-cell"), cellId, true)
+}
+
+func (c *CellController) UncordonCell(ctx context.Context, logger lager.Logger, cellId string) error {
+	ctx, span := trace.StartSpan(ctx, "CellController.UncordonCell")
+	defer span.End()
+
+	return c.cellDB.SetCellCordoned(ctx, logger.Session("uncordon-cell"), cellId, false)
+}
+
+func (c *CellController) CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error) {
+	ctx, span := trace.StartSpan(ctx, "CellController.CellDrains")
+	defer span.End()
+
+	return c.cellDB.CellDrains(ctx, logger)
+}
+
+// DrainCell cordons the cell and asks its rep to evacuate it. The drain is
+// marked as failed when the rep cannot be asked to evacuate; otherwise it is
+// completed by ConvergeCellDrains once no ActualLRPs remain on the cell.
+func (c *CellController) DrainCell(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error) {
+	ctx, span := trace.StartSpan(ctx, "CellController.DrainCell")
+	defer span.End()
+
+	logger = logger.Session("drain-cell", lager.Data{"cell_id": cellId})
+	traceId := trace.RequestIdFromContext(ctx)
+
+	cell, err := c.serviceClient.CellById(logger, cellId)
+	if err != nil {
+		logger.Error("failed-fetching-cell", err)
+		return nil, err
+	}
+
+	drain, err := c.cellDB.StartCellDrain(ctx, logger, cellId)
+	if err != nil {
+		return nil, err
+	}
+	go c.actualLRPInstanceHub.Emit(models.NewCellDrainChangedEvent(drain, traceId))
+
+	_, repSpan := trace.StartClientSpan(ctx, "rep.Evacuate")
+	err = c.repAdminClient.Evacuate(logger, traceId, cell)
+	trace.EndSpan(repSpan, err)
+	if err != nil {
+		logger.Error("failed-evacuating-cell", err)
+		failed, failErr := c.cellDB.FailCellDrain(ctx, logger, cellId, err.Error())
+		if failErr != nil {
+			return nil, failErr
+		}
+		go c.actualLRPInstanceHub.Emit(models.NewCellDrainChangedEvent(failed, traceId))
+		return failed, nil
+	}
+
+	return drain, nil
+}
+
+// ConvergeCellDrains recounts the ActualLRPs left on the cells being drained
+// and emits the drains that progressed.
+func (c *CellController) ConvergeCellDrains(ctx context.Context, logger lager.Logger) error {
+	ctx, span := trace.StartSpan(ctx, "CellController.ConvergeCellDrains")
+	defer span.End()
+
+	logger = logger.Session("converge-cell-drains")
+	traceId := trace.RequestIdFromContext(ctx)
+
+	drains, err := c.cellDB.CellDrains(ctx, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, drain := range drains {
+		if drain.State != models.CellDrain_Draining {
+			continue
+		}
+
+		before, after, err := c.cellDB.UpdateCellDrainProgress(ctx, logger, drain.CellId)
+		if err != nil {
+			logger.Error("failed-updating-cell-drain-progress", err, lager.Data{"cell_id": drain.CellId})
+			continue
+		}
+
+		if !after.Equal(before) {
+			logger.Info("cell-drain-progressed", lager.Data{
+				"cell_id":             after.CellId,
+				"state":               after.State.String(),
+				"remaining_instances": after.RemainingInstances,
+			})
+			go c.actualLRPInstanceHub.Emit(models.NewCellDrainChangedEvent(after, traceId))
+		}
+	}
+
+	return nil
+}
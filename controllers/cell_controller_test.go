@@ -0,0 +1,173 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/repadmin"
+	"code.cloudfoundry.org/bbs/repadmin/repadminfakes"
+	"code.cloudfoundry.org/lager/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cell Controller", func() {
+	var (
+		fakeCellDB           *dbfakes.FakeCellDB
+		fakeRepAdminClient   *repadminfakes.FakeClient
+		actualLRPInstanceHub *eventfakes.FakeHub
+		controller           *controllers.CellController
+	)
+
+	BeforeEach(func() {
+		fakeCellDB = new(dbfakes.FakeCellDB)
+		fakeRepAdminClient = new(repadminfakes.FakeClient)
+		actualLRPInstanceHub = new(eventfakes.FakeHub)
+
+		controller = controllers.NewCellController(
+			fakeCellDB,
+			fakeServiceClient,
+			fakeRepAdminClient,
+			actualLRPInstanceHub,
+		)
+	})
+
+	Describe("CordonCell", func() {
+		It("cordons the cell", func() {
+			Expect(controller.CordonCell(ctx, logger, "cell-id")).To(Succeed())
+
+			Expect(fakeCellDB.SetCellCordonedCallCount()).To(Equal(1))
+			_, _, cellId, cordoned := fakeCellDB.SetCellCordonedArgsForCall(0)
+			Expect(cellId).To(Equal("cell-id"))
+			Expect(cordoned).To(BeTrue())
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		It("uncordons the cell", func() {
+			Expect(controller.UncordonCell(ctx, logger, "cell-id")).To(Succeed())
+
+			Expect(fakeCellDB.SetCellCordonedCallCount()).To(Equal(1))
+			_, _, cellId, cordoned := fakeCellDB.SetCellCordonedArgsForCall(0)
+			Expect(cellId).To(Equal("cell-id"))
+			Expect(cordoned).To(BeFalse())
+		})
+	})
+
+	Describe("DrainCell", func() {
+		var (
+			cellPresence models.CellPresence
+			drain        *models.CellDrain
+		)
+
+		BeforeEach(func() {
+			cellPresence = models.NewCellPresence("cell-id", "http://cell.example.com:1800", "https://cell.example.com:1801", "zone", models.CellCapacity{}, nil, nil, nil, nil, nil, nil)
+			fakeServiceClient.CellByIdReturns(&cellPresence, nil)
+
+			drain = &models.CellDrain{CellId: "cell-id", State: models.CellDrain_Draining, InitialInstances: 2, RemainingInstances: 2}
+			fakeCellDB.StartCellDrainReturns(drain, nil)
+		})
+
+		It("starts the drain and asks the rep to evacuate", func() {
+			started, err := controller.DrainCell(ctx, logger, "cell-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(Equal(drain))
+
+			_, _, cellId := fakeCellDB.StartCellDrainArgsForCall(0)
+			Expect(cellId).To(Equal("cell-id"))
+
+			Expect(fakeRepAdminClient.EvacuateCallCount()).To(Equal(1))
+			_, _, evacuatedCell := fakeRepAdminClient.EvacuateArgsForCall(0)
+			Expect(evacuatedCell).To(Equal(&cellPresence))
+
+			Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(1))
+			Expect(actualLRPInstanceHub.EmitArgsForCall(0)).To(Equal(models.NewCellDrainChangedEvent(drain, "")))
+			Expect(fakeCellDB.FailCellDrainCallCount()).To(Equal(0))
+		})
+
+		Context("when the cell is not present", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellByIdReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("does not start the drain", func() {
+				_, err := controller.DrainCell(ctx, logger, "cell-id")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+				Expect(fakeCellDB.StartCellDrainCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the rep fails to evacuate", func() {
+			var failed *models.CellDrain
+
+			BeforeEach(func() {
+				fakeRepAdminClient.EvacuateReturns(errors.New("boom"))
+				failed = &models.CellDrain{CellId: "cell-id", State: models.CellDrain_Failed, Error: "boom"}
+				fakeCellDB.FailCellDrainReturns(failed, nil)
+			})
+
+			It("marks the drain as failed", func() {
+				returned, err := controller.DrainCell(ctx, logger, "cell-id")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(returned).To(Equal(failed))
+
+				_, _, cellId, message := fakeCellDB.FailCellDrainArgsForCall(0)
+				Expect(cellId).To(Equal("cell-id"))
+				Expect(message).To(Equal("boom"))
+
+				Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(2))
+			})
+		})
+
+		Context("when the rep admin port is not configured", func() {
+			BeforeEach(func() {
+				fakeRepAdminClient.EvacuateReturns(repadmin.ErrAdminPortNotConfigured)
+				fakeCellDB.FailCellDrainReturns(&models.CellDrain{CellId: "cell-id", State: models.CellDrain_Failed}, nil)
+			})
+
+			It("marks the drain as failed", func() {
+				_, err := controller.DrainCell(ctx, logger, "cell-id")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, _, message := fakeCellDB.FailCellDrainArgsForCall(0)
+				Expect(message).To(Equal(repadmin.ErrAdminPortNotConfigured.Error()))
+			})
+		})
+	})
+
+	Describe("ConvergeCellDrains", func() {
+		BeforeEach(func() {
+			fakeCellDB.CellDrainsReturns([]*models.CellDrain{
+				{CellId: "draining-cell", State: models.CellDrain_Draining, RemainingInstances: 1},
+				{CellId: "stalled-cell", State: models.CellDrain_Draining, RemainingInstances: 2},
+				{CellId: "drained-cell", State: models.CellDrain_Drained},
+			}, nil)
+
+			fakeCellDB.UpdateCellDrainProgressStub = func(_ context.Context, _ lager.Logger, cellId string) (*models.CellDrain, *models.CellDrain, error) {
+				switch cellId {
+				case "draining-cell":
+					return &models.CellDrain{CellId: cellId, State: models.CellDrain_Draining, RemainingInstances: 1},
+						&models.CellDrain{CellId: cellId, State: models.CellDrain_Drained}, nil
+				default:
+					drain := &models.CellDrain{CellId: cellId, State: models.CellDrain_Draining, RemainingInstances: 2}
+					return drain, drain, nil
+				}
+			}
+		})
+
+		It("updates the draining cells and emits the drains that progressed", func() {
+			Expect(controller.ConvergeCellDrains(ctx, logger)).To(Succeed())
+
+			Expect(fakeCellDB.UpdateCellDrainProgressCallCount()).To(Equal(2))
+			Eventually(actualLRPInstanceHub.EmitCallCount).Should(Equal(1))
+			Consistently(actualLRPInstanceHub.EmitCallCount).Should(Equal(1))
+			Expect(actualLRPInstanceHub.EmitArgsForCall(0)).To(Equal(models.NewCellDrainChangedEvent(
+				&models.CellDrain{CellId: "draining-cell", State: models.CellDrain_Drained}, "",
+			)))
+		})
+	})
+})
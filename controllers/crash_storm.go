@@ -0,0 +1,10 @@
+package controllers
+
+import "code.cloudfoundry.org/bbs/models"
+
+//counterfeiter:generate -o fakes/fake_crash_storm_detector.go . CrashStormDetector
+type CrashStormDetector interface {
+	RecordCrash(traceId string, key *models.ActualLRPKey)
+	RestartsPaused(key *models.ActualLRPKey) bool
+	CloseExpiredBreakers(traceId string)
+}
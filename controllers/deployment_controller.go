@@ -0,0 +1,319 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/calculator"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type DeploymentController struct {
+	deploymentDB         db.DeploymentDB
+	desiredLRPDB         db.DesiredLRPDB
+	actualLRPDB          db.ActualLRPDB
+	auctioneerClient     auctioneer.Client
+	retirer              Retirer
+	desiredHub           events.Hub
+	actualHub            events.Hub
+	actualLRPInstanceHub events.Hub
+}
+
+func NewDeploymentController(
+	deploymentDB db.DeploymentDB,
+	desiredLRPDB db.DesiredLRPDB,
+	actualLRPDB db.ActualLRPDB,
+	auctioneerClient auctioneer.Client,
+	retirer Retirer,
+	desiredHub events.Hub,
+	actualHub events.Hub,
+	actualLRPInstanceHub events.Hub,
+) *DeploymentController {
+	return &DeploymentController{
+		deploymentDB:         deploymentDB,
+		desiredLRPDB:         desiredLRPDB,
+		actualLRPDB:          actualLRPDB,
+		auctioneerClient:     auctioneerClient,
+		retirer:              retirer,
+		desiredHub:           desiredHub,
+		actualHub:            actualHub,
+		actualLRPInstanceHub: actualLRPInstanceHub,
+	}
+}
+
+func (c *DeploymentController) StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.StartDeployment")
+	defer span.End()
+
+	logger = logger.Session("start-deployment", lager.Data{"process_guid": processGuid})
+
+	ctx, _ = db.WithWriteVersion(ctx)
+	before, deployment, err := c.deploymentDB.StartDeployment(ctx, logger, processGuid, runInfo, maxSurge, maxUnavailable)
+	if err != nil {
+		return nil, err
+	}
+
+	c.emitDesiredLRPChanged(ctx, logger, before)
+	go c.desiredHub.Emit(models.NewDeploymentChangedEvent(deployment, trace.RequestIdFromContext(ctx)))
+
+	return deployment, nil
+}
+
+func (c *DeploymentController) DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.DeploymentByProcessGuid")
+	defer span.End()
+
+	return c.deploymentDB.DeploymentByProcessGuid(ctx, logger, processGuid)
+}
+
+func (c *DeploymentController) PauseDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.PauseDeployment")
+	defer span.End()
+
+	return c.setPaused(ctx, logger.Session("pause-deployment", lager.Data{"process_guid": processGuid}), processGuid, true)
+}
+
+func (c *DeploymentController) ResumeDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.ResumeDeployment")
+	defer span.End()
+
+	return c.setPaused(ctx, logger.Session("resume-deployment", lager.Data{"process_guid": processGuid}), processGuid, false)
+}
+
+func (c *DeploymentController) setPaused(ctx context.Context, logger lager.Logger, processGuid string, paused bool) (*models.Deployment, error) {
+	deployment, err := c.deploymentDB.SetDeploymentPaused(ctx, logger, processGuid, paused)
+	if err != nil {
+		return nil, err
+	}
+
+	go c.desiredHub.Emit(models.NewDeploymentChangedEvent(deployment, trace.RequestIdFromContext(ctx)))
+	return deployment, nil
+}
+
+func (c *DeploymentController) RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.RollbackDeployment")
+	defer span.End()
+
+	logger = logger.Session("rollback-deployment", lager.Data{"process_guid": processGuid})
+
+	ctx, _ = db.WithWriteVersion(ctx)
+	before, deployment, err := c.deploymentDB.RollbackDeployment(ctx, logger, processGuid)
+	if err != nil {
+		return nil, err
+	}
+
+	c.emitDesiredLRPChanged(ctx, logger, before)
+	go c.desiredHub.Emit(models.NewDeploymentChangedEvent(deployment, trace.RequestIdFromContext(ctx)))
+
+	return deployment, nil
+}
+
+// ProgressDeployments moves every active, unpaused deployment on by one
+// batch: it retires as many pending instances as max_unavailable allows and
+// records the instances whose replacements are running and routable. A
+// deployment with nothing left to replace is completed.
+func (c *DeploymentController) ProgressDeployments(ctx context.Context, logger lager.Logger) {
+	ctx, span := trace.StartSpan(ctx, "DeploymentController.ProgressDeployments")
+	defer span.End()
+
+	logger = logger.Session("progress-deployments")
+
+	deployments, err := c.deploymentDB.ActiveDeployments(ctx, logger)
+	if err != nil {
+		logger.Error("failed-fetching-active-deployments", err)
+		return
+	}
+
+	for _, deployment := range deployments {
+		if deployment.Paused {
+			continue
+		}
+		c.progressDeployment(ctx, logger, deployment)
+	}
+}
+
+func (c *DeploymentController) progressDeployment(ctx context.Context, logger lager.Logger, deployment *models.Deployment) {
+	logger = logger.Session("progress-deployment", lager.Data{"process_guid": deployment.ProcessGuid, "deployment_guid": deployment.DeploymentGuid})
+
+	actualLRPs, err := c.actualLRPDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: deployment.ProcessGuid})
+	if err != nil {
+		logger.Error("failed-fetching-actual-lrps", err)
+		return
+	}
+
+	ordinary := map[int32]*models.ActualLRP{}
+	for _, lrp := range actualLRPs {
+		if lrp.Presence == models.ActualLRP_Ordinary {
+			ordinary[lrp.Index] = lrp
+		}
+	}
+
+	changed := false
+	for index, instanceGuid := range deployment.RetiringInstances {
+		lrp := ordinary[index]
+		if lrp != nil && lrp.InstanceGuid != instanceGuid && isAvailable(lrp) {
+			delete(deployment.RetiringInstances, index)
+			changed = true
+		}
+	}
+
+	// start missing instances, such as the surge instances or the ones that
+	// were just retired, instead of waiting for convergence to notice them
+	missing := []int32{}
+	for index := int32(0); index < deployment.Instances+deployment.MaxSurge; index++ {
+		if ordinary[index] == nil {
+			missing = append(missing, index)
+		}
+	}
+	if len(missing) > 0 {
+		c.startInstances(ctx, logger, deployment, missing)
+	}
+
+	available := int32(0)
+	for index, lrp := range ordinary {
+		if _, retiring := deployment.RetiringInstances[index]; retiring {
+			continue
+		}
+		if index < deployment.Instances+deployment.MaxSurge && isAvailable(lrp) {
+			available++
+		}
+	}
+	budget := available - (deployment.Instances - deployment.MaxUnavailable)
+
+	pendingIndices := append([]int32{}, deployment.PendingIndices...)
+	for _, index := range pendingIndices {
+		lrp := ordinary[index]
+		if lrp == nil {
+			deployment.Retire(index, "")
+			changed = true
+			continue
+		}
+
+		// an instance that is not serving can be replaced without reducing
+		// the availability of the LRP
+		serving := isAvailable(lrp)
+		if serving && budget <= 0 {
+			continue
+		}
+
+		err := c.retirer.RetireActualLRP(ctx, logger, &lrp.ActualLRPKey)
+		if err != nil {
+			logger.Error("failed-retiring-actual-lrp", err, lager.Data{"index": index})
+			continue
+		}
+
+		deployment.Retire(index, lrp.InstanceGuid)
+		changed = true
+		if serving {
+			budget--
+		}
+	}
+
+	if deployment.Replaced() {
+		c.completeDeployment(ctx, logger, deployment)
+		return
+	}
+
+	if !changed {
+		return
+	}
+
+	err = c.deploymentDB.UpdateDeploymentProgress(ctx, logger, deployment)
+	if err != nil {
+		logger.Error("failed-updating-deployment-progress", err)
+		return
+	}
+
+	go c.desiredHub.Emit(models.NewDeploymentChangedEvent(deployment, trace.RequestIdFromContext(ctx)))
+}
+
+func (c *DeploymentController) completeDeployment(ctx context.Context, logger lager.Logger, deployment *models.Deployment) {
+	logger = logger.Session("complete-deployment")
+
+	ctx, _ = db.WithWriteVersion(ctx)
+	before, completed, err := c.deploymentDB.CompleteDeployment(ctx, logger, deployment.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-completing-deployment", err)
+		return
+	}
+
+	for index := completed.Instances; index < completed.Instances+completed.MaxSurge; index++ {
+		key := models.NewActualLRPKey(completed.ProcessGuid, index, completed.Domain)
+		err := c.retirer.RetireActualLRP(ctx, logger, &key)
+		if err != nil && err != models.ErrResourceNotFound {
+			logger.Error("failed-retiring-surge-instance", err, lager.Data{"index": index})
+		}
+	}
+
+	c.emitDesiredLRPChanged(ctx, logger, before)
+	go c.desiredHub.Emit(models.NewDeploymentChangedEvent(completed, trace.RequestIdFromContext(ctx)))
+}
+
+func (c *DeploymentController) startInstances(ctx context.Context, logger lager.Logger, deployment *models.Deployment, indices []int32) {
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+	logger = logger.Session("start-instances", lager.Data{"indices": indices})
+
+	schedulingInfo, err := c.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, deployment.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-scheduling-info", err)
+		return
+	}
+
+	eventCalculator := calculator.ActualLRPEventCalculator{
+		ActualLRPGroupHub:    c.actualHub,
+		ActualLRPInstanceHub: c.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
+	}
+
+	createdIndices := []int{}
+	for _, index := range indices {
+		key := models.NewActualLRPKey(deployment.ProcessGuid, index, deployment.Domain)
+		actualLRP, err := c.actualLRPDB.CreateUnclaimedActualLRP(ctx, logger, &key)
+		if err != nil {
+			logger.Info("failed-creating-unclaimed-actual-lrp", lager.Data{"index": index, "err_message": err.Error()})
+			continue
+		}
+
+		lrps := eventCalculator.RecordChange(nil, actualLRP, nil)
+		go eventCalculator.EmitEvents(trace.RequestIdFromContext(ctx), nil, lrps)
+		createdIndices = append(createdIndices, int(index))
+	}
+
+	if len(createdIndices) == 0 {
+		return
+	}
+
+	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, createdIndices...)
+	_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestLRPAuctions")
+	err = c.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&start})
+	trace.EndSpan(auctioneerSpan, err)
+	if err != nil {
+		logger.Error("failed-to-request-auction", err)
+	}
+}
+
+// emitDesiredLRPChanged emits the change of the desired LRP by a deployment,
+// at the resource version of the writes recorded in the context.
+func (c *DeploymentController) emitDesiredLRPChanged(ctx context.Context, logger lager.Logger, before *models.DesiredLRP) {
+	after, err := c.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger, before.ProcessGuid)
+	if err != nil {
+		logger.Error("failed-fetching-desired-lrp", err)
+		return
+	}
+
+	writeVersion, _ := db.WriteVersionFromContext(ctx)
+	go c.desiredHub.Emit(writeVersion.Versioned(models.NewDesiredLRPChangedEvent(before, after, trace.RequestIdFromContext(ctx))))
+}
+
+// isAvailable reports whether the instance is running and, when the cell
+// reported it, routable.
+func isAvailable(lrp *models.ActualLRP) bool {
+	if lrp.State != models.ActualLRPStateRunning {
+		return false
+	}
+	return !lrp.RoutableExists() || lrp.GetRoutable()
+}
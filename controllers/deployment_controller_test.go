@@ -0,0 +1,309 @@
+package controllers_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Controller", func() {
+	var (
+		fakeDeploymentDB     *dbfakes.FakeDeploymentDB
+		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
+		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
+		fakeAuctioneerClient *auctioneerfakes.FakeClient
+		retirer              *fakes.FakeRetirer
+		desiredHub           *eventfakes.FakeHub
+		actualHub            *eventfakes.FakeHub
+		actualLRPInstanceHub *eventfakes.FakeHub
+
+		desiredLRP *models.DesiredLRP
+		controller *controllers.DeploymentController
+	)
+
+	BeforeEach(func() {
+		fakeDeploymentDB = new(dbfakes.FakeDeploymentDB)
+		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
+		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
+		retirer = new(fakes.FakeRetirer)
+		desiredHub = new(eventfakes.FakeHub)
+		actualHub = new(eventfakes.FakeHub)
+		actualLRPInstanceHub = new(eventfakes.FakeHub)
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("some-guid")
+		fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(desiredLRP, nil)
+		schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
+		fakeDesiredLRPDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&schedulingInfo, nil)
+
+		controller = controllers.NewDeploymentController(
+			fakeDeploymentDB,
+			fakeDesiredLRPDB,
+			fakeActualLRPDB,
+			fakeAuctioneerClient,
+			retirer,
+			desiredHub,
+			actualHub,
+			actualLRPInstanceHub,
+		)
+	})
+
+	Describe("StartDeployment", func() {
+		var (
+			runInfo    *models.DesiredLRPRunInfo
+			deployment *models.Deployment
+		)
+
+		BeforeEach(func() {
+			info := desiredLRP.DesiredLRPRunInfo(time.Now())
+			runInfo = &info
+			deployment = &models.Deployment{ProcessGuid: "some-guid", State: models.Deployment_InProgress}
+			fakeDeploymentDB.StartDeploymentReturns(desiredLRP, deployment, nil)
+		})
+
+		It("starts the deployment and emits events", func() {
+			started, err := controller.StartDeployment(ctx, logger, "some-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(Equal(deployment))
+
+			Expect(fakeDeploymentDB.StartDeploymentCallCount()).To(Equal(1))
+			_, _, processGuid, actualRunInfo, maxSurge, maxUnavailable := fakeDeploymentDB.StartDeploymentArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(actualRunInfo).To(Equal(runInfo))
+			Expect(maxSurge).To(BeEquivalentTo(1))
+			Expect(maxUnavailable).To(BeEquivalentTo(0))
+
+			Eventually(desiredHub.EmitCallCount).Should(Equal(2))
+			emitted := []models.Event{desiredHub.EmitArgsForCall(0), desiredHub.EmitArgsForCall(1)}
+			Expect(emitted).To(ContainElement(BeAssignableToTypeOf(&models.DesiredLRPChangedEvent{})))
+			Expect(emitted).To(ContainElement(Equal(models.NewDeploymentChangedEvent(deployment, ""))))
+		})
+
+		Context("when the DB fails", func() {
+			BeforeEach(func() {
+				fakeDeploymentDB.StartDeploymentReturns(nil, nil, models.ErrResourceConflict)
+			})
+
+			It("returns the error and emits nothing", func() {
+				_, err := controller.StartDeployment(ctx, logger, "some-guid", runInfo, 1, 0)
+				Expect(err).To(Equal(models.ErrResourceConflict))
+				Consistently(desiredHub.EmitCallCount).Should(Equal(0))
+			})
+		})
+	})
+
+	Describe("PauseDeployment", func() {
+		It("pauses the deployment and emits an event", func() {
+			paused := &models.Deployment{ProcessGuid: "some-guid", Paused: true}
+			fakeDeploymentDB.SetDeploymentPausedReturns(paused, nil)
+
+			deployment, err := controller.PauseDeployment(ctx, logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment).To(Equal(paused))
+
+			_, _, processGuid, isPaused := fakeDeploymentDB.SetDeploymentPausedArgsForCall(0)
+			Expect(processGuid).To(Equal("some-guid"))
+			Expect(isPaused).To(BeTrue())
+			Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+		})
+	})
+
+	Describe("RollbackDeployment", func() {
+		It("rolls back the deployment and emits events", func() {
+			rolledBack := &models.Deployment{ProcessGuid: "some-guid", State: models.Deployment_RollingBack}
+			fakeDeploymentDB.RollbackDeploymentReturns(desiredLRP, rolledBack, nil)
+
+			deployment, err := controller.RollbackDeployment(ctx, logger, "some-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment).To(Equal(rolledBack))
+			Eventually(desiredHub.EmitCallCount).Should(Equal(2))
+		})
+
+		It("returns transition errors", func() {
+			transitionErr := models.NewDeploymentTransitionError(models.Deployment_Succeeded, "roll back")
+			fakeDeploymentDB.RollbackDeploymentReturns(nil, nil, transitionErr)
+
+			_, err := controller.RollbackDeployment(ctx, logger, "some-guid")
+			Expect(err).To(Equal(transitionErr))
+		})
+	})
+
+	Describe("ProgressDeployments", func() {
+		var (
+			deployment *models.Deployment
+			actualLRPs []*models.ActualLRP
+		)
+
+		runningLRP := func(index int32, instanceGuid string) *models.ActualLRP {
+			lrp := model_helpers.NewValidActualLRP("some-guid", index)
+			lrp.InstanceGuid = instanceGuid
+			lrp.State = models.ActualLRPStateRunning
+			lrp.SetRoutable(true)
+			return lrp
+		}
+
+		BeforeEach(func() {
+			deployment = &models.Deployment{
+				DeploymentGuid: "deployment-guid",
+				ProcessGuid:    "some-guid",
+				Domain:         "some-domain",
+				State:          models.Deployment_InProgress,
+				MaxSurge:       1,
+				MaxUnavailable: 0,
+				Instances:      2,
+				PendingIndices: []int32{0, 1},
+			}
+			actualLRPs = []*models.ActualLRP{
+				runningLRP(0, "old-0"),
+				runningLRP(1, "old-1"),
+			}
+			fakeDeploymentDB.ActiveDeploymentsReturns([]*models.Deployment{deployment}, nil)
+			fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+			fakeActualLRPDB.CreateUnclaimedActualLRPStub = func(_ context.Context, _ lager.Logger, key *models.ActualLRPKey) (*models.ActualLRP, error) {
+				return &models.ActualLRP{ActualLRPKey: *key, State: models.ActualLRPStateUnclaimed}, nil
+			}
+		})
+
+		It("starts missing surge instances", func() {
+			controller.ProgressDeployments(ctx, logger)
+
+			Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(1))
+			_, _, key := fakeActualLRPDB.CreateUnclaimedActualLRPArgsForCall(0)
+			Expect(key.Index).To(BeEquivalentTo(2))
+
+			Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			_, _, startRequests := fakeAuctioneerClient.RequestLRPAuctionsArgsForCall(0)
+			Expect(startRequests[0].Indices).To(ConsistOf(2))
+		})
+
+		It("does not retire instances that max_unavailable does not allow", func() {
+			controller.ProgressDeployments(ctx, logger)
+
+			Expect(retirer.RetireActualLRPCallCount()).To(Equal(0))
+			Expect(fakeDeploymentDB.UpdateDeploymentProgressCallCount()).To(Equal(0))
+		})
+
+		Context("when the surge instance is running and routable", func() {
+			BeforeEach(func() {
+				actualLRPs = append(actualLRPs, runningLRP(2, "new-2"))
+				fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+			})
+
+			It("retires one pending instance and records the progress", func() {
+				controller.ProgressDeployments(ctx, logger)
+
+				Expect(retirer.RetireActualLRPCallCount()).To(Equal(1))
+				_, _, key := retirer.RetireActualLRPArgsForCall(0)
+				Expect(key.Index).To(BeEquivalentTo(0))
+
+				Expect(fakeDeploymentDB.UpdateDeploymentProgressCallCount()).To(Equal(1))
+				_, _, updated := fakeDeploymentDB.UpdateDeploymentProgressArgsForCall(0)
+				Expect(updated.PendingIndices).To(ConsistOf(int32(1)))
+				Expect(updated.RetiringInstances).To(Equal(map[int32]string{0: "old-0"}))
+
+				Eventually(desiredHub.EmitCallCount).Should(Equal(1))
+				Expect(desiredHub.EmitArgsForCall(0)).To(BeAssignableToTypeOf(&models.DeploymentChangedEvent{}))
+			})
+
+			Context("when the surge instance is not routable yet", func() {
+				BeforeEach(func() {
+					actualLRPs[2].SetRoutable(false)
+				})
+
+				It("does not retire any instance", func() {
+					controller.ProgressDeployments(ctx, logger)
+					Expect(retirer.RetireActualLRPCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when retiring fails", func() {
+				BeforeEach(func() {
+					retirer.RetireActualLRPReturns(errors.New("boom"))
+				})
+
+				It("does not record the instance as retiring", func() {
+					controller.ProgressDeployments(ctx, logger)
+					Expect(fakeDeploymentDB.UpdateDeploymentProgressCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the replacement of a retiring instance is running", func() {
+			BeforeEach(func() {
+				deployment.PendingIndices = []int32{1}
+				deployment.RetiringInstances = map[int32]string{0: "old-0"}
+				actualLRPs = []*models.ActualLRP{
+					runningLRP(0, "new-0"),
+					runningLRP(1, "old-1"),
+					runningLRP(2, "new-2"),
+				}
+				fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+			})
+
+			It("marks the index as replaced and retires the next one", func() {
+				controller.ProgressDeployments(ctx, logger)
+
+				Expect(retirer.RetireActualLRPCallCount()).To(Equal(1))
+				_, _, key := retirer.RetireActualLRPArgsForCall(0)
+				Expect(key.Index).To(BeEquivalentTo(1))
+
+				_, _, updated := fakeDeploymentDB.UpdateDeploymentProgressArgsForCall(0)
+				Expect(updated.PendingIndices).To(BeEmpty())
+				Expect(updated.RetiringInstances).To(Equal(map[int32]string{1: "old-1"}))
+			})
+		})
+
+		Context("when every instance has been replaced", func() {
+			BeforeEach(func() {
+				deployment.PendingIndices = nil
+				deployment.RetiringInstances = map[int32]string{1: "old-1"}
+				actualLRPs = []*models.ActualLRP{
+					runningLRP(0, "new-0"),
+					runningLRP(1, "new-1"),
+					runningLRP(2, "new-2"),
+				}
+				fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+
+				completed := *deployment
+				completed.State = models.Deployment_Succeeded
+				completed.RetiringInstances = nil
+				fakeDeploymentDB.CompleteDeploymentReturns(desiredLRP, &completed, nil)
+			})
+
+			It("completes the deployment and retires the surge instances", func() {
+				controller.ProgressDeployments(ctx, logger)
+
+				Expect(fakeDeploymentDB.CompleteDeploymentCallCount()).To(Equal(1))
+				Expect(fakeDeploymentDB.UpdateDeploymentProgressCallCount()).To(Equal(0))
+
+				Expect(retirer.RetireActualLRPCallCount()).To(Equal(1))
+				_, _, key := retirer.RetireActualLRPArgsForCall(0)
+				Expect(key.Index).To(BeEquivalentTo(2))
+
+				Eventually(desiredHub.EmitCallCount).Should(Equal(2))
+			})
+		})
+
+		Context("when the deployment is paused", func() {
+			BeforeEach(func() {
+				deployment.Paused = true
+			})
+
+			It("leaves it alone", func() {
+				controller.ProgressDeployments(ctx, logger)
+				Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -17,9 +17,11 @@ type EvacuationController struct {
 	actualLRPDB          db.ActualLRPDB
 	suspectLRPDB         db.SuspectDB
 	desiredLRPDB         db.DesiredLRPDB
+	historyDB            db.ActualLRPHistoryDB
 	auctioneerClient     auctioneer.Client
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
+	crashStormDetector   CrashStormDetector
 }
 
 func NewEvacuationController(
@@ -27,22 +29,30 @@ func NewEvacuationController(
 	actualLRPDB db.ActualLRPDB,
 	suspectLRPDB db.SuspectDB,
 	desiredLRPDB db.DesiredLRPDB,
+	historyDB db.ActualLRPHistoryDB,
 	auctioneerClient auctioneer.Client,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
+	crashStormDetector CrashStormDetector,
 ) *EvacuationController {
 	return &EvacuationController{
 		db:                   db,
 		actualLRPDB:          actualLRPDB,
 		suspectLRPDB:         suspectLRPDB,
 		desiredLRPDB:         desiredLRPDB,
+		historyDB:            historyDB,
 		auctioneerClient:     auctioneerClient,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
+		crashStormDetector:   crashStormDetector,
 	}
 }
 
 func (h *EvacuationController) RemoveEvacuatingActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey) error {
+	ctx, span := trace.StartSpan(ctx, "EvacuationController.RemoveEvacuatingActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	actualLRPs, err := h.actualLRPDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: actualLRPKey.ProcessGuid, Index: &actualLRPKey.Index})
 	if err != nil {
 		return err
@@ -51,6 +61,7 @@ func (h *EvacuationController) RemoveEvacuatingActualLRP(ctx context.Context, lo
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	newLRPs := make([]*models.ActualLRP, len(actualLRPs))
@@ -90,6 +101,7 @@ func (h *EvacuationController) RemoveEvacuatingActualLRP(ctx context.Context, lo
 		return err
 	}
 	newLRPs = eventCalculator.RecordChange(lrp, nil, actualLRPs)
+	h.recordRemoved(ctx, logger, lrp)
 
 	return nil
 }
@@ -133,13 +145,19 @@ func (h *EvacuationController) removeEvacuatingOrSuspect(
 	}
 
 	lrps = calculator.RecordChange(lrp, nil, lrps)
+	h.recordRemoved(ctx, logger, lrp)
 	return true, lrps, nil
 }
 
 func (h *EvacuationController) EvacuateClaimedActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "EvacuationController.EvacuateClaimedActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	guid := actualLRPKey.ProcessGuid
@@ -177,6 +195,8 @@ func (h *EvacuationController) EvacuateClaimedActualLRP(ctx context.Context, log
 	}
 
 	newLRPs = eventCalculator.RecordChange(before, after, newLRPs)
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Evacuated, actualLRPKey, actualLRPInstanceKey, after, "")
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
 
 	h.requestAuction(ctx, logger, actualLRPKey)
 
@@ -184,9 +204,14 @@ func (h *EvacuationController) EvacuateClaimedActualLRP(ctx context.Context, log
 }
 
 func (h *EvacuationController) EvacuateCrashedActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey, errorMessage string) error {
+	ctx, span := trace.StartSpan(ctx, "EvacuationController.EvacuateCrashedActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	guid := actualLRPKey.ProcessGuid
@@ -221,6 +246,9 @@ func (h *EvacuationController) EvacuateCrashedActualLRP(ctx context.Context, log
 	}
 
 	newLRPs = eventCalculator.RecordChange(before, after, newLRPs)
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Crashed, actualLRPKey, actualLRPInstanceKey, after, errorMessage)
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
+	h.crashStormDetector.RecordCrash(trace.RequestIdFromContext(ctx), actualLRPKey)
 
 	return nil
 }
@@ -250,9 +278,14 @@ func (h *EvacuationController) EvacuateRunningActualLRP(
 	routable bool,
 	availabilityZone string,
 ) (bool, error) {
+	ctx, span := trace.StartSpan(ctx, "EvacuationController.EvacuateRunningActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 	guid := actualLRPKey.ProcessGuid
 	index := actualLRPKey.Index
@@ -336,6 +369,7 @@ func (h *EvacuationController) EvacuateRunningActualLRP(
 				return true, err
 			}
 			newLRPs = eventCalculator.RecordChange(targetActualLRP, nil, newLRPs)
+			h.recordRemoved(ctx, logger, targetActualLRP)
 			return false, nil
 		}
 
@@ -352,6 +386,8 @@ func (h *EvacuationController) EvacuateRunningActualLRP(
 				return true, err
 			}
 			newLRPs = eventCalculator.RecordChange(targetActualLRP, after, newLRPs)
+			record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Evacuated, actualLRPKey, actualLRPInstanceKey, after, "")
+			recordActualLRPTransition(ctx, logger, h.historyDB, record)
 			h.requestAuction(ctx, logger, actualLRPKey)
 			return false, nil
 		}
@@ -388,6 +424,10 @@ func (h *EvacuationController) EvacuateRunningActualLRP(
 		}
 
 		newLRPs = eventCalculator.RecordChange(nil, newLRP, newLRPs)
+		if err == nil && targetActualLRP == nil {
+			record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Evacuated, actualLRPKey, actualLRPInstanceKey, newLRP, "")
+			recordActualLRPTransition(ctx, logger, h.historyDB, record)
+		}
 		return true, err
 	}
 
@@ -404,9 +444,14 @@ func (h *EvacuationController) EvacuateRunningActualLRP(
 }
 
 func (h *EvacuationController) EvacuateStoppedActualLRP(ctx context.Context, logger lager.Logger, actualLRPKey *models.ActualLRPKey, actualLRPInstanceKey *models.ActualLRPInstanceKey) error {
+	ctx, span := trace.StartSpan(ctx, "EvacuationController.EvacuateStoppedActualLRP")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	guid := actualLRPKey.ProcessGuid
@@ -442,6 +487,8 @@ func (h *EvacuationController) EvacuateStoppedActualLRP(ctx context.Context, log
 
 	lrp := lookupLRPInSlice(actualLRPs, actualLRPInstanceKey)
 	newLRPs = eventCalculator.RecordChange(lrp, nil, newLRPs)
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Removed, actualLRPKey, actualLRPInstanceKey, nil, "")
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
 
 	return nil
 }
@@ -454,17 +501,21 @@ func (h *EvacuationController) requestAuction(ctx context.Context, logger lager.
 	}
 
 	startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedInfo, int(lrpKey.Index))
+	_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestLRPAuctions")
 	err = h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&startRequest})
+	trace.EndSpan(auctioneerSpan, err)
 	if err != nil {
 		logger.Error("failed-requesting-auction", err)
 	}
 }
 
 func (h *EvacuationController) evacuateInstance(ctx context.Context, logger lager.Logger, allLRPs []*models.ActualLRP, actualLRP *models.ActualLRP) error {
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	evacuating, err := h.db.EvacuateActualLRP(ctx, logger, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, &actualLRP.ActualLRPNetInfo, actualLRP.ActualLrpInternalRoutes, actualLRP.MetricTags, actualLRP.GetRoutable(), actualLRP.AvailabilityZone)
@@ -478,6 +529,8 @@ func (h *EvacuationController) evacuateInstance(ctx context.Context, logger lage
 	// with a single changed event and keep the group events backward
 	// compatible.
 	newLRPs := eventCalculator.RecordChange(actualLRP, evacuating, allLRPs)
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Evacuated, &actualLRP.ActualLRPKey, &actualLRP.ActualLRPInstanceKey, evacuating, "")
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
 
 	defer func() {
 		go eventCalculator.EmitEvents(trace.RequestIdFromContext(ctx), allLRPs, newLRPs)
@@ -516,6 +569,7 @@ func (h *EvacuationController) removeEvacuating(ctx context.Context, logger lage
 	err := h.db.RemoveEvacuatingActualLRP(ctx, logger, &evacuating.ActualLRPKey, &evacuating.ActualLRPInstanceKey)
 
 	if err == nil {
+		h.recordRemoved(ctx, logger, evacuating)
 		return evacuating, nil
 	}
 
@@ -525,3 +579,9 @@ func (h *EvacuationController) removeEvacuating(ctx context.Context, logger lage
 
 	return nil, err
 }
+
+// recordRemoved records the removal of the evacuating or suspect instance.
+func (h *EvacuationController) recordRemoved(ctx context.Context, logger lager.Logger, lrp *models.ActualLRP) {
+	record := models.NewActualLRPHistoryRecord(models.ActualLRPHistoryRecord_Removed, &lrp.ActualLRPKey, &lrp.ActualLRPInstanceKey, nil, "")
+	recordActualLRPTransition(ctx, logger, h.historyDB, record)
+}
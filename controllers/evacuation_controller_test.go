@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
 	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
@@ -24,14 +25,16 @@ var _ = Describe("Evacuation Controller", func() {
 	const traceId = "some-trace-id"
 
 	var (
-		logger               *lagertest.TestLogger
-		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
-		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
-		fakeEvacuationDB     *dbfakes.FakeEvacuationDB
-		fakeSuspectDB        *dbfakes.FakeSuspectDB
-		fakeAuctioneerClient *auctioneerfakes.FakeClient
-		actualHub            *eventfakes.FakeHub
-		actualLRPInstanceHub *eventfakes.FakeHub
+		logger                 *lagertest.TestLogger
+		fakeActualLRPDB        *dbfakes.FakeActualLRPDB
+		fakeDesiredLRPDB       *dbfakes.FakeDesiredLRPDB
+		fakeEvacuationDB       *dbfakes.FakeEvacuationDB
+		fakeSuspectDB          *dbfakes.FakeSuspectDB
+		fakeActualLRPHistoryDB *dbfakes.FakeActualLRPHistoryDB
+		fakeAuctioneerClient   *auctioneerfakes.FakeClient
+		actualHub              *eventfakes.FakeHub
+		actualLRPInstanceHub   *eventfakes.FakeHub
+		fakeCrashStormDetector *fakes.FakeCrashStormDetector
 
 		controller *controllers.EvacuationController
 		err        error
@@ -43,6 +46,7 @@ var _ = Describe("Evacuation Controller", func() {
 		fakeSuspectDB = new(dbfakes.FakeSuspectDB)
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeEvacuationDB = new(dbfakes.FakeEvacuationDB)
+		fakeActualLRPHistoryDB = new(dbfakes.FakeActualLRPHistoryDB)
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		logger = lagertest.NewTestLogger("test")
 
@@ -53,14 +57,17 @@ var _ = Describe("Evacuation Controller", func() {
 
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
+		fakeCrashStormDetector = new(fakes.FakeCrashStormDetector)
 		controller = controllers.NewEvacuationController(
 			fakeEvacuationDB,
 			fakeActualLRPDB,
 			fakeSuspectDB,
 			fakeDesiredLRPDB,
+			fakeActualLRPHistoryDB,
 			fakeAuctioneerClient,
 			actualHub,
 			actualLRPInstanceHub,
+			fakeCrashStormDetector,
 		)
 	})
 
@@ -275,6 +282,21 @@ var _ = Describe("Evacuation Controller", func() {
 			Expect(actualTraceId).To(Equal(traceId))
 		})
 
+		It("records the evacuation in the history of the instance", func() {
+			Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+			_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+			Expect(record).To(Equal(&models.ActualLRPHistoryRecord{
+				ProcessGuid:  "process-guid",
+				Index:        1,
+				InstanceGuid: lrpInstanceKey.InstanceGuid,
+				CellId:       lrpInstanceKey.CellId,
+				Transition:   models.ActualLRPHistoryRecord_Evacuated,
+				State:        models.ActualLRPStateUnclaimed,
+				CrashCount:   afterActualLRP.CrashCount,
+				TraceId:      traceId,
+			}))
+		})
+
 		It("emits an LRPChanged event", func() {
 			Eventually(actualHub.EmitCallCount).Should(Equal(1))
 
@@ -409,6 +431,13 @@ var _ = Describe("Evacuation Controller", func() {
 				Expect(instanceKey).To(Equal(lrpInstanceKey))
 			})
 
+			It("records the removal of the evacuating lrp", func() {
+				Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+				_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+				Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Removed))
+				Expect(record.InstanceGuid).To(Equal(lrpInstanceKey.InstanceGuid))
+			})
+
 			It("emits an ActualLRPRemovedEvent", func() {
 				Eventually(actualHub.EmitCallCount).Should(Equal(1))
 				event := actualHub.EmitArgsForCall(0)
@@ -575,6 +604,21 @@ var _ = Describe("Evacuation Controller", func() {
 			Expect(errorMessage).To(Equal("i failed"))
 		})
 
+		It("records the crash in the history of the instance", func() {
+			Expect(fakeActualLRPHistoryDB.RecordActualLRPTransitionCallCount()).To(Equal(1))
+			_, _, record := fakeActualLRPHistoryDB.RecordActualLRPTransitionArgsForCall(0)
+			Expect(record.Transition).To(Equal(models.ActualLRPHistoryRecord_Crashed))
+			Expect(record.InstanceGuid).To(Equal(instanceKey.InstanceGuid))
+			Expect(record.Reason).To(Equal("i failed"))
+		})
+
+		It("records the crash with the crash storm detector", func() {
+			Expect(fakeCrashStormDetector.RecordCrashCallCount()).To(Equal(1))
+			actualTraceId, actualKey := fakeCrashStormDetector.RecordCrashArgsForCall(0)
+			Expect(actualTraceId).To(Equal(traceId))
+			Expect(*actualKey).To(Equal(actualLRP.ActualLRPKey))
+		})
+
 		It("does not emit any events", func() {
 			Consistently(actualHub.EmitCallCount).Should(Equal(0))
 			Consistently(actualLRPInstanceHub.EmitCallCount).Should(Equal(0))
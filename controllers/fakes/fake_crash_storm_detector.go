@@ -0,0 +1,192 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+type FakeCrashStormDetector struct {
+	CloseExpiredBreakersStub        func(string)
+	closeExpiredBreakersMutex       sync.RWMutex
+	closeExpiredBreakersArgsForCall []struct {
+		arg1 string
+	}
+	RecordCrashStub        func(string, *models.ActualLRPKey)
+	recordCrashMutex       sync.RWMutex
+	recordCrashArgsForCall []struct {
+		arg1 string
+		arg2 *models.ActualLRPKey
+	}
+	RestartsPausedStub        func(*models.ActualLRPKey) bool
+	restartsPausedMutex       sync.RWMutex
+	restartsPausedArgsForCall []struct {
+		arg1 *models.ActualLRPKey
+	}
+	restartsPausedReturns struct {
+		result1 bool
+	}
+	restartsPausedReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCrashStormDetector) CloseExpiredBreakers(arg1 string) {
+	fake.closeExpiredBreakersMutex.Lock()
+	fake.closeExpiredBreakersArgsForCall = append(fake.closeExpiredBreakersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.CloseExpiredBreakersStub
+	fake.recordInvocation("CloseExpiredBreakers", []interface{}{arg1})
+	fake.closeExpiredBreakersMutex.Unlock()
+	if stub != nil {
+		fake.CloseExpiredBreakersStub(arg1)
+	}
+}
+
+func (fake *FakeCrashStormDetector) CloseExpiredBreakersCallCount() int {
+	fake.closeExpiredBreakersMutex.RLock()
+	defer fake.closeExpiredBreakersMutex.RUnlock()
+	return len(fake.closeExpiredBreakersArgsForCall)
+}
+
+func (fake *FakeCrashStormDetector) CloseExpiredBreakersCalls(stub func(string)) {
+	fake.closeExpiredBreakersMutex.Lock()
+	defer fake.closeExpiredBreakersMutex.Unlock()
+	fake.CloseExpiredBreakersStub = stub
+}
+
+func (fake *FakeCrashStormDetector) CloseExpiredBreakersArgsForCall(i int) string {
+	fake.closeExpiredBreakersMutex.RLock()
+	defer fake.closeExpiredBreakersMutex.RUnlock()
+	argsForCall := fake.closeExpiredBreakersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCrashStormDetector) RecordCrash(arg1 string, arg2 *models.ActualLRPKey) {
+	fake.recordCrashMutex.Lock()
+	fake.recordCrashArgsForCall = append(fake.recordCrashArgsForCall, struct {
+		arg1 string
+		arg2 *models.ActualLRPKey
+	}{arg1, arg2})
+	stub := fake.RecordCrashStub
+	fake.recordInvocation("RecordCrash", []interface{}{arg1, arg2})
+	fake.recordCrashMutex.Unlock()
+	if stub != nil {
+		fake.RecordCrashStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeCrashStormDetector) RecordCrashCallCount() int {
+	fake.recordCrashMutex.RLock()
+	defer fake.recordCrashMutex.RUnlock()
+	return len(fake.recordCrashArgsForCall)
+}
+
+func (fake *FakeCrashStormDetector) RecordCrashCalls(stub func(string, *models.ActualLRPKey)) {
+	fake.recordCrashMutex.Lock()
+	defer fake.recordCrashMutex.Unlock()
+	fake.RecordCrashStub = stub
+}
+
+func (fake *FakeCrashStormDetector) RecordCrashArgsForCall(i int) (string, *models.ActualLRPKey) {
+	fake.recordCrashMutex.RLock()
+	defer fake.recordCrashMutex.RUnlock()
+	argsForCall := fake.recordCrashArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCrashStormDetector) RestartsPaused(arg1 *models.ActualLRPKey) bool {
+	fake.restartsPausedMutex.Lock()
+	ret, specificReturn := fake.restartsPausedReturnsOnCall[len(fake.restartsPausedArgsForCall)]
+	fake.restartsPausedArgsForCall = append(fake.restartsPausedArgsForCall, struct {
+		arg1 *models.ActualLRPKey
+	}{arg1})
+	stub := fake.RestartsPausedStub
+	fakeReturns := fake.restartsPausedReturns
+	fake.recordInvocation("RestartsPaused", []interface{}{arg1})
+	fake.restartsPausedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCrashStormDetector) RestartsPausedCallCount() int {
+	fake.restartsPausedMutex.RLock()
+	defer fake.restartsPausedMutex.RUnlock()
+	return len(fake.restartsPausedArgsForCall)
+}
+
+func (fake *FakeCrashStormDetector) RestartsPausedCalls(stub func(*models.ActualLRPKey) bool) {
+	fake.restartsPausedMutex.Lock()
+	defer fake.restartsPausedMutex.Unlock()
+	fake.RestartsPausedStub = stub
+}
+
+func (fake *FakeCrashStormDetector) RestartsPausedArgsForCall(i int) *models.ActualLRPKey {
+	fake.restartsPausedMutex.RLock()
+	defer fake.restartsPausedMutex.RUnlock()
+	argsForCall := fake.restartsPausedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCrashStormDetector) RestartsPausedReturns(result1 bool) {
+	fake.restartsPausedMutex.Lock()
+	defer fake.restartsPausedMutex.Unlock()
+	fake.RestartsPausedStub = nil
+	fake.restartsPausedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCrashStormDetector) RestartsPausedReturnsOnCall(i int, result1 bool) {
+	fake.restartsPausedMutex.Lock()
+	defer fake.restartsPausedMutex.Unlock()
+	fake.RestartsPausedStub = nil
+	if fake.restartsPausedReturnsOnCall == nil {
+		fake.restartsPausedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.restartsPausedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeCrashStormDetector) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.closeExpiredBreakersMutex.RLock()
+	defer fake.closeExpiredBreakersMutex.RUnlock()
+	fake.recordCrashMutex.RLock()
+	defer fake.recordCrashMutex.RUnlock()
+	fake.restartsPausedMutex.RLock()
+	defer fake.restartsPausedMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCrashStormDetector) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ controllers.CrashStormDetector = new(FakeCrashStormDetector)
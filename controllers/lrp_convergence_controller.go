@@ -39,6 +39,7 @@ type LRPConvergenceController struct {
 	retirer                Retirer
 	convergenceWorkersSize int
 	lrpStatMetronNotifier  metrics.LRPStatMetronNotifier
+	crashStormDetector     CrashStormDetector
 }
 
 func NewLRPConvergenceController(
@@ -55,6 +56,7 @@ func NewLRPConvergenceController(
 	retirer Retirer,
 	convergenceWorkersSize int,
 	lrpStatMetronNotifier metrics.LRPStatMetronNotifier,
+	crashStormDetector CrashStormDetector,
 ) *LRPConvergenceController {
 	return &LRPConvergenceController{
 		logger:                 logger,
@@ -70,10 +72,15 @@ func NewLRPConvergenceController(
 		retirer:                retirer,
 		convergenceWorkersSize: convergenceWorkersSize,
 		lrpStatMetronNotifier:  lrpStatMetronNotifier,
+		crashStormDetector:     crashStormDetector,
 	}
 }
 
 func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
+	ctx, span := trace.StartSpan(ctx, "LRPConvergenceController.ConvergeLRPs")
+	defer span.End()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
+
 	logger := h.logger.Session("converge-lrps")
 	traceId := trace.RequestIdFromContext(ctx)
 
@@ -98,12 +105,12 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 
 	events := convergenceResult.Events
 	for _, e := range events {
-		go h.actualHub.Emit(e)
+		go h.actualHub.Emit(writeVersion.Versioned(e))
 	}
 
 	instanceEvents := convergenceResult.InstanceEvents
 	for _, e := range instanceEvents {
-		go h.actualLRPInstanceHub.Emit(e)
+		go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(e))
 	}
 
 	keysToRetire := convergenceResult.KeysToRetire
@@ -126,7 +133,9 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 		startLogger := logger.WithData(lager.Data{"start_requests_count": len(startRequests)})
 		if len(startRequests) > 0 {
 			startLogger.Debug("requesting-start-auctions")
+			_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestLRPAuctions")
 			err = h.auctioneerClient.RequestLRPAuctions(logger, traceId, startRequests)
+			trace.EndSpan(auctioneerSpan, err)
 			if err != nil {
 				startLogger.Error("failed-to-request-starts", err, lager.Data{"lrp_start_auctions": startRequests})
 			}
@@ -166,8 +175,8 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			}
 
 			//lint:ignore SA1019 - still need to emit these events until the ActaulLRPGroup api is deleted
-			go h.actualHub.Emit(models.NewActualLRPCreatedEvent(lrp.ToActualLRPGroup()))
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(lrp, traceId))
+			go h.actualHub.Emit(writeVersion.Versioned(models.NewActualLRPCreatedEvent(lrp.ToActualLRPGroup())))
+			go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceCreatedEvent(lrp, traceId)))
 
 			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
 			startRequestLock.Lock()
@@ -176,7 +185,14 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 		})
 	}
 
+	h.crashStormDetector.CloseExpiredBreakers(traceId)
+
 	for _, lrpKey := range convergenceResult.UnstartedLRPKeys {
+		if lrpKey.Crashed && h.crashStormDetector.RestartsPaused(lrpKey.Key) {
+			logger.Info("skipping-restart-during-crash-storm", lager.Data{"key": lrpKey.Key})
+			continue
+		}
+
 		dereferencedKey := *lrpKey
 		works = append(works, func() {
 			before, after, err := h.lrpDB.UnclaimActualLRP(ctx, logger, true, dereferencedKey.Key)
@@ -186,10 +202,10 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			} else if !after.Equal(before) {
 				logger.Info("emitting-changed-event", lager.Data{"before": before, "after": after})
 				//lint:ignore SA1019 - still need to emit these events until the ActaulLRPGroup api is deleted
-				go h.actualHub.Emit(models.NewActualLRPChangedEvent(before.ToActualLRPGroup(), after.ToActualLRPGroup()))
+				go h.actualHub.Emit(writeVersion.Versioned(models.NewActualLRPChangedEvent(before.ToActualLRPGroup(), after.ToActualLRPGroup())))
 				go func() {
-					h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(after, traceId))
-					h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceRemovedEvent(before, traceId))
+					h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceCreatedEvent(after, traceId)))
+					h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceRemovedEvent(before, traceId)))
 				}()
 			}
 
@@ -226,8 +242,8 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 
 				//emit instance events for removing suspect and creating unclaimed
 				go func() {
-					h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(after, traceId))
-					h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceRemovedEvent(before, traceId))
+					h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceCreatedEvent(after, traceId)))
+					h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceRemovedEvent(before, traceId)))
 				}()
 
 				return
@@ -238,14 +254,14 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 				logger.Error("cannot-change-lrp-presence", err, lager.Data{"key": dereferencedKey})
 				return
 			}
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceChangedEvent(before, after, traceId))
+			go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceChangedEvent(before, after, traceId)))
 
 			unclaimed, err := h.lrpDB.CreateUnclaimedActualLRP(ctx, logger.Session("create-unclaimed-actual"), dereferencedKey.Key)
 			if err != nil {
 				logger.Error("cannot-unclaim-lrp", err)
 				return
 			}
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(unclaimed, traceId))
+			go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceCreatedEvent(unclaimed, traceId)))
 
 			startRequest := auctioneer.NewLRPStartRequestFromSchedulingInfo(dereferencedKey.SchedulingInfo, int(dereferencedKey.Key.Index))
 			startRequestLock.Lock()
@@ -269,11 +285,11 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			}
 			if removedLRP != nil {
 				//lint:ignore SA1019 - still need to emit these events until the ActaulLRPGroup api is deleted
-				go h.actualHub.Emit(models.NewActualLRPRemovedEvent(removedLRP.ToActualLRPGroup()))
-				go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceRemovedEvent(removedLRP, traceId))
+				go h.actualHub.Emit(writeVersion.Versioned(models.NewActualLRPRemovedEvent(removedLRP.ToActualLRPGroup())))
+				go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceRemovedEvent(removedLRP, traceId)))
 
 			}
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceChangedEvent(beforeLRP, afterLRP, traceId))
+			go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceChangedEvent(beforeLRP, afterLRP, traceId)))
 		})
 	}
 
@@ -288,8 +304,8 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			}
 
 			//lint:ignore SA1019 - still need to emit these events until the ActaulLRPGroup api is deleted
-			go h.actualHub.Emit(models.NewActualLRPRemovedEvent(suspectLRP.ToActualLRPGroup()))
-			go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceRemovedEvent(suspectLRP, traceId))
+			go h.actualHub.Emit(writeVersion.Versioned(models.NewActualLRPRemovedEvent(suspectLRP.ToActualLRPGroup())))
+			go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceRemovedEvent(suspectLRP, traceId)))
 		})
 	}
 
@@ -313,7 +329,9 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 				internalRoutes = append(internalRoutes, internalroutes.InternalRoute{Hostname: ir.Hostname})
 			}
 			lrpUpdate := rep.NewLRPUpdate(dereferencedLRPKey.InstanceKey.InstanceGuid, *dereferencedLRPKey.Key, internalRoutes, nil)
+			_, repSpan := trace.StartClientSpan(ctx, "rep.UpdateLRPInstance")
 			err = repClient.UpdateLRPInstance(logger, lrpUpdate)
+			trace.EndSpan(repSpan, err)
 			if err != nil {
 				logger.Error("updating-lrp-instance", err)
 			}
@@ -336,7 +354,9 @@ func (h *LRPConvergenceController) ConvergeLRPs(ctx context.Context) {
 			}
 
 			lrpUpdate := rep.NewLRPUpdate(dereferencedLRPKey.InstanceKey.InstanceGuid, *dereferencedLRPKey.Key, nil, lrpKey.DesiredMetricTags)
+			_, repSpan := trace.StartClientSpan(ctx, "rep.UpdateLRPInstance")
 			err = repClient.UpdateLRPInstance(logger, lrpUpdate)
+			trace.EndSpan(repSpan, err)
 			if err != nil {
 				logger.Error("updating-lrp-instance", err)
 			}
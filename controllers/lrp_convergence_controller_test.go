@@ -41,6 +41,7 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		retirer                   *fakes.FakeRetirer
 		fakeAuctioneerClient      *auctioneerfakes.FakeClient
 		fakeLRPStatMetronNotifier *mfakes.FakeLRPStatMetronNotifier
+		fakeCrashStormDetector    *fakes.FakeCrashStormDetector
 
 		keysToRetire         []*models.ActualLRPKey
 		keysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
@@ -77,6 +78,7 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		actualHub = &eventfakes.FakeHub{}
 		actualLRPInstanceHub = &eventfakes.FakeHub{}
 		retirer = &fakes.FakeRetirer{}
+		fakeCrashStormDetector = new(fakes.FakeCrashStormDetector)
 	})
 
 	JustBeforeEach(func() {
@@ -94,6 +96,7 @@ var _ = Describe("LRP Convergence Controllers", func() {
 			retirer,
 			2,
 			fakeLRPStatMetronNotifier,
+			fakeCrashStormDetector,
 		)
 		controller.ConvergeLRPs(context.WithValue(ctx, trace.RequestIdHeaderCtxKey, traceId))
 	})
@@ -104,6 +107,11 @@ var _ = Describe("LRP Convergence Controllers", func() {
 		Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 	})
 
+	It("closes the expired crash storm breakers", func() {
+		Expect(fakeCrashStormDetector.CloseExpiredBreakersCallCount()).To(Equal(1))
+		Expect(fakeCrashStormDetector.CloseExpiredBreakersArgsForCall(0)).To(Equal(traceId))
+	})
+
 	Describe("metrics", func() {
 		Context("when convergence occurs", func() {
 			BeforeEach(func() {
@@ -221,9 +229,10 @@ var _ = Describe("LRP Convergence Controllers", func() {
 
 	Context("when there are unstarted ActualLRPs", func() {
 		var (
-			key            *models.ActualLRPKey
-			schedulingInfo models.DesiredLRPSchedulingInfo
-			before, after  *models.ActualLRP
+			key                      *models.ActualLRPKey
+			schedulingInfo           models.DesiredLRPSchedulingInfo
+			lrpKeyWithSchedulingInfo *models.ActualLRPKeyWithSchedulingInfo
+			before, after            *models.ActualLRP
 		)
 
 		BeforeEach(func() {
@@ -234,7 +243,7 @@ var _ = Describe("LRP Convergence Controllers", func() {
 				Index:       0,
 				Domain:      lrp.Domain,
 			}
-			lrpKeyWithSchedulingInfo := &models.ActualLRPKeyWithSchedulingInfo{
+			lrpKeyWithSchedulingInfo = &models.ActualLRPKeyWithSchedulingInfo{
 				Key:            key,
 				SchedulingInfo: &schedulingInfo,
 			}
@@ -282,6 +291,39 @@ var _ = Describe("LRP Convergence Controllers", func() {
 			Expect(event).To(Equal(models.NewActualLRPInstanceRemovedEvent(before, traceId)))
 		})
 
+		Context("when a crash storm breaker pauses the restarts of the LRP", func() {
+			BeforeEach(func() {
+				fakeCrashStormDetector.RestartsPausedReturns(true)
+			})
+
+			It("does not check the key of an LRP that did not crash", func() {
+				Expect(fakeCrashStormDetector.RestartsPausedCallCount()).To(BeZero())
+			})
+
+			It("auctions off an LRP that did not crash", func() {
+				Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(Equal(1))
+			})
+
+			Context("and the LRP crashed", func() {
+				BeforeEach(func() {
+					lrpKeyWithSchedulingInfo.Crashed = true
+				})
+
+				It("checks the key of the LRP", func() {
+					Expect(fakeCrashStormDetector.RestartsPausedCallCount()).To(Equal(1))
+					Expect(fakeCrashStormDetector.RestartsPausedArgsForCall(0)).To(Equal(key))
+				})
+
+				It("does not transition the LRP to UNCLAIMED state", func() {
+					Consistently(fakeLRPDB.UnclaimActualLRPCallCount).Should(BeZero())
+				})
+
+				It("does not auction off the LRP", func() {
+					Expect(fakeAuctioneerClient.RequestLRPAuctionsCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("and the LRP isn't changed", func() {
 			BeforeEach(func() {
 				*before = *after
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate -o fakes/fake_task_desirer.go . TaskDesirer
+type TaskDesirer interface {
+	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGUID, domain string) error
+	CancelTask(ctx context.Context, logger lager.Logger, taskGUID string) error
+}
+
+type ScheduledTaskController struct {
+	clock           clock.Clock
+	scheduledTaskDB db.ScheduledTaskDB
+	taskDB          db.TaskDB
+	taskDesirer     TaskDesirer
+}
+
+func NewScheduledTaskController(
+	clock clock.Clock,
+	scheduledTaskDB db.ScheduledTaskDB,
+	taskDB db.TaskDB,
+	taskDesirer TaskDesirer,
+) *ScheduledTaskController {
+	return &ScheduledTaskController{
+		clock:           clock,
+		scheduledTaskDB: scheduledTaskDB,
+		taskDB:          taskDB,
+		taskDesirer:     taskDesirer,
+	}
+}
+
+func (c *ScheduledTaskController) ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error) {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.ScheduledTasks")
+	defer span.End()
+
+	return c.scheduledTaskDB.ScheduledTasks(ctx, logger.Session("scheduled-tasks"), domain)
+}
+
+func (c *ScheduledTaskController) DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error) {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.DesireScheduledTask")
+	defer span.End()
+
+	logger = logger.Session("desire-scheduled-task", lager.Data{"schedule_guid": schedule.ScheduleGuid})
+	return c.scheduledTaskDB.DesireScheduledTask(ctx, logger, schedule)
+}
+
+func (c *ScheduledTaskController) UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.UpdateScheduledTask")
+	defer span.End()
+
+	logger = logger.Session("update-scheduled-task", lager.Data{"schedule_guid": scheduleGuid})
+	return c.scheduledTaskDB.UpdateScheduledTask(ctx, logger, scheduleGuid, update)
+}
+
+func (c *ScheduledTaskController) SuspendScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error) {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.SuspendScheduledTask")
+	defer span.End()
+
+	logger = logger.Session("suspend-scheduled-task", lager.Data{"schedule_guid": scheduleGuid, "suspended": suspended})
+	return c.scheduledTaskDB.SetScheduledTaskSuspended(ctx, logger, scheduleGuid, suspended)
+}
+
+func (c *ScheduledTaskController) DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.DeleteScheduledTask")
+	defer span.End()
+
+	logger = logger.Session("delete-scheduled-task", lager.Data{"schedule_guid": scheduleGuid})
+	return c.scheduledTaskDB.DeleteScheduledTask(ctx, logger, scheduleGuid)
+}
+
+// ScheduleTasks desires a task for every schedule that is due. Runs missed
+// while no BBS was scheduling are collapsed into a single run, and the next
+// run is computed from the current time.
+func (c *ScheduledTaskController) ScheduleTasks(ctx context.Context, logger lager.Logger) error {
+	ctx, span := trace.StartSpan(ctx, "ScheduledTaskController.ScheduleTasks")
+	defer span.End()
+
+	logger = logger.Session("schedule-tasks")
+
+	now := c.clock.Now()
+	schedules, err := c.scheduledTaskDB.DueScheduledTasks(ctx, logger, now)
+	if err != nil {
+		logger.Error("failed-fetching-due-scheduled-tasks", err)
+		return err
+	}
+
+	for _, schedule := range schedules {
+		c.scheduleTask(ctx, logger, schedule)
+	}
+
+	return nil
+}
+
+func (c *ScheduledTaskController) scheduleTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) {
+	scheduledAt := schedule.NextRunAt
+	run := &models.ScheduledTaskRun{
+		TaskGuid:    schedule.TaskGuidForRun(scheduledAt),
+		ScheduledAt: scheduledAt,
+	}
+	logger = logger.Session("schedule-task", lager.Data{"schedule_guid": schedule.ScheduleGuid, "task_guid": run.TaskGuid})
+
+	activeRuns := c.activeRuns(ctx, logger, schedule)
+	switch {
+	case len(activeRuns) == 0:
+	case schedule.ConcurrencyPolicy == models.ScheduledTask_Forbid:
+		logger.Info("skipping-run-while-previous-is-active", lager.Data{"active_task_guids": activeRuns})
+		run.Skipped = true
+	case schedule.ConcurrencyPolicy == models.ScheduledTask_Replace:
+		for _, taskGuid := range activeRuns {
+			err := c.taskDesirer.CancelTask(ctx, logger, taskGuid)
+			if err != nil {
+				logger.Error("failed-cancelling-previous-run", err, lager.Data{"previous_task_guid": taskGuid})
+			}
+		}
+	}
+
+	if !run.Skipped {
+		err := c.taskDesirer.DesireTask(ctx, logger, schedule.TaskDefinition, run.TaskGuid, schedule.Domain)
+		if err == models.ErrResourceExists {
+			// another BBS desired this run before recording it
+			logger.Info("run-already-desired")
+		} else if err != nil {
+			logger.Error("failed-desiring-task", err)
+			return
+		}
+	}
+
+	nextRunAt := int64(0)
+	next, err := schedule.NextRunAfter(c.clock.Now())
+	if err != nil {
+		logger.Error("failed-computing-next-run", err)
+	} else if !next.IsZero() {
+		nextRunAt = next.UnixNano()
+	}
+
+	_, err = c.scheduledTaskDB.RecordScheduledTaskRun(ctx, logger, schedule.ScheduleGuid, scheduledAt, run, nextRunAt)
+	if err != nil {
+		logger.Error("failed-recording-run", err)
+	}
+}
+
+// activeRuns returns the guids of the recent runs whose tasks have not yet
+// completed.
+func (c *ScheduledTaskController) activeRuns(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) []string {
+	activeRuns := []string{}
+	for _, run := range schedule.RecentRuns {
+		if run.Skipped {
+			continue
+		}
+
+		task, err := c.taskDB.TaskByGuid(ctx, logger, run.TaskGuid)
+		if err == models.ErrResourceNotFound {
+			continue
+		} else if err != nil {
+			logger.Error("failed-fetching-previous-run", err, lager.Data{"previous_task_guid": run.TaskGuid})
+			continue
+		}
+
+		if task.State != models.Task_Completed && task.State != models.Task_Resolving {
+			activeRuns = append(activeRuns, run.TaskGuid)
+		}
+	}
+	return activeRuns
+}
@@ -0,0 +1,156 @@
+package controllers_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/controllers/fakes"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTask Controller", func() {
+	var (
+		fakeClock           *fakeclock.FakeClock
+		fakeScheduledTaskDB *dbfakes.FakeScheduledTaskDB
+		fakeTaskDB          *dbfakes.FakeTaskDB
+		taskDesirer         *fakes.FakeTaskDesirer
+
+		schedule   *models.ScheduledTask
+		dueAt      time.Time
+		controller *controllers.ScheduledTaskController
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Date(2026, 3, 1, 12, 0, 30, 0, time.UTC))
+		fakeScheduledTaskDB = new(dbfakes.FakeScheduledTaskDB)
+		fakeTaskDB = new(dbfakes.FakeTaskDB)
+		taskDesirer = new(fakes.FakeTaskDesirer)
+
+		dueAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		schedule = &models.ScheduledTask{
+			ScheduleGuid:   "nightly",
+			Domain:         "some-domain",
+			CronExpression: "*/5 * * * *",
+			TaskDefinition: model_helpers.NewValidTaskDefinition(),
+			NextRunAt:      dueAt.UnixNano(),
+		}
+		fakeScheduledTaskDB.DueScheduledTasksReturns([]*models.ScheduledTask{schedule}, nil)
+		fakeTaskDB.TaskByGuidReturns(nil, models.ErrResourceNotFound)
+
+		controller = controllers.NewScheduledTaskController(fakeClock, fakeScheduledTaskDB, fakeTaskDB, taskDesirer)
+	})
+
+	Describe("ScheduleTasks", func() {
+		It("desires a task for the due run and records it", func() {
+			Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+
+			_, _, now := fakeScheduledTaskDB.DueScheduledTasksArgsForCall(0)
+			Expect(now).To(Equal(fakeClock.Now()))
+
+			Expect(taskDesirer.DesireTaskCallCount()).To(Equal(1))
+			_, _, def, taskGuid, domain := taskDesirer.DesireTaskArgsForCall(0)
+			Expect(def).To(Equal(schedule.TaskDefinition))
+			Expect(taskGuid).To(Equal("nightly-1772366400"))
+			Expect(domain).To(Equal("some-domain"))
+
+			Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(1))
+			_, _, scheduleGuid, expectedNextRunAt, run, nextRunAt := fakeScheduledTaskDB.RecordScheduledTaskRunArgsForCall(0)
+			Expect(scheduleGuid).To(Equal("nightly"))
+			Expect(expectedNextRunAt).To(Equal(dueAt.UnixNano()))
+			Expect(run).To(Equal(&models.ScheduledTaskRun{TaskGuid: "nightly-1772366400", ScheduledAt: dueAt.UnixNano()}))
+			Expect(nextRunAt).To(Equal(time.Date(2026, 3, 1, 12, 5, 0, 0, time.UTC).UnixNano()))
+		})
+
+		It("records the run when another BBS already desired its task", func() {
+			taskDesirer.DesireTaskReturns(models.ErrResourceExists)
+
+			Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+			Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(1))
+		})
+
+		It("does not record the run when the task cannot be desired", func() {
+			taskDesirer.DesireTaskReturns(errors.New("boom"))
+
+			Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+			Expect(fakeScheduledTaskDB.RecordScheduledTaskRunCallCount()).To(Equal(0))
+		})
+
+		It("returns the error when the due schedules cannot be fetched", func() {
+			fakeScheduledTaskDB.DueScheduledTasksReturns(nil, errors.New("boom"))
+
+			Expect(controller.ScheduleTasks(ctx, logger)).To(MatchError("boom"))
+			Expect(taskDesirer.DesireTaskCallCount()).To(Equal(0))
+		})
+
+		Context("when a previous run is still active", func() {
+			BeforeEach(func() {
+				schedule.RecentRuns = []*models.ScheduledTaskRun{
+					{TaskGuid: "nightly-previous", ScheduledAt: dueAt.Add(-5 * time.Minute).UnixNano()},
+				}
+				fakeTaskDB.TaskByGuidReturns(&models.Task{TaskGuid: "nightly-previous", State: models.Task_Running}, nil)
+			})
+
+			It("runs the tasks concurrently by default", func() {
+				Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+				Expect(taskDesirer.CancelTaskCallCount()).To(Equal(0))
+				Expect(taskDesirer.DesireTaskCallCount()).To(Equal(1))
+			})
+
+			Context("and concurrent runs are forbidden", func() {
+				BeforeEach(func() {
+					schedule.ConcurrencyPolicy = models.ScheduledTask_Forbid
+				})
+
+				It("skips the run", func() {
+					Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+					Expect(taskDesirer.DesireTaskCallCount()).To(Equal(0))
+
+					_, _, _, _, run, _ := fakeScheduledTaskDB.RecordScheduledTaskRunArgsForCall(0)
+					Expect(run.Skipped).To(BeTrue())
+				})
+
+				It("runs once the previous run has completed", func() {
+					fakeTaskDB.TaskByGuidReturns(&models.Task{TaskGuid: "nightly-previous", State: models.Task_Completed}, nil)
+
+					Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+					Expect(taskDesirer.DesireTaskCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("and concurrent runs are replaced", func() {
+				BeforeEach(func() {
+					schedule.ConcurrencyPolicy = models.ScheduledTask_Replace
+				})
+
+				It("cancels the previous run before desiring the new one", func() {
+					Expect(controller.ScheduleTasks(ctx, logger)).To(Succeed())
+
+					Expect(taskDesirer.CancelTaskCallCount()).To(Equal(1))
+					_, _, taskGuid := taskDesirer.CancelTaskArgsForCall(0)
+					Expect(taskGuid).To(Equal("nightly-previous"))
+					Expect(taskDesirer.DesireTaskCallCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+
+	Describe("SuspendScheduledTask", func() {
+		It("suspends the schedule", func() {
+			fakeScheduledTaskDB.SetScheduledTaskSuspendedReturns(schedule, nil)
+
+			suspended, err := controller.SuspendScheduledTask(ctx, logger, "nightly", true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(suspended).To(Equal(schedule))
+
+			_, _, scheduleGuid, value := fakeScheduledTaskDB.SetScheduledTaskSuspendedArgsForCall(0)
+			Expect(scheduleGuid).To(Equal("nightly"))
+			Expect(value).To(BeTrue())
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/admission"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/metrics"
@@ -18,6 +19,7 @@ import (
 
 type TaskController struct {
 	db                     db.TaskDB
+	admitter               admission.Admitter
 	taskCompletionClient   taskworkpool.TaskCompletionClient
 	auctioneerClient       auctioneer.Client
 	serviceClient          serviceclient.ServiceClient
@@ -29,6 +31,7 @@ type TaskController struct {
 
 func NewTaskController(
 	db db.TaskDB,
+	admitter admission.Admitter,
 	taskCompletionClient taskworkpool.TaskCompletionClient,
 	auctioneerClient auctioneer.Client,
 	serviceClient serviceclient.ServiceClient,
@@ -39,6 +42,7 @@ func NewTaskController(
 ) *TaskController {
 	return &TaskController{
 		db:                     db,
+		admitter:               admitter,
 		taskCompletionClient:   taskCompletionClient,
 		auctioneerClient:       auctioneerClient,
 		serviceClient:          serviceClient,
@@ -49,35 +53,66 @@ func NewTaskController(
 	}
 }
 
-func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, domain, cellID string) ([]*models.Task, error) {
+func (c *TaskController) ListTasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, db.ListMetadata, error) {
+	ctx, span := trace.StartSpan(ctx, "TaskController.ListTasks")
+	defer span.End()
+
+	logger = logger.Session("list-tasks")
+
+	return c.db.ListTasks(ctx, logger, filter)
+}
+
+func (c *TaskController) Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	ctx, span := trace.StartSpan(ctx, "TaskController.Tasks")
+	defer span.End()
+
 	logger = logger.Session("tasks")
 
-	filter := models.TaskFilter{Domain: domain, CellID: cellID}
 	return c.db.Tasks(ctx, logger, filter)
 }
 
 func (c *TaskController) TaskByGuid(ctx context.Context, logger lager.Logger, taskGUID string) (*models.Task, error) {
+	ctx, span := trace.StartSpan(ctx, "TaskController.TaskByGuid")
+	defer span.End()
+
 	logger = logger.Session("task-by-guid")
 
 	return c.db.TaskByGuid(ctx, logger, taskGUID)
 }
 
 func (c *TaskController) DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGUID, domain string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.DesireTask")
+	defer span.End()
+
 	var err error
 	var task *models.Task
 	logger = logger.Session("desire-task")
 
 	logger = logger.WithData(lager.Data{"task_guid": taskGUID})
 
+	taskDefinition, err = c.admitter.AdmitTask(ctx, logger, taskGUID, domain, taskDefinition)
+	if err != nil {
+		return err
+	}
+
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	task, err = c.db.DesireTask(ctx, logger, taskDefinition, taskGUID, domain)
 	if err != nil {
 		return err
 	}
-	go c.taskHub.Emit(models.NewTaskCreatedEvent(task))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskCreatedEvent(task)))
+
+	if task.State == models.Task_Waiting {
+		// convergence auctions the task once its parents have completed
+		logger.Debug("task-waiting-for-parents", lager.Data{"depends_on": taskDefinition.DependsOn})
+		return nil
+	}
 
 	logger.Debug("start-task-auction-request")
 	taskStartRequest := auctioneer.NewTaskStartRequestFromModel(taskGUID, domain, taskDefinition)
+	_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestTaskAuctions")
 	err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.TaskStartRequest{&taskStartRequest})
+	trace.EndSpan(auctioneerSpan, err)
 	if err != nil {
 		logger.Error("failed-requesting-task-auction", err)
 		// The creation succeeded, the auction request error can be dropped
@@ -89,23 +124,31 @@ func (c *TaskController) DesireTask(ctx context.Context, logger lager.Logger, ta
 }
 
 func (c *TaskController) StartTask(ctx context.Context, logger lager.Logger, taskGUID, cellID string) (shouldStart bool, err error) {
+	ctx, span := trace.StartSpan(ctx, "TaskController.StartTask")
+	defer span.End()
+
 	logger = logger.Session("start-task", lager.Data{"task_guid": taskGUID, "cell_id": cellID})
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, shouldStart, err := c.db.StartTask(ctx, logger, taskGUID, cellID)
 	if err == nil && shouldStart {
-		go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+		go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 		c.taskStatMetronNotifier.RecordTaskStarted(cellID)
 	}
 	return shouldStart, err
 }
 
 func (c *TaskController) CancelTask(ctx context.Context, logger lager.Logger, taskGUID string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.CancelTask")
+	defer span.End()
+
 	logger = logger.Session("cancel-task")
 
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, cellID, err := c.db.CancelTask(ctx, logger, taskGUID)
 	if err != nil {
 		return err
 	}
-	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
@@ -131,7 +174,9 @@ func (c *TaskController) CancelTask(ctx context.Context, logger lager.Logger, ta
 		return err
 	}
 	logger.Info("start-rep-cancel-task", lager.Data{"task_guid": taskGUID})
+	_, repSpan := trace.StartClientSpan(ctx, "rep.CancelTask")
 	err = repClient.CancelTask(logger, taskGUID)
+	trace.EndSpan(repSpan, err)
 	if err != nil {
 		logger.Error("failed-rep-cancel-task", err)
 		// don't return an error, the rep will converge later
@@ -142,14 +187,18 @@ func (c *TaskController) CancelTask(ctx context.Context, logger lager.Logger, ta
 }
 
 func (c *TaskController) FailTask(ctx context.Context, logger lager.Logger, taskGUID, failureReason string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.FailTask")
+	defer span.End()
+
 	var err error
 
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, err := c.db.FailTask(ctx, logger, taskGUID, failureReason)
 	if err != nil {
 		return err
 	}
 
-	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
@@ -160,6 +209,9 @@ func (c *TaskController) FailTask(ctx context.Context, logger lager.Logger, task
 }
 
 func (c *TaskController) RejectTask(ctx context.Context, logger lager.Logger, taskGUID, rejectionReason string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.RejectTask")
+	defer span.End()
+
 	logger = logger.Session("reject-task", lager.Data{"guid": taskGUID})
 	logger.Info("start")
 	defer logger.Info("complete")
@@ -171,6 +223,7 @@ func (c *TaskController) RejectTask(ctx context.Context, logger lager.Logger, ta
 	}
 
 	logger.Info("reject-task", lager.Data{"rejection-reason": rejectionReason})
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, rejectTaskErr := c.db.RejectTask(ctx, logger, taskGUID, rejectionReason)
 	if rejectTaskErr != nil {
 		logger.Error("failed-to-reject-task", rejectTaskErr)
@@ -180,7 +233,7 @@ func (c *TaskController) RejectTask(ctx context.Context, logger lager.Logger, ta
 		return c.FailTask(ctx, logger, taskGUID, rejectionReason)
 	}
 
-	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
 	return rejectTaskErr
 }
@@ -194,14 +247,18 @@ func (c *TaskController) CompleteTask(
 	failureReason,
 	result string,
 ) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.CompleteTask")
+	defer span.End()
+
 	var err error
 	logger = logger.Session("complete-task")
 
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, err := c.db.CompleteTask(ctx, logger, taskGUID, cellID, failed, failureReason, result)
 	if err != nil {
 		return err
 	}
-	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
 	if failed {
 		c.taskStatMetronNotifier.RecordTaskFailed(cellID)
@@ -209,6 +266,12 @@ func (c *TaskController) CompleteTask(
 		c.taskStatMetronNotifier.RecordTaskSucceeded(cellID)
 	}
 
+	// a task that is retried waits for its next attempt instead of completing
+	if after.State == models.Task_Waiting {
+		logger.Info("task-waiting-to-retry", lager.Data{"task_guid": taskGUID, "retry_at": after.RetryAt})
+		return nil
+	}
+
 	if after.CompletionCallbackUrl != "" {
 		logger.Info("task-client-completing-task")
 		go c.taskCompletionClient.Submit(c.db, c.taskHub, after)
@@ -218,25 +281,33 @@ func (c *TaskController) CompleteTask(
 }
 
 func (c *TaskController) ResolvingTask(ctx context.Context, logger lager.Logger, taskGUID string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.ResolvingTask")
+	defer span.End()
+
 	logger = logger.Session("resolving-task")
 
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	before, after, err := c.db.ResolvingTask(ctx, logger, taskGUID)
 	if err != nil {
 		return err
 	}
-	go c.taskHub.Emit(models.NewTaskChangedEvent(before, after))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
 	return nil
 }
 
 func (c *TaskController) DeleteTask(ctx context.Context, logger lager.Logger, taskGUID string) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.DeleteTask")
+	defer span.End()
+
 	logger = logger.Session("delete-task")
 
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	task, err := c.db.DeleteTask(ctx, logger, taskGUID)
 	if err != nil {
 		return err
 	}
-	go c.taskHub.Emit(models.NewTaskRemovedEvent(task))
+	go c.taskHub.Emit(writeVersion.Versioned(models.NewTaskRemovedEvent(task)))
 
 	return nil
 }
@@ -248,6 +319,9 @@ func (c *TaskController) ConvergeTasks(
 	expirePendingTaskDuration,
 	expireCompletedTaskDuration time.Duration,
 ) error {
+	ctx, span := trace.StartSpan(ctx, "TaskController.ConvergeTasks")
+	defer span.End()
+
 	var err error
 	logger = logger.Session("converge-tasks")
 
@@ -263,6 +337,7 @@ func (c *TaskController) ConvergeTasks(
 	logger.Debug("succeeded-listing-cells")
 
 	convergenceStartTime := time.Now()
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	taskConvergenceResult := c.db.ConvergeTasks(
 		ctx,
 		logger,
@@ -285,12 +360,14 @@ func (c *TaskController) ConvergeTasks(
 
 	logger.Debug("emitting-events-from-convergence", lager.Data{"num_tasks_to_complete": len(taskConvergenceResult.TasksToComplete)})
 	for _, event := range taskConvergenceResult.Events {
-		go c.taskHub.Emit(event)
+		go c.taskHub.Emit(writeVersion.Versioned(event))
 	}
 
 	if len(taskConvergenceResult.TasksToAuction) > 0 {
 		logger.Debug("requesting-task-auctions", lager.Data{"num_tasks_to_auction": len(taskConvergenceResult.TasksToAuction)})
+		_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestTaskAuctions")
 		err = c.auctioneerClient.RequestTaskAuctions(logger, trace.RequestIdFromContext(ctx), taskConvergenceResult.TasksToAuction)
+		trace.EndSpan(auctioneerSpan, err)
 		if err != nil {
 			taskGuids := make([]string, len(taskConvergenceResult.TasksToAuction))
 			for i, task := range taskConvergenceResult.TasksToAuction {
@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/admission/admissionfakes"
 	"code.cloudfoundry.org/bbs/controllers"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
@@ -16,6 +17,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool/taskworkpoolfakes"
 	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"code.cloudfoundry.org/rep"
 	. "github.com/onsi/ginkgo/v2"
@@ -27,6 +29,7 @@ var _ = Describe("Task Controller", func() {
 	var (
 		logger                   *lagertest.TestLogger
 		fakeTaskDB               *dbfakes.FakeTaskDB
+		fakeAdmitter             *admissionfakes.FakeAdmitter
 		fakeAuctioneerClient     *auctioneerfakes.FakeClient
 		fakeTaskCompletionClient *taskworkpoolfakes.FakeTaskCompletionClient
 		taskHub                  *eventfakes.FakeHub
@@ -39,6 +42,10 @@ var _ = Describe("Task Controller", func() {
 
 	BeforeEach(func() {
 		fakeTaskDB = new(dbfakes.FakeTaskDB)
+		fakeAdmitter = new(admissionfakes.FakeAdmitter)
+		fakeAdmitter.AdmitTaskStub = func(_ context.Context, _ lager.Logger, _, _ string, def *models.TaskDefinition) (*models.TaskDefinition, error) {
+			return def, nil
+		}
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		fakeTaskCompletionClient = new(taskworkpoolfakes.FakeTaskCompletionClient)
 		fakeTaskStatNotifier = &fakes.FakeTaskStatMetronNotifier{}
@@ -53,6 +60,7 @@ var _ = Describe("Task Controller", func() {
 	JustBeforeEach(func() {
 		controller = controllers.NewTaskController(
 			fakeTaskDB,
+			fakeAdmitter,
 			fakeTaskCompletionClient,
 			fakeAuctioneerClient,
 			fakeServiceClient,
@@ -79,7 +87,7 @@ var _ = Describe("Task Controller", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualTasks, err = controller.Tasks(ctx, logger, domain, cellId)
+			actualTasks, err = controller.Tasks(ctx, logger, models.TaskFilter{Domain: domain, CellID: cellId})
 		})
 
 		Context("when reading tasks from DB succeeds", func() {
@@ -247,6 +255,24 @@ var _ = Describe("Task Controller", func() {
 				Expect(create.Key()).To(Equal(taskGuid))
 			})
 
+			Context("when the write is given a resource version", func() {
+				BeforeEach(func() {
+					fakeTaskDB.DesireTaskStub = func(ctx context.Context, _ lager.Logger, _ *models.TaskDefinition, taskGuid, _ string) (*models.Task, error) {
+						writeVersion, ok := db.WriteVersionFromContext(ctx)
+						Expect(ok).To(BeTrue())
+						writeVersion.Record(42)
+						return &models.Task{TaskGuid: taskGuid}, nil
+					}
+				})
+
+				It("emits the event with the resource version of the write", func() {
+					Eventually(taskHub.EmitCallCount).Should(Equal(1))
+					create, ok := taskHub.EmitArgsForCall(0).(*models.TaskCreatedEvent)
+					Expect(ok).To(BeTrue())
+					Expect(create.ResourceVersion).To(BeEquivalentTo(42))
+				})
+			})
+
 			Context("when requesting a task auction succeeds", func() {
 				BeforeEach(func() {
 					fakeAuctioneerClient.RequestTaskAuctionsReturns(nil)
@@ -282,6 +308,22 @@ var _ = Describe("Task Controller", func() {
 			})
 		})
 
+		Context("when the task is waiting for its parents", func() {
+			BeforeEach(func() {
+				taskDef.DependsOn = []string{"parent-guid"}
+				fakeTaskDB.DesireTaskReturns(&models.Task{TaskGuid: taskGuid, State: models.Task_Waiting, TaskDefinition: taskDef}, nil)
+			})
+
+			It("emits a TaskCreateEvent to the hub", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(taskHub.EmitCallCount).Should(Equal(1))
+			})
+
+			It("does not request an auction", func() {
+				Consistently(fakeAuctioneerClient.RequestTaskAuctionsCallCount).Should(Equal(0))
+			})
+		})
+
 		Context("when desiring the task fails", func() {
 			BeforeEach(func() {
 				fakeTaskDB.DesireTaskReturns(nil, errors.New("kaboom"))
@@ -295,6 +337,59 @@ var _ = Describe("Task Controller", func() {
 				Consistently(taskHub.EmitCallCount).Should(Equal(0))
 			})
 		})
+
+		Context("when admission modifies the task definition", func() {
+			var admittedDef *models.TaskDefinition
+
+			BeforeEach(func() {
+				admittedDef = model_helpers.NewValidTaskDefinition()
+				admittedDef.LogRateLimit = &models.LogRateLimit{BytesPerSecond: 1024}
+				fakeAdmitter.AdmitTaskReturns(admittedDef, nil)
+				fakeTaskDB.DesireTaskReturns(&models.Task{TaskGuid: taskGuid}, nil)
+			})
+
+			It("admits the task definition", func() {
+				Expect(fakeAdmitter.AdmitTaskCallCount()).To(Equal(1))
+				_, _, actualTaskGuid, actualDomain, actualTaskDef := fakeAdmitter.AdmitTaskArgsForCall(0)
+				Expect(actualTaskGuid).To(Equal(taskGuid))
+				Expect(actualDomain).To(Equal(domain))
+				Expect(actualTaskDef).To(Equal(taskDef))
+			})
+
+			It("desires the admitted task definition", func() {
+				Expect(err).NotTo(HaveOccurred())
+				_, _, actualTaskDef, _, _ := fakeTaskDB.DesireTaskArgsForCall(0)
+				Expect(actualTaskDef).To(Equal(admittedDef))
+			})
+		})
+
+		Context("when admission denies the task", func() {
+			BeforeEach(func() {
+				fakeAdmitter.AdmitTaskReturns(nil, models.NewAdmissionDeniedError("policy", "privileged tasks are not allowed"))
+			})
+
+			It("responds with the error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_AdmissionDenied))
+			})
+
+			It("does not desire the task", func() {
+				Expect(fakeTaskDB.DesireTaskCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the task exceeds the quota of its domain", func() {
+			BeforeEach(func() {
+				fakeTaskDB.DesireTaskReturns(nil, models.NewQuotaExceededError(domain, "running tasks", 1, 1, 1))
+			})
+
+			It("responds with the error", func() {
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_QuotaExceeded))
+			})
+
+			It("does not request an auction for the task", func() {
+				Expect(fakeAuctioneerClient.RequestTaskAuctionsCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Describe("StartTask", func() {
@@ -316,7 +411,8 @@ var _ = Describe("Task Controller", func() {
 			It("calls StartTask", func() {
 				Expect(fakeTaskDB.StartTaskCallCount()).To(Equal(1))
 				taskContext, taskLogger, taskGuid, cellId := fakeTaskDB.StartTaskArgsForCall(0)
-				Expect(taskContext).To(Equal(ctx))
+				_, recordsWriteVersion := db.WriteVersionFromContext(taskContext)
+				Expect(recordsWriteVersion).To(BeTrue())
 				Expect(taskLogger.SessionName()).To(ContainSubstring("start-task"))
 				Expect(taskGuid).To(Equal(taskGuid))
 				Expect(cellId).To(Equal(cellId))
@@ -420,7 +516,9 @@ var _ = Describe("Task Controller", func() {
 				It("returns no error", func() {
 					Expect(fakeTaskDB.CancelTaskCallCount()).To(Equal(1))
 					taskContext, taskLogger, taskGuid := fakeTaskDB.CancelTaskArgsForCall(0)
-					Expect(taskContext).To(Equal(ctxWithTrace))
+					Expect(trace.RequestIdFromContext(taskContext)).To(Equal("some-trace-id"))
+					_, recordsWriteVersion := db.WriteVersionFromContext(taskContext)
+					Expect(recordsWriteVersion).To(BeTrue())
 					Expect(taskLogger.SessionName()).To(ContainSubstring("cancel-task"))
 					Expect(taskGuid).To(Equal("task-guid"))
 					Expect(err).NotTo(HaveOccurred())
@@ -762,6 +860,23 @@ var _ = Describe("Task Controller", func() {
 			err = controller.CompleteTask(ctx, logger, taskGuid, cellId, failed, failureReason, result)
 		})
 
+		Context("when the task is retried", func() {
+			BeforeEach(func() {
+				after.CompletionCallbackUrl = "bogus"
+				after.State = models.Task_Waiting
+				after.RetryAt = 1
+			})
+
+			It("records the failure", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeTaskStatNotifier.RecordTaskFailedCallCount()).To(Equal(1))
+			})
+
+			It("does not complete the callback", func() {
+				Consistently(fakeTaskCompletionClient.SubmitCallCount).Should(Equal(0))
+			})
+		})
+
 		Context("when the task is not marked failed", func() {
 			BeforeEach(func() {
 				failed = false
@@ -985,7 +1100,8 @@ var _ = Describe("Task Controller", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(fakeTaskDB.ConvergeTasksCallCount()).To(Equal(1))
 				taskContext, taskLogger, actualCellSet, actualKickDuration, actualPendingDuration, actualCompletedDuration := fakeTaskDB.ConvergeTasksArgsForCall(0)
-				Expect(taskContext).To(Equal(ctx))
+				_, recordsWriteVersion := db.WriteVersionFromContext(taskContext)
+				Expect(recordsWriteVersion).To(BeTrue())
 				Expect(taskLogger.SessionName()).To(ContainSubstring("converge-tasks"))
 				Expect(actualCellSet).To(BeEquivalentTo(cellSet))
 				Expect(actualKickDuration).To(BeEquivalentTo(kickTaskDuration))
@@ -14,6 +14,10 @@ import (
 	"code.cloudfoundry.org/clock"
 )
 
+// DEFAULT_ACTUAL_LRP_HISTORY_LIMIT is how many records of each ActualLRP index
+// are kept when no limit is configured.
+const DEFAULT_ACTUAL_LRP_HISTORY_LIMIT = 20
+
 //go:generate counterfeiter -generate
 
 //counterfeiter:generate -o fake_controllers/fake_lrp_convergence_controller.go . LrpConvergenceController
@@ -26,17 +30,30 @@ type TaskController interface {
 	ConvergeTasks(ctx context.Context, logger lager.Logger, kickTaskDuration, expirePendingTaskDuration, expireCompletedTaskDuration time.Duration) error
 }
 
+//counterfeiter:generate -o fake_controllers/fake_actual_lrp_history_pruner.go . ActualLRPHistoryPruner
+type ActualLRPHistoryPruner interface {
+	PruneActualLRPHistory(ctx context.Context, logger lager.Logger, limit int) (int64, error)
+}
+
+//counterfeiter:generate -o fake_controllers/fake_cell_drain_controller.go . CellDrainController
+type CellDrainController interface {
+	ConvergeCellDrains(ctx context.Context, logger lager.Logger) error
+}
+
 type Converger struct {
 	id                          string
 	serviceClient               serviceclient.ServiceClient
 	lrpConvergenceController    LrpConvergenceController
 	taskController              TaskController
+	actualLRPHistoryPruner      ActualLRPHistoryPruner
+	cellDrainController         CellDrainController
 	logger                      lager.Logger
 	clock                       clock.Clock
 	convergeRepeatInterval      time.Duration
 	kickTaskDuration            time.Duration
 	expirePendingTaskDuration   time.Duration
 	expireCompletedTaskDuration time.Duration
+	actualLRPHistoryLimit       int
 	closeOnce                   *sync.Once
 }
 
@@ -45,11 +62,14 @@ func New(
 	clock clock.Clock,
 	lrpConvergenceController LrpConvergenceController,
 	taskController TaskController,
+	actualLRPHistoryPruner ActualLRPHistoryPruner,
+	cellDrainController CellDrainController,
 	serviceClient serviceclient.ServiceClient,
 	convergeRepeatInterval,
 	kickTaskDuration,
 	expirePendingTaskDuration,
 	expireCompletedTaskDuration time.Duration,
+	actualLRPHistoryLimit int,
 ) *Converger {
 
 	uuid, err := uuid.NewV4()
@@ -64,10 +84,13 @@ func New(
 		serviceClient:               serviceClient,
 		lrpConvergenceController:    lrpConvergenceController,
 		taskController:              taskController,
+		actualLRPHistoryPruner:      actualLRPHistoryPruner,
+		cellDrainController:         cellDrainController,
 		convergeRepeatInterval:      convergeRepeatInterval,
 		kickTaskDuration:            kickTaskDuration,
 		expirePendingTaskDuration:   expirePendingTaskDuration,
 		expireCompletedTaskDuration: expireCompletedTaskDuration,
+		actualLRPHistoryLimit:       actualLRPHistoryLimit,
 		closeOnce:                   &sync.Once{},
 	}
 }
@@ -150,6 +173,18 @@ func (c *Converger) converge(convergeChan chan struct{}) {
 
 		c.lrpConvergenceController.ConvergeLRPs(context.Background())
 
+		err := c.cellDrainController.ConvergeCellDrains(context.Background(), c.logger)
+		if err != nil {
+			logger.Error("failed-to-converge-cell-drains", err)
+		}
+
+		pruned, err := c.actualLRPHistoryPruner.PruneActualLRPHistory(context.Background(), c.logger, c.actualLRPHistoryLimit)
+		if err != nil {
+			logger.Error("failed-to-prune-actual-lrp-history", err)
+		} else if pruned > 0 {
+			logger.Info("pruned-actual-lrp-history", lager.Data{"count": pruned})
+		}
+
 		convergeChan <- struct{}{}
 	}()
 }
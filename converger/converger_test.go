@@ -14,6 +14,7 @@ import (
 	"code.cloudfoundry.org/clock/fakeclock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 
 	"code.cloudfoundry.org/bbs/converger"
 	"code.cloudfoundry.org/bbs/converger/fake_controllers"
@@ -25,6 +26,8 @@ var _ = Describe("ConvergerProcess", func() {
 	var (
 		fakeLrpConvergenceController *fake_controllers.FakeLrpConvergenceController
 		fakeTaskController           *fake_controllers.FakeTaskController
+		fakeActualLRPHistoryPruner   *fake_controllers.FakeActualLRPHistoryPruner
+		fakeCellDrainController      *fake_controllers.FakeCellDrainController
 		fakeBBSServiceClient         *serviceclientfakes.FakeServiceClient
 		logger                       *lagertest.TestLogger
 		fakeClock                    *fakeclock.FakeClock
@@ -32,6 +35,7 @@ var _ = Describe("ConvergerProcess", func() {
 		kickTaskDuration             time.Duration
 		expirePendingTaskDuration    time.Duration
 		expireCompletedTaskDuration  time.Duration
+		actualLRPHistoryLimit        int
 
 		process ifrit.Process
 
@@ -42,6 +46,8 @@ var _ = Describe("ConvergerProcess", func() {
 	BeforeEach(func() {
 		fakeLrpConvergenceController = new(fake_controllers.FakeLrpConvergenceController)
 		fakeTaskController = new(fake_controllers.FakeTaskController)
+		fakeActualLRPHistoryPruner = new(fake_controllers.FakeActualLRPHistoryPruner)
+		fakeCellDrainController = new(fake_controllers.FakeCellDrainController)
 		fakeBBSServiceClient = new(serviceclientfakes.FakeServiceClient)
 		logger = lagertest.NewTestLogger("test")
 		fakeClock = fakeclock.NewFakeClock(time.Now())
@@ -51,6 +57,7 @@ var _ = Describe("ConvergerProcess", func() {
 		kickTaskDuration = 10 * time.Millisecond
 		expirePendingTaskDuration = 30 * time.Second
 		expireCompletedTaskDuration = 60 * time.Minute
+		actualLRPHistoryLimit = 20
 
 		cellEvents := make(chan models.CellEvent, 100)
 		errs := make(chan error, 100)
@@ -68,11 +75,14 @@ var _ = Describe("ConvergerProcess", func() {
 				fakeClock,
 				fakeLrpConvergenceController,
 				fakeTaskController,
+				fakeActualLRPHistoryPruner,
+				fakeCellDrainController,
 				fakeBBSServiceClient,
 				convergeRepeatInterval,
 				kickTaskDuration,
 				expirePendingTaskDuration,
 				expireCompletedTaskDuration,
+				actualLRPHistoryLimit,
 			),
 		)
 	})
@@ -104,6 +114,53 @@ var _ = Describe("ConvergerProcess", func() {
 			Expect(actualExpirePendingTaskDuration).To(Equal(expirePendingTaskDuration))
 			Expect(actualExpireCompletedTaskDuration).To(Equal(expireCompletedTaskDuration))
 		})
+
+		It("prunes the actual LRP history to its limit after converging LRPs on every pass", func() {
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeActualLRPHistoryPruner.PruneActualLRPHistoryCallCount).Should(Equal(1))
+			Expect(fakeLrpConvergenceController.ConvergeLRPsCallCount()).To(Equal(1))
+
+			_, _, limit := fakeActualLRPHistoryPruner.PruneActualLRPHistoryArgsForCall(0)
+			Expect(limit).To(Equal(actualLRPHistoryLimit))
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeActualLRPHistoryPruner.PruneActualLRPHistoryCallCount).Should(Equal(2))
+		})
+
+		It("converges the cell drains after converging LRPs on every pass", func() {
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeCellDrainController.ConvergeCellDrainsCallCount).Should(Equal(1))
+			Expect(fakeLrpConvergenceController.ConvergeLRPsCallCount()).To(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+			Eventually(fakeCellDrainController.ConvergeCellDrainsCallCount).Should(Equal(2))
+		})
+
+		Context("when converging the cell drains fails", func() {
+			BeforeEach(func() {
+				fakeCellDrainController.ConvergeCellDrainsReturns(errors.New("boom"))
+			})
+
+			It("logs the failure and keeps converging", func() {
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+				Eventually(logger).Should(gbytes.Say("failed-to-converge-cell-drains"))
+				Eventually(fakeActualLRPHistoryPruner.PruneActualLRPHistoryCallCount).Should(Equal(1))
+			})
+		})
+
+		Context("when pruning the actual LRP history fails", func() {
+			BeforeEach(func() {
+				fakeActualLRPHistoryPruner.PruneActualLRPHistoryReturns(0, errors.New("boom"))
+			})
+
+			It("logs the failure and keeps converging", func() {
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+				Eventually(logger).Should(gbytes.Say("failed-to-prune-actual-lrp-history"))
+
+				fakeClock.WaitForWatcherAndIncrement(convergeRepeatInterval + aBit)
+				Eventually(fakeLrpConvergenceController.ConvergeLRPsCallCount).Should(Equal(2))
+			})
+		})
 	})
 
 	Describe("converging when cells disappear", func() {
@@ -0,0 +1,122 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeActualLRPHistoryPruner struct {
+	PruneActualLRPHistoryStub        func(context.Context, lager.Logger, int) (int64, error)
+	pruneActualLRPHistoryMutex       sync.RWMutex
+	pruneActualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}
+	pruneActualLRPHistoryReturns struct {
+		result1 int64
+		result2 error
+	}
+	pruneActualLRPHistoryReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 int) (int64, error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPHistoryReturnsOnCall[len(fake.pruneActualLRPHistoryArgsForCall)]
+	fake.pruneActualLRPHistoryArgsForCall = append(fake.pruneActualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PruneActualLRPHistoryStub
+	fakeReturns := fake.pruneActualLRPHistoryReturns
+	fake.recordInvocation("PruneActualLRPHistory", []interface{}{arg1, arg2, arg3})
+	fake.pruneActualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistoryCallCount() int {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	return len(fake.pruneActualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistoryCalls(stub func(context.Context, lager.Logger, int) (int64, error)) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = stub
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, int) {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistoryReturns(result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	fake.pruneActualLRPHistoryReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryPruner) PruneActualLRPHistoryReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	if fake.pruneActualLRPHistoryReturnsOnCall == nil {
+		fake.pruneActualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.pruneActualLRPHistoryReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryPruner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeActualLRPHistoryPruner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.ActualLRPHistoryPruner = new(FakeActualLRPHistoryPruner)
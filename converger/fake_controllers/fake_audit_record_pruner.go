@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/converger"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeAuditRecordPruner struct {
+	DeleteAuditRecordsBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteAuditRecordsBeforeMutex       sync.RWMutex
+	deleteAuditRecordsBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteAuditRecordsBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteAuditRecordsBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteAuditRecordsBeforeReturnsOnCall[len(fake.deleteAuditRecordsBeforeArgsForCall)]
+	fake.deleteAuditRecordsBeforeArgsForCall = append(fake.deleteAuditRecordsBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAuditRecordsBeforeStub
+	fakeReturns := fake.deleteAuditRecordsBeforeReturns
+	fake.recordInvocation("DeleteAuditRecordsBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteAuditRecordsBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBeforeCallCount() int {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	return len(fake.deleteAuditRecordsBeforeArgsForCall)
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = stub
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	argsForCall := fake.deleteAuditRecordsBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBeforeReturns(result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	fake.deleteAuditRecordsBeforeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordPruner) DeleteAuditRecordsBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	if fake.deleteAuditRecordsBeforeReturnsOnCall == nil {
+		fake.deleteAuditRecordsBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteAuditRecordsBeforeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordPruner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditRecordPruner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.AuditRecordPruner = new(FakeAuditRecordPruner)
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/converger"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeCellDrainController struct {
+	ConvergeCellDrainsStub        func(context.Context, lager.Logger) error
+	convergeCellDrainsMutex       sync.RWMutex
+	convergeCellDrainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	convergeCellDrainsReturns struct {
+		result1 error
+	}
+	convergeCellDrainsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrains(arg1 context.Context, arg2 lager.Logger) error {
+	fake.convergeCellDrainsMutex.Lock()
+	ret, specificReturn := fake.convergeCellDrainsReturnsOnCall[len(fake.convergeCellDrainsArgsForCall)]
+	fake.convergeCellDrainsArgsForCall = append(fake.convergeCellDrainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.ConvergeCellDrainsStub
+	fakeReturns := fake.convergeCellDrainsReturns
+	fake.recordInvocation("ConvergeCellDrains", []interface{}{arg1, arg2})
+	fake.convergeCellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrainsCallCount() int {
+	fake.convergeCellDrainsMutex.RLock()
+	defer fake.convergeCellDrainsMutex.RUnlock()
+	return len(fake.convergeCellDrainsArgsForCall)
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrainsCalls(stub func(context.Context, lager.Logger) error) {
+	fake.convergeCellDrainsMutex.Lock()
+	defer fake.convergeCellDrainsMutex.Unlock()
+	fake.ConvergeCellDrainsStub = stub
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.convergeCellDrainsMutex.RLock()
+	defer fake.convergeCellDrainsMutex.RUnlock()
+	argsForCall := fake.convergeCellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrainsReturns(result1 error) {
+	fake.convergeCellDrainsMutex.Lock()
+	defer fake.convergeCellDrainsMutex.Unlock()
+	fake.ConvergeCellDrainsStub = nil
+	fake.convergeCellDrainsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellDrainController) ConvergeCellDrainsReturnsOnCall(i int, result1 error) {
+	fake.convergeCellDrainsMutex.Lock()
+	defer fake.convergeCellDrainsMutex.Unlock()
+	fake.ConvergeCellDrainsStub = nil
+	if fake.convergeCellDrainsReturnsOnCall == nil {
+		fake.convergeCellDrainsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.convergeCellDrainsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellDrainController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.convergeCellDrainsMutex.RLock()
+	defer fake.convergeCellDrainsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellDrainController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.CellDrainController = new(FakeCellDrainController)
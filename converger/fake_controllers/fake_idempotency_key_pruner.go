@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/converger"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeIdempotencyKeyPruner struct {
+	DeleteIdempotencyKeysBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteIdempotencyKeysBeforeMutex       sync.RWMutex
+	deleteIdempotencyKeysBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteIdempotencyKeysBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteIdempotencyKeysBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteIdempotencyKeysBeforeReturnsOnCall[len(fake.deleteIdempotencyKeysBeforeArgsForCall)]
+	fake.deleteIdempotencyKeysBeforeArgsForCall = append(fake.deleteIdempotencyKeysBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteIdempotencyKeysBeforeStub
+	fakeReturns := fake.deleteIdempotencyKeysBeforeReturns
+	fake.recordInvocation("DeleteIdempotencyKeysBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBeforeCallCount() int {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	return len(fake.deleteIdempotencyKeysBeforeArgsForCall)
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = stub
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	argsForCall := fake.deleteIdempotencyKeysBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBeforeReturns(result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	fake.deleteIdempotencyKeysBeforeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyPruner) DeleteIdempotencyKeysBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	if fake.deleteIdempotencyKeysBeforeReturnsOnCall == nil {
+		fake.deleteIdempotencyKeysBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteIdempotencyKeysBeforeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyPruner) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIdempotencyKeyPruner) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ converger.IdempotencyKeyPruner = new(FakeIdempotencyKeyPruner)
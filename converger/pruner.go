@@ -0,0 +1,102 @@
+package converger
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// DEFAULT_AUDIT_RECORD_RETENTION is how long audit records are kept when no
+// retention is configured.
+const DEFAULT_AUDIT_RECORD_RETENTION = 30 * 24 * time.Hour
+
+// DEFAULT_IDEMPOTENCY_KEY_WINDOW is how long idempotency keys are kept when no
+// window is configured.
+const DEFAULT_IDEMPOTENCY_KEY_WINDOW = time.Hour
+
+// DEFAULT_PRUNE_INTERVAL is how often the audit records and idempotency keys
+// are pruned when no interval is configured.
+const DEFAULT_PRUNE_INTERVAL = 10 * time.Minute
+
+//counterfeiter:generate -o fake_controllers/fake_audit_record_pruner.go . AuditRecordPruner
+type AuditRecordPruner interface {
+	DeleteAuditRecordsBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error)
+}
+
+//counterfeiter:generate -o fake_controllers/fake_idempotency_key_pruner.go . IdempotencyKeyPruner
+type IdempotencyKeyPruner interface {
+	DeleteIdempotencyKeysBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error)
+}
+
+// Pruner deletes the audit records past their retention and the idempotency
+// keys past their window at a fixed interval. It runs apart from convergence
+// so that a large deletion does not hold up a convergence pass.
+type Pruner struct {
+	logger               lager.Logger
+	clock                clock.Clock
+	auditRecordPruner    AuditRecordPruner
+	idempotencyKeyPruner IdempotencyKeyPruner
+	pruneInterval        time.Duration
+	auditRecordRetention time.Duration
+	idempotencyKeyWindow time.Duration
+}
+
+func NewPruner(
+	logger lager.Logger,
+	clock clock.Clock,
+	auditRecordPruner AuditRecordPruner,
+	idempotencyKeyPruner IdempotencyKeyPruner,
+	pruneInterval,
+	auditRecordRetention,
+	idempotencyKeyWindow time.Duration,
+) *Pruner {
+	return &Pruner{
+		logger:               logger,
+		clock:                clock,
+		auditRecordPruner:    auditRecordPruner,
+		idempotencyKeyPruner: idempotencyKeyPruner,
+		pruneInterval:        pruneInterval,
+		auditRecordRetention: auditRecordRetention,
+		idempotencyKeyWindow: idempotencyKeyWindow,
+	}
+}
+
+func (p *Pruner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := p.logger.Session("pruner")
+	logger.Info("started")
+	defer logger.Info("done")
+
+	ticker := p.clock.NewTicker(p.pruneInterval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+
+		case <-ticker.C():
+			p.prune(logger.Session("pruning"))
+		}
+	}
+}
+
+func (p *Pruner) prune(logger lager.Logger) {
+	pruned, err := p.auditRecordPruner.DeleteAuditRecordsBefore(context.Background(), logger, p.clock.Now().Add(-p.auditRecordRetention))
+	if err != nil {
+		logger.Error("failed-to-prune-audit-records", err)
+	} else if pruned > 0 {
+		logger.Info("pruned-audit-records", lager.Data{"count": pruned})
+	}
+
+	pruned, err = p.idempotencyKeyPruner.DeleteIdempotencyKeysBefore(context.Background(), logger, p.clock.Now().Add(-p.idempotencyKeyWindow))
+	if err != nil {
+		logger.Error("failed-to-prune-idempotency-keys", err)
+	} else if pruned > 0 {
+		logger.Info("pruned-idempotency-keys", lager.Data{"count": pruned})
+	}
+}
@@ -0,0 +1,106 @@
+package converger_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/converger"
+	"code.cloudfoundry.org/bbs/converger/fake_controllers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+var _ = Describe("Pruner", func() {
+	const (
+		pruneInterval        = 10 * time.Minute
+		auditRecordRetention = 24 * time.Hour
+		idempotencyKeyWindow = time.Hour
+	)
+
+	var (
+		fakeClock                *fakeclock.FakeClock
+		fakeAuditRecordPruner    *fake_controllers.FakeAuditRecordPruner
+		fakeIdempotencyKeyPruner *fake_controllers.FakeIdempotencyKeyPruner
+		logger                   *lagertest.TestLogger
+		process                  ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		fakeAuditRecordPruner = new(fake_controllers.FakeAuditRecordPruner)
+		fakeIdempotencyKeyPruner = new(fake_controllers.FakeIdempotencyKeyPruner)
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	JustBeforeEach(func() {
+		runner := converger.NewPruner(
+			logger,
+			fakeClock,
+			fakeAuditRecordPruner,
+			fakeIdempotencyKeyPruner,
+			pruneInterval,
+			auditRecordRetention,
+			idempotencyKeyWindow,
+		)
+		process = ginkgomon.Invoke(runner)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	It("prunes the audit records older than their retention every interval", func() {
+		Consistently(fakeAuditRecordPruner.DeleteAuditRecordsBeforeCallCount).Should(Equal(0))
+
+		fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+		Eventually(fakeAuditRecordPruner.DeleteAuditRecordsBeforeCallCount).Should(Equal(1))
+
+		_, _, before := fakeAuditRecordPruner.DeleteAuditRecordsBeforeArgsForCall(0)
+		Expect(before).To(BeTemporally("~", fakeClock.Now().Add(-auditRecordRetention)))
+
+		fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+		Eventually(fakeAuditRecordPruner.DeleteAuditRecordsBeforeCallCount).Should(Equal(2))
+	})
+
+	It("prunes the idempotency keys older than their window every interval", func() {
+		fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+		Eventually(fakeIdempotencyKeyPruner.DeleteIdempotencyKeysBeforeCallCount).Should(Equal(1))
+
+		_, _, before := fakeIdempotencyKeyPruner.DeleteIdempotencyKeysBeforeArgsForCall(0)
+		Expect(before).To(BeTemporally("~", fakeClock.Now().Add(-idempotencyKeyWindow)))
+
+		fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+		Eventually(fakeIdempotencyKeyPruner.DeleteIdempotencyKeysBeforeCallCount).Should(Equal(2))
+	})
+
+	It("prunes in its own session", func() {
+		fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+		Eventually(fakeAuditRecordPruner.DeleteAuditRecordsBeforeCallCount).Should(Equal(1))
+
+		_, pruneLogger, _ := fakeAuditRecordPruner.DeleteAuditRecordsBeforeArgsForCall(0)
+		Expect(pruneLogger.SessionName()).To(Equal("test.pruner.pruning"))
+	})
+
+	Context("when pruning audit records fails", func() {
+		BeforeEach(func() {
+			fakeAuditRecordPruner.DeleteAuditRecordsBeforeReturns(0, errors.New("boom"))
+		})
+
+		It("logs the failure and still prunes the idempotency keys", func() {
+			fakeClock.WaitForWatcherAndIncrement(pruneInterval)
+			Eventually(logger).Should(gbytes.Say("failed-to-prune-audit-records"))
+			Eventually(fakeIdempotencyKeyPruner.DeleteIdempotencyKeysBeforeCallCount).Should(Equal(1))
+		})
+	})
+
+	It("exits when signaled", func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,58 @@
+package crashstorm
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/durationjson"
+)
+
+const (
+	DefaultWindow           = time.Minute
+	DefaultCooldown         = 5 * time.Minute
+	DefaultGlobalThreshold  = 1000
+	DefaultDomainThreshold  = 250
+	DefaultProcessThreshold = 50
+)
+
+// Config sets when the BBS pauses crash restarts because too many ActualLRPs
+// crash at once. Unless it is enabled no restart is paused.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// Window is how far back crashes are counted, DefaultWindow if unset.
+	Window durationjson.Duration `json:"window,omitempty"`
+	// Cooldown is how long a tripped breaker pauses restarts unless it is
+	// reset, DefaultCooldown if unset.
+	Cooldown durationjson.Duration `json:"cooldown,omitempty"`
+	// GlobalThreshold, DomainThreshold and ProcessThreshold are how many
+	// crashes within the window, across all ActualLRPs, those of a domain and
+	// those of a process guid, trip the breaker of the scope. Each defaults
+	// to its Default constant if unset.
+	GlobalThreshold  int `json:"global_threshold,omitempty"`
+	DomainThreshold  int `json:"domain_threshold,omitempty"`
+	ProcessThreshold int `json:"process_threshold,omitempty"`
+}
+
+func (c Config) Validate() error {
+	if c.Window < 0 || c.Cooldown < 0 {
+		return errors.New("crash storm: negative window or cooldown")
+	}
+	if c.GlobalThreshold < 0 || c.DomainThreshold < 0 || c.ProcessThreshold < 0 {
+		return errors.New("crash storm: negative global_threshold, domain_threshold or process_threshold")
+	}
+	return nil
+}
+
+func durationOrDefault(d durationjson.Duration, defaultDuration time.Duration) time.Duration {
+	if d == 0 {
+		return defaultDuration
+	}
+	return time.Duration(d)
+}
+
+func thresholdOrDefault(threshold, defaultThreshold int) int {
+	if threshold == 0 {
+		return defaultThreshold
+	}
+	return threshold
+}
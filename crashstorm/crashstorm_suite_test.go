@@ -0,0 +1,13 @@
+package crashstorm_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestCrashStorm(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Crash Storm Suite")
+}
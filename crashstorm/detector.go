@@ -0,0 +1,269 @@
+package crashstorm
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	logging "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const (
+	crashStormBreakersOpenMetric = "CrashStormBreakersOpen"
+	crashStormBreakerTripsMetric = "CrashStormBreakerTrips"
+)
+
+type scope struct {
+	scope models.CrashStormBreaker_Scope
+	key   string
+}
+
+func scopesOf(key *models.ActualLRPKey) []scope {
+	return []scope{
+		{scope: models.CrashStormBreaker_Global},
+		{scope: models.CrashStormBreaker_Domain, key: key.Domain},
+		{scope: models.CrashStormBreaker_ProcessGuid, key: key.ProcessGuid},
+	}
+}
+
+// Detector counts the crashes of ActualLRPs over a sliding window, globally,
+// per domain and per process guid, and trips the breaker of a scope once its
+// crashes reach the threshold of the scope. While the breaker is open the
+// crash restarts of the ActualLRPs in the scope are paused, until the
+// cooldown has passed or the breaker is reset.
+//
+// Breakers are kept in memory by the active BBS and are lost when another BBS
+// takes over.
+type Detector struct {
+	logger       lager.Logger
+	clock        clock.Clock
+	enabled      bool
+	window       time.Duration
+	cooldown     time.Duration
+	thresholds   map[models.CrashStormBreaker_Scope]int
+	hub          events.Hub
+	metronClient logging.IngressClient
+
+	lock     sync.Mutex
+	crashes  map[scope][]time.Time
+	breakers map[scope]*models.CrashStormBreaker
+}
+
+// NewDetector returns a detector that emits the changes of its breakers on the
+// hub. A detector that is not enabled never trips a breaker.
+func NewDetector(
+	logger lager.Logger,
+	clock clock.Clock,
+	config Config,
+	hub events.Hub,
+	metronClient logging.IngressClient,
+) (*Detector, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Detector{
+		logger:   logger.Session("crash-storm-detector"),
+		clock:    clock,
+		enabled:  config.Enabled,
+		window:   durationOrDefault(config.Window, DefaultWindow),
+		cooldown: durationOrDefault(config.Cooldown, DefaultCooldown),
+		thresholds: map[models.CrashStormBreaker_Scope]int{
+			models.CrashStormBreaker_Global:      thresholdOrDefault(config.GlobalThreshold, DefaultGlobalThreshold),
+			models.CrashStormBreaker_Domain:      thresholdOrDefault(config.DomainThreshold, DefaultDomainThreshold),
+			models.CrashStormBreaker_ProcessGuid: thresholdOrDefault(config.ProcessThreshold, DefaultProcessThreshold),
+		},
+		hub:          hub,
+		metronClient: metronClient,
+		crashes:      map[scope][]time.Time{},
+		breakers:     map[scope]*models.CrashStormBreaker{},
+	}, nil
+}
+
+// RecordCrash counts the crash of the ActualLRP in each of its scopes and
+// trips the breakers of the scopes that reach their threshold.
+func (d *Detector) RecordCrash(traceId string, key *models.ActualLRPKey) {
+	if !d.enabled {
+		return
+	}
+
+	now := d.clock.Now()
+	tripped := []*models.CrashStormBreaker{}
+
+	d.lock.Lock()
+	for _, s := range scopesOf(key) {
+		if breaker, ok := d.breakers[s]; ok && breaker.IsOpen(now.UnixNano()) {
+			continue
+		}
+
+		crashes := append(d.recentCrashes(s, now), now)
+		if len(crashes) < d.thresholds[s.scope] {
+			d.crashes[s] = crashes
+			continue
+		}
+
+		delete(d.crashes, s)
+		breaker := &models.CrashStormBreaker{
+			Scope:      s.scope,
+			Key:        s.key,
+			CrashCount: int32(len(crashes)),
+			TrippedAt:  now.UnixNano(),
+			ClosesAt:   now.Add(d.cooldown).UnixNano(),
+		}
+		d.breakers[s] = breaker
+		tripped = append(tripped, copyBreaker(breaker))
+	}
+	open := len(d.breakers)
+	d.lock.Unlock()
+
+	if len(tripped) == 0 {
+		return
+	}
+
+	for _, breaker := range tripped {
+		d.logger.Info("tripped-breaker", lager.Data{
+			"scope":       breaker.Scope.String(),
+			"key":         breaker.Key,
+			"crash_count": breaker.CrashCount,
+			"window":      d.window,
+		})
+		d.hub.Emit(models.NewCrashStormBreakerChangedEvent(breaker, traceId))
+
+		err := d.metronClient.IncrementCounter(crashStormBreakerTripsMetric)
+		if err != nil {
+			d.logger.Error("failed-sending-breaker-trips", err)
+		}
+	}
+	d.sendOpenBreakers(open)
+}
+
+// recentCrashes returns the crashes of the scope within the window ending at
+// now.
+func (d *Detector) recentCrashes(s scope, now time.Time) []time.Time {
+	crashes := d.crashes[s]
+	start := now.Add(-d.window)
+	for len(crashes) > 0 && !crashes[0].After(start) {
+		crashes = crashes[1:]
+	}
+	return crashes
+}
+
+// RestartsPaused reports whether the crash restarts of the ActualLRP are
+// paused by the open breaker of any of its scopes.
+func (d *Detector) RestartsPaused(key *models.ActualLRPKey) bool {
+	if !d.enabled {
+		return false
+	}
+
+	now := d.clock.Now().UnixNano()
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	for _, s := range scopesOf(key) {
+		if breaker, ok := d.breakers[s]; ok && breaker.IsOpen(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// CloseExpiredBreakers closes the breakers whose cooldown has passed and
+// forgets the crashes that left the window.
+func (d *Detector) CloseExpiredBreakers(traceId string) {
+	if !d.enabled {
+		return
+	}
+
+	now := d.clock.Now()
+	closed := []*models.CrashStormBreaker{}
+
+	d.lock.Lock()
+	for s, breaker := range d.breakers {
+		if !breaker.IsOpen(now.UnixNano()) {
+			delete(d.breakers, s)
+			breaker.ClosedAt = now.UnixNano()
+			closed = append(closed, breaker)
+		}
+	}
+	for s := range d.crashes {
+		if crashes := d.recentCrashes(s, now); len(crashes) > 0 {
+			d.crashes[s] = crashes
+		} else {
+			delete(d.crashes, s)
+		}
+	}
+	open := len(d.breakers)
+	d.lock.Unlock()
+
+	for _, breaker := range closed {
+		d.logger.Info("closed-breaker", lager.Data{"scope": breaker.Scope.String(), "key": breaker.Key})
+		d.hub.Emit(models.NewCrashStormBreakerChangedEvent(breaker, traceId))
+	}
+	d.sendOpenBreakers(open)
+}
+
+// Breakers returns the open breakers, ordered by scope and key.
+func (d *Detector) Breakers() []*models.CrashStormBreaker {
+	now := d.clock.Now().UnixNano()
+	breakers := []*models.CrashStormBreaker{}
+
+	d.lock.Lock()
+	for _, breaker := range d.breakers {
+		if breaker.IsOpen(now) {
+			breakers = append(breakers, copyBreaker(breaker))
+		}
+	}
+	d.lock.Unlock()
+
+	sort.Slice(breakers, func(i, j int) bool {
+		if breakers[i].Scope != breakers[j].Scope {
+			return breakers[i].Scope < breakers[j].Scope
+		}
+		return breakers[i].Key < breakers[j].Key
+	})
+	return breakers
+}
+
+// Reset closes the open breaker of the scope, so that the crash restarts it
+// paused resume on the next convergence. The crashes counted towards the
+// breaker of the scope are forgotten.
+func (d *Detector) Reset(traceId string, breakerScope models.CrashStormBreaker_Scope, key string) (*models.CrashStormBreaker, error) {
+	now := d.clock.Now().UnixNano()
+	s := scope{scope: breakerScope, key: key}
+
+	d.lock.Lock()
+	breaker, ok := d.breakers[s]
+	if !ok || !breaker.IsOpen(now) {
+		d.lock.Unlock()
+		return nil, models.ErrResourceNotFound
+	}
+	delete(d.breakers, s)
+	delete(d.crashes, s)
+	breaker.ClosedAt = now
+	open := len(d.breakers)
+	d.lock.Unlock()
+
+	d.logger.Info("reset-breaker", lager.Data{"scope": breaker.Scope.String(), "key": breaker.Key})
+	d.hub.Emit(models.NewCrashStormBreakerChangedEvent(breaker, traceId))
+	d.sendOpenBreakers(open)
+
+	return copyBreaker(breaker), nil
+}
+
+func (d *Detector) sendOpenBreakers(open int) {
+	err := d.metronClient.SendMetric(crashStormBreakersOpenMetric, open)
+	if err != nil {
+		d.logger.Error("failed-sending-open-breakers", err)
+	}
+}
+
+func copyBreaker(breaker *models.CrashStormBreaker) *models.CrashStormBreaker {
+	c := *breaker
+	return &c
+}
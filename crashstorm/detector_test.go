@@ -0,0 +1,229 @@
+package crashstorm_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/crashstorm"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/durationjson"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Detector", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeClock        *fakeclock.FakeClock
+		fakeHub          *eventfakes.FakeHub
+		fakeMetronClient *mfakes.FakeIngressClient
+		config           crashstorm.Config
+
+		detector *crashstorm.Detector
+
+		key, otherProcessKey, otherDomainKey *models.ActualLRPKey
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		fakeHub = new(eventfakes.FakeHub)
+		fakeMetronClient = new(mfakes.FakeIngressClient)
+		config = crashstorm.Config{
+			Enabled:          true,
+			Window:           durationjson.Duration(time.Minute),
+			Cooldown:         durationjson.Duration(5 * time.Minute),
+			GlobalThreshold:  10,
+			DomainThreshold:  5,
+			ProcessThreshold: 3,
+		}
+
+		key = &models.ActualLRPKey{ProcessGuid: "process-guid", Index: 0, Domain: "domain"}
+		otherProcessKey = &models.ActualLRPKey{ProcessGuid: "other-process-guid", Index: 0, Domain: "domain"}
+		otherDomainKey = &models.ActualLRPKey{ProcessGuid: "another-process-guid", Index: 0, Domain: "other-domain"}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		detector, err = crashstorm.NewDetector(logger, fakeClock, config, fakeHub, fakeMetronClient)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	recordCrashes := func(key *models.ActualLRPKey, n int) {
+		for i := 0; i < n; i++ {
+			detector.RecordCrash("some-trace-id", key)
+		}
+	}
+
+	It("rejects negative thresholds", func() {
+		config.ProcessThreshold = -1
+		_, err := crashstorm.NewDetector(logger, fakeClock, config, fakeHub, fakeMetronClient)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("when the crashes of a process stay under its threshold", func() {
+		JustBeforeEach(func() {
+			recordCrashes(key, 2)
+		})
+
+		It("does not pause its restarts", func() {
+			Expect(detector.RestartsPaused(key)).To(BeFalse())
+			Expect(detector.Breakers()).To(BeEmpty())
+			Expect(fakeHub.EmitCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the crashes of a process reach its threshold within the window", func() {
+		JustBeforeEach(func() {
+			recordCrashes(key, 3)
+		})
+
+		It("trips the breaker of the process", func() {
+			Expect(detector.Breakers()).To(ConsistOf(&models.CrashStormBreaker{
+				Scope:      models.CrashStormBreaker_ProcessGuid,
+				Key:        "process-guid",
+				CrashCount: 3,
+				TrippedAt:  fakeClock.Now().UnixNano(),
+				ClosesAt:   fakeClock.Now().Add(5 * time.Minute).UnixNano(),
+			}))
+		})
+
+		It("pauses the restarts of the process only", func() {
+			Expect(detector.RestartsPaused(key)).To(BeTrue())
+			Expect(detector.RestartsPaused(otherProcessKey)).To(BeFalse())
+		})
+
+		It("emits a breaker changed event", func() {
+			Expect(fakeHub.EmitCallCount()).To(Equal(1))
+			event := fakeHub.EmitArgsForCall(0).(*models.CrashStormBreakerChangedEvent)
+			Expect(event.Breaker.Scope).To(Equal(models.CrashStormBreaker_ProcessGuid))
+			Expect(event.Breaker.Key).To(Equal("process-guid"))
+			Expect(event.TraceId).To(Equal("some-trace-id"))
+		})
+
+		It("emits the breaker metrics", func() {
+			Expect(fakeMetronClient.IncrementCounterCallCount()).To(Equal(1))
+			Expect(fakeMetronClient.IncrementCounterArgsForCall(0)).To(Equal("CrashStormBreakerTrips"))
+
+			Expect(fakeMetronClient.SendMetricCallCount()).To(Equal(1))
+			name, value, _ := fakeMetronClient.SendMetricArgsForCall(0)
+			Expect(name).To(Equal("CrashStormBreakersOpen"))
+			Expect(value).To(Equal(1))
+		})
+
+		Context("and the cooldown passes", func() {
+			JustBeforeEach(func() {
+				fakeClock.Increment(5 * time.Minute)
+			})
+
+			It("resumes the restarts of the process", func() {
+				Expect(detector.RestartsPaused(key)).To(BeFalse())
+			})
+
+			It("closes the breaker when expired breakers are closed", func() {
+				detector.CloseExpiredBreakers("other-trace-id")
+
+				Expect(fakeHub.EmitCallCount()).To(Equal(2))
+				event := fakeHub.EmitArgsForCall(1).(*models.CrashStormBreakerChangedEvent)
+				Expect(event.Breaker.ClosedAt).To(Equal(fakeClock.Now().UnixNano()))
+				Expect(event.TraceId).To(Equal("other-trace-id"))
+				Expect(detector.Breakers()).To(BeEmpty())
+			})
+		})
+
+		Context("and the breaker is reset", func() {
+			It("closes the breaker and resumes the restarts of the process", func() {
+				breaker, err := detector.Reset("other-trace-id", models.CrashStormBreaker_ProcessGuid, "process-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(breaker.ClosedAt).To(Equal(fakeClock.Now().UnixNano()))
+
+				Expect(detector.RestartsPaused(key)).To(BeFalse())
+				Expect(detector.Breakers()).To(BeEmpty())
+				Expect(fakeHub.EmitCallCount()).To(Equal(2))
+			})
+
+			It("counts the crashes of the process from scratch", func() {
+				_, err := detector.Reset("other-trace-id", models.CrashStormBreaker_ProcessGuid, "process-guid")
+				Expect(err).NotTo(HaveOccurred())
+
+				recordCrashes(key, 2)
+				for _, breaker := range detector.Breakers() {
+					Expect(breaker.Scope).NotTo(Equal(models.CrashStormBreaker_ProcessGuid))
+				}
+			})
+		})
+	})
+
+	Context("when the crashes of a process are spread over more than the window", func() {
+		JustBeforeEach(func() {
+			recordCrashes(key, 2)
+			fakeClock.Increment(time.Minute)
+			recordCrashes(key, 1)
+		})
+
+		It("does not trip the breaker", func() {
+			Expect(detector.RestartsPaused(key)).To(BeFalse())
+		})
+	})
+
+	Context("when the crashes of a domain reach its threshold", func() {
+		JustBeforeEach(func() {
+			recordCrashes(key, 2)
+			recordCrashes(otherProcessKey, 2)
+			recordCrashes(&models.ActualLRPKey{ProcessGuid: "third-process-guid", Domain: "domain"}, 1)
+		})
+
+		It("pauses the restarts of every process of the domain", func() {
+			Expect(detector.Breakers()).To(HaveLen(1))
+			Expect(detector.Breakers()[0].Scope).To(Equal(models.CrashStormBreaker_Domain))
+			Expect(detector.Breakers()[0].Key).To(Equal("domain"))
+
+			Expect(detector.RestartsPaused(key)).To(BeTrue())
+			Expect(detector.RestartsPaused(otherProcessKey)).To(BeTrue())
+			Expect(detector.RestartsPaused(otherDomainKey)).To(BeFalse())
+		})
+	})
+
+	Context("when the crashes across all domains reach the global threshold", func() {
+		BeforeEach(func() {
+			config.GlobalThreshold = 4
+		})
+
+		JustBeforeEach(func() {
+			recordCrashes(key, 2)
+			recordCrashes(otherDomainKey, 2)
+		})
+
+		It("pauses every restart", func() {
+			Expect(detector.Breakers()).To(ConsistOf(&models.CrashStormBreaker{
+				Scope:      models.CrashStormBreaker_Global,
+				CrashCount: 4,
+				TrippedAt:  fakeClock.Now().UnixNano(),
+				ClosesAt:   fakeClock.Now().Add(5 * time.Minute).UnixNano(),
+			}))
+			Expect(detector.RestartsPaused(&models.ActualLRPKey{ProcessGuid: "any", Domain: "any"})).To(BeTrue())
+		})
+	})
+
+	Context("when resetting a breaker that is not open", func() {
+		It("returns a not found error", func() {
+			_, err := detector.Reset("some-trace-id", models.CrashStormBreaker_Domain, "domain")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Context("when the detector is not enabled", func() {
+		BeforeEach(func() {
+			config.Enabled = false
+		})
+
+		It("never pauses restarts", func() {
+			recordCrashes(key, 20)
+			Expect(detector.RestartsPaused(key)).To(BeFalse())
+			Expect(detector.Breakers()).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1 @@
+package crashstorm // import "code.cloudfoundry.org/bbs/crashstorm"
@@ -0,0 +1,26 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . ActualLRPHistoryDB
+
+// ActualLRPHistoryDB stores the transitions of the ActualLRP instances, so
+// that the instances of a process can be debugged after they have moved or
+// been removed.
+type ActualLRPHistoryDB interface {
+	// ActualLRPHistory returns the records of the instances at the index of
+	// the process, oldest first.
+	ActualLRPHistory(ctx context.Context, logger lager.Logger, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error)
+	// RecordActualLRPTransition stores the record, with the current time.
+	RecordActualLRPTransition(ctx context.Context, logger lager.Logger, record *models.ActualLRPHistoryRecord) error
+	// PruneActualLRPHistory keeps the newest limit records of each index of
+	// each process, deletes the records of the processes that are no longer
+	// desired, and returns how many records were deleted. A limit of 0 keeps
+	// all the records of the desired processes.
+	PruneActualLRPHistory(ctx context.Context, logger lager.Logger, limit int) (int64, error)
+}
@@ -0,0 +1,25 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . AuditRecordDB
+
+// AuditRecordDB queries the audit log. Records are written by the mutations
+// of the other DBs, in their transactions, when their context carries an
+// audit.Call.
+type AuditRecordDB interface {
+	// AuditRecords returns the records matching the filter, oldest first.
+	AuditRecords(ctx context.Context, logger lager.Logger, filter models.AuditRecordFilter) ([]*models.AuditRecord, error)
+	// DeleteAuditRecordsBefore prunes the records created before the given
+	// time and returns how many were deleted.
+	DeleteAuditRecordsBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error)
+	// RecordDenial records that the call the context carries was denied,
+	// along with the domain it acts on when it is known.
+	RecordDenial(ctx context.Context, logger lager.Logger, domain string) error
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . CellDB
+
+// CellDB stores the schedulability of the cells and the progress of the
+// drains requested through the API. The presence of the cells themselves is
+// kept in locket.
+type CellDB interface {
+	CordonedCellIds(ctx context.Context, logger lager.Logger) ([]string, error)
+	// SetCellCordoned cordons or uncordons the cell. Cells that were never
+	// cordoned are schedulable.
+	SetCellCordoned(ctx context.Context, logger lager.Logger, cellId string, cordoned bool) error
+
+	CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error)
+	// StartCellDrain cordons the cell and starts tracking its drain from the
+	// ActualLRPs on it, replacing any previous drain of the cell.
+	StartCellDrain(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error)
+	FailCellDrain(ctx context.Context, logger lager.Logger, cellId, errorMessage string) (*models.CellDrain, error)
+	// UpdateCellDrainProgress counts the ActualLRPs still on a draining cell,
+	// and marks the drain as drained once none are left. It returns the drain
+	// as it was before.
+	UpdateCellDrainProgress(ctx context.Context, logger lager.Logger, cellId string) (before, after *models.CellDrain, err error)
+}
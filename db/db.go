@@ -5,12 +5,22 @@ package db
 //counterfeiter:generate . DB
 
 type DB interface {
+	ActualLRPHistoryDB
+	AuditRecordDB
+	CellDB
+	DeploymentDB
 	DomainDB
+	DomainQuotaDB
 	EncryptionDB
 	EvacuationDB
 	LRPDB
 	TaskDB
+	ScheduledTaskDB
+	TaskCallbackDB
 	VersionDB
 	SuspectDB
 	BBSHealthCheckDB
+	EventLogDB
+	IdempotencyKeyDB
+	ResourceVersionDB
 }
@@ -165,6 +165,23 @@ type FakeActualLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	ListActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)
+	listActualLRPsMutex       sync.RWMutex
+	listActualLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	listActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listActualLRPsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -865,6 +882,75 @@ func (fake *FakeActualLRPDB) FailActualLRPReturnsOnCall(i int, result1 *models.A
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActualLRPDB) ListActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error) {
+	fake.listActualLRPsMutex.Lock()
+	ret, specificReturn := fake.listActualLRPsReturnsOnCall[len(fake.listActualLRPsArgsForCall)]
+	fake.listActualLRPsArgsForCall = append(fake.listActualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListActualLRPsStub
+	fakeReturns := fake.listActualLRPsReturns
+	fake.recordInvocation("ListActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listActualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActualLRPDB) ListActualLRPsCallCount() int {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	return len(fake.listActualLRPsArgsForCall)
+}
+
+func (fake *FakeActualLRPDB) ListActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = stub
+}
+
+func (fake *FakeActualLRPDB) ListActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	argsForCall := fake.listActualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActualLRPDB) ListActualLRPsReturns(result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	fake.listActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActualLRPDB) ListActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	if fake.listActualLRPsReturnsOnCall == nil {
+		fake.listActualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listActualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActualLRPDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -1173,6 +1259,8 @@ func (fake *FakeActualLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
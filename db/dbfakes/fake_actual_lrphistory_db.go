@@ -0,0 +1,286 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeActualLRPHistoryDB struct {
+	ActualLRPHistoryStub        func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	PruneActualLRPHistoryStub        func(context.Context, lager.Logger, int) (int64, error)
+	pruneActualLRPHistoryMutex       sync.RWMutex
+	pruneActualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}
+	pruneActualLRPHistoryReturns struct {
+		result1 int64
+		result2 error
+	}
+	pruneActualLRPHistoryReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RecordActualLRPTransitionStub        func(context.Context, lager.Logger, *models.ActualLRPHistoryRecord) error
+	recordActualLRPTransitionMutex       sync.RWMutex
+	recordActualLRPTransitionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPHistoryRecord
+	}
+	recordActualLRPTransitionReturns struct {
+		result1 error
+	}
+	recordActualLRPTransitionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistoryCalls(stub func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryDB) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 int) (int64, error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPHistoryReturnsOnCall[len(fake.pruneActualLRPHistoryArgsForCall)]
+	fake.pruneActualLRPHistoryArgsForCall = append(fake.pruneActualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PruneActualLRPHistoryStub
+	fakeReturns := fake.pruneActualLRPHistoryReturns
+	fake.recordInvocation("PruneActualLRPHistory", []interface{}{arg1, arg2, arg3})
+	fake.pruneActualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistoryCallCount() int {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	return len(fake.pruneActualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistoryCalls(stub func(context.Context, lager.Logger, int) (int64, error)) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = stub
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, int) {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistoryReturns(result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	fake.pruneActualLRPHistoryReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryDB) PruneActualLRPHistoryReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	if fake.pruneActualLRPHistoryReturnsOnCall == nil {
+		fake.pruneActualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.pruneActualLRPHistoryReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransition(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPHistoryRecord) error {
+	fake.recordActualLRPTransitionMutex.Lock()
+	ret, specificReturn := fake.recordActualLRPTransitionReturnsOnCall[len(fake.recordActualLRPTransitionArgsForCall)]
+	fake.recordActualLRPTransitionArgsForCall = append(fake.recordActualLRPTransitionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPHistoryRecord
+	}{arg1, arg2, arg3})
+	stub := fake.RecordActualLRPTransitionStub
+	fakeReturns := fake.recordActualLRPTransitionReturns
+	fake.recordInvocation("RecordActualLRPTransition", []interface{}{arg1, arg2, arg3})
+	fake.recordActualLRPTransitionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransitionCallCount() int {
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	return len(fake.recordActualLRPTransitionArgsForCall)
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransitionCalls(stub func(context.Context, lager.Logger, *models.ActualLRPHistoryRecord) error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = stub
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransitionArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPHistoryRecord) {
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	argsForCall := fake.recordActualLRPTransitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransitionReturns(result1 error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = nil
+	fake.recordActualLRPTransitionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPHistoryDB) RecordActualLRPTransitionReturnsOnCall(i int, result1 error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = nil
+	if fake.recordActualLRPTransitionReturnsOnCall == nil {
+		fake.recordActualLRPTransitionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordActualLRPTransitionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeActualLRPHistoryDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeActualLRPHistoryDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ActualLRPHistoryDB = new(FakeActualLRPHistoryDB)
@@ -0,0 +1,285 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeAuditRecordDB struct {
+	AuditRecordsStub        func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, error)
+	auditRecordsMutex       sync.RWMutex
+	auditRecordsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsReturns struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}
+	auditRecordsReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}
+	DeleteAuditRecordsBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteAuditRecordsBeforeMutex       sync.RWMutex
+	deleteAuditRecordsBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteAuditRecordsBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteAuditRecordsBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	RecordDenialStub        func(context.Context, lager.Logger, string) error
+	recordDenialMutex       sync.RWMutex
+	recordDenialArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	recordDenialReturns struct {
+		result1 error
+	}
+	recordDenialReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditRecordDB) AuditRecords(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, error) {
+	fake.auditRecordsMutex.Lock()
+	ret, specificReturn := fake.auditRecordsReturnsOnCall[len(fake.auditRecordsArgsForCall)]
+	fake.auditRecordsArgsForCall = append(fake.auditRecordsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsStub
+	fakeReturns := fake.auditRecordsReturns
+	fake.recordInvocation("AuditRecords", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditRecordDB) AuditRecordsCallCount() int {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	return len(fake.auditRecordsArgsForCall)
+}
+
+func (fake *FakeAuditRecordDB) AuditRecordsCalls(stub func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, error)) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = stub
+}
+
+func (fake *FakeAuditRecordDB) AuditRecordsArgsForCall(i int) (context.Context, lager.Logger, models.AuditRecordFilter) {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	argsForCall := fake.auditRecordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditRecordDB) AuditRecordsReturns(result1 []*models.AuditRecord, result2 error) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	fake.auditRecordsReturns = struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordDB) AuditRecordsReturnsOnCall(i int, result1 []*models.AuditRecord, result2 error) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	if fake.auditRecordsReturnsOnCall == nil {
+		fake.auditRecordsReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 error
+		})
+	}
+	fake.auditRecordsReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteAuditRecordsBeforeReturnsOnCall[len(fake.deleteAuditRecordsBeforeArgsForCall)]
+	fake.deleteAuditRecordsBeforeArgsForCall = append(fake.deleteAuditRecordsBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteAuditRecordsBeforeStub
+	fakeReturns := fake.deleteAuditRecordsBeforeReturns
+	fake.recordInvocation("DeleteAuditRecordsBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteAuditRecordsBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBeforeCallCount() int {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	return len(fake.deleteAuditRecordsBeforeArgsForCall)
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = stub
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	argsForCall := fake.deleteAuditRecordsBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBeforeReturns(result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	fake.deleteAuditRecordsBeforeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordDB) DeleteAuditRecordsBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	if fake.deleteAuditRecordsBeforeReturnsOnCall == nil {
+		fake.deleteAuditRecordsBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteAuditRecordsBeforeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditRecordDB) RecordDenial(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.recordDenialMutex.Lock()
+	ret, specificReturn := fake.recordDenialReturnsOnCall[len(fake.recordDenialArgsForCall)]
+	fake.recordDenialArgsForCall = append(fake.recordDenialArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RecordDenialStub
+	fakeReturns := fake.recordDenialReturns
+	fake.recordInvocation("RecordDenial", []interface{}{arg1, arg2, arg3})
+	fake.recordDenialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditRecordDB) RecordDenialCallCount() int {
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	return len(fake.recordDenialArgsForCall)
+}
+
+func (fake *FakeAuditRecordDB) RecordDenialCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = stub
+}
+
+func (fake *FakeAuditRecordDB) RecordDenialArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	argsForCall := fake.recordDenialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAuditRecordDB) RecordDenialReturns(result1 error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = nil
+	fake.recordDenialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditRecordDB) RecordDenialReturnsOnCall(i int, result1 error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = nil
+	if fake.recordDenialReturnsOnCall == nil {
+		fake.recordDenialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordDenialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditRecordDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditRecordDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditRecordDB = new(FakeAuditRecordDB)
@@ -0,0 +1,538 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeCellDB struct {
+	CellDrainsStub        func(context.Context, lager.Logger) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	CordonedCellIdsStub        func(context.Context, lager.Logger) ([]string, error)
+	cordonedCellIdsMutex       sync.RWMutex
+	cordonedCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cordonedCellIdsReturns struct {
+		result1 []string
+		result2 error
+	}
+	cordonedCellIdsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	FailCellDrainStub        func(context.Context, lager.Logger, string, string) (*models.CellDrain, error)
+	failCellDrainMutex       sync.RWMutex
+	failCellDrainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	failCellDrainReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	failCellDrainReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	SetCellCordonedStub        func(context.Context, lager.Logger, string, bool) error
+	setCellCordonedMutex       sync.RWMutex
+	setCellCordonedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setCellCordonedReturns struct {
+		result1 error
+	}
+	setCellCordonedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartCellDrainStub        func(context.Context, lager.Logger, string) (*models.CellDrain, error)
+	startCellDrainMutex       sync.RWMutex
+	startCellDrainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	startCellDrainReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	startCellDrainReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	UpdateCellDrainProgressStub        func(context.Context, lager.Logger, string) (*models.CellDrain, *models.CellDrain, error)
+	updateCellDrainProgressMutex       sync.RWMutex
+	updateCellDrainProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	updateCellDrainProgressReturns struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}
+	updateCellDrainProgressReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeCellDB) CellDrains(arg1 context.Context, arg2 lager.Logger) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellDB) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeCellDB) CellDrainsCalls(stub func(context.Context, lager.Logger) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeCellDB) CellDrainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellDB) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) CordonedCellIds(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
+	fake.cordonedCellIdsMutex.Lock()
+	ret, specificReturn := fake.cordonedCellIdsReturnsOnCall[len(fake.cordonedCellIdsArgsForCall)]
+	fake.cordonedCellIdsArgsForCall = append(fake.cordonedCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CordonedCellIdsStub
+	fakeReturns := fake.cordonedCellIdsReturns
+	fake.recordInvocation("CordonedCellIds", []interface{}{arg1, arg2})
+	fake.cordonedCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellDB) CordonedCellIdsCallCount() int {
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
+	return len(fake.cordonedCellIdsArgsForCall)
+}
+
+func (fake *FakeCellDB) CordonedCellIdsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = stub
+}
+
+func (fake *FakeCellDB) CordonedCellIdsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
+	argsForCall := fake.cordonedCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCellDB) CordonedCellIdsReturns(result1 []string, result2 error) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = nil
+	fake.cordonedCellIdsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) CordonedCellIdsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = nil
+	if fake.cordonedCellIdsReturnsOnCall == nil {
+		fake.cordonedCellIdsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cordonedCellIdsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) FailCellDrain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.CellDrain, error) {
+	fake.failCellDrainMutex.Lock()
+	ret, specificReturn := fake.failCellDrainReturnsOnCall[len(fake.failCellDrainArgsForCall)]
+	fake.failCellDrainArgsForCall = append(fake.failCellDrainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailCellDrainStub
+	fakeReturns := fake.failCellDrainReturns
+	fake.recordInvocation("FailCellDrain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failCellDrainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellDB) FailCellDrainCallCount() int {
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
+	return len(fake.failCellDrainArgsForCall)
+}
+
+func (fake *FakeCellDB) FailCellDrainCalls(stub func(context.Context, lager.Logger, string, string) (*models.CellDrain, error)) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = stub
+}
+
+func (fake *FakeCellDB) FailCellDrainArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
+	argsForCall := fake.failCellDrainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCellDB) FailCellDrainReturns(result1 *models.CellDrain, result2 error) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = nil
+	fake.failCellDrainReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) FailCellDrainReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = nil
+	if fake.failCellDrainReturnsOnCall == nil {
+		fake.failCellDrainReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.failCellDrainReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) SetCellCordoned(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) error {
+	fake.setCellCordonedMutex.Lock()
+	ret, specificReturn := fake.setCellCordonedReturnsOnCall[len(fake.setCellCordonedArgsForCall)]
+	fake.setCellCordonedArgsForCall = append(fake.setCellCordonedArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetCellCordonedStub
+	fakeReturns := fake.setCellCordonedReturns
+	fake.recordInvocation("SetCellCordoned", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setCellCordonedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeCellDB) SetCellCordonedCallCount() int {
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	return len(fake.setCellCordonedArgsForCall)
+}
+
+func (fake *FakeCellDB) SetCellCordonedCalls(stub func(context.Context, lager.Logger, string, bool) error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = stub
+}
+
+func (fake *FakeCellDB) SetCellCordonedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	argsForCall := fake.setCellCordonedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeCellDB) SetCellCordonedReturns(result1 error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = nil
+	fake.setCellCordonedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellDB) SetCellCordonedReturnsOnCall(i int, result1 error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = nil
+	if fake.setCellCordonedReturnsOnCall == nil {
+		fake.setCellCordonedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCellCordonedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeCellDB) StartCellDrain(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, error) {
+	fake.startCellDrainMutex.Lock()
+	ret, specificReturn := fake.startCellDrainReturnsOnCall[len(fake.startCellDrainArgsForCall)]
+	fake.startCellDrainArgsForCall = append(fake.startCellDrainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.StartCellDrainStub
+	fakeReturns := fake.startCellDrainReturns
+	fake.recordInvocation("StartCellDrain", []interface{}{arg1, arg2, arg3})
+	fake.startCellDrainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCellDB) StartCellDrainCallCount() int {
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	return len(fake.startCellDrainArgsForCall)
+}
+
+func (fake *FakeCellDB) StartCellDrainCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, error)) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = stub
+}
+
+func (fake *FakeCellDB) StartCellDrainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	argsForCall := fake.startCellDrainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellDB) StartCellDrainReturns(result1 *models.CellDrain, result2 error) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = nil
+	fake.startCellDrainReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) StartCellDrainReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = nil
+	if fake.startCellDrainReturnsOnCall == nil {
+		fake.startCellDrainReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.startCellDrainReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgress(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, *models.CellDrain, error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	ret, specificReturn := fake.updateCellDrainProgressReturnsOnCall[len(fake.updateCellDrainProgressArgsForCall)]
+	fake.updateCellDrainProgressArgsForCall = append(fake.updateCellDrainProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCellDrainProgressStub
+	fakeReturns := fake.updateCellDrainProgressReturns
+	fake.recordInvocation("UpdateCellDrainProgress", []interface{}{arg1, arg2, arg3})
+	fake.updateCellDrainProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgressCallCount() int {
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	return len(fake.updateCellDrainProgressArgsForCall)
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgressCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, *models.CellDrain, error)) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = stub
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgressArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	argsForCall := fake.updateCellDrainProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgressReturns(result1 *models.CellDrain, result2 *models.CellDrain, result3 error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = nil
+	fake.updateCellDrainProgressReturns = struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCellDB) UpdateCellDrainProgressReturnsOnCall(i int, result1 *models.CellDrain, result2 *models.CellDrain, result3 error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = nil
+	if fake.updateCellDrainProgressReturnsOnCall == nil {
+		fake.updateCellDrainProgressReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 *models.CellDrain
+			result3 error
+		})
+	}
+	fake.updateCellDrainProgressReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCellDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeCellDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.CellDB = new(FakeCellDB)
@@ -13,6 +13,36 @@ import (
 )
 
 type FakeDB struct {
+	ActiveDeploymentsStub        func(context.Context, lager.Logger) ([]*models.Deployment, error)
+	activeDeploymentsMutex       sync.RWMutex
+	activeDeploymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	activeDeploymentsReturns struct {
+		result1 []*models.Deployment
+		result2 error
+	}
+	activeDeploymentsReturnsOnCall map[int]struct {
+		result1 []*models.Deployment
+		result2 error
+	}
+	ActualLRPHistoryStub        func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
 	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -43,6 +73,21 @@ type FakeDB struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	AuditRecordsStub        func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, error)
+	auditRecordsMutex       sync.RWMutex
+	auditRecordsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsReturns struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}
+	auditRecordsReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}
 	CancelTaskStub        func(context.Context, lager.Logger, string) (*models.Task, *models.Task, string, error)
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -62,6 +107,20 @@ type FakeDB struct {
 		result3 string
 		result4 error
 	}
+	CellDrainsStub        func(context.Context, lager.Logger) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
 	ChangeActualLRPPresenceStub        func(context.Context, lager.Logger, *models.ActualLRPKey, models.ActualLRP_Presence, models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error)
 	changeActualLRPPresenceMutex       sync.RWMutex
 	changeActualLRPPresenceArgsForCall []struct {
@@ -100,6 +159,54 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	ClaimDueTaskCallbacksStub        func(context.Context, lager.Logger, time.Time, time.Time, int) ([]*models.TaskCallback, error)
+	claimDueTaskCallbacksMutex       sync.RWMutex
+	claimDueTaskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+		arg4 time.Time
+		arg5 int
+	}
+	claimDueTaskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	claimDueTaskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	CompleteDeploymentStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)
+	completeDeploymentMutex       sync.RWMutex
+	completeDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	completeDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	completeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	CompleteIdempotencyKeyStub        func(context.Context, lager.Logger, string, []byte) error
+	completeIdempotencyKeyMutex       sync.RWMutex
+	completeIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []byte
+	}
+	completeIdempotencyKeyReturns struct {
+		result1 error
+	}
+	completeIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CompleteTaskStub        func(context.Context, lager.Logger, string, string, bool, string, string) (*models.Task, *models.Task, error)
 	completeTaskMutex       sync.RWMutex
 	completeTaskArgsForCall []struct {
@@ -150,6 +257,20 @@ type FakeDB struct {
 	convergeTasksReturnsOnCall map[int]struct {
 		result1 db.TaskConvergenceResult
 	}
+	CordonedCellIdsStub        func(context.Context, lager.Logger) ([]string, error)
+	cordonedCellIdsMutex       sync.RWMutex
+	cordonedCellIdsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cordonedCellIdsReturns struct {
+		result1 []string
+		result2 error
+	}
+	cordonedCellIdsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	CountActualLRPsByStateStub        func(context.Context, lager.Logger) (int, int, int, int, int)
 	countActualLRPsByStateMutex       sync.RWMutex
 	countActualLRPsByStateArgsForCall []struct {
@@ -218,6 +339,63 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	DeleteAuditRecordsBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteAuditRecordsBeforeMutex       sync.RWMutex
+	deleteAuditRecordsBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteAuditRecordsBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteAuditRecordsBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	DeleteEventsBeforeStub        func(context.Context, lager.Logger, string, uint64) error
+	deleteEventsBeforeMutex       sync.RWMutex
+	deleteEventsBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	deleteEventsBeforeReturns struct {
+		result1 error
+	}
+	deleteEventsBeforeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteIdempotencyKeysBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteIdempotencyKeysBeforeMutex       sync.RWMutex
+	deleteIdempotencyKeysBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteIdempotencyKeysBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteIdempotencyKeysBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	DeleteScheduledTaskStub        func(context.Context, lager.Logger, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(context.Context, lager.Logger, string) (*models.Task, error)
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -233,6 +411,34 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	DeleteTaskCallbackStub        func(context.Context, lager.Logger, string) error
+	deleteTaskCallbackMutex       sync.RWMutex
+	deleteTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteTaskCallbackReturns struct {
+		result1 error
+	}
+	deleteTaskCallbackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeploymentByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	DesireLRPStub        func(context.Context, lager.Logger, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -246,6 +452,21 @@ type FakeDB struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	DesireTaskStub        func(context.Context, lager.Logger, *models.TaskDefinition, string, string) (*models.Task, error)
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -355,6 +576,65 @@ type FakeDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DomainQuotaByDomainStub        func(context.Context, lager.Logger, string) (*models.DomainQuota, error)
+	domainQuotaByDomainMutex       sync.RWMutex
+	domainQuotaByDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainQuotaByDomainReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	domainQuotaByDomainReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	DueScheduledTasksStub        func(context.Context, lager.Logger, time.Time) ([]*models.ScheduledTask, error)
+	dueScheduledTasksMutex       sync.RWMutex
+	dueScheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	dueScheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	dueScheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
 	EncryptionKeyLabelStub        func(context.Context, lager.Logger) (string, error)
 	encryptionKeyLabelMutex       sync.RWMutex
 	encryptionKeyLabelArgsForCall []struct {
@@ -369,6 +649,22 @@ type FakeDB struct {
 		result1 string
 		result2 error
 	}
+	EnqueueTaskCallbackStub        func(context.Context, lager.Logger, string, time.Time) (*models.TaskCallback, error)
+	enqueueTaskCallbackMutex       sync.RWMutex
+	enqueueTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Time
+	}
+	enqueueTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	enqueueTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
 	EvacuateActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (*models.ActualLRP, error)
 	evacuateActualLRPMutex       sync.RWMutex
 	evacuateActualLRPArgsForCall []struct {
@@ -390,6 +686,38 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	EventIDAtResourceVersionStub        func(context.Context, lager.Logger, string, uint64) (uint64, error)
+	eventIDAtResourceVersionMutex       sync.RWMutex
+	eventIDAtResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	eventIDAtResourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	eventIDAtResourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	EventsSinceStub        func(context.Context, lager.Logger, string, uint64) ([]db.StoredEvent, error)
+	eventsSinceMutex       sync.RWMutex
+	eventsSinceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	eventsSinceReturns struct {
+		result1 []db.StoredEvent
+		result2 error
+	}
+	eventsSinceReturnsOnCall map[int]struct {
+		result1 []db.StoredEvent
+		result2 error
+	}
 	FailActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, string) (*models.ActualLRP, *models.ActualLRP, error)
 	failActualLRPMutex       sync.RWMutex
 	failActualLRPArgsForCall []struct {
@@ -408,6 +736,22 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	FailCellDrainStub        func(context.Context, lager.Logger, string, string) (*models.CellDrain, error)
+	failCellDrainMutex       sync.RWMutex
+	failCellDrainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	failCellDrainReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	failCellDrainReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
 	FailTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	failTaskMutex       sync.RWMutex
 	failTaskArgsForCall []struct {
@@ -440,6 +784,103 @@ type FakeDB struct {
 		result1 []string
 		result2 error
 	}
+	InsertEventStub        func(context.Context, lager.Logger, string, db.StoredEvent) error
+	insertEventMutex       sync.RWMutex
+	insertEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.StoredEvent
+	}
+	insertEventReturns struct {
+		result1 error
+	}
+	insertEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LastEventIDStub        func(context.Context, lager.Logger, string) (uint64, error)
+	lastEventIDMutex       sync.RWMutex
+	lastEventIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	lastEventIDReturns struct {
+		result1 uint64
+		result2 error
+	}
+	lastEventIDReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	ListActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)
+	listActualLRPsMutex       sync.RWMutex
+	listActualLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	listActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listActualLRPsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListDesiredLRPSchedulingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)
+	listDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	listDesiredLRPSchedulingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPSchedulingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListDesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)
+	listDesiredLRPsMutex       sync.RWMutex
+	listDesiredLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListTasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)
+	listTasksMutex       sync.RWMutex
+	listTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	listTasksReturns struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
+	listTasksReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
 	PerformBBSHealthCheckStub        func(context.Context, lager.Logger, time.Time) error
 	performBBSHealthCheckMutex       sync.RWMutex
 	performBBSHealthCheckArgsForCall []struct {
@@ -485,25 +926,116 @@ type FakeDB struct {
 		result3 *models.ActualLRP
 		result4 error
 	}
-	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
-	rejectTaskMutex       sync.RWMutex
-	rejectTaskArgsForCall []struct {
+	PruneActualLRPHistoryStub        func(context.Context, lager.Logger, int) (int64, error)
+	pruneActualLRPHistoryMutex       sync.RWMutex
+	pruneActualLRPHistoryArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
+		arg3 int
 	}
-	rejectTaskReturns struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
+	pruneActualLRPHistoryReturns struct {
+		result1 int64
+		result2 error
 	}
-	rejectTaskReturnsOnCall map[int]struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
+	pruneActualLRPHistoryReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
 	}
-	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
+	RecordActualLRPTransitionStub        func(context.Context, lager.Logger, *models.ActualLRPHistoryRecord) error
+	recordActualLRPTransitionMutex       sync.RWMutex
+	recordActualLRPTransitionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPHistoryRecord
+	}
+	recordActualLRPTransitionReturns struct {
+		result1 error
+	}
+	recordActualLRPTransitionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RecordDenialStub        func(context.Context, lager.Logger, string) error
+	recordDenialMutex       sync.RWMutex
+	recordDenialArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	recordDenialReturns struct {
+		result1 error
+	}
+	recordDenialReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RecordScheduledTaskRunStub        func(context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) (*models.ScheduledTask, error)
+	recordScheduledTaskRunMutex       sync.RWMutex
+	recordScheduledTaskRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 *models.ScheduledTaskRun
+		arg6 int64
+	}
+	recordScheduledTaskRunReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	recordScheduledTaskRunReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	RecordTaskCallbackAttemptStub        func(context.Context, lager.Logger, string, int32, string, time.Time, bool) (*models.TaskCallback, error)
+	recordTaskCallbackAttemptMutex       sync.RWMutex
+	recordTaskCallbackAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 string
+		arg6 time.Time
+		arg7 bool
+	}
+	recordTaskCallbackAttemptReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	recordTaskCallbackAttemptReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	rejectTaskReturns struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}
+	rejectTaskReturnsOnCall map[int]struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}
+	ReleaseIdempotencyKeyStub        func(context.Context, lager.Logger, string) error
+	releaseIdempotencyKeyMutex       sync.RWMutex
+	releaseIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	releaseIdempotencyKeyReturns struct {
+		result1 error
+	}
+	releaseIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
 		arg1 context.Context
@@ -531,6 +1063,19 @@ type FakeDB struct {
 	removeDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveEvacuatingActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
 	removeEvacuatingActualLRPMutex       sync.RWMutex
 	removeEvacuatingActualLRPArgsForCall []struct {
@@ -560,6 +1105,38 @@ type FakeDB struct {
 		result1 *models.ActualLRP
 		result2 error
 	}
+	ReplayTaskCallbackStub        func(context.Context, lager.Logger, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ReserveIdempotencyKeyStub        func(context.Context, lager.Logger, string, string, time.Time) (*models.IdempotencyKey, error)
+	reserveIdempotencyKeyMutex       sync.RWMutex
+	reserveIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}
+	reserveIdempotencyKeyReturns struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}
+	reserveIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}
 	ResolvingTaskStub        func(context.Context, lager.Logger, string) (*models.Task, *models.Task, error)
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -577,6 +1154,112 @@ type FakeDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	ResourceVersionStub        func(context.Context, lager.Logger) (uint64, error)
+	resourceVersionMutex       sync.RWMutex
+	resourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	resourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	resourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	RollbackDeploymentStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	ScheduledTaskByGuidStub        func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)
+	scheduledTaskByGuidMutex       sync.RWMutex
+	scheduledTaskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTaskByGuidReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	scheduledTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTasksStub        func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetCellCordonedStub        func(context.Context, lager.Logger, string, bool) error
+	setCellCordonedMutex       sync.RWMutex
+	setCellCordonedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setCellCordonedReturns struct {
+		result1 error
+	}
+	setCellCordonedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDeploymentPausedStub        func(context.Context, lager.Logger, string, bool) (*models.Deployment, error)
+	setDeploymentPausedMutex       sync.RWMutex
+	setDeploymentPausedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setDeploymentPausedReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	setDeploymentPausedReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	SetDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
 	SetEncryptionKeyLabelStub        func(context.Context, lager.Logger, string) error
 	setEncryptionKeyLabelMutex       sync.RWMutex
 	setEncryptionKeyLabelArgsForCall []struct {
@@ -590,6 +1273,22 @@ type FakeDB struct {
 	setEncryptionKeyLabelReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetScheduledTaskSuspendedStub        func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)
+	setScheduledTaskSuspendedMutex       sync.RWMutex
+	setScheduledTaskSuspendedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setScheduledTaskSuspendedReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	setScheduledTaskSuspendedReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	SetVersionStub        func(helpers.Tx, context.Context, lager.Logger, *models.Version) error
 	setVersionMutex       sync.RWMutex
 	setVersionArgsForCall []struct {
@@ -628,6 +1327,41 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	StartCellDrainStub        func(context.Context, lager.Logger, string) (*models.CellDrain, error)
+	startCellDrainMutex       sync.RWMutex
+	startCellDrainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	startCellDrainReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	startCellDrainReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	StartDeploymentStub        func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.DesiredLRP, *models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
 	StartTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, bool, error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
@@ -663,6 +1397,21 @@ type FakeDB struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskCallbacksStub        func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
 	TasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -715,6 +1464,36 @@ type FakeDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	UpdateCellDrainProgressStub        func(context.Context, lager.Logger, string) (*models.CellDrain, *models.CellDrain, error)
+	updateCellDrainProgressMutex       sync.RWMutex
+	updateCellDrainProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	updateCellDrainProgressReturns struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}
+	updateCellDrainProgressReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}
+	UpdateDeploymentProgressStub        func(context.Context, lager.Logger, *models.Deployment) error
+	updateDeploymentProgressMutex       sync.RWMutex
+	updateDeploymentProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.Deployment
+	}
+	updateDeploymentProgressReturns struct {
+		result1 error
+	}
+	updateDeploymentProgressReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) (*models.DesiredLRP, error)
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -731,6 +1510,22 @@ type FakeDB struct {
 		result1 *models.DesiredLRP
 		result2 error
 	}
+	UpdateScheduledTaskStub        func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	UpsertDomainStub        func(context.Context, lager.Logger, string, uint32) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -764,20 +1559,19 @@ type FakeDB struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
-	fake.actualLRPsMutex.Lock()
-	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
-	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+func (fake *FakeDB) ActiveDeployments(arg1 context.Context, arg2 lager.Logger) ([]*models.Deployment, error) {
+	fake.activeDeploymentsMutex.Lock()
+	ret, specificReturn := fake.activeDeploymentsReturnsOnCall[len(fake.activeDeploymentsArgsForCall)]
+	fake.activeDeploymentsArgsForCall = append(fake.activeDeploymentsArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.ActualLRPFilter
-	}{arg1, arg2, arg3})
-	stub := fake.ActualLRPsStub
-	fakeReturns := fake.actualLRPsReturns
-	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
-	fake.actualLRPsMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.ActiveDeploymentsStub
+	fakeReturns := fake.activeDeploymentsReturns
+	fake.recordInvocation("ActiveDeployments", []interface{}{arg1, arg2})
+	fake.activeDeploymentsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -785,65 +1579,198 @@ func (fake *FakeDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 mod
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) ActualLRPsCallCount() int {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	return len(fake.actualLRPsArgsForCall)
+func (fake *FakeDB) ActiveDeploymentsCallCount() int {
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	return len(fake.activeDeploymentsArgsForCall)
 }
 
-func (fake *FakeDB) ActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
-	fake.actualLRPsMutex.Lock()
-	defer fake.actualLRPsMutex.Unlock()
-	fake.ActualLRPsStub = stub
+func (fake *FakeDB) ActiveDeploymentsCalls(stub func(context.Context, lager.Logger) ([]*models.Deployment, error)) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = stub
 }
 
-func (fake *FakeDB) ActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	argsForCall := fake.actualLRPsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeDB) ActiveDeploymentsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	argsForCall := fake.activeDeploymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDB) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
-	fake.actualLRPsMutex.Lock()
-	defer fake.actualLRPsMutex.Unlock()
-	fake.ActualLRPsStub = nil
-	fake.actualLRPsReturns = struct {
-		result1 []*models.ActualLRP
+func (fake *FakeDB) ActiveDeploymentsReturns(result1 []*models.Deployment, result2 error) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = nil
+	fake.activeDeploymentsReturns = struct {
+		result1 []*models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
-	fake.actualLRPsMutex.Lock()
-	defer fake.actualLRPsMutex.Unlock()
-	fake.ActualLRPsStub = nil
-	if fake.actualLRPsReturnsOnCall == nil {
-		fake.actualLRPsReturnsOnCall = make(map[int]struct {
-			result1 []*models.ActualLRP
+func (fake *FakeDB) ActiveDeploymentsReturnsOnCall(i int, result1 []*models.Deployment, result2 error) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = nil
+	if fake.activeDeploymentsReturnsOnCall == nil {
+		fake.activeDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Deployment
 			result2 error
 		})
 	}
-	fake.actualLRPsReturnsOnCall[i] = struct {
-		result1 []*models.ActualLRP
+	fake.activeDeploymentsReturnsOnCall[i] = struct {
+		result1 []*models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) ActualLRPsByProcessGuids(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPsByProcessGuidsFilter) ([]*models.ActualLRP, error) {
-	fake.actualLRPsByProcessGuidsMutex.Lock()
-	ret, specificReturn := fake.actualLRPsByProcessGuidsReturnsOnCall[len(fake.actualLRPsByProcessGuidsArgsForCall)]
-	fake.actualLRPsByProcessGuidsArgsForCall = append(fake.actualLRPsByProcessGuidsArgsForCall, struct {
+func (fake *FakeDB) ActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.ActualLRPsByProcessGuidsFilter
-	}{arg1, arg2, arg3})
-	stub := fake.ActualLRPsByProcessGuidsStub
-	fakeReturns := fake.actualLRPsByProcessGuidsReturns
-	fake.recordInvocation("ActualLRPsByProcessGuids", []interface{}{arg1, arg2, arg3})
-	fake.actualLRPsByProcessGuidsMutex.Unlock()
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeDB) ActualLRPHistoryCalls(stub func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
+}
+
+func (fake *FakeDB) ActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
+	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsStub
+	fakeReturns := fake.actualLRPsReturns
+	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) ActualLRPsCallCount() int {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	return len(fake.actualLRPsArgsForCall)
+}
+
+func (fake *FakeDB) ActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = stub
+}
+
+func (fake *FakeDB) ActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	argsForCall := fake.actualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	fake.actualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	if fake.actualLRPsReturnsOnCall == nil {
+		fake.actualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 error
+		})
+	}
+	fake.actualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ActualLRPsByProcessGuids(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPsByProcessGuidsFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsByProcessGuidsReturnsOnCall[len(fake.actualLRPsByProcessGuidsArgsForCall)]
+	fake.actualLRPsByProcessGuidsArgsForCall = append(fake.actualLRPsByProcessGuidsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPsByProcessGuidsFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsByProcessGuidsStub
+	fakeReturns := fake.actualLRPsByProcessGuidsReturns
+	fake.recordInvocation("ActualLRPsByProcessGuids", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsByProcessGuidsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -896,6 +1823,72 @@ func (fake *FakeDB) ActualLRPsByProcessGuidsReturnsOnCall(i int, result1 []*mode
 	}{result1, result2}
 }
 
+func (fake *FakeDB) AuditRecords(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, error) {
+	fake.auditRecordsMutex.Lock()
+	ret, specificReturn := fake.auditRecordsReturnsOnCall[len(fake.auditRecordsArgsForCall)]
+	fake.auditRecordsArgsForCall = append(fake.auditRecordsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsStub
+	fakeReturns := fake.auditRecordsReturns
+	fake.recordInvocation("AuditRecords", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) AuditRecordsCallCount() int {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	return len(fake.auditRecordsArgsForCall)
+}
+
+func (fake *FakeDB) AuditRecordsCalls(stub func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, error)) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = stub
+}
+
+func (fake *FakeDB) AuditRecordsArgsForCall(i int) (context.Context, lager.Logger, models.AuditRecordFilter) {
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
+	argsForCall := fake.auditRecordsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) AuditRecordsReturns(result1 []*models.AuditRecord, result2 error) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	fake.auditRecordsReturns = struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) AuditRecordsReturnsOnCall(i int, result1 []*models.AuditRecord, result2 error) {
+	fake.auditRecordsMutex.Lock()
+	defer fake.auditRecordsMutex.Unlock()
+	fake.AuditRecordsStub = nil
+	if fake.auditRecordsReturnsOnCall == nil {
+		fake.auditRecordsReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 error
+		})
+	}
+	fake.auditRecordsReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CancelTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, string, error) {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -968,6 +1961,71 @@ func (fake *FakeDB) CancelTaskReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeDB) CellDrains(arg1 context.Context, arg2 lager.Logger) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeDB) CellDrainsCalls(stub func(context.Context, lager.Logger) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeDB) CellDrainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) ChangeActualLRPPresence(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 models.ActualLRP_Presence, arg5 models.ActualLRP_Presence) (*models.ActualLRP, *models.ActualLRP, error) {
 	fake.changeActualLRPPresenceMutex.Lock()
 	ret, specificReturn := fake.changeActualLRPPresenceReturnsOnCall[len(fake.changeActualLRPPresenceArgsForCall)]
@@ -1110,120 +2168,326 @@ func (fake *FakeDB) ClaimActualLRPReturnsOnCall(i int, result1 *models.ActualLRP
 	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) CompleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 bool, arg6 string, arg7 string) (*models.Task, *models.Task, error) {
-	fake.completeTaskMutex.Lock()
-	ret, specificReturn := fake.completeTaskReturnsOnCall[len(fake.completeTaskArgsForCall)]
-	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
+func (fake *FakeDB) ClaimDueTaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 time.Time, arg4 time.Time, arg5 int) ([]*models.TaskCallback, error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	ret, specificReturn := fake.claimDueTaskCallbacksReturnsOnCall[len(fake.claimDueTaskCallbacksArgsForCall)]
+	fake.claimDueTaskCallbacksArgsForCall = append(fake.claimDueTaskCallbacksArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
-		arg5 bool
-		arg6 string
-		arg7 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	stub := fake.CompleteTaskStub
-	fakeReturns := fake.completeTaskReturns
-	fake.recordInvocation("CompleteTask", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
-	fake.completeTaskMutex.Unlock()
+		arg3 time.Time
+		arg4 time.Time
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ClaimDueTaskCallbacksStub
+	fakeReturns := fake.claimDueTaskCallbacksReturns
+	fake.recordInvocation("ClaimDueTaskCallbacks", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.claimDueTaskCallbacksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) CompleteTaskCallCount() int {
-	fake.completeTaskMutex.RLock()
-	defer fake.completeTaskMutex.RUnlock()
-	return len(fake.completeTaskArgsForCall)
+func (fake *FakeDB) ClaimDueTaskCallbacksCallCount() int {
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	return len(fake.claimDueTaskCallbacksArgsForCall)
 }
 
-func (fake *FakeDB) CompleteTaskCalls(stub func(context.Context, lager.Logger, string, string, bool, string, string) (*models.Task, *models.Task, error)) {
-	fake.completeTaskMutex.Lock()
-	defer fake.completeTaskMutex.Unlock()
-	fake.CompleteTaskStub = stub
+func (fake *FakeDB) ClaimDueTaskCallbacksCalls(stub func(context.Context, lager.Logger, time.Time, time.Time, int) ([]*models.TaskCallback, error)) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = stub
 }
 
-func (fake *FakeDB) CompleteTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, bool, string, string) {
-	fake.completeTaskMutex.RLock()
-	defer fake.completeTaskMutex.RUnlock()
-	argsForCall := fake.completeTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+func (fake *FakeDB) ClaimDueTaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, time.Time, time.Time, int) {
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	argsForCall := fake.claimDueTaskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.completeTaskMutex.Lock()
-	defer fake.completeTaskMutex.Unlock()
-	fake.CompleteTaskStub = nil
-	fake.completeTaskReturns = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) ClaimDueTaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = nil
+	fake.claimDueTaskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) CompleteTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.completeTaskMutex.Lock()
-	defer fake.completeTaskMutex.Unlock()
-	fake.CompleteTaskStub = nil
-	if fake.completeTaskReturnsOnCall == nil {
-		fake.completeTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
-			result3 error
+func (fake *FakeDB) ClaimDueTaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = nil
+	if fake.claimDueTaskCallbacksReturnsOnCall == nil {
+		fake.claimDueTaskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
 		})
 	}
-	fake.completeTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+	fake.claimDueTaskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) ConvergeLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet) db.ConvergenceResult {
-	fake.convergeLRPsMutex.Lock()
-	ret, specificReturn := fake.convergeLRPsReturnsOnCall[len(fake.convergeLRPsArgsForCall)]
-	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
+func (fake *FakeDB) CompleteDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.completeDeploymentMutex.Lock()
+	ret, specificReturn := fake.completeDeploymentReturnsOnCall[len(fake.completeDeploymentArgsForCall)]
+	fake.completeDeploymentArgsForCall = append(fake.completeDeploymentArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.CellSet
+		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.ConvergeLRPsStub
-	fakeReturns := fake.convergeLRPsReturns
-	fake.recordInvocation("ConvergeLRPs", []interface{}{arg1, arg2, arg3})
-	fake.convergeLRPsMutex.Unlock()
+	stub := fake.CompleteDeploymentStub
+	fakeReturns := fake.completeDeploymentReturns
+	fake.recordInvocation("CompleteDeployment", []interface{}{arg1, arg2, arg3})
+	fake.completeDeploymentMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeDB) ConvergeLRPsCallCount() int {
-	fake.convergeLRPsMutex.RLock()
-	defer fake.convergeLRPsMutex.RUnlock()
-	return len(fake.convergeLRPsArgsForCall)
+func (fake *FakeDB) CompleteDeploymentCallCount() int {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	return len(fake.completeDeploymentArgsForCall)
 }
 
-func (fake *FakeDB) ConvergeLRPsCalls(stub func(context.Context, lager.Logger, models.CellSet) db.ConvergenceResult) {
-	fake.convergeLRPsMutex.Lock()
-	defer fake.convergeLRPsMutex.Unlock()
-	fake.ConvergeLRPsStub = stub
+func (fake *FakeDB) CompleteDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = stub
 }
 
-func (fake *FakeDB) ConvergeLRPsArgsForCall(i int) (context.Context, lager.Logger, models.CellSet) {
-	fake.convergeLRPsMutex.RLock()
-	defer fake.convergeLRPsMutex.RUnlock()
-	argsForCall := fake.convergeLRPsArgsForCall[i]
+func (fake *FakeDB) CompleteDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	argsForCall := fake.completeDeploymentArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) ConvergeLRPsReturns(result1 db.ConvergenceResult) {
+func (fake *FakeDB) CompleteDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = nil
+	fake.completeDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) CompleteDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = nil
+	if fake.completeDeploymentReturnsOnCall == nil {
+		fake.completeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
+			result3 error
+		})
+	}
+	fake.completeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) CompleteIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []byte) error {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.completeIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.completeIdempotencyKeyReturnsOnCall[len(fake.completeIdempotencyKeyArgsForCall)]
+	fake.completeIdempotencyKeyArgsForCall = append(fake.completeIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.CompleteIdempotencyKeyStub
+	fakeReturns := fake.completeIdempotencyKeyReturns
+	fake.recordInvocation("CompleteIdempotencyKey", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.completeIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) CompleteIdempotencyKeyCallCount() int {
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
+	return len(fake.completeIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeDB) CompleteIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string, []byte) error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = stub
+}
+
+func (fake *FakeDB) CompleteIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string, []byte) {
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.completeIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) CompleteIdempotencyKeyReturns(result1 error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = nil
+	fake.completeIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) CompleteIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = nil
+	if fake.completeIdempotencyKeyReturnsOnCall == nil {
+		fake.completeIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) CompleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 bool, arg6 string, arg7 string) (*models.Task, *models.Task, error) {
+	fake.completeTaskMutex.Lock()
+	ret, specificReturn := fake.completeTaskReturnsOnCall[len(fake.completeTaskArgsForCall)]
+	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 bool
+		arg6 string
+		arg7 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.CompleteTaskStub
+	fakeReturns := fake.completeTaskReturns
+	fake.recordInvocation("CompleteTask", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.completeTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) CompleteTaskCallCount() int {
+	fake.completeTaskMutex.RLock()
+	defer fake.completeTaskMutex.RUnlock()
+	return len(fake.completeTaskArgsForCall)
+}
+
+func (fake *FakeDB) CompleteTaskCalls(stub func(context.Context, lager.Logger, string, string, bool, string, string) (*models.Task, *models.Task, error)) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = stub
+}
+
+func (fake *FakeDB) CompleteTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, bool, string, string) {
+	fake.completeTaskMutex.RLock()
+	defer fake.completeTaskMutex.RUnlock()
+	argsForCall := fake.completeTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeDB) CompleteTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = nil
+	fake.completeTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) CompleteTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = nil
+	if fake.completeTaskReturnsOnCall == nil {
+		fake.completeTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
+		})
+	}
+	fake.completeTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ConvergeLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.CellSet) db.ConvergenceResult {
+	fake.convergeLRPsMutex.Lock()
+	ret, specificReturn := fake.convergeLRPsReturnsOnCall[len(fake.convergeLRPsArgsForCall)]
+	fake.convergeLRPsArgsForCall = append(fake.convergeLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CellSet
+	}{arg1, arg2, arg3})
+	stub := fake.ConvergeLRPsStub
+	fakeReturns := fake.convergeLRPsReturns
+	fake.recordInvocation("ConvergeLRPs", []interface{}{arg1, arg2, arg3})
+	fake.convergeLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) ConvergeLRPsCallCount() int {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	return len(fake.convergeLRPsArgsForCall)
+}
+
+func (fake *FakeDB) ConvergeLRPsCalls(stub func(context.Context, lager.Logger, models.CellSet) db.ConvergenceResult) {
+	fake.convergeLRPsMutex.Lock()
+	defer fake.convergeLRPsMutex.Unlock()
+	fake.ConvergeLRPsStub = stub
+}
+
+func (fake *FakeDB) ConvergeLRPsArgsForCall(i int) (context.Context, lager.Logger, models.CellSet) {
+	fake.convergeLRPsMutex.RLock()
+	defer fake.convergeLRPsMutex.RUnlock()
+	argsForCall := fake.convergeLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ConvergeLRPsReturns(result1 db.ConvergenceResult) {
 	fake.convergeLRPsMutex.Lock()
 	defer fake.convergeLRPsMutex.Unlock()
 	fake.ConvergeLRPsStub = nil
@@ -1312,6 +2576,71 @@ func (fake *FakeDB) ConvergeTasksReturnsOnCall(i int, result1 db.TaskConvergence
 	}{result1}
 }
 
+func (fake *FakeDB) CordonedCellIds(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
+	fake.cordonedCellIdsMutex.Lock()
+	ret, specificReturn := fake.cordonedCellIdsReturnsOnCall[len(fake.cordonedCellIdsArgsForCall)]
+	fake.cordonedCellIdsArgsForCall = append(fake.cordonedCellIdsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CordonedCellIdsStub
+	fakeReturns := fake.cordonedCellIdsReturns
+	fake.recordInvocation("CordonedCellIds", []interface{}{arg1, arg2})
+	fake.cordonedCellIdsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) CordonedCellIdsCallCount() int {
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
+	return len(fake.cordonedCellIdsArgsForCall)
+}
+
+func (fake *FakeDB) CordonedCellIdsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = stub
+}
+
+func (fake *FakeDB) CordonedCellIdsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
+	argsForCall := fake.cordonedCellIdsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) CordonedCellIdsReturns(result1 []string, result2 error) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = nil
+	fake.cordonedCellIdsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) CordonedCellIdsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.cordonedCellIdsMutex.Lock()
+	defer fake.cordonedCellIdsMutex.Unlock()
+	fake.CordonedCellIdsStub = nil
+	if fake.cordonedCellIdsReturnsOnCall == nil {
+		fake.cordonedCellIdsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.cordonedCellIdsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) CountActualLRPsByState(arg1 context.Context, arg2 lager.Logger) (int, int, int, int, int) {
 	fake.countActualLRPsByStateMutex.Lock()
 	ret, specificReturn := fake.countActualLRPsByStateReturnsOnCall[len(fake.countActualLRPsByStateArgsForCall)]
@@ -1588,18 +2917,18 @@ func (fake *FakeDB) CreateUnclaimedActualLRPReturnsOnCall(i int, result1 *models
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
-	fake.deleteTaskMutex.Lock()
-	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
-	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+func (fake *FakeDB) DeleteAuditRecordsBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteAuditRecordsBeforeReturnsOnCall[len(fake.deleteAuditRecordsBeforeArgsForCall)]
+	fake.deleteAuditRecordsBeforeArgsForCall = append(fake.deleteAuditRecordsBeforeArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
+		arg3 time.Time
 	}{arg1, arg2, arg3})
-	stub := fake.DeleteTaskStub
-	fakeReturns := fake.deleteTaskReturns
-	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
-	fake.deleteTaskMutex.Unlock()
+	stub := fake.DeleteAuditRecordsBeforeStub
+	fakeReturns := fake.deleteAuditRecordsBeforeReturns
+	fake.recordInvocation("DeleteAuditRecordsBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteAuditRecordsBeforeMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1609,65 +2938,66 @@ func (fake *FakeDB) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 str
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) DeleteTaskCallCount() int {
-	fake.deleteTaskMutex.RLock()
-	defer fake.deleteTaskMutex.RUnlock()
-	return len(fake.deleteTaskArgsForCall)
+func (fake *FakeDB) DeleteAuditRecordsBeforeCallCount() int {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	return len(fake.deleteAuditRecordsBeforeArgsForCall)
 }
 
-func (fake *FakeDB) DeleteTaskCalls(stub func(context.Context, lager.Logger, string) (*models.Task, error)) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = stub
+func (fake *FakeDB) DeleteAuditRecordsBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = stub
 }
 
-func (fake *FakeDB) DeleteTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.deleteTaskMutex.RLock()
-	defer fake.deleteTaskMutex.RUnlock()
-	argsForCall := fake.deleteTaskArgsForCall[i]
+func (fake *FakeDB) DeleteAuditRecordsBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	argsForCall := fake.deleteAuditRecordsBeforeArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = nil
-	fake.deleteTaskReturns = struct {
-		result1 *models.Task
+func (fake *FakeDB) DeleteAuditRecordsBeforeReturns(result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	fake.deleteAuditRecordsBeforeReturns = struct {
+		result1 int64
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DeleteTaskReturnsOnCall(i int, result1 *models.Task, result2 error) {
-	fake.deleteTaskMutex.Lock()
-	defer fake.deleteTaskMutex.Unlock()
-	fake.DeleteTaskStub = nil
-	if fake.deleteTaskReturnsOnCall == nil {
-		fake.deleteTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
+func (fake *FakeDB) DeleteAuditRecordsBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteAuditRecordsBeforeMutex.Lock()
+	defer fake.deleteAuditRecordsBeforeMutex.Unlock()
+	fake.DeleteAuditRecordsBeforeStub = nil
+	if fake.deleteAuditRecordsBeforeReturnsOnCall == nil {
+		fake.deleteAuditRecordsBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
 			result2 error
 		})
 	}
-	fake.deleteTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
+	fake.deleteAuditRecordsBeforeReturnsOnCall[i] = struct {
+		result1 int64
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) error {
-	fake.desireLRPMutex.Lock()
-	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
-	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
+func (fake *FakeDB) DeleteEventsBefore(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) error {
+	fake.deleteEventsBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteEventsBeforeReturnsOnCall[len(fake.deleteEventsBeforeArgsForCall)]
+	fake.deleteEventsBeforeArgsForCall = append(fake.deleteEventsBeforeArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.DesiredLRP
-	}{arg1, arg2, arg3})
-	stub := fake.DesireLRPStub
-	fakeReturns := fake.desireLRPReturns
-	fake.recordInvocation("DesireLRP", []interface{}{arg1, arg2, arg3})
-	fake.desireLRPMutex.Unlock()
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEventsBeforeStub
+	fakeReturns := fake.deleteEventsBeforeReturns
+	fake.recordInvocation("DeleteEventsBefore", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEventsBeforeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1
@@ -1675,64 +3005,62 @@ func (fake *FakeDB) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *mod
 	return fakeReturns.result1
 }
 
-func (fake *FakeDB) DesireLRPCallCount() int {
-	fake.desireLRPMutex.RLock()
-	defer fake.desireLRPMutex.RUnlock()
-	return len(fake.desireLRPArgsForCall)
+func (fake *FakeDB) DeleteEventsBeforeCallCount() int {
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	return len(fake.deleteEventsBeforeArgsForCall)
 }
 
-func (fake *FakeDB) DesireLRPCalls(stub func(context.Context, lager.Logger, *models.DesiredLRP) error) {
-	fake.desireLRPMutex.Lock()
-	defer fake.desireLRPMutex.Unlock()
-	fake.DesireLRPStub = stub
+func (fake *FakeDB) DeleteEventsBeforeCalls(stub func(context.Context, lager.Logger, string, uint64) error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = stub
 }
 
-func (fake *FakeDB) DesireLRPArgsForCall(i int) (context.Context, lager.Logger, *models.DesiredLRP) {
-	fake.desireLRPMutex.RLock()
-	defer fake.desireLRPMutex.RUnlock()
-	argsForCall := fake.desireLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeDB) DeleteEventsBeforeArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	argsForCall := fake.deleteEventsBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeDB) DesireLRPReturns(result1 error) {
-	fake.desireLRPMutex.Lock()
-	defer fake.desireLRPMutex.Unlock()
-	fake.DesireLRPStub = nil
-	fake.desireLRPReturns = struct {
+func (fake *FakeDB) DeleteEventsBeforeReturns(result1 error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = nil
+	fake.deleteEventsBeforeReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeDB) DesireLRPReturnsOnCall(i int, result1 error) {
-	fake.desireLRPMutex.Lock()
-	defer fake.desireLRPMutex.Unlock()
-	fake.DesireLRPStub = nil
-	if fake.desireLRPReturnsOnCall == nil {
-		fake.desireLRPReturnsOnCall = make(map[int]struct {
+func (fake *FakeDB) DeleteEventsBeforeReturnsOnCall(i int, result1 error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = nil
+	if fake.deleteEventsBeforeReturnsOnCall == nil {
+		fake.deleteEventsBeforeReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.desireLRPReturnsOnCall[i] = struct {
+	fake.deleteEventsBeforeReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeDB) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string) (*models.Task, error) {
-	fake.desireTaskMutex.Lock()
-	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
-	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
+func (fake *FakeDB) DeleteIdempotencyKeysBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteIdempotencyKeysBeforeReturnsOnCall[len(fake.deleteIdempotencyKeysBeforeArgsForCall)]
+	fake.deleteIdempotencyKeysBeforeArgsForCall = append(fake.deleteIdempotencyKeysBeforeArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.TaskDefinition
-		arg4 string
-		arg5 string
-	}{arg1, arg2, arg3, arg4, arg5})
-	stub := fake.DesireTaskStub
-	fakeReturns := fake.desireTaskReturns
-	fake.recordInvocation("DesireTask", []interface{}{arg1, arg2, arg3, arg4, arg5})
-	fake.desireTaskMutex.Unlock()
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteIdempotencyKeysBeforeStub
+	fakeReturns := fake.deleteIdempotencyKeysBeforeReturns
+	fake.recordInvocation("DeleteIdempotencyKeysBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteIdempotencyKeysBeforeMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1740,129 +3068,126 @@ func (fake *FakeDB) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 *mo
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) DesireTaskCallCount() int {
-	fake.desireTaskMutex.RLock()
-	defer fake.desireTaskMutex.RUnlock()
-	return len(fake.desireTaskArgsForCall)
+func (fake *FakeDB) DeleteIdempotencyKeysBeforeCallCount() int {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	return len(fake.deleteIdempotencyKeysBeforeArgsForCall)
 }
 
-func (fake *FakeDB) DesireTaskCalls(stub func(context.Context, lager.Logger, *models.TaskDefinition, string, string) (*models.Task, error)) {
-	fake.desireTaskMutex.Lock()
-	defer fake.desireTaskMutex.Unlock()
-	fake.DesireTaskStub = stub
+func (fake *FakeDB) DeleteIdempotencyKeysBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = stub
 }
 
-func (fake *FakeDB) DesireTaskArgsForCall(i int) (context.Context, lager.Logger, *models.TaskDefinition, string, string) {
-	fake.desireTaskMutex.RLock()
-	defer fake.desireTaskMutex.RUnlock()
-	argsForCall := fake.desireTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+func (fake *FakeDB) DeleteIdempotencyKeysBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	argsForCall := fake.deleteIdempotencyKeysBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesireTaskReturns(result1 *models.Task, result2 error) {
-	fake.desireTaskMutex.Lock()
-	defer fake.desireTaskMutex.Unlock()
-	fake.DesireTaskStub = nil
-	fake.desireTaskReturns = struct {
-		result1 *models.Task
+func (fake *FakeDB) DeleteIdempotencyKeysBeforeReturns(result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	fake.deleteIdempotencyKeysBeforeReturns = struct {
+		result1 int64
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesireTaskReturnsOnCall(i int, result1 *models.Task, result2 error) {
-	fake.desireTaskMutex.Lock()
-	defer fake.desireTaskMutex.Unlock()
-	fake.DesireTaskStub = nil
-	if fake.desireTaskReturnsOnCall == nil {
-		fake.desireTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
+func (fake *FakeDB) DeleteIdempotencyKeysBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	if fake.deleteIdempotencyKeysBeforeReturnsOnCall == nil {
+		fake.deleteIdempotencyKeysBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
 			result2 error
 		})
 	}
-	fake.desireTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
+	fake.deleteIdempotencyKeysBeforeReturnsOnCall[i] = struct {
+		result1 int64
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
-	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+func (fake *FakeDB) DeleteScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPByProcessGuidStub
-	fakeReturns := fake.desiredLRPByProcessGuidReturns
-	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPByProcessGuidMutex.Unlock()
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuidCallCount() int {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	return len(fake.desiredLRPByProcessGuidArgsForCall)
+func (fake *FakeDB) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = stub
+func (fake *FakeDB) DeleteScheduledTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.desiredLRPByProcessGuidMutex.RLock()
-	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
-	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+func (fake *FakeDB) DeleteScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	fake.desiredLRPByProcessGuidReturns = struct {
-		result1 *models.DesiredLRP
-		result2 error
-	}{result1, result2}
+func (fake *FakeDB) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
-	fake.desiredLRPByProcessGuidMutex.Lock()
-	defer fake.desiredLRPByProcessGuidMutex.Unlock()
-	fake.DesiredLRPByProcessGuidStub = nil
-	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
-		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
-			result1 *models.DesiredLRP
-			result2 error
+func (fake *FakeDB) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
-		result1 *models.DesiredLRP
-		result2 error
-	}{result1, result2}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
-	fake.desiredLRPRoutingInfosMutex.Lock()
-	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
-	fake.desiredLRPRoutingInfosArgsForCall = append(fake.desiredLRPRoutingInfosArgsForCall, struct {
+func (fake *FakeDB) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.DesiredLRPFilter
+		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPRoutingInfosStub
-	fakeReturns := fake.desiredLRPRoutingInfosReturns
-	fake.recordInvocation("DesiredLRPRoutingInfos", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPRoutingInfosMutex.Unlock()
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -1872,129 +3197,126 @@ func (fake *FakeDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logg
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfosCallCount() int {
-	fake.desiredLRPRoutingInfosMutex.RLock()
-	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
-	return len(fake.desiredLRPRoutingInfosArgsForCall)
+func (fake *FakeDB) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
-	fake.desiredLRPRoutingInfosMutex.Lock()
-	defer fake.desiredLRPRoutingInfosMutex.Unlock()
-	fake.DesiredLRPRoutingInfosStub = stub
+func (fake *FakeDB) DeleteTaskCalls(stub func(context.Context, lager.Logger, string) (*models.Task, error)) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
-	fake.desiredLRPRoutingInfosMutex.RLock()
-	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
-	argsForCall := fake.desiredLRPRoutingInfosArgsForCall[i]
+func (fake *FakeDB) DeleteTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfosReturns(result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPRoutingInfosMutex.Lock()
-	defer fake.desiredLRPRoutingInfosMutex.Unlock()
-	fake.DesiredLRPRoutingInfosStub = nil
-	fake.desiredLRPRoutingInfosReturns = struct {
-		result1 []*models.DesiredLRP
+func (fake *FakeDB) DeleteTaskReturns(result1 *models.Task, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 *models.Task
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPRoutingInfosReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPRoutingInfosMutex.Lock()
-	defer fake.desiredLRPRoutingInfosMutex.Unlock()
-	fake.DesiredLRPRoutingInfosStub = nil
-	if fake.desiredLRPRoutingInfosReturnsOnCall == nil {
-		fake.desiredLRPRoutingInfosReturnsOnCall = make(map[int]struct {
-			result1 []*models.DesiredLRP
+func (fake *FakeDB) DeleteTaskReturnsOnCall(i int, result1 *models.Task, result2 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
 			result2 error
 		})
 	}
-	fake.desiredLRPRoutingInfosReturnsOnCall[i] = struct {
-		result1 []*models.DesiredLRP
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRPSchedulingInfo, error) {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
-	ret, specificReturn := fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)]
-	fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall = append(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall, struct {
+func (fake *FakeDB) DeleteTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.deleteTaskCallbackReturnsOnCall[len(fake.deleteTaskCallbackArgsForCall)]
+	fake.deleteTaskCallbackArgsForCall = append(fake.deleteTaskCallbackArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPSchedulingInfoByProcessGuidStub
-	fakeReturns := fake.desiredLRPSchedulingInfoByProcessGuidReturns
-	fake.recordInvocation("DesiredLRPSchedulingInfoByProcessGuid", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	stub := fake.DeleteTaskCallbackStub
+	fakeReturns := fake.deleteTaskCallbackReturns
+	fake.recordInvocation("DeleteTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskCallbackMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidCallCount() int {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
-	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
-	return len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)
+func (fake *FakeDB) DeleteTaskCallbackCallCount() int {
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	return len(fake.deleteTaskCallbackArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)) {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
-	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
-	fake.DesiredLRPSchedulingInfoByProcessGuidStub = stub
+func (fake *FakeDB) DeleteTaskCallbackCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
-	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
-	argsForCall := fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall[i]
+func (fake *FakeDB) DeleteTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	argsForCall := fake.deleteTaskCallbackArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidReturns(result1 *models.DesiredLRPSchedulingInfo, result2 error) {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
-	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
-	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
-	fake.desiredLRPSchedulingInfoByProcessGuidReturns = struct {
-		result1 *models.DesiredLRPSchedulingInfo
-		result2 error
-	}{result1, result2}
+func (fake *FakeDB) DeleteTaskCallbackReturns(result1 error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = nil
+	fake.deleteTaskCallbackReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRPSchedulingInfo, result2 error) {
-	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
-	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
-	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
-	if fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall == nil {
-		fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall = make(map[int]struct {
-			result1 *models.DesiredLRPSchedulingInfo
-			result2 error
+func (fake *FakeDB) DeleteTaskCallbackReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = nil
+	if fake.deleteTaskCallbackReturnsOnCall == nil {
+		fake.deleteTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[i] = struct {
-		result1 *models.DesiredLRPSchedulingInfo
-		result2 error
-	}{result1, result2}
+	fake.deleteTaskCallbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
-	fake.desiredLRPSchedulingInfosMutex.Lock()
-	ret, specificReturn := fake.desiredLRPSchedulingInfosReturnsOnCall[len(fake.desiredLRPSchedulingInfosArgsForCall)]
-	fake.desiredLRPSchedulingInfosArgsForCall = append(fake.desiredLRPSchedulingInfosArgsForCall, struct {
+func (fake *FakeDB) DeploymentByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.DesiredLRPFilter
+		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPSchedulingInfosStub
-	fakeReturns := fake.desiredLRPSchedulingInfosReturns
-	fake.recordInvocation("DesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPSchedulingInfosMutex.Unlock()
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -2004,132 +3326,126 @@ func (fake *FakeDB) DesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.L
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfosCallCount() int {
-	fake.desiredLRPSchedulingInfosMutex.RLock()
-	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
-	return len(fake.desiredLRPSchedulingInfosArgsForCall)
+func (fake *FakeDB) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)) {
-	fake.desiredLRPSchedulingInfosMutex.Lock()
-	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
-	fake.DesiredLRPSchedulingInfosStub = stub
+func (fake *FakeDB) DeploymentByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
-	fake.desiredLRPSchedulingInfosMutex.RLock()
-	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
-	argsForCall := fake.desiredLRPSchedulingInfosArgsForCall[i]
+func (fake *FakeDB) DeploymentByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
-	fake.desiredLRPSchedulingInfosMutex.Lock()
-	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
-	fake.DesiredLRPSchedulingInfosStub = nil
-	fake.desiredLRPSchedulingInfosReturns = struct {
-		result1 []*models.DesiredLRPSchedulingInfo
+func (fake *FakeDB) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
-	fake.desiredLRPSchedulingInfosMutex.Lock()
-	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
-	fake.DesiredLRPSchedulingInfosStub = nil
-	if fake.desiredLRPSchedulingInfosReturnsOnCall == nil {
-		fake.desiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
-			result1 []*models.DesiredLRPSchedulingInfo
+func (fake *FakeDB) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
 			result2 error
 		})
 	}
-	fake.desiredLRPSchedulingInfosReturnsOnCall[i] = struct {
-		result1 []*models.DesiredLRPSchedulingInfo
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (models.DesiredLRP_UpdateStrategy, int32, error) {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
-	ret, specificReturn := fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall[len(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall)]
-	fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall = append(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall, struct {
+func (fake *FakeDB) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) error {
+	fake.desireLRPMutex.Lock()
+	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
+	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
+		arg3 *models.DesiredLRP
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPUpdateStrategyByProcessGuidStub
-	fakeReturns := fake.desiredLRPUpdateStrategyByProcessGuidReturns
-	fake.recordInvocation("DesiredLRPUpdateStrategyByProcessGuid", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
+	stub := fake.DesireLRPStub
+	fakeReturns := fake.desireLRPReturns
+	fake.recordInvocation("DesireLRP", []interface{}{arg1, arg2, arg3})
+	fake.desireLRPMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidCallCount() int {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.RLock()
-	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
-	return len(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall)
+func (fake *FakeDB) DesireLRPCallCount() int {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	return len(fake.desireLRPArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (models.DesiredLRP_UpdateStrategy, int32, error)) {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
-	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
-	fake.DesiredLRPUpdateStrategyByProcessGuidStub = stub
+func (fake *FakeDB) DesireLRPCalls(stub func(context.Context, lager.Logger, *models.DesiredLRP) error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.RLock()
-	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
-	argsForCall := fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall[i]
+func (fake *FakeDB) DesireLRPArgsForCall(i int) (context.Context, lager.Logger, *models.DesiredLRP) {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	argsForCall := fake.desireLRPArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidReturns(result1 models.DesiredLRP_UpdateStrategy, result2 int32, result3 error) {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
-	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
-	fake.DesiredLRPUpdateStrategyByProcessGuidStub = nil
-	fake.desiredLRPUpdateStrategyByProcessGuidReturns = struct {
-		result1 models.DesiredLRP_UpdateStrategy
-		result2 int32
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) DesireLRPReturns(result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	fake.desireLRPReturns = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidReturnsOnCall(i int, result1 models.DesiredLRP_UpdateStrategy, result2 int32, result3 error) {
-	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
-	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
-	fake.DesiredLRPUpdateStrategyByProcessGuidStub = nil
-	if fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall == nil {
-		fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall = make(map[int]struct {
-			result1 models.DesiredLRP_UpdateStrategy
-			result2 int32
-			result3 error
+func (fake *FakeDB) DesireLRPReturnsOnCall(i int, result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	if fake.desireLRPReturnsOnCall == nil {
+		fake.desireLRPReturnsOnCall = make(map[int]struct {
+			result1 error
 		})
 	}
-	fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall[i] = struct {
-		result1 models.DesiredLRP_UpdateStrategy
-		result2 int32
-		result3 error
-	}{result1, result2, result3}
+	fake.desireLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
-func (fake *FakeDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
-	fake.desiredLRPsMutex.Lock()
-	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
-	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
+func (fake *FakeDB) DesireScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 models.DesiredLRPFilter
+		arg3 *models.ScheduledTask
 	}{arg1, arg2, arg3})
-	stub := fake.DesiredLRPsStub
-	fakeReturns := fake.desiredLRPsReturns
-	fake.recordInvocation("DesiredLRPs", []interface{}{arg1, arg2, arg3})
-	fake.desiredLRPsMutex.Unlock()
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
@@ -2139,64 +3455,67 @@ func (fake *FakeDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 mo
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) DesiredLRPsCallCount() int {
-	fake.desiredLRPsMutex.RLock()
-	defer fake.desiredLRPsMutex.RUnlock()
-	return len(fake.desiredLRPsArgsForCall)
+func (fake *FakeDB) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
 }
 
-func (fake *FakeDB) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = stub
+func (fake *FakeDB) DesireScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
 }
 
-func (fake *FakeDB) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
-	fake.desiredLRPsMutex.RLock()
-	defer fake.desiredLRPsMutex.RUnlock()
-	argsForCall := fake.desiredLRPsArgsForCall[i]
+func (fake *FakeDB) DesireScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = nil
-	fake.desiredLRPsReturns = struct {
-		result1 []*models.DesiredLRP
+func (fake *FakeDB) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
-	fake.desiredLRPsMutex.Lock()
-	defer fake.desiredLRPsMutex.Unlock()
-	fake.DesiredLRPsStub = nil
-	if fake.desiredLRPsReturnsOnCall == nil {
-		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
-			result1 []*models.DesiredLRP
+func (fake *FakeDB) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
 			result2 error
 		})
 	}
-	fake.desiredLRPsReturnsOnCall[i] = struct {
-		result1 []*models.DesiredLRP
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) EncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger) (string, error) {
-	fake.encryptionKeyLabelMutex.Lock()
-	ret, specificReturn := fake.encryptionKeyLabelReturnsOnCall[len(fake.encryptionKeyLabelArgsForCall)]
-	fake.encryptionKeyLabelArgsForCall = append(fake.encryptionKeyLabelArgsForCall, struct {
+func (fake *FakeDB) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.TaskDefinition, arg4 string, arg5 string) (*models.Task, error) {
+	fake.desireTaskMutex.Lock()
+	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
+	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-	}{arg1, arg2})
-	stub := fake.EncryptionKeyLabelStub
-	fakeReturns := fake.encryptionKeyLabelReturns
-	fake.recordInvocation("EncryptionKeyLabel", []interface{}{arg1, arg2})
-	fake.encryptionKeyLabelMutex.Unlock()
+		arg3 *models.TaskDefinition
+		arg4 string
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DesireTaskStub
+	fakeReturns := fake.desireTaskReturns
+	fake.recordInvocation("DesireTask", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.desireTaskMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2204,76 +3523,65 @@ func (fake *FakeDB) EncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger)
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) EncryptionKeyLabelCallCount() int {
-	fake.encryptionKeyLabelMutex.RLock()
-	defer fake.encryptionKeyLabelMutex.RUnlock()
-	return len(fake.encryptionKeyLabelArgsForCall)
+func (fake *FakeDB) DesireTaskCallCount() int {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	return len(fake.desireTaskArgsForCall)
 }
 
-func (fake *FakeDB) EncryptionKeyLabelCalls(stub func(context.Context, lager.Logger) (string, error)) {
-	fake.encryptionKeyLabelMutex.Lock()
-	defer fake.encryptionKeyLabelMutex.Unlock()
-	fake.EncryptionKeyLabelStub = stub
+func (fake *FakeDB) DesireTaskCalls(stub func(context.Context, lager.Logger, *models.TaskDefinition, string, string) (*models.Task, error)) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = stub
 }
 
-func (fake *FakeDB) EncryptionKeyLabelArgsForCall(i int) (context.Context, lager.Logger) {
-	fake.encryptionKeyLabelMutex.RLock()
-	defer fake.encryptionKeyLabelMutex.RUnlock()
-	argsForCall := fake.encryptionKeyLabelArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDB) DesireTaskArgsForCall(i int) (context.Context, lager.Logger, *models.TaskDefinition, string, string) {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	argsForCall := fake.desireTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeDB) EncryptionKeyLabelReturns(result1 string, result2 error) {
-	fake.encryptionKeyLabelMutex.Lock()
-	defer fake.encryptionKeyLabelMutex.Unlock()
-	fake.EncryptionKeyLabelStub = nil
-	fake.encryptionKeyLabelReturns = struct {
-		result1 string
+func (fake *FakeDB) DesireTaskReturns(result1 *models.Task, result2 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	fake.desireTaskReturns = struct {
+		result1 *models.Task
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) EncryptionKeyLabelReturnsOnCall(i int, result1 string, result2 error) {
-	fake.encryptionKeyLabelMutex.Lock()
-	defer fake.encryptionKeyLabelMutex.Unlock()
-	fake.EncryptionKeyLabelStub = nil
-	if fake.encryptionKeyLabelReturnsOnCall == nil {
-		fake.encryptionKeyLabelReturnsOnCall = make(map[int]struct {
-			result1 string
+func (fake *FakeDB) DesireTaskReturnsOnCall(i int, result1 *models.Task, result2 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	if fake.desireTaskReturnsOnCall == nil {
+		fake.desireTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
 			result2 error
 		})
 	}
-	fake.encryptionKeyLabelReturnsOnCall[i] = struct {
-		result1 string
+	fake.desireTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) EvacuateActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) (*models.ActualLRP, error) {
-	var arg6Copy []*models.ActualLRPInternalRoute
-	if arg6 != nil {
-		arg6Copy = make([]*models.ActualLRPInternalRoute, len(arg6))
-		copy(arg6Copy, arg6)
-	}
-	fake.evacuateActualLRPMutex.Lock()
-	ret, specificReturn := fake.evacuateActualLRPReturnsOnCall[len(fake.evacuateActualLRPArgsForCall)]
-	fake.evacuateActualLRPArgsForCall = append(fake.evacuateActualLRPArgsForCall, struct {
+func (fake *FakeDB) DesiredLRPByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
+	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.ActualLRPKey
-		arg4 *models.ActualLRPInstanceKey
-		arg5 *models.ActualLRPNetInfo
-		arg6 []*models.ActualLRPInternalRoute
-		arg7 map[string]string
-		arg8 bool
-		arg9 string
-	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
-	stub := fake.EvacuateActualLRPStub
-	fakeReturns := fake.evacuateActualLRPReturns
-	fake.recordInvocation("EvacuateActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
-	fake.evacuateActualLRPMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPByProcessGuidStub
+	fakeReturns := fake.desiredLRPByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPByProcessGuidMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2281,204 +3589,2665 @@ func (fake *FakeDB) EvacuateActualLRP(arg1 context.Context, arg2 lager.Logger, a
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) EvacuateActualLRPCallCount() int {
-	fake.evacuateActualLRPMutex.RLock()
-	defer fake.evacuateActualLRPMutex.RUnlock()
-	return len(fake.evacuateActualLRPArgsForCall)
+func (fake *FakeDB) DesiredLRPByProcessGuidCallCount() int {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPByProcessGuidArgsForCall)
 }
 
-func (fake *FakeDB) EvacuateActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (*models.ActualLRP, error)) {
-	fake.evacuateActualLRPMutex.Lock()
-	defer fake.evacuateActualLRPMutex.Unlock()
-	fake.EvacuateActualLRPStub = stub
-}
+func (fake *FakeDB) DesiredLRPByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = stub
+}
 
-func (fake *FakeDB) EvacuateActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) {
-	fake.evacuateActualLRPMutex.RLock()
-	defer fake.evacuateActualLRPMutex.RUnlock()
-	argsForCall := fake.evacuateActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+func (fake *FakeDB) DesiredLRPByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) EvacuateActualLRPReturns(result1 *models.ActualLRP, result2 error) {
-	fake.evacuateActualLRPMutex.Lock()
-	defer fake.evacuateActualLRPMutex.Unlock()
-	fake.EvacuateActualLRPStub = nil
-	fake.evacuateActualLRPReturns = struct {
-		result1 *models.ActualLRP
+func (fake *FakeDB) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	fake.desiredLRPByProcessGuidReturns = struct {
+		result1 *models.DesiredLRP
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) EvacuateActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 error) {
-	fake.evacuateActualLRPMutex.Lock()
-	defer fake.evacuateActualLRPMutex.Unlock()
-	fake.EvacuateActualLRPStub = nil
-	if fake.evacuateActualLRPReturnsOnCall == nil {
-		fake.evacuateActualLRPReturnsOnCall = make(map[int]struct {
-			result1 *models.ActualLRP
+func (fake *FakeDB) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
 			result2 error
 		})
 	}
-	fake.evacuateActualLRPReturnsOnCall[i] = struct {
-		result1 *models.ActualLRP
+	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) FailActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 string) (*models.ActualLRP, *models.ActualLRP, error) {
-	fake.failActualLRPMutex.Lock()
-	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
-	fake.failActualLRPArgsForCall = append(fake.failActualLRPArgsForCall, struct {
+func (fake *FakeDB) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
+	fake.desiredLRPRoutingInfosArgsForCall = append(fake.desiredLRPRoutingInfosArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.ActualLRPKey
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.FailActualLRPStub
-	fakeReturns := fake.failActualLRPReturns
-	fake.recordInvocation("FailActualLRP", []interface{}{arg1, arg2, arg3, arg4})
-	fake.failActualLRPMutex.Unlock()
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRoutingInfosStub
+	fakeReturns := fake.desiredLRPRoutingInfosReturns
+	fake.recordInvocation("DesiredLRPRoutingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRoutingInfosMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) FailActualLRPCallCount() int {
-	fake.failActualLRPMutex.RLock()
-	defer fake.failActualLRPMutex.RUnlock()
-	return len(fake.failActualLRPArgsForCall)
+func (fake *FakeDB) DesiredLRPRoutingInfosCallCount() int {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	return len(fake.desiredLRPRoutingInfosArgsForCall)
 }
 
-func (fake *FakeDB) FailActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, string) (*models.ActualLRP, *models.ActualLRP, error)) {
-	fake.failActualLRPMutex.Lock()
-	defer fake.failActualLRPMutex.Unlock()
-	fake.FailActualLRPStub = stub
+func (fake *FakeDB) DesiredLRPRoutingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = stub
 }
 
-func (fake *FakeDB) FailActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, string) {
-	fake.failActualLRPMutex.RLock()
-	defer fake.failActualLRPMutex.RUnlock()
-	argsForCall := fake.failActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeDB) DesiredLRPRoutingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPRoutingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) FailActualLRPReturns(result1 *models.ActualLRP, result2 *models.ActualLRP, result3 error) {
-	fake.failActualLRPMutex.Lock()
-	defer fake.failActualLRPMutex.Unlock()
-	fake.FailActualLRPStub = nil
-	fake.failActualLRPReturns = struct {
-		result1 *models.ActualLRP
-		result2 *models.ActualLRP
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) DesiredLRPRoutingInfosReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	fake.desiredLRPRoutingInfosReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) FailActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 *models.ActualLRP, result3 error) {
-	fake.failActualLRPMutex.Lock()
-	defer fake.failActualLRPMutex.Unlock()
-	fake.FailActualLRPStub = nil
-	if fake.failActualLRPReturnsOnCall == nil {
-		fake.failActualLRPReturnsOnCall = make(map[int]struct {
-			result1 *models.ActualLRP
-			result2 *models.ActualLRP
-			result3 error
+func (fake *FakeDB) DesiredLRPRoutingInfosReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	if fake.desiredLRPRoutingInfosReturnsOnCall == nil {
+		fake.desiredLRPRoutingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
 		})
 	}
-	fake.failActualLRPReturnsOnCall[i] = struct {
-		result1 *models.ActualLRP
-		result2 *models.ActualLRP
-		result3 error
-	}{result1, result2, result3}
+	fake.desiredLRPRoutingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) FailTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
-	fake.failTaskMutex.Lock()
-	ret, specificReturn := fake.failTaskReturnsOnCall[len(fake.failTaskArgsForCall)]
-	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)]
+	fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall = append(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.FailTaskStub
-	fakeReturns := fake.failTaskReturns
-	fake.recordInvocation("FailTask", []interface{}{arg1, arg2, arg3, arg4})
-	fake.failTaskMutex.Unlock()
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfoByProcessGuidStub
+	fakeReturns := fake.desiredLRPSchedulingInfoByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfoByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) FailTaskCallCount() int {
-	fake.failTaskMutex.RLock()
-	defer fake.failTaskMutex.RUnlock()
-	return len(fake.failTaskArgsForCall)
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidCallCount() int {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)
 }
 
-func (fake *FakeDB) FailTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
-	fake.failTaskMutex.Lock()
-	defer fake.failTaskMutex.Unlock()
-	fake.FailTaskStub = stub
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = stub
 }
 
-func (fake *FakeDB) FailTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
-	fake.failTaskMutex.RLock()
-	defer fake.failTaskMutex.RUnlock()
-	argsForCall := fake.failTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.failTaskMutex.Lock()
-	defer fake.failTaskMutex.Unlock()
-	fake.FailTaskStub = nil
-	fake.failTaskReturns = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidReturns(result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	fake.desiredLRPSchedulingInfoByProcessGuidReturns = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) FailTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.failTaskMutex.Lock()
-	defer fake.failTaskMutex.Unlock()
-	fake.FailTaskStub = nil
-	if fake.failTaskReturnsOnCall == nil {
-		fake.failTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
-			result3 error
+func (fake *FakeDB) DesiredLRPSchedulingInfoByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	if fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfosReturnsOnCall[len(fake.desiredLRPSchedulingInfosArgsForCall)]
+	fake.desiredLRPSchedulingInfosArgsForCall = append(fake.desiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfosStub
+	fakeReturns := fake.desiredLRPSchedulingInfosReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfosCallCount() int {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	fake.desiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	if fake.desiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (models.DesiredLRP_UpdateStrategy, int32, error) {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall[len(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall)]
+	fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall = append(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPUpdateStrategyByProcessGuidStub
+	fakeReturns := fake.desiredLRPUpdateStrategyByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPUpdateStrategyByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidCallCount() int {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.RLock()
+	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (models.DesiredLRP_UpdateStrategy, int32, error)) {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
+	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
+	fake.DesiredLRPUpdateStrategyByProcessGuidStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.RLock()
+	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPUpdateStrategyByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidReturns(result1 models.DesiredLRP_UpdateStrategy, result2 int32, result3 error) {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
+	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
+	fake.DesiredLRPUpdateStrategyByProcessGuidStub = nil
+	fake.desiredLRPUpdateStrategyByProcessGuidReturns = struct {
+		result1 models.DesiredLRP_UpdateStrategy
+		result2 int32
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) DesiredLRPUpdateStrategyByProcessGuidReturnsOnCall(i int, result1 models.DesiredLRP_UpdateStrategy, result2 int32, result3 error) {
+	fake.desiredLRPUpdateStrategyByProcessGuidMutex.Lock()
+	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.Unlock()
+	fake.DesiredLRPUpdateStrategyByProcessGuidStub = nil
+	if fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 models.DesiredLRP_UpdateStrategy
+			result2 int32
+			result3 error
+		})
+	}
+	fake.desiredLRPUpdateStrategyByProcessGuidReturnsOnCall[i] = struct {
+		result1 models.DesiredLRP_UpdateStrategy
+		result2 int32
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
+	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsStub
+	fakeReturns := fake.desiredLRPsReturns
+	fake.recordInvocation("DesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DesiredLRPsCallCount() int {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	return len(fake.desiredLRPsArgsForCall)
+}
+
+func (fake *FakeDB) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = stub
+}
+
+func (fake *FakeDB) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	argsForCall := fake.desiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	fake.desiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	if fake.desiredLRPsReturnsOnCall == nil {
+		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotaByDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainQuota, error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	ret, specificReturn := fake.domainQuotaByDomainReturnsOnCall[len(fake.domainQuotaByDomainArgsForCall)]
+	fake.domainQuotaByDomainArgsForCall = append(fake.domainQuotaByDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainQuotaByDomainStub
+	fakeReturns := fake.domainQuotaByDomainReturns
+	fake.recordInvocation("DomainQuotaByDomain", []interface{}{arg1, arg2, arg3})
+	fake.domainQuotaByDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainQuotaByDomainCallCount() int {
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	return len(fake.domainQuotaByDomainArgsForCall)
+}
+
+func (fake *FakeDB) DomainQuotaByDomainCalls(stub func(context.Context, lager.Logger, string) (*models.DomainQuota, error)) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = stub
+}
+
+func (fake *FakeDB) DomainQuotaByDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	argsForCall := fake.domainQuotaByDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DomainQuotaByDomainReturns(result1 *models.DomainQuota, result2 error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = nil
+	fake.domainQuotaByDomainReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotaByDomainReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = nil
+	if fake.domainQuotaByDomainReturnsOnCall == nil {
+		fake.domainQuotaByDomainReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotaByDomainReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeDB) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeDB) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeDB) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeDB) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DueScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) ([]*models.ScheduledTask, error) {
+	fake.dueScheduledTasksMutex.Lock()
+	ret, specificReturn := fake.dueScheduledTasksReturnsOnCall[len(fake.dueScheduledTasksArgsForCall)]
+	fake.dueScheduledTasksArgsForCall = append(fake.dueScheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DueScheduledTasksStub
+	fakeReturns := fake.dueScheduledTasksReturns
+	fake.recordInvocation("DueScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.dueScheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) DueScheduledTasksCallCount() int {
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
+	return len(fake.dueScheduledTasksArgsForCall)
+}
+
+func (fake *FakeDB) DueScheduledTasksCalls(stub func(context.Context, lager.Logger, time.Time) ([]*models.ScheduledTask, error)) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = stub
+}
+
+func (fake *FakeDB) DueScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
+	argsForCall := fake.dueScheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) DueScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = nil
+	fake.dueScheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) DueScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = nil
+	if fake.dueScheduledTasksReturnsOnCall == nil {
+		fake.dueScheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.dueScheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger) (string, error) {
+	fake.encryptionKeyLabelMutex.Lock()
+	ret, specificReturn := fake.encryptionKeyLabelReturnsOnCall[len(fake.encryptionKeyLabelArgsForCall)]
+	fake.encryptionKeyLabelArgsForCall = append(fake.encryptionKeyLabelArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.EncryptionKeyLabelStub
+	fakeReturns := fake.encryptionKeyLabelReturns
+	fake.recordInvocation("EncryptionKeyLabel", []interface{}{arg1, arg2})
+	fake.encryptionKeyLabelMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EncryptionKeyLabelCallCount() int {
+	fake.encryptionKeyLabelMutex.RLock()
+	defer fake.encryptionKeyLabelMutex.RUnlock()
+	return len(fake.encryptionKeyLabelArgsForCall)
+}
+
+func (fake *FakeDB) EncryptionKeyLabelCalls(stub func(context.Context, lager.Logger) (string, error)) {
+	fake.encryptionKeyLabelMutex.Lock()
+	defer fake.encryptionKeyLabelMutex.Unlock()
+	fake.EncryptionKeyLabelStub = stub
+}
+
+func (fake *FakeDB) EncryptionKeyLabelArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.encryptionKeyLabelMutex.RLock()
+	defer fake.encryptionKeyLabelMutex.RUnlock()
+	argsForCall := fake.encryptionKeyLabelArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) EncryptionKeyLabelReturns(result1 string, result2 error) {
+	fake.encryptionKeyLabelMutex.Lock()
+	defer fake.encryptionKeyLabelMutex.Unlock()
+	fake.EncryptionKeyLabelStub = nil
+	fake.encryptionKeyLabelReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EncryptionKeyLabelReturnsOnCall(i int, result1 string, result2 error) {
+	fake.encryptionKeyLabelMutex.Lock()
+	defer fake.encryptionKeyLabelMutex.Unlock()
+	fake.EncryptionKeyLabelStub = nil
+	if fake.encryptionKeyLabelReturnsOnCall == nil {
+		fake.encryptionKeyLabelReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.encryptionKeyLabelReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EnqueueTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Time) (*models.TaskCallback, error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.enqueueTaskCallbackReturnsOnCall[len(fake.enqueueTaskCallbackArgsForCall)]
+	fake.enqueueTaskCallbackArgsForCall = append(fake.enqueueTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EnqueueTaskCallbackStub
+	fakeReturns := fake.enqueueTaskCallbackReturns
+	fake.recordInvocation("EnqueueTaskCallback", []interface{}{arg1, arg2, arg3, arg4})
+	fake.enqueueTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EnqueueTaskCallbackCallCount() int {
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
+	return len(fake.enqueueTaskCallbackArgsForCall)
+}
+
+func (fake *FakeDB) EnqueueTaskCallbackCalls(stub func(context.Context, lager.Logger, string, time.Time) (*models.TaskCallback, error)) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = stub
+}
+
+func (fake *FakeDB) EnqueueTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string, time.Time) {
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
+	argsForCall := fake.enqueueTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) EnqueueTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = nil
+	fake.enqueueTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EnqueueTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = nil
+	if fake.enqueueTaskCallbackReturnsOnCall == nil {
+		fake.enqueueTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.enqueueTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EvacuateActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) (*models.ActualLRP, error) {
+	var arg6Copy []*models.ActualLRPInternalRoute
+	if arg6 != nil {
+		arg6Copy = make([]*models.ActualLRPInternalRoute, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.evacuateActualLRPMutex.Lock()
+	ret, specificReturn := fake.evacuateActualLRPReturnsOnCall[len(fake.evacuateActualLRPArgsForCall)]
+	fake.evacuateActualLRPArgsForCall = append(fake.evacuateActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 *models.ActualLRPNetInfo
+		arg6 []*models.ActualLRPInternalRoute
+		arg7 map[string]string
+		arg8 bool
+		arg9 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	stub := fake.EvacuateActualLRPStub
+	fakeReturns := fake.evacuateActualLRPReturns
+	fake.recordInvocation("EvacuateActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	fake.evacuateActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EvacuateActualLRPCallCount() int {
+	fake.evacuateActualLRPMutex.RLock()
+	defer fake.evacuateActualLRPMutex.RUnlock()
+	return len(fake.evacuateActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) EvacuateActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (*models.ActualLRP, error)) {
+	fake.evacuateActualLRPMutex.Lock()
+	defer fake.evacuateActualLRPMutex.Unlock()
+	fake.EvacuateActualLRPStub = stub
+}
+
+func (fake *FakeDB) EvacuateActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) {
+	fake.evacuateActualLRPMutex.RLock()
+	defer fake.evacuateActualLRPMutex.RUnlock()
+	argsForCall := fake.evacuateActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+}
+
+func (fake *FakeDB) EvacuateActualLRPReturns(result1 *models.ActualLRP, result2 error) {
+	fake.evacuateActualLRPMutex.Lock()
+	defer fake.evacuateActualLRPMutex.Unlock()
+	fake.EvacuateActualLRPStub = nil
+	fake.evacuateActualLRPReturns = struct {
+		result1 *models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EvacuateActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 error) {
+	fake.evacuateActualLRPMutex.Lock()
+	defer fake.evacuateActualLRPMutex.Unlock()
+	fake.EvacuateActualLRPStub = nil
+	if fake.evacuateActualLRPReturnsOnCall == nil {
+		fake.evacuateActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRP
+			result2 error
+		})
+	}
+	fake.evacuateActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EventIDAtResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) (uint64, error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	ret, specificReturn := fake.eventIDAtResourceVersionReturnsOnCall[len(fake.eventIDAtResourceVersionArgsForCall)]
+	fake.eventIDAtResourceVersionArgsForCall = append(fake.eventIDAtResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EventIDAtResourceVersionStub
+	fakeReturns := fake.eventIDAtResourceVersionReturns
+	fake.recordInvocation("EventIDAtResourceVersion", []interface{}{arg1, arg2, arg3, arg4})
+	fake.eventIDAtResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EventIDAtResourceVersionCallCount() int {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	return len(fake.eventIDAtResourceVersionArgsForCall)
+}
+
+func (fake *FakeDB) EventIDAtResourceVersionCalls(stub func(context.Context, lager.Logger, string, uint64) (uint64, error)) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = stub
+}
+
+func (fake *FakeDB) EventIDAtResourceVersionArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	argsForCall := fake.eventIDAtResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) EventIDAtResourceVersionReturns(result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	fake.eventIDAtResourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EventIDAtResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	if fake.eventIDAtResourceVersionReturnsOnCall == nil {
+		fake.eventIDAtResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.eventIDAtResourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EventsSince(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) ([]db.StoredEvent, error) {
+	fake.eventsSinceMutex.Lock()
+	ret, specificReturn := fake.eventsSinceReturnsOnCall[len(fake.eventsSinceArgsForCall)]
+	fake.eventsSinceArgsForCall = append(fake.eventsSinceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EventsSinceStub
+	fakeReturns := fake.eventsSinceReturns
+	fake.recordInvocation("EventsSince", []interface{}{arg1, arg2, arg3, arg4})
+	fake.eventsSinceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) EventsSinceCallCount() int {
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
+	return len(fake.eventsSinceArgsForCall)
+}
+
+func (fake *FakeDB) EventsSinceCalls(stub func(context.Context, lager.Logger, string, uint64) ([]db.StoredEvent, error)) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = stub
+}
+
+func (fake *FakeDB) EventsSinceArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
+	argsForCall := fake.eventsSinceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) EventsSinceReturns(result1 []db.StoredEvent, result2 error) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = nil
+	fake.eventsSinceReturns = struct {
+		result1 []db.StoredEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) EventsSinceReturnsOnCall(i int, result1 []db.StoredEvent, result2 error) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = nil
+	if fake.eventsSinceReturnsOnCall == nil {
+		fake.eventsSinceReturnsOnCall = make(map[int]struct {
+			result1 []db.StoredEvent
+			result2 error
+		})
+	}
+	fake.eventsSinceReturnsOnCall[i] = struct {
+		result1 []db.StoredEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FailActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 string) (*models.ActualLRP, *models.ActualLRP, error) {
+	fake.failActualLRPMutex.Lock()
+	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
+	fake.failActualLRPArgsForCall = append(fake.failActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailActualLRPStub
+	fakeReturns := fake.failActualLRPReturns
+	fake.recordInvocation("FailActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) FailActualLRPCallCount() int {
+	fake.failActualLRPMutex.RLock()
+	defer fake.failActualLRPMutex.RUnlock()
+	return len(fake.failActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) FailActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, string) (*models.ActualLRP, *models.ActualLRP, error)) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = stub
+}
+
+func (fake *FakeDB) FailActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, string) {
+	fake.failActualLRPMutex.RLock()
+	defer fake.failActualLRPMutex.RUnlock()
+	argsForCall := fake.failActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) FailActualLRPReturns(result1 *models.ActualLRP, result2 *models.ActualLRP, result3 error) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = nil
+	fake.failActualLRPReturns = struct {
+		result1 *models.ActualLRP
+		result2 *models.ActualLRP
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) FailActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 *models.ActualLRP, result3 error) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = nil
+	if fake.failActualLRPReturnsOnCall == nil {
+		fake.failActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRP
+			result2 *models.ActualLRP
+			result3 error
+		})
+	}
+	fake.failActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRP
+		result2 *models.ActualLRP
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) FailCellDrain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.CellDrain, error) {
+	fake.failCellDrainMutex.Lock()
+	ret, specificReturn := fake.failCellDrainReturnsOnCall[len(fake.failCellDrainArgsForCall)]
+	fake.failCellDrainArgsForCall = append(fake.failCellDrainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailCellDrainStub
+	fakeReturns := fake.failCellDrainReturns
+	fake.recordInvocation("FailCellDrain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failCellDrainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) FailCellDrainCallCount() int {
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
+	return len(fake.failCellDrainArgsForCall)
+}
+
+func (fake *FakeDB) FailCellDrainCalls(stub func(context.Context, lager.Logger, string, string) (*models.CellDrain, error)) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = stub
+}
+
+func (fake *FakeDB) FailCellDrainArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
+	argsForCall := fake.failCellDrainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) FailCellDrainReturns(result1 *models.CellDrain, result2 error) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = nil
+	fake.failCellDrainReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FailCellDrainReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.failCellDrainMutex.Lock()
+	defer fake.failCellDrainMutex.Unlock()
+	fake.FailCellDrainStub = nil
+	if fake.failCellDrainReturnsOnCall == nil {
+		fake.failCellDrainReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.failCellDrainReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FailTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
+	fake.failTaskMutex.Lock()
+	ret, specificReturn := fake.failTaskReturnsOnCall[len(fake.failTaskArgsForCall)]
+	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailTaskStub
+	fakeReturns := fake.failTaskReturns
+	fake.recordInvocation("FailTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) FailTaskCallCount() int {
+	fake.failTaskMutex.RLock()
+	defer fake.failTaskMutex.RUnlock()
+	return len(fake.failTaskArgsForCall)
+}
+
+func (fake *FakeDB) FailTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = stub
+}
+
+func (fake *FakeDB) FailTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.failTaskMutex.RLock()
+	defer fake.failTaskMutex.RUnlock()
+	argsForCall := fake.failTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) FailTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = nil
+	fake.failTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) FailTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = nil
+	if fake.failTaskReturnsOnCall == nil {
+		fake.failTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
+		})
+	}
+	fake.failTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) FreshDomains(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
+	fake.freshDomainsMutex.Lock()
+	ret, specificReturn := fake.freshDomainsReturnsOnCall[len(fake.freshDomainsArgsForCall)]
+	fake.freshDomainsArgsForCall = append(fake.freshDomainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.FreshDomainsStub
+	fakeReturns := fake.freshDomainsReturns
+	fake.recordInvocation("FreshDomains", []interface{}{arg1, arg2})
+	fake.freshDomainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) FreshDomainsCallCount() int {
+	fake.freshDomainsMutex.RLock()
+	defer fake.freshDomainsMutex.RUnlock()
+	return len(fake.freshDomainsArgsForCall)
+}
+
+func (fake *FakeDB) FreshDomainsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
+	fake.freshDomainsMutex.Lock()
+	defer fake.freshDomainsMutex.Unlock()
+	fake.FreshDomainsStub = stub
+}
+
+func (fake *FakeDB) FreshDomainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.freshDomainsMutex.RLock()
+	defer fake.freshDomainsMutex.RUnlock()
+	argsForCall := fake.freshDomainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) FreshDomainsReturns(result1 []string, result2 error) {
+	fake.freshDomainsMutex.Lock()
+	defer fake.freshDomainsMutex.Unlock()
+	fake.FreshDomainsStub = nil
+	fake.freshDomainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) FreshDomainsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.freshDomainsMutex.Lock()
+	defer fake.freshDomainsMutex.Unlock()
+	fake.FreshDomainsStub = nil
+	if fake.freshDomainsReturnsOnCall == nil {
+		fake.freshDomainsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.freshDomainsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) InsertEvent(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 db.StoredEvent) error {
+	fake.insertEventMutex.Lock()
+	ret, specificReturn := fake.insertEventReturnsOnCall[len(fake.insertEventArgsForCall)]
+	fake.insertEventArgsForCall = append(fake.insertEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.StoredEvent
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.InsertEventStub
+	fakeReturns := fake.insertEventReturns
+	fake.recordInvocation("InsertEvent", []interface{}{arg1, arg2, arg3, arg4})
+	fake.insertEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) InsertEventCallCount() int {
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	return len(fake.insertEventArgsForCall)
+}
+
+func (fake *FakeDB) InsertEventCalls(stub func(context.Context, lager.Logger, string, db.StoredEvent) error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = stub
+}
+
+func (fake *FakeDB) InsertEventArgsForCall(i int) (context.Context, lager.Logger, string, db.StoredEvent) {
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	argsForCall := fake.insertEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) InsertEventReturns(result1 error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = nil
+	fake.insertEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) InsertEventReturnsOnCall(i int, result1 error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = nil
+	if fake.insertEventReturnsOnCall == nil {
+		fake.insertEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) LastEventID(arg1 context.Context, arg2 lager.Logger, arg3 string) (uint64, error) {
+	fake.lastEventIDMutex.Lock()
+	ret, specificReturn := fake.lastEventIDReturnsOnCall[len(fake.lastEventIDArgsForCall)]
+	fake.lastEventIDArgsForCall = append(fake.lastEventIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LastEventIDStub
+	fakeReturns := fake.lastEventIDReturns
+	fake.recordInvocation("LastEventID", []interface{}{arg1, arg2, arg3})
+	fake.lastEventIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) LastEventIDCallCount() int {
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	return len(fake.lastEventIDArgsForCall)
+}
+
+func (fake *FakeDB) LastEventIDCalls(stub func(context.Context, lager.Logger, string) (uint64, error)) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = stub
+}
+
+func (fake *FakeDB) LastEventIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	argsForCall := fake.lastEventIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) LastEventIDReturns(result1 uint64, result2 error) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	fake.lastEventIDReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) LastEventIDReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	if fake.lastEventIDReturnsOnCall == nil {
+		fake.lastEventIDReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.lastEventIDReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ListActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error) {
+	fake.listActualLRPsMutex.Lock()
+	ret, specificReturn := fake.listActualLRPsReturnsOnCall[len(fake.listActualLRPsArgsForCall)]
+	fake.listActualLRPsArgsForCall = append(fake.listActualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListActualLRPsStub
+	fakeReturns := fake.listActualLRPsReturns
+	fake.recordInvocation("ListActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listActualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ListActualLRPsCallCount() int {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	return len(fake.listActualLRPsArgsForCall)
+}
+
+func (fake *FakeDB) ListActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = stub
+}
+
+func (fake *FakeDB) ListActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	argsForCall := fake.listActualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ListActualLRPsReturns(result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	fake.listActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	if fake.listActualLRPsReturnsOnCall == nil {
+		fake.listActualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listActualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPSchedulingInfosReturnsOnCall[len(fake.listDesiredLRPSchedulingInfosArgsForCall)]
+	fake.listDesiredLRPSchedulingInfosArgsForCall = append(fake.listDesiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPSchedulingInfosStub
+	fakeReturns := fake.listDesiredLRPSchedulingInfosReturns
+	fake.recordInvocation("ListDesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfosCallCount() int {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.listDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	fake.listDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListDesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	if fake.listDesiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.listDesiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListDesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error) {
+	fake.listDesiredLRPsMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPsReturnsOnCall[len(fake.listDesiredLRPsArgsForCall)]
+	fake.listDesiredLRPsArgsForCall = append(fake.listDesiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPsStub
+	fakeReturns := fake.listDesiredLRPsReturns
+	fake.recordInvocation("ListDesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ListDesiredLRPsCallCount() int {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	return len(fake.listDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeDB) ListDesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = stub
+}
+
+func (fake *FakeDB) ListDesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ListDesiredLRPsReturns(result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	fake.listDesiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListDesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	if fake.listDesiredLRPsReturnsOnCall == nil {
+		fake.listDesiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, db.ListMetadata, error) {
+	fake.listTasksMutex.Lock()
+	ret, specificReturn := fake.listTasksReturnsOnCall[len(fake.listTasksArgsForCall)]
+	fake.listTasksArgsForCall = append(fake.listTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListTasksStub
+	fakeReturns := fake.listTasksReturns
+	fake.recordInvocation("ListTasks", []interface{}{arg1, arg2, arg3})
+	fake.listTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) ListTasksCallCount() int {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	return len(fake.listTasksArgsForCall)
+}
+
+func (fake *FakeDB) ListTasksCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = stub
+}
+
+func (fake *FakeDB) ListTasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	argsForCall := fake.listTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ListTasksReturns(result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	fake.listTasksReturns = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ListTasksReturnsOnCall(i int, result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	if fake.listTasksReturnsOnCall == nil {
+		fake.listTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listTasksReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) PerformBBSHealthCheck(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) error {
+	fake.performBBSHealthCheckMutex.Lock()
+	ret, specificReturn := fake.performBBSHealthCheckReturnsOnCall[len(fake.performBBSHealthCheckArgsForCall)]
+	fake.performBBSHealthCheckArgsForCall = append(fake.performBBSHealthCheckArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.PerformBBSHealthCheckStub
+	fakeReturns := fake.performBBSHealthCheckReturns
+	fake.recordInvocation("PerformBBSHealthCheck", []interface{}{arg1, arg2, arg3})
+	fake.performBBSHealthCheckMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) PerformBBSHealthCheckCallCount() int {
+	fake.performBBSHealthCheckMutex.RLock()
+	defer fake.performBBSHealthCheckMutex.RUnlock()
+	return len(fake.performBBSHealthCheckArgsForCall)
+}
+
+func (fake *FakeDB) PerformBBSHealthCheckCalls(stub func(context.Context, lager.Logger, time.Time) error) {
+	fake.performBBSHealthCheckMutex.Lock()
+	defer fake.performBBSHealthCheckMutex.Unlock()
+	fake.PerformBBSHealthCheckStub = stub
+}
+
+func (fake *FakeDB) PerformBBSHealthCheckArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.performBBSHealthCheckMutex.RLock()
+	defer fake.performBBSHealthCheckMutex.RUnlock()
+	argsForCall := fake.performBBSHealthCheckArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) PerformBBSHealthCheckReturns(result1 error) {
+	fake.performBBSHealthCheckMutex.Lock()
+	defer fake.performBBSHealthCheckMutex.Unlock()
+	fake.PerformBBSHealthCheckStub = nil
+	fake.performBBSHealthCheckReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) PerformBBSHealthCheckReturnsOnCall(i int, result1 error) {
+	fake.performBBSHealthCheckMutex.Lock()
+	defer fake.performBBSHealthCheckMutex.Unlock()
+	fake.PerformBBSHealthCheckStub = nil
+	if fake.performBBSHealthCheckReturnsOnCall == nil {
+		fake.performBBSHealthCheckReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.performBBSHealthCheckReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) PerformEncryption(arg1 context.Context, arg2 lager.Logger) error {
+	fake.performEncryptionMutex.Lock()
+	ret, specificReturn := fake.performEncryptionReturnsOnCall[len(fake.performEncryptionArgsForCall)]
+	fake.performEncryptionArgsForCall = append(fake.performEncryptionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PerformEncryptionStub
+	fakeReturns := fake.performEncryptionReturns
+	fake.recordInvocation("PerformEncryption", []interface{}{arg1, arg2})
+	fake.performEncryptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) PerformEncryptionCallCount() int {
+	fake.performEncryptionMutex.RLock()
+	defer fake.performEncryptionMutex.RUnlock()
+	return len(fake.performEncryptionArgsForCall)
+}
+
+func (fake *FakeDB) PerformEncryptionCalls(stub func(context.Context, lager.Logger) error) {
+	fake.performEncryptionMutex.Lock()
+	defer fake.performEncryptionMutex.Unlock()
+	fake.PerformEncryptionStub = stub
+}
+
+func (fake *FakeDB) PerformEncryptionArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.performEncryptionMutex.RLock()
+	defer fake.performEncryptionMutex.RUnlock()
+	argsForCall := fake.performEncryptionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDB) PerformEncryptionReturns(result1 error) {
+	fake.performEncryptionMutex.Lock()
+	defer fake.performEncryptionMutex.Unlock()
+	fake.PerformEncryptionStub = nil
+	fake.performEncryptionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) PerformEncryptionReturnsOnCall(i int, result1 error) {
+	fake.performEncryptionMutex.Lock()
+	defer fake.performEncryptionMutex.Unlock()
+	fake.PerformEncryptionStub = nil
+	if fake.performEncryptionReturnsOnCall == nil {
+		fake.performEncryptionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.performEncryptionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.ActualLRP, *models.ActualLRP, *models.ActualLRP, error) {
+	fake.promoteSuspectActualLRPMutex.Lock()
+	ret, specificReturn := fake.promoteSuspectActualLRPReturnsOnCall[len(fake.promoteSuspectActualLRPArgsForCall)]
+	fake.promoteSuspectActualLRPArgsForCall = append(fake.promoteSuspectActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.PromoteSuspectActualLRPStub
+	fakeReturns := fake.promoteSuspectActualLRPReturns
+	fake.recordInvocation("PromoteSuspectActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.promoteSuspectActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRPCallCount() int {
+	fake.promoteSuspectActualLRPMutex.RLock()
+	defer fake.promoteSuspectActualLRPMutex.RUnlock()
+	return len(fake.promoteSuspectActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRPCalls(stub func(context.Context, lager.Logger, string, int32) (*models.ActualLRP, *models.ActualLRP, *models.ActualLRP, error)) {
+	fake.promoteSuspectActualLRPMutex.Lock()
+	defer fake.promoteSuspectActualLRPMutex.Unlock()
+	fake.PromoteSuspectActualLRPStub = stub
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.promoteSuspectActualLRPMutex.RLock()
+	defer fake.promoteSuspectActualLRPMutex.RUnlock()
+	argsForCall := fake.promoteSuspectActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRPReturns(result1 *models.ActualLRP, result2 *models.ActualLRP, result3 *models.ActualLRP, result4 error) {
+	fake.promoteSuspectActualLRPMutex.Lock()
+	defer fake.promoteSuspectActualLRPMutex.Unlock()
+	fake.PromoteSuspectActualLRPStub = nil
+	fake.promoteSuspectActualLRPReturns = struct {
+		result1 *models.ActualLRP
+		result2 *models.ActualLRP
+		result3 *models.ActualLRP
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeDB) PromoteSuspectActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 *models.ActualLRP, result3 *models.ActualLRP, result4 error) {
+	fake.promoteSuspectActualLRPMutex.Lock()
+	defer fake.promoteSuspectActualLRPMutex.Unlock()
+	fake.PromoteSuspectActualLRPStub = nil
+	if fake.promoteSuspectActualLRPReturnsOnCall == nil {
+		fake.promoteSuspectActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRP
+			result2 *models.ActualLRP
+			result3 *models.ActualLRP
+			result4 error
+		})
+	}
+	fake.promoteSuspectActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRP
+		result2 *models.ActualLRP
+		result3 *models.ActualLRP
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeDB) PruneActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 int) (int64, error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.pruneActualLRPHistoryReturnsOnCall[len(fake.pruneActualLRPHistoryArgsForCall)]
+	fake.pruneActualLRPHistoryArgsForCall = append(fake.pruneActualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 int
+	}{arg1, arg2, arg3})
+	stub := fake.PruneActualLRPHistoryStub
+	fakeReturns := fake.pruneActualLRPHistoryReturns
+	fake.recordInvocation("PruneActualLRPHistory", []interface{}{arg1, arg2, arg3})
+	fake.pruneActualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) PruneActualLRPHistoryCallCount() int {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	return len(fake.pruneActualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeDB) PruneActualLRPHistoryCalls(stub func(context.Context, lager.Logger, int) (int64, error)) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = stub
+}
+
+func (fake *FakeDB) PruneActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, int) {
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.pruneActualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) PruneActualLRPHistoryReturns(result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	fake.pruneActualLRPHistoryReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) PruneActualLRPHistoryReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.pruneActualLRPHistoryMutex.Lock()
+	defer fake.pruneActualLRPHistoryMutex.Unlock()
+	fake.PruneActualLRPHistoryStub = nil
+	if fake.pruneActualLRPHistoryReturnsOnCall == nil {
+		fake.pruneActualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.pruneActualLRPHistoryReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RecordActualLRPTransition(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPHistoryRecord) error {
+	fake.recordActualLRPTransitionMutex.Lock()
+	ret, specificReturn := fake.recordActualLRPTransitionReturnsOnCall[len(fake.recordActualLRPTransitionArgsForCall)]
+	fake.recordActualLRPTransitionArgsForCall = append(fake.recordActualLRPTransitionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPHistoryRecord
+	}{arg1, arg2, arg3})
+	stub := fake.RecordActualLRPTransitionStub
+	fakeReturns := fake.recordActualLRPTransitionReturns
+	fake.recordInvocation("RecordActualLRPTransition", []interface{}{arg1, arg2, arg3})
+	fake.recordActualLRPTransitionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RecordActualLRPTransitionCallCount() int {
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	return len(fake.recordActualLRPTransitionArgsForCall)
+}
+
+func (fake *FakeDB) RecordActualLRPTransitionCalls(stub func(context.Context, lager.Logger, *models.ActualLRPHistoryRecord) error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = stub
+}
+
+func (fake *FakeDB) RecordActualLRPTransitionArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPHistoryRecord) {
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	argsForCall := fake.recordActualLRPTransitionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RecordActualLRPTransitionReturns(result1 error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = nil
+	fake.recordActualLRPTransitionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordActualLRPTransitionReturnsOnCall(i int, result1 error) {
+	fake.recordActualLRPTransitionMutex.Lock()
+	defer fake.recordActualLRPTransitionMutex.Unlock()
+	fake.RecordActualLRPTransitionStub = nil
+	if fake.recordActualLRPTransitionReturnsOnCall == nil {
+		fake.recordActualLRPTransitionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordActualLRPTransitionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordDenial(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.recordDenialMutex.Lock()
+	ret, specificReturn := fake.recordDenialReturnsOnCall[len(fake.recordDenialArgsForCall)]
+	fake.recordDenialArgsForCall = append(fake.recordDenialArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RecordDenialStub
+	fakeReturns := fake.recordDenialReturns
+	fake.recordInvocation("RecordDenial", []interface{}{arg1, arg2, arg3})
+	fake.recordDenialMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RecordDenialCallCount() int {
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	return len(fake.recordDenialArgsForCall)
+}
+
+func (fake *FakeDB) RecordDenialCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = stub
+}
+
+func (fake *FakeDB) RecordDenialArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	argsForCall := fake.recordDenialArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RecordDenialReturns(result1 error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = nil
+	fake.recordDenialReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordDenialReturnsOnCall(i int, result1 error) {
+	fake.recordDenialMutex.Lock()
+	defer fake.recordDenialMutex.Unlock()
+	fake.RecordDenialStub = nil
+	if fake.recordDenialReturnsOnCall == nil {
+		fake.recordDenialReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordDenialReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RecordScheduledTaskRun(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int64, arg5 *models.ScheduledTaskRun, arg6 int64) (*models.ScheduledTask, error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	ret, specificReturn := fake.recordScheduledTaskRunReturnsOnCall[len(fake.recordScheduledTaskRunArgsForCall)]
+	fake.recordScheduledTaskRunArgsForCall = append(fake.recordScheduledTaskRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 *models.ScheduledTaskRun
+		arg6 int64
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.RecordScheduledTaskRunStub
+	fakeReturns := fake.recordScheduledTaskRunReturns
+	fake.recordInvocation("RecordScheduledTaskRun", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordScheduledTaskRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunCallCount() int {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	return len(fake.recordScheduledTaskRunArgsForCall)
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunCalls(stub func(context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) (*models.ScheduledTask, error)) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = stub
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunArgsForCall(i int) (context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	argsForCall := fake.recordScheduledTaskRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	fake.recordScheduledTaskRunReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RecordScheduledTaskRunReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	if fake.recordScheduledTaskRunReturnsOnCall == nil {
+		fake.recordScheduledTaskRunReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.recordScheduledTaskRunReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttempt(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 string, arg6 time.Time, arg7 bool) (*models.TaskCallback, error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	ret, specificReturn := fake.recordTaskCallbackAttemptReturnsOnCall[len(fake.recordTaskCallbackAttemptArgsForCall)]
+	fake.recordTaskCallbackAttemptArgsForCall = append(fake.recordTaskCallbackAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 string
+		arg6 time.Time
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.RecordTaskCallbackAttemptStub
+	fakeReturns := fake.recordTaskCallbackAttemptReturns
+	fake.recordInvocation("RecordTaskCallbackAttempt", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordTaskCallbackAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttemptCallCount() int {
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
+	return len(fake.recordTaskCallbackAttemptArgsForCall)
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttemptCalls(stub func(context.Context, lager.Logger, string, int32, string, time.Time, bool) (*models.TaskCallback, error)) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = stub
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttemptArgsForCall(i int) (context.Context, lager.Logger, string, int32, string, time.Time, bool) {
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
+	argsForCall := fake.recordTaskCallbackAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttemptReturns(result1 *models.TaskCallback, result2 error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = nil
+	fake.recordTaskCallbackAttemptReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RecordTaskCallbackAttemptReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = nil
+	if fake.recordTaskCallbackAttemptReturnsOnCall == nil {
+		fake.recordTaskCallbackAttemptReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.recordTaskCallbackAttemptReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
+	fake.rejectTaskMutex.Lock()
+	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RejectTaskStub
+	fakeReturns := fake.rejectTaskReturns
+	fake.recordInvocation("RejectTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rejectTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeDB) RejectTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = stub
+}
+
+func (fake *FakeDB) RejectTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	argsForCall := fake.rejectTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	if fake.rejectTaskReturnsOnCall == nil {
+		fake.rejectTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
+		})
+	}
+	fake.rejectTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.releaseIdempotencyKeyReturnsOnCall[len(fake.releaseIdempotencyKeyArgsForCall)]
+	fake.releaseIdempotencyKeyArgsForCall = append(fake.releaseIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseIdempotencyKeyStub
+	fakeReturns := fake.releaseIdempotencyKeyReturns
+	fake.recordInvocation("ReleaseIdempotencyKey", []interface{}{arg1, arg2, arg3})
+	fake.releaseIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKeyCallCount() int {
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
+	return len(fake.releaseIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = stub
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.releaseIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKeyReturns(result1 error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = nil
+	fake.releaseIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) ReleaseIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = nil
+	if fake.releaseIdempotencyKeyReturnsOnCall == nil {
+		fake.releaseIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
+	fake.removeActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
+	fake.removeActualLRPArgsForCall = append(fake.removeActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.RemoveActualLRPStub
+	fakeReturns := fake.removeActualLRPReturns
+	fake.recordInvocation("RemoveActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.removeActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RemoveActualLRPCallCount() int {
+	fake.removeActualLRPMutex.RLock()
+	defer fake.removeActualLRPMutex.RUnlock()
+	return len(fake.removeActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) RemoveActualLRPCalls(stub func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = stub
+}
+
+func (fake *FakeDB) RemoveActualLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) {
+	fake.removeActualLRPMutex.RLock()
+	defer fake.removeActualLRPMutex.RUnlock()
+	argsForCall := fake.removeActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeDB) RemoveActualLRPReturns(result1 error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = nil
+	fake.removeActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveActualLRPReturnsOnCall(i int, result1 error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = nil
+	if fake.removeActualLRPReturnsOnCall == nil {
+		fake.removeActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
+	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDesiredLRPStub
+	fakeReturns := fake.removeDesiredLRPReturns
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{arg1, arg2, arg3})
+	fake.removeDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RemoveDesiredLRPCallCount() int {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	return len(fake.removeDesiredLRPArgsForCall)
+}
+
+func (fake *FakeDB) RemoveDesiredLRPCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = stub
+}
+
+func (fake *FakeDB) RemoveDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	argsForCall := fake.removeDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RemoveDesiredLRPReturns(result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	fake.removeDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	if fake.removeDesiredLRPReturnsOnCall == nil {
+		fake.removeDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDB) RemoveDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeDB) RemoveDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeEvacuatingActualLRPReturnsOnCall[len(fake.removeEvacuatingActualLRPArgsForCall)]
+	fake.removeEvacuatingActualLRPArgsForCall = append(fake.removeEvacuatingActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveEvacuatingActualLRPStub
+	fakeReturns := fake.removeEvacuatingActualLRPReturns
+	fake.recordInvocation("RemoveEvacuatingActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeEvacuatingActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRPCallCount() int {
+	fake.removeEvacuatingActualLRPMutex.RLock()
+	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	return len(fake.removeEvacuatingActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = stub
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.removeEvacuatingActualLRPMutex.RLock()
+	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	argsForCall := fake.removeEvacuatingActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRPReturns(result1 error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = nil
+	fake.removeEvacuatingActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveEvacuatingActualLRPReturnsOnCall(i int, result1 error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = nil
+	if fake.removeEvacuatingActualLRPReturnsOnCall == nil {
+		fake.removeEvacuatingActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeEvacuatingActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) (*models.ActualLRP, error) {
+	fake.removeSuspectActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeSuspectActualLRPReturnsOnCall[len(fake.removeSuspectActualLRPArgsForCall)]
+	fake.removeSuspectActualLRPArgsForCall = append(fake.removeSuspectActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveSuspectActualLRPStub
+	fakeReturns := fake.removeSuspectActualLRPReturns
+	fake.recordInvocation("RemoveSuspectActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.removeSuspectActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRPCallCount() int {
+	fake.removeSuspectActualLRPMutex.RLock()
+	defer fake.removeSuspectActualLRPMutex.RUnlock()
+	return len(fake.removeSuspectActualLRPArgsForCall)
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey) (*models.ActualLRP, error)) {
+	fake.removeSuspectActualLRPMutex.Lock()
+	defer fake.removeSuspectActualLRPMutex.Unlock()
+	fake.RemoveSuspectActualLRPStub = stub
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey) {
+	fake.removeSuspectActualLRPMutex.RLock()
+	defer fake.removeSuspectActualLRPMutex.RUnlock()
+	argsForCall := fake.removeSuspectActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRPReturns(result1 *models.ActualLRP, result2 error) {
+	fake.removeSuspectActualLRPMutex.Lock()
+	defer fake.removeSuspectActualLRPMutex.Unlock()
+	fake.RemoveSuspectActualLRPStub = nil
+	fake.removeSuspectActualLRPReturns = struct {
+		result1 *models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) RemoveSuspectActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 error) {
+	fake.removeSuspectActualLRPMutex.Lock()
+	defer fake.removeSuspectActualLRPMutex.Unlock()
+	fake.RemoveSuspectActualLRPStub = nil
+	if fake.removeSuspectActualLRPReturnsOnCall == nil {
+		fake.removeSuspectActualLRPReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRP
+			result2 error
 		})
 	}
-	fake.failTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+	fake.removeSuspectActualLRPReturnsOnCall[i] = struct {
+		result1 *models.ActualLRP
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) FreshDomains(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
-	fake.freshDomainsMutex.Lock()
-	ret, specificReturn := fake.freshDomainsReturnsOnCall[len(fake.freshDomainsArgsForCall)]
-	fake.freshDomainsArgsForCall = append(fake.freshDomainsArgsForCall, struct {
+func (fake *FakeDB) ReplayTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-	}{arg1, arg2})
-	stub := fake.FreshDomainsStub
-	fakeReturns := fake.freshDomainsReturns
-	fake.recordInvocation("FreshDomains", []interface{}{arg1, arg2})
-	fake.freshDomainsMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -2486,264 +6255,267 @@ func (fake *FakeDB) FreshDomains(arg1 context.Context, arg2 lager.Logger) ([]str
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) FreshDomainsCallCount() int {
-	fake.freshDomainsMutex.RLock()
-	defer fake.freshDomainsMutex.RUnlock()
-	return len(fake.freshDomainsArgsForCall)
+func (fake *FakeDB) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
 }
 
-func (fake *FakeDB) FreshDomainsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
-	fake.freshDomainsMutex.Lock()
-	defer fake.freshDomainsMutex.Unlock()
-	fake.FreshDomainsStub = stub
+func (fake *FakeDB) ReplayTaskCallbackCalls(stub func(context.Context, lager.Logger, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
 }
 
-func (fake *FakeDB) FreshDomainsArgsForCall(i int) (context.Context, lager.Logger) {
-	fake.freshDomainsMutex.RLock()
-	defer fake.freshDomainsMutex.RUnlock()
-	argsForCall := fake.freshDomainsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDB) ReplayTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) FreshDomainsReturns(result1 []string, result2 error) {
-	fake.freshDomainsMutex.Lock()
-	defer fake.freshDomainsMutex.Unlock()
-	fake.FreshDomainsStub = nil
-	fake.freshDomainsReturns = struct {
-		result1 []string
+func (fake *FakeDB) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) FreshDomainsReturnsOnCall(i int, result1 []string, result2 error) {
-	fake.freshDomainsMutex.Lock()
-	defer fake.freshDomainsMutex.Unlock()
-	fake.FreshDomainsStub = nil
-	if fake.freshDomainsReturnsOnCall == nil {
-		fake.freshDomainsReturnsOnCall = make(map[int]struct {
-			result1 []string
+func (fake *FakeDB) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
 			result2 error
 		})
 	}
-	fake.freshDomainsReturnsOnCall[i] = struct {
-		result1 []string
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) PerformBBSHealthCheck(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) error {
-	fake.performBBSHealthCheckMutex.Lock()
-	ret, specificReturn := fake.performBBSHealthCheckReturnsOnCall[len(fake.performBBSHealthCheckArgsForCall)]
-	fake.performBBSHealthCheckArgsForCall = append(fake.performBBSHealthCheckArgsForCall, struct {
+func (fake *FakeDB) ReserveIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 time.Time) (*models.IdempotencyKey, error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.reserveIdempotencyKeyReturnsOnCall[len(fake.reserveIdempotencyKeyArgsForCall)]
+	fake.reserveIdempotencyKeyArgsForCall = append(fake.reserveIdempotencyKeyArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 time.Time
-	}{arg1, arg2, arg3})
-	stub := fake.PerformBBSHealthCheckStub
-	fakeReturns := fake.performBBSHealthCheckReturns
-	fake.recordInvocation("PerformBBSHealthCheck", []interface{}{arg1, arg2, arg3})
-	fake.performBBSHealthCheckMutex.Unlock()
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ReserveIdempotencyKeyStub
+	fakeReturns := fake.reserveIdempotencyKeyReturns
+	fake.recordInvocation("ReserveIdempotencyKey", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.reserveIdempotencyKeyMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) PerformBBSHealthCheckCallCount() int {
-	fake.performBBSHealthCheckMutex.RLock()
-	defer fake.performBBSHealthCheckMutex.RUnlock()
-	return len(fake.performBBSHealthCheckArgsForCall)
+func (fake *FakeDB) ReserveIdempotencyKeyCallCount() int {
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
+	return len(fake.reserveIdempotencyKeyArgsForCall)
 }
 
-func (fake *FakeDB) PerformBBSHealthCheckCalls(stub func(context.Context, lager.Logger, time.Time) error) {
-	fake.performBBSHealthCheckMutex.Lock()
-	defer fake.performBBSHealthCheckMutex.Unlock()
-	fake.PerformBBSHealthCheckStub = stub
+func (fake *FakeDB) ReserveIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string, string, time.Time) (*models.IdempotencyKey, error)) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = stub
 }
 
-func (fake *FakeDB) PerformBBSHealthCheckArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
-	fake.performBBSHealthCheckMutex.RLock()
-	defer fake.performBBSHealthCheckMutex.RUnlock()
-	argsForCall := fake.performBBSHealthCheckArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeDB) ReserveIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string, string, time.Time) {
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.reserveIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
-func (fake *FakeDB) PerformBBSHealthCheckReturns(result1 error) {
-	fake.performBBSHealthCheckMutex.Lock()
-	defer fake.performBBSHealthCheckMutex.Unlock()
-	fake.PerformBBSHealthCheckStub = nil
-	fake.performBBSHealthCheckReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeDB) ReserveIdempotencyKeyReturns(result1 *models.IdempotencyKey, result2 error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = nil
+	fake.reserveIdempotencyKeyReturns = struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) PerformBBSHealthCheckReturnsOnCall(i int, result1 error) {
-	fake.performBBSHealthCheckMutex.Lock()
-	defer fake.performBBSHealthCheckMutex.Unlock()
-	fake.PerformBBSHealthCheckStub = nil
-	if fake.performBBSHealthCheckReturnsOnCall == nil {
-		fake.performBBSHealthCheckReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeDB) ReserveIdempotencyKeyReturnsOnCall(i int, result1 *models.IdempotencyKey, result2 error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = nil
+	if fake.reserveIdempotencyKeyReturnsOnCall == nil {
+		fake.reserveIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 *models.IdempotencyKey
+			result2 error
 		})
 	}
-	fake.performBBSHealthCheckReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.reserveIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) PerformEncryption(arg1 context.Context, arg2 lager.Logger) error {
-	fake.performEncryptionMutex.Lock()
-	ret, specificReturn := fake.performEncryptionReturnsOnCall[len(fake.performEncryptionArgsForCall)]
-	fake.performEncryptionArgsForCall = append(fake.performEncryptionArgsForCall, struct {
+func (fake *FakeDB) ResolvingTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, error) {
+	fake.resolvingTaskMutex.Lock()
+	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
+	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-	}{arg1, arg2})
-	stub := fake.PerformEncryptionStub
-	fakeReturns := fake.performEncryptionReturns
-	fake.recordInvocation("PerformEncryption", []interface{}{arg1, arg2})
-	fake.performEncryptionMutex.Unlock()
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolvingTaskStub
+	fakeReturns := fake.resolvingTaskReturns
+	fake.recordInvocation("ResolvingTask", []interface{}{arg1, arg2, arg3})
+	fake.resolvingTaskMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2, ret.result3
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeDB) PerformEncryptionCallCount() int {
-	fake.performEncryptionMutex.RLock()
-	defer fake.performEncryptionMutex.RUnlock()
-	return len(fake.performEncryptionArgsForCall)
+func (fake *FakeDB) ResolvingTaskCallCount() int {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	return len(fake.resolvingTaskArgsForCall)
 }
 
-func (fake *FakeDB) PerformEncryptionCalls(stub func(context.Context, lager.Logger) error) {
-	fake.performEncryptionMutex.Lock()
-	defer fake.performEncryptionMutex.Unlock()
-	fake.PerformEncryptionStub = stub
+func (fake *FakeDB) ResolvingTaskCalls(stub func(context.Context, lager.Logger, string) (*models.Task, *models.Task, error)) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = stub
 }
 
-func (fake *FakeDB) PerformEncryptionArgsForCall(i int) (context.Context, lager.Logger) {
-	fake.performEncryptionMutex.RLock()
-	defer fake.performEncryptionMutex.RUnlock()
-	argsForCall := fake.performEncryptionArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+func (fake *FakeDB) ResolvingTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	argsForCall := fake.resolvingTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) PerformEncryptionReturns(result1 error) {
-	fake.performEncryptionMutex.Lock()
-	defer fake.performEncryptionMutex.Unlock()
-	fake.PerformEncryptionStub = nil
-	fake.performEncryptionReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	fake.resolvingTaskReturns = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) PerformEncryptionReturnsOnCall(i int, result1 error) {
-	fake.performEncryptionMutex.Lock()
-	defer fake.performEncryptionMutex.Unlock()
-	fake.PerformEncryptionStub = nil
-	if fake.performEncryptionReturnsOnCall == nil {
-		fake.performEncryptionReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeDB) ResolvingTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 *models.Task
+			result3 error
 		})
 	}
-	fake.performEncryptionReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 *models.Task
+		result3 error
+	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) PromoteSuspectActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) (*models.ActualLRP, *models.ActualLRP, *models.ActualLRP, error) {
-	fake.promoteSuspectActualLRPMutex.Lock()
-	ret, specificReturn := fake.promoteSuspectActualLRPReturnsOnCall[len(fake.promoteSuspectActualLRPArgsForCall)]
-	fake.promoteSuspectActualLRPArgsForCall = append(fake.promoteSuspectActualLRPArgsForCall, struct {
+func (fake *FakeDB) ResourceVersion(arg1 context.Context, arg2 lager.Logger) (uint64, error) {
+	fake.resourceVersionMutex.Lock()
+	ret, specificReturn := fake.resourceVersionReturnsOnCall[len(fake.resourceVersionArgsForCall)]
+	fake.resourceVersionArgsForCall = append(fake.resourceVersionArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 int32
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.PromoteSuspectActualLRPStub
-	fakeReturns := fake.promoteSuspectActualLRPReturns
-	fake.recordInvocation("PromoteSuspectActualLRP", []interface{}{arg1, arg2, arg3, arg4})
-	fake.promoteSuspectActualLRPMutex.Unlock()
+	}{arg1, arg2})
+	stub := fake.ResourceVersionStub
+	fakeReturns := fake.resourceVersionReturns
+	fake.recordInvocation("ResourceVersion", []interface{}{arg1, arg2})
+	fake.resourceVersionMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3, ret.result4
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
-}
-
-func (fake *FakeDB) PromoteSuspectActualLRPCallCount() int {
-	fake.promoteSuspectActualLRPMutex.RLock()
-	defer fake.promoteSuspectActualLRPMutex.RUnlock()
-	return len(fake.promoteSuspectActualLRPArgsForCall)
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) PromoteSuspectActualLRPCalls(stub func(context.Context, lager.Logger, string, int32) (*models.ActualLRP, *models.ActualLRP, *models.ActualLRP, error)) {
-	fake.promoteSuspectActualLRPMutex.Lock()
-	defer fake.promoteSuspectActualLRPMutex.Unlock()
-	fake.PromoteSuspectActualLRPStub = stub
+func (fake *FakeDB) ResourceVersionCallCount() int {
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	return len(fake.resourceVersionArgsForCall)
 }
 
-func (fake *FakeDB) PromoteSuspectActualLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
-	fake.promoteSuspectActualLRPMutex.RLock()
-	defer fake.promoteSuspectActualLRPMutex.RUnlock()
-	argsForCall := fake.promoteSuspectActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeDB) ResourceVersionCalls(stub func(context.Context, lager.Logger) (uint64, error)) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = stub
 }
 
-func (fake *FakeDB) PromoteSuspectActualLRPReturns(result1 *models.ActualLRP, result2 *models.ActualLRP, result3 *models.ActualLRP, result4 error) {
-	fake.promoteSuspectActualLRPMutex.Lock()
-	defer fake.promoteSuspectActualLRPMutex.Unlock()
-	fake.PromoteSuspectActualLRPStub = nil
-	fake.promoteSuspectActualLRPReturns = struct {
-		result1 *models.ActualLRP
-		result2 *models.ActualLRP
-		result3 *models.ActualLRP
-		result4 error
-	}{result1, result2, result3, result4}
+func (fake *FakeDB) ResourceVersionArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	argsForCall := fake.resourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeDB) PromoteSuspectActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 *models.ActualLRP, result3 *models.ActualLRP, result4 error) {
-	fake.promoteSuspectActualLRPMutex.Lock()
-	defer fake.promoteSuspectActualLRPMutex.Unlock()
-	fake.PromoteSuspectActualLRPStub = nil
-	if fake.promoteSuspectActualLRPReturnsOnCall == nil {
-		fake.promoteSuspectActualLRPReturnsOnCall = make(map[int]struct {
-			result1 *models.ActualLRP
-			result2 *models.ActualLRP
-			result3 *models.ActualLRP
-			result4 error
+func (fake *FakeDB) ResourceVersionReturns(result1 uint64, result2 error) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = nil
+	fake.resourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) ResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = nil
+	if fake.resourceVersionReturnsOnCall == nil {
+		fake.resourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
 		})
 	}
-	fake.promoteSuspectActualLRPReturnsOnCall[i] = struct {
-		result1 *models.ActualLRP
-		result2 *models.ActualLRP
-		result3 *models.ActualLRP
-		result4 error
-	}{result1, result2, result3, result4}
+	fake.resourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
-	fake.rejectTaskMutex.Lock()
-	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
-	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+func (fake *FakeDB) RollbackDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
-	stub := fake.RejectTaskStub
-	fakeReturns := fake.rejectTaskReturns
-	fake.recordInvocation("RejectTask", []interface{}{arg1, arg2, arg3, arg4})
-	fake.rejectTaskMutex.Unlock()
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -2751,195 +6523,199 @@ func (fake *FakeDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 str
 	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
 }
 
-func (fake *FakeDB) RejectTaskCallCount() int {
-	fake.rejectTaskMutex.RLock()
-	defer fake.rejectTaskMutex.RUnlock()
-	return len(fake.rejectTaskArgsForCall)
+func (fake *FakeDB) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
 }
 
-func (fake *FakeDB) RejectTaskCalls(stub func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = stub
+func (fake *FakeDB) RollbackDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
 }
 
-func (fake *FakeDB) RejectTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
-	fake.rejectTaskMutex.RLock()
-	defer fake.rejectTaskMutex.RUnlock()
-	argsForCall := fake.rejectTaskArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+func (fake *FakeDB) RollbackDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) RejectTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = nil
-	fake.rejectTaskReturns = struct {
-		result1 *models.Task
-		result2 *models.Task
+func (fake *FakeDB) RollbackDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) RejectTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.rejectTaskMutex.Lock()
-	defer fake.rejectTaskMutex.Unlock()
-	fake.RejectTaskStub = nil
-	if fake.rejectTaskReturnsOnCall == nil {
-		fake.rejectTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
+func (fake *FakeDB) RollbackDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
 			result3 error
 		})
 	}
-	fake.rejectTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
-	fake.removeActualLRPMutex.Lock()
-	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
-	fake.removeActualLRPArgsForCall = append(fake.removeActualLRPArgsForCall, struct {
+func (fake *FakeDB) ScheduledTaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.ScheduledTask, error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.scheduledTaskByGuidReturnsOnCall[len(fake.scheduledTaskByGuidArgsForCall)]
+	fake.scheduledTaskByGuidArgsForCall = append(fake.scheduledTaskByGuidArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
-		arg4 int32
-		arg5 *models.ActualLRPInstanceKey
-	}{arg1, arg2, arg3, arg4, arg5})
-	stub := fake.RemoveActualLRPStub
-	fakeReturns := fake.removeActualLRPReturns
-	fake.recordInvocation("RemoveActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
-	fake.removeActualLRPMutex.Unlock()
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTaskByGuidStub
+	fakeReturns := fake.scheduledTaskByGuidReturns
+	fake.recordInvocation("ScheduledTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTaskByGuidMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4, arg5)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) RemoveActualLRPCallCount() int {
-	fake.removeActualLRPMutex.RLock()
-	defer fake.removeActualLRPMutex.RUnlock()
-	return len(fake.removeActualLRPArgsForCall)
+func (fake *FakeDB) ScheduledTaskByGuidCallCount() int {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	return len(fake.scheduledTaskByGuidArgsForCall)
 }
 
-func (fake *FakeDB) RemoveActualLRPCalls(stub func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error) {
-	fake.removeActualLRPMutex.Lock()
-	defer fake.removeActualLRPMutex.Unlock()
-	fake.RemoveActualLRPStub = stub
+func (fake *FakeDB) ScheduledTaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = stub
 }
 
-func (fake *FakeDB) RemoveActualLRPArgsForCall(i int) (context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) {
-	fake.removeActualLRPMutex.RLock()
-	defer fake.removeActualLRPMutex.RUnlock()
-	argsForCall := fake.removeActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+func (fake *FakeDB) ScheduledTaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	argsForCall := fake.scheduledTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) RemoveActualLRPReturns(result1 error) {
-	fake.removeActualLRPMutex.Lock()
-	defer fake.removeActualLRPMutex.Unlock()
-	fake.RemoveActualLRPStub = nil
-	fake.removeActualLRPReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeDB) ScheduledTaskByGuidReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	fake.scheduledTaskByGuidReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveActualLRPReturnsOnCall(i int, result1 error) {
-	fake.removeActualLRPMutex.Lock()
-	defer fake.removeActualLRPMutex.Unlock()
-	fake.RemoveActualLRPStub = nil
-	if fake.removeActualLRPReturnsOnCall == nil {
-		fake.removeActualLRPReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeDB) ScheduledTaskByGuidReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	if fake.scheduledTaskByGuidReturnsOnCall == nil {
+		fake.scheduledTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
 		})
 	}
-	fake.removeActualLRPReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.scheduledTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
-	fake.removeDesiredLRPMutex.Lock()
-	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
-	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
+func (fake *FakeDB) ScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
 		arg3 string
 	}{arg1, arg2, arg3})
-	stub := fake.RemoveDesiredLRPStub
-	fakeReturns := fake.removeDesiredLRPReturns
-	fake.recordInvocation("RemoveDesiredLRP", []interface{}{arg1, arg2, arg3})
-	fake.removeDesiredLRPMutex.Unlock()
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) RemoveDesiredLRPCallCount() int {
-	fake.removeDesiredLRPMutex.RLock()
-	defer fake.removeDesiredLRPMutex.RUnlock()
-	return len(fake.removeDesiredLRPArgsForCall)
+func (fake *FakeDB) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
 }
 
-func (fake *FakeDB) RemoveDesiredLRPCalls(stub func(context.Context, lager.Logger, string) error) {
-	fake.removeDesiredLRPMutex.Lock()
-	defer fake.removeDesiredLRPMutex.Unlock()
-	fake.RemoveDesiredLRPStub = stub
+func (fake *FakeDB) ScheduledTasksCalls(stub func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
 }
 
-func (fake *FakeDB) RemoveDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.removeDesiredLRPMutex.RLock()
-	defer fake.removeDesiredLRPMutex.RUnlock()
-	argsForCall := fake.removeDesiredLRPArgsForCall[i]
+func (fake *FakeDB) ScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) RemoveDesiredLRPReturns(result1 error) {
-	fake.removeDesiredLRPMutex.Lock()
-	defer fake.removeDesiredLRPMutex.Unlock()
-	fake.RemoveDesiredLRPStub = nil
-	fake.removeDesiredLRPReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeDB) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
-	fake.removeDesiredLRPMutex.Lock()
-	defer fake.removeDesiredLRPMutex.Unlock()
-	fake.RemoveDesiredLRPStub = nil
-	if fake.removeDesiredLRPReturnsOnCall == nil {
-		fake.removeDesiredLRPReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeDB) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
 		})
 	}
-	fake.removeDesiredLRPReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
-	fake.removeEvacuatingActualLRPMutex.Lock()
-	ret, specificReturn := fake.removeEvacuatingActualLRPReturnsOnCall[len(fake.removeEvacuatingActualLRPArgsForCall)]
-	fake.removeEvacuatingActualLRPArgsForCall = append(fake.removeEvacuatingActualLRPArgsForCall, struct {
+func (fake *FakeDB) SetCellCordoned(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) error {
+	fake.setCellCordonedMutex.Lock()
+	ret, specificReturn := fake.setCellCordonedReturnsOnCall[len(fake.setCellCordonedArgsForCall)]
+	fake.setCellCordonedArgsForCall = append(fake.setCellCordonedArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.ActualLRPKey
-		arg4 *models.ActualLRPInstanceKey
+		arg3 string
+		arg4 bool
 	}{arg1, arg2, arg3, arg4})
-	stub := fake.RemoveEvacuatingActualLRPStub
-	fakeReturns := fake.removeEvacuatingActualLRPReturns
-	fake.recordInvocation("RemoveEvacuatingActualLRP", []interface{}{arg1, arg2, arg3, arg4})
-	fake.removeEvacuatingActualLRPMutex.Unlock()
+	stub := fake.SetCellCordonedStub
+	fakeReturns := fake.setCellCordonedReturns
+	fake.recordInvocation("SetCellCordoned", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setCellCordonedMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3, arg4)
 	}
@@ -2949,62 +6725,63 @@ func (fake *FakeDB) RemoveEvacuatingActualLRP(arg1 context.Context, arg2 lager.L
 	return fakeReturns.result1
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRPCallCount() int {
-	fake.removeEvacuatingActualLRPMutex.RLock()
-	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
-	return len(fake.removeEvacuatingActualLRPArgsForCall)
+func (fake *FakeDB) SetCellCordonedCallCount() int {
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	return len(fake.setCellCordonedArgsForCall)
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error) {
-	fake.removeEvacuatingActualLRPMutex.Lock()
-	defer fake.removeEvacuatingActualLRPMutex.Unlock()
-	fake.RemoveEvacuatingActualLRPStub = stub
+func (fake *FakeDB) SetCellCordonedCalls(stub func(context.Context, lager.Logger, string, bool) error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = stub
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
-	fake.removeEvacuatingActualLRPMutex.RLock()
-	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
-	argsForCall := fake.removeEvacuatingActualLRPArgsForCall[i]
+func (fake *FakeDB) SetCellCordonedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	argsForCall := fake.setCellCordonedArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRPReturns(result1 error) {
-	fake.removeEvacuatingActualLRPMutex.Lock()
-	defer fake.removeEvacuatingActualLRPMutex.Unlock()
-	fake.RemoveEvacuatingActualLRPStub = nil
-	fake.removeEvacuatingActualLRPReturns = struct {
+func (fake *FakeDB) SetCellCordonedReturns(result1 error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = nil
+	fake.setCellCordonedReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeDB) RemoveEvacuatingActualLRPReturnsOnCall(i int, result1 error) {
-	fake.removeEvacuatingActualLRPMutex.Lock()
-	defer fake.removeEvacuatingActualLRPMutex.Unlock()
-	fake.RemoveEvacuatingActualLRPStub = nil
-	if fake.removeEvacuatingActualLRPReturnsOnCall == nil {
-		fake.removeEvacuatingActualLRPReturnsOnCall = make(map[int]struct {
+func (fake *FakeDB) SetCellCordonedReturnsOnCall(i int, result1 error) {
+	fake.setCellCordonedMutex.Lock()
+	defer fake.setCellCordonedMutex.Unlock()
+	fake.SetCellCordonedStub = nil
+	if fake.setCellCordonedReturnsOnCall == nil {
+		fake.setCellCordonedReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.removeEvacuatingActualLRPReturnsOnCall[i] = struct {
+	fake.setCellCordonedReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) (*models.ActualLRP, error) {
-	fake.removeSuspectActualLRPMutex.Lock()
-	ret, specificReturn := fake.removeSuspectActualLRPReturnsOnCall[len(fake.removeSuspectActualLRPArgsForCall)]
-	fake.removeSuspectActualLRPArgsForCall = append(fake.removeSuspectActualLRPArgsForCall, struct {
+func (fake *FakeDB) SetDeploymentPaused(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.Deployment, error) {
+	fake.setDeploymentPausedMutex.Lock()
+	ret, specificReturn := fake.setDeploymentPausedReturnsOnCall[len(fake.setDeploymentPausedArgsForCall)]
+	fake.setDeploymentPausedArgsForCall = append(fake.setDeploymentPausedArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 *models.ActualLRPKey
-	}{arg1, arg2, arg3})
-	stub := fake.RemoveSuspectActualLRPStub
-	fakeReturns := fake.removeSuspectActualLRPReturns
-	fake.recordInvocation("RemoveSuspectActualLRP", []interface{}{arg1, arg2, arg3})
-	fake.removeSuspectActualLRPMutex.Unlock()
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDeploymentPausedStub
+	fakeReturns := fake.setDeploymentPausedReturns
+	fake.recordInvocation("SetDeploymentPaused", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDeploymentPausedMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -3012,118 +6789,115 @@ func (fake *FakeDB) RemoveSuspectActualLRP(arg1 context.Context, arg2 lager.Logg
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRPCallCount() int {
-	fake.removeSuspectActualLRPMutex.RLock()
-	defer fake.removeSuspectActualLRPMutex.RUnlock()
-	return len(fake.removeSuspectActualLRPArgsForCall)
+func (fake *FakeDB) SetDeploymentPausedCallCount() int {
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	return len(fake.setDeploymentPausedArgsForCall)
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey) (*models.ActualLRP, error)) {
-	fake.removeSuspectActualLRPMutex.Lock()
-	defer fake.removeSuspectActualLRPMutex.Unlock()
-	fake.RemoveSuspectActualLRPStub = stub
+func (fake *FakeDB) SetDeploymentPausedCalls(stub func(context.Context, lager.Logger, string, bool) (*models.Deployment, error)) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = stub
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey) {
-	fake.removeSuspectActualLRPMutex.RLock()
-	defer fake.removeSuspectActualLRPMutex.RUnlock()
-	argsForCall := fake.removeSuspectActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeDB) SetDeploymentPausedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	argsForCall := fake.setDeploymentPausedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRPReturns(result1 *models.ActualLRP, result2 error) {
-	fake.removeSuspectActualLRPMutex.Lock()
-	defer fake.removeSuspectActualLRPMutex.Unlock()
-	fake.RemoveSuspectActualLRPStub = nil
-	fake.removeSuspectActualLRPReturns = struct {
-		result1 *models.ActualLRP
+func (fake *FakeDB) SetDeploymentPausedReturns(result1 *models.Deployment, result2 error) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = nil
+	fake.setDeploymentPausedReturns = struct {
+		result1 *models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) RemoveSuspectActualLRPReturnsOnCall(i int, result1 *models.ActualLRP, result2 error) {
-	fake.removeSuspectActualLRPMutex.Lock()
-	defer fake.removeSuspectActualLRPMutex.Unlock()
-	fake.RemoveSuspectActualLRPStub = nil
-	if fake.removeSuspectActualLRPReturnsOnCall == nil {
-		fake.removeSuspectActualLRPReturnsOnCall = make(map[int]struct {
-			result1 *models.ActualLRP
+func (fake *FakeDB) SetDeploymentPausedReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = nil
+	if fake.setDeploymentPausedReturnsOnCall == nil {
+		fake.setDeploymentPausedReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
 			result2 error
 		})
 	}
-	fake.removeSuspectActualLRPReturnsOnCall[i] = struct {
-		result1 *models.ActualLRP
+	fake.setDeploymentPausedReturnsOnCall[i] = struct {
+		result1 *models.Deployment
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeDB) ResolvingTask(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, *models.Task, error) {
-	fake.resolvingTaskMutex.Lock()
-	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
-	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
+func (fake *FakeDB) SetDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
+		arg3 *models.DomainQuota
 	}{arg1, arg2, arg3})
-	stub := fake.ResolvingTaskStub
-	fakeReturns := fake.resolvingTaskReturns
-	fake.recordInvocation("ResolvingTask", []interface{}{arg1, arg2, arg3})
-	fake.resolvingTaskMutex.Unlock()
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
 	if stub != nil {
 		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeDB) ResolvingTaskCallCount() int {
-	fake.resolvingTaskMutex.RLock()
-	defer fake.resolvingTaskMutex.RUnlock()
-	return len(fake.resolvingTaskArgsForCall)
+func (fake *FakeDB) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
 }
 
-func (fake *FakeDB) ResolvingTaskCalls(stub func(context.Context, lager.Logger, string) (*models.Task, *models.Task, error)) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = stub
+func (fake *FakeDB) SetDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
 }
 
-func (fake *FakeDB) ResolvingTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
-	fake.resolvingTaskMutex.RLock()
-	defer fake.resolvingTaskMutex.RUnlock()
-	argsForCall := fake.resolvingTaskArgsForCall[i]
+func (fake *FakeDB) SetDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeDB) ResolvingTaskReturns(result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = nil
-	fake.resolvingTaskReturns = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeDB) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeDB) ResolvingTaskReturnsOnCall(i int, result1 *models.Task, result2 *models.Task, result3 error) {
-	fake.resolvingTaskMutex.Lock()
-	defer fake.resolvingTaskMutex.Unlock()
-	fake.ResolvingTaskStub = nil
-	if fake.resolvingTaskReturnsOnCall == nil {
-		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
-			result1 *models.Task
-			result2 *models.Task
-			result3 error
+func (fake *FakeDB) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
 		})
 	}
-	fake.resolvingTaskReturnsOnCall[i] = struct {
-		result1 *models.Task
-		result2 *models.Task
-		result3 error
-	}{result1, result2, result3}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeDB) SetEncryptionKeyLabel(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
@@ -3189,6 +6963,73 @@ func (fake *FakeDB) SetEncryptionKeyLabelReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeDB) SetScheduledTaskSuspended(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	ret, specificReturn := fake.setScheduledTaskSuspendedReturnsOnCall[len(fake.setScheduledTaskSuspendedArgsForCall)]
+	fake.setScheduledTaskSuspendedArgsForCall = append(fake.setScheduledTaskSuspendedArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetScheduledTaskSuspendedStub
+	fakeReturns := fake.setScheduledTaskSuspendedReturns
+	fake.recordInvocation("SetScheduledTaskSuspended", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setScheduledTaskSuspendedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) SetScheduledTaskSuspendedCallCount() int {
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
+	return len(fake.setScheduledTaskSuspendedArgsForCall)
+}
+
+func (fake *FakeDB) SetScheduledTaskSuspendedCalls(stub func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = stub
+}
+
+func (fake *FakeDB) SetScheduledTaskSuspendedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
+	argsForCall := fake.setScheduledTaskSuspendedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) SetScheduledTaskSuspendedReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = nil
+	fake.setScheduledTaskSuspendedReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) SetScheduledTaskSuspendedReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = nil
+	if fake.setScheduledTaskSuspendedReturnsOnCall == nil {
+		fake.setScheduledTaskSuspendedReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.setScheduledTaskSuspendedReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) SetVersion(arg1 helpers.Tx, arg2 context.Context, arg3 lager.Logger, arg4 *models.Version) error {
 	fake.setVersionMutex.Lock()
 	ret, specificReturn := fake.setVersionReturnsOnCall[len(fake.setVersionArgsForCall)]
@@ -3334,6 +7175,144 @@ func (fake *FakeDB) StartActualLRPReturnsOnCall(i int, result1 *models.ActualLRP
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) StartCellDrain(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, error) {
+	fake.startCellDrainMutex.Lock()
+	ret, specificReturn := fake.startCellDrainReturnsOnCall[len(fake.startCellDrainArgsForCall)]
+	fake.startCellDrainArgsForCall = append(fake.startCellDrainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.StartCellDrainStub
+	fakeReturns := fake.startCellDrainReturns
+	fake.recordInvocation("StartCellDrain", []interface{}{arg1, arg2, arg3})
+	fake.startCellDrainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) StartCellDrainCallCount() int {
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	return len(fake.startCellDrainArgsForCall)
+}
+
+func (fake *FakeDB) StartCellDrainCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, error)) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = stub
+}
+
+func (fake *FakeDB) StartCellDrainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	argsForCall := fake.startCellDrainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) StartCellDrainReturns(result1 *models.CellDrain, result2 error) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = nil
+	fake.startCellDrainReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) StartCellDrainReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.startCellDrainMutex.Lock()
+	defer fake.startCellDrainMutex.Unlock()
+	fake.StartCellDrainStub = nil
+	if fake.startCellDrainReturnsOnCall == nil {
+		fake.startCellDrainReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.startCellDrainReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) StartDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeDB) StartDeploymentCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeDB) StartDeploymentArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDB) StartDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) StartDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
+			result3 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDB) StartTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, bool, error) {
 	fake.startTaskMutex.Lock()
 	ret, specificReturn := fake.startTaskReturnsOnCall[len(fake.startTaskArgsForCall)]
@@ -3473,6 +7452,72 @@ func (fake *FakeDB) TaskByGuidReturnsOnCall(i int, result1 *models.Task, result2
 	}{result1, result2}
 }
 
+func (fake *FakeDB) TaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeDB) TaskCallbacksCalls(stub func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeDB) TaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -3680,6 +7725,138 @@ func (fake *FakeDB) UnclaimActualLRPIfAllRunningReturnsOnCall(i int, result1 *mo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeDB) UpdateCellDrainProgress(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, *models.CellDrain, error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	ret, specificReturn := fake.updateCellDrainProgressReturnsOnCall[len(fake.updateCellDrainProgressArgsForCall)]
+	fake.updateCellDrainProgressArgsForCall = append(fake.updateCellDrainProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateCellDrainProgressStub
+	fakeReturns := fake.updateCellDrainProgressReturns
+	fake.recordInvocation("UpdateCellDrainProgress", []interface{}{arg1, arg2, arg3})
+	fake.updateCellDrainProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDB) UpdateCellDrainProgressCallCount() int {
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	return len(fake.updateCellDrainProgressArgsForCall)
+}
+
+func (fake *FakeDB) UpdateCellDrainProgressCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, *models.CellDrain, error)) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = stub
+}
+
+func (fake *FakeDB) UpdateCellDrainProgressArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	argsForCall := fake.updateCellDrainProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UpdateCellDrainProgressReturns(result1 *models.CellDrain, result2 *models.CellDrain, result3 error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = nil
+	fake.updateCellDrainProgressReturns = struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) UpdateCellDrainProgressReturnsOnCall(i int, result1 *models.CellDrain, result2 *models.CellDrain, result3 error) {
+	fake.updateCellDrainProgressMutex.Lock()
+	defer fake.updateCellDrainProgressMutex.Unlock()
+	fake.UpdateCellDrainProgressStub = nil
+	if fake.updateCellDrainProgressReturnsOnCall == nil {
+		fake.updateCellDrainProgressReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 *models.CellDrain
+			result3 error
+		})
+	}
+	fake.updateCellDrainProgressReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 *models.CellDrain
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDB) UpdateDeploymentProgress(arg1 context.Context, arg2 lager.Logger, arg3 *models.Deployment) error {
+	fake.updateDeploymentProgressMutex.Lock()
+	ret, specificReturn := fake.updateDeploymentProgressReturnsOnCall[len(fake.updateDeploymentProgressArgsForCall)]
+	fake.updateDeploymentProgressArgsForCall = append(fake.updateDeploymentProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.Deployment
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateDeploymentProgressStub
+	fakeReturns := fake.updateDeploymentProgressReturns
+	fake.recordInvocation("UpdateDeploymentProgress", []interface{}{arg1, arg2, arg3})
+	fake.updateDeploymentProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDB) UpdateDeploymentProgressCallCount() int {
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
+	return len(fake.updateDeploymentProgressArgsForCall)
+}
+
+func (fake *FakeDB) UpdateDeploymentProgressCalls(stub func(context.Context, lager.Logger, *models.Deployment) error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = stub
+}
+
+func (fake *FakeDB) UpdateDeploymentProgressArgsForCall(i int) (context.Context, lager.Logger, *models.Deployment) {
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
+	argsForCall := fake.updateDeploymentProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDB) UpdateDeploymentProgressReturns(result1 error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = nil
+	fake.updateDeploymentProgressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDB) UpdateDeploymentProgressReturnsOnCall(i int, result1 error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = nil
+	if fake.updateDeploymentProgressReturnsOnCall == nil {
+		fake.updateDeploymentProgressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDeploymentProgressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeDB) UpdateDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) (*models.DesiredLRP, error) {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -3747,6 +7924,73 @@ func (fake *FakeDB) UpdateDesiredLRPReturnsOnCall(i int, result1 *models.Desired
 	}{result1, result2}
 }
 
+func (fake *FakeDB) UpdateScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDB) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeDB) UpdateScheduledTaskCalls(stub func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeDB) UpdateScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDB) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDB) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeDB) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint32) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -3880,22 +8124,38 @@ func (fake *FakeDB) VersionReturnsOnCall(i int, result1 *models.Version, result2
 func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
 	fake.actualLRPsByProcessGuidsMutex.RLock()
 	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	fake.auditRecordsMutex.RLock()
+	defer fake.auditRecordsMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
 	fake.changeActualLRPPresenceMutex.RLock()
 	defer fake.changeActualLRPPresenceMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
 	fake.convergeLRPsMutex.RLock()
 	defer fake.convergeLRPsMutex.RUnlock()
 	fake.convergeTasksMutex.RLock()
 	defer fake.convergeTasksMutex.RUnlock()
+	fake.cordonedCellIdsMutex.RLock()
+	defer fake.cordonedCellIdsMutex.RUnlock()
 	fake.countActualLRPsByStateMutex.RLock()
 	defer fake.countActualLRPsByStateMutex.RUnlock()
 	fake.countDesiredInstancesMutex.RLock()
@@ -3904,10 +8164,24 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.crashActualLRPMutex.RUnlock()
 	fake.createUnclaimedActualLRPMutex.RLock()
 	defer fake.createUnclaimedActualLRPMutex.RUnlock()
+	fake.deleteAuditRecordsBeforeMutex.RLock()
+	defer fake.deleteAuditRecordsBeforeMutex.RUnlock()
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
@@ -3922,52 +8196,126 @@ func (fake *FakeDB) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
 	fake.encryptionKeyLabelMutex.RLock()
 	defer fake.encryptionKeyLabelMutex.RUnlock()
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
 	fake.evacuateActualLRPMutex.RLock()
 	defer fake.evacuateActualLRPMutex.RUnlock()
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.failCellDrainMutex.RLock()
+	defer fake.failCellDrainMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
 	fake.freshDomainsMutex.RLock()
 	defer fake.freshDomainsMutex.RUnlock()
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
 	fake.performBBSHealthCheckMutex.RLock()
 	defer fake.performBBSHealthCheckMutex.RUnlock()
 	fake.performEncryptionMutex.RLock()
 	defer fake.performEncryptionMutex.RUnlock()
 	fake.promoteSuspectActualLRPMutex.RLock()
 	defer fake.promoteSuspectActualLRPMutex.RUnlock()
+	fake.pruneActualLRPHistoryMutex.RLock()
+	defer fake.pruneActualLRPHistoryMutex.RUnlock()
+	fake.recordActualLRPTransitionMutex.RLock()
+	defer fake.recordActualLRPTransitionMutex.RUnlock()
+	fake.recordDenialMutex.RLock()
+	defer fake.recordDenialMutex.RUnlock()
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
 	fake.removeEvacuatingActualLRPMutex.RLock()
 	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
 	fake.removeSuspectActualLRPMutex.RLock()
 	defer fake.removeSuspectActualLRPMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setCellCordonedMutex.RLock()
+	defer fake.setCellCordonedMutex.RUnlock()
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
 	fake.setEncryptionKeyLabelMutex.RLock()
 	defer fake.setEncryptionKeyLabelMutex.RUnlock()
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
 	fake.setVersionMutex.RLock()
 	defer fake.setVersionMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startCellDrainMutex.RLock()
+	defer fake.startCellDrainMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.unclaimActualLRPMutex.RLock()
 	defer fake.unclaimActualLRPMutex.RUnlock()
 	fake.unclaimActualLRPIfAllRunningMutex.RLock()
 	defer fake.unclaimActualLRPIfAllRunningMutex.RUnlock()
+	fake.updateCellDrainProgressMutex.RLock()
+	defer fake.updateCellDrainProgressMutex.RUnlock()
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	fake.versionMutex.RLock()
@@ -0,0 +1,637 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeDeploymentDB struct {
+	ActiveDeploymentsStub        func(context.Context, lager.Logger) ([]*models.Deployment, error)
+	activeDeploymentsMutex       sync.RWMutex
+	activeDeploymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	activeDeploymentsReturns struct {
+		result1 []*models.Deployment
+		result2 error
+	}
+	activeDeploymentsReturnsOnCall map[int]struct {
+		result1 []*models.Deployment
+		result2 error
+	}
+	CompleteDeploymentStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)
+	completeDeploymentMutex       sync.RWMutex
+	completeDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	completeDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	completeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	DeploymentByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	RollbackDeploymentStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	SetDeploymentPausedStub        func(context.Context, lager.Logger, string, bool) (*models.Deployment, error)
+	setDeploymentPausedMutex       sync.RWMutex
+	setDeploymentPausedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setDeploymentPausedReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	setDeploymentPausedReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	StartDeploymentStub        func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.DesiredLRP, *models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}
+	UpdateDeploymentProgressStub        func(context.Context, lager.Logger, *models.Deployment) error
+	updateDeploymentProgressMutex       sync.RWMutex
+	updateDeploymentProgressArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.Deployment
+	}
+	updateDeploymentProgressReturns struct {
+		result1 error
+	}
+	updateDeploymentProgressReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeploymentDB) ActiveDeployments(arg1 context.Context, arg2 lager.Logger) ([]*models.Deployment, error) {
+	fake.activeDeploymentsMutex.Lock()
+	ret, specificReturn := fake.activeDeploymentsReturnsOnCall[len(fake.activeDeploymentsArgsForCall)]
+	fake.activeDeploymentsArgsForCall = append(fake.activeDeploymentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.ActiveDeploymentsStub
+	fakeReturns := fake.activeDeploymentsReturns
+	fake.recordInvocation("ActiveDeployments", []interface{}{arg1, arg2})
+	fake.activeDeploymentsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentDB) ActiveDeploymentsCallCount() int {
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	return len(fake.activeDeploymentsArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) ActiveDeploymentsCalls(stub func(context.Context, lager.Logger) ([]*models.Deployment, error)) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = stub
+}
+
+func (fake *FakeDeploymentDB) ActiveDeploymentsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	argsForCall := fake.activeDeploymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentDB) ActiveDeploymentsReturns(result1 []*models.Deployment, result2 error) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = nil
+	fake.activeDeploymentsReturns = struct {
+		result1 []*models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) ActiveDeploymentsReturnsOnCall(i int, result1 []*models.Deployment, result2 error) {
+	fake.activeDeploymentsMutex.Lock()
+	defer fake.activeDeploymentsMutex.Unlock()
+	fake.ActiveDeploymentsStub = nil
+	if fake.activeDeploymentsReturnsOnCall == nil {
+		fake.activeDeploymentsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Deployment
+			result2 error
+		})
+	}
+	fake.activeDeploymentsReturnsOnCall[i] = struct {
+		result1 []*models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) CompleteDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.completeDeploymentMutex.Lock()
+	ret, specificReturn := fake.completeDeploymentReturnsOnCall[len(fake.completeDeploymentArgsForCall)]
+	fake.completeDeploymentArgsForCall = append(fake.completeDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CompleteDeploymentStub
+	fakeReturns := fake.completeDeploymentReturns
+	fake.recordInvocation("CompleteDeployment", []interface{}{arg1, arg2, arg3})
+	fake.completeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentDB) CompleteDeploymentCallCount() int {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	return len(fake.completeDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) CompleteDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentDB) CompleteDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	argsForCall := fake.completeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentDB) CompleteDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = nil
+	fake.completeDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) CompleteDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.completeDeploymentMutex.Lock()
+	defer fake.completeDeploymentMutex.Unlock()
+	fake.CompleteDeploymentStub = nil
+	if fake.completeDeploymentReturnsOnCall == nil {
+		fake.completeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
+			result3 error
+		})
+	}
+	fake.completeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) RollbackDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentDB) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) RollbackDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentDB) RollbackDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentDB) RollbackDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) RollbackDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
+			result3 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPaused(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.Deployment, error) {
+	fake.setDeploymentPausedMutex.Lock()
+	ret, specificReturn := fake.setDeploymentPausedReturnsOnCall[len(fake.setDeploymentPausedArgsForCall)]
+	fake.setDeploymentPausedArgsForCall = append(fake.setDeploymentPausedArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetDeploymentPausedStub
+	fakeReturns := fake.setDeploymentPausedReturns
+	fake.recordInvocation("SetDeploymentPaused", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setDeploymentPausedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPausedCallCount() int {
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	return len(fake.setDeploymentPausedArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPausedCalls(stub func(context.Context, lager.Logger, string, bool) (*models.Deployment, error)) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = stub
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPausedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	argsForCall := fake.setDeploymentPausedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPausedReturns(result1 *models.Deployment, result2 error) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = nil
+	fake.setDeploymentPausedReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) SetDeploymentPausedReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.setDeploymentPausedMutex.Lock()
+	defer fake.setDeploymentPausedMutex.Unlock()
+	fake.SetDeploymentPausedStub = nil
+	if fake.setDeploymentPausedReturnsOnCall == nil {
+		fake.setDeploymentPausedReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.setDeploymentPausedReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentDB) StartDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.DesiredLRP, *models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDeploymentDB) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) StartDeploymentCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.DesiredLRP, *models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentDB) StartDeploymentArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDeploymentDB) StartDeploymentReturns(result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) StartDeploymentReturnsOnCall(i int, result1 *models.DesiredLRP, result2 *models.Deployment, result3 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 *models.Deployment
+			result3 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 *models.Deployment
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgress(arg1 context.Context, arg2 lager.Logger, arg3 *models.Deployment) error {
+	fake.updateDeploymentProgressMutex.Lock()
+	ret, specificReturn := fake.updateDeploymentProgressReturnsOnCall[len(fake.updateDeploymentProgressArgsForCall)]
+	fake.updateDeploymentProgressArgsForCall = append(fake.updateDeploymentProgressArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.Deployment
+	}{arg1, arg2, arg3})
+	stub := fake.UpdateDeploymentProgressStub
+	fakeReturns := fake.updateDeploymentProgressReturns
+	fake.recordInvocation("UpdateDeploymentProgress", []interface{}{arg1, arg2, arg3})
+	fake.updateDeploymentProgressMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgressCallCount() int {
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
+	return len(fake.updateDeploymentProgressArgsForCall)
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgressCalls(stub func(context.Context, lager.Logger, *models.Deployment) error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = stub
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgressArgsForCall(i int) (context.Context, lager.Logger, *models.Deployment) {
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
+	argsForCall := fake.updateDeploymentProgressArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgressReturns(result1 error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = nil
+	fake.updateDeploymentProgressReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeploymentDB) UpdateDeploymentProgressReturnsOnCall(i int, result1 error) {
+	fake.updateDeploymentProgressMutex.Lock()
+	defer fake.updateDeploymentProgressMutex.Unlock()
+	fake.UpdateDeploymentProgressStub = nil
+	if fake.updateDeploymentProgressReturnsOnCall == nil {
+		fake.updateDeploymentProgressReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDeploymentProgressReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDeploymentDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.activeDeploymentsMutex.RLock()
+	defer fake.activeDeploymentsMutex.RUnlock()
+	fake.completeDeploymentMutex.RLock()
+	defer fake.completeDeploymentMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.setDeploymentPausedMutex.RLock()
+	defer fake.setDeploymentPausedMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	fake.updateDeploymentProgressMutex.RLock()
+	defer fake.updateDeploymentProgressMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeploymentDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DeploymentDB = new(FakeDeploymentDB)
@@ -116,6 +116,40 @@ type FakeDesiredLRPDB struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	ListDesiredLRPSchedulingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)
+	listDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	listDesiredLRPSchedulingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPSchedulingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListDesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)
+	listDesiredLRPsMutex       sync.RWMutex
+	listDesiredLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
 	RemoveDesiredLRPStub        func(context.Context, lager.Logger, string) error
 	removeDesiredLRPMutex       sync.RWMutex
 	removeDesiredLRPArgsForCall []struct {
@@ -611,6 +645,144 @@ func (fake *FakeDesiredLRPDB) DesiredLRPsReturnsOnCall(i int, result1 []*models.
 	}{result1, result2}
 }
 
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPSchedulingInfosReturnsOnCall[len(fake.listDesiredLRPSchedulingInfosArgsForCall)]
+	fake.listDesiredLRPSchedulingInfosArgsForCall = append(fake.listDesiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPSchedulingInfosStub
+	fakeReturns := fake.listDesiredLRPSchedulingInfosReturns
+	fake.recordInvocation("ListDesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfosCallCount() int {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.listDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	fake.listDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	if fake.listDesiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.listDesiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error) {
+	fake.listDesiredLRPsMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPsReturnsOnCall[len(fake.listDesiredLRPsArgsForCall)]
+	fake.listDesiredLRPsArgsForCall = append(fake.listDesiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPsStub
+	fakeReturns := fake.listDesiredLRPsReturns
+	fake.recordInvocation("ListDesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPsCallCount() int {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	return len(fake.listDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = stub
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPsReturns(result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	fake.listDesiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeDesiredLRPDB) ListDesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	if fake.listDesiredLRPsReturnsOnCall == nil {
+		fake.listDesiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeDesiredLRPDB) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
 	fake.removeDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
@@ -758,6 +930,10 @@ func (fake *FakeDesiredLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPUpdateStrategyByProcessGuidMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
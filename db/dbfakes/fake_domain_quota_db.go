@@ -0,0 +1,448 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeDomainQuotaDB struct {
+	DomainQuotaByDomainStub        func(context.Context, lager.Logger, string) (*models.DomainQuota, error)
+	domainQuotaByDomainMutex       sync.RWMutex
+	domainQuotaByDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainQuotaByDomainReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	domainQuotaByDomainReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 error
+	}
+	RemoveDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainQuota, error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	ret, specificReturn := fake.domainQuotaByDomainReturnsOnCall[len(fake.domainQuotaByDomainArgsForCall)]
+	fake.domainQuotaByDomainArgsForCall = append(fake.domainQuotaByDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainQuotaByDomainStub
+	fakeReturns := fake.domainQuotaByDomainReturns
+	fake.recordInvocation("DomainQuotaByDomain", []interface{}{arg1, arg2, arg3})
+	fake.domainQuotaByDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomainCallCount() int {
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	return len(fake.domainQuotaByDomainArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomainCalls(stub func(context.Context, lager.Logger, string) (*models.DomainQuota, error)) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	argsForCall := fake.domainQuotaByDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomainReturns(result1 *models.DomainQuota, result2 error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = nil
+	fake.domainQuotaByDomainReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotaByDomainReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.domainQuotaByDomainMutex.Lock()
+	defer fake.domainQuotaByDomainMutex.Unlock()
+	fake.DomainQuotaByDomainStub = nil
+	if fake.domainQuotaByDomainReturnsOnCall == nil {
+		fake.domainQuotaByDomainReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotaByDomainReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageReturns(result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDomainQuotaDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.domainQuotaByDomainMutex.RLock()
+	defer fake.domainQuotaByDomainMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDomainQuotaDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.DomainQuotaDB = new(FakeDomainQuotaDB)
@@ -0,0 +1,452 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeEventLogDB struct {
+	DeleteEventsBeforeStub        func(context.Context, lager.Logger, string, uint64) error
+	deleteEventsBeforeMutex       sync.RWMutex
+	deleteEventsBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	deleteEventsBeforeReturns struct {
+		result1 error
+	}
+	deleteEventsBeforeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EventIDAtResourceVersionStub        func(context.Context, lager.Logger, string, uint64) (uint64, error)
+	eventIDAtResourceVersionMutex       sync.RWMutex
+	eventIDAtResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	eventIDAtResourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	eventIDAtResourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	EventsSinceStub        func(context.Context, lager.Logger, string, uint64) ([]db.StoredEvent, error)
+	eventsSinceMutex       sync.RWMutex
+	eventsSinceArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}
+	eventsSinceReturns struct {
+		result1 []db.StoredEvent
+		result2 error
+	}
+	eventsSinceReturnsOnCall map[int]struct {
+		result1 []db.StoredEvent
+		result2 error
+	}
+	InsertEventStub        func(context.Context, lager.Logger, string, db.StoredEvent) error
+	insertEventMutex       sync.RWMutex
+	insertEventArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.StoredEvent
+	}
+	insertEventReturns struct {
+		result1 error
+	}
+	insertEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	LastEventIDStub        func(context.Context, lager.Logger, string) (uint64, error)
+	lastEventIDMutex       sync.RWMutex
+	lastEventIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	lastEventIDReturns struct {
+		result1 uint64
+		result2 error
+	}
+	lastEventIDReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBefore(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) error {
+	fake.deleteEventsBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteEventsBeforeReturnsOnCall[len(fake.deleteEventsBeforeArgsForCall)]
+	fake.deleteEventsBeforeArgsForCall = append(fake.deleteEventsBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.DeleteEventsBeforeStub
+	fakeReturns := fake.deleteEventsBeforeReturns
+	fake.recordInvocation("DeleteEventsBefore", []interface{}{arg1, arg2, arg3, arg4})
+	fake.deleteEventsBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBeforeCallCount() int {
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	return len(fake.deleteEventsBeforeArgsForCall)
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBeforeCalls(stub func(context.Context, lager.Logger, string, uint64) error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = stub
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBeforeArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	argsForCall := fake.deleteEventsBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBeforeReturns(result1 error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = nil
+	fake.deleteEventsBeforeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEventLogDB) DeleteEventsBeforeReturnsOnCall(i int, result1 error) {
+	fake.deleteEventsBeforeMutex.Lock()
+	defer fake.deleteEventsBeforeMutex.Unlock()
+	fake.DeleteEventsBeforeStub = nil
+	if fake.deleteEventsBeforeReturnsOnCall == nil {
+		fake.deleteEventsBeforeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteEventsBeforeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) (uint64, error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	ret, specificReturn := fake.eventIDAtResourceVersionReturnsOnCall[len(fake.eventIDAtResourceVersionArgsForCall)]
+	fake.eventIDAtResourceVersionArgsForCall = append(fake.eventIDAtResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EventIDAtResourceVersionStub
+	fakeReturns := fake.eventIDAtResourceVersionReturns
+	fake.recordInvocation("EventIDAtResourceVersion", []interface{}{arg1, arg2, arg3, arg4})
+	fake.eventIDAtResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersionCallCount() int {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	return len(fake.eventIDAtResourceVersionArgsForCall)
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersionCalls(stub func(context.Context, lager.Logger, string, uint64) (uint64, error)) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = stub
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersionArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	argsForCall := fake.eventIDAtResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersionReturns(result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	fake.eventIDAtResourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) EventIDAtResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	if fake.eventIDAtResourceVersionReturnsOnCall == nil {
+		fake.eventIDAtResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.eventIDAtResourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) EventsSince(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 uint64) ([]db.StoredEvent, error) {
+	fake.eventsSinceMutex.Lock()
+	ret, specificReturn := fake.eventsSinceReturnsOnCall[len(fake.eventsSinceArgsForCall)]
+	fake.eventsSinceArgsForCall = append(fake.eventsSinceArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 uint64
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EventsSinceStub
+	fakeReturns := fake.eventsSinceReturns
+	fake.recordInvocation("EventsSince", []interface{}{arg1, arg2, arg3, arg4})
+	fake.eventsSinceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventLogDB) EventsSinceCallCount() int {
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
+	return len(fake.eventsSinceArgsForCall)
+}
+
+func (fake *FakeEventLogDB) EventsSinceCalls(stub func(context.Context, lager.Logger, string, uint64) ([]db.StoredEvent, error)) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = stub
+}
+
+func (fake *FakeEventLogDB) EventsSinceArgsForCall(i int) (context.Context, lager.Logger, string, uint64) {
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
+	argsForCall := fake.eventsSinceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEventLogDB) EventsSinceReturns(result1 []db.StoredEvent, result2 error) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = nil
+	fake.eventsSinceReturns = struct {
+		result1 []db.StoredEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) EventsSinceReturnsOnCall(i int, result1 []db.StoredEvent, result2 error) {
+	fake.eventsSinceMutex.Lock()
+	defer fake.eventsSinceMutex.Unlock()
+	fake.EventsSinceStub = nil
+	if fake.eventsSinceReturnsOnCall == nil {
+		fake.eventsSinceReturnsOnCall = make(map[int]struct {
+			result1 []db.StoredEvent
+			result2 error
+		})
+	}
+	fake.eventsSinceReturnsOnCall[i] = struct {
+		result1 []db.StoredEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) InsertEvent(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 db.StoredEvent) error {
+	fake.insertEventMutex.Lock()
+	ret, specificReturn := fake.insertEventReturnsOnCall[len(fake.insertEventArgsForCall)]
+	fake.insertEventArgsForCall = append(fake.insertEventArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 db.StoredEvent
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.InsertEventStub
+	fakeReturns := fake.insertEventReturns
+	fake.recordInvocation("InsertEvent", []interface{}{arg1, arg2, arg3, arg4})
+	fake.insertEventMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEventLogDB) InsertEventCallCount() int {
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	return len(fake.insertEventArgsForCall)
+}
+
+func (fake *FakeEventLogDB) InsertEventCalls(stub func(context.Context, lager.Logger, string, db.StoredEvent) error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = stub
+}
+
+func (fake *FakeEventLogDB) InsertEventArgsForCall(i int) (context.Context, lager.Logger, string, db.StoredEvent) {
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	argsForCall := fake.insertEventArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeEventLogDB) InsertEventReturns(result1 error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = nil
+	fake.insertEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEventLogDB) InsertEventReturnsOnCall(i int, result1 error) {
+	fake.insertEventMutex.Lock()
+	defer fake.insertEventMutex.Unlock()
+	fake.InsertEventStub = nil
+	if fake.insertEventReturnsOnCall == nil {
+		fake.insertEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeEventLogDB) LastEventID(arg1 context.Context, arg2 lager.Logger, arg3 string) (uint64, error) {
+	fake.lastEventIDMutex.Lock()
+	ret, specificReturn := fake.lastEventIDReturnsOnCall[len(fake.lastEventIDArgsForCall)]
+	fake.lastEventIDArgsForCall = append(fake.lastEventIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.LastEventIDStub
+	fakeReturns := fake.lastEventIDReturns
+	fake.recordInvocation("LastEventID", []interface{}{arg1, arg2, arg3})
+	fake.lastEventIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventLogDB) LastEventIDCallCount() int {
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	return len(fake.lastEventIDArgsForCall)
+}
+
+func (fake *FakeEventLogDB) LastEventIDCalls(stub func(context.Context, lager.Logger, string) (uint64, error)) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = stub
+}
+
+func (fake *FakeEventLogDB) LastEventIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	argsForCall := fake.lastEventIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeEventLogDB) LastEventIDReturns(result1 uint64, result2 error) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	fake.lastEventIDReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) LastEventIDReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	if fake.lastEventIDReturnsOnCall == nil {
+		fake.lastEventIDReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.lastEventIDReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLogDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteEventsBeforeMutex.RLock()
+	defer fake.deleteEventsBeforeMutex.RUnlock()
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	fake.eventsSinceMutex.RLock()
+	defer fake.eventsSinceMutex.RUnlock()
+	fake.insertEventMutex.RLock()
+	defer fake.insertEventMutex.RUnlock()
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEventLogDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.EventLogDB = new(FakeEventLogDB)
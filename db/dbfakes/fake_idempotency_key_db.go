@@ -0,0 +1,374 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeIdempotencyKeyDB struct {
+	CompleteIdempotencyKeyStub        func(context.Context, lager.Logger, string, []byte) error
+	completeIdempotencyKeyMutex       sync.RWMutex
+	completeIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []byte
+	}
+	completeIdempotencyKeyReturns struct {
+		result1 error
+	}
+	completeIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteIdempotencyKeysBeforeStub        func(context.Context, lager.Logger, time.Time) (int64, error)
+	deleteIdempotencyKeysBeforeMutex       sync.RWMutex
+	deleteIdempotencyKeysBeforeArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	deleteIdempotencyKeysBeforeReturns struct {
+		result1 int64
+		result2 error
+	}
+	deleteIdempotencyKeysBeforeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	ReleaseIdempotencyKeyStub        func(context.Context, lager.Logger, string) error
+	releaseIdempotencyKeyMutex       sync.RWMutex
+	releaseIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	releaseIdempotencyKeyReturns struct {
+		result1 error
+	}
+	releaseIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReserveIdempotencyKeyStub        func(context.Context, lager.Logger, string, string, time.Time) (*models.IdempotencyKey, error)
+	reserveIdempotencyKeyMutex       sync.RWMutex
+	reserveIdempotencyKeyArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}
+	reserveIdempotencyKeyReturns struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}
+	reserveIdempotencyKeyReturnsOnCall map[int]struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 []byte) error {
+	var arg4Copy []byte
+	if arg4 != nil {
+		arg4Copy = make([]byte, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.completeIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.completeIdempotencyKeyReturnsOnCall[len(fake.completeIdempotencyKeyArgsForCall)]
+	fake.completeIdempotencyKeyArgsForCall = append(fake.completeIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 []byte
+	}{arg1, arg2, arg3, arg4Copy})
+	stub := fake.CompleteIdempotencyKeyStub
+	fakeReturns := fake.completeIdempotencyKeyReturns
+	fake.recordInvocation("CompleteIdempotencyKey", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.completeIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKeyCallCount() int {
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
+	return len(fake.completeIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string, []byte) error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = stub
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string, []byte) {
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.completeIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKeyReturns(result1 error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = nil
+	fake.completeIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyKeyDB) CompleteIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.completeIdempotencyKeyMutex.Lock()
+	defer fake.completeIdempotencyKeyMutex.Unlock()
+	fake.CompleteIdempotencyKeyStub = nil
+	if fake.completeIdempotencyKeyReturnsOnCall == nil {
+		fake.completeIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBefore(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) (int64, error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	ret, specificReturn := fake.deleteIdempotencyKeysBeforeReturnsOnCall[len(fake.deleteIdempotencyKeysBeforeArgsForCall)]
+	fake.deleteIdempotencyKeysBeforeArgsForCall = append(fake.deleteIdempotencyKeysBeforeArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteIdempotencyKeysBeforeStub
+	fakeReturns := fake.deleteIdempotencyKeysBeforeReturns
+	fake.recordInvocation("DeleteIdempotencyKeysBefore", []interface{}{arg1, arg2, arg3})
+	fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBeforeCallCount() int {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	return len(fake.deleteIdempotencyKeysBeforeArgsForCall)
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBeforeCalls(stub func(context.Context, lager.Logger, time.Time) (int64, error)) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = stub
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBeforeArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	argsForCall := fake.deleteIdempotencyKeysBeforeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBeforeReturns(result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	fake.deleteIdempotencyKeysBeforeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyDB) DeleteIdempotencyKeysBeforeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.deleteIdempotencyKeysBeforeMutex.Lock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.Unlock()
+	fake.DeleteIdempotencyKeysBeforeStub = nil
+	if fake.deleteIdempotencyKeysBeforeReturnsOnCall == nil {
+		fake.deleteIdempotencyKeysBeforeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.deleteIdempotencyKeysBeforeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.releaseIdempotencyKeyReturnsOnCall[len(fake.releaseIdempotencyKeyArgsForCall)]
+	fake.releaseIdempotencyKeyArgsForCall = append(fake.releaseIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReleaseIdempotencyKeyStub
+	fakeReturns := fake.releaseIdempotencyKeyReturns
+	fake.recordInvocation("ReleaseIdempotencyKey", []interface{}{arg1, arg2, arg3})
+	fake.releaseIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKeyCallCount() int {
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
+	return len(fake.releaseIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = stub
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.releaseIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKeyReturns(result1 error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = nil
+	fake.releaseIdempotencyKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyKeyDB) ReleaseIdempotencyKeyReturnsOnCall(i int, result1 error) {
+	fake.releaseIdempotencyKeyMutex.Lock()
+	defer fake.releaseIdempotencyKeyMutex.Unlock()
+	fake.ReleaseIdempotencyKeyStub = nil
+	if fake.releaseIdempotencyKeyReturnsOnCall == nil {
+		fake.releaseIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKey(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 time.Time) (*models.IdempotencyKey, error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	ret, specificReturn := fake.reserveIdempotencyKeyReturnsOnCall[len(fake.reserveIdempotencyKeyArgsForCall)]
+	fake.reserveIdempotencyKeyArgsForCall = append(fake.reserveIdempotencyKeyArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 time.Time
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ReserveIdempotencyKeyStub
+	fakeReturns := fake.reserveIdempotencyKeyReturns
+	fake.recordInvocation("ReserveIdempotencyKey", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.reserveIdempotencyKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKeyCallCount() int {
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
+	return len(fake.reserveIdempotencyKeyArgsForCall)
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKeyCalls(stub func(context.Context, lager.Logger, string, string, time.Time) (*models.IdempotencyKey, error)) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = stub
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKeyArgsForCall(i int) (context.Context, lager.Logger, string, string, time.Time) {
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
+	argsForCall := fake.reserveIdempotencyKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKeyReturns(result1 *models.IdempotencyKey, result2 error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = nil
+	fake.reserveIdempotencyKeyReturns = struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyDB) ReserveIdempotencyKeyReturnsOnCall(i int, result1 *models.IdempotencyKey, result2 error) {
+	fake.reserveIdempotencyKeyMutex.Lock()
+	defer fake.reserveIdempotencyKeyMutex.Unlock()
+	fake.ReserveIdempotencyKeyStub = nil
+	if fake.reserveIdempotencyKeyReturnsOnCall == nil {
+		fake.reserveIdempotencyKeyReturnsOnCall = make(map[int]struct {
+			result1 *models.IdempotencyKey
+			result2 error
+		})
+	}
+	fake.reserveIdempotencyKeyReturnsOnCall[i] = struct {
+		result1 *models.IdempotencyKey
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeIdempotencyKeyDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.completeIdempotencyKeyMutex.RLock()
+	defer fake.completeIdempotencyKeyMutex.RUnlock()
+	fake.deleteIdempotencyKeysBeforeMutex.RLock()
+	defer fake.deleteIdempotencyKeysBeforeMutex.RUnlock()
+	fake.releaseIdempotencyKeyMutex.RLock()
+	defer fake.releaseIdempotencyKeyMutex.RUnlock()
+	fake.reserveIdempotencyKeyMutex.RLock()
+	defer fake.reserveIdempotencyKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeIdempotencyKeyDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.IdempotencyKeyDB = new(FakeIdempotencyKeyDB)
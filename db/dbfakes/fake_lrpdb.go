@@ -283,6 +283,57 @@ type FakeLRPDB struct {
 		result2 *models.ActualLRP
 		result3 error
 	}
+	ListActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)
+	listActualLRPsMutex       sync.RWMutex
+	listActualLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	listActualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listActualLRPsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListDesiredLRPSchedulingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)
+	listDesiredLRPSchedulingInfosMutex       sync.RWMutex
+	listDesiredLRPSchedulingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPSchedulingInfosReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPSchedulingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}
+	ListDesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)
+	listDesiredLRPsMutex       sync.RWMutex
+	listDesiredLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	listDesiredLRPsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
+	listDesiredLRPsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}
 	RemoveActualLRPStub        func(context.Context, lager.Logger, string, int32, *models.ActualLRPInstanceKey) error
 	removeActualLRPMutex       sync.RWMutex
 	removeActualLRPArgsForCall []struct {
@@ -1537,6 +1588,213 @@ func (fake *FakeLRPDB) FailActualLRPReturnsOnCall(i int, result1 *models.ActualL
 	}{result1, result2, result3}
 }
 
+func (fake *FakeLRPDB) ListActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error) {
+	fake.listActualLRPsMutex.Lock()
+	ret, specificReturn := fake.listActualLRPsReturnsOnCall[len(fake.listActualLRPsArgsForCall)]
+	fake.listActualLRPsArgsForCall = append(fake.listActualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListActualLRPsStub
+	fakeReturns := fake.listActualLRPsReturns
+	fake.recordInvocation("ListActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listActualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLRPDB) ListActualLRPsCallCount() int {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	return len(fake.listActualLRPsArgsForCall)
+}
+
+func (fake *FakeLRPDB) ListActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, db.ListMetadata, error)) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = stub
+}
+
+func (fake *FakeLRPDB) ListActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	argsForCall := fake.listActualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) ListActualLRPsReturns(result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	fake.listActualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLRPDB) ListActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 db.ListMetadata, result3 error) {
+	fake.listActualLRPsMutex.Lock()
+	defer fake.listActualLRPsMutex.Unlock()
+	fake.ListActualLRPsStub = nil
+	if fake.listActualLRPsReturnsOnCall == nil {
+		fake.listActualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listActualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPSchedulingInfosReturnsOnCall[len(fake.listDesiredLRPSchedulingInfosArgsForCall)]
+	fake.listDesiredLRPSchedulingInfosArgsForCall = append(fake.listDesiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPSchedulingInfosStub
+	fakeReturns := fake.listDesiredLRPSchedulingInfosReturns
+	fake.recordInvocation("ListDesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfosCallCount() int {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.listDesiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, db.ListMetadata, error)) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	fake.listDesiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPSchedulingInfosMutex.Lock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.Unlock()
+	fake.ListDesiredLRPSchedulingInfosStub = nil
+	if fake.listDesiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.listDesiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error) {
+	fake.listDesiredLRPsMutex.Lock()
+	ret, specificReturn := fake.listDesiredLRPsReturnsOnCall[len(fake.listDesiredLRPsArgsForCall)]
+	fake.listDesiredLRPsArgsForCall = append(fake.listDesiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListDesiredLRPsStub
+	fakeReturns := fake.listDesiredLRPsReturns
+	fake.recordInvocation("ListDesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.listDesiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPsCallCount() int {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	return len(fake.listDesiredLRPsArgsForCall)
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, db.ListMetadata, error)) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = stub
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
+	argsForCall := fake.listDesiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPsReturns(result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	fake.listDesiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLRPDB) ListDesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 db.ListMetadata, result3 error) {
+	fake.listDesiredLRPsMutex.Lock()
+	defer fake.listDesiredLRPsMutex.Unlock()
+	fake.ListDesiredLRPsStub = nil
+	if fake.listDesiredLRPsReturnsOnCall == nil {
+		fake.listDesiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listDesiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeLRPDB) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 *models.ActualLRPInstanceKey) error {
 	fake.removeActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
@@ -1991,6 +2249,12 @@ func (fake *FakeLRPDB) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPsMutex.RUnlock()
 	fake.failActualLRPMutex.RLock()
 	defer fake.failActualLRPMutex.RUnlock()
+	fake.listActualLRPsMutex.RLock()
+	defer fake.listActualLRPsMutex.RUnlock()
+	fake.listDesiredLRPSchedulingInfosMutex.RLock()
+	defer fake.listDesiredLRPSchedulingInfosMutex.RUnlock()
+	fake.listDesiredLRPsMutex.RLock()
+	defer fake.listDesiredLRPsMutex.RUnlock()
 	fake.removeActualLRPMutex.RLock()
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeResourceVersionDB struct {
+	ResourceVersionStub        func(context.Context, lager.Logger) (uint64, error)
+	resourceVersionMutex       sync.RWMutex
+	resourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	resourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	resourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersion(arg1 context.Context, arg2 lager.Logger) (uint64, error) {
+	fake.resourceVersionMutex.Lock()
+	ret, specificReturn := fake.resourceVersionReturnsOnCall[len(fake.resourceVersionArgsForCall)]
+	fake.resourceVersionArgsForCall = append(fake.resourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.ResourceVersionStub
+	fakeReturns := fake.resourceVersionReturns
+	fake.recordInvocation("ResourceVersion", []interface{}{arg1, arg2})
+	fake.resourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersionCallCount() int {
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	return len(fake.resourceVersionArgsForCall)
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersionCalls(stub func(context.Context, lager.Logger) (uint64, error)) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = stub
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersionArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	argsForCall := fake.resourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersionReturns(result1 uint64, result2 error) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = nil
+	fake.resourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceVersionDB) ResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.resourceVersionMutex.Lock()
+	defer fake.resourceVersionMutex.Unlock()
+	fake.ResourceVersionStub = nil
+	if fake.resourceVersionReturnsOnCall == nil {
+		fake.resourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.resourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResourceVersionDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.resourceVersionMutex.RLock()
+	defer fake.resourceVersionMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeResourceVersionDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ResourceVersionDB = new(FakeResourceVersionDB)
@@ -0,0 +1,710 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeScheduledTaskDB struct {
+	DeleteScheduledTaskStub        func(context.Context, lager.Logger, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DesireScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	DueScheduledTasksStub        func(context.Context, lager.Logger, time.Time) ([]*models.ScheduledTask, error)
+	dueScheduledTasksMutex       sync.RWMutex
+	dueScheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}
+	dueScheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	dueScheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	RecordScheduledTaskRunStub        func(context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) (*models.ScheduledTask, error)
+	recordScheduledTaskRunMutex       sync.RWMutex
+	recordScheduledTaskRunArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 *models.ScheduledTaskRun
+		arg6 int64
+	}
+	recordScheduledTaskRunReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	recordScheduledTaskRunReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTaskByGuidStub        func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)
+	scheduledTaskByGuidMutex       sync.RWMutex
+	scheduledTaskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTaskByGuidReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	scheduledTaskByGuidReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	ScheduledTasksStub        func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetScheduledTaskSuspendedStub        func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)
+	setScheduledTaskSuspendedMutex       sync.RWMutex
+	setScheduledTaskSuspendedArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	setScheduledTaskSuspendedReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	setScheduledTaskSuspendedReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	UpdateScheduledTaskStub        func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskDB) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 time.Time) ([]*models.ScheduledTask, error) {
+	fake.dueScheduledTasksMutex.Lock()
+	ret, specificReturn := fake.dueScheduledTasksReturnsOnCall[len(fake.dueScheduledTasksArgsForCall)]
+	fake.dueScheduledTasksArgsForCall = append(fake.dueScheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	stub := fake.DueScheduledTasksStub
+	fakeReturns := fake.dueScheduledTasksReturns
+	fake.recordInvocation("DueScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.dueScheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasksCallCount() int {
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
+	return len(fake.dueScheduledTasksArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasksCalls(stub func(context.Context, lager.Logger, time.Time) ([]*models.ScheduledTask, error)) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, time.Time) {
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
+	argsForCall := fake.dueScheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = nil
+	fake.dueScheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) DueScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.dueScheduledTasksMutex.Lock()
+	defer fake.dueScheduledTasksMutex.Unlock()
+	fake.DueScheduledTasksStub = nil
+	if fake.dueScheduledTasksReturnsOnCall == nil {
+		fake.dueScheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.dueScheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRun(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int64, arg5 *models.ScheduledTaskRun, arg6 int64) (*models.ScheduledTask, error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	ret, specificReturn := fake.recordScheduledTaskRunReturnsOnCall[len(fake.recordScheduledTaskRunArgsForCall)]
+	fake.recordScheduledTaskRunArgsForCall = append(fake.recordScheduledTaskRunArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int64
+		arg5 *models.ScheduledTaskRun
+		arg6 int64
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.RecordScheduledTaskRunStub
+	fakeReturns := fake.recordScheduledTaskRunReturns
+	fake.recordInvocation("RecordScheduledTaskRun", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.recordScheduledTaskRunMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRunCallCount() int {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	return len(fake.recordScheduledTaskRunArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRunCalls(stub func(context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) (*models.ScheduledTask, error)) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRunArgsForCall(i int) (context.Context, lager.Logger, string, int64, *models.ScheduledTaskRun, int64) {
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	argsForCall := fake.recordScheduledTaskRunArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRunReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	fake.recordScheduledTaskRunReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) RecordScheduledTaskRunReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.recordScheduledTaskRunMutex.Lock()
+	defer fake.recordScheduledTaskRunMutex.Unlock()
+	fake.RecordScheduledTaskRunStub = nil
+	if fake.recordScheduledTaskRunReturnsOnCall == nil {
+		fake.recordScheduledTaskRunReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.recordScheduledTaskRunReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.ScheduledTask, error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	ret, specificReturn := fake.scheduledTaskByGuidReturnsOnCall[len(fake.scheduledTaskByGuidArgsForCall)]
+	fake.scheduledTaskByGuidArgsForCall = append(fake.scheduledTaskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTaskByGuidStub
+	fakeReturns := fake.scheduledTaskByGuidReturns
+	fake.recordInvocation("ScheduledTaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTaskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuidCallCount() int {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	return len(fake.scheduledTaskByGuidArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.ScheduledTask, error)) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	argsForCall := fake.scheduledTaskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuidReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	fake.scheduledTaskByGuidReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTaskByGuidReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.scheduledTaskByGuidMutex.Lock()
+	defer fake.scheduledTaskByGuidMutex.Unlock()
+	fake.ScheduledTaskByGuidStub = nil
+	if fake.scheduledTaskByGuidReturnsOnCall == nil {
+		fake.scheduledTaskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTaskByGuidReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasksCalls(stub func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspended(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	ret, specificReturn := fake.setScheduledTaskSuspendedReturnsOnCall[len(fake.setScheduledTaskSuspendedArgsForCall)]
+	fake.setScheduledTaskSuspendedArgsForCall = append(fake.setScheduledTaskSuspendedArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SetScheduledTaskSuspendedStub
+	fakeReturns := fake.setScheduledTaskSuspendedReturns
+	fake.recordInvocation("SetScheduledTaskSuspended", []interface{}{arg1, arg2, arg3, arg4})
+	fake.setScheduledTaskSuspendedMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspendedCallCount() int {
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
+	return len(fake.setScheduledTaskSuspendedArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspendedCalls(stub func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspendedArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
+	argsForCall := fake.setScheduledTaskSuspendedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspendedReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = nil
+	fake.setScheduledTaskSuspendedReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) SetScheduledTaskSuspendedReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.setScheduledTaskSuspendedMutex.Lock()
+	defer fake.setScheduledTaskSuspendedMutex.Unlock()
+	fake.SetScheduledTaskSuspendedStub = nil
+	if fake.setScheduledTaskSuspendedReturnsOnCall == nil {
+		fake.setScheduledTaskSuspendedReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.setScheduledTaskSuspendedReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTaskCalls(stub func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeScheduledTaskDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	fake.dueScheduledTasksMutex.RLock()
+	defer fake.dueScheduledTasksMutex.RUnlock()
+	fake.recordScheduledTaskRunMutex.RLock()
+	defer fake.recordScheduledTaskRunMutex.RUnlock()
+	fake.scheduledTaskByGuidMutex.RLock()
+	defer fake.scheduledTaskByGuidMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setScheduledTaskSuspendedMutex.RLock()
+	defer fake.setScheduledTaskSuspendedMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeScheduledTaskDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ScheduledTaskDB = new(FakeScheduledTaskDB)
@@ -0,0 +1,548 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeTaskCallbackDB struct {
+	ClaimDueTaskCallbacksStub        func(context.Context, lager.Logger, time.Time, time.Time, int) ([]*models.TaskCallback, error)
+	claimDueTaskCallbacksMutex       sync.RWMutex
+	claimDueTaskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+		arg4 time.Time
+		arg5 int
+	}
+	claimDueTaskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	claimDueTaskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	DeleteTaskCallbackStub        func(context.Context, lager.Logger, string) error
+	deleteTaskCallbackMutex       sync.RWMutex
+	deleteTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteTaskCallbackReturns struct {
+		result1 error
+	}
+	deleteTaskCallbackReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnqueueTaskCallbackStub        func(context.Context, lager.Logger, string, time.Time) (*models.TaskCallback, error)
+	enqueueTaskCallbackMutex       sync.RWMutex
+	enqueueTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Time
+	}
+	enqueueTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	enqueueTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	RecordTaskCallbackAttemptStub        func(context.Context, lager.Logger, string, int32, string, time.Time, bool) (*models.TaskCallback, error)
+	recordTaskCallbackAttemptMutex       sync.RWMutex
+	recordTaskCallbackAttemptArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 string
+		arg6 time.Time
+		arg7 bool
+	}
+	recordTaskCallbackAttemptReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	recordTaskCallbackAttemptReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ReplayTaskCallbackStub        func(context.Context, lager.Logger, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	TaskCallbacksStub        func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 time.Time, arg4 time.Time, arg5 int) ([]*models.TaskCallback, error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	ret, specificReturn := fake.claimDueTaskCallbacksReturnsOnCall[len(fake.claimDueTaskCallbacksArgsForCall)]
+	fake.claimDueTaskCallbacksArgsForCall = append(fake.claimDueTaskCallbacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 time.Time
+		arg4 time.Time
+		arg5 int
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.ClaimDueTaskCallbacksStub
+	fakeReturns := fake.claimDueTaskCallbacksReturns
+	fake.recordInvocation("ClaimDueTaskCallbacks", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.claimDueTaskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacksCallCount() int {
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	return len(fake.claimDueTaskCallbacksArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacksCalls(stub func(context.Context, lager.Logger, time.Time, time.Time, int) ([]*models.TaskCallback, error)) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, time.Time, time.Time, int) {
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	argsForCall := fake.claimDueTaskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = nil
+	fake.claimDueTaskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) ClaimDueTaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.claimDueTaskCallbacksMutex.Lock()
+	defer fake.claimDueTaskCallbacksMutex.Unlock()
+	fake.ClaimDueTaskCallbacksStub = nil
+	if fake.claimDueTaskCallbacksReturnsOnCall == nil {
+		fake.claimDueTaskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.claimDueTaskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.deleteTaskCallbackReturnsOnCall[len(fake.deleteTaskCallbackArgsForCall)]
+	fake.deleteTaskCallbackArgsForCall = append(fake.deleteTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteTaskCallbackStub
+	fakeReturns := fake.deleteTaskCallbackReturns
+	fake.recordInvocation("DeleteTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallbackCallCount() int {
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	return len(fake.deleteTaskCallbackArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallbackCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	argsForCall := fake.deleteTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallbackReturns(result1 error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = nil
+	fake.deleteTaskCallbackReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskCallbackDB) DeleteTaskCallbackReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskCallbackMutex.Lock()
+	defer fake.deleteTaskCallbackMutex.Unlock()
+	fake.DeleteTaskCallbackStub = nil
+	if fake.deleteTaskCallbackReturnsOnCall == nil {
+		fake.deleteTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskCallbackReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Time) (*models.TaskCallback, error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.enqueueTaskCallbackReturnsOnCall[len(fake.enqueueTaskCallbackArgsForCall)]
+	fake.enqueueTaskCallbackArgsForCall = append(fake.enqueueTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EnqueueTaskCallbackStub
+	fakeReturns := fake.enqueueTaskCallbackReturns
+	fake.recordInvocation("EnqueueTaskCallback", []interface{}{arg1, arg2, arg3, arg4})
+	fake.enqueueTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallbackCallCount() int {
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
+	return len(fake.enqueueTaskCallbackArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallbackCalls(stub func(context.Context, lager.Logger, string, time.Time) (*models.TaskCallback, error)) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string, time.Time) {
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
+	argsForCall := fake.enqueueTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = nil
+	fake.enqueueTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) EnqueueTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.enqueueTaskCallbackMutex.Lock()
+	defer fake.enqueueTaskCallbackMutex.Unlock()
+	fake.EnqueueTaskCallbackStub = nil
+	if fake.enqueueTaskCallbackReturnsOnCall == nil {
+		fake.enqueueTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.enqueueTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttempt(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32, arg5 string, arg6 time.Time, arg7 bool) (*models.TaskCallback, error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	ret, specificReturn := fake.recordTaskCallbackAttemptReturnsOnCall[len(fake.recordTaskCallbackAttemptArgsForCall)]
+	fake.recordTaskCallbackAttemptArgsForCall = append(fake.recordTaskCallbackAttemptArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+		arg5 string
+		arg6 time.Time
+		arg7 bool
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.RecordTaskCallbackAttemptStub
+	fakeReturns := fake.recordTaskCallbackAttemptReturns
+	fake.recordInvocation("RecordTaskCallbackAttempt", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.recordTaskCallbackAttemptMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttemptCallCount() int {
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
+	return len(fake.recordTaskCallbackAttemptArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttemptCalls(stub func(context.Context, lager.Logger, string, int32, string, time.Time, bool) (*models.TaskCallback, error)) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttemptArgsForCall(i int) (context.Context, lager.Logger, string, int32, string, time.Time, bool) {
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
+	argsForCall := fake.recordTaskCallbackAttemptArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttemptReturns(result1 *models.TaskCallback, result2 error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = nil
+	fake.recordTaskCallbackAttemptReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) RecordTaskCallbackAttemptReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.recordTaskCallbackAttemptMutex.Lock()
+	defer fake.recordTaskCallbackAttemptMutex.Unlock()
+	fake.RecordTaskCallbackAttemptStub = nil
+	if fake.recordTaskCallbackAttemptReturnsOnCall == nil {
+		fake.recordTaskCallbackAttemptReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.recordTaskCallbackAttemptReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallbackCalls(stub func(context.Context, lager.Logger, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacksCalls(stub func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTaskCallbackDB) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.claimDueTaskCallbacksMutex.RLock()
+	defer fake.claimDueTaskCallbacksMutex.RUnlock()
+	fake.deleteTaskCallbackMutex.RLock()
+	defer fake.deleteTaskCallbackMutex.RUnlock()
+	fake.enqueueTaskCallbackMutex.RLock()
+	defer fake.enqueueTaskCallbackMutex.RUnlock()
+	fake.recordTaskCallbackAttemptMutex.RLock()
+	defer fake.recordTaskCallbackAttemptMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeTaskCallbackDB) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.TaskCallbackDB = new(FakeTaskCallbackDB)
@@ -118,6 +118,23 @@ type FakeTaskDB struct {
 		result2 *models.Task
 		result3 error
 	}
+	ListTasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)
+	listTasksMutex       sync.RWMutex
+	listTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	listTasksReturns struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
+	listTasksReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
 	RejectTaskStub        func(context.Context, lager.Logger, string, string) (*models.Task, *models.Task, error)
 	rejectTaskMutex       sync.RWMutex
 	rejectTaskArgsForCall []struct {
@@ -622,6 +639,75 @@ func (fake *FakeTaskDB) FailTaskReturnsOnCall(i int, result1 *models.Task, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTaskDB) ListTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, db.ListMetadata, error) {
+	fake.listTasksMutex.Lock()
+	ret, specificReturn := fake.listTasksReturnsOnCall[len(fake.listTasksArgsForCall)]
+	fake.listTasksArgsForCall = append(fake.listTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListTasksStub
+	fakeReturns := fake.listTasksReturns
+	fake.recordInvocation("ListTasks", []interface{}{arg1, arg2, arg3})
+	fake.listTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskDB) ListTasksCallCount() int {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	return len(fake.listTasksArgsForCall)
+}
+
+func (fake *FakeTaskDB) ListTasksCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = stub
+}
+
+func (fake *FakeTaskDB) ListTasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	argsForCall := fake.listTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDB) ListTasksReturns(result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	fake.listTasksReturns = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskDB) ListTasksReturnsOnCall(i int, result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	if fake.listTasksReturnsOnCall == nil {
+		fake.listTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listTasksReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskDB) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (*models.Task, *models.Task, error) {
 	fake.rejectTaskMutex.Lock()
 	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
@@ -981,6 +1067,8 @@ func (fake *FakeTaskDB) Invocations() map[string][][]interface{} {
 	defer fake.desireTaskMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
@@ -0,0 +1,32 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . DeploymentDB
+
+type DeploymentDB interface {
+	// StartDeployment replaces the run info of the DesiredLRP with runInfo and
+	// adds maxSurge instances to it. It returns the DesiredLRP as it was
+	// before the deployment.
+	StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (beforeDesiredLRP *models.DesiredLRP, deployment *models.Deployment, err error)
+	DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+	ActiveDeployments(ctx context.Context, logger lager.Logger) ([]*models.Deployment, error)
+
+	// UpdateDeploymentProgress records the pending indices and retiring
+	// instances of the deployment, provided it has not changed state since
+	// it was read.
+	UpdateDeploymentProgress(ctx context.Context, logger lager.Logger, deployment *models.Deployment) error
+	SetDeploymentPaused(ctx context.Context, logger lager.Logger, processGuid string, paused bool) (*models.Deployment, error)
+	// RollbackDeployment restores the run info the deployment replaced. It
+	// returns the DesiredLRP as it was before.
+	RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (beforeDesiredLRP *models.DesiredLRP, deployment *models.Deployment, err error)
+	// CompleteDeployment removes the surge instances from the DesiredLRP and
+	// marks the deployment as succeeded or rolled back. It returns the
+	// DesiredLRP as it was before.
+	CompleteDeployment(ctx context.Context, logger lager.Logger, processGuid string) (beforeDesiredLRP *models.DesiredLRP, deployment *models.Deployment, err error)
+}
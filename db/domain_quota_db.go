@@ -0,0 +1,24 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . DomainQuotaDB
+
+type DomainQuotaDB interface {
+	DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error)
+	// DomainQuotaByDomain returns ErrResourceNotFound if the domain has no
+	// quota.
+	DomainQuotaByDomain(ctx context.Context, logger lager.Logger, domain string) (*models.DomainQuota, error)
+	// SetDomainQuota creates or replaces the quota of the domain.
+	SetDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) (*models.DomainQuota, error)
+	RemoveDomainQuota(ctx context.Context, logger lager.Logger, domain string) error
+
+	// DomainUsage sums the resources of the desired LRP instances and the
+	// active tasks of the domain.
+	DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, error)
+}
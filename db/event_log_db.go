@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// StoredEvent is an event as recorded in a persistent event log. Payload
+// holds the marshaled event, and ResourceVersion the resource version the
+// event was emitted at.
+type StoredEvent struct {
+	ID              uint64
+	EventType       string
+	Payload         []byte
+	ResourceVersion uint64
+}
+
+//counterfeiter:generate . EventLogDB
+type EventLogDB interface {
+	InsertEvent(ctx context.Context, logger lager.Logger, stream string, event StoredEvent) error
+	// EventsSince returns the events of the stream with an ID of at least id,
+	// oldest first, so that callers can tell whether id itself is retained.
+	EventsSince(ctx context.Context, logger lager.Logger, stream string, id uint64) ([]StoredEvent, error)
+	LastEventID(ctx context.Context, logger lager.Logger, stream string) (uint64, error)
+	// EventIDAtResourceVersion returns the ID of the most recent event of the
+	// stream emitted at or before the resource version, or 0 if there is none.
+	EventIDAtResourceVersion(ctx context.Context, logger lager.Logger, stream string, resourceVersion uint64) (uint64, error)
+	DeleteEventsBefore(ctx context.Context, logger lager.Logger, stream string, id uint64) error
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . IdempotencyKeyDB
+
+// IdempotencyKeyDB stores the responses of the calls made with an
+// idempotency key, so that retried calls are not served twice.
+type IdempotencyKeyDB interface {
+	// ReserveIdempotencyKey records that a call with the key and request hash
+	// is being served, and returns nil. When the key is already recorded
+	// since expiredBefore, it returns that record instead; an older record is
+	// replaced. It returns ErrResourceExists if another call reserves the key
+	// at the same time.
+	ReserveIdempotencyKey(ctx context.Context, logger lager.Logger, key, requestHash string, expiredBefore time.Time) (*models.IdempotencyKey, error)
+	// CompleteIdempotencyKey stores the response of the call that reserved
+	// the key.
+	CompleteIdempotencyKey(ctx context.Context, logger lager.Logger, key string, response []byte) error
+	// ReleaseIdempotencyKey removes the key reserved by a call that failed,
+	// so that it is served again when retried.
+	ReleaseIdempotencyKey(ctx context.Context, logger lager.Logger, key string) error
+	// DeleteIdempotencyKeysBefore prunes the keys recorded before the given
+	// time and returns how many were deleted.
+	DeleteIdempotencyKeysBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error)
+}
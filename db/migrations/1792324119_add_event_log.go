@@ -0,0 +1,64 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddEventLog())
+}
+
+type AddEventLog struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddEventLog() migration.Migration {
+	return &AddEventLog{}
+}
+
+func (e *AddEventLog) String() string {
+	return migrationString(e)
+}
+
+func (e *AddEventLog) Version() int64 {
+	return 1792324119
+}
+
+func (e *AddEventLog) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddEventLog) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddEventLog) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddEventLog) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-event-log")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS event_log(
+	stream VARCHAR(255) NOT NULL,
+	id BIGINT NOT NULL,
+	event_type VARCHAR(255) NOT NULL,
+	payload MEDIUMTEXT NOT NULL,
+	PRIMARY KEY (stream, id)
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddEventLog", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE event_log;")
+
+		migration = migrations.NewAddEventLog()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792324119))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO event_log (stream, id, event_type, payload) VALUES (?, ?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "tasks", 1, "task_created", "payload")
+			Expect(err).NotTo(HaveOccurred())
+
+			querySQL := "SELECT event_type, payload FROM event_log WHERE stream = ? AND id = ?"
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "tasks", 1)
+			var eventType, payload string
+			Expect(row.Scan(&eventType, &payload)).To(Succeed())
+			Expect(eventType).To(Equal("task_created"))
+			Expect(payload).To(Equal("payload"))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
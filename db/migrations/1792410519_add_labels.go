@@ -0,0 +1,106 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddLabels())
+}
+
+type AddLabels struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddLabels() migration.Migration {
+	return &AddLabels{}
+}
+
+func (e *AddLabels) String() string {
+	return migrationString(e)
+}
+
+func (e *AddLabels) Version() int64 {
+	return 1792410519
+}
+
+func (e *AddLabels) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddLabels) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddLabels) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddLabels) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-labels")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var alterDesiredLRPsSQL string
+	if e.dbFlavor == helpers.MySQL {
+		alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
+	ADD COLUMN labels MEDIUMTEXT;`
+	} else {
+		alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
+	ADD COLUMN IF NOT EXISTS labels TEXT;`
+	}
+	logger.Info("altering-table", lager.Data{"query": alterDesiredLRPsSQL})
+	_, err := tx.Exec(alterDesiredLRPsSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+
+	createTablesSQL := []string{
+		`CREATE TABLE IF NOT EXISTS desired_lrp_labels(
+	process_guid VARCHAR(255) NOT NULL,
+	label_key VARCHAR(255) NOT NULL,
+	label_value VARCHAR(255) NOT NULL,
+	PRIMARY KEY (process_guid, label_key)
+);`,
+		`CREATE TABLE IF NOT EXISTS task_labels(
+	task_guid VARCHAR(255) NOT NULL,
+	label_key VARCHAR(255) NOT NULL,
+	label_value VARCHAR(255) NOT NULL,
+	PRIMARY KEY (task_guid, label_key)
+);`,
+	}
+
+	logger.Info("creating-tables")
+	for _, query := range createTablesSQL {
+		_, err := tx.Exec(helpers.RebindForFlavor(query, e.dbFlavor))
+		if err != nil {
+			logger.Error("failed-creating-table", err)
+			return err
+		}
+	}
+
+	createIndicesSQL := []string{
+		`CREATE INDEX desired_lrp_labels_key_value_idx ON desired_lrp_labels (label_key, label_value)`,
+		`CREATE INDEX task_labels_key_value_idx ON task_labels (label_key, label_value)`,
+	}
+
+	logger.Info("creating-indices")
+	for _, query := range createIndicesSQL {
+		if e.dbFlavor != helpers.MySQL {
+			query = strings.Replace(query, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+		}
+		_, err := tx.Exec(query)
+		if err != nil && !isDuplicateIndexError(err) {
+			logger.Error("failed-creating-index", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddLabels", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE desired_lrp_labels;")
+		rawSQLDB.Exec("DROP TABLE task_labels;")
+
+		mig = migrations.NewAddLabels()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1792410519))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				testUpInTransaction(rawSQLDB, m, logger)
+			}
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+			mig.SetClock(fakeClock)
+		})
+
+		It("adds a labels column to desired lrps", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO desired_lrps
+						  (process_guid, domain, log_guid, instances, memory_mb,
+							  disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info, labels)
+						  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain",
+				"log guid", 2, 1, 1, "rootfs", "routes", "volumes yo", "1", "run info", `{"team":"a"}`,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var labels string
+			row := rawSQLDB.QueryRow("SELECT labels FROM desired_lrps")
+			Expect(row.Scan(&labels)).To(Succeed())
+			Expect(labels).To(Equal(`{"team":"a"}`))
+		})
+
+		It("adds the label tables", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			for _, insertSQL := range []string{
+				"INSERT INTO desired_lrp_labels (process_guid, label_key, label_value) VALUES (?, ?, ?)",
+				"INSERT INTO task_labels (task_guid, label_key, label_value) VALUES (?, ?, ?)",
+			} {
+				_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "guid", "team", "a")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "guid", "team", "b")
+				Expect(err).To(HaveOccurred())
+			}
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
@@ -0,0 +1,72 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddDeployments())
+}
+
+type AddDeployments struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddDeployments() migration.Migration {
+	return &AddDeployments{}
+}
+
+func (e *AddDeployments) String() string {
+	return migrationString(e)
+}
+
+func (e *AddDeployments) Version() int64 {
+	return 1792497919
+}
+
+func (e *AddDeployments) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddDeployments) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddDeployments) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddDeployments) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-deployments")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS deployments(
+	process_guid VARCHAR(255) PRIMARY KEY,
+	guid VARCHAR(255) NOT NULL,
+	domain VARCHAR(255) NOT NULL,
+	state INT NOT NULL DEFAULT 0,
+	paused BOOL DEFAULT false,
+	max_surge INT NOT NULL DEFAULT 0,
+	max_unavailable INT NOT NULL DEFAULT 0,
+	instances INT NOT NULL DEFAULT 0,
+	pending_indices MEDIUMTEXT,
+	retiring_instances MEDIUMTEXT,
+	previous_run_info MEDIUMTEXT,
+	created_at BIGINT NOT NULL DEFAULT 0,
+	updated_at BIGINT NOT NULL DEFAULT 0
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddDeployments", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE deployments;")
+
+		migration = migrations.NewAddDeployments()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792497919))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO deployments (process_guid, guid, domain, max_surge, pending_indices) VALUES (?, ?, ?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "process-guid", "deployment-guid", "domain", 1, "[0,1]")
+			Expect(err).NotTo(HaveOccurred())
+
+			querySQL := "SELECT guid, state, paused, max_surge, pending_indices FROM deployments WHERE process_guid = ?"
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "process-guid")
+			var guid, pendingIndices string
+			var state, maxSurge int
+			var paused bool
+			Expect(row.Scan(&guid, &state, &paused, &maxSurge, &pendingIndices)).To(Succeed())
+			Expect(guid).To(Equal("deployment-guid"))
+			Expect(state).To(Equal(0))
+			Expect(paused).To(BeFalse())
+			Expect(maxSurge).To(Equal(1))
+			Expect(pendingIndices).To(Equal("[0,1]"))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
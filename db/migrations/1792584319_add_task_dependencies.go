@@ -0,0 +1,92 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddTaskDependencies())
+}
+
+type AddTaskDependencies struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddTaskDependencies() migration.Migration {
+	return &AddTaskDependencies{}
+}
+
+func (e *AddTaskDependencies) String() string {
+	return migrationString(e)
+}
+
+func (e *AddTaskDependencies) Version() int64 {
+	return 1792584319
+}
+
+func (e *AddTaskDependencies) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddTaskDependencies) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddTaskDependencies) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddTaskDependencies) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-task-dependencies")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var alterTasksSQL string
+	if e.dbFlavor == helpers.MySQL {
+		alterTasksSQL = `ALTER TABLE tasks
+	ADD COLUMN promoted_at BIGINT DEFAULT 0;`
+	} else {
+		alterTasksSQL = `ALTER TABLE tasks
+	ADD COLUMN IF NOT EXISTS promoted_at BIGINT DEFAULT 0;`
+	}
+	logger.Info("altering-table", lager.Data{"query": alterTasksSQL})
+	_, err := tx.Exec(alterTasksSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS task_dependencies(
+	task_guid VARCHAR(255) NOT NULL,
+	parent_guid VARCHAR(255) NOT NULL,
+	parent_completed BOOL NOT NULL DEFAULT false,
+	parent_failed BOOL NOT NULL DEFAULT false,
+	PRIMARY KEY (task_guid, parent_guid)
+);`
+
+	logger.Info("creating-table")
+	_, err = tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	createIndexSQL := `CREATE INDEX task_dependencies_parent_guid_idx ON task_dependencies (parent_guid)`
+	if e.dbFlavor != helpers.MySQL {
+		createIndexSQL = strings.Replace(createIndexSQL, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+	}
+
+	logger.Info("creating-index")
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+
+	return nil
+}
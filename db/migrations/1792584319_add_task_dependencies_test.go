@@ -0,0 +1,99 @@
+package migrations_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddTaskDependencies", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+		rawSQLDB.Exec("DROP TABLE task_dependencies;")
+
+		mig = migrations.NewAddTaskDependencies()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1792584319))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				testUpInTransaction(rawSQLDB, m, logger)
+			}
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+			mig.SetClock(fakeClock)
+		})
+
+		It("adds a promoted_at column to tasks defaulting to zero", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks
+						  (guid, domain, created_at, updated_at, first_completed_at, state, cell_id, result, failed, failure_reason, task_definition)
+						  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain", 1, 1, 0, 5, "", "", false, "", "task definition",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var promotedAt int64
+			row := rawSQLDB.QueryRow("SELECT promoted_at FROM tasks")
+			Expect(row.Scan(&promotedAt)).To(Succeed())
+			Expect(promotedAt).To(BeEquivalentTo(0))
+		})
+
+		It("adds the task dependencies table", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			insertSQL := "INSERT INTO task_dependencies (task_guid, parent_guid) VALUES (?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "child", "parent")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "child", "parent")
+			Expect(err).To(HaveOccurred())
+
+			var completed, failed bool
+			row := rawSQLDB.QueryRow("SELECT parent_completed, parent_failed FROM task_dependencies")
+			Expect(row.Scan(&completed, &failed)).To(Succeed())
+			Expect(completed).To(BeFalse())
+			Expect(failed).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddScheduledTasks())
+}
+
+type AddScheduledTasks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddScheduledTasks() migration.Migration {
+	return &AddScheduledTasks{}
+}
+
+func (e *AddScheduledTasks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddScheduledTasks) Version() int64 {
+	return 1792670719
+}
+
+func (e *AddScheduledTasks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddScheduledTasks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddScheduledTasks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddScheduledTasks) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-scheduled-tasks")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS scheduled_tasks(
+	guid VARCHAR(255) PRIMARY KEY,
+	domain VARCHAR(255) NOT NULL,
+	cron_expression VARCHAR(255) NOT NULL,
+	time_zone VARCHAR(255) NOT NULL DEFAULT '',
+	concurrency_policy INT NOT NULL DEFAULT 0,
+	history_limit INT NOT NULL DEFAULT 0,
+	suspended BOOL DEFAULT false,
+	task_definition MEDIUMTEXT NOT NULL,
+	recent_runs MEDIUMTEXT,
+	next_run_at BIGINT NOT NULL DEFAULT 0,
+	created_at BIGINT NOT NULL DEFAULT 0,
+	updated_at BIGINT NOT NULL DEFAULT 0
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	createIndexSQL := `CREATE INDEX scheduled_tasks_next_run_at_idx ON scheduled_tasks (next_run_at)`
+	if e.dbFlavor != helpers.MySQL {
+		createIndexSQL = strings.Replace(createIndexSQL, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+	}
+
+	logger.Info("creating-index")
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddScheduledTasks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE scheduled_tasks;")
+
+		migration = migrations.NewAddScheduledTasks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792670719))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO scheduled_tasks (guid, domain, cron_expression, task_definition, next_run_at) VALUES (?, ?, ?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "schedule-guid", "domain", "*/5 * * * *", "task definition", 42)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "schedule-guid", "domain", "*/5 * * * *", "task definition", 42)
+			Expect(err).To(HaveOccurred())
+
+			querySQL := "SELECT cron_expression, time_zone, concurrency_policy, suspended, next_run_at FROM scheduled_tasks WHERE guid = ?"
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "schedule-guid")
+			var cronExpression, timeZone string
+			var policy int
+			var suspended bool
+			var nextRunAt int64
+			Expect(row.Scan(&cronExpression, &timeZone, &policy, &suspended, &nextRunAt)).To(Succeed())
+			Expect(cronExpression).To(Equal("*/5 * * * *"))
+			Expect(timeZone).To(Equal(""))
+			Expect(policy).To(Equal(0))
+			Expect(suspended).To(BeFalse())
+			Expect(nextRunAt).To(BeEquivalentTo(42))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
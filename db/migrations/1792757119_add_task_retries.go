@@ -0,0 +1,68 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddTaskRetries())
+}
+
+type AddTaskRetries struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddTaskRetries() migration.Migration {
+	return &AddTaskRetries{}
+}
+
+func (e *AddTaskRetries) String() string {
+	return migrationString(e)
+}
+
+func (e *AddTaskRetries) Version() int64 {
+	return 1792757119
+}
+
+func (e *AddTaskRetries) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddTaskRetries) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddTaskRetries) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddTaskRetries) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-task-retries")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	alterTasksSQLs := []string{
+		`ALTER TABLE tasks ADD COLUMN retry_at BIGINT DEFAULT 0;`,
+		`ALTER TABLE tasks ADD COLUMN failed_attempts MEDIUMTEXT;`,
+	}
+
+	for _, alterTasksSQL := range alterTasksSQLs {
+		if e.dbFlavor != helpers.MySQL {
+			alterTasksSQL = strings.Replace(alterTasksSQL, "ADD COLUMN", "ADD COLUMN IF NOT EXISTS", 1)
+		}
+
+		logger.Info("altering-table", lager.Data{"query": alterTasksSQL})
+		_, err := tx.Exec(helpers.RebindForFlavor(alterTasksSQL, e.dbFlavor))
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddTaskRetries", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		mig = migrations.NewAddTaskRetries()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1792757119))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				testUpInTransaction(rawSQLDB, m, logger)
+			}
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+			mig.SetClock(fakeClock)
+		})
+
+		It("adds the retry_at and failed_attempts columns to tasks", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks
+						  (guid, domain, created_at, updated_at, first_completed_at, state, cell_id, result, failed, failure_reason, task_definition)
+						  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain", 1, 1, 0, 1, "", "", false, "", "task definition",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var retryAt int64
+			var failedAttempts sql.NullString
+			row := rawSQLDB.QueryRow("SELECT retry_at, failed_attempts FROM tasks")
+			Expect(row.Scan(&retryAt, &failedAttempts)).To(Succeed())
+			Expect(retryAt).To(BeEquivalentTo(0))
+			Expect(failedAttempts.Valid).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
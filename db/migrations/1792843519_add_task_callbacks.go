@@ -0,0 +1,80 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddTaskCallbacks())
+}
+
+type AddTaskCallbacks struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddTaskCallbacks() migration.Migration {
+	return &AddTaskCallbacks{}
+}
+
+func (e *AddTaskCallbacks) String() string {
+	return migrationString(e)
+}
+
+func (e *AddTaskCallbacks) Version() int64 {
+	return 1792843519
+}
+
+func (e *AddTaskCallbacks) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddTaskCallbacks) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddTaskCallbacks) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddTaskCallbacks) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-task-callbacks")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS task_callbacks(
+	task_guid VARCHAR(255) PRIMARY KEY,
+	state INT NOT NULL DEFAULT 0,
+	attempts INT NOT NULL DEFAULT 0,
+	next_attempt_at BIGINT NOT NULL DEFAULT 0,
+	last_status_code INT NOT NULL DEFAULT 0,
+	last_error VARCHAR(1024) NOT NULL DEFAULT '',
+	created_at BIGINT NOT NULL DEFAULT 0,
+	updated_at BIGINT NOT NULL DEFAULT 0
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	createIndexSQL := `CREATE INDEX task_callbacks_next_attempt_at_idx ON task_callbacks (state, next_attempt_at)`
+	if e.dbFlavor != helpers.MySQL {
+		createIndexSQL = strings.Replace(createIndexSQL, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+	}
+
+	logger.Info("creating-index")
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+
+	return nil
+}
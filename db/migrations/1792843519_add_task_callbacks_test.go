@@ -0,0 +1,66 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddTaskCallbacks", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE task_callbacks;")
+
+		migration = migrations.NewAddTaskCallbacks()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792843519))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO task_callbacks (task_guid, next_attempt_at) VALUES (?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "task-guid", 42)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "task-guid", 42)
+			Expect(err).To(HaveOccurred())
+
+			querySQL := "SELECT state, attempts, next_attempt_at, last_status_code, last_error FROM task_callbacks WHERE task_guid = ?"
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "task-guid")
+			var state, attempts, lastStatusCode int
+			var nextAttemptAt int64
+			var lastError string
+			Expect(row.Scan(&state, &attempts, &nextAttemptAt, &lastStatusCode, &lastError)).To(Succeed())
+			Expect(state).To(Equal(0))
+			Expect(attempts).To(Equal(0))
+			Expect(nextAttemptAt).To(BeEquivalentTo(42))
+			Expect(lastStatusCode).To(Equal(0))
+			Expect(lastError).To(Equal(""))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddDomainQuotas())
+}
+
+type AddDomainQuotas struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddDomainQuotas() migration.Migration {
+	return &AddDomainQuotas{}
+}
+
+func (e *AddDomainQuotas) String() string {
+	return migrationString(e)
+}
+
+func (e *AddDomainQuotas) Version() int64 {
+	return 1792929919
+}
+
+func (e *AddDomainQuotas) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddDomainQuotas) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddDomainQuotas) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddDomainQuotas) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-domain-quotas")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS domain_quotas(
+	domain VARCHAR(255) PRIMARY KEY,
+	memory_mb BIGINT NOT NULL DEFAULT 0,
+	disk_mb BIGINT NOT NULL DEFAULT 0,
+	instances INT NOT NULL DEFAULT 0,
+	running_tasks INT NOT NULL DEFAULT 0,
+	log_rate_bytes_per_second BIGINT NOT NULL DEFAULT 0
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	return nil
+}
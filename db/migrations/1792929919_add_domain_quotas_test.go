@@ -0,0 +1,65 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddDomainQuotas", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE domain_quotas;")
+
+		migration = migrations.NewAddDomainQuotas()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1792929919))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO domain_quotas (domain, memory_mb) VALUES (?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "some-domain", 1024)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "some-domain", 1024)
+			Expect(err).To(HaveOccurred())
+
+			querySQL := "SELECT memory_mb, disk_mb, instances, running_tasks, log_rate_bytes_per_second FROM domain_quotas WHERE domain = ?"
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "some-domain")
+			var memoryMb, diskMb, logRate int64
+			var instances, runningTasks int
+			Expect(row.Scan(&memoryMb, &diskMb, &instances, &runningTasks, &logRate)).To(Succeed())
+			Expect(memoryMb).To(BeEquivalentTo(1024))
+			Expect(diskMb).To(BeZero())
+			Expect(instances).To(BeZero())
+			Expect(runningTasks).To(BeZero())
+			Expect(logRate).To(BeZero())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,94 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddAuditRecords())
+}
+
+type AddAuditRecords struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddAuditRecords() migration.Migration {
+	return &AddAuditRecords{}
+}
+
+func (e *AddAuditRecords) String() string {
+	return migrationString(e)
+}
+
+func (e *AddAuditRecords) Version() int64 {
+	return 1793016319
+}
+
+func (e *AddAuditRecords) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddAuditRecords) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddAuditRecords) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddAuditRecords) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-audit-records")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	idColumn := "id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY"
+	if e.dbFlavor != helpers.MySQL {
+		idColumn = "id BIGSERIAL PRIMARY KEY"
+	}
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS audit_records(
+	` + idColumn + `,
+	created_at BIGINT NOT NULL,
+	route VARCHAR(255) NOT NULL,
+	trace_id VARCHAR(255) NOT NULL DEFAULT '',
+	actor_common_name VARCHAR(255) NOT NULL DEFAULT '',
+	actor_organizational_units VARCHAR(1024) NOT NULL DEFAULT '',
+	remote_addr VARCHAR(255) NOT NULL DEFAULT '',
+	kind INT NOT NULL,
+	action INT NOT NULL,
+	domain VARCHAR(255) NOT NULL DEFAULT '',
+	process_guid VARCHAR(255) NOT NULL DEFAULT '',
+	instance_index INT NOT NULL DEFAULT 0,
+	instance_guid VARCHAR(255) NOT NULL DEFAULT '',
+	task_guid VARCHAR(255) NOT NULL DEFAULT '',
+	changes MEDIUMTEXT NOT NULL
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	for _, column := range []string{"created_at", "process_guid", "instance_guid", "task_guid", "actor_common_name"} {
+		createIndexSQL := "CREATE INDEX audit_records_" + column + "_idx ON audit_records (" + column + ")"
+		if e.dbFlavor != helpers.MySQL {
+			createIndexSQL = strings.Replace(createIndexSQL, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+		}
+
+		logger.Info("creating-index", lager.Data{"column": column})
+		_, err = tx.Exec(createIndexSQL)
+		if err != nil && !isDuplicateIndexError(err) {
+			logger.Error("failed-creating-index", err, lager.Data{"column": column})
+			return err
+		}
+	}
+
+	return nil
+}
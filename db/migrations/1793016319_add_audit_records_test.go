@@ -0,0 +1,71 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddAuditRecords", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE audit_records;")
+
+		migration = migrations.NewAddAuditRecords()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793016319))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table, generating the ids of the records", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO audit_records (created_at, route, kind, action, task_guid, changes) VALUES (?, ?, ?, ?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), 42, "DesireTask", 2, 0, "task-guid", "[]")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), 43, "CancelTask", 2, 1, "task-guid", "[]")
+			Expect(err).NotTo(HaveOccurred())
+
+			rows, err := rawSQLDB.Query(helpers.RebindForFlavor("SELECT id, route, process_guid FROM audit_records WHERE task_guid = ? ORDER BY id", flavor), "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			var ids []int64
+			var routes []string
+			for rows.Next() {
+				var id int64
+				var route, processGuid string
+				Expect(rows.Scan(&id, &route, &processGuid)).To(Succeed())
+				Expect(processGuid).To(Equal(""))
+				ids = append(ids, id)
+				routes = append(routes, route)
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+			Expect(routes).To(Equal([]string{"DesireTask", "CancelTask"}))
+			Expect(ids[1]).To(BeNumerically(">", ids[0]))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,76 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddIdempotencyKeys())
+}
+
+type AddIdempotencyKeys struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddIdempotencyKeys() migration.Migration {
+	return &AddIdempotencyKeys{}
+}
+
+func (e *AddIdempotencyKeys) String() string {
+	return migrationString(e)
+}
+
+func (e *AddIdempotencyKeys) Version() int64 {
+	return 1793102719
+}
+
+func (e *AddIdempotencyKeys) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddIdempotencyKeys) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddIdempotencyKeys) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddIdempotencyKeys) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-idempotency-keys")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS idempotency_keys(
+	idempotency_key VARCHAR(255) PRIMARY KEY,
+	request_hash VARCHAR(64) NOT NULL,
+	created_at BIGINT NOT NULL,
+	response MEDIUMTEXT
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	createIndexSQL := "CREATE INDEX idempotency_keys_created_at_idx ON idempotency_keys (created_at)"
+	if e.dbFlavor != helpers.MySQL {
+		createIndexSQL = strings.Replace(createIndexSQL, "CREATE INDEX", "CREATE INDEX IF NOT EXISTS", 1)
+	}
+
+	logger.Info("creating-index")
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package migrations_test
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddIdempotencyKeys", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE idempotency_keys;")
+
+		migration = migrations.NewAddIdempotencyKeys()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793102719))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table, keyed by the idempotency key", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO idempotency_keys (idempotency_key, request_hash, created_at) VALUES (?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "some-key", "some-hash", 42)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "some-key", "other-hash", 43)
+			Expect(err).To(HaveOccurred())
+
+			var requestHash string
+			var response sql.NullString
+			querySQL := "SELECT request_hash, response FROM idempotency_keys WHERE idempotency_key = ?"
+			err = rawSQLDB.QueryRow(helpers.RebindForFlavor(querySQL, flavor), "some-key").Scan(&requestHash, &response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(requestHash).To(Equal("some-hash"))
+			Expect(response.Valid).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
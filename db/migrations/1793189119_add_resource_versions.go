@@ -0,0 +1,79 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddResourceVersions())
+}
+
+type AddResourceVersions struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddResourceVersions() migration.Migration {
+	return &AddResourceVersions{}
+}
+
+func (e *AddResourceVersions) String() string {
+	return migrationString(e)
+}
+
+func (e *AddResourceVersions) Version() int64 {
+	return 1793189119
+}
+
+func (e *AddResourceVersions) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddResourceVersions) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddResourceVersions) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddResourceVersions) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-resource-versions")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	// Versions are allocated outside the transactions of the writes, from a
+	// sequence on Postgres and an auto-increment column on MySQL, so that
+	// concurrent writes do not wait on each other.
+	createSequenceSQL := "CREATE SEQUENCE IF NOT EXISTS resource_versions"
+	if e.dbFlavor == helpers.MySQL {
+		createSequenceSQL = `CREATE TABLE IF NOT EXISTS resource_versions(
+	id BIGINT PRIMARY KEY AUTO_INCREMENT
+);`
+	}
+
+	logger.Info("creating-sequence")
+	_, err := tx.Exec(createSequenceSQL)
+	if err != nil {
+		logger.Error("failed-creating-sequence", err)
+		return err
+	}
+
+	alterEventLogSQL := "ALTER TABLE event_log ADD COLUMN resource_version BIGINT DEFAULT 0;"
+	if e.dbFlavor != helpers.MySQL {
+		alterEventLogSQL = strings.Replace(alterEventLogSQL, "ADD COLUMN", "ADD COLUMN IF NOT EXISTS", 1)
+	}
+
+	logger.Info("altering-table", lager.Data{"query": alterEventLogSQL})
+	_, err = tx.Exec(helpers.RebindForFlavor(alterEventLogSQL, e.dbFlavor))
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddResourceVersions", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE resource_versions;")
+		rawSQLDB.Exec("DROP SEQUENCE resource_versions;")
+		rawSQLDB.Exec("DROP TABLE event_log;")
+
+		mig = migrations.NewAddResourceVersions()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1793189119))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			eventLogMigration := migrations.NewAddEventLog()
+			eventLogMigration.SetDBFlavor(flavor)
+			testUpInTransaction(rawSQLDB, eventLogMigration, logger)
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+		})
+
+		It("adds the resource version sequence, starting at one", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			Expect(nextResourceVersion()).To(BeEquivalentTo(1))
+			Expect(nextResourceVersion()).To(BeEquivalentTo(2))
+		})
+
+		It("adds the resource_version column to event_log", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor("INSERT INTO event_log (stream, id, event_type, payload) VALUES (?, ?, ?, ?)", flavor),
+				"some-stream", 1, "some-type", "some-payload",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var resourceVersion int64
+			err = rawSQLDB.QueryRow("SELECT resource_version FROM event_log").Scan(&resourceVersion)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resourceVersion).To(BeEquivalentTo(0))
+		})
+
+		It("does not reset the sequence when run again", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+			Expect(nextResourceVersion()).To(BeEquivalentTo(1))
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+			Expect(nextResourceVersion()).To(BeEquivalentTo(2))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
+
+func nextResourceVersion() int64 {
+	if flavor == helpers.MySQL {
+		result, err := rawSQLDB.Exec("INSERT INTO resource_versions () VALUES ()")
+		Expect(err).NotTo(HaveOccurred())
+		version, err := result.LastInsertId()
+		Expect(err).NotTo(HaveOccurred())
+		return version
+	}
+
+	var version int64
+	err := rawSQLDB.QueryRow("SELECT nextval('resource_versions')").Scan(&version)
+	Expect(err).NotTo(HaveOccurred())
+	return version
+}
@@ -0,0 +1,87 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddActualLRPHistory())
+}
+
+type AddActualLRPHistory struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddActualLRPHistory() migration.Migration {
+	return &AddActualLRPHistory{}
+}
+
+func (e *AddActualLRPHistory) String() string {
+	return migrationString(e)
+}
+
+func (e *AddActualLRPHistory) Version() int64 {
+	return 1793275519
+}
+
+func (e *AddActualLRPHistory) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddActualLRPHistory) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddActualLRPHistory) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddActualLRPHistory) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-actual-lrp-history")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	idColumn := "id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY"
+	if e.dbFlavor != helpers.MySQL {
+		idColumn = "id BIGSERIAL PRIMARY KEY"
+	}
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS actual_lrp_history(
+	` + idColumn + `,
+	process_guid VARCHAR(255) NOT NULL,
+	instance_index INT NOT NULL,
+	instance_guid VARCHAR(255) NOT NULL DEFAULT '',
+	cell_id VARCHAR(255) NOT NULL DEFAULT '',
+	transition INT NOT NULL,
+	state VARCHAR(255) NOT NULL DEFAULT '',
+	reason MEDIUMTEXT NOT NULL,
+	crash_count INT NOT NULL DEFAULT 0,
+	created_at BIGINT NOT NULL,
+	trace_id VARCHAR(255) NOT NULL DEFAULT ''
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	createIndexSQL := "CREATE INDEX actual_lrp_history_instance_idx ON actual_lrp_history (process_guid, instance_index)"
+	if e.dbFlavor != helpers.MySQL {
+		createIndexSQL = "CREATE INDEX IF NOT EXISTS actual_lrp_history_instance_idx ON actual_lrp_history (process_guid, instance_index)"
+	}
+
+	logger.Info("creating-index")
+	_, err = tx.Exec(createIndexSQL)
+	if err != nil && !isDuplicateIndexError(err) {
+		logger.Error("failed-creating-index", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddActualLRPHistory", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE actual_lrp_history;")
+
+		migration = migrations.NewAddActualLRPHistory()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793275519))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table, generating the ids of the records", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			insertSQL := "INSERT INTO actual_lrp_history (process_guid, instance_index, transition, reason, created_at) VALUES (?, ?, ?, ?, ?)"
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "process-guid", 1, 0, "", 42)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = rawSQLDB.Exec(helpers.RebindForFlavor(insertSQL, flavor), "process-guid", 1, 2, "boom", 43)
+			Expect(err).NotTo(HaveOccurred())
+
+			rows, err := rawSQLDB.Query(helpers.RebindForFlavor("SELECT id, transition, cell_id FROM actual_lrp_history WHERE process_guid = ? AND instance_index = ? ORDER BY id", flavor), "process-guid", 1)
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			var ids []int64
+			var transitions []int
+			for rows.Next() {
+				var id int64
+				var transition int
+				var cellID string
+				Expect(rows.Scan(&id, &transition, &cellID)).To(Succeed())
+				Expect(cellID).To(Equal(""))
+				ids = append(ids, id)
+				transitions = append(transitions, transition)
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+			Expect(transitions).To(Equal([]int{0, 2}))
+			Expect(ids[1]).To(BeNumerically(">", ids[0]))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddRestartPolicy())
+}
+
+type AddRestartPolicy struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddRestartPolicy() migration.Migration {
+	return &AddRestartPolicy{}
+}
+
+func (e *AddRestartPolicy) String() string {
+	return migrationString(e)
+}
+
+func (e *AddRestartPolicy) Version() int64 {
+	return 1793361919
+}
+
+func (e *AddRestartPolicy) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddRestartPolicy) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddRestartPolicy) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddRestartPolicy) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-restart-policy")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	var alterDesiredLRPsSQL string
+	if e.dbFlavor == helpers.MySQL {
+		alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
+	ADD COLUMN restart_policy MEDIUMTEXT;`
+	} else {
+		alterDesiredLRPsSQL = `ALTER TABLE desired_lrps
+	ADD COLUMN IF NOT EXISTS restart_policy TEXT;`
+	}
+
+	logger.Info("altering-table", lager.Data{"query": alterDesiredLRPsSQL})
+	_, err := tx.Exec(alterDesiredLRPsSQL)
+	if err != nil && !isDuplicateColumnError(err) {
+		logger.Error("failed-altering-table", err)
+		return err
+	}
+
+	return nil
+}
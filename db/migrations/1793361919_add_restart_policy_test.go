@@ -0,0 +1,84 @@
+package migrations_test
+
+import (
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock/fakeclock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddRestartPolicy", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		mig = migrations.NewAddRestartPolicy()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1793361919))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			initialMigrations := []migration.Migration{
+				migrations.NewInitSQL(),
+				migrations.NewIncreaseRunInfoColumnSize(),
+			}
+
+			for _, m := range initialMigrations {
+				m.SetDBFlavor(flavor)
+				m.SetClock(fakeClock)
+				testUpInTransaction(rawSQLDB, m, logger)
+			}
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+			mig.SetClock(fakeClock)
+		})
+
+		It("adds a nullable restart_policy column to desired lrps", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+			_, err := rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO desired_lrps
+						  (process_guid, domain, log_guid, instances, memory_mb,
+							  disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info)
+						  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				"guid", "domain",
+				"log guid", 2, 1, 1, "rootfs", "routes", "volumes yo", "1", "run info",
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			var restartPolicy sql.NullString
+			row := rawSQLDB.QueryRow("SELECT restart_policy FROM desired_lrps")
+			Expect(row.Scan(&restartPolicy)).To(Succeed())
+			Expect(restartPolicy.Valid).To(BeFalse())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
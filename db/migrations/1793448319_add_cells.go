@@ -0,0 +1,67 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddCells())
+}
+
+type AddCells struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddCells() migration.Migration {
+	return &AddCells{}
+}
+
+func (e *AddCells) String() string {
+	return migrationString(e)
+}
+
+func (e *AddCells) Version() int64 {
+	return 1793448319
+}
+
+func (e *AddCells) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddCells) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddCells) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+func (e *AddCells) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-cells")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	createTableSQL := `CREATE TABLE IF NOT EXISTS cells(
+	cell_id VARCHAR(255) PRIMARY KEY,
+	cordoned BOOL NOT NULL DEFAULT false,
+	drain_state INT NOT NULL DEFAULT 0,
+	drain_initial_instances INT NOT NULL DEFAULT 0,
+	drain_remaining_instances INT NOT NULL DEFAULT 0,
+	drain_error MEDIUMTEXT,
+	drain_started_at BIGINT NOT NULL DEFAULT 0,
+	drain_updated_at BIGINT NOT NULL DEFAULT 0
+);`
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(helpers.RebindForFlavor(createTableSQL, e.dbFlavor))
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddCells", func() {
+	var (
+		migration migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE cells;")
+
+		migration = migrations.NewAddCells()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(migration))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(migration.Version()).To(BeEquivalentTo(1793448319))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			migration.SetCryptor(cryptor)
+			migration.SetDBFlavor(flavor)
+		})
+
+		It("adds the table, defaulting cells to schedulable and not draining", func() {
+			testUpInTransaction(rawSQLDB, migration, logger)
+
+			_, err := rawSQLDB.Exec(helpers.RebindForFlavor("INSERT INTO cells (cell_id) VALUES (?)", flavor), "cell-1")
+			Expect(err).NotTo(HaveOccurred())
+
+			var cordoned bool
+			var drainStartedAt int64
+			row := rawSQLDB.QueryRow(helpers.RebindForFlavor("SELECT cordoned, drain_started_at FROM cells WHERE cell_id = ?", flavor), "cell-1")
+			Expect(row.Scan(&cordoned, &drainStartedAt)).To(Succeed())
+			Expect(cordoned).To(BeFalse())
+			Expect(drainStartedAt).To(BeZero())
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, migration, logger)
+		})
+	})
+})
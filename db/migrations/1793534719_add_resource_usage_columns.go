@@ -0,0 +1,183 @@
+package migrations
+
+import (
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddResourceUsageColumns())
+}
+
+// AddResourceUsageColumns stores the resources of Tasks, and the log rate
+// limit of DesiredLRPs, next to their encoded definitions, so that the usage
+// of a domain can be summed by the database. A log rate of -1 is unlimited.
+type AddResourceUsageColumns struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddResourceUsageColumns() migration.Migration {
+	return &AddResourceUsageColumns{}
+}
+
+func (e *AddResourceUsageColumns) String() string {
+	return migrationString(e)
+}
+
+func (e *AddResourceUsageColumns) Version() int64 {
+	return 1793534719
+}
+
+func (e *AddResourceUsageColumns) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddResourceUsageColumns) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddResourceUsageColumns) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+var addResourceUsageColumnsSQL = []string{
+	`ALTER TABLE desired_lrps ADD COLUMN log_rate_bytes_per_second BIGINT NOT NULL DEFAULT 0;`,
+	`ALTER TABLE tasks ADD COLUMN memory_mb INT NOT NULL DEFAULT 0;`,
+	`ALTER TABLE tasks ADD COLUMN disk_mb INT NOT NULL DEFAULT 0;`,
+	`ALTER TABLE tasks ADD COLUMN log_rate_bytes_per_second BIGINT NOT NULL DEFAULT 0;`,
+}
+
+func (e *AddResourceUsageColumns) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-resource-usage-columns")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	for _, query := range addResourceUsageColumnsSQL {
+		if e.dbFlavor != helpers.MySQL {
+			query = strings.Replace(query, "ADD COLUMN", "ADD COLUMN IF NOT EXISTS", 1)
+		}
+
+		logger.Info("altering-table", lager.Data{"query": query})
+		_, err := tx.Exec(query)
+		if err != nil && !isDuplicateColumnError(err) {
+			logger.Error("failed-altering-table", err)
+			return err
+		}
+	}
+
+	err := e.backfillDesiredLRPs(tx, logger)
+	if err != nil {
+		return err
+	}
+
+	return e.backfillTasks(tx, logger)
+}
+
+func (e *AddResourceUsageColumns) backfillDesiredLRPs(tx *sql.Tx, logger lager.Logger) error {
+	rows, err := tx.Query("SELECT process_guid, run_info FROM desired_lrps")
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	logRates := map[string]int64{}
+	for rows.Next() {
+		var processGuid string
+		var runInfoData []byte
+		err := rows.Scan(&processGuid, &runInfoData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+
+		var runInfo models.DesiredLRPRunInfo
+		err = e.serializer.Unmarshal(logger, runInfoData, &runInfo)
+		if err != nil {
+			logger.Error("failed-parsing-run-info", err, lager.Data{"process_guid": processGuid})
+			continue
+		}
+		logRates[processGuid] = storedLogRate(runInfo.LogRateLimit)
+	}
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+	err = rows.Close()
+	if err != nil {
+		logger.Error("failed-to-close-row", err)
+	}
+
+	updateQuery := helpers.RebindForFlavor("UPDATE desired_lrps SET log_rate_bytes_per_second = ? WHERE process_guid = ?", e.dbFlavor)
+	for processGuid, logRate := range logRates {
+		_, err = tx.Exec(updateQuery, logRate, processGuid)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp", err, lager.Data{"process_guid": processGuid})
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillTasks only fills in the resources of the active Tasks, the only
+// ones counted in the usage of their domain.
+func (e *AddResourceUsageColumns) backfillTasks(tx *sql.Tx, logger lager.Logger) error {
+	rows, err := tx.Query(
+		helpers.RebindForFlavor("SELECT guid, task_definition FROM tasks WHERE state IN (?, ?, ?)", e.dbFlavor),
+		models.Task_Waiting, models.Task_Pending, models.Task_Running,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return err
+	}
+
+	definitions := map[string]*models.TaskDefinition{}
+	for rows.Next() {
+		var guid string
+		var taskDefData []byte
+		err := rows.Scan(&guid, &taskDefData)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+
+		taskDef := &models.TaskDefinition{}
+		err = e.serializer.Unmarshal(logger, taskDefData, taskDef)
+		if err != nil {
+			logger.Error("failed-parsing-task-definition", err, lager.Data{"task_guid": guid})
+			continue
+		}
+		definitions[guid] = taskDef
+	}
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return rows.Err()
+	}
+	err = rows.Close()
+	if err != nil {
+		logger.Error("failed-to-close-row", err)
+	}
+
+	updateQuery := helpers.RebindForFlavor("UPDATE tasks SET memory_mb = ?, disk_mb = ?, log_rate_bytes_per_second = ? WHERE guid = ?", e.dbFlavor)
+	for guid, taskDef := range definitions {
+		_, err = tx.Exec(updateQuery, taskDef.MemoryMb, taskDef.DiskMb, storedLogRate(taskDef.LogRateLimit), guid)
+		if err != nil {
+			logger.Error("failed-updating-task", err, lager.Data{"task_guid": guid})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func storedLogRate(limit *models.LogRateLimit) int64 {
+	if limit == nil || limit.BytesPerSecond < 0 {
+		return -1
+	}
+	return limit.BytesPerSecond
+}
@@ -0,0 +1,142 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddResourceUsageColumns", func() {
+	var (
+		mig        migration.Migration
+		serializer format.Serializer
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE domains;")
+		rawSQLDB.Exec("DROP TABLE tasks;")
+		rawSQLDB.Exec("DROP TABLE desired_lrps;")
+		rawSQLDB.Exec("DROP TABLE actual_lrps;")
+
+		serializer = format.NewSerializer(cryptor)
+
+		initialMigration := migrations.NewInitSQL()
+		initialMigration.SetDBFlavor(flavor)
+		initialMigration.SetClock(fakeClock)
+		testUpInTransaction(rawSQLDB, initialMigration, logger)
+
+		mig = migrations.NewAddResourceUsageColumns()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1793534719))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+		})
+
+		insertDesiredLRP := func(processGuid string, runInfo *models.DesiredLRPRunInfo) {
+			runInfoData, err := serializer.Marshal(logger, runInfo)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO desired_lrps
+					  (process_guid, domain, log_guid, instances, memory_mb,
+						  disk_mb, rootfs, routes, volume_placement, modification_tag_epoch, run_info)
+					  VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+					flavor,
+				),
+				processGuid, "domain", "log guid", 2, 1, 1, "rootfs", "routes", "volumes yo", "1", runInfoData,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		insertTask := func(guid string, state models.Task_State, taskDef *models.TaskDefinition) {
+			taskDefData, err := serializer.Marshal(logger, taskDef)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rawSQLDB.Exec(
+				helpers.RebindForFlavor(
+					`INSERT INTO tasks
+						  (guid, domain, state, task_definition)
+						  VALUES (?, ?, ?, ?)`,
+					flavor,
+				),
+				guid, "domain", state, taskDefData,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		It("backfills the log rate of the desired lrps, storing unlimited as -1", func() {
+			insertDesiredLRP("limited", &models.DesiredLRPRunInfo{LogRateLimit: &models.LogRateLimit{BytesPerSecond: 2048}})
+			insertDesiredLRP("unlimited", &models.DesiredLRPRunInfo{})
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			logRates := map[string]int64{}
+			rows, err := rawSQLDB.Query("SELECT process_guid, log_rate_bytes_per_second FROM desired_lrps")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+			for rows.Next() {
+				var processGuid string
+				var logRate int64
+				Expect(rows.Scan(&processGuid, &logRate)).To(Succeed())
+				logRates[processGuid] = logRate
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+
+			Expect(logRates).To(Equal(map[string]int64{"limited": 2048, "unlimited": -1}))
+		})
+
+		It("backfills the resources of the active tasks only", func() {
+			insertTask("running", models.Task_Running, &models.TaskDefinition{
+				MemoryMb:     256,
+				DiskMb:       512,
+				LogRateLimit: &models.LogRateLimit{BytesPerSecond: 1024},
+			})
+			insertTask("completed", models.Task_Completed, &models.TaskDefinition{
+				MemoryMb: 256,
+				DiskMb:   512,
+			})
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			type resources struct{ memoryMB, diskMB, logRate int64 }
+			usage := map[string]resources{}
+			rows, err := rawSQLDB.Query("SELECT guid, memory_mb, disk_mb, log_rate_bytes_per_second FROM tasks")
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+			for rows.Next() {
+				var guid string
+				var r resources
+				Expect(rows.Scan(&guid, &r.memoryMB, &r.diskMB, &r.logRate)).To(Succeed())
+				usage[guid] = r
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+
+			Expect(usage).To(Equal(map[string]resources{
+				"running":   {memoryMB: 256, diskMB: 512, logRate: 1024},
+				"completed": {},
+			}))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package migrations
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/encryption"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/migration"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func init() {
+	appendMigration(NewAddResourceVersionCounter())
+}
+
+// AddResourceVersionCounter replaces the resource_versions sequence with a
+// counter row, incremented at the end of the transaction of every versioned
+// write. The lock on the row orders the commits of the writes by version.
+type AddResourceVersionCounter struct {
+	serializer format.Serializer
+	clock      clock.Clock
+	dbFlavor   string
+}
+
+func NewAddResourceVersionCounter() migration.Migration {
+	return &AddResourceVersionCounter{}
+}
+
+func (e *AddResourceVersionCounter) String() string {
+	return migrationString(e)
+}
+
+func (e *AddResourceVersionCounter) Version() int64 {
+	return 1793621119
+}
+
+func (e *AddResourceVersionCounter) SetCryptor(cryptor encryption.Cryptor) {
+	e.serializer = format.NewSerializer(cryptor)
+}
+
+func (e *AddResourceVersionCounter) SetClock(c clock.Clock)    { e.clock = c }
+func (e *AddResourceVersionCounter) SetDBFlavor(flavor string) { e.dbFlavor = flavor }
+
+const createResourceVersionCounterSQL = `CREATE TABLE IF NOT EXISTS resource_version_counter(
+	id INT PRIMARY KEY,
+	version BIGINT NOT NULL DEFAULT 0
+);`
+
+func (e *AddResourceVersionCounter) Up(tx *sql.Tx, logger lager.Logger) error {
+	logger = logger.Session("add-resource-version-counter")
+	logger.Info("starting")
+	defer logger.Info("completed")
+
+	logger.Info("creating-table")
+	_, err := tx.Exec(createResourceVersionCounterSQL)
+	if err != nil {
+		logger.Error("failed-creating-table", err)
+		return err
+	}
+
+	var counters int
+	err = tx.QueryRow("SELECT COUNT(*) FROM resource_version_counter").Scan(&counters)
+	if err != nil {
+		logger.Error("failed-counting-rows", err)
+		return err
+	}
+	if counters > 0 {
+		return nil
+	}
+
+	// the counter continues from the greatest version allocated from the
+	// sequence, so that versions already given to clients stay in the past
+	selectVersionSQL := "SELECT CASE WHEN is_called THEN last_value ELSE 0 END FROM resource_versions"
+	dropSequenceSQL := "DROP SEQUENCE IF EXISTS resource_versions"
+	if e.dbFlavor == helpers.MySQL {
+		selectVersionSQL = "SELECT COALESCE(MAX(id), 0) FROM resource_versions"
+		dropSequenceSQL = "DROP TABLE IF EXISTS resource_versions"
+	}
+
+	var version int64
+	err = tx.QueryRow(selectVersionSQL).Scan(&version)
+	if err != nil {
+		logger.Error("failed-reading-resource-version", err)
+		return err
+	}
+
+	_, err = tx.Exec(helpers.RebindForFlavor("INSERT INTO resource_version_counter (id, version) VALUES (1, ?)", e.dbFlavor), version)
+	if err != nil {
+		logger.Error("failed-inserting-counter", err)
+		return err
+	}
+
+	logger.Info("dropping-sequence")
+	_, err = tx.Exec(dropSequenceSQL)
+	if err != nil {
+		logger.Error("failed-dropping-sequence", err)
+		return err
+	}
+
+	return nil
+}
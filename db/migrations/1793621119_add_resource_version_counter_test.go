@@ -0,0 +1,93 @@
+package migrations_test
+
+import (
+	"code.cloudfoundry.org/bbs/db/migrations"
+	"code.cloudfoundry.org/bbs/migration"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddResourceVersionCounter", func() {
+	var (
+		mig migration.Migration
+	)
+
+	BeforeEach(func() {
+		rawSQLDB.Exec("DROP TABLE resource_version_counter;")
+		rawSQLDB.Exec("DROP TABLE resource_versions;")
+		rawSQLDB.Exec("DROP SEQUENCE resource_versions;")
+		rawSQLDB.Exec("DROP TABLE event_log;")
+
+		mig = migrations.NewAddResourceVersionCounter()
+	})
+
+	It("appends itself to the migration list", func() {
+		Expect(migrations.AllMigrations()).To(ContainElement(mig))
+	})
+
+	Describe("Version", func() {
+		It("returns the timestamp from which it was created", func() {
+			Expect(mig.Version()).To(BeEquivalentTo(1793621119))
+		})
+	})
+
+	Describe("Up", func() {
+		BeforeEach(func() {
+			eventLogMigration := migrations.NewAddEventLog()
+			eventLogMigration.SetDBFlavor(flavor)
+			testUpInTransaction(rawSQLDB, eventLogMigration, logger)
+
+			resourceVersionsMigration := migrations.NewAddResourceVersions()
+			resourceVersionsMigration.SetDBFlavor(flavor)
+			testUpInTransaction(rawSQLDB, resourceVersionsMigration, logger)
+
+			mig.SetCryptor(cryptor)
+			mig.SetDBFlavor(flavor)
+		})
+
+		It("continues the counter from the greatest version of the sequence", func() {
+			nextResourceVersion()
+			nextResourceVersion()
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			Expect(counterResourceVersion()).To(BeEquivalentTo(2))
+		})
+
+		It("starts the counter at zero when no version was allocated", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			Expect(counterResourceVersion()).To(BeEquivalentTo(0))
+		})
+
+		It("drops the sequence", func() {
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			_, err := rawSQLDB.Exec("SELECT * FROM resource_versions")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("does not reset the counter when run again", func() {
+			nextResourceVersion()
+			testUpInTransaction(rawSQLDB, mig, logger)
+
+			_, err := rawSQLDB.Exec("UPDATE resource_version_counter SET version = version + 1")
+			Expect(err).NotTo(HaveOccurred())
+
+			testUpInTransaction(rawSQLDB, mig, logger)
+			Expect(counterResourceVersion()).To(BeEquivalentTo(2))
+		})
+
+		It("is idempotent", func() {
+			testIdempotency(rawSQLDB, mig, logger)
+		})
+	})
+})
+
+func counterResourceVersion() int64 {
+	var version int64
+	err := rawSQLDB.QueryRow("SELECT version FROM resource_version_counter WHERE id = 1").Scan(&version)
+	Expect(err).NotTo(HaveOccurred())
+	return version
+}
@@ -52,3 +52,18 @@ func isDuplicateColumnError(err error) bool {
 
 	return false
 }
+
+func isDuplicateIndexError(err error) bool {
+	switch e := err.(type) {
+	case *mysql.MySQLError:
+		if e.Number == 1061 {
+			return true
+		}
+	case *pgconn.PgError:
+		if e.Code == "42P07" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . ResourceVersionDB
+
+// ResourceVersionDB exposes the resource version, a cluster-wide counter
+// that gives every write of a desired LRP, actual LRP or task a greater
+// version.
+type ResourceVersionDB interface {
+	// ResourceVersion returns the version of the most recently committed
+	// write.
+	ResourceVersion(ctx context.Context, logger lager.Logger) (uint64, error)
+}
+
+// ListMetadata describes a list read from the database.
+type ListMetadata struct {
+	// ResourceVersion is read in the transaction of the list, which reflects
+	// every write at or before it.
+	ResourceVersion uint64
+
+	// NextPageToken is the token of the page that follows a paginated list,
+	// or empty when no rows follow it.
+	NextPageToken string
+}
+
+// WriteVersion records the resource version of the writes made with a
+// context, and with the contexts derived from it.
+type WriteVersion struct {
+	version uint64
+	parent  *WriteVersion
+	lock    sync.Mutex
+}
+
+type writeVersionContextKey struct{}
+
+// WithWriteVersion returns a context recording the resource version of the
+// writes of desired LRPs, actual LRPs and tasks made with it, so that their
+// events can carry it.
+func WithWriteVersion(ctx context.Context) (context.Context, *WriteVersion) {
+	parent, _ := WriteVersionFromContext(ctx)
+	writeVersion := &WriteVersion{parent: parent}
+	return context.WithValue(ctx, writeVersionContextKey{}, writeVersion), writeVersion
+}
+
+// WriteVersionFromContext returns the WriteVersion the context records the
+// writes made with it in, if any.
+func WriteVersionFromContext(ctx context.Context) (*WriteVersion, bool) {
+	writeVersion, ok := ctx.Value(writeVersionContextKey{}).(*WriteVersion)
+	return writeVersion, ok
+}
+
+// Record records a write at the resource version.
+func (w *WriteVersion) Record(version uint64) {
+	w.lock.Lock()
+	if version > w.version {
+		w.version = version
+	}
+	w.lock.Unlock()
+
+	if w.parent != nil {
+		w.parent.Record(version)
+	}
+}
+
+// ResourceVersion returns the greatest resource version of the writes
+// recorded so far, or zero when there are none.
+func (w *WriteVersion) ResourceVersion() uint64 {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.version
+}
+
+// Versioned sets the resource version of the writes recorded so far on the
+// event when it is the event of a write, and returns it. A nil WriteVersion
+// leaves the event unchanged.
+func (w *WriteVersion) Versioned(event models.Event) models.Event {
+	if versioned, ok := event.(models.VersionedEvent); ok && w != nil {
+		versioned.SetResourceVersion(w.ResourceVersion())
+	}
+	return event
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . ScheduledTaskDB
+
+type ScheduledTaskDB interface {
+	ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error)
+	ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, scheduleGuid string) (*models.ScheduledTask, error)
+
+	// DesireScheduledTask stores the schedule together with the time of its
+	// first run.
+	DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error)
+	UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	// SetScheduledTaskSuspended suspends or resumes the schedule. A resumed
+	// schedule runs next at its first time after now, so the runs missed
+	// while it was suspended are not made up for.
+	SetScheduledTaskSuspended(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error)
+	DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error
+
+	// DueScheduledTasks returns the schedules that are not suspended and whose
+	// next run is at or before now.
+	DueScheduledTasks(ctx context.Context, logger lager.Logger, now time.Time) ([]*models.ScheduledTask, error)
+	// RecordScheduledTaskRun adds run to the recent runs of the schedule and
+	// moves its next run to nextRunAt, provided the next run is still the one
+	// at expectedNextRunAt. Otherwise it returns ErrResourceConflict.
+	RecordScheduledTaskRun(ctx context.Context, logger lager.Logger, scheduleGuid string, expectedNextRunAt int64, run *models.ScheduledTaskRun, nextRunAt int64) (*models.ScheduledTask, error)
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -18,11 +19,21 @@ const (
 	Truncated = "(truncated)"
 )
 
-func (db *SQLDB) getActualLRPs(ctx context.Context, logger lager.Logger, wheres string, whereBindings ...interface{}) ([]*models.ActualLRP, error) {
+// getActualLRPs reads the page of actual LRPs after the given key, or all of
+// them when no page size or key is given.
+func (db *SQLDB) getActualLRPs(ctx context.Context, logger lager.Logger, after []interface{}, pageSize int32, wheres string, whereBindings ...interface{}) ([]*models.ActualLRP, bbsdb.ListMetadata, error) {
 	var actualLRPs []*models.ActualLRP
-	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
-		rows, err := db.all(ctx, logger, tx, actualLRPsTable,
-			actualLRPColumns, helpers.NoLockRow,
+	var metadata bbsdb.ListMetadata
+	err := db.readSnapshot(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		metadata.ResourceVersion, err = db.resourceVersion(ctx, logger, tx)
+		if err != nil {
+			return err
+		}
+
+		rows, err := db.allPage(ctx, logger, tx, actualLRPsTable,
+			actualLRPColumns, actualLRPPageColumns, actualLRPRowPageToken,
+			after, pageSize,
 			wheres, whereBindings...,
 		)
 		if err != nil {
@@ -31,10 +42,14 @@ func (db *SQLDB) getActualLRPs(ctx context.Context, logger lager.Logger, wheres
 		}
 		defer rows.Close()
 		actualLRPs, err = db.scanAndCleanupActualLRPs(ctx, logger, tx, rows)
+		metadata.NextPageToken = rows.NextPageToken()
 		return err
 	})
+	if err != nil {
+		return nil, bbsdb.ListMetadata{}, err
+	}
 
-	return actualLRPs, err
+	return actualLRPs, metadata, nil
 }
 
 func (db *SQLDB) ChangeActualLRPPresence(ctx context.Context, logger lager.Logger, key *models.ActualLRPKey, from, to models.ActualLRP_Presence) (before *models.ActualLRP, after *models.ActualLRP, err error) {
@@ -68,6 +83,11 @@ func (db *SQLDB) ChangeActualLRPPresence(ctx context.Context, logger lager.Logge
 }
 
 func (db *SQLDB) ActualLRPs(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	lrps, _, err := db.ListActualLRPs(ctx, logger, filter)
+	return lrps, err
+}
+
+func (db *SQLDB) ListActualLRPs(ctx context.Context, logger lager.Logger, filter models.ActualLRPFilter) ([]*models.ActualLRP, bbsdb.ListMetadata, error) {
 	logger = logger.Session("db-actual-lrps", lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
@@ -95,12 +115,13 @@ func (db *SQLDB) ActualLRPs(ctx context.Context, logger lager.Logger, filter mod
 		values = append(values, *filter.Index)
 	}
 
-	lrps, err := db.getActualLRPs(ctx, logger, strings.Join(wheres, " AND "), values...)
+	after, err := actualLRPPageKey(filter.PageToken)
 	if err != nil {
-		return nil, err
+		logger.Error("failed-decoding-page-token", err)
+		return nil, bbsdb.ListMetadata{}, err
 	}
 
-	return lrps, nil
+	return db.getActualLRPs(ctx, logger, after, filter.PageSize, strings.Join(wheres, " AND "), values...)
 }
 
 func (db *SQLDB) ActualLRPsByProcessGuids(ctx context.Context, logger lager.Logger, filter models.ActualLRPsByProcessGuidsFilter) ([]*models.ActualLRP, error) {
@@ -120,7 +141,7 @@ func (db *SQLDB) ActualLRPsByProcessGuids(ctx context.Context, logger lager.Logg
 		wheres = append(wheres, fmt.Sprintf("process_guid IN (%s)", strings.Join(placeholders, ",")))
 	}
 
-	lrps, err := db.getActualLRPs(ctx, logger, strings.Join(wheres, " AND "), values...)
+	lrps, _, err := db.getActualLRPs(ctx, logger, nil, 0, strings.Join(wheres, " AND "), values...)
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +179,16 @@ func (db *SQLDB) CreateUnclaimedActualLRP(ctx context.Context, logger lager.Logg
 	}
 
 	now := db.clock.Now().UnixNano()
+	lrp := &models.ActualLRP{
+		ActualLRPKey:            *key,
+		State:                   models.ActualLRPStateUnclaimed,
+		Since:                   now,
+		ModificationTag:         models.ModificationTag{Epoch: guid, Index: 0},
+		ActualLrpInternalRoutes: []*models.ActualLRPInternalRoute{},
+		MetricTags:              map[string]string{},
+	}
+	lrp.SetRoutable(false)
+
 	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 		_, err := db.insert(ctx, logger, tx, actualLRPsTable,
 			helpers.SQLAttributes{
@@ -174,22 +205,16 @@ func (db *SQLDB) CreateUnclaimedActualLRP(ctx context.Context, logger lager.Logg
 				"routable":               false,
 			},
 		)
+		if err != nil {
+			return err
+		}
 
-		return err
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Create, nil, lrp), nil, lrp)
 	})
 	if err != nil {
 		logger.Error("failed-to-create-unclaimed-actual-lrp", err)
 		return nil, err
 	}
-	lrp := &models.ActualLRP{
-		ActualLRPKey:            *key,
-		State:                   models.ActualLRPStateUnclaimed,
-		Since:                   now,
-		ModificationTag:         models.ModificationTag{Epoch: guid, Index: 0},
-		ActualLrpInternalRoutes: []*models.ActualLRPInternalRoute{},
-		MetricTags:              map[string]string{},
-	}
-	lrp.SetRoutable(false)
 	return lrp, nil
 }
 
@@ -300,7 +325,7 @@ func (db *SQLDB) ClaimActualLRP(ctx context.Context, logger lager.Logger, proces
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
 	})
 
 	return &beforeActualLRP, actualLRP, err
@@ -335,7 +360,10 @@ func (db *SQLDB) StartActualLRP(
 		actualLRP, err = db.fetchActualLRPForUpdate(ctx, logger, key.ProcessGuid, key.Index, models.ActualLRP_Ordinary, tx)
 		if err == models.ErrResourceNotFound {
 			actualLRP, err = db.createRunningActualLRP(ctx, logger, key, instanceKey, netInfo, internalRoutes, metricTags, routable, availabilityZone, tx)
-			return err
+			if err != nil {
+				return err
+			}
+			return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Create, nil, actualLRP), nil, actualLRP)
 		}
 
 		if err != nil {
@@ -415,7 +443,7 @@ func (db *SQLDB) StartActualLRP(
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
 	})
 
 	return &beforeActualLRP, actualLRP, err
@@ -475,7 +503,12 @@ func (db *SQLDB) CrashActualLRP(ctx context.Context, logger lager.Logger, key *m
 			return err
 		}
 
-		if actualLRP.ShouldRestartImmediately(models.NewDefaultRestartCalculator()) {
+		restartPolicy, err := db.fetchRestartPolicy(ctx, logger, tx, key.ProcessGuid)
+		if err != nil {
+			return err
+		}
+
+		if actualLRP.ShouldRestartImmediately(models.NewRestartCalculatorFromPolicy(restartPolicy)) {
 			actualLRP.State = models.ActualLRPStateUnclaimed
 			immediateRestart = true
 		}
@@ -502,7 +535,7 @@ func (db *SQLDB) CrashActualLRP(ctx context.Context, logger lager.Logger, key *m
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
 	})
 
 	return &beforeActualLRP, actualLRP, immediateRestart, err
@@ -549,7 +582,7 @@ func (db *SQLDB) FailActualLRP(ctx context.Context, logger lager.Logger, key *mo
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
 	})
 
 	return &beforeActualLRP, actualLRP, err
@@ -561,7 +594,16 @@ func (db *SQLDB) RemoveActualLRP(ctx context.Context, logger lager.Logger, proce
 	defer logger.Info("complete")
 
 	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var beforeActualLRP *models.ActualLRP
 		var err error
+		if auditing(ctx) {
+			beforeActualLRP, err = db.fetchActualLRPForUpdate(ctx, logger, processGuid, index, models.ActualLRP_Ordinary, tx)
+			if err != nil {
+				logger.Error("failed-fetching-actual-lrp", err)
+				return err
+			}
+		}
+
 		var result sql.Result
 		if instanceKey == nil {
 			result, err = db.delete(ctx, logger, tx, actualLRPsTable,
@@ -589,7 +631,10 @@ func (db *SQLDB) RemoveActualLRP(ctx context.Context, logger lager.Logger, proce
 			return models.ErrResourceNotFound
 		}
 
-		return nil
+		if beforeActualLRP == nil {
+			return nil
+		}
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Remove, beforeActualLRP, nil), beforeActualLRP, nil)
 	})
 }
 
@@ -705,6 +750,11 @@ func (db *SQLDB) unclaimActualLRP(ctx context.Context, logger lager.Logger, tx h
 		return nil, nil, err
 	}
 
+	err = db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	return &beforeActualLRP, actualLRP, nil
 }
 
@@ -808,7 +858,7 @@ func (db *SQLDB) fetchActualLRPForUpdate(ctx context.Context, logger lager.Logge
 	return actualLRPs[0], nil
 }
 
-func (db *SQLDB) scanAndCleanupActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, rows *sql.Rows) ([]*models.ActualLRP, error) {
+func (db *SQLDB) scanAndCleanupActualLRPs(ctx context.Context, logger lager.Logger, q helpers.Queryable, rows rowIterator) ([]*models.ActualLRP, error) {
 	result := []*models.ActualLRP{}
 	actualsToDelete := []*models.ActualLRP{}
 
@@ -414,6 +414,33 @@ var _ = Describe("ActualLRPDB", func() {
 			Expect(actualLRPs).To(ConsistOf(allActualLRPs))
 		})
 
+		It("pages through all the actual lrps without repeating any", func() {
+			pagedLRPs := []*models.ActualLRP{}
+			filter := models.ActualLRPFilter{PageSize: 2}
+			for {
+				actualLRPs, metadata, err := sqlDB.ListActualLRPs(ctx, logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(actualLRPs)).To(BeNumerically("<=", 2))
+				pagedLRPs = append(pagedLRPs, actualLRPs...)
+				if metadata.NextPageToken == "" {
+					break
+				}
+				filter.PageToken = metadata.NextPageToken
+			}
+			Expect(pagedLRPs).To(ConsistOf(allActualLRPs))
+		})
+
+		It("does not return a next page token with the last page", func() {
+			_, metadata, err := sqlDB.ListActualLRPs(ctx, logger, models.ActualLRPFilter{PageSize: int32(len(allActualLRPs))})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(metadata.NextPageToken).To(BeEmpty())
+		})
+
+		It("returns an error when the page token is invalid", func() {
+			_, err := sqlDB.ActualLRPs(ctx, logger, models.ActualLRPFilter{PageSize: 2, PageToken: "not a token"})
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+		})
+
 		Context("when the net_info cannot be decoded", func() {
 			var actualLRPWithInvalidData *models.ActualLRP
 
@@ -438,6 +465,21 @@ var _ = Describe("ActualLRPDB", func() {
 
 				Expect(actualLRPs).NotTo(ContainElement(actualLRPWithInvalidData))
 			})
+
+			It("pages past the pruned actual lrp", func() {
+				pagedLRPs := []*models.ActualLRP{}
+				filter := models.ActualLRPFilter{PageSize: 1}
+				for {
+					actualLRPs, metadata, err := sqlDB.ListActualLRPs(ctx, logger, filter)
+					Expect(err).NotTo(HaveOccurred())
+					pagedLRPs = append(pagedLRPs, actualLRPs...)
+					if metadata.NextPageToken == "" {
+						break
+					}
+					filter.PageToken = metadata.NextPageToken
+				}
+				Expect(pagedLRPs).To(ConsistOf(allActualLRPs))
+			})
 		})
 
 		Context("when the internal routes cannot be decoded", func() {
@@ -1749,6 +1791,22 @@ var _ = Describe("ActualLRPDB", func() {
 						})
 					})
 				})
+
+				Context("and its desired lrp has a never restart policy", func() {
+					BeforeEach(func() {
+						desiredLRP := model_helpers.NewValidDesiredLRP(actualLRP.ProcessGuid)
+						desiredLRP.RestartPolicy = &models.RestartPolicy{NeverRestart: true}
+						Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+					})
+
+					It("leaves the lrp CRASHED", func() {
+						_, afterActualLRP, shouldRestart, err := sqlDB.CrashActualLRP(ctx, logger, &actualLRP.ActualLRPKey, instanceKey, "because it didn't go well")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(shouldRestart).To(BeFalse())
+						Expect(afterActualLRP.State).To(Equal(models.ActualLRPStateCrashed))
+						Expect(afterActualLRP.CrashCount).To(BeEquivalentTo(1))
+					})
+				})
 			})
 
 			Context("and it's CLAIMED", func() {
@@ -0,0 +1,189 @@
+package sqldb
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) ActualLRPHistory(ctx context.Context, logger lager.Logger, processGuid string, index int32) ([]*models.ActualLRPHistoryRecord, error) {
+	logger = logger.Session("db-actual-lrp-history", lager.Data{"process_guid": processGuid, "index": index})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.helper.AllPaginated(ctx, logger, db.db, actualLRPHistoryTable,
+		actualLRPHistoryColumns, helpers.ColumnList{actualLRPHistoryTable + ".id"},
+		nil, 0,
+		"process_guid = ? AND instance_index = ?", processGuid, index,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	records := []*models.ActualLRPHistoryRecord{}
+	for rows.Next() {
+		record, err := db.fetchActualLRPHistoryRecord(logger, rows)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return records, nil
+}
+
+func (db *SQLDB) RecordActualLRPTransition(ctx context.Context, logger lager.Logger, record *models.ActualLRPHistoryRecord) error {
+	logger = logger.Session("db-record-actual-lrp-transition", lager.Data{"process_guid": record.ProcessGuid, "index": record.Index, "transition": record.Transition})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	_, err := db.insert(ctx, logger, db.db, actualLRPHistoryTable, helpers.SQLAttributes{
+		"process_guid":   record.ProcessGuid,
+		"instance_index": record.Index,
+		"instance_guid":  record.InstanceGuid,
+		"cell_id":        record.CellId,
+		"transition":     record.Transition,
+		"state":          truncateString(record.State, 255),
+		"reason":         record.Reason,
+		"crash_count":    record.CrashCount,
+		"created_at":     db.clock.Now().UnixNano(),
+		"trace_id":       truncateString(record.TraceId, 255),
+	})
+	if err != nil {
+		logger.Error("failed-inserting-actual-lrp-history-record", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) PruneActualLRPHistory(ctx context.Context, logger lager.Logger, limit int) (int64, error) {
+	logger = logger.Session("db-prune-actual-lrp-history", lager.Data{"limit": limit})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	result, err := db.delete(ctx, logger, db.db, actualLRPHistoryTable,
+		"process_guid NOT IN (SELECT process_guid FROM "+desiredLRPsTable+")",
+	)
+	if err != nil {
+		logger.Error("failed-deleting-history-of-removed-processes", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	if limit <= 0 {
+		return deleted, nil
+	}
+
+	instances, err := db.actualLRPHistoryInstancesOver(ctx, logger, limit)
+	if err != nil {
+		return deleted, err
+	}
+
+	for _, key := range instances {
+		// The id of the oldest record to keep: the records are numbered in
+		// the order they are recorded.
+		var oldestID int64
+		row := db.db.QueryRowContext(ctx, db.helper.Rebind(`
+			SELECT id FROM actual_lrp_history
+			WHERE process_guid = ? AND instance_index = ?
+			ORDER BY id DESC
+			LIMIT 1 OFFSET ?`),
+			key.ProcessGuid, key.Index, limit-1,
+		)
+		err := row.Scan(&oldestID)
+		if err != nil {
+			logger.Error("failed-finding-oldest-record-to-keep", err, lager.Data{"process_guid": key.ProcessGuid, "index": key.Index})
+			return deleted, db.convertSQLError(err)
+		}
+
+		result, err := db.delete(ctx, logger, db.db, actualLRPHistoryTable,
+			"process_guid = ? AND instance_index = ? AND id < ?", key.ProcessGuid, key.Index, oldestID,
+		)
+		if err != nil {
+			logger.Error("failed-deleting-old-records", err, lager.Data{"process_guid": key.ProcessGuid, "index": key.Index})
+			return deleted, db.convertSQLError(err)
+		}
+
+		count, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-rows-affected", err)
+			return deleted, db.convertSQLError(err)
+		}
+		deleted += count
+	}
+
+	return deleted, nil
+}
+
+// actualLRPHistoryInstancesOver returns the instances with more than limit
+// records.
+func (db *SQLDB) actualLRPHistoryInstancesOver(ctx context.Context, logger lager.Logger, limit int) ([]models.ActualLRPKey, error) {
+	rows, err := db.db.QueryContext(ctx, db.helper.Rebind(`
+		SELECT process_guid, instance_index FROM actual_lrp_history
+		GROUP BY process_guid, instance_index
+		HAVING COUNT(*) > ?`),
+		limit,
+	)
+	if err != nil {
+		logger.Error("failed-counting-records", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	instances := []models.ActualLRPKey{}
+	for rows.Next() {
+		var key models.ActualLRPKey
+		err := rows.Scan(&key.ProcessGuid, &key.Index)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+		instances = append(instances, key)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return instances, nil
+}
+
+func (db *SQLDB) fetchActualLRPHistoryRecord(logger lager.Logger, scanner helpers.RowScanner) (*models.ActualLRPHistoryRecord, error) {
+	record := &models.ActualLRPHistoryRecord{}
+	err := scanner.Scan(
+		&record.Id,
+		&record.ProcessGuid,
+		&record.Index,
+		&record.InstanceGuid,
+		&record.CellId,
+		&record.Transition,
+		&record.State,
+		&record.Reason,
+		&record.CrashCount,
+		&record.CreatedAt,
+		&record.TraceId,
+	)
+	if err != nil {
+		logger.Error("failed-scanning-actual-lrp-history-record", err)
+		return nil, err
+	}
+
+	return record, nil
+}
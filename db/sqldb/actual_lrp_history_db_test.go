@@ -0,0 +1,118 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualLRPHistoryDB", func() {
+	record := func(processGuid string, index int32, transition models.ActualLRPHistoryRecord_Transition) *models.ActualLRPHistoryRecord {
+		return &models.ActualLRPHistoryRecord{
+			ProcessGuid:  processGuid,
+			Index:        index,
+			InstanceGuid: "instance-guid",
+			CellId:       "cell-id",
+			Transition:   transition,
+		}
+	}
+
+	transitionsOf := func(processGuid string, index int32) []models.ActualLRPHistoryRecord_Transition {
+		records, err := sqlDB.ActualLRPHistory(ctx, logger, processGuid, index)
+		Expect(err).NotTo(HaveOccurred())
+
+		transitions := []models.ActualLRPHistoryRecord_Transition{}
+		for _, record := range records {
+			transitions = append(transitions, record.Transition)
+		}
+		return transitions
+	}
+
+	Describe("RecordActualLRPTransition", func() {
+		It("records the transition with the current time", func() {
+			crashed := record("process-guid", 1, models.ActualLRPHistoryRecord_Crashed)
+			crashed.State = models.ActualLRPStateCrashed
+			crashed.Reason = "boom"
+			crashed.CrashCount = 3
+			crashed.TraceId = "some-trace-id"
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, crashed)).To(Succeed())
+
+			records, err := sqlDB.ActualLRPHistory(ctx, logger, "process-guid", 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(records[0].Id).NotTo(BeZero())
+			Expect(records[0].CreatedAt).To(Equal(fakeClock.Now().UnixNano()))
+
+			crashed.Id = records[0].Id
+			crashed.CreatedAt = records[0].CreatedAt
+			Expect(records[0]).To(Equal(crashed))
+		})
+	})
+
+	Describe("ActualLRPHistory", func() {
+		BeforeEach(func() {
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("process-guid", 1, models.ActualLRPHistoryRecord_Claimed))).To(Succeed())
+			fakeClock.Increment(time.Second)
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("process-guid", 0, models.ActualLRPHistoryRecord_Claimed))).To(Succeed())
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("process-guid", 1, models.ActualLRPHistoryRecord_Started))).To(Succeed())
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("other-guid", 1, models.ActualLRPHistoryRecord_Failed))).To(Succeed())
+		})
+
+		It("returns the records of the index of the process, oldest first", func() {
+			Expect(transitionsOf("process-guid", 1)).To(Equal([]models.ActualLRPHistoryRecord_Transition{
+				models.ActualLRPHistoryRecord_Claimed,
+				models.ActualLRPHistoryRecord_Started,
+			}))
+		})
+
+		It("returns no records for an instance without history", func() {
+			Expect(transitionsOf("process-guid", 2)).To(BeEmpty())
+		})
+	})
+
+	Describe("PruneActualLRPHistory", func() {
+		BeforeEach(func() {
+			for _, processGuid := range []string{"process-guid", "other-guid"} {
+				Expect(sqlDB.DesireLRP(ctx, logger, model_helpers.NewValidDesiredLRP(processGuid))).To(Succeed())
+			}
+
+			for _, transition := range []models.ActualLRPHistoryRecord_Transition{
+				models.ActualLRPHistoryRecord_Claimed,
+				models.ActualLRPHistoryRecord_Started,
+				models.ActualLRPHistoryRecord_Crashed,
+				models.ActualLRPHistoryRecord_Removed,
+			} {
+				Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("process-guid", 0, transition))).To(Succeed())
+			}
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("process-guid", 1, models.ActualLRPHistoryRecord_Claimed))).To(Succeed())
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("other-guid", 0, models.ActualLRPHistoryRecord_Claimed))).To(Succeed())
+			Expect(sqlDB.RecordActualLRPTransition(ctx, logger, record("removed-guid", 0, models.ActualLRPHistoryRecord_Removed))).To(Succeed())
+		})
+
+		It("keeps the newest records of each instance and the records of the desired processes", func() {
+			deleted, err := sqlDB.PruneActualLRPHistory(ctx, logger, 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeEquivalentTo(3))
+
+			Expect(transitionsOf("process-guid", 0)).To(Equal([]models.ActualLRPHistoryRecord_Transition{
+				models.ActualLRPHistoryRecord_Crashed,
+				models.ActualLRPHistoryRecord_Removed,
+			}))
+			Expect(transitionsOf("process-guid", 1)).To(HaveLen(1))
+			Expect(transitionsOf("other-guid", 0)).To(HaveLen(1))
+			Expect(transitionsOf("removed-guid", 0)).To(BeEmpty())
+		})
+
+		It("keeps all the records of the desired processes with a limit of 0", func() {
+			deleted, err := sqlDB.PruneActualLRPHistory(ctx, logger, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeEquivalentTo(1))
+
+			Expect(transitionsOf("process-guid", 0)).To(HaveLen(4))
+			Expect(transitionsOf("removed-guid", 0)).To(BeEmpty())
+		})
+	})
+})
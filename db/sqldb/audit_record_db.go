@@ -0,0 +1,265 @@
+package sqldb
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) AuditRecords(ctx context.Context, logger lager.Logger, filter models.AuditRecordFilter) ([]*models.AuditRecord, error) {
+	logger = logger.Session("db-audit-records", lager.Data{"filter": filter})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := []string{}
+	values := []interface{}{}
+
+	if filter.Guid != "" {
+		wheres = append(wheres, "(process_guid = ? OR instance_guid = ? OR task_guid = ?)")
+		values = append(values, filter.Guid, filter.Guid, filter.Guid)
+	}
+
+	if filter.Actor != "" {
+		wheres = append(wheres, "actor_common_name = ?")
+		values = append(values, filter.Actor)
+	}
+
+	if filter.Since > 0 {
+		wheres = append(wheres, "created_at >= ?")
+		values = append(values, filter.Since)
+	}
+
+	if filter.Until > 0 {
+		wheres = append(wheres, "created_at <= ?")
+		values = append(values, filter.Until)
+	}
+
+	after, err := auditRecordPageKey(filter.PageToken)
+	if err != nil {
+		logger.Error("failed-decoding-page-token", err)
+		return nil, err
+	}
+
+	rows, err := db.helper.AllPaginated(ctx, logger, db.db, auditRecordsTable,
+		auditRecordColumns, auditRecordOrderColumns,
+		after, int(filter.PageSize),
+		strings.Join(wheres, " AND "), values...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	records := []*models.AuditRecord{}
+	for rows.Next() {
+		record, err := db.fetchAuditRecord(logger, rows)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return records, nil
+}
+
+func (db *SQLDB) DeleteAuditRecordsBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error) {
+	logger = logger.Session("db-delete-audit-records-before", lager.Data{"before": before})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	result, err := db.delete(ctx, logger, db.db, auditRecordsTable, "created_at < ?", before.UnixNano())
+	if err != nil {
+		logger.Error("failed-deleting-audit-records", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return deleted, nil
+}
+
+func (db *SQLDB) RecordDenial(ctx context.Context, logger lager.Logger, domain string) error {
+	logger = logger.Session("db-record-denial", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	err := db.recordAudit(ctx, logger, db.db, &models.AuditRecord{
+		Kind:   models.AuditRecord_Call,
+		Action: models.AuditRecord_Deny,
+		Domain: domain,
+	}, nil, nil)
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+	return nil
+}
+
+// auditing returns whether the changes made with the context are recorded in
+// the audit log, for mutations that need to look up the state of the
+// resource to record it.
+func auditing(ctx context.Context) bool {
+	_, ok := audit.FromContext(ctx)
+	return ok
+}
+
+// recordAudit records the change of the resource from before to after in the
+// audit log, in the transaction making the change, when the context carries
+// the API call the change is made for. record identifies the resource.
+func (db *SQLDB) recordAudit(ctx context.Context, logger lager.Logger, q helpers.Queryable, record *models.AuditRecord, before, after interface{}) error {
+	call, ok := audit.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	changes, err := audit.Diff(before, after)
+	if err != nil {
+		logger.Error("failed-diffing-audit-record", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	changesData, err := json.Marshal(changes)
+	if err != nil {
+		logger.Error("failed-marshalling-audit-changes", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	encodedChanges, err := db.encoder.Encode(changesData)
+	if err != nil {
+		logger.Error("failed-encoding-audit-changes", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	organizationalUnitsData, err := json.Marshal(call.OrganizationalUnits)
+	if err != nil {
+		logger.Error("failed-marshalling-organizational-units", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	_, err = db.insert(ctx, logger, q, auditRecordsTable, helpers.SQLAttributes{
+		"created_at":                 db.clock.Now().UnixNano(),
+		"route":                      call.Route,
+		"trace_id":                   truncateString(call.TraceID, 255),
+		"actor_common_name":          truncateString(call.CommonName, 255),
+		"actor_organizational_units": truncateString(string(organizationalUnitsData), 1024),
+		"remote_addr":                truncateString(call.RemoteAddr, 255),
+		"kind":                       record.Kind,
+		"action":                     record.Action,
+		"domain":                     record.Domain,
+		"process_guid":               record.ProcessGuid,
+		"instance_index":             record.Index,
+		"instance_guid":              record.InstanceGuid,
+		"task_guid":                  record.TaskGuid,
+		"changes":                    encodedChanges,
+	})
+	if err != nil {
+		logger.Error("failed-inserting-audit-record", err)
+		return err
+	}
+
+	return nil
+}
+
+func desiredLRPAuditRecord(action models.AuditRecord_Action, desiredLRP *models.DesiredLRP) *models.AuditRecord {
+	return &models.AuditRecord{
+		Kind:        models.AuditRecord_DesiredLRP,
+		Action:      action,
+		Domain:      desiredLRP.Domain,
+		ProcessGuid: desiredLRP.ProcessGuid,
+	}
+}
+
+// actualLRPAuditRecord identifies the instance by the guid it has after the
+// change, or had before it when the change removes the instance from a cell.
+func actualLRPAuditRecord(action models.AuditRecord_Action, before, after *models.ActualLRP) *models.AuditRecord {
+	actualLRP := after
+	if actualLRP == nil {
+		actualLRP = before
+	}
+
+	instanceGuid := actualLRP.InstanceGuid
+	if instanceGuid == "" && before != nil {
+		instanceGuid = before.InstanceGuid
+	}
+
+	return &models.AuditRecord{
+		Kind:         models.AuditRecord_ActualLRP,
+		Action:       action,
+		Domain:       actualLRP.Domain,
+		ProcessGuid:  actualLRP.ProcessGuid,
+		Index:        actualLRP.Index,
+		InstanceGuid: instanceGuid,
+	}
+}
+
+func taskAuditRecord(action models.AuditRecord_Action, task *models.Task) *models.AuditRecord {
+	return &models.AuditRecord{
+		Kind:     models.AuditRecord_Task,
+		Action:   action,
+		Domain:   task.Domain,
+		TaskGuid: task.TaskGuid,
+	}
+}
+
+func (db *SQLDB) fetchAuditRecord(logger lager.Logger, scanner helpers.RowScanner) (*models.AuditRecord, error) {
+	record := &models.AuditRecord{}
+	var organizationalUnitsData string
+	var changesData []byte
+	err := scanner.Scan(
+		&record.Id,
+		&record.CreatedAt,
+		&record.Route,
+		&record.TraceId,
+		&record.ActorCommonName,
+		&organizationalUnitsData,
+		&record.RemoteAddr,
+		&record.Kind,
+		&record.Action,
+		&record.Domain,
+		&record.ProcessGuid,
+		&record.Index,
+		&record.InstanceGuid,
+		&record.TaskGuid,
+		&changesData,
+	)
+	if err != nil {
+		logger.Error("failed-scanning-audit-record", err)
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(organizationalUnitsData), &record.ActorOrganizationalUnits)
+	if err != nil {
+		logger.Error("failed-unmarshalling-organizational-units", err)
+		return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	decodedChanges, err := db.encoder.Decode(changesData)
+	if err != nil {
+		logger.Error("failed-decoding-audit-changes", err)
+		return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	err = json.Unmarshal(decodedChanges, &record.Changes)
+	if err != nil {
+		logger.Error("failed-unmarshalling-audit-changes", err)
+		return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	return record, nil
+}
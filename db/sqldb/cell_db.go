@@ -0,0 +1,281 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) CordonedCellIds(ctx context.Context, logger lager.Logger) ([]string, error) {
+	logger = logger.Session("db-cordoned-cell-ids")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, cellsTable,
+		helpers.ColumnList{cellsTable + ".cell_id"}, helpers.NoLockRow,
+		"cordoned = ?", true,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	cellIds := []string{}
+	for rows.Next() {
+		var cellId string
+		err := rows.Scan(&cellId)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		cellIds = append(cellIds, cellId)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return cellIds, nil
+}
+
+func (db *SQLDB) SetCellCordoned(ctx context.Context, logger lager.Logger, cellId string, cordoned bool) error {
+	logger = logger.Session("db-set-cell-cordoned", lager.Data{"cell_id": cellId, "cordoned": cordoned})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.upsert(ctx, logger, tx, cellsTable,
+			helpers.SQLAttributes{
+				"cell_id":  cellId,
+				"cordoned": cordoned,
+			},
+			"cell_id = ?", cellId,
+		)
+		if err != nil {
+			logger.Error("failed-upserting-cell", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error) {
+	logger = logger.Session("db-cell-drains")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, cellsTable,
+		cellDrainColumns, helpers.NoLockRow,
+		"drain_started_at > 0",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	drains := []*models.CellDrain{}
+	for rows.Next() {
+		drain, err := db.fetchCellDrain(logger, rows)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		drains = append(drains, drain)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return drains, nil
+}
+
+func (db *SQLDB) StartCellDrain(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error) {
+	logger = logger.Session("db-start-cell-drain", lager.Data{"cell_id": cellId})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var drain *models.CellDrain
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		instances, err := db.countActualLRPsOnCell(ctx, logger, tx, cellId)
+		if err != nil {
+			return err
+		}
+
+		now := db.clock.Now().UnixNano()
+		drain = &models.CellDrain{
+			CellId:             cellId,
+			State:              models.CellDrain_Draining,
+			InitialInstances:   int32(instances),
+			RemainingInstances: int32(instances),
+			StartedAt:          now,
+			UpdatedAt:          now,
+		}
+
+		_, err = db.upsert(ctx, logger, tx, cellsTable,
+			helpers.SQLAttributes{
+				"cell_id":                   cellId,
+				"cordoned":                  true,
+				"drain_state":               drain.State,
+				"drain_initial_instances":   drain.InitialInstances,
+				"drain_remaining_instances": drain.RemainingInstances,
+				"drain_error":               drain.Error,
+				"drain_started_at":          drain.StartedAt,
+				"drain_updated_at":          drain.UpdatedAt,
+			},
+			"cell_id = ?", cellId,
+		)
+		if err != nil {
+			logger.Error("failed-upserting-cell", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return drain, nil
+}
+
+func (db *SQLDB) FailCellDrain(ctx context.Context, logger lager.Logger, cellId, errorMessage string) (*models.CellDrain, error) {
+	logger = logger.Session("db-fail-cell-drain", lager.Data{"cell_id": cellId})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var drain *models.CellDrain
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		drain, err = db.fetchCellDrainForUpdate(ctx, logger, tx, cellId)
+		if err != nil {
+			return err
+		}
+
+		drain.State = models.CellDrain_Failed
+		drain.Error = errorMessage
+		drain.UpdatedAt = db.clock.Now().UnixNano()
+
+		return db.updateCellDrain(ctx, logger, tx, drain)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return drain, nil
+}
+
+func (db *SQLDB) UpdateCellDrainProgress(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, *models.CellDrain, error) {
+	logger = logger.Session("db-update-cell-drain-progress", lager.Data{"cell_id": cellId})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var before, after *models.CellDrain
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		before, err = db.fetchCellDrainForUpdate(ctx, logger, tx, cellId)
+		if err != nil {
+			return err
+		}
+
+		drain := *before
+		after = &drain
+		if before.State != models.CellDrain_Draining {
+			return nil
+		}
+
+		instances, err := db.countActualLRPsOnCell(ctx, logger, tx, cellId)
+		if err != nil {
+			return err
+		}
+
+		after.RemainingInstances = int32(instances)
+		if instances == 0 {
+			after.State = models.CellDrain_Drained
+		}
+		if after.Equal(before) {
+			return nil
+		}
+
+		after.UpdatedAt = db.clock.Now().UnixNano()
+		return db.updateCellDrain(ctx, logger, tx, after)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return before, after, nil
+}
+
+func (db *SQLDB) countActualLRPsOnCell(ctx context.Context, logger lager.Logger, q helpers.Queryable, cellId string) (int, error) {
+	query := `
+		SELECT COUNT(*) AS instances
+			FROM actual_lrps
+			WHERE actual_lrps.cell_id = ?
+	`
+
+	var instances int
+	row := q.QueryRowContext(ctx, db.helper.Rebind(query), cellId)
+	err := row.Scan(&instances)
+	if err != nil {
+		logger.Error("failed-counting-actual-lrps-on-cell", err)
+		return 0, err
+	}
+	return instances, nil
+}
+
+func (db *SQLDB) fetchCellDrainForUpdate(ctx context.Context, logger lager.Logger, tx helpers.Tx, cellId string) (*models.CellDrain, error) {
+	row := db.one(ctx, logger, tx, cellsTable,
+		cellDrainColumns, helpers.LockRow,
+		"cell_id = ? AND drain_started_at > 0", cellId,
+	)
+	return db.fetchCellDrain(logger, row)
+}
+
+func (db *SQLDB) updateCellDrain(ctx context.Context, logger lager.Logger, tx helpers.Tx, drain *models.CellDrain) error {
+	_, err := db.update(ctx, logger, tx, cellsTable,
+		helpers.SQLAttributes{
+			"drain_state":               drain.State,
+			"drain_remaining_instances": drain.RemainingInstances,
+			"drain_error":               drain.Error,
+			"drain_updated_at":          drain.UpdatedAt,
+		},
+		"cell_id = ?", drain.CellId,
+	)
+	if err != nil {
+		logger.Error("failed-updating-cell-drain", err)
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) fetchCellDrain(logger lager.Logger, scanner helpers.RowScanner) (*models.CellDrain, error) {
+	drain := &models.CellDrain{}
+	var drainError sql.NullString
+	err := scanner.Scan(
+		&drain.CellId,
+		&drain.State,
+		&drain.InitialInstances,
+		&drain.RemainingInstances,
+		&drainError,
+		&drain.StartedAt,
+		&drain.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	drain.Error = drainError.String
+	return drain, nil
+}
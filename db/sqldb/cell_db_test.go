@@ -0,0 +1,165 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellDB", func() {
+	claimActualLRP := func(processGuid string, index int32, cellId string) {
+		key := models.NewActualLRPKey(processGuid, index, "some-domain")
+		_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &key)
+		Expect(err).NotTo(HaveOccurred())
+		instanceKey := models.NewActualLRPInstanceKey(processGuid+"-instance", cellId)
+		_, _, err = sqlDB.ClaimActualLRP(ctx, logger, processGuid, index, &instanceKey)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("SetCellCordoned", func() {
+		It("cordons and uncordons the cell", func() {
+			Expect(sqlDB.SetCellCordoned(ctx, logger, "cell-a", true)).To(Succeed())
+			Expect(sqlDB.SetCellCordoned(ctx, logger, "cell-b", true)).To(Succeed())
+
+			cellIds, err := sqlDB.CordonedCellIds(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cellIds).To(ConsistOf("cell-a", "cell-b"))
+
+			Expect(sqlDB.SetCellCordoned(ctx, logger, "cell-a", false)).To(Succeed())
+
+			cellIds, err = sqlDB.CordonedCellIds(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cellIds).To(ConsistOf("cell-b"))
+		})
+	})
+
+	Describe("StartCellDrain", func() {
+		BeforeEach(func() {
+			claimActualLRP("some-guid", 0, "cell-a")
+			claimActualLRP("some-guid", 1, "cell-a")
+			claimActualLRP("some-guid", 2, "cell-b")
+		})
+
+		It("cordons the cell and counts the instances to drain", func() {
+			drain, err := sqlDB.StartCellDrain(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drain).To(Equal(&models.CellDrain{
+				CellId:             "cell-a",
+				State:              models.CellDrain_Draining,
+				InitialInstances:   2,
+				RemainingInstances: 2,
+				StartedAt:          fakeClock.Now().UnixNano(),
+				UpdatedAt:          fakeClock.Now().UnixNano(),
+			}))
+
+			cellIds, err := sqlDB.CordonedCellIds(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cellIds).To(ConsistOf("cell-a"))
+
+			drains, err := sqlDB.CellDrains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drains).To(Equal([]*models.CellDrain{drain}))
+		})
+
+		It("restarts a failed drain", func() {
+			_, err := sqlDB.StartCellDrain(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.FailCellDrain(ctx, logger, "cell-a", "boom")
+			Expect(err).NotTo(HaveOccurred())
+
+			drain, err := sqlDB.StartCellDrain(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drain.State).To(Equal(models.CellDrain_Draining))
+			Expect(drain.Error).To(BeEmpty())
+		})
+	})
+
+	Describe("CellDrains", func() {
+		It("does not list cordoned cells that were never drained", func() {
+			Expect(sqlDB.SetCellCordoned(ctx, logger, "cell-a", true)).To(Succeed())
+
+			drains, err := sqlDB.CellDrains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drains).To(BeEmpty())
+		})
+	})
+
+	Describe("FailCellDrain", func() {
+		It("marks the drain as failed", func() {
+			_, err := sqlDB.StartCellDrain(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(time.Minute)
+			drain, err := sqlDB.FailCellDrain(ctx, logger, "cell-a", "boom")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drain.State).To(Equal(models.CellDrain_Failed))
+			Expect(drain.Error).To(Equal("boom"))
+			Expect(drain.UpdatedAt).To(Equal(fakeClock.Now().UnixNano()))
+
+			drains, err := sqlDB.CellDrains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drains).To(Equal([]*models.CellDrain{drain}))
+		})
+
+		It("returns not found for cells that are not drained", func() {
+			Expect(sqlDB.SetCellCordoned(ctx, logger, "cell-a", true)).To(Succeed())
+
+			_, err := sqlDB.FailCellDrain(ctx, logger, "cell-a", "boom")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("UpdateCellDrainProgress", func() {
+		BeforeEach(func() {
+			claimActualLRP("some-guid", 0, "cell-a")
+			claimActualLRP("other-guid", 0, "cell-a")
+
+			_, err := sqlDB.StartCellDrain(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.Increment(time.Minute)
+		})
+
+		It("does not change the drain while the instances remain", func() {
+			before, after, err := sqlDB.UpdateCellDrainProgress(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after).To(Equal(before))
+		})
+
+		It("counts the remaining instances", func() {
+			Expect(sqlDB.RemoveActualLRP(ctx, logger, "some-guid", 0, nil)).To(Succeed())
+
+			before, after, err := sqlDB.UpdateCellDrainProgress(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before.RemainingInstances).To(BeEquivalentTo(2))
+			Expect(after.RemainingInstances).To(BeEquivalentTo(1))
+			Expect(after.State).To(Equal(models.CellDrain_Draining))
+			Expect(after.UpdatedAt).To(Equal(fakeClock.Now().UnixNano()))
+		})
+
+		It("marks the drain as drained once no instances remain", func() {
+			Expect(sqlDB.RemoveActualLRP(ctx, logger, "some-guid", 0, nil)).To(Succeed())
+			Expect(sqlDB.RemoveActualLRP(ctx, logger, "other-guid", 0, nil)).To(Succeed())
+
+			_, after, err := sqlDB.UpdateCellDrainProgress(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after.State).To(Equal(models.CellDrain_Drained))
+			Expect(after.RemainingInstances).To(BeZero())
+
+			drains, err := sqlDB.CellDrains(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drains).To(Equal([]*models.CellDrain{after}))
+		})
+
+		It("leaves failed drains alone", func() {
+			failed, err := sqlDB.FailCellDrain(ctx, logger, "cell-a", "boom")
+			Expect(err).NotTo(HaveOccurred())
+
+			before, after, err := sqlDB.UpdateCellDrainProgress(ctx, logger, "cell-a")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before).To(Equal(failed))
+			Expect(after).To(Equal(failed))
+		})
+	})
+})
@@ -0,0 +1,522 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.DesiredLRP, *models.Deployment, error) {
+	logger = logger.Session("db-start-deployment", lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var beforeDesiredLRP *models.DesiredLRP
+	var deployment *models.Deployment
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		var originalRunInfo *models.DesiredLRPRunInfo
+		row := db.one(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.LockRow,
+			"process_guid = ?", processGuid,
+		)
+		beforeDesiredLRP, originalRunInfo, err = db.fetchDesiredLRP(ctx, logger, row, tx)
+		if err != nil {
+			logger.Error("failed-lock-desired", err)
+			return err
+		}
+
+		existing, _, err := db.fetchDeploymentForUpdate(ctx, logger, tx, processGuid)
+		if err != nil && err != sql.ErrNoRows {
+			logger.Error("failed-fetching-deployment", err)
+			return err
+		}
+		if existing != nil && existing.IsActive() {
+			logger.Info("deployment-in-progress", lager.Data{"deployment_guid": existing.DeploymentGuid})
+			return models.ErrResourceConflict
+		}
+
+		newRunInfo := *runInfo
+		newRunInfo.DesiredLRPKey = originalRunInfo.DesiredLRPKey
+		newRunInfo.CreatedAt = originalRunInfo.CreatedAt
+
+		runInfoData, err := db.serializeModel(logger, &newRunInfo)
+		if err != nil {
+			logger.Error("failed-serializing-run-info", err)
+			return err
+		}
+
+		previousRunInfoData, err := db.serializeModel(logger, originalRunInfo)
+		if err != nil {
+			logger.Error("failed-serializing-run-info", err)
+			return err
+		}
+
+		_, err = db.update(ctx, logger, tx, desiredLRPsTable,
+			helpers.SQLAttributes{
+				"run_info":                  runInfoData,
+				"log_rate_bytes_per_second": logRateBytesPerSecond(newRunInfo.LogRateLimit),
+				"instances":                 beforeDesiredLRP.Instances + maxSurge,
+				"modification_tag_index":    beforeDesiredLRP.ModificationTag.Index + 1,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp", err)
+			return err
+		}
+
+		guid, err := db.guidProvider.NextGUID()
+		if err != nil {
+			logger.Error("failed-to-generate-guid", err)
+			return models.ErrGUIDGeneration
+		}
+
+		pendingIndices := make([]int32, beforeDesiredLRP.Instances)
+		for i := range pendingIndices {
+			pendingIndices[i] = int32(i)
+		}
+
+		now := db.clock.Now().UnixNano()
+		deployment = &models.Deployment{
+			DeploymentGuid: guid,
+			ProcessGuid:    processGuid,
+			Domain:         beforeDesiredLRP.Domain,
+			State:          models.Deployment_InProgress,
+			MaxSurge:       maxSurge,
+			MaxUnavailable: maxUnavailable,
+			Instances:      beforeDesiredLRP.Instances,
+			PendingIndices: pendingIndices,
+			CreatedAt:      now,
+			UpdatedAt:      now,
+		}
+
+		pendingData, retiringData, err := encodeDeploymentProgress(logger, deployment)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.delete(ctx, logger, tx, deploymentsTable, "process_guid = ?", processGuid)
+		if err != nil {
+			logger.Error("failed-deleting-previous-deployment", err)
+			return err
+		}
+
+		_, err = db.insert(ctx, logger, tx, deploymentsTable,
+			helpers.SQLAttributes{
+				"process_guid":       deployment.ProcessGuid,
+				"guid":               deployment.DeploymentGuid,
+				"domain":             deployment.Domain,
+				"state":              deployment.State,
+				"paused":             deployment.Paused,
+				"max_surge":          deployment.MaxSurge,
+				"max_unavailable":    deployment.MaxUnavailable,
+				"instances":          deployment.Instances,
+				"pending_indices":    pendingData,
+				"retiring_instances": retiringData,
+				"previous_run_info":  previousRunInfoData,
+				"created_at":         deployment.CreatedAt,
+				"updated_at":         deployment.UpdatedAt,
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-deployment", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return beforeDesiredLRP, deployment, err
+}
+
+func (db *SQLDB) DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error) {
+	logger = logger.Session("db-deployment-by-process-guid", lager.Data{"process_guid": processGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var deployment *models.Deployment
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, deploymentsTable,
+			deploymentColumns, helpers.NoLockRow,
+			"process_guid = ?", processGuid,
+		)
+		deployment, err = db.fetchDeployment(logger, row)
+		return err
+	})
+
+	return deployment, err
+}
+
+func (db *SQLDB) ActiveDeployments(ctx context.Context, logger lager.Logger) ([]*models.Deployment, error) {
+	logger = logger.Session("db-active-deployments")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	deployments := []*models.Deployment{}
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		rows, err := db.all(ctx, logger, tx, deploymentsTable,
+			deploymentColumns, helpers.NoLockRow,
+			"state IN (?, ?)", models.Deployment_InProgress, models.Deployment_RollingBack,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			deployment, err := db.fetchDeployment(logger, rows)
+			if err != nil {
+				logger.Error("failed-reading-row", err)
+				continue
+			}
+			deployments = append(deployments, deployment)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return db.convertSQLError(rows.Err())
+		}
+
+		return nil
+	})
+
+	return deployments, err
+}
+
+func (db *SQLDB) UpdateDeploymentProgress(ctx context.Context, logger lager.Logger, deployment *models.Deployment) error {
+	logger = logger.Session("db-update-deployment-progress", lager.Data{"process_guid": deployment.ProcessGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	pendingData, retiringData, err := encodeDeploymentProgress(logger, deployment)
+	if err != nil {
+		return err
+	}
+
+	deployment.UpdatedAt = db.clock.Now().UnixNano()
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		result, err := db.update(ctx, logger, tx, deploymentsTable,
+			helpers.SQLAttributes{
+				"pending_indices":    pendingData,
+				"retiring_instances": retiringData,
+				"updated_at":         deployment.UpdatedAt,
+			},
+			"process_guid = ? AND guid = ? AND state = ?",
+			deployment.ProcessGuid, deployment.DeploymentGuid, deployment.State,
+		)
+		if err != nil {
+			logger.Error("failed-updating-deployment", err)
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-getting-rows-affected", err)
+			return err
+		}
+
+		if rowsAffected == 0 {
+			logger.Info("deployment-changed-state")
+			return models.ErrResourceConflict
+		}
+
+		return nil
+	})
+}
+
+func (db *SQLDB) SetDeploymentPaused(ctx context.Context, logger lager.Logger, processGuid string, paused bool) (*models.Deployment, error) {
+	logger = logger.Session("db-set-deployment-paused", lager.Data{"process_guid": processGuid, "paused": paused})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var deployment *models.Deployment
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		deployment, _, err = db.fetchDeploymentForUpdate(ctx, logger, tx, processGuid)
+		if err != nil {
+			logger.Error("failed-fetching-deployment", err)
+			return err
+		}
+
+		if !deployment.IsActive() {
+			action := "resume"
+			if paused {
+				action = "pause"
+			}
+			return models.NewDeploymentTransitionError(deployment.State, action)
+		}
+
+		deployment.Paused = paused
+		deployment.UpdatedAt = db.clock.Now().UnixNano()
+
+		_, err = db.update(ctx, logger, tx, deploymentsTable,
+			helpers.SQLAttributes{
+				"paused":     deployment.Paused,
+				"updated_at": deployment.UpdatedAt,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-deployment", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return deployment, err
+}
+
+func (db *SQLDB) RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRP, *models.Deployment, error) {
+	logger = logger.Session("db-rollback-deployment", lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var beforeDesiredLRP *models.DesiredLRP
+	var deployment *models.Deployment
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.LockRow,
+			"process_guid = ?", processGuid,
+		)
+		beforeDesiredLRP, _, err = db.fetchDesiredLRP(ctx, logger, row, tx)
+		if err != nil {
+			logger.Error("failed-lock-desired", err)
+			return err
+		}
+
+		var previousRunInfoData []byte
+		deployment, previousRunInfoData, err = db.fetchDeploymentForUpdate(ctx, logger, tx, processGuid)
+		if err != nil {
+			logger.Error("failed-fetching-deployment", err)
+			return err
+		}
+
+		if deployment.State != models.Deployment_InProgress {
+			return models.NewDeploymentTransitionError(deployment.State, "roll back")
+		}
+
+		var previousRunInfo models.DesiredLRPRunInfo
+		err = db.deserializeModel(logger, previousRunInfoData, &previousRunInfo)
+		if err != nil {
+			logger.Error("failed-parsing-run-info", err)
+			return err
+		}
+
+		deployment.Rollback()
+		deployment.Paused = false
+		deployment.UpdatedAt = db.clock.Now().UnixNano()
+
+		_, err = db.update(ctx, logger, tx, desiredLRPsTable,
+			helpers.SQLAttributes{
+				"run_info":                  previousRunInfoData,
+				"log_rate_bytes_per_second": logRateBytesPerSecond(previousRunInfo.LogRateLimit),
+				"modification_tag_index":    beforeDesiredLRP.ModificationTag.Index + 1,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp", err)
+			return err
+		}
+
+		pendingData, retiringData, err := encodeDeploymentProgress(logger, deployment)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.update(ctx, logger, tx, deploymentsTable,
+			helpers.SQLAttributes{
+				"state":              deployment.State,
+				"paused":             deployment.Paused,
+				"pending_indices":    pendingData,
+				"retiring_instances": retiringData,
+				"updated_at":         deployment.UpdatedAt,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-deployment", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return beforeDesiredLRP, deployment, err
+}
+
+func (db *SQLDB) CompleteDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRP, *models.Deployment, error) {
+	logger = logger.Session("db-complete-deployment", lager.Data{"process_guid": processGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	var beforeDesiredLRP *models.DesiredLRP
+	var deployment *models.Deployment
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.LockRow,
+			"process_guid = ?", processGuid,
+		)
+		beforeDesiredLRP, _, err = db.fetchDesiredLRP(ctx, logger, row, tx)
+		if err != nil {
+			logger.Error("failed-lock-desired", err)
+			return err
+		}
+
+		deployment, _, err = db.fetchDeploymentForUpdate(ctx, logger, tx, processGuid)
+		if err != nil {
+			logger.Error("failed-fetching-deployment", err)
+			return err
+		}
+
+		if !deployment.IsActive() {
+			return models.NewDeploymentTransitionError(deployment.State, "complete")
+		}
+
+		instances := beforeDesiredLRP.Instances - deployment.MaxSurge
+		if instances < 0 {
+			instances = 0
+		}
+
+		_, err = db.update(ctx, logger, tx, desiredLRPsTable,
+			helpers.SQLAttributes{
+				"instances":              instances,
+				"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-desired-lrp", err)
+			return err
+		}
+
+		if deployment.State == models.Deployment_RollingBack {
+			deployment.State = models.Deployment_RolledBack
+		} else {
+			deployment.State = models.Deployment_Succeeded
+		}
+		deployment.Paused = false
+		deployment.PendingIndices = nil
+		deployment.RetiringInstances = nil
+		deployment.UpdatedAt = db.clock.Now().UnixNano()
+
+		_, err = db.update(ctx, logger, tx, deploymentsTable,
+			helpers.SQLAttributes{
+				"state":              deployment.State,
+				"paused":             deployment.Paused,
+				"pending_indices":    nil,
+				"retiring_instances": nil,
+				"previous_run_info":  nil,
+				"updated_at":         deployment.UpdatedAt,
+			},
+			"process_guid = ?", processGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-deployment", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return beforeDesiredLRP, deployment, err
+}
+
+// activeDeploymentExists is used to keep the instances of a DesiredLRP from
+// being changed while a deployment has added surge instances to it.
+func (db *SQLDB) activeDeploymentExists(ctx context.Context, logger lager.Logger, tx helpers.Tx, processGuid string) (bool, error) {
+	deployment, _, err := db.fetchDeploymentForUpdate(ctx, logger, tx, processGuid)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return deployment.IsActive(), nil
+}
+
+func (db *SQLDB) fetchDeploymentForUpdate(ctx context.Context, logger lager.Logger, tx helpers.Tx, processGuid string) (*models.Deployment, []byte, error) {
+	var previousRunInfoData []byte
+	row := db.one(ctx, logger, tx, deploymentsTable,
+		deploymentForUpdateColumns, helpers.LockRow,
+		"process_guid = ?", processGuid,
+	)
+	deployment, err := db.fetchDeployment(logger, row, &previousRunInfoData)
+	return deployment, previousRunInfoData, err
+}
+
+// "scanner" needs to have the columns defined in the deploymentColumns constant
+func (db *SQLDB) fetchDeployment(logger lager.Logger, scanner helpers.RowScanner, dest ...interface{}) (*models.Deployment, error) {
+	deployment := &models.Deployment{}
+	var pendingData, retiringData []byte
+	values := []interface{}{
+		&deployment.ProcessGuid,
+		&deployment.DeploymentGuid,
+		&deployment.Domain,
+		&deployment.State,
+		&deployment.Paused,
+		&deployment.MaxSurge,
+		&deployment.MaxUnavailable,
+		&deployment.Instances,
+		&pendingData,
+		&retiringData,
+		&deployment.CreatedAt,
+		&deployment.UpdatedAt,
+	}
+	values = append(values, dest...)
+
+	err := scanner.Scan(values...)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	if len(pendingData) > 0 {
+		err = json.Unmarshal(pendingData, &deployment.PendingIndices)
+		if err != nil {
+			logger.Error("failed-parsing-pending-indices", err)
+			return nil, models.ErrDeserialize
+		}
+	}
+
+	if len(retiringData) > 0 {
+		err = json.Unmarshal(retiringData, &deployment.RetiringInstances)
+		if err != nil {
+			logger.Error("failed-parsing-retiring-instances", err)
+			return nil, models.ErrDeserialize
+		}
+	}
+
+	return deployment, nil
+}
+
+func encodeDeploymentProgress(logger lager.Logger, deployment *models.Deployment) ([]byte, []byte, error) {
+	pendingData, err := json.Marshal(deployment.PendingIndices)
+	if err != nil {
+		logger.Error("failed-marshalling-pending-indices", err)
+		return nil, nil, models.ErrBadRequest
+	}
+
+	retiringData, err := json.Marshal(deployment.RetiringInstances)
+	if err != nil {
+		logger.Error("failed-marshalling-retiring-instances", err)
+		return nil, nil, models.ErrBadRequest
+	}
+
+	return pendingData, retiringData, nil
+}
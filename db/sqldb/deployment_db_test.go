@@ -0,0 +1,177 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentDB", func() {
+	var (
+		desiredLRP *models.DesiredLRP
+		runInfo    *models.DesiredLRPRunInfo
+	)
+
+	BeforeEach(func() {
+		fakeGUIDProvider.NextGUIDReturns("deployment-guid", nil)
+
+		desiredLRP = model_helpers.NewValidDesiredLRP("the-guid")
+		desiredLRP.Instances = 3
+		Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+		info := desiredLRP.DesiredLRPRunInfo(time.Now())
+		info.EnvironmentVariables = []models.EnvironmentVariable{{Name: "VERSION", Value: "2"}}
+		runInfo = &info
+	})
+
+	Describe("StartDeployment", func() {
+		It("swaps the run info and adds the surge instances", func() {
+			before, deployment, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(before.Instances).To(BeEquivalentTo(3))
+
+			Expect(deployment.DeploymentGuid).To(Equal("deployment-guid"))
+			Expect(deployment.State).To(Equal(models.Deployment_InProgress))
+			Expect(deployment.Instances).To(BeEquivalentTo(3))
+			Expect(deployment.PendingIndices).To(Equal([]int32{0, 1, 2}))
+
+			after, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after.Instances).To(BeEquivalentTo(4))
+			Expect(after.EnvironmentVariables).To(Equal(runInfo.EnvironmentVariables))
+			Expect(after.ModificationTag.Index).To(Equal(before.ModificationTag.Index + 1))
+
+			fetched, err := sqlDB.DeploymentByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetched).To(Equal(deployment))
+		})
+
+		It("does not start a second deployment while one is active", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).To(Equal(models.ErrResourceConflict))
+		})
+
+		It("keeps the instances from being updated while it is active", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			update := &models.DesiredLRPUpdate{}
+			update.SetInstances(5)
+			_, err = sqlDB.UpdateDesiredLRP(ctx, logger, "the-guid", update)
+			Expect(err).To(Equal(models.ErrResourceConflict))
+		})
+
+		It("returns not found for unknown desired LRPs", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "unknown-guid", runInfo, 1, 0)
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("UpdateDeploymentProgress", func() {
+		var deployment *models.Deployment
+
+		BeforeEach(func() {
+			var err error
+			_, deployment, err = sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the pending and retiring instances", func() {
+			deployment.Retire(0, "instance-guid")
+			Expect(sqlDB.UpdateDeploymentProgress(ctx, logger, deployment)).To(Succeed())
+
+			fetched, err := sqlDB.DeploymentByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetched.PendingIndices).To(Equal([]int32{1, 2}))
+			Expect(fetched.RetiringInstances).To(Equal(map[int32]string{0: "instance-guid"}))
+		})
+
+		It("fails when the deployment was rolled back in the meantime", func() {
+			_, _, err := sqlDB.RollbackDeployment(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.UpdateDeploymentProgress(ctx, logger, deployment)).To(Equal(models.ErrResourceConflict))
+		})
+	})
+
+	Describe("ActiveDeployments", func() {
+		It("returns the deployments in progress, including paused ones", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.SetDeploymentPaused(ctx, logger, "the-guid", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			deployments, err := sqlDB.ActiveDeployments(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployments).To(HaveLen(1))
+			Expect(deployments[0].Paused).To(BeTrue())
+
+			_, _, err = sqlDB.CompleteDeployment(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			deployments, err = sqlDB.ActiveDeployments(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployments).To(BeEmpty())
+		})
+	})
+
+	Describe("RollbackDeployment", func() {
+		It("restores the previous run info", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, deployment, err := sqlDB.RollbackDeployment(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.State).To(Equal(models.Deployment_RollingBack))
+
+			after, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after.EnvironmentVariables).To(Equal(desiredLRP.EnvironmentVariables))
+			Expect(after.Instances).To(BeEquivalentTo(4))
+		})
+
+		It("cannot roll back a finished deployment", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.CompleteDeployment(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, _, err = sqlDB.RollbackDeployment(ctx, logger, "the-guid")
+			Expect(models.ConvertError(err).Type).To(Equal(models.Error_InvalidStateTransition))
+		})
+	})
+
+	Describe("CompleteDeployment", func() {
+		It("removes the surge instances", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, deployment, err := sqlDB.CompleteDeployment(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deployment.State).To(Equal(models.Deployment_Succeeded))
+
+			after, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(after.Instances).To(BeEquivalentTo(3))
+			Expect(after.EnvironmentVariables).To(Equal(runInfo.EnvironmentVariables))
+		})
+	})
+
+	Describe("RemoveDesiredLRP", func() {
+		It("removes the deployment", func() {
+			_, _, err := sqlDB.StartDeployment(ctx, logger, "the-guid", runInfo, 1, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.RemoveDesiredLRP(ctx, logger, "the-guid")).To(Succeed())
+
+			_, err = sqlDB.DeploymentByProcessGuid(ctx, logger, "the-guid")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
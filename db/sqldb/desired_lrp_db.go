@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -17,6 +18,13 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 	defer logger.Info("complete")
 
 	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		requested := &models.DomainUsage{}
+		requested.AddDesiredLRP(desiredLRP, desiredLRP.Instances)
+		err := db.admitToDomainQuota(ctx, logger, tx, desiredLRP.Domain, requested)
+		if err != nil {
+			return err
+		}
+
 		routesData, err := db.encodeRouteData(logger, desiredLRP.Routes)
 		if err != nil {
 			logger.Error("failed-encoding-route-data", err)
@@ -61,34 +69,58 @@ func (db *SQLDB) DesireLRP(ctx context.Context, logger lager.Logger, desiredLRP
 			return err
 		}
 
+		labelsData, err := json.Marshal(desiredLRP.Labels)
+		if err != nil {
+			logger.Error("failed-to-serialize-model", err)
+			return err
+		}
+
+		var restartPolicyData []byte
+		if desiredLRP.RestartPolicy != nil {
+			restartPolicyData, err = json.Marshal(desiredLRP.RestartPolicy)
+			if err != nil {
+				logger.Error("failed-to-serialize-model", err)
+				return err
+			}
+		}
+
 		desiredLRP.ModificationTag = &models.ModificationTag{Epoch: guid, Index: 0}
 
 		_, err = db.insert(ctx, logger, tx, desiredLRPsTable,
 			helpers.SQLAttributes{
-				"process_guid":           desiredLRP.ProcessGuid,
-				"domain":                 desiredLRP.Domain,
-				"log_guid":               desiredLRP.LogGuid,
-				"annotation":             desiredLRP.Annotation,
-				"instances":              desiredLRP.Instances,
-				"memory_mb":              desiredLRP.MemoryMb,
-				"disk_mb":                desiredLRP.DiskMb,
-				"max_pids":               desiredLRP.MaxPids,
-				"rootfs":                 desiredLRP.RootFs,
-				"volume_placement":       volumePlacementData,
-				"modification_tag_epoch": desiredLRP.ModificationTag.Epoch,
-				"modification_tag_index": desiredLRP.ModificationTag.Index,
-				"routes":                 routesData,
-				"run_info":               runInfoData,
-				"placement_tags":         placementTagData,
-				"metric_tags":            metricTagsData,
-				"update_strategy":        desiredLRP.UpdateStrategy,
+				"process_guid":              desiredLRP.ProcessGuid,
+				"domain":                    desiredLRP.Domain,
+				"log_guid":                  desiredLRP.LogGuid,
+				"annotation":                desiredLRP.Annotation,
+				"instances":                 desiredLRP.Instances,
+				"memory_mb":                 desiredLRP.MemoryMb,
+				"disk_mb":                   desiredLRP.DiskMb,
+				"max_pids":                  desiredLRP.MaxPids,
+				"rootfs":                    desiredLRP.RootFs,
+				"volume_placement":          volumePlacementData,
+				"modification_tag_epoch":    desiredLRP.ModificationTag.Epoch,
+				"modification_tag_index":    desiredLRP.ModificationTag.Index,
+				"routes":                    routesData,
+				"run_info":                  runInfoData,
+				"placement_tags":            placementTagData,
+				"metric_tags":               metricTagsData,
+				"update_strategy":           desiredLRP.UpdateStrategy,
+				"labels":                    labelsData,
+				"restart_policy":            restartPolicyData,
+				"log_rate_bytes_per_second": logRateBytesPerSecond(desiredLRP.LogRateLimit),
 			},
 		)
 		if err != nil {
 			logger.Error("failed-inserting-desired", err)
 			return err
 		}
-		return nil
+
+		err = desiredLRPLabels.replace(ctx, logger, db, tx, desiredLRP.ProcessGuid, desiredLRP.Labels)
+		if err != nil {
+			return err
+		}
+
+		return db.recordAudit(ctx, logger, tx, desiredLRPAuditRecord(models.AuditRecord_Create, desiredLRP), nil, desiredLRP)
 	})
 }
 
@@ -114,6 +146,11 @@ func (db *SQLDB) DesiredLRPByProcessGuid(ctx context.Context, logger lager.Logge
 }
 
 func (db *SQLDB) DesiredLRPs(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	desiredLRPs, _, err := db.ListDesiredLRPs(ctx, logger, filter)
+	return desiredLRPs, err
+}
+
+func (db *SQLDB) ListDesiredLRPs(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRP, bbsdb.ListMetadata, error) {
 	logger = logger.Session("db-desired-lrps", lager.Data{"filter": filter})
 	logger.Debug("start")
 	defer logger.Debug("complete")
@@ -147,11 +184,29 @@ func (db *SQLDB) DesiredLRPs(ctx context.Context, logger lager.Logger, filter mo
 		}
 	}
 
+	labelWheres, labelValues := desiredLRPLabels.wheres(filter.LabelSelector)
+	wheres = append(wheres, labelWheres...)
+	values = append(values, labelValues...)
+
+	after, err := desiredLRPPageKey(filter.PageToken)
+	if err != nil {
+		logger.Error("failed-decoding-page-token", err)
+		return nil, bbsdb.ListMetadata{}, err
+	}
+
 	results := []*models.DesiredLRP{}
+	var metadata bbsdb.ListMetadata
 
-	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
-		rows, err := db.all(ctx, logger, tx, desiredLRPsTable,
-			desiredLRPColumns, helpers.NoLockRow,
+	err = db.readSnapshot(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		metadata.ResourceVersion, err = db.resourceVersion(ctx, logger, tx)
+		if err != nil {
+			return err
+		}
+
+		rows, err := db.allPage(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, desiredLRPPageColumns, desiredLRPRowPageToken,
+			after, filter.PageSize,
 			strings.Join(wheres, " AND "), values...,
 		)
 		if err != nil {
@@ -165,14 +220,20 @@ func (db *SQLDB) DesiredLRPs(ctx context.Context, logger lager.Logger, filter mo
 			logger.Error("failed-fetching-row", rows.Err())
 			return db.convertSQLError(rows.Err())
 		}
+		metadata.NextPageToken = rows.NextPageToken()
 
 		return nil
 	})
 
-	return results, err
+	return results, metadata, err
 }
 
 func (db *SQLDB) DesiredLRPSchedulingInfos(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	schedulingInfos, _, err := db.ListDesiredLRPSchedulingInfos(ctx, logger, filter)
+	return schedulingInfos, err
+}
+
+func (db *SQLDB) ListDesiredLRPSchedulingInfos(ctx context.Context, logger lager.Logger, filter models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, bbsdb.ListMetadata, error) {
 	logger = logger.Session("db-desired-lrps-scheduling-infos", lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
@@ -193,9 +254,20 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(ctx context.Context, logger lager.Log
 		}
 	}
 
+	labelWheres, labelValues := desiredLRPLabels.wheres(filter.LabelSelector)
+	wheres = append(wheres, labelWheres...)
+	values = append(values, labelValues...)
+
 	results := []*models.DesiredLRPSchedulingInfo{}
+	var metadata bbsdb.ListMetadata
+
+	err := db.readSnapshot(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		metadata.ResourceVersion, err = db.resourceVersion(ctx, logger, tx)
+		if err != nil {
+			return err
+		}
 
-	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 		rows, err := db.all(ctx, logger, tx, desiredLRPsTable,
 			schedulingInfoColumns, helpers.NoLockRow,
 			strings.Join(wheres, " AND "), values...,
@@ -223,7 +295,7 @@ func (db *SQLDB) DesiredLRPSchedulingInfos(ctx context.Context, logger lager.Log
 		return nil
 	})
 
-	return results, err
+	return results, metadata, err
 }
 
 func (db *SQLDB) DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.DesiredLRPSchedulingInfo, error) {
@@ -338,6 +410,15 @@ func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, proc
 			return err
 		}
 
+		if update.InstancesExists() && update.GetInstances() > beforeDesiredLRP.Instances {
+			requested := &models.DomainUsage{}
+			requested.AddDesiredLRP(beforeDesiredLRP, update.GetInstances()-beforeDesiredLRP.Instances)
+			err = db.admitToDomainQuota(ctx, logger, tx, beforeDesiredLRP.Domain, requested)
+			if err != nil {
+				return err
+			}
+		}
+
 		updateAttributes := helpers.SQLAttributes{"modification_tag_index": beforeDesiredLRP.ModificationTag.Index + 1}
 
 		if update.AnnotationExists() {
@@ -345,6 +426,15 @@ func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, proc
 		}
 
 		if update.InstancesExists() {
+			deploying, err := db.activeDeploymentExists(ctx, logger, tx, processGuid)
+			if err != nil {
+				logger.Error("failed-fetching-deployment", err)
+				return err
+			}
+			if deploying {
+				logger.Info("cannot-scale-during-deployment")
+				return models.ErrResourceConflict
+			}
 			updateAttributes["instances"] = update.GetInstances()
 		}
 
@@ -388,7 +478,21 @@ func (db *SQLDB) UpdateDesiredLRP(ctx context.Context, logger lager.Logger, proc
 			return err
 		}
 
-		return nil
+		if !auditing(ctx) {
+			return nil
+		}
+
+		row = db.one(ctx, logger, tx, desiredLRPsTable,
+			desiredLRPColumns, helpers.NoLockRow,
+			"process_guid = ?", processGuid,
+		)
+		afterDesiredLRP, _, err := db.fetchDesiredLRP(ctx, logger, row, tx)
+		if err != nil {
+			logger.Error("failed-fetching-updated-desired", err)
+			return err
+		}
+
+		return db.recordAudit(ctx, logger, tx, desiredLRPAuditRecord(models.AuditRecord_Update, beforeDesiredLRP), beforeDesiredLRP, afterDesiredLRP)
 	})
 
 	return beforeDesiredLRP, err
@@ -428,7 +532,17 @@ func (db *SQLDB) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, proc
 	defer logger.Info("complete")
 
 	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
-		err := db.lockDesiredLRPByGuidForUpdate(ctx, logger, processGuid, tx)
+		var beforeDesiredLRP *models.DesiredLRP
+		var err error
+		if auditing(ctx) {
+			row := db.one(ctx, logger, tx, desiredLRPsTable,
+				desiredLRPColumns, helpers.LockRow,
+				"process_guid = ?", processGuid,
+			)
+			beforeDesiredLRP, _, err = db.fetchDesiredLRP(ctx, logger, row, tx)
+		} else {
+			err = db.lockDesiredLRPByGuidForUpdate(ctx, logger, processGuid, tx)
+		}
 		if err != nil {
 			logger.Error("failed-lock-desired", err)
 			return err
@@ -440,14 +554,28 @@ func (db *SQLDB) RemoveDesiredLRP(ctx context.Context, logger lager.Logger, proc
 			return err
 		}
 
-		return nil
+		_, err = db.delete(ctx, logger, tx, deploymentsTable, "process_guid = ?", processGuid)
+		if err != nil {
+			logger.Error("failed-deleting-deployment", err)
+			return err
+		}
+
+		err = desiredLRPLabels.remove(ctx, logger, db, tx, processGuid)
+		if err != nil {
+			return err
+		}
+
+		if beforeDesiredLRP == nil {
+			return nil
+		}
+		return db.recordAudit(ctx, logger, tx, desiredLRPAuditRecord(models.AuditRecord_Remove, beforeDesiredLRP), beforeDesiredLRP, nil)
 	})
 }
 
 // "rows" needs to have the columns defined in the schedulingInfoColumns constant
 func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scanner helpers.RowScanner, dest ...interface{}) (*models.DesiredLRPSchedulingInfo, error) {
 	schedulingInfo := &models.DesiredLRPSchedulingInfo{}
-	var routeData, volumePlacementData, placementTagData []byte
+	var routeData, volumePlacementData, placementTagData, labelsData, restartPolicyData []byte
 	values := []interface{}{
 		&schedulingInfo.ProcessGuid,
 		&schedulingInfo.Domain,
@@ -463,6 +591,8 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 		&schedulingInfo.ModificationTag.Epoch,
 		&schedulingInfo.ModificationTag.Index,
 		&placementTagData,
+		&labelsData,
+		&restartPolicyData,
 	}
 	values = append(values, dest...)
 
@@ -503,10 +633,56 @@ func (db *SQLDB) fetchDesiredLRPSchedulingInfoAndMore(logger lager.Logger, scann
 			return nil, err
 		}
 	}
+	if labelsData != nil {
+		err = json.Unmarshal(labelsData, &schedulingInfo.Labels)
+		if err != nil {
+			logger.Error("failed-parsing-labels", err)
+			return nil, err
+		}
+	}
+	if restartPolicyData != nil {
+		err = json.Unmarshal(restartPolicyData, &schedulingInfo.RestartPolicy)
+		if err != nil {
+			logger.Error("failed-parsing-restart-policy", err)
+			return nil, err
+		}
+	}
 
 	return schedulingInfo, nil
 }
 
+// fetchRestartPolicy returns the restart policy of the desired LRP, or nil
+// when the LRP has none or is no longer desired, in which case the default
+// restart calculator applies.
+func (db *SQLDB) fetchRestartPolicy(ctx context.Context, logger lager.Logger, q helpers.Queryable, processGuid string) (*models.RestartPolicy, error) {
+	var restartPolicyData []byte
+	row := db.one(ctx, logger, q, desiredLRPsTable,
+		helpers.ColumnList{"restart_policy"}, helpers.NoLockRow,
+		"process_guid = ?", processGuid,
+	)
+	err := row.Scan(&restartPolicyData)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		logger.Error("failed-fetching-restart-policy", err)
+		return nil, err
+	}
+
+	if restartPolicyData == nil {
+		return nil, nil
+	}
+
+	var restartPolicy models.RestartPolicy
+	err = json.Unmarshal(restartPolicyData, &restartPolicy)
+	if err != nil {
+		logger.Error("failed-parsing-restart-policy", err)
+		return nil, err
+	}
+
+	return &restartPolicy, nil
+}
+
 func (db *SQLDB) fetchDesiredLRPRoutingInfo(logger lager.Logger, scanner helpers.RowScanner, dest ...interface{}) (*models.DesiredLRP, error) {
 	routingInfo := &models.DesiredLRP{}
 	var modificationTagEpoch string
@@ -575,7 +751,7 @@ func (db *SQLDB) lockDesiredLRPByGuidForUpdate(ctx context.Context, logger lager
 	return nil
 }
 
-func (db *SQLDB) fetchDesiredLRPs(ctx context.Context, logger lager.Logger, rows *sql.Rows, queryable helpers.Queryable) ([]*models.DesiredLRP, error) {
+func (db *SQLDB) fetchDesiredLRPs(ctx context.Context, logger lager.Logger, rows rowIterator, queryable helpers.Queryable) ([]*models.DesiredLRP, error) {
 	guids := []string{}
 	lrps := []*models.DesiredLRP{}
 	for rows.Next() {
@@ -655,7 +831,7 @@ func (db *SQLDB) deleteInvalidLRPs(ctx context.Context, logger lager.Logger, que
 			return err
 		}
 	}
-	return nil
+	return desiredLRPLabels.remove(ctx, logger, db, queryable, guids...)
 }
 
 func (db *SQLDB) fetchDesiredLRPSchedulingInfo(logger lager.Logger, scanner helpers.RowScanner) (*models.DesiredLRPSchedulingInfo, error) {
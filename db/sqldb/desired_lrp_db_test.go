@@ -28,6 +28,30 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRP).To(Equal(expectedDesiredLRP))
 		})
 
+		Context("when the lrp has a restart policy", func() {
+			BeforeEach(func() {
+				expectedDesiredLRP.RestartPolicy = &models.RestartPolicy{
+					ImmediateRestarts: 1,
+					MinBackoffMs:      1000,
+					MaxBackoffMs:      60000,
+					MaxRestarts:       5,
+				}
+			})
+
+			It("saves the restart policy", func() {
+				Expect(sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)).To(Succeed())
+
+				desiredLRP, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "the-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRP.RestartPolicy).To(Equal(expectedDesiredLRP.RestartPolicy))
+
+				schedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(ctx, logger, models.DesiredLRPFilter{ProcessGuids: []string{"the-guid"}})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(schedulingInfos).To(HaveLen(1))
+				Expect(schedulingInfos[0].RestartPolicy).To(Equal(expectedDesiredLRP.RestartPolicy))
+			})
+		})
+
 		Context("when the process_guid is already taken", func() {
 			BeforeEach(func() {
 				err := sqlDB.DesireLRP(ctx, logger, expectedDesiredLRP)
@@ -169,6 +193,46 @@ var _ = Describe("DesiredLRPDB", func() {
 			Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs))
 		})
 
+		It("can paginate by process guid", func() {
+			desiredLRPs, metadata, err := sqlDB.ListDesiredLRPs(ctx, logger, models.DesiredLRPFilter{PageSize: 2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[:2]))
+			Expect(metadata.NextPageToken).To(Equal(models.NewDesiredLRPPageToken(desiredLRPs[1]).Encode()))
+
+			desiredLRPs, metadata, err = sqlDB.ListDesiredLRPs(ctx, logger, models.DesiredLRPFilter{PageSize: 2, PageToken: metadata.NextPageToken})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(Equal(expectedDesiredLRPs[2:]))
+			Expect(metadata.NextPageToken).To(BeEmpty())
+		})
+
+		It("pages past desired lrps with invalid run infos", func() {
+			Expect(sqlDB.DesireLRP(ctx, logger, model_helpers.NewValidDesiredLRP("invalid"))).To(Succeed())
+			queryStr := `UPDATE desired_lrps SET run_info = 'garbage' WHERE process_guid = 'invalid'`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err := db.ExecContext(ctx, queryStr)
+			Expect(err).NotTo(HaveOccurred())
+
+			pagedLRPs := []*models.DesiredLRP{}
+			filter := models.DesiredLRPFilter{PageSize: 1}
+			for {
+				desiredLRPs, metadata, err := sqlDB.ListDesiredLRPs(ctx, logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				pagedLRPs = append(pagedLRPs, desiredLRPs...)
+				if metadata.NextPageToken == "" {
+					break
+				}
+				filter.PageToken = metadata.NextPageToken
+			}
+			Expect(pagedLRPs).To(ConsistOf(expectedDesiredLRPs))
+		})
+
+		It("returns an error when the page token is invalid", func() {
+			_, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{PageSize: 2, PageToken: "not a token"})
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+		})
+
 		It("prunes all desired lrps with invalid run infos", func() {
 			desiredLRPWithInvalidRunInfo := model_helpers.NewValidDesiredLRP("invalid")
 			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRPWithInvalidRunInfo)).To(Succeed())
@@ -219,6 +283,87 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by label selector", func() {
+			var frontend, backend *models.DesiredLRP
+
+			BeforeEach(func() {
+				frontend = model_helpers.NewValidDesiredLRP("app-4-d-4")
+				frontend.Labels = map[string]string{"team": "a", "tier": "frontend"}
+				Expect(sqlDB.DesireLRP(ctx, logger, frontend)).To(Succeed())
+
+				backend = model_helpers.NewValidDesiredLRP("app-5-d-5")
+				backend.Labels = map[string]string{"team": "b", "tier": "backend"}
+				Expect(sqlDB.DesireLRP(ctx, logger, backend)).To(Succeed())
+			})
+
+			It("returns the desired lrps with an equal label", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(frontend))
+			})
+
+			It("returns the desired lrps with a label in the set", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorIn, Values: []string{"a", "b"}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(frontend, backend))
+			})
+
+			It("includes the desired lrps without the label in negative requirements", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "tier", Operator: models.LabelSelectorOperatorNotIn, Values: []string{"backend"}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(HaveLen(4))
+				Expect(desiredLRPs).NotTo(ContainElement(backend))
+			})
+
+			It("combines requirements", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorExists},
+						{Key: "tier", Operator: models.LabelSelectorOperatorNotEquals, Values: []string{"frontend"}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(backend))
+			})
+
+			It("returns the desired lrps without the label", func() {
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorDoesNotExist},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(expectedDesiredLRPs))
+			})
+
+			It("forgets the labels of removed desired lrps", func() {
+				Expect(sqlDB.RemoveDesiredLRP(ctx, logger, frontend.ProcessGuid)).To(Succeed())
+
+				unlabelled := model_helpers.NewValidDesiredLRP(frontend.ProcessGuid)
+				Expect(sqlDB.DesireLRP(ctx, logger, unlabelled)).To(Succeed())
+
+				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorExists},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPs).To(ConsistOf(backend))
+			})
+		})
+
 		Context("when filtering by app guids", func() {
 			It("returns LRPs that have matching app-guids", func() {
 				desiredLRPs, err := sqlDB.DesiredLRPs(ctx, logger, models.DesiredLRPFilter{AppGuids: []string{"app-1"}})
@@ -367,6 +512,25 @@ var _ = Describe("DesiredLRPDB", func() {
 			})
 		})
 
+		Context("when filtering by label selector", func() {
+			It("returns the matching scheduling infos with their labels", func() {
+				labelled := model_helpers.NewValidDesiredLRP("app-4-d-4")
+				labelled.Labels = map[string]string{"team": "a"}
+				Expect(sqlDB.DesireLRP(ctx, logger, labelled)).To(Succeed())
+
+				filter := models.DesiredLRPFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+					},
+				}
+				desiredLRPSchedulingInfos, err := sqlDB.DesiredLRPSchedulingInfos(ctx, logger, filter)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(desiredLRPSchedulingInfos).To(HaveLen(1))
+				Expect(desiredLRPSchedulingInfos[0].ProcessGuid).To(Equal("app-4-d-4"))
+				Expect(desiredLRPSchedulingInfos[0].Labels).To(Equal(map[string]string{"team": "a"}))
+			})
+		})
+
 		Context("when the routes are invalid", func() {
 			BeforeEach(func() {
 				queryStr := "UPDATE desired_lrps SET routes = ? WHERE process_guid = ?"
@@ -2,10 +2,12 @@ package sqldb
 
 import (
 	"context"
+	"database/sql"
 	"math"
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
 )
 
@@ -84,6 +86,22 @@ func (db *SQLDB) UpsertDomain(ctx context.Context, logger lager.Logger, domain s
 			expireTime = math.MaxInt64
 		}
 
+		var before *domainAuditState
+		if auditing(ctx) {
+			before = &domainAuditState{Domain: domain}
+			row := db.one(ctx, logger, tx, domainsTable,
+				helpers.ColumnList{"expire_time"}, helpers.LockRow,
+				"domain = ?", domain,
+			)
+			err := row.Scan(&before.ExpireTime)
+			if err == sql.ErrNoRows {
+				before = nil
+			} else if err != nil {
+				logger.Error("failed-fetching-domain", err)
+				return err
+			}
+		}
+
 		ok, err := db.upsert(ctx, logger, tx, domainsTable,
 			helpers.SQLAttributes{"domain": domain, "expire_time": expireTime},
 			"domain = ?", domain,
@@ -98,6 +116,17 @@ func (db *SQLDB) UpsertDomain(ctx context.Context, logger lager.Logger, domain s
 			logger.Info("added-domain", lager.Data{"domain": domain})
 		}
 
-		return nil
+		action := models.AuditRecord_Update
+		if before == nil {
+			action = models.AuditRecord_Create
+		}
+		record := &models.AuditRecord{Kind: models.AuditRecord_Domain, Action: action, Domain: domain}
+		return db.recordAudit(ctx, logger, tx, record, before, &domainAuditState{Domain: domain, ExpireTime: expireTime})
 	})
 }
+
+// domainAuditState is the state of a domain recorded in the audit log.
+type domainAuditState struct {
+	Domain     string `json:"domain"`
+	ExpireTime int64  `json:"expire_time"`
+}
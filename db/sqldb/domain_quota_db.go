@@ -0,0 +1,250 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) DomainQuotas(ctx context.Context, logger lager.Logger) ([]*models.DomainQuota, error) {
+	logger = logger.Session("db-domain-quotas")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.all(ctx, logger, db.db, domainQuotasTable,
+		domainQuotaColumns, helpers.NoLockRow,
+		"",
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	quotas := []*models.DomainQuota{}
+	for rows.Next() {
+		quota, err := db.fetchDomainQuota(logger, rows)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		quotas = append(quotas, quota)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return quotas, nil
+}
+
+func (db *SQLDB) DomainQuotaByDomain(ctx context.Context, logger lager.Logger, domain string) (*models.DomainQuota, error) {
+	logger = logger.Session("db-domain-quota-by-domain", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	row := db.one(ctx, logger, db.db, domainQuotasTable,
+		domainQuotaColumns, helpers.NoLockRow,
+		"domain = ?", domain,
+	)
+	quota, err := db.fetchDomainQuota(logger, row)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	return quota, nil
+}
+
+func (db *SQLDB) SetDomainQuota(ctx context.Context, logger lager.Logger, quota *models.DomainQuota) (*models.DomainQuota, error) {
+	logger = logger.Session("db-set-domain-quota", lager.Data{"quota": quota})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.upsert(ctx, logger, tx, domainQuotasTable,
+			helpers.SQLAttributes{
+				"domain":                    quota.Domain,
+				"memory_mb":                 quota.MemoryMb,
+				"disk_mb":                   quota.DiskMb,
+				"instances":                 quota.Instances,
+				"running_tasks":             quota.RunningTasks,
+				"log_rate_bytes_per_second": quota.LogRateBytesPerSecond,
+			},
+			"domain = ?", quota.Domain,
+		)
+		if err != nil {
+			logger.Error("failed-upserting-domain-quota", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return quota, nil
+}
+
+func (db *SQLDB) RemoveDomainQuota(ctx context.Context, logger lager.Logger, domain string) error {
+	logger = logger.Session("db-remove-domain-quota", lager.Data{"domain": domain})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		result, err := db.delete(ctx, logger, tx, domainQuotasTable, "domain = ?", domain)
+		if err != nil {
+			logger.Error("failed-deleting-domain-quota", err)
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-getting-rows-affected", err)
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return models.ErrResourceNotFound
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) DomainUsage(ctx context.Context, logger lager.Logger, domain string) (*models.DomainUsage, error) {
+	logger = logger.Session("db-domain-usage", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	usage, err := db.domainUsage(ctx, logger, db.db, domain)
+	if err != nil {
+		return nil, db.convertSQLError(err)
+	}
+
+	return usage, nil
+}
+
+// domainUsage sums the resources of the desired LRPs and of the active Tasks
+// of the domain from the columns kept next to their encoded definitions,
+// where a negative log rate is unlimited.
+func (db *SQLDB) domainUsage(ctx context.Context, logger lager.Logger, q helpers.Queryable, domain string) (*models.DomainUsage, error) {
+	bigint := "BIGINT"
+	if db.flavor == helpers.MySQL {
+		bigint = "SIGNED"
+	}
+
+	usage := &models.DomainUsage{Domain: domain}
+
+	var lrpMemoryMB, lrpDiskMB, lrpLogRate int64
+	var unlimitedLRPs int
+	row := q.QueryRowContext(ctx, db.helper.Rebind(fmt.Sprintf(`
+		SELECT
+			COALESCE(SUM(instances), 0),
+			COALESCE(SUM(CAST(memory_mb AS %[1]s) * instances), 0),
+			COALESCE(SUM(CAST(disk_mb AS %[1]s) * instances), 0),
+			COALESCE(SUM(CASE WHEN log_rate_bytes_per_second >= 0 THEN log_rate_bytes_per_second * instances ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN log_rate_bytes_per_second < 0 AND instances > 0 THEN 1 ELSE 0 END), 0)
+		FROM desired_lrps
+		WHERE domain = ?
+	`, bigint)), domain)
+	err := row.Scan(&usage.Instances, &lrpMemoryMB, &lrpDiskMB, &lrpLogRate, &unlimitedLRPs)
+	if err != nil {
+		logger.Error("failed-desired-lrp-usage-query", err)
+		return nil, err
+	}
+
+	var taskMemoryMB, taskDiskMB, taskLogRate int64
+	var unlimitedTasks int
+	row = q.QueryRowContext(ctx, db.helper.Rebind(fmt.Sprintf(`
+		SELECT
+			COUNT(*),
+			COALESCE(SUM(CAST(memory_mb AS %[1]s)), 0),
+			COALESCE(SUM(CAST(disk_mb AS %[1]s)), 0),
+			COALESCE(SUM(CASE WHEN log_rate_bytes_per_second >= 0 THEN log_rate_bytes_per_second ELSE 0 END), 0),
+			COALESCE(SUM(CASE WHEN log_rate_bytes_per_second < 0 THEN 1 ELSE 0 END), 0)
+		FROM tasks
+		WHERE domain = ? AND state IN (?, ?, ?)
+	`, bigint)), domain, models.Task_Waiting, models.Task_Pending, models.Task_Running)
+	err = row.Scan(&usage.RunningTasks, &taskMemoryMB, &taskDiskMB, &taskLogRate, &unlimitedTasks)
+	if err != nil {
+		logger.Error("failed-task-usage-query", err)
+		return nil, err
+	}
+
+	usage.MemoryMb = lrpMemoryMB + taskMemoryMB
+	usage.DiskMb = lrpDiskMB + taskDiskMB
+	usage.LogRateBytesPerSecond = lrpLogRate + taskLogRate
+	usage.UnlimitedLogRate = unlimitedLRPs+unlimitedTasks > 0
+
+	return usage, nil
+}
+
+// admitToDomainQuota returns a QuotaExceeded error if the requested
+// resources do not fit in the quota of the domain. The quota row stays locked
+// until the transaction ends, so that concurrent requests desiring resources
+// in the domain are checked one after another against the usage they leave.
+// Domains without a quota admit everything.
+func (db *SQLDB) admitToDomainQuota(ctx context.Context, logger lager.Logger, tx helpers.Tx, domain string, requested *models.DomainUsage) error {
+	logger = logger.Session("admit-to-domain-quota", lager.Data{"domain": domain})
+
+	row := db.one(ctx, logger, tx, domainQuotasTable,
+		domainQuotaColumns, helpers.LockRow,
+		"domain = ?", domain,
+	)
+	quota, err := db.fetchDomainQuota(logger, row)
+	if err == sql.ErrNoRows {
+		return nil
+	}
+	if err != nil {
+		logger.Error("failed-locking-domain-quota", err)
+		return err
+	}
+
+	usage, err := db.domainUsage(ctx, logger, tx, domain)
+	if err != nil {
+		return err
+	}
+
+	err = quota.Admit(usage, requested)
+	if err != nil {
+		logger.Info("quota-exceeded", lager.Data{"error": err.Error()})
+		return err
+	}
+
+	return nil
+}
+
+// logRateBytesPerSecond is the log rate stored for a workload with the given
+// limit, -1 when it is unlimited.
+func logRateBytesPerSecond(limit *models.LogRateLimit) int64 {
+	if limit == nil || limit.BytesPerSecond < 0 {
+		return -1
+	}
+	return limit.BytesPerSecond
+}
+
+func (db *SQLDB) fetchDomainQuota(logger lager.Logger, scanner helpers.RowScanner) (*models.DomainQuota, error) {
+	quota := &models.DomainQuota{}
+	err := scanner.Scan(
+		&quota.Domain,
+		&quota.MemoryMb,
+		&quota.DiskMb,
+		&quota.Instances,
+		&quota.RunningTasks,
+		&quota.LogRateBytesPerSecond,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	return quota, nil
+}
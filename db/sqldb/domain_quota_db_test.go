@@ -0,0 +1,273 @@
+package sqldb_test
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainQuotaDB", func() {
+	var quota *models.DomainQuota
+
+	BeforeEach(func() {
+		quota = &models.DomainQuota{
+			Domain:                "some-domain",
+			MemoryMb:              1024,
+			DiskMb:                4096,
+			Instances:             4,
+			RunningTasks:          2,
+			LogRateBytesPerSecond: 1000,
+		}
+	})
+
+	Describe("SetDomainQuota", func() {
+		It("stores the quota", func() {
+			setQuota, err := sqlDB.SetDomainQuota(ctx, logger, quota)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setQuota).To(Equal(quota))
+
+			fetchedQuota, err := sqlDB.DomainQuotaByDomain(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetchedQuota).To(Equal(quota))
+		})
+
+		It("replaces an existing quota", func() {
+			_, err := sqlDB.SetDomainQuota(ctx, logger, quota)
+			Expect(err).NotTo(HaveOccurred())
+
+			quota.Instances = 10
+			_, err = sqlDB.SetDomainQuota(ctx, logger, quota)
+			Expect(err).NotTo(HaveOccurred())
+
+			quotas, err := sqlDB.DomainQuotas(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(quotas).To(Equal([]*models.DomainQuota{quota}))
+		})
+	})
+
+	Describe("DomainQuotaByDomain", func() {
+		It("returns not found for domains without a quota", func() {
+			_, err := sqlDB.DomainQuotaByDomain(ctx, logger, "other-domain")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("RemoveDomainQuota", func() {
+		It("removes the quota", func() {
+			_, err := sqlDB.SetDomainQuota(ctx, logger, quota)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.RemoveDomainQuota(ctx, logger, "some-domain")).To(Succeed())
+
+			_, err = sqlDB.DomainQuotaByDomain(ctx, logger, "some-domain")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+
+		It("returns not found for domains without a quota", func() {
+			Expect(sqlDB.RemoveDomainQuota(ctx, logger, "other-domain")).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("DomainUsage", func() {
+		BeforeEach(func() {
+			lrp := model_helpers.NewValidDesiredLRP("some-guid")
+			lrp.Domain = "some-domain"
+			lrp.Instances = 2
+			Expect(sqlDB.DesireLRP(ctx, logger, lrp)).To(Succeed())
+
+			otherLRP := model_helpers.NewValidDesiredLRP("other-guid")
+			otherLRP.Domain = "other-domain"
+			Expect(sqlDB.DesireLRP(ctx, logger, otherLRP)).To(Succeed())
+
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "pending-task", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "completed-task", "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, _, err = sqlDB.StartTask(ctx, logger, "completed-task", "some-cell")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = sqlDB.CompleteTask(ctx, logger, "completed-task", "some-cell", false, "", "")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("sums the desired instances and active tasks of the domain", func() {
+			lrp := model_helpers.NewValidDesiredLRP("some-guid")
+			task := model_helpers.NewValidTaskDefinition()
+
+			expected := &models.DomainUsage{Domain: "some-domain"}
+			expected.AddDesiredLRP(lrp, 2)
+			expected.AddTask(task)
+
+			usage, err := sqlDB.DomainUsage(ctx, logger, "some-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(expected))
+		})
+
+		Context("when the workloads of the domain have log rate limits", func() {
+			BeforeEach(func() {
+				lrp := model_helpers.NewValidDesiredLRP("limited-guid")
+				lrp.Domain = "limited-domain"
+				lrp.Instances = 3
+				lrp.LogRateLimit = &models.LogRateLimit{BytesPerSecond: 100}
+				Expect(sqlDB.DesireLRP(ctx, logger, lrp)).To(Succeed())
+
+				stoppedLRP := model_helpers.NewValidDesiredLRP("stopped-guid")
+				stoppedLRP.Domain = "limited-domain"
+				stoppedLRP.Instances = 0
+				stoppedLRP.LogRateLimit = nil
+				Expect(sqlDB.DesireLRP(ctx, logger, stoppedLRP)).To(Succeed())
+
+				taskDef := model_helpers.NewValidTaskDefinition()
+				taskDef.LogRateLimit = &models.LogRateLimit{BytesPerSecond: 50}
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, "limited-task", "limited-domain")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("sums the log rates, ignoring desired LRPs without instances", func() {
+				usage, err := sqlDB.DomainUsage(ctx, logger, "limited-domain")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage.LogRateBytesPerSecond).To(BeEquivalentTo(350))
+				Expect(usage.UnlimitedLogRate).To(BeFalse())
+			})
+
+			Context("when a task of the domain has no log rate limit", func() {
+				BeforeEach(func() {
+					taskDef := model_helpers.NewValidTaskDefinition()
+					taskDef.LogRateLimit = nil
+					_, err := sqlDB.DesireTask(ctx, logger, taskDef, "unlimited-task", "limited-domain")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("marks the usage as unlimited", func() {
+					usage, err := sqlDB.DomainUsage(ctx, logger, "limited-domain")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(usage.LogRateBytesPerSecond).To(BeEquivalentTo(350))
+					Expect(usage.UnlimitedLogRate).To(BeTrue())
+				})
+			})
+		})
+	})
+
+	Describe("admitting workloads to the quota of their domain", func() {
+		BeforeEach(func() {
+			quota.MemoryMb = 0
+			quota.DiskMb = 0
+			quota.Instances = 4
+			quota.RunningTasks = 2
+			quota.LogRateBytesPerSecond = 0
+			_, err := sqlDB.SetDomainQuota(ctx, logger, quota)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Describe("DesireLRP", func() {
+			It("rejects desired LRPs over the quota without storing them", func() {
+				lrp := model_helpers.NewValidDesiredLRP("some-guid")
+				lrp.Domain = "some-domain"
+				lrp.Instances = 5
+				err := sqlDB.DesireLRP(ctx, logger, lrp)
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_QuotaExceeded))
+
+				_, err = sqlDB.DesiredLRPByProcessGuid(ctx, logger, "some-guid")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("desires LRPs within the quota", func() {
+				lrp := model_helpers.NewValidDesiredLRP("some-guid")
+				lrp.Domain = "some-domain"
+				lrp.Instances = 4
+				Expect(sqlDB.DesireLRP(ctx, logger, lrp)).To(Succeed())
+			})
+		})
+
+		Describe("UpdateDesiredLRP", func() {
+			BeforeEach(func() {
+				lrp := model_helpers.NewValidDesiredLRP("some-guid")
+				lrp.Domain = "some-domain"
+				lrp.Instances = 3
+				Expect(sqlDB.DesireLRP(ctx, logger, lrp)).To(Succeed())
+			})
+
+			It("only admits the added instances", func() {
+				update := &models.DesiredLRPUpdate{}
+				update.SetInstances(5)
+				_, err := sqlDB.UpdateDesiredLRP(ctx, logger, "some-guid", update)
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_QuotaExceeded))
+				Expect(err.Error()).To(ContainSubstring("2 requested, 3 of 4 in use"))
+
+				lrp, err := sqlDB.DesiredLRPByProcessGuid(ctx, logger, "some-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(lrp.Instances).To(BeEquivalentTo(3))
+			})
+
+			It("scales down a domain over its quota", func() {
+				quota.Instances = 1
+				_, err := sqlDB.SetDomainQuota(ctx, logger, quota)
+				Expect(err).NotTo(HaveOccurred())
+
+				update := &models.DesiredLRPUpdate{}
+				update.SetInstances(2)
+				_, err = sqlDB.UpdateDesiredLRP(ctx, logger, "some-guid", update)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Describe("DesireTask", func() {
+			It("rejects tasks over the quota without storing them", func() {
+				_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-1", "some-domain")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-2", "some-domain")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-3", "some-domain")
+				Expect(models.ConvertError(err).Type).To(Equal(models.Error_QuotaExceeded))
+
+				_, err = sqlDB.TaskByGuid(ctx, logger, "task-3")
+				Expect(err).To(Equal(models.ErrResourceNotFound))
+			})
+
+			It("does not limit domains without a quota", func() {
+				for i := 0; i < 3; i++ {
+					_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), fmt.Sprintf("task-%d", i), "other-domain")
+					Expect(err).NotTo(HaveOccurred())
+				}
+			})
+		})
+
+		Context("when tasks are desired concurrently", func() {
+			BeforeEach(func() {
+				// every transaction needs its own connection to wait on the
+				// lock of the quota
+				rawDB.SetMaxOpenConns(10)
+			})
+
+			It("admits no more than the quota", func() {
+				errs := make(chan error, 10)
+				for i := 0; i < 10; i++ {
+					go func(i int) {
+						defer GinkgoRecover()
+						_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), fmt.Sprintf("task-%d", i), "some-domain")
+						errs <- err
+					}(i)
+				}
+
+				desired := 0
+				for i := 0; i < 10; i++ {
+					err := <-errs
+					if err == nil {
+						desired++
+						continue
+					}
+					Expect(models.ConvertError(err).Type).To(Equal(models.Error_QuotaExceeded))
+				}
+				Expect(desired).To(Equal(2))
+
+				usage, err := sqlDB.DomainUsage(ctx, logger, "some-domain")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(usage.RunningTasks).To(BeEquivalentTo(2))
+			})
+		})
+	})
+})
@@ -61,6 +61,24 @@ func (db *SQLDB) PerformEncryption(ctx context.Context, logger lager.Logger) err
 				PrimaryKeyFunc:  func() primaryKey { return &desiredLRPPrimaryKey{} },
 			})
 		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       deploymentsTable,
+				PrimaryKeyNames: []string{"process_guid"},
+				Columns:         []string{"previous_run_info"},
+				EncryptIfEmpty:  false,
+				PrimaryKeyFunc:  func() primaryKey { return &desiredLRPPrimaryKey{} },
+			})
+		},
+		func() {
+			errCh <- db.reEncrypt(ctx, logger, encryptable{
+				TableName:       scheduledTasksTable,
+				PrimaryKeyNames: []string{"guid"},
+				Columns:         []string{"task_definition"},
+				EncryptIfEmpty:  true,
+				PrimaryKeyFunc:  func() primaryKey { return &taskPrimaryKey{} },
+			})
+		},
 		func() {
 			errCh <- db.reEncrypt(ctx, logger, encryptable{
 				TableName:       actualLRPsTable,
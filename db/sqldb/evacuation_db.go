@@ -35,7 +35,10 @@ func (db *SQLDB) EvacuateActualLRP(
 		if err == models.ErrResourceNotFound {
 			logger.Debug("creating-evacuating-lrp")
 			actualLRP, err = db.createEvacuatingActualLRP(ctx, logger, lrpKey, instanceKey, netInfo, internalRoutes, metricTags, routable, availabilityZone, tx)
-			return err
+			if err != nil {
+				return err
+			}
+			return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Create, nil, actualLRP), nil, actualLRP)
 		}
 
 		if err != nil {
@@ -50,6 +53,7 @@ func (db *SQLDB) EvacuateActualLRP(
 			return models.ErrResourceExists
 		}
 
+		beforeActualLRP := *actualLRP
 		now := db.clock.Now().UnixNano()
 		actualLRP.ModificationTag.Increment()
 		actualLRP.ActualLRPKey = *lrpKey
@@ -99,7 +103,7 @@ func (db *SQLDB) EvacuateActualLRP(
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Update, &beforeActualLRP, actualLRP), &beforeActualLRP, actualLRP)
 	})
 
 	return actualLRP, err
@@ -139,7 +143,7 @@ func (db *SQLDB) RemoveEvacuatingActualLRP(ctx context.Context, logger lager.Log
 			return models.ErrActualLRPCannotBeRemoved
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, actualLRPAuditRecord(models.AuditRecord_Remove, lrp, nil), lrp, nil)
 	})
 }
 
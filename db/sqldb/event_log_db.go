@@ -0,0 +1,135 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) InsertEvent(ctx context.Context, logger lager.Logger, stream string, event bbsdb.StoredEvent) error {
+	logger = logger.Session("db-insert-event", lager.Data{"stream": stream, "id": event.ID})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	payload, err := db.encoder.Encode(event.Payload)
+	if err != nil {
+		logger.Error("failed-encoding-payload", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	_, err = db.insert(ctx, logger, db.db, eventLogTable, helpers.SQLAttributes{
+		"stream":           stream,
+		"id":               int64(event.ID),
+		"event_type":       event.EventType,
+		"payload":          payload,
+		"resource_version": int64(event.ResourceVersion),
+	})
+	if err != nil {
+		logger.Error("failed-inserting-event", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
+
+func (db *SQLDB) EventsSince(ctx context.Context, logger lager.Logger, stream string, id uint64) ([]bbsdb.StoredEvent, error) {
+	logger = logger.Session("db-events-since", lager.Data{"stream": stream, "id": id})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	rows, err := db.helper.AllPaginated(ctx, logger, db.db, eventLogTable,
+		eventLogColumns, eventLogOrderColumns, nil, 0,
+		"stream = ? AND id >= ?", stream, int64(id),
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	var events []bbsdb.StoredEvent
+	for rows.Next() {
+		var eventID int64
+		var eventType string
+		var payload []byte
+		var resourceVersion int64
+		err := rows.Scan(&eventID, &eventType, &payload, &resourceVersion)
+		if err != nil {
+			logger.Error("failed-scanning-row", err)
+			return nil, db.convertSQLError(err)
+		}
+
+		decoded, err := db.encoder.Decode(payload)
+		if err != nil {
+			logger.Error("failed-decoding-payload", err)
+			return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+		}
+
+		events = append(events, bbsdb.StoredEvent{
+			ID:              uint64(eventID),
+			EventType:       eventType,
+			Payload:         decoded,
+			ResourceVersion: uint64(resourceVersion),
+		})
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return events, nil
+}
+
+func (db *SQLDB) LastEventID(ctx context.Context, logger lager.Logger, stream string) (uint64, error) {
+	logger = logger.Session("db-last-event-id", lager.Data{"stream": stream})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var lastID sql.NullInt64
+	row := db.db.QueryRowContext(ctx, helpers.RebindForFlavor("SELECT MAX(id) FROM "+eventLogTable+" WHERE stream = ?", db.flavor), stream)
+	err := row.Scan(&lastID)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return uint64(lastID.Int64), nil
+}
+
+func (db *SQLDB) EventIDAtResourceVersion(ctx context.Context, logger lager.Logger, stream string, resourceVersion uint64) (uint64, error) {
+	logger = logger.Session("db-event-id-at-resource-version", lager.Data{"stream": stream, "resource_version": resourceVersion})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var id sql.NullInt64
+	row := db.db.QueryRowContext(ctx,
+		helpers.RebindForFlavor("SELECT MAX(id) FROM "+eventLogTable+" WHERE stream = ? AND resource_version <= ?", db.flavor),
+		stream, int64(resourceVersion),
+	)
+	err := row.Scan(&id)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return uint64(id.Int64), nil
+}
+
+func (db *SQLDB) DeleteEventsBefore(ctx context.Context, logger lager.Logger, stream string, id uint64) error {
+	logger = logger.Session("db-delete-events-before", lager.Data{"stream": stream, "id": id})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	_, err := db.delete(ctx, logger, db.db, eventLogTable, "stream = ? AND id < ?", stream, int64(id))
+	if err != nil {
+		logger.Error("failed-deleting-events", err)
+		return db.convertSQLError(err)
+	}
+
+	return nil
+}
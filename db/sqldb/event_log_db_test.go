@@ -0,0 +1,101 @@
+package sqldb_test
+
+import (
+	thepackagedb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/test_helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventLogDB", func() {
+	BeforeEach(func() {
+		for id := uint64(1); id <= 3; id++ {
+			err := sqlDB.InsertEvent(ctx, logger, "tasks", thepackagedb.StoredEvent{
+				ID:              id,
+				EventType:       "task_created",
+				Payload:         []byte{byte(id)},
+				ResourceVersion: id * 10,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		err := sqlDB.InsertEvent(ctx, logger, "desired_lrps", thepackagedb.StoredEvent{
+			ID:        10,
+			EventType: "desired_lrp_created",
+			Payload:   []byte("other-stream"),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("InsertEvent", func() {
+		It("encrypts the payload", func() {
+			queryStr := "SELECT payload FROM event_log WHERE stream = ? AND id = ?"
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			var payload []byte
+			Expect(db.QueryRowContext(ctx, queryStr, "desired_lrps", 10).Scan(&payload)).To(Succeed())
+			Expect(string(payload)).NotTo(ContainSubstring("other-stream"))
+		})
+
+		It("rejects a duplicate ID", func() {
+			err := sqlDB.InsertEvent(ctx, logger, "tasks", thepackagedb.StoredEvent{ID: 3, EventType: "task_created", Payload: []byte("x")})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("EventsSince", func() {
+		It("returns the stream's events from the given ID onwards, oldest first", func() {
+			events, err := sqlDB.EventsSince(ctx, logger, "tasks", 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal([]thepackagedb.StoredEvent{
+				{ID: 2, EventType: "task_created", Payload: []byte{2}, ResourceVersion: 20},
+				{ID: 3, EventType: "task_created", Payload: []byte{3}, ResourceVersion: 30},
+			}))
+		})
+
+		It("returns nothing for an unknown stream", func() {
+			events, err := sqlDB.EventsSince(ctx, logger, "unknown", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+	})
+
+	Describe("LastEventID", func() {
+		It("returns the highest ID of the stream", func() {
+			Expect(sqlDB.LastEventID(ctx, logger, "tasks")).To(BeEquivalentTo(3))
+			Expect(sqlDB.LastEventID(ctx, logger, "desired_lrps")).To(BeEquivalentTo(10))
+		})
+
+		It("returns zero for an empty stream", func() {
+			Expect(sqlDB.LastEventID(ctx, logger, "unknown")).To(BeEquivalentTo(0))
+		})
+	})
+
+	Describe("EventIDAtResourceVersion", func() {
+		It("returns the ID of the stream's most recent event at or before the version", func() {
+			Expect(sqlDB.EventIDAtResourceVersion(ctx, logger, "tasks", 20)).To(BeEquivalentTo(2))
+			Expect(sqlDB.EventIDAtResourceVersion(ctx, logger, "tasks", 25)).To(BeEquivalentTo(2))
+			Expect(sqlDB.EventIDAtResourceVersion(ctx, logger, "tasks", 100)).To(BeEquivalentTo(3))
+		})
+
+		It("returns zero when every event of the stream is more recent", func() {
+			Expect(sqlDB.EventIDAtResourceVersion(ctx, logger, "tasks", 5)).To(BeEquivalentTo(0))
+			Expect(sqlDB.EventIDAtResourceVersion(ctx, logger, "unknown", 100)).To(BeEquivalentTo(0))
+		})
+	})
+
+	Describe("DeleteEventsBefore", func() {
+		It("removes only the stream's older events", func() {
+			Expect(sqlDB.DeleteEventsBefore(ctx, logger, "tasks", 3)).To(Succeed())
+
+			events, err := sqlDB.EventsSince(ctx, logger, "tasks", 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].ID).To(BeEquivalentTo(3))
+
+			Expect(sqlDB.LastEventID(ctx, logger, "desired_lrps")).To(BeEquivalentTo(10))
+		})
+	})
+})
@@ -32,3 +32,51 @@ func (h *sqlHelper) All(
 
 	return q.QueryContext(ctx, h.Rebind(query), whereBindings...)
 }
+
+// SELECT <columns> FROM <table> WHERE ... AND (<orderBy>) > (<after>) ORDER BY <orderBy> LIMIT <limit>
+//
+// Keyset pagination: rows are returned in orderBy order, starting strictly
+// after the row identified by the after values. orderBy must be a unique key
+// of the table so that pages remain stable while rows are inserted or deleted.
+// An empty after starts from the first row and a limit of 0 returns all rows.
+func (h *sqlHelper) AllPaginated(
+	ctx context.Context,
+	logger lager.Logger,
+	q Queryable,
+	table string,
+	columns ColumnList,
+	orderBy ColumnList,
+	after []interface{},
+	limit int,
+	wheres string,
+	whereBindings ...interface{},
+) (*sql.Rows, error) {
+	if len(after) > 0 && len(after) != len(orderBy) {
+		return nil, fmt.Errorf("expected %d keyset values, got %d", len(orderBy), len(after))
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s\n", strings.Join(columns, ", "), table)
+
+	conditions := []string{}
+	if len(wheres) > 0 {
+		conditions = append(conditions, "("+wheres+")")
+	}
+
+	bindings := append([]interface{}{}, whereBindings...)
+	if len(after) > 0 {
+		conditions = append(conditions, fmt.Sprintf("(%s) > (%s)", strings.Join(orderBy, ", "), QuestionMarks(len(after))))
+		bindings = append(bindings, after...)
+	}
+
+	if len(conditions) > 0 {
+		query += "WHERE " + strings.Join(conditions, " AND ") + "\n"
+	}
+
+	query += "ORDER BY " + strings.Join(orderBy, ", ")
+
+	if limit > 0 {
+		query += fmt.Sprintf("\nLIMIT %d", limit)
+	}
+
+	return q.QueryContext(ctx, h.Rebind(query), bindings...)
+}
@@ -16,6 +16,8 @@ type Monitor interface {
 	Total() int64
 	Succeeded() int64
 	Failed() int64
+	// TotalDuration is the time spent running the queries counted by Total.
+	TotalDuration() time.Duration
 
 	ReadAndResetDurationMax() time.Duration
 	ReadAndResetInFlightMax() int64
@@ -25,6 +27,7 @@ type monitor struct {
 	total     int64
 	succeeded int64
 	failed    int64
+	duration  int64
 
 	durationLock *sync.RWMutex
 	durationMax  time.Duration
@@ -47,16 +50,19 @@ func (m *monitor) Monitor(f func() error) error {
 
 	start := time.Now()
 	err := f()
-	m.setDurationMax(time.Since(start))
+	duration := time.Since(start)
+	m.setDurationMax(duration)
 
 	if err != nil && err != sql.ErrNoRows {
 		if err != sql.ErrTxDone {
 			atomic.AddInt64(&m.total, 1)
 			atomic.AddInt64(&m.failed, 1)
+			atomic.AddInt64(&m.duration, int64(duration))
 		}
 	} else {
 		atomic.AddInt64(&m.total, 1)
 		atomic.AddInt64(&m.succeeded, 1)
+		atomic.AddInt64(&m.duration, int64(duration))
 	}
 
 	return err
@@ -74,6 +80,10 @@ func (m *monitor) Failed() int64 {
 	return atomic.LoadInt64(&m.failed)
 }
 
+func (m *monitor) TotalDuration() time.Duration {
+	return time.Duration(atomic.LoadInt64(&m.duration))
+}
+
 func (m *monitor) ReadAndResetInFlightMax() int64 {
 	var oldMax int64
 	m.inFlightLock.Lock()
@@ -156,6 +156,25 @@ var _ = Describe("Monitor", func() {
 		})
 	})
 
+	Describe("#TotalDuration", func() {
+		It("returns the time spent running the queries counted in the total", func() {
+			mon.Monitor(func() error {
+				time.Sleep(10 * time.Millisecond)
+				return nil
+			})
+			mon.Monitor(func() error {
+				time.Sleep(10 * time.Millisecond)
+				return errors.New("foo")
+			})
+			mon.Monitor(func() error {
+				time.Sleep(100 * time.Millisecond)
+				return sql.ErrTxDone
+			})
+			Expect(mon.TotalDuration()).To(BeNumerically(">=", 20*time.Millisecond))
+			Expect(mon.TotalDuration()).To(BeNumerically("<", 100*time.Millisecond))
+		})
+	})
+
 	Describe("#Succeeded", func() {
 		It("returns the number of queries succeeded", func() {
 			mon.Monitor(func() error {
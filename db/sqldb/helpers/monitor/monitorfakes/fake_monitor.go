@@ -70,6 +70,16 @@ type FakeMonitor struct {
 	totalReturnsOnCall map[int]struct {
 		result1 int64
 	}
+	TotalDurationStub        func() time.Duration
+	totalDurationMutex       sync.RWMutex
+	totalDurationArgsForCall []struct {
+	}
+	totalDurationReturns struct {
+		result1 time.Duration
+	}
+	totalDurationReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -400,6 +410,59 @@ func (fake *FakeMonitor) TotalReturnsOnCall(i int, result1 int64) {
 	}{result1}
 }
 
+func (fake *FakeMonitor) TotalDuration() time.Duration {
+	fake.totalDurationMutex.Lock()
+	ret, specificReturn := fake.totalDurationReturnsOnCall[len(fake.totalDurationArgsForCall)]
+	fake.totalDurationArgsForCall = append(fake.totalDurationArgsForCall, struct {
+	}{})
+	stub := fake.TotalDurationStub
+	fakeReturns := fake.totalDurationReturns
+	fake.recordInvocation("TotalDuration", []interface{}{})
+	fake.totalDurationMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeMonitor) TotalDurationCallCount() int {
+	fake.totalDurationMutex.RLock()
+	defer fake.totalDurationMutex.RUnlock()
+	return len(fake.totalDurationArgsForCall)
+}
+
+func (fake *FakeMonitor) TotalDurationCalls(stub func() time.Duration) {
+	fake.totalDurationMutex.Lock()
+	defer fake.totalDurationMutex.Unlock()
+	fake.TotalDurationStub = stub
+}
+
+func (fake *FakeMonitor) TotalDurationReturns(result1 time.Duration) {
+	fake.totalDurationMutex.Lock()
+	defer fake.totalDurationMutex.Unlock()
+	fake.TotalDurationStub = nil
+	fake.totalDurationReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeMonitor) TotalDurationReturnsOnCall(i int, result1 time.Duration) {
+	fake.totalDurationMutex.Lock()
+	defer fake.totalDurationMutex.Unlock()
+	fake.TotalDurationStub = nil
+	if fake.totalDurationReturnsOnCall == nil {
+		fake.totalDurationReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.totalDurationReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeMonitor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -415,6 +478,8 @@ func (fake *FakeMonitor) Invocations() map[string][][]interface{} {
 	defer fake.succeededMutex.RUnlock()
 	fake.totalMutex.RLock()
 	defer fake.totalMutex.RUnlock()
+	fake.totalDurationMutex.RLock()
+	defer fake.totalDurationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
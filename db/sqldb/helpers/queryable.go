@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
+	"code.cloudfoundry.org/bbs/trace"
+	"go.opentelemetry.io/otel/attribute"
+	oteltrace "go.opentelemetry.io/otel/trace"
 )
 
 //counterfeiter:generate . RowScanner
@@ -84,12 +87,14 @@ func (q *monitoredDB) BeginTx(ctx context.Context, opts *sql.TxOptions) (Tx, err
 }
 
 func (q *monitoredDB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startStatementSpan(ctx, "sql.exec", query)
 	var result sql.Result
 	err := q.monitor.Monitor(func() error {
 		var err error
 		result, err = q.db.ExecContext(ctx, query, args...)
 		return err
 	})
+	trace.EndSpan(span, err)
 	return result, err
 }
 
@@ -98,26 +103,31 @@ func (q *monitoredDB) PrepareContext(ctx context.Context, query string) (*sql.St
 }
 
 func (q *monitoredDB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startStatementSpan(ctx, "sql.query", query)
 	var result *sql.Rows
 	err := q.monitor.Monitor(func() error {
 		var err error
 		result, err = q.db.QueryContext(ctx, query, args...)
 		return err
 	})
+	trace.EndSpan(span, err)
 	return result, err
 }
 
 func (q *monitoredDB) QueryRowContext(ctx context.Context, query string, args ...interface{}) RowScanner {
-	return NewRowScanner(q.monitor, q.db.QueryRowContext(ctx, query, args...))
+	ctx, span := startStatementSpan(ctx, "sql.query-row", query)
+	return &scannableRow{monitor: q.monitor, scanner: q.db.QueryRowContext(ctx, query, args...), span: span}
 }
 
 func (tx *monitoredTx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	ctx, span := startStatementSpan(ctx, "sql.exec", query)
 	var result sql.Result
 	err := tx.monitor.Monitor(func() error {
 		var err error
 		result, err = tx.tx.ExecContext(ctx, query, args...)
 		return err
 	})
+	trace.EndSpan(span, err)
 	return result, err
 }
 
@@ -126,17 +136,20 @@ func (tx *monitoredTx) PrepareContext(ctx context.Context, query string) (*sql.S
 }
 
 func (tx *monitoredTx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	ctx, span := startStatementSpan(ctx, "sql.query", query)
 	var result *sql.Rows
 	err := tx.monitor.Monitor(func() error {
 		var err error
 		result, err = tx.tx.QueryContext(ctx, query, args...)
 		return err
 	})
+	trace.EndSpan(span, err)
 	return result, err
 }
 
 func (tx *monitoredTx) QueryRowContext(ctx context.Context, query string, args ...interface{}) RowScanner {
-	return NewRowScanner(tx.monitor, tx.tx.QueryRowContext(ctx, query, args...))
+	ctx, span := startStatementSpan(ctx, "sql.query-row", query)
+	return &scannableRow{monitor: tx.monitor, scanner: tx.tx.QueryRowContext(ctx, query, args...), span: span}
 }
 
 func (tx *monitoredTx) Commit() error {
@@ -150,6 +163,7 @@ func (tx *monitoredTx) Rollback() error {
 type scannableRow struct {
 	monitor monitor.Monitor
 	scanner RowScanner
+	span    oteltrace.Span
 }
 
 func NewRowScanner(monitor monitor.Monitor, scanner RowScanner) RowScanner {
@@ -157,7 +171,22 @@ func NewRowScanner(monitor monitor.Monitor, scanner RowScanner) RowScanner {
 }
 
 func (r *scannableRow) Scan(dest ...interface{}) error {
-	return r.monitor.Monitor(func() error {
+	err := r.monitor.Monitor(func() error {
 		return r.scanner.Scan(dest...)
 	})
+	if r.span != nil {
+		// the span of a row query ends once the row is read
+		spanErr := err
+		if err == sql.ErrNoRows {
+			spanErr = nil
+		}
+		trace.EndSpan(r.span, spanErr)
+	}
+	return err
+}
+
+// startStatementSpan starts the span of a SQL statement, which is recorded
+// without its arguments.
+func startStatementSpan(ctx context.Context, name, query string) (context.Context, oteltrace.Span) {
+	return trace.StartClientSpan(ctx, name, attribute.String("db.query.text", query))
 }
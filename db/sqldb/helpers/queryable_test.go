@@ -11,6 +11,12 @@ import (
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
 )
 
 var _ = Describe("SQL Helpers", func() {
@@ -73,6 +79,50 @@ var _ = Describe("SQL Helpers", func() {
 		})
 	})
 
+	Describe("Tracing", func() {
+		var recorder *tracetest.SpanRecorder
+
+		BeforeEach(func() {
+			recorder = tracetest.NewSpanRecorder()
+			otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		})
+
+		AfterEach(func() {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		})
+
+		It("records a span for each statement", func() {
+			q := helpers.NewMonitoredDB(db, mon)
+
+			_, err := helper.Insert(ctx, logger, q, tableName, helpers.SQLAttributes{"existingcol": 3})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(recorder.Ended()).To(HaveLen(1))
+			span := recorder.Ended()[0]
+			Expect(span.Name()).To(Equal("sql.exec"))
+			Expect(span.Attributes()).To(ContainElement(HaveField("Key", attribute.Key("db.query.text"))))
+		})
+
+		It("ends the span of a row query once the row is read", func() {
+			q := helpers.NewMonitoredDB(db, mon)
+			row := helper.One(ctx, logger, q, tableName, []string{"existingcol"}, false, "existingcol = ?", 12345)
+			Expect(recorder.Ended()).To(BeEmpty())
+
+			var value int
+			Expect(row.Scan(&value)).To(MatchError(sql.ErrNoRows))
+			Expect(recorder.Ended()).To(HaveLen(1))
+			Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Unset))
+		})
+
+		It("marks the span of a failed statement as failed", func() {
+			q := helpers.NewMonitoredDB(db, mon)
+
+			_, err := helper.Insert(ctx, logger, q, tableName, helpers.SQLAttributes{"wrongcolumn": 3})
+			Expect(err).To(HaveOccurred())
+			Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Error))
+		})
+	})
+
 	Describe("Insert", func() {
 		It("executes queries", func() {
 			q := helpers.NewMonitoredDB(db, mon)
@@ -176,6 +226,56 @@ var _ = Describe("SQL Helpers", func() {
 		})
 	})
 
+	Describe("AllPaginated", func() {
+		BeforeEach(func() {
+			m := monitor.New()
+			q := helpers.NewMonitoredDB(db, m)
+			for _, v := range []int{4, 1, 3, 5, 2} {
+				_, err := helper.Insert(ctx, logger, q, tableName, helpers.SQLAttributes{"existingcol": v})
+				Expect(err).NotTo(HaveOccurred())
+			}
+		})
+
+		fetch := func(after []interface{}, limit int, wheres string, bindings ...interface{}) []int {
+			q := helpers.NewMonitoredDB(db, mon)
+			rows, err := helper.AllPaginated(ctx, logger, q, tableName, []string{"existingcol"}, []string{"existingcol"}, after, limit, wheres, bindings...)
+			Expect(err).NotTo(HaveOccurred())
+			defer rows.Close()
+
+			values := []int{}
+			for rows.Next() {
+				var value int
+				Expect(rows.Scan(&value)).To(Succeed())
+				values = append(values, value)
+			}
+			Expect(rows.Err()).NotTo(HaveOccurred())
+			return values
+		}
+
+		It("returns the first page in key order", func() {
+			Expect(fetch(nil, 2, "")).To(Equal([]int{1, 2}))
+		})
+
+		It("returns the rows after the given key", func() {
+			Expect(fetch([]interface{}{2}, 2, "")).To(Equal([]int{3, 4}))
+			Expect(fetch([]interface{}{4}, 2, "")).To(Equal([]int{5}))
+		})
+
+		It("returns all remaining rows when the limit is zero", func() {
+			Expect(fetch([]interface{}{1}, 0, "")).To(Equal([]int{2, 3, 4, 5}))
+		})
+
+		It("combines the key with the where clause", func() {
+			Expect(fetch([]interface{}{1}, 2, "existingcol <> ?", 3)).To(Equal([]int{2, 4}))
+		})
+
+		It("returns an error when the key does not match the order columns", func() {
+			q := helpers.NewMonitoredDB(db, mon)
+			_, err := helper.AllPaginated(ctx, logger, q, tableName, []string{"existingcol"}, []string{"existingcol"}, []interface{}{1, 2}, 2, "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Describe("Upsert", func() {
 		It("executes queries", func() {
 			q := helpers.NewMonitoredDB(db, mon)
@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"database/sql"
 	"database/sql/driver"
 	"time"
 
@@ -13,10 +14,20 @@ import (
 // BEGIN TRANSACTION; f ... ; COMMIT; or
 // BEGIN TRANSACTION; f ... ; ROLLBACK; if f returns an error.
 func (h *sqlHelper) Transact(ctx context.Context, logger lager.Logger, db QueryableDB, f func(logger lager.Logger, tx Tx) error) error {
+	return h.transact(ctx, logger, db, nil, f)
+}
+
+// TransactSnapshot is Transact in a REPEATABLE READ transaction, whose reads
+// all see the database as it was at the first one.
+func (h *sqlHelper) TransactSnapshot(ctx context.Context, logger lager.Logger, db QueryableDB, f func(logger lager.Logger, tx Tx) error) error {
+	return h.transact(ctx, logger, db, &sql.TxOptions{Isolation: sql.LevelRepeatableRead}, f)
+}
+
+func (h *sqlHelper) transact(ctx context.Context, logger lager.Logger, db QueryableDB, opts *sql.TxOptions, f func(logger lager.Logger, tx Tx) error) error {
 	return h.RetryOnDeadlock(logger, func() error {
 		// meow - the transact wrapper called Begin.
 		// The test is making sure Begin is called 3 times.
-		tx, err := db.BeginTx(ctx, nil)
+		tx, err := db.BeginTx(ctx, opts)
 		if err != nil {
 			logger.Error("failed-starting-transaction", err)
 			return err
@@ -0,0 +1,162 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) ReserveIdempotencyKey(ctx context.Context, logger lager.Logger, key, requestHash string, expiredBefore time.Time) (*models.IdempotencyKey, error) {
+	logger = logger.Session("db-reserve-idempotency-key", lager.Data{"key": key})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var existing *models.IdempotencyKey
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		existing = nil
+
+		row := db.one(ctx, logger, tx, idempotencyKeysTable,
+			idempotencyKeyColumns, helpers.LockRow,
+			"idempotency_key = ?", key,
+		)
+		record, err := db.fetchIdempotencyKey(logger, row)
+		if err != nil && err != sql.ErrNoRows {
+			logger.Error("failed-fetching-idempotency-key", err)
+			return err
+		}
+
+		now := db.clock.Now().UnixNano()
+		if err == sql.ErrNoRows {
+			_, err = db.insert(ctx, logger, tx, idempotencyKeysTable, helpers.SQLAttributes{
+				"idempotency_key": key,
+				"request_hash":    requestHash,
+				"created_at":      now,
+			})
+			if err != nil {
+				logger.Error("failed-inserting-idempotency-key", err)
+				return err
+			}
+			return nil
+		}
+
+		if record.CreatedAt >= expiredBefore.UnixNano() {
+			existing = record
+			return nil
+		}
+
+		_, err = db.update(ctx, logger, tx, idempotencyKeysTable,
+			helpers.SQLAttributes{
+				"request_hash": requestHash,
+				"created_at":   now,
+				"response":     nil,
+			},
+			"idempotency_key = ?", key,
+		)
+		if err != nil {
+			logger.Error("failed-updating-idempotency-key", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
+func (db *SQLDB) CompleteIdempotencyKey(ctx context.Context, logger lager.Logger, key string, response []byte) error {
+	logger = logger.Session("db-complete-idempotency-key", lager.Data{"key": key})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	encodedResponse, err := db.encoder.Encode(response)
+	if err != nil {
+		logger.Error("failed-encoding-response", err)
+		return models.NewError(models.Error_InvalidRecord, err.Error())
+	}
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.update(ctx, logger, tx, idempotencyKeysTable,
+			helpers.SQLAttributes{"response": encodedResponse},
+			"idempotency_key = ?", key,
+		)
+		if err != nil {
+			logger.Error("failed-updating-idempotency-key", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) ReleaseIdempotencyKey(ctx context.Context, logger lager.Logger, key string) error {
+	logger = logger.Session("db-release-idempotency-key", lager.Data{"key": key})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.delete(ctx, logger, tx, idempotencyKeysTable, "idempotency_key = ? AND response IS NULL", key)
+		if err != nil {
+			logger.Error("failed-deleting-idempotency-key", err)
+			return err
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) DeleteIdempotencyKeysBefore(ctx context.Context, logger lager.Logger, before time.Time) (int64, error) {
+	logger = logger.Session("db-delete-idempotency-keys-before", lager.Data{"before": before})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	result, err := db.delete(ctx, logger, db.db, idempotencyKeysTable, "created_at < ?", before.UnixNano())
+	if err != nil {
+		logger.Error("failed-deleting-idempotency-keys", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return 0, db.convertSQLError(err)
+	}
+
+	return deleted, nil
+}
+
+func (db *SQLDB) fetchIdempotencyKey(logger lager.Logger, scanner helpers.RowScanner) (*models.IdempotencyKey, error) {
+	record := &models.IdempotencyKey{}
+	var responseData []byte
+	err := scanner.Scan(
+		&record.Key,
+		&record.RequestHash,
+		&record.CreatedAt,
+		&responseData,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	if responseData != nil {
+		record.Response, err = db.encoder.Decode(responseData)
+		if err != nil {
+			logger.Error("failed-decoding-response", err)
+			return nil, models.NewError(models.Error_InvalidRecord, err.Error())
+		}
+		// an empty response is still a response
+		if record.Response == nil {
+			record.Response = []byte{}
+		}
+	}
+
+	return record, nil
+}
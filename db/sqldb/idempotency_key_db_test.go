@@ -0,0 +1,115 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IdempotencyKeyDB", func() {
+	var expiredBefore time.Time
+
+	BeforeEach(func() {
+		expiredBefore = fakeClock.Now().Add(-time.Hour)
+	})
+
+	Describe("ReserveIdempotencyKey", func() {
+		It("reserves a key that is not recorded", func() {
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(BeNil())
+		})
+
+		It("returns the record of a key being served", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "other-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(Equal(&models.IdempotencyKey{
+				Key:         "some-key",
+				RequestHash: "some-hash",
+				CreatedAt:   fakeClock.Now().UnixNano(),
+			}))
+		})
+
+		It("returns the response of a completed key", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.CompleteIdempotencyKey(ctx, logger, "some-key", []byte("some-response"))).To(Succeed())
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.Response).To(Equal([]byte("some-response")))
+		})
+
+		It("tells an empty response from a key being served", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.CompleteIdempotencyKey(ctx, logger, "some-key", []byte{})).To(Succeed())
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.Response).To(Equal([]byte{}))
+		})
+
+		It("reserves a key recorded before it expired again", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.CompleteIdempotencyKey(ctx, logger, "some-key", []byte("some-response"))).To(Succeed())
+
+			fakeClock.Increment(2 * time.Hour)
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "other-hash", fakeClock.Now().Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(BeNil())
+
+			existing, err = sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "other-hash", fakeClock.Now().Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing.RequestHash).To(Equal("other-hash"))
+			Expect(existing.Response).To(BeNil())
+		})
+	})
+
+	Describe("ReleaseIdempotencyKey", func() {
+		It("releases a key being served", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.ReleaseIdempotencyKey(ctx, logger, "some-key")).To(Succeed())
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "other-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(BeNil())
+		})
+
+		It("keeps a completed key", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.CompleteIdempotencyKey(ctx, logger, "some-key", []byte("some-response"))).To(Succeed())
+			Expect(sqlDB.ReleaseIdempotencyKey(ctx, logger, "some-key")).To(Succeed())
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "some-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).NotTo(BeNil())
+		})
+	})
+
+	Describe("DeleteIdempotencyKeysBefore", func() {
+		It("deletes the keys recorded before the given time", func() {
+			_, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "old-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			fakeClock.Increment(time.Minute)
+			_, err = sqlDB.ReserveIdempotencyKey(ctx, logger, "new-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+
+			deleted, err := sqlDB.DeleteIdempotencyKeysBefore(ctx, logger, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted).To(BeEquivalentTo(1))
+
+			existing, err := sqlDB.ReserveIdempotencyKey(ctx, logger, "new-key", "some-hash", expiredBefore)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).NotTo(BeNil())
+		})
+	})
+})
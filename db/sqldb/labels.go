@@ -0,0 +1,113 @@
+package sqldb
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// labelIndex describes a table holding one row per label of the records of
+// its owner table, so that label selectors can be answered from an index.
+type labelIndex struct {
+	table       string
+	guidColumn  string
+	ownerTable  string
+	ownerColumn string
+}
+
+var (
+	desiredLRPLabels = labelIndex{
+		table:       desiredLRPLabelsTable,
+		guidColumn:  "process_guid",
+		ownerTable:  desiredLRPsTable,
+		ownerColumn: "process_guid",
+	}
+
+	taskLabels = labelIndex{
+		table:       taskLabelsTable,
+		guidColumn:  "task_guid",
+		ownerTable:  tasksTable,
+		ownerColumn: "guid",
+	}
+)
+
+// replace indexes the labels of the given record, discarding any labels
+// left over from a previous record with the same guid.
+func (index labelIndex) replace(ctx context.Context, logger lager.Logger, db *SQLDB, q helpers.Queryable, guid string, labels map[string]string) error {
+	err := index.remove(ctx, logger, db, q, guid)
+	if err != nil {
+		return err
+	}
+
+	for key, value := range labels {
+		_, err := db.insert(ctx, logger, q, index.table,
+			helpers.SQLAttributes{
+				index.guidColumn: guid,
+				"label_key":      key,
+				"label_value":    value,
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-label", err, lager.Data{"label_key": key})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (index labelIndex) remove(ctx context.Context, logger lager.Logger, db *SQLDB, q helpers.Queryable, guids ...string) error {
+	if len(guids) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, len(guids))
+	for _, guid := range guids {
+		values = append(values, guid)
+	}
+
+	_, err := db.delete(ctx, logger, q, index.table,
+		fmt.Sprintf("%s IN (%s)", index.guidColumn, helpers.QuestionMarks(len(guids))), values...,
+	)
+	if err != nil {
+		logger.Error("failed-deleting-labels", err)
+		return err
+	}
+
+	return nil
+}
+
+// wheres returns a condition on the owner table for each requirement of the
+// selector, together with their bindings.
+func (index labelIndex) wheres(selector []*models.LabelSelectorRequirement) ([]string, []interface{}) {
+	var wheres []string
+	var values []interface{}
+
+	for _, requirement := range selector {
+		subquery := fmt.Sprintf("SELECT 1 FROM %s WHERE %s.%s = %s.%s AND %s.label_key = ?",
+			index.table, index.table, index.guidColumn, index.ownerTable, index.ownerColumn, index.table,
+		)
+		values = append(values, requirement.Key)
+
+		if len(requirement.Values) > 0 {
+			subquery += fmt.Sprintf(" AND %s.label_value IN (%s)", index.table, helpers.QuestionMarks(len(requirement.Values)))
+			for _, value := range requirement.Values {
+				values = append(values, value)
+			}
+		}
+
+		switch requirement.Operator {
+		case models.LabelSelectorOperatorNotEquals,
+			models.LabelSelectorOperatorNotIn,
+			models.LabelSelectorOperatorDoesNotExist:
+			wheres = append(wheres, "NOT EXISTS ("+subquery+")")
+		default:
+			wheres = append(wheres, "EXISTS ("+subquery+")")
+		}
+	}
+
+	return wheres, values
+}
@@ -10,28 +10,35 @@ import (
 	"strings"
 	"time"
 
-	"code.cloudfoundry.org/bbs/db"
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/trace"
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/routing-info/internalroutes"
 )
 
-func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) db.ConvergenceResult {
+// ConvergenceLRPPhaseDurationMetric is the duration of each phase of LRP
+// convergence, tagged with the name of the phase.
+const ConvergenceLRPPhaseDurationMetric = "ConvergenceLRPPhaseDuration"
+
+func (db *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) bbsdb.ConvergenceResult {
 	logger = logger.Session("db-converge-lrps")
+	ctx, span := trace.StartSpan(ctx, "converge-lrps")
+	defer span.End()
 	logger.Info("starting")
 	defer logger.Info("complete")
 
-	now := sqldb.clock.Now()
-	sqldb.pruneDomains(ctx, logger, now)
-	events, instanceEvents := sqldb.pruneEvacuatingActualLRPs(ctx, logger, cellSet)
-	domainSet, err := sqldb.domainSet(ctx, logger)
+	now := db.clock.Now()
+	db.pruneDomains(ctx, logger, now)
+	events, instanceEvents := db.pruneEvacuatingActualLRPs(ctx, logger, cellSet)
+	domainSet, err := db.domainSet(ctx, logger)
 	if err != nil {
-		return db.ConvergenceResult{}
+		return bbsdb.ConvergenceResult{}
 	}
 
-	converge := newConvergence(sqldb)
+	converge := newConvergence(db)
 	converge.staleUnclaimedActualLRPs(ctx, logger, now)
 	converge.actualLRPsWithMissingCells(ctx, logger, cellSet)
 	converge.lrpInstanceCounts(ctx, logger, domainSet)
@@ -45,7 +52,7 @@ func (sqldb *SQLDB) ConvergeLRPs(ctx context.Context, logger lager.Logger, cellS
 	converge.lrpsWithInternalRouteChanges(ctx, logger)
 	converge.lrpsWithMetricTagChanges(ctx, logger)
 
-	return db.ConvergenceResult{
+	return bbsdb.ConvergenceResult{
 		MissingLRPKeys:               converge.missingLRPKeys,
 		UnstartedLRPKeys:             converge.unstartedLRPKeys,
 		KeysToRetire:                 converge.keysToRetire,
@@ -80,8 +87,8 @@ type convergence struct {
 
 	unstartedLRPKeys []*models.ActualLRPKeyWithSchedulingInfo
 
-	keysWithInternalRouteChanges []*db.ActualLRPKeyWithInternalRoutes
-	keysWithMetricTagChanges     []*db.ActualLRPKeyWithMetricTags
+	keysWithInternalRouteChanges []*bbsdb.ActualLRPKeyWithInternalRoutes
+	keysWithMetricTagChanges     []*bbsdb.ActualLRPKeyWithMetricTags
 }
 
 func newConvergence(db *SQLDB) *convergence {
@@ -93,6 +100,8 @@ func newConvergence(db *SQLDB) *convergence {
 // Adds stale UNCLAIMED Actual LRPs to the list of start requests.
 func (c *convergence) staleUnclaimedActualLRPs(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("stale-unclaimed-actual-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "stale-unclaimed-actual-lrps")
+	defer endPhase()
 
 	rows, err := c.selectStaleUnclaimedLRPs(ctx, logger, c.db, now)
 	if err != nil {
@@ -125,7 +134,8 @@ func (c *convergence) staleUnclaimedActualLRPs(ctx context.Context, logger lager
 // and transitions them to UNCLAIMED.
 func (c *convergence) crashedActualLRPs(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("crashed-actual-lrps")
-	restartCalculator := models.NewDefaultRestartCalculator()
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "crashed-actual-lrps")
+	defer endPhase()
 
 	rows, err := c.selectCrashedLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -145,10 +155,12 @@ func (c *convergence) crashedActualLRPs(ctx context.Context, logger lager.Logger
 		actual.ActualLRPKey = models.NewActualLRPKey(schedulingInfo.ProcessGuid, int32(index), schedulingInfo.Domain)
 		actual.State = models.ActualLRPStateCrashed
 
+		restartCalculator := models.NewRestartCalculatorFromPolicy(schedulingInfo.RestartPolicy)
 		if actual.ShouldRestartCrash(now, restartCalculator) {
 			c.unstartedLRPKeys = append(c.unstartedLRPKeys, &models.ActualLRPKeyWithSchedulingInfo{
 				Key:            &actual.ActualLRPKey,
 				SchedulingInfo: schedulingInfo,
+				Crashed:        true,
 			})
 			logger.Info("creating-start-request",
 				lager.Data{"reason": "crashed-instance", "process_guid": actual.ProcessGuid, "index": index})
@@ -163,6 +175,8 @@ func (c *convergence) crashedActualLRPs(ctx context.Context, logger lager.Logger
 
 func (c *convergence) lrpsWithInternalRouteChanges(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("lrps-with-internal-route-changes")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "lrps-with-internal-route-changes")
+	defer endPhase()
 	rows, err := c.selectLRPsWithRoutes(ctx, logger, c.db)
 	if err != nil {
 		logger.Error("failed-query", err)
@@ -228,7 +242,7 @@ func (c *convergence) lrpsWithInternalRouteChanges(ctx context.Context, logger l
 		}
 
 		if !actualInternalRoutes.Equal(desiredInternalRoutes) {
-			c.keysWithInternalRouteChanges = append(c.keysWithInternalRouteChanges, &db.ActualLRPKeyWithInternalRoutes{
+			c.keysWithInternalRouteChanges = append(c.keysWithInternalRouteChanges, &bbsdb.ActualLRPKeyWithInternalRoutes{
 				Key:                   actualLRPKey,
 				InstanceKey:           actualLRPInstanceKey,
 				DesiredInternalRoutes: desiredInternalRoutes,
@@ -244,6 +258,8 @@ func (c *convergence) lrpsWithInternalRouteChanges(ctx context.Context, logger l
 
 func (c *convergence) lrpsWithMetricTagChanges(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("lrps-with-metric-tag-changes")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "lrps-with-metric-tag-changes")
+	defer endPhase()
 	rows, err := c.selectLRPsWithMetricTags(ctx, logger, c.db)
 	if err != nil {
 		logger.Error("failed-query", err)
@@ -311,7 +327,7 @@ func (c *convergence) lrpsWithMetricTagChanges(ctx context.Context, logger lager
 		}
 
 		if actualMetricTags != nil && !reflect.DeepEqual(desiredMetricTags, actualMetricTags) {
-			c.keysWithMetricTagChanges = append(c.keysWithMetricTagChanges, &db.ActualLRPKeyWithMetricTags{
+			c.keysWithMetricTagChanges = append(c.keysWithMetricTagChanges, &bbsdb.ActualLRPKeyWithMetricTags{
 				Key:               actualLRPKey,
 				InstanceKey:       actualLRPInstanceKey,
 				DesiredMetricTags: desiredMetricTags,
@@ -353,6 +369,8 @@ func scanActualLRPs(logger lager.Logger, rows *sql.Rows) []*models.ActualLRPKey
 // list of keys to retire.
 func (c *convergence) orphanedActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("orphaned-actual-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "orphaned-actual-lrps")
+	defer endPhase()
 
 	rows, err := c.selectOrphanedActualLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -365,6 +383,8 @@ func (c *convergence) orphanedActualLRPs(ctx context.Context, logger lager.Logge
 
 func (c *convergence) extraSuspectActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("extra-suspect-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "extra-suspect-lrps")
+	defer endPhase()
 
 	rows, err := c.selectExtraSuspectActualLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -377,6 +397,8 @@ func (c *convergence) extraSuspectActualLRPs(ctx context.Context, logger lager.L
 
 func (c *convergence) orphanedSuspectActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("orphaned-suspect-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "orphaned-suspect-lrps")
+	defer endPhase()
 
 	rows, err := c.selectOrphanedSuspectActualLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -389,6 +411,8 @@ func (c *convergence) orphanedSuspectActualLRPs(ctx context.Context, logger lage
 
 func (c *convergence) suspectRunningActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("suspect-running-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "suspect-running-lrps")
+	defer endPhase()
 
 	rows, err := c.selectSuspectRunningActualLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -401,6 +425,8 @@ func (c *convergence) suspectRunningActualLRPs(ctx context.Context, logger lager
 
 func (c *convergence) suspectClaimedActualLRPs(ctx context.Context, logger lager.Logger) {
 	logger = logger.Session("suspect-running-lrps")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "suspect-claimed-lrps")
+	defer endPhase()
 
 	rows, err := c.selectSuspectClaimedActualLRPs(ctx, logger, c.db)
 	if err != nil {
@@ -415,6 +441,8 @@ func (c *convergence) suspectClaimedActualLRPs(ctx context.Context, logger lager
 // Adds extra Actual LRPs  to the list of keys to retire.
 func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger, domainSet map[string]struct{}) {
 	logger = logger.Session("lrp-instance-counts")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "lrp-instance-counts")
+	defer endPhase()
 
 	rows, err := c.selectLRPInstanceCounts(ctx, logger, c.db)
 	if err != nil {
@@ -490,6 +518,8 @@ func (c *convergence) lrpInstanceCounts(ctx context.Context, logger lager.Logger
 // convergence) and add them to the list of start requests.
 func (c *convergence) suspectActualLRPsWithExistingCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) {
 	logger = logger.Session("suspect-lrps-with-existing-cells")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "suspect-lrps-with-existing-cells")
+	defer endPhase()
 
 	if len(cellSet) == 0 {
 		return
@@ -508,6 +538,8 @@ func (c *convergence) suspectActualLRPsWithExistingCells(ctx context.Context, lo
 // convergence) and add them to the list of start requests.
 func (c *convergence) actualLRPsWithMissingCells(ctx context.Context, logger lager.Logger, cellSet models.CellSet) {
 	logger = logger.Session("actual-lrps-with-missing-cells")
+	ctx, endPhase := c.startConvergencePhase(ctx, logger, "actual-lrps-with-missing-cells")
+	defer endPhase()
 
 	var ordinaryKeysWithMissingCells []*models.ActualLRPKeyWithSchedulingInfo
 
@@ -553,6 +585,8 @@ func (c *convergence) actualLRPsWithMissingCells(ctx context.Context, logger lag
 
 func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time.Time) {
 	logger = logger.Session("prune-domains")
+	ctx, endPhase := db.startConvergencePhase(ctx, logger, "prune-domains")
+	defer endPhase()
 
 	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
 		domains, err := db.domains(ctx, logger, tx, time.Time{})
@@ -582,6 +616,8 @@ func (db *SQLDB) pruneDomains(ctx context.Context, logger lager.Logger, now time
 
 func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Logger, cellSet models.CellSet) ([]models.Event, []models.Event) {
 	logger = logger.Session("prune-evacuating-actual-lrps")
+	ctx, endPhase := db.startConvergencePhase(ctx, logger, "prune-evacuating-actual-lrps")
+	defer endPhase()
 
 	wheres := []string{"presence = ?"}
 	bindings := []interface{}{models.ActualLRP_Evacuating}
@@ -594,7 +630,7 @@ func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Log
 		}
 	}
 
-	lrpsToDelete, err := db.getActualLRPs(ctx, logger, strings.Join(wheres, " AND "), bindings...)
+	lrpsToDelete, _, err := db.getActualLRPs(ctx, logger, nil, 0, strings.Join(wheres, " AND "), bindings...)
 	if err != nil {
 		logger.Error("failed-fetching-evacuating-lrps-with-missing-cells", err)
 	}
@@ -614,6 +650,21 @@ func (db *SQLDB) pruneEvacuatingActualLRPs(ctx context.Context, logger lager.Log
 	return events, instanceEvents
 }
 
+// startConvergencePhase starts the span of a phase of LRP convergence, and
+// returns the function that ends it and sends the duration of the phase.
+func (db *SQLDB) startConvergencePhase(ctx context.Context, logger lager.Logger, phase string) (context.Context, func()) {
+	ctx, span := trace.StartSpan(ctx, "converge-lrps."+phase)
+	start := db.clock.Now()
+
+	return ctx, func() {
+		span.End()
+		err := db.metronClient.SendDuration(ConvergenceLRPPhaseDurationMetric, db.clock.Since(start), loggregator.WithEnvelopeTag("phase", phase))
+		if err != nil {
+			logger.Error("failed-sending-phase-duration", err)
+		}
+	}
+}
+
 func (db *SQLDB) domainSet(ctx context.Context, logger lager.Logger) (map[string]struct{}, error) {
 	logger.Debug("listing-domains")
 	domains, err := db.FreshDomains(ctx, logger)
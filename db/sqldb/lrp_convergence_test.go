@@ -7,10 +7,12 @@ import (
 	"time"
 
 	bbsdb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/sqldb"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/test_helpers"
 	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
 	"code.cloudfoundry.org/routing-info/internalroutes"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -41,6 +43,28 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Describe("phase durations", func() {
+		It("sends the duration of each phase, tagged with the phase", func() {
+			sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+
+			phases := []string{}
+			for i := 0; i < fakeMetronClient.SendDurationCallCount(); i++ {
+				name, _, opts := fakeMetronClient.SendDurationArgsForCall(i)
+				if name != sqldb.ConvergenceLRPPhaseDurationMetric {
+					continue
+				}
+				envelope := &loggregator_v2.Envelope{Tags: map[string]string{}}
+				for _, opt := range opts {
+					opt(envelope)
+				}
+				phases = append(phases, envelope.Tags["phase"])
+			}
+
+			Expect(phases).To(HaveLen(14))
+			Expect(phases).To(ContainElements("prune-domains", "stale-unclaimed-actual-lrps", "lrp-instance-counts", "suspect-claimed-lrps"))
+		})
+	})
+
 	Describe("pruning evacuating lrps", func() {
 		var (
 			processGuid, domain string
@@ -1083,6 +1107,7 @@ var _ = Describe("LRPConvergence", func() {
 				Expect(result.UnstartedLRPKeys).To(ContainElement(&models.ActualLRPKeyWithSchedulingInfo{
 					Key:            &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain},
 					SchedulingInfo: &expectedSched,
+					Crashed:        true,
 				}))
 			})
 		})
@@ -1110,6 +1135,7 @@ var _ = Describe("LRPConvergence", func() {
 				Expect(result.UnstartedLRPKeys).To(ContainElement(&models.ActualLRPKeyWithSchedulingInfo{
 					Key:            &models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain},
 					SchedulingInfo: &expectedSched,
+					Crashed:        true,
 				}))
 			})
 		})
@@ -1264,6 +1290,70 @@ var _ = Describe("LRPConvergence", func() {
 		})
 	})
 
+	Context("when the crashed ActualLRPs have a restart policy", func() {
+		var (
+			domain        string
+			processGuid   string
+			restartPolicy *models.RestartPolicy
+			crashCount    int32
+		)
+
+		BeforeEach(func() {
+			domain = "some-domain"
+			processGuid = "desired-with-restart-policy"
+			Expect(sqlDB.UpsertDomain(ctx, logger, domain, 5)).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP(processGuid)
+			desiredLRP.Domain = domain
+			desiredLRP.Instances = 1
+			desiredLRP.RestartPolicy = restartPolicy
+			Expect(sqlDB.DesireLRP(ctx, logger, desiredLRP)).To(Succeed())
+
+			actualLRPKey := models.NewActualLRPKey(processGuid, 0, domain)
+			_, err := sqlDB.CreateUnclaimedActualLRP(ctx, logger, &actualLRPKey)
+			Expect(err).NotTo(HaveOccurred())
+
+			queryStr := `
+			UPDATE actual_lrps
+			SET crash_count = ?, state = ?, since = ?
+			`
+			if test_helpers.UsePostgres() {
+				queryStr = test_helpers.ReplaceQuestionMarks(queryStr)
+			}
+			_, err = db.ExecContext(ctx, queryStr, crashCount, models.ActualLRPStateCrashed, fakeClock.Now().UnixNano())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("and the policy allows more immediate restarts than the default", func() {
+			BeforeEach(func() {
+				restartPolicy = &models.RestartPolicy{ImmediateRestarts: 10}
+				crashCount = models.DefaultImmediateRestarts + 1
+			})
+
+			It("adds the key to UnstartedLRPKeys", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				Expect(result.UnstartedLRPKeys).To(HaveLen(1))
+				Expect(result.UnstartedLRPKeys[0].Key).To(Equal(&models.ActualLRPKey{ProcessGuid: processGuid, Index: 0, Domain: domain}))
+				Expect(result.UnstartedLRPKeys[0].SchedulingInfo.RestartPolicy).To(Equal(restartPolicy))
+				Expect(result.UnstartedLRPKeys[0].Crashed).To(BeTrue())
+			})
+		})
+
+		Context("and the policy never restarts", func() {
+			BeforeEach(func() {
+				restartPolicy = &models.RestartPolicy{NeverRestart: true}
+				crashCount = 1
+			})
+
+			It("does not add the key to UnstartedLRPKeys", func() {
+				result := sqlDB.ConvergeLRPs(ctx, logger, cellSet)
+				Expect(result.UnstartedLRPKeys).To(BeEmpty())
+			})
+		})
+	})
+
 	Context("there is an ActualLRP without a corresponding DesiredLRP", func() {
 		var (
 			processGuid, domain string
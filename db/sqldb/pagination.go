@@ -0,0 +1,131 @@
+package sqldb
+
+import (
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+func desiredLRPPageKey(pageToken string) ([]interface{}, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+
+	token, err := models.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.ProcessGuid}, nil
+}
+
+func actualLRPPageKey(pageToken string) ([]interface{}, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+
+	token, err := models.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.ProcessGuid, token.Index, token.Presence}, nil
+}
+
+func taskPageKey(pageToken string) ([]interface{}, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+
+	token, err := models.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.TaskGuid}, nil
+}
+
+func auditRecordPageKey(pageToken string) ([]interface{}, error) {
+	if pageToken == "" {
+		return nil, nil
+	}
+
+	token, err := models.DecodePageToken(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{token.AuditRecordId}, nil
+}
+
+// rowIterator is the part of *sql.Rows the scanning functions use, so that
+// they can read a page through pageRows.
+type rowIterator interface {
+	helpers.RowScanner
+	Next() bool
+	Err() error
+	Close() error
+}
+
+// pageRows reads a page of rows queried with one row more than the page holds.
+// It remembers the key of the last row of the page, whether or not the row
+// could be read, and whether more rows follow the page.
+type pageRows struct {
+	*sql.Rows
+	pageSize  int32
+	pageToken func(dest []interface{}) models.PageToken
+
+	read int32
+	last models.PageToken
+	more bool
+}
+
+func (r *pageRows) Next() bool {
+	if r.pageSize > 0 && r.read == r.pageSize {
+		r.more = r.Rows.Next()
+		return false
+	}
+	if !r.Rows.Next() {
+		return false
+	}
+	r.read++
+	return true
+}
+
+func (r *pageRows) Scan(dest ...interface{}) error {
+	err := r.Rows.Scan(dest...)
+	if err != nil {
+		return err
+	}
+	r.last = r.pageToken(dest)
+	return nil
+}
+
+// NextPageToken returns the token of the next page, or an empty token when no
+// rows follow the page.
+func (r *pageRows) NextPageToken() string {
+	if !r.more {
+		return ""
+	}
+	return r.last.Encode()
+}
+
+// The page token functions read the key of a row from the leading columns
+// scanned into dest, which are the page columns of its table.
+
+func desiredLRPRowPageToken(dest []interface{}) models.PageToken {
+	return models.PageToken{ProcessGuid: *dest[0].(*string)}
+}
+
+func actualLRPRowPageToken(dest []interface{}) models.PageToken {
+	return models.PageToken{
+		ProcessGuid: *dest[0].(*string),
+		Index:       *dest[1].(*int32),
+		Presence:    *dest[2].(*models.ActualLRP_Presence),
+	}
+}
+
+func taskRowPageToken(dest []interface{}) models.PageToken {
+	return models.PageToken{TaskGuid: *dest[0].(*string)}
+}
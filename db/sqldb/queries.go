@@ -13,10 +13,24 @@ import (
 )
 
 const (
-	tasksTable       = "tasks"
-	desiredLRPsTable = "desired_lrps"
-	actualLRPsTable  = "actual_lrps"
-	domainsTable     = "domains"
+	tasksTable                  = "tasks"
+	desiredLRPsTable            = "desired_lrps"
+	actualLRPsTable             = "actual_lrps"
+	auditRecordsTable           = "audit_records"
+	domainsTable                = "domains"
+	domainQuotasTable           = "domain_quotas"
+	eventLogTable               = "event_log"
+	deploymentsTable            = "deployments"
+	scheduledTasksTable         = "scheduled_tasks"
+	taskCallbacksTable          = "task_callbacks"
+	idempotencyKeysTable        = "idempotency_keys"
+	resourceVersionCounterTable = "resource_version_counter"
+	actualLRPHistoryTable       = "actual_lrp_history"
+	cellsTable                  = "cells"
+
+	desiredLRPLabelsTable = "desired_lrp_labels"
+	taskLabelsTable       = "task_labels"
+	taskDependenciesTable = "task_dependencies"
 )
 
 var (
@@ -46,6 +60,8 @@ var (
 		desiredLRPsTable + ".modification_tag_epoch",
 		desiredLRPsTable + ".modification_tag_index",
 		desiredLRPsTable + ".placement_tags",
+		desiredLRPsTable + ".labels",
+		desiredLRPsTable + ".restart_policy",
 	}
 
 	desiredLRPColumns = append(schedulingInfoColumns,
@@ -54,6 +70,70 @@ var (
 		desiredLRPsTable+".update_strategy",
 	)
 
+	deploymentColumns = helpers.ColumnList{
+		deploymentsTable + ".process_guid",
+		deploymentsTable + ".guid",
+		deploymentsTable + ".domain",
+		deploymentsTable + ".state",
+		deploymentsTable + ".paused",
+		deploymentsTable + ".max_surge",
+		deploymentsTable + ".max_unavailable",
+		deploymentsTable + ".instances",
+		deploymentsTable + ".pending_indices",
+		deploymentsTable + ".retiring_instances",
+		deploymentsTable + ".created_at",
+		deploymentsTable + ".updated_at",
+	}
+
+	deploymentForUpdateColumns = append(deploymentColumns,
+		deploymentsTable+".previous_run_info",
+	)
+
+	cellDrainColumns = helpers.ColumnList{
+		cellsTable + ".cell_id",
+		cellsTable + ".drain_state",
+		cellsTable + ".drain_initial_instances",
+		cellsTable + ".drain_remaining_instances",
+		cellsTable + ".drain_error",
+		cellsTable + ".drain_started_at",
+		cellsTable + ".drain_updated_at",
+	}
+
+	domainQuotaColumns = helpers.ColumnList{
+		domainQuotasTable + ".domain",
+		domainQuotasTable + ".memory_mb",
+		domainQuotasTable + ".disk_mb",
+		domainQuotasTable + ".instances",
+		domainQuotasTable + ".running_tasks",
+		domainQuotasTable + ".log_rate_bytes_per_second",
+	}
+
+	taskCallbackColumns = helpers.ColumnList{
+		taskCallbacksTable + ".task_guid",
+		taskCallbacksTable + ".state",
+		taskCallbacksTable + ".attempts",
+		taskCallbacksTable + ".next_attempt_at",
+		taskCallbacksTable + ".last_status_code",
+		taskCallbacksTable + ".last_error",
+		taskCallbacksTable + ".created_at",
+		taskCallbacksTable + ".updated_at",
+	}
+
+	scheduledTaskColumns = helpers.ColumnList{
+		scheduledTasksTable + ".guid",
+		scheduledTasksTable + ".domain",
+		scheduledTasksTable + ".cron_expression",
+		scheduledTasksTable + ".time_zone",
+		scheduledTasksTable + ".concurrency_policy",
+		scheduledTasksTable + ".history_limit",
+		scheduledTasksTable + ".suspended",
+		scheduledTasksTable + ".task_definition",
+		scheduledTasksTable + ".recent_runs",
+		scheduledTasksTable + ".next_run_at",
+		scheduledTasksTable + ".created_at",
+		scheduledTasksTable + ".updated_at",
+	}
+
 	taskColumns = helpers.ColumnList{
 		tasksTable + ".guid",
 		tasksTable + ".domain",
@@ -68,6 +148,8 @@ var (
 		tasksTable + ".task_definition",
 		tasksTable + ".rejection_count",
 		tasksTable + ".rejection_reason",
+		tasksTable + ".retry_at",
+		tasksTable + ".failed_attempts",
 	}
 
 	actualLRPColumns = helpers.ColumnList{
@@ -99,10 +181,78 @@ var (
 		actualLRPsTable + ".cell_id",
 	}
 
+	desiredLRPPageColumns = helpers.ColumnList{
+		desiredLRPsTable + ".process_guid",
+	}
+
+	actualLRPPageColumns = helpers.ColumnList{
+		actualLRPsTable + ".process_guid",
+		actualLRPsTable + ".instance_index",
+		actualLRPsTable + ".presence",
+	}
+
+	taskPageColumns = helpers.ColumnList{
+		tasksTable + ".guid",
+	}
+
 	domainColumns = helpers.ColumnList{
 		domainsTable + ".domain",
 		domainsTable + ".expire_time",
 	}
+
+	eventLogColumns = helpers.ColumnList{
+		eventLogTable + ".id",
+		eventLogTable + ".event_type",
+		eventLogTable + ".payload",
+		eventLogTable + ".resource_version",
+	}
+
+	eventLogOrderColumns = helpers.ColumnList{
+		eventLogTable + ".id",
+	}
+
+	auditRecordColumns = helpers.ColumnList{
+		auditRecordsTable + ".id",
+		auditRecordsTable + ".created_at",
+		auditRecordsTable + ".route",
+		auditRecordsTable + ".trace_id",
+		auditRecordsTable + ".actor_common_name",
+		auditRecordsTable + ".actor_organizational_units",
+		auditRecordsTable + ".remote_addr",
+		auditRecordsTable + ".kind",
+		auditRecordsTable + ".action",
+		auditRecordsTable + ".domain",
+		auditRecordsTable + ".process_guid",
+		auditRecordsTable + ".instance_index",
+		auditRecordsTable + ".instance_guid",
+		auditRecordsTable + ".task_guid",
+		auditRecordsTable + ".changes",
+	}
+
+	idempotencyKeyColumns = helpers.ColumnList{
+		idempotencyKeysTable + ".idempotency_key",
+		idempotencyKeysTable + ".request_hash",
+		idempotencyKeysTable + ".created_at",
+		idempotencyKeysTable + ".response",
+	}
+
+	auditRecordOrderColumns = helpers.ColumnList{
+		auditRecordsTable + ".id",
+	}
+
+	actualLRPHistoryColumns = helpers.ColumnList{
+		actualLRPHistoryTable + ".id",
+		actualLRPHistoryTable + ".process_guid",
+		actualLRPHistoryTable + ".instance_index",
+		actualLRPHistoryTable + ".instance_guid",
+		actualLRPHistoryTable + ".cell_id",
+		actualLRPHistoryTable + ".transition",
+		actualLRPHistoryTable + ".state",
+		actualLRPHistoryTable + ".reason",
+		actualLRPHistoryTable + ".crash_count",
+		actualLRPHistoryTable + ".created_at",
+		actualLRPHistoryTable + ".trace_id",
+	}
 )
 
 func (db *SQLDB) CreateConfigurationsTable(ctx context.Context, logger lager.Logger) error {
@@ -399,18 +549,66 @@ func (db *SQLDB) all(ctx context.Context, logger lager.Logger, q helpers.Queryab
 	return db.helper.All(ctx, logger, q, table, columns, lockRow, wheres, whereBindings...)
 }
 
+// allPaginated falls back to an unordered query when neither a page size nor
+// a keyset position is given, so unpaginated callers see no change in the
+// generated SQL.
+func (db *SQLDB) allPaginated(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string,
+	columns helpers.ColumnList, orderBy helpers.ColumnList,
+	after []interface{}, pageSize int32,
+	wheres string, whereBindings ...interface{},
+) (*sql.Rows, error) {
+	if pageSize == 0 && len(after) == 0 {
+		return db.helper.All(ctx, logger, q, table, columns, helpers.NoLockRow, wheres, whereBindings...)
+	}
+	return db.helper.AllPaginated(ctx, logger, q, table, columns, orderBy, after, int(pageSize), wheres, whereBindings...)
+}
+
+// allPage queries the page of rows after the given key along with the row
+// that follows it, so that the returned pageRows can tell whether another
+// page follows.
+func (db *SQLDB) allPage(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string,
+	columns helpers.ColumnList, orderBy helpers.ColumnList,
+	pageToken func(dest []interface{}) models.PageToken,
+	after []interface{}, pageSize int32,
+	wheres string, whereBindings ...interface{},
+) (*pageRows, error) {
+	limit := pageSize
+	if pageSize > 0 {
+		limit++
+	}
+	rows, err := db.allPaginated(ctx, logger, q, table, columns, orderBy, after, limit, wheres, whereBindings...)
+	if err != nil {
+		return nil, err
+	}
+	return &pageRows{Rows: rows, pageSize: pageSize, pageToken: pageToken}, nil
+}
+
+// upsert, insert, update and delete give their transaction a resource
+// version when they change rows of a versioned table.
 func (db *SQLDB) upsert(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string, attributes helpers.SQLAttributes, wheres string, whereBindings ...interface{}) (bool, error) {
-	return db.helper.Upsert(ctx, logger, q, table, attributes, wheres, whereBindings...)
+	var ok bool
+	_, err := db.versioned(ctx, logger, q, table, func(q helpers.Queryable) (sql.Result, error) {
+		var err error
+		ok, err = db.helper.Upsert(ctx, logger, q, table, attributes, wheres, whereBindings...)
+		return nil, err
+	})
+	return ok, err
 }
 
 func (db *SQLDB) insert(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string, attributes helpers.SQLAttributes) (sql.Result, error) {
-	return db.helper.Insert(ctx, logger, q, table, attributes)
+	return db.versioned(ctx, logger, q, table, func(q helpers.Queryable) (sql.Result, error) {
+		return db.helper.Insert(ctx, logger, q, table, attributes)
+	})
 }
 
 func (db *SQLDB) update(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string, updates helpers.SQLAttributes, wheres string, whereBindings ...interface{}) (sql.Result, error) {
-	return db.helper.Update(ctx, logger, q, table, updates, wheres, whereBindings...)
+	return db.versioned(ctx, logger, q, table, func(q helpers.Queryable) (sql.Result, error) {
+		return db.helper.Update(ctx, logger, q, table, updates, wheres, whereBindings...)
+	})
 }
 
 func (db *SQLDB) delete(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string, wheres string, whereBindings ...interface{}) (sql.Result, error) {
-	return db.helper.Delete(ctx, logger, q, table, wheres, whereBindings...)
+	return db.versioned(ctx, logger, q, table, func(q helpers.Queryable) (sql.Result, error) {
+		return db.helper.Delete(ctx, logger, q, table, wheres, whereBindings...)
+	})
 }
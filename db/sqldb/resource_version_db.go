@@ -0,0 +1,99 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// versionedTables are the tables whose writes are given a resource version.
+var versionedTables = map[string]bool{
+	desiredLRPsTable: true,
+	actualLRPsTable:  true,
+	tasksTable:       true,
+}
+
+// versionedTx is a transaction of a SQLDB, recording whether its writes
+// changed rows of a versioned table.
+type versionedTx struct {
+	helpers.Tx
+	changed bool
+}
+
+func (db *SQLDB) ResourceVersion(ctx context.Context, logger lager.Logger) (uint64, error) {
+	logger = logger.Session("db-resource-version")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	return db.resourceVersion(ctx, logger, db.db)
+}
+
+// resourceVersion returns the version of the most recently committed write.
+// A list read after it in the same snapshot reflects every write up to it.
+func (db *SQLDB) resourceVersion(ctx context.Context, logger lager.Logger, q helpers.Queryable) (uint64, error) {
+	var version int64
+	err := q.QueryRowContext(ctx, "SELECT version FROM "+resourceVersionCounterTable+" WHERE id = 1").Scan(&version)
+	if err != nil {
+		logger.Error("failed-reading-resource-version", err)
+		return 0, db.convertSQLError(err)
+	}
+	return uint64(version), nil
+}
+
+// nextResourceVersion increments the counter in the transaction. Its row
+// stays locked until the transaction ends, so the writes given versions
+// commit in the order of their versions.
+func (db *SQLDB) nextResourceVersion(ctx context.Context, logger lager.Logger, tx helpers.Tx) (uint64, error) {
+	var version int64
+	var err error
+	if db.flavor == helpers.MySQL {
+		var result sql.Result
+		result, err = tx.ExecContext(ctx, "UPDATE "+resourceVersionCounterTable+" SET version = LAST_INSERT_ID(version + 1) WHERE id = 1")
+		if err == nil {
+			version, err = result.LastInsertId()
+		}
+	} else {
+		err = tx.QueryRowContext(ctx, "UPDATE "+resourceVersionCounterTable+" SET version = version + 1 WHERE id = 1 RETURNING version").Scan(&version)
+	}
+	if err != nil {
+		logger.Error("failed-allocating-resource-version", err)
+		return 0, err
+	}
+	return uint64(version), nil
+}
+
+// versioned makes the write with the queryable, marking its transaction as
+// changed when it changes rows of a versioned table. A write outside of a
+// transaction is made in a transaction of its own, so that it commits
+// together with its version.
+func (db *SQLDB) versioned(ctx context.Context, logger lager.Logger, q helpers.Queryable, table string, write func(q helpers.Queryable) (sql.Result, error)) (sql.Result, error) {
+	if !versionedTables[table] {
+		return write(q)
+	}
+
+	tx, ok := q.(*versionedTx)
+	if !ok {
+		var result sql.Result
+		err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+			var err error
+			result, err = db.versioned(ctx, logger, tx, table, write)
+			return err
+		})
+		return result, err
+	}
+
+	result, err := write(tx)
+	if err != nil {
+		return result, err
+	}
+	if result != nil {
+		rowsAffected, err := result.RowsAffected()
+		if err == nil && rowsAffected == 0 {
+			return result, nil
+		}
+	}
+	tx.changed = true
+	return result, nil
+}
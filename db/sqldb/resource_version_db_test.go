@@ -0,0 +1,130 @@
+package sqldb_test
+
+import (
+	"fmt"
+
+	thepackagedb "code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/sqldb"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceVersionDB", func() {
+	var before uint64
+
+	BeforeEach(func() {
+		var err error
+		before, err = sqlDB.ResourceVersion(ctx, logger)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("ResourceVersion", func() {
+		It("increases with every write of a task", func() {
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			afterDesire, err := sqlDB.ResourceVersion(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(afterDesire).To(BeNumerically(">", before))
+
+			_, _, _, err = sqlDB.CancelTask(ctx, logger, "task-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(BeNumerically(">", afterDesire))
+		})
+
+		It("increases with every write of an LRP", func() {
+			Expect(sqlDB.DesireLRP(ctx, logger, model_helpers.NewValidDesiredLRP("process-guid"))).To(Succeed())
+
+			afterDesire, err := sqlDB.ResourceVersion(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(afterDesire).To(BeNumerically(">", before))
+
+			_, err = sqlDB.CreateUnclaimedActualLRP(ctx, logger, &models.ActualLRPKey{ProcessGuid: "process-guid", Index: 0, Domain: "domain"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(BeNumerically(">", afterDesire))
+		})
+
+		It("does not change with writes of other resources", func() {
+			Expect(sqlDB.UpsertDomain(ctx, logger, "domain", 100)).To(Succeed())
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(Equal(before))
+		})
+
+		It("does not change when a write fails", func() {
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+			afterDesire, err := sqlDB.ResourceVersion(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).To(HaveOccurred())
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(Equal(afterDesire))
+		})
+
+		It("is shared by the SQLDBs of every BBS", func() {
+			otherDB := sqldb.NewSQLDB(db, 5, 5, cryptor, fakeGUIDProvider, fakeClock, dbFlavor, fakeMetronClient, false)
+			Expect(otherDB.ResourceVersion(ctx, logger)).To(Equal(before))
+
+			_, err := otherDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(Equal(before + 1))
+		})
+
+		Context("when tasks are desired concurrently", func() {
+			BeforeEach(func() {
+				// every transaction needs its own connection to wait on the
+				// lock of the counter
+				rawDB.SetMaxOpenConns(10)
+			})
+
+			It("gives the write of each its own version", func() {
+				versions := make(chan uint64, 10)
+				for i := 0; i < 10; i++ {
+					go func(i int) {
+						defer GinkgoRecover()
+						writeCtx, writeVersion := thepackagedb.WithWriteVersion(ctx)
+						_, err := sqlDB.DesireTask(writeCtx, logger, model_helpers.NewValidTaskDefinition(), fmt.Sprintf("task-%d", i), "domain")
+						Expect(err).NotTo(HaveOccurred())
+						versions <- writeVersion.ResourceVersion()
+					}(i)
+				}
+
+				seen := map[uint64]bool{}
+				for i := 0; i < 10; i++ {
+					version := <-versions
+					Expect(version).To(BeNumerically(">", before))
+					Expect(seen).NotTo(HaveKey(version))
+					seen[version] = true
+				}
+				Expect(sqlDB.ResourceVersion(ctx, logger)).To(Equal(before + 10))
+			})
+		})
+	})
+
+	Describe("WriteVersion", func() {
+		It("records the version of the writes made with the context", func() {
+			writeCtx, writeVersion := thepackagedb.WithWriteVersion(ctx)
+			_, err := sqlDB.DesireTask(writeCtx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(writeVersion.ResourceVersion()).To(BeNumerically(">", before))
+			Expect(sqlDB.ResourceVersion(ctx, logger)).To(Equal(writeVersion.ResourceVersion()))
+		})
+	})
+
+	Describe("ListMetadata", func() {
+		It("carries the version the list reflects", func() {
+			_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "task-guid", "domain")
+			Expect(err).NotTo(HaveOccurred())
+			committed, err := sqlDB.ResourceVersion(ctx, logger)
+			Expect(err).NotTo(HaveOccurred())
+
+			tasks, metadata, err := sqlDB.ListTasks(ctx, logger, models.TaskFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(metadata.ResourceVersion).To(Equal(committed))
+		})
+	})
+})
@@ -0,0 +1,336 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+func (db *SQLDB) ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error) {
+	logger = logger.Session("db-scheduled-tasks", lager.Data{"domain": domain})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := ""
+	values := []interface{}{}
+	if domain != "" {
+		wheres = "domain = ?"
+		values = append(values, domain)
+	}
+
+	return db.selectScheduledTasks(ctx, logger, wheres, values...)
+}
+
+func (db *SQLDB) DueScheduledTasks(ctx context.Context, logger lager.Logger, now time.Time) ([]*models.ScheduledTask, error) {
+	logger = logger.Session("db-due-scheduled-tasks")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	// a next run of 0 means the schedule never fires
+	return db.selectScheduledTasks(ctx, logger,
+		"suspended = ? AND next_run_at > 0 AND next_run_at <= ?", false, now.UnixNano(),
+	)
+}
+
+func (db *SQLDB) selectScheduledTasks(ctx context.Context, logger lager.Logger, wheres string, values ...interface{}) ([]*models.ScheduledTask, error) {
+	schedules := []*models.ScheduledTask{}
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		rows, err := db.all(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.NoLockRow,
+			wheres, values...,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			schedule, err := db.fetchScheduledTask(logger, rows)
+			if err != nil {
+				logger.Error("failed-reading-row", err)
+				continue
+			}
+			schedules = append(schedules, schedule)
+		}
+
+		if rows.Err() != nil {
+			logger.Error("failed-fetching-row", rows.Err())
+			return db.convertSQLError(rows.Err())
+		}
+
+		return nil
+	})
+
+	return schedules, err
+}
+
+func (db *SQLDB) ScheduledTaskByGuid(ctx context.Context, logger lager.Logger, scheduleGuid string) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-scheduled-task-by-guid", lager.Data{"schedule_guid": scheduleGuid})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	var schedule *models.ScheduledTask
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.NoLockRow,
+			"guid = ?", scheduleGuid,
+		)
+		schedule, err = db.fetchScheduledTask(logger, row)
+		return err
+	})
+
+	return schedule, err
+}
+
+func (db *SQLDB) DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-desire-scheduled-task", lager.Data{"schedule_guid": schedule.ScheduleGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	schedule = copyScheduledTask(schedule)
+	now := db.clock.Now()
+
+	nextRunAt, err := scheduledNextRunAt(schedule, now)
+	if err != nil {
+		logger.Error("failed-computing-next-run", err)
+		return nil, err
+	}
+
+	schedule.Suspended = false
+	schedule.RecentRuns = nil
+	schedule.NextRunAt = nextRunAt
+	schedule.CreatedAt = now.UnixNano()
+	schedule.UpdatedAt = now.UnixNano()
+
+	attributes, err := db.scheduledTaskAttributes(logger, schedule)
+	if err != nil {
+		return nil, err
+	}
+	attributes["guid"] = schedule.ScheduleGuid
+	attributes["domain"] = schedule.Domain
+	attributes["created_at"] = schedule.CreatedAt
+
+	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.insert(ctx, logger, tx, scheduledTasksTable, attributes)
+		if err != nil {
+			logger.Error("failed-inserting-scheduled-task", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return schedule, nil
+}
+
+func (db *SQLDB) UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-update-scheduled-task", lager.Data{"schedule_guid": scheduleGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.modifyScheduledTask(ctx, logger, scheduleGuid, func(schedule *models.ScheduledTask, now time.Time) (bool, error) {
+		return update.Apply(schedule), nil
+	})
+}
+
+func (db *SQLDB) SetScheduledTaskSuspended(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-set-scheduled-task-suspended", lager.Data{"schedule_guid": scheduleGuid, "suspended": suspended})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.modifyScheduledTask(ctx, logger, scheduleGuid, func(schedule *models.ScheduledTask, now time.Time) (bool, error) {
+		resumed := schedule.Suspended && !suspended
+		schedule.Suspended = suspended
+		return resumed, nil
+	})
+}
+
+func (db *SQLDB) RecordScheduledTaskRun(ctx context.Context, logger lager.Logger, scheduleGuid string, expectedNextRunAt int64, run *models.ScheduledTaskRun, nextRunAt int64) (*models.ScheduledTask, error) {
+	logger = logger.Session("db-record-scheduled-task-run", lager.Data{"schedule_guid": scheduleGuid, "task_guid": run.TaskGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.modifyScheduledTask(ctx, logger, scheduleGuid, func(schedule *models.ScheduledTask, now time.Time) (bool, error) {
+		if schedule.NextRunAt != expectedNextRunAt {
+			logger.Info("scheduled-task-already-run", lager.Data{"next_run_at": schedule.NextRunAt})
+			return false, models.ErrResourceConflict
+		}
+
+		schedule.RecordRun(run)
+		schedule.NextRunAt = nextRunAt
+		return false, nil
+	})
+}
+
+// modifyScheduledTask applies modify to the locked schedule and stores the
+// result. When modify reports that the schedule changed, the next run is
+// recomputed from now.
+func (db *SQLDB) modifyScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, modify func(*models.ScheduledTask, time.Time) (bool, error)) (*models.ScheduledTask, error) {
+	var schedule *models.ScheduledTask
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, scheduledTasksTable,
+			scheduledTaskColumns, helpers.LockRow,
+			"guid = ?", scheduleGuid,
+		)
+		schedule, err = db.fetchScheduledTask(logger, row)
+		if err != nil {
+			logger.Error("failed-fetching-scheduled-task", err)
+			return err
+		}
+
+		now := db.clock.Now()
+		rescheduled, err := modify(schedule, now)
+		if err != nil {
+			return err
+		}
+
+		if rescheduled {
+			schedule.NextRunAt, err = scheduledNextRunAt(schedule, now)
+			if err != nil {
+				logger.Error("failed-computing-next-run", err)
+				return err
+			}
+		}
+		schedule.UpdatedAt = now.UnixNano()
+
+		attributes, err := db.scheduledTaskAttributes(logger, schedule)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.update(ctx, logger, tx, scheduledTasksTable, attributes, "guid = ?", scheduleGuid)
+		if err != nil {
+			logger.Error("failed-updating-scheduled-task", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return schedule, err
+}
+
+func (db *SQLDB) DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error {
+	logger = logger.Session("db-delete-scheduled-task", lager.Data{"schedule_guid": scheduleGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		result, err := db.delete(ctx, logger, tx, scheduledTasksTable, "guid = ?", scheduleGuid)
+		if err != nil {
+			logger.Error("failed-deleting-scheduled-task", err)
+			return err
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			logger.Error("failed-getting-rows-affected", err)
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return models.ErrResourceNotFound
+		}
+
+		return nil
+	})
+}
+
+// scheduledTaskAttributes returns the columns of the schedule that can
+// change after it was desired.
+func (db *SQLDB) scheduledTaskAttributes(logger lager.Logger, schedule *models.ScheduledTask) (helpers.SQLAttributes, error) {
+	taskDefData, err := db.serializeModel(logger, schedule.TaskDefinition)
+	if err != nil {
+		logger.Error("failed-serializing-task-definition", err)
+		return nil, err
+	}
+
+	recentRunsData, err := json.Marshal(schedule.RecentRuns)
+	if err != nil {
+		logger.Error("failed-encoding-recent-runs", err)
+		return nil, err
+	}
+
+	return helpers.SQLAttributes{
+		"cron_expression":    schedule.CronExpression,
+		"time_zone":          schedule.TimeZone,
+		"concurrency_policy": schedule.ConcurrencyPolicy,
+		"history_limit":      schedule.HistoryLimit,
+		"suspended":          schedule.Suspended,
+		"task_definition":    taskDefData,
+		"recent_runs":        recentRunsData,
+		"next_run_at":        schedule.NextRunAt,
+		"updated_at":         schedule.UpdatedAt,
+	}, nil
+}
+
+func (db *SQLDB) fetchScheduledTask(logger lager.Logger, scanner helpers.RowScanner) (*models.ScheduledTask, error) {
+	schedule := &models.ScheduledTask{}
+	var taskDefData, recentRunsData []byte
+	err := scanner.Scan(
+		&schedule.ScheduleGuid,
+		&schedule.Domain,
+		&schedule.CronExpression,
+		&schedule.TimeZone,
+		&schedule.ConcurrencyPolicy,
+		&schedule.HistoryLimit,
+		&schedule.Suspended,
+		&taskDefData,
+		&recentRunsData,
+		&schedule.NextRunAt,
+		&schedule.CreatedAt,
+		&schedule.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	schedule.TaskDefinition = &models.TaskDefinition{}
+	err = db.deserializeModel(logger, taskDefData, schedule.TaskDefinition)
+	if err != nil {
+		return nil, models.ErrDeserialize
+	}
+
+	if len(recentRunsData) > 0 {
+		err = json.Unmarshal(recentRunsData, &schedule.RecentRuns)
+		if err != nil {
+			logger.Error("failed-parsing-recent-runs", err)
+			return nil, models.ErrDeserialize
+		}
+	}
+
+	return schedule, nil
+}
+
+// scheduledNextRunAt returns the next run of the schedule after now, or 0
+// when the schedule never fires.
+func scheduledNextRunAt(schedule *models.ScheduledTask, now time.Time) (int64, error) {
+	next, err := schedule.NextRunAfter(now)
+	if err != nil {
+		return 0, models.NewError(models.Error_InvalidRequest, err.Error())
+	}
+	if next.IsZero() {
+		return 0, nil
+	}
+	return next.UnixNano(), nil
+}
+
+func copyScheduledTask(schedule *models.ScheduledTask) *models.ScheduledTask {
+	copied := *schedule
+	return &copied
+}
@@ -0,0 +1,157 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTaskDB", func() {
+	var schedule *models.ScheduledTask
+
+	BeforeEach(func() {
+		schedule = &models.ScheduledTask{
+			ScheduleGuid:   "nightly",
+			Domain:         "some-domain",
+			CronExpression: "*/5 * * * *",
+			TaskDefinition: model_helpers.NewValidTaskDefinition(),
+		}
+	})
+
+	Describe("DesireScheduledTask", func() {
+		It("stores the schedule with its next run", func() {
+			desired, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			next, err := schedule.NextRunAfter(fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desired.NextRunAt).To(Equal(next.UnixNano()))
+			Expect(desired.CreatedAt).To(Equal(fakeClock.Now().UnixNano()))
+
+			fetched, err := sqlDB.ScheduledTaskByGuid(ctx, logger, "nightly")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fetched).To(Equal(desired))
+		})
+
+		It("does not desire the same schedule twice", func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).To(Equal(models.ErrResourceExists))
+		})
+	})
+
+	Describe("ScheduledTasks", func() {
+		It("filters the schedules by domain", func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			other := *schedule
+			other.ScheduleGuid = "hourly"
+			other.Domain = "other-domain"
+			_, err = sqlDB.DesireScheduledTask(ctx, logger, &other)
+			Expect(err).NotTo(HaveOccurred())
+
+			schedules, err := sqlDB.ScheduledTasks(ctx, logger, "other-domain")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedules).To(HaveLen(1))
+			Expect(schedules[0].ScheduleGuid).To(Equal("hourly"))
+
+			schedules, err = sqlDB.ScheduledTasks(ctx, logger, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schedules).To(HaveLen(2))
+		})
+	})
+
+	Describe("DueScheduledTasks", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the schedules whose next run has passed", func() {
+			due, err := sqlDB.DueScheduledTasks(ctx, logger, fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(due).To(BeEmpty())
+
+			due, err = sqlDB.DueScheduledTasks(ctx, logger, fakeClock.Now().Add(5*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(due).To(HaveLen(1))
+		})
+
+		It("does not return suspended schedules", func() {
+			_, err := sqlDB.SetScheduledTaskSuspended(ctx, logger, "nightly", true)
+			Expect(err).NotTo(HaveOccurred())
+
+			due, err := sqlDB.DueScheduledTasks(ctx, logger, fakeClock.Now().Add(5*time.Minute))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(due).To(BeEmpty())
+		})
+	})
+
+	Describe("UpdateScheduledTask", func() {
+		It("recomputes the next run when the cron expression changes", func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			fakeClock.Increment(time.Hour)
+			update := &models.ScheduledTaskUpdate{}
+			update.SetCronExpression("@daily")
+			updated, err := sqlDB.UpdateScheduledTask(ctx, logger, "nightly", update)
+			Expect(err).NotTo(HaveOccurred())
+
+			next, err := updated.NextRunAfter(fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.NextRunAt).To(Equal(next.UnixNano()))
+		})
+
+		It("returns not found for unknown schedules", func() {
+			_, err := sqlDB.UpdateScheduledTask(ctx, logger, "unknown", &models.ScheduledTaskUpdate{})
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("RecordScheduledTaskRun", func() {
+		var desired *models.ScheduledTask
+
+		BeforeEach(func() {
+			var err error
+			desired, err = sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the run and moves the next run on", func() {
+			run := &models.ScheduledTaskRun{TaskGuid: desired.TaskGuidForRun(desired.NextRunAt), ScheduledAt: desired.NextRunAt}
+			recorded, err := sqlDB.RecordScheduledTaskRun(ctx, logger, "nightly", desired.NextRunAt, run, desired.NextRunAt+1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.RecentRuns).To(Equal([]*models.ScheduledTaskRun{run}))
+			Expect(recorded.NextRunAt).To(Equal(desired.NextRunAt + 1))
+		})
+
+		It("does not record a run that was already recorded", func() {
+			run := &models.ScheduledTaskRun{TaskGuid: "some-task", ScheduledAt: desired.NextRunAt}
+			_, err := sqlDB.RecordScheduledTaskRun(ctx, logger, "nightly", desired.NextRunAt, run, desired.NextRunAt+1)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.RecordScheduledTaskRun(ctx, logger, "nightly", desired.NextRunAt, run, desired.NextRunAt+1)
+			Expect(err).To(Equal(models.ErrResourceConflict))
+		})
+	})
+
+	Describe("DeleteScheduledTask", func() {
+		It("removes the schedule", func() {
+			_, err := sqlDB.DesireScheduledTask(ctx, logger, schedule)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.DeleteScheduledTask(ctx, logger, "nightly")).To(Succeed())
+
+			_, err = sqlDB.ScheduledTaskByGuid(ctx, logger, "nightly")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+			Expect(sqlDB.DeleteScheduledTask(ctx, logger, "nightly")).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
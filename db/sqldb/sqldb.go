@@ -3,6 +3,7 @@ package sqldb
 import (
 	"context"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/encryption"
 	"code.cloudfoundry.org/bbs/format"
@@ -56,8 +57,41 @@ func NewSQLDB(
 	}
 }
 
+// transact gives the transaction a resource version when its writes change
+// rows of a versioned table. The version is allocated last, so that the lock
+// on the counter is only held while the transaction commits, and is recorded
+// in the WriteVersion of the context once it has committed.
 func (db *SQLDB) transact(ctx context.Context, logger lager.Logger, f func(logger lager.Logger, tx helpers.Tx) error) error {
-	err := db.helper.Transact(ctx, logger, db.db, f)
+	var version uint64
+	err := db.helper.Transact(ctx, logger, db.db, func(logger lager.Logger, tx helpers.Tx) error {
+		version = 0
+		versionedTx := &versionedTx{Tx: tx}
+		err := f(logger, versionedTx)
+		if err != nil || !versionedTx.changed {
+			return err
+		}
+
+		version, err = db.nextResourceVersion(ctx, logger, tx)
+		return err
+	})
+	if err != nil {
+		return db.convertSQLError(err)
+	}
+
+	if writeVersion, ok := bbsdb.WriteVersionFromContext(ctx); ok && version > 0 {
+		writeVersion.Record(version)
+	}
+	return nil
+}
+
+// readSnapshot runs f in a transaction whose reads all see the database as it
+// was at the first one, so that a list is consistent with the resource
+// version read before it. The invalid records removed while reading are not
+// given a resource version.
+func (db *SQLDB) readSnapshot(ctx context.Context, logger lager.Logger, f func(logger lager.Logger, tx helpers.Tx) error) error {
+	err := db.helper.TransactSnapshot(ctx, logger, db.db, func(logger lager.Logger, tx helpers.Tx) error {
+		return f(logger, &versionedTx{Tx: tx})
+	})
 	if err != nil {
 		return db.convertSQLError(err)
 	}
@@ -199,6 +199,18 @@ var truncateTablesSQL = []string{
 	"TRUNCATE TABLE desired_lrps",
 	"TRUNCATE TABLE actual_lrps",
 	"TRUNCATE TABLE configurations",
+	"TRUNCATE TABLE event_log",
+	"TRUNCATE TABLE desired_lrp_labels",
+	"TRUNCATE TABLE task_labels",
+	"TRUNCATE TABLE task_dependencies",
+	"TRUNCATE TABLE deployments",
+	"TRUNCATE TABLE scheduled_tasks",
+	"TRUNCATE TABLE task_callbacks",
+	"TRUNCATE TABLE domain_quotas",
+	"TRUNCATE TABLE audit_records",
+	"TRUNCATE TABLE idempotency_keys",
+	"TRUNCATE TABLE actual_lrp_history",
+	"TRUNCATE TABLE cells",
 }
 
 func randStr(strSize int) string {
@@ -0,0 +1,247 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// notInTaskCallbacks restricts a query on the tasks table to the tasks
+// without a callback in the outbox.
+const notInTaskCallbacks = "guid NOT IN (SELECT task_guid FROM " + taskCallbacksTable + ")"
+
+func (db *SQLDB) TaskCallbacks(ctx context.Context, logger lager.Logger, deadLettered bool) ([]*models.TaskCallback, error) {
+	logger = logger.Session("db-task-callbacks", lager.Data{"dead_lettered": deadLettered})
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	wheres := ""
+	values := []interface{}{}
+	if deadLettered {
+		wheres = "state = ?"
+		values = append(values, models.TaskCallback_DeadLettered)
+	}
+
+	return db.selectTaskCallbacks(ctx, logger, db.db, 0, wheres, values...)
+}
+
+func (db *SQLDB) EnqueueTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string, claimedUntil time.Time) (*models.TaskCallback, error) {
+	logger = logger.Session("db-enqueue-task-callback", lager.Data{"task_guid": taskGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	now := db.clock.Now().UnixNano()
+	callback := &models.TaskCallback{
+		TaskGuid:      taskGuid,
+		State:         models.TaskCallback_Pending,
+		NextAttemptAt: claimedUntil.UnixNano(),
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.insert(ctx, logger, tx, taskCallbacksTable, helpers.SQLAttributes{
+			"task_guid":       callback.TaskGuid,
+			"state":           callback.State,
+			"attempts":        callback.Attempts,
+			"next_attempt_at": callback.NextAttemptAt,
+			"created_at":      callback.CreatedAt,
+			"updated_at":      callback.UpdatedAt,
+		})
+		if err != nil {
+			logger.Error("failed-inserting-task-callback", err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+func (db *SQLDB) ClaimDueTaskCallbacks(ctx context.Context, logger lager.Logger, now, claimedUntil time.Time, limit int) ([]*models.TaskCallback, error) {
+	logger = logger.Session("db-claim-due-task-callbacks")
+	logger.Debug("starting")
+	defer logger.Debug("complete")
+
+	due, err := db.selectTaskCallbacks(ctx, logger, db.db, limit,
+		"state = ? AND next_attempt_at <= ?", models.TaskCallback_Pending, now.UnixNano(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := []*models.TaskCallback{}
+	for _, callback := range due {
+		// another delivery may have claimed the callback since it was read
+		result, err := db.update(ctx, logger, db.db, taskCallbacksTable,
+			helpers.SQLAttributes{
+				"next_attempt_at": claimedUntil.UnixNano(),
+				"updated_at":      now.UnixNano(),
+			},
+			"task_guid = ? AND state = ? AND next_attempt_at = ?",
+			callback.TaskGuid, models.TaskCallback_Pending, callback.NextAttemptAt,
+		)
+		if err != nil {
+			logger.Error("failed-claiming-task-callback", err, lager.Data{"task_guid": callback.TaskGuid})
+			continue
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil || rowsAffected == 0 {
+			continue
+		}
+
+		callback.NextAttemptAt = claimedUntil.UnixNano()
+		callback.UpdatedAt = now.UnixNano()
+		claimed = append(claimed, callback)
+	}
+
+	return claimed, nil
+}
+
+func (db *SQLDB) RecordTaskCallbackAttempt(ctx context.Context, logger lager.Logger, taskGuid string, statusCode int32, lastError string, nextAttemptAt time.Time, deadLetter bool) (*models.TaskCallback, error) {
+	logger = logger.Session("db-record-task-callback-attempt", lager.Data{"task_guid": taskGuid, "status_code": statusCode, "dead_letter": deadLetter})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.modifyTaskCallback(ctx, logger, taskGuid, func(callback *models.TaskCallback) {
+		callback.Attempts++
+		callback.LastStatusCode = statusCode
+		callback.LastError = truncateString(lastError, 1024)
+		callback.NextAttemptAt = nextAttemptAt.UnixNano()
+		if deadLetter {
+			callback.State = models.TaskCallback_DeadLettered
+		}
+	})
+}
+
+func (db *SQLDB) ReplayTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) (*models.TaskCallback, error) {
+	logger = logger.Session("db-replay-task-callback", lager.Data{"task_guid": taskGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	now := db.clock.Now().UnixNano()
+	return db.modifyTaskCallback(ctx, logger, taskGuid, func(callback *models.TaskCallback) {
+		callback.State = models.TaskCallback_Pending
+		callback.Attempts = 0
+		callback.NextAttemptAt = now
+	})
+}
+
+func (db *SQLDB) DeleteTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) error {
+	logger = logger.Session("db-delete-task-callback", lager.Data{"task_guid": taskGuid})
+	logger.Info("starting")
+	defer logger.Info("complete")
+
+	return db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		_, err := db.delete(ctx, logger, tx, taskCallbacksTable, "task_guid = ?", taskGuid)
+		if err != nil {
+			logger.Error("failed-deleting-task-callback", err)
+			return err
+		}
+		return nil
+	})
+}
+
+// modifyTaskCallback applies modify to the locked callback and stores the
+// result.
+func (db *SQLDB) modifyTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string, modify func(*models.TaskCallback)) (*models.TaskCallback, error) {
+	var callback *models.TaskCallback
+	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		row := db.one(ctx, logger, tx, taskCallbacksTable,
+			taskCallbackColumns, helpers.LockRow,
+			"task_guid = ?", taskGuid,
+		)
+		callback, err = db.fetchTaskCallback(logger, row)
+		if err != nil {
+			logger.Error("failed-fetching-task-callback", err)
+			return err
+		}
+
+		modify(callback)
+		callback.UpdatedAt = db.clock.Now().UnixNano()
+
+		_, err = db.update(ctx, logger, tx, taskCallbacksTable,
+			helpers.SQLAttributes{
+				"state":            callback.State,
+				"attempts":         callback.Attempts,
+				"next_attempt_at":  callback.NextAttemptAt,
+				"last_status_code": callback.LastStatusCode,
+				"last_error":       callback.LastError,
+				"updated_at":       callback.UpdatedAt,
+			},
+			"task_guid = ?", taskGuid,
+		)
+		if err != nil {
+			logger.Error("failed-updating-task-callback", err)
+			return err
+		}
+
+		return nil
+	})
+
+	return callback, err
+}
+
+func (db *SQLDB) selectTaskCallbacks(ctx context.Context, logger lager.Logger, q helpers.Queryable, limit int, wheres string, values ...interface{}) ([]*models.TaskCallback, error) {
+	rows, err := db.allPaginated(ctx, logger, q, taskCallbacksTable,
+		taskCallbackColumns,
+		helpers.ColumnList{taskCallbacksTable + ".next_attempt_at", taskCallbacksTable + ".task_guid"},
+		nil, int32(limit),
+		wheres, values...,
+	)
+	if err != nil {
+		logger.Error("failed-query", err)
+		return nil, db.convertSQLError(err)
+	}
+	defer rows.Close()
+
+	callbacks := []*models.TaskCallback{}
+	for rows.Next() {
+		callback, err := db.fetchTaskCallback(logger, rows)
+		if err != nil {
+			logger.Error("failed-reading-row", err)
+			continue
+		}
+		callbacks = append(callbacks, callback)
+	}
+
+	if rows.Err() != nil {
+		logger.Error("failed-fetching-row", rows.Err())
+		return nil, db.convertSQLError(rows.Err())
+	}
+
+	return callbacks, nil
+}
+
+func (db *SQLDB) fetchTaskCallback(logger lager.Logger, scanner helpers.RowScanner) (*models.TaskCallback, error) {
+	callback := &models.TaskCallback{}
+	err := scanner.Scan(
+		&callback.TaskGuid,
+		&callback.State,
+		&callback.Attempts,
+		&callback.NextAttemptAt,
+		&callback.LastStatusCode,
+		&callback.LastError,
+		&callback.CreatedAt,
+		&callback.UpdatedAt,
+	)
+	if err == sql.ErrNoRows {
+		return nil, err
+	}
+
+	if err != nil {
+		logger.Error("failed-scanning", err)
+		return nil, err
+	}
+
+	return callback, nil
+}
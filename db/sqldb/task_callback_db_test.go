@@ -0,0 +1,131 @@
+package sqldb_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskCallbackDB", func() {
+	var claimedUntil time.Time
+
+	BeforeEach(func() {
+		claimedUntil = fakeClock.Now().Add(time.Minute)
+	})
+
+	Describe("EnqueueTaskCallback", func() {
+		It("stores a pending callback claimed by the caller", func() {
+			callback, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callback.State).To(Equal(models.TaskCallback_Pending))
+			Expect(callback.NextAttemptAt).To(Equal(claimedUntil.UnixNano()))
+
+			callbacks, err := sqlDB.TaskCallbacks(ctx, logger, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callbacks).To(Equal([]*models.TaskCallback{callback}))
+		})
+
+		It("does not enqueue the same callback twice", func() {
+			_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).To(Equal(models.ErrResourceExists))
+		})
+	})
+
+	Describe("ClaimDueTaskCallbacks", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", fakeClock.Now())
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.EnqueueTaskCallback(ctx, logger, "other-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("claims the callbacks whose next attempt is due", func() {
+			claimed, err := sqlDB.ClaimDueTaskCallbacks(ctx, logger, fakeClock.Now(), claimedUntil, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+			Expect(claimed[0].TaskGuid).To(Equal("some-task"))
+			Expect(claimed[0].NextAttemptAt).To(Equal(claimedUntil.UnixNano()))
+
+			claimed, err = sqlDB.ClaimDueTaskCallbacks(ctx, logger, fakeClock.Now(), claimedUntil, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+
+		It("does not claim dead-lettered callbacks", func() {
+			_, err := sqlDB.RecordTaskCallbackAttempt(ctx, logger, "some-task", 503, "", fakeClock.Now(), true)
+			Expect(err).NotTo(HaveOccurred())
+
+			claimed, err := sqlDB.ClaimDueTaskCallbacks(ctx, logger, fakeClock.Now(), claimedUntil, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+	})
+
+	Describe("RecordTaskCallbackAttempt", func() {
+		BeforeEach(func() {
+			_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("records the outcome of the attempt", func() {
+			nextAttemptAt := fakeClock.Now().Add(2 * time.Second)
+			callback, err := sqlDB.RecordTaskCallbackAttempt(ctx, logger, "some-task", 0, "connection refused", nextAttemptAt, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callback.Attempts).To(BeEquivalentTo(1))
+			Expect(callback.LastError).To(Equal("connection refused"))
+			Expect(callback.NextAttemptAt).To(Equal(nextAttemptAt.UnixNano()))
+			Expect(callback.State).To(Equal(models.TaskCallback_Pending))
+		})
+
+		It("dead-letters the callback", func() {
+			_, err := sqlDB.RecordTaskCallbackAttempt(ctx, logger, "some-task", 503, "", fakeClock.Now(), true)
+			Expect(err).NotTo(HaveOccurred())
+
+			callbacks, err := sqlDB.TaskCallbacks(ctx, logger, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callbacks).To(HaveLen(1))
+			Expect(callbacks[0].LastStatusCode).To(BeEquivalentTo(503))
+		})
+	})
+
+	Describe("ReplayTaskCallback", func() {
+		It("makes a dead-lettered callback due again", func() {
+			_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = sqlDB.RecordTaskCallbackAttempt(ctx, logger, "some-task", 503, "", claimedUntil, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			callback, err := sqlDB.ReplayTaskCallback(ctx, logger, "some-task")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callback.State).To(Equal(models.TaskCallback_Pending))
+			Expect(callback.Attempts).To(BeZero())
+
+			claimed, err := sqlDB.ClaimDueTaskCallbacks(ctx, logger, fakeClock.Now(), claimedUntil, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+		})
+
+		It("returns not found for unknown callbacks", func() {
+			_, err := sqlDB.ReplayTaskCallback(ctx, logger, "unknown")
+			Expect(err).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("DeleteTaskCallback", func() {
+		It("removes the callback", func() {
+			_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "some-task", claimedUntil)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(sqlDB.DeleteTaskCallback(ctx, logger, "some-task")).To(Succeed())
+
+			callbacks, err := sqlDB.TaskCallbacks(ctx, logger, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(callbacks).To(BeEmpty())
+		})
+	})
+})
@@ -26,6 +26,13 @@ func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cell
 
 	convergenceResult := db.TaskConvergenceResult{}
 
+	// do this first so that promoted tasks are auctioned below, and so that
+	// completed parents are looked at before they expire and are deleted
+	// promotedEvents is a list of waiting tasks that have either become pending or failed because of their parents
+	promotedEvents, failedFetches := sqldb.promoteWaitingTasks(ctx, logger)
+	convergenceResult.Events = append(convergenceResult.Events, promotedEvents...)
+	convergenceResult.Metrics.TasksPruned += failedFetches
+
 	// failedEvents is a list of tasks that have transitioned from the pending to the completed state (but expired and failed)
 	// failedFetches are tasks that failed to deserialize (invalid task def)
 	// rowsAffected are the number of pending tasks that have expired
@@ -70,6 +77,124 @@ func (sqldb *SQLDB) ConvergeTasks(ctx context.Context, logger lager.Logger, cell
 	return convergenceResult
 }
 
+// promoteWaitingTasks moves waiting tasks on once their parents have
+// completed. A task whose policy requires its parents to succeed fails as
+// soon as one of them fails, which may in turn fail its own children, so the
+// waiting tasks are looked at again until no more failures cascade.
+func (db *SQLDB) promoteWaitingTasks(ctx context.Context, logger lager.Logger) ([]models.Event, uint64) {
+	logger = logger.Session("promote-waiting-tasks")
+
+	var events []models.Event
+	var invalidTasksCount uint64
+
+	for {
+		rows, err := db.all(ctx, logger, db.db, tasksTable,
+			taskColumns, helpers.NoLockRow,
+			"state = ?", models.Task_Waiting,
+		)
+		if err != nil {
+			logger.Error("failed-query", err)
+			return events, invalidTasksCount
+		}
+
+		tasks, _, invalidCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
+		if err != nil {
+			logger.Error("failed-fetching-some-tasks", err)
+		}
+		invalidTasksCount += uint64(invalidCount)
+
+		if len(tasks) == 0 {
+			return events, invalidTasksCount
+		}
+
+		outcomes, err := db.parentOutcomes(ctx, logger, tasks)
+		if err != nil {
+			return events, invalidTasksCount
+		}
+
+		now := db.clock.Now().UnixNano()
+		cascaded := false
+		for _, task := range tasks {
+			failedParent := ""
+			finished := true
+			if task.RetryAt > 0 {
+				// the task is waiting out the backoff of a failed attempt;
+				// it is only looked at on convergence passes, so the wait
+				// is rounded up to the next pass after its retry time
+				finished = task.RetryAt <= now
+			} else {
+				for _, parentGuid := range task.DependsOn {
+					switch outcomes[task.TaskGuid][parentGuid] {
+					case parentUnfinished:
+						finished = false
+					case parentFailed:
+						if failedParent == "" {
+							failedParent = parentGuid
+						}
+					}
+				}
+			}
+
+			afterTask := *task
+			var updates helpers.SQLAttributes
+			if failedParent != "" && task.DependencyPolicy == models.TaskDefinition_RequireSuccess {
+				afterTask.Failed = true
+				afterTask.FailureReason = fmt.Sprintf("parent task %s failed", failedParent)
+				afterTask.Result = ""
+				afterTask.State = models.Task_Completed
+				afterTask.FirstCompletedAt = now
+				afterTask.UpdatedAt = now
+				updates = helpers.SQLAttributes{
+					"failed":             true,
+					"failure_reason":     afterTask.FailureReason,
+					"result":             "",
+					"state":              models.Task_Completed,
+					"first_completed_at": now,
+					"updated_at":         now,
+				}
+			} else if finished {
+				afterTask.State = models.Task_Pending
+				afterTask.RetryAt = 0
+				afterTask.UpdatedAt = now
+				updates = helpers.SQLAttributes{
+					"state":       models.Task_Pending,
+					"promoted_at": now,
+					"retry_at":    0,
+					"updated_at":  now,
+				}
+			} else {
+				continue
+			}
+
+			result, err := db.update(ctx, logger, db.db, tasksTable, updates,
+				"guid = ? AND state = ?", task.TaskGuid, models.Task_Waiting,
+			)
+			if err != nil {
+				logger.Error("failed-updating-task", err, lager.Data{"task_guid": task.TaskGuid})
+				continue
+			}
+
+			// the task may have been cancelled in the meantime
+			rowsAffected, err := result.RowsAffected()
+			if err != nil || rowsAffected == 0 {
+				continue
+			}
+
+			if afterTask.State == models.Task_Completed {
+				logger.Info("failed-task-with-failed-parent", lager.Data{"task_guid": task.TaskGuid, "parent_guid": failedParent})
+				cascaded = true
+			} else {
+				logger.Info("promoted-task", lager.Data{"task_guid": task.TaskGuid})
+			}
+			events = append(events, models.NewTaskChangedEvent(task, &afterTask))
+		}
+
+		if !cascaded {
+			return events, invalidTasksCount
+		}
+	}
+}
+
 func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]models.Event, uint64, int64) {
 	logger = logger.Session("fail-expired-pending-tasks")
 
@@ -77,23 +202,26 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 
 	rows, err := db.all(ctx, logger, db.db, tasksTable,
 		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at < ?", models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano())
+		"state = ? AND created_at < ? AND promoted_at < ?", models.Task_Pending,
+		now.Add(-expirePendingTaskDuration).UnixNano(), now.Add(-expirePendingTaskDuration).UnixNano())
 	if err != nil {
 		logger.Error("failed-query", err)
 		return nil, 0, 0
 	}
 	defer rows.Close()
 
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
+	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
 	if err != nil {
 		logger.Error("failed-fetching-some-tasks", err)
 	}
 
-	wheres := []string{"state = ?", "created_at < ?"}
-	bindings := []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano()}
+	events, tasks, validTaskGuids := db.retryFailedTasks(ctx, logger, tasks, expiredFailureReason)
+
+	wheres := []string{"state = ?", "created_at < ?", "promoted_at < ?"}
+	bindings := []interface{}{models.Task_Pending, now.Add(-expirePendingTaskDuration).UnixNano(), now.Add(-expirePendingTaskDuration).UnixNano()}
 
 	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount), 0
+		return events, uint64(invalidTasksCount), 0
 	}
 
 	wheres = append(wheres, fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids))))
@@ -113,10 +241,9 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 		strings.Join(wheres, " AND "), bindings...)
 	if err != nil {
 		logger.Error("failed-query", err)
-		return nil, uint64(invalidTasksCount), 0
+		return events, uint64(invalidTasksCount), 0
 	}
 
-	var events []models.Event
 	for _, task := range tasks {
 		afterTask := *task
 		afterTask.Failed = true
@@ -137,13 +264,43 @@ func (db *SQLDB) failExpiredPendingTasks(ctx context.Context, logger lager.Logge
 	return events, uint64(invalidTasksCount), rowsAffected
 }
 
+// retryFailedTasks retries the tasks whose retry policy allows another
+// attempt after failing with failureReason. It returns the events of the
+// retried tasks, and the remaining tasks and their guids, which are to be
+// failed.
+func (db *SQLDB) retryFailedTasks(ctx context.Context, logger lager.Logger, tasks []*models.Task, failureReason string) ([]models.Event, []*models.Task, []string) {
+	var events []models.Event
+	remaining := make([]*models.Task, 0, len(tasks))
+	remainingGuids := make([]string, 0, len(tasks))
+
+	for _, task := range tasks {
+		backoff, retry := task.RetryBackoff(failureReason)
+		if !retry {
+			remaining = append(remaining, task)
+			remainingGuids = append(remainingGuids, task.TaskGuid)
+			continue
+		}
+
+		afterTask := *task
+		retried, err := db.retryTask(ctx, logger, db.db, &afterTask, failureReason, backoff)
+		if err != nil || !retried {
+			continue
+		}
+
+		logger.Info("retrying-failed-task", lager.Data{"task_guid": task.TaskGuid, "backoff": backoff.String()})
+		events = append(events, models.NewTaskChangedEvent(task, &afterTask))
+	}
+
+	return events, remaining, remainingGuids
+}
+
 func (db *SQLDB) getTaskStartRequestsForKickablePendingTasks(ctx context.Context, logger lager.Logger, expirePendingTaskDuration time.Duration) ([]*auctioneer.TaskStartRequest, uint64) {
 	logger = logger.Session("get-task-start-requests-for-kickable-pending-tasks")
 
 	rows, err := db.all(ctx, logger, db.db, tasksTable,
 		taskColumns, helpers.NoLockRow,
-		"state = ? AND created_at > ?",
-		models.Task_Pending, db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
+		"state = ? AND (created_at > ? OR promoted_at > ?)",
+		models.Task_Pending, db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(), db.clock.Now().Add(-expirePendingTaskDuration).UnixNano(),
 	)
 
 	if err != nil {
@@ -190,13 +347,15 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	}
 	defer rows.Close()
 
-	tasks, validTaskGuids, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
+	tasks, _, invalidTasksCount, err := db.fetchTasks(ctx, logger, rows, db.db, false)
 	if err != nil {
 		logger.Error("failed-fetching-tasks", err)
 	}
 
+	events, tasks, validTaskGuids := db.retryFailedTasks(ctx, logger, tasks, cellDisappearedFailureReason)
+
 	if len(validTaskGuids) == 0 {
-		return nil, uint64(invalidTasksCount), 0
+		return events, uint64(invalidTasksCount), 0
 	}
 
 	wheres += fmt.Sprintf(" AND guid IN (%s)", helpers.QuestionMarks(len(validTaskGuids)))
@@ -218,10 +377,9 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 	)
 	if err != nil {
 		logger.Error("failed-updating-tasks", err)
-		return nil, uint64(invalidTasksCount), 0
+		return events, uint64(invalidTasksCount), 0
 	}
 
-	var events []models.Event
 	for _, task := range tasks {
 		afterTask := *task
 		afterTask.Failed = true
@@ -246,9 +404,10 @@ func (db *SQLDB) failTasksWithDisappearedCells(ctx context.Context, logger lager
 func (db *SQLDB) demoteKickableResolvingTasks(ctx context.Context, logger lager.Logger, kickTasksDuration time.Duration) ([]models.Event, uint64) {
 	logger = logger.Session("demote-kickable-resolving-tasks")
 
+	// the callbacks of tasks in the outbox are retried by its dispatcher
 	rows, err := db.all(ctx, logger, db.db, tasksTable,
 		taskColumns, helpers.NoLockRow,
-		"state = ? AND updated_at < ?", models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano(),
+		"state = ? AND updated_at < ? AND "+notInTaskCallbacks, models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano(),
 	)
 	if err != nil {
 		logger.Error("failed-query", err)
@@ -261,7 +420,7 @@ func (db *SQLDB) demoteKickableResolvingTasks(ctx context.Context, logger lager.
 		logger.Error("failed-fetching-tasks", err)
 	}
 
-	wheres := []string{"state = ?", "updated_at < ?"}
+	wheres := []string{"state = ?", "updated_at < ?", notInTaskCallbacks}
 	bindings := []interface{}{models.Task_Resolving, db.clock.Now().Add(-kickTasksDuration).UnixNano()}
 
 	if len(validTaskGuids) == 0 {
@@ -328,6 +487,13 @@ func (db *SQLDB) deleteExpiredCompletedTasks(ctx context.Context, logger lager.L
 		return nil, int64(invalidTasksCount)
 	}
 
+	// #nosec G104 - failures are logged, and children that miss the outcome of a deleted parent treat it as failed
+	db.recordParentOutcomes(ctx, logger, db.db, tasks...)
+	// #nosec G104 - failures are logged, and leftover dependencies are only read for waiting tasks
+	db.removeTaskDependencies(ctx, logger, db.db, validTaskGuids...)
+	// #nosec G104 - failures are logged, and leftover labels are replaced if the guid is reused
+	taskLabels.remove(ctx, logger, db, db.db, validTaskGuids...)
+
 	var events []models.Event
 	for _, task := range tasks {
 		events = append(events, models.NewTaskRemovedEvent(task))
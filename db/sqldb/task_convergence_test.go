@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 )
 
 var _ = Describe("Convergence of Tasks", func() {
@@ -310,6 +311,175 @@ var _ = Describe("Convergence of Tasks", func() {
 			})
 		})
 
+		Context("waiting tasks", func() {
+			var childDef, anyCompletionDef *models.TaskDefinition
+
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, "parent-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "parent-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+
+				childDef = model_helpers.NewValidTaskDefinition()
+				childDef.DependsOn = []string{"parent-task"}
+				_, err = sqlDB.DesireTask(ctx, logger, childDef, "child-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				grandchildDef := model_helpers.NewValidTaskDefinition()
+				grandchildDef.DependsOn = []string{"child-task"}
+				_, err = sqlDB.DesireTask(ctx, logger, grandchildDef, "grandchild-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+
+				anyCompletionDef = model_helpers.NewValidTaskDefinition()
+				anyCompletionDef.DependsOn = []string{"parent-task"}
+				anyCompletionDef.DependencyPolicy = models.TaskDefinition_RequireCompletion
+				_, err = sqlDB.DesireTask(ctx, logger, anyCompletionDef, "any-completion-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("while the parent is running", func() {
+				It("leaves the children waiting", func() {
+					for _, guid := range []string{"child-task", "grandchild-task", "any-completion-task"} {
+						task, err := sqlDB.TaskByGuid(ctx, logger, guid)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Waiting))
+					}
+					Expect(convergenceResult.TasksToAuction).To(BeEmpty())
+				})
+			})
+
+			Context("when the parent succeeds", func() {
+				BeforeEach(func() {
+					_, _, err := sqlDB.CompleteTask(ctx, logger, "parent-task", existingCellID, false, "", "")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("promotes the children to pending and auctions them", func() {
+					for _, guid := range []string{"child-task", "any-completion-task"} {
+						task, err := sqlDB.TaskByGuid(ctx, logger, guid)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Pending))
+					}
+
+					Expect(convergenceResult.TasksToAuction).To(ConsistOf(
+						PointTo(Equal(auctioneer.NewTaskStartRequestFromModel("child-task", domain, childDef))),
+						PointTo(Equal(auctioneer.NewTaskStartRequestFromModel("any-completion-task", domain, anyCompletionDef))),
+					))
+				})
+
+				It("leaves the grandchild waiting", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "grandchild-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Waiting))
+				})
+
+				Context("and has been deleted", func() {
+					BeforeEach(func() {
+						_, _, err := sqlDB.ResolvingTask(ctx, logger, "parent-task")
+						Expect(err).NotTo(HaveOccurred())
+						_, err = sqlDB.DeleteTask(ctx, logger, "parent-task")
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("still promotes the children", func() {
+						task, err := sqlDB.TaskByGuid(ctx, logger, "child-task")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(task.State).To(Equal(models.Task_Pending))
+					})
+				})
+			})
+
+			Context("when the parent fails", func() {
+				BeforeEach(func() {
+					_, _, err := sqlDB.CompleteTask(ctx, logger, "parent-task", existingCellID, true, "boom", "")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("cascades the failure to the tasks that require success", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "child-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.Failed).To(BeTrue())
+					Expect(task.FailureReason).To(Equal("parent task parent-task failed"))
+
+					task, err = sqlDB.TaskByGuid(ctx, logger, "grandchild-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Completed))
+					Expect(task.FailureReason).To(Equal("parent task child-task failed"))
+				})
+
+				It("promotes the tasks that only require completion", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "any-completion-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(convergenceResult.TasksToAuction).To(HaveLen(1))
+				})
+
+				It("returns TaskChangedEvents for the failed and promoted tasks", func() {
+					Expect(convergenceResult.Events).To(HaveLen(3))
+				})
+			})
+		})
+
+		Context("retried tasks", func() {
+			var retryDef *models.TaskDefinition
+
+			BeforeEach(func() {
+				retryDef = model_helpers.NewValidTaskDefinition()
+				retryDef.RetryPolicy = &models.TaskRetryPolicy{MaxAttempts: 3, InitialBackoffMs: 1000}
+				_, err := sqlDB.DesireTask(ctx, logger, retryDef, "retried-task", domain)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, _, err = sqlDB.StartTask(ctx, logger, "retried-task", existingCellID)
+				Expect(err).NotTo(HaveOccurred())
+				_, _, err = sqlDB.CompleteTask(ctx, logger, "retried-task", existingCellID, true, "boom", "")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			Context("during the backoff", func() {
+				It("leaves the task waiting", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "retried-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Waiting))
+					Expect(convergenceResult.TasksToAuction).To(BeEmpty())
+				})
+			})
+
+			Context("once the backoff has passed", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(time.Second)
+				})
+
+				It("promotes the task to pending and auctions it", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "retried-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Pending))
+					Expect(task.RetryAt).To(BeZero())
+					Expect(task.FailedAttempts).To(HaveLen(1))
+
+					Expect(convergenceResult.TasksToAuction).To(ConsistOf(
+						PointTo(Equal(auctioneer.NewTaskStartRequestFromModel("retried-task", domain, retryDef))),
+					))
+				})
+			})
+
+			Context("when the cell of a retried attempt disappears", func() {
+				BeforeEach(func() {
+					fakeClock.Increment(time.Second)
+					_, err := db.ExecContext(ctx, "UPDATE tasks SET state = 2, cell_id = 'missing-cell', retry_at = 0 WHERE guid = 'retried-task'")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("retries the task again", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "retried-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Waiting))
+					Expect(task.FailedAttempts).To(HaveLen(2))
+					Expect(task.FailedAttempts[1].CellId).To(Equal("missing-cell"))
+					Expect(task.RetryAt).To(BeNumerically("~", fakeClock.Now().Add(1500*time.Millisecond).UnixNano(), int64(500*time.Millisecond)))
+				})
+			})
+		})
+
 		Context("resolving tasks", func() {
 			var resolvingExpiredTask, resolvingKickableTask *models.Task
 
@@ -414,6 +584,20 @@ var _ = Describe("Convergence of Tasks", func() {
 
 				Expect(convergenceResult.Events).To(ConsistOf(event1, event2, event3))
 			})
+
+			Context("when the callback of the task is in the outbox", func() {
+				BeforeEach(func() {
+					_, err := sqlDB.EnqueueTaskCallback(ctx, logger, "resolving-kickable-task", fakeClock.Now())
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("leaves the task resolving for the outbox to deliver", func() {
+					task, err := sqlDB.TaskByGuid(ctx, logger, "resolving-kickable-task")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(task.State).To(Equal(models.Task_Resolving))
+					Expect(convergenceResult.TasksToComplete).To(BeEmpty())
+				})
+			})
 		})
 	})
 })
@@ -3,8 +3,11 @@ package sqldb
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"strings"
+	"time"
 
+	bbsdb "code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -21,21 +24,59 @@ func (db *SQLDB) DesireTask(ctx context.Context, logger lager.Logger, taskDef *m
 		return nil, err
 	}
 
+	state := models.Task_Pending
+	if taskDef.HasDependencies() {
+		state = models.Task_Waiting
+	}
+
 	now := db.clock.Now().UnixNano()
+	task := &models.Task{
+		TaskDefinition:   taskDef,
+		TaskGuid:         taskGuid,
+		Domain:           domain,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+		FirstCompletedAt: 0,
+		State:            state,
+	}
+
 	err = db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		requested := &models.DomainUsage{}
+		requested.AddTask(taskDef)
+		err := db.admitToDomainQuota(ctx, logger, tx, domain, requested)
+		if err != nil {
+			return err
+		}
+
 		_, err = db.insert(ctx, logger, tx, tasksTable,
 			helpers.SQLAttributes{
-				"guid":               taskGuid,
-				"domain":             domain,
-				"created_at":         now,
-				"updated_at":         now,
-				"first_completed_at": 0,
-				"state":              models.Task_Pending,
-				"task_definition":    taskDefData,
+				"guid":                      taskGuid,
+				"domain":                    domain,
+				"created_at":                now,
+				"updated_at":                now,
+				"first_completed_at":        0,
+				"state":                     state,
+				"task_definition":           taskDefData,
+				"memory_mb":                 taskDef.MemoryMb,
+				"disk_mb":                   taskDef.DiskMb,
+				"log_rate_bytes_per_second": logRateBytesPerSecond(taskDef.LogRateLimit),
 			},
 		)
+		if err != nil {
+			return err
+		}
 
-		return err
+		err = db.insertTaskDependencies(ctx, logger, tx, taskGuid, taskDef.DependsOn)
+		if err != nil {
+			return err
+		}
+
+		err = taskLabels.replace(ctx, logger, db, tx, taskGuid, taskDef.Labels)
+		if err != nil {
+			return err
+		}
+
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Create, task), nil, task)
 	})
 
 	if err != nil {
@@ -43,18 +84,15 @@ func (db *SQLDB) DesireTask(ctx context.Context, logger lager.Logger, taskDef *m
 		return nil, err
 	}
 
-	return &models.Task{
-		TaskDefinition:   taskDef,
-		TaskGuid:         taskGuid,
-		Domain:           domain,
-		CreatedAt:        now,
-		UpdatedAt:        now,
-		FirstCompletedAt: 0,
-		State:            models.Task_Pending,
-	}, nil
+	return task, nil
 }
 
 func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error) {
+	tasks, _, err := db.ListTasks(ctx, logger, filter)
+	return tasks, err
+}
+
+func (db *SQLDB) ListTasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, bbsdb.ListMetadata, error) {
 	logger = logger.Session("db-tasks", lager.Data{"filter": filter})
 	logger.Debug("starting")
 	defer logger.Debug("complete")
@@ -72,11 +110,29 @@ func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.T
 		values = append(values, filter.CellID)
 	}
 
+	labelWheres, labelValues := taskLabels.wheres(filter.LabelSelector)
+	wheres = append(wheres, labelWheres...)
+	values = append(values, labelValues...)
+
+	after, err := taskPageKey(filter.PageToken)
+	if err != nil {
+		logger.Error("failed-decoding-page-token", err)
+		return nil, bbsdb.ListMetadata{}, err
+	}
+
 	results := []*models.Task{}
+	var metadata bbsdb.ListMetadata
 
-	err := db.transact(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
-		rows, err := db.all(ctx, logger, tx, tasksTable,
-			taskColumns, helpers.NoLockRow,
+	err = db.readSnapshot(ctx, logger, func(logger lager.Logger, tx helpers.Tx) error {
+		var err error
+		metadata.ResourceVersion, err = db.resourceVersion(ctx, logger, tx)
+		if err != nil {
+			return err
+		}
+
+		rows, err := db.allPage(ctx, logger, tx, tasksTable,
+			taskColumns, taskPageColumns, taskRowPageToken,
+			after, filter.PageSize,
 			strings.Join(wheres, " AND "), values...,
 		)
 		if err != nil {
@@ -90,11 +146,12 @@ func (db *SQLDB) Tasks(ctx context.Context, logger lager.Logger, filter models.T
 			logger.Error("failed-fetch", err)
 			return err
 		}
+		metadata.NextPageToken = rows.NextPageToken()
 
 		return nil
 	})
 
-	return results, err
+	return results, metadata, err
 }
 
 func (db *SQLDB) TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error) {
@@ -164,7 +221,7 @@ func (db *SQLDB) StartTask(ctx context.Context, logger lager.Logger, taskGuid, c
 		afterTask.CellId = cellId
 
 		started = true
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, started, err
@@ -191,7 +248,7 @@ func (db *SQLDB) CancelTask(ctx context.Context, logger lager.Logger, taskGuid s
 		cellID = afterTask.CellId
 
 		if err = afterTask.ValidateTransitionTo(models.Task_Completed); err != nil {
-			if afterTask.State != models.Task_Pending {
+			if afterTask.State != models.Task_Pending && afterTask.State != models.Task_Waiting {
 				logger.Error("failed-to-transition-task-to-completed", err)
 				return err
 			}
@@ -201,7 +258,7 @@ func (db *SQLDB) CancelTask(ctx context.Context, logger lager.Logger, taskGuid s
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, cellID, err
@@ -234,12 +291,21 @@ func (db *SQLDB) CompleteTask(ctx context.Context, logger lager.Logger, taskGuid
 			return err
 		}
 
+		if backoff, retry := afterTask.RetryBackoff(failureReason); failed && retry {
+			logger.Info("retrying-failed-task", lager.Data{"failure_reason": failureReason, "backoff": backoff.String()})
+			_, err = db.retryTask(ctx, logger, tx, afterTask, failureReason, backoff)
+			if err != nil {
+				return err
+			}
+			return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
+		}
+
 		err = db.completeTask(ctx, logger, afterTask, failed, failureReason, taskResult, tx)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, err
@@ -275,7 +341,7 @@ func (db *SQLDB) FailTask(ctx context.Context, logger lager.Logger, taskGuid, fa
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, err
@@ -324,7 +390,7 @@ func (db *SQLDB) RejectTask(ctx context.Context, logger lager.Logger, taskGuid,
 			return err
 		}
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, err
@@ -371,7 +437,7 @@ func (db *SQLDB) ResolvingTask(ctx context.Context, logger lager.Logger, taskGui
 		afterTask.State = models.Task_Resolving
 		afterTask.UpdatedAt = now
 
-		return nil
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Update, &beforeTask), &beforeTask, afterTask)
 	})
 
 	return &beforeTask, afterTask, err
@@ -404,7 +470,28 @@ func (db *SQLDB) DeleteTask(ctx context.Context, logger lager.Logger, taskGuid s
 			return err
 		}
 
-		return nil
+		err = db.recordParentOutcomes(ctx, logger, tx, task)
+		if err != nil {
+			return err
+		}
+
+		err = db.removeTaskDependencies(ctx, logger, tx, taskGuid)
+		if err != nil {
+			return err
+		}
+
+		_, err = db.delete(ctx, logger, tx, taskCallbacksTable, "task_guid = ?", taskGuid)
+		if err != nil {
+			logger.Error("failed-deleting-task-callback", err)
+			return err
+		}
+
+		err = taskLabels.remove(ctx, logger, db, tx, taskGuid)
+		if err != nil {
+			return err
+		}
+
+		return db.recordAudit(ctx, logger, tx, taskAuditRecord(models.AuditRecord_Remove, task), task, nil)
 	})
 	return task, err
 }
@@ -440,6 +527,45 @@ func (db *SQLDB) completeTask(ctx context.Context, logger lager.Logger, task *mo
 	return nil
 }
 
+// retryTask records the failed attempt of the task and puts it back to
+// waiting for the backoff of its retry policy, after which convergence makes
+// it pending again. It reports whether the task was still in the state it was
+// fetched in.
+func (db *SQLDB) retryTask(ctx context.Context, logger lager.Logger, queryable helpers.Queryable, task *models.Task, failureReason string, backoff time.Duration) (bool, error) {
+	fromState := task.State
+	now := db.clock.Now()
+	task.Retry(truncateString(failureReason, 1024), now.UnixNano(), now.Add(backoff).UnixNano())
+
+	failedAttemptsData, err := json.Marshal(task.FailedAttempts)
+	if err != nil {
+		logger.Error("failed-encoding-failed-attempts", err)
+		return false, err
+	}
+
+	result, err := db.update(ctx, logger, queryable, tasksTable,
+		helpers.SQLAttributes{
+			"state":           task.State,
+			"retry_at":        task.RetryAt,
+			"failed_attempts": string(failedAttemptsData),
+			"cell_id":         "",
+			"updated_at":      task.UpdatedAt,
+		},
+		"guid = ? AND state = ?", task.TaskGuid, fromState,
+	)
+	if err != nil {
+		logger.Error("failed-updating-tasks", err)
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed-rows-affected", err)
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
 func (db *SQLDB) fetchTaskForUpdate(ctx context.Context, logger lager.Logger, taskGuid string, queryable helpers.Queryable) (*models.Task, error) {
 	row := db.one(ctx, logger, queryable, tasksTable,
 		taskColumns, helpers.LockRow,
@@ -448,7 +574,7 @@ func (db *SQLDB) fetchTaskForUpdate(ctx context.Context, logger lager.Logger, ta
 	return db.fetchTask(ctx, logger, row, queryable)
 }
 
-func (db *SQLDB) fetchTasks(ctx context.Context, logger lager.Logger, rows *sql.Rows, queryable helpers.Queryable, abortOnError bool) ([]*models.Task, []string, int, error) {
+func (db *SQLDB) fetchTasks(ctx context.Context, logger lager.Logger, rows rowIterator, queryable helpers.Queryable, abortOnError bool) ([]*models.Task, []string, int, error) {
 	tasks := []*models.Task{}
 	invalidGuids := []string{}
 	validGuids := []string{}
@@ -507,10 +633,10 @@ func (db *SQLDB) fetchTask(ctx context.Context, logger lager.Logger, scanner hel
 func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScanner) (*models.Task, string, error) {
 	var guid, domain, cellID, failureReason, rejectionReason string
 	var result sql.NullString
-	var createdAt, updatedAt, firstCompletedAt int64
+	var createdAt, updatedAt, firstCompletedAt, retryAt int64
 	var state, rejectionCount int32
 	var failed bool
-	var taskDefData []byte
+	var taskDefData, failedAttemptsData []byte
 
 	err := scanner.Scan(
 		&guid,
@@ -526,6 +652,8 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		&taskDefData,
 		&rejectionCount,
 		&rejectionReason,
+		&retryAt,
+		&failedAttemptsData,
 	)
 
 	if err == sql.ErrNoRows {
@@ -543,6 +671,15 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		return nil, guid, models.ErrDeserialize
 	}
 
+	var failedAttempts []*models.TaskAttempt
+	if len(failedAttemptsData) > 0 {
+		err = json.Unmarshal(failedAttemptsData, &failedAttempts)
+		if err != nil {
+			logger.Error("failed-parsing-failed-attempts", err)
+			return nil, guid, models.ErrDeserialize
+		}
+	}
+
 	task := &models.Task{
 		TaskGuid:         guid,
 		Domain:           domain,
@@ -557,6 +694,8 @@ func (db *SQLDB) fetchTaskInternal(logger lager.Logger, scanner helpers.RowScann
 		TaskDefinition:   &taskDef,
 		RejectionCount:   rejectionCount,
 		RejectionReason:  rejectionReason,
+		RetryAt:          retryAt,
+		FailedAttempts:   failedAttempts,
 	}
 	return task, guid, nil
 }
@@ -569,5 +708,11 @@ func (db *SQLDB) deleteInvalidTasks(ctx context.Context, logger lager.Logger, qu
 			logger.Error("failed-deleting-task", err)
 		}
 	}
-	return nil
+
+	err := db.removeTaskDependencies(ctx, logger, queryable, guids...)
+	if err != nil {
+		return err
+	}
+
+	return taskLabels.remove(ctx, logger, db, queryable, guids...)
 }
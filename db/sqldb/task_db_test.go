@@ -51,7 +51,7 @@ var _ = Describe("TaskDB", func() {
 
 				var guid, domain, cellID, failureReason, rejectionReason string
 				var result sql.NullString
-				var createdAt, updatedAt, firstCompletedAt int64
+				var createdAt, updatedAt, firstCompletedAt, promotedAt int64
 				var state, rejectionCount int32
 				var failed bool
 				var taskDefData []byte
@@ -70,6 +70,7 @@ var _ = Describe("TaskDB", func() {
 					&taskDefData,
 					&rejectionCount,
 					&rejectionReason,
+					&promotedAt,
 				)
 				Expect(err).NotTo(HaveOccurred())
 
@@ -78,6 +79,7 @@ var _ = Describe("TaskDB", func() {
 				Expect(createdAt).To(Equal(fakeClock.Now().UTC().UnixNano()))
 				Expect(updatedAt).To(Equal(fakeClock.Now().UTC().UnixNano()))
 				Expect(firstCompletedAt).To(BeEquivalentTo(0))
+				Expect(promotedAt).To(BeEquivalentTo(0))
 				Expect(state).To(BeEquivalentTo(models.Task_Pending))
 				Expect(result.String).To(Equal(""))
 				Expect(failureReason).To(Equal(""))
@@ -126,6 +128,36 @@ var _ = Describe("TaskDB", func() {
 				Expect(count).To(Equal(1))
 			})
 		})
+
+		Context("when the task depends on other tasks", func() {
+			BeforeEach(func() {
+				_, err := sqlDB.DesireTask(ctx, logger, model_helpers.NewValidTaskDefinition(), "parent-guid", taskDomain)
+				Expect(err).NotTo(HaveOccurred())
+				taskDef.DependsOn = []string{"parent-guid"}
+			})
+
+			It("persists the task in the waiting state", func() {
+				Expect(errDesire).NotTo(HaveOccurred())
+				Expect(desiredTask.State).To(Equal(models.Task_Waiting))
+
+				task, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(task.State).To(Equal(models.Task_Waiting))
+			})
+
+			Context("when a parent does not exist", func() {
+				BeforeEach(func() {
+					taskDef.DependsOn = []string{"parent-guid", "missing-guid"}
+				})
+
+				It("returns an error and does not persist the task", func() {
+					Expect(errDesire).To(Equal(models.ErrResourceNotFound))
+
+					_, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+					Expect(err).To(Equal(models.ErrResourceNotFound))
+				})
+			})
+		})
 	})
 
 	Describe("Tasks", func() {
@@ -169,12 +201,61 @@ var _ = Describe("TaskDB", func() {
 				Expect(tasks[0]).To(Equal(expectedTasks[1]))
 			})
 
+			It("can filter by label selector", func() {
+				taskDef := model_helpers.NewValidTaskDefinition()
+				taskDef.Labels = map[string]string{"team": "a"}
+				_, err := sqlDB.DesireTask(ctx, logger, taskDef, "d-guid", "domain-3")
+				Expect(err).NotTo(HaveOccurred())
+
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(HaveLen(1))
+				Expect(tasks[0].TaskGuid).To(Equal("d-guid"))
+				Expect(tasks[0].Labels).To(Equal(map[string]string{"team": "a"}))
+
+				tasks, err = sqlDB.Tasks(ctx, logger, models.TaskFilter{
+					LabelSelector: []*models.LabelSelectorRequirement{
+						{Key: "team", Operator: models.LabelSelectorOperatorDoesNotExist},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(ConsistOf(expectedTasks))
+			})
+
 			It("can filter by domain and cell id", func() {
 				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{CellID: "cell-1", Domain: "domain-2"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(tasks).To(HaveLen(1))
 				Expect(tasks[0]).To(Equal(expectedTasks[2]))
 			})
+
+			It("can paginate by task guid", func() {
+				tasks, metadata, err := sqlDB.ListTasks(ctx, logger, models.TaskFilter{PageSize: 2})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[:2]))
+				Expect(metadata.NextPageToken).To(Equal(models.NewTaskPageToken(tasks[1]).Encode()))
+
+				tasks, metadata, err = sqlDB.ListTasks(ctx, logger, models.TaskFilter{PageSize: 2, PageToken: metadata.NextPageToken})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[2:]))
+				Expect(metadata.NextPageToken).To(BeEmpty())
+			})
+
+			It("applies filters to each page", func() {
+				token := models.NewTaskPageToken(expectedTasks[0]).Encode()
+				tasks, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{Domain: "domain-2", PageSize: 1, PageToken: token})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks).To(Equal(expectedTasks[1:2]))
+			})
+
+			It("returns an error when the page token is invalid", func() {
+				_, err := sqlDB.Tasks(ctx, logger, models.TaskFilter{PageSize: 2, PageToken: "not a token"})
+				Expect(err).To(Equal(models.ErrInvalidPageToken))
+			})
 		})
 
 		Context("when there are no tasks", func() {
@@ -588,6 +669,45 @@ var _ = Describe("TaskDB", func() {
 						Expect(task.CellId).To(Equal(""))
 					})
 
+					Context("when the task has a retry policy", func() {
+						BeforeEach(func() {
+							taskDefinition.RetryPolicy = &models.TaskRetryPolicy{
+								MaxAttempts:             2,
+								InitialBackoffMs:        5000,
+								RetryableFailureReasons: []string{"blew up"},
+							}
+						})
+
+						It("waits to retry a failure that matches the policy", func() {
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, true, "it blew up", "")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(after.State).To(Equal(models.Task_Waiting))
+							Expect(after.RetryAt).To(BeNumerically("~", fakeClock.Now().Add(3750*time.Millisecond).UnixNano(), int64(1250*time.Millisecond)))
+
+							task, err := sqlDB.TaskByGuid(ctx, logger, taskGuid)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(task.State).To(Equal(models.Task_Waiting))
+							Expect(task.CellId).To(BeEmpty())
+							Expect(task.Failed).To(BeFalse())
+							Expect(task.FailedAttempts).To(Equal([]*models.TaskAttempt{
+								{CellId: cellID, FailureReason: "it blew up", FailedAt: fakeClock.Now().UnixNano()},
+							}))
+						})
+
+						It("completes a failure that does not match the policy", func() {
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, true, "out of memory", "")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(after.State).To(Equal(models.Task_Completed))
+							Expect(after.FailedAttempts).To(BeEmpty())
+						})
+
+						It("completes a successful task", func() {
+							_, after, err := sqlDB.CompleteTask(ctx, logger, taskGuid, cellID, false, "", "")
+							Expect(err).NotTo(HaveOccurred())
+							Expect(after.State).To(Equal(models.Task_Completed))
+						})
+					})
+
 					Context("when the rejection reason is longer than 1K", func() {
 						var (
 							failureReason string
@@ -0,0 +1,208 @@
+package sqldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// parentOutcome is what a waiting task knows about one of its parents.
+type parentOutcome int
+
+const (
+	parentUnfinished parentOutcome = iota
+	parentSucceeded
+	parentFailed
+)
+
+// insertTaskDependencies records the parents of a waiting task. The parents
+// are locked so that they cannot be deleted before the rows that remember
+// their outcome exist.
+func (db *SQLDB) insertTaskDependencies(ctx context.Context, logger lager.Logger, tx helpers.Tx, taskGuid string, parentGuids []string) error {
+	for _, parentGuid := range parentGuids {
+		var guid string
+		row := db.one(ctx, logger, tx, tasksTable, helpers.ColumnList{"guid"}, helpers.LockRow, "guid = ?", parentGuid)
+		err := row.Scan(&guid)
+		if err == sql.ErrNoRows {
+			logger.Error("failed-finding-parent-task", err, lager.Data{"parent_guid": parentGuid})
+			return models.ErrResourceNotFound
+		}
+		if err != nil {
+			logger.Error("failed-locking-parent-task", err, lager.Data{"parent_guid": parentGuid})
+			return err
+		}
+
+		_, err = db.insert(ctx, logger, tx, taskDependenciesTable,
+			helpers.SQLAttributes{
+				"task_guid":   taskGuid,
+				"parent_guid": parentGuid,
+			},
+		)
+		if err != nil {
+			logger.Error("failed-inserting-task-dependency", err, lager.Data{"parent_guid": parentGuid})
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordParentOutcomes remembers how the given tasks finished on behalf of
+// their waiting children, since the tasks themselves are about to be deleted.
+func (db *SQLDB) recordParentOutcomes(ctx context.Context, logger lager.Logger, q helpers.Queryable, tasks ...*models.Task) error {
+	for _, task := range tasks {
+		_, err := db.update(ctx, logger, q, taskDependenciesTable,
+			helpers.SQLAttributes{
+				"parent_completed": true,
+				"parent_failed":    task.Failed,
+			},
+			"parent_guid = ?", task.TaskGuid,
+		)
+		if err != nil {
+			logger.Error("failed-recording-parent-outcome", err, lager.Data{"task_guid": task.TaskGuid})
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *SQLDB) removeTaskDependencies(ctx context.Context, logger lager.Logger, q helpers.Queryable, guids ...string) error {
+	if len(guids) == 0 {
+		return nil
+	}
+
+	values := make([]interface{}, 0, len(guids))
+	for _, guid := range guids {
+		values = append(values, guid)
+	}
+
+	_, err := db.delete(ctx, logger, q, taskDependenciesTable,
+		fmt.Sprintf("task_guid IN (%s)", helpers.QuestionMarks(len(guids))), values...,
+	)
+	if err != nil {
+		logger.Error("failed-deleting-task-dependencies", err)
+		return err
+	}
+
+	return nil
+}
+
+// parentOutcomes returns the outcome of every parent of the given waiting
+// tasks, keyed by child and then parent guid. A parent that is still in the
+// tasks table speaks for itself; otherwise the outcome recorded when it was
+// deleted is used. A parent that disappeared without leaving an outcome, for
+// instance because its definition could not be read, counts as failed.
+func (db *SQLDB) parentOutcomes(ctx context.Context, logger lager.Logger, tasks []*models.Task) (map[string]map[string]parentOutcome, error) {
+	childGuids := make([]interface{}, 0, len(tasks))
+	parentGuids := []interface{}{}
+	seenParents := map[string]struct{}{}
+	for _, task := range tasks {
+		childGuids = append(childGuids, task.TaskGuid)
+		for _, parentGuid := range task.DependsOn {
+			if _, seen := seenParents[parentGuid]; !seen {
+				seenParents[parentGuid] = struct{}{}
+				parentGuids = append(parentGuids, parentGuid)
+			}
+		}
+	}
+
+	live := map[string]parentOutcome{}
+	if len(parentGuids) > 0 {
+		rows, err := db.all(ctx, logger, db.db, tasksTable,
+			helpers.ColumnList{"guid", "state", "failed"}, helpers.NoLockRow,
+			fmt.Sprintf("guid IN (%s)", helpers.QuestionMarks(len(parentGuids))), parentGuids...,
+		)
+		if err != nil {
+			logger.Error("failed-querying-parent-tasks", err)
+			return nil, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var guid string
+			var state int32
+			var failed bool
+			err := rows.Scan(&guid, &state, &failed)
+			if err != nil {
+				logger.Error("failed-scanning-parent-task", err)
+				return nil, err
+			}
+
+			switch models.Task_State(state) {
+			case models.Task_Completed, models.Task_Resolving:
+				if failed {
+					live[guid] = parentFailed
+				} else {
+					live[guid] = parentSucceeded
+				}
+			default:
+				live[guid] = parentUnfinished
+			}
+		}
+		if err := rows.Err(); err != nil {
+			logger.Error("failed-reading-parent-tasks", err)
+			return nil, err
+		}
+	}
+
+	recorded := map[string]map[string]parentOutcome{}
+	if len(childGuids) > 0 {
+		rows, err := db.all(ctx, logger, db.db, taskDependenciesTable,
+			helpers.ColumnList{"task_guid", "parent_guid", "parent_completed", "parent_failed"}, helpers.NoLockRow,
+			fmt.Sprintf("task_guid IN (%s)", helpers.QuestionMarks(len(childGuids))), childGuids...,
+		)
+		if err != nil {
+			logger.Error("failed-querying-task-dependencies", err)
+			return nil, err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var taskGuid, parentGuid string
+			var completed, failed bool
+			err := rows.Scan(&taskGuid, &parentGuid, &completed, &failed)
+			if err != nil {
+				logger.Error("failed-scanning-task-dependency", err)
+				return nil, err
+			}
+			if !completed {
+				continue
+			}
+
+			if recorded[taskGuid] == nil {
+				recorded[taskGuid] = map[string]parentOutcome{}
+			}
+			if failed {
+				recorded[taskGuid][parentGuid] = parentFailed
+			} else {
+				recorded[taskGuid][parentGuid] = parentSucceeded
+			}
+		}
+		if err := rows.Err(); err != nil {
+			logger.Error("failed-reading-task-dependencies", err)
+			return nil, err
+		}
+	}
+
+	outcomes := map[string]map[string]parentOutcome{}
+	for _, task := range tasks {
+		outcomes[task.TaskGuid] = map[string]parentOutcome{}
+		for _, parentGuid := range task.DependsOn {
+			outcome, found := live[parentGuid]
+			if !found {
+				outcome, found = recorded[task.TaskGuid][parentGuid]
+			}
+			if !found {
+				outcome = parentFailed
+			}
+			outcomes[task.TaskGuid][parentGuid] = outcome
+		}
+	}
+
+	return outcomes, nil
+}
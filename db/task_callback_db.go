@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate . TaskCallbackDB
+
+// TaskCallbackDB is the outbox of task completion callbacks. A callback is
+// removed together with its task once it has been delivered.
+type TaskCallbackDB interface {
+	TaskCallbacks(ctx context.Context, logger lager.Logger, deadLettered bool) ([]*models.TaskCallback, error)
+
+	// EnqueueTaskCallback records the callback of the task, claimed by the
+	// caller until claimedUntil. It returns ErrResourceExists if the callback
+	// is already in the outbox.
+	EnqueueTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string, claimedUntil time.Time) (*models.TaskCallback, error)
+	// ClaimDueTaskCallbacks returns at most limit pending callbacks whose next
+	// attempt is at or before now, and moves their next attempt to
+	// claimedUntil so that no other delivery picks them up meanwhile.
+	ClaimDueTaskCallbacks(ctx context.Context, logger lager.Logger, now, claimedUntil time.Time, limit int) ([]*models.TaskCallback, error)
+	// RecordTaskCallbackAttempt records a failed delivery. The callback is
+	// attempted again at nextAttemptAt, or dead-lettered when deadLetter is
+	// set.
+	RecordTaskCallbackAttempt(ctx context.Context, logger lager.Logger, taskGuid string, statusCode int32, lastError string, nextAttemptAt time.Time, deadLetter bool) (*models.TaskCallback, error)
+	// ReplayTaskCallback makes the callback pending again with no attempts,
+	// to be delivered by the next dispatch.
+	ReplayTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) (*models.TaskCallback, error)
+	DeleteTaskCallback(ctx context.Context, logger lager.Logger, taskGuid string) error
+}
@@ -31,6 +31,9 @@ type TaskMetrics struct {
 //counterfeiter:generate . TaskDB
 type TaskDB interface {
 	Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
+	// ListTasks returns the tasks matching the filter along with the metadata
+	// of the list.
+	ListTasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, ListMetadata, error)
 	TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error)
 
 	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) (*models.Task, error)
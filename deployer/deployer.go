@@ -0,0 +1,62 @@
+package deployer
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate -o fake_controllers/fake_deployment_controller.go . DeploymentController
+type DeploymentController interface {
+	ProgressDeployments(ctx context.Context, logger lager.Logger)
+}
+
+const DEFAULT_PROGRESS_INTERVAL = 5 * time.Second
+
+// Deployer moves the active deployments on at a fixed interval.
+type Deployer struct {
+	logger               lager.Logger
+	clock                clock.Clock
+	deploymentController DeploymentController
+	progressInterval     time.Duration
+}
+
+func New(
+	logger lager.Logger,
+	clock clock.Clock,
+	deploymentController DeploymentController,
+	progressInterval time.Duration,
+) *Deployer {
+	return &Deployer{
+		logger:               logger,
+		clock:                clock,
+		deploymentController: deploymentController,
+		progressInterval:     progressInterval,
+	}
+}
+
+func (d *Deployer) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := d.logger.Session("deployer")
+	logger.Info("started")
+	defer logger.Info("done")
+
+	ticker := d.clock.NewTicker(d.progressInterval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+
+		case <-ticker.C():
+			d.deploymentController.ProgressDeployments(context.Background(), logger)
+		}
+	}
+}
@@ -0,0 +1,13 @@
+package deployer_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestDeployer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Deployer Suite")
+}
@@ -0,0 +1,52 @@
+package deployer_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/deployer"
+	"code.cloudfoundry.org/bbs/deployer/fake_controllers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+var _ = Describe("Deployer", func() {
+	const progressInterval = 5 * time.Second
+
+	var (
+		fakeClock      *fakeclock.FakeClock
+		fakeController *fake_controllers.FakeDeploymentController
+		process        ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		fakeController = new(fake_controllers.FakeDeploymentController)
+
+		runner := deployer.New(lagertest.NewTestLogger("test"), fakeClock, fakeController, progressInterval)
+		process = ginkgomon.Invoke(runner)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	It("progresses the deployments every interval", func() {
+		Consistently(fakeController.ProgressDeploymentsCallCount).Should(Equal(0))
+
+		fakeClock.WaitForWatcherAndIncrement(progressInterval)
+		Eventually(fakeController.ProgressDeploymentsCallCount).Should(Equal(1))
+
+		fakeClock.WaitForWatcherAndIncrement(progressInterval)
+		Eventually(fakeController.ProgressDeploymentsCallCount).Should(Equal(2))
+	})
+
+	It("exits when signaled", func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+})
@@ -0,0 +1,80 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/deployer"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeDeploymentController struct {
+	ProgressDeploymentsStub        func(context.Context, lager.Logger)
+	progressDeploymentsMutex       sync.RWMutex
+	progressDeploymentsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeploymentController) ProgressDeployments(arg1 context.Context, arg2 lager.Logger) {
+	fake.progressDeploymentsMutex.Lock()
+	fake.progressDeploymentsArgsForCall = append(fake.progressDeploymentsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.ProgressDeploymentsStub
+	fake.recordInvocation("ProgressDeployments", []interface{}{arg1, arg2})
+	fake.progressDeploymentsMutex.Unlock()
+	if stub != nil {
+		fake.ProgressDeploymentsStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeDeploymentController) ProgressDeploymentsCallCount() int {
+	fake.progressDeploymentsMutex.RLock()
+	defer fake.progressDeploymentsMutex.RUnlock()
+	return len(fake.progressDeploymentsArgsForCall)
+}
+
+func (fake *FakeDeploymentController) ProgressDeploymentsCalls(stub func(context.Context, lager.Logger)) {
+	fake.progressDeploymentsMutex.Lock()
+	defer fake.progressDeploymentsMutex.Unlock()
+	fake.ProgressDeploymentsStub = stub
+}
+
+func (fake *FakeDeploymentController) ProgressDeploymentsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.progressDeploymentsMutex.RLock()
+	defer fake.progressDeploymentsMutex.RUnlock()
+	argsForCall := fake.progressDeploymentsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeDeploymentController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.progressDeploymentsMutex.RLock()
+	defer fake.progressDeploymentsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeploymentController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ deployer.DeploymentController = new(FakeDeploymentController)
@@ -0,0 +1 @@
+package fake_controllers // import "code.cloudfoundry.org/bbs/deployer/fake_controllers"
@@ -0,0 +1 @@
+package deployer // import "code.cloudfoundry.org/bbs/deployer"
@@ -0,0 +1,48 @@
+package bbs
+
+import (
+	"errors"
+	"fmt"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+// RequestError is the error of a call made by a ContextClient. It names the
+// route of the call and wraps the error it failed with: a models.Error
+// returned by the BBS, the error of the context the call was made with, or
+// that of the transport.
+//
+// Since models.Error values of the same type are alike for errors.Is,
+// errors.Is(err, models.ErrResourceNotFound) tells whether a call failed
+// because the resource does not exist.
+type RequestError struct {
+	Route string
+	Err   error
+}
+
+func (e *RequestError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Route, e.Err)
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// responseError returns the error the BBS responded to a call of the route
+// with, if any.
+func responseError(route string, err *models.Error) error {
+	if err == nil {
+		return nil
+	}
+	return &RequestError{Route: route, Err: err}
+}
+
+// requestCause returns the error wrapped by a RequestError, or the error
+// itself when it is not one.
+func requestCause(err error) error {
+	var requestErr *RequestError
+	if errors.As(err, &requestErr) {
+		return requestErr.Err
+	}
+	return err
+}
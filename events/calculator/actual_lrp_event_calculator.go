@@ -3,6 +3,7 @@ package calculator
 import (
 	"sort"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
 )
@@ -77,6 +78,9 @@ type ActualLRPEventCalculator struct {
 	// Deprecated: use ActualLRPInstanceHub instead
 	ActualLRPGroupHub    events.Hub
 	ActualLRPInstanceHub events.Hub
+	// WriteVersion records the writes the events are emitted for, and sets
+	// their resource version when they are emitted.
+	WriteVersion *db.WriteVersion
 }
 
 // EmitCrashEvents emits only the events for a crash scenario. Specifically:
@@ -129,7 +133,7 @@ func (e ActualLRPEventCalculator) EmitCrashEvents(traceId string, beforeSet, aft
 	})
 
 	for _, ev := range groupEvents {
-		e.ActualLRPGroupHub.Emit(ev)
+		e.ActualLRPGroupHub.Emit(e.WriteVersion.Versioned(ev))
 	}
 
 	instanceEvents := []models.Event{}
@@ -168,7 +172,7 @@ func (e ActualLRPEventCalculator) EmitCrashEvents(traceId string, beforeSet, aft
 	})
 
 	for _, ev := range instanceEvents {
-		e.ActualLRPInstanceHub.Emit(ev)
+		e.ActualLRPInstanceHub.Emit(e.WriteVersion.Versioned(ev))
 	}
 }
 
@@ -183,7 +187,7 @@ func (e ActualLRPEventCalculator) EmitEvents(traceId string, beforeSet, afterSet
 	afterGroup := models.ResolveActualLRPGroup(removeNilLRPs(afterSet))
 
 	for _, ev := range generateLRPGroupEvents(beforeGroup, afterGroup) {
-		e.ActualLRPGroupHub.Emit(ev)
+		e.ActualLRPGroupHub.Emit(e.WriteVersion.Versioned(ev))
 	}
 
 	// stretch the two slices to be of equal size.  make sure we do this after
@@ -200,7 +204,7 @@ func (e ActualLRPEventCalculator) EmitEvents(traceId string, beforeSet, afterSet
 	})
 
 	for _, ev := range events {
-		e.ActualLRPInstanceHub.Emit(ev)
+		e.ActualLRPInstanceHub.Emit(e.WriteVersion.Versioned(ev))
 	}
 }
 
@@ -1,9 +1,12 @@
 package calculator_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events/calculator"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/models"
@@ -69,6 +72,23 @@ var _ = Describe("ActualLrpEventCalculator", func() {
 				lrpInstanceEvent := actualInstanceHub.EmitArgsForCall(0)
 				Expect(lrpInstanceEvent).To(Equal(&models.ActualLRPInstanceCreatedEvent{ActualLrp: newLRP, TraceId: "some-trace-id"}))
 			})
+
+			Context("when the calculator records the write", func() {
+				BeforeEach(func() {
+					_, eventCalculator.WriteVersion = db.WithWriteVersion(context.Background())
+					eventCalculator.WriteVersion.Record(42)
+				})
+
+				It("emits the events with the resource version of the write", func() {
+					eventCalculator.EmitEvents("some-trace-id", beforeSet, afterSet)
+
+					Expect(actualHub.EmitCallCount()).To(Equal(1))
+					//lint:ignore SA1019 - calling deprecated model while unit testing deprecated method
+					Expect(actualHub.EmitArgsForCall(0)).To(Equal(&models.ActualLRPCreatedEvent{ActualLrpGroup: newLRP.ToActualLRPGroup(), ResourceVersion: 42}))
+					Expect(actualInstanceHub.EmitCallCount()).To(Equal(1))
+					Expect(actualInstanceHub.EmitArgsForCall(0)).To(Equal(&models.ActualLRPInstanceCreatedEvent{ActualLrp: newLRP, TraceId: "some-trace-id", ResourceVersion: 42}))
+				})
+			})
 		})
 
 		Context("when an LRP is being deleted (i.e., the 'after' set has a nil value)", func() {
@@ -0,0 +1,264 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/gogo/protobuf/proto"
+)
+
+// DB_EVENT_LOG_PRUNE_INTERVAL is the number of appends between deletions of
+// events that have fallen out of the retained window.
+const DB_EVENT_LOG_PRUNE_INTERVAL = 100
+
+type dbEventLog struct {
+	logger lager.Logger
+	db     db.EventLogDB
+	stream string
+	size   uint64
+
+	loaded bool
+	lastID uint64
+	// pending are the appended events not yet recorded in the database,
+	// oldest first. They are recorded by a flush outside the hub's lock, and
+	// replayed from memory until then. At most size events are pending, as
+	// no more can be resumed across.
+	pending  []pendingEvent
+	flushing bool
+	// lostID is the ID of the most recent event that could not be recorded.
+	lostID            uint64
+	appendsSincePrune int
+	lock              sync.Mutex
+}
+
+var errTooManyPendingEvents = errors.New("too many events are waiting to be recorded")
+
+type pendingEvent struct {
+	stored db.StoredEvent
+	event  models.Event
+}
+
+// NewDBEventLog returns an EventLog that records the events of the given
+// stream in the database and retains the most recent size of them, so that
+// subscribers can resume across a BBS restart or failover.
+//
+// The last ID is read from the database on first use rather than on
+// creation, since only the BBS holding the lock emits events and a standby
+// instance would otherwise start from a stale position.
+func NewDBEventLog(logger lager.Logger, eventLogDB db.EventLogDB, stream string, size int) EventLog {
+	return &dbEventLog{
+		logger: logger.Session("db-event-log", lager.Data{"stream": stream}),
+		db:     eventLogDB,
+		stream: stream,
+		size:   uint64(size),
+	}
+}
+
+// Append assigns the next ID to the event and queues it to be recorded, so
+// that emitting an event does not wait on the database. When the size of
+// the log is already pending, for example because the database is slow or
+// unavailable, the oldest pending event is dropped and lost.
+func (log *dbEventLog) Append(event models.Event, resourceVersion uint64) uint64 {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	log.load()
+	log.lastID++
+	log.appendsSincePrune++
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		log.logger.Error("failed-to-marshal-event", err, lager.Data{"id": log.lastID})
+		log.lostID = log.lastID
+		return log.lastID
+	}
+
+	log.pending = append(log.pending, pendingEvent{
+		stored: db.StoredEvent{
+			ID:              log.lastID,
+			EventType:       event.EventType(),
+			Payload:         payload,
+			ResourceVersion: resourceVersion,
+		},
+		event: event,
+	})
+	if uint64(len(log.pending)) > log.size {
+		dropped := log.pending[0].stored.ID
+		log.logger.Error("failed-to-queue-event", errTooManyPendingEvents, lager.Data{"id": dropped})
+		log.pending = log.pending[1:]
+		if dropped > log.lostID {
+			log.lostID = dropped
+		}
+	}
+	if !log.flushing {
+		log.flushing = true
+		go log.flush()
+	}
+
+	return log.lastID
+}
+
+// flush records the pending events in order until none are left. Events that
+// fail to be recorded are lost, and resumes across them are reported as
+// expired.
+func (log *dbEventLog) flush() {
+	for {
+		log.lock.Lock()
+		if len(log.pending) == 0 {
+			log.flushing = false
+			log.lock.Unlock()
+			return
+		}
+		batch := log.pending
+		log.lock.Unlock()
+
+		var lostID uint64
+		for _, pending := range batch {
+			err := log.db.InsertEvent(context.Background(), log.logger, log.stream, pending.stored)
+			if err != nil {
+				log.logger.Error("failed-to-record-event", err, lager.Data{"id": pending.stored.ID})
+				lostID = pending.stored.ID
+			}
+		}
+
+		lastID := batch[len(batch)-1].stored.ID
+
+		log.lock.Lock()
+		// events may have been dropped from the front of the pending events
+		// while the batch was recorded
+		for len(log.pending) > 0 && log.pending[0].stored.ID <= lastID {
+			log.pending = log.pending[1:]
+		}
+		if lostID > log.lostID {
+			log.lostID = lostID
+		}
+		prune := log.appendsSincePrune >= DB_EVENT_LOG_PRUNE_INTERVAL
+		if prune {
+			log.appendsSincePrune = 0
+		}
+		log.lock.Unlock()
+
+		if prune && lastID > log.size {
+			err := log.db.DeleteEventsBefore(context.Background(), log.logger, log.stream, lastID-log.size+1)
+			if err != nil {
+				log.logger.Error("failed-to-prune-events", err)
+			}
+		}
+	}
+}
+
+func (log *dbEventLog) LastID() uint64 {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	log.load()
+	return log.lastID
+}
+
+func (log *dbEventLog) Since(id uint64) ([]LoggedEvent, error) {
+	log.lock.Lock()
+	log.load()
+	lastID := log.lastID
+	lostID := log.lostID
+	pending := log.pending
+	log.lock.Unlock()
+
+	if id == lastID {
+		return nil, nil
+	}
+	if id > lastID || lastID-id > log.size || lostID > id {
+		return nil, ErrEventsExpired
+	}
+
+	stored, err := log.db.EventsSince(context.Background(), log.logger, log.stream, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The pending events were not recorded when the log was last locked, but
+	// may have been since; they are replayed from memory after the last
+	// recorded one.
+	retained := make([]pendingEvent, 0, len(stored)+len(pending))
+	for _, storedEvent := range stored {
+		if storedEvent.ID > lastID {
+			break
+		}
+		retained = append(retained, pendingEvent{stored: storedEvent})
+	}
+	for _, pendingEvent := range pending {
+		if len(retained) > 0 && pendingEvent.stored.ID <= retained[len(retained)-1].stored.ID {
+			continue
+		}
+		retained = append(retained, pendingEvent)
+	}
+
+	// Every event from the given ID to the last one must be retained; a gap
+	// is an event that was lost, by this BBS or by one before it.
+	if len(retained) == 0 || retained[0].stored.ID != id || retained[len(retained)-1].stored.ID != lastID {
+		return nil, ErrEventsExpired
+	}
+	for i := 1; i < len(retained); i++ {
+		if retained[i].stored.ID != retained[i-1].stored.ID+1 {
+			return nil, ErrEventsExpired
+		}
+	}
+
+	logged := make([]LoggedEvent, 0, len(retained)-1)
+	for _, retainedEvent := range retained[1:] {
+		event := retainedEvent.event
+		if event == nil {
+			event, err = decodeEvent(retainedEvent.stored.EventType, retainedEvent.stored.Payload)
+			if err != nil {
+				return nil, err
+			}
+		}
+		logged = append(logged, LoggedEvent{ID: retainedEvent.stored.ID, ResourceVersion: retainedEvent.stored.ResourceVersion, Event: event})
+	}
+
+	return logged, nil
+}
+
+func (log *dbEventLog) IDAtResourceVersion(resourceVersion uint64) (uint64, error) {
+	log.lock.Lock()
+	lostID := log.lostID
+	pending := log.pending
+	log.lock.Unlock()
+
+	id, err := log.db.EventIDAtResourceVersion(context.Background(), log.logger, log.stream, resourceVersion)
+	if err != nil {
+		return 0, err
+	}
+	for _, pendingEvent := range pending {
+		if pendingEvent.stored.ResourceVersion <= resourceVersion && pendingEvent.stored.ID > id {
+			id = pendingEvent.stored.ID
+		}
+	}
+	if id == 0 || lostID > id {
+		return 0, ErrEventsExpired
+	}
+
+	return id, nil
+}
+
+func (log *dbEventLog) load() {
+	if log.loaded {
+		return
+	}
+
+	lastID, err := log.db.LastEventID(context.Background(), log.logger, log.stream)
+	if err != nil {
+		log.logger.Error("failed-to-load-last-event-id", err)
+		lastID = 0
+	}
+
+	if lastID == 0 {
+		lastID = initialEventID()
+	}
+
+	log.lastID = lastID
+	log.loaded = true
+}
@@ -0,0 +1,322 @@
+package events_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/gogo/protobuf/proto"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DBEventLog", func() {
+	var (
+		fakeDB   *dbfakes.FakeEventLogDB
+		eventLog events.EventLog
+		event    *models.TaskRemovedEvent
+	)
+
+	BeforeEach(func() {
+		fakeDB = new(dbfakes.FakeEventLogDB)
+		fakeDB.LastEventIDReturns(100, nil)
+		eventLog = events.NewDBEventLog(lagertest.NewTestLogger("test"), fakeDB, "tasks", 10)
+		event = models.NewTaskRemovedEvent(&models.Task{TaskGuid: "task-guid"})
+	})
+
+	It("continues from the last ID recorded in the database", func() {
+		Expect(eventLog.LastID()).To(BeEquivalentTo(100))
+		Expect(eventLog.Append(event, 0)).To(BeEquivalentTo(101))
+
+		Expect(fakeDB.LastEventIDCallCount()).To(Equal(1))
+		_, _, stream := fakeDB.LastEventIDArgsForCall(0)
+		Expect(stream).To(Equal("tasks"))
+	})
+
+	It("records appended events", func() {
+		eventLog.Append(event, 0)
+
+		Eventually(fakeDB.InsertEventCallCount).Should(Equal(1))
+		_, _, stream, stored := fakeDB.InsertEventArgsForCall(0)
+		Expect(stream).To(Equal("tasks"))
+		Expect(stored.ID).To(BeEquivalentTo(101))
+		Expect(stored.EventType).To(Equal(models.EventTypeTaskRemoved))
+
+		payload, err := proto.Marshal(event)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stored.Payload).To(Equal(payload))
+	})
+
+	It("records the resource version of appended events", func() {
+		eventLog.Append(event, 42)
+
+		Eventually(fakeDB.InsertEventCallCount).Should(Equal(1))
+		_, _, _, stored := fakeDB.InsertEventArgsForCall(0)
+		Expect(stored.ResourceVersion).To(BeEquivalentTo(42))
+	})
+
+	It("prunes events that fall out of the retained window", func() {
+		for i := 0; i < events.DB_EVENT_LOG_PRUNE_INTERVAL; i++ {
+			eventLog.Append(event, 0)
+		}
+
+		Eventually(fakeDB.DeleteEventsBeforeCallCount).Should(Equal(1))
+		_, _, stream, id := fakeDB.DeleteEventsBeforeArgsForCall(0)
+		Expect(stream).To(Equal("tasks"))
+		Expect(id).To(BeEquivalentTo(100 + events.DB_EVENT_LOG_PRUNE_INTERVAL - 10 + 1))
+	})
+
+	It("does not wait for the database to record appended events", func() {
+		inserting := make(chan struct{})
+		defer close(inserting)
+		fakeDB.InsertEventStub = func(context.Context, lager.Logger, string, db.StoredEvent) error {
+			<-inserting
+			return nil
+		}
+
+		Expect(eventLog.Append(event, 0)).To(BeEquivalentTo(101))
+		Expect(eventLog.Append(event, 0)).To(BeEquivalentTo(102))
+	})
+
+	Describe("Since", func() {
+		BeforeEach(func() {
+			for i := 0; i < 3; i++ {
+				eventLog.Append(event, 0)
+			}
+			Eventually(fakeDB.InsertEventCallCount).Should(Equal(3))
+		})
+
+		It("replays the stored events after the given ID", func() {
+			payload, err := proto.Marshal(event)
+			Expect(err).NotTo(HaveOccurred())
+			fakeDB.EventsSinceReturns([]db.StoredEvent{
+				{ID: 101, EventType: models.EventTypeTaskRemoved, Payload: payload},
+				{ID: 102, EventType: models.EventTypeTaskRemoved, Payload: payload},
+				{ID: 103, EventType: models.EventTypeTaskRemoved, Payload: payload},
+			}, nil)
+
+			replay, err := eventLog.Since(101)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(HaveLen(2))
+			Expect(replay[0].ID).To(BeEquivalentTo(102))
+			Expect(replay[0].Event).To(Equal(event))
+			Expect(replay[1].ID).To(BeEquivalentTo(103))
+
+			_, _, stream, id := fakeDB.EventsSinceArgsForCall(0)
+			Expect(stream).To(Equal("tasks"))
+			Expect(id).To(BeEquivalentTo(101))
+		})
+
+		It("does not query the database when the ID is the last one", func() {
+			replay, err := eventLog.Since(103)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(BeEmpty())
+			Expect(fakeDB.EventsSinceCallCount()).To(Equal(0))
+		})
+
+		It("reports events as expired when the given ID is no longer stored", func() {
+			fakeDB.EventsSinceReturns([]db.StoredEvent{{ID: 102}, {ID: 103}}, nil)
+
+			Eventually(func() error {
+				_, err := eventLog.Since(101)
+				return err
+			}).Should(Equal(events.ErrEventsExpired))
+		})
+
+		It("reports events as expired when the stored events have a gap", func() {
+			payload, err := proto.Marshal(event)
+			Expect(err).NotTo(HaveOccurred())
+			fakeDB.EventsSinceReturns([]db.StoredEvent{
+				{ID: 101, EventType: models.EventTypeTaskRemoved, Payload: payload},
+				{ID: 103, EventType: models.EventTypeTaskRemoved, Payload: payload},
+			}, nil)
+
+			Eventually(func() error {
+				_, err := eventLog.Since(101)
+				return err
+			}).Should(Equal(events.ErrEventsExpired))
+		})
+
+		It("reports events as expired when the gap exceeds the retained window", func() {
+			_, err := eventLog.Since(50)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+			Expect(fakeDB.EventsSinceCallCount()).To(Equal(0))
+		})
+
+		It("returns database errors", func() {
+			fakeDB.EventsSinceReturns(nil, errors.New("boom"))
+
+			_, err := eventLog.Since(101)
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+
+	Context("when events are not recorded yet", func() {
+		var inserting chan struct{}
+
+		BeforeEach(func() {
+			blocked := make(chan struct{})
+			inserting = blocked
+			fakeDB.InsertEventStub = func(context.Context, lager.Logger, string, db.StoredEvent) error {
+				<-blocked
+				return nil
+			}
+
+			for i := 0; i < 3; i++ {
+				eventLog.Append(event, uint64(40+i))
+			}
+		})
+
+		AfterEach(func() {
+			close(inserting)
+		})
+
+		It("replays them from memory", func() {
+			payload, err := proto.Marshal(event)
+			Expect(err).NotTo(HaveOccurred())
+			fakeDB.EventsSinceReturns([]db.StoredEvent{
+				{ID: 101, EventType: models.EventTypeTaskRemoved, Payload: payload},
+			}, nil)
+
+			replay, err := eventLog.Since(101)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(HaveLen(2))
+			Expect(replay[0].ID).To(BeEquivalentTo(102))
+			Expect(replay[0].ResourceVersion).To(BeEquivalentTo(41))
+			Expect(replay[0].Event).To(Equal(event))
+			Expect(replay[1].ID).To(BeEquivalentTo(103))
+		})
+
+		It("resolves resource versions to them", func() {
+			fakeDB.EventIDAtResourceVersionReturns(100, nil)
+
+			Expect(eventLog.IDAtResourceVersion(41)).To(BeEquivalentTo(102))
+		})
+	})
+
+	Context("when more events are waiting to be recorded than the log retains", func() {
+		var inserting chan struct{}
+
+		BeforeEach(func() {
+			blocked := make(chan struct{})
+			inserting = blocked
+			fakeDB.InsertEventStub = func(context.Context, lager.Logger, string, db.StoredEvent) error {
+				<-blocked
+				return nil
+			}
+
+			// 101 is being recorded, 103 to 112 are pending and 101 and 102
+			// are dropped
+			eventLog.Append(event, 40)
+			Eventually(fakeDB.InsertEventCallCount).Should(Equal(1))
+			for i := 1; i < 12; i++ {
+				eventLog.Append(event, uint64(40+i))
+			}
+		})
+
+		AfterEach(func() {
+			close(inserting)
+		})
+
+		It("still assigns the appended events IDs", func() {
+			Expect(eventLog.LastID()).To(BeEquivalentTo(112))
+		})
+
+		It("replays the events still pending", func() {
+			replay, err := eventLog.Since(103)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(HaveLen(9))
+			Expect(replay[0].ID).To(BeEquivalentTo(104))
+		})
+
+		It("reports resumes from the dropped events as expired", func() {
+			_, err := eventLog.Since(102)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+		})
+
+		It("reports resource versions before the dropped events as expired", func() {
+			fakeDB.EventIDAtResourceVersionReturns(100, nil)
+
+			_, err := eventLog.IDAtResourceVersion(41)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+		})
+
+		It("records the pending events once the database catches up", func() {
+			close(inserting)
+			inserting = make(chan struct{})
+			Eventually(fakeDB.InsertEventCallCount).Should(Equal(11))
+
+			_, _, _, stored := fakeDB.InsertEventArgsForCall(1)
+			Expect(stored.ID).To(BeEquivalentTo(103))
+			_, _, _, stored = fakeDB.InsertEventArgsForCall(10)
+			Expect(stored.ID).To(BeEquivalentTo(112))
+		})
+	})
+
+	Context("when an event fails to be recorded", func() {
+		BeforeEach(func() {
+			fakeDB.InsertEventReturnsOnCall(1, errors.New("boom"))
+			for i := 0; i < 3; i++ {
+				eventLog.Append(event, uint64(40+i))
+			}
+			Eventually(fakeDB.InsertEventCallCount).Should(Equal(3))
+		})
+
+		It("reports resumes across it as expired", func() {
+			fakeDB.EventsSinceReturns([]db.StoredEvent{{ID: 101}, {ID: 103}}, nil)
+
+			Eventually(func() error {
+				_, err := eventLog.Since(101)
+				return err
+			}).Should(Equal(events.ErrEventsExpired))
+		})
+
+		It("reports resource versions before it as expired", func() {
+			fakeDB.EventIDAtResourceVersionReturns(101, nil)
+
+			Eventually(func() error {
+				_, err := eventLog.IDAtResourceVersion(40)
+				return err
+			}).Should(Equal(events.ErrEventsExpired))
+		})
+
+		It("resumes after it", func() {
+			fakeDB.EventsSinceReturns([]db.StoredEvent{{ID: 103}}, nil)
+
+			replay, err := eventLog.Since(103)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(BeEmpty())
+		})
+	})
+
+	Describe("IDAtResourceVersion", func() {
+		It("returns the ID of the stored event at the version", func() {
+			fakeDB.EventIDAtResourceVersionReturns(102, nil)
+
+			Expect(eventLog.IDAtResourceVersion(42)).To(BeEquivalentTo(102))
+			_, _, stream, resourceVersion := fakeDB.EventIDAtResourceVersionArgsForCall(0)
+			Expect(stream).To(Equal("tasks"))
+			Expect(resourceVersion).To(BeEquivalentTo(42))
+		})
+
+		It("reports events as expired when no stored event is at or before the version", func() {
+			fakeDB.EventIDAtResourceVersionReturns(0, nil)
+
+			_, err := eventLog.IDAtResourceVersion(42)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+		})
+
+		It("returns database errors", func() {
+			fakeDB.EventIDAtResourceVersionReturns(0, errors.New("boom"))
+
+			_, err := eventLog.IDAtResourceVersion(42)
+			Expect(err).To(MatchError("boom"))
+		})
+	})
+})
@@ -0,0 +1,144 @@
+package events
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+)
+
+const DEFAULT_EVENT_LOG_SIZE = 4096
+
+var ErrEventsExpired = errors.New("events are no longer retained")
+
+// LoggedEvent is an event together with the ID its EventLog assigned to it
+// and the resource version it was emitted at.
+type LoggedEvent struct {
+	ID              uint64
+	ResourceVersion uint64
+	Event           models.Event
+}
+
+//counterfeiter:generate -o eventfakes/fake_event_log.go . EventLog
+
+// EventLog assigns monotonically increasing IDs to the events emitted on a
+// hub and retains a bounded window of them, so that subscribers can resume
+// after a disconnect without losing events.
+type EventLog interface {
+	// Append assigns the next ID to the event and records it along with the
+	// resource version it was emitted at. Resource versions never decrease
+	// from one event to the next.
+	Append(event models.Event, resourceVersion uint64) uint64
+
+	// LastID returns the ID of the most recently appended event.
+	LastID() uint64
+
+	// Since returns, oldest first, every event appended after the one with the
+	// given ID. ErrEventsExpired is returned when some of those events are no
+	// longer retained, or when the ID was never handed out by this log.
+	Since(id uint64) ([]LoggedEvent, error)
+
+	// IDAtResourceVersion returns the ID of the most recent event appended
+	// at or before the resource version, so that every event after it was
+	// emitted at a later version. ErrEventsExpired is returned when some of
+	// those later events are no longer retained.
+	IDAtResourceVersion(resourceVersion uint64) (uint64, error)
+}
+
+// initialEventID seeds a new log from the wall clock, so that IDs handed out
+// before a restart are older than any ID handed out after it and are detected
+// as expired rather than being mistaken for recent positions.
+func initialEventID() uint64 {
+	return uint64(time.Now().UnixNano())
+}
+
+type ringEventLog struct {
+	events []LoggedEvent
+	next   int
+	count  int
+	lastID uint64
+	// droppedVersion is the resource version of the most recent event that
+	// is no longer retained.
+	droppedVersion uint64
+	lock           sync.Mutex
+}
+
+// NewRingEventLog returns an in-memory EventLog that retains the most recent
+// size events.
+func NewRingEventLog(size int) EventLog {
+	return &ringEventLog{
+		events: make([]LoggedEvent, size),
+		lastID: initialEventID(),
+	}
+}
+
+func (log *ringEventLog) Append(event models.Event, resourceVersion uint64) uint64 {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	log.lastID++
+	if len(log.events) == 0 {
+		log.droppedVersion = resourceVersion
+		return log.lastID
+	}
+
+	if log.count == len(log.events) {
+		log.droppedVersion = log.events[log.next].ResourceVersion
+	}
+
+	log.events[log.next] = LoggedEvent{ID: log.lastID, ResourceVersion: resourceVersion, Event: event}
+	log.next = (log.next + 1) % len(log.events)
+	if log.count < len(log.events) {
+		log.count++
+	}
+
+	return log.lastID
+}
+
+func (log *ringEventLog) LastID() uint64 {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	return log.lastID
+}
+
+func (log *ringEventLog) Since(id uint64) ([]LoggedEvent, error) {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	if id > log.lastID {
+		return nil, ErrEventsExpired
+	}
+
+	missed := log.lastID - id
+	if missed > uint64(log.count) {
+		return nil, ErrEventsExpired
+	}
+
+	replay := make([]LoggedEvent, 0, missed)
+	for i := int(missed); i > 0; i-- {
+		index := (log.next - i + len(log.events)) % len(log.events)
+		replay = append(replay, log.events[index])
+	}
+
+	return replay, nil
+}
+
+func (log *ringEventLog) IDAtResourceVersion(resourceVersion uint64) (uint64, error) {
+	log.lock.Lock()
+	defer log.lock.Unlock()
+
+	for i := 1; i <= log.count; i++ {
+		event := log.events[(log.next-i+len(log.events))%len(log.events)]
+		if event.ResourceVersion <= resourceVersion {
+			return event.ID, nil
+		}
+	}
+
+	if log.droppedVersion > resourceVersion {
+		return 0, ErrEventsExpired
+	}
+
+	return log.lastID - uint64(log.count), nil
+}
@@ -0,0 +1,126 @@
+package events_test
+
+import (
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RingEventLog", func() {
+	var eventLog events.EventLog
+
+	BeforeEach(func() {
+		eventLog = events.NewRingEventLog(3)
+	})
+
+	It("assigns monotonically increasing IDs", func() {
+		first := eventLog.Append(eventfakes.FakeEvent{Token: "A"}, 0)
+		second := eventLog.Append(eventfakes.FakeEvent{Token: "B"}, 0)
+		Expect(second).To(Equal(first + 1))
+		Expect(eventLog.LastID()).To(Equal(second))
+	})
+
+	It("returns the events appended after the given ID, oldest first", func() {
+		start := eventLog.LastID()
+		eventLog.Append(eventfakes.FakeEvent{Token: "A"}, 0)
+		eventLog.Append(eventfakes.FakeEvent{Token: "B"}, 0)
+
+		replay, err := eventLog.Since(start + 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replay).To(Equal([]events.LoggedEvent{
+			{ID: start + 2, Event: eventfakes.FakeEvent{Token: "B"}},
+		}))
+	})
+
+	It("returns nothing when the ID is the last one", func() {
+		eventLog.Append(eventfakes.FakeEvent{Token: "A"}, 0)
+
+		replay, err := eventLog.Since(eventLog.LastID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replay).To(BeEmpty())
+	})
+
+	It("wraps around, retaining only the most recent events", func() {
+		start := eventLog.LastID()
+		for _, token := range []string{"A", "B", "C", "D"} {
+			eventLog.Append(eventfakes.FakeEvent{Token: token}, 0)
+		}
+
+		replay, err := eventLog.Since(start + 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(replay).To(Equal([]events.LoggedEvent{
+			{ID: start + 2, Event: eventfakes.FakeEvent{Token: "B"}},
+			{ID: start + 3, Event: eventfakes.FakeEvent{Token: "C"}},
+			{ID: start + 4, Event: eventfakes.FakeEvent{Token: "D"}},
+		}))
+
+		_, err = eventLog.Since(start)
+		Expect(err).To(Equal(events.ErrEventsExpired))
+	})
+
+	It("reports IDs it never handed out as expired", func() {
+		_, err := eventLog.Since(eventLog.LastID() + 1)
+		Expect(err).To(Equal(events.ErrEventsExpired))
+	})
+
+	Describe("IDAtResourceVersion", func() {
+		var start uint64
+
+		BeforeEach(func() {
+			start = eventLog.LastID()
+			eventLog.Append(eventfakes.FakeEvent{Token: "A"}, 10)
+			eventLog.Append(eventfakes.FakeEvent{Token: "B"}, 20)
+			eventLog.Append(eventfakes.FakeEvent{Token: "C"}, 20)
+		})
+
+		It("returns the ID of the most recent event at or before the version", func() {
+			Expect(eventLog.IDAtResourceVersion(10)).To(Equal(start + 1))
+			Expect(eventLog.IDAtResourceVersion(15)).To(Equal(start + 1))
+			Expect(eventLog.IDAtResourceVersion(20)).To(Equal(start + 3))
+		})
+
+		It("returns the ID before the first event when every event is more recent", func() {
+			Expect(eventLog.IDAtResourceVersion(5)).To(Equal(start))
+		})
+
+		It("records the version along with the event", func() {
+			replay, err := eventLog.Since(start + 2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(replay).To(Equal([]events.LoggedEvent{
+				{ID: start + 3, ResourceVersion: 20, Event: eventfakes.FakeEvent{Token: "C"}},
+			}))
+		})
+
+		Context("when events after the version are no longer retained", func() {
+			BeforeEach(func() {
+				eventLog.Append(eventfakes.FakeEvent{Token: "D"}, 30)
+			})
+
+			It("reports them as expired", func() {
+				_, err := eventLog.IDAtResourceVersion(5)
+				Expect(err).To(Equal(events.ErrEventsExpired))
+			})
+
+			It("still finds versions after the dropped events", func() {
+				Expect(eventLog.IDAtResourceVersion(10)).To(Equal(start + 1))
+				Expect(eventLog.IDAtResourceVersion(25)).To(Equal(start + 3))
+			})
+		})
+	})
+
+	Context("when the log retains nothing", func() {
+		BeforeEach(func() {
+			eventLog = events.NewRingEventLog(0)
+		})
+
+		It("still assigns IDs but cannot replay", func() {
+			start := eventLog.LastID()
+			Expect(eventLog.Append(eventfakes.FakeEvent{Token: "A"}, 0)).To(Equal(start + 1))
+
+			_, err := eventLog.Since(start)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+		})
+	})
+})
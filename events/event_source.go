@@ -56,6 +56,12 @@ func (e closeError) Error() string {
 }
 
 func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error) {
+	return NewEventWithID(strconv.Itoa(eventID), event)
+}
+
+// NewEventWithID is like NewEventFromModelEvent but takes the SSE event ID
+// verbatim, for streams whose IDs are not plain counters.
+func NewEventWithID(eventID string, event models.Event) (sse.Event, error) {
 	payload, err := proto.Marshal(event)
 	if err != nil {
 		return sse.Event{}, err
@@ -63,7 +69,7 @@ func NewEventFromModelEvent(eventID int, event models.Event) (sse.Event, error)
 
 	encodedPayload := base64.StdEncoding.EncodeToString(payload)
 	return sse.Event{
-		ID:   strconv.Itoa(eventID),
+		ID:   eventID,
 		Name: string(event.EventType()),
 		Data: []byte(encodedPayload),
 	}, nil
@@ -88,6 +94,15 @@ type EventSource interface {
 	Close() error
 }
 
+//counterfeiter:generate -o eventfakes/fake_logged_event_source.go . LoggedEventSource
+
+// LoggedEventSource provides sequential access to the events of a hub along
+// with the IDs its EventLog assigned to them.
+type LoggedEventSource interface {
+	Next() (LoggedEvent, error)
+	Close() error
+}
+
 //counterfeiter:generate -o eventfakes/fake_raw_event_source.go . RawEventSource
 
 type RawEventSource interface {
@@ -140,12 +155,20 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		return nil, NewInvalidPayloadError(rawEvent.Name, err)
 	}
 
-	switch rawEvent.Name {
+	return decodeEvent(rawEvent.Name, data)
+}
+
+func parseProtoEvent(rawEvent sse.Event) (models.Event, error) {
+	return decodeEvent(rawEvent.Name, rawEvent.Data)
+}
+
+func decodeEvent(eventType string, data []byte) (models.Event, error) {
+	switch eventType {
 	case models.EventTypeDesiredLRPCreated:
 		event := new(models.DesiredLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -154,7 +177,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -163,7 +186,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.DesiredLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -174,7 +197,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -185,7 +208,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -196,7 +219,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -205,7 +228,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPCrashedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -214,7 +237,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.TaskCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -223,7 +246,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.TaskChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -232,7 +255,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.TaskRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -241,7 +264,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPInstanceCreatedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -250,7 +273,7 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPInstanceChangedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
@@ -259,7 +282,43 @@ func parseRawEvent(rawEvent sse.Event) (models.Event, error) {
 		event := new(models.ActualLRPInstanceRemovedEvent)
 		err := proto.Unmarshal(data, event)
 		if err != nil {
-			return nil, NewInvalidPayloadError(rawEvent.Name, err)
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeDeploymentChanged:
+		event := new(models.DeploymentChangedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeCrashStormBreakerChanged:
+		event := new(models.CrashStormBreakerChangedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeCellDrainChanged:
+		event := new(models.CellDrainChangedEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
+		}
+
+		return event, nil
+
+	case models.EventTypeResyncRequired:
+		event := new(models.ResyncRequiredEvent)
+		err := proto.Unmarshal(data, event)
+		if err != nil {
+			return nil, NewInvalidPayloadError(eventType, err)
 		}
 
 		return event, nil
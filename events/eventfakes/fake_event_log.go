@@ -0,0 +1,337 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package eventfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+)
+
+type FakeEventLog struct {
+	AppendStub        func(models.Event, uint64) uint64
+	appendMutex       sync.RWMutex
+	appendArgsForCall []struct {
+		arg1 models.Event
+		arg2 uint64
+	}
+	appendReturns struct {
+		result1 uint64
+	}
+	appendReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	IDAtResourceVersionStub        func(uint64) (uint64, error)
+	iDAtResourceVersionMutex       sync.RWMutex
+	iDAtResourceVersionArgsForCall []struct {
+		arg1 uint64
+	}
+	iDAtResourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	iDAtResourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	LastIDStub        func() uint64
+	lastIDMutex       sync.RWMutex
+	lastIDArgsForCall []struct {
+	}
+	lastIDReturns struct {
+		result1 uint64
+	}
+	lastIDReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	SinceStub        func(uint64) ([]events.LoggedEvent, error)
+	sinceMutex       sync.RWMutex
+	sinceArgsForCall []struct {
+		arg1 uint64
+	}
+	sinceReturns struct {
+		result1 []events.LoggedEvent
+		result2 error
+	}
+	sinceReturnsOnCall map[int]struct {
+		result1 []events.LoggedEvent
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeEventLog) Append(arg1 models.Event, arg2 uint64) uint64 {
+	fake.appendMutex.Lock()
+	ret, specificReturn := fake.appendReturnsOnCall[len(fake.appendArgsForCall)]
+	fake.appendArgsForCall = append(fake.appendArgsForCall, struct {
+		arg1 models.Event
+		arg2 uint64
+	}{arg1, arg2})
+	stub := fake.AppendStub
+	fakeReturns := fake.appendReturns
+	fake.recordInvocation("Append", []interface{}{arg1, arg2})
+	fake.appendMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEventLog) AppendCallCount() int {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	return len(fake.appendArgsForCall)
+}
+
+func (fake *FakeEventLog) AppendCalls(stub func(models.Event, uint64) uint64) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = stub
+}
+
+func (fake *FakeEventLog) AppendArgsForCall(i int) (models.Event, uint64) {
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	argsForCall := fake.appendArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEventLog) AppendReturns(result1 uint64) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = nil
+	fake.appendReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeEventLog) AppendReturnsOnCall(i int, result1 uint64) {
+	fake.appendMutex.Lock()
+	defer fake.appendMutex.Unlock()
+	fake.AppendStub = nil
+	if fake.appendReturnsOnCall == nil {
+		fake.appendReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.appendReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeEventLog) IDAtResourceVersion(arg1 uint64) (uint64, error) {
+	fake.iDAtResourceVersionMutex.Lock()
+	ret, specificReturn := fake.iDAtResourceVersionReturnsOnCall[len(fake.iDAtResourceVersionArgsForCall)]
+	fake.iDAtResourceVersionArgsForCall = append(fake.iDAtResourceVersionArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	stub := fake.IDAtResourceVersionStub
+	fakeReturns := fake.iDAtResourceVersionReturns
+	fake.recordInvocation("IDAtResourceVersion", []interface{}{arg1})
+	fake.iDAtResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventLog) IDAtResourceVersionCallCount() int {
+	fake.iDAtResourceVersionMutex.RLock()
+	defer fake.iDAtResourceVersionMutex.RUnlock()
+	return len(fake.iDAtResourceVersionArgsForCall)
+}
+
+func (fake *FakeEventLog) IDAtResourceVersionCalls(stub func(uint64) (uint64, error)) {
+	fake.iDAtResourceVersionMutex.Lock()
+	defer fake.iDAtResourceVersionMutex.Unlock()
+	fake.IDAtResourceVersionStub = stub
+}
+
+func (fake *FakeEventLog) IDAtResourceVersionArgsForCall(i int) uint64 {
+	fake.iDAtResourceVersionMutex.RLock()
+	defer fake.iDAtResourceVersionMutex.RUnlock()
+	argsForCall := fake.iDAtResourceVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEventLog) IDAtResourceVersionReturns(result1 uint64, result2 error) {
+	fake.iDAtResourceVersionMutex.Lock()
+	defer fake.iDAtResourceVersionMutex.Unlock()
+	fake.IDAtResourceVersionStub = nil
+	fake.iDAtResourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLog) IDAtResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.iDAtResourceVersionMutex.Lock()
+	defer fake.iDAtResourceVersionMutex.Unlock()
+	fake.IDAtResourceVersionStub = nil
+	if fake.iDAtResourceVersionReturnsOnCall == nil {
+		fake.iDAtResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.iDAtResourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLog) LastID() uint64 {
+	fake.lastIDMutex.Lock()
+	ret, specificReturn := fake.lastIDReturnsOnCall[len(fake.lastIDArgsForCall)]
+	fake.lastIDArgsForCall = append(fake.lastIDArgsForCall, struct {
+	}{})
+	stub := fake.LastIDStub
+	fakeReturns := fake.lastIDReturns
+	fake.recordInvocation("LastID", []interface{}{})
+	fake.lastIDMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeEventLog) LastIDCallCount() int {
+	fake.lastIDMutex.RLock()
+	defer fake.lastIDMutex.RUnlock()
+	return len(fake.lastIDArgsForCall)
+}
+
+func (fake *FakeEventLog) LastIDCalls(stub func() uint64) {
+	fake.lastIDMutex.Lock()
+	defer fake.lastIDMutex.Unlock()
+	fake.LastIDStub = stub
+}
+
+func (fake *FakeEventLog) LastIDReturns(result1 uint64) {
+	fake.lastIDMutex.Lock()
+	defer fake.lastIDMutex.Unlock()
+	fake.LastIDStub = nil
+	fake.lastIDReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeEventLog) LastIDReturnsOnCall(i int, result1 uint64) {
+	fake.lastIDMutex.Lock()
+	defer fake.lastIDMutex.Unlock()
+	fake.LastIDStub = nil
+	if fake.lastIDReturnsOnCall == nil {
+		fake.lastIDReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.lastIDReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeEventLog) Since(arg1 uint64) ([]events.LoggedEvent, error) {
+	fake.sinceMutex.Lock()
+	ret, specificReturn := fake.sinceReturnsOnCall[len(fake.sinceArgsForCall)]
+	fake.sinceArgsForCall = append(fake.sinceArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	stub := fake.SinceStub
+	fakeReturns := fake.sinceReturns
+	fake.recordInvocation("Since", []interface{}{arg1})
+	fake.sinceMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeEventLog) SinceCallCount() int {
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	return len(fake.sinceArgsForCall)
+}
+
+func (fake *FakeEventLog) SinceCalls(stub func(uint64) ([]events.LoggedEvent, error)) {
+	fake.sinceMutex.Lock()
+	defer fake.sinceMutex.Unlock()
+	fake.SinceStub = stub
+}
+
+func (fake *FakeEventLog) SinceArgsForCall(i int) uint64 {
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	argsForCall := fake.sinceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeEventLog) SinceReturns(result1 []events.LoggedEvent, result2 error) {
+	fake.sinceMutex.Lock()
+	defer fake.sinceMutex.Unlock()
+	fake.SinceStub = nil
+	fake.sinceReturns = struct {
+		result1 []events.LoggedEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLog) SinceReturnsOnCall(i int, result1 []events.LoggedEvent, result2 error) {
+	fake.sinceMutex.Lock()
+	defer fake.sinceMutex.Unlock()
+	fake.SinceStub = nil
+	if fake.sinceReturnsOnCall == nil {
+		fake.sinceReturnsOnCall = make(map[int]struct {
+			result1 []events.LoggedEvent
+			result2 error
+		})
+	}
+	fake.sinceReturnsOnCall[i] = struct {
+		result1 []events.LoggedEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeEventLog) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.appendMutex.RLock()
+	defer fake.appendMutex.RUnlock()
+	fake.iDAtResourceVersionMutex.RLock()
+	defer fake.iDAtResourceVersionMutex.RUnlock()
+	fake.lastIDMutex.RLock()
+	defer fake.lastIDMutex.RUnlock()
+	fake.sinceMutex.RLock()
+	defer fake.sinceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeEventLog) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ events.EventLog = new(FakeEventLog)
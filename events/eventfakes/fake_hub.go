@@ -24,11 +24,48 @@ type FakeHub struct {
 	emitArgsForCall []struct {
 		arg1 models.Event
 	}
+	EventIDAtResourceVersionStub        func(uint64) (uint64, error)
+	eventIDAtResourceVersionMutex       sync.RWMutex
+	eventIDAtResourceVersionArgsForCall []struct {
+		arg1 uint64
+	}
+	eventIDAtResourceVersionReturns struct {
+		result1 uint64
+		result2 error
+	}
+	eventIDAtResourceVersionReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 error
+	}
+	LastEventIDStub        func() uint64
+	lastEventIDMutex       sync.RWMutex
+	lastEventIDArgsForCall []struct {
+	}
+	lastEventIDReturns struct {
+		result1 uint64
+	}
+	lastEventIDReturnsOnCall map[int]struct {
+		result1 uint64
+	}
 	RegisterCallbackStub        func(func(count int))
 	registerCallbackMutex       sync.RWMutex
 	registerCallbackArgsForCall []struct {
 		arg1 func(count int)
 	}
+	ResumeStub        func(uint64, models.EventFilter) (events.LoggedEventSource, error)
+	resumeMutex       sync.RWMutex
+	resumeArgsForCall []struct {
+		arg1 uint64
+		arg2 models.EventFilter
+	}
+	resumeReturns struct {
+		result1 events.LoggedEventSource
+		result2 error
+	}
+	resumeReturnsOnCall map[int]struct {
+		result1 events.LoggedEventSource
+		result2 error
+	}
 	SubscribeStub        func() (events.EventSource, error)
 	subscribeMutex       sync.RWMutex
 	subscribeArgsForCall []struct {
@@ -134,6 +171,123 @@ func (fake *FakeHub) EmitArgsForCall(i int) models.Event {
 	return argsForCall.arg1
 }
 
+func (fake *FakeHub) EventIDAtResourceVersion(arg1 uint64) (uint64, error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	ret, specificReturn := fake.eventIDAtResourceVersionReturnsOnCall[len(fake.eventIDAtResourceVersionArgsForCall)]
+	fake.eventIDAtResourceVersionArgsForCall = append(fake.eventIDAtResourceVersionArgsForCall, struct {
+		arg1 uint64
+	}{arg1})
+	stub := fake.EventIDAtResourceVersionStub
+	fakeReturns := fake.eventIDAtResourceVersionReturns
+	fake.recordInvocation("EventIDAtResourceVersion", []interface{}{arg1})
+	fake.eventIDAtResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHub) EventIDAtResourceVersionCallCount() int {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	return len(fake.eventIDAtResourceVersionArgsForCall)
+}
+
+func (fake *FakeHub) EventIDAtResourceVersionCalls(stub func(uint64) (uint64, error)) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = stub
+}
+
+func (fake *FakeHub) EventIDAtResourceVersionArgsForCall(i int) uint64 {
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	argsForCall := fake.eventIDAtResourceVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHub) EventIDAtResourceVersionReturns(result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	fake.eventIDAtResourceVersionReturns = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHub) EventIDAtResourceVersionReturnsOnCall(i int, result1 uint64, result2 error) {
+	fake.eventIDAtResourceVersionMutex.Lock()
+	defer fake.eventIDAtResourceVersionMutex.Unlock()
+	fake.EventIDAtResourceVersionStub = nil
+	if fake.eventIDAtResourceVersionReturnsOnCall == nil {
+		fake.eventIDAtResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 error
+		})
+	}
+	fake.eventIDAtResourceVersionReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHub) LastEventID() uint64 {
+	fake.lastEventIDMutex.Lock()
+	ret, specificReturn := fake.lastEventIDReturnsOnCall[len(fake.lastEventIDArgsForCall)]
+	fake.lastEventIDArgsForCall = append(fake.lastEventIDArgsForCall, struct {
+	}{})
+	stub := fake.LastEventIDStub
+	fakeReturns := fake.lastEventIDReturns
+	fake.recordInvocation("LastEventID", []interface{}{})
+	fake.lastEventIDMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHub) LastEventIDCallCount() int {
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
+	return len(fake.lastEventIDArgsForCall)
+}
+
+func (fake *FakeHub) LastEventIDCalls(stub func() uint64) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = stub
+}
+
+func (fake *FakeHub) LastEventIDReturns(result1 uint64) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	fake.lastEventIDReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeHub) LastEventIDReturnsOnCall(i int, result1 uint64) {
+	fake.lastEventIDMutex.Lock()
+	defer fake.lastEventIDMutex.Unlock()
+	fake.LastEventIDStub = nil
+	if fake.lastEventIDReturnsOnCall == nil {
+		fake.lastEventIDReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.lastEventIDReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
 func (fake *FakeHub) RegisterCallback(arg1 func(count int)) {
 	fake.registerCallbackMutex.Lock()
 	fake.registerCallbackArgsForCall = append(fake.registerCallbackArgsForCall, struct {
@@ -166,6 +320,71 @@ func (fake *FakeHub) RegisterCallbackArgsForCall(i int) func(count int) {
 	return argsForCall.arg1
 }
 
+func (fake *FakeHub) Resume(arg1 uint64, arg2 models.EventFilter) (events.LoggedEventSource, error) {
+	fake.resumeMutex.Lock()
+	ret, specificReturn := fake.resumeReturnsOnCall[len(fake.resumeArgsForCall)]
+	fake.resumeArgsForCall = append(fake.resumeArgsForCall, struct {
+		arg1 uint64
+		arg2 models.EventFilter
+	}{arg1, arg2})
+	stub := fake.ResumeStub
+	fakeReturns := fake.resumeReturns
+	fake.recordInvocation("Resume", []interface{}{arg1, arg2})
+	fake.resumeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeHub) ResumeCallCount() int {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	return len(fake.resumeArgsForCall)
+}
+
+func (fake *FakeHub) ResumeCalls(stub func(uint64, models.EventFilter) (events.LoggedEventSource, error)) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = stub
+}
+
+func (fake *FakeHub) ResumeArgsForCall(i int) (uint64, models.EventFilter) {
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
+	argsForCall := fake.resumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeHub) ResumeReturns(result1 events.LoggedEventSource, result2 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	fake.resumeReturns = struct {
+		result1 events.LoggedEventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeHub) ResumeReturnsOnCall(i int, result1 events.LoggedEventSource, result2 error) {
+	fake.resumeMutex.Lock()
+	defer fake.resumeMutex.Unlock()
+	fake.ResumeStub = nil
+	if fake.resumeReturnsOnCall == nil {
+		fake.resumeReturnsOnCall = make(map[int]struct {
+			result1 events.LoggedEventSource
+			result2 error
+		})
+	}
+	fake.resumeReturnsOnCall[i] = struct {
+		result1 events.LoggedEventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeHub) Subscribe() (events.EventSource, error) {
 	fake.subscribeMutex.Lock()
 	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
@@ -253,8 +472,14 @@ func (fake *FakeHub) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.emitMutex.RLock()
 	defer fake.emitMutex.RUnlock()
+	fake.eventIDAtResourceVersionMutex.RLock()
+	defer fake.eventIDAtResourceVersionMutex.RUnlock()
+	fake.lastEventIDMutex.RLock()
+	defer fake.lastEventIDMutex.RUnlock()
 	fake.registerCallbackMutex.RLock()
 	defer fake.registerCallbackMutex.RUnlock()
+	fake.resumeMutex.RLock()
+	defer fake.resumeMutex.RUnlock()
 	fake.subscribeMutex.RLock()
 	defer fake.subscribeMutex.RUnlock()
 	fake.unregisterCallbackMutex.RLock()
@@ -0,0 +1,197 @@
+package events
+
+import "code.cloudfoundry.org/bbs/models"
+
+// eventMatcher applies the domain, guid, event type and label selector
+// fields of an EventFilter. Cell filtering is left to the event handlers, since the
+// deprecated ActualLRPGroup events have to be resolved before their cell is
+// known.
+type eventMatcher struct {
+	domain        string
+	processGuids  map[string]struct{}
+	taskGuids     map[string]struct{}
+	eventTypes    map[string]struct{}
+	labelSelector models.LabelSelector
+}
+
+// newEventMatcher returns nil when the filter matches every event.
+func newEventMatcher(filter models.EventFilter) *eventMatcher {
+	if filter.Domain == "" && len(filter.ProcessGuids) == 0 && len(filter.TaskGuids) == 0 &&
+		len(filter.EventTypes) == 0 && len(filter.LabelSelector) == 0 {
+		return nil
+	}
+
+	return &eventMatcher{
+		domain:        filter.Domain,
+		processGuids:  toSet(filter.ProcessGuids),
+		taskGuids:     toSet(filter.TaskGuids),
+		eventTypes:    toSet(filter.EventTypes),
+		labelSelector: filter.LabelSelector,
+	}
+}
+
+func (matcher *eventMatcher) matches(event models.Event) bool {
+	switch event.(type) {
+	case *models.DeploymentChangedEvent, *models.CrashStormBreakerChangedEvent, *models.CellDrainChangedEvent:
+		return matcher.requests(event.EventType()) && matcher.matchesKeys(event)
+	}
+
+	if matcher == nil {
+		return true
+	}
+
+	if _, ok := event.(*models.ResyncRequiredEvent); ok {
+		return true
+	}
+
+	if matcher.eventTypes != nil {
+		if _, ok := matcher.eventTypes[event.EventType()]; !ok {
+			return false
+		}
+	}
+
+	return matcher.matchesKeys(event)
+}
+
+// requests reports whether the filter asks for the event type explicitly.
+// Deployment, crash storm breaker and cell drain events are only sent to
+// subscribers that do, so that existing subscribers are not sent events they
+// cannot decode.
+func (matcher *eventMatcher) requests(eventType string) bool {
+	if matcher == nil {
+		return false
+	}
+	_, ok := matcher.eventTypes[eventType]
+	return ok
+}
+
+func (matcher *eventMatcher) matchesKeys(event models.Event) bool {
+	keys := keysOf(event)
+
+	if matcher.domain != "" && matcher.domain != keys.domain {
+		return false
+	}
+
+	if matcher.processGuids != nil {
+		if _, ok := matcher.processGuids[keys.processGuid]; !ok {
+			return false
+		}
+	}
+
+	if matcher.taskGuids != nil {
+		if _, ok := matcher.taskGuids[keys.taskGuid]; !ok {
+			return false
+		}
+	}
+
+	return matcher.labelSelector.Matches(keys.labels)
+}
+
+// eventKeys are the attributes of an event that subscribers can filter on.
+// Those that do not apply to an event are left empty; in particular ActualLRP
+// events carry no labels.
+type eventKeys struct {
+	domain      string
+	processGuid string
+	taskGuid    string
+	labels      map[string]string
+}
+
+func keysOf(event models.Event) eventKeys {
+	switch event := event.(type) {
+	case *models.DesiredLRPCreatedEvent:
+		return desiredLRPKeys(event.DesiredLrp)
+	case *models.DesiredLRPChangedEvent:
+		return desiredLRPKeys(event.After)
+	case *models.DesiredLRPRemovedEvent:
+		return desiredLRPKeys(event.DesiredLrp)
+
+	//lint:ignore SA1019 - need to support this event until the deprecation becomes deletion
+	case *models.ActualLRPCreatedEvent:
+		return actualLRPGroupKeys(event.ActualLrpGroup)
+	//lint:ignore SA1019 - need to support this event until the deprecation becomes deletion
+	case *models.ActualLRPChangedEvent:
+		return actualLRPGroupKeys(event.After)
+	//lint:ignore SA1019 - need to support this event until the deprecation becomes deletion
+	case *models.ActualLRPRemovedEvent:
+		return actualLRPGroupKeys(event.ActualLrpGroup)
+
+	case *models.ActualLRPInstanceCreatedEvent:
+		return actualLRPKeys(event.ActualLrp)
+	case *models.ActualLRPInstanceChangedEvent:
+		return eventKeys{domain: event.Domain, processGuid: event.ProcessGuid}
+	case *models.ActualLRPInstanceRemovedEvent:
+		return actualLRPKeys(event.ActualLrp)
+	case *models.ActualLRPCrashedEvent:
+		return eventKeys{domain: event.Domain, processGuid: event.ProcessGuid}
+
+	case *models.DeploymentChangedEvent:
+		return eventKeys{domain: event.Deployment.GetDomain(), processGuid: event.Deployment.GetProcessGuid()}
+	case *models.CrashStormBreakerChangedEvent:
+		return crashStormBreakerKeys(event.Breaker)
+
+	case *models.TaskCreatedEvent:
+		return taskKeys(event.Task)
+	case *models.TaskChangedEvent:
+		return taskKeys(event.After)
+	case *models.TaskRemovedEvent:
+		return taskKeys(event.Task)
+	}
+
+	return eventKeys{}
+}
+
+func desiredLRPKeys(desiredLRP *models.DesiredLRP) eventKeys {
+	if desiredLRP == nil {
+		return eventKeys{}
+	}
+	return eventKeys{domain: desiredLRP.Domain, processGuid: desiredLRP.ProcessGuid, labels: desiredLRP.Labels}
+}
+
+func actualLRPGroupKeys(group *models.ActualLRPGroup) eventKeys {
+	if group == nil {
+		return eventKeys{}
+	}
+	if group.Instance != nil {
+		return actualLRPKeys(group.Instance)
+	}
+	return actualLRPKeys(group.Evacuating)
+}
+
+func actualLRPKeys(actualLRP *models.ActualLRP) eventKeys {
+	if actualLRP == nil {
+		return eventKeys{}
+	}
+	return eventKeys{domain: actualLRP.Domain, processGuid: actualLRP.ProcessGuid}
+}
+
+func taskKeys(task *models.Task) eventKeys {
+	if task == nil {
+		return eventKeys{}
+	}
+	return eventKeys{domain: task.Domain, taskGuid: task.TaskGuid, labels: task.GetLabels()}
+}
+
+// crashStormBreakerKeys returns the domain or process guid that the breaker
+// pauses. Global breakers have neither.
+func crashStormBreakerKeys(breaker *models.CrashStormBreaker) eventKeys {
+	switch breaker.GetScope() {
+	case models.CrashStormBreaker_Domain:
+		return eventKeys{domain: breaker.GetKey()}
+	case models.CrashStormBreaker_ProcessGuid:
+		return eventKeys{processGuid: breaker.GetKey()}
+	}
+	return eventKeys{}
+}
+
+func toSet(values []string) map[string]struct{} {
+	if len(values) == 0 {
+		return nil
+	}
+
+	set := make(map[string]struct{}, len(values))
+	for _, value := range values {
+		set[value] = struct{}{}
+	}
+	return set
+}
@@ -1,7 +1,9 @@
 package events
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -24,22 +26,75 @@ type Hub interface {
 	Emit(models.Event)
 	Close() error
 
+	// LastEventID returns the ID of the most recently emitted event.
+	LastEventID() uint64
+
+	// Resume subscribes to the hub after first replaying every event emitted
+	// after lastEventID. When those events are no longer retained, a
+	// ResyncRequiredEvent is delivered in their place. Only events matching
+	// the filter are replayed or queued for the subscriber; its CellID is not
+	// applied by the hub.
+	Resume(lastEventID uint64, filter models.EventFilter) (LoggedEventSource, error)
+
+	// EventIDAtResourceVersion returns the ID to Resume from to receive the
+	// event of every write after the resource version. ErrEventsExpired is
+	// returned when the hub cannot tell which events those are: it has no
+	// resource versions, the version precedes the first one it accepts
+	// events from, or some of the events after it are no longer retained.
+	EventIDAtResourceVersion(resourceVersion uint64) (uint64, error)
+
 	RegisterCallback(func(count int))
 	UnregisterCallback()
 }
 
+//counterfeiter:generate -o eventfakes/fake_resource_version_source.go . ResourceVersionSource
+
+// ResourceVersionSource tells the hub the resource version it starts at.
+type ResourceVersionSource interface {
+	// ResourceVersion returns the version of the most recently committed
+	// write.
+	ResourceVersion(ctx context.Context, logger lager.Logger) (uint64, error)
+}
+
 type hub struct {
 	subscribers map[*hubSource]struct{}
+	eventLog    EventLog
+	versions    ResourceVersionSource
 	closed      bool
 	lock        sync.Mutex
 	logger      lager.Logger
 
+	// firstVersion is the earliest resource version the hub can resume after.
+	// Writes before it may have been made by another BBS, whose events the
+	// hub never saw. It is loaded from the source on first use, under
+	// versionLock rather than lock so that emitting does not wait on it.
+	firstVersion       uint64
+	firstVersionLoaded bool
+	versionLock        sync.Mutex
+	// lastVersion is the resource version of the most recently appended
+	// event.
+	lastVersion uint64
+
 	cb func(count int)
 }
 
 func NewHub(logger lager.Logger) Hub {
+	return NewHubWithEventLog(logger, NewRingEventLog(DEFAULT_EVENT_LOG_SIZE))
+}
+
+func NewHubWithEventLog(logger lager.Logger, eventLog EventLog) Hub {
+	return NewVersionedHub(logger, eventLog, nil)
+}
+
+// NewVersionedHub returns a hub that records every event with the resource
+// version of its write, so that subscribers can resume after a resource
+// version. Events are accepted from the version versions reports on first
+// use.
+func NewVersionedHub(logger lager.Logger, eventLog EventLog, versions ResourceVersionSource) Hub {
 	return &hub{
 		subscribers: make(map[*hubSource]struct{}),
+		eventLog:    eventLog,
+		versions:    versions,
 		logger:      logger,
 	}
 }
@@ -69,7 +124,7 @@ func (hub *hub) Subscribe() (EventSource, error) {
 		return nil, ErrSubscribedToClosedHub
 	}
 
-	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, hub.subscriberClosed)
+	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS, nil, hub.subscriberClosed)
 	hub.subscribers[sub] = struct{}{}
 	cb := hub.cb
 	size := len(hub.subscribers)
@@ -81,12 +136,143 @@ func (hub *hub) Subscribe() (EventSource, error) {
 	return sub, nil
 }
 
+func (hub *hub) LastEventID() uint64 {
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+
+	return hub.eventLog.LastID()
+}
+
+func (hub *hub) Resume(lastEventID uint64, filter models.EventFilter) (LoggedEventSource, error) {
+	hub.lock.Lock()
+
+	if hub.closed {
+		hub.lock.Unlock()
+
+		return nil, ErrSubscribedToClosedHub
+	}
+
+	replay, err := hub.eventLog.Since(lastEventID)
+	if err != nil {
+		if err != ErrEventsExpired {
+			hub.logger.Error("failed-to-replay-events", err, lager.Data{"last_event_id": lastEventID})
+		}
+		reason := fmt.Sprintf("events after %d are no longer retained", lastEventID)
+		replay = []LoggedEvent{{ID: hub.eventLog.LastID(), Event: models.NewResyncRequiredEvent(reason)}}
+	}
+
+	matcher := newEventMatcher(filter)
+	matched := make([]LoggedEvent, 0, len(replay))
+	for _, event := range replay {
+		if matcher.matches(event.Event) {
+			matched = append(matched, event)
+		}
+	}
+
+	sub := newSource(MAX_PENDING_SUBSCRIBER_EVENTS+len(matched), matcher, hub.subscriberClosed)
+	for _, event := range matched {
+		sub.events <- event
+	}
+	hub.subscribers[sub] = struct{}{}
+	cb := hub.cb
+	size := len(hub.subscribers)
+	hub.lock.Unlock()
+
+	if cb != nil {
+		cb(size)
+	}
+	return &loggedHubSource{source: sub}, nil
+}
+
+func (hub *hub) EventIDAtResourceVersion(resourceVersion uint64) (uint64, error) {
+	if hub.versions == nil {
+		return 0, ErrEventsExpired
+	}
+
+	firstVersion, err := hub.loadFirstVersion()
+	if err != nil {
+		return 0, err
+	}
+	if resourceVersion < firstVersion {
+		return 0, ErrEventsExpired
+	}
+
+	hub.lock.Lock()
+	defer hub.lock.Unlock()
+
+	if resourceVersion >= hub.lastVersion {
+		return hub.eventLog.LastID(), nil
+	}
+
+	return hub.eventLog.IDAtResourceVersion(resourceVersion)
+}
+
+// loadFirstVersion returns the first version, loading it from the source on
+// first use.
+func (hub *hub) loadFirstVersion() (uint64, error) {
+	hub.versionLock.Lock()
+	defer hub.versionLock.Unlock()
+
+	if !hub.firstVersionLoaded {
+		version, err := hub.versions.ResourceVersion(context.Background(), hub.logger)
+		if err != nil {
+			return 0, err
+		}
+		hub.firstVersion = version
+		hub.firstVersionLoaded = true
+	}
+
+	return hub.firstVersion, nil
+}
+
+// appendedVersion returns the resource version to append the event at: that
+// of its write, raised to the version of the event before it so that the
+// versions of appended events never decrease. It must be called with the
+// lock held. Writes are not emitted in the order of their versions, and an
+// event raised past its write is only replayed to more subscribers than
+// need it.
+func (hub *hub) appendedVersion(event models.Event, firstVersion uint64) uint64 {
+	if hub.versions == nil {
+		return 0
+	}
+
+	version := firstVersion
+	if versioned, ok := event.(models.VersionedEvent); ok && versioned.GetResourceVersion() > version {
+		version = versioned.GetResourceVersion()
+	}
+	if version < hub.lastVersion {
+		version = hub.lastVersion
+	}
+
+	hub.lastVersion = version
+	return version
+}
+
 func (hub *hub) Emit(event models.Event) {
+	var firstVersion uint64
+	if hub.versions != nil {
+		var err error
+		firstVersion, err = hub.loadFirstVersion()
+		if err != nil {
+			hub.logger.Error("failed-to-get-resource-version", err)
+		}
+	}
+
 	hub.lock.Lock()
 	size := len(hub.subscribers)
 
+	resourceVersion := hub.appendedVersion(event, firstVersion)
+	loggedEvent := LoggedEvent{
+		ID:              hub.eventLog.Append(event, resourceVersion),
+		ResourceVersion: resourceVersion,
+		Event:           event,
+	}
 	for sub := range hub.subscribers {
-		err := sub.send(event)
+		if !sub.matcher.matches(event) {
+			continue
+		}
+
+		err := sub.send(loggedEvent)
 		if err != nil {
 			hub.logger.Error("got-error-sending-event", err)
 			delete(hub.subscribers, sub)
@@ -141,15 +327,17 @@ func (hub *hub) subscriberClosed(source *hubSource) {
 }
 
 type hubSource struct {
-	events        chan models.Event
+	events        chan LoggedEvent
+	matcher       *eventMatcher
 	closeCallback func(*hubSource)
 	closed        bool
 	lock          sync.Mutex
 }
 
-func newSource(maxPendingEvents int, closeCallback func(*hubSource)) *hubSource {
+func newSource(maxPendingEvents int, matcher *eventMatcher, closeCallback func(*hubSource)) *hubSource {
 	return &hubSource{
-		events:        make(chan models.Event, maxPendingEvents),
+		events:        make(chan LoggedEvent, maxPendingEvents),
+		matcher:       matcher,
 		closeCallback: closeCallback,
 	}
 }
@@ -159,7 +347,7 @@ func (source *hubSource) Next() (models.Event, error) {
 	if !ok {
 		return nil, ErrReadFromClosedSource
 	}
-	return event, nil
+	return event.Event, nil
 }
 
 func (source *hubSource) Close() error {
@@ -175,7 +363,7 @@ func (source *hubSource) Close() error {
 	return nil
 }
 
-func (source *hubSource) send(event models.Event) error {
+func (source *hubSource) send(event LoggedEvent) error {
 	source.lock.Lock()
 
 	if source.closed {
@@ -198,3 +386,19 @@ func (source *hubSource) send(event models.Event) error {
 		return ErrSlowConsumer
 	}
 }
+
+type loggedHubSource struct {
+	source *hubSource
+}
+
+func (s *loggedHubSource) Next() (LoggedEvent, error) {
+	event, ok := <-s.source.events
+	if !ok {
+		return LoggedEvent{}, ErrReadFromClosedSource
+	}
+	return event, nil
+}
+
+func (s *loggedHubSource) Close() error {
+	return s.source.Close()
+}
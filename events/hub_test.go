@@ -1,10 +1,12 @@
 package events_test
 
 import (
+	"errors"
 	"strconv"
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -132,6 +134,386 @@ var _ = Describe("Hub", func() {
 		Expect(err).To(Equal(events.ErrReadFromClosedSource))
 	})
 
+	Describe("Resume", func() {
+		It("replays the retained events after the given ID before live ones", func() {
+			hub.Emit(eventfakes.FakeEvent{Token: "1"})
+			seenID := hub.LastEventID()
+			hub.Emit(eventfakes.FakeEvent{Token: "2"})
+
+			source, err := hub.Resume(seenID, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "3"})
+
+			Expect(source.Next()).To(Equal(events.LoggedEvent{ID: seenID + 1, Event: eventfakes.FakeEvent{Token: "2"}}))
+			Expect(source.Next()).To(Equal(events.LoggedEvent{ID: seenID + 2, Event: eventfakes.FakeEvent{Token: "3"}}))
+		})
+
+		It("recovers the events a slow consumer missed", func() {
+			slowConsumer, err := hub.Resume(hub.LastEventID(), models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			for eventToken := 0; eventToken <= events.MAX_PENDING_SUBSCRIBER_EVENTS; eventToken++ {
+				hub.Emit(eventfakes.FakeEvent{Token: strconv.Itoa(eventToken)})
+			}
+
+			var lastSeen events.LoggedEvent
+			for {
+				event, err := slowConsumer.Next()
+				if err != nil {
+					Expect(err).To(Equal(events.ErrReadFromClosedSource))
+					break
+				}
+				lastSeen = event
+			}
+
+			source, err := hub.Resume(lastSeen.ID, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.Next()).To(Equal(events.LoggedEvent{
+				ID:    lastSeen.ID + 1,
+				Event: eventfakes.FakeEvent{Token: strconv.Itoa(events.MAX_PENDING_SUBSCRIBER_EVENTS)},
+			}))
+		})
+
+		It("asks for a resync when the missed events are no longer retained", func() {
+			source, err := hub.Resume(1, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.ID).To(Equal(hub.LastEventID()))
+			Expect(event.Event).To(BeAssignableToTypeOf(&models.ResyncRequiredEvent{}))
+		})
+
+		It("replays from the hub's event log", func() {
+			fakeEventLog := new(eventfakes.FakeEventLog)
+			fakeEventLog.SinceReturns([]events.LoggedEvent{{ID: 7, Event: eventfakes.FakeEvent{Token: "7"}}}, nil)
+			hub = events.NewHubWithEventLog(lagertest.NewTestLogger("something"), fakeEventLog)
+
+			source, err := hub.Resume(6, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(source.Next()).To(Equal(events.LoggedEvent{ID: 7, Event: eventfakes.FakeEvent{Token: "7"}}))
+			Expect(fakeEventLog.SinceArgsForCall(0)).To(BeEquivalentTo(6))
+		})
+
+		It("does not accept new subscribers once closed", func() {
+			Expect(hub.Close()).To(Succeed())
+
+			_, err := hub.Resume(hub.LastEventID(), models.EventFilter{})
+			Expect(err).To(Equal(events.ErrSubscribedToClosedHub))
+		})
+	})
+
+	Describe("EventIDAtResourceVersion", func() {
+		var versions *eventfakes.FakeResourceVersionSource
+
+		taskEvent := func(guid string, resourceVersion uint64) *models.TaskCreatedEvent {
+			event := models.NewTaskCreatedEvent(&models.Task{TaskGuid: guid})
+			event.ResourceVersion = resourceVersion
+			return event
+		}
+
+		BeforeEach(func() {
+			versions = new(eventfakes.FakeResourceVersionSource)
+			versions.ResourceVersionReturns(10, nil)
+			hub = events.NewVersionedHub(lagertest.NewTestLogger("something"), events.NewRingEventLog(events.DEFAULT_EVENT_LOG_SIZE), versions)
+		})
+
+		It("records emitted events at the resource version of their write", func() {
+			start := hub.LastEventID()
+			source, err := hub.Resume(start, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			event := taskEvent("a", 12)
+			hub.Emit(event)
+
+			Expect(source.Next()).To(Equal(events.LoggedEvent{ID: start + 1, ResourceVersion: 12, Event: event}))
+		})
+
+		It("records events without a version at the version of the event before them", func() {
+			start := hub.LastEventID()
+			source, err := hub.Resume(start, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(eventfakes.FakeEvent{Token: "A"})
+			hub.Emit(taskEvent("b", 12))
+			hub.Emit(eventfakes.FakeEvent{Token: "C"})
+
+			versionsRecorded := []uint64{}
+			for i := 0; i < 3; i++ {
+				event, err := source.Next()
+				Expect(err).NotTo(HaveOccurred())
+				versionsRecorded = append(versionsRecorded, event.ResourceVersion)
+			}
+			Expect(versionsRecorded).To(Equal([]uint64{10, 12, 12}))
+		})
+
+		It("does not record an event at a version before the event before it", func() {
+			start := hub.LastEventID()
+			source, err := hub.Resume(start, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(taskEvent("a", 15))
+			hub.Emit(taskEvent("b", 12))
+
+			_, err = source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.ResourceVersion).To(BeEquivalentTo(15))
+		})
+
+		It("loads the first version once", func() {
+			hub.Emit(taskEvent("a", 12))
+			hub.Emit(taskEvent("b", 13))
+			_, err := hub.EventIDAtResourceVersion(12)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(versions.ResourceVersionCallCount()).To(Equal(1))
+		})
+
+		It("resumes with the events of the writes after the version", func() {
+			hub.Emit(taskEvent("a", 12))
+			hub.Emit(taskEvent("b", 20))
+
+			id, err := hub.EventIDAtResourceVersion(15)
+			Expect(err).NotTo(HaveOccurred())
+
+			source, err := hub.Resume(id, models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(taskEvent("b", 20)))
+			Expect(event.ResourceVersion).To(BeEquivalentTo(20))
+		})
+
+		It("resumes with live events only when the version is current", func() {
+			hub.Emit(taskEvent("a", 12))
+
+			Expect(hub.EventIDAtResourceVersion(12)).To(Equal(hub.LastEventID()))
+		})
+
+		It("reports versions before the first one as expired", func() {
+			hub.Emit(taskEvent("a", 12))
+
+			_, err := hub.EventIDAtResourceVersion(5)
+			Expect(err).To(Equal(events.ErrEventsExpired))
+		})
+
+		Context("when the resource version cannot be read", func() {
+			BeforeEach(func() {
+				versions.ResourceVersionReturns(0, errors.New("boom"))
+				hub.Emit(taskEvent("a", 12))
+			})
+
+			It("returns the error", func() {
+				_, err := hub.EventIDAtResourceVersion(12)
+				Expect(err).To(MatchError("boom"))
+			})
+
+			It("reads it again on next use", func() {
+				versions.ResourceVersionReturns(30, nil)
+				hub.Emit(taskEvent("b", 31))
+
+				_, err := hub.EventIDAtResourceVersion(12)
+				Expect(err).To(Equal(events.ErrEventsExpired))
+				Expect(hub.EventIDAtResourceVersion(31)).To(Equal(hub.LastEventID()))
+			})
+		})
+
+		Context("without resource versions", func() {
+			BeforeEach(func() {
+				hub = events.NewHub(lagertest.NewTestLogger("something"))
+			})
+
+			It("reports every version as expired", func() {
+				_, err := hub.EventIDAtResourceVersion(10)
+				Expect(err).To(Equal(events.ErrEventsExpired))
+			})
+		})
+	})
+
+	Describe("filtering", func() {
+		var (
+			matchingEvent, otherDomainEvent, otherGuidEvent models.Event
+		)
+
+		BeforeEach(func() {
+			matchingEvent = models.NewDesiredLRPCreatedEvent(&models.DesiredLRP{ProcessGuid: "pg-1", Domain: "domain-1"}, "")
+			otherDomainEvent = models.NewDesiredLRPCreatedEvent(&models.DesiredLRP{ProcessGuid: "pg-1", Domain: "domain-2"}, "")
+			otherGuidEvent = models.NewActualLRPInstanceCreatedEvent(&models.ActualLRP{
+				ActualLRPKey: models.NewActualLRPKey("pg-2", 0, "domain-1"),
+			}, "")
+		})
+
+		It("only queues the events matching the filter", func() {
+			source, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				Domain:       "domain-1",
+				ProcessGuids: []string{"pg-1"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(otherDomainEvent)
+			hub.Emit(otherGuidEvent)
+			hub.Emit(matchingEvent)
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(matchingEvent))
+		})
+
+		It("does not spend the pending event budget on filtered events", func() {
+			source, err := hub.Resume(hub.LastEventID(), models.EventFilter{Domain: "domain-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			for i := 0; i <= events.MAX_PENDING_SUBSCRIBER_EVENTS; i++ {
+				hub.Emit(otherDomainEvent)
+			}
+			hub.Emit(matchingEvent)
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(matchingEvent))
+		})
+
+		It("filters by event type", func() {
+			source, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				EventTypes: []string{models.EventTypeActualLRPInstanceCreated},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(matchingEvent)
+			hub.Emit(otherGuidEvent)
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(otherGuidEvent))
+		})
+
+		It("only sends deployment events to subscribers that ask for them", func() {
+			deploymentEvent := models.NewDeploymentChangedEvent(&models.Deployment{ProcessGuid: "pg-1", Domain: "domain-1"}, "")
+
+			unfiltered, err := hub.Resume(hub.LastEventID(), models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			requested, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				EventTypes: []string{models.EventTypeDeploymentChanged},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(deploymentEvent)
+			hub.Emit(matchingEvent)
+
+			event, err := unfiltered.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(matchingEvent))
+
+			event, err = requested.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(deploymentEvent))
+		})
+
+		It("only sends crash storm breaker events to subscribers that ask for them", func() {
+			breakerEvent := models.NewCrashStormBreakerChangedEvent(&models.CrashStormBreaker{
+				Scope: models.CrashStormBreaker_Domain,
+				Key:   "domain-1",
+			}, "")
+
+			unfiltered, err := hub.Resume(hub.LastEventID(), models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			requested, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				Domain:     "domain-1",
+				EventTypes: []string{models.EventTypeCrashStormBreakerChanged},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(breakerEvent)
+			hub.Emit(matchingEvent)
+
+			event, err := unfiltered.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(matchingEvent))
+
+			event, err = requested.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(breakerEvent))
+		})
+
+		It("only sends cell drain events to subscribers that ask for them", func() {
+			drainEvent := models.NewCellDrainChangedEvent(&models.CellDrain{CellId: "cell-1"}, "")
+
+			unfiltered, err := hub.Resume(hub.LastEventID(), models.EventFilter{})
+			Expect(err).NotTo(HaveOccurred())
+			requested, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				EventTypes: []string{models.EventTypeCellDrainChanged},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(drainEvent)
+			hub.Emit(matchingEvent)
+
+			event, err := unfiltered.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(matchingEvent))
+
+			event, err = requested.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(drainEvent))
+		})
+
+		It("filters tasks by guid", func() {
+			source, err := hub.Resume(hub.LastEventID(), models.EventFilter{TaskGuids: []string{"task-2"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-1"}))
+			hub.Emit(matchingEvent)
+			taskEvent := models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-2"})
+			hub.Emit(taskEvent)
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(taskEvent))
+		})
+
+		It("filters the replayed events", func() {
+			seenID := hub.LastEventID()
+			hub.Emit(otherDomainEvent)
+			hub.Emit(matchingEvent)
+
+			source, err := hub.Resume(seenID, models.EventFilter{Domain: "domain-1"})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(source.Next()).To(Equal(events.LoggedEvent{ID: seenID + 2, Event: matchingEvent}))
+		})
+
+		It("filters by label selector", func() {
+			source, err := hub.Resume(hub.LastEventID(), models.EventFilter{
+				LabelSelector: []*models.LabelSelectorRequirement{
+					{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			hub.Emit(matchingEvent)
+			hub.Emit(otherGuidEvent)
+			labelledTask := &models.Task{TaskGuid: "task-1", TaskDefinition: &models.TaskDefinition{Labels: map[string]string{"team": "a"}}}
+			taskEvent := models.NewTaskCreatedEvent(labelledTask)
+			hub.Emit(taskEvent)
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(Equal(taskEvent))
+		})
+
+		It("always delivers resync events", func() {
+			source, err := hub.Resume(1, models.EventFilter{Domain: "domain-1", EventTypes: []string{models.EventTypeTaskCreated}})
+			Expect(err).NotTo(HaveOccurred())
+
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Event).To(BeAssignableToTypeOf(&models.ResyncRequiredEvent{}))
+		})
+	})
+
 	Describe("closing an event source", func() {
 		It("prevents current events from propagating to the source", func() {
 			source, err := hub.Subscribe()
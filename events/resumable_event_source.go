@@ -0,0 +1,181 @@
+package events
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/vito/go-sse/sse"
+)
+
+// ConnectFunc opens a raw event stream. When lastEventID is not empty, the
+// server is asked to resume the stream after that event.
+type ConnectFunc func(lastEventID string) (RawEventSource, error)
+
+type resumableEventSource struct {
+	connect       ConnectFunc
+	parse         func(sse.Event) (models.Event, error)
+	retryInterval time.Duration
+	maxRetries    uint16
+
+	rawEventSource RawEventSource
+	lastEventID    string
+	lock           sync.Mutex
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// NewResumableEventSource returns an EventSource that reconnects whenever its
+// stream ends or fails, asking the server to resume after the last event
+// returned by Next so that no events are lost in between.
+//
+// The initial connection is attempted maxRetries additional times, or until
+// it succeeds when maxRetries is 0. A lost connection is reattempted
+// maxRetries times, and at least once, before Next returns an error.
+func NewResumableEventSource(connect ConnectFunc, retryInterval time.Duration, maxRetries uint16) (EventSource, error) {
+	return newResumableEventSource(connect, parseRawEvent, retryInterval, maxRetries)
+}
+
+// NewResumableProtoEventSource is like NewResumableEventSource for streams
+// whose raw events carry the protobuf encoding of the event as is, rather
+// than base64 encoded, such as the gRPC event streams.
+func NewResumableProtoEventSource(connect ConnectFunc, retryInterval time.Duration, maxRetries uint16) (EventSource, error) {
+	return newResumableEventSource(connect, parseProtoEvent, retryInterval, maxRetries)
+}
+
+func newResumableEventSource(connect ConnectFunc, parse func(sse.Event) (models.Event, error), retryInterval time.Duration, maxRetries uint16) (EventSource, error) {
+	source := &resumableEventSource{
+		connect:       connect,
+		parse:         parse,
+		retryInterval: retryInterval,
+		maxRetries:    maxRetries,
+		closed:        make(chan struct{}),
+	}
+
+	maxAttempts := 0
+	if maxRetries > 0 {
+		maxAttempts = int(maxRetries) + 1
+	}
+
+	raw, err := source.dial(maxAttempts)
+	if err != nil {
+		return nil, err
+	}
+	source.rawEventSource = raw
+
+	return source, nil
+}
+
+func (source *resumableEventSource) Next() (models.Event, error) {
+	for {
+		source.lock.Lock()
+		raw := source.rawEventSource
+		source.lock.Unlock()
+
+		if raw == nil || source.isClosed() {
+			return nil, ErrSourceClosed
+		}
+
+		rawEvent, err := raw.Next()
+		if err == nil {
+			if rawEvent.ID != "" {
+				source.lock.Lock()
+				source.lastEventID = rawEvent.ID
+				source.lock.Unlock()
+			}
+			return source.parse(rawEvent)
+		}
+
+		if source.isClosed() {
+			return nil, ErrSourceClosed
+		}
+
+		source.lock.Lock()
+		source.rawEventSource = nil
+		source.lock.Unlock()
+
+		// #nosec G104 - the stream has already failed, there is nothing more to do with it
+		raw.Close()
+
+		maxAttempts := int(source.maxRetries)
+		if maxAttempts == 0 {
+			maxAttempts = 1
+		}
+
+		raw, err = source.dial(maxAttempts)
+		if err == ErrSourceClosed {
+			return nil, err
+		} else if err != nil {
+			return nil, NewRawEventSourceError(err)
+		}
+
+		source.lock.Lock()
+		if source.isClosed() {
+			source.lock.Unlock()
+			// #nosec G104 - the source was closed while reconnecting
+			raw.Close()
+			return nil, ErrSourceClosed
+		}
+		source.rawEventSource = raw
+		source.lock.Unlock()
+	}
+}
+
+func (source *resumableEventSource) Close() error {
+	source.closeOnce.Do(func() {
+		close(source.closed)
+	})
+
+	source.lock.Lock()
+	raw := source.rawEventSource
+	source.rawEventSource = nil
+	source.lock.Unlock()
+
+	if raw == nil {
+		return nil
+	}
+
+	err := raw.Close()
+	if err != nil {
+		return NewCloseError(err)
+	}
+
+	return nil
+}
+
+// dial connects to the stream, making up to maxAttempts attempts or retrying
+// until it succeeds when maxAttempts is 0.
+func (source *resumableEventSource) dial(maxAttempts int) (RawEventSource, error) {
+	source.lock.Lock()
+	lastEventID := source.lastEventID
+	source.lock.Unlock()
+
+	attempts := 0
+	for {
+		raw, err := source.connect(lastEventID)
+		if err == nil {
+			return raw, nil
+		}
+
+		attempts++
+		if maxAttempts > 0 && attempts >= maxAttempts {
+			return nil, err
+		}
+
+		select {
+		case <-time.After(source.retryInterval):
+		case <-source.closed:
+			return nil, ErrSourceClosed
+		}
+	}
+}
+
+func (source *resumableEventSource) isClosed() bool {
+	select {
+	case <-source.closed:
+		return true
+	default:
+		return false
+	}
+}
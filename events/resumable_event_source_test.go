@@ -0,0 +1,148 @@
+package events_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"io"
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("ResumableEventSource", func() {
+	var (
+		rawSources   []*eventfakes.FakeRawEventSource
+		lastEventIDs []string
+		connectErr   error
+		connect      events.ConnectFunc
+		maxRetries   uint16
+		taskEvent    sse.Event
+		expected     *models.TaskRemovedEvent
+	)
+
+	BeforeEach(func() {
+		rawSources = []*eventfakes.FakeRawEventSource{}
+		lastEventIDs = []string{}
+		connectErr = nil
+		maxRetries = 0
+
+		connect = func(lastEventID string) (events.RawEventSource, error) {
+			lastEventIDs = append(lastEventIDs, lastEventID)
+			if connectErr != nil {
+				return nil, connectErr
+			}
+			raw := new(eventfakes.FakeRawEventSource)
+			raw.NextReturns(taskEvent, nil)
+			rawSources = append(rawSources, raw)
+			return raw, nil
+		}
+
+		expected = models.NewTaskRemovedEvent(&models.Task{TaskGuid: "task-guid"})
+		payload, err := proto.Marshal(expected)
+		Expect(err).NotTo(HaveOccurred())
+		taskEvent = sse.Event{
+			ID:   "42",
+			Name: models.EventTypeTaskRemoved,
+			Data: []byte(base64.StdEncoding.EncodeToString(payload)),
+		}
+	})
+
+	It("reconnects after the stream ends, resuming after the last event", func() {
+		source, err := events.NewResumableEventSource(connect, time.Millisecond, maxRetries)
+		Expect(err).NotTo(HaveOccurred())
+
+		rawSources[0].NextReturnsOnCall(0, taskEvent, nil)
+		rawSources[0].NextReturnsOnCall(1, sse.Event{}, io.EOF)
+
+		Expect(source.Next()).To(Equal(expected))
+
+		resumed := make(chan models.Event)
+		go func() {
+			defer GinkgoRecover()
+			event, err := source.Next()
+			Expect(err).NotTo(HaveOccurred())
+			resumed <- event
+		}()
+
+		Eventually(resumed).Should(Receive(Equal(expected)))
+		Expect(rawSources).To(HaveLen(2))
+		Expect(rawSources[0].CloseCallCount()).To(Equal(1))
+		Expect(lastEventIDs).To(Equal([]string{"", "42"}))
+	})
+
+	It("returns the error once reconnecting fails", func() {
+		maxRetries = 2
+		source, err := events.NewResumableEventSource(connect, time.Millisecond, maxRetries)
+		Expect(err).NotTo(HaveOccurred())
+
+		rawSources[0].NextReturns(sse.Event{}, errors.New("connection reset"))
+		connectErr = errors.New("connection refused")
+
+		_, err = source.Next()
+		Expect(err).To(Equal(events.NewRawEventSourceError(connectErr)))
+		Expect(lastEventIDs).To(HaveLen(3))
+	})
+
+	It("decodes unencoded protobuf payloads when created with NewResumableProtoEventSource", func() {
+		source, err := events.NewResumableProtoEventSource(connect, time.Millisecond, maxRetries)
+		Expect(err).NotTo(HaveOccurred())
+
+		payload, err := proto.Marshal(expected)
+		Expect(err).NotTo(HaveOccurred())
+		rawSources[0].NextReturns(sse.Event{ID: "42", Name: models.EventTypeTaskRemoved, Data: payload}, nil)
+
+		Expect(source.Next()).To(Equal(expected))
+	})
+
+	It("retries the initial connection up to maxRetries times", func() {
+		connectErr = errors.New("connection refused")
+
+		_, err := events.NewResumableEventSource(connect, time.Millisecond, 2)
+		Expect(err).To(Equal(connectErr))
+		Expect(lastEventIDs).To(HaveLen(3))
+	})
+
+	Describe("Close", func() {
+		It("closes the raw source and stops further reads", func() {
+			source, err := events.NewResumableEventSource(connect, time.Millisecond, maxRetries)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(source.Close()).To(Succeed())
+			Expect(rawSources[0].CloseCallCount()).To(Equal(1))
+
+			_, err = source.Next()
+			Expect(err).To(Equal(events.ErrSourceClosed))
+		})
+
+		It("interrupts an in-flight Next without reconnecting", func() {
+			source, err := events.NewResumableEventSource(connect, time.Millisecond, maxRetries)
+			Expect(err).NotTo(HaveOccurred())
+
+			closed := make(chan struct{})
+			rawSources[0].NextStub = func() (sse.Event, error) {
+				<-closed
+				return sse.Event{}, errors.New("use of closed connection")
+			}
+			rawSources[0].CloseStub = func() error {
+				close(closed)
+				return nil
+			}
+
+			errCh := make(chan error)
+			go func() {
+				_, err := source.Next()
+				errCh <- err
+			}()
+
+			Expect(source.Close()).To(Succeed())
+			Eventually(errCh).Should(Receive(Equal(events.ErrSourceClosed)))
+			Expect(rawSources).To(HaveLen(1))
+		})
+	})
+})
@@ -58,6 +58,22 @@ type FakeClient struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPHistoryStub        func(lager.Logger, string, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
 	ActualLRPsStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -88,6 +104,40 @@ type FakeClient struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	ActualLRPsPageStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+	actualLRPsPageMutex       sync.RWMutex
+	actualLRPsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsPageReturns struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	actualLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	AuditRecordsPageStub        func(lager.Logger, string, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+	auditRecordsPageMutex       sync.RWMutex
+	auditRecordsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsPageReturns struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	auditRecordsPageReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
 	CancelTaskStub        func(lager.Logger, string, string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -101,6 +151,20 @@ type FakeClient struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CellDrainsStub        func(lager.Logger, string) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
 	CellsStub        func(lager.Logger, string) ([]*models.CellPresence, error)
 	cellsMutex       sync.RWMutex
 	cellsArgsForCall []struct {
@@ -115,6 +179,46 @@ type FakeClient struct {
 		result1 []*models.CellPresence
 		result2 error
 	}
+	CordonCellStub        func(lager.Logger, string, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CrashStormBreakersStub        func(lager.Logger, string) ([]*models.CrashStormBreaker, error)
+	crashStormBreakersMutex       sync.RWMutex
+	crashStormBreakersArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	crashStormBreakersReturns struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	crashStormBreakersReturnsOnCall map[int]struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	DeleteScheduledTaskStub        func(lager.Logger, string, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(lager.Logger, string, string) error
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -128,6 +232,21 @@ type FakeClient struct {
 	deleteTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeploymentByProcessGuidStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	DesireLRPStub        func(lager.Logger, string, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -141,6 +260,21 @@ type FakeClient struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	DesireTaskStub        func(lager.Logger, string, string, string, *models.TaskDefinition) error
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -231,6 +365,54 @@ type FakeClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsPageStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	desiredLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	DomainQuotasStub        func(lager.Logger, string) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(lager.Logger, string, string) (*models.DomainUsage, *models.DomainQuota, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
 	DomainsStub        func(lager.Logger, string) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -245,6 +427,50 @@ type FakeClient struct {
 		result1 []string
 		result2 error
 	}
+	DrainCellStub        func(lager.Logger, string, string) (*models.CellDrain, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	OverloadStatusStub        func(lager.Logger, string) (*models.OverloadStatus, error)
+	overloadStatusMutex       sync.RWMutex
+	overloadStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	overloadStatusReturns struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	overloadStatusReturnsOnCall map[int]struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	PauseDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	pauseDeploymentMutex       sync.RWMutex
+	pauseDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	pauseDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	pauseDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	PingStub        func(lager.Logger, string) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -270,6 +496,50 @@ type FakeClient struct {
 	removeDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveDomainQuotaStub        func(lager.Logger, string, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplayTaskCallbackStub        func(lager.Logger, string, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ResetCrashStormBreakerStub        func(lager.Logger, string, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)
+	resetCrashStormBreakerMutex       sync.RWMutex
+	resetCrashStormBreakerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}
+	resetCrashStormBreakerReturns struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	resetCrashStormBreakerReturnsOnCall map[int]struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
 	ResolvingTaskStub        func(lager.Logger, string, string) error
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -283,6 +553,21 @@ type FakeClient struct {
 	resolvingTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResumeDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	resumeDeploymentMutex       sync.RWMutex
+	resumeDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	resumeDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	resumeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	RetireActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -296,6 +581,69 @@ type FakeClient struct {
 	retireActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	ScheduledTasksStub        func(lager.Logger, string, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetDomainQuotaStub        func(lager.Logger, string, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	StartDeploymentStub        func(lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	SubscribeToEventsStub        func(lager.Logger) (events.EventSource, error)
 	subscribeToEventsMutex       sync.RWMutex
 	subscribeToEventsArgsForCall []struct {
@@ -350,6 +698,20 @@ type FakeClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToInstanceEventsWithFilterStub        func(lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToInstanceEventsWithFilterMutex       sync.RWMutex
+	subscribeToInstanceEventsWithFilterArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}
+	subscribeToInstanceEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
 	SubscribeToTaskEventsStub        func(lager.Logger) (events.EventSource, error)
 	subscribeToTaskEventsMutex       sync.RWMutex
 	subscribeToTaskEventsArgsForCall []struct {
@@ -363,6 +725,36 @@ type FakeClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToTaskEventsWithFilterStub        func(lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToTaskEventsWithFilterMutex       sync.RWMutex
+	subscribeToTaskEventsWithFilterArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}
+	subscribeToTaskEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SuspendScheduledTaskStub        func(lager.Logger, string, string, bool) (*models.ScheduledTask, error)
+	suspendScheduledTaskMutex       sync.RWMutex
+	suspendScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 bool
+	}
+	suspendScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	suspendScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	TaskByGuidStub        func(lager.Logger, string, string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -378,6 +770,21 @@ type FakeClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskCallbacksStub        func(lager.Logger, string, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
 	TasksStub        func(lager.Logger, string) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -422,6 +829,23 @@ type FakeClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksPageStub        func(lager.Logger, string, models.TaskFilter) ([]*models.Task, string, error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	tasksPageReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
 	TasksWithFilterStub        func(lager.Logger, string, models.TaskFilter) ([]*models.Task, error)
 	tasksWithFilterMutex       sync.RWMutex
 	tasksWithFilterArgsForCall []struct {
@@ -437,6 +861,19 @@ type FakeClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	UncordonCellStub        func(lager.Logger, string, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(lager.Logger, string, string, *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -451,6 +888,22 @@ type FakeClient struct {
 	updateDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateScheduledTaskStub        func(lager.Logger, string, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	UpsertDomainStub        func(lager.Logger, string, string, time.Duration) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -668,20 +1121,21 @@ func (fake *FakeClient) ActualLRPGroupsByProcessGuidReturnsOnCall(i int, result1
 	}{result1, result2}
 }
 
-func (fake *FakeClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
-	fake.actualLRPsMutex.Lock()
-	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
-	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+func (fake *FakeClient) ActualLRPHistory(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
-		arg3 models.ActualLRPFilter
-	}{arg1, arg2, arg3})
-	stub := fake.ActualLRPsStub
-	fakeReturns := fake.actualLRPsReturns
-	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
-	fake.actualLRPsMutex.Unlock()
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -689,23 +1143,89 @@ func (fake *FakeClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3 models.A
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) ActualLRPsCallCount() int {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	return len(fake.actualLRPsArgsForCall)
+func (fake *FakeClient) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
 }
 
-func (fake *FakeClient) ActualLRPsCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
-	fake.actualLRPsMutex.Lock()
-	defer fake.actualLRPsMutex.Unlock()
-	fake.ActualLRPsStub = stub
+func (fake *FakeClient) ActualLRPHistoryCalls(stub func(lager.Logger, string, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
 }
 
-func (fake *FakeClient) ActualLRPsArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	argsForCall := fake.actualLRPsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeClient) ActualLRPHistoryArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
+	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsStub
+	fakeReturns := fake.actualLRPsReturns
+	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ActualLRPsCallCount() int {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	return len(fake.actualLRPsArgsForCall)
+}
+
+func (fake *FakeClient) ActualLRPsCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = stub
+}
+
+func (fake *FakeClient) ActualLRPsArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	argsForCall := fake.actualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeClient) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
@@ -805,6 +1325,144 @@ func (fake *FakeClient) ActualLRPsByProcessGuidsReturnsOnCall(i int, result1 []*
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ActualLRPsPage(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	fake.actualLRPsPageMutex.Lock()
+	ret, specificReturn := fake.actualLRPsPageReturnsOnCall[len(fake.actualLRPsPageArgsForCall)]
+	fake.actualLRPsPageArgsForCall = append(fake.actualLRPsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsPageStub
+	fakeReturns := fake.actualLRPsPageReturns
+	fake.recordInvocation("ActualLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ActualLRPsPageCallCount() int {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	return len(fake.actualLRPsPageArgsForCall)
+}
+
+func (fake *FakeClient) ActualLRPsPageCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = stub
+}
+
+func (fake *FakeClient) ActualLRPsPageArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	argsForCall := fake.actualLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ActualLRPsPageReturns(result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	fake.actualLRPsPageReturns = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ActualLRPsPageReturnsOnCall(i int, result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	if fake.actualLRPsPageReturnsOnCall == nil {
+		fake.actualLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.actualLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) AuditRecordsPage(arg1 lager.Logger, arg2 string, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	fake.auditRecordsPageMutex.Lock()
+	ret, specificReturn := fake.auditRecordsPageReturnsOnCall[len(fake.auditRecordsPageArgsForCall)]
+	fake.auditRecordsPageArgsForCall = append(fake.auditRecordsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsPageStub
+	fakeReturns := fake.auditRecordsPageReturns
+	fake.recordInvocation("AuditRecordsPage", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) AuditRecordsPageCallCount() int {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	return len(fake.auditRecordsPageArgsForCall)
+}
+
+func (fake *FakeClient) AuditRecordsPageCalls(stub func(lager.Logger, string, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = stub
+}
+
+func (fake *FakeClient) AuditRecordsPageArgsForCall(i int) (lager.Logger, string, models.AuditRecordFilter) {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	argsForCall := fake.auditRecordsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) AuditRecordsPageReturns(result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	fake.auditRecordsPageReturns = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) AuditRecordsPageReturnsOnCall(i int, result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	if fake.auditRecordsPageReturnsOnCall == nil {
+		fake.auditRecordsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 string
+			result3 error
+		})
+	}
+	fake.auditRecordsPageReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) CancelTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -868,6 +1526,71 @@ func (fake *FakeClient) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) CellDrains(arg1 lager.Logger, arg2 string) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeClient) CellDrainsCalls(stub func(lager.Logger, string) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeClient) CellDrainsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Cells(arg1 lager.Logger, arg2 string) ([]*models.CellPresence, error) {
 	fake.cellsMutex.Lock()
 	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
@@ -933,6 +1656,197 @@ func (fake *FakeClient) CellsReturnsOnCall(i int, result1 []*models.CellPresence
 	}{result1, result2}
 }
 
+func (fake *FakeClient) CordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeClient) CordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeClient) CordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CrashStormBreakers(arg1 lager.Logger, arg2 string) ([]*models.CrashStormBreaker, error) {
+	fake.crashStormBreakersMutex.Lock()
+	ret, specificReturn := fake.crashStormBreakersReturnsOnCall[len(fake.crashStormBreakersArgsForCall)]
+	fake.crashStormBreakersArgsForCall = append(fake.crashStormBreakersArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CrashStormBreakersStub
+	fakeReturns := fake.crashStormBreakersReturns
+	fake.recordInvocation("CrashStormBreakers", []interface{}{arg1, arg2})
+	fake.crashStormBreakersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CrashStormBreakersCallCount() int {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	return len(fake.crashStormBreakersArgsForCall)
+}
+
+func (fake *FakeClient) CrashStormBreakersCalls(stub func(lager.Logger, string) ([]*models.CrashStormBreaker, error)) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = stub
+}
+
+func (fake *FakeClient) CrashStormBreakersArgsForCall(i int) (lager.Logger, string) {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	argsForCall := fake.crashStormBreakersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CrashStormBreakersReturns(result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	fake.crashStormBreakersReturns = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CrashStormBreakersReturnsOnCall(i int, result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	if fake.crashStormBreakersReturnsOnCall == nil {
+		fake.crashStormBreakersReturnsOnCall = make(map[int]struct {
+			result1 []*models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.crashStormBreakersReturnsOnCall[i] = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeleteScheduledTask(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) DeleteScheduledTaskCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) DeleteScheduledTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) DeleteTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.deleteTaskMutex.Lock()
 	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
@@ -996,6 +1910,72 @@ func (fake *FakeClient) DeleteTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) DeploymentByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeClient) DeploymentByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeClient) DeploymentByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) DesireLRP(arg1 lager.Logger, arg2 string, arg3 *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
@@ -1059,10 +2039,76 @@ func (fake *FakeClient) DesireLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
-	fake.desireTaskMutex.Lock()
-	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
-	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
+func (fake *FakeClient) DesireScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) DesireScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) DesireScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
+	fake.desireTaskMutex.Lock()
+	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
+	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
@@ -1454,6 +2500,209 @@ func (fake *FakeClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.Desire
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DesiredLRPsPage(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	fake.desiredLRPsPageMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsPageReturnsOnCall[len(fake.desiredLRPsPageArgsForCall)]
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsPageStub
+	fakeReturns := fake.desiredLRPsPageReturns
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeClient) DesiredLRPsPageCalls(stub func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = stub
+}
+
+func (fake *FakeClient) DesiredLRPsPageArgsForCall(i int) (lager.Logger, string, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	argsForCall := fake.desiredLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) DesiredLRPsPageReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	if fake.desiredLRPsPageReturnsOnCall == nil {
+		fake.desiredLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.desiredLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) DomainQuotas(arg1 lager.Logger, arg2 string) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeClient) DomainQuotasCalls(stub func(lager.Logger, string) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeClient) DomainQuotasArgsForCall(i int) (lager.Logger, string) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DomainUsage(arg1 lager.Logger, arg2 string, arg3 string) (*models.DomainUsage, *models.DomainQuota, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeClient) DomainUsageCalls(stub func(lager.Logger, string, string) (*models.DomainUsage, *models.DomainQuota, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeClient) DomainUsageArgsForCall(i int) (lager.Logger, string, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DomainUsageReturns(result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 *models.DomainQuota
+			result3 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Domains(arg1 lager.Logger, arg2 string) ([]string, error) {
 	fake.domainsMutex.Lock()
 	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
@@ -1519,6 +2768,203 @@ func (fake *FakeClient) DomainsReturnsOnCall(i int, result1 []string, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeClient) DrainCell(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellDrain, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeClient) DrainCellCalls(stub func(lager.Logger, string, string) (*models.CellDrain, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeClient) DrainCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) DrainCellReturns(result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DrainCellReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OverloadStatus(arg1 lager.Logger, arg2 string) (*models.OverloadStatus, error) {
+	fake.overloadStatusMutex.Lock()
+	ret, specificReturn := fake.overloadStatusReturnsOnCall[len(fake.overloadStatusArgsForCall)]
+	fake.overloadStatusArgsForCall = append(fake.overloadStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.OverloadStatusStub
+	fakeReturns := fake.overloadStatusReturns
+	fake.recordInvocation("OverloadStatus", []interface{}{arg1, arg2})
+	fake.overloadStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) OverloadStatusCallCount() int {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	return len(fake.overloadStatusArgsForCall)
+}
+
+func (fake *FakeClient) OverloadStatusCalls(stub func(lager.Logger, string) (*models.OverloadStatus, error)) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = stub
+}
+
+func (fake *FakeClient) OverloadStatusArgsForCall(i int) (lager.Logger, string) {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	argsForCall := fake.overloadStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) OverloadStatusReturns(result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	fake.overloadStatusReturns = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) OverloadStatusReturnsOnCall(i int, result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	if fake.overloadStatusReturnsOnCall == nil {
+		fake.overloadStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.OverloadStatus
+			result2 error
+		})
+	}
+	fake.overloadStatusReturnsOnCall[i] = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PauseDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.pauseDeploymentMutex.Lock()
+	ret, specificReturn := fake.pauseDeploymentReturnsOnCall[len(fake.pauseDeploymentArgsForCall)]
+	fake.pauseDeploymentArgsForCall = append(fake.pauseDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PauseDeploymentStub
+	fakeReturns := fake.pauseDeploymentReturns
+	fake.recordInvocation("PauseDeployment", []interface{}{arg1, arg2, arg3})
+	fake.pauseDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) PauseDeploymentCallCount() int {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	return len(fake.pauseDeploymentArgsForCall)
+}
+
+func (fake *FakeClient) PauseDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = stub
+}
+
+func (fake *FakeClient) PauseDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	argsForCall := fake.pauseDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) PauseDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	fake.pauseDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PauseDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	if fake.pauseDeploymentReturnsOnCall == nil {
+		fake.pauseDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.pauseDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Ping(arg1 lager.Logger, arg2 string) bool {
 	fake.pingMutex.Lock()
 	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
@@ -1644,6 +3090,202 @@ func (fake *FakeClient) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RemoveDomainQuota(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeClient) RemoveDomainQuotaCalls(stub func(lager.Logger, string, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeClient) RemoveDomainQuotaArgsForCall(i int) (lager.Logger, string, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ReplayTaskCallback(arg1 lager.Logger, arg2 string, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
+}
+
+func (fake *FakeClient) ReplayTaskCallbackCalls(stub func(lager.Logger, string, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
+}
+
+func (fake *FakeClient) ReplayTaskCallbackArgsForCall(i int) (lager.Logger, string, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ResetCrashStormBreaker(arg1 lager.Logger, arg2 string, arg3 models.CrashStormBreaker_Scope, arg4 string) (*models.CrashStormBreaker, error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	ret, specificReturn := fake.resetCrashStormBreakerReturnsOnCall[len(fake.resetCrashStormBreakerArgsForCall)]
+	fake.resetCrashStormBreakerArgsForCall = append(fake.resetCrashStormBreakerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetCrashStormBreakerStub
+	fakeReturns := fake.resetCrashStormBreakerReturns
+	fake.recordInvocation("ResetCrashStormBreaker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetCrashStormBreakerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ResetCrashStormBreakerCallCount() int {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	return len(fake.resetCrashStormBreakerArgsForCall)
+}
+
+func (fake *FakeClient) ResetCrashStormBreakerCalls(stub func(lager.Logger, string, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = stub
+}
+
+func (fake *FakeClient) ResetCrashStormBreakerArgsForCall(i int) (lager.Logger, string, models.CrashStormBreaker_Scope, string) {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	argsForCall := fake.resetCrashStormBreakerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) ResetCrashStormBreakerReturns(result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	fake.resetCrashStormBreakerReturns = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ResetCrashStormBreakerReturnsOnCall(i int, result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	if fake.resetCrashStormBreakerReturnsOnCall == nil {
+		fake.resetCrashStormBreakerReturnsOnCall = make(map[int]struct {
+			result1 *models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.resetCrashStormBreakerReturnsOnCall[i] = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ResolvingTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.resolvingTaskMutex.Lock()
 	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
@@ -1707,6 +3349,72 @@ func (fake *FakeClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ResumeDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.resumeDeploymentMutex.Lock()
+	ret, specificReturn := fake.resumeDeploymentReturnsOnCall[len(fake.resumeDeploymentArgsForCall)]
+	fake.resumeDeploymentArgsForCall = append(fake.resumeDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeDeploymentStub
+	fakeReturns := fake.resumeDeploymentReturns
+	fake.recordInvocation("ResumeDeployment", []interface{}{arg1, arg2, arg3})
+	fake.resumeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ResumeDeploymentCallCount() int {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	return len(fake.resumeDeploymentArgsForCall)
+}
+
+func (fake *FakeClient) ResumeDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = stub
+}
+
+func (fake *FakeClient) ResumeDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	argsForCall := fake.resumeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ResumeDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	fake.resumeDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ResumeDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	if fake.resumeDeploymentReturnsOnCall == nil {
+		fake.resumeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.resumeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) RetireActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey) error {
 	fake.retireActualLRPMutex.Lock()
 	ret, specificReturn := fake.retireActualLRPReturnsOnCall[len(fake.retireActualLRPArgsForCall)]
@@ -1720,54 +3428,321 @@ func (fake *FakeClient) RetireActualLRP(arg1 lager.Logger, arg2 string, arg3 *mo
 	fake.recordInvocation("RetireActualLRP", []interface{}{arg1, arg2, arg3})
 	fake.retireActualLRPMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) RetireActualLRPCallCount() int {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	return len(fake.retireActualLRPArgsForCall)
+}
+
+func (fake *FakeClient) RetireActualLRPCalls(stub func(lager.Logger, string, *models.ActualLRPKey) error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = stub
+}
+
+func (fake *FakeClient) RetireActualLRPArgsForCall(i int) (lager.Logger, string, *models.ActualLRPKey) {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	argsForCall := fake.retireActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RetireActualLRPReturns(result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	fake.retireActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	if fake.retireActualLRPReturnsOnCall == nil {
+		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retireActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RollbackDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeClient) RollbackDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeClient) RollbackDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) RollbackDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RollbackDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScheduledTasks(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeClient) ScheduledTasksCalls(stub func(lager.Logger, string, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeClient) ScheduledTasksArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetDomainQuota(arg1 lager.Logger, arg2 string, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
+}
+
+func (fake *FakeClient) SetDomainQuotaCalls(stub func(lager.Logger, string, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
+}
+
+func (fake *FakeClient) SetDomainQuotaArgsForCall(i int) (lager.Logger, string, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StartDeployment(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeClient) RetireActualLRPCallCount() int {
-	fake.retireActualLRPMutex.RLock()
-	defer fake.retireActualLRPMutex.RUnlock()
-	return len(fake.retireActualLRPArgsForCall)
+func (fake *FakeClient) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
 }
 
-func (fake *FakeClient) RetireActualLRPCalls(stub func(lager.Logger, string, *models.ActualLRPKey) error) {
-	fake.retireActualLRPMutex.Lock()
-	defer fake.retireActualLRPMutex.Unlock()
-	fake.RetireActualLRPStub = stub
+func (fake *FakeClient) StartDeploymentCalls(stub func(lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
 }
 
-func (fake *FakeClient) RetireActualLRPArgsForCall(i int) (lager.Logger, string, *models.ActualLRPKey) {
-	fake.retireActualLRPMutex.RLock()
-	defer fake.retireActualLRPMutex.RUnlock()
-	argsForCall := fake.retireActualLRPArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeClient) StartDeploymentArgsForCall(i int) (lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
-func (fake *FakeClient) RetireActualLRPReturns(result1 error) {
-	fake.retireActualLRPMutex.Lock()
-	defer fake.retireActualLRPMutex.Unlock()
-	fake.RetireActualLRPStub = nil
-	fake.retireActualLRPReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeClient) StartDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
-	fake.retireActualLRPMutex.Lock()
-	defer fake.retireActualLRPMutex.Unlock()
-	fake.RetireActualLRPStub = nil
-	if fake.retireActualLRPReturnsOnCall == nil {
-		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeClient) StartDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
 		})
 	}
-	fake.retireActualLRPReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeClient) SubscribeToEvents(arg1 lager.Logger) (events.EventSource, error) {
@@ -2028,6 +4003,71 @@ func (fake *FakeClient) SubscribeToInstanceEventsByCellIDReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilter(arg1 lager.Logger, arg2 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsWithFilterReturnsOnCall[len(fake.subscribeToInstanceEventsWithFilterArgsForCall)]
+	fake.subscribeToInstanceEventsWithFilterArgsForCall = append(fake.subscribeToInstanceEventsWithFilterArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}{arg1, arg2})
+	stub := fake.SubscribeToInstanceEventsWithFilterStub
+	fakeReturns := fake.subscribeToInstanceEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToInstanceEventsWithFilter", []interface{}{arg1, arg2})
+	fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilterCallCount() int {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilterCalls(stub func(lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = stub
+}
+
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	fake.subscribeToInstanceEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscribeToInstanceEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	if fake.subscribeToInstanceEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SubscribeToTaskEvents(arg1 lager.Logger) (events.EventSource, error) {
 	fake.subscribeToTaskEventsMutex.Lock()
 	ret, specificReturn := fake.subscribeToTaskEventsReturnsOnCall[len(fake.subscribeToTaskEventsArgsForCall)]
@@ -2092,6 +4132,138 @@ func (fake *FakeClient) SubscribeToTaskEventsReturnsOnCall(i int, result1 events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SubscribeToTaskEventsWithFilter(arg1 lager.Logger, arg2 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsWithFilterReturnsOnCall[len(fake.subscribeToTaskEventsWithFilterArgsForCall)]
+	fake.subscribeToTaskEventsWithFilterArgsForCall = append(fake.subscribeToTaskEventsWithFilterArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}{arg1, arg2})
+	stub := fake.SubscribeToTaskEventsWithFilterStub
+	fakeReturns := fake.subscribeToTaskEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToTaskEventsWithFilter", []interface{}{arg1, arg2})
+	fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsWithFilterCallCount() int {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsWithFilterCalls(stub func(lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = stub
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	fake.subscribeToTaskEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SubscribeToTaskEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	if fake.subscribeToTaskEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToTaskEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SuspendScheduledTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.suspendScheduledTaskReturnsOnCall[len(fake.suspendScheduledTaskArgsForCall)]
+	fake.suspendScheduledTaskArgsForCall = append(fake.suspendScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SuspendScheduledTaskStub
+	fakeReturns := fake.suspendScheduledTaskReturns
+	fake.recordInvocation("SuspendScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.suspendScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SuspendScheduledTaskCallCount() int {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	return len(fake.suspendScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) SuspendScheduledTaskCalls(stub func(lager.Logger, string, string, bool) (*models.ScheduledTask, error)) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) SuspendScheduledTaskArgsForCall(i int) (lager.Logger, string, string, bool) {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	argsForCall := fake.suspendScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) SuspendScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	fake.suspendScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SuspendScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	if fake.suspendScheduledTaskReturnsOnCall == nil {
+		fake.suspendScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.suspendScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) TaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
@@ -2158,6 +4330,72 @@ func (fake *FakeClient) TaskByGuidReturnsOnCall(i int, result1 *models.Task, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TaskCallbacks(arg1 lager.Logger, arg2 string, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeClient) TaskCallbacksCalls(stub func(lager.Logger, string, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeClient) TaskCallbacksArgsForCall(i int) (lager.Logger, string, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Tasks(arg1 lager.Logger, arg2 string) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -2355,6 +4593,75 @@ func (fake *FakeClient) TasksByDomainReturnsOnCall(i int, result1 []*models.Task
 	}{result1, result2}
 }
 
+func (fake *FakeClient) TasksPage(arg1 lager.Logger, arg2 string, arg3 models.TaskFilter) ([]*models.Task, string, error) {
+	fake.tasksPageMutex.Lock()
+	ret, specificReturn := fake.tasksPageReturnsOnCall[len(fake.tasksPageArgsForCall)]
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksPageStub
+	fakeReturns := fake.tasksPageReturns
+	fake.recordInvocation("TasksPage", []interface{}{arg1, arg2, arg3})
+	fake.tasksPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeClient) TasksPageCalls(stub func(lager.Logger, string, models.TaskFilter) ([]*models.Task, string, error)) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = stub
+}
+
+func (fake *FakeClient) TasksPageArgsForCall(i int) (lager.Logger, string, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	argsForCall := fake.tasksPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) TasksPageReturnsOnCall(i int, result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	if fake.tasksPageReturnsOnCall == nil {
+		fake.tasksPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 string
+			result3 error
+		})
+	}
+	fake.tasksPageReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) TasksWithFilter(arg1 lager.Logger, arg2 string, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksWithFilterMutex.Lock()
 	ret, specificReturn := fake.tasksWithFilterReturnsOnCall[len(fake.tasksWithFilterArgsForCall)]
@@ -2421,6 +4728,69 @@ func (fake *FakeClient) TasksWithFilterReturnsOnCall(i int, result1 []*models.Ta
 	}{result1, result2}
 }
 
+func (fake *FakeClient) UncordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeClient) UncordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeClient) UncordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UpdateDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -2485,6 +4855,73 @@ func (fake *FakeClient) UpdateDesiredLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) UpdateScheduledTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeClient) UpdateScheduledTaskCalls(stub func(lager.Logger, string, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeClient) UpdateScheduledTaskArgsForCall(i int) (lager.Logger, string, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) UpsertDomain(arg1 lager.Logger, arg2 string, arg3 string, arg4 time.Duration) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -2558,18 +4995,36 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.actualLRPGroupsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
 	fake.actualLRPsByProcessGuidsMutex.RLock()
 	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
@@ -2582,16 +5037,44 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
 	defer fake.subscribeToEventsMutex.RUnlock()
 	fake.subscribeToEventsByCellIDMutex.RLock()
@@ -2600,20 +5083,34 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.subscribeToInstanceEventsMutex.RUnlock()
 	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
 	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
 	fake.subscribeToTaskEventsMutex.RLock()
 	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
 	defer fake.tasksByCellIDMutex.RUnlock()
 	fake.tasksByDomainMutex.RLock()
 	defer fake.tasksByDomainMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
 	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,5502 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_bbs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeContextClient struct {
+	ActualLRPGroupByProcessGuidAndIndexStub        func(context.Context, lager.Logger, string, int) (*models.ActualLRPGroup, error)
+	actualLRPGroupByProcessGuidAndIndexMutex       sync.RWMutex
+	actualLRPGroupByProcessGuidAndIndexArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int
+	}
+	actualLRPGroupByProcessGuidAndIndexReturns struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupByProcessGuidAndIndexReturnsOnCall map[int]struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPGroupsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
+	actualLRPGroupsMutex       sync.RWMutex
+	actualLRPGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPGroupsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPGroupsByProcessGuidStub        func(context.Context, lager.Logger, string) ([]*models.ActualLRPGroup, error)
+	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
+	actualLRPGroupsByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	actualLRPGroupsByProcessGuidReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupsByProcessGuidReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPHistoryStub        func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
+	actualLRPsMutex       sync.RWMutex
+	actualLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	actualLRPsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	ActualLRPsByProcessGuidsStub        func(context.Context, lager.Logger, []string) ([]*models.ActualLRP, error)
+	actualLRPsByProcessGuidsMutex       sync.RWMutex
+	actualLRPsByProcessGuidsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}
+	actualLRPsByProcessGuidsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	actualLRPsByProcessGuidsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	ActualLRPsPageStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+	actualLRPsPageMutex       sync.RWMutex
+	actualLRPsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsPageReturns struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	actualLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	ActualLRPsWithResourceVersionStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error)
+	actualLRPsWithResourceVersionMutex       sync.RWMutex
+	actualLRPsWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsWithResourceVersionReturns struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}
+	actualLRPsWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}
+	AuditRecordsPageStub        func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+	auditRecordsPageMutex       sync.RWMutex
+	auditRecordsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsPageReturns struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	auditRecordsPageReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	CancelTaskStub        func(context.Context, lager.Logger, string) error
+	cancelTaskMutex       sync.RWMutex
+	cancelTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cancelTaskReturns struct {
+		result1 error
+	}
+	cancelTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CellDrainsStub        func(context.Context, lager.Logger) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	CellsStub        func(context.Context, lager.Logger) ([]*models.CellPresence, error)
+	cellsMutex       sync.RWMutex
+	cellsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellsReturns struct {
+		result1 []*models.CellPresence
+		result2 error
+	}
+	cellsReturnsOnCall map[int]struct {
+		result1 []*models.CellPresence
+		result2 error
+	}
+	CordonCellStub        func(context.Context, lager.Logger, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CrashStormBreakersStub        func(context.Context, lager.Logger) ([]*models.CrashStormBreaker, error)
+	crashStormBreakersMutex       sync.RWMutex
+	crashStormBreakersArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	crashStormBreakersReturns struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	crashStormBreakersReturnsOnCall map[int]struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	DeleteScheduledTaskStub        func(context.Context, lager.Logger, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskStub        func(context.Context, lager.Logger, string) error
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteTaskReturns struct {
+		result1 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeploymentByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	DesireLRPStub        func(context.Context, lager.Logger, *models.DesiredLRP) error
+	desireLRPMutex       sync.RWMutex
+	desireLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}
+	desireLRPReturns struct {
+		result1 error
+	}
+	desireLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DesireScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	DesireTaskStub        func(context.Context, lager.Logger, string, string, *models.TaskDefinition) error
+	desireTaskMutex       sync.RWMutex
+	desireTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}
+	desireTaskReturns struct {
+		result1 error
+	}
+	desireTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DesiredLRPByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)
+	desiredLRPByProcessGuidMutex       sync.RWMutex
+	desiredLRPByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPByProcessGuidReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	desiredLRPByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPRoutingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	desiredLRPRoutingInfosMutex       sync.RWMutex
+	desiredLRPRoutingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPRoutingInfosReturns struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	desiredLRPRoutingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPSchedulingInfoByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)
+	desiredLRPSchedulingInfoByProcessGuidMutex       sync.RWMutex
+	desiredLRPSchedulingInfoByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPSchedulingInfoByProcessGuidReturns struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	desiredLRPSchedulingInfoByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	DesiredLRPSchedulingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
+	desiredLRPSchedulingInfosMutex       sync.RWMutex
+	desiredLRPSchedulingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	desiredLRPSchedulingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	DesiredLRPSchedulingInfosWithResourceVersionStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error)
+	desiredLRPSchedulingInfosWithResourceVersionMutex       sync.RWMutex
+	desiredLRPSchedulingInfosWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosWithResourceVersionReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}
+	desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}
+	DesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	desiredLRPsMutex       sync.RWMutex
+	desiredLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	desiredLRPsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPsPageStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	desiredLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	DesiredLRPsWithResourceVersionStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error)
+	desiredLRPsWithResourceVersionMutex       sync.RWMutex
+	desiredLRPsWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsWithResourceVersionReturns struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	desiredLRPsWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, *models.DomainQuota, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	DomainsStub        func(context.Context, lager.Logger) ([]string, error)
+	domainsMutex       sync.RWMutex
+	domainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainsReturns struct {
+		result1 []string
+		result2 error
+	}
+	domainsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	DrainCellStub        func(context.Context, lager.Logger, string) (*models.CellDrain, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	OverloadStatusStub        func(context.Context, lager.Logger) (*models.OverloadStatus, error)
+	overloadStatusMutex       sync.RWMutex
+	overloadStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	overloadStatusReturns struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	overloadStatusReturnsOnCall map[int]struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	PauseDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	pauseDeploymentMutex       sync.RWMutex
+	pauseDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	pauseDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	pauseDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	PingStub        func(context.Context, lager.Logger) bool
+	pingMutex       sync.RWMutex
+	pingArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	pingReturns struct {
+		result1 bool
+	}
+	pingReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RemoveDesiredLRPStub        func(context.Context, lager.Logger, string) error
+	removeDesiredLRPMutex       sync.RWMutex
+	removeDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDesiredLRPReturns struct {
+		result1 error
+	}
+	removeDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplayTaskCallbackStub        func(context.Context, lager.Logger, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ResetCrashStormBreakerStub        func(context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)
+	resetCrashStormBreakerMutex       sync.RWMutex
+	resetCrashStormBreakerArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}
+	resetCrashStormBreakerReturns struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	resetCrashStormBreakerReturnsOnCall map[int]struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	ResolvingTaskStub        func(context.Context, lager.Logger, string) error
+	resolvingTaskMutex       sync.RWMutex
+	resolvingTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	resolvingTaskReturns struct {
+		result1 error
+	}
+	resolvingTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResumeDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	resumeDeploymentMutex       sync.RWMutex
+	resumeDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	resumeDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	resumeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	RetireActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) error
+	retireActualLRPMutex       sync.RWMutex
+	retireActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}
+	retireActualLRPReturns struct {
+		result1 error
+	}
+	retireActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollbackDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	ScheduledTasksStub        func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	StartDeploymentStub        func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	SubscribeToEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToEventsMutex       sync.RWMutex
+	subscribeToEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToEventsByCellIDStub        func(context.Context, lager.Logger, string) (events.EventSource, error)
+	subscribeToEventsByCellIDMutex       sync.RWMutex
+	subscribeToEventsByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	subscribeToEventsByCellIDReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToEventsByCellIDReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToInstanceEventsMutex       sync.RWMutex
+	subscribeToInstanceEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToInstanceEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsByCellIDStub        func(context.Context, lager.Logger, string) (events.EventSource, error)
+	subscribeToInstanceEventsByCellIDMutex       sync.RWMutex
+	subscribeToInstanceEventsByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	subscribeToInstanceEventsByCellIDReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsByCellIDReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsWithFilterStub        func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToInstanceEventsWithFilterMutex       sync.RWMutex
+	subscribeToInstanceEventsWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}
+	subscribeToInstanceEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToTaskEventsMutex       sync.RWMutex
+	subscribeToTaskEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToTaskEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsWithFilterStub        func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToTaskEventsWithFilterMutex       sync.RWMutex
+	subscribeToTaskEventsWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}
+	subscribeToTaskEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SuspendScheduledTaskStub        func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)
+	suspendScheduledTaskMutex       sync.RWMutex
+	suspendScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	suspendScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	suspendScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	TaskByGuidStub        func(context.Context, lager.Logger, string) (*models.Task, error)
+	taskByGuidMutex       sync.RWMutex
+	taskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskByGuidReturns struct {
+		result1 *models.Task
+		result2 error
+	}
+	taskByGuidReturnsOnCall map[int]struct {
+		result1 *models.Task
+		result2 error
+	}
+	TaskCallbacksStub        func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	TasksStub        func(context.Context, lager.Logger) ([]*models.Task, error)
+	tasksMutex       sync.RWMutex
+	tasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	tasksReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksByCellIDStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByCellIDMutex       sync.RWMutex
+	tasksByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByCellIDReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByCellIDReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksByDomainStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByDomainMutex       sync.RWMutex
+	tasksByDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByDomainReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByDomainReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksPageStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, string, error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	tasksPageReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	TasksWithFilterStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
+	tasksWithFilterMutex       sync.RWMutex
+	tasksWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksWithFilterReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksWithFilterReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksWithResourceVersionStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, uint64, error)
+	tasksWithResourceVersionMutex       sync.RWMutex
+	tasksWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksWithResourceVersionReturns struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}
+	tasksWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}
+	UncordonCellStub        func(context.Context, lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateDesiredLRPStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) error
+	updateDesiredLRPMutex       sync.RWMutex
+	updateDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}
+	updateDesiredLRPReturns struct {
+		result1 error
+	}
+	updateDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateScheduledTaskStub        func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	UpsertDomainStub        func(context.Context, lager.Logger, string, time.Duration) error
+	upsertDomainMutex       sync.RWMutex
+	upsertDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}
+	upsertDomainReturns struct {
+		result1 error
+	}
+	upsertDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndex(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
+	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
+	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
+	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(context.Context, lager.Logger, string, int) (*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndexArgsForCall(i int) (context.Context, lager.Logger, string, int) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupByProcessGuidAndIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndexReturns(result1 *models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = nil
+	fake.actualLRPGroupByProcessGuidAndIndexReturns = struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPGroupByProcessGuidAndIndexReturnsOnCall(i int, result1 *models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = nil
+	if fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall == nil {
+		fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[i] = struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPGroups(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupsMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupsReturnsOnCall[len(fake.actualLRPGroupsArgsForCall)]
+	fake.actualLRPGroupsArgsForCall = append(fake.actualLRPGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPGroupsStub
+	fakeReturns := fake.actualLRPGroupsReturns
+	fake.recordInvocation("ActualLRPGroups", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsCallCount() int {
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	return len(fake.actualLRPGroupsArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = nil
+	fake.actualLRPGroupsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsReturnsOnCall(i int, result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = nil
+	if fake.actualLRPGroupsReturnsOnCall == nil {
+		fake.actualLRPGroupsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupsByProcessGuidReturnsOnCall[len(fake.actualLRPGroupsByProcessGuidArgsForCall)]
+	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPGroupsByProcessGuidStub
+	fakeReturns := fake.actualLRPGroupsByProcessGuidReturns
+	fake.recordInvocation("ActualLRPGroupsByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuidCallCount() int {
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	return len(fake.actualLRPGroupsByProcessGuidArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuidCalls(stub func(context.Context, lager.Logger, string) ([]*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupsByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuidReturns(result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = nil
+	fake.actualLRPGroupsByProcessGuidReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPGroupsByProcessGuidReturnsOnCall(i int, result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = nil
+	if fake.actualLRPGroupsByProcessGuidReturnsOnCall == nil {
+		fake.actualLRPGroupsByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupsByProcessGuidReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPHistoryCalls(stub func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
+	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsStub
+	fakeReturns := fake.actualLRPsReturns
+	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPsCallCount() int {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	return len(fake.actualLRPsArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	argsForCall := fake.actualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	fake.actualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	if fake.actualLRPsReturnsOnCall == nil {
+		fake.actualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 error
+		})
+	}
+	fake.actualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuids(arg1 context.Context, arg2 lager.Logger, arg3 []string) ([]*models.ActualLRP, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsByProcessGuidsReturnsOnCall[len(fake.actualLRPsByProcessGuidsArgsForCall)]
+	fake.actualLRPsByProcessGuidsArgsForCall = append(fake.actualLRPsByProcessGuidsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ActualLRPsByProcessGuidsStub
+	fakeReturns := fake.actualLRPsByProcessGuidsReturns
+	fake.recordInvocation("ActualLRPsByProcessGuids", []interface{}{arg1, arg2, arg3Copy})
+	fake.actualLRPsByProcessGuidsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuidsCallCount() int {
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	return len(fake.actualLRPsByProcessGuidsArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuidsCalls(stub func(context.Context, lager.Logger, []string) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuidsArgsForCall(i int) (context.Context, lager.Logger, []string) {
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	argsForCall := fake.actualLRPsByProcessGuidsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuidsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = nil
+	fake.actualLRPsByProcessGuidsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPsByProcessGuidsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = nil
+	if fake.actualLRPsByProcessGuidsReturnsOnCall == nil {
+		fake.actualLRPsByProcessGuidsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 error
+		})
+	}
+	fake.actualLRPsByProcessGuidsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ActualLRPsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	fake.actualLRPsPageMutex.Lock()
+	ret, specificReturn := fake.actualLRPsPageReturnsOnCall[len(fake.actualLRPsPageArgsForCall)]
+	fake.actualLRPsPageArgsForCall = append(fake.actualLRPsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsPageStub
+	fakeReturns := fake.actualLRPsPageReturns
+	fake.recordInvocation("ActualLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) ActualLRPsPageCallCount() int {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	return len(fake.actualLRPsPageArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPsPageCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPsPageArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	argsForCall := fake.actualLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPsPageReturns(result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	fake.actualLRPsPageReturns = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) ActualLRPsPageReturnsOnCall(i int, result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	if fake.actualLRPsPageReturnsOnCall == nil {
+		fake.actualLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.actualLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.actualLRPsWithResourceVersionReturnsOnCall[len(fake.actualLRPsWithResourceVersionArgsForCall)]
+	fake.actualLRPsWithResourceVersionArgsForCall = append(fake.actualLRPsWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsWithResourceVersionStub
+	fakeReturns := fake.actualLRPsWithResourceVersionReturns
+	fake.recordInvocation("ActualLRPsWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersionCallCount() int {
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	return len(fake.actualLRPsWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error)) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = stub
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.actualLRPsWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersionReturns(result1 []*models.ActualLRP, result2 uint64, result3 error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = nil
+	fake.actualLRPsWithResourceVersionReturns = struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) ActualLRPsWithResourceVersionReturnsOnCall(i int, result1 []*models.ActualLRP, result2 uint64, result3 error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = nil
+	if fake.actualLRPsWithResourceVersionReturnsOnCall == nil {
+		fake.actualLRPsWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.actualLRPsWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) AuditRecordsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	fake.auditRecordsPageMutex.Lock()
+	ret, specificReturn := fake.auditRecordsPageReturnsOnCall[len(fake.auditRecordsPageArgsForCall)]
+	fake.auditRecordsPageArgsForCall = append(fake.auditRecordsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsPageStub
+	fakeReturns := fake.auditRecordsPageReturns
+	fake.recordInvocation("AuditRecordsPage", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) AuditRecordsPageCallCount() int {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	return len(fake.auditRecordsPageArgsForCall)
+}
+
+func (fake *FakeContextClient) AuditRecordsPageCalls(stub func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = stub
+}
+
+func (fake *FakeContextClient) AuditRecordsPageArgsForCall(i int) (context.Context, lager.Logger, models.AuditRecordFilter) {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	argsForCall := fake.auditRecordsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) AuditRecordsPageReturns(result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	fake.auditRecordsPageReturns = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) AuditRecordsPageReturnsOnCall(i int, result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	if fake.auditRecordsPageReturnsOnCall == nil {
+		fake.auditRecordsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 string
+			result3 error
+		})
+	}
+	fake.auditRecordsPageReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) CancelTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cancelTaskMutex.Lock()
+	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
+	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CancelTaskStub
+	fakeReturns := fake.cancelTaskReturns
+	fake.recordInvocation("CancelTask", []interface{}{arg1, arg2, arg3})
+	fake.cancelTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) CancelTaskCallCount() int {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return len(fake.cancelTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) CancelTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = stub
+}
+
+func (fake *FakeContextClient) CancelTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	argsForCall := fake.cancelTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) CancelTaskReturns(result1 error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = nil
+	fake.cancelTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) CancelTaskReturnsOnCall(i int, result1 error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = nil
+	if fake.cancelTaskReturnsOnCall == nil {
+		fake.cancelTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) CellDrains(arg1 context.Context, arg2 lager.Logger) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeContextClient) CellDrainsCalls(stub func(context.Context, lager.Logger) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeContextClient) CellDrainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) Cells(arg1 context.Context, arg2 lager.Logger) ([]*models.CellPresence, error) {
+	fake.cellsMutex.Lock()
+	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
+	fake.cellsArgsForCall = append(fake.cellsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellsStub
+	fakeReturns := fake.cellsReturns
+	fake.recordInvocation("Cells", []interface{}{arg1, arg2})
+	fake.cellsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) CellsCallCount() int {
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	return len(fake.cellsArgsForCall)
+}
+
+func (fake *FakeContextClient) CellsCalls(stub func(context.Context, lager.Logger) ([]*models.CellPresence, error)) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = stub
+}
+
+func (fake *FakeContextClient) CellsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	argsForCall := fake.cellsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) CellsReturns(result1 []*models.CellPresence, result2 error) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = nil
+	fake.cellsReturns = struct {
+		result1 []*models.CellPresence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) CellsReturnsOnCall(i int, result1 []*models.CellPresence, result2 error) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = nil
+	if fake.cellsReturnsOnCall == nil {
+		fake.cellsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellPresence
+			result2 error
+		})
+	}
+	fake.cellsReturnsOnCall[i] = struct {
+		result1 []*models.CellPresence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) CordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeContextClient) CordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeContextClient) CordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) CrashStormBreakers(arg1 context.Context, arg2 lager.Logger) ([]*models.CrashStormBreaker, error) {
+	fake.crashStormBreakersMutex.Lock()
+	ret, specificReturn := fake.crashStormBreakersReturnsOnCall[len(fake.crashStormBreakersArgsForCall)]
+	fake.crashStormBreakersArgsForCall = append(fake.crashStormBreakersArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CrashStormBreakersStub
+	fakeReturns := fake.crashStormBreakersReturns
+	fake.recordInvocation("CrashStormBreakers", []interface{}{arg1, arg2})
+	fake.crashStormBreakersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) CrashStormBreakersCallCount() int {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	return len(fake.crashStormBreakersArgsForCall)
+}
+
+func (fake *FakeContextClient) CrashStormBreakersCalls(stub func(context.Context, lager.Logger) ([]*models.CrashStormBreaker, error)) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = stub
+}
+
+func (fake *FakeContextClient) CrashStormBreakersArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	argsForCall := fake.crashStormBreakersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) CrashStormBreakersReturns(result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	fake.crashStormBreakersReturns = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) CrashStormBreakersReturnsOnCall(i int, result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	if fake.crashStormBreakersReturnsOnCall == nil {
+		fake.crashStormBreakersReturnsOnCall = make(map[int]struct {
+			result1 []*models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.crashStormBreakersReturnsOnCall[i] = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DeleteScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) DeleteScheduledTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeContextClient) DeleteScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) DeleteTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeContextClient) DeleteTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DeleteTaskReturns(result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DeleteTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) error {
+	fake.desireLRPMutex.Lock()
+	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
+	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}{arg1, arg2, arg3})
+	stub := fake.DesireLRPStub
+	fakeReturns := fake.desireLRPReturns
+	fake.recordInvocation("DesireLRP", []interface{}{arg1, arg2, arg3})
+	fake.desireLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) DesireLRPCallCount() int {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	return len(fake.desireLRPArgsForCall)
+}
+
+func (fake *FakeContextClient) DesireLRPCalls(stub func(context.Context, lager.Logger, *models.DesiredLRP) error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = stub
+}
+
+func (fake *FakeContextClient) DesireLRPArgsForCall(i int) (context.Context, lager.Logger, *models.DesiredLRP) {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	argsForCall := fake.desireLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesireLRPReturns(result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	fake.desireLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DesireLRPReturnsOnCall(i int, result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	if fake.desireLRPReturnsOnCall == nil {
+		fake.desireLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DesireScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) DesireScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeContextClient) DesireScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
+	fake.desireTaskMutex.Lock()
+	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
+	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DesireTaskStub
+	fakeReturns := fake.desireTaskReturns
+	fake.recordInvocation("DesireTask", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.desireTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) DesireTaskCallCount() int {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	return len(fake.desireTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) DesireTaskCalls(stub func(context.Context, lager.Logger, string, string, *models.TaskDefinition) error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = stub
+}
+
+func (fake *FakeContextClient) DesireTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, *models.TaskDefinition) {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	argsForCall := fake.desireTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeContextClient) DesireTaskReturns(result1 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	fake.desireTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DesireTaskReturnsOnCall(i int, result1 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	if fake.desireTaskReturnsOnCall == nil {
+		fake.desireTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
+	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPByProcessGuidStub
+	fakeReturns := fake.desiredLRPByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuidCallCount() int {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPByProcessGuidArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	fake.desiredLRPByProcessGuidReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
+	fake.desiredLRPRoutingInfosArgsForCall = append(fake.desiredLRPRoutingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRoutingInfosStub
+	fakeReturns := fake.desiredLRPRoutingInfosReturns
+	fake.recordInvocation("DesiredLRPRoutingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRoutingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfosCallCount() int {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	return len(fake.desiredLRPRoutingInfosArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPRoutingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfosReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	fake.desiredLRPRoutingInfosReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPRoutingInfosReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	if fake.desiredLRPRoutingInfosReturnsOnCall == nil {
+		fake.desiredLRPRoutingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPRoutingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)]
+	fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall = append(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfoByProcessGuidStub
+	fakeReturns := fake.desiredLRPSchedulingInfoByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfoByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuidCallCount() int {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuidReturns(result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	fake.desiredLRPSchedulingInfoByProcessGuidReturns = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfoByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	if fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfosReturnsOnCall[len(fake.desiredLRPSchedulingInfosArgsForCall)]
+	fake.desiredLRPSchedulingInfosArgsForCall = append(fake.desiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfosStub
+	fakeReturns := fake.desiredLRPSchedulingInfosReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosCallCount() int {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	fake.desiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	if fake.desiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall[len(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall)]
+	fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall = append(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfosWithResourceVersionStub
+	fakeReturns := fake.desiredLRPSchedulingInfosWithResourceVersionReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfosWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersionCallCount() int {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error)) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersionReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 uint64, result3 error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = nil
+	fake.desiredLRPSchedulingInfosWithResourceVersionReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DesiredLRPSchedulingInfosWithResourceVersionReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 uint64, result3 error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = nil
+	if fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
+	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsStub
+	fakeReturns := fake.desiredLRPsReturns
+	fake.recordInvocation("DesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DesiredLRPsCallCount() int {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	return len(fake.desiredLRPsArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	argsForCall := fake.desiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	fake.desiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	if fake.desiredLRPsReturnsOnCall == nil {
+		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DesiredLRPsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	fake.desiredLRPsPageMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsPageReturnsOnCall[len(fake.desiredLRPsPageArgsForCall)]
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsPageStub
+	fakeReturns := fake.desiredLRPsPageReturns
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPsPageCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPsPageArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	argsForCall := fake.desiredLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DesiredLRPsPageReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	if fake.desiredLRPsPageReturnsOnCall == nil {
+		fake.desiredLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.desiredLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsWithResourceVersionReturnsOnCall[len(fake.desiredLRPsWithResourceVersionArgsForCall)]
+	fake.desiredLRPsWithResourceVersionArgsForCall = append(fake.desiredLRPsWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsWithResourceVersionStub
+	fakeReturns := fake.desiredLRPsWithResourceVersionReturns
+	fake.recordInvocation("DesiredLRPsWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersionCallCount() int {
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	return len(fake.desiredLRPsWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error)) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = stub
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.desiredLRPsWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersionReturns(result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = nil
+	fake.desiredLRPsWithResourceVersionReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DesiredLRPsWithResourceVersionReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = nil
+	if fake.desiredLRPsWithResourceVersionReturnsOnCall == nil {
+		fake.desiredLRPsWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.desiredLRPsWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeContextClient) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeContextClient) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, *models.DomainQuota, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeContextClient) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, *models.DomainQuota, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeContextClient) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DomainUsageReturns(result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 *models.DomainQuota
+			result3 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) Domains(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
+	fake.domainsMutex.Lock()
+	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainsStub
+	fakeReturns := fake.domainsReturns
+	fake.recordInvocation("Domains", []interface{}{arg1, arg2})
+	fake.domainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeContextClient) DomainsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = stub
+}
+
+func (fake *FakeContextClient) DomainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	argsForCall := fake.domainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) DomainsReturns(result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DomainsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	if fake.domainsReturnsOnCall == nil {
+		fake.domainsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.domainsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DrainCell(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeContextClient) DrainCellCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeContextClient) DrainCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) DrainCellReturns(result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) DrainCellReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) OverloadStatus(arg1 context.Context, arg2 lager.Logger) (*models.OverloadStatus, error) {
+	fake.overloadStatusMutex.Lock()
+	ret, specificReturn := fake.overloadStatusReturnsOnCall[len(fake.overloadStatusArgsForCall)]
+	fake.overloadStatusArgsForCall = append(fake.overloadStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.OverloadStatusStub
+	fakeReturns := fake.overloadStatusReturns
+	fake.recordInvocation("OverloadStatus", []interface{}{arg1, arg2})
+	fake.overloadStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) OverloadStatusCallCount() int {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	return len(fake.overloadStatusArgsForCall)
+}
+
+func (fake *FakeContextClient) OverloadStatusCalls(stub func(context.Context, lager.Logger) (*models.OverloadStatus, error)) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = stub
+}
+
+func (fake *FakeContextClient) OverloadStatusArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	argsForCall := fake.overloadStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) OverloadStatusReturns(result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	fake.overloadStatusReturns = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) OverloadStatusReturnsOnCall(i int, result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	if fake.overloadStatusReturnsOnCall == nil {
+		fake.overloadStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.OverloadStatus
+			result2 error
+		})
+	}
+	fake.overloadStatusReturnsOnCall[i] = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) PauseDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.pauseDeploymentMutex.Lock()
+	ret, specificReturn := fake.pauseDeploymentReturnsOnCall[len(fake.pauseDeploymentArgsForCall)]
+	fake.pauseDeploymentArgsForCall = append(fake.pauseDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PauseDeploymentStub
+	fakeReturns := fake.pauseDeploymentReturns
+	fake.recordInvocation("PauseDeployment", []interface{}{arg1, arg2, arg3})
+	fake.pauseDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) PauseDeploymentCallCount() int {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	return len(fake.pauseDeploymentArgsForCall)
+}
+
+func (fake *FakeContextClient) PauseDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = stub
+}
+
+func (fake *FakeContextClient) PauseDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	argsForCall := fake.pauseDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) PauseDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	fake.pauseDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) PauseDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	if fake.pauseDeploymentReturnsOnCall == nil {
+		fake.pauseDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.pauseDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) Ping(arg1 context.Context, arg2 lager.Logger) bool {
+	fake.pingMutex.Lock()
+	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
+	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PingStub
+	fakeReturns := fake.pingReturns
+	fake.recordInvocation("Ping", []interface{}{arg1, arg2})
+	fake.pingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) PingCallCount() int {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	return len(fake.pingArgsForCall)
+}
+
+func (fake *FakeContextClient) PingCalls(stub func(context.Context, lager.Logger) bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = stub
+}
+
+func (fake *FakeContextClient) PingArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	argsForCall := fake.pingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) PingReturns(result1 bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	fake.pingReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeContextClient) PingReturnsOnCall(i int, result1 bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	if fake.pingReturnsOnCall == nil {
+		fake.pingReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pingReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
+	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDesiredLRPStub
+	fakeReturns := fake.removeDesiredLRPReturns
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{arg1, arg2, arg3})
+	fake.removeDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRPCallCount() int {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	return len(fake.removeDesiredLRPArgsForCall)
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRPCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = stub
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	argsForCall := fake.removeDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRPReturns(result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	fake.removeDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	if fake.removeDesiredLRPReturnsOnCall == nil {
+		fake.removeDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) RemoveDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeContextClient) RemoveDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeContextClient) RemoveDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) ReplayTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
+}
+
+func (fake *FakeContextClient) ReplayTaskCallbackCalls(stub func(context.Context, lager.Logger, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
+}
+
+func (fake *FakeContextClient) ReplayTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreaker(arg1 context.Context, arg2 lager.Logger, arg3 models.CrashStormBreaker_Scope, arg4 string) (*models.CrashStormBreaker, error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	ret, specificReturn := fake.resetCrashStormBreakerReturnsOnCall[len(fake.resetCrashStormBreakerArgsForCall)]
+	fake.resetCrashStormBreakerArgsForCall = append(fake.resetCrashStormBreakerArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetCrashStormBreakerStub
+	fakeReturns := fake.resetCrashStormBreakerReturns
+	fake.recordInvocation("ResetCrashStormBreaker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetCrashStormBreakerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreakerCallCount() int {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	return len(fake.resetCrashStormBreakerArgsForCall)
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreakerCalls(stub func(context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = stub
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreakerArgsForCall(i int) (context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	argsForCall := fake.resetCrashStormBreakerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreakerReturns(result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	fake.resetCrashStormBreakerReturns = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ResetCrashStormBreakerReturnsOnCall(i int, result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	if fake.resetCrashStormBreakerReturnsOnCall == nil {
+		fake.resetCrashStormBreakerReturnsOnCall = make(map[int]struct {
+			result1 *models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.resetCrashStormBreakerReturnsOnCall[i] = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ResolvingTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.resolvingTaskMutex.Lock()
+	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
+	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolvingTaskStub
+	fakeReturns := fake.resolvingTaskReturns
+	fake.recordInvocation("ResolvingTask", []interface{}{arg1, arg2, arg3})
+	fake.resolvingTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) ResolvingTaskCallCount() int {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	return len(fake.resolvingTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) ResolvingTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = stub
+}
+
+func (fake *FakeContextClient) ResolvingTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	argsForCall := fake.resolvingTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ResolvingTaskReturns(result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	fake.resolvingTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) ResumeDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.resumeDeploymentMutex.Lock()
+	ret, specificReturn := fake.resumeDeploymentReturnsOnCall[len(fake.resumeDeploymentArgsForCall)]
+	fake.resumeDeploymentArgsForCall = append(fake.resumeDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeDeploymentStub
+	fakeReturns := fake.resumeDeploymentReturns
+	fake.recordInvocation("ResumeDeployment", []interface{}{arg1, arg2, arg3})
+	fake.resumeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ResumeDeploymentCallCount() int {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	return len(fake.resumeDeploymentArgsForCall)
+}
+
+func (fake *FakeContextClient) ResumeDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = stub
+}
+
+func (fake *FakeContextClient) ResumeDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	argsForCall := fake.resumeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ResumeDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	fake.resumeDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ResumeDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	if fake.resumeDeploymentReturnsOnCall == nil {
+		fake.resumeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.resumeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) RetireActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) error {
+	fake.retireActualLRPMutex.Lock()
+	ret, specificReturn := fake.retireActualLRPReturnsOnCall[len(fake.retireActualLRPArgsForCall)]
+	fake.retireActualLRPArgsForCall = append(fake.retireActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RetireActualLRPStub
+	fakeReturns := fake.retireActualLRPReturns
+	fake.recordInvocation("RetireActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.retireActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) RetireActualLRPCallCount() int {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	return len(fake.retireActualLRPArgsForCall)
+}
+
+func (fake *FakeContextClient) RetireActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey) error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = stub
+}
+
+func (fake *FakeContextClient) RetireActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey) {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	argsForCall := fake.retireActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) RetireActualLRPReturns(result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	fake.retireActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	if fake.retireActualLRPReturnsOnCall == nil {
+		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retireActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) RollbackDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeContextClient) RollbackDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeContextClient) RollbackDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) RollbackDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) RollbackDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeContextClient) ScheduledTasksCalls(stub func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeContextClient) ScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SetDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
+}
+
+func (fake *FakeContextClient) SetDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
+}
+
+func (fake *FakeContextClient) SetDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) StartDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeContextClient) StartDeploymentCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeContextClient) StartDeploymentArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeContextClient) StartDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) StartDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToEventsReturnsOnCall[len(fake.subscribeToEventsArgsForCall)]
+	fake.subscribeToEventsArgsForCall = append(fake.subscribeToEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToEventsStub
+	fakeReturns := fake.subscribeToEventsReturns
+	fake.recordInvocation("SubscribeToEvents", []interface{}{arg1, arg2})
+	fake.subscribeToEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToEventsCallCount() int {
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	return len(fake.subscribeToEventsArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) SubscribeToEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = nil
+	fake.subscribeToEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = nil
+	if fake.subscribeToEventsReturnsOnCall == nil {
+		fake.subscribeToEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) (events.EventSource, error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	ret, specificReturn := fake.subscribeToEventsByCellIDReturnsOnCall[len(fake.subscribeToEventsByCellIDArgsForCall)]
+	fake.subscribeToEventsByCellIDArgsForCall = append(fake.subscribeToEventsByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToEventsByCellIDStub
+	fakeReturns := fake.subscribeToEventsByCellIDReturns
+	fake.recordInvocation("SubscribeToEventsByCellID", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToEventsByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellIDCallCount() int {
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	return len(fake.subscribeToEventsByCellIDArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellIDCalls(stub func(context.Context, lager.Logger, string) (events.EventSource, error)) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	argsForCall := fake.subscribeToEventsByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellIDReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = nil
+	fake.subscribeToEventsByCellIDReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToEventsByCellIDReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = nil
+	if fake.subscribeToEventsByCellIDReturnsOnCall == nil {
+		fake.subscribeToEventsByCellIDReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToEventsByCellIDReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsReturnsOnCall[len(fake.subscribeToInstanceEventsArgsForCall)]
+	fake.subscribeToInstanceEventsArgsForCall = append(fake.subscribeToInstanceEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToInstanceEventsStub
+	fakeReturns := fake.subscribeToInstanceEventsReturns
+	fake.recordInvocation("SubscribeToInstanceEvents", []interface{}{arg1, arg2})
+	fake.subscribeToInstanceEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsCallCount() int {
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = nil
+	fake.subscribeToInstanceEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = nil
+	if fake.subscribeToInstanceEventsReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsByCellIDReturnsOnCall[len(fake.subscribeToInstanceEventsByCellIDArgsForCall)]
+	fake.subscribeToInstanceEventsByCellIDArgsForCall = append(fake.subscribeToInstanceEventsByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToInstanceEventsByCellIDStub
+	fakeReturns := fake.subscribeToInstanceEventsByCellIDReturns
+	fake.recordInvocation("SubscribeToInstanceEventsByCellID", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellIDCallCount() int {
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsByCellIDArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellIDCalls(stub func(context.Context, lager.Logger, string) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellIDReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = nil
+	fake.subscribeToInstanceEventsByCellIDReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsByCellIDReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = nil
+	if fake.subscribeToInstanceEventsByCellIDReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsByCellIDReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsByCellIDReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsWithFilterReturnsOnCall[len(fake.subscribeToInstanceEventsWithFilterArgsForCall)]
+	fake.subscribeToInstanceEventsWithFilterArgsForCall = append(fake.subscribeToInstanceEventsWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToInstanceEventsWithFilterStub
+	fakeReturns := fake.subscribeToInstanceEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToInstanceEventsWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilterCallCount() int {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilterCalls(stub func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.EventFilter) {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	fake.subscribeToInstanceEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToInstanceEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	if fake.subscribeToInstanceEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsReturnsOnCall[len(fake.subscribeToTaskEventsArgsForCall)]
+	fake.subscribeToTaskEventsArgsForCall = append(fake.subscribeToTaskEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToTaskEventsStub
+	fakeReturns := fake.subscribeToTaskEventsReturns
+	fake.recordInvocation("SubscribeToTaskEvents", []interface{}{arg1, arg2})
+	fake.subscribeToTaskEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsCallCount() int {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = nil
+	fake.subscribeToTaskEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = nil
+	if fake.subscribeToTaskEventsReturnsOnCall == nil {
+		fake.subscribeToTaskEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsWithFilterReturnsOnCall[len(fake.subscribeToTaskEventsWithFilterArgsForCall)]
+	fake.subscribeToTaskEventsWithFilterArgsForCall = append(fake.subscribeToTaskEventsWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToTaskEventsWithFilterStub
+	fakeReturns := fake.subscribeToTaskEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToTaskEventsWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilterCallCount() int {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilterCalls(stub func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = stub
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.EventFilter) {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	fake.subscribeToTaskEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SubscribeToTaskEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	if fake.subscribeToTaskEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToTaskEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SuspendScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.suspendScheduledTaskReturnsOnCall[len(fake.suspendScheduledTaskArgsForCall)]
+	fake.suspendScheduledTaskArgsForCall = append(fake.suspendScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SuspendScheduledTaskStub
+	fakeReturns := fake.suspendScheduledTaskReturns
+	fake.recordInvocation("SuspendScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.suspendScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) SuspendScheduledTaskCallCount() int {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	return len(fake.suspendScheduledTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) SuspendScheduledTaskCalls(stub func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = stub
+}
+
+func (fake *FakeContextClient) SuspendScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	argsForCall := fake.suspendScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) SuspendScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	fake.suspendScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) SuspendScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	if fake.suspendScheduledTaskReturnsOnCall == nil {
+		fake.suspendScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.suspendScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
+	fake.taskByGuidMutex.Lock()
+	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
+	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskByGuidStub
+	fakeReturns := fake.taskByGuidReturns
+	fake.recordInvocation("TaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.taskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TaskByGuidCallCount() int {
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	return len(fake.taskByGuidArgsForCall)
+}
+
+func (fake *FakeContextClient) TaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Task, error)) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = stub
+}
+
+func (fake *FakeContextClient) TaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	argsForCall := fake.taskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TaskByGuidReturns(result1 *models.Task, result2 error) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = nil
+	fake.taskByGuidReturns = struct {
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TaskByGuidReturnsOnCall(i int, result1 *models.Task, result2 error) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = nil
+	if fake.taskByGuidReturnsOnCall == nil {
+		fake.taskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 error
+		})
+	}
+	fake.taskByGuidReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeContextClient) TaskCallbacksCalls(stub func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeContextClient) TaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) Tasks(arg1 context.Context, arg2 lager.Logger) ([]*models.Task, error) {
+	fake.tasksMutex.Lock()
+	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
+	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.TasksStub
+	fakeReturns := fake.tasksReturns
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2})
+	fake.tasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TasksCallCount() int {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	return len(fake.tasksArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksCalls(stub func(context.Context, lager.Logger) ([]*models.Task, error)) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = stub
+}
+
+func (fake *FakeContextClient) TasksArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	argsForCall := fake.tasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeContextClient) TasksReturns(result1 []*models.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	fake.tasksReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	if fake.tasksReturnsOnCall == nil {
+		fake.tasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByCellIDMutex.Lock()
+	ret, specificReturn := fake.tasksByCellIDReturnsOnCall[len(fake.tasksByCellIDArgsForCall)]
+	fake.tasksByCellIDArgsForCall = append(fake.tasksByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByCellIDStub
+	fakeReturns := fake.tasksByCellIDReturns
+	fake.recordInvocation("TasksByCellID", []interface{}{arg1, arg2, arg3})
+	fake.tasksByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TasksByCellIDCallCount() int {
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	return len(fake.tasksByCellIDArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksByCellIDCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = stub
+}
+
+func (fake *FakeContextClient) TasksByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	argsForCall := fake.tasksByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TasksByCellIDReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = nil
+	fake.tasksByCellIDReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksByCellIDReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = nil
+	if fake.tasksByCellIDReturnsOnCall == nil {
+		fake.tasksByCellIDReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByCellIDReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksByDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByDomainMutex.Lock()
+	ret, specificReturn := fake.tasksByDomainReturnsOnCall[len(fake.tasksByDomainArgsForCall)]
+	fake.tasksByDomainArgsForCall = append(fake.tasksByDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByDomainStub
+	fakeReturns := fake.tasksByDomainReturns
+	fake.recordInvocation("TasksByDomain", []interface{}{arg1, arg2, arg3})
+	fake.tasksByDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TasksByDomainCallCount() int {
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	return len(fake.tasksByDomainArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksByDomainCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = stub
+}
+
+func (fake *FakeContextClient) TasksByDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	argsForCall := fake.tasksByDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TasksByDomainReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = nil
+	fake.tasksByDomainReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksByDomainReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = nil
+	if fake.tasksByDomainReturnsOnCall == nil {
+		fake.tasksByDomainReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByDomainReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksPage(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, string, error) {
+	fake.tasksPageMutex.Lock()
+	ret, specificReturn := fake.tasksPageReturnsOnCall[len(fake.tasksPageArgsForCall)]
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksPageStub
+	fakeReturns := fake.tasksPageReturns
+	fake.recordInvocation("TasksPage", []interface{}{arg1, arg2, arg3})
+	fake.tasksPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksPageCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, string, error)) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = stub
+}
+
+func (fake *FakeContextClient) TasksPageArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	argsForCall := fake.tasksPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) TasksPageReturnsOnCall(i int, result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	if fake.tasksPageReturnsOnCall == nil {
+		fake.tasksPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 string
+			result3 error
+		})
+	}
+	fake.tasksPageReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) TasksWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
+	fake.tasksWithFilterMutex.Lock()
+	ret, specificReturn := fake.tasksWithFilterReturnsOnCall[len(fake.tasksWithFilterArgsForCall)]
+	fake.tasksWithFilterArgsForCall = append(fake.tasksWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksWithFilterStub
+	fakeReturns := fake.tasksWithFilterReturns
+	fake.recordInvocation("TasksWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.tasksWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) TasksWithFilterCallCount() int {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return len(fake.tasksWithFilterArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksWithFilterCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = stub
+}
+
+func (fake *FakeContextClient) TasksWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	argsForCall := fake.tasksWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TasksWithFilterReturns(result1 []*models.Task, result2 error) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = nil
+	fake.tasksWithFilterReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksWithFilterReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = nil
+	if fake.tasksWithFilterReturnsOnCall == nil {
+		fake.tasksWithFilterReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksWithFilterReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, uint64, error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.tasksWithResourceVersionReturnsOnCall[len(fake.tasksWithResourceVersionArgsForCall)]
+	fake.tasksWithResourceVersionArgsForCall = append(fake.tasksWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksWithResourceVersionStub
+	fakeReturns := fake.tasksWithResourceVersionReturns
+	fake.recordInvocation("TasksWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.tasksWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersionCallCount() int {
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	return len(fake.tasksWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, uint64, error)) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = stub
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.tasksWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersionReturns(result1 []*models.Task, result2 uint64, result3 error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = nil
+	fake.tasksWithResourceVersionReturns = struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) TasksWithResourceVersionReturnsOnCall(i int, result1 []*models.Task, result2 uint64, result3 error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = nil
+	if fake.tasksWithResourceVersionReturnsOnCall == nil {
+		fake.tasksWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.tasksWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeContextClient) UncordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeContextClient) UncordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeContextClient) UncordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeContextClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) error {
+	fake.updateDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
+	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateDesiredLRPStub
+	fakeReturns := fake.updateDesiredLRPReturns
+	fake.recordInvocation("UpdateDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRPCallCount() int {
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	return len(fake.updateDesiredLRPArgsForCall)
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRPCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = stub
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPUpdate) {
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	argsForCall := fake.updateDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRPReturns(result1 error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = nil
+	fake.updateDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) UpdateDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = nil
+	if fake.updateDesiredLRPReturnsOnCall == nil {
+		fake.updateDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) UpdateScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeContextClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeContextClient) UpdateScheduledTaskCalls(stub func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeContextClient) UpdateScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeContextClient) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Duration) error {
+	fake.upsertDomainMutex.Lock()
+	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
+	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpsertDomainStub
+	fakeReturns := fake.upsertDomainReturns
+	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.upsertDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeContextClient) UpsertDomainCallCount() int {
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	return len(fake.upsertDomainArgsForCall)
+}
+
+func (fake *FakeContextClient) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, time.Duration) error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = stub
+}
+
+func (fake *FakeContextClient) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, time.Duration) {
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	argsForCall := fake.upsertDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeContextClient) UpsertDomainReturns(result1 error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = nil
+	fake.upsertDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) UpsertDomainReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = nil
+	if fake.upsertDomainReturnsOnCall == nil {
+		fake.upsertDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeContextClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeContextClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ bbs.ContextClient = new(FakeContextClient)
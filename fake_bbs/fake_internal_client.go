@@ -58,6 +58,22 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRPGroup
 		result2 error
 	}
+	ActualLRPHistoryStub        func(lager.Logger, string, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
 	ActualLRPsStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)
 	actualLRPsMutex       sync.RWMutex
 	actualLRPsArgsForCall []struct {
@@ -88,6 +104,40 @@ type FakeInternalClient struct {
 		result1 []*models.ActualLRP
 		result2 error
 	}
+	ActualLRPsPageStub        func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+	actualLRPsPageMutex       sync.RWMutex
+	actualLRPsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsPageReturns struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	actualLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	AuditRecordsPageStub        func(lager.Logger, string, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+	auditRecordsPageMutex       sync.RWMutex
+	auditRecordsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsPageReturns struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	auditRecordsPageReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
 	CancelTaskStub        func(lager.Logger, string, string) error
 	cancelTaskMutex       sync.RWMutex
 	cancelTaskArgsForCall []struct {
@@ -101,6 +151,20 @@ type FakeInternalClient struct {
 	cancelTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CellDrainsStub        func(lager.Logger, string) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
 	CellsStub        func(lager.Logger, string) ([]*models.CellPresence, error)
 	cellsMutex       sync.RWMutex
 	cellsArgsForCall []struct {
@@ -146,6 +210,19 @@ type FakeInternalClient struct {
 	completeTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CordonCellStub        func(lager.Logger, string, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CrashActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) error
 	crashActualLRPMutex       sync.RWMutex
 	crashActualLRPArgsForCall []struct {
@@ -161,6 +238,33 @@ type FakeInternalClient struct {
 	crashActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CrashStormBreakersStub        func(lager.Logger, string) ([]*models.CrashStormBreaker, error)
+	crashStormBreakersMutex       sync.RWMutex
+	crashStormBreakersArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	crashStormBreakersReturns struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	crashStormBreakersReturnsOnCall map[int]struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	DeleteScheduledTaskStub        func(lager.Logger, string, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteTaskStub        func(lager.Logger, string, string) error
 	deleteTaskMutex       sync.RWMutex
 	deleteTaskArgsForCall []struct {
@@ -174,6 +278,21 @@ type FakeInternalClient struct {
 	deleteTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeploymentByProcessGuidStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	DesireLRPStub        func(lager.Logger, string, *models.DesiredLRP) error
 	desireLRPMutex       sync.RWMutex
 	desireLRPArgsForCall []struct {
@@ -187,6 +306,21 @@ type FakeInternalClient struct {
 	desireLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DesireScheduledTaskStub        func(lager.Logger, string, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	DesireTaskStub        func(lager.Logger, string, string, string, *models.TaskDefinition) error
 	desireTaskMutex       sync.RWMutex
 	desireTaskArgsForCall []struct {
@@ -277,6 +411,54 @@ type FakeInternalClient struct {
 		result1 []*models.DesiredLRP
 		result2 error
 	}
+	DesiredLRPsPageStub        func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	desiredLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	DomainQuotasStub        func(lager.Logger, string) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(lager.Logger, string, string) (*models.DomainUsage, *models.DomainQuota, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
 	DomainsStub        func(lager.Logger, string) ([]string, error)
 	domainsMutex       sync.RWMutex
 	domainsArgsForCall []struct {
@@ -291,6 +473,21 @@ type FakeInternalClient struct {
 		result1 []string
 		result2 error
 	}
+	DrainCellStub        func(lager.Logger, string, string) (*models.CellDrain, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
 	EvacuateClaimedActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
 	evacuateClaimedActualLRPMutex       sync.RWMutex
 	evacuateClaimedActualLRPArgsForCall []struct {
@@ -389,6 +586,35 @@ type FakeInternalClient struct {
 	failTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	OverloadStatusStub        func(lager.Logger, string) (*models.OverloadStatus, error)
+	overloadStatusMutex       sync.RWMutex
+	overloadStatusArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+	}
+	overloadStatusReturns struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	overloadStatusReturnsOnCall map[int]struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	PauseDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	pauseDeploymentMutex       sync.RWMutex
+	pauseDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	pauseDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	pauseDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	PingStub        func(lager.Logger, string) bool
 	pingMutex       sync.RWMutex
 	pingArgsForCall []struct {
@@ -442,6 +668,19 @@ type FakeInternalClient struct {
 	removeDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemoveDomainQuotaStub        func(lager.Logger, string, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	RemoveEvacuatingActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
 	removeEvacuatingActualLRPMutex       sync.RWMutex
 	removeEvacuatingActualLRPArgsForCall []struct {
@@ -456,6 +695,37 @@ type FakeInternalClient struct {
 	removeEvacuatingActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReplayTaskCallbackStub        func(lager.Logger, string, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ResetCrashStormBreakerStub        func(lager.Logger, string, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)
+	resetCrashStormBreakerMutex       sync.RWMutex
+	resetCrashStormBreakerArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}
+	resetCrashStormBreakerReturns struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	resetCrashStormBreakerReturnsOnCall map[int]struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
 	ResolvingTaskStub        func(lager.Logger, string, string) error
 	resolvingTaskMutex       sync.RWMutex
 	resolvingTaskArgsForCall []struct {
@@ -469,6 +739,21 @@ type FakeInternalClient struct {
 	resolvingTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ResumeDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	resumeDeploymentMutex       sync.RWMutex
+	resumeDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	resumeDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	resumeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	RetireActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey) error
 	retireActualLRPMutex       sync.RWMutex
 	retireActualLRPArgsForCall []struct {
@@ -482,6 +767,51 @@ type FakeInternalClient struct {
 	retireActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RollbackDeploymentStub        func(lager.Logger, string, string) (*models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	ScheduledTasksStub        func(lager.Logger, string, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetDomainQuotaStub        func(lager.Logger, string, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
 	StartActualLRPStub        func(lager.Logger, string, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) error
 	startActualLRPMutex       sync.RWMutex
 	startActualLRPArgsForCall []struct {
@@ -501,6 +831,24 @@ type FakeInternalClient struct {
 	startActualLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	StartDeploymentStub        func(lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
 	StartTaskStub        func(lager.Logger, string, string, string) (bool, error)
 	startTaskMutex       sync.RWMutex
 	startTaskArgsForCall []struct {
@@ -571,6 +919,20 @@ type FakeInternalClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToInstanceEventsWithFilterStub        func(lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToInstanceEventsWithFilterMutex       sync.RWMutex
+	subscribeToInstanceEventsWithFilterArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}
+	subscribeToInstanceEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
 	SubscribeToTaskEventsStub        func(lager.Logger) (events.EventSource, error)
 	subscribeToTaskEventsMutex       sync.RWMutex
 	subscribeToTaskEventsArgsForCall []struct {
@@ -584,6 +946,36 @@ type FakeInternalClient struct {
 		result1 events.EventSource
 		result2 error
 	}
+	SubscribeToTaskEventsWithFilterStub        func(lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToTaskEventsWithFilterMutex       sync.RWMutex
+	subscribeToTaskEventsWithFilterArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}
+	subscribeToTaskEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SuspendScheduledTaskStub        func(lager.Logger, string, string, bool) (*models.ScheduledTask, error)
+	suspendScheduledTaskMutex       sync.RWMutex
+	suspendScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 bool
+	}
+	suspendScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	suspendScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	TaskByGuidStub        func(lager.Logger, string, string) (*models.Task, error)
 	taskByGuidMutex       sync.RWMutex
 	taskByGuidArgsForCall []struct {
@@ -599,6 +991,21 @@ type FakeInternalClient struct {
 		result1 *models.Task
 		result2 error
 	}
+	TaskCallbacksStub        func(lager.Logger, string, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
 	TasksStub        func(lager.Logger, string) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
@@ -643,6 +1050,23 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	TasksPageStub        func(lager.Logger, string, models.TaskFilter) ([]*models.Task, string, error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	tasksPageReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
 	TasksWithFilterStub        func(lager.Logger, string, models.TaskFilter) ([]*models.Task, error)
 	tasksWithFilterMutex       sync.RWMutex
 	tasksWithFilterArgsForCall []struct {
@@ -658,6 +1082,19 @@ type FakeInternalClient struct {
 		result1 []*models.Task
 		result2 error
 	}
+	UncordonCellStub        func(lager.Logger, string, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateDesiredLRPStub        func(lager.Logger, string, string, *models.DesiredLRPUpdate) error
 	updateDesiredLRPMutex       sync.RWMutex
 	updateDesiredLRPArgsForCall []struct {
@@ -672,6 +1109,22 @@ type FakeInternalClient struct {
 	updateDesiredLRPReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateScheduledTaskStub        func(lager.Logger, string, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
 	UpsertDomainStub        func(lager.Logger, string, string, time.Duration) error
 	upsertDomainMutex       sync.RWMutex
 	upsertDomainArgsForCall []struct {
@@ -889,20 +1342,21 @@ func (fake *FakeInternalClient) ActualLRPGroupsByProcessGuidReturnsOnCall(i int,
 	}{result1, result2}
 }
 
-func (fake *FakeInternalClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
-	fake.actualLRPsMutex.Lock()
-	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
-	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+func (fake *FakeInternalClient) ActualLRPHistory(arg1 lager.Logger, arg2 string, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
-		arg3 models.ActualLRPFilter
-	}{arg1, arg2, arg3})
-	stub := fake.ActualLRPsStub
-	fakeReturns := fake.actualLRPsReturns
-	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
-	fake.actualLRPsMutex.Unlock()
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -910,23 +1364,89 @@ func (fake *FakeInternalClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3
 	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeInternalClient) ActualLRPsCallCount() int {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	return len(fake.actualLRPsArgsForCall)
+func (fake *FakeInternalClient) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
 }
 
-func (fake *FakeInternalClient) ActualLRPsCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
-	fake.actualLRPsMutex.Lock()
-	defer fake.actualLRPsMutex.Unlock()
-	fake.ActualLRPsStub = stub
+func (fake *FakeInternalClient) ActualLRPHistoryCalls(stub func(lager.Logger, string, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
 }
 
-func (fake *FakeInternalClient) ActualLRPsArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
-	fake.actualLRPsMutex.RLock()
-	defer fake.actualLRPsMutex.RUnlock()
-	argsForCall := fake.actualLRPsArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+func (fake *FakeInternalClient) ActualLRPHistoryArgsForCall(i int) (lager.Logger, string, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ActualLRPs(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
+	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsStub
+	fakeReturns := fake.actualLRPsReturns
+	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ActualLRPsCallCount() int {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	return len(fake.actualLRPsArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPsCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = stub
+}
+
+func (fake *FakeInternalClient) ActualLRPsArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	argsForCall := fake.actualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeInternalClient) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
@@ -1026,6 +1546,144 @@ func (fake *FakeInternalClient) ActualLRPsByProcessGuidsReturnsOnCall(i int, res
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) ActualLRPsPage(arg1 lager.Logger, arg2 string, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	fake.actualLRPsPageMutex.Lock()
+	ret, specificReturn := fake.actualLRPsPageReturnsOnCall[len(fake.actualLRPsPageArgsForCall)]
+	fake.actualLRPsPageArgsForCall = append(fake.actualLRPsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsPageStub
+	fakeReturns := fake.actualLRPsPageReturns
+	fake.recordInvocation("ActualLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) ActualLRPsPageCallCount() int {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	return len(fake.actualLRPsPageArgsForCall)
+}
+
+func (fake *FakeInternalClient) ActualLRPsPageCalls(stub func(lager.Logger, string, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = stub
+}
+
+func (fake *FakeInternalClient) ActualLRPsPageArgsForCall(i int) (lager.Logger, string, models.ActualLRPFilter) {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	argsForCall := fake.actualLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ActualLRPsPageReturns(result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	fake.actualLRPsPageReturns = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) ActualLRPsPageReturnsOnCall(i int, result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	if fake.actualLRPsPageReturnsOnCall == nil {
+		fake.actualLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.actualLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) AuditRecordsPage(arg1 lager.Logger, arg2 string, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	fake.auditRecordsPageMutex.Lock()
+	ret, specificReturn := fake.auditRecordsPageReturnsOnCall[len(fake.auditRecordsPageArgsForCall)]
+	fake.auditRecordsPageArgsForCall = append(fake.auditRecordsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsPageStub
+	fakeReturns := fake.auditRecordsPageReturns
+	fake.recordInvocation("AuditRecordsPage", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) AuditRecordsPageCallCount() int {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	return len(fake.auditRecordsPageArgsForCall)
+}
+
+func (fake *FakeInternalClient) AuditRecordsPageCalls(stub func(lager.Logger, string, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = stub
+}
+
+func (fake *FakeInternalClient) AuditRecordsPageArgsForCall(i int) (lager.Logger, string, models.AuditRecordFilter) {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	argsForCall := fake.auditRecordsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) AuditRecordsPageReturns(result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	fake.auditRecordsPageReturns = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) AuditRecordsPageReturnsOnCall(i int, result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	if fake.auditRecordsPageReturnsOnCall == nil {
+		fake.auditRecordsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 string
+			result3 error
+		})
+	}
+	fake.auditRecordsPageReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) CancelTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.cancelTaskMutex.Lock()
 	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
@@ -1089,6 +1747,71 @@ func (fake *FakeInternalClient) CancelTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CellDrains(arg1 lager.Logger, arg2 string) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeInternalClient) CellDrainsCalls(stub func(lager.Logger, string) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeInternalClient) CellDrainsArgsForCall(i int) (lager.Logger, string) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Cells(arg1 lager.Logger, arg2 string) ([]*models.CellPresence, error) {
 	fake.cellsMutex.Lock()
 	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
@@ -1285,6 +2008,69 @@ func (fake *FakeInternalClient) CompleteTaskReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) CordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) CordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) CrashActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 string) error {
 	fake.crashActualLRPMutex.Lock()
 	ret, specificReturn := fake.crashActualLRPReturnsOnCall[len(fake.crashActualLRPArgsForCall)]
@@ -1350,6 +2136,134 @@ func (fake *FakeInternalClient) CrashActualLRPReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeInternalClient) CrashStormBreakers(arg1 lager.Logger, arg2 string) ([]*models.CrashStormBreaker, error) {
+	fake.crashStormBreakersMutex.Lock()
+	ret, specificReturn := fake.crashStormBreakersReturnsOnCall[len(fake.crashStormBreakersArgsForCall)]
+	fake.crashStormBreakersArgsForCall = append(fake.crashStormBreakersArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.CrashStormBreakersStub
+	fakeReturns := fake.crashStormBreakersReturns
+	fake.recordInvocation("CrashStormBreakers", []interface{}{arg1, arg2})
+	fake.crashStormBreakersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) CrashStormBreakersCallCount() int {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	return len(fake.crashStormBreakersArgsForCall)
+}
+
+func (fake *FakeInternalClient) CrashStormBreakersCalls(stub func(lager.Logger, string) ([]*models.CrashStormBreaker, error)) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = stub
+}
+
+func (fake *FakeInternalClient) CrashStormBreakersArgsForCall(i int) (lager.Logger, string) {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	argsForCall := fake.crashStormBreakersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) CrashStormBreakersReturns(result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	fake.crashStormBreakersReturns = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) CrashStormBreakersReturnsOnCall(i int, result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	if fake.crashStormBreakersReturnsOnCall == nil {
+		fake.crashStormBreakersReturnsOnCall = make(map[int]struct {
+			result1 []*models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.crashStormBreakersReturnsOnCall[i] = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTask(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskCalls(stub func(lager.Logger, string, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) DeleteTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.deleteTaskMutex.Lock()
 	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
@@ -1413,6 +2327,72 @@ func (fake *FakeInternalClient) DeleteTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) DeploymentByProcessGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalClient) DeploymentByProcessGuidCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalClient) DeploymentByProcessGuidArgsForCall(i int) (lager.Logger, string, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) DesireLRP(arg1 lager.Logger, arg2 string, arg3 *models.DesiredLRP) error {
 	fake.desireLRPMutex.Lock()
 	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
@@ -1476,10 +2456,76 @@ func (fake *FakeInternalClient) DesireLRPReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeInternalClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
-	fake.desireTaskMutex.Lock()
-	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
-	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
+func (fake *FakeInternalClient) DesireScheduledTask(arg1 lager.Logger, arg2 string, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskCalls(stub func(lager.Logger, string, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskArgsForCall(i int) (lager.Logger, string, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DesireTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
+	fake.desireTaskMutex.Lock()
+	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
+	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
 		arg1 lager.Logger
 		arg2 string
 		arg3 string
@@ -1871,6 +2917,209 @@ func (fake *FakeInternalClient) DesiredLRPsReturnsOnCall(i int, result1 []*model
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DesiredLRPsPage(arg1 lager.Logger, arg2 string, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	fake.desiredLRPsPageMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsPageReturnsOnCall[len(fake.desiredLRPsPageArgsForCall)]
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsPageStub
+	fakeReturns := fake.desiredLRPsPageReturns
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeInternalClient) DesiredLRPsPageCalls(stub func(lager.Logger, string, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = stub
+}
+
+func (fake *FakeInternalClient) DesiredLRPsPageArgsForCall(i int) (lager.Logger, string, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	argsForCall := fake.desiredLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) DesiredLRPsPageReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	if fake.desiredLRPsPageReturnsOnCall == nil {
+		fake.desiredLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.desiredLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) DomainQuotas(arg1 lager.Logger, arg2 string) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainQuotasCalls(stub func(lager.Logger, string) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeInternalClient) DomainQuotasArgsForCall(i int) (lager.Logger, string) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DomainUsage(arg1 lager.Logger, arg2 string, arg3 string) (*models.DomainUsage, *models.DomainQuota, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeInternalClient) DomainUsageCalls(stub func(lager.Logger, string, string) (*models.DomainUsage, *models.DomainQuota, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeInternalClient) DomainUsageArgsForCall(i int) (lager.Logger, string, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DomainUsageReturns(result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 *models.DomainQuota
+			result3 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) Domains(arg1 lager.Logger, arg2 string) ([]string, error) {
 	fake.domainsMutex.Lock()
 	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
@@ -1936,6 +3185,72 @@ func (fake *FakeInternalClient) DomainsReturnsOnCall(i int, result1 []string, re
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) DrainCell(arg1 lager.Logger, arg2 string, arg3 string) (*models.CellDrain, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) DrainCellCalls(stub func(lager.Logger, string, string) (*models.CellDrain, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeInternalClient) DrainCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) DrainCellReturns(result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) DrainCellReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) EvacuateClaimedActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) (bool, error) {
 	fake.evacuateClaimedActualLRPMutex.Lock()
 	ret, specificReturn := fake.evacuateClaimedActualLRPReturnsOnCall[len(fake.evacuateClaimedActualLRPArgsForCall)]
@@ -2343,6 +3658,137 @@ func (fake *FakeInternalClient) FailTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeInternalClient) OverloadStatus(arg1 lager.Logger, arg2 string) (*models.OverloadStatus, error) {
+	fake.overloadStatusMutex.Lock()
+	ret, specificReturn := fake.overloadStatusReturnsOnCall[len(fake.overloadStatusArgsForCall)]
+	fake.overloadStatusArgsForCall = append(fake.overloadStatusArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.OverloadStatusStub
+	fakeReturns := fake.overloadStatusReturns
+	fake.recordInvocation("OverloadStatus", []interface{}{arg1, arg2})
+	fake.overloadStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) OverloadStatusCallCount() int {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	return len(fake.overloadStatusArgsForCall)
+}
+
+func (fake *FakeInternalClient) OverloadStatusCalls(stub func(lager.Logger, string) (*models.OverloadStatus, error)) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = stub
+}
+
+func (fake *FakeInternalClient) OverloadStatusArgsForCall(i int) (lager.Logger, string) {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	argsForCall := fake.overloadStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) OverloadStatusReturns(result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	fake.overloadStatusReturns = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) OverloadStatusReturnsOnCall(i int, result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	if fake.overloadStatusReturnsOnCall == nil {
+		fake.overloadStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.OverloadStatus
+			result2 error
+		})
+	}
+	fake.overloadStatusReturnsOnCall[i] = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) PauseDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.pauseDeploymentMutex.Lock()
+	ret, specificReturn := fake.pauseDeploymentReturnsOnCall[len(fake.pauseDeploymentArgsForCall)]
+	fake.pauseDeploymentArgsForCall = append(fake.pauseDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PauseDeploymentStub
+	fakeReturns := fake.pauseDeploymentReturns
+	fake.recordInvocation("PauseDeployment", []interface{}{arg1, arg2, arg3})
+	fake.pauseDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) PauseDeploymentCallCount() int {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	return len(fake.pauseDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalClient) PauseDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = stub
+}
+
+func (fake *FakeInternalClient) PauseDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	argsForCall := fake.pauseDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) PauseDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	fake.pauseDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) PauseDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	if fake.pauseDeploymentReturnsOnCall == nil {
+		fake.pauseDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.pauseDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Ping(arg1 lager.Logger, arg2 string) bool {
 	fake.pingMutex.Lock()
 	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
@@ -2596,6 +4042,69 @@ func (fake *FakeInternalClient) RemoveDesiredLRPReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeInternalClient) RemoveDomainQuota(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalClient) RemoveDomainQuotaCalls(stub func(lager.Logger, string, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalClient) RemoveDomainQuotaArgsForCall(i int) (lager.Logger, string, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) RemoveEvacuatingActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
 	fake.removeEvacuatingActualLRPMutex.Lock()
 	ret, specificReturn := fake.removeEvacuatingActualLRPReturnsOnCall[len(fake.removeEvacuatingActualLRPArgsForCall)]
@@ -2660,6 +4169,139 @@ func (fake *FakeInternalClient) RemoveEvacuatingActualLRPReturnsOnCall(i int, re
 	}{result1}
 }
 
+func (fake *FakeInternalClient) ReplayTaskCallback(arg1 lager.Logger, arg2 string, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
+}
+
+func (fake *FakeInternalClient) ReplayTaskCallbackCalls(stub func(lager.Logger, string, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
+}
+
+func (fake *FakeInternalClient) ReplayTaskCallbackArgsForCall(i int) (lager.Logger, string, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreaker(arg1 lager.Logger, arg2 string, arg3 models.CrashStormBreaker_Scope, arg4 string) (*models.CrashStormBreaker, error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	ret, specificReturn := fake.resetCrashStormBreakerReturnsOnCall[len(fake.resetCrashStormBreakerArgsForCall)]
+	fake.resetCrashStormBreakerArgsForCall = append(fake.resetCrashStormBreakerArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetCrashStormBreakerStub
+	fakeReturns := fake.resetCrashStormBreakerReturns
+	fake.recordInvocation("ResetCrashStormBreaker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetCrashStormBreakerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreakerCallCount() int {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	return len(fake.resetCrashStormBreakerArgsForCall)
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreakerCalls(stub func(lager.Logger, string, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = stub
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreakerArgsForCall(i int) (lager.Logger, string, models.CrashStormBreaker_Scope, string) {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	argsForCall := fake.resetCrashStormBreakerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreakerReturns(result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	fake.resetCrashStormBreakerReturns = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ResetCrashStormBreakerReturnsOnCall(i int, result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	if fake.resetCrashStormBreakerReturnsOnCall == nil {
+		fake.resetCrashStormBreakerReturnsOnCall = make(map[int]struct {
+			result1 *models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.resetCrashStormBreakerReturnsOnCall[i] = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) ResolvingTask(arg1 lager.Logger, arg2 string, arg3 string) error {
 	fake.resolvingTaskMutex.Lock()
 	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
@@ -2723,6 +4365,72 @@ func (fake *FakeInternalClient) ResolvingTaskReturnsOnCall(i int, result1 error)
 	}{result1}
 }
 
+func (fake *FakeInternalClient) ResumeDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.resumeDeploymentMutex.Lock()
+	ret, specificReturn := fake.resumeDeploymentReturnsOnCall[len(fake.resumeDeploymentArgsForCall)]
+	fake.resumeDeploymentArgsForCall = append(fake.resumeDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeDeploymentStub
+	fakeReturns := fake.resumeDeploymentReturns
+	fake.recordInvocation("ResumeDeployment", []interface{}{arg1, arg2, arg3})
+	fake.resumeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ResumeDeploymentCallCount() int {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	return len(fake.resumeDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalClient) ResumeDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = stub
+}
+
+func (fake *FakeInternalClient) ResumeDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	argsForCall := fake.resumeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ResumeDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	fake.resumeDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ResumeDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	if fake.resumeDeploymentReturnsOnCall == nil {
+		fake.resumeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.resumeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) RetireActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey) error {
 	fake.retireActualLRPMutex.Lock()
 	ret, specificReturn := fake.retireActualLRPReturnsOnCall[len(fake.retireActualLRPArgsForCall)]
@@ -2772,18 +4480,216 @@ func (fake *FakeInternalClient) RetireActualLRPReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakeInternalClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
-	fake.retireActualLRPMutex.Lock()
-	defer fake.retireActualLRPMutex.Unlock()
-	fake.RetireActualLRPStub = nil
-	if fake.retireActualLRPReturnsOnCall == nil {
-		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
-			result1 error
+func (fake *FakeInternalClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	if fake.retireActualLRPReturnsOnCall == nil {
+		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retireActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) RollbackDeployment(arg1 lager.Logger, arg2 string, arg3 string) (*models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalClient) RollbackDeploymentCalls(stub func(lager.Logger, string, string) (*models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeInternalClient) RollbackDeploymentArgsForCall(i int) (lager.Logger, string, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) RollbackDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) RollbackDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ScheduledTasks(arg1 lager.Logger, arg2 string, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeInternalClient) ScheduledTasksCalls(stub func(lager.Logger, string, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeInternalClient) ScheduledTasksArgsForCall(i int) (lager.Logger, string, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SetDomainQuota(arg1 lager.Logger, arg2 string, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalClient) SetDomainQuotaCalls(stub func(lager.Logger, string, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalClient) SetDomainQuotaArgsForCall(i int) (lager.Logger, string, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
 		})
 	}
-	fake.retireActualLRPReturnsOnCall[i] = struct {
-		result1 error
-	}{result1}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeInternalClient) StartActualLRP(arg1 lager.Logger, arg2 string, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) error {
@@ -2860,6 +4766,75 @@ func (fake *FakeInternalClient) StartActualLRPReturnsOnCall(i int, result1 error
 	}{result1}
 }
 
+func (fake *FakeInternalClient) StartDeployment(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalClient) StartDeploymentCalls(stub func(lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeInternalClient) StartDeploymentArgsForCall(i int) (lager.Logger, string, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInternalClient) StartDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) StartDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) StartTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 string) (bool, error) {
 	fake.startTaskMutex.Lock()
 	ret, specificReturn := fake.startTaskReturnsOnCall[len(fake.startTaskArgsForCall)]
@@ -3185,6 +5160,71 @@ func (fake *FakeInternalClient) SubscribeToInstanceEventsByCellIDReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilter(arg1 lager.Logger, arg2 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsWithFilterReturnsOnCall[len(fake.subscribeToInstanceEventsWithFilterArgsForCall)]
+	fake.subscribeToInstanceEventsWithFilterArgsForCall = append(fake.subscribeToInstanceEventsWithFilterArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}{arg1, arg2})
+	stub := fake.SubscribeToInstanceEventsWithFilterStub
+	fakeReturns := fake.subscribeToInstanceEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToInstanceEventsWithFilter", []interface{}{arg1, arg2})
+	fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilterCallCount() int {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilterCalls(stub func(lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = stub
+}
+
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	fake.subscribeToInstanceEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SubscribeToInstanceEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	if fake.subscribeToInstanceEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) SubscribeToTaskEvents(arg1 lager.Logger) (events.EventSource, error) {
 	fake.subscribeToTaskEventsMutex.Lock()
 	ret, specificReturn := fake.subscribeToTaskEventsReturnsOnCall[len(fake.subscribeToTaskEventsArgsForCall)]
@@ -3249,6 +5289,138 @@ func (fake *FakeInternalClient) SubscribeToTaskEventsReturnsOnCall(i int, result
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilter(arg1 lager.Logger, arg2 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsWithFilterReturnsOnCall[len(fake.subscribeToTaskEventsWithFilterArgsForCall)]
+	fake.subscribeToTaskEventsWithFilterArgsForCall = append(fake.subscribeToTaskEventsWithFilterArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 models.EventFilter
+	}{arg1, arg2})
+	stub := fake.SubscribeToTaskEventsWithFilterStub
+	fakeReturns := fake.subscribeToTaskEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToTaskEventsWithFilter", []interface{}{arg1, arg2})
+	fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterCallCount() int {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterCalls(stub func(lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = stub
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterArgsForCall(i int) (lager.Logger, models.EventFilter) {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	fake.subscribeToTaskEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SubscribeToTaskEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	if fake.subscribeToTaskEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToTaskEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.suspendScheduledTaskReturnsOnCall[len(fake.suspendScheduledTaskArgsForCall)]
+	fake.suspendScheduledTaskArgsForCall = append(fake.suspendScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SuspendScheduledTaskStub
+	fakeReturns := fake.suspendScheduledTaskReturns
+	fake.recordInvocation("SuspendScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.suspendScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTaskCallCount() int {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	return len(fake.suspendScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTaskCalls(stub func(lager.Logger, string, string, bool) (*models.ScheduledTask, error)) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTaskArgsForCall(i int) (lager.Logger, string, string, bool) {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	argsForCall := fake.suspendScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	fake.suspendScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) SuspendScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	if fake.suspendScheduledTaskReturnsOnCall == nil {
+		fake.suspendScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.suspendScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) TaskByGuid(arg1 lager.Logger, arg2 string, arg3 string) (*models.Task, error) {
 	fake.taskByGuidMutex.Lock()
 	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
@@ -3315,6 +5487,72 @@ func (fake *FakeInternalClient) TaskByGuidReturnsOnCall(i int, result1 *models.T
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TaskCallbacks(arg1 lager.Logger, arg2 string, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeInternalClient) TaskCallbacksCalls(stub func(lager.Logger, string, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeInternalClient) TaskCallbacksArgsForCall(i int) (lager.Logger, string, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) Tasks(arg1 lager.Logger, arg2 string) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
@@ -3512,6 +5750,75 @@ func (fake *FakeInternalClient) TasksByDomainReturnsOnCall(i int, result1 []*mod
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) TasksPage(arg1 lager.Logger, arg2 string, arg3 models.TaskFilter) ([]*models.Task, string, error) {
+	fake.tasksPageMutex.Lock()
+	ret, specificReturn := fake.tasksPageReturnsOnCall[len(fake.tasksPageArgsForCall)]
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksPageStub
+	fakeReturns := fake.tasksPageReturns
+	fake.recordInvocation("TasksPage", []interface{}{arg1, arg2, arg3})
+	fake.tasksPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalClient) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeInternalClient) TasksPageCalls(stub func(lager.Logger, string, models.TaskFilter) ([]*models.Task, string, error)) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = stub
+}
+
+func (fake *FakeInternalClient) TasksPageArgsForCall(i int) (lager.Logger, string, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	argsForCall := fake.tasksPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalClient) TasksPageReturnsOnCall(i int, result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	if fake.tasksPageReturnsOnCall == nil {
+		fake.tasksPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 string
+			result3 error
+		})
+	}
+	fake.tasksPageReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeInternalClient) TasksWithFilter(arg1 lager.Logger, arg2 string, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksWithFilterMutex.Lock()
 	ret, specificReturn := fake.tasksWithFilterReturnsOnCall[len(fake.tasksWithFilterArgsForCall)]
@@ -3578,6 +5885,69 @@ func (fake *FakeInternalClient) TasksWithFilterReturnsOnCall(i int, result1 []*m
 	}{result1, result2}
 }
 
+func (fake *FakeInternalClient) UncordonCell(arg1 lager.Logger, arg2 string, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeInternalClient) UncordonCellCalls(stub func(lager.Logger, string, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeInternalClient) UncordonCellArgsForCall(i int) (lager.Logger, string, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInternalClient) UpdateDesiredLRP(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.DesiredLRPUpdate) error {
 	fake.updateDesiredLRPMutex.Lock()
 	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
@@ -3642,6 +6012,73 @@ func (fake *FakeInternalClient) UpdateDesiredLRPReturnsOnCall(i int, result1 err
 	}{result1}
 }
 
+func (fake *FakeInternalClient) UpdateScheduledTask(arg1 lager.Logger, arg2 string, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskCalls(stub func(lager.Logger, string, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskArgsForCall(i int) (lager.Logger, string, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalClient) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeInternalClient) UpsertDomain(arg1 lager.Logger, arg2 string, arg3 string, arg4 time.Duration) error {
 	fake.upsertDomainMutex.Lock()
 	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
@@ -3715,24 +6152,42 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.actualLRPGroupsMutex.RUnlock()
 	fake.actualLRPGroupsByProcessGuidMutex.RLock()
 	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
 	fake.actualLRPsMutex.RLock()
 	defer fake.actualLRPsMutex.RUnlock()
 	fake.actualLRPsByProcessGuidsMutex.RLock()
 	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
 	fake.cancelTaskMutex.RLock()
 	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
 	fake.cellsMutex.RLock()
 	defer fake.cellsMutex.RUnlock()
 	fake.claimActualLRPMutex.RLock()
 	defer fake.claimActualLRPMutex.RUnlock()
 	fake.completeTaskMutex.RLock()
 	defer fake.completeTaskMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
 	fake.crashActualLRPMutex.RLock()
 	defer fake.crashActualLRPMutex.RUnlock()
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
 	fake.deleteTaskMutex.RLock()
 	defer fake.deleteTaskMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
 	fake.desireLRPMutex.RLock()
 	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
 	fake.desireTaskMutex.RLock()
 	defer fake.desireTaskMutex.RUnlock()
 	fake.desiredLRPByProcessGuidMutex.RLock()
@@ -3745,8 +6200,16 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
 	fake.desiredLRPsMutex.RLock()
 	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
 	fake.domainsMutex.RLock()
 	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
 	fake.evacuateClaimedActualLRPMutex.RLock()
 	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
 	fake.evacuateCrashedActualLRPMutex.RLock()
@@ -3759,6 +6222,10 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.failActualLRPMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
 	fake.pingMutex.RLock()
 	defer fake.pingMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
@@ -3767,14 +6234,30 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.removeActualLRPMutex.RUnlock()
 	fake.removeDesiredLRPMutex.RLock()
 	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
 	fake.removeEvacuatingActualLRPMutex.RLock()
 	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
 	defer fake.resolvingTaskMutex.RUnlock()
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
 	fake.retireActualLRPMutex.RLock()
 	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
 	fake.startActualLRPMutex.RLock()
 	defer fake.startActualLRPMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
 	fake.startTaskMutex.RLock()
 	defer fake.startTaskMutex.RUnlock()
 	fake.subscribeToEventsMutex.RLock()
@@ -3785,20 +6268,34 @@ func (fake *FakeInternalClient) Invocations() map[string][][]interface{} {
 	defer fake.subscribeToInstanceEventsMutex.RUnlock()
 	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
 	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
 	fake.subscribeToTaskEventsMutex.RLock()
 	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
 	fake.taskByGuidMutex.RLock()
 	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	fake.tasksByCellIDMutex.RLock()
 	defer fake.tasksByCellIDMutex.RUnlock()
 	fake.tasksByDomainMutex.RLock()
 	defer fake.tasksByDomainMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
 	fake.tasksWithFilterMutex.RLock()
 	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
 	fake.updateDesiredLRPMutex.RLock()
 	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
 	fake.upsertDomainMutex.RLock()
 	defer fake.upsertDomainMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,6687 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_bbs
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeInternalContextClient struct {
+	ActualLRPGroupByProcessGuidAndIndexStub        func(context.Context, lager.Logger, string, int) (*models.ActualLRPGroup, error)
+	actualLRPGroupByProcessGuidAndIndexMutex       sync.RWMutex
+	actualLRPGroupByProcessGuidAndIndexArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int
+	}
+	actualLRPGroupByProcessGuidAndIndexReturns struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupByProcessGuidAndIndexReturnsOnCall map[int]struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPGroupsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)
+	actualLRPGroupsMutex       sync.RWMutex
+	actualLRPGroupsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPGroupsReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPGroupsByProcessGuidStub        func(context.Context, lager.Logger, string) ([]*models.ActualLRPGroup, error)
+	actualLRPGroupsByProcessGuidMutex       sync.RWMutex
+	actualLRPGroupsByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	actualLRPGroupsByProcessGuidReturns struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	actualLRPGroupsByProcessGuidReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}
+	ActualLRPHistoryStub        func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)
+	actualLRPHistoryMutex       sync.RWMutex
+	actualLRPHistoryArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}
+	actualLRPHistoryReturns struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	actualLRPHistoryReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}
+	ActualLRPsStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)
+	actualLRPsMutex       sync.RWMutex
+	actualLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	actualLRPsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	ActualLRPsByProcessGuidsStub        func(context.Context, lager.Logger, []string) ([]*models.ActualLRP, error)
+	actualLRPsByProcessGuidsMutex       sync.RWMutex
+	actualLRPsByProcessGuidsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}
+	actualLRPsByProcessGuidsReturns struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	actualLRPsByProcessGuidsReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}
+	ActualLRPsPageStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)
+	actualLRPsPageMutex       sync.RWMutex
+	actualLRPsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsPageReturns struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	actualLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}
+	ActualLRPsWithResourceVersionStub        func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error)
+	actualLRPsWithResourceVersionMutex       sync.RWMutex
+	actualLRPsWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}
+	actualLRPsWithResourceVersionReturns struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}
+	actualLRPsWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}
+	AuditRecordsPageStub        func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)
+	auditRecordsPageMutex       sync.RWMutex
+	auditRecordsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}
+	auditRecordsPageReturns struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	auditRecordsPageReturnsOnCall map[int]struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}
+	CancelTaskStub        func(context.Context, lager.Logger, string) error
+	cancelTaskMutex       sync.RWMutex
+	cancelTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cancelTaskReturns struct {
+		result1 error
+	}
+	cancelTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CellDrainsStub        func(context.Context, lager.Logger) ([]*models.CellDrain, error)
+	cellDrainsMutex       sync.RWMutex
+	cellDrainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellDrainsReturns struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	cellDrainsReturnsOnCall map[int]struct {
+		result1 []*models.CellDrain
+		result2 error
+	}
+	CellsStub        func(context.Context, lager.Logger) ([]*models.CellPresence, error)
+	cellsMutex       sync.RWMutex
+	cellsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	cellsReturns struct {
+		result1 []*models.CellPresence
+		result2 error
+	}
+	cellsReturnsOnCall map[int]struct {
+		result1 []*models.CellPresence
+		result2 error
+	}
+	ClaimActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
+	claimActualLRPMutex       sync.RWMutex
+	claimActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}
+	claimActualLRPReturns struct {
+		result1 error
+	}
+	claimActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CompleteTaskStub        func(context.Context, lager.Logger, string, string, bool, string, string) error
+	completeTaskMutex       sync.RWMutex
+	completeTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 bool
+		arg6 string
+		arg7 string
+	}
+	completeTaskReturns struct {
+		result1 error
+	}
+	completeTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonCellStub        func(context.Context, lager.Logger, string) error
+	cordonCellMutex       sync.RWMutex
+	cordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	cordonCellReturns struct {
+		result1 error
+	}
+	cordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CrashActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) error
+	crashActualLRPMutex       sync.RWMutex
+	crashActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 string
+	}
+	crashActualLRPReturns struct {
+		result1 error
+	}
+	crashActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CrashStormBreakersStub        func(context.Context, lager.Logger) ([]*models.CrashStormBreaker, error)
+	crashStormBreakersMutex       sync.RWMutex
+	crashStormBreakersArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	crashStormBreakersReturns struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	crashStormBreakersReturnsOnCall map[int]struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}
+	DeleteScheduledTaskStub        func(context.Context, lager.Logger, string) error
+	deleteScheduledTaskMutex       sync.RWMutex
+	deleteScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteScheduledTaskReturns struct {
+		result1 error
+	}
+	deleteScheduledTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteTaskStub        func(context.Context, lager.Logger, string) error
+	deleteTaskMutex       sync.RWMutex
+	deleteTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deleteTaskReturns struct {
+		result1 error
+	}
+	deleteTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeploymentByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	DesireLRPStub        func(context.Context, lager.Logger, *models.DesiredLRP) error
+	desireLRPMutex       sync.RWMutex
+	desireLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}
+	desireLRPReturns struct {
+		result1 error
+	}
+	desireLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DesireScheduledTaskStub        func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)
+	desireScheduledTaskMutex       sync.RWMutex
+	desireScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}
+	desireScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	desireScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	DesireTaskStub        func(context.Context, lager.Logger, string, string, *models.TaskDefinition) error
+	desireTaskMutex       sync.RWMutex
+	desireTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}
+	desireTaskReturns struct {
+		result1 error
+	}
+	desireTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DesiredLRPByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)
+	desiredLRPByProcessGuidMutex       sync.RWMutex
+	desiredLRPByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPByProcessGuidReturns struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	desiredLRPByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPRoutingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	desiredLRPRoutingInfosMutex       sync.RWMutex
+	desiredLRPRoutingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPRoutingInfosReturns struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	desiredLRPRoutingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPSchedulingInfoByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)
+	desiredLRPSchedulingInfoByProcessGuidMutex       sync.RWMutex
+	desiredLRPSchedulingInfoByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	desiredLRPSchedulingInfoByProcessGuidReturns struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	desiredLRPSchedulingInfoByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	DesiredLRPSchedulingInfosStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)
+	desiredLRPSchedulingInfosMutex       sync.RWMutex
+	desiredLRPSchedulingInfosArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	desiredLRPSchedulingInfosReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}
+	DesiredLRPSchedulingInfosWithResourceVersionStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error)
+	desiredLRPSchedulingInfosWithResourceVersionMutex       sync.RWMutex
+	desiredLRPSchedulingInfosWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPSchedulingInfosWithResourceVersionReturns struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}
+	desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}
+	DesiredLRPsStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)
+	desiredLRPsMutex       sync.RWMutex
+	desiredLRPsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsReturns struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	desiredLRPsReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}
+	DesiredLRPsPageStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)
+	desiredLRPsPageMutex       sync.RWMutex
+	desiredLRPsPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsPageReturns struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	desiredLRPsPageReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}
+	DesiredLRPsWithResourceVersionStub        func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error)
+	desiredLRPsWithResourceVersionMutex       sync.RWMutex
+	desiredLRPsWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}
+	desiredLRPsWithResourceVersionReturns struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	desiredLRPsWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}
+	DomainQuotasStub        func(context.Context, lager.Logger) ([]*models.DomainQuota, error)
+	domainQuotasMutex       sync.RWMutex
+	domainQuotasArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainQuotasReturns struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	domainQuotasReturnsOnCall map[int]struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}
+	DomainUsageStub        func(context.Context, lager.Logger, string) (*models.DomainUsage, *models.DomainQuota, error)
+	domainUsageMutex       sync.RWMutex
+	domainUsageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	domainUsageReturns struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	domainUsageReturnsOnCall map[int]struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}
+	DomainsStub        func(context.Context, lager.Logger) ([]string, error)
+	domainsMutex       sync.RWMutex
+	domainsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	domainsReturns struct {
+		result1 []string
+		result2 error
+	}
+	domainsReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	DrainCellStub        func(context.Context, lager.Logger, string) (*models.CellDrain, error)
+	drainCellMutex       sync.RWMutex
+	drainCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	drainCellReturns struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	drainCellReturnsOnCall map[int]struct {
+		result1 *models.CellDrain
+		result2 error
+	}
+	EvacuateClaimedActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
+	evacuateClaimedActualLRPMutex       sync.RWMutex
+	evacuateClaimedActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}
+	evacuateClaimedActualLRPReturns struct {
+		result1 bool
+		result2 error
+	}
+	evacuateClaimedActualLRPReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	EvacuateCrashedActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) (bool, error)
+	evacuateCrashedActualLRPMutex       sync.RWMutex
+	evacuateCrashedActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 string
+	}
+	evacuateCrashedActualLRPReturns struct {
+		result1 bool
+		result2 error
+	}
+	evacuateCrashedActualLRPReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	EvacuateRunningActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (bool, error)
+	evacuateRunningActualLRPMutex       sync.RWMutex
+	evacuateRunningActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 *models.ActualLRPNetInfo
+		arg6 []*models.ActualLRPInternalRoute
+		arg7 map[string]string
+		arg8 bool
+		arg9 string
+	}
+	evacuateRunningActualLRPReturns struct {
+		result1 bool
+		result2 error
+	}
+	evacuateRunningActualLRPReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	EvacuateStoppedActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)
+	evacuateStoppedActualLRPMutex       sync.RWMutex
+	evacuateStoppedActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}
+	evacuateStoppedActualLRPReturns struct {
+		result1 bool
+		result2 error
+	}
+	evacuateStoppedActualLRPReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FailActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, string) error
+	failActualLRPMutex       sync.RWMutex
+	failActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 string
+	}
+	failActualLRPReturns struct {
+		result1 error
+	}
+	failActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	FailTaskStub        func(context.Context, lager.Logger, string, string) error
+	failTaskMutex       sync.RWMutex
+	failTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	failTaskReturns struct {
+		result1 error
+	}
+	failTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	OverloadStatusStub        func(context.Context, lager.Logger) (*models.OverloadStatus, error)
+	overloadStatusMutex       sync.RWMutex
+	overloadStatusArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	overloadStatusReturns struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	overloadStatusReturnsOnCall map[int]struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}
+	PauseDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	pauseDeploymentMutex       sync.RWMutex
+	pauseDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	pauseDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	pauseDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	PingStub        func(context.Context, lager.Logger) bool
+	pingMutex       sync.RWMutex
+	pingArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	pingReturns struct {
+		result1 bool
+	}
+	pingReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	RejectTaskStub        func(context.Context, lager.Logger, string, string) error
+	rejectTaskMutex       sync.RWMutex
+	rejectTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	rejectTaskReturns struct {
+		result1 error
+	}
+	rejectTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
+	removeActualLRPMutex       sync.RWMutex
+	removeActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}
+	removeActualLRPReturns struct {
+		result1 error
+	}
+	removeActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveDesiredLRPStub        func(context.Context, lager.Logger, string) error
+	removeDesiredLRPMutex       sync.RWMutex
+	removeDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDesiredLRPReturns struct {
+		result1 error
+	}
+	removeDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveDomainQuotaStub        func(context.Context, lager.Logger, string) error
+	removeDomainQuotaMutex       sync.RWMutex
+	removeDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	removeDomainQuotaReturns struct {
+		result1 error
+	}
+	removeDomainQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemoveEvacuatingActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error
+	removeEvacuatingActualLRPMutex       sync.RWMutex
+	removeEvacuatingActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}
+	removeEvacuatingActualLRPReturns struct {
+		result1 error
+	}
+	removeEvacuatingActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ReplayTaskCallbackStub        func(context.Context, lager.Logger, string) (*models.TaskCallback, error)
+	replayTaskCallbackMutex       sync.RWMutex
+	replayTaskCallbackArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	replayTaskCallbackReturns struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	replayTaskCallbackReturnsOnCall map[int]struct {
+		result1 *models.TaskCallback
+		result2 error
+	}
+	ResetCrashStormBreakerStub        func(context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)
+	resetCrashStormBreakerMutex       sync.RWMutex
+	resetCrashStormBreakerArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}
+	resetCrashStormBreakerReturns struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	resetCrashStormBreakerReturnsOnCall map[int]struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}
+	ResolvingTaskStub        func(context.Context, lager.Logger, string) error
+	resolvingTaskMutex       sync.RWMutex
+	resolvingTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	resolvingTaskReturns struct {
+		result1 error
+	}
+	resolvingTaskReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ResumeDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	resumeDeploymentMutex       sync.RWMutex
+	resumeDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	resumeDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	resumeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	RetireActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey) error
+	retireActualLRPMutex       sync.RWMutex
+	retireActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}
+	retireActualLRPReturns struct {
+		result1 error
+	}
+	retireActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RollbackDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	ScheduledTasksStub        func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)
+	scheduledTasksMutex       sync.RWMutex
+	scheduledTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	scheduledTasksReturns struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	scheduledTasksReturnsOnCall map[int]struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}
+	SetDomainQuotaStub        func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)
+	setDomainQuotaMutex       sync.RWMutex
+	setDomainQuotaArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}
+	setDomainQuotaReturns struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	setDomainQuotaReturnsOnCall map[int]struct {
+		result1 *models.DomainQuota
+		result2 error
+	}
+	StartActualLRPStub        func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) error
+	startActualLRPMutex       sync.RWMutex
+	startActualLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 *models.ActualLRPNetInfo
+		arg6 []*models.ActualLRPInternalRoute
+		arg7 map[string]string
+		arg8 bool
+		arg9 string
+	}
+	startActualLRPReturns struct {
+		result1 error
+	}
+	startActualLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	StartDeploymentStub        func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	StartTaskStub        func(context.Context, lager.Logger, string, string) (bool, error)
+	startTaskMutex       sync.RWMutex
+	startTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}
+	startTaskReturns struct {
+		result1 bool
+		result2 error
+	}
+	startTaskReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SubscribeToEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToEventsMutex       sync.RWMutex
+	subscribeToEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToEventsByCellIDStub        func(context.Context, lager.Logger, string) (events.EventSource, error)
+	subscribeToEventsByCellIDMutex       sync.RWMutex
+	subscribeToEventsByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	subscribeToEventsByCellIDReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToEventsByCellIDReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToInstanceEventsMutex       sync.RWMutex
+	subscribeToInstanceEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToInstanceEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsByCellIDStub        func(context.Context, lager.Logger, string) (events.EventSource, error)
+	subscribeToInstanceEventsByCellIDMutex       sync.RWMutex
+	subscribeToInstanceEventsByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	subscribeToInstanceEventsByCellIDReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsByCellIDReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToInstanceEventsWithFilterStub        func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToInstanceEventsWithFilterMutex       sync.RWMutex
+	subscribeToInstanceEventsWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}
+	subscribeToInstanceEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToInstanceEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsStub        func(context.Context, lager.Logger) (events.EventSource, error)
+	subscribeToTaskEventsMutex       sync.RWMutex
+	subscribeToTaskEventsArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	subscribeToTaskEventsReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SubscribeToTaskEventsWithFilterStub        func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)
+	subscribeToTaskEventsWithFilterMutex       sync.RWMutex
+	subscribeToTaskEventsWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}
+	subscribeToTaskEventsWithFilterReturns struct {
+		result1 events.EventSource
+		result2 error
+	}
+	subscribeToTaskEventsWithFilterReturnsOnCall map[int]struct {
+		result1 events.EventSource
+		result2 error
+	}
+	SuspendScheduledTaskStub        func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)
+	suspendScheduledTaskMutex       sync.RWMutex
+	suspendScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}
+	suspendScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	suspendScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	TaskByGuidStub        func(context.Context, lager.Logger, string) (*models.Task, error)
+	taskByGuidMutex       sync.RWMutex
+	taskByGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	taskByGuidReturns struct {
+		result1 *models.Task
+		result2 error
+	}
+	taskByGuidReturnsOnCall map[int]struct {
+		result1 *models.Task
+		result2 error
+	}
+	TaskCallbacksStub        func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)
+	taskCallbacksMutex       sync.RWMutex
+	taskCallbacksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}
+	taskCallbacksReturns struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	taskCallbacksReturnsOnCall map[int]struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}
+	TasksStub        func(context.Context, lager.Logger) ([]*models.Task, error)
+	tasksMutex       sync.RWMutex
+	tasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	tasksReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksByCellIDStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByCellIDMutex       sync.RWMutex
+	tasksByCellIDArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByCellIDReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByCellIDReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksByDomainStub        func(context.Context, lager.Logger, string) ([]*models.Task, error)
+	tasksByDomainMutex       sync.RWMutex
+	tasksByDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	tasksByDomainReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksByDomainReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksPageStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, string, error)
+	tasksPageMutex       sync.RWMutex
+	tasksPageArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksPageReturns struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	tasksPageReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}
+	TasksWithFilterStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
+	tasksWithFilterMutex       sync.RWMutex
+	tasksWithFilterArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksWithFilterReturns struct {
+		result1 []*models.Task
+		result2 error
+	}
+	tasksWithFilterReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 error
+	}
+	TasksWithResourceVersionStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, uint64, error)
+	tasksWithResourceVersionMutex       sync.RWMutex
+	tasksWithResourceVersionArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	tasksWithResourceVersionReturns struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}
+	tasksWithResourceVersionReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}
+	UncordonCellStub        func(context.Context, lager.Logger, string) error
+	uncordonCellMutex       sync.RWMutex
+	uncordonCellArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	uncordonCellReturns struct {
+		result1 error
+	}
+	uncordonCellReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateDesiredLRPStub        func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) error
+	updateDesiredLRPMutex       sync.RWMutex
+	updateDesiredLRPArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}
+	updateDesiredLRPReturns struct {
+		result1 error
+	}
+	updateDesiredLRPReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateScheduledTaskStub        func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	updateScheduledTaskMutex       sync.RWMutex
+	updateScheduledTaskArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}
+	updateScheduledTaskReturns struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	updateScheduledTaskReturnsOnCall map[int]struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}
+	UpsertDomainStub        func(context.Context, lager.Logger, string, time.Duration) error
+	upsertDomainMutex       sync.RWMutex
+	upsertDomainArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}
+	upsertDomainReturns struct {
+		result1 error
+	}
+	upsertDomainReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndex(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int) (*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)]
+	fake.actualLRPGroupByProcessGuidAndIndexArgsForCall = append(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPGroupByProcessGuidAndIndexStub
+	fakeReturns := fake.actualLRPGroupByProcessGuidAndIndexReturns
+	fake.recordInvocation("ActualLRPGroupByProcessGuidAndIndex", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndexCallCount() int {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	return len(fake.actualLRPGroupByProcessGuidAndIndexArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndexCalls(stub func(context.Context, lager.Logger, string, int) (*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndexArgsForCall(i int) (context.Context, lager.Logger, string, int) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupByProcessGuidAndIndexArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndexReturns(result1 *models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = nil
+	fake.actualLRPGroupByProcessGuidAndIndexReturns = struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupByProcessGuidAndIndexReturnsOnCall(i int, result1 *models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.Lock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.Unlock()
+	fake.ActualLRPGroupByProcessGuidAndIndexStub = nil
+	if fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall == nil {
+		fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall = make(map[int]struct {
+			result1 *models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupByProcessGuidAndIndexReturnsOnCall[i] = struct {
+		result1 *models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroups(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupsMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupsReturnsOnCall[len(fake.actualLRPGroupsArgsForCall)]
+	fake.actualLRPGroupsArgsForCall = append(fake.actualLRPGroupsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPGroupsStub
+	fakeReturns := fake.actualLRPGroupsReturns
+	fake.recordInvocation("ActualLRPGroups", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPGroupsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsCallCount() int {
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	return len(fake.actualLRPGroupsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsReturns(result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = nil
+	fake.actualLRPGroupsReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsReturnsOnCall(i int, result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsMutex.Lock()
+	defer fake.actualLRPGroupsMutex.Unlock()
+	fake.ActualLRPGroupsStub = nil
+	if fake.actualLRPGroupsReturnsOnCall == nil {
+		fake.actualLRPGroupsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ActualLRPGroup, error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.actualLRPGroupsByProcessGuidReturnsOnCall[len(fake.actualLRPGroupsByProcessGuidArgsForCall)]
+	fake.actualLRPGroupsByProcessGuidArgsForCall = append(fake.actualLRPGroupsByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPGroupsByProcessGuidStub
+	fakeReturns := fake.actualLRPGroupsByProcessGuidReturns
+	fake.recordInvocation("ActualLRPGroupsByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuidCallCount() int {
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	return len(fake.actualLRPGroupsByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuidCalls(stub func(context.Context, lager.Logger, string) ([]*models.ActualLRPGroup, error)) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	argsForCall := fake.actualLRPGroupsByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuidReturns(result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = nil
+	fake.actualLRPGroupsByProcessGuidReturns = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPGroupsByProcessGuidReturnsOnCall(i int, result1 []*models.ActualLRPGroup, result2 error) {
+	fake.actualLRPGroupsByProcessGuidMutex.Lock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.Unlock()
+	fake.ActualLRPGroupsByProcessGuidStub = nil
+	if fake.actualLRPGroupsByProcessGuidReturnsOnCall == nil {
+		fake.actualLRPGroupsByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPGroup
+			result2 error
+		})
+	}
+	fake.actualLRPGroupsByProcessGuidReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPGroup
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistory(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 int32) ([]*models.ActualLRPHistoryRecord, error) {
+	fake.actualLRPHistoryMutex.Lock()
+	ret, specificReturn := fake.actualLRPHistoryReturnsOnCall[len(fake.actualLRPHistoryArgsForCall)]
+	fake.actualLRPHistoryArgsForCall = append(fake.actualLRPHistoryArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 int32
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ActualLRPHistoryStub
+	fakeReturns := fake.actualLRPHistoryReturns
+	fake.recordInvocation("ActualLRPHistory", []interface{}{arg1, arg2, arg3, arg4})
+	fake.actualLRPHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistoryCallCount() int {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	return len(fake.actualLRPHistoryArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistoryCalls(stub func(context.Context, lager.Logger, string, int32) ([]*models.ActualLRPHistoryRecord, error)) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistoryArgsForCall(i int) (context.Context, lager.Logger, string, int32) {
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	argsForCall := fake.actualLRPHistoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistoryReturns(result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	fake.actualLRPHistoryReturns = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPHistoryReturnsOnCall(i int, result1 []*models.ActualLRPHistoryRecord, result2 error) {
+	fake.actualLRPHistoryMutex.Lock()
+	defer fake.actualLRPHistoryMutex.Unlock()
+	fake.ActualLRPHistoryStub = nil
+	if fake.actualLRPHistoryReturnsOnCall == nil {
+		fake.actualLRPHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRPHistoryRecord
+			result2 error
+		})
+	}
+	fake.actualLRPHistoryReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRPHistoryRecord
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, error) {
+	fake.actualLRPsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsReturnsOnCall[len(fake.actualLRPsArgsForCall)]
+	fake.actualLRPsArgsForCall = append(fake.actualLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsStub
+	fakeReturns := fake.actualLRPsReturns
+	fake.recordInvocation("ActualLRPs", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsCallCount() int {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	return len(fake.actualLRPsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	argsForCall := fake.actualLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	fake.actualLRPsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsMutex.Lock()
+	defer fake.actualLRPsMutex.Unlock()
+	fake.ActualLRPsStub = nil
+	if fake.actualLRPsReturnsOnCall == nil {
+		fake.actualLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 error
+		})
+	}
+	fake.actualLRPsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuids(arg1 context.Context, arg2 lager.Logger, arg3 []string) ([]*models.ActualLRP, error) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	ret, specificReturn := fake.actualLRPsByProcessGuidsReturnsOnCall[len(fake.actualLRPsByProcessGuidsArgsForCall)]
+	fake.actualLRPsByProcessGuidsArgsForCall = append(fake.actualLRPsByProcessGuidsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	stub := fake.ActualLRPsByProcessGuidsStub
+	fakeReturns := fake.actualLRPsByProcessGuidsReturns
+	fake.recordInvocation("ActualLRPsByProcessGuids", []interface{}{arg1, arg2, arg3Copy})
+	fake.actualLRPsByProcessGuidsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuidsCallCount() int {
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	return len(fake.actualLRPsByProcessGuidsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuidsCalls(stub func(context.Context, lager.Logger, []string) ([]*models.ActualLRP, error)) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuidsArgsForCall(i int) (context.Context, lager.Logger, []string) {
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	argsForCall := fake.actualLRPsByProcessGuidsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuidsReturns(result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = nil
+	fake.actualLRPsByProcessGuidsReturns = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsByProcessGuidsReturnsOnCall(i int, result1 []*models.ActualLRP, result2 error) {
+	fake.actualLRPsByProcessGuidsMutex.Lock()
+	defer fake.actualLRPsByProcessGuidsMutex.Unlock()
+	fake.ActualLRPsByProcessGuidsStub = nil
+	if fake.actualLRPsByProcessGuidsReturnsOnCall == nil {
+		fake.actualLRPsByProcessGuidsReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 error
+		})
+	}
+	fake.actualLRPsByProcessGuidsReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, string, error) {
+	fake.actualLRPsPageMutex.Lock()
+	ret, specificReturn := fake.actualLRPsPageReturnsOnCall[len(fake.actualLRPsPageArgsForCall)]
+	fake.actualLRPsPageArgsForCall = append(fake.actualLRPsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsPageStub
+	fakeReturns := fake.actualLRPsPageReturns
+	fake.recordInvocation("ActualLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPageCallCount() int {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	return len(fake.actualLRPsPageArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPageCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, string, error)) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPageArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	argsForCall := fake.actualLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPageReturns(result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	fake.actualLRPsPageReturns = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsPageReturnsOnCall(i int, result1 []*models.ActualLRP, result2 string, result3 error) {
+	fake.actualLRPsPageMutex.Lock()
+	defer fake.actualLRPsPageMutex.Unlock()
+	fake.ActualLRPsPageStub = nil
+	if fake.actualLRPsPageReturnsOnCall == nil {
+		fake.actualLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.actualLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.actualLRPsWithResourceVersionReturnsOnCall[len(fake.actualLRPsWithResourceVersionArgsForCall)]
+	fake.actualLRPsWithResourceVersionArgsForCall = append(fake.actualLRPsWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.ActualLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ActualLRPsWithResourceVersionStub
+	fakeReturns := fake.actualLRPsWithResourceVersionReturns
+	fake.recordInvocation("ActualLRPsWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.actualLRPsWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersionCallCount() int {
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	return len(fake.actualLRPsWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.ActualLRPFilter) ([]*models.ActualLRP, uint64, error)) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = stub
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.ActualLRPFilter) {
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.actualLRPsWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersionReturns(result1 []*models.ActualLRP, result2 uint64, result3 error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = nil
+	fake.actualLRPsWithResourceVersionReturns = struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) ActualLRPsWithResourceVersionReturnsOnCall(i int, result1 []*models.ActualLRP, result2 uint64, result3 error) {
+	fake.actualLRPsWithResourceVersionMutex.Lock()
+	defer fake.actualLRPsWithResourceVersionMutex.Unlock()
+	fake.ActualLRPsWithResourceVersionStub = nil
+	if fake.actualLRPsWithResourceVersionReturnsOnCall == nil {
+		fake.actualLRPsWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.ActualLRP
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.actualLRPsWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.ActualLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.AuditRecordFilter) ([]*models.AuditRecord, string, error) {
+	fake.auditRecordsPageMutex.Lock()
+	ret, specificReturn := fake.auditRecordsPageReturnsOnCall[len(fake.auditRecordsPageArgsForCall)]
+	fake.auditRecordsPageArgsForCall = append(fake.auditRecordsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.AuditRecordFilter
+	}{arg1, arg2, arg3})
+	stub := fake.AuditRecordsPageStub
+	fakeReturns := fake.auditRecordsPageReturns
+	fake.recordInvocation("AuditRecordsPage", []interface{}{arg1, arg2, arg3})
+	fake.auditRecordsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPageCallCount() int {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	return len(fake.auditRecordsPageArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPageCalls(stub func(context.Context, lager.Logger, models.AuditRecordFilter) ([]*models.AuditRecord, string, error)) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = stub
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPageArgsForCall(i int) (context.Context, lager.Logger, models.AuditRecordFilter) {
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	argsForCall := fake.auditRecordsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPageReturns(result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	fake.auditRecordsPageReturns = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) AuditRecordsPageReturnsOnCall(i int, result1 []*models.AuditRecord, result2 string, result3 error) {
+	fake.auditRecordsPageMutex.Lock()
+	defer fake.auditRecordsPageMutex.Unlock()
+	fake.AuditRecordsPageStub = nil
+	if fake.auditRecordsPageReturnsOnCall == nil {
+		fake.auditRecordsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.AuditRecord
+			result2 string
+			result3 error
+		})
+	}
+	fake.auditRecordsPageReturnsOnCall[i] = struct {
+		result1 []*models.AuditRecord
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) CancelTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cancelTaskMutex.Lock()
+	ret, specificReturn := fake.cancelTaskReturnsOnCall[len(fake.cancelTaskArgsForCall)]
+	fake.cancelTaskArgsForCall = append(fake.cancelTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CancelTaskStub
+	fakeReturns := fake.cancelTaskReturns
+	fake.recordInvocation("CancelTask", []interface{}{arg1, arg2, arg3})
+	fake.cancelTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) CancelTaskCallCount() int {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	return len(fake.cancelTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CancelTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) CancelTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	argsForCall := fake.cancelTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) CancelTaskReturns(result1 error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = nil
+	fake.cancelTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CancelTaskReturnsOnCall(i int, result1 error) {
+	fake.cancelTaskMutex.Lock()
+	defer fake.cancelTaskMutex.Unlock()
+	fake.CancelTaskStub = nil
+	if fake.cancelTaskReturnsOnCall == nil {
+		fake.cancelTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CellDrains(arg1 context.Context, arg2 lager.Logger) ([]*models.CellDrain, error) {
+	fake.cellDrainsMutex.Lock()
+	ret, specificReturn := fake.cellDrainsReturnsOnCall[len(fake.cellDrainsArgsForCall)]
+	fake.cellDrainsArgsForCall = append(fake.cellDrainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellDrainsStub
+	fakeReturns := fake.cellDrainsReturns
+	fake.recordInvocation("CellDrains", []interface{}{arg1, arg2})
+	fake.cellDrainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) CellDrainsCallCount() int {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	return len(fake.cellDrainsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CellDrainsCalls(stub func(context.Context, lager.Logger) ([]*models.CellDrain, error)) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = stub
+}
+
+func (fake *FakeInternalContextClient) CellDrainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	argsForCall := fake.cellDrainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) CellDrainsReturns(result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	fake.cellDrainsReturns = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) CellDrainsReturnsOnCall(i int, result1 []*models.CellDrain, result2 error) {
+	fake.cellDrainsMutex.Lock()
+	defer fake.cellDrainsMutex.Unlock()
+	fake.CellDrainsStub = nil
+	if fake.cellDrainsReturnsOnCall == nil {
+		fake.cellDrainsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellDrain
+			result2 error
+		})
+	}
+	fake.cellDrainsReturnsOnCall[i] = struct {
+		result1 []*models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) Cells(arg1 context.Context, arg2 lager.Logger) ([]*models.CellPresence, error) {
+	fake.cellsMutex.Lock()
+	ret, specificReturn := fake.cellsReturnsOnCall[len(fake.cellsArgsForCall)]
+	fake.cellsArgsForCall = append(fake.cellsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CellsStub
+	fakeReturns := fake.cellsReturns
+	fake.recordInvocation("Cells", []interface{}{arg1, arg2})
+	fake.cellsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) CellsCallCount() int {
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	return len(fake.cellsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CellsCalls(stub func(context.Context, lager.Logger) ([]*models.CellPresence, error)) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = stub
+}
+
+func (fake *FakeInternalContextClient) CellsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	argsForCall := fake.cellsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) CellsReturns(result1 []*models.CellPresence, result2 error) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = nil
+	fake.cellsReturns = struct {
+		result1 []*models.CellPresence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) CellsReturnsOnCall(i int, result1 []*models.CellPresence, result2 error) {
+	fake.cellsMutex.Lock()
+	defer fake.cellsMutex.Unlock()
+	fake.CellsStub = nil
+	if fake.cellsReturnsOnCall == nil {
+		fake.cellsReturnsOnCall = make(map[int]struct {
+			result1 []*models.CellPresence
+			result2 error
+		})
+	}
+	fake.cellsReturnsOnCall[i] = struct {
+		result1 []*models.CellPresence
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
+	fake.claimActualLRPMutex.Lock()
+	ret, specificReturn := fake.claimActualLRPReturnsOnCall[len(fake.claimActualLRPArgsForCall)]
+	fake.claimActualLRPArgsForCall = append(fake.claimActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ClaimActualLRPStub
+	fakeReturns := fake.claimActualLRPReturns
+	fake.recordInvocation("ClaimActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.claimActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRPCallCount() int {
+	fake.claimActualLRPMutex.RLock()
+	defer fake.claimActualLRPMutex.RUnlock()
+	return len(fake.claimActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error) {
+	fake.claimActualLRPMutex.Lock()
+	defer fake.claimActualLRPMutex.Unlock()
+	fake.ClaimActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.claimActualLRPMutex.RLock()
+	defer fake.claimActualLRPMutex.RUnlock()
+	argsForCall := fake.claimActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRPReturns(result1 error) {
+	fake.claimActualLRPMutex.Lock()
+	defer fake.claimActualLRPMutex.Unlock()
+	fake.ClaimActualLRPStub = nil
+	fake.claimActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) ClaimActualLRPReturnsOnCall(i int, result1 error) {
+	fake.claimActualLRPMutex.Lock()
+	defer fake.claimActualLRPMutex.Unlock()
+	fake.ClaimActualLRPStub = nil
+	if fake.claimActualLRPReturnsOnCall == nil {
+		fake.claimActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.claimActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CompleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 bool, arg6 string, arg7 string) error {
+	fake.completeTaskMutex.Lock()
+	ret, specificReturn := fake.completeTaskReturnsOnCall[len(fake.completeTaskArgsForCall)]
+	fake.completeTaskArgsForCall = append(fake.completeTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 bool
+		arg6 string
+		arg7 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	stub := fake.CompleteTaskStub
+	fakeReturns := fake.completeTaskReturns
+	fake.recordInvocation("CompleteTask", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6, arg7})
+	fake.completeTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) CompleteTaskCallCount() int {
+	fake.completeTaskMutex.RLock()
+	defer fake.completeTaskMutex.RUnlock()
+	return len(fake.completeTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CompleteTaskCalls(stub func(context.Context, lager.Logger, string, string, bool, string, string) error) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) CompleteTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, bool, string, string) {
+	fake.completeTaskMutex.RLock()
+	defer fake.completeTaskMutex.RUnlock()
+	argsForCall := fake.completeTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7
+}
+
+func (fake *FakeInternalContextClient) CompleteTaskReturns(result1 error) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = nil
+	fake.completeTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CompleteTaskReturnsOnCall(i int, result1 error) {
+	fake.completeTaskMutex.Lock()
+	defer fake.completeTaskMutex.Unlock()
+	fake.CompleteTaskStub = nil
+	if fake.completeTaskReturnsOnCall == nil {
+		fake.completeTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.completeTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.cordonCellMutex.Lock()
+	ret, specificReturn := fake.cordonCellReturnsOnCall[len(fake.cordonCellArgsForCall)]
+	fake.cordonCellArgsForCall = append(fake.cordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.CordonCellStub
+	fakeReturns := fake.cordonCellReturns
+	fake.recordInvocation("CordonCell", []interface{}{arg1, arg2, arg3})
+	fake.cordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) CordonCellCallCount() int {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	return len(fake.cordonCellArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = stub
+}
+
+func (fake *FakeInternalContextClient) CordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	argsForCall := fake.cordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) CordonCellReturns(result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	fake.cordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CordonCellReturnsOnCall(i int, result1 error) {
+	fake.cordonCellMutex.Lock()
+	defer fake.cordonCellMutex.Unlock()
+	fake.CordonCellStub = nil
+	if fake.cordonCellReturnsOnCall == nil {
+		fake.cordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 string) error {
+	fake.crashActualLRPMutex.Lock()
+	ret, specificReturn := fake.crashActualLRPReturnsOnCall[len(fake.crashActualLRPArgsForCall)]
+	fake.crashActualLRPArgsForCall = append(fake.crashActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.CrashActualLRPStub
+	fakeReturns := fake.crashActualLRPReturns
+	fake.recordInvocation("CrashActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.crashActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRPCallCount() int {
+	fake.crashActualLRPMutex.RLock()
+	defer fake.crashActualLRPMutex.RUnlock()
+	return len(fake.crashActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) error) {
+	fake.crashActualLRPMutex.Lock()
+	defer fake.crashActualLRPMutex.Unlock()
+	fake.CrashActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) {
+	fake.crashActualLRPMutex.RLock()
+	defer fake.crashActualLRPMutex.RUnlock()
+	argsForCall := fake.crashActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRPReturns(result1 error) {
+	fake.crashActualLRPMutex.Lock()
+	defer fake.crashActualLRPMutex.Unlock()
+	fake.CrashActualLRPStub = nil
+	fake.crashActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CrashActualLRPReturnsOnCall(i int, result1 error) {
+	fake.crashActualLRPMutex.Lock()
+	defer fake.crashActualLRPMutex.Unlock()
+	fake.CrashActualLRPStub = nil
+	if fake.crashActualLRPReturnsOnCall == nil {
+		fake.crashActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.crashActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakers(arg1 context.Context, arg2 lager.Logger) ([]*models.CrashStormBreaker, error) {
+	fake.crashStormBreakersMutex.Lock()
+	ret, specificReturn := fake.crashStormBreakersReturnsOnCall[len(fake.crashStormBreakersArgsForCall)]
+	fake.crashStormBreakersArgsForCall = append(fake.crashStormBreakersArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.CrashStormBreakersStub
+	fakeReturns := fake.crashStormBreakersReturns
+	fake.recordInvocation("CrashStormBreakers", []interface{}{arg1, arg2})
+	fake.crashStormBreakersMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakersCallCount() int {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	return len(fake.crashStormBreakersArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakersCalls(stub func(context.Context, lager.Logger) ([]*models.CrashStormBreaker, error)) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = stub
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakersArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	argsForCall := fake.crashStormBreakersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakersReturns(result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	fake.crashStormBreakersReturns = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) CrashStormBreakersReturnsOnCall(i int, result1 []*models.CrashStormBreaker, result2 error) {
+	fake.crashStormBreakersMutex.Lock()
+	defer fake.crashStormBreakersMutex.Unlock()
+	fake.CrashStormBreakersStub = nil
+	if fake.crashStormBreakersReturnsOnCall == nil {
+		fake.crashStormBreakersReturnsOnCall = make(map[int]struct {
+			result1 []*models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.crashStormBreakersReturnsOnCall[i] = struct {
+		result1 []*models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.deleteScheduledTaskReturnsOnCall[len(fake.deleteScheduledTaskArgsForCall)]
+	fake.deleteScheduledTaskArgsForCall = append(fake.deleteScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteScheduledTaskStub
+	fakeReturns := fake.deleteScheduledTaskReturns
+	fake.recordInvocation("DeleteScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTaskCallCount() int {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	return len(fake.deleteScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	argsForCall := fake.deleteScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTaskReturns(result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	fake.deleteScheduledTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DeleteScheduledTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteScheduledTaskMutex.Lock()
+	defer fake.deleteScheduledTaskMutex.Unlock()
+	fake.DeleteScheduledTaskStub = nil
+	if fake.deleteScheduledTaskReturnsOnCall == nil {
+		fake.deleteScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteScheduledTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DeleteTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.deleteTaskMutex.Lock()
+	ret, specificReturn := fake.deleteTaskReturnsOnCall[len(fake.deleteTaskArgsForCall)]
+	fake.deleteTaskArgsForCall = append(fake.deleteTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeleteTaskStub
+	fakeReturns := fake.deleteTaskReturns
+	fake.recordInvocation("DeleteTask", []interface{}{arg1, arg2, arg3})
+	fake.deleteTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) DeleteTaskCallCount() int {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	return len(fake.deleteTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DeleteTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) DeleteTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	argsForCall := fake.deleteTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DeleteTaskReturns(result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	fake.deleteTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DeleteTaskReturnsOnCall(i int, result1 error) {
+	fake.deleteTaskMutex.Lock()
+	defer fake.deleteTaskMutex.Unlock()
+	fake.DeleteTaskStub = nil
+	if fake.deleteTaskReturnsOnCall == nil {
+		fake.deleteTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesireLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.DesiredLRP) error {
+	fake.desireLRPMutex.Lock()
+	ret, specificReturn := fake.desireLRPReturnsOnCall[len(fake.desireLRPArgsForCall)]
+	fake.desireLRPArgsForCall = append(fake.desireLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DesiredLRP
+	}{arg1, arg2, arg3})
+	stub := fake.DesireLRPStub
+	fakeReturns := fake.desireLRPReturns
+	fake.recordInvocation("DesireLRP", []interface{}{arg1, arg2, arg3})
+	fake.desireLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) DesireLRPCallCount() int {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	return len(fake.desireLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesireLRPCalls(stub func(context.Context, lager.Logger, *models.DesiredLRP) error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesireLRPArgsForCall(i int) (context.Context, lager.Logger, *models.DesiredLRP) {
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	argsForCall := fake.desireLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesireLRPReturns(result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	fake.desireLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DesireLRPReturnsOnCall(i int, result1 error) {
+	fake.desireLRPMutex.Lock()
+	defer fake.desireLRPMutex.Unlock()
+	fake.DesireLRPStub = nil
+	if fake.desireLRPReturnsOnCall == nil {
+		fake.desireLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 *models.ScheduledTask) (*models.ScheduledTask, error) {
+	fake.desireScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.desireScheduledTaskReturnsOnCall[len(fake.desireScheduledTaskArgsForCall)]
+	fake.desireScheduledTaskArgsForCall = append(fake.desireScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ScheduledTask
+	}{arg1, arg2, arg3})
+	stub := fake.DesireScheduledTaskStub
+	fakeReturns := fake.desireScheduledTaskReturns
+	fake.recordInvocation("DesireScheduledTask", []interface{}{arg1, arg2, arg3})
+	fake.desireScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTaskCallCount() int {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	return len(fake.desireScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTaskCalls(stub func(context.Context, lager.Logger, *models.ScheduledTask) (*models.ScheduledTask, error)) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, *models.ScheduledTask) {
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	argsForCall := fake.desireScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	fake.desireScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesireScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.desireScheduledTaskMutex.Lock()
+	defer fake.desireScheduledTaskMutex.Unlock()
+	fake.DesireScheduledTaskStub = nil
+	if fake.desireScheduledTaskReturnsOnCall == nil {
+		fake.desireScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.desireScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesireTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string, arg5 *models.TaskDefinition) error {
+	fake.desireTaskMutex.Lock()
+	ret, specificReturn := fake.desireTaskReturnsOnCall[len(fake.desireTaskArgsForCall)]
+	fake.desireTaskArgsForCall = append(fake.desireTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+		arg5 *models.TaskDefinition
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.DesireTaskStub
+	fakeReturns := fake.desireTaskReturns
+	fake.recordInvocation("DesireTask", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.desireTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) DesireTaskCallCount() int {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	return len(fake.desireTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesireTaskCalls(stub func(context.Context, lager.Logger, string, string, *models.TaskDefinition) error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesireTaskArgsForCall(i int) (context.Context, lager.Logger, string, string, *models.TaskDefinition) {
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	argsForCall := fake.desireTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInternalContextClient) DesireTaskReturns(result1 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	fake.desireTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DesireTaskReturnsOnCall(i int, result1 error) {
+	fake.desireTaskMutex.Lock()
+	defer fake.desireTaskMutex.Unlock()
+	fake.DesireTaskStub = nil
+	if fake.desireTaskReturnsOnCall == nil {
+		fake.desireTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.desireTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRP, error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPByProcessGuidReturnsOnCall[len(fake.desiredLRPByProcessGuidArgsForCall)]
+	fake.desiredLRPByProcessGuidArgsForCall = append(fake.desiredLRPByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPByProcessGuidStub
+	fakeReturns := fake.desiredLRPByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuidCallCount() int {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRP, error)) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuidReturns(result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	fake.desiredLRPByProcessGuidReturns = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRP, result2 error) {
+	fake.desiredLRPByProcessGuidMutex.Lock()
+	defer fake.desiredLRPByProcessGuidMutex.Unlock()
+	fake.DesiredLRPByProcessGuidStub = nil
+	if fake.desiredLRPByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPRoutingInfosReturnsOnCall[len(fake.desiredLRPRoutingInfosArgsForCall)]
+	fake.desiredLRPRoutingInfosArgsForCall = append(fake.desiredLRPRoutingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPRoutingInfosStub
+	fakeReturns := fake.desiredLRPRoutingInfosReturns
+	fake.recordInvocation("DesiredLRPRoutingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPRoutingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfosCallCount() int {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	return len(fake.desiredLRPRoutingInfosArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPRoutingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfosReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	fake.desiredLRPRoutingInfosReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPRoutingInfosReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPRoutingInfosMutex.Lock()
+	defer fake.desiredLRPRoutingInfosMutex.Unlock()
+	fake.DesiredLRPRoutingInfosStub = nil
+	if fake.desiredLRPRoutingInfosReturnsOnCall == nil {
+		fake.desiredLRPRoutingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPRoutingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)]
+	fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall = append(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfoByProcessGuidStub
+	fakeReturns := fake.desiredLRPSchedulingInfoByProcessGuidReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfoByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuidCallCount() int {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfoByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuidReturns(result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	fake.desiredLRPSchedulingInfoByProcessGuidReturns = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfoByProcessGuidReturnsOnCall(i int, result1 *models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.Lock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.Unlock()
+	fake.DesiredLRPSchedulingInfoByProcessGuidStub = nil
+	if fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfoByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfos(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfosReturnsOnCall[len(fake.desiredLRPSchedulingInfosArgsForCall)]
+	fake.desiredLRPSchedulingInfosArgsForCall = append(fake.desiredLRPSchedulingInfosArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfosStub
+	fakeReturns := fake.desiredLRPSchedulingInfosReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfos", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfosMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosCallCount() int {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, error)) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfosArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	fake.desiredLRPSchedulingInfosReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 error) {
+	fake.desiredLRPSchedulingInfosMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosStub = nil
+	if fake.desiredLRPSchedulingInfosReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfosReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 error
+		})
+	}
+	fake.desiredLRPSchedulingInfosReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall[len(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall)]
+	fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall = append(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPSchedulingInfosWithResourceVersionStub
+	fakeReturns := fake.desiredLRPSchedulingInfosWithResourceVersionReturns
+	fake.recordInvocation("DesiredLRPSchedulingInfosWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersionCallCount() int {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	return len(fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRPSchedulingInfo, uint64, error)) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.desiredLRPSchedulingInfosWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersionReturns(result1 []*models.DesiredLRPSchedulingInfo, result2 uint64, result3 error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = nil
+	fake.desiredLRPSchedulingInfosWithResourceVersionReturns = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPSchedulingInfosWithResourceVersionReturnsOnCall(i int, result1 []*models.DesiredLRPSchedulingInfo, result2 uint64, result3 error) {
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPSchedulingInfosWithResourceVersionStub = nil
+	if fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall == nil {
+		fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRPSchedulingInfo
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.desiredLRPSchedulingInfosWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRPSchedulingInfo
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPs(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, error) {
+	fake.desiredLRPsMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsReturnsOnCall[len(fake.desiredLRPsArgsForCall)]
+	fake.desiredLRPsArgsForCall = append(fake.desiredLRPsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsStub
+	fakeReturns := fake.desiredLRPsReturns
+	fake.recordInvocation("DesiredLRPs", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsCallCount() int {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	return len(fake.desiredLRPsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, error)) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	argsForCall := fake.desiredLRPsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsReturns(result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	fake.desiredLRPsReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 error) {
+	fake.desiredLRPsMutex.Lock()
+	defer fake.desiredLRPsMutex.Unlock()
+	fake.DesiredLRPsStub = nil
+	if fake.desiredLRPsReturnsOnCall == nil {
+		fake.desiredLRPsReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 error
+		})
+	}
+	fake.desiredLRPsReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPage(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error) {
+	fake.desiredLRPsPageMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsPageReturnsOnCall[len(fake.desiredLRPsPageArgsForCall)]
+	fake.desiredLRPsPageArgsForCall = append(fake.desiredLRPsPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsPageStub
+	fakeReturns := fake.desiredLRPsPageReturns
+	fake.recordInvocation("DesiredLRPsPage", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPageCallCount() int {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	return len(fake.desiredLRPsPageArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPageCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, string, error)) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPageArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	argsForCall := fake.desiredLRPsPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPageReturns(result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	fake.desiredLRPsPageReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsPageReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 string, result3 error) {
+	fake.desiredLRPsPageMutex.Lock()
+	defer fake.desiredLRPsPageMutex.Unlock()
+	fake.DesiredLRPsPageStub = nil
+	if fake.desiredLRPsPageReturnsOnCall == nil {
+		fake.desiredLRPsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 string
+			result3 error
+		})
+	}
+	fake.desiredLRPsPageReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.desiredLRPsWithResourceVersionReturnsOnCall[len(fake.desiredLRPsWithResourceVersionArgsForCall)]
+	fake.desiredLRPsWithResourceVersionArgsForCall = append(fake.desiredLRPsWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.DesiredLRPFilter
+	}{arg1, arg2, arg3})
+	stub := fake.DesiredLRPsWithResourceVersionStub
+	fakeReturns := fake.desiredLRPsWithResourceVersionReturns
+	fake.recordInvocation("DesiredLRPsWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersionCallCount() int {
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	return len(fake.desiredLRPsWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.DesiredLRPFilter) ([]*models.DesiredLRP, uint64, error)) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = stub
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.DesiredLRPFilter) {
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.desiredLRPsWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersionReturns(result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = nil
+	fake.desiredLRPsWithResourceVersionReturns = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DesiredLRPsWithResourceVersionReturnsOnCall(i int, result1 []*models.DesiredLRP, result2 uint64, result3 error) {
+	fake.desiredLRPsWithResourceVersionMutex.Lock()
+	defer fake.desiredLRPsWithResourceVersionMutex.Unlock()
+	fake.DesiredLRPsWithResourceVersionStub = nil
+	if fake.desiredLRPsWithResourceVersionReturnsOnCall == nil {
+		fake.desiredLRPsWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.DesiredLRP
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.desiredLRPsWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.DesiredLRP
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DomainQuotas(arg1 context.Context, arg2 lager.Logger) ([]*models.DomainQuota, error) {
+	fake.domainQuotasMutex.Lock()
+	ret, specificReturn := fake.domainQuotasReturnsOnCall[len(fake.domainQuotasArgsForCall)]
+	fake.domainQuotasArgsForCall = append(fake.domainQuotasArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainQuotasStub
+	fakeReturns := fake.domainQuotasReturns
+	fake.recordInvocation("DomainQuotas", []interface{}{arg1, arg2})
+	fake.domainQuotasMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DomainQuotasCallCount() int {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	return len(fake.domainQuotasArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DomainQuotasCalls(stub func(context.Context, lager.Logger) ([]*models.DomainQuota, error)) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = stub
+}
+
+func (fake *FakeInternalContextClient) DomainQuotasArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	argsForCall := fake.domainQuotasArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) DomainQuotasReturns(result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	fake.domainQuotasReturns = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DomainQuotasReturnsOnCall(i int, result1 []*models.DomainQuota, result2 error) {
+	fake.domainQuotasMutex.Lock()
+	defer fake.domainQuotasMutex.Unlock()
+	fake.DomainQuotasStub = nil
+	if fake.domainQuotasReturnsOnCall == nil {
+		fake.domainQuotasReturnsOnCall = make(map[int]struct {
+			result1 []*models.DomainQuota
+			result2 error
+		})
+	}
+	fake.domainQuotasReturnsOnCall[i] = struct {
+		result1 []*models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DomainUsage(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.DomainUsage, *models.DomainQuota, error) {
+	fake.domainUsageMutex.Lock()
+	ret, specificReturn := fake.domainUsageReturnsOnCall[len(fake.domainUsageArgsForCall)]
+	fake.domainUsageArgsForCall = append(fake.domainUsageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DomainUsageStub
+	fakeReturns := fake.domainUsageReturns
+	fake.recordInvocation("DomainUsage", []interface{}{arg1, arg2, arg3})
+	fake.domainUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) DomainUsageCallCount() int {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	return len(fake.domainUsageArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DomainUsageCalls(stub func(context.Context, lager.Logger, string) (*models.DomainUsage, *models.DomainQuota, error)) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = stub
+}
+
+func (fake *FakeInternalContextClient) DomainUsageArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	argsForCall := fake.domainUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DomainUsageReturns(result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	fake.domainUsageReturns = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) DomainUsageReturnsOnCall(i int, result1 *models.DomainUsage, result2 *models.DomainQuota, result3 error) {
+	fake.domainUsageMutex.Lock()
+	defer fake.domainUsageMutex.Unlock()
+	fake.DomainUsageStub = nil
+	if fake.domainUsageReturnsOnCall == nil {
+		fake.domainUsageReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainUsage
+			result2 *models.DomainQuota
+			result3 error
+		})
+	}
+	fake.domainUsageReturnsOnCall[i] = struct {
+		result1 *models.DomainUsage
+		result2 *models.DomainQuota
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) Domains(arg1 context.Context, arg2 lager.Logger) ([]string, error) {
+	fake.domainsMutex.Lock()
+	ret, specificReturn := fake.domainsReturnsOnCall[len(fake.domainsArgsForCall)]
+	fake.domainsArgsForCall = append(fake.domainsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.DomainsStub
+	fakeReturns := fake.domainsReturns
+	fake.recordInvocation("Domains", []interface{}{arg1, arg2})
+	fake.domainsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DomainsCallCount() int {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	return len(fake.domainsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DomainsCalls(stub func(context.Context, lager.Logger) ([]string, error)) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = stub
+}
+
+func (fake *FakeInternalContextClient) DomainsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	argsForCall := fake.domainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) DomainsReturns(result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	fake.domainsReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DomainsReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.domainsMutex.Lock()
+	defer fake.domainsMutex.Unlock()
+	fake.DomainsStub = nil
+	if fake.domainsReturnsOnCall == nil {
+		fake.domainsReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.domainsReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DrainCell(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.CellDrain, error) {
+	fake.drainCellMutex.Lock()
+	ret, specificReturn := fake.drainCellReturnsOnCall[len(fake.drainCellArgsForCall)]
+	fake.drainCellArgsForCall = append(fake.drainCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DrainCellStub
+	fakeReturns := fake.drainCellReturns
+	fake.recordInvocation("DrainCell", []interface{}{arg1, arg2, arg3})
+	fake.drainCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) DrainCellCallCount() int {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	return len(fake.drainCellArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) DrainCellCalls(stub func(context.Context, lager.Logger, string) (*models.CellDrain, error)) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = stub
+}
+
+func (fake *FakeInternalContextClient) DrainCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	argsForCall := fake.drainCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) DrainCellReturns(result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	fake.drainCellReturns = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) DrainCellReturnsOnCall(i int, result1 *models.CellDrain, result2 error) {
+	fake.drainCellMutex.Lock()
+	defer fake.drainCellMutex.Unlock()
+	fake.DrainCellStub = nil
+	if fake.drainCellReturnsOnCall == nil {
+		fake.drainCellReturnsOnCall = make(map[int]struct {
+			result1 *models.CellDrain
+			result2 error
+		})
+	}
+	fake.drainCellReturnsOnCall[i] = struct {
+		result1 *models.CellDrain
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) (bool, error) {
+	fake.evacuateClaimedActualLRPMutex.Lock()
+	ret, specificReturn := fake.evacuateClaimedActualLRPReturnsOnCall[len(fake.evacuateClaimedActualLRPArgsForCall)]
+	fake.evacuateClaimedActualLRPArgsForCall = append(fake.evacuateClaimedActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EvacuateClaimedActualLRPStub
+	fakeReturns := fake.evacuateClaimedActualLRPReturns
+	fake.recordInvocation("EvacuateClaimedActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.evacuateClaimedActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRPCallCount() int {
+	fake.evacuateClaimedActualLRPMutex.RLock()
+	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
+	return len(fake.evacuateClaimedActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)) {
+	fake.evacuateClaimedActualLRPMutex.Lock()
+	defer fake.evacuateClaimedActualLRPMutex.Unlock()
+	fake.EvacuateClaimedActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.evacuateClaimedActualLRPMutex.RLock()
+	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
+	argsForCall := fake.evacuateClaimedActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRPReturns(result1 bool, result2 error) {
+	fake.evacuateClaimedActualLRPMutex.Lock()
+	defer fake.evacuateClaimedActualLRPMutex.Unlock()
+	fake.EvacuateClaimedActualLRPStub = nil
+	fake.evacuateClaimedActualLRPReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateClaimedActualLRPReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.evacuateClaimedActualLRPMutex.Lock()
+	defer fake.evacuateClaimedActualLRPMutex.Unlock()
+	fake.EvacuateClaimedActualLRPStub = nil
+	if fake.evacuateClaimedActualLRPReturnsOnCall == nil {
+		fake.evacuateClaimedActualLRPReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.evacuateClaimedActualLRPReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 string) (bool, error) {
+	fake.evacuateCrashedActualLRPMutex.Lock()
+	ret, specificReturn := fake.evacuateCrashedActualLRPReturnsOnCall[len(fake.evacuateCrashedActualLRPArgsForCall)]
+	fake.evacuateCrashedActualLRPArgsForCall = append(fake.evacuateCrashedActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	stub := fake.EvacuateCrashedActualLRPStub
+	fakeReturns := fake.evacuateCrashedActualLRPReturns
+	fake.recordInvocation("EvacuateCrashedActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5})
+	fake.evacuateCrashedActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRPCallCount() int {
+	fake.evacuateCrashedActualLRPMutex.RLock()
+	defer fake.evacuateCrashedActualLRPMutex.RUnlock()
+	return len(fake.evacuateCrashedActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) (bool, error)) {
+	fake.evacuateCrashedActualLRPMutex.Lock()
+	defer fake.evacuateCrashedActualLRPMutex.Unlock()
+	fake.EvacuateCrashedActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, string) {
+	fake.evacuateCrashedActualLRPMutex.RLock()
+	defer fake.evacuateCrashedActualLRPMutex.RUnlock()
+	argsForCall := fake.evacuateCrashedActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRPReturns(result1 bool, result2 error) {
+	fake.evacuateCrashedActualLRPMutex.Lock()
+	defer fake.evacuateCrashedActualLRPMutex.Unlock()
+	fake.EvacuateCrashedActualLRPStub = nil
+	fake.evacuateCrashedActualLRPReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateCrashedActualLRPReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.evacuateCrashedActualLRPMutex.Lock()
+	defer fake.evacuateCrashedActualLRPMutex.Unlock()
+	fake.EvacuateCrashedActualLRPStub = nil
+	if fake.evacuateCrashedActualLRPReturnsOnCall == nil {
+		fake.evacuateCrashedActualLRPReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.evacuateCrashedActualLRPReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) (bool, error) {
+	var arg6Copy []*models.ActualLRPInternalRoute
+	if arg6 != nil {
+		arg6Copy = make([]*models.ActualLRPInternalRoute, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.evacuateRunningActualLRPMutex.Lock()
+	ret, specificReturn := fake.evacuateRunningActualLRPReturnsOnCall[len(fake.evacuateRunningActualLRPArgsForCall)]
+	fake.evacuateRunningActualLRPArgsForCall = append(fake.evacuateRunningActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 *models.ActualLRPNetInfo
+		arg6 []*models.ActualLRPInternalRoute
+		arg7 map[string]string
+		arg8 bool
+		arg9 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	stub := fake.EvacuateRunningActualLRPStub
+	fakeReturns := fake.evacuateRunningActualLRPReturns
+	fake.recordInvocation("EvacuateRunningActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	fake.evacuateRunningActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRPCallCount() int {
+	fake.evacuateRunningActualLRPMutex.RLock()
+	defer fake.evacuateRunningActualLRPMutex.RUnlock()
+	return len(fake.evacuateRunningActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) (bool, error)) {
+	fake.evacuateRunningActualLRPMutex.Lock()
+	defer fake.evacuateRunningActualLRPMutex.Unlock()
+	fake.EvacuateRunningActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) {
+	fake.evacuateRunningActualLRPMutex.RLock()
+	defer fake.evacuateRunningActualLRPMutex.RUnlock()
+	argsForCall := fake.evacuateRunningActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRPReturns(result1 bool, result2 error) {
+	fake.evacuateRunningActualLRPMutex.Lock()
+	defer fake.evacuateRunningActualLRPMutex.Unlock()
+	fake.EvacuateRunningActualLRPStub = nil
+	fake.evacuateRunningActualLRPReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateRunningActualLRPReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.evacuateRunningActualLRPMutex.Lock()
+	defer fake.evacuateRunningActualLRPMutex.Unlock()
+	fake.EvacuateRunningActualLRPStub = nil
+	if fake.evacuateRunningActualLRPReturnsOnCall == nil {
+		fake.evacuateRunningActualLRPReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.evacuateRunningActualLRPReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) (bool, error) {
+	fake.evacuateStoppedActualLRPMutex.Lock()
+	ret, specificReturn := fake.evacuateStoppedActualLRPReturnsOnCall[len(fake.evacuateStoppedActualLRPArgsForCall)]
+	fake.evacuateStoppedActualLRPArgsForCall = append(fake.evacuateStoppedActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.EvacuateStoppedActualLRPStub
+	fakeReturns := fake.evacuateStoppedActualLRPReturns
+	fake.recordInvocation("EvacuateStoppedActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.evacuateStoppedActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRPCallCount() int {
+	fake.evacuateStoppedActualLRPMutex.RLock()
+	defer fake.evacuateStoppedActualLRPMutex.RUnlock()
+	return len(fake.evacuateStoppedActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) (bool, error)) {
+	fake.evacuateStoppedActualLRPMutex.Lock()
+	defer fake.evacuateStoppedActualLRPMutex.Unlock()
+	fake.EvacuateStoppedActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.evacuateStoppedActualLRPMutex.RLock()
+	defer fake.evacuateStoppedActualLRPMutex.RUnlock()
+	argsForCall := fake.evacuateStoppedActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRPReturns(result1 bool, result2 error) {
+	fake.evacuateStoppedActualLRPMutex.Lock()
+	defer fake.evacuateStoppedActualLRPMutex.Unlock()
+	fake.EvacuateStoppedActualLRPStub = nil
+	fake.evacuateStoppedActualLRPReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) EvacuateStoppedActualLRPReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.evacuateStoppedActualLRPMutex.Lock()
+	defer fake.evacuateStoppedActualLRPMutex.Unlock()
+	fake.EvacuateStoppedActualLRPStub = nil
+	if fake.evacuateStoppedActualLRPReturnsOnCall == nil {
+		fake.evacuateStoppedActualLRPReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.evacuateStoppedActualLRPReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) FailActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 string) error {
+	fake.failActualLRPMutex.Lock()
+	ret, specificReturn := fake.failActualLRPReturnsOnCall[len(fake.failActualLRPArgsForCall)]
+	fake.failActualLRPArgsForCall = append(fake.failActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailActualLRPStub
+	fakeReturns := fake.failActualLRPReturns
+	fake.recordInvocation("FailActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) FailActualLRPCallCount() int {
+	fake.failActualLRPMutex.RLock()
+	defer fake.failActualLRPMutex.RUnlock()
+	return len(fake.failActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) FailActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, string) error) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) FailActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, string) {
+	fake.failActualLRPMutex.RLock()
+	defer fake.failActualLRPMutex.RUnlock()
+	argsForCall := fake.failActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) FailActualLRPReturns(result1 error) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = nil
+	fake.failActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) FailActualLRPReturnsOnCall(i int, result1 error) {
+	fake.failActualLRPMutex.Lock()
+	defer fake.failActualLRPMutex.Unlock()
+	fake.FailActualLRPStub = nil
+	if fake.failActualLRPReturnsOnCall == nil {
+		fake.failActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.failActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) FailTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) error {
+	fake.failTaskMutex.Lock()
+	ret, specificReturn := fake.failTaskReturnsOnCall[len(fake.failTaskArgsForCall)]
+	fake.failTaskArgsForCall = append(fake.failTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.FailTaskStub
+	fakeReturns := fake.failTaskReturns
+	fake.recordInvocation("FailTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.failTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) FailTaskCallCount() int {
+	fake.failTaskMutex.RLock()
+	defer fake.failTaskMutex.RUnlock()
+	return len(fake.failTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) FailTaskCalls(stub func(context.Context, lager.Logger, string, string) error) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) FailTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.failTaskMutex.RLock()
+	defer fake.failTaskMutex.RUnlock()
+	argsForCall := fake.failTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) FailTaskReturns(result1 error) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = nil
+	fake.failTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) FailTaskReturnsOnCall(i int, result1 error) {
+	fake.failTaskMutex.Lock()
+	defer fake.failTaskMutex.Unlock()
+	fake.FailTaskStub = nil
+	if fake.failTaskReturnsOnCall == nil {
+		fake.failTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.failTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) OverloadStatus(arg1 context.Context, arg2 lager.Logger) (*models.OverloadStatus, error) {
+	fake.overloadStatusMutex.Lock()
+	ret, specificReturn := fake.overloadStatusReturnsOnCall[len(fake.overloadStatusArgsForCall)]
+	fake.overloadStatusArgsForCall = append(fake.overloadStatusArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.OverloadStatusStub
+	fakeReturns := fake.overloadStatusReturns
+	fake.recordInvocation("OverloadStatus", []interface{}{arg1, arg2})
+	fake.overloadStatusMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) OverloadStatusCallCount() int {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	return len(fake.overloadStatusArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) OverloadStatusCalls(stub func(context.Context, lager.Logger) (*models.OverloadStatus, error)) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = stub
+}
+
+func (fake *FakeInternalContextClient) OverloadStatusArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	argsForCall := fake.overloadStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) OverloadStatusReturns(result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	fake.overloadStatusReturns = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) OverloadStatusReturnsOnCall(i int, result1 *models.OverloadStatus, result2 error) {
+	fake.overloadStatusMutex.Lock()
+	defer fake.overloadStatusMutex.Unlock()
+	fake.OverloadStatusStub = nil
+	if fake.overloadStatusReturnsOnCall == nil {
+		fake.overloadStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.OverloadStatus
+			result2 error
+		})
+	}
+	fake.overloadStatusReturnsOnCall[i] = struct {
+		result1 *models.OverloadStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) PauseDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.pauseDeploymentMutex.Lock()
+	ret, specificReturn := fake.pauseDeploymentReturnsOnCall[len(fake.pauseDeploymentArgsForCall)]
+	fake.pauseDeploymentArgsForCall = append(fake.pauseDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PauseDeploymentStub
+	fakeReturns := fake.pauseDeploymentReturns
+	fake.recordInvocation("PauseDeployment", []interface{}{arg1, arg2, arg3})
+	fake.pauseDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) PauseDeploymentCallCount() int {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	return len(fake.pauseDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) PauseDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = stub
+}
+
+func (fake *FakeInternalContextClient) PauseDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	argsForCall := fake.pauseDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) PauseDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	fake.pauseDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) PauseDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	if fake.pauseDeploymentReturnsOnCall == nil {
+		fake.pauseDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.pauseDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) Ping(arg1 context.Context, arg2 lager.Logger) bool {
+	fake.pingMutex.Lock()
+	ret, specificReturn := fake.pingReturnsOnCall[len(fake.pingArgsForCall)]
+	fake.pingArgsForCall = append(fake.pingArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.PingStub
+	fakeReturns := fake.pingReturns
+	fake.recordInvocation("Ping", []interface{}{arg1, arg2})
+	fake.pingMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) PingCallCount() int {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	return len(fake.pingArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) PingCalls(stub func(context.Context, lager.Logger) bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = stub
+}
+
+func (fake *FakeInternalContextClient) PingArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	argsForCall := fake.pingArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) PingReturns(result1 bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	fake.pingReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) PingReturnsOnCall(i int, result1 bool) {
+	fake.pingMutex.Lock()
+	defer fake.pingMutex.Unlock()
+	fake.PingStub = nil
+	if fake.pingReturnsOnCall == nil {
+		fake.pingReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.pingReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) error {
+	fake.rejectTaskMutex.Lock()
+	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
+	fake.rejectTaskArgsForCall = append(fake.rejectTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RejectTaskStub
+	fakeReturns := fake.rejectTaskReturns
+	fake.recordInvocation("RejectTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.rejectTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RejectTaskCallCount() int {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	return len(fake.rejectTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RejectTaskCalls(stub func(context.Context, lager.Logger, string, string) error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) RejectTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	argsForCall := fake.rejectTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) RejectTaskReturns(result1 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	fake.rejectTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RejectTaskReturnsOnCall(i int, result1 error) {
+	fake.rejectTaskMutex.Lock()
+	defer fake.rejectTaskMutex.Unlock()
+	fake.RejectTaskStub = nil
+	if fake.rejectTaskReturnsOnCall == nil {
+		fake.rejectTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.rejectTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
+	fake.removeActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeActualLRPReturnsOnCall[len(fake.removeActualLRPArgsForCall)]
+	fake.removeActualLRPArgsForCall = append(fake.removeActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveActualLRPStub
+	fakeReturns := fake.removeActualLRPReturns
+	fake.recordInvocation("RemoveActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRPCallCount() int {
+	fake.removeActualLRPMutex.RLock()
+	defer fake.removeActualLRPMutex.RUnlock()
+	return len(fake.removeActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.removeActualLRPMutex.RLock()
+	defer fake.removeActualLRPMutex.RUnlock()
+	argsForCall := fake.removeActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRPReturns(result1 error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = nil
+	fake.removeActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveActualLRPReturnsOnCall(i int, result1 error) {
+	fake.removeActualLRPMutex.Lock()
+	defer fake.removeActualLRPMutex.Unlock()
+	fake.RemoveActualLRPStub = nil
+	if fake.removeActualLRPReturnsOnCall == nil {
+		fake.removeActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.removeDesiredLRPReturnsOnCall[len(fake.removeDesiredLRPArgsForCall)]
+	fake.removeDesiredLRPArgsForCall = append(fake.removeDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDesiredLRPStub
+	fakeReturns := fake.removeDesiredLRPReturns
+	fake.recordInvocation("RemoveDesiredLRP", []interface{}{arg1, arg2, arg3})
+	fake.removeDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRPCallCount() int {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	return len(fake.removeDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRPCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	argsForCall := fake.removeDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRPReturns(result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	fake.removeDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.removeDesiredLRPMutex.Lock()
+	defer fake.removeDesiredLRPMutex.Unlock()
+	fake.RemoveDesiredLRPStub = nil
+	if fake.removeDesiredLRPReturnsOnCall == nil {
+		fake.removeDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.removeDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.removeDomainQuotaReturnsOnCall[len(fake.removeDomainQuotaArgsForCall)]
+	fake.removeDomainQuotaArgsForCall = append(fake.removeDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RemoveDomainQuotaStub
+	fakeReturns := fake.removeDomainQuotaReturns
+	fake.recordInvocation("RemoveDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.removeDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuotaCallCount() int {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	return len(fake.removeDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuotaCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	argsForCall := fake.removeDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuotaReturns(result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	fake.removeDomainQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveDomainQuotaReturnsOnCall(i int, result1 error) {
+	fake.removeDomainQuotaMutex.Lock()
+	defer fake.removeDomainQuotaMutex.Unlock()
+	fake.RemoveDomainQuotaStub = nil
+	if fake.removeDomainQuotaReturnsOnCall == nil {
+		fake.removeDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeDomainQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey) error {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	ret, specificReturn := fake.removeEvacuatingActualLRPReturnsOnCall[len(fake.removeEvacuatingActualLRPArgsForCall)]
+	fake.removeEvacuatingActualLRPArgsForCall = append(fake.removeEvacuatingActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.RemoveEvacuatingActualLRPStub
+	fakeReturns := fake.removeEvacuatingActualLRPReturns
+	fake.recordInvocation("RemoveEvacuatingActualLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.removeEvacuatingActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRPCallCount() int {
+	fake.removeEvacuatingActualLRPMutex.RLock()
+	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	return len(fake.removeEvacuatingActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey) {
+	fake.removeEvacuatingActualLRPMutex.RLock()
+	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	argsForCall := fake.removeEvacuatingActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRPReturns(result1 error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = nil
+	fake.removeEvacuatingActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RemoveEvacuatingActualLRPReturnsOnCall(i int, result1 error) {
+	fake.removeEvacuatingActualLRPMutex.Lock()
+	defer fake.removeEvacuatingActualLRPMutex.Unlock()
+	fake.RemoveEvacuatingActualLRPStub = nil
+	if fake.removeEvacuatingActualLRPReturnsOnCall == nil {
+		fake.removeEvacuatingActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeEvacuatingActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallback(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.TaskCallback, error) {
+	fake.replayTaskCallbackMutex.Lock()
+	ret, specificReturn := fake.replayTaskCallbackReturnsOnCall[len(fake.replayTaskCallbackArgsForCall)]
+	fake.replayTaskCallbackArgsForCall = append(fake.replayTaskCallbackArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ReplayTaskCallbackStub
+	fakeReturns := fake.replayTaskCallbackReturns
+	fake.recordInvocation("ReplayTaskCallback", []interface{}{arg1, arg2, arg3})
+	fake.replayTaskCallbackMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallbackCallCount() int {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	return len(fake.replayTaskCallbackArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallbackCalls(stub func(context.Context, lager.Logger, string) (*models.TaskCallback, error)) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = stub
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallbackArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	argsForCall := fake.replayTaskCallbackArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallbackReturns(result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	fake.replayTaskCallbackReturns = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ReplayTaskCallbackReturnsOnCall(i int, result1 *models.TaskCallback, result2 error) {
+	fake.replayTaskCallbackMutex.Lock()
+	defer fake.replayTaskCallbackMutex.Unlock()
+	fake.ReplayTaskCallbackStub = nil
+	if fake.replayTaskCallbackReturnsOnCall == nil {
+		fake.replayTaskCallbackReturnsOnCall = make(map[int]struct {
+			result1 *models.TaskCallback
+			result2 error
+		})
+	}
+	fake.replayTaskCallbackReturnsOnCall[i] = struct {
+		result1 *models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreaker(arg1 context.Context, arg2 lager.Logger, arg3 models.CrashStormBreaker_Scope, arg4 string) (*models.CrashStormBreaker, error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	ret, specificReturn := fake.resetCrashStormBreakerReturnsOnCall[len(fake.resetCrashStormBreakerArgsForCall)]
+	fake.resetCrashStormBreakerArgsForCall = append(fake.resetCrashStormBreakerArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.CrashStormBreaker_Scope
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.ResetCrashStormBreakerStub
+	fakeReturns := fake.resetCrashStormBreakerReturns
+	fake.recordInvocation("ResetCrashStormBreaker", []interface{}{arg1, arg2, arg3, arg4})
+	fake.resetCrashStormBreakerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreakerCallCount() int {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	return len(fake.resetCrashStormBreakerArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreakerCalls(stub func(context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) (*models.CrashStormBreaker, error)) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = stub
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreakerArgsForCall(i int) (context.Context, lager.Logger, models.CrashStormBreaker_Scope, string) {
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	argsForCall := fake.resetCrashStormBreakerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreakerReturns(result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	fake.resetCrashStormBreakerReturns = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ResetCrashStormBreakerReturnsOnCall(i int, result1 *models.CrashStormBreaker, result2 error) {
+	fake.resetCrashStormBreakerMutex.Lock()
+	defer fake.resetCrashStormBreakerMutex.Unlock()
+	fake.ResetCrashStormBreakerStub = nil
+	if fake.resetCrashStormBreakerReturnsOnCall == nil {
+		fake.resetCrashStormBreakerReturnsOnCall = make(map[int]struct {
+			result1 *models.CrashStormBreaker
+			result2 error
+		})
+	}
+	fake.resetCrashStormBreakerReturnsOnCall[i] = struct {
+		result1 *models.CrashStormBreaker
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ResolvingTask(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.resolvingTaskMutex.Lock()
+	ret, specificReturn := fake.resolvingTaskReturnsOnCall[len(fake.resolvingTaskArgsForCall)]
+	fake.resolvingTaskArgsForCall = append(fake.resolvingTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResolvingTaskStub
+	fakeReturns := fake.resolvingTaskReturns
+	fake.recordInvocation("ResolvingTask", []interface{}{arg1, arg2, arg3})
+	fake.resolvingTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) ResolvingTaskCallCount() int {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	return len(fake.resolvingTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ResolvingTaskCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) ResolvingTaskArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	argsForCall := fake.resolvingTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ResolvingTaskReturns(result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	fake.resolvingTaskReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) ResolvingTaskReturnsOnCall(i int, result1 error) {
+	fake.resolvingTaskMutex.Lock()
+	defer fake.resolvingTaskMutex.Unlock()
+	fake.ResolvingTaskStub = nil
+	if fake.resolvingTaskReturnsOnCall == nil {
+		fake.resolvingTaskReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.resolvingTaskReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) ResumeDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.resumeDeploymentMutex.Lock()
+	ret, specificReturn := fake.resumeDeploymentReturnsOnCall[len(fake.resumeDeploymentArgsForCall)]
+	fake.resumeDeploymentArgsForCall = append(fake.resumeDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeDeploymentStub
+	fakeReturns := fake.resumeDeploymentReturns
+	fake.recordInvocation("ResumeDeployment", []interface{}{arg1, arg2, arg3})
+	fake.resumeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ResumeDeploymentCallCount() int {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	return len(fake.resumeDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ResumeDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = stub
+}
+
+func (fake *FakeInternalContextClient) ResumeDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	argsForCall := fake.resumeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ResumeDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	fake.resumeDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ResumeDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	if fake.resumeDeploymentReturnsOnCall == nil {
+		fake.resumeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.resumeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey) error {
+	fake.retireActualLRPMutex.Lock()
+	ret, specificReturn := fake.retireActualLRPReturnsOnCall[len(fake.retireActualLRPArgsForCall)]
+	fake.retireActualLRPArgsForCall = append(fake.retireActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+	}{arg1, arg2, arg3})
+	stub := fake.RetireActualLRPStub
+	fakeReturns := fake.retireActualLRPReturns
+	fake.recordInvocation("RetireActualLRP", []interface{}{arg1, arg2, arg3})
+	fake.retireActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRPCallCount() int {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	return len(fake.retireActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey) error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey) {
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	argsForCall := fake.retireActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRPReturns(result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	fake.retireActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RetireActualLRPReturnsOnCall(i int, result1 error) {
+	fake.retireActualLRPMutex.Lock()
+	defer fake.retireActualLRPMutex.Unlock()
+	fake.RetireActualLRPStub = nil
+	if fake.retireActualLRPReturnsOnCall == nil {
+		fake.retireActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retireActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) RollbackDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) RollbackDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeInternalContextClient) RollbackDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) RollbackDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) RollbackDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasks(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.ScheduledTask, error) {
+	fake.scheduledTasksMutex.Lock()
+	ret, specificReturn := fake.scheduledTasksReturnsOnCall[len(fake.scheduledTasksArgsForCall)]
+	fake.scheduledTasksArgsForCall = append(fake.scheduledTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ScheduledTasksStub
+	fakeReturns := fake.scheduledTasksReturns
+	fake.recordInvocation("ScheduledTasks", []interface{}{arg1, arg2, arg3})
+	fake.scheduledTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasksCallCount() int {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	return len(fake.scheduledTasksArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasksCalls(stub func(context.Context, lager.Logger, string) ([]*models.ScheduledTask, error)) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = stub
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasksArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	argsForCall := fake.scheduledTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasksReturns(result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	fake.scheduledTasksReturns = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) ScheduledTasksReturnsOnCall(i int, result1 []*models.ScheduledTask, result2 error) {
+	fake.scheduledTasksMutex.Lock()
+	defer fake.scheduledTasksMutex.Unlock()
+	fake.ScheduledTasksStub = nil
+	if fake.scheduledTasksReturnsOnCall == nil {
+		fake.scheduledTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.scheduledTasksReturnsOnCall[i] = struct {
+		result1 []*models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuota(arg1 context.Context, arg2 lager.Logger, arg3 *models.DomainQuota) (*models.DomainQuota, error) {
+	fake.setDomainQuotaMutex.Lock()
+	ret, specificReturn := fake.setDomainQuotaReturnsOnCall[len(fake.setDomainQuotaArgsForCall)]
+	fake.setDomainQuotaArgsForCall = append(fake.setDomainQuotaArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.DomainQuota
+	}{arg1, arg2, arg3})
+	stub := fake.SetDomainQuotaStub
+	fakeReturns := fake.setDomainQuotaReturns
+	fake.recordInvocation("SetDomainQuota", []interface{}{arg1, arg2, arg3})
+	fake.setDomainQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuotaCallCount() int {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	return len(fake.setDomainQuotaArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuotaCalls(stub func(context.Context, lager.Logger, *models.DomainQuota) (*models.DomainQuota, error)) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = stub
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuotaArgsForCall(i int) (context.Context, lager.Logger, *models.DomainQuota) {
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	argsForCall := fake.setDomainQuotaArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuotaReturns(result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	fake.setDomainQuotaReturns = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SetDomainQuotaReturnsOnCall(i int, result1 *models.DomainQuota, result2 error) {
+	fake.setDomainQuotaMutex.Lock()
+	defer fake.setDomainQuotaMutex.Unlock()
+	fake.SetDomainQuotaStub = nil
+	if fake.setDomainQuotaReturnsOnCall == nil {
+		fake.setDomainQuotaReturnsOnCall = make(map[int]struct {
+			result1 *models.DomainQuota
+			result2 error
+		})
+	}
+	fake.setDomainQuotaReturnsOnCall[i] = struct {
+		result1 *models.DomainQuota
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) StartActualLRP(arg1 context.Context, arg2 lager.Logger, arg3 *models.ActualLRPKey, arg4 *models.ActualLRPInstanceKey, arg5 *models.ActualLRPNetInfo, arg6 []*models.ActualLRPInternalRoute, arg7 map[string]string, arg8 bool, arg9 string) error {
+	var arg6Copy []*models.ActualLRPInternalRoute
+	if arg6 != nil {
+		arg6Copy = make([]*models.ActualLRPInternalRoute, len(arg6))
+		copy(arg6Copy, arg6)
+	}
+	fake.startActualLRPMutex.Lock()
+	ret, specificReturn := fake.startActualLRPReturnsOnCall[len(fake.startActualLRPArgsForCall)]
+	fake.startActualLRPArgsForCall = append(fake.startActualLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 *models.ActualLRPKey
+		arg4 *models.ActualLRPInstanceKey
+		arg5 *models.ActualLRPNetInfo
+		arg6 []*models.ActualLRPInternalRoute
+		arg7 map[string]string
+		arg8 bool
+		arg9 string
+	}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	stub := fake.StartActualLRPStub
+	fakeReturns := fake.startActualLRPReturns
+	fake.recordInvocation("StartActualLRP", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6Copy, arg7, arg8, arg9})
+	fake.startActualLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6, arg7, arg8, arg9)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) StartActualLRPCallCount() int {
+	fake.startActualLRPMutex.RLock()
+	defer fake.startActualLRPMutex.RUnlock()
+	return len(fake.startActualLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) StartActualLRPCalls(stub func(context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) error) {
+	fake.startActualLRPMutex.Lock()
+	defer fake.startActualLRPMutex.Unlock()
+	fake.StartActualLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) StartActualLRPArgsForCall(i int) (context.Context, lager.Logger, *models.ActualLRPKey, *models.ActualLRPInstanceKey, *models.ActualLRPNetInfo, []*models.ActualLRPInternalRoute, map[string]string, bool, string) {
+	fake.startActualLRPMutex.RLock()
+	defer fake.startActualLRPMutex.RUnlock()
+	argsForCall := fake.startActualLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6, argsForCall.arg7, argsForCall.arg8, argsForCall.arg9
+}
+
+func (fake *FakeInternalContextClient) StartActualLRPReturns(result1 error) {
+	fake.startActualLRPMutex.Lock()
+	defer fake.startActualLRPMutex.Unlock()
+	fake.StartActualLRPStub = nil
+	fake.startActualLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) StartActualLRPReturnsOnCall(i int, result1 error) {
+	fake.startActualLRPMutex.Lock()
+	defer fake.startActualLRPMutex.Unlock()
+	fake.StartActualLRPStub = nil
+	if fake.startActualLRPReturnsOnCall == nil {
+		fake.startActualLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.startActualLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) StartDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) StartDeploymentCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeInternalContextClient) StartDeploymentArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeInternalContextClient) StartDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) StartDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) StartTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) (bool, error) {
+	fake.startTaskMutex.Lock()
+	ret, specificReturn := fake.startTaskReturnsOnCall[len(fake.startTaskArgsForCall)]
+	fake.startTaskArgsForCall = append(fake.startTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.StartTaskStub
+	fakeReturns := fake.startTaskReturns
+	fake.recordInvocation("StartTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.startTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) StartTaskCallCount() int {
+	fake.startTaskMutex.RLock()
+	defer fake.startTaskMutex.RUnlock()
+	return len(fake.startTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) StartTaskCalls(stub func(context.Context, lager.Logger, string, string) (bool, error)) {
+	fake.startTaskMutex.Lock()
+	defer fake.startTaskMutex.Unlock()
+	fake.StartTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) StartTaskArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+	fake.startTaskMutex.RLock()
+	defer fake.startTaskMutex.RUnlock()
+	argsForCall := fake.startTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) StartTaskReturns(result1 bool, result2 error) {
+	fake.startTaskMutex.Lock()
+	defer fake.startTaskMutex.Unlock()
+	fake.StartTaskStub = nil
+	fake.startTaskReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) StartTaskReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.startTaskMutex.Lock()
+	defer fake.startTaskMutex.Unlock()
+	fake.StartTaskStub = nil
+	if fake.startTaskReturnsOnCall == nil {
+		fake.startTaskReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.startTaskReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToEventsReturnsOnCall[len(fake.subscribeToEventsArgsForCall)]
+	fake.subscribeToEventsArgsForCall = append(fake.subscribeToEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToEventsStub
+	fakeReturns := fake.subscribeToEventsReturns
+	fake.recordInvocation("SubscribeToEvents", []interface{}{arg1, arg2})
+	fake.subscribeToEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsCallCount() int {
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	return len(fake.subscribeToEventsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = nil
+	fake.subscribeToEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsMutex.Lock()
+	defer fake.subscribeToEventsMutex.Unlock()
+	fake.SubscribeToEventsStub = nil
+	if fake.subscribeToEventsReturnsOnCall == nil {
+		fake.subscribeToEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) (events.EventSource, error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	ret, specificReturn := fake.subscribeToEventsByCellIDReturnsOnCall[len(fake.subscribeToEventsByCellIDArgsForCall)]
+	fake.subscribeToEventsByCellIDArgsForCall = append(fake.subscribeToEventsByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToEventsByCellIDStub
+	fakeReturns := fake.subscribeToEventsByCellIDReturns
+	fake.recordInvocation("SubscribeToEventsByCellID", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToEventsByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellIDCallCount() int {
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	return len(fake.subscribeToEventsByCellIDArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellIDCalls(stub func(context.Context, lager.Logger, string) (events.EventSource, error)) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	argsForCall := fake.subscribeToEventsByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellIDReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = nil
+	fake.subscribeToEventsByCellIDReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToEventsByCellIDReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToEventsByCellIDMutex.Lock()
+	defer fake.subscribeToEventsByCellIDMutex.Unlock()
+	fake.SubscribeToEventsByCellIDStub = nil
+	if fake.subscribeToEventsByCellIDReturnsOnCall == nil {
+		fake.subscribeToEventsByCellIDReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToEventsByCellIDReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsReturnsOnCall[len(fake.subscribeToInstanceEventsArgsForCall)]
+	fake.subscribeToInstanceEventsArgsForCall = append(fake.subscribeToInstanceEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToInstanceEventsStub
+	fakeReturns := fake.subscribeToInstanceEventsReturns
+	fake.recordInvocation("SubscribeToInstanceEvents", []interface{}{arg1, arg2})
+	fake.subscribeToInstanceEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsCallCount() int {
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = nil
+	fake.subscribeToInstanceEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsMutex.Lock()
+	defer fake.subscribeToInstanceEventsMutex.Unlock()
+	fake.SubscribeToInstanceEventsStub = nil
+	if fake.subscribeToInstanceEventsReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsByCellIDReturnsOnCall[len(fake.subscribeToInstanceEventsByCellIDArgsForCall)]
+	fake.subscribeToInstanceEventsByCellIDArgsForCall = append(fake.subscribeToInstanceEventsByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToInstanceEventsByCellIDStub
+	fakeReturns := fake.subscribeToInstanceEventsByCellIDReturns
+	fake.recordInvocation("SubscribeToInstanceEventsByCellID", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellIDCallCount() int {
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsByCellIDArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellIDCalls(stub func(context.Context, lager.Logger, string) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellIDReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = nil
+	fake.subscribeToInstanceEventsByCellIDReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsByCellIDReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsByCellIDMutex.Lock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.Unlock()
+	fake.SubscribeToInstanceEventsByCellIDStub = nil
+	if fake.subscribeToInstanceEventsByCellIDReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsByCellIDReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsByCellIDReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToInstanceEventsWithFilterReturnsOnCall[len(fake.subscribeToInstanceEventsWithFilterArgsForCall)]
+	fake.subscribeToInstanceEventsWithFilterArgsForCall = append(fake.subscribeToInstanceEventsWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToInstanceEventsWithFilterStub
+	fakeReturns := fake.subscribeToInstanceEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToInstanceEventsWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilterCallCount() int {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToInstanceEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilterCalls(stub func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.EventFilter) {
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToInstanceEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	fake.subscribeToInstanceEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToInstanceEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToInstanceEventsWithFilterMutex.Lock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.Unlock()
+	fake.SubscribeToInstanceEventsWithFilterStub = nil
+	if fake.subscribeToInstanceEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToInstanceEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToInstanceEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEvents(arg1 context.Context, arg2 lager.Logger) (events.EventSource, error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsReturnsOnCall[len(fake.subscribeToTaskEventsArgsForCall)]
+	fake.subscribeToTaskEventsArgsForCall = append(fake.subscribeToTaskEventsArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.SubscribeToTaskEventsStub
+	fakeReturns := fake.subscribeToTaskEventsReturns
+	fake.recordInvocation("SubscribeToTaskEvents", []interface{}{arg1, arg2})
+	fake.subscribeToTaskEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsCallCount() int {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsCalls(stub func(context.Context, lager.Logger) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = nil
+	fake.subscribeToTaskEventsReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsMutex.Lock()
+	defer fake.subscribeToTaskEventsMutex.Unlock()
+	fake.SubscribeToTaskEventsStub = nil
+	if fake.subscribeToTaskEventsReturnsOnCall == nil {
+		fake.subscribeToTaskEventsReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.EventFilter) (events.EventSource, error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	ret, specificReturn := fake.subscribeToTaskEventsWithFilterReturnsOnCall[len(fake.subscribeToTaskEventsWithFilterArgsForCall)]
+	fake.subscribeToTaskEventsWithFilterArgsForCall = append(fake.subscribeToTaskEventsWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.EventFilter
+	}{arg1, arg2, arg3})
+	stub := fake.SubscribeToTaskEventsWithFilterStub
+	fakeReturns := fake.subscribeToTaskEventsWithFilterReturns
+	fake.recordInvocation("SubscribeToTaskEventsWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilterCallCount() int {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	return len(fake.subscribeToTaskEventsWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilterCalls(stub func(context.Context, lager.Logger, models.EventFilter) (events.EventSource, error)) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = stub
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.EventFilter) {
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	argsForCall := fake.subscribeToTaskEventsWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilterReturns(result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	fake.subscribeToTaskEventsWithFilterReturns = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SubscribeToTaskEventsWithFilterReturnsOnCall(i int, result1 events.EventSource, result2 error) {
+	fake.subscribeToTaskEventsWithFilterMutex.Lock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.Unlock()
+	fake.SubscribeToTaskEventsWithFilterStub = nil
+	if fake.subscribeToTaskEventsWithFilterReturnsOnCall == nil {
+		fake.subscribeToTaskEventsWithFilterReturnsOnCall = make(map[int]struct {
+			result1 events.EventSource
+			result2 error
+		})
+	}
+	fake.subscribeToTaskEventsWithFilterReturnsOnCall[i] = struct {
+		result1 events.EventSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 bool) (*models.ScheduledTask, error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.suspendScheduledTaskReturnsOnCall[len(fake.suspendScheduledTaskArgsForCall)]
+	fake.suspendScheduledTaskArgsForCall = append(fake.suspendScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 bool
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.SuspendScheduledTaskStub
+	fakeReturns := fake.suspendScheduledTaskReturns
+	fake.recordInvocation("SuspendScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.suspendScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTaskCallCount() int {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	return len(fake.suspendScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTaskCalls(stub func(context.Context, lager.Logger, string, bool) (*models.ScheduledTask, error)) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, bool) {
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	argsForCall := fake.suspendScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	fake.suspendScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) SuspendScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.suspendScheduledTaskMutex.Lock()
+	defer fake.suspendScheduledTaskMutex.Unlock()
+	fake.SuspendScheduledTaskStub = nil
+	if fake.suspendScheduledTaskReturnsOnCall == nil {
+		fake.suspendScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.suspendScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TaskByGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Task, error) {
+	fake.taskByGuidMutex.Lock()
+	ret, specificReturn := fake.taskByGuidReturnsOnCall[len(fake.taskByGuidArgsForCall)]
+	fake.taskByGuidArgsForCall = append(fake.taskByGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TaskByGuidStub
+	fakeReturns := fake.taskByGuidReturns
+	fake.recordInvocation("TaskByGuid", []interface{}{arg1, arg2, arg3})
+	fake.taskByGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TaskByGuidCallCount() int {
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	return len(fake.taskByGuidArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TaskByGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Task, error)) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = stub
+}
+
+func (fake *FakeInternalContextClient) TaskByGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	argsForCall := fake.taskByGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TaskByGuidReturns(result1 *models.Task, result2 error) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = nil
+	fake.taskByGuidReturns = struct {
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TaskByGuidReturnsOnCall(i int, result1 *models.Task, result2 error) {
+	fake.taskByGuidMutex.Lock()
+	defer fake.taskByGuidMutex.Unlock()
+	fake.TaskByGuidStub = nil
+	if fake.taskByGuidReturnsOnCall == nil {
+		fake.taskByGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Task
+			result2 error
+		})
+	}
+	fake.taskByGuidReturnsOnCall[i] = struct {
+		result1 *models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacks(arg1 context.Context, arg2 lager.Logger, arg3 bool) ([]*models.TaskCallback, error) {
+	fake.taskCallbacksMutex.Lock()
+	ret, specificReturn := fake.taskCallbacksReturnsOnCall[len(fake.taskCallbacksArgsForCall)]
+	fake.taskCallbacksArgsForCall = append(fake.taskCallbacksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 bool
+	}{arg1, arg2, arg3})
+	stub := fake.TaskCallbacksStub
+	fakeReturns := fake.taskCallbacksReturns
+	fake.recordInvocation("TaskCallbacks", []interface{}{arg1, arg2, arg3})
+	fake.taskCallbacksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacksCallCount() int {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	return len(fake.taskCallbacksArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacksCalls(stub func(context.Context, lager.Logger, bool) ([]*models.TaskCallback, error)) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = stub
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacksArgsForCall(i int) (context.Context, lager.Logger, bool) {
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	argsForCall := fake.taskCallbacksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacksReturns(result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	fake.taskCallbacksReturns = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TaskCallbacksReturnsOnCall(i int, result1 []*models.TaskCallback, result2 error) {
+	fake.taskCallbacksMutex.Lock()
+	defer fake.taskCallbacksMutex.Unlock()
+	fake.TaskCallbacksStub = nil
+	if fake.taskCallbacksReturnsOnCall == nil {
+		fake.taskCallbacksReturnsOnCall = make(map[int]struct {
+			result1 []*models.TaskCallback
+			result2 error
+		})
+	}
+	fake.taskCallbacksReturnsOnCall[i] = struct {
+		result1 []*models.TaskCallback
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) Tasks(arg1 context.Context, arg2 lager.Logger) ([]*models.Task, error) {
+	fake.tasksMutex.Lock()
+	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
+	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.TasksStub
+	fakeReturns := fake.tasksReturns
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2})
+	fake.tasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TasksCallCount() int {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	return len(fake.tasksArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksCalls(stub func(context.Context, lager.Logger) ([]*models.Task, error)) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	argsForCall := fake.tasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeInternalContextClient) TasksReturns(result1 []*models.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	fake.tasksReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksMutex.Lock()
+	defer fake.tasksMutex.Unlock()
+	fake.TasksStub = nil
+	if fake.tasksReturnsOnCall == nil {
+		fake.tasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksByCellID(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByCellIDMutex.Lock()
+	ret, specificReturn := fake.tasksByCellIDReturnsOnCall[len(fake.tasksByCellIDArgsForCall)]
+	fake.tasksByCellIDArgsForCall = append(fake.tasksByCellIDArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByCellIDStub
+	fakeReturns := fake.tasksByCellIDReturns
+	fake.recordInvocation("TasksByCellID", []interface{}{arg1, arg2, arg3})
+	fake.tasksByCellIDMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TasksByCellIDCallCount() int {
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	return len(fake.tasksByCellIDArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksByCellIDCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksByCellIDArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	argsForCall := fake.tasksByCellIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TasksByCellIDReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = nil
+	fake.tasksByCellIDReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksByCellIDReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByCellIDMutex.Lock()
+	defer fake.tasksByCellIDMutex.Unlock()
+	fake.TasksByCellIDStub = nil
+	if fake.tasksByCellIDReturnsOnCall == nil {
+		fake.tasksByCellIDReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByCellIDReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksByDomain(arg1 context.Context, arg2 lager.Logger, arg3 string) ([]*models.Task, error) {
+	fake.tasksByDomainMutex.Lock()
+	ret, specificReturn := fake.tasksByDomainReturnsOnCall[len(fake.tasksByDomainArgsForCall)]
+	fake.tasksByDomainArgsForCall = append(fake.tasksByDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.TasksByDomainStub
+	fakeReturns := fake.tasksByDomainReturns
+	fake.recordInvocation("TasksByDomain", []interface{}{arg1, arg2, arg3})
+	fake.tasksByDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TasksByDomainCallCount() int {
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	return len(fake.tasksByDomainArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksByDomainCalls(stub func(context.Context, lager.Logger, string) ([]*models.Task, error)) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksByDomainArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	argsForCall := fake.tasksByDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TasksByDomainReturns(result1 []*models.Task, result2 error) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = nil
+	fake.tasksByDomainReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksByDomainReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksByDomainMutex.Lock()
+	defer fake.tasksByDomainMutex.Unlock()
+	fake.TasksByDomainStub = nil
+	if fake.tasksByDomainReturnsOnCall == nil {
+		fake.tasksByDomainReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksByDomainReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksPage(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, string, error) {
+	fake.tasksPageMutex.Lock()
+	ret, specificReturn := fake.tasksPageReturnsOnCall[len(fake.tasksPageArgsForCall)]
+	fake.tasksPageArgsForCall = append(fake.tasksPageArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksPageStub
+	fakeReturns := fake.tasksPageReturns
+	fake.recordInvocation("TasksPage", []interface{}{arg1, arg2, arg3})
+	fake.tasksPageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) TasksPageCallCount() int {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	return len(fake.tasksPageArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksPageCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, string, error)) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksPageArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	argsForCall := fake.tasksPageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TasksPageReturns(result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	fake.tasksPageReturns = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) TasksPageReturnsOnCall(i int, result1 []*models.Task, result2 string, result3 error) {
+	fake.tasksPageMutex.Lock()
+	defer fake.tasksPageMutex.Unlock()
+	fake.TasksPageStub = nil
+	if fake.tasksPageReturnsOnCall == nil {
+		fake.tasksPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 string
+			result3 error
+		})
+	}
+	fake.tasksPageReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilter(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
+	fake.tasksWithFilterMutex.Lock()
+	ret, specificReturn := fake.tasksWithFilterReturnsOnCall[len(fake.tasksWithFilterArgsForCall)]
+	fake.tasksWithFilterArgsForCall = append(fake.tasksWithFilterArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksWithFilterStub
+	fakeReturns := fake.tasksWithFilterReturns
+	fake.recordInvocation("TasksWithFilter", []interface{}{arg1, arg2, arg3})
+	fake.tasksWithFilterMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilterCallCount() int {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	return len(fake.tasksWithFilterArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilterCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilterArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	argsForCall := fake.tasksWithFilterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilterReturns(result1 []*models.Task, result2 error) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = nil
+	fake.tasksWithFilterReturns = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksWithFilterReturnsOnCall(i int, result1 []*models.Task, result2 error) {
+	fake.tasksWithFilterMutex.Lock()
+	defer fake.tasksWithFilterMutex.Unlock()
+	fake.TasksWithFilterStub = nil
+	if fake.tasksWithFilterReturnsOnCall == nil {
+		fake.tasksWithFilterReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 error
+		})
+	}
+	fake.tasksWithFilterReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersion(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, uint64, error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	ret, specificReturn := fake.tasksWithResourceVersionReturnsOnCall[len(fake.tasksWithResourceVersionArgsForCall)]
+	fake.tasksWithResourceVersionArgsForCall = append(fake.tasksWithResourceVersionArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.TasksWithResourceVersionStub
+	fakeReturns := fake.tasksWithResourceVersionReturns
+	fake.recordInvocation("TasksWithResourceVersion", []interface{}{arg1, arg2, arg3})
+	fake.tasksWithResourceVersionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersionCallCount() int {
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	return len(fake.tasksWithResourceVersionArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersionCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, uint64, error)) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = stub
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersionArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	argsForCall := fake.tasksWithResourceVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersionReturns(result1 []*models.Task, result2 uint64, result3 error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = nil
+	fake.tasksWithResourceVersionReturns = struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) TasksWithResourceVersionReturnsOnCall(i int, result1 []*models.Task, result2 uint64, result3 error) {
+	fake.tasksWithResourceVersionMutex.Lock()
+	defer fake.tasksWithResourceVersionMutex.Unlock()
+	fake.TasksWithResourceVersionStub = nil
+	if fake.tasksWithResourceVersionReturnsOnCall == nil {
+		fake.tasksWithResourceVersionReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.tasksWithResourceVersionReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeInternalContextClient) UncordonCell(arg1 context.Context, arg2 lager.Logger, arg3 string) error {
+	fake.uncordonCellMutex.Lock()
+	ret, specificReturn := fake.uncordonCellReturnsOnCall[len(fake.uncordonCellArgsForCall)]
+	fake.uncordonCellArgsForCall = append(fake.uncordonCellArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.UncordonCellStub
+	fakeReturns := fake.uncordonCellReturns
+	fake.recordInvocation("UncordonCell", []interface{}{arg1, arg2, arg3})
+	fake.uncordonCellMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) UncordonCellCallCount() int {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	return len(fake.uncordonCellArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) UncordonCellCalls(stub func(context.Context, lager.Logger, string) error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = stub
+}
+
+func (fake *FakeInternalContextClient) UncordonCellArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	argsForCall := fake.uncordonCellArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInternalContextClient) UncordonCellReturns(result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	fake.uncordonCellReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) UncordonCellReturnsOnCall(i int, result1 error) {
+	fake.uncordonCellMutex.Lock()
+	defer fake.uncordonCellMutex.Unlock()
+	fake.UncordonCellStub = nil
+	if fake.uncordonCellReturnsOnCall == nil {
+		fake.uncordonCellReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonCellReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRP(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPUpdate) error {
+	fake.updateDesiredLRPMutex.Lock()
+	ret, specificReturn := fake.updateDesiredLRPReturnsOnCall[len(fake.updateDesiredLRPArgsForCall)]
+	fake.updateDesiredLRPArgsForCall = append(fake.updateDesiredLRPArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateDesiredLRPStub
+	fakeReturns := fake.updateDesiredLRPReturns
+	fake.recordInvocation("UpdateDesiredLRP", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateDesiredLRPMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRPCallCount() int {
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	return len(fake.updateDesiredLRPArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRPCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPUpdate) error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = stub
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRPArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPUpdate) {
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	argsForCall := fake.updateDesiredLRPArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRPReturns(result1 error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = nil
+	fake.updateDesiredLRPReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) UpdateDesiredLRPReturnsOnCall(i int, result1 error) {
+	fake.updateDesiredLRPMutex.Lock()
+	defer fake.updateDesiredLRPMutex.Unlock()
+	fake.UpdateDesiredLRPStub = nil
+	if fake.updateDesiredLRPReturnsOnCall == nil {
+		fake.updateDesiredLRPReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateDesiredLRPReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.ScheduledTaskUpdate) (*models.ScheduledTask, error) {
+	fake.updateScheduledTaskMutex.Lock()
+	ret, specificReturn := fake.updateScheduledTaskReturnsOnCall[len(fake.updateScheduledTaskArgsForCall)]
+	fake.updateScheduledTaskArgsForCall = append(fake.updateScheduledTaskArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.ScheduledTaskUpdate
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpdateScheduledTaskStub
+	fakeReturns := fake.updateScheduledTaskReturns
+	fake.recordInvocation("UpdateScheduledTask", []interface{}{arg1, arg2, arg3, arg4})
+	fake.updateScheduledTaskMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTaskCallCount() int {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	return len(fake.updateScheduledTaskArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTaskCalls(stub func(context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = stub
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTaskArgsForCall(i int) (context.Context, lager.Logger, string, *models.ScheduledTaskUpdate) {
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	argsForCall := fake.updateScheduledTaskArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTaskReturns(result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	fake.updateScheduledTaskReturns = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) UpdateScheduledTaskReturnsOnCall(i int, result1 *models.ScheduledTask, result2 error) {
+	fake.updateScheduledTaskMutex.Lock()
+	defer fake.updateScheduledTaskMutex.Unlock()
+	fake.UpdateScheduledTaskStub = nil
+	if fake.updateScheduledTaskReturnsOnCall == nil {
+		fake.updateScheduledTaskReturnsOnCall = make(map[int]struct {
+			result1 *models.ScheduledTask
+			result2 error
+		})
+	}
+	fake.updateScheduledTaskReturnsOnCall[i] = struct {
+		result1 *models.ScheduledTask
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeInternalContextClient) UpsertDomain(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 time.Duration) error {
+	fake.upsertDomainMutex.Lock()
+	ret, specificReturn := fake.upsertDomainReturnsOnCall[len(fake.upsertDomainArgsForCall)]
+	fake.upsertDomainArgsForCall = append(fake.upsertDomainArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 time.Duration
+	}{arg1, arg2, arg3, arg4})
+	stub := fake.UpsertDomainStub
+	fakeReturns := fake.upsertDomainReturns
+	fake.recordInvocation("UpsertDomain", []interface{}{arg1, arg2, arg3, arg4})
+	fake.upsertDomainMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeInternalContextClient) UpsertDomainCallCount() int {
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	return len(fake.upsertDomainArgsForCall)
+}
+
+func (fake *FakeInternalContextClient) UpsertDomainCalls(stub func(context.Context, lager.Logger, string, time.Duration) error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = stub
+}
+
+func (fake *FakeInternalContextClient) UpsertDomainArgsForCall(i int) (context.Context, lager.Logger, string, time.Duration) {
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	argsForCall := fake.upsertDomainArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeInternalContextClient) UpsertDomainReturns(result1 error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = nil
+	fake.upsertDomainReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) UpsertDomainReturnsOnCall(i int, result1 error) {
+	fake.upsertDomainMutex.Lock()
+	defer fake.upsertDomainMutex.Unlock()
+	fake.UpsertDomainStub = nil
+	if fake.upsertDomainReturnsOnCall == nil {
+		fake.upsertDomainReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.upsertDomainReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInternalContextClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.actualLRPGroupByProcessGuidAndIndexMutex.RLock()
+	defer fake.actualLRPGroupByProcessGuidAndIndexMutex.RUnlock()
+	fake.actualLRPGroupsMutex.RLock()
+	defer fake.actualLRPGroupsMutex.RUnlock()
+	fake.actualLRPGroupsByProcessGuidMutex.RLock()
+	defer fake.actualLRPGroupsByProcessGuidMutex.RUnlock()
+	fake.actualLRPHistoryMutex.RLock()
+	defer fake.actualLRPHistoryMutex.RUnlock()
+	fake.actualLRPsMutex.RLock()
+	defer fake.actualLRPsMutex.RUnlock()
+	fake.actualLRPsByProcessGuidsMutex.RLock()
+	defer fake.actualLRPsByProcessGuidsMutex.RUnlock()
+	fake.actualLRPsPageMutex.RLock()
+	defer fake.actualLRPsPageMutex.RUnlock()
+	fake.actualLRPsWithResourceVersionMutex.RLock()
+	defer fake.actualLRPsWithResourceVersionMutex.RUnlock()
+	fake.auditRecordsPageMutex.RLock()
+	defer fake.auditRecordsPageMutex.RUnlock()
+	fake.cancelTaskMutex.RLock()
+	defer fake.cancelTaskMutex.RUnlock()
+	fake.cellDrainsMutex.RLock()
+	defer fake.cellDrainsMutex.RUnlock()
+	fake.cellsMutex.RLock()
+	defer fake.cellsMutex.RUnlock()
+	fake.claimActualLRPMutex.RLock()
+	defer fake.claimActualLRPMutex.RUnlock()
+	fake.completeTaskMutex.RLock()
+	defer fake.completeTaskMutex.RUnlock()
+	fake.cordonCellMutex.RLock()
+	defer fake.cordonCellMutex.RUnlock()
+	fake.crashActualLRPMutex.RLock()
+	defer fake.crashActualLRPMutex.RUnlock()
+	fake.crashStormBreakersMutex.RLock()
+	defer fake.crashStormBreakersMutex.RUnlock()
+	fake.deleteScheduledTaskMutex.RLock()
+	defer fake.deleteScheduledTaskMutex.RUnlock()
+	fake.deleteTaskMutex.RLock()
+	defer fake.deleteTaskMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	fake.desireLRPMutex.RLock()
+	defer fake.desireLRPMutex.RUnlock()
+	fake.desireScheduledTaskMutex.RLock()
+	defer fake.desireScheduledTaskMutex.RUnlock()
+	fake.desireTaskMutex.RLock()
+	defer fake.desireTaskMutex.RUnlock()
+	fake.desiredLRPByProcessGuidMutex.RLock()
+	defer fake.desiredLRPByProcessGuidMutex.RUnlock()
+	fake.desiredLRPRoutingInfosMutex.RLock()
+	defer fake.desiredLRPRoutingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfoByProcessGuidMutex.RLock()
+	defer fake.desiredLRPSchedulingInfoByProcessGuidMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosMutex.RUnlock()
+	fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPSchedulingInfosWithResourceVersionMutex.RUnlock()
+	fake.desiredLRPsMutex.RLock()
+	defer fake.desiredLRPsMutex.RUnlock()
+	fake.desiredLRPsPageMutex.RLock()
+	defer fake.desiredLRPsPageMutex.RUnlock()
+	fake.desiredLRPsWithResourceVersionMutex.RLock()
+	defer fake.desiredLRPsWithResourceVersionMutex.RUnlock()
+	fake.domainQuotasMutex.RLock()
+	defer fake.domainQuotasMutex.RUnlock()
+	fake.domainUsageMutex.RLock()
+	defer fake.domainUsageMutex.RUnlock()
+	fake.domainsMutex.RLock()
+	defer fake.domainsMutex.RUnlock()
+	fake.drainCellMutex.RLock()
+	defer fake.drainCellMutex.RUnlock()
+	fake.evacuateClaimedActualLRPMutex.RLock()
+	defer fake.evacuateClaimedActualLRPMutex.RUnlock()
+	fake.evacuateCrashedActualLRPMutex.RLock()
+	defer fake.evacuateCrashedActualLRPMutex.RUnlock()
+	fake.evacuateRunningActualLRPMutex.RLock()
+	defer fake.evacuateRunningActualLRPMutex.RUnlock()
+	fake.evacuateStoppedActualLRPMutex.RLock()
+	defer fake.evacuateStoppedActualLRPMutex.RUnlock()
+	fake.failActualLRPMutex.RLock()
+	defer fake.failActualLRPMutex.RUnlock()
+	fake.failTaskMutex.RLock()
+	defer fake.failTaskMutex.RUnlock()
+	fake.overloadStatusMutex.RLock()
+	defer fake.overloadStatusMutex.RUnlock()
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	fake.pingMutex.RLock()
+	defer fake.pingMutex.RUnlock()
+	fake.rejectTaskMutex.RLock()
+	defer fake.rejectTaskMutex.RUnlock()
+	fake.removeActualLRPMutex.RLock()
+	defer fake.removeActualLRPMutex.RUnlock()
+	fake.removeDesiredLRPMutex.RLock()
+	defer fake.removeDesiredLRPMutex.RUnlock()
+	fake.removeDomainQuotaMutex.RLock()
+	defer fake.removeDomainQuotaMutex.RUnlock()
+	fake.removeEvacuatingActualLRPMutex.RLock()
+	defer fake.removeEvacuatingActualLRPMutex.RUnlock()
+	fake.replayTaskCallbackMutex.RLock()
+	defer fake.replayTaskCallbackMutex.RUnlock()
+	fake.resetCrashStormBreakerMutex.RLock()
+	defer fake.resetCrashStormBreakerMutex.RUnlock()
+	fake.resolvingTaskMutex.RLock()
+	defer fake.resolvingTaskMutex.RUnlock()
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	fake.retireActualLRPMutex.RLock()
+	defer fake.retireActualLRPMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.scheduledTasksMutex.RLock()
+	defer fake.scheduledTasksMutex.RUnlock()
+	fake.setDomainQuotaMutex.RLock()
+	defer fake.setDomainQuotaMutex.RUnlock()
+	fake.startActualLRPMutex.RLock()
+	defer fake.startActualLRPMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	fake.startTaskMutex.RLock()
+	defer fake.startTaskMutex.RUnlock()
+	fake.subscribeToEventsMutex.RLock()
+	defer fake.subscribeToEventsMutex.RUnlock()
+	fake.subscribeToEventsByCellIDMutex.RLock()
+	defer fake.subscribeToEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsMutex.RLock()
+	defer fake.subscribeToInstanceEventsMutex.RUnlock()
+	fake.subscribeToInstanceEventsByCellIDMutex.RLock()
+	defer fake.subscribeToInstanceEventsByCellIDMutex.RUnlock()
+	fake.subscribeToInstanceEventsWithFilterMutex.RLock()
+	defer fake.subscribeToInstanceEventsWithFilterMutex.RUnlock()
+	fake.subscribeToTaskEventsMutex.RLock()
+	defer fake.subscribeToTaskEventsMutex.RUnlock()
+	fake.subscribeToTaskEventsWithFilterMutex.RLock()
+	defer fake.subscribeToTaskEventsWithFilterMutex.RUnlock()
+	fake.suspendScheduledTaskMutex.RLock()
+	defer fake.suspendScheduledTaskMutex.RUnlock()
+	fake.taskByGuidMutex.RLock()
+	defer fake.taskByGuidMutex.RUnlock()
+	fake.taskCallbacksMutex.RLock()
+	defer fake.taskCallbacksMutex.RUnlock()
+	fake.tasksMutex.RLock()
+	defer fake.tasksMutex.RUnlock()
+	fake.tasksByCellIDMutex.RLock()
+	defer fake.tasksByCellIDMutex.RUnlock()
+	fake.tasksByDomainMutex.RLock()
+	defer fake.tasksByDomainMutex.RUnlock()
+	fake.tasksPageMutex.RLock()
+	defer fake.tasksPageMutex.RUnlock()
+	fake.tasksWithFilterMutex.RLock()
+	defer fake.tasksWithFilterMutex.RUnlock()
+	fake.tasksWithResourceVersionMutex.RLock()
+	defer fake.tasksWithResourceVersionMutex.RUnlock()
+	fake.uncordonCellMutex.RLock()
+	defer fake.uncordonCellMutex.RUnlock()
+	fake.updateDesiredLRPMutex.RLock()
+	defer fake.updateDesiredLRPMutex.RUnlock()
+	fake.updateScheduledTaskMutex.RLock()
+	defer fake.updateScheduledTaskMutex.RUnlock()
+	fake.upsertDomainMutex.RLock()
+	defer fake.upsertDomainMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeInternalContextClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ bbs.InternalContextClient = new(FakeInternalContextClient)
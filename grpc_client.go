@@ -0,0 +1,355 @@
+package bbs
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/gogo/protobuf/proto"
+	"github.com/vito/go-sse/sse"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// LastEventIDMetadataKey is the gRPC metadata key of the ID of the last event
+// received on an event stream, the counterpart of the Last-Event-ID header.
+const LastEventIDMetadataKey = "last-event-id"
+
+// RetryAfterMetadataKey is the gRPC trailer of throttled and shed calls
+// telling the client how many seconds to wait before retrying.
+const RetryAfterMetadataKey = "retry-after"
+
+// IdempotencyKeyMetadataKey is the gRPC metadata key of the idempotency key of
+// a call, the counterpart of the Idempotency-Key header.
+const IdempotencyKeyMetadataKey = "idempotency-key"
+
+// grpcMethods maps the routes used by the client to the methods of the BBS
+// gRPC service. Routes that are not served over gRPC, such as the r0 routes
+// the client falls back to on older servers, are missing.
+var grpcMethods = map[string]string{
+	PingRoute_r0: "/models.BBS/Ping",
+
+	DomainsRoute_r0:      "/models.BBS/Domains",
+	UpsertDomainRoute_r0: "/models.BBS/UpsertDomain",
+
+	DomainQuotasRoute_r0:      "/models.BBS/DomainQuotas",
+	SetDomainQuotaRoute_r0:    "/models.BBS/SetDomainQuota",
+	RemoveDomainQuotaRoute_r0: "/models.BBS/RemoveDomainQuota",
+	DomainUsageRoute_r0:       "/models.BBS/DomainUsage",
+
+	ActualLRPsRoute_r0:                          "/models.BBS/ActualLRPs",
+	ActualLRPsByProcessGuidsRoute_r0:            "/models.BBS/ActualLRPsByProcessGuids",
+	ActualLRPGroupsRoute_r0:                     "/models.BBS/ActualLRPGroups",
+	ActualLRPGroupsByProcessGuidRoute_r0:        "/models.BBS/ActualLRPGroupsByProcessGuid",
+	ActualLRPGroupByProcessGuidAndIndexRoute_r0: "/models.BBS/ActualLRPGroupByProcessGuidAndIndex",
+	ActualLRPHistoryRoute_r0:                    "/models.BBS/ActualLRPHistory",
+
+	ClaimActualLRPRoute_r0:  "/models.BBS/ClaimActualLRP",
+	StartActualLRPRoute_r1:  "/models.BBS/StartActualLRP",
+	CrashActualLRPRoute_r0:  "/models.BBS/CrashActualLRP",
+	FailActualLRPRoute_r0:   "/models.BBS/FailActualLRP",
+	RemoveActualLRPRoute_r0: "/models.BBS/RemoveActualLRP",
+	RetireActualLRPRoute_r0: "/models.BBS/RetireActualLRP",
+
+	RemoveEvacuatingActualLRPRoute_r0: "/models.BBS/RemoveEvacuatingActualLRP",
+	EvacuateClaimedActualLRPRoute_r0:  "/models.BBS/EvacuateClaimedActualLRP",
+	EvacuateCrashedActualLRPRoute_r0:  "/models.BBS/EvacuateCrashedActualLRP",
+	EvacuateStoppedActualLRPRoute_r0:  "/models.BBS/EvacuateStoppedActualLRP",
+	EvacuateRunningActualLRPRoute_r1:  "/models.BBS/EvacuateRunningActualLRP",
+
+	DesiredLRPsRoute_r3:                      "/models.BBS/DesiredLRPs",
+	DesiredLRPByProcessGuidRoute_r3:          "/models.BBS/DesiredLRPByProcessGuid",
+	DesiredLRPSchedulingInfosRoute_r0:        "/models.BBS/DesiredLRPSchedulingInfos",
+	DesiredLRPSchedulingInfoByProcessGuid_r0: "/models.BBS/DesiredLRPSchedulingInfoByProcessGuid",
+	DesiredLRPRoutingInfosRoute_r0:           "/models.BBS/DesiredLRPRoutingInfos",
+	DesireDesiredLRPRoute_r2:                 "/models.BBS/DesireDesiredLRP",
+	UpdateDesiredLRPRoute_r0:                 "/models.BBS/UpdateDesiredLRP",
+	RemoveDesiredLRPRoute_r0:                 "/models.BBS/RemoveDesiredLRP",
+
+	StartDeploymentRoute_r0:         "/models.BBS/StartDeployment",
+	DeploymentByProcessGuidRoute_r0: "/models.BBS/DeploymentByProcessGuid",
+	PauseDeploymentRoute_r0:         "/models.BBS/PauseDeployment",
+	ResumeDeploymentRoute_r0:        "/models.BBS/ResumeDeployment",
+	RollbackDeploymentRoute_r0:      "/models.BBS/RollbackDeployment",
+
+	TasksRoute_r3:         "/models.BBS/Tasks",
+	TaskByGuidRoute_r3:    "/models.BBS/TaskByGuid",
+	DesireTaskRoute_r2:    "/models.BBS/DesireTask",
+	StartTaskRoute_r0:     "/models.BBS/StartTask",
+	CancelTaskRoute_r0:    "/models.BBS/CancelTask",
+	FailTaskRoute_r0:      "/models.BBS/FailTask",
+	RejectTaskRoute_r0:    "/models.BBS/RejectTask",
+	CompleteTaskRoute_r0:  "/models.BBS/CompleteTask",
+	ResolvingTaskRoute_r0: "/models.BBS/ResolvingTask",
+	DeleteTaskRoute_r0:    "/models.BBS/DeleteTask",
+
+	ScheduledTasksRoute_r0:       "/models.BBS/ScheduledTasks",
+	DesireScheduledTaskRoute_r0:  "/models.BBS/DesireScheduledTask",
+	UpdateScheduledTaskRoute_r0:  "/models.BBS/UpdateScheduledTask",
+	SuspendScheduledTaskRoute_r0: "/models.BBS/SuspendScheduledTask",
+	DeleteScheduledTaskRoute_r0:  "/models.BBS/DeleteScheduledTask",
+
+	TaskCallbacksRoute_r0:      "/models.BBS/TaskCallbacks",
+	ReplayTaskCallbackRoute_r0: "/models.BBS/ReplayTaskCallback",
+
+	AuditRecordsRoute_r0: "/models.BBS/AuditRecords",
+
+	OverloadStatusRoute_r0: "/models.BBS/OverloadStatus",
+
+	CrashStormBreakersRoute_r0:     "/models.BBS/CrashStormBreakers",
+	ResetCrashStormBreakerRoute_r0: "/models.BBS/ResetCrashStormBreaker",
+
+	LRPGroupEventStreamRoute_r1:    "/models.BBS/LRPGroupEvents",
+	LRPInstanceEventStreamRoute_r1: "/models.BBS/LRPInstanceEvents",
+	TaskEventStreamRoute_r1:        "/models.BBS/TaskEvents",
+
+	CellsRoute_r0:        "/models.BBS/Cells",
+	CordonCellRoute_r0:   "/models.BBS/CordonCell",
+	UncordonCellRoute_r0: "/models.BBS/UncordonCell",
+	DrainCellRoute_r0:    "/models.BBS/DrainCell",
+	CellDrainsRoute_r0:   "/models.BBS/CellDrains",
+}
+
+// grpcRoutes maps the methods of the BBS gRPC service to the routes they
+// serve.
+var grpcRoutes = func() map[string]string {
+	routes := make(map[string]string, len(grpcMethods))
+	for route, method := range grpcMethods {
+		routes[method] = route
+	}
+	return routes
+}()
+
+// GRPCRoute returns the route of the HTTP API that the full method of the
+// BBS gRPC service serves, so that the server applies the policies of the
+// route to its calls.
+func GRPCRoute(method string) (string, bool) {
+	route, ok := grpcRoutes[method]
+	return route, ok
+}
+
+// NewGRPCClient returns a client of the BBS gRPC API. The URL of the config
+// is the host:port address of the gRPC server; its TLS, retry and request
+// timeout settings apply as they do to the HTTP client.
+func NewGRPCClient(cfg ClientConfig) (InternalClient, error) {
+	contextClient, err := NewGRPCContextClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &traceIDClient{client: contextClient}, nil
+}
+
+// NewGRPCContextClient returns a ContextClient of the BBS gRPC API, set up
+// like the client returned by NewGRPCClient.
+func NewGRPCContextClient(cfg ClientConfig) (InternalContextClient, error) {
+	if cfg.Retries == 0 {
+		cfg.Retries = DefaultRetryCount
+	}
+
+	if cfg.RetryInterval == 0 {
+		cfg.RetryInterval = time.Second
+	}
+
+	if cfg.InsecureSkipVerify {
+		cfg.CAFile = ""
+	}
+
+	creds := insecure.NewCredentials()
+	if cfg.IsTLS {
+		tlsConfig, err := clientTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(cfg.URL, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		grpcConn:          conn,
+		requestTimeout:    cfg.RequestTimeout,
+		requestRetryCount: cfg.Retries,
+		retryInterval:     cfg.RetryInterval,
+	}, nil
+}
+
+func (c *client) doGRPCRequest(ctx context.Context, logger lager.Logger, requestName string, requestBody, responseBody proto.Message) error {
+	logger = logger.Session("do-grpc-request")
+
+	method, ok := grpcMethods[requestName]
+	if !ok {
+		return &RequestError{Route: requestName, Err: EndpointNotFoundErr}
+	}
+
+	ctx = withCallIdempotencyKey(ctx)
+	var err error
+	for attempts := 0; attempts < c.requestRetryCount; attempts++ {
+		logger.Debug("doing-request", lager.Data{"attempt": attempts + 1, "method": method})
+
+		start := time.Now().UnixNano()
+		err = c.invoke(ctx, method, requestBody, responseBody)
+		finish := time.Now().UnixNano()
+
+		if err != nil {
+			logger.Error("failed-doing-request", err)
+			if ctx.Err() != nil {
+				return &RequestError{Route: requestName, Err: ctx.Err()}
+			}
+			if attempts+1 < c.requestRetryCount {
+				if sleepErr := sleep(ctx, retryDelay(err)); sleepErr != nil {
+					return &RequestError{Route: requestName, Err: sleepErr}
+				}
+			}
+		} else {
+			logger.Debug("complete", lager.Data{"method": method, "duration_in_ns": finish - start})
+			break
+		}
+	}
+	if err != nil {
+		return &RequestError{Route: requestName, Err: err}
+	}
+	return nil
+}
+
+func (c *client) invoke(ctx context.Context, method string, request, response proto.Message) error {
+	ctx = metadata.AppendToOutgoingContext(ctx, trace.RequestIdHeader, trace.RequestIdFromContext(ctx))
+	for key, value := range trace.InjectMap(ctx) {
+		ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+	}
+	if key := IdempotencyKeyFromContext(ctx); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, IdempotencyKeyMetadataKey, key)
+	}
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+
+	var trailer metadata.MD
+	err := c.grpcConn.Invoke(ctx, method, request, response, grpc.Trailer(&trailer))
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.DeadlineExceeded:
+		return models.NewError(models.Error_Timeout, err.Error())
+	case codes.Unimplemented:
+		return EndpointNotFoundErr
+	case codes.PermissionDenied:
+		return models.ErrForbidden
+	case codes.ResourceExhausted:
+		return models.NewThrottledError(retryAfter(trailer))
+	case codes.Aborted:
+		return models.NewIdempotencyKeyInUseError(retryAfter(trailer))
+	case codes.FailedPrecondition:
+		return models.ErrIdempotencyKeyMismatch
+	case codes.Unavailable:
+		if len(trailer.Get(RetryAfterMetadataKey)) > 0 {
+			return models.NewOverloadedError(retryAfter(trailer))
+		}
+		return err
+	default:
+		return err
+	}
+}
+
+func (c *client) subscribeToGRPCEvents(ctx context.Context, route string, filter models.EventFilter) (events.EventSource, error) {
+	method, ok := grpcMethods[route]
+	if !ok {
+		return nil, EndpointNotFoundErr
+	}
+
+	request := models.NewEventsByCellId(filter)
+
+	connect := func(lastEventID string) (events.RawEventSource, error) {
+		ctx, cancel := context.WithCancel(ctx)
+		if lastEventID != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, LastEventIDMetadataKey, lastEventID)
+		}
+
+		stream, err := c.grpcConn.NewStream(ctx, &grpc.StreamDesc{ServerStreams: true}, method)
+		if err == nil {
+			err = stream.SendMsg(request)
+		}
+		if err == nil {
+			err = stream.CloseSend()
+		}
+		if err == nil {
+			err = waitForSubscription(stream)
+		}
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+
+		return &grpcEventSource{stream: stream, cancel: cancel}, nil
+	}
+
+	return events.NewResumableProtoEventSource(connect, c.retryInterval, uint16(c.requestRetryCount))
+}
+
+// waitForSubscription waits for the headers the server sends once it has
+// subscribed to the event hubs, returning the error the stream ended with
+// instead when there are none.
+func waitForSubscription(stream grpc.ClientStream) error {
+	header, err := stream.Header()
+	if err != nil {
+		return err
+	}
+
+	if header == nil {
+		err = stream.RecvMsg(&models.StreamedEvent{})
+		trailer := stream.Trailer()
+		switch {
+		case status.Code(err) == codes.ResourceExhausted:
+			return models.NewThrottledError(retryAfter(trailer))
+		case status.Code(err) == codes.Unavailable && len(trailer.Get(RetryAfterMetadataKey)) > 0:
+			return models.NewOverloadedError(retryAfter(trailer))
+		}
+		return err
+	}
+
+	return nil
+}
+
+// retryAfter returns how long the trailer of a throttled or shed call tells
+// the client to wait before retrying.
+func retryAfter(trailer metadata.MD) time.Duration {
+	seconds := 0
+	if values := trailer.Get(RetryAfterMetadataKey); len(values) > 0 {
+		seconds, _ = strconv.Atoi(values[0])
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// grpcEventSource reads the events of a gRPC event stream as raw events,
+// leaving their payload as is.
+type grpcEventSource struct {
+	stream grpc.ClientStream
+	cancel context.CancelFunc
+}
+
+func (s *grpcEventSource) Next() (sse.Event, error) {
+	event := &models.StreamedEvent{}
+	err := s.stream.RecvMsg(event)
+	if err != nil {
+		return sse.Event{}, err
+	}
+
+	return sse.Event{ID: event.Id, Name: event.Type, Data: event.Payload}, nil
+}
+
+func (s *grpcEventSource) Close() error {
+	s.cancel()
+	return nil
+}
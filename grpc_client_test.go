@@ -0,0 +1,230 @@
+package bbs_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type fakeGRPCServer struct {
+	models.UnimplementedBBSServer
+
+	traceIDs    chan string
+	deadlines   chan time.Time
+	lastEventID chan string
+	taskEvents  chan *models.StreamedEvent
+}
+
+func (s *fakeGRPCServer) Tasks(ctx context.Context, request *models.TasksRequest) (*models.TasksResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.traceIDs <- first(md.Get(trace.RequestIdHeader))
+	return &models.TasksResponse{Tasks: []*models.Task{{TaskGuid: "task-guid"}}}, nil
+}
+
+func (s *fakeGRPCServer) TaskByGuid(ctx context.Context, request *models.TaskByGuidRequest) (*models.TaskResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.traceIDs <- first(md.Get(trace.RequestIdHeader))
+	deadline, _ := ctx.Deadline()
+	s.deadlines <- deadline
+	return &models.TaskResponse{Error: models.ErrResourceNotFound}, nil
+}
+
+func (s *fakeGRPCServer) DeleteTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	return nil, status.Error(codes.PermissionDenied, "Forbidden")
+}
+
+func (s *fakeGRPCServer) CancelTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	err := grpc.SetTrailer(ctx, metadata.Pairs(bbs.RetryAfterMetadataKey, "2"))
+	if err != nil {
+		return nil, err
+	}
+	return nil, status.Error(codes.ResourceExhausted, "Too Many Requests")
+}
+
+func (s *fakeGRPCServer) ResolvingTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	err := grpc.SetTrailer(ctx, metadata.Pairs(bbs.RetryAfterMetadataKey, "5"))
+	if err != nil {
+		return nil, err
+	}
+	return nil, status.Error(codes.Unavailable, "Service Unavailable")
+}
+
+func (s *fakeGRPCServer) TaskEvents(request *models.EventsByCellId, stream models.BBS_TaskEventsServer) error {
+	md, _ := metadata.FromIncomingContext(stream.Context())
+	s.lastEventID <- first(md.Get(bbs.LastEventIDMetadataKey))
+
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case event := <-s.taskEvents:
+			err := stream.Send(event)
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+var _ = Describe("gRPC Client", func() {
+	var (
+		fakeServer *fakeGRPCServer
+		grpcServer *grpc.Server
+		cfg        bbs.ClientConfig
+		client     bbs.InternalClient
+		logger     lager.Logger
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("bbs-client")
+		fakeServer = &fakeGRPCServer{
+			traceIDs:    make(chan string, 1),
+			deadlines:   make(chan time.Time, 1),
+			lastEventID: make(chan string, 2),
+			taskEvents:  make(chan *models.StreamedEvent, 1),
+		}
+
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+
+		grpcServer = grpc.NewServer()
+		models.RegisterBBSServer(grpcServer, fakeServer)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcServer.Serve(listener)).To(Succeed())
+		}()
+
+		cfg = bbs.ClientConfig{
+			URL:           listener.Addr().String(),
+			Retries:       1,
+			RetryInterval: time.Millisecond,
+		}
+		client, err = bbs.NewGRPCClient(cfg)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		grpcServer.Stop()
+	})
+
+	It("maps every method of the gRPC service to the route it serves", func() {
+		service := grpcServer.GetServiceInfo()["models.BBS"]
+		Expect(service.Methods).NotTo(BeEmpty())
+		for _, method := range service.Methods {
+			route, ok := bbs.GRPCRoute("/models.BBS/" + method.Name)
+			Expect(ok).To(BeTrue(), method.Name)
+			_, found := bbs.Routes.FindRouteByName(route)
+			Expect(found).To(BeTrue(), method.Name)
+		}
+	})
+
+	It("calls the method of the gRPC service", func() {
+		tasks, err := client.Tasks(logger, "some-trace-id")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks).To(HaveLen(1))
+		Expect(tasks[0].TaskGuid).To(Equal("task-guid"))
+		Expect(fakeServer.traceIDs).To(Receive(Equal("some-trace-id")))
+	})
+
+	It("returns an endpoint not found error for methods the server does not implement", func() {
+		_, err := client.Domains(logger, "some-trace-id")
+		Expect(err).To(Equal(bbs.EndpointNotFoundErr))
+	})
+
+	It("returns a forbidden error for calls the server does not authorize", func() {
+		err := client.DeleteTask(logger, "some-trace-id", "task-guid")
+		Expect(err).To(Equal(models.ErrForbidden))
+	})
+
+	It("returns a throttled error with the retry hint for throttled calls", func() {
+		err := client.CancelTask(logger, "some-trace-id", "task-guid")
+		Expect(err).To(Equal(models.NewThrottledError(2 * time.Second)))
+	})
+
+	It("returns an overloaded error with the retry hint for shed calls", func() {
+		err := client.ResolvingTask(logger, "some-trace-id", "task-guid")
+		Expect(err).To(Equal(models.NewOverloadedError(5 * time.Second)))
+	})
+
+	It("returns the models error of failed calls", func() {
+		_, err := client.TaskByGuid(logger, "some-trace-id", "task-guid")
+		Expect(err).To(Equal(models.ErrResourceNotFound))
+	})
+
+	Context("when calling with a context", func() {
+		var contextClient bbs.InternalContextClient
+
+		BeforeEach(func() {
+			var err error
+			contextClient, err = bbs.NewGRPCContextClient(cfg)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("propagates the trace ID and deadline of the context", func() {
+			ctx, cancel := context.WithTimeout(trace.WithRequestId(context.Background(), "some-trace-id"), 10*time.Second)
+			defer cancel()
+			deadline, _ := ctx.Deadline()
+
+			_, err := contextClient.TaskByGuid(ctx, logger, "task-guid")
+			Expect(errors.Is(err, models.ErrResourceNotFound)).To(BeTrue())
+
+			var requestErr *bbs.RequestError
+			Expect(errors.As(err, &requestErr)).To(BeTrue())
+			Expect(requestErr.Route).To(Equal(bbs.TaskByGuidRoute_r3))
+
+			Expect(fakeServer.traceIDs).To(Receive(Equal("some-trace-id")))
+			Expect(fakeServer.deadlines).To(Receive(BeTemporally("~", deadline, time.Second)))
+		})
+
+		It("returns the error of a cancelled context", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := contextClient.Tasks(ctx, logger)
+			Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+		})
+	})
+
+	It("subscribes to event streams", func() {
+		eventSource, err := client.SubscribeToTaskEvents(logger)
+		Expect(err).NotTo(HaveOccurred())
+		defer eventSource.Close()
+
+		Expect(fakeServer.lastEventID).To(Receive(BeEmpty()))
+
+		payload, err := proto.Marshal(models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-guid", TaskDefinition: &models.TaskDefinition{}}))
+		Expect(err).NotTo(HaveOccurred())
+		fakeServer.taskEvents <- &models.StreamedEvent{Id: "1", Type: models.EventTypeTaskCreated, Payload: payload}
+
+		event, err := eventSource.Next()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(event).To(BeAssignableToTypeOf(&models.TaskCreatedEvent{}))
+		Expect(event.(*models.TaskCreatedEvent).Task.TaskGuid).To(Equal("task-guid"))
+	})
+})
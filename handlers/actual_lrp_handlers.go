@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/db"
@@ -31,13 +32,7 @@ func (h *ActualLRPHandler) ActualLRPs(logger lager.Logger, w http.ResponseWriter
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		var index *int32
-		if request.IndexExists() {
-			i := request.GetIndex()
-			index = &i
-		}
-		filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId, Index: index, ProcessGuid: request.ProcessGuid}
-		response.ActualLrps, err = h.db.ActualLRPs(req.Context(), logger, filter)
+		err = h.actualLRPs(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -46,6 +41,27 @@ func (h *ActualLRPHandler) ActualLRPs(logger lager.Logger, w http.ResponseWriter
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *ActualLRPHandler) actualLRPs(ctx context.Context, logger lager.Logger, request *models.ActualLRPsRequest, response *models.ActualLRPsResponse) error {
+	var index *int32
+	if request.IndexExists() {
+		i := request.GetIndex()
+		index = &i
+	}
+	filter := models.ActualLRPFilter{
+		Domain:      request.Domain,
+		CellID:      request.CellId,
+		Index:       index,
+		ProcessGuid: request.ProcessGuid,
+		PageSize:    request.PageSize,
+		PageToken:   request.PageToken,
+	}
+	actualLRPs, metadata, err := h.db.ListActualLRPs(ctx, logger, filter)
+	response.ActualLrps = actualLRPs
+	response.ResourceVersion = metadata.ResourceVersion
+	response.NextPageToken = metadata.NextPageToken
+	return err
+}
+
 func (h *ActualLRPHandler) ActualLRPsByProcessGuids(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("multiple-actual-lrps").WithTraceInfo(req)
@@ -57,8 +73,7 @@ func (h *ActualLRPHandler) ActualLRPsByProcessGuids(logger lager.Logger, w http.
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.ActualLRPsByProcessGuidsFilter{ProcessGuids: request.ProcessGuids}
-		response.ActualLrps, err = h.db.ActualLRPsByProcessGuids(req.Context(), logger, filter)
+		err = h.actualLRPsByProcessGuids(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -67,6 +82,13 @@ func (h *ActualLRPHandler) ActualLRPsByProcessGuids(logger lager.Logger, w http.
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *ActualLRPHandler) actualLRPsByProcessGuids(ctx context.Context, logger lager.Logger, request *models.ActualLRPsByProcessGuidsRequest, response *models.ActualLRPsByProcessGuidsResponse) error {
+	var err error
+	filter := models.ActualLRPsByProcessGuidsFilter{ProcessGuids: request.ProcessGuids}
+	response.ActualLrps, err = h.db.ActualLRPsByProcessGuids(ctx, logger, filter)
+	return err
+}
+
 // Deprecated: use ActaulLRPs instead
 func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
@@ -83,13 +105,18 @@ func (h *ActualLRPHandler) ActualLRPGroups(logger lager.Logger, w http.ResponseW
 		return
 	}
 
+	err = h.actualLRPGroups(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPHandler) actualLRPGroups(ctx context.Context, logger lager.Logger, request *models.ActualLRPGroupsRequest, response *models.ActualLRPGroupsResponse) error {
 	filter := models.ActualLRPFilter{Domain: request.Domain, CellID: request.CellId}
-	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
+	lrps, err := h.db.ActualLRPs(ctx, logger, filter)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 	response.ActualLrpGroups = models.ResolveActualLRPGroups(lrps)
+	return nil
 }
 
 // Deprecated: use ActaulLRPs instead
@@ -107,13 +134,18 @@ func (h *ActualLRPHandler) ActualLRPGroupsByProcessGuid(logger lager.Logger, w h
 		response.Error = models.ConvertError(err)
 		return
 	}
+	err = h.actualLRPGroupsByProcessGuid(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPHandler) actualLRPGroupsByProcessGuid(ctx context.Context, logger lager.Logger, request *models.ActualLRPGroupsByProcessGuidRequest, response *models.ActualLRPGroupsResponse) error {
 	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid}
-	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
+	lrps, err := h.db.ActualLRPs(ctx, logger, filter)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 	response.ActualLrpGroups = models.ResolveActualLRPGroups(lrps)
+	return nil
 }
 
 // Deprecated: use ActaulLRPs instead
@@ -131,16 +163,21 @@ func (h *ActualLRPHandler) ActualLRPGroupByProcessGuidAndIndex(logger lager.Logg
 		response.Error = models.ConvertError(err)
 		return
 	}
+	err = h.actualLRPGroupByProcessGuidAndIndex(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPHandler) actualLRPGroupByProcessGuidAndIndex(ctx context.Context, logger lager.Logger, request *models.ActualLRPGroupByProcessGuidAndIndexRequest, response *models.ActualLRPGroupResponse) error {
 	filter := models.ActualLRPFilter{ProcessGuid: request.ProcessGuid, Index: &request.Index}
-	lrps, err := h.db.ActualLRPs(req.Context(), logger, filter)
+	lrps, err := h.db.ActualLRPs(ctx, logger, filter)
 
 	if err == nil && len(lrps) == 0 {
 		err = models.ErrResourceNotFound
 	}
 
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 	response.ActualLrpGroup = models.ResolveActualLRPGroup(lrps)
+	return nil
 }
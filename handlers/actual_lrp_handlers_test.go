@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"strings"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
@@ -118,7 +119,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 				actualLRPs = []*models.ActualLRP{
 					&suspectLRP1, &actualLRP1, &actualLRP2, &evacuatingLRP2,
 				}
-				fakeActualLRPDB.ActualLRPsReturns(actualLRPs, nil)
+				fakeActualLRPDB.ListActualLRPsReturns(actualLRPs, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of actual lrps", func() {
@@ -133,8 +134,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("calls the DB with no filters to retrieve the actual lrp groups", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{}))
 				})
 			})
@@ -145,8 +146,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("calls the DB with the domain filter to retrieve the actual lrps", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{Domain: "domain-1"}))
 				})
 			})
@@ -157,8 +158,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("calls the DB with the cell id filter to retrieve the actual lrps ", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{CellID: "cellid-1"}))
 				})
 			})
@@ -169,12 +170,47 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("calls the DB with the process guid filter to retrieve the actual lrps", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.ActualLRPFilter{ProcessGuid: "process-guid-1"}))
 				})
 			})
 
+			Context("and paginating", func() {
+				var pageToken string
+
+				BeforeEach(func() {
+					pageToken = models.PageToken{ProcessGuid: "process-guid-0"}.Encode()
+					requestBody = &models.ActualLRPsRequest{PageSize: 4, PageToken: pageToken}
+				})
+
+				It("calls the DB with the page size and token", func() {
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
+					Expect(filter).To(Equal(models.ActualLRPFilter{PageSize: 4, PageToken: pageToken}))
+				})
+
+				It("does not return a next page token when the list is complete", func() {
+					response := models.ActualLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.NextPageToken).To(BeEmpty())
+				})
+
+				Context("when more actual lrps follow the page", func() {
+					BeforeEach(func() {
+						fakeActualLRPDB.ListActualLRPsReturns(actualLRPs, db.ListMetadata{NextPageToken: "next-page-token"}, nil)
+					})
+
+					It("returns the next page token of the list", func() {
+						response := models.ActualLRPsResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.NextPageToken).To(Equal("next-page-token"))
+					})
+				})
+			})
+
 			Context("and filtering by instance index", func() {
 				BeforeEach(func() {
 					req := &models.ActualLRPsRequest{}
@@ -183,8 +219,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("calls the DB with the index filter to retrieve the actual lrps", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter.Index).NotTo(BeNil())
 					Expect(*filter.Index).To(Equal(int32(1)))
 				})
@@ -202,8 +238,8 @@ var _ = Describe("ActualLRP Handlers", func() {
 				})
 
 				It("call the DB with all provided filters to retrieve the actual lrps", func() {
-					Expect(fakeActualLRPDB.ActualLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeActualLRPDB.ActualLRPsArgsForCall(0)
+					Expect(fakeActualLRPDB.ListActualLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeActualLRPDB.ListActualLRPsArgsForCall(0)
 					Expect(filter.Domain).To(Equal("potato"))
 					Expect(filter.CellID).To(Equal("cellid-1"))
 					Expect(filter.ProcessGuid).To(Equal("process-guid-0"))
@@ -215,7 +251,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB returns no actual lrps", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, nil)
+				fakeActualLRPDB.ListActualLRPsReturns([]*models.ActualLRP{}, db.ListMetadata{}, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -231,7 +267,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, models.NewUnrecoverableError(nil))
+				fakeActualLRPDB.ListActualLRPsReturns([]*models.ActualLRP{}, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -243,7 +279,7 @@ var _ = Describe("ActualLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeActualLRPDB.ActualLRPsReturns([]*models.ActualLRP{}, models.ErrUnknownError)
+				fakeActualLRPDB.ListActualLRPsReturns([]*models.ActualLRP{}, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -255,6 +291,21 @@ var _ = Describe("ActualLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the DB has a resource version", func() {
+			BeforeEach(func() {
+				fakeActualLRPDB.ListActualLRPsReturns(nil, db.ListMetadata{ResourceVersion: 42}, nil)
+			})
+
+			It("returns it along with the actual lrps", func() {
+				response := &models.ActualLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ResourceVersion).To(BeEquivalentTo(42))
+			})
+		})
 	})
 
 	Describe("ActualLRPsByProcessGuids", func() {
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type ActualLRPHistoryHandler struct {
+	db       db.ActualLRPHistoryDB
+	exitChan chan<- struct{}
+}
+
+func NewActualLRPHistoryHandler(db db.ActualLRPHistoryDB, exitChan chan<- struct{}) *ActualLRPHistoryHandler {
+	return &ActualLRPHistoryHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *ActualLRPHistoryHandler) ActualLRPHistory(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("actual-lrp-history").WithTraceInfo(req)
+
+	request := &models.ActualLRPHistoryRequest{}
+	response := &models.ActualLRPHistoryResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.actualLRPHistory(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPHistoryHandler) actualLRPHistory(ctx context.Context, logger lager.Logger, request *models.ActualLRPHistoryRequest, response *models.ActualLRPHistoryResponse) error {
+	var err error
+	response.Records, err = h.db.ActualLRPHistory(ctx, logger, request.ProcessGuid, request.Index)
+	return err
+}
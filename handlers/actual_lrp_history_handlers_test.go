@@ -0,0 +1,88 @@
+package handlers_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualLRPHistory Handlers", func() {
+	var (
+		logger    *lagertest.TestLogger
+		historyDB *dbfakes.FakeActualLRPHistoryDB
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ActualLRPHistoryHandler
+		exitCh           chan struct{}
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		historyDB = new(dbfakes.FakeActualLRPHistoryDB)
+		handler = handlers.NewActualLRPHistoryHandler(historyDB, exitCh)
+	})
+
+	Describe("ActualLRPHistory", func() {
+		var records []*models.ActualLRPHistoryRecord
+
+		BeforeEach(func() {
+			records = []*models.ActualLRPHistoryRecord{
+				{Id: 1, ProcessGuid: "process-guid", Index: 1, InstanceGuid: "instance-guid", CellId: "cell-1", Transition: models.ActualLRPHistoryRecord_Claimed},
+				{Id: 2, ProcessGuid: "process-guid", Index: 1, InstanceGuid: "instance-guid", CellId: "cell-1", Transition: models.ActualLRPHistoryRecord_Crashed, Reason: "boom", CrashCount: 1},
+			}
+			historyDB.ActualLRPHistoryReturns(records, nil)
+		})
+
+		It("returns the history of the instances at the index of the process", func() {
+			handler.ActualLRPHistory(logger, responseRecorder, newTestRequest(&models.ActualLRPHistoryRequest{
+				ProcessGuid: "process-guid",
+				Index:       1,
+			}))
+
+			Expect(historyDB.ActualLRPHistoryCallCount()).To(Equal(1))
+			_, _, processGuid, index := historyDB.ActualLRPHistoryArgsForCall(0)
+			Expect(processGuid).To(Equal("process-guid"))
+			Expect(index).To(BeEquivalentTo(1))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.ActualLRPHistoryResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Records).To(Equal(records))
+		})
+
+		It("rejects requests without a process guid", func() {
+			handler.ActualLRPHistory(logger, responseRecorder, newTestRequest(&models.ActualLRPHistoryRequest{Index: 1}))
+
+			Expect(historyDB.ActualLRPHistoryCallCount()).To(Equal(0))
+			response := &models.ActualLRPHistoryResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+
+		It("responds with the error of the DB", func() {
+			historyDB.ActualLRPHistoryReturns(nil, errors.New("boom"))
+			handler.ActualLRPHistory(logger, responseRecorder, newTestRequest(&models.ActualLRPHistoryRequest{ProcessGuid: "process-guid"}))
+
+			response := &models.ActualLRPHistoryResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.Type).To(Equal(models.Error_UnknownError))
+		})
+
+		It("responds with an unrecoverable error and exits", func() {
+			historyDB.ActualLRPHistoryReturns(nil, models.NewUnrecoverableError(nil))
+			handler.ActualLRPHistory(logger, responseRecorder, newTestRequest(&models.ActualLRPHistoryRequest{ProcessGuid: "process-guid"}))
+
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+})
@@ -62,10 +62,14 @@ func (h *ActualLRPLifecycleHandler) ClaimActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
-	err = h.controller.ClaimActualLRP(req.Context(), logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
+	err = h.claimActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *ActualLRPLifecycleHandler) claimActualLRP(ctx context.Context, logger lager.Logger, request *models.ClaimActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
+	return h.controller.ClaimActualLRP(ctx, logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
+}
+
 func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("start-actual-lrp").WithTraceInfo(req)
 	logger.Debug("starting")
@@ -82,14 +86,19 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP(logger lager.Logger, w http.R
 		response.Error = models.ConvertError(err)
 		return
 	}
+
+	err = h.startActualLRP(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPLifecycleHandler) startActualLRP(ctx context.Context, logger lager.Logger, request *models.StartActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
 	routable := true
 	if request.RoutableExists() {
 		r := request.GetRoutable()
 		routable = r
 	}
 
-	err = h.controller.StartActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo, request.ActualLrpInternalRoutes, request.MetricTags, routable, request.AvailabilityZone)
-	response.Error = models.ConvertError(err)
+	return h.controller.StartActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo, request.ActualLrpInternalRoutes, request.MetricTags, routable, request.AvailabilityZone)
 }
 
 func (h *ActualLRPLifecycleHandler) StartActualLRP_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -108,13 +117,10 @@ func (h *ActualLRPLifecycleHandler) StartActualLRP_r0(logger lager.Logger, w htt
 		response.Error = models.ConvertError(err)
 		return
 	}
-	routable := true
-	if request.RoutableExists() {
-		r := request.GetRoutable()
-		routable = r
-	}
+	request.ActualLrpInternalRoutes = []*models.ActualLRPInternalRoute{}
+	request.MetricTags = nil
 
-	err = h.controller.StartActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo, []*models.ActualLRPInternalRoute{}, nil, routable, request.AvailabilityZone)
+	err = h.startActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
@@ -134,11 +140,15 @@ func (h *ActualLRPLifecycleHandler) CrashActualLRP(logger lager.Logger, w http.R
 		return
 	}
 
+	err = h.crashActualLRP(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ActualLRPLifecycleHandler) crashActualLRP(ctx context.Context, logger lager.Logger, request *models.CrashActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
 	actualLRPKey := request.ActualLrpKey
 	actualLRPInstanceKey := request.ActualLrpInstanceKey
 
-	err = h.controller.CrashActualLRP(req.Context(), logger, actualLRPKey, actualLRPInstanceKey, request.ErrorMessage)
-	response.Error = models.ConvertError(err)
+	return h.controller.CrashActualLRP(ctx, logger, actualLRPKey, actualLRPInstanceKey, request.ErrorMessage)
 }
 
 func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -159,10 +169,14 @@ func (h *ActualLRPLifecycleHandler) FailActualLRP(logger lager.Logger, w http.Re
 		return
 	}
 
-	err = h.controller.FailActualLRP(req.Context(), logger, request.ActualLrpKey, request.ErrorMessage)
+	err = h.failActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *ActualLRPLifecycleHandler) failActualLRP(ctx context.Context, logger lager.Logger, request *models.FailActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
+	return h.controller.FailActualLRP(ctx, logger, request.ActualLrpKey, request.ErrorMessage)
+}
+
 func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("remove-actual-lrp").WithTraceInfo(req)
@@ -181,10 +195,14 @@ func (h *ActualLRPLifecycleHandler) RemoveActualLRP(logger lager.Logger, w http.
 		return
 	}
 
-	err = h.controller.RemoveActualLRP(req.Context(), logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
+	err = h.removeActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *ActualLRPLifecycleHandler) removeActualLRP(ctx context.Context, logger lager.Logger, request *models.RemoveActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
+	return h.controller.RemoveActualLRP(ctx, logger, request.ProcessGuid, request.Index, request.ActualLrpInstanceKey)
+}
+
 func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("retire-actual-lrp").WithTraceInfo(req)
 	logger.Debug("starting")
@@ -202,6 +220,10 @@ func (h *ActualLRPLifecycleHandler) RetireActualLRP(logger lager.Logger, w http.
 		return
 	}
 
-	err = h.controller.RetireActualLRP(trace.ContextWithRequestId(req), logger, request.ActualLrpKey)
+	err = h.retireActualLRP(trace.ContextWithRequestId(req), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *ActualLRPLifecycleHandler) retireActualLRP(ctx context.Context, logger lager.Logger, request *models.RetireActualLRPRequest, response *models.ActualLRPLifecycleResponse) error {
+	return h.controller.RetireActualLRP(ctx, logger, request.ActualLrpKey)
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"google.golang.org/grpc"
+)
+
+// AuditWrap makes the changes served by the handler be audited as made by
+// the call of the route, identified by the client certificate and trace ID
+// of the request.
+func AuditWrap(route string, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := auditCall(r.Context(), route, trace.RequestIdFromRequest(r), authorization.IdentityFromTLS(r.TLS), r.RemoteAddr)
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
+
+// AuditInterceptor makes the changes served by the calls to the gRPC API be
+// audited as AuditWrap does those of the requests to the HTTP API.
+func AuditInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		identity, remoteAddr := peerIdentity(ctx)
+		return handler(auditCall(ctx, route, trace.RequestIdFromContext(ctx), identity, remoteAddr), request)
+	}
+}
+
+// auditCall returns the context auditing the changes made within it as made
+// by the call of the route.
+func auditCall(ctx context.Context, route, traceID string, identity authorization.Identity, remoteAddr string) context.Context {
+	return audit.NewContext(ctx, audit.Call{
+		Route:               route,
+		TraceID:             traceID,
+		CommonName:          identity.CommonName,
+		OrganizationalUnits: identity.OrganizationalUnits,
+		RemoteAddr:          remoteAddr,
+	})
+}
+
+type AuditRecordHandler struct {
+	db       db.AuditRecordDB
+	exitChan chan<- struct{}
+}
+
+func NewAuditRecordHandler(db db.AuditRecordDB, exitChan chan<- struct{}) *AuditRecordHandler {
+	return &AuditRecordHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *AuditRecordHandler) AuditRecords(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("audit-records").WithTraceInfo(req)
+
+	request := &models.AuditRecordsRequest{}
+	response := &models.AuditRecordsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.auditRecords(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// auditRecords sets the audit records of the page the request asks for on
+// the response, along with the token of the page that follows.
+func (h *AuditRecordHandler) auditRecords(ctx context.Context, logger lager.Logger, request *models.AuditRecordsRequest, response *models.AuditRecordsResponse) error {
+	filter := models.AuditRecordFilter{
+		Guid:      request.Guid,
+		Actor:     request.Actor,
+		Since:     request.Since,
+		Until:     request.Until,
+		PageSize:  request.PageSize,
+		PageToken: request.PageToken,
+	}
+	var err error
+	response.AuditRecords, err = h.db.AuditRecords(ctx, logger, filter)
+	if request.PageSize > 0 && len(response.AuditRecords) == int(request.PageSize) {
+		response.NextPageToken = models.NewAuditRecordPageToken(response.AuditRecords[len(response.AuditRecords)-1]).Encode()
+	}
+	return err
+}
@@ -0,0 +1,123 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditRecord Handlers", func() {
+	var (
+		logger        *lagertest.TestLogger
+		auditRecordDB *dbfakes.FakeAuditRecordDB
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.AuditRecordHandler
+		exitCh           chan struct{}
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		auditRecordDB = new(dbfakes.FakeAuditRecordDB)
+		handler = handlers.NewAuditRecordHandler(auditRecordDB, exitCh)
+	})
+
+	Describe("AuditWrap", func() {
+		It("serves the request with the call of the route in its context", func() {
+			var call audit.Call
+			var ok bool
+			wrapped := handlers.AuditWrap(bbs.DesireTaskRoute_r2, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				call, ok = audit.FromContext(r.Context())
+			}))
+
+			req := newTestRequest(&models.DesireTaskRequest{TaskGuid: "task-guid"})
+			req.RemoteAddr = "10.0.0.1:5678"
+			req.Header.Set(trace.RequestIdHeader, "7f461654-74d1-1ee5-8367-77d85df2cdab")
+			req.TLS = &tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{
+					{Subject: pkix.Name{CommonName: "cc", OrganizationalUnit: []string{"app:cc"}}},
+				},
+			}
+			wrapped.ServeHTTP(responseRecorder, req)
+
+			Expect(ok).To(BeTrue())
+			Expect(call).To(Equal(audit.Call{
+				Route:               bbs.DesireTaskRoute_r2,
+				TraceID:             "7f461654-74d1-1ee5-8367-77d85df2cdab",
+				CommonName:          "cc",
+				OrganizationalUnits: []string{"app:cc"},
+				RemoteAddr:          "10.0.0.1:5678",
+			}))
+		})
+	})
+
+	Describe("AuditRecords", func() {
+		var records []*models.AuditRecord
+
+		BeforeEach(func() {
+			records = []*models.AuditRecord{
+				{Id: 1, Kind: models.AuditRecord_Task, Action: models.AuditRecord_Create, TaskGuid: "task-guid"},
+				{Id: 2, Kind: models.AuditRecord_Task, Action: models.AuditRecord_Remove, TaskGuid: "task-guid"},
+			}
+			auditRecordDB.AuditRecordsReturns(records, nil)
+		})
+
+		It("lists the audit records matching the filter", func() {
+			handler.AuditRecords(logger, responseRecorder, newTestRequest(&models.AuditRecordsRequest{
+				Guid:  "task-guid",
+				Actor: "cc",
+				Since: 10,
+				Until: 20,
+			}))
+
+			Expect(auditRecordDB.AuditRecordsCallCount()).To(Equal(1))
+			_, _, filter := auditRecordDB.AuditRecordsArgsForCall(0)
+			Expect(filter).To(Equal(models.AuditRecordFilter{Guid: "task-guid", Actor: "cc", Since: 10, Until: 20}))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.AuditRecordsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.AuditRecords).To(Equal(records))
+			Expect(response.NextPageToken).To(BeEmpty())
+		})
+
+		It("returns the token of the next page when the page is full", func() {
+			handler.AuditRecords(logger, responseRecorder, newTestRequest(&models.AuditRecordsRequest{PageSize: 2}))
+
+			response := &models.AuditRecordsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.NextPageToken).To(Equal(models.NewAuditRecordPageToken(records[1]).Encode()))
+		})
+
+		It("rejects invalid time ranges", func() {
+			handler.AuditRecords(logger, responseRecorder, newTestRequest(&models.AuditRecordsRequest{Since: 20, Until: 10}))
+
+			Expect(auditRecordDB.AuditRecordsCallCount()).To(Equal(0))
+			response := &models.AuditRecordsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+
+		It("responds with an unrecoverable error and exits", func() {
+			auditRecordDB.AuditRecordsReturns(nil, models.NewUnrecoverableError(nil))
+			handler.AuditRecords(logger, responseRecorder, newTestRequest(&models.AuditRecordsRequest{}))
+
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+})
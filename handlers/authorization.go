@@ -0,0 +1,278 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DomainResolver returns the domain the request of a route acts on, so that
+// roles scoped to domains can be authorized. It returns false for routes
+// that do not act on a single domain, which scoped roles never grant.
+type DomainResolver func(ctx context.Context, logger lager.Logger, route string, body []byte) (string, bool)
+
+// AuthorizationWrap serves the requests the authorizer grants the route to,
+// based on the identity of their client certificate, and responds with '403
+// Forbidden' to all others. Denied requests are logged to both loggers and
+// recorded in the audit log.
+func AuthorizationWrap(logger, accessLogger lager.Logger, authorizer *authorization.Authorizer, route string, resolveDomain DomainResolver, auditDB db.AuditRecordDB, handler http.Handler) http.HandlerFunc {
+	logger = logger.Session("authorization")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		readBody := func() ([]byte, error) {
+			body, err := io.ReadAll(r.Body)
+			r.Body = io.NopCloser(bytes.NewReader(body))
+			return body, err
+		}
+
+		identity := authorization.IdentityFromTLS(r.TLS)
+		granted, err := authorizeCall(r.Context(), logger, accessLogger, authorizer, resolveDomain, auditDB, route, trace.RequestIdFromRequest(r), identity, r.RemoteAddr, readBody)
+		switch {
+		case err != nil:
+			w.WriteHeader(http.StatusBadRequest)
+		case !granted:
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			handler.ServeHTTP(w, r)
+		}
+	}
+}
+
+// AuthorizationInterceptor serves the calls to the gRPC API the authorizer
+// grants, as AuthorizationWrap does the requests to the HTTP API, and fails
+// all others with PermissionDenied.
+func AuthorizationInterceptor(logger, accessLogger lager.Logger, authorizer *authorization.Authorizer, resolveDomain DomainResolver, auditDB db.AuditRecordDB) grpc.UnaryServerInterceptor {
+	logger = logger.Session("authorization")
+
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		identity, remoteAddr := peerIdentity(ctx)
+		granted, err := authorizeCall(ctx, logger, accessLogger, authorizer, resolveDomain, auditDB, route, trace.RequestIdFromContext(ctx), identity, remoteAddr, marshalRequest(request))
+		switch {
+		case err != nil:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case !granted:
+			return nil, errForbidden
+		}
+		return handler(ctx, request)
+	}
+}
+
+// authorizeCall reports whether the authorizer grants the call of the route
+// to the identity. The body of the call is only read when a role scoped to
+// domains needs the domain it acts on; the error of reading it is returned.
+// Denied calls are logged to both loggers and recorded in the audit log.
+func authorizeCall(ctx context.Context, logger, accessLogger lager.Logger, authorizer *authorization.Authorizer, resolveDomain DomainResolver, auditDB db.AuditRecordDB, route, traceID string, identity authorization.Identity, remoteAddr string, body func() ([]byte, error)) (bool, error) {
+	grant := authorizer.Grant(identity, route)
+	if grant.Unscoped() {
+		return true, nil
+	}
+
+	domain := ""
+	if grant.Scoped() {
+		body, err := body()
+		if err != nil {
+			logger.Error("failed-to-read-body", err)
+			return false, err
+		}
+
+		var ok bool
+		domain, ok = resolveDomain(ctx, logger, route, body)
+		if ok && grant.AllowsDomain(domain) {
+			return true, nil
+		}
+	}
+
+	data := lager.Data{
+		"route":                                 route,
+		"remote_addr":                           remoteAddr,
+		"peer_cert_subject_common_name":         identity.CommonName,
+		"peer_cert_subject_organizational_unit": identity.OrganizationalUnits,
+		"roles":                                 authorizer.Roles(identity),
+	}
+	if domain != "" {
+		data["domain"] = domain
+	}
+	logger.Info("denied", data)
+	if accessLogger != nil {
+		accessLogger.Session("authorization").Info("denied", data)
+	}
+
+	err := auditDB.RecordDenial(auditCall(ctx, route, traceID, identity, remoteAddr), logger, domain)
+	if err != nil {
+		logger.Error("failed-to-record-denial", err)
+	}
+	return false, nil
+}
+
+type domainRequest interface {
+	Unmarshal([]byte) error
+	GetDomain() string
+}
+
+type processGuidRequest interface {
+	Unmarshal([]byte) error
+	GetProcessGuid() string
+}
+
+type taskGuidRequest interface {
+	Unmarshal([]byte) error
+	GetTaskGuid() string
+}
+
+type scheduleGuidRequest interface {
+	Unmarshal([]byte) error
+	GetScheduleGuid() string
+}
+
+// NewDomainResolver resolves the domain from the request where it carries
+// one, and otherwise looks up the domain of the desired LRP, actual LRP, task
+// or scheduled task the request refers to.
+func NewDomainResolver(db db.DB) DomainResolver {
+	return func(ctx context.Context, logger lager.Logger, route string, body []byte) (string, bool) {
+		switch route {
+		case bbs.UpsertDomainRoute_r0:
+			return domainFromRequest(body, &models.UpsertDomainRequest{})
+		case bbs.DomainUsageRoute_r0:
+			return domainFromRequest(body, &models.DomainUsageRequest{})
+		case bbs.ActualLRPsRoute_r0:
+			return domainFromRequest(body, &models.ActualLRPsRequest{})
+		case bbs.DesiredLRPsRoute_r3, bbs.DesiredLRPSchedulingInfosRoute_r0, bbs.DesiredLRPRoutingInfosRoute_r0:
+			return domainFromRequest(body, &models.DesiredLRPsRequest{})
+		case bbs.TasksRoute_r3:
+			return domainFromRequest(body, &models.TasksRequest{})
+		case bbs.DesireTaskRoute_r2:
+			return domainFromRequest(body, &models.DesireTaskRequest{})
+		case bbs.ScheduledTasksRoute_r0:
+			return domainFromRequest(body, &models.ScheduledTasksRequest{})
+		case bbs.DesireScheduledTaskRoute_r0:
+			return domainFromRequest(body, &models.DesireScheduledTaskRequest{})
+
+		case bbs.DesireDesiredLRPRoute_r2:
+			request := &models.DesireLRPRequest{}
+			if request.Unmarshal(body) != nil {
+				return "", false
+			}
+			return request.GetDesiredLrp().GetDomain(), true
+		case bbs.RetireActualLRPRoute_r0:
+			return domainOfActualLRP(ctx, logger, db, body)
+
+		case bbs.DesiredLRPByProcessGuidRoute_r3, bbs.DesiredLRPSchedulingInfoByProcessGuid_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.DesiredLRPByProcessGuidRequest{})
+		case bbs.UpdateDesiredLRPRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.UpdateDesiredLRPRequest{})
+		case bbs.RemoveDesiredLRPRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.RemoveDesiredLRPRequest{})
+		case bbs.StartDeploymentRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.StartDeploymentRequest{})
+		case bbs.DeploymentByProcessGuidRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.DeploymentByProcessGuidRequest{})
+		case bbs.PauseDeploymentRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.PauseDeploymentRequest{})
+		case bbs.ResumeDeploymentRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.ResumeDeploymentRequest{})
+		case bbs.RollbackDeploymentRoute_r0:
+			return domainOfDesiredLRP(ctx, logger, db, body, &models.RollbackDeploymentRequest{})
+
+		case bbs.TaskByGuidRoute_r3:
+			return domainOfTask(ctx, logger, db, body, &models.TaskByGuidRequest{})
+		case bbs.CancelTaskRoute_r0, bbs.ResolvingTaskRoute_r0, bbs.DeleteTaskRoute_r0:
+			return domainOfTask(ctx, logger, db, body, &models.TaskGuidRequest{})
+
+		case bbs.UpdateScheduledTaskRoute_r0:
+			return domainOfScheduledTask(ctx, logger, db, body, &models.UpdateScheduledTaskRequest{})
+		case bbs.SuspendScheduledTaskRoute_r0:
+			return domainOfScheduledTask(ctx, logger, db, body, &models.SuspendScheduledTaskRequest{})
+		case bbs.DeleteScheduledTaskRoute_r0:
+			return domainOfScheduledTask(ctx, logger, db, body, &models.DeleteScheduledTaskRequest{})
+		}
+
+		return "", false
+	}
+}
+
+func domainFromRequest(body []byte, request domainRequest) (string, bool) {
+	if request.Unmarshal(body) != nil {
+		return "", false
+	}
+	return request.GetDomain(), true
+}
+
+func domainOfDesiredLRP(ctx context.Context, logger lager.Logger, db db.DesiredLRPDB, body []byte, request processGuidRequest) (string, bool) {
+	if request.Unmarshal(body) != nil {
+		return "", false
+	}
+
+	schedulingInfo, err := db.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, request.GetProcessGuid())
+	if err != nil {
+		logger.Debug("failed-to-resolve-domain", lager.Data{"process_guid": request.GetProcessGuid(), "error": err.Error()})
+		return "", false
+	}
+	return schedulingInfo.Domain, true
+}
+
+// domainOfActualLRP looks up the domain of the ActualLRP to retire by its
+// process guid and index, the way it is retired, rather than trusting the
+// domain of the key in the request.
+func domainOfActualLRP(ctx context.Context, logger lager.Logger, db db.ActualLRPDB, body []byte) (string, bool) {
+	request := &models.RetireActualLRPRequest{}
+	if request.Unmarshal(body) != nil {
+		return "", false
+	}
+
+	key := request.GetActualLrpKey()
+	index := key.GetIndex()
+	lrps, err := db.ActualLRPs(ctx, logger, models.ActualLRPFilter{ProcessGuid: key.GetProcessGuid(), Index: &index})
+	if err != nil {
+		logger.Debug("failed-to-resolve-domain", lager.Data{"process_guid": key.GetProcessGuid(), "index": index, "error": err.Error()})
+		return "", false
+	}
+	if len(lrps) == 0 {
+		return "", false
+	}
+
+	domain := lrps[0].Domain
+	for _, lrp := range lrps[1:] {
+		if lrp.Domain != domain {
+			return "", false
+		}
+	}
+	return domain, true
+}
+
+func domainOfTask(ctx context.Context, logger lager.Logger, db db.TaskDB, body []byte, request taskGuidRequest) (string, bool) {
+	if request.Unmarshal(body) != nil {
+		return "", false
+	}
+
+	task, err := db.TaskByGuid(ctx, logger, request.GetTaskGuid())
+	if err != nil {
+		logger.Debug("failed-to-resolve-domain", lager.Data{"task_guid": request.GetTaskGuid(), "error": err.Error()})
+		return "", false
+	}
+	return task.Domain, true
+}
+
+func domainOfScheduledTask(ctx context.Context, logger lager.Logger, db db.ScheduledTaskDB, body []byte, request scheduleGuidRequest) (string, bool) {
+	if request.Unmarshal(body) != nil {
+		return "", false
+	}
+
+	scheduledTask, err := db.ScheduledTaskByGuid(ctx, logger, request.GetScheduleGuid())
+	if err != nil {
+		logger.Debug("failed-to-resolve-domain", lager.Data{"schedule_guid": request.GetScheduleGuid(), "error": err.Error()})
+		return "", false
+	}
+	return scheduledTask.Domain, true
+}
@@ -0,0 +1,217 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("AuthorizationWrap", func() {
+	var (
+		logger       *lagertest.TestLogger
+		accessLogger *lagertest.TestLogger
+		fakeDB       *dbfakes.FakeDB
+		authorizer   *authorization.Authorizer
+
+		responseRecorder *httptest.ResponseRecorder
+		servedBodies     [][]byte
+		handler          http.Handler
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		accessLogger = lagertest.NewTestLogger("access")
+		fakeDB = new(dbfakes.FakeDB)
+		responseRecorder = httptest.NewRecorder()
+		servedBodies = nil
+
+		var err error
+		authorizer, err = authorization.NewAuthorizer([]authorization.Rule{
+			{CommonName: "cc", Roles: []string{authorization.RoleScheduler}},
+			{OrganizationalUnit: "cell:*", Roles: []string{authorization.RoleCell}},
+			{CommonName: "tenant", Roles: []string{authorization.RoleScheduler, authorization.RoleReadOnly}, Domains: []string{"tenant-domain"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			Expect(err).NotTo(HaveOccurred())
+			servedBodies = append(servedBodies, body)
+		})
+	})
+
+	serve := func(route, commonName string, ous []string, request proto.Message) {
+		req := newTestRequest(request)
+		req.RemoteAddr = "some-remote-addr"
+		req.Header.Set(trace.RequestIdHeader, "some-trace-id")
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{
+				{Subject: pkix.Name{CommonName: commonName, OrganizationalUnit: ous}},
+			},
+		}
+		wrapped := handlers.AuthorizationWrap(logger, accessLogger, authorizer, route, handlers.NewDomainResolver(fakeDB), fakeDB, handler)
+		wrapped.ServeHTTP(responseRecorder, req)
+	}
+
+	It("serves requests granted to the client", func() {
+		serve(bbs.DesireTaskRoute_r2, "cc", nil, &models.DesireTaskRequest{TaskGuid: "task-guid", Domain: "some-domain"})
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		Expect(servedBodies).To(HaveLen(1))
+	})
+
+	It("matches the organizational unit of the client", func() {
+		serve(bbs.StartTaskRoute_r0, "cell-z1-0", []string{"cell:z1"}, &models.StartTaskRequest{TaskGuid: "task-guid"})
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+	})
+
+	It("forbids routes outside of the roles of the client and audits it", func() {
+		serve(bbs.CompleteTaskRoute_r0, "cc", nil, &models.CompleteTaskRequest{TaskGuid: "task-guid"})
+		Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+		Expect(servedBodies).To(BeEmpty())
+
+		Expect(logger).To(gbytes.Say("authorization.denied"))
+		Expect(logger).To(gbytes.Say(`"peer_cert_subject_common_name":"cc"`))
+		Expect(accessLogger).To(gbytes.Say("authorization.denied"))
+		Expect(accessLogger).To(gbytes.Say(`"roles":\["scheduler"\]`))
+		Expect(accessLogger).To(gbytes.Say(`"route":"CompleteTask"`))
+
+		Expect(fakeDB.RecordDenialCallCount()).To(Equal(1))
+		ctx, _, domain := fakeDB.RecordDenialArgsForCall(0)
+		Expect(domain).To(BeEmpty())
+		call, ok := audit.FromContext(ctx)
+		Expect(ok).To(BeTrue())
+		Expect(call).To(Equal(audit.Call{
+			Route:      bbs.CompleteTaskRoute_r0,
+			TraceID:    "some-trace-id",
+			CommonName: "cc",
+			RemoteAddr: "some-remote-addr",
+		}))
+	})
+
+	It("does not record the requests it serves in the audit log", func() {
+		serve(bbs.DesireTaskRoute_r2, "cc", nil, &models.DesireTaskRequest{TaskGuid: "task-guid", Domain: "some-domain"})
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		Expect(fakeDB.RecordDenialCallCount()).To(Equal(0))
+	})
+
+	It("still forbids the request when it fails to record the denial", func() {
+		fakeDB.RecordDenialReturns(errors.New("boom"))
+
+		serve(bbs.CompleteTaskRoute_r0, "cc", nil, &models.CompleteTaskRequest{TaskGuid: "task-guid"})
+		Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+		Expect(logger).To(gbytes.Say("authorization.failed-to-record-denial"))
+	})
+
+	It("forbids clients without matching rules", func() {
+		serve(bbs.PingRoute_r0, "stranger", nil, &models.PingRequest{})
+		Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+	})
+
+	Context("when the roles of the client are scoped to domains", func() {
+		It("serves requests for those domains, passing on the request body", func() {
+			request := &models.DesireLRPRequest{DesiredLrp: &models.DesiredLRP{ProcessGuid: "process-guid", Domain: "tenant-domain"}}
+			serve(bbs.DesireDesiredLRPRoute_r2, "tenant", nil, request)
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			Expect(servedBodies).To(HaveLen(1))
+			served := &models.DesireLRPRequest{}
+			Expect(served.Unmarshal(servedBodies[0])).To(Succeed())
+			Expect(served.DesiredLrp.ProcessGuid).To(Equal("process-guid"))
+		})
+
+		It("forbids requests for other domains", func() {
+			serve(bbs.TasksRoute_r3, "tenant", nil, &models.TasksRequest{Domain: "other-domain"})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+			Expect(logger).To(gbytes.Say(`"domain":"other-domain"`))
+
+			Expect(fakeDB.RecordDenialCallCount()).To(Equal(1))
+			_, _, domain := fakeDB.RecordDenialArgsForCall(0)
+			Expect(domain).To(Equal("other-domain"))
+		})
+
+		It("forbids requests across every domain", func() {
+			serve(bbs.TasksRoute_r3, "tenant", nil, &models.TasksRequest{})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("forbids routes that do not act on a domain", func() {
+			serve(bbs.CellsRoute_r0, "tenant", nil, &models.CellsRequest{})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+
+			responseRecorder = httptest.NewRecorder()
+			serve(bbs.TaskEventStreamRoute_r1, "tenant", nil, &models.EventsByCellId{})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("looks up the domain of the desired LRP", func() {
+			fakeDB.DesiredLRPSchedulingInfoByProcessGuidReturns(&models.DesiredLRPSchedulingInfo{
+				DesiredLRPKey: models.NewDesiredLRPKey("process-guid", "tenant-domain", "log-guid"),
+			}, nil)
+
+			serve(bbs.RemoveDesiredLRPRoute_r0, "tenant", nil, &models.RemoveDesiredLRPRequest{ProcessGuid: "process-guid"})
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			_, _, processGuid := fakeDB.DesiredLRPSchedulingInfoByProcessGuidArgsForCall(0)
+			Expect(processGuid).To(Equal("process-guid"))
+		})
+
+		It("looks up the domain of the actual LRP to retire", func() {
+			fakeDB.ActualLRPsReturns([]*models.ActualLRP{
+				{ActualLRPKey: models.NewActualLRPKey("process-guid", 1, "other-domain")},
+			}, nil)
+
+			key := models.NewActualLRPKey("process-guid", 1, "tenant-domain")
+			serve(bbs.RetireActualLRPRoute_r0, "tenant", nil, &models.RetireActualLRPRequest{ActualLrpKey: &key})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+			Expect(logger).To(gbytes.Say(`"domain":"other-domain"`))
+
+			_, _, filter := fakeDB.ActualLRPsArgsForCall(0)
+			Expect(filter.ProcessGuid).To(Equal("process-guid"))
+			Expect(*filter.Index).To(BeEquivalentTo(1))
+		})
+
+		It("allows retiring actual LRPs of the domain", func() {
+			fakeDB.ActualLRPsReturns([]*models.ActualLRP{
+				{ActualLRPKey: models.NewActualLRPKey("process-guid", 1, "tenant-domain")},
+			}, nil)
+
+			key := models.NewActualLRPKey("process-guid", 1, "tenant-domain")
+			serve(bbs.RetireActualLRPRoute_r0, "tenant", nil, &models.RetireActualLRPRequest{ActualLrpKey: &key})
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("looks up the domain of the task", func() {
+			fakeDB.TaskByGuidReturns(&models.Task{TaskGuid: "task-guid", Domain: "other-domain"}, nil)
+
+			serve(bbs.CancelTaskRoute_r0, "tenant", nil, &models.TaskGuidRequest{TaskGuid: "task-guid"})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+
+			_, _, taskGuid := fakeDB.TaskByGuidArgsForCall(0)
+			Expect(taskGuid).To(Equal("task-guid"))
+		})
+
+		It("forbids requests for resources that cannot be found", func() {
+			fakeDB.TaskByGuidReturns(nil, models.ErrResourceNotFound)
+
+			serve(bbs.DeleteTaskRoute_r0, "tenant", nil, &models.TaskGuidRequest{TaskGuid: "task-guid"})
+			Expect(responseRecorder.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -8,14 +9,26 @@ import (
 	"code.cloudfoundry.org/lager/v3"
 )
 
+//counterfeiter:generate -o fake_controllers/fake_cell_controller.go . CellController
+
+type CellController interface {
+	CordonedCellIds(ctx context.Context, logger lager.Logger) ([]string, error)
+	CordonCell(ctx context.Context, logger lager.Logger, cellId string) error
+	UncordonCell(ctx context.Context, logger lager.Logger, cellId string) error
+	DrainCell(ctx context.Context, logger lager.Logger, cellId string) (*models.CellDrain, error)
+	CellDrains(ctx context.Context, logger lager.Logger) ([]*models.CellDrain, error)
+}
+
 type CellHandler struct {
 	serviceClient serviceclient.ServiceClient
+	controller    CellController
 	exitChan      chan<- struct{}
 }
 
-func NewCellHandler(serviceClient serviceclient.ServiceClient, exitChan chan<- struct{}) *CellHandler {
+func NewCellHandler(serviceClient serviceclient.ServiceClient, controller CellController, exitChan chan<- struct{}) *CellHandler {
 	return &CellHandler{
 		serviceClient: serviceClient,
+		controller:    controller,
 		exitChan:      exitChan,
 	}
 }
@@ -24,13 +37,130 @@ func (h *CellHandler) Cells(logger lager.Logger, w http.ResponseWriter, req *htt
 	var err error
 	logger = logger.Session("cells").WithTraceInfo(req)
 	response := &models.CellsResponse{}
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err = h.cells(req.Context(), logger, &models.CellsRequest{}, response)
+	response.Error = models.ConvertError(err)
+}
+
+// cells sets the cells that are present on the response, marking those that
+// are cordoned.
+func (h *CellHandler) cells(ctx context.Context, logger lager.Logger, request *models.CellsRequest, response *models.CellsResponse) error {
 	cellSet, err := h.serviceClient.Cells(logger)
-	cells := []*models.CellPresence{}
+	if err != nil {
+		return err
+	}
+
+	cordonedCellIds, err := h.controller.CordonedCellIds(ctx, logger)
+	if err != nil {
+		return err
+	}
+	cordoned := make(map[string]bool, len(cordonedCellIds))
+	for _, cellId := range cordonedCellIds {
+		cordoned[cellId] = true
+	}
+
+	response.Cells = []*models.CellPresence{}
 	for _, cp := range cellSet {
-		cells = append(cells, cp)
+		cp.Cordoned = cordoned[cp.CellId]
+		response.Cells = append(response.Cells, cp)
 	}
-	response.Cells = cells
+	return nil
+}
+
+func (h *CellHandler) CordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("cordon-cell").WithTraceInfo(req)
+
+	request := &models.CordonCellRequest{}
+	response := &models.CordonCellResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.cordonCell(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
-	writeResponse(w, response)
-	exitIfUnrecoverable(logger, h.exitChan, response.Error)
+}
+
+func (h *CellHandler) cordonCell(ctx context.Context, logger lager.Logger, request *models.CordonCellRequest, response *models.CordonCellResponse) error {
+	return h.controller.CordonCell(ctx, logger, request.CellId)
+}
+
+func (h *CellHandler) UncordonCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("uncordon-cell").WithTraceInfo(req)
+
+	request := &models.UncordonCellRequest{}
+	response := &models.UncordonCellResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.uncordonCell(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *CellHandler) uncordonCell(ctx context.Context, logger lager.Logger, request *models.UncordonCellRequest, response *models.UncordonCellResponse) error {
+	return h.controller.UncordonCell(ctx, logger, request.CellId)
+}
+
+func (h *CellHandler) DrainCell(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("drain-cell").WithTraceInfo(req)
+
+	request := &models.DrainCellRequest{}
+	response := &models.DrainCellResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.drainCell(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *CellHandler) drainCell(ctx context.Context, logger lager.Logger, request *models.DrainCellRequest, response *models.DrainCellResponse) error {
+	var err error
+	response.Drain, err = h.controller.DrainCell(ctx, logger, request.CellId)
+	return err
+}
+
+func (h *CellHandler) CellDrains(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("cell-drains").WithTraceInfo(req)
+
+	request := &models.CellDrainsRequest{}
+	response := &models.CellDrainsResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.cellDrains(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *CellHandler) cellDrains(ctx context.Context, logger lager.Logger, request *models.CellDrainsRequest, response *models.CellDrainsResponse) error {
+	var err error
+	response.Drains, err = h.controller.CellDrains(ctx, logger)
+	return err
 }
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/serviceclient/serviceclientfakes"
 	"code.cloudfoundry.org/lager/v3"
@@ -22,6 +23,7 @@ var _ = Describe("Cell Handlers", func() {
 		responseRecorder  *httptest.ResponseRecorder
 		handler           *handlers.CellHandler
 		fakeServiceClient *serviceclientfakes.FakeServiceClient
+		controller        *fake_controllers.FakeCellController
 		exitCh            chan struct{}
 		cells             []*models.CellPresence
 		cellSet           models.CellSet
@@ -37,7 +39,8 @@ var _ = Describe("Cell Handlers", func() {
 		exitCh = make(chan struct{}, 1)
 		requestIdHeader = "0bc29108-c522-4360-93dd-30ca38cce13d"
 		b3RequestIdHeader = fmt.Sprintf(`"trace-id":"%s"`, strings.Replace(requestIdHeader, "-", "", -1))
-		handler = handlers.NewCellHandler(fakeServiceClient, exitCh)
+		controller = new(fake_controllers.FakeCellController)
+		handler = handlers.NewCellHandler(fakeServiceClient, controller, exitCh)
 		cells = []*models.CellPresence{
 			{
 				CellId:     "cell-1",
@@ -98,6 +101,41 @@ var _ = Describe("Cell Handlers", func() {
 			})
 		})
 
+		Context("when cells are cordoned", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(cellSet, nil)
+				controller.CordonedCellIdsReturns([]string{"cell-2", "gone-cell"}, nil)
+			})
+
+			It("marks the cordoned cells", func() {
+				response := &models.CellsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.Cells).To(HaveLen(2))
+				for _, cell := range response.Cells {
+					Expect(cell.Cordoned).To(Equal(cell.CellId == "cell-2"))
+				}
+			})
+		})
+
+		Context("when reading the cordoned cells fails", func() {
+			BeforeEach(func() {
+				fakeServiceClient.CellsReturns(cellSet, nil)
+				controller.CordonedCellIdsReturns(nil, models.ErrUnknownError)
+			})
+
+			It("does not return cells that may be cordoned", func() {
+				response := &models.CellsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(Equal(models.ErrUnknownError))
+				Expect(response.Cells).To(BeNil())
+			})
+		})
+
 		Context("when the serviceClient returns no cells", func() {
 			BeforeEach(func() {
 				fakeServiceClient.CellsReturns(nil, nil)
@@ -144,4 +182,124 @@ var _ = Describe("Cell Handlers", func() {
 			})
 		})
 	})
+
+	Describe("CordonCell", func() {
+		var requestBody interface{}
+
+		BeforeEach(func() {
+			requestBody = &models.CordonCellRequest{CellId: "cell-1"}
+		})
+
+		JustBeforeEach(func() {
+			handler.CordonCell(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("cordons the cell", func() {
+			response := &models.CordonCellResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+
+			Expect(controller.CordonCellCallCount()).To(Equal(1))
+			_, _, cellId := controller.CordonCellArgsForCall(0)
+			Expect(cellId).To(Equal("cell-1"))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.CordonCellRequest{}
+			})
+
+			It("responds with a bad request error", func() {
+				response := &models.CordonCellResponse{}
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(controller.CordonCellCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the controller returns an unrecoverable error", func() {
+			BeforeEach(func() {
+				controller.CordonCellReturns(models.NewUnrecoverableError(nil))
+			})
+
+			It("writes to the exit channel", func() {
+				Eventually(exitCh).Should(Receive())
+			})
+		})
+	})
+
+	Describe("UncordonCell", func() {
+		JustBeforeEach(func() {
+			handler.UncordonCell(logger, responseRecorder, newTestRequest(&models.UncordonCellRequest{CellId: "cell-1"}))
+		})
+
+		It("uncordons the cell", func() {
+			response := &models.UncordonCellResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+
+			Expect(controller.UncordonCellCallCount()).To(Equal(1))
+			_, _, cellId := controller.UncordonCellArgsForCall(0)
+			Expect(cellId).To(Equal("cell-1"))
+		})
+	})
+
+	Describe("DrainCell", func() {
+		var drain *models.CellDrain
+
+		BeforeEach(func() {
+			drain = &models.CellDrain{CellId: "cell-1", State: models.CellDrain_Draining, InitialInstances: 3, RemainingInstances: 3}
+			controller.DrainCellReturns(drain, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.DrainCell(logger, responseRecorder, newTestRequest(&models.DrainCellRequest{CellId: "cell-1"}))
+		})
+
+		It("drains the cell", func() {
+			response := &models.DrainCellResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Drain).To(Equal(drain))
+
+			_, _, cellId := controller.DrainCellArgsForCall(0)
+			Expect(cellId).To(Equal("cell-1"))
+		})
+
+		Context("when the cell is not present", func() {
+			BeforeEach(func() {
+				controller.DrainCellReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("responds with the error", func() {
+				response := &models.DrainCellResponse{}
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+				Expect(response.Drain).To(BeNil())
+			})
+		})
+	})
+
+	Describe("CellDrains", func() {
+		var drains []*models.CellDrain
+
+		BeforeEach(func() {
+			drains = []*models.CellDrain{
+				{CellId: "cell-1", State: models.CellDrain_Drained},
+				{CellId: "cell-2", State: models.CellDrain_Failed, Error: "boom"},
+			}
+			controller.CellDrainsReturns(drains, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.CellDrains(logger, responseRecorder, newTestRequest(&models.CellDrainsRequest{}))
+		})
+
+		It("returns the drains", func() {
+			response := &models.CellDrainsResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Drains).To(Equal(drains))
+		})
+	})
 })
@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/crashstorm"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type CrashStormHandler struct {
+	detector *crashstorm.Detector
+}
+
+func NewCrashStormHandler(detector *crashstorm.Detector) *CrashStormHandler {
+	return &CrashStormHandler{
+		detector: detector,
+	}
+}
+
+func (h *CrashStormHandler) CrashStormBreakers(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("crash-storm-breakers").WithTraceInfo(req)
+
+	request := &models.CrashStormBreakersRequest{}
+	response := &models.CrashStormBreakersResponse{}
+
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.crashStormBreakers(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *CrashStormHandler) crashStormBreakers(ctx context.Context, logger lager.Logger, request *models.CrashStormBreakersRequest, response *models.CrashStormBreakersResponse) error {
+	response.Breakers = h.detector.Breakers()
+	return nil
+}
+
+func (h *CrashStormHandler) ResetCrashStormBreaker(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("reset-crash-storm-breaker").WithTraceInfo(req)
+
+	request := &models.ResetCrashStormBreakerRequest{}
+	response := &models.ResetCrashStormBreakerResponse{}
+
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.resetCrashStormBreaker(trace.ContextWithRequestId(req), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *CrashStormHandler) resetCrashStormBreaker(ctx context.Context, logger lager.Logger, request *models.ResetCrashStormBreakerRequest, response *models.ResetCrashStormBreakerResponse) error {
+	var err error
+	response.Breaker, err = h.detector.Reset(trace.RequestIdFromContext(ctx), request.Scope, request.Key)
+	return err
+}
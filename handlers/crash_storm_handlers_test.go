@@ -0,0 +1,111 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/crashstorm"
+	"code.cloudfoundry.org/bbs/events/eventfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Crash Storm Handlers", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeHub          *eventfakes.FakeHub
+		detector         *crashstorm.Detector
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.CrashStormHandler
+	)
+
+	BeforeEach(func() {
+		var err error
+
+		logger = lagertest.NewTestLogger("test")
+		fakeHub = new(eventfakes.FakeHub)
+		detector, err = crashstorm.NewDetector(
+			logger,
+			fakeclock.NewFakeClock(time.Unix(123, 0)),
+			crashstorm.Config{Enabled: true, ProcessThreshold: 1},
+			fakeHub,
+			new(mfakes.FakeIngressClient),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		responseRecorder = httptest.NewRecorder()
+		handler = handlers.NewCrashStormHandler(detector)
+
+		detector.RecordCrash("some-trace-id", &models.ActualLRPKey{ProcessGuid: "process-guid", Domain: "domain"})
+	})
+
+	Describe("CrashStormBreakers", func() {
+		It("responds with the open breakers", func() {
+			handler.CrashStormBreakers(logger, responseRecorder, newTestRequest(&models.CrashStormBreakersRequest{}))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			response := &models.CrashStormBreakersResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Breakers).To(HaveLen(1))
+			Expect(response.Breakers[0].Scope).To(Equal(models.CrashStormBreaker_ProcessGuid))
+			Expect(response.Breakers[0].Key).To(Equal("process-guid"))
+		})
+	})
+
+	Describe("ResetCrashStormBreaker", func() {
+		var request *models.ResetCrashStormBreakerRequest
+
+		BeforeEach(func() {
+			request = &models.ResetCrashStormBreakerRequest{
+				Scope: models.CrashStormBreaker_ProcessGuid,
+				Key:   "process-guid",
+			}
+		})
+
+		JustBeforeEach(func() {
+			handler.ResetCrashStormBreaker(logger, responseRecorder, newTestRequest(request))
+		})
+
+		It("closes the breaker", func() {
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			response := &models.ResetCrashStormBreakerResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Breaker.Key).To(Equal("process-guid"))
+			Expect(response.Breaker.ClosedAt).NotTo(BeZero())
+
+			Expect(detector.Breakers()).To(BeEmpty())
+		})
+
+		Context("when the breaker is not open", func() {
+			BeforeEach(func() {
+				request.Key = "other-process-guid"
+			})
+
+			It("responds with a not found error", func() {
+				response := &models.ResetCrashStormBreakerResponse{}
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				request.Key = ""
+			})
+
+			It("responds with a bad request error", func() {
+				response := &models.ResetCrashStormBreakerResponse{}
+				Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+	})
+})
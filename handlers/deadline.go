@@ -0,0 +1,27 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+)
+
+// DeadlineWrap serves requests with a context that is done once the timeout
+// the client sent in the X-Request-Timeout header has passed, so that the
+// handlers stop querying the database for calls their client has given up on.
+func DeadlineWrap(handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		milliseconds, err := strconv.ParseInt(r.Header.Get(bbs.RequestTimeoutHeader), 10, 64)
+		if err != nil || milliseconds <= 0 {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), time.Duration(milliseconds)*time.Millisecond)
+		defer cancel()
+		handler.ServeHTTP(w, r.WithContext(ctx))
+	}
+}
@@ -0,0 +1,60 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeadlineWrap", func() {
+	var (
+		deadline    time.Time
+		hasDeadline bool
+		request     *http.Request
+	)
+
+	BeforeEach(func() {
+		hasDeadline = false
+		request = httptest.NewRequest("POST", "/v1/tasks/list.r3", nil)
+	})
+
+	JustBeforeEach(func() {
+		handler := handlers.DeadlineWrap(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			deadline, hasDeadline = r.Context().Deadline()
+		}))
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	Context("when the client sends a request timeout", func() {
+		BeforeEach(func() {
+			request.Header.Set(bbs.RequestTimeoutHeader, "2000")
+		})
+
+		It("serves the request with a context that is done once it has passed", func() {
+			Expect(hasDeadline).To(BeTrue())
+			Expect(time.Until(deadline)).To(BeNumerically("~", 2*time.Second, time.Second))
+		})
+	})
+
+	Context("when the client does not send a request timeout", func() {
+		It("serves the request without a deadline", func() {
+			Expect(hasDeadline).To(BeFalse())
+		})
+	})
+
+	Context("when the request timeout is invalid", func() {
+		BeforeEach(func() {
+			request.Header.Set(bbs.RequestTimeoutHeader, "soon")
+		})
+
+		It("serves the request without a deadline", func() {
+			Expect(hasDeadline).To(BeFalse())
+		})
+	})
+})
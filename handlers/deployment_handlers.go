@@ -0,0 +1,159 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate -o fake_controllers/fake_deployment_controller.go . DeploymentController
+
+type DeploymentController interface {
+	StartDeployment(ctx context.Context, logger lager.Logger, processGuid string, runInfo *models.DesiredLRPRunInfo, maxSurge, maxUnavailable int32) (*models.Deployment, error)
+	DeploymentByProcessGuid(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+	PauseDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+	ResumeDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+	RollbackDeployment(ctx context.Context, logger lager.Logger, processGuid string) (*models.Deployment, error)
+}
+
+type DeploymentHandler struct {
+	controller DeploymentController
+	exitChan   chan<- struct{}
+}
+
+func NewDeploymentHandler(
+	controller DeploymentController,
+	exitChan chan<- struct{},
+) *DeploymentHandler {
+	return &DeploymentHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *DeploymentHandler) StartDeployment(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("start-deployment").WithTraceInfo(req)
+
+	request := &models.StartDeploymentRequest{}
+	response := &models.DeploymentResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.startDeployment(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DeploymentHandler) startDeployment(ctx context.Context, logger lager.Logger, request *models.StartDeploymentRequest, response *models.DeploymentResponse) error {
+	var err error
+	response.Deployment, err = h.controller.StartDeployment(ctx, logger, request.ProcessGuid, request.RunInfo, request.MaxSurge, request.MaxUnavailable)
+	return err
+}
+
+func (h *DeploymentHandler) DeploymentByProcessGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("deployment-by-process-guid").WithTraceInfo(req)
+
+	request := &models.DeploymentByProcessGuidRequest{}
+	response := &models.DeploymentResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.deploymentByProcessGuid(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DeploymentHandler) deploymentByProcessGuid(ctx context.Context, logger lager.Logger, request *models.DeploymentByProcessGuidRequest, response *models.DeploymentResponse) error {
+	var err error
+	response.Deployment, err = h.controller.DeploymentByProcessGuid(ctx, logger, request.ProcessGuid)
+	return err
+}
+
+func (h *DeploymentHandler) PauseDeployment(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("pause-deployment").WithTraceInfo(req)
+
+	request := &models.PauseDeploymentRequest{}
+	response := &models.DeploymentResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.pauseDeployment(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DeploymentHandler) pauseDeployment(ctx context.Context, logger lager.Logger, request *models.PauseDeploymentRequest, response *models.DeploymentResponse) error {
+	var err error
+	response.Deployment, err = h.controller.PauseDeployment(ctx, logger, request.ProcessGuid)
+	return err
+}
+
+func (h *DeploymentHandler) ResumeDeployment(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("resume-deployment").WithTraceInfo(req)
+
+	request := &models.ResumeDeploymentRequest{}
+	response := &models.DeploymentResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.resumeDeployment(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DeploymentHandler) resumeDeployment(ctx context.Context, logger lager.Logger, request *models.ResumeDeploymentRequest, response *models.DeploymentResponse) error {
+	var err error
+	response.Deployment, err = h.controller.ResumeDeployment(ctx, logger, request.ProcessGuid)
+	return err
+}
+
+func (h *DeploymentHandler) RollbackDeployment(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("rollback-deployment").WithTraceInfo(req)
+
+	request := &models.RollbackDeploymentRequest{}
+	response := &models.DeploymentResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.rollbackDeployment(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DeploymentHandler) rollbackDeployment(ctx context.Context, logger lager.Logger, request *models.RollbackDeploymentRequest, response *models.DeploymentResponse) error {
+	var err error
+	response.Deployment, err = h.controller.RollbackDeployment(ctx, logger, request.ProcessGuid)
+	return err
+}
@@ -0,0 +1,168 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Handlers", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeDeploymentController
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.DeploymentHandler
+		exitCh           chan struct{}
+
+		deployment *models.Deployment
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		controller = &fake_controllers.FakeDeploymentController{}
+		handler = handlers.NewDeploymentHandler(controller, exitCh)
+
+		deployment = &models.Deployment{
+			DeploymentGuid: "deployment-guid",
+			ProcessGuid:    "process-guid",
+			State:          models.Deployment_InProgress,
+		}
+	})
+
+	parseResponse := func() *models.DeploymentResponse {
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.DeploymentResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		return response
+	}
+
+	Describe("StartDeployment", func() {
+		var requestBody *models.StartDeploymentRequest
+
+		BeforeEach(func() {
+			desiredLRP := model_helpers.NewValidDesiredLRP("process-guid")
+			runInfo := desiredLRP.DesiredLRPRunInfo(time.Now())
+			requestBody = &models.StartDeploymentRequest{
+				ProcessGuid:    "process-guid",
+				RunInfo:        &runInfo,
+				MaxSurge:       1,
+				MaxUnavailable: 0,
+			}
+			controller.StartDeploymentReturns(deployment, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.StartDeployment(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("starts the deployment", func() {
+			Expect(controller.StartDeploymentCallCount()).To(Equal(1))
+			_, _, processGuid, runInfo, maxSurge, maxUnavailable := controller.StartDeploymentArgsForCall(0)
+			Expect(processGuid).To(Equal("process-guid"))
+			Expect(runInfo).To(Equal(requestBody.RunInfo))
+			Expect(maxSurge).To(BeEquivalentTo(1))
+			Expect(maxUnavailable).To(BeEquivalentTo(0))
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.Deployment).To(Equal(deployment))
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				requestBody.MaxSurge = 0
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(controller.StartDeploymentCallCount()).To(Equal(0))
+				response := parseResponse()
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when a deployment is already active", func() {
+			BeforeEach(func() {
+				controller.StartDeploymentReturns(nil, models.ErrResourceConflict)
+			})
+
+			It("responds with the error", func() {
+				response := parseResponse()
+				Expect(response.Error).To(Equal(models.ErrResourceConflict))
+			})
+		})
+	})
+
+	Describe("DeploymentByProcessGuid", func() {
+		It("returns the deployment", func() {
+			controller.DeploymentByProcessGuidReturns(deployment, nil)
+			handler.DeploymentByProcessGuid(logger, responseRecorder, newTestRequest(&models.DeploymentByProcessGuidRequest{ProcessGuid: "process-guid"}))
+
+			_, _, processGuid := controller.DeploymentByProcessGuidArgsForCall(0)
+			Expect(processGuid).To(Equal("process-guid"))
+			Expect(parseResponse().Deployment).To(Equal(deployment))
+		})
+
+		It("responds with not found when there is no deployment", func() {
+			controller.DeploymentByProcessGuidReturns(nil, models.ErrResourceNotFound)
+			handler.DeploymentByProcessGuid(logger, responseRecorder, newTestRequest(&models.DeploymentByProcessGuidRequest{ProcessGuid: "process-guid"}))
+
+			Expect(parseResponse().Error).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("PauseDeployment", func() {
+		It("pauses the deployment", func() {
+			controller.PauseDeploymentReturns(deployment, nil)
+			handler.PauseDeployment(logger, responseRecorder, newTestRequest(&models.PauseDeploymentRequest{ProcessGuid: "process-guid"}))
+
+			Expect(controller.PauseDeploymentCallCount()).To(Equal(1))
+			Expect(parseResponse().Deployment).To(Equal(deployment))
+		})
+	})
+
+	Describe("ResumeDeployment", func() {
+		It("resumes the deployment", func() {
+			controller.ResumeDeploymentReturns(deployment, nil)
+			handler.ResumeDeployment(logger, responseRecorder, newTestRequest(&models.ResumeDeploymentRequest{ProcessGuid: "process-guid"}))
+
+			Expect(controller.ResumeDeploymentCallCount()).To(Equal(1))
+			Expect(parseResponse().Deployment).To(Equal(deployment))
+		})
+	})
+
+	Describe("RollbackDeployment", func() {
+		It("rolls back the deployment", func() {
+			controller.RollbackDeploymentReturns(deployment, nil)
+			handler.RollbackDeployment(logger, responseRecorder, newTestRequest(&models.RollbackDeploymentRequest{ProcessGuid: "process-guid"}))
+
+			Expect(controller.RollbackDeploymentCallCount()).To(Equal(1))
+			Expect(parseResponse().Deployment).To(Equal(deployment))
+		})
+
+		It("responds with transition errors", func() {
+			controller.RollbackDeploymentReturns(nil, models.NewDeploymentTransitionError(models.Deployment_Succeeded, "roll back"))
+			handler.RollbackDeployment(logger, responseRecorder, newTestRequest(&models.RollbackDeploymentRequest{ProcessGuid: "process-guid"}))
+
+			Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidStateTransition))
+		})
+
+		It("rejects requests without a process guid", func() {
+			handler.RollbackDeployment(logger, responseRecorder, newTestRequest(&models.RollbackDeploymentRequest{}))
+
+			Expect(controller.RollbackDeploymentCallCount()).To(Equal(0))
+			Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs/admission"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/events/calculator"
@@ -27,6 +28,7 @@ const BbsLogSource = "DIEGO-API"
 type DesiredLRPHandler struct {
 	desiredLRPDB         db.DesiredLRPDB
 	actualLRPDB          db.ActualLRPDB
+	admitter             admission.Admitter
 	desiredHub           events.Hub
 	actualHub            events.Hub
 	actualLRPInstanceHub events.Hub
@@ -42,6 +44,7 @@ func NewDesiredLRPHandler(
 	updateWorkersCount int,
 	desiredLRPDB db.DesiredLRPDB,
 	actualLRPDB db.ActualLRPDB,
+	admitter admission.Admitter,
 	desiredHub events.Hub,
 	actualHub events.Hub,
 	actualLRPInstanceHub events.Hub,
@@ -54,6 +57,7 @@ func NewDesiredLRPHandler(
 	return &DesiredLRPHandler{
 		desiredLRPDB:         desiredLRPDB,
 		actualLRPDB:          actualLRPDB,
+		admitter:             admitter,
 		desiredHub:           desiredHub,
 		actualHub:            actualHub,
 		actualLRPInstanceHub: actualLRPInstanceHub,
@@ -75,18 +79,7 @@ func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{Domain: request.Domain, ProcessGuids: request.ProcessGuids, AppGuids: request.AppGuids}
-
-		var desiredLRPs []*models.DesiredLRP
-		desiredLRPs, err = h.desiredLRPDB.DesiredLRPs(req.Context(), logger, filter)
-		for i, d := range desiredLRPs {
-			desiredLRPs[i] = d.VersionDownTo(targetVersion).PopulateMetricsGuid()
-			if len(desiredLRPs[i].CachedDependencies) == 0 {
-				desiredLRPs[i].CachedDependencies = nil
-			}
-		}
-
-		response.DesiredLrps = desiredLRPs
+		err = h.listDesiredLRPs(req.Context(), logger, targetVersion, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -95,6 +88,32 @@ func (h *DesiredLRPHandler) commonDesiredLRPs(logger lager.Logger, targetVersion
 
 }
 
+// listDesiredLRPs sets the desired LRPs of the page the request asks for on
+// the response, in the target version.
+func (h *DesiredLRPHandler) listDesiredLRPs(ctx context.Context, logger lager.Logger, targetVersion format.Version, request *models.DesiredLRPsRequest, response *models.DesiredLRPsResponse) error {
+	filter := models.DesiredLRPFilter{
+		Domain:        request.Domain,
+		ProcessGuids:  request.ProcessGuids,
+		AppGuids:      request.AppGuids,
+		PageSize:      request.PageSize,
+		PageToken:     request.PageToken,
+		LabelSelector: request.LabelSelector,
+	}
+
+	desiredLRPs, metadata, err := h.desiredLRPDB.ListDesiredLRPs(ctx, logger, filter)
+	response.ResourceVersion = metadata.ResourceVersion
+	response.NextPageToken = metadata.NextPageToken
+	for i, d := range desiredLRPs {
+		desiredLRPs[i] = d.VersionDownTo(targetVersion).PopulateMetricsGuid()
+		if len(desiredLRPs[i].CachedDependencies) == 0 {
+			desiredLRPs[i].CachedDependencies = nil
+		}
+	}
+
+	response.DesiredLrps = desiredLRPs
+	return err
+}
+
 func (h *DesiredLRPHandler) DesiredLRPs(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	h.commonDesiredLRPs(logger, format.V3, w, req)
 }
@@ -112,12 +131,7 @@ func (h *DesiredLRPHandler) commonDesiredLRPByProcessGuid(logger lager.Logger, t
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		var desiredLRP *models.DesiredLRP
-		desiredLRP, err = h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger, request.ProcessGuid)
-		if desiredLRP != nil {
-			desiredLRP = desiredLRP.VersionDownTo(targetVersion).PopulateMetricsGuid()
-		}
-		response.DesiredLrp = desiredLRP
+		err = h.desiredLRPByProcessGuid(req.Context(), logger, targetVersion, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -126,6 +140,17 @@ func (h *DesiredLRPHandler) commonDesiredLRPByProcessGuid(logger lager.Logger, t
 
 }
 
+// desiredLRPByProcessGuid sets the desired LRP of the process guid of the
+// request on the response, in the target version.
+func (h *DesiredLRPHandler) desiredLRPByProcessGuid(ctx context.Context, logger lager.Logger, targetVersion format.Version, request *models.DesiredLRPByProcessGuidRequest, response *models.DesiredLRPResponse) error {
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger, request.ProcessGuid)
+	if desiredLRP != nil {
+		desiredLRP = desiredLRP.VersionDownTo(targetVersion).PopulateMetricsGuid()
+	}
+	response.DesiredLrp = desiredLRP
+	return err
+}
+
 func (h *DesiredLRPHandler) DesiredLRPByProcessGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	h.commonDesiredLRPByProcessGuid(logger, format.V3, w, req)
 }
@@ -145,12 +170,7 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfos(logger lager.Logger, w htt
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{
-			Domain:       request.Domain,
-			ProcessGuids: request.ProcessGuids,
-			AppGuids:     request.AppGuids,
-		}
-		response.DesiredLrpSchedulingInfos, err = h.desiredLRPDB.DesiredLRPSchedulingInfos(req.Context(), logger, filter)
+		err = h.desiredLRPSchedulingInfos(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -158,6 +178,19 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfos(logger lager.Logger, w htt
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DesiredLRPHandler) desiredLRPSchedulingInfos(ctx context.Context, logger lager.Logger, request *models.DesiredLRPsRequest, response *models.DesiredLRPSchedulingInfosResponse) error {
+	filter := models.DesiredLRPFilter{
+		Domain:        request.Domain,
+		ProcessGuids:  request.ProcessGuids,
+		AppGuids:      request.AppGuids,
+		LabelSelector: request.LabelSelector,
+	}
+	schedulingInfos, metadata, err := h.desiredLRPDB.ListDesiredLRPSchedulingInfos(ctx, logger, filter)
+	response.DesiredLrpSchedulingInfos = schedulingInfos
+	response.ResourceVersion = metadata.ResourceVersion
+	return err
+}
+
 func (h *DesiredLRPHandler) DesiredLRPSchedulingInfoByProcessGuid(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("desired-lrp-scheduling-info-by-process-guid").WithTraceInfo(req)
@@ -169,7 +202,7 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfoByProcessGuid(logger lager.L
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		response.DesiredLrpSchedulingInfo, err = h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(req.Context(), logger, request.ProcessGuid)
+		err = h.desiredLRPSchedulingInfoByProcessGuid(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -177,6 +210,12 @@ func (h *DesiredLRPHandler) DesiredLRPSchedulingInfoByProcessGuid(logger lager.L
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DesiredLRPHandler) desiredLRPSchedulingInfoByProcessGuid(ctx context.Context, logger lager.Logger, request *models.DesiredLRPByProcessGuidRequest, response *models.DesiredLRPSchedulingInfoByProcessGuidResponse) error {
+	var err error
+	response.DesiredLrpSchedulingInfo, err = h.desiredLRPDB.DesiredLRPSchedulingInfoByProcessGuid(ctx, logger, request.ProcessGuid)
+	return err
+}
+
 func (h *DesiredLRPHandler) DesiredLRPRoutingInfos(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("desired-lrp-routing-infos")
@@ -188,11 +227,7 @@ func (h *DesiredLRPHandler) DesiredLRPRoutingInfos(logger lager.Logger, w http.R
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		filter := models.DesiredLRPFilter{
-			Domain:       request.Domain,
-			ProcessGuids: request.ProcessGuids,
-		}
-		response.DesiredLrps, err = h.desiredLRPDB.DesiredLRPRoutingInfos(req.Context(), logger, filter)
+		err = h.desiredLRPRoutingInfos(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
@@ -200,6 +235,16 @@ func (h *DesiredLRPHandler) DesiredLRPRoutingInfos(logger lager.Logger, w http.R
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DesiredLRPHandler) desiredLRPRoutingInfos(ctx context.Context, logger lager.Logger, request *models.DesiredLRPsRequest, response *models.DesiredLRPsResponse) error {
+	filter := models.DesiredLRPFilter{
+		Domain:       request.Domain,
+		ProcessGuids: request.ProcessGuids,
+	}
+	var err error
+	response.DesiredLrps, err = h.desiredLRPDB.DesiredLRPRoutingInfos(ctx, logger, filter)
+	return err
+}
+
 func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("desire-lrp").WithTraceInfo(req)
 
@@ -210,32 +255,40 @@ func (h *DesiredLRPHandler) DesireDesiredLRP(logger lager.Logger, w http.Respons
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
-		logger.Error("failed-parsing-request", err)
-		response.Error = models.ConvertError(err)
-		if err = h.logDesiredLrpParsingErrors(response.Error, request.GetDesiredLrp().GetProcessGuid()); err != nil {
-			logger.Error("failed-sending-app-logs", err)
-		}
+		response.Error = h.invalidRequest(logger, err, request.GetDesiredLrp().GetProcessGuid())
 		return
 	}
 
-	err = h.desiredLRPDB.DesireLRP(req.Context(), logger, request.DesiredLrp)
+	err = h.desireDesiredLRP(trace.ContextWithRequestId(req), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// desireDesiredLRP desires the LRP of the request and starts its instances.
+func (h *DesiredLRPHandler) desireDesiredLRP(ctx context.Context, logger lager.Logger, request *models.DesireLRPRequest, response *models.DesiredLRPLifecycleResponse) error {
+	var err error
+	request.DesiredLrp, err = h.admitter.AdmitDesiredLRP(ctx, logger, request.DesiredLrp)
+	if err != nil {
+		return err
+	}
+
+	writeCtx, writeVersion := db.WithWriteVersion(ctx)
+	err = h.desiredLRPDB.DesireLRP(writeCtx, logger, request.DesiredLrp)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger, request.DesiredLrp.ProcessGuid)
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger, request.DesiredLrp.ProcessGuid)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 
-	go h.desiredHub.Emit(models.NewDesiredLRPCreatedEvent(desiredLRP, trace.RequestIdFromRequest(req)))
+	go h.desiredHub.Emit(writeVersion.Versioned(models.NewDesiredLRPCreatedEvent(desiredLRP, trace.RequestIdFromContext(ctx))))
 
 	schedulingInfo := request.DesiredLrp.DesiredLRPSchedulingInfo()
 	if schedulingInfo.Instances > 0 {
-		h.startInstanceRange(trace.ContextWithRequestId(req), logger, 0, schedulingInfo.Instances, &schedulingInfo)
+		h.startInstanceRange(ctx, logger, 0, schedulingInfo.Instances, &schedulingInfo)
 	}
+	return nil
 }
 
 func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -248,29 +301,38 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 
 	err := parseRequest(logger, req, request)
 	if err != nil {
-		logger.Error("failed-parsing-request", err)
-		response.Error = models.ConvertError(err)
-		if err = h.logDesiredLrpParsingErrors(response.Error, request.GetProcessGuid()); err != nil {
-			logger.Error("failed-sending-app-logs", err)
-		}
+		response.Error = h.invalidRequest(logger, err, request.GetProcessGuid())
 		return
 	}
 
+	err = h.updateDesiredLRP(trace.ContextWithRequestId(req), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// updateDesiredLRP applies the update of the request to the desired LRP, and
+// starts, stops or updates its instances to match.
+func (h *DesiredLRPHandler) updateDesiredLRP(ctx context.Context, logger lager.Logger, request *models.UpdateDesiredLRPRequest, response *models.DesiredLRPLifecycleResponse) error {
 	logger = logger.WithData(lager.Data{"guid": request.ProcessGuid})
 
+	var err error
+	request.Update, err = h.admitter.AdmitDesiredLRPUpdate(ctx, logger, request.ProcessGuid, request.Update)
+	if err != nil {
+		return err
+	}
+
 	logger.Debug("updating-desired-lrp")
-	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(req.Context(), logger, request.ProcessGuid, request.Update)
+	writeCtx, writeVersion := db.WithWriteVersion(ctx)
+	beforeDesiredLRP, err := h.desiredLRPDB.UpdateDesiredLRP(writeCtx, logger, request.ProcessGuid, request.Update)
 	if err != nil {
 		logger.Debug("failed-updating-desired-lrp")
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 	logger.Debug("completed-updating-desired-lrp")
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger, request.ProcessGuid)
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger, request.ProcessGuid)
 	if err != nil {
 		logger.Error("failed-fetching-desired-lrp", err)
-		return
+		return nil
 	}
 
 	if request.Update.InstancesExists() {
@@ -283,13 +345,13 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 		if requestedInstances > 0 {
 			logger.Debug("increasing-the-instances")
 			schedulingInfo := desiredLRP.DesiredLRPSchedulingInfo()
-			h.startInstanceRange(trace.ContextWithRequestId(req), logger, previousInstanceCount, request.Update.GetInstances(), &schedulingInfo)
+			h.startInstanceRange(ctx, logger, previousInstanceCount, request.Update.GetInstances(), &schedulingInfo)
 		}
 
 		if requestedInstances < 0 {
 			logger.Debug("decreasing-the-instances")
 			numExtraActualLRP := previousInstanceCount + requestedInstances
-			h.stopInstancesFrom(trace.ContextWithRequestId(req), logger, request.ProcessGuid, int(numExtraActualLRP))
+			h.stopInstancesFrom(ctx, logger, request.ProcessGuid, int(numExtraActualLRP))
 		}
 	}
 
@@ -297,10 +359,11 @@ func (h *DesiredLRPHandler) UpdateDesiredLRP(logger lager.Logger, w http.Respons
 	metricTagsUpdated := request.Update.IsMetricTagsUpdated(beforeDesiredLRP.MetricTags)
 
 	if internalRoutesUpdated || metricTagsUpdated {
-		h.updateInstances(trace.ContextWithRequestId(req), logger, request.ProcessGuid, request.Update, internalRoutesUpdated, metricTagsUpdated)
+		h.updateInstances(ctx, logger, request.ProcessGuid, request.Update, internalRoutesUpdated, metricTagsUpdated)
 	}
 
-	go h.desiredHub.Emit(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP, trace.RequestIdFromRequest(req)))
+	go h.desiredHub.Emit(writeVersion.Versioned(models.NewDesiredLRPChangedEvent(beforeDesiredLRP, desiredLRP, trace.RequestIdFromContext(ctx))))
+	return nil
 }
 
 func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -316,23 +379,31 @@ func (h *DesiredLRPHandler) RemoveDesiredLRP(logger lager.Logger, w http.Respons
 		response.Error = models.ConvertError(err)
 		return
 	}
+
+	err = h.removeDesiredLRP(trace.ContextWithRequestId(req), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// removeDesiredLRP removes the desired LRP of the request and stops its
+// instances.
+func (h *DesiredLRPHandler) removeDesiredLRP(ctx context.Context, logger lager.Logger, request *models.RemoveDesiredLRPRequest, response *models.DesiredLRPLifecycleResponse) error {
 	logger = logger.WithData(lager.Data{"process_guid": request.ProcessGuid})
 
-	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(req.Context(), logger.Session("fetch-desired"), request.ProcessGuid)
+	desiredLRP, err := h.desiredLRPDB.DesiredLRPByProcessGuid(ctx, logger.Session("fetch-desired"), request.ProcessGuid)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 
-	err = h.desiredLRPDB.RemoveDesiredLRP(req.Context(), logger.Session("remove-desired"), request.ProcessGuid)
+	writeCtx, writeVersion := db.WithWriteVersion(ctx)
+	err = h.desiredLRPDB.RemoveDesiredLRP(writeCtx, logger.Session("remove-desired"), request.ProcessGuid)
 	if err != nil {
-		response.Error = models.ConvertError(err)
-		return
+		return err
 	}
 
-	go h.desiredHub.Emit(models.NewDesiredLRPRemovedEvent(desiredLRP, trace.RequestIdFromRequest(req)))
+	go h.desiredHub.Emit(writeVersion.Versioned(models.NewDesiredLRPRemovedEvent(desiredLRP, trace.RequestIdFromContext(ctx))))
 
-	h.stopInstancesFrom(trace.ContextWithRequestId(req), logger, request.ProcessGuid, 0)
+	h.stopInstancesFrom(ctx, logger, request.ProcessGuid, 0)
+	return nil
 }
 
 func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager.Logger, lower, upper int32, schedulingInfo *models.DesiredLRPSchedulingInfo) {
@@ -350,7 +421,9 @@ func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager
 	start := auctioneer.NewLRPStartRequestFromSchedulingInfo(schedulingInfo, createdIndices...)
 
 	logger.Info("start-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": createdIndices})
+	_, auctioneerSpan := trace.StartClientSpan(ctx, "auctioneer.RequestLRPAuctions")
 	err := h.auctioneerClient.RequestLRPAuctions(logger, trace.RequestIdFromContext(ctx), []*auctioneer.LRPStartRequest{&start})
+	trace.EndSpan(auctioneerSpan, err)
 	logger.Info("finished-lrp-auction-request", lager.Data{"app_guid": schedulingInfo.ProcessGuid, "indices": createdIndices})
 	if err != nil {
 		logger.Error("failed-to-request-auction", err)
@@ -358,12 +431,14 @@ func (h *DesiredLRPHandler) startInstanceRange(ctx context.Context, logger lager
 }
 
 func (h *DesiredLRPHandler) createUnclaimedActualLRPs(ctx context.Context, logger lager.Logger, keys []*models.ActualLRPKey) []int {
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	count := len(keys)
 	createdIndicesChan := make(chan int, count)
 
 	eventCalculator := calculator.ActualLRPEventCalculator{
 		ActualLRPGroupHub:    h.actualHub,
 		ActualLRPInstanceHub: h.actualLRPInstanceHub,
+		WriteVersion:         writeVersion,
 	}
 
 	works := make([]func(), count)
@@ -406,6 +481,7 @@ func (h *DesiredLRPHandler) createUnclaimedActualLRPs(ctx context.Context, logge
 
 func (h *DesiredLRPHandler) stopInstancesFrom(ctx context.Context, logger lager.Logger, processGuid string, index int) {
 	logger = logger.Session("stop-instances-from", lager.Data{"process_guid": processGuid, "index": index})
+	ctx, writeVersion := db.WithWriteVersion(ctx)
 	actualLRPs, err := h.actualLRPDB.ActualLRPs(ctx, logger.Session("fetch-actuals"), models.ActualLRPFilter{ProcessGuid: processGuid})
 	if err != nil {
 		logger.Error("failed-fetching-actual-lrps", err)
@@ -424,8 +500,8 @@ func (h *DesiredLRPHandler) stopInstancesFrom(ctx context.Context, logger lager.
 						logger.Error("failed-removing-lrp-instance", err)
 					} else {
 						//lint:ignore SA1019 - implementing deprecated logic until it is removed
-						go h.actualHub.Emit(models.NewActualLRPRemovedEvent(lrp.ToActualLRPGroup()))
-						go h.actualLRPInstanceHub.Emit(models.NewActualLRPInstanceRemovedEvent(lrp, trace.RequestIdFromContext(ctx)))
+						go h.actualHub.Emit(writeVersion.Versioned(models.NewActualLRPRemovedEvent(lrp.ToActualLRPGroup())))
+						go h.actualLRPInstanceHub.Emit(writeVersion.Versioned(models.NewActualLRPInstanceRemovedEvent(lrp, trace.RequestIdFromContext(ctx))))
 					}
 				default:
 					cellPresence, err := h.serviceClient.CellById(logger, lrp.CellId)
@@ -440,7 +516,9 @@ func (h *DesiredLRPHandler) stopInstancesFrom(ctx context.Context, logger lager.
 					}
 					logger.Debug("stopping-lrp-instance")
 					go func() {
+						_, repSpan := trace.StartClientSpan(ctx, "rep.StopLRPInstance")
 						err := repClient.StopLRPInstance(logger, lrp.ActualLRPKey, lrp.ActualLRPInstanceKey)
+						trace.EndSpan(repSpan, err)
 						if err != nil {
 							logger.Error("failed-stopping-lrp-instance", err)
 						}
@@ -498,7 +576,9 @@ func (h *DesiredLRPHandler) updateInstances(ctx context.Context, logger lager.Lo
 
 			lrpUpdate := rep.NewLRPUpdate(lrp.ActualLRPInstanceKey.InstanceGuid, lrp.ActualLRPKey, internalRoutes, metricTags)
 			go func() {
+				_, repSpan := trace.StartClientSpan(ctx, "rep.UpdateLRPInstance")
 				err := repClient.UpdateLRPInstance(logger, lrpUpdate)
+				trace.EndSpan(repSpan, err)
 				if err != nil {
 					logger.Error("updating-lrp-instance", err)
 				}
@@ -507,6 +587,18 @@ func (h *DesiredLRPHandler) updateInstances(ctx context.Context, logger lager.Lo
 	}
 }
 
+// invalidRequest returns the error a request to desire or update the LRP of
+// the process guid that failed to parse is answered with, and logs it to the
+// app of the LRP.
+func (h *DesiredLRPHandler) invalidRequest(logger lager.Logger, err error, processGuid string) *models.Error {
+	logger.Error("failed-parsing-request", err)
+	modelErr := models.ConvertError(err)
+	if err = h.logDesiredLrpParsingErrors(modelErr, processGuid); err != nil {
+		logger.Error("failed-sending-app-logs", err)
+	}
+	return modelErr
+}
+
 func (h *DesiredLRPHandler) logDesiredLrpParsingErrors(err *models.Error, processGuid string) error {
 	appGuid := parseAppGuidFromProcessGuid(processGuid)
 	if appGuid == "" {
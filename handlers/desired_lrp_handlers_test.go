@@ -13,6 +13,8 @@ import (
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/auctioneer/auctioneerfakes"
+	"code.cloudfoundry.org/bbs/admission/admissionfakes"
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/db/dbfakes"
 	"code.cloudfoundry.org/bbs/events/eventfakes"
 	"code.cloudfoundry.org/bbs/format"
@@ -34,6 +36,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		logger               *lagertest.TestLogger
 		fakeDesiredLRPDB     *dbfakes.FakeDesiredLRPDB
 		fakeActualLRPDB      *dbfakes.FakeActualLRPDB
+		fakeAdmitter         *admissionfakes.FakeAdmitter
 		fakeAuctioneerClient *auctioneerfakes.FakeClient
 		fakeMetronClient     *mfakes.FakeIngressClient
 		desiredHub           *eventfakes.FakeHub
@@ -55,6 +58,13 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		var err error
 		fakeDesiredLRPDB = new(dbfakes.FakeDesiredLRPDB)
 		fakeActualLRPDB = new(dbfakes.FakeActualLRPDB)
+		fakeAdmitter = new(admissionfakes.FakeAdmitter)
+		fakeAdmitter.AdmitDesiredLRPStub = func(_ context.Context, _ lager.Logger, lrp *models.DesiredLRP) (*models.DesiredLRP, error) {
+			return lrp, nil
+		}
+		fakeAdmitter.AdmitDesiredLRPUpdateStub = func(_ context.Context, _ lager.Logger, _ string, update *models.DesiredLRPUpdate) (*models.DesiredLRPUpdate, error) {
+			return update, nil
+		}
 		fakeAuctioneerClient = new(auctioneerfakes.FakeClient)
 		fakeMetronClient = &mfakes.FakeIngressClient{}
 		logger = lagertest.NewTestLogger("test")
@@ -71,6 +81,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			5,
 			fakeDesiredLRPDB,
 			fakeActualLRPDB,
+			fakeAdmitter,
 			desiredHub,
 			actualHub,
 			actualLRPInstanceHub,
@@ -102,7 +113,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			BeforeEach(func() {
 				desiredLRPs = []*models.DesiredLRP{&desiredLRP1, &desiredLRP2}
-				fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPs, nil)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns(desiredLRPs, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of desired lrps", func() {
@@ -128,7 +139,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 						&models.DesiredLRP{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}},
 						&models.DesiredLRP{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}},
 					}
-					fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPsWithImageLayers, nil)
+					fakeDesiredLRPDB.ListDesiredLRPsReturns(desiredLRPsWithImageLayers, db.ListMetadata{}, nil)
 
 					for _, d := range desiredLRPsWithImageLayers {
 						desiredLRP := d.Copy()
@@ -155,7 +166,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 						{MetricTags: map[string]*models.MetricTagValue{"source_id": {Static: "some-guid"}}},
 						{MetricsGuid: "some-metrics-guid"},
 					}
-					fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPsWithMetricTags, nil)
+					fakeDesiredLRPDB.ListDesiredLRPsReturns(desiredLRPsWithMetricTags, db.ListMetadata{}, nil)
 
 					for _, d := range desiredLRPsWithMetricTags {
 						desiredLRP := d.Copy()
@@ -176,8 +187,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -188,8 +199,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
@@ -200,8 +211,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the process guid filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter.ProcessGuids).To(Equal([]string{"g1", "g2"}))
 				})
 			})
@@ -209,7 +220,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns no desired lrps", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, nil)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -225,7 +236,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -237,7 +248,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -269,7 +280,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 		Context("when reading desired lrps from DB succeeds", func() {
 
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{desiredLRP1.Copy(), desiredLRP2.Copy()}, nil)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{desiredLRP1.Copy(), desiredLRP2.Copy()}, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of desired lrps", func() {
@@ -291,7 +302,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 						{MetricTags: map[string]*models.MetricTagValue{"source_id": {Static: "some-guid"}}},
 						{MetricsGuid: "some-metrics-guid"},
 					}
-					fakeDesiredLRPDB.DesiredLRPsReturns(desiredLRPsWithMetricTags, nil)
+					fakeDesiredLRPDB.ListDesiredLRPsReturns(desiredLRPsWithMetricTags, db.ListMetadata{}, nil)
 
 					for _, d := range desiredLRPsWithMetricTags {
 						desiredLRP := d.Copy()
@@ -312,8 +323,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -324,8 +335,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
@@ -336,11 +347,65 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the process guid filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 					Expect(filter.ProcessGuids).To(Equal([]string{"g1", "g2"}))
 				})
 			})
+
+			Context("and paginating", func() {
+				var pageToken string
+
+				BeforeEach(func() {
+					desiredLRP2.ProcessGuid = "process-guid-2"
+					fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{desiredLRP1.Copy(), desiredLRP2.Copy()}, db.ListMetadata{}, nil)
+
+					pageToken = models.PageToken{ProcessGuid: "process-guid-0"}.Encode()
+					requestBody = &models.DesiredLRPsRequest{PageSize: 2, PageToken: pageToken}
+				})
+
+				It("call the DB with the page size and token", func() {
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
+					Expect(filter.PageSize).To(BeEquivalentTo(2))
+					Expect(filter.PageToken).To(Equal(pageToken))
+				})
+
+				It("does not return a next page token when the list is complete", func() {
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.NextPageToken).To(BeEmpty())
+				})
+
+				Context("when more desired lrps follow the page", func() {
+					BeforeEach(func() {
+						fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{desiredLRP1.Copy(), desiredLRP2.Copy()}, db.ListMetadata{NextPageToken: "next-page-token"}, nil)
+					})
+
+					It("returns the next page token of the list", func() {
+						response := models.DesiredLRPsResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.NextPageToken).To(Equal("next-page-token"))
+					})
+				})
+			})
+		})
+
+		Context("when the page token is invalid", func() {
+			BeforeEach(func() {
+				requestBody = &models.DesiredLRPsRequest{PageSize: 2, PageToken: "not a token"}
+			})
+
+			It("returns an invalid request error without calling the DB", func() {
+				Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(0))
+
+				response := models.DesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
 		})
 
 		Context("when filtering by app guids", func() {
@@ -349,15 +414,50 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			})
 
 			It("passes the app guid filter to the DB", func() {
-				Expect(fakeDesiredLRPDB.DesiredLRPsCallCount()).To(Equal(1))
-				_, _, filter := fakeDesiredLRPDB.DesiredLRPsArgsForCall(0)
+				Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+				_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
 				Expect(filter.AppGuids).To(Equal([]string{"app-guid-1"}))
 			})
 		})
 
+		Context("when filtering by label selector", func() {
+			var selector []*models.LabelSelectorRequirement
+
+			BeforeEach(func() {
+				selector = []*models.LabelSelectorRequirement{
+					{Key: "team", Operator: models.LabelSelectorOperatorIn, Values: []string{"a", "b"}},
+				}
+				requestBody = &models.DesiredLRPsRequest{LabelSelector: selector}
+			})
+
+			It("passes the label selector to the DB", func() {
+				Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(1))
+				_, _, filter := fakeDesiredLRPDB.ListDesiredLRPsArgsForCall(0)
+				Expect(filter.LabelSelector).To(Equal(selector))
+			})
+
+			Context("and the selector is invalid", func() {
+				BeforeEach(func() {
+					requestBody = &models.DesiredLRPsRequest{
+						LabelSelector: []*models.LabelSelectorRequirement{
+							{Key: "team", Operator: models.LabelSelectorOperatorEquals},
+						},
+					}
+				})
+
+				It("returns an invalid request error", func() {
+					Expect(fakeDesiredLRPDB.ListDesiredLRPsCallCount()).To(Equal(0))
+					response := models.DesiredLRPsResponse{}
+					err := response.Unmarshal(responseRecorder.Body.Bytes())
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				})
+			})
+		})
+
 		Context("when the DB returns no desired lrps", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, nil)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -373,7 +473,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -385,7 +485,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPsReturns([]*models.DesiredLRP{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.ListDesiredLRPsReturns([]*models.DesiredLRP{}, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -397,6 +497,22 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the DB has a resource version", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.ListDesiredLRPsReturns(nil, db.ListMetadata{ResourceVersion: 42}, nil)
+			})
+
+			It("returns it along with the list", func() {
+				response := models.DesiredLRPsResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ResourceVersion).To(BeEquivalentTo(42))
+			})
+		})
+
 	})
 
 	Describe("DesiredLRPByProcessGuid_r2", func() {
@@ -668,7 +784,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			BeforeEach(func() {
 				schedulingInfos = []*models.DesiredLRPSchedulingInfo{&schedulingInfo1, &schedulingInfo2}
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns(schedulingInfos, nil)
+				fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosReturns(schedulingInfos, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of desired lrps", func() {
@@ -683,8 +799,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 			Context("and no filter is provided", func() {
 				It("call the DB with no filters to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter).To(Equal(models.DesiredLRPFilter{}))
 				})
 			})
@@ -695,8 +811,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the domain filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter.Domain).To(Equal("domain-1"))
 				})
 			})
@@ -707,8 +823,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("call the DB with the process guids filter to retrieve the desired lrps", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter.ProcessGuids).To(Equal([]string{"guid-1", "guid-2"}))
 				})
 			})
@@ -719,8 +835,8 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				})
 
 				It("passes the appids to the DB", func() {
-					Expect(fakeDesiredLRPDB.DesiredLRPSchedulingInfosCallCount()).To(Equal(1))
-					_, _, filter := fakeDesiredLRPDB.DesiredLRPSchedulingInfosArgsForCall(0)
+					Expect(fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosCallCount()).To(Equal(1))
+					_, _, filter := fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosArgsForCall(0)
 					Expect(filter.AppGuids).To(Equal([]string{"appid-1"}))
 				})
 			})
@@ -728,7 +844,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns no desired lrps", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, nil)
+				fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, db.ListMetadata{}, nil)
 			})
 
 			It("returns an empty list", func() {
@@ -744,7 +860,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, models.NewUnrecoverableError(nil))
+				fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -756,7 +872,7 @@ var _ = Describe("DesiredLRP Handlers", func() {
 
 		Context("when the DB errors out", func() {
 			BeforeEach(func() {
-				fakeDesiredLRPDB.DesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, models.ErrUnknownError)
+				fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosReturns([]*models.DesiredLRPSchedulingInfo{}, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -768,6 +884,22 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the DB has a resource version", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.ListDesiredLRPSchedulingInfosReturns(nil, db.ListMetadata{ResourceVersion: 42}, nil)
+			})
+
+			It("returns it along with the list", func() {
+				response := models.DesiredLRPSchedulingInfosResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ResourceVersion).To(BeEquivalentTo(42))
+			})
+		})
+
 	})
 
 	Describe("DesiredLRPSchedulingInfoByProcessGuid", func() {
@@ -1213,6 +1345,68 @@ var _ = Describe("DesiredLRP Handlers", func() {
 				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
 			})
 		})
+
+		Context("when admission modifies the desired lrp", func() {
+			var admittedLRP *models.DesiredLRP
+
+			BeforeEach(func() {
+				admittedLRP = model_helpers.NewValidDesiredLRP("some-guid")
+				admittedLRP.Instances = 5
+				admittedLRP.LogRateLimit = &models.LogRateLimit{BytesPerSecond: 1024}
+				fakeAdmitter.AdmitDesiredLRPReturns(admittedLRP, nil)
+			})
+
+			It("admits the desired lrp", func() {
+				Expect(fakeAdmitter.AdmitDesiredLRPCallCount()).To(Equal(1))
+				_, _, actualLRP := fakeAdmitter.AdmitDesiredLRPArgsForCall(0)
+				Expect(actualLRP).To(Equal(desiredLRP))
+			})
+
+			It("desires the admitted desired lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(1))
+				_, _, actualLRP := fakeDesiredLRPDB.DesireLRPArgsForCall(0)
+				Expect(actualLRP).To(Equal(admittedLRP))
+			})
+		})
+
+		Context("when admission denies the desired lrp", func() {
+			BeforeEach(func() {
+				fakeAdmitter.AdmitDesiredLRPReturns(nil, models.NewAdmissionDeniedError("policy", "privileged containers are not allowed"))
+			})
+
+			It("responds with an AdmissionDenied error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_AdmissionDenied))
+				Expect(response.Error.Message).To(ContainSubstring("privileged containers are not allowed"))
+			})
+
+			It("does not desire the lrp", func() {
+				Expect(fakeDesiredLRPDB.DesireLRPCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the desired lrp exceeds the quota of its domain", func() {
+			BeforeEach(func() {
+				fakeDesiredLRPDB.DesireLRPReturns(models.NewQuotaExceededError(desiredLRP.Domain, "instances", 6, 2, 5))
+			})
+
+			It("responds with a QuotaExceeded error", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error.Type).To(Equal(models.Error_QuotaExceeded))
+			})
+
+			It("does not create actual LRPs", func() {
+				Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+			})
+		})
 	})
 
 	Describe("UpdateDesiredLRP", func() {
@@ -1271,6 +1465,48 @@ var _ = Describe("DesiredLRP Handlers", func() {
 			})
 		})
 
+		Context("when admission modifies the update", func() {
+			var admittedUpdate *models.DesiredLRPUpdate
+
+			BeforeEach(func() {
+				admittedUpdate = &models.DesiredLRPUpdate{}
+				admittedUpdate.SetAnnotation("admitted")
+				fakeAdmitter.AdmitDesiredLRPUpdateReturns(admittedUpdate, nil)
+				fakeDesiredLRPDB.UpdateDesiredLRPReturns(beforeDesiredLRP, nil)
+				fakeDesiredLRPDB.DesiredLRPByProcessGuidReturns(afterDesiredLRP, nil)
+			})
+
+			It("admits the update", func() {
+				Expect(fakeAdmitter.AdmitDesiredLRPUpdateCallCount()).To(Equal(1))
+				_, _, actualProcessGuid, actualUpdate := fakeAdmitter.AdmitDesiredLRPUpdateArgsForCall(0)
+				Expect(actualProcessGuid).To(Equal(processGuid))
+				Expect(actualUpdate).To(Equal(update))
+			})
+
+			It("applies the admitted update", func() {
+				Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(1))
+				_, _, _, actualUpdate := fakeDesiredLRPDB.UpdateDesiredLRPArgsForCall(0)
+				Expect(actualUpdate).To(Equal(admittedUpdate))
+			})
+		})
+
+		Context("when admission denies the update", func() {
+			BeforeEach(func() {
+				fakeAdmitter.AdmitDesiredLRPUpdateReturns(nil, models.NewAdmissionDeniedError("policy", "annotations are frozen"))
+			})
+
+			It("responds with an AdmissionDenied error", func() {
+				response := models.DesiredLRPLifecycleResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_AdmissionDenied))
+			})
+
+			It("does not update the desired lrp", func() {
+				Expect(fakeDesiredLRPDB.UpdateDesiredLRPCallCount()).To(Equal(0))
+			})
+		})
+
 		Context("when updating desired lrp in DB succeeds", func() {
 			BeforeEach(func() {
 				fakeDesiredLRPDB.UpdateDesiredLRPReturns(beforeDesiredLRP, nil)
@@ -1326,6 +1562,27 @@ var _ = Describe("DesiredLRP Handlers", func() {
 					}, nil)
 				})
 
+				Context("when the increase exceeds the quota of the domain", func() {
+					BeforeEach(func() {
+						update.SetInstances(5)
+						fakeDesiredLRPDB.UpdateDesiredLRPReturns(nil, models.NewQuotaExceededError("some-domain", "instances", 4, 3, 2))
+					})
+
+					It("responds with a QuotaExceeded error", func() {
+						Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+						response := models.DesiredLRPLifecycleResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(response.Error.Type).To(Equal(models.Error_QuotaExceeded))
+						Expect(response.Error.Message).To(ContainSubstring("2 requested, 3 of 4 in use"))
+					})
+
+					It("does not start any instances", func() {
+						Expect(fakeActualLRPDB.CreateUnclaimedActualLRPCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when the number of instances decreased", func() {
 					var actualLRPs []*models.ActualLRP
 
@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -30,12 +31,18 @@ func (h *DomainHandler) Domains(logger lager.Logger, w http.ResponseWriter, req
 	var err error
 	logger = logger.Session("domains").WithTraceInfo(req)
 	response := &models.DomainsResponse{}
-	response.Domains, err = h.db.FreshDomains(req.Context(), logger)
+	err = h.domains(req.Context(), logger, &models.DomainsRequest{}, response)
 	response.Error = models.ConvertError(err)
 	writeResponse(w, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
 
+func (h *DomainHandler) domains(ctx context.Context, logger lager.Logger, request *models.DomainsRequest, response *models.DomainsResponse) error {
+	var err error
+	response.Domains, err = h.db.FreshDomains(ctx, logger)
+	return err
+}
+
 func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("upsert").WithTraceInfo(req)
@@ -45,10 +52,14 @@ func (h *DomainHandler) Upsert(logger lager.Logger, w http.ResponseWriter, req *
 
 	err = parseRequest(logger, req, request)
 	if err == nil {
-		err = h.db.UpsertDomain(req.Context(), logger, request.Domain, request.Ttl)
+		err = h.upsert(req.Context(), logger, request, response)
 	}
 
 	response.Error = models.ConvertError(err)
 	writeResponse(w, response)
 	exitIfUnrecoverable(logger, h.exitChan, response.Error)
 }
+
+func (h *DomainHandler) upsert(ctx context.Context, logger lager.Logger, request *models.UpsertDomainRequest, response *models.UpsertDomainResponse) error {
+	return h.db.UpsertDomain(ctx, logger, request.Domain, request.Ttl)
+}
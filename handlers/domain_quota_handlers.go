@@ -0,0 +1,127 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type DomainQuotaHandler struct {
+	db       db.DomainQuotaDB
+	exitChan chan<- struct{}
+}
+
+func NewDomainQuotaHandler(db db.DomainQuotaDB, exitChan chan<- struct{}) *DomainQuotaHandler {
+	return &DomainQuotaHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *DomainQuotaHandler) DomainQuotas(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("domain-quotas").WithTraceInfo(req)
+
+	request := &models.DomainQuotasRequest{}
+	response := &models.DomainQuotasResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.domainQuotas(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) domainQuotas(ctx context.Context, logger lager.Logger, request *models.DomainQuotasRequest, response *models.DomainQuotasResponse) error {
+	var err error
+	response.DomainQuotas, err = h.db.DomainQuotas(ctx, logger)
+	return err
+}
+
+func (h *DomainQuotaHandler) SetDomainQuota(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("set-domain-quota").WithTraceInfo(req)
+
+	request := &models.SetDomainQuotaRequest{}
+	response := &models.DomainQuotaResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.setDomainQuota(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) setDomainQuota(ctx context.Context, logger lager.Logger, request *models.SetDomainQuotaRequest, response *models.DomainQuotaResponse) error {
+	var err error
+	response.DomainQuota, err = h.db.SetDomainQuota(ctx, logger, request.DomainQuota)
+	return err
+}
+
+func (h *DomainQuotaHandler) RemoveDomainQuota(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("remove-domain-quota").WithTraceInfo(req)
+
+	request := &models.RemoveDomainQuotaRequest{}
+	response := &models.DomainQuotaLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.removeDomainQuota(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *DomainQuotaHandler) removeDomainQuota(ctx context.Context, logger lager.Logger, request *models.RemoveDomainQuotaRequest, response *models.DomainQuotaLifecycleResponse) error {
+	return h.db.RemoveDomainQuota(ctx, logger, request.Domain)
+}
+
+func (h *DomainQuotaHandler) DomainUsage(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("domain-usage").WithTraceInfo(req)
+
+	request := &models.DomainUsageRequest{}
+	response := &models.DomainUsageResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.domainUsage(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// domainUsage sets the usage of the domain on the response, along with its
+// quota if it has one.
+func (h *DomainQuotaHandler) domainUsage(ctx context.Context, logger lager.Logger, request *models.DomainUsageRequest, response *models.DomainUsageResponse) error {
+	quota, err := h.db.DomainQuotaByDomain(ctx, logger, request.Domain)
+	if err != nil && err != models.ErrResourceNotFound {
+		return err
+	}
+	response.DomainQuota = quota
+
+	response.Usage, err = h.db.DomainUsage(ctx, logger, request.Domain)
+	return err
+}
@@ -0,0 +1,142 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainQuota Handlers", func() {
+	var (
+		logger  *lagertest.TestLogger
+		quotaDB *dbfakes.FakeDomainQuotaDB
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.DomainQuotaHandler
+		exitCh           chan struct{}
+
+		quota *models.DomainQuota
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		quotaDB = new(dbfakes.FakeDomainQuotaDB)
+		handler = handlers.NewDomainQuotaHandler(quotaDB, exitCh)
+
+		quota = &models.DomainQuota{
+			Domain:       "some-domain",
+			MemoryMb:     1024,
+			Instances:    4,
+			RunningTasks: 2,
+		}
+	})
+
+	Describe("DomainQuotas", func() {
+		It("lists the quotas", func() {
+			quotaDB.DomainQuotasReturns([]*models.DomainQuota{quota}, nil)
+			handler.DomainQuotas(logger, responseRecorder, newTestRequest(&models.DomainQuotasRequest{}))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.DomainQuotasResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.DomainQuotas).To(Equal([]*models.DomainQuota{quota}))
+		})
+
+		It("responds with an unrecoverable error and exits", func() {
+			quotaDB.DomainQuotasReturns(nil, models.NewUnrecoverableError(nil))
+			handler.DomainQuotas(logger, responseRecorder, newTestRequest(&models.DomainQuotasRequest{}))
+
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+
+	Describe("SetDomainQuota", func() {
+		It("sets the quota", func() {
+			quotaDB.SetDomainQuotaReturns(quota, nil)
+			handler.SetDomainQuota(logger, responseRecorder, newTestRequest(&models.SetDomainQuotaRequest{DomainQuota: quota}))
+
+			_, _, actualQuota := quotaDB.SetDomainQuotaArgsForCall(0)
+			Expect(actualQuota).To(Equal(quota))
+
+			response := &models.DomainQuotaResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.DomainQuota).To(Equal(quota))
+		})
+
+		It("rejects invalid quotas", func() {
+			quota.MemoryMb = -1
+			handler.SetDomainQuota(logger, responseRecorder, newTestRequest(&models.SetDomainQuotaRequest{DomainQuota: quota}))
+
+			Expect(quotaDB.SetDomainQuotaCallCount()).To(Equal(0))
+			response := &models.DomainQuotaResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Describe("RemoveDomainQuota", func() {
+		It("removes the quota", func() {
+			handler.RemoveDomainQuota(logger, responseRecorder, newTestRequest(&models.RemoveDomainQuotaRequest{Domain: "some-domain"}))
+
+			_, _, domain := quotaDB.RemoveDomainQuotaArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+
+			response := &models.DomainQuotaLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+		})
+
+		It("responds with not found for domains without a quota", func() {
+			quotaDB.RemoveDomainQuotaReturns(models.ErrResourceNotFound)
+			handler.RemoveDomainQuota(logger, responseRecorder, newTestRequest(&models.RemoveDomainQuotaRequest{Domain: "unknown"}))
+
+			response := &models.DomainQuotaLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+
+	Describe("DomainUsage", func() {
+		var usage *models.DomainUsage
+
+		BeforeEach(func() {
+			usage = &models.DomainUsage{Domain: "some-domain", MemoryMb: 512, Instances: 2}
+			quotaDB.DomainUsageReturns(usage, nil)
+		})
+
+		It("responds with the usage and quota of the domain", func() {
+			quotaDB.DomainQuotaByDomainReturns(quota, nil)
+			handler.DomainUsage(logger, responseRecorder, newTestRequest(&models.DomainUsageRequest{Domain: "some-domain"}))
+
+			_, _, domain := quotaDB.DomainUsageArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+
+			response := &models.DomainUsageResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Usage).To(Equal(usage))
+			Expect(response.DomainQuota).To(Equal(quota))
+		})
+
+		It("responds with the usage of domains without a quota", func() {
+			quotaDB.DomainQuotaByDomainReturns(nil, models.ErrResourceNotFound)
+			handler.DomainUsage(logger, responseRecorder, newTestRequest(&models.DomainUsageRequest{Domain: "some-domain"}))
+
+			response := &models.DomainUsageResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Usage).To(Equal(usage))
+			Expect(response.DomainQuota).To(BeNil())
+		})
+	})
+})
@@ -57,10 +57,14 @@ func (h *EvacuationHandler) RemoveEvacuatingActualLRP(logger lager.Logger, w htt
 		return
 	}
 
-	err = h.controller.RemoveEvacuatingActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+	err = h.removeEvacuatingActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *EvacuationHandler) removeEvacuatingActualLRP(ctx context.Context, logger lager.Logger, request *models.RemoveEvacuatingActualLRPRequest, response *models.RemoveEvacuatingActualLRPResponse) error {
+	return h.controller.RemoveEvacuatingActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+}
+
 func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("evacuate-claimed-actual-lrp").WithTraceInfo(req)
 	logger.Info("started")
@@ -79,9 +83,14 @@ func (h *EvacuationHandler) EvacuateClaimedActualLRP(logger lager.Logger, w http
 		return
 	}
 
-	keepContainer, err := h.controller.EvacuateClaimedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+	err = h.evacuateClaimedActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
-	response.KeepContainer = keepContainer
+}
+
+func (h *EvacuationHandler) evacuateClaimedActualLRP(ctx context.Context, logger lager.Logger, request *models.EvacuateClaimedActualLRPRequest, response *models.EvacuationResponse) error {
+	var err error
+	response.KeepContainer, err = h.controller.EvacuateClaimedActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+	return err
 }
 
 func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -101,10 +110,14 @@ func (h *EvacuationHandler) EvacuateCrashedActualLRP(logger lager.Logger, w http
 		return
 	}
 
-	err = h.controller.EvacuateCrashedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ErrorMessage)
+	err = h.evacuateCrashedActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *EvacuationHandler) evacuateCrashedActualLRP(ctx context.Context, logger lager.Logger, request *models.EvacuateCrashedActualLRPRequest, response *models.EvacuationResponse) error {
+	return h.controller.EvacuateCrashedActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ErrorMessage)
+}
+
 func (h *EvacuationHandler) commonEvacuateRunningActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request, useInternalRoutesAndTags bool) {
 	logger = logger.Session("evacuate-running-actual-lrp").WithTraceInfo(req)
 	logger.Info("starting")
@@ -122,22 +135,25 @@ func (h *EvacuationHandler) commonEvacuateRunningActualLRP(logger lager.Logger,
 		return
 	}
 
-	var keepContainer bool
-	var actualLrpInternalRoutes []*models.ActualLRPInternalRoute
-	var metricTags map[string]string
-	if useInternalRoutesAndTags {
-		actualLrpInternalRoutes = request.ActualLrpInternalRoutes
-		metricTags = request.MetricTags
+	if !useInternalRoutesAndTags {
+		request.ActualLrpInternalRoutes = nil
+		request.MetricTags = nil
 	}
+
+	err = h.evacuateRunningActualLRP(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *EvacuationHandler) evacuateRunningActualLRP(ctx context.Context, logger lager.Logger, request *models.EvacuateRunningActualLRPRequest, response *models.EvacuationResponse) error {
 	routable := true
 	if request.RoutableExists() {
 		r := request.GetRoutable()
 		routable = r
 	}
 
-	keepContainer, err = h.controller.EvacuateRunningActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo, actualLrpInternalRoutes, metricTags, routable, request.AvailabilityZone)
-	response.Error = models.ConvertError(err)
-	response.KeepContainer = keepContainer
+	var err error
+	response.KeepContainer, err = h.controller.EvacuateRunningActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey, request.ActualLrpNetInfo, request.ActualLrpInternalRoutes, request.MetricTags, routable, request.AvailabilityZone)
+	return err
 }
 
 func (h *EvacuationHandler) EvacuateRunningActualLRP(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -166,6 +182,10 @@ func (h *EvacuationHandler) EvacuateStoppedActualLRP(logger lager.Logger, w http
 		return
 	}
 
-	err = h.controller.EvacuateStoppedActualLRP(req.Context(), logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+	err = h.evacuateStoppedActualLRP(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *EvacuationHandler) evacuateStoppedActualLRP(ctx context.Context, logger lager.Logger, request *models.EvacuateStoppedActualLRPRequest, response *models.EvacuationResponse) error {
+	return h.controller.EvacuateStoppedActualLRP(ctx, logger, request.ActualLrpKey, request.ActualLrpInstanceKey)
+}
@@ -2,8 +2,11 @@ package handlers
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
@@ -51,7 +54,133 @@ func NewLRPInstanceEventHandler(desiredHub, lrpInstanceHub events.Hub) *LRPInsta
 	}
 }
 
-func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventChan <-chan models.Event, errorChan <-chan error) {
+// streamCursor is the position of an event stream in each of the hubs it
+// merges. It is sent as the SSE event ID, so that a client reconnecting with
+// Last-Event-ID resumes every hub where it left off.
+type streamCursor []uint64
+
+// resumeCursor returns the position given by the Last-Event-ID of the
+// request, or the position after the resource version the request asks for,
+// or the current position of each hub when neither is given. A Last-Event-ID
+// that does not describe a stream over the same hubs is ignored.
+//
+// A hub that cannot resume after the resource version is given position 0,
+// which it reports as expired with a ResyncRequiredEvent.
+func resumeCursor(logger lager.Logger, lastEventID string, resourceVersion uint64, hubs ...events.Hub) streamCursor {
+	if lastEventID != "" {
+		cursor, err := parseStreamCursor(lastEventID, len(hubs))
+		if err == nil {
+			logger.Info("resuming-event-stream", lager.Data{"last_event_id": lastEventID})
+			return cursor
+		}
+		logger.Error("failed-parsing-last-event-id", err, lager.Data{"last_event_id": lastEventID})
+	}
+
+	cursor := make(streamCursor, len(hubs))
+	if resourceVersion != 0 {
+		logger.Info("resuming-event-stream-after-resource-version", lager.Data{"resource_version": resourceVersion})
+		for i, hub := range hubs {
+			id, err := hub.EventIDAtResourceVersion(resourceVersion)
+			if err != nil {
+				logger.Error("failed-resuming-after-resource-version", err, lager.Data{"resource_version": resourceVersion})
+				id = 0
+			}
+			cursor[i] = id
+		}
+		return cursor
+	}
+
+	for i, hub := range hubs {
+		cursor[i] = hub.LastEventID()
+	}
+	return cursor
+}
+
+func parseStreamCursor(lastEventID string, hubCount int) (streamCursor, error) {
+	parts := strings.Split(lastEventID, ",")
+	if len(parts) != hubCount {
+		return nil, fmt.Errorf("expected %d event ids, got %d", hubCount, len(parts))
+	}
+
+	cursor := make(streamCursor, hubCount)
+	for i, part := range parts {
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		cursor[i] = id
+	}
+	return cursor, nil
+}
+
+func (cursor streamCursor) String() string {
+	ids := make([]string, len(cursor))
+	for i, id := range cursor {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(ids, ",")
+}
+
+// streamEvent is an event read from the hub at position hub of the stream's
+// cursor.
+type streamEvent struct {
+	hub   int
+	event events.LoggedEvent
+}
+
+// eventStream merges the events of the hub sources it was opened with. Each
+// of its events advances the cursor, which is how the stream is resumed.
+type eventStream struct {
+	cursor    streamCursor
+	events    chan streamEvent
+	errors    chan error
+	closeChan chan struct{}
+	sources   []events.LoggedEventSource
+}
+
+func newEventStream(cursor streamCursor) *eventStream {
+	return &eventStream{
+		cursor:    cursor,
+		events:    make(chan streamEvent),
+		errors:    make(chan error),
+		closeChan: make(chan struct{}),
+	}
+}
+
+// add starts reading the events of the hub at position hub of the cursor.
+// The source is closed along with the stream.
+func (stream *eventStream) add(hub int, source events.LoggedEventSource, fetchEvent EventFetcher) {
+	stream.sources = append(stream.sources, source)
+	go streamSource(hub, stream.events, stream.errors, stream.closeChan, fetchEvent)
+}
+
+// next returns the next event along with the ID that resumes the stream
+// after it.
+func (stream *eventStream) next(done <-chan struct{}) (string, models.Event, error) {
+	var event streamEvent
+	select {
+	case event = <-stream.events:
+	case err := <-stream.errors:
+		return "", nil, err
+	case <-done:
+		return "", nil, errStreamDone
+	}
+
+	stream.cursor[event.hub] = event.event.ID
+	return stream.cursor.String(), event.event.Event, nil
+}
+
+func (stream *eventStream) Close() {
+	close(stream.closeChan)
+	for i := len(stream.sources) - 1; i >= 0; i-- {
+		// #nosec G104 - the subscriber has gone away, there is nothing to do about a failure to unsubscribe
+		stream.sources[i].Close()
+	}
+}
+
+var errStreamDone = errors.New("event stream done")
+
+func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, stream *eventStream) {
 	w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
 	w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
 	w.Header().Add("Connection", "keep-alive")
@@ -76,27 +205,24 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 		return
 	}
 
-	var event models.Event
-	eventID := 0
-	done := make(chan bool, 1)
+	done := make(chan struct{})
 	go func() {
 		// #nosec G104 - ignore errors when reading hijacked HTTP requests so we don't spam our logs during a DoS
 		rw.ReadFrom(conn)
-		done <- true
+		close(done)
 	}()
 
 	for {
-		select {
-		case event = <-eventChan:
-		case err := <-errorChan:
-			logger.Error("failed-to-get-next-event", err)
-			return
-		case <-done:
+		id, event, err := stream.next(done)
+		if err == errStreamDone {
 			logger.Debug("received-close-notify")
 			return
+		} else if err != nil {
+			logger.Error("failed-to-get-next-event", err)
+			return
 		}
 
-		sseEvent, err := events.NewEventFromModelEvent(eventID, event)
+		sseEvent, err := events.NewEventWithID(id, event)
 		if err != nil {
 			logger.Error("failed-to-marshal-event", err)
 			return
@@ -112,14 +238,12 @@ func streamEventsToResponse(logger lager.Logger, w http.ResponseWriter, eventCha
 
 		fmt.Fprintf(conn, "%x;\r\n", buf.Len())
 		fmt.Fprintf(conn, "%s\r\n", buf.String())
-
-		eventID++
 	}
 }
 
-type EventFetcher func() (models.Event, error)
+type EventFetcher func() (events.LoggedEvent, error)
 
-func streamSource(eventChan chan<- models.Event, errorChan chan<- error, closeChan chan struct{}, fetchEvent EventFetcher) {
+func streamSource(hub int, eventChan chan<- streamEvent, errorChan chan<- error, closeChan chan struct{}, fetchEvent EventFetcher) {
 	for {
 		event, err := fetchEvent()
 		if err != nil {
@@ -130,7 +254,7 @@ func streamSource(eventChan chan<- models.Event, errorChan chan<- error, closeCh
 			return
 		}
 		select {
-		case eventChan <- event:
+		case eventChan <- streamEvent{hub: hub, event: event}:
 		case <-closeChan:
 			return
 		}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/lager/v3"
@@ -19,40 +20,47 @@ func (h *LRPGroupEventsHandler) commonSubscribe(logger lager.Logger, w http.Resp
 		return
 	}
 
+	stream, err := h.subscribe(logger, request, req.Header.Get("Last-Event-ID"), target)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer stream.Close()
+
+	streamEventsToResponse(logger, w, stream)
+}
+
+func (h *LRPGroupEventsHandler) subscribe(logger lager.Logger, request *models.EventsByCellId, lastEventID string, target format.Version) (*eventStream, error) {
 	logger.Info("subscribed-to-event-stream", lager.Data{"cell_id": request.CellId})
 
-	desiredSource, err := h.desiredHub.Subscribe()
+	filter := request.EventFilter()
+	stream := newEventStream(resumeCursor(logger, lastEventID, request.ResourceVersion, h.desiredHub, h.actualHub))
+
+	desiredSource, err := h.desiredHub.Resume(stream.cursor[0], filter)
 	if err != nil {
 		logger.Error("failed-to-subscribe-to-desired-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		return nil, err
 	}
-	defer desiredSource.Close()
 
-	actualSource, err := h.actualHub.Subscribe()
+	actualSource, err := h.actualHub.Resume(stream.cursor[1], filter)
 	if err != nil {
 		logger.Error("failed-to-subscribe-to-actual-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		// #nosec G104 - the subscription has already failed
+		desiredSource.Close()
+		return nil, err
 	}
-	defer actualSource.Close()
-
-	eventChan := make(chan models.Event)
-	errorChan := make(chan error)
-	closeChan := make(chan struct{})
-	defer close(closeChan)
 
 	actualEventsFetcher := actualSource.Next
 	if request.CellId != "" {
-		actualEventsFetcher = func() (models.Event, error) {
+		actualEventsFetcher = func() (events.LoggedEvent, error) {
 			for {
 				event, err := actualSource.Next()
 				if err != nil {
 					return event, err
 				}
 
-				if matches, err := filterByCellID(request.CellId, event, err); err != nil {
-					return nil, err
+				if matches, err := filterByCellID(request.CellId, event.Event, err); err != nil {
+					return events.LoggedEvent{}, err
 				} else if matches {
 					return event, nil
 				}
@@ -60,19 +68,19 @@ func (h *LRPGroupEventsHandler) commonSubscribe(logger lager.Logger, w http.Resp
 		}
 	}
 
-	desiredEventsFetcher := func() (models.Event, error) {
+	desiredEventsFetcher := func() (events.LoggedEvent, error) {
 		event, err := desiredSource.Next()
 		if err != nil {
 			return event, err
 		}
-		event = models.VersionDesiredLRPsTo(event, target)
+		event.Event = models.VersionDesiredLRPsTo(event.Event, target)
 		return event, err
 	}
 
-	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
-	go streamSource(eventChan, errorChan, closeChan, actualEventsFetcher)
+	stream.add(0, desiredSource, desiredEventsFetcher)
+	stream.add(1, actualSource, actualEventsFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	return stream, nil
 }
 
 func (h *LRPGroupEventsHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -94,58 +102,65 @@ func (h *LRPInstanceEventHandler) commonSubscribe(logger lager.Logger, w http.Re
 		return
 	}
 
+	stream, err := h.subscribe(logger, request, req.Header.Get("Last-Event-ID"), target)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer stream.Close()
+
+	streamEventsToResponse(logger, w, stream)
+}
+
+func (h *LRPInstanceEventHandler) subscribe(logger lager.Logger, request *models.EventsByCellId, lastEventID string, target format.Version) (*eventStream, error) {
 	logger.Info("subscribed-to-instance-event-stream", lager.Data{"cell_id": request.CellId})
 
-	desiredSource, err := h.desiredHub.Subscribe()
+	filter := request.EventFilter()
+	stream := newEventStream(resumeCursor(logger, lastEventID, request.ResourceVersion, h.desiredHub, h.lrpInstanceHub))
+
+	desiredSource, err := h.desiredHub.Resume(stream.cursor[0], filter)
 	if err != nil {
 		logger.Error("failed-to-subscribe-to-desired-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		return nil, err
 	}
-	defer desiredSource.Close()
 
-	lrpInstanceSource, err := h.lrpInstanceHub.Subscribe()
+	lrpInstanceSource, err := h.lrpInstanceHub.Resume(stream.cursor[1], filter)
 	if err != nil {
 		logger.Error("failed-to-subscribe-to-actual-instance-event-hub", err)
-		w.WriteHeader(http.StatusInternalServerError)
-		return
+		// #nosec G104 - the subscription has already failed
+		desiredSource.Close()
+		return nil, err
 	}
-	defer lrpInstanceSource.Close()
-
-	eventChan := make(chan models.Event)
-	errorChan := make(chan error)
-	closeChan := make(chan struct{})
-	defer close(closeChan)
 
 	lrpInstanceEventFetcher := lrpInstanceSource.Next
 	if request.CellId != "" {
-		lrpInstanceEventFetcher = func() (models.Event, error) {
+		lrpInstanceEventFetcher = func() (events.LoggedEvent, error) {
 			for {
 				event, err := lrpInstanceSource.Next()
 				if err != nil {
 					return event, err
 				}
 
-				if filterInstanceEventByCellID(request.CellId, event, err) {
+				if filterInstanceEventByCellID(request.CellId, event.Event, err) {
 					return event, nil
 				}
 			}
 		}
 	}
 
-	desiredEventsFetcher := func() (models.Event, error) {
+	desiredEventsFetcher := func() (events.LoggedEvent, error) {
 		event, err := desiredSource.Next()
 		if err != nil {
 			return event, err
 		}
-		event = models.VersionDesiredLRPsTo(event, target)
+		event.Event = models.VersionDesiredLRPsTo(event.Event, target)
 		return event, err
 	}
 
-	go streamSource(eventChan, errorChan, closeChan, desiredEventsFetcher)
-	go streamSource(eventChan, errorChan, closeChan, lrpInstanceEventFetcher)
+	stream.add(0, desiredSource, desiredEventsFetcher)
+	stream.add(1, lrpInstanceSource, lrpInstanceEventFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	return stream, nil
 }
 
 func (h *LRPInstanceEventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -158,33 +173,48 @@ func (h *LRPInstanceEventHandler) Subscribe_r1(logger lager.Logger, w http.Respo
 
 func (h *TaskEventHandler) commonSubscribe(logger lager.Logger, w http.ResponseWriter, req *http.Request, target format.Version) {
 	logger = logger.Session("tasks-subscribe-r0").WithTraceInfo(req)
-	logger.Info("subscribed-to-tasks-event-stream")
 
-	taskSource, err := h.taskHub.Subscribe()
+	request := &models.EventsByCellId{}
+	err := parseRequest(logger, req, request)
 	if err != nil {
-		logger.Error("failed-to-subscribe-to-task-event-hub", err)
+		logger.Error("failed-parsing-request", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
-	defer taskSource.Close()
 
-	eventChan := make(chan models.Event)
-	errorChan := make(chan error)
-	closeChan := make(chan struct{})
-	defer close(closeChan)
+	stream, err := h.subscribe(logger, request, req.Header.Get("Last-Event-ID"), target)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	defer stream.Close()
 
-	taskEventsFetcher := func() (models.Event, error) {
+	streamEventsToResponse(logger, w, stream)
+}
+
+func (h *TaskEventHandler) subscribe(logger lager.Logger, request *models.EventsByCellId, lastEventID string, target format.Version) (*eventStream, error) {
+	logger.Info("subscribed-to-tasks-event-stream")
+
+	stream := newEventStream(resumeCursor(logger, lastEventID, request.ResourceVersion, h.taskHub))
+
+	taskSource, err := h.taskHub.Resume(stream.cursor[0], request.EventFilter())
+	if err != nil {
+		logger.Error("failed-to-subscribe-to-task-event-hub", err)
+		return nil, err
+	}
+
+	taskEventsFetcher := func() (events.LoggedEvent, error) {
 		event, err := taskSource.Next()
 		if err != nil {
 			return event, err
 		}
-		event = models.VersionTaskDefinitionsTo(event, target)
+		event.Event = models.VersionTaskDefinitionsTo(event.Event, target)
 		return event, err
 	}
 
-	go streamSource(eventChan, errorChan, closeChan, taskEventsFetcher)
+	stream.add(0, taskSource, taskEventsFetcher)
 
-	streamEventsToResponse(logger, w, eventChan, errorChan)
+	return stream, nil
 }
 
 func (h *TaskEventHandler) Subscribe_r0(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -268,6 +298,11 @@ func filterInstanceEventByCellID(cellID string, bbsEvent models.Event, err error
 		if x.ActualLRPInstanceKey.CellId != cellID {
 			return false
 		}
+
+	case *models.CellDrainChangedEvent:
+		if x.Drain.GetCellId() != cellID {
+			return false
+		}
 	}
 
 	return true
@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/bbs/events"
@@ -120,20 +121,20 @@ var _ = Describe("Event Handlers", func() {
 					hub.Emit(&eventfakes.FakeEvent{Token: "A"})
 					encodedPayload := base64.StdEncoding.EncodeToString([]byte("A"))
 
-					Expect(reader.Next()).To(Equal(sse.Event{
-						ID:   "0",
-						Name: "fake",
-						Data: []byte(encodedPayload),
-					}))
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.Name).To(Equal("fake"))
+					Expect(event.Data).To(Equal([]byte(encodedPayload)))
+					Expect(strings.Split(event.ID, ",")).To(ContainElement(strconv.FormatUint(hub.LastEventID(), 10)))
 
 					hub.Emit(&eventfakes.FakeEvent{Token: "B"})
 
 					encodedPayload = base64.StdEncoding.EncodeToString([]byte("B"))
-					Expect(reader.Next()).To(Equal(sse.Event{
-						ID:   "1",
-						Name: "fake",
-						Data: []byte(encodedPayload),
-					}))
+					event, err = reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.Name).To(Equal("fake"))
+					Expect(event.Data).To(Equal([]byte(encodedPayload)))
+					Expect(strings.Split(event.ID, ",")).To(ContainElement(strconv.FormatUint(hub.LastEventID(), 10)))
 				})
 
 				It("returns Content-Type as text/event-stream", func() {
@@ -147,11 +148,9 @@ var _ = Describe("Event Handlers", func() {
 					hub.Emit(&eventfakes.FakeEvent{Token: "A"})
 					encodedPayload := base64.StdEncoding.EncodeToString([]byte("A"))
 
-					Expect(reader.Next()).To(Equal(sse.Event{
-						ID:   "0",
-						Name: "fake",
-						Data: []byte(encodedPayload),
-					}))
+					event, err := reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(event.Data).To(Equal([]byte(encodedPayload)))
 
 					reader.Close()
 
@@ -769,6 +768,97 @@ var _ = Describe("Event Handlers", func() {
 		})
 	})
 
+	Describe("Instance Events resuming from Last-Event-ID", func() {
+		var (
+			desiredHub     events.Hub
+			lrpInstanceHub events.Hub
+			server         *httptest.Server
+			lastEventID    string
+			reader         *sse.ReadCloser
+		)
+
+		BeforeEach(func() {
+			desiredHub = events.NewHub(logger)
+			lrpInstanceHub = events.NewHub(logger)
+			handler = handlers.NewLRPInstanceEventHandler(desiredHub, lrpInstanceHub)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handler.Subscribe_r1(logger, w, r)
+			}))
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("GET", server.URL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Last-Event-ID", lastEventID)
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+			reader = sse.NewReadCloser(response.Body)
+		})
+
+		AfterEach(func() {
+			server.Close()
+			desiredHub.Close()
+			lrpInstanceHub.Close()
+		})
+
+		Context("when the missed events are retained", func() {
+			var desiredID, instanceID uint64
+
+			BeforeEach(func() {
+				desiredID = desiredHub.LastEventID()
+				instanceID = lrpInstanceHub.LastEventID()
+				lastEventID = fmt.Sprintf("%d,%d", desiredID, instanceID)
+
+				desiredHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+				lrpInstanceHub.Emit(&eventfakes.FakeEvent{Token: "B"})
+			})
+
+			It("replays them from every hub", func() {
+				payloads := []string{}
+				var event sse.Event
+				for i := 0; i < 2; i++ {
+					var err error
+					event, err = reader.Next()
+					Expect(err).NotTo(HaveOccurred())
+					payloads = append(payloads, string(event.Data))
+				}
+
+				Expect(payloads).To(ConsistOf(
+					base64.StdEncoding.EncodeToString([]byte("A")),
+					base64.StdEncoding.EncodeToString([]byte("B")),
+				))
+				Expect(event.ID).To(Equal(fmt.Sprintf("%d,%d", desiredID+1, instanceID+1)))
+			})
+		})
+
+		Context("when the missed events are no longer retained", func() {
+			BeforeEach(func() {
+				lastEventID = "1,1"
+			})
+
+			It("asks the client to resync", func() {
+				event, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.Name).To(Equal(models.EventTypeResyncRequired))
+			})
+		})
+
+		Context("when the Last-Event-ID is not a position in this stream", func() {
+			BeforeEach(func() {
+				lastEventID = "12"
+			})
+
+			It("streams from the current position", func() {
+				desiredHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+
+				event, err := reader.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(event.Data).To(Equal([]byte(base64.StdEncoding.EncodeToString([]byte("A")))))
+				Eventually(logger).Should(gbytes.Say("failed-parsing-last-event-id"))
+			})
+		})
+	})
+
 	Describe("Tasks Subscribe_r0", func() {
 		var (
 			taskHub events.Hub
@@ -863,6 +953,155 @@ var _ = Describe("Event Handlers", func() {
 		})
 	})
 
+	Describe("Task Events resuming from Last-Event-ID", func() {
+		var (
+			taskHub events.Hub
+			server  *httptest.Server
+		)
+
+		BeforeEach(func() {
+			taskHub = events.NewHub(logger)
+			handler = handlers.NewTaskEventHandler(taskHub)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handler.Subscribe_r1(logger, w, r)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+			taskHub.Close()
+		})
+
+		It("replays only the events after the given ID", func() {
+			taskHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+			seenID := taskHub.LastEventID()
+			taskHub.Emit(&eventfakes.FakeEvent{Token: "B"})
+
+			request, err := http.NewRequest("GET", server.URL, nil)
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Last-Event-ID", strconv.FormatUint(seenID, 10))
+			response, err := http.DefaultClient.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+			reader := sse.NewReadCloser(response.Body)
+
+			event, err := reader.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.ID).To(Equal(strconv.FormatUint(seenID+1, 10)))
+			Expect(event.Data).To(Equal([]byte(base64.StdEncoding.EncodeToString([]byte("B")))))
+		})
+	})
+
+	Describe("Task Events resuming after a resource version", func() {
+		var (
+			taskHub  events.Hub
+			versions *eventfakes.FakeResourceVersionSource
+			server   *httptest.Server
+		)
+
+		BeforeEach(func() {
+			versions = new(eventfakes.FakeResourceVersionSource)
+			versions.ResourceVersionReturns(10, nil)
+			taskHub = events.NewVersionedHub(logger, events.NewRingEventLog(events.DEFAULT_EVENT_LOG_SIZE), versions)
+			handler = handlers.NewTaskEventHandler(taskHub)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handler.Subscribe_r1(logger, w, r)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+			taskHub.Close()
+		})
+
+		subscribe := func(resourceVersion uint64) *sse.ReadCloser {
+			body, err := models.NewEventsByCellId(models.EventFilter{SinceResourceVersion: resourceVersion}).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := http.Post(server.URL, "application/x-protobuf", strings.NewReader(string(body)))
+			Expect(err).NotTo(HaveOccurred())
+			return sse.NewReadCloser(response.Body)
+		}
+
+		It("replays only the events of the writes after the resource version", func() {
+			taskA := models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-a"})
+			taskA.ResourceVersion = 10
+			taskHub.Emit(taskA)
+			taskB := models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-b"})
+			taskB.ResourceVersion = 11
+			taskHub.Emit(taskB)
+
+			event, err := subscribe(10).Next()
+			Expect(err).NotTo(HaveOccurred())
+			payload, err := base64.StdEncoding.DecodeString(string(event.Data))
+			Expect(err).NotTo(HaveOccurred())
+			created := &models.TaskCreatedEvent{}
+			Expect(created.Unmarshal(payload)).To(Succeed())
+			Expect(created.Task.TaskGuid).To(Equal("task-b"))
+			Expect(created.ResourceVersion).To(BeEquivalentTo(11))
+		})
+
+		It("asks the client to resync when the resource version predates the hub", func() {
+			taskHub.Emit(&eventfakes.FakeEvent{Token: "A"})
+
+			event, err := subscribe(5).Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.Name).To(Equal(models.EventTypeResyncRequired))
+		})
+	})
+
+	Describe("Task Events with a filter", func() {
+		var (
+			taskHub events.Hub
+			server  *httptest.Server
+		)
+
+		BeforeEach(func() {
+			taskHub = events.NewHub(logger)
+			handler = handlers.NewTaskEventHandler(taskHub)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handler.Subscribe_r1(logger, w, r)
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+			taskHub.Close()
+		})
+
+		It("only streams the matching events", func() {
+			body, err := models.NewEventsByCellId(models.EventFilter{
+				Domain:    "domain-1",
+				TaskGuids: []string{"task-2"},
+			}).Marshal()
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err := http.Post(server.URL, "application/x-protobuf", strings.NewReader(string(body)))
+			Expect(err).NotTo(HaveOccurred())
+			eventSource := events.NewEventSource(sse.NewReadCloser(response.Body))
+
+			otherDomainTask := model_helpers.NewValidTask("task-2")
+			otherDomainTask.Domain = "domain-2"
+			otherGuidTask := model_helpers.NewValidTask("task-1")
+			otherGuidTask.Domain = "domain-1"
+			task := model_helpers.NewValidTask("task-2")
+			task.Domain = "domain-1"
+
+			taskHub.Emit(models.NewTaskCreatedEvent(otherDomainTask))
+			taskHub.Emit(models.NewTaskCreatedEvent(otherGuidTask))
+			taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+			event, err := eventSource.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event).To(Equal(models.NewTaskCreatedEvent(task)))
+		})
+
+		It("rejects a malformed filter", func() {
+			response, err := http.Post(server.URL, "application/x-protobuf", strings.NewReader("garbage"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
 	Describe("Tasks Subscribe_r1", func() {
 		var (
 			taskHub events.Hub
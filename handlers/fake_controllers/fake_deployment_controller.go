@@ -0,0 +1,461 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeDeploymentController struct {
+	DeploymentByProcessGuidStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	deploymentByProcessGuidMutex       sync.RWMutex
+	deploymentByProcessGuidArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	deploymentByProcessGuidReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	deploymentByProcessGuidReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	PauseDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	pauseDeploymentMutex       sync.RWMutex
+	pauseDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	pauseDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	pauseDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	ResumeDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	resumeDeploymentMutex       sync.RWMutex
+	resumeDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	resumeDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	resumeDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	RollbackDeploymentStub        func(context.Context, lager.Logger, string) (*models.Deployment, error)
+	rollbackDeploymentMutex       sync.RWMutex
+	rollbackDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}
+	rollbackDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	rollbackDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	StartDeploymentStub        func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)
+	startDeploymentMutex       sync.RWMutex
+	startDeploymentArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}
+	startDeploymentReturns struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	startDeploymentReturnsOnCall map[int]struct {
+		result1 *models.Deployment
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuid(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	ret, specificReturn := fake.deploymentByProcessGuidReturnsOnCall[len(fake.deploymentByProcessGuidArgsForCall)]
+	fake.deploymentByProcessGuidArgsForCall = append(fake.deploymentByProcessGuidArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.DeploymentByProcessGuidStub
+	fakeReturns := fake.deploymentByProcessGuidReturns
+	fake.recordInvocation("DeploymentByProcessGuid", []interface{}{arg1, arg2, arg3})
+	fake.deploymentByProcessGuidMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuidCallCount() int {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	return len(fake.deploymentByProcessGuidArgsForCall)
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuidCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = stub
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuidArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	argsForCall := fake.deploymentByProcessGuidArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuidReturns(result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	fake.deploymentByProcessGuidReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) DeploymentByProcessGuidReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.deploymentByProcessGuidMutex.Lock()
+	defer fake.deploymentByProcessGuidMutex.Unlock()
+	fake.DeploymentByProcessGuidStub = nil
+	if fake.deploymentByProcessGuidReturnsOnCall == nil {
+		fake.deploymentByProcessGuidReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.deploymentByProcessGuidReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) PauseDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.pauseDeploymentMutex.Lock()
+	ret, specificReturn := fake.pauseDeploymentReturnsOnCall[len(fake.pauseDeploymentArgsForCall)]
+	fake.pauseDeploymentArgsForCall = append(fake.pauseDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PauseDeploymentStub
+	fakeReturns := fake.pauseDeploymentReturns
+	fake.recordInvocation("PauseDeployment", []interface{}{arg1, arg2, arg3})
+	fake.pauseDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentController) PauseDeploymentCallCount() int {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	return len(fake.pauseDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentController) PauseDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentController) PauseDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	argsForCall := fake.pauseDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentController) PauseDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	fake.pauseDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) PauseDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.pauseDeploymentMutex.Lock()
+	defer fake.pauseDeploymentMutex.Unlock()
+	fake.PauseDeploymentStub = nil
+	if fake.pauseDeploymentReturnsOnCall == nil {
+		fake.pauseDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.pauseDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) ResumeDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.resumeDeploymentMutex.Lock()
+	ret, specificReturn := fake.resumeDeploymentReturnsOnCall[len(fake.resumeDeploymentArgsForCall)]
+	fake.resumeDeploymentArgsForCall = append(fake.resumeDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.ResumeDeploymentStub
+	fakeReturns := fake.resumeDeploymentReturns
+	fake.recordInvocation("ResumeDeployment", []interface{}{arg1, arg2, arg3})
+	fake.resumeDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentController) ResumeDeploymentCallCount() int {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	return len(fake.resumeDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentController) ResumeDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentController) ResumeDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	argsForCall := fake.resumeDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentController) ResumeDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	fake.resumeDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) ResumeDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.resumeDeploymentMutex.Lock()
+	defer fake.resumeDeploymentMutex.Unlock()
+	fake.ResumeDeploymentStub = nil
+	if fake.resumeDeploymentReturnsOnCall == nil {
+		fake.resumeDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.resumeDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) RollbackDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string) (*models.Deployment, error) {
+	fake.rollbackDeploymentMutex.Lock()
+	ret, specificReturn := fake.rollbackDeploymentReturnsOnCall[len(fake.rollbackDeploymentArgsForCall)]
+	fake.rollbackDeploymentArgsForCall = append(fake.rollbackDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.RollbackDeploymentStub
+	fakeReturns := fake.rollbackDeploymentReturns
+	fake.recordInvocation("RollbackDeployment", []interface{}{arg1, arg2, arg3})
+	fake.rollbackDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentController) RollbackDeploymentCallCount() int {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	return len(fake.rollbackDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentController) RollbackDeploymentCalls(stub func(context.Context, lager.Logger, string) (*models.Deployment, error)) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentController) RollbackDeploymentArgsForCall(i int) (context.Context, lager.Logger, string) {
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	argsForCall := fake.rollbackDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeDeploymentController) RollbackDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	fake.rollbackDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) RollbackDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.rollbackDeploymentMutex.Lock()
+	defer fake.rollbackDeploymentMutex.Unlock()
+	fake.RollbackDeploymentStub = nil
+	if fake.rollbackDeploymentReturnsOnCall == nil {
+		fake.rollbackDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.rollbackDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) StartDeployment(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 *models.DesiredLRPRunInfo, arg5 int32, arg6 int32) (*models.Deployment, error) {
+	fake.startDeploymentMutex.Lock()
+	ret, specificReturn := fake.startDeploymentReturnsOnCall[len(fake.startDeploymentArgsForCall)]
+	fake.startDeploymentArgsForCall = append(fake.startDeploymentArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 string
+		arg4 *models.DesiredLRPRunInfo
+		arg5 int32
+		arg6 int32
+	}{arg1, arg2, arg3, arg4, arg5, arg6})
+	stub := fake.StartDeploymentStub
+	fakeReturns := fake.startDeploymentReturns
+	fake.recordInvocation("StartDeployment", []interface{}{arg1, arg2, arg3, arg4, arg5, arg6})
+	fake.startDeploymentMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5, arg6)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeDeploymentController) StartDeploymentCallCount() int {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	return len(fake.startDeploymentArgsForCall)
+}
+
+func (fake *FakeDeploymentController) StartDeploymentCalls(stub func(context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) (*models.Deployment, error)) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = stub
+}
+
+func (fake *FakeDeploymentController) StartDeploymentArgsForCall(i int) (context.Context, lager.Logger, string, *models.DesiredLRPRunInfo, int32, int32) {
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	argsForCall := fake.startDeploymentArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
+}
+
+func (fake *FakeDeploymentController) StartDeploymentReturns(result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	fake.startDeploymentReturns = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) StartDeploymentReturnsOnCall(i int, result1 *models.Deployment, result2 error) {
+	fake.startDeploymentMutex.Lock()
+	defer fake.startDeploymentMutex.Unlock()
+	fake.StartDeploymentStub = nil
+	if fake.startDeploymentReturnsOnCall == nil {
+		fake.startDeploymentReturnsOnCall = make(map[int]struct {
+			result1 *models.Deployment
+			result2 error
+		})
+	}
+	fake.startDeploymentReturnsOnCall[i] = struct {
+		result1 *models.Deployment
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeDeploymentController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deploymentByProcessGuidMutex.RLock()
+	defer fake.deploymentByProcessGuidMutex.RUnlock()
+	fake.pauseDeploymentMutex.RLock()
+	defer fake.pauseDeploymentMutex.RUnlock()
+	fake.resumeDeploymentMutex.RLock()
+	defer fake.resumeDeploymentMutex.RUnlock()
+	fake.rollbackDeploymentMutex.RLock()
+	defer fake.rollbackDeploymentMutex.RUnlock()
+	fake.startDeploymentMutex.RLock()
+	defer fake.startDeploymentMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeDeploymentController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ handlers.DeploymentController = new(FakeDeploymentController)
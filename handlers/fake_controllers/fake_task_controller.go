@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/models"
 	lager "code.cloudfoundry.org/lager/v3"
@@ -99,6 +100,23 @@ type FakeTaskController struct {
 	failTaskReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListTasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)
+	listTasksMutex       sync.RWMutex
+	listTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}
+	listTasksReturns struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
+	listTasksReturnsOnCall map[int]struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}
 	RejectTaskStub        func(context.Context, lager.Logger, string, string) error
 	rejectTaskMutex       sync.RWMutex
 	rejectTaskArgsForCall []struct {
@@ -157,13 +175,12 @@ type FakeTaskController struct {
 		result1 *models.Task
 		result2 error
 	}
-	TasksStub        func(context.Context, lager.Logger, string, string) ([]*models.Task, error)
+	TasksStub        func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)
 	tasksMutex       sync.RWMutex
 	tasksArgsForCall []struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
+		arg3 models.TaskFilter
 	}
 	tasksReturns struct {
 		result1 []*models.Task
@@ -564,6 +581,75 @@ func (fake *FakeTaskController) FailTaskReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTaskController) ListTasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, db.ListMetadata, error) {
+	fake.listTasksMutex.Lock()
+	ret, specificReturn := fake.listTasksReturnsOnCall[len(fake.listTasksArgsForCall)]
+	fake.listTasksArgsForCall = append(fake.listTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
+	stub := fake.ListTasksStub
+	fakeReturns := fake.listTasksReturns
+	fake.recordInvocation("ListTasks", []interface{}{arg1, arg2, arg3})
+	fake.listTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTaskController) ListTasksCallCount() int {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	return len(fake.listTasksArgsForCall)
+}
+
+func (fake *FakeTaskController) ListTasksCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, db.ListMetadata, error)) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = stub
+}
+
+func (fake *FakeTaskController) ListTasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
+	argsForCall := fake.listTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskController) ListTasksReturns(result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	fake.listTasksReturns = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTaskController) ListTasksReturnsOnCall(i int, result1 []*models.Task, result2 db.ListMetadata, result3 error) {
+	fake.listTasksMutex.Lock()
+	defer fake.listTasksMutex.Unlock()
+	fake.ListTasksStub = nil
+	if fake.listTasksReturnsOnCall == nil {
+		fake.listTasksReturnsOnCall = make(map[int]struct {
+			result1 []*models.Task
+			result2 db.ListMetadata
+			result3 error
+		})
+	}
+	fake.listTasksReturnsOnCall[i] = struct {
+		result1 []*models.Task
+		result2 db.ListMetadata
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTaskController) RejectTask(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) error {
 	fake.rejectTaskMutex.Lock()
 	ret, specificReturn := fake.rejectTaskReturnsOnCall[len(fake.rejectTaskArgsForCall)]
@@ -824,21 +910,20 @@ func (fake *FakeTaskController) TaskByGuidReturnsOnCall(i int, result1 *models.T
 	}{result1, result2}
 }
 
-func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 string, arg4 string) ([]*models.Task, error) {
+func (fake *FakeTaskController) Tasks(arg1 context.Context, arg2 lager.Logger, arg3 models.TaskFilter) ([]*models.Task, error) {
 	fake.tasksMutex.Lock()
 	ret, specificReturn := fake.tasksReturnsOnCall[len(fake.tasksArgsForCall)]
 	fake.tasksArgsForCall = append(fake.tasksArgsForCall, struct {
 		arg1 context.Context
 		arg2 lager.Logger
-		arg3 string
-		arg4 string
-	}{arg1, arg2, arg3, arg4})
+		arg3 models.TaskFilter
+	}{arg1, arg2, arg3})
 	stub := fake.TasksStub
 	fakeReturns := fake.tasksReturns
-	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("Tasks", []interface{}{arg1, arg2, arg3})
 	fake.tasksMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -852,17 +937,17 @@ func (fake *FakeTaskController) TasksCallCount() int {
 	return len(fake.tasksArgsForCall)
 }
 
-func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, string, string) ([]*models.Task, error)) {
+func (fake *FakeTaskController) TasksCalls(stub func(context.Context, lager.Logger, models.TaskFilter) ([]*models.Task, error)) {
 	fake.tasksMutex.Lock()
 	defer fake.tasksMutex.Unlock()
 	fake.TasksStub = stub
 }
 
-func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, string, string) {
+func (fake *FakeTaskController) TasksArgsForCall(i int) (context.Context, lager.Logger, models.TaskFilter) {
 	fake.tasksMutex.RLock()
 	defer fake.tasksMutex.RUnlock()
 	argsForCall := fake.tasksArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeTaskController) TasksReturns(result1 []*models.Task, result2 error) {
@@ -906,6 +991,8 @@ func (fake *FakeTaskController) Invocations() map[string][][]interface{} {
 	defer fake.desireTaskMutex.RUnlock()
 	fake.failTaskMutex.RLock()
 	defer fake.failTaskMutex.RUnlock()
+	fake.listTasksMutex.RLock()
+	defer fake.listTasksMutex.RUnlock()
 	fake.rejectTaskMutex.RLock()
 	defer fake.rejectTaskMutex.RUnlock()
 	fake.resolvingTaskMutex.RLock()
@@ -0,0 +1,1141 @@
+package handlers
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/auctioneer"
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/crashstorm"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/format"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/bbs/repadmin"
+	"code.cloudfoundry.org/bbs/serviceclient"
+	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/clock"
+	loggingclient "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/rep"
+	"github.com/gogo/protobuf/proto"
+	"github.com/tedsuo/ifrit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCServer serves the BBS API over gRPC with the same handlers as the
+// HTTP API. Servers created with its ServerOptions check, trace, audit, log
+// and meter calls as the requests of the latest version of the matching HTTP
+// route are, but fail them with a gRPC status where the HTTP API responds
+// with an error status. Errors of the calls themselves are returned in the
+// error field of their response, as they are over HTTP.
+type GRPCServer struct {
+	logger                lager.Logger
+	accessLogger          lager.Logger
+	advancedMetricsConfig config.AdvancedMetrics
+	emitter               middleware.Emitter
+	handlers              apiHandlers
+	idempotencyKeyDB      db.IdempotencyKeyDB
+	auditRecordDB         db.AuditRecordDB
+	authorizer            *authorization.Authorizer
+	resolveDomain         DomainResolver
+	limiter               *ratelimit.Limiter
+	overload              *overload.Controller
+	idempotencyClock      clock.Clock
+	idempotencyKeyWindow  time.Duration
+	migrationsDone        <-chan struct{}
+	exitChan              chan<- struct{}
+}
+
+func NewGRPCServer(
+	logger,
+	accessLogger lager.Logger,
+	updateWorkers int,
+	maxTaskPlacementRetries int,
+	advancedMetricsConfig config.AdvancedMetrics,
+	emitter middleware.Emitter,
+	db db.DB,
+	desiredHub, actualHub, actualLRPInstanceHub, taskHub events.Hub,
+	taskCompletionClient taskworkpool.TaskCompletionClient,
+	serviceClient serviceclient.ServiceClient,
+	auctioneerClient auctioneer.Client,
+	repClientFactory rep.ClientFactory,
+	repAdminClient repadmin.Client,
+	admitter admission.Admitter,
+	authorizer *authorization.Authorizer,
+	limiter *ratelimit.Limiter,
+	overloadController *overload.Controller,
+	crashStormDetector *crashstorm.Detector,
+	idempotencyKeyWindow time.Duration,
+	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
+	migrationsDone <-chan struct{},
+	exitChan chan struct{},
+	metronClient loggingclient.IngressClient,
+) *GRPCServer {
+	return &GRPCServer{
+		logger:                logger.Session("grpc"),
+		accessLogger:          accessLogger,
+		advancedMetricsConfig: advancedMetricsConfig,
+		emitter:               emitter,
+		handlers:              newAPIHandlers(updateWorkers, maxTaskPlacementRetries, db, desiredHub, actualHub, actualLRPInstanceHub, taskHub, taskCompletionClient, serviceClient, auctioneerClient, repClientFactory, repAdminClient, admitter, overloadController, crashStormDetector, taskStatMetronNotifier, exitChan, metronClient),
+		idempotencyKeyDB:      db,
+		auditRecordDB:         db,
+		authorizer:            authorizer,
+		resolveDomain:         NewDomainResolver(db),
+		limiter:               limiter,
+		overload:              overloadController,
+		idempotencyClock:      clock.NewClock(),
+		idempotencyKeyWindow:  idempotencyKeyWindow,
+		migrationsDone:        migrationsDone,
+		exitChan:              exitChan,
+	}
+}
+
+func (s *GRPCServer) Ping(ctx context.Context, request *models.PingRequest) (*models.PingResponse, error) {
+	response := &models.PingResponse{}
+	logger := s.callLogger(ctx)
+
+	s.handlers.ping.ping(ctx, logger, request, response)
+	return response, nil
+}
+
+func (s *GRPCServer) Domains(ctx context.Context, request *models.DomainsRequest) (*models.DomainsResponse, error) {
+	response := &models.DomainsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := s.handlers.domain.domains(ctx, logger, request, response)
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) UpsertDomain(ctx context.Context, request *models.UpsertDomainRequest) (*models.UpsertDomainResponse, error) {
+	response := &models.UpsertDomainResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.domain.upsert(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DomainQuotas(ctx context.Context, request *models.DomainQuotasRequest) (*models.DomainQuotasResponse, error) {
+	response := &models.DomainQuotasResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.domainQuota.domainQuotas(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) SetDomainQuota(ctx context.Context, request *models.SetDomainQuotaRequest) (*models.DomainQuotaResponse, error) {
+	response := &models.DomainQuotaResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.domainQuota.setDomainQuota(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RemoveDomainQuota(ctx context.Context, request *models.RemoveDomainQuotaRequest) (*models.DomainQuotaLifecycleResponse, error) {
+	response := &models.DomainQuotaLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.domainQuota.removeDomainQuota(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DomainUsage(ctx context.Context, request *models.DomainUsageRequest) (*models.DomainUsageResponse, error) {
+	response := &models.DomainUsageResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.domainQuota.domainUsage(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ActualLRPs(ctx context.Context, request *models.ActualLRPsRequest) (*models.ActualLRPsResponse, error) {
+	response := &models.ActualLRPsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRP.actualLRPs(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ActualLRPsByProcessGuids(ctx context.Context, request *models.ActualLRPsByProcessGuidsRequest) (*models.ActualLRPsByProcessGuidsResponse, error) {
+	response := &models.ActualLRPsByProcessGuidsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRP.actualLRPsByProcessGuids(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+// Deprecated: use ActualLRPs instead
+func (s *GRPCServer) ActualLRPGroups(ctx context.Context, request *models.ActualLRPGroupsRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRP.actualLRPGroups(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+// Deprecated: use ActualLRPs instead
+func (s *GRPCServer) ActualLRPGroupsByProcessGuid(ctx context.Context, request *models.ActualLRPGroupsByProcessGuidRequest) (*models.ActualLRPGroupsResponse, error) {
+	response := &models.ActualLRPGroupsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRP.actualLRPGroupsByProcessGuid(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+// Deprecated: use ActualLRPs instead
+func (s *GRPCServer) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, request *models.ActualLRPGroupByProcessGuidAndIndexRequest) (*models.ActualLRPGroupResponse, error) {
+	response := &models.ActualLRPGroupResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRP.actualLRPGroupByProcessGuidAndIndex(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ActualLRPHistory(ctx context.Context, request *models.ActualLRPHistoryRequest) (*models.ActualLRPHistoryResponse, error) {
+	response := &models.ActualLRPHistoryResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPHistory.actualLRPHistory(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ClaimActualLRP(ctx context.Context, request *models.ClaimActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.claimActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) StartActualLRP(ctx context.Context, request *models.StartActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.startActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CrashActualLRP(ctx context.Context, request *models.CrashActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.crashActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) FailActualLRP(ctx context.Context, request *models.FailActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.failActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RemoveActualLRP(ctx context.Context, request *models.RemoveActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.removeActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RetireActualLRP(ctx context.Context, request *models.RetireActualLRPRequest) (*models.ActualLRPLifecycleResponse, error) {
+	response := &models.ActualLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.actualLRPLifecycle.retireActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RemoveEvacuatingActualLRP(ctx context.Context, request *models.RemoveEvacuatingActualLRPRequest) (*models.RemoveEvacuatingActualLRPResponse, error) {
+	response := &models.RemoveEvacuatingActualLRPResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.evacuation.removeEvacuatingActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) EvacuateClaimedActualLRP(ctx context.Context, request *models.EvacuateClaimedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{KeepContainer: true}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.evacuation.evacuateClaimedActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) EvacuateCrashedActualLRP(ctx context.Context, request *models.EvacuateCrashedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.evacuation.evacuateCrashedActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) EvacuateStoppedActualLRP(ctx context.Context, request *models.EvacuateStoppedActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.evacuation.evacuateStoppedActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) EvacuateRunningActualLRP(ctx context.Context, request *models.EvacuateRunningActualLRPRequest) (*models.EvacuationResponse, error) {
+	response := &models.EvacuationResponse{KeepContainer: true}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.evacuation.evacuateRunningActualLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesiredLRPs(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPsResponse, error) {
+	response := &models.DesiredLRPsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.listDesiredLRPs(ctx, logger, format.V3, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesiredLRPByProcessGuid(ctx context.Context, request *models.DesiredLRPByProcessGuidRequest) (*models.DesiredLRPResponse, error) {
+	response := &models.DesiredLRPResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.desiredLRPByProcessGuid(ctx, logger, format.V3, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesiredLRPSchedulingInfos(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPSchedulingInfosResponse, error) {
+	response := &models.DesiredLRPSchedulingInfosResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.desiredLRPSchedulingInfos(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, request *models.DesiredLRPByProcessGuidRequest) (*models.DesiredLRPSchedulingInfoByProcessGuidResponse, error) {
+	response := &models.DesiredLRPSchedulingInfoByProcessGuidResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.desiredLRPSchedulingInfoByProcessGuid(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesiredLRPRoutingInfos(ctx context.Context, request *models.DesiredLRPsRequest) (*models.DesiredLRPsResponse, error) {
+	response := &models.DesiredLRPsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.desiredLRPRoutingInfos(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesireDesiredLRP(ctx context.Context, request *models.DesireLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err != nil {
+		response.Error = s.handlers.desiredLRP.invalidRequest(logger, err, request.GetDesiredLrp().GetProcessGuid())
+		return response, nil
+	}
+	err = s.handlers.desiredLRP.desireDesiredLRP(ctx, logger, request, response)
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) UpdateDesiredLRP(ctx context.Context, request *models.UpdateDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err != nil {
+		response.Error = s.handlers.desiredLRP.invalidRequest(logger, err, request.GetProcessGuid())
+		return response, nil
+	}
+	err = s.handlers.desiredLRP.updateDesiredLRP(ctx, logger, request, response)
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RemoveDesiredLRP(ctx context.Context, request *models.RemoveDesiredLRPRequest) (*models.DesiredLRPLifecycleResponse, error) {
+	response := &models.DesiredLRPLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.desiredLRP.removeDesiredLRP(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) StartDeployment(ctx context.Context, request *models.StartDeploymentRequest) (*models.DeploymentResponse, error) {
+	response := &models.DeploymentResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.deployment.startDeployment(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DeploymentByProcessGuid(ctx context.Context, request *models.DeploymentByProcessGuidRequest) (*models.DeploymentResponse, error) {
+	response := &models.DeploymentResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.deployment.deploymentByProcessGuid(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) PauseDeployment(ctx context.Context, request *models.PauseDeploymentRequest) (*models.DeploymentResponse, error) {
+	response := &models.DeploymentResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.deployment.pauseDeployment(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ResumeDeployment(ctx context.Context, request *models.ResumeDeploymentRequest) (*models.DeploymentResponse, error) {
+	response := &models.DeploymentResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.deployment.resumeDeployment(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RollbackDeployment(ctx context.Context, request *models.RollbackDeploymentRequest) (*models.DeploymentResponse, error) {
+	response := &models.DeploymentResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.deployment.rollbackDeployment(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) Tasks(ctx context.Context, request *models.TasksRequest) (*models.TasksResponse, error) {
+	response := &models.TasksResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.listTasks(ctx, logger, format.V3, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) TaskByGuid(ctx context.Context, request *models.TaskByGuidRequest) (*models.TaskResponse, error) {
+	response := &models.TaskResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.taskByGuid(ctx, logger, format.V3, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesireTask(ctx context.Context, request *models.DesireTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.desireTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) StartTask(ctx context.Context, request *models.StartTaskRequest) (*models.StartTaskResponse, error) {
+	response := &models.StartTaskResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.startTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CancelTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.cancelTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+// Deprecated: use CancelTask instead
+func (s *GRPCServer) FailTask(ctx context.Context, request *models.FailTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.failTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) RejectTask(ctx context.Context, request *models.RejectTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.rejectTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CompleteTask(ctx context.Context, request *models.CompleteTaskRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.completeTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ResolvingTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.resolvingTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DeleteTask(ctx context.Context, request *models.TaskGuidRequest) (*models.TaskLifecycleResponse, error) {
+	response := &models.TaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.task.deleteTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ScheduledTasks(ctx context.Context, request *models.ScheduledTasksRequest) (*models.ScheduledTasksResponse, error) {
+	response := &models.ScheduledTasksResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.scheduledTask.scheduledTasks(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DesireScheduledTask(ctx context.Context, request *models.DesireScheduledTaskRequest) (*models.ScheduledTaskResponse, error) {
+	response := &models.ScheduledTaskResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.scheduledTask.desireScheduledTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) UpdateScheduledTask(ctx context.Context, request *models.UpdateScheduledTaskRequest) (*models.ScheduledTaskResponse, error) {
+	response := &models.ScheduledTaskResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.scheduledTask.updateScheduledTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) SuspendScheduledTask(ctx context.Context, request *models.SuspendScheduledTaskRequest) (*models.ScheduledTaskResponse, error) {
+	response := &models.ScheduledTaskResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.scheduledTask.suspendScheduledTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DeleteScheduledTask(ctx context.Context, request *models.DeleteScheduledTaskRequest) (*models.ScheduledTaskLifecycleResponse, error) {
+	response := &models.ScheduledTaskLifecycleResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.scheduledTask.deleteScheduledTask(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) TaskCallbacks(ctx context.Context, request *models.TaskCallbacksRequest) (*models.TaskCallbacksResponse, error) {
+	response := &models.TaskCallbacksResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.taskCallback.taskCallbacks(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ReplayTaskCallback(ctx context.Context, request *models.ReplayTaskCallbackRequest) (*models.ReplayTaskCallbackResponse, error) {
+	response := &models.ReplayTaskCallbackResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.taskCallback.replayTaskCallback(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) AuditRecords(ctx context.Context, request *models.AuditRecordsRequest) (*models.AuditRecordsResponse, error) {
+	response := &models.AuditRecordsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.auditRecord.auditRecords(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) OverloadStatus(ctx context.Context, request *models.OverloadStatusRequest) (*models.OverloadStatusResponse, error) {
+	response := &models.OverloadStatusResponse{}
+	logger := s.callLogger(ctx)
+
+	err := s.handlers.overload.overloadStatus(ctx, logger, request, response)
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CrashStormBreakers(ctx context.Context, request *models.CrashStormBreakersRequest) (*models.CrashStormBreakersResponse, error) {
+	response := &models.CrashStormBreakersResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.crashStorm.crashStormBreakers(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) ResetCrashStormBreaker(ctx context.Context, request *models.ResetCrashStormBreakerRequest) (*models.ResetCrashStormBreakerResponse, error) {
+	response := &models.ResetCrashStormBreakerResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.crashStorm.resetCrashStormBreaker(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) Cells(ctx context.Context, request *models.CellsRequest) (*models.CellsResponse, error) {
+	response := &models.CellsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := s.handlers.cell.cells(ctx, logger, request, response)
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CordonCell(ctx context.Context, request *models.CordonCellRequest) (*models.CordonCellResponse, error) {
+	response := &models.CordonCellResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.cell.cordonCell(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) UncordonCell(ctx context.Context, request *models.UncordonCellRequest) (*models.UncordonCellResponse, error) {
+	response := &models.UncordonCellResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.cell.uncordonCell(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) DrainCell(ctx context.Context, request *models.DrainCellRequest) (*models.DrainCellResponse, error) {
+	response := &models.DrainCellResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.cell.drainCell(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+func (s *GRPCServer) CellDrains(ctx context.Context, request *models.CellDrainsRequest) (*models.CellDrainsResponse, error) {
+	response := &models.CellDrainsResponse{}
+	logger := s.callLogger(ctx)
+
+	err := validateRequest(logger, request)
+	if err == nil {
+		err = s.handlers.cell.cellDrains(ctx, logger, request, response)
+	}
+	response.Error = models.ConvertError(err)
+	return response, nil
+}
+
+// Deprecated: use LRPInstanceEvents instead
+func (s *GRPCServer) LRPGroupEvents(request *models.EventsByCellId, server models.BBS_LRPGroupEventsServer) error {
+	logger := s.logger.Session("lrp-group-events")
+	return s.streamEvents(logger, bbs.LRPGroupEventStreamRoute_r1, request, server, s.handlers.lrpGroupEvents.subscribe)
+}
+
+func (s *GRPCServer) LRPInstanceEvents(request *models.EventsByCellId, server models.BBS_LRPInstanceEventsServer) error {
+	logger := s.logger.Session("lrp-instance-events")
+	return s.streamEvents(logger, bbs.LRPInstanceEventStreamRoute_r1, request, server, s.handlers.lrpInstanceEvents.subscribe)
+}
+
+func (s *GRPCServer) TaskEvents(request *models.EventsByCellId, server models.BBS_TaskEventsServer) error {
+	logger := s.logger.Session("task-events")
+	return s.streamEvents(logger, bbs.TaskEventStreamRoute_r1, request, server, s.handlers.taskEvents.subscribe)
+}
+
+// errorResponse is a response carrying the error of its call.
+type errorResponse interface {
+	GetError() *models.Error
+}
+
+// ServerOptions returns the options of a gRPC server serving the API, which
+// check, trace, audit, log and meter its unary calls with the interceptors
+// of the policies the HTTP API wraps the handlers of the routes in, in the
+// same order.
+func (s *GRPCServer) ServerOptions() []grpc.ServerOption {
+	interceptors := []grpc.UnaryServerInterceptor{
+		UnavailableInterceptor(s.migrationsDone),
+		TracingInterceptor(),
+	}
+	if s.overload != nil {
+		interceptors = append(interceptors, OverloadInterceptor(s.logger, s.overload))
+	}
+	if s.limiter != nil {
+		interceptors = append(interceptors, RateLimitInterceptor(s.logger, s.limiter, s.emitter, s.advancedMetricsConfig))
+	}
+	if s.authorizer != nil {
+		interceptors = append(interceptors, AuthorizationInterceptor(s.logger, s.accessLogger, s.authorizer, s.resolveDomain, s.auditRecordDB))
+	}
+	if s.idempotencyKeyWindow > 0 {
+		interceptors = append(interceptors, IdempotencyInterceptor(s.logger, s.idempotencyKeyDB, s.idempotencyClock, s.idempotencyKeyWindow))
+	}
+	interceptors = append(interceptors, AuditInterceptor(), s.logCall)
+
+	return []grpc.ServerOption{grpc.ChainUnaryInterceptor(interceptors...)}
+}
+
+type callLoggerKey struct{}
+
+// logCall logs and meters the call as LogWrap and RecordMetrics do the
+// requests to the HTTP API, and serves it with the logger of the call in its
+// context.
+func (s *GRPCServer) logCall(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	route, _ := bbs.GRPCRoute(info.FullMethod)
+	identity, remoteAddr := peerIdentity(ctx)
+	requestLog, done := middleware.StartCall(s.logger, s.accessLogger, lager.Data{
+		"method":                                info.FullMethod,
+		"route":                                 route,
+		"remote_addr":                           remoteAddr,
+		"peer_cert_subject_common_name":         identity.CommonName,
+		"peer_cert_subject_organizational_unit": identity.OrganizationalUnits,
+	})
+	defer done()
+
+	logger := trace.LoggerWithTraceInfo(requestLog, trace.RequestIdFromContext(ctx))
+	start := time.Now()
+	response, err := handler(context.WithValue(ctx, callLoggerKey{}, logger), request)
+	middleware.RecordCall(s.emitter, s.advancedMetricsConfig, route, time.Since(start))
+
+	if response, ok := response.(errorResponse); ok {
+		exitIfUnrecoverable(requestLog, s.exitChan, response.GetError())
+	}
+	return response, err
+}
+
+// callLogger returns the logger of the call logCall serves.
+func (s *GRPCServer) callLogger(ctx context.Context) lager.Logger {
+	if logger, ok := ctx.Value(callLoggerKey{}).(lager.Logger); ok {
+		return logger
+	}
+	return s.logger
+}
+
+type eventSubscriber func(logger lager.Logger, request *models.EventsByCellId, lastEventID string, target format.Version) (*eventStream, error)
+
+// streamEvents streams the events of the subscription to the client. Streams
+// are checked with the same policies as the unary calls, which their
+// interceptors do not apply to.
+func (s *GRPCServer) streamEvents(logger lager.Logger, route string, request *models.EventsByCellId, server grpc.ServerStream, subscribe eventSubscriber) error {
+	if !closed(s.migrationsDone) {
+		return errNotReady
+	}
+
+	ctx := server.Context()
+	identity, remoteAddr := peerIdentity(ctx)
+
+	if s.overload != nil {
+		if retryAfter, shed := shedCall(logger.Session("overload"), s.overload, route, remoteAddr); shed {
+			server.SetTrailer(retryAfterTrailer(retryAfter))
+			return errOverloaded
+		}
+	}
+
+	if s.limiter != nil {
+		release, retryAfter, ok := acquireCall(ctx, logger.Session("rate-limit"), s.limiter, s.emitter, s.advancedMetricsConfig, route, identity, remoteAddr)
+		if !ok {
+			server.SetTrailer(retryAfterTrailer(retryAfter))
+			return errThrottled
+		}
+		defer release()
+	}
+
+	if s.authorizer != nil {
+		md, _ := metadata.FromIncomingContext(ctx)
+		traceID := metadataValue(md, trace.RequestIdHeader)
+		granted, err := authorizeCall(ctx, logger.Session("authorization"), s.accessLogger, s.authorizer, s.resolveDomain, s.auditRecordDB, route, traceID, identity, remoteAddr, marshalRequest(request))
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		if !granted {
+			return errForbidden
+		}
+	}
+
+	err := request.Validate()
+	if err != nil {
+		logger.Error("invalid-request", err)
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	stream, err := subscribe(logger, request, metadataValue(md, bbs.LastEventIDMetadataKey), format.V3)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer stream.Close()
+
+	// tell the client it has subscribed, as there may not be any event to
+	// send for a while
+	err = server.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	for {
+		id, event, err := stream.next(ctx.Done())
+		if err == errStreamDone {
+			logger.Debug("received-close-notify")
+			return nil
+		} else if err != nil {
+			logger.Error("failed-to-get-next-event", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		payload, err := proto.Marshal(event)
+		if err != nil {
+			logger.Error("failed-to-marshal-event", err)
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		err = server.SendMsg(&models.StreamedEvent{
+			Id:      id,
+			Type:    event.EventType(),
+			Payload: payload,
+		})
+		if err != nil {
+			logger.Debug("failed-to-send-event", lager.Data{"error": err.Error()})
+			return err
+		}
+	}
+}
+
+var (
+	errNotReady   = status.Error(codes.Unavailable, "the BBS is not ready to serve requests")
+	errOverloaded = status.Error(codes.Unavailable, "the BBS is overloaded")
+	errThrottled  = status.Error(codes.ResourceExhausted, "too many requests")
+	errForbidden  = status.Error(codes.PermissionDenied, "the client is not authorized to call the route")
+)
+
+func setTrailer(ctx context.Context, logger lager.Logger, trailer metadata.MD) {
+	err := grpc.SetTrailer(ctx, trailer)
+	if err != nil {
+		logger.Debug("failed-to-set-trailer", lager.Data{"error": err.Error()})
+	}
+}
+
+func retryAfterTrailer(retryAfter time.Duration) metadata.MD {
+	return metadata.Pairs(bbs.RetryAfterMetadataKey, strconv.Itoa(retryAfterSeconds(retryAfter)))
+}
+
+func metadataValue(md metadata.MD, key string) string {
+	if values := md.Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+// marshalRequest returns the function encoding the request of a call as the
+// body of the matching request to the HTTP API.
+func marshalRequest(request any) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		message, ok := request.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("unexpected request %T", request)
+		}
+		return proto.Marshal(message)
+	}
+}
+
+// newResponse returns an empty response of the method of the call.
+func newResponse(info *grpc.UnaryServerInfo) proto.Message {
+	name := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	method := reflect.ValueOf(info.Server).MethodByName(name)
+	return reflect.New(method.Type().Out(0).Elem()).Interface().(proto.Message)
+}
+
+// peerIdentity returns the identity of the client certificate and the
+// address of the peer of the call.
+func peerIdentity(ctx context.Context) (authorization.Identity, string) {
+	identity := authorization.Identity{}
+	remoteAddr := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddr = p.Addr.String()
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			identity = authorization.IdentityFromTLS(&tlsInfo.State)
+		}
+	}
+	return identity, remoteAddr
+}
+
+type grpcRunner struct {
+	listenAddress string
+	tlsConfig     *tls.Config
+	server        *GRPCServer
+}
+
+// NewGRPCRunner returns a runner serving the BBS gRPC API on the given
+// address, over TLS when tlsConfig is not nil.
+func NewGRPCRunner(listenAddress string, tlsConfig *tls.Config, server *GRPCServer) ifrit.Runner {
+	return &grpcRunner{
+		listenAddress: listenAddress,
+		tlsConfig:     tlsConfig,
+		server:        server,
+	}
+}
+
+func (r *grpcRunner) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	listener, err := net.Listen("tcp", r.listenAddress)
+	if err != nil {
+		return err
+	}
+
+	opts := r.server.ServerOptions()
+	if r.tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(r.tlsConfig)))
+	}
+
+	server := grpc.NewServer(opts...)
+	models.RegisterBBSServer(server, r.server)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	close(ready)
+
+	select {
+	case <-signals:
+		// event streams never end on their own, so a graceful stop would wait
+		// for them forever
+		server.Stop()
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
@@ -0,0 +1,488 @@
+package handlers_test
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/audit"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware/fakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+var _ = Describe("GRPCServer", func() {
+	var (
+		logger               *lagertest.TestLogger
+		fakeDB               *dbfakes.FakeDB
+		fakeEmitter          *fakes.FakeEmitter
+		desiredHub           events.Hub
+		actualHub            events.Hub
+		instanceHub          events.Hub
+		taskHub              events.Hub
+		migrationsDone       chan struct{}
+		exitChan             chan struct{}
+		authorizer           *authorization.Authorizer
+		limiter              *ratelimit.Limiter
+		overloaded           *overload.Controller
+		idempotencyKeyWindow time.Duration
+
+		grpcServer *grpc.Server
+		conn       *grpc.ClientConn
+		client     models.BBSClient
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeDB)
+		fakeEmitter = new(fakes.FakeEmitter)
+		desiredHub = events.NewHub(logger)
+		actualHub = events.NewHub(logger)
+		instanceHub = events.NewHub(logger)
+		taskHub = events.NewHub(logger)
+		migrationsDone = make(chan struct{})
+		exitChan = make(chan struct{}, 1)
+		authorizer = nil
+		limiter = nil
+		overloaded = nil
+		idempotencyKeyWindow = 0
+	})
+
+	JustBeforeEach(func() {
+		server := handlers.NewGRPCServer(
+			logger,
+			nil,
+			1,
+			1,
+			config.AdvancedMetrics{},
+			fakeEmitter,
+			fakeDB,
+			desiredHub,
+			actualHub,
+			instanceHub,
+			taskHub,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			authorizer,
+			limiter,
+			overloaded,
+			nil,
+			idempotencyKeyWindow,
+			nil,
+			migrationsDone,
+			exitChan,
+			nil,
+		)
+
+		listener := bufconn.Listen(1024 * 1024)
+		grpcServer = grpc.NewServer(server.ServerOptions()...)
+		models.RegisterBBSServer(grpcServer, server)
+		go func() {
+			defer GinkgoRecover()
+			Expect(grpcServer.Serve(listener)).To(Succeed())
+		}()
+
+		var err error
+		conn, err = grpc.NewClient("passthrough:///bufnet",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return listener.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		)
+		Expect(err).NotTo(HaveOccurred())
+		client = models.NewBBSClient(conn)
+	})
+
+	AfterEach(func() {
+		Expect(conn.Close()).To(Succeed())
+		grpcServer.Stop()
+		desiredHub.Close()
+		actualHub.Close()
+		instanceHub.Close()
+		taskHub.Close()
+	})
+
+	Describe("unary calls", func() {
+		It("is unavailable until the migrations are done", func() {
+			_, err := client.Ping(context.Background(), &models.PingRequest{})
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		Context("when the migrations are done", func() {
+			BeforeEach(func() {
+				close(migrationsDone)
+			})
+
+			It("serves them with the controllers", func() {
+				fakeDB.ListTasksReturns([]*models.Task{{TaskGuid: "task-guid"}}, db.ListMetadata{ResourceVersion: 7}, nil)
+
+				ctx := metadata.AppendToOutgoingContext(context.Background(), trace.RequestIdHeader, "some-trace-id")
+				response, err := client.Tasks(ctx, &models.TasksRequest{Domain: "some-domain"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error).To(BeNil())
+				Expect(response.Tasks).To(HaveLen(1))
+				Expect(response.Tasks[0].TaskGuid).To(Equal("task-guid"))
+				Expect(response.ResourceVersion).To(BeEquivalentTo(7))
+
+				Expect(fakeDB.ListTasksCallCount()).To(Equal(1))
+				callCtx, _, filter := fakeDB.ListTasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal("some-domain"))
+				Expect(trace.RequestIdFromContext(callCtx)).To(Equal("some-trace-id"))
+			})
+
+			It("records the metrics of the call", func() {
+				_, err := client.Ping(context.Background(), &models.PingRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeEmitter.IncrementRequestCounterCallCount()).To(Equal(1))
+				Expect(fakeEmitter.UpdateLatencyCallCount()).To(Equal(1))
+			})
+
+			It("returns the errors of invalid requests in the response", func() {
+				response, err := client.TaskByGuid(context.Background(), &models.TaskByGuidRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+				Expect(fakeDB.TaskByGuidCallCount()).To(Equal(0))
+			})
+
+			It("exits on unrecoverable errors", func() {
+				fakeDB.ListTasksReturns(nil, db.ListMetadata{}, models.NewUnrecoverableError(nil))
+
+				response, err := client.Tasks(context.Background(), &models.TasksRequest{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.Error.Type).To(Equal(models.Error_Unrecoverable))
+				Eventually(exitChan).Should(Receive())
+			})
+
+			Context("when the client is not authorized to make the call", func() {
+				BeforeEach(func() {
+					var err error
+					authorizer, err = authorization.NewAuthorizer([]authorization.Rule{
+						{CommonName: "cc", Roles: []string{authorization.RoleReadOnly}},
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns PermissionDenied, logs it and records it in the audit log", func() {
+					ctx := metadata.AppendToOutgoingContext(context.Background(), trace.RequestIdHeader, "some-trace-id")
+					_, err := client.Tasks(ctx, &models.TasksRequest{})
+					Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+					Expect(fakeDB.ListTasksCallCount()).To(Equal(0))
+					Expect(logger).To(gbytes.Say("authorization.denied"))
+
+					Expect(fakeDB.RecordDenialCallCount()).To(Equal(1))
+					auditCtx, _, domain := fakeDB.RecordDenialArgsForCall(0)
+					Expect(domain).To(BeEmpty())
+					call, ok := audit.FromContext(auditCtx)
+					Expect(ok).To(BeTrue())
+					Expect(call.Route).To(Equal(bbs.TasksRoute_r3))
+					Expect(call.TraceID).To(Equal("some-trace-id"))
+				})
+			})
+
+			Context("when the client is throttled", func() {
+				BeforeEach(func() {
+					var err error
+					limiter, err = ratelimit.NewLimiter(fakeclock.NewFakeClock(time.Now()), ratelimit.Config{
+						Enabled: true,
+						Rules:   []ratelimit.Rule{{RequestsPerSecond: 0.5}},
+					})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("returns ResourceExhausted with the retry hint and counts it", func() {
+					_, err := client.Tasks(context.Background(), &models.TasksRequest{})
+					Expect(err).NotTo(HaveOccurred())
+
+					var trailer metadata.MD
+					_, err = client.Tasks(context.Background(), &models.TasksRequest{}, grpc.Trailer(&trailer))
+					Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+					Expect(trailer.Get(bbs.RetryAfterMetadataKey)).To(Equal([]string{"2"}))
+					Expect(fakeDB.ListTasksCallCount()).To(Equal(1))
+					Expect(fakeEmitter.IncrementThrottledRequestCounterCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the BBS sheds the call", func() {
+				var process ifrit.Process
+
+				BeforeEach(func() {
+					overloaded, process = newOverloadController(overload.LevelListings)
+				})
+
+				AfterEach(func() {
+					ginkgomon.Interrupt(process)
+				})
+
+				It("returns Unavailable with the retry hint", func() {
+					var trailer metadata.MD
+					_, err := client.Tasks(context.Background(), &models.TasksRequest{}, grpc.Trailer(&trailer))
+					Expect(status.Code(err)).To(Equal(codes.Unavailable))
+					Expect(trailer.Get(bbs.RetryAfterMetadataKey)).To(Equal([]string{"5"}))
+					Expect(fakeDB.ListTasksCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the call carries an idempotency key", func() {
+				var ctx context.Context
+
+				BeforeEach(func() {
+					idempotencyKeyWindow = time.Hour
+					ctx = metadata.AppendToOutgoingContext(context.Background(), bbs.IdempotencyKeyMetadataKey, "some-key")
+				})
+
+				It("serves the call and records its response", func() {
+					_, err := client.UpsertDomain(ctx, &models.UpsertDomainRequest{Domain: "some-domain", Ttl: 10})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeDB.UpsertDomainCallCount()).To(Equal(1))
+
+					Expect(fakeDB.ReserveIdempotencyKeyCallCount()).To(Equal(1))
+					_, _, key, _, _ := fakeDB.ReserveIdempotencyKeyArgsForCall(0)
+					Expect(key).To(Equal("some-key"))
+					Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(1))
+				})
+
+				It("releases the key when the call fails on a deadlock", func() {
+					fakeDB.UpsertDomainReturns(models.ErrDeadlock)
+
+					response, err := client.UpsertDomain(ctx, &models.UpsertDomainRequest{Domain: "some-domain", Ttl: 10})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(response.Error.Type).To(Equal(models.Error_Deadlock))
+					Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(0))
+					Expect(fakeDB.ReleaseIdempotencyKeyCallCount()).To(Equal(1))
+				})
+
+				It("does not reserve the key of read-only calls", func() {
+					_, err := client.Tasks(ctx, &models.TasksRequest{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(fakeDB.ReserveIdempotencyKeyCallCount()).To(Equal(0))
+				})
+
+				Context("when the key was used for the same call", func() {
+					BeforeEach(func() {
+						fakeDB.ReserveIdempotencyKeyStub = func(_ context.Context, _ lager.Logger, key, requestHash string, _ time.Time) (*models.IdempotencyKey, error) {
+							response, err := proto.Marshal(&models.UpsertDomainResponse{Error: models.ErrResourceConflict})
+							Expect(err).NotTo(HaveOccurred())
+							return &models.IdempotencyKey{Key: key, RequestHash: requestHash, Response: response}, nil
+						}
+					})
+
+					It("replays the response of the first call", func() {
+						response, err := client.UpsertDomain(ctx, &models.UpsertDomainRequest{Domain: "some-domain", Ttl: 10})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.Error).To(Equal(models.ErrResourceConflict))
+						Expect(fakeDB.UpsertDomainCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the first call with the key is being served", func() {
+					BeforeEach(func() {
+						fakeDB.ReserveIdempotencyKeyReturns(nil, models.ErrResourceExists)
+					})
+
+					It("returns Aborted with the retry hint", func() {
+						var trailer metadata.MD
+						_, err := client.UpsertDomain(ctx, &models.UpsertDomainRequest{Domain: "some-domain", Ttl: 10}, grpc.Trailer(&trailer))
+						Expect(status.Code(err)).To(Equal(codes.Aborted))
+						Expect(trailer.Get(bbs.RetryAfterMetadataKey)).To(Equal([]string{"1"}))
+						Expect(fakeDB.UpsertDomainCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the key was used for a different call", func() {
+					BeforeEach(func() {
+						fakeDB.ReserveIdempotencyKeyReturns(&models.IdempotencyKey{Key: "some-key", RequestHash: "other-hash"}, nil)
+					})
+
+					It("returns FailedPrecondition", func() {
+						_, err := client.UpsertDomain(ctx, &models.UpsertDomainRequest{Domain: "some-domain", Ttl: 10})
+						Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+						Expect(fakeDB.UpsertDomainCallCount()).To(Equal(0))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("event streams", func() {
+		It("is unavailable until the migrations are done", func() {
+			stream, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.Unavailable))
+		})
+
+		Context("when the migrations are done", func() {
+			BeforeEach(func() {
+				close(migrationsDone)
+			})
+
+			It("streams the events of the hubs", func() {
+				stream, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Header()
+				Expect(err).NotTo(HaveOccurred())
+
+				task := &models.Task{TaskGuid: "task-guid", Domain: "some-domain", TaskDefinition: &models.TaskDefinition{}}
+				taskHub.Emit(models.NewTaskCreatedEvent(task))
+
+				streamed, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(streamed.Id).NotTo(BeEmpty())
+				Expect(streamed.Type).To(Equal(models.EventTypeTaskCreated))
+
+				event := &models.TaskCreatedEvent{}
+				Expect(proto.Unmarshal(streamed.Payload, event)).To(Succeed())
+				Expect(event.Task.TaskGuid).To(Equal("task-guid"))
+			})
+
+			It("applies the filter of the request", func() {
+				stream, err := client.LRPInstanceEvents(context.Background(), &models.EventsByCellId{CellId: "cell-a"})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Header()
+				Expect(err).NotTo(HaveOccurred())
+
+				instanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(&models.ActualLRP{
+					ActualLRPKey:         models.NewActualLRPKey("process-guid", 0, "some-domain"),
+					ActualLRPInstanceKey: models.NewActualLRPInstanceKey("instance-guid", "cell-b"),
+				}, ""))
+				instanceHub.Emit(models.NewActualLRPInstanceCreatedEvent(&models.ActualLRP{
+					ActualLRPKey:         models.NewActualLRPKey("process-guid", 1, "some-domain"),
+					ActualLRPInstanceKey: models.NewActualLRPInstanceKey("instance-guid", "cell-a"),
+				}, ""))
+
+				streamed, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+
+				event := &models.ActualLRPInstanceCreatedEvent{}
+				Expect(proto.Unmarshal(streamed.Payload, event)).To(Succeed())
+				Expect(event.ActualLrp.Index).To(BeEquivalentTo(1))
+			})
+
+			It("resumes after the event whose ID is sent as last-event-id", func() {
+				taskHub.Emit(models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-1", TaskDefinition: &models.TaskDefinition{}}))
+				lastEventID := taskHub.LastEventID()
+				taskHub.Emit(models.NewTaskCreatedEvent(&models.Task{TaskGuid: "task-2", TaskDefinition: &models.TaskDefinition{}}))
+
+				ctx := metadata.AppendToOutgoingContext(context.Background(), "last-event-id", strconv.FormatUint(lastEventID, 10))
+				stream, err := client.TaskEvents(ctx, &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+
+				streamed, err := stream.Recv()
+				Expect(err).NotTo(HaveOccurred())
+
+				event := &models.TaskCreatedEvent{}
+				Expect(proto.Unmarshal(streamed.Payload, event)).To(Succeed())
+				Expect(event.Task.TaskGuid).To(Equal("task-2"))
+			})
+		})
+
+		Context("when the client is not authorized to stream the events", func() {
+			BeforeEach(func() {
+				close(migrationsDone)
+
+				var err error
+				authorizer, err = authorization.NewAuthorizer([]authorization.Rule{
+					{CommonName: "cc", Roles: []string{authorization.RoleReadOnly}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns PermissionDenied and audits it", func() {
+				stream, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(logger).To(gbytes.Say("authorization.denied"))
+				Expect(logger).To(gbytes.Say(`"route":"TaskEventStream"`))
+
+				Expect(fakeDB.RecordDenialCallCount()).To(Equal(1))
+				auditCtx, _, _ := fakeDB.RecordDenialArgsForCall(0)
+				call, ok := audit.FromContext(auditCtx)
+				Expect(ok).To(BeTrue())
+				Expect(call.Route).To(Equal(bbs.TaskEventStreamRoute_r1))
+			})
+		})
+
+		Context("when the client is throttled", func() {
+			BeforeEach(func() {
+				close(migrationsDone)
+
+				var err error
+				limiter, err = ratelimit.NewLimiter(fakeclock.NewFakeClock(time.Now()), ratelimit.Config{
+					Enabled: true,
+					Rules:   []ratelimit.Rule{{MaxInFlight: 1}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns ResourceExhausted with the retry hint", func() {
+				stream, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+				_, err = stream.Header()
+				Expect(err).NotTo(HaveOccurred())
+
+				throttled, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = throttled.Recv()
+				Expect(status.Code(err)).To(Equal(codes.ResourceExhausted))
+				Expect(throttled.Trailer().Get(bbs.RetryAfterMetadataKey)).NotTo(BeEmpty())
+			})
+		})
+
+		Context("when the BBS sheds event subscriptions", func() {
+			var process ifrit.Process
+
+			BeforeEach(func() {
+				close(migrationsDone)
+				overloaded, process = newOverloadController(overload.LevelEventSubscriptions)
+			})
+
+			AfterEach(func() {
+				ginkgomon.Interrupt(process)
+			})
+
+			It("returns Unavailable with the retry hint", func() {
+				stream, err := client.TaskEvents(context.Background(), &models.EventsByCellId{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = stream.Recv()
+				Expect(status.Code(err)).To(Equal(codes.Unavailable))
+				Expect(stream.Trailer().Get(bbs.RetryAfterMetadataKey)).To(Equal([]string{"5"}))
+			})
+		})
+	})
+})
@@ -4,18 +4,26 @@ import (
 	"io"
 	"net/http"
 	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/auctioneer"
 	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/admission"
+	"code.cloudfoundry.org/bbs/authorization"
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/controllers"
+	"code.cloudfoundry.org/bbs/crashstorm"
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/handlers/middleware"
 	"code.cloudfoundry.org/bbs/metrics"
 	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/bbs/repadmin"
 	"code.cloudfoundry.org/bbs/serviceclient"
 	"code.cloudfoundry.org/bbs/taskworkpool"
+	"code.cloudfoundry.org/clock"
 	loggingclient "code.cloudfoundry.org/diego-logging-client"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/rep"
@@ -37,37 +45,39 @@ func New(
 	serviceClient serviceclient.ServiceClient,
 	auctioneerClient auctioneer.Client,
 	repClientFactory rep.ClientFactory,
+	repAdminClient repadmin.Client,
+	admitter admission.Admitter,
+	authorizer *authorization.Authorizer,
+	limiter *ratelimit.Limiter,
+	overloadController *overload.Controller,
+	crashStormDetector *crashstorm.Detector,
+	idempotencyKeyWindow time.Duration,
 	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
 	migrationsDone <-chan struct{},
 	exitChan chan struct{},
 	metronClient loggingclient.IngressClient,
 ) http.Handler {
-	pingHandler := NewPingHandler()
-	domainHandler := NewDomainHandler(db, exitChan)
-	actualLRPHandler := NewActualLRPHandler(db, exitChan)
-	actualLRPController := controllers.NewActualLRPLifecycleController(
-		db, db, db, db,
-		auctioneerClient,
-		serviceClient,
-		repClientFactory,
-		actualHub,
-		actualLRPInstanceHub,
-	)
-	evacuationController := controllers.NewEvacuationController(
-		db, db, db, db,
-		auctioneerClient,
-		actualHub,
-		actualLRPInstanceHub,
-	)
-	actualLRPLifecycleHandler := NewActualLRPLifecycleHandler(actualLRPController, exitChan)
-	evacuationHandler := NewEvacuationHandler(evacuationController, exitChan)
-	desiredLRPHandler := NewDesiredLRPHandler(updateWorkers, db, db, desiredHub, actualHub, actualLRPInstanceHub, auctioneerClient, repClientFactory, serviceClient, exitChan, metronClient)
-	taskController := controllers.NewTaskController(db, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries)
-	taskHandler := NewTaskHandler(taskController, exitChan)
-	lrpGroupEventsHandler := NewLRPGroupEventsHandler(desiredHub, actualHub)
-	taskEventsHandler := NewTaskEventHandler(taskHub)
-	lrpInstanceEventsHandler := NewLRPInstanceEventHandler(desiredHub, actualLRPInstanceHub)
-	cellsHandler := NewCellHandler(serviceClient, exitChan)
+	apiHandlers := newAPIHandlers(updateWorkers, maxTaskPlacementRetries, db, desiredHub, actualHub, actualLRPInstanceHub, taskHub, taskCompletionClient, serviceClient, auctioneerClient, repClientFactory, repAdminClient, admitter, overloadController, crashStormDetector, taskStatMetronNotifier, exitChan, metronClient)
+
+	pingHandler := apiHandlers.ping
+	domainHandler := apiHandlers.domain
+	domainQuotaHandler := apiHandlers.domainQuota
+	actualLRPHandler := apiHandlers.actualLRP
+	actualLRPHistoryHandler := apiHandlers.actualLRPHistory
+	actualLRPLifecycleHandler := apiHandlers.actualLRPLifecycle
+	evacuationHandler := apiHandlers.evacuation
+	desiredLRPHandler := apiHandlers.desiredLRP
+	deploymentHandler := apiHandlers.deployment
+	taskHandler := apiHandlers.task
+	scheduledTaskHandler := apiHandlers.scheduledTask
+	taskCallbackHandler := apiHandlers.taskCallback
+	auditRecordHandler := apiHandlers.auditRecord
+	overloadHandler := apiHandlers.overload
+	crashStormHandler := apiHandlers.crashStorm
+	lrpGroupEventsHandler := apiHandlers.lrpGroupEvents
+	taskEventsHandler := apiHandlers.taskEvents
+	lrpInstanceEventsHandler := apiHandlers.lrpInstanceEvents
+	cellsHandler := apiHandlers.cell
 
 	metricsAndLoggingWrap := func(loggableHandlerFunc middleware.LoggableHandlerFunc, routeName string) http.HandlerFunc {
 		return middleware.RecordMetrics(middleware.LogWrap(logger, accessLogger, loggableHandlerFunc), emitter, advancedMetricsConfig, routeName)
@@ -81,6 +91,12 @@ func New(
 		bbs.DomainsRoute_r0:      metricsAndLoggingWrap(domainHandler.Domains, bbs.DomainsRoute_r0),
 		bbs.UpsertDomainRoute_r0: metricsAndLoggingWrap(domainHandler.Upsert, bbs.UpsertDomainRoute_r0),
 
+		// Domain Quotas
+		bbs.DomainQuotasRoute_r0:      metricsAndLoggingWrap(domainQuotaHandler.DomainQuotas, bbs.DomainQuotasRoute_r0),
+		bbs.SetDomainQuotaRoute_r0:    metricsAndLoggingWrap(domainQuotaHandler.SetDomainQuota, bbs.SetDomainQuotaRoute_r0),
+		bbs.RemoveDomainQuotaRoute_r0: metricsAndLoggingWrap(domainQuotaHandler.RemoveDomainQuota, bbs.RemoveDomainQuotaRoute_r0),
+		bbs.DomainUsageRoute_r0:       metricsAndLoggingWrap(domainQuotaHandler.DomainUsage, bbs.DomainUsageRoute_r0),
+
 		// Actual LRPs
 		bbs.ActualLRPsRoute_r0: metricsAndLoggingWrap(actualLRPHandler.ActualLRPs, bbs.ActualLRPsRoute_r0),
 		// Multiple Actual LRPs by multiple Process GUIDs
@@ -91,6 +107,7 @@ func New(
 		bbs.ActualLRPGroupsByProcessGuidRoute_r0: metricsAndLoggingWrap(actualLRPHandler.ActualLRPGroupsByProcessGuid, bbs.ActualLRPGroupsByProcessGuidRoute_r0),
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0: metricsAndLoggingWrap(actualLRPHandler.ActualLRPGroupByProcessGuidAndIndex, bbs.ActualLRPGroupByProcessGuidAndIndexRoute_r0),
+		bbs.ActualLRPHistoryRoute_r0:                    metricsAndLoggingWrap(actualLRPHistoryHandler.ActualLRPHistory, bbs.ActualLRPHistoryRoute_r0),
 
 		// Actual LRP Lifecycle
 		bbs.ClaimActualLRPRoute_r0: metricsAndLoggingWrap(actualLRPLifecycleHandler.ClaimActualLRP, bbs.ClaimActualLRPRoute_r0),
@@ -125,6 +142,13 @@ func New(
 		bbs.UpdateDesiredLRPRoute_r0:                 metricsAndLoggingWrap(desiredLRPHandler.UpdateDesiredLRP, bbs.UpdateDesiredLRPRoute_r0),
 		bbs.RemoveDesiredLRPRoute_r0:                 metricsAndLoggingWrap(desiredLRPHandler.RemoveDesiredLRP, bbs.RemoveDesiredLRPRoute_r0),
 
+		// Deployments
+		bbs.StartDeploymentRoute_r0:         metricsAndLoggingWrap(deploymentHandler.StartDeployment, bbs.StartDeploymentRoute_r0),
+		bbs.DeploymentByProcessGuidRoute_r0: metricsAndLoggingWrap(deploymentHandler.DeploymentByProcessGuid, bbs.DeploymentByProcessGuidRoute_r0),
+		bbs.PauseDeploymentRoute_r0:         metricsAndLoggingWrap(deploymentHandler.PauseDeployment, bbs.PauseDeploymentRoute_r0),
+		bbs.ResumeDeploymentRoute_r0:        metricsAndLoggingWrap(deploymentHandler.ResumeDeployment, bbs.ResumeDeploymentRoute_r0),
+		bbs.RollbackDeploymentRoute_r0:      metricsAndLoggingWrap(deploymentHandler.RollbackDeployment, bbs.RollbackDeploymentRoute_r0),
+
 		// Tasks
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.TasksRoute_r2: metricsAndLoggingWrap(taskHandler.Tasks_r2, bbs.TasksRoute_r2), // DEPRECATED
@@ -142,6 +166,27 @@ func New(
 		bbs.ResolvingTaskRoute_r0: metricsAndLoggingWrap(taskHandler.ResolvingTask, bbs.ResolvingTaskRoute_r0),
 		bbs.DeleteTaskRoute_r0:    metricsAndLoggingWrap(taskHandler.DeleteTask, bbs.DeleteTaskRoute_r0),
 
+		// Scheduled Tasks
+		bbs.ScheduledTasksRoute_r0:       metricsAndLoggingWrap(scheduledTaskHandler.ScheduledTasks, bbs.ScheduledTasksRoute_r0),
+		bbs.DesireScheduledTaskRoute_r0:  metricsAndLoggingWrap(scheduledTaskHandler.DesireScheduledTask, bbs.DesireScheduledTaskRoute_r0),
+		bbs.UpdateScheduledTaskRoute_r0:  metricsAndLoggingWrap(scheduledTaskHandler.UpdateScheduledTask, bbs.UpdateScheduledTaskRoute_r0),
+		bbs.SuspendScheduledTaskRoute_r0: metricsAndLoggingWrap(scheduledTaskHandler.SuspendScheduledTask, bbs.SuspendScheduledTaskRoute_r0),
+		bbs.DeleteScheduledTaskRoute_r0:  metricsAndLoggingWrap(scheduledTaskHandler.DeleteScheduledTask, bbs.DeleteScheduledTaskRoute_r0),
+
+		// Task Callbacks
+		bbs.TaskCallbacksRoute_r0:      metricsAndLoggingWrap(taskCallbackHandler.TaskCallbacks, bbs.TaskCallbacksRoute_r0),
+		bbs.ReplayTaskCallbackRoute_r0: metricsAndLoggingWrap(taskCallbackHandler.ReplayTaskCallback, bbs.ReplayTaskCallbackRoute_r0),
+
+		// Audit Records
+		bbs.AuditRecordsRoute_r0: metricsAndLoggingWrap(auditRecordHandler.AuditRecords, bbs.AuditRecordsRoute_r0),
+
+		// Overload
+		bbs.OverloadStatusRoute_r0: metricsAndLoggingWrap(overloadHandler.OverloadStatus, bbs.OverloadStatusRoute_r0),
+
+		// Crash Storm Breakers
+		bbs.CrashStormBreakersRoute_r0:     metricsAndLoggingWrap(crashStormHandler.CrashStormBreakers, bbs.CrashStormBreakersRoute_r0),
+		bbs.ResetCrashStormBreakerRoute_r0: metricsAndLoggingWrap(crashStormHandler.ResetCrashStormBreaker, bbs.ResetCrashStormBreakerRoute_r0),
+
 		// Events
 		//lint:ignore SA1019 - implementing deprecated logic until it is removed
 		bbs.EventStreamRoute_r0: middleware.RecordRequestCount(middleware.LogWrap(logger, accessLogger, lrpGroupEventsHandler.Subscribe_r0), emitter), // DEPRECATED
@@ -154,7 +199,49 @@ func New(
 		bbs.LRPInstanceEventStreamRoute_r1: middleware.RecordRequestCount(middleware.LogWrap(logger, accessLogger, lrpInstanceEventsHandler.Subscribe_r1), emitter),
 
 		// Cells
-		bbs.CellsRoute_r0: metricsAndLoggingWrap(cellsHandler.Cells, bbs.CellsRoute_r0),
+		bbs.CellsRoute_r0:        metricsAndLoggingWrap(cellsHandler.Cells, bbs.CellsRoute_r0),
+		bbs.CordonCellRoute_r0:   metricsAndLoggingWrap(cellsHandler.CordonCell, bbs.CordonCellRoute_r0),
+		bbs.UncordonCellRoute_r0: metricsAndLoggingWrap(cellsHandler.UncordonCell, bbs.UncordonCellRoute_r0),
+		bbs.DrainCellRoute_r0:    metricsAndLoggingWrap(cellsHandler.DrainCell, bbs.DrainCellRoute_r0),
+		bbs.CellDrainsRoute_r0:   metricsAndLoggingWrap(cellsHandler.CellDrains, bbs.CellDrainsRoute_r0),
+	}
+
+	for route, action := range actions {
+		actions[route] = AuditWrap(route, action)
+	}
+
+	if idempotencyKeyWindow > 0 {
+		idempotencyClock := clock.NewClock()
+		for route, action := range actions {
+			if !authorization.RoleAllows(authorization.RoleReadOnly, route) {
+				actions[route] = IdempotencyWrap(logger, db, idempotencyClock, idempotencyKeyWindow, route, action)
+			}
+		}
+	}
+
+	if authorizer != nil {
+		resolveDomain := NewDomainResolver(db)
+		for route, action := range actions {
+			actions[route] = AuthorizationWrap(logger, accessLogger, authorizer, route, resolveDomain, db, action)
+		}
+	}
+
+	if limiter != nil {
+		for route, action := range actions {
+			actions[route] = RateLimitWrap(logger, limiter, emitter, advancedMetricsConfig, route, action)
+		}
+	}
+
+	if overloadController != nil {
+		for route, action := range actions {
+			actions[route] = OverloadWrap(logger, overloadController, route, action)
+		}
+	}
+
+	for route, action := range actions {
+		if ratelimit.ClassOf(route) != ratelimit.ClassEvents {
+			actions[route] = TracingWrap(route, action)
+		}
 	}
 
 	handler, err := rata.NewRouter(bbs.Routes, actions)
@@ -162,9 +249,94 @@ func New(
 		panic("unable to create router: " + err.Error())
 	}
 
-	return UnavailableWrap(handler,
+	return DeadlineWrap(UnavailableWrap(handler,
 		migrationsDone,
+	))
+}
+
+// apiHandlers are the handlers both the HTTP and the gRPC API serve their
+// calls with.
+type apiHandlers struct {
+	ping               *PingHandler
+	domain             *DomainHandler
+	domainQuota        *DomainQuotaHandler
+	actualLRP          *ActualLRPHandler
+	actualLRPHistory   *ActualLRPHistoryHandler
+	actualLRPLifecycle *ActualLRPLifecycleHandler
+	evacuation         *EvacuationHandler
+	desiredLRP         *DesiredLRPHandler
+	deployment         *DeploymentHandler
+	task               *TaskHandler
+	scheduledTask      *ScheduledTaskHandler
+	taskCallback       *TaskCallbackHandler
+	auditRecord        *AuditRecordHandler
+	overload           *OverloadHandler
+	crashStorm         *CrashStormHandler
+	lrpGroupEvents     *LRPGroupEventsHandler
+	lrpInstanceEvents  *LRPInstanceEventHandler
+	taskEvents         *TaskEventHandler
+	cell               *CellHandler
+}
+
+func newAPIHandlers(
+	updateWorkers int,
+	maxTaskPlacementRetries int,
+	db db.DB,
+	desiredHub, actualHub, actualLRPInstanceHub, taskHub events.Hub,
+	taskCompletionClient taskworkpool.TaskCompletionClient,
+	serviceClient serviceclient.ServiceClient,
+	auctioneerClient auctioneer.Client,
+	repClientFactory rep.ClientFactory,
+	repAdminClient repadmin.Client,
+	admitter admission.Admitter,
+	overloadController *overload.Controller,
+	crashStormDetector *crashstorm.Detector,
+	taskStatMetronNotifier metrics.TaskStatMetronNotifier,
+	exitChan chan struct{},
+	metronClient loggingclient.IngressClient,
+) apiHandlers {
+	actualLRPController := controllers.NewActualLRPLifecycleController(
+		db, db, db, db, db,
+		auctioneerClient,
+		serviceClient,
+		repClientFactory,
+		actualHub,
+		actualLRPInstanceHub,
+		crashStormDetector,
+	)
+	evacuationController := controllers.NewEvacuationController(
+		db, db, db, db, db,
+		auctioneerClient,
+		actualHub,
+		actualLRPInstanceHub,
+		crashStormDetector,
 	)
+	deploymentController := controllers.NewDeploymentController(db, db, db, auctioneerClient, actualLRPController, desiredHub, actualHub, actualLRPInstanceHub)
+	taskController := controllers.NewTaskController(db, admitter, taskCompletionClient, auctioneerClient, serviceClient, repClientFactory, taskHub, taskStatMetronNotifier, maxTaskPlacementRetries)
+	scheduledTaskController := controllers.NewScheduledTaskController(clock.NewClock(), db, db, taskController)
+	cellController := controllers.NewCellController(db, serviceClient, repAdminClient, actualLRPInstanceHub)
+
+	return apiHandlers{
+		ping:               NewPingHandler(),
+		domain:             NewDomainHandler(db, exitChan),
+		domainQuota:        NewDomainQuotaHandler(db, exitChan),
+		actualLRP:          NewActualLRPHandler(db, exitChan),
+		actualLRPHistory:   NewActualLRPHistoryHandler(db, exitChan),
+		actualLRPLifecycle: NewActualLRPLifecycleHandler(actualLRPController, exitChan),
+		evacuation:         NewEvacuationHandler(evacuationController, exitChan),
+		desiredLRP:         NewDesiredLRPHandler(updateWorkers, db, db, admitter, desiredHub, actualHub, actualLRPInstanceHub, auctioneerClient, repClientFactory, serviceClient, exitChan, metronClient),
+		deployment:         NewDeploymentHandler(deploymentController, exitChan),
+		task:               NewTaskHandler(taskController, exitChan),
+		scheduledTask:      NewScheduledTaskHandler(scheduledTaskController, exitChan),
+		taskCallback:       NewTaskCallbackHandler(db, exitChan),
+		auditRecord:        NewAuditRecordHandler(db, exitChan),
+		overload:           NewOverloadHandler(overloadController),
+		crashStorm:         NewCrashStormHandler(crashStormDetector),
+		lrpGroupEvents:     NewLRPGroupEventsHandler(desiredHub, actualHub),
+		lrpInstanceEvents:  NewLRPInstanceEventHandler(desiredHub, actualLRPInstanceHub),
+		taskEvents:         NewTaskEventHandler(taskHub),
+		cell:               NewCellHandler(serviceClient, cellController, exitChan),
+	}
 }
 
 func parseRequest(logger lager.Logger, req *http.Request, request MessageValidator) error {
@@ -180,6 +352,12 @@ func parseRequest(logger lager.Logger, req *http.Request, request MessageValidat
 		return models.ErrBadRequest
 	}
 
+	return validateRequest(logger, request)
+}
+
+// validateRequest returns the InvalidRequest error a request that is not
+// valid is answered with.
+func validateRequest(logger lager.Logger, request MessageValidator) error {
 	if err := request.Validate(); err != nil {
 		logger.Error("invalid-request", err)
 		return models.NewError(models.Error_InvalidRequest, err.Error())
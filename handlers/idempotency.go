@@ -0,0 +1,282 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxIdempotencyKeyLength is the size of the column the keys are stored in.
+const maxIdempotencyKeyLength = 255
+
+// IdempotencyWrap serves the requests of the route that carry an
+// Idempotency-Key header at most once per key within the window, and answers
+// the requests reusing a key with the response of the first one. It responds
+// with '409 Conflict' and a Retry-After header while the first request is
+// being served, and with '422 Unprocessable Entity' to a request reusing the
+// key of a different call.
+//
+// Only the responses of calls that were served are kept: the key of a call
+// failing with a non-200 status, or whose response carries an error that
+// leaves the call undone, is released, so that it can be retried.
+func IdempotencyWrap(logger lager.Logger, db db.IdempotencyKeyDB, clock clock.Clock, window time.Duration, route string, handler http.Handler) http.HandlerFunc {
+	logger = logger.Session("idempotency")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get(bbs.IdempotencyKeyHeader)
+		if key == "" {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		logger := logger.WithData(lager.Data{"route": route, "key": key})
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			logger.Error("failed-to-read-body", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		reservation, response := reserveCall(r.Context(), logger, db, clock, window, route, key, authorization.IdentityFromTLS(r.TLS).CommonName, body)
+		switch reservation {
+		case keyTooLong:
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		case keyInUse:
+			w.Header().Set(bbs.RetryAfterHeader, "1")
+			w.WriteHeader(http.StatusConflict)
+			return
+		case keyNotReserved:
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		case keyMismatch:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			return
+		case keyReplayed:
+			w.Header().Set("Content-Length", strconv.Itoa(len(response)))
+			w.Header().Set(bbs.ContentTypeHeader, bbs.ProtoContentType)
+			w.WriteHeader(http.StatusOK)
+			// #nosec G104 - ignore errors when writing HTTP responses so we don't spam our logs during a DoS
+			w.Write(response)
+			return
+		}
+
+		recorder := &bufferedResponseWriter{header: w.Header(), status: http.StatusOK}
+		handler.ServeHTTP(recorder, r)
+
+		keep := recorder.status == http.StatusOK && keepsResponse(errorOfResponse(recorder.body.Bytes()))
+		finishCall(r.Context(), logger, db, key, recorder.body.Bytes(), keep)
+
+		w.WriteHeader(recorder.status)
+		// #nosec G104 - ignore errors when writing HTTP responses so we don't spam our logs during a DoS
+		w.Write(recorder.body.Bytes())
+	}
+}
+
+// IdempotencyInterceptor serves the calls to the gRPC API that carry an
+// idempotency-key metadata at most once per key within the window, as
+// IdempotencyWrap does the requests to the HTTP API. Calls reusing a key fail
+// with Aborted and a retry-after trailer while the first call is being
+// served, and with FailedPrecondition when it was used for a different call.
+// Calls of read-only routes change nothing, and are always served.
+func IdempotencyInterceptor(logger lager.Logger, db db.IdempotencyKeyDB, clock clock.Clock, window time.Duration) grpc.UnaryServerInterceptor {
+	logger = logger.Session("idempotency")
+
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		md, _ := metadata.FromIncomingContext(ctx)
+		key := metadataValue(md, bbs.IdempotencyKeyMetadataKey)
+		if key == "" || authorization.RoleAllows(authorization.RoleReadOnly, route) {
+			return handler(ctx, request)
+		}
+
+		logger := logger.WithData(lager.Data{"route": route, "key": key})
+		body, err := marshalRequest(request)()
+		if err != nil {
+			logger.Error("failed-to-marshal-request", err)
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		identity, _ := peerIdentity(ctx)
+		reservation, stored := reserveCall(ctx, logger, db, clock, window, route, key, identity.CommonName, body)
+		switch reservation {
+		case keyTooLong:
+			return nil, status.Error(codes.InvalidArgument, "the idempotency key is too long")
+		case keyInUse:
+			setTrailer(ctx, logger, metadata.Pairs(bbs.RetryAfterMetadataKey, "1"))
+			return nil, status.Error(codes.Aborted, "the idempotency key is in use")
+		case keyNotReserved:
+			return nil, status.Error(codes.Internal, "failed to reserve the idempotency key")
+		case keyMismatch:
+			return nil, status.Error(codes.FailedPrecondition, "the idempotency key was used for a different call")
+		case keyReplayed:
+			response := newResponse(info)
+			err = proto.Unmarshal(stored, response)
+			if err != nil {
+				logger.Error("failed-to-unmarshal-response", err)
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			return response, nil
+		}
+
+		response, err := handler(ctx, request)
+		message, ok := response.(proto.Message)
+		if err != nil || !ok {
+			finishCall(ctx, logger, db, key, nil, false)
+			return response, err
+		}
+
+		body, err = proto.Marshal(message)
+		if err != nil {
+			logger.Error("failed-to-marshal-response", err)
+			finishCall(ctx, logger, db, key, nil, false)
+			return nil, status.Error(codes.Internal, "failed to marshal the response")
+		}
+
+		keep := true
+		if response, ok := response.(errorResponse); ok {
+			keep = keepsResponse(response.GetError())
+		}
+		finishCall(ctx, logger, db, key, body, keep)
+		return response, nil
+	}
+}
+
+type keyReservation int
+
+const (
+	keyReserved keyReservation = iota
+	keyTooLong
+	keyInUse
+	keyNotReserved
+	keyMismatch
+	keyReplayed
+)
+
+// reserveCall reserves the key for the call of the route by the client with
+// the body. When the key was already used for the same call, it returns the
+// response the call was answered with.
+func reserveCall(ctx context.Context, logger lager.Logger, db db.IdempotencyKeyDB, clock clock.Clock, window time.Duration, route, key, commonName string, body []byte) (keyReservation, []byte) {
+	if len(key) > maxIdempotencyKeyLength {
+		logger.Info("key-too-long")
+		return keyTooLong, nil
+	}
+
+	requestHash := hashRequest(route, commonName, body)
+	existing, err := db.ReserveIdempotencyKey(ctx, logger, key, requestHash, clock.Now().Add(-window))
+	switch {
+	case err == models.ErrResourceExists, err == nil && existing != nil && existing.RequestHash == requestHash && existing.Response == nil:
+		logger.Debug("key-in-use")
+		return keyInUse, nil
+	case err != nil:
+		logger.Error("failed-to-reserve-key", err)
+		return keyNotReserved, nil
+	case existing != nil && existing.RequestHash != requestHash:
+		logger.Info("key-mismatch")
+		return keyMismatch, nil
+	case existing != nil:
+		logger.Debug("replaying-response")
+		return keyReplayed, existing.Response
+	}
+	return keyReserved, nil
+}
+
+// finishCall records the response of the served call for its key, or
+// releases the key when the response is not kept, so that the call can be
+// retried. The outcome is recorded even if the client of the call is gone.
+func finishCall(ctx context.Context, logger lager.Logger, db db.IdempotencyKeyDB, key string, response []byte, keep bool) {
+	ctx = context.WithoutCancel(ctx)
+	if keep {
+		err := db.CompleteIdempotencyKey(ctx, logger, key, response)
+		if err != nil {
+			logger.Error("failed-to-complete-key", err)
+		}
+		return
+	}
+
+	err := db.ReleaseIdempotencyKey(ctx, logger, key)
+	if err != nil {
+		logger.Error("failed-to-release-key", err)
+	}
+}
+
+// hashRequest identifies a call by its route, client and body, so that a key
+// reused for a different call is told apart.
+func hashRequest(route, commonName string, body []byte) string {
+	hash := sha256.New()
+	hash.Write([]byte(route))
+	hash.Write([]byte{0})
+	hash.Write([]byte(commonName))
+	hash.Write([]byte{0})
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// keepsResponse reports whether the response of a served call carrying the
+// error is kept for its key. Calls failing on a deadlock or an unrecoverable
+// error were rolled back, so their key is released for them to be retried.
+func keepsResponse(err *models.Error) bool {
+	switch err.GetType() {
+	case models.Error_Deadlock, models.Error_Unrecoverable:
+		return false
+	}
+	return true
+}
+
+// errorOfResponse returns the error carried by the encoded response of a
+// call, nil if it carries none.
+func errorOfResponse(body []byte) *models.Error {
+	response := &responseError{}
+	err := proto.Unmarshal(body, response)
+	if err != nil {
+		return nil
+	}
+	return response.Error
+}
+
+// responseError decodes the error of a response of the BBS API, which every
+// response carrying one has as its first field.
+type responseError struct {
+	Error *models.Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *responseError) Reset()         { *m = responseError{} }
+func (m *responseError) String() string { return proto.CompactTextString(m) }
+func (*responseError) ProtoMessage()    {}
+
+// bufferedResponseWriter collects the response of an HTTP handler.
+type bufferedResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (w *bufferedResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *bufferedResponseWriter) Write(data []byte) (int, error) {
+	return w.body.Write(data)
+}
+
+func (w *bufferedResponseWriter) WriteHeader(status int) {
+	w.status = status
+}
@@ -0,0 +1,239 @@
+package handlers_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	"github.com/gogo/protobuf/proto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IdempotencyWrap", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeDB           *dbfakes.FakeIdempotencyKeyDB
+		fakeClock        *fakeclock.FakeClock
+		served           int
+		servedBody       []byte
+		handlerStatus    int
+		handlerBody      []byte
+		request          *http.Request
+		responseRecorder *httptest.ResponseRecorder
+	)
+
+	newRequest := func(body string) *http.Request {
+		request := httptest.NewRequest("POST", "/v1/tasks/desire.r2", strings.NewReader(body))
+		request.Header.Set(bbs.IdempotencyKeyHeader, "some-key")
+		return request
+	}
+
+	serve := func(request *http.Request) *httptest.ResponseRecorder {
+		handler := handlers.IdempotencyWrap(logger, fakeDB, fakeClock, time.Hour, bbs.DesireTaskRoute_r2, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served++
+			servedBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(handlerStatus)
+			w.Write(handlerBody)
+		}))
+		responseRecorder := httptest.NewRecorder()
+		handler.ServeHTTP(responseRecorder, request)
+		return responseRecorder
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeDB = new(dbfakes.FakeIdempotencyKeyDB)
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		served = 0
+		servedBody = nil
+		handlerStatus = http.StatusOK
+		handlerBody = []byte("some-response")
+		request = newRequest("some-body")
+	})
+
+	JustBeforeEach(func() {
+		responseRecorder = serve(request)
+	})
+
+	Context("when the request has no idempotency key", func() {
+		BeforeEach(func() {
+			request.Header.Del(bbs.IdempotencyKeyHeader)
+		})
+
+		It("serves the request without recording it", func() {
+			Expect(served).To(Equal(1))
+			Expect(fakeDB.ReserveIdempotencyKeyCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the key has not been used", func() {
+		It("reserves the key for the window, serves the request and records its response", func() {
+			Expect(fakeDB.ReserveIdempotencyKeyCallCount()).To(Equal(1))
+			_, _, key, _, expiredBefore := fakeDB.ReserveIdempotencyKeyArgsForCall(0)
+			Expect(key).To(Equal("some-key"))
+			Expect(expiredBefore).To(Equal(fakeClock.Now().Add(-time.Hour)))
+
+			Expect(served).To(Equal(1))
+			Expect(servedBody).To(Equal([]byte("some-body")))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Body.String()).To(Equal("some-response"))
+
+			Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(1))
+			_, _, key, response := fakeDB.CompleteIdempotencyKeyArgsForCall(0)
+			Expect(key).To(Equal("some-key"))
+			Expect(response).To(Equal([]byte("some-response")))
+		})
+
+		Context("when the request is not served", func() {
+			BeforeEach(func() {
+				handlerStatus = http.StatusServiceUnavailable
+			})
+
+			It("releases the key", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(0))
+				Expect(fakeDB.ReleaseIdempotencyKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the response carries a deadlock", func() {
+			BeforeEach(func() {
+				handlerBody = marshalResponse(&models.TaskLifecycleResponse{Error: models.ErrDeadlock})
+			})
+
+			It("releases the key and responds", func() {
+				Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+				Expect(responseRecorder.Body.Bytes()).To(Equal(handlerBody))
+				Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(0))
+				Expect(fakeDB.ReleaseIdempotencyKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the response carries an unrecoverable error", func() {
+			BeforeEach(func() {
+				handlerBody = marshalResponse(&models.TaskLifecycleResponse{Error: models.NewUnrecoverableError(nil)})
+			})
+
+			It("releases the key", func() {
+				Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(0))
+				Expect(fakeDB.ReleaseIdempotencyKeyCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the response carries another error", func() {
+			BeforeEach(func() {
+				handlerBody = marshalResponse(&models.TaskLifecycleResponse{Error: models.ErrResourceExists})
+			})
+
+			It("records the response", func() {
+				Expect(fakeDB.CompleteIdempotencyKeyCallCount()).To(Equal(1))
+				_, _, _, response := fakeDB.CompleteIdempotencyKeyArgsForCall(0)
+				Expect(response).To(Equal(handlerBody))
+				Expect(fakeDB.ReleaseIdempotencyKeyCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when the key was used for the same call", func() {
+		BeforeEach(func() {
+			serve(newRequest("some-body"))
+			_, _, _, requestHash, _ := fakeDB.ReserveIdempotencyKeyArgsForCall(0)
+			fakeDB.ReserveIdempotencyKeyReturns(&models.IdempotencyKey{
+				Key:         "some-key",
+				RequestHash: requestHash,
+				Response:    []byte("first-response"),
+			}, nil)
+			served = 0
+		})
+
+		It("replays the response of the first call", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			Expect(responseRecorder.Header().Get("Content-Type")).To(Equal(bbs.ProtoContentType))
+			Expect(responseRecorder.Body.String()).To(Equal("first-response"))
+		})
+	})
+
+	Context("when the first call with the key is being served", func() {
+		BeforeEach(func() {
+			serve(newRequest("some-body"))
+			_, _, _, requestHash, _ := fakeDB.ReserveIdempotencyKeyArgsForCall(0)
+			fakeDB.ReserveIdempotencyKeyReturns(&models.IdempotencyKey{Key: "some-key", RequestHash: requestHash}, nil)
+			served = 0
+		})
+
+		It("responds with a conflict and asks the client to retry", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+			Expect(responseRecorder.Header().Get(bbs.RetryAfterHeader)).To(Equal("1"))
+		})
+	})
+
+	Context("when another request reserves the key at the same time", func() {
+		BeforeEach(func() {
+			fakeDB.ReserveIdempotencyKeyReturns(nil, models.ErrResourceExists)
+		})
+
+		It("responds with a conflict and asks the client to retry", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusConflict))
+			Expect(responseRecorder.Header().Get(bbs.RetryAfterHeader)).To(Equal("1"))
+		})
+	})
+
+	Context("when the key was used for a different call", func() {
+		BeforeEach(func() {
+			serve(newRequest("other-body"))
+			_, _, _, requestHash, _ := fakeDB.ReserveIdempotencyKeyArgsForCall(0)
+			fakeDB.ReserveIdempotencyKeyReturns(&models.IdempotencyKey{
+				Key:         "some-key",
+				RequestHash: requestHash,
+				Response:    []byte("first-response"),
+			}, nil)
+			served = 0
+		})
+
+		It("responds with unprocessable entity", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusUnprocessableEntity))
+		})
+	})
+
+	Context("when the key is too long", func() {
+		BeforeEach(func() {
+			request.Header.Set(bbs.IdempotencyKeyHeader, string(bytes.Repeat([]byte("k"), 256)))
+		})
+
+		It("responds with a bad request", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("when reserving the key fails", func() {
+		BeforeEach(func() {
+			fakeDB.ReserveIdempotencyKeyReturns(nil, models.ErrUnknownError)
+		})
+
+		It("responds with an internal server error", func() {
+			Expect(served).To(Equal(0))
+			Expect(responseRecorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
+
+func marshalResponse(response proto.Message) []byte {
+	data, err := proto.Marshal(response)
+	Expect(err).NotTo(HaveOccurred())
+	return data
+}
@@ -15,6 +15,18 @@ type FakeEmitter struct {
 		arg1 int
 		arg2 string
 	}
+	IncrementThrottledRequestCounterStub        func(int, string)
+	incrementThrottledRequestCounterMutex       sync.RWMutex
+	incrementThrottledRequestCounterArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	ObserveRequestLatencyStub        func(time.Duration, string)
+	observeRequestLatencyMutex       sync.RWMutex
+	observeRequestLatencyArgsForCall []struct {
+		arg1 time.Duration
+		arg2 string
+	}
 	UpdateLatencyStub        func(time.Duration, string)
 	updateLatencyMutex       sync.RWMutex
 	updateLatencyArgsForCall []struct {
@@ -58,6 +70,72 @@ func (fake *FakeEmitter) IncrementRequestCounterArgsForCall(i int) (int, string)
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeEmitter) IncrementThrottledRequestCounter(arg1 int, arg2 string) {
+	fake.incrementThrottledRequestCounterMutex.Lock()
+	fake.incrementThrottledRequestCounterArgsForCall = append(fake.incrementThrottledRequestCounterArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.IncrementThrottledRequestCounterStub
+	fake.recordInvocation("IncrementThrottledRequestCounter", []interface{}{arg1, arg2})
+	fake.incrementThrottledRequestCounterMutex.Unlock()
+	if stub != nil {
+		fake.IncrementThrottledRequestCounterStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeEmitter) IncrementThrottledRequestCounterCallCount() int {
+	fake.incrementThrottledRequestCounterMutex.RLock()
+	defer fake.incrementThrottledRequestCounterMutex.RUnlock()
+	return len(fake.incrementThrottledRequestCounterArgsForCall)
+}
+
+func (fake *FakeEmitter) IncrementThrottledRequestCounterCalls(stub func(int, string)) {
+	fake.incrementThrottledRequestCounterMutex.Lock()
+	defer fake.incrementThrottledRequestCounterMutex.Unlock()
+	fake.IncrementThrottledRequestCounterStub = stub
+}
+
+func (fake *FakeEmitter) IncrementThrottledRequestCounterArgsForCall(i int) (int, string) {
+	fake.incrementThrottledRequestCounterMutex.RLock()
+	defer fake.incrementThrottledRequestCounterMutex.RUnlock()
+	argsForCall := fake.incrementThrottledRequestCounterArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeEmitter) ObserveRequestLatency(arg1 time.Duration, arg2 string) {
+	fake.observeRequestLatencyMutex.Lock()
+	fake.observeRequestLatencyArgsForCall = append(fake.observeRequestLatencyArgsForCall, struct {
+		arg1 time.Duration
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ObserveRequestLatencyStub
+	fake.recordInvocation("ObserveRequestLatency", []interface{}{arg1, arg2})
+	fake.observeRequestLatencyMutex.Unlock()
+	if stub != nil {
+		fake.ObserveRequestLatencyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeEmitter) ObserveRequestLatencyCallCount() int {
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
+	return len(fake.observeRequestLatencyArgsForCall)
+}
+
+func (fake *FakeEmitter) ObserveRequestLatencyCalls(stub func(time.Duration, string)) {
+	fake.observeRequestLatencyMutex.Lock()
+	defer fake.observeRequestLatencyMutex.Unlock()
+	fake.ObserveRequestLatencyStub = stub
+}
+
+func (fake *FakeEmitter) ObserveRequestLatencyArgsForCall(i int) (time.Duration, string) {
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
+	argsForCall := fake.observeRequestLatencyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeEmitter) UpdateLatency(arg1 time.Duration, arg2 string) {
 	fake.updateLatencyMutex.Lock()
 	fake.updateLatencyArgsForCall = append(fake.updateLatencyArgsForCall, struct {
@@ -96,6 +174,10 @@ func (fake *FakeEmitter) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.incrementRequestCounterMutex.RLock()
 	defer fake.incrementRequestCounterMutex.RUnlock()
+	fake.incrementThrottledRequestCounterMutex.RLock()
+	defer fake.incrementThrottledRequestCounterMutex.RUnlock()
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
 	fake.updateLatencyMutex.RLock()
 	defer fake.updateLatencyMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -16,7 +16,9 @@ type LoggableHandlerFunc func(logger lager.Logger, w http.ResponseWriter, r *htt
 //counterfeiter:generate -o fakes/fake_emitter.go . Emitter
 type Emitter interface {
 	IncrementRequestCounter(delta int, route string)
+	IncrementThrottledRequestCounter(delta int, route string)
 	UpdateLatency(latency time.Duration, route string)
+	ObserveRequestLatency(latency time.Duration, route string)
 }
 
 func LogWrap(logger, accessLogger lager.Logger, loggableHandlerFunc LoggableHandlerFunc) http.HandlerFunc {
@@ -43,33 +45,38 @@ func LogWrap(logger, accessLogger lager.Logger, loggableHandlerFunc LoggableHand
 		return lagerData
 	}
 
-	if accessLogger != nil {
-		return func(w http.ResponseWriter, r *http.Request) {
-			requestLog := logger.Session("request")
-			requestAccessLogger := accessLogger.Session("request")
-
-			requestAccessLogger.Info("serving", lagerDataFromReq(r))
-			requestLog.Debug("serving", lagerDataFromReq(r))
-
-			start := time.Now()
-			defer requestLog.Debug("done", lagerDataFromReq(r))
-			defer func() {
-				requestTime := time.Since(start)
-				lagerData := lagerDataFromReq(r)
-				lagerData["duration"] = requestTime
-				requestAccessLogger.Info("done", lagerData)
-			}()
-			loggableHandlerFunc(requestLog, w, r)
-		}
-	} else {
-		return func(w http.ResponseWriter, r *http.Request) {
-			requestLog := logger.Session("request")
+	return func(w http.ResponseWriter, r *http.Request) {
+		requestLog, done := StartCall(logger, accessLogger, lagerDataFromReq(r))
+		defer done()
+
+		loggableHandlerFunc(requestLog, w, r)
+	}
+}
+
+// StartCall logs the start of a call to both loggers, and returns the logger
+// of the call and a function logging its end, along with its duration to the
+// access logger. It logs the requests LogWrap serves, and the calls served
+// without an HTTP handler, such as the calls to the gRPC API.
+func StartCall(logger, accessLogger lager.Logger, data lager.Data) (lager.Logger, func()) {
+	requestLog := logger.Session("request")
 
-			requestLog.Debug("serving", lagerDataFromReq(r))
-			defer requestLog.Debug("done", lagerDataFromReq(r))
+	if accessLogger == nil {
+		requestLog.Debug("serving", data)
+		return requestLog, func() { requestLog.Debug("done", data) }
+	}
+
+	requestAccessLogger := accessLogger.Session("request")
+	requestAccessLogger.Info("serving", data)
+	requestLog.Debug("serving", data)
 
-			loggableHandlerFunc(requestLog, w, r)
+	start := time.Now()
+	return requestLog, func() {
+		doneData := lager.Data{"duration": time.Since(start)}
+		for k, v := range data {
+			doneData[k] = v
 		}
+		requestAccessLogger.Info("done", doneData)
+		requestLog.Debug("done", data)
 	}
 }
 
@@ -131,35 +138,45 @@ func RecordRequestCount(f http.Handler, emitter Emitter) http.HandlerFunc {
 }
 
 func RecordMetrics(f http.HandlerFunc, emitter Emitter, advancedMetricsConfig config.AdvancedMetrics, calledRoute string) http.HandlerFunc {
-	// Record Default Metrics
-	handlerMeta := initHandlerWithMetadata(f)
-	handlerMeta = recordLatency(handlerMeta)
+	if advancedMetricsConfig.Enabled && calledRoute == "" {
+		panic("calledRoute is required for advanced metrics")
+	}
 
-	handlerMeta = updateLatency(handlerMeta, emitter, "")
-	handlerMeta = incrementRequestCount(handlerMeta, emitter, "")
+	return func(w http.ResponseWriter, r *http.Request) {
+		startTime := time.Now()
+		f(w, r)
+		RecordCall(emitter, advancedMetricsConfig, calledRoute, time.Since(startTime))
+	}
+}
 
-	// Record Advanced Metrics
-	if advancedMetricsConfig.Enabled {
-		if calledRoute == "" {
-			panic("calledRoute is required for advanced metrics")
-		}
+// RecordThrottle counts a throttled request, and counts it for the route too
+// if advanced metrics count the requests of the route.
+func RecordThrottle(emitter Emitter, advancedMetricsConfig config.AdvancedMetrics, calledRoute string) {
+	emitter.IncrementThrottledRequestCounter(1, "")
 
-		handlerMeta = recordAdvancedMetrics(handlerMeta, emitter, advancedMetricsConfig, calledRoute)
+	if advancedMetricsConfig.Enabled && slices.Contains(advancedMetricsConfig.RouteConfig.RequestCountRoutes, calledRoute) {
+		emitter.IncrementThrottledRequestCounter(1, calledRoute)
 	}
-
-	return stripMetadata(handlerMeta)
 }
 
-func recordAdvancedMetrics(handlerMeta handlerWithMetadata, emitter Emitter, advancedMetricsConfig config.AdvancedMetrics, calledRoute string) handlerWithMetadata {
-	isRouteFound := slices.Contains(advancedMetricsConfig.RouteConfig.RequestCountRoutes, calledRoute)
-	if isRouteFound {
-		handlerMeta = incrementRequestCount(handlerMeta, emitter, calledRoute)
+// RecordCall records the latency and count of a call of the route, and those
+// of the route too when advanced metrics record them. It records the requests
+// RecordMetrics serves, and the calls served without an HTTP handler, such as
+// the calls to the gRPC API.
+func RecordCall(emitter Emitter, advancedMetricsConfig config.AdvancedMetrics, calledRoute string, latency time.Duration) {
+	emitter.UpdateLatency(latency, "")
+	emitter.IncrementRequestCounter(1, "")
+
+	if !advancedMetricsConfig.Enabled {
+		return
 	}
 
-	isRouteFound = slices.Contains(advancedMetricsConfig.RouteConfig.RequestLatencyRoutes, calledRoute)
-	if isRouteFound {
-		handlerMeta = updateLatency(handlerMeta, emitter, calledRoute)
+	if slices.Contains(advancedMetricsConfig.RouteConfig.RequestCountRoutes, calledRoute) {
+		emitter.IncrementRequestCounter(1, calledRoute)
 	}
 
-	return handlerMeta
+	if slices.Contains(advancedMetricsConfig.RouteConfig.RequestLatencyRoutes, calledRoute) {
+		emitter.UpdateLatency(latency, calledRoute)
+		emitter.ObserveRequestLatency(latency, calledRoute)
+	}
 }
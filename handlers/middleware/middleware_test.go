@@ -106,6 +106,11 @@ var _ = Describe("Test Middleware", func() {
 					It("should not emit advanced metrics per route", func() {
 						validateRecordDefaultMetricsWithOneRequest(handler, emitter)
 					})
+
+					It("should not observe the latency of a route", func() {
+						handler.ServeHTTP(nil, nil)
+						Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(0))
+					})
 				})
 
 				When("route is passed", func() {
@@ -116,6 +121,11 @@ var _ = Describe("Test Middleware", func() {
 					It("should not call the emitter with any passed route", func() {
 						validateRecordDefaultMetricsWithOneRequest(handler, emitter)
 					})
+
+					It("should not observe the latency of the route", func() {
+						handler.ServeHTTP(nil, nil)
+						Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(0))
+					})
 				})
 			})
 
@@ -146,6 +156,11 @@ var _ = Describe("Test Middleware", func() {
 						It("should emit only Default Metrics", func() {
 							validateRecordDefaultMetricsWithOneRequest(handler, emitter)
 						})
+
+						It("should not observe the latency of the route", func() {
+							handler.ServeHTTP(nil, nil)
+							Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(0))
+						})
 					})
 					When("and NOT IN RequestCountRoutes and IN RequestLatencyRoutes", func() {
 						BeforeEach(func() {
@@ -171,6 +186,14 @@ var _ = Describe("Test Middleware", func() {
 							Expect(actualLatencyDurationSecondCall).To(Equal(actualLatencyDuration))
 							Expect(actualLatencyRouteSecondCall).To(Equal(route))
 						})
+
+						It("should observe the latency of the route", func() {
+							handler.ServeHTTP(nil, nil)
+							Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(1))
+							actualDuration, actualRoute := emitter.ObserveRequestLatencyArgsForCall(0)
+							Expect(actualDuration).To(BeNumerically(">=", waitTime))
+							Expect(actualRoute).To(Equal(route))
+						})
 					})
 					When("and IN RequestCountRoutes and NOT IN RequestLatencyRoutes", func() {
 						BeforeEach(func() {
@@ -232,6 +255,82 @@ var _ = Describe("Test Middleware", func() {
 		})
 	})
 
+	Context("RecordThrottle", func() {
+		var emitter *fakes.FakeEmitter
+
+		BeforeEach(func() {
+			emitter = &fakes.FakeEmitter{}
+		})
+
+		It("counts the throttled request", func() {
+			middleware.RecordThrottle(emitter, config.AdvancedMetrics{}, "TEST_ROUTE")
+
+			Expect(emitter.IncrementThrottledRequestCounterCallCount()).To(Equal(1))
+			delta, route := emitter.IncrementThrottledRequestCounterArgsForCall(0)
+			Expect(delta).To(Equal(1))
+			Expect(route).To(Equal(""))
+		})
+
+		It("counts it for the route too when advanced metrics count its requests", func() {
+			advancedMetricsConfig := config.AdvancedMetrics{
+				Enabled:     true,
+				RouteConfig: config.RouteConfiguration{RequestCountRoutes: []string{"TEST_ROUTE"}},
+			}
+			middleware.RecordThrottle(emitter, advancedMetricsConfig, "TEST_ROUTE")
+
+			Expect(emitter.IncrementThrottledRequestCounterCallCount()).To(Equal(2))
+			_, route := emitter.IncrementThrottledRequestCounterArgsForCall(1)
+			Expect(route).To(Equal("TEST_ROUTE"))
+		})
+	})
+
+	Context("RecordCall", func() {
+		var emitter *fakes.FakeEmitter
+
+		BeforeEach(func() {
+			emitter = &fakes.FakeEmitter{}
+		})
+
+		It("records the latency and count of the call", func() {
+			middleware.RecordCall(emitter, config.AdvancedMetrics{}, "TEST_ROUTE", time.Second)
+
+			Expect(emitter.UpdateLatencyCallCount()).To(Equal(1))
+			latency, route := emitter.UpdateLatencyArgsForCall(0)
+			Expect(latency).To(Equal(time.Second))
+			Expect(route).To(Equal(""))
+
+			Expect(emitter.IncrementRequestCounterCallCount()).To(Equal(1))
+			_, route = emitter.IncrementRequestCounterArgsForCall(0)
+			Expect(route).To(Equal(""))
+
+			Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(0))
+		})
+
+		It("records them for the route too when advanced metrics track it", func() {
+			advancedMetricsConfig := config.AdvancedMetrics{
+				Enabled: true,
+				RouteConfig: config.RouteConfiguration{
+					RequestCountRoutes:   []string{"TEST_ROUTE"},
+					RequestLatencyRoutes: []string{"TEST_ROUTE"},
+				},
+			}
+			middleware.RecordCall(emitter, advancedMetricsConfig, "TEST_ROUTE", time.Second)
+
+			Expect(emitter.IncrementRequestCounterCallCount()).To(Equal(2))
+			_, route := emitter.IncrementRequestCounterArgsForCall(1)
+			Expect(route).To(Equal("TEST_ROUTE"))
+
+			Expect(emitter.UpdateLatencyCallCount()).To(Equal(2))
+			_, route = emitter.UpdateLatencyArgsForCall(1)
+			Expect(route).To(Equal("TEST_ROUTE"))
+
+			Expect(emitter.ObserveRequestLatencyCallCount()).To(Equal(1))
+			latency, route := emitter.ObserveRequestLatencyArgsForCall(0)
+			Expect(latency).To(Equal(time.Second))
+			Expect(route).To(Equal("TEST_ROUTE"))
+		})
+	})
+
 	Context("LogWrap", func() {
 		var (
 			logger              *lagertest.TestLogger
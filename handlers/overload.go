@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/lager/v3"
+	"google.golang.org/grpc"
+)
+
+// OverloadWrap serves the requests of the route unless the controller sheds
+// them, and responds with '503 Service Unavailable' and a Retry-After header
+// to those it does.
+func OverloadWrap(logger lager.Logger, controller *overload.Controller, route string, handler http.Handler) http.HandlerFunc {
+	logger = logger.Session("overload")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		retryAfter, shed := shedCall(logger, controller, route, r.RemoteAddr)
+		if !shed {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(bbs.RetryAfterHeader, strconv.Itoa(retryAfterSeconds(retryAfter)))
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+}
+
+// OverloadInterceptor serves the calls to the gRPC API unless the controller
+// sheds them, as OverloadWrap does the requests to the HTTP API, and fails
+// those it does with Unavailable and a retry-after trailer.
+func OverloadInterceptor(logger lager.Logger, controller *overload.Controller) grpc.UnaryServerInterceptor {
+	logger = logger.Session("overload")
+
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		_, remoteAddr := peerIdentity(ctx)
+		retryAfter, shed := shedCall(logger, controller, route, remoteAddr)
+		if shed {
+			setTrailer(ctx, logger, retryAfterTrailer(retryAfter))
+			return nil, errOverloaded
+		}
+		return handler(ctx, request)
+	}
+}
+
+// shedCall reports whether the controller sheds the call of the route, and
+// when to retry it if it does.
+func shedCall(logger lager.Logger, controller *overload.Controller, route, remoteAddr string) (time.Duration, bool) {
+	retryAfter, shed := controller.Shed(route)
+	if shed {
+		logger.Debug("shed", lager.Data{
+			"route":       route,
+			"remote_addr": remoteAddr,
+			"level":       controller.Level().String(),
+		})
+	}
+	return retryAfter, shed
+}
+
+type OverloadHandler struct {
+	controller *overload.Controller
+}
+
+func NewOverloadHandler(controller *overload.Controller) *OverloadHandler {
+	return &OverloadHandler{
+		controller: controller,
+	}
+}
+
+func (h *OverloadHandler) OverloadStatus(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	response := &models.OverloadStatusResponse{}
+	err := h.overloadStatus(req.Context(), logger, &models.OverloadStatusRequest{}, response)
+	response.Error = models.ConvertError(err)
+	writeResponse(w, response)
+}
+
+func (h *OverloadHandler) overloadStatus(ctx context.Context, logger lager.Logger, request *models.OverloadStatusRequest, response *models.OverloadStatusResponse) error {
+	if h.controller != nil {
+		response.Status = h.controller.Status()
+	} else {
+		response.Status = &models.OverloadStatus{LevelName: overload.LevelNone.String()}
+	}
+	return nil
+}
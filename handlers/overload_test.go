@@ -0,0 +1,115 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor/monitorfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/metrics/metricsfakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+// newOverloadController returns a running overload controller raised to the
+// given level by queries that each take a second.
+func newOverloadController(level overload.Level) (*overload.Controller, ifrit.Process) {
+	fakeClock := fakeclock.NewFakeClock(time.Now())
+	fakeMetronClient := new(mfakes.FakeIngressClient)
+
+	fakeMonitor := new(monitorfakes.FakeMonitor)
+	fakeMonitor.TotalStub = func() int64 {
+		return int64(fakeMonitor.TotalCallCount())
+	}
+	fakeMonitor.TotalDurationStub = func() time.Duration {
+		return time.Duration(fakeMonitor.TotalDurationCallCount()) * time.Second
+	}
+
+	controller, err := overload.NewController(lagertest.NewTestLogger("test"), fakeClock, overload.Config{Enabled: true}, new(metricsfakes.FakeDBStats), fakeMonitor, fakeMetronClient)
+	Expect(err).NotTo(HaveOccurred())
+
+	process := ifrit.Background(controller)
+	Eventually(process.Ready()).Should(BeClosed())
+
+	for controller.Level() < level {
+		adjustments := fakeMetronClient.SendMetricCallCount()
+		fakeClock.WaitForWatcherAndIncrement(overload.DefaultPollInterval)
+		Eventually(fakeMetronClient.SendMetricCallCount).Should(Equal(adjustments + 1))
+	}
+
+	return controller, process
+}
+
+var _ = Describe("Overload", func() {
+	var (
+		logger           *lagertest.TestLogger
+		controller       *overload.Controller
+		process          ifrit.Process
+		served           bool
+		responseRecorder *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		served = false
+		responseRecorder = httptest.NewRecorder()
+		controller, process = newOverloadController(overload.LevelListings)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	Describe("OverloadWrap", func() {
+		serve := func(route string) {
+			handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				served = true
+			})
+			handlers.OverloadWrap(logger, controller, route, handler).ServeHTTP(responseRecorder, newTestRequest(""))
+		}
+
+		It("serves the calls the controller does not shed", func() {
+			serve(bbs.DesireTaskRoute_r2)
+			Expect(served).To(BeTrue())
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		})
+
+		It("responds to the shed calls with a retry hint", func() {
+			serve(bbs.DesiredLRPsRoute_r3)
+			Expect(served).To(BeFalse())
+			Expect(responseRecorder.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(responseRecorder.Header().Get(bbs.RetryAfterHeader)).To(Equal("5"))
+		})
+	})
+
+	Describe("OverloadStatus", func() {
+		It("responds with the status of the controller", func() {
+			handlers.NewOverloadHandler(controller).OverloadStatus(logger, responseRecorder, newTestRequest(""))
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+
+			response := &models.OverloadStatusResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.Status.LevelName).To(Equal("listings"))
+			Expect(response.Status.QueryLatency).To(Equal(int64(time.Second)))
+		})
+
+		It("responds with no shedding when load shedding is disabled", func() {
+			handlers.NewOverloadHandler(nil).OverloadStatus(logger, responseRecorder, newTestRequest(""))
+
+			response := &models.OverloadStatusResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Status.Level).To(BeZero())
+			Expect(response.Status.LevelName).To(Equal("none"))
+		})
+	})
+})
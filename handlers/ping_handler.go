@@ -1,6 +1,7 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 
 	"code.cloudfoundry.org/bbs/models"
@@ -16,6 +17,10 @@ func NewPingHandler() *PingHandler {
 
 func (h *PingHandler) Ping(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	response := &models.PingResponse{}
-	response.Available = true
+	h.ping(req.Context(), logger, &models.PingRequest{}, response)
 	writeResponse(w, response)
 }
+
+func (h *PingHandler) ping(ctx context.Context, logger lager.Logger, request *models.PingRequest, response *models.PingResponse) {
+	response.Available = true
+}
@@ -0,0 +1,83 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/handlers/middleware"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/lager/v3"
+	"google.golang.org/grpc"
+)
+
+// RateLimitWrap serves the requests the limiter admits, based on the identity
+// of their client certificate and the class of the route, and responds with
+// '429 Too Many Requests' and a Retry-After header to all others.
+func RateLimitWrap(logger lager.Logger, limiter *ratelimit.Limiter, emitter middleware.Emitter, advancedMetricsConfig config.AdvancedMetrics, route string, handler http.Handler) http.HandlerFunc {
+	logger = logger.Session("rate-limit")
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		identity := authorization.IdentityFromTLS(r.TLS)
+		release, retryAfter, ok := acquireCall(r.Context(), logger, limiter, emitter, advancedMetricsConfig, route, identity, r.RemoteAddr)
+		if ok {
+			defer release()
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set(bbs.RetryAfterHeader, strconv.Itoa(retryAfterSeconds(retryAfter)))
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+}
+
+// RateLimitInterceptor serves the calls to the gRPC API the limiter admits,
+// as RateLimitWrap does the requests to the HTTP API, and fails all others
+// with ResourceExhausted and a retry-after trailer.
+func RateLimitInterceptor(logger lager.Logger, limiter *ratelimit.Limiter, emitter middleware.Emitter, advancedMetricsConfig config.AdvancedMetrics) grpc.UnaryServerInterceptor {
+	logger = logger.Session("rate-limit")
+
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		identity, remoteAddr := peerIdentity(ctx)
+		release, retryAfter, ok := acquireCall(ctx, logger, limiter, emitter, advancedMetricsConfig, route, identity, remoteAddr)
+		if !ok {
+			setTrailer(ctx, logger, retryAfterTrailer(retryAfter))
+			return nil, errThrottled
+		}
+		defer release()
+
+		return handler(ctx, request)
+	}
+}
+
+// acquireCall admits the call of the route by the identity to the limiter,
+// and returns the function releasing it. Calls the limiter does not admit
+// are counted as throttled, and are to be retried after the returned hint.
+func acquireCall(ctx context.Context, logger lager.Logger, limiter *ratelimit.Limiter, emitter middleware.Emitter, advancedMetricsConfig config.AdvancedMetrics, route string, identity authorization.Identity, remoteAddr string) (func(), time.Duration, bool) {
+	release, retryAfter, ok := limiter.Acquire(ctx, identity, route)
+	if ok {
+		return release, 0, true
+	}
+
+	logger.Debug("throttled", lager.Data{
+		"route":                                 route,
+		"remote_addr":                           remoteAddr,
+		"peer_cert_subject_common_name":         identity.CommonName,
+		"peer_cert_subject_organizational_unit": identity.OrganizationalUnits,
+		"retry_after":                           retryAfter,
+	})
+	middleware.RecordThrottle(emitter, advancedMetricsConfig, route)
+	return nil, retryAfter, false
+}
+
+// retryAfterSeconds rounds the hint up to the whole seconds of the
+// Retry-After header.
+func retryAfterSeconds(retryAfter time.Duration) int {
+	return int(math.Ceil(retryAfter.Seconds()))
+}
@@ -0,0 +1,92 @@
+package handlers_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/cmd/bbs/config"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/middleware/fakes"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RateLimitWrap", func() {
+	var (
+		logger      *lagertest.TestLogger
+		fakeEmitter *fakes.FakeEmitter
+		limiter     *ratelimit.Limiter
+		served      int
+		handler     http.Handler
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeEmitter = new(fakes.FakeEmitter)
+		served = 0
+
+		var err error
+		limiter, err = ratelimit.NewLimiter(fakeclock.NewFakeClock(time.Now()), ratelimit.Config{
+			Enabled: true,
+			Rules: []ratelimit.Rule{{
+				CommonName:        "route_emitter",
+				RequestsPerSecond: 0.5,
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served++
+		})
+	})
+
+	serve := func(commonName string) *httptest.ResponseRecorder {
+		req := newTestRequest(&models.DesiredLRPsRequest{})
+		req.TLS = &tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: commonName}}},
+		}
+		responseRecorder := httptest.NewRecorder()
+		advancedMetricsConfig := config.AdvancedMetrics{
+			Enabled:     true,
+			RouteConfig: config.RouteConfiguration{RequestCountRoutes: []string{bbs.DesiredLRPsRoute_r3}},
+		}
+		handlers.RateLimitWrap(logger, limiter, fakeEmitter, advancedMetricsConfig, bbs.DesiredLRPsRoute_r3, handler).ServeHTTP(responseRecorder, req)
+		return responseRecorder
+	}
+
+	It("serves the requests the limiter admits", func() {
+		Expect(serve("route_emitter").Code).To(Equal(http.StatusOK))
+		Expect(served).To(Equal(1))
+		Expect(fakeEmitter.IncrementThrottledRequestCounterCallCount()).To(Equal(0))
+	})
+
+	It("responds to throttled requests with a retry hint and counts them", func() {
+		serve("route_emitter")
+		responseRecorder := serve("route_emitter")
+
+		Expect(responseRecorder.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(responseRecorder.Header().Get(bbs.RetryAfterHeader)).To(Equal("2"))
+		Expect(served).To(Equal(1))
+
+		Expect(fakeEmitter.IncrementThrottledRequestCounterCallCount()).To(Equal(2))
+		delta, route := fakeEmitter.IncrementThrottledRequestCounterArgsForCall(0)
+		Expect(delta).To(Equal(1))
+		Expect(route).To(Equal(""))
+		_, route = fakeEmitter.IncrementThrottledRequestCounterArgsForCall(1)
+		Expect(route).To(Equal(bbs.DesiredLRPsRoute_r3))
+	})
+
+	It("does not throttle other clients", func() {
+		serve("route_emitter")
+		Expect(serve("cc").Code).To(Equal(http.StatusOK))
+	})
+})
@@ -0,0 +1,157 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//counterfeiter:generate -o fake_controllers/fake_scheduled_task_controller.go . ScheduledTaskController
+
+type ScheduledTaskController interface {
+	ScheduledTasks(ctx context.Context, logger lager.Logger, domain string) ([]*models.ScheduledTask, error)
+	DesireScheduledTask(ctx context.Context, logger lager.Logger, schedule *models.ScheduledTask) (*models.ScheduledTask, error)
+	UpdateScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, update *models.ScheduledTaskUpdate) (*models.ScheduledTask, error)
+	SuspendScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string, suspended bool) (*models.ScheduledTask, error)
+	DeleteScheduledTask(ctx context.Context, logger lager.Logger, scheduleGuid string) error
+}
+
+type ScheduledTaskHandler struct {
+	controller ScheduledTaskController
+	exitChan   chan<- struct{}
+}
+
+func NewScheduledTaskHandler(
+	controller ScheduledTaskController,
+	exitChan chan<- struct{},
+) *ScheduledTaskHandler {
+	return &ScheduledTaskHandler{
+		controller: controller,
+		exitChan:   exitChan,
+	}
+}
+
+func (h *ScheduledTaskHandler) ScheduledTasks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("scheduled-tasks").WithTraceInfo(req)
+
+	request := &models.ScheduledTasksRequest{}
+	response := &models.ScheduledTasksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.scheduledTasks(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) scheduledTasks(ctx context.Context, logger lager.Logger, request *models.ScheduledTasksRequest, response *models.ScheduledTasksResponse) error {
+	var err error
+	response.ScheduledTasks, err = h.controller.ScheduledTasks(ctx, logger, request.Domain)
+	return err
+}
+
+func (h *ScheduledTaskHandler) DesireScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("desire-scheduled-task").WithTraceInfo(req)
+
+	request := &models.DesireScheduledTaskRequest{}
+	response := &models.ScheduledTaskResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.desireScheduledTask(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) desireScheduledTask(ctx context.Context, logger lager.Logger, request *models.DesireScheduledTaskRequest, response *models.ScheduledTaskResponse) error {
+	var err error
+	response.ScheduledTask, err = h.controller.DesireScheduledTask(ctx, logger, request.ScheduledTask())
+	return err
+}
+
+func (h *ScheduledTaskHandler) UpdateScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("update-scheduled-task").WithTraceInfo(req)
+
+	request := &models.UpdateScheduledTaskRequest{}
+	response := &models.ScheduledTaskResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.updateScheduledTask(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) updateScheduledTask(ctx context.Context, logger lager.Logger, request *models.UpdateScheduledTaskRequest, response *models.ScheduledTaskResponse) error {
+	var err error
+	response.ScheduledTask, err = h.controller.UpdateScheduledTask(ctx, logger, request.ScheduleGuid, request.Update)
+	return err
+}
+
+func (h *ScheduledTaskHandler) SuspendScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("suspend-scheduled-task").WithTraceInfo(req)
+
+	request := &models.SuspendScheduledTaskRequest{}
+	response := &models.ScheduledTaskResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.suspendScheduledTask(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) suspendScheduledTask(ctx context.Context, logger lager.Logger, request *models.SuspendScheduledTaskRequest, response *models.ScheduledTaskResponse) error {
+	var err error
+	response.ScheduledTask, err = h.controller.SuspendScheduledTask(ctx, logger, request.ScheduleGuid, request.Suspended)
+	return err
+}
+
+func (h *ScheduledTaskHandler) DeleteScheduledTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("delete-scheduled-task").WithTraceInfo(req)
+
+	request := &models.DeleteScheduledTaskRequest{}
+	response := &models.ScheduledTaskLifecycleResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.deleteScheduledTask(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *ScheduledTaskHandler) deleteScheduledTask(ctx context.Context, logger lager.Logger, request *models.DeleteScheduledTaskRequest, response *models.ScheduledTaskLifecycleResponse) error {
+	return h.controller.DeleteScheduledTask(ctx, logger, request.ScheduleGuid)
+}
@@ -0,0 +1,171 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTask Handlers", func() {
+	var (
+		logger     *lagertest.TestLogger
+		controller *fake_controllers.FakeScheduledTaskController
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.ScheduledTaskHandler
+		exitCh           chan struct{}
+
+		schedule *models.ScheduledTask
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		controller = &fake_controllers.FakeScheduledTaskController{}
+		handler = handlers.NewScheduledTaskHandler(controller, exitCh)
+
+		schedule = &models.ScheduledTask{
+			ScheduleGuid:   "nightly",
+			Domain:         "some-domain",
+			CronExpression: "0 2 * * *",
+			TaskDefinition: model_helpers.NewValidTaskDefinition(),
+		}
+	})
+
+	parseResponse := func() *models.ScheduledTaskResponse {
+		Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+		response := &models.ScheduledTaskResponse{}
+		err := response.Unmarshal(responseRecorder.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		return response
+	}
+
+	Describe("ScheduledTasks", func() {
+		It("lists the schedules of the domain", func() {
+			controller.ScheduledTasksReturns([]*models.ScheduledTask{schedule}, nil)
+			handler.ScheduledTasks(logger, responseRecorder, newTestRequest(&models.ScheduledTasksRequest{Domain: "some-domain"}))
+
+			_, _, domain := controller.ScheduledTasksArgsForCall(0)
+			Expect(domain).To(Equal("some-domain"))
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.ScheduledTasksResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.ScheduledTasks).To(Equal([]*models.ScheduledTask{schedule}))
+		})
+	})
+
+	Describe("DesireScheduledTask", func() {
+		var requestBody *models.DesireScheduledTaskRequest
+
+		BeforeEach(func() {
+			requestBody = &models.DesireScheduledTaskRequest{
+				ScheduleGuid:   schedule.ScheduleGuid,
+				Domain:         schedule.Domain,
+				CronExpression: schedule.CronExpression,
+				TaskDefinition: schedule.TaskDefinition,
+			}
+			controller.DesireScheduledTaskReturns(schedule, nil)
+		})
+
+		JustBeforeEach(func() {
+			handler.DesireScheduledTask(logger, responseRecorder, newTestRequest(requestBody))
+		})
+
+		It("desires the schedule", func() {
+			Expect(controller.DesireScheduledTaskCallCount()).To(Equal(1))
+			_, _, desired := controller.DesireScheduledTaskArgsForCall(0)
+			Expect(desired).To(Equal(schedule))
+
+			response := parseResponse()
+			Expect(response.Error).To(BeNil())
+			Expect(response.ScheduledTask).To(Equal(schedule))
+		})
+
+		Context("when the cron expression is invalid", func() {
+			BeforeEach(func() {
+				requestBody.CronExpression = "every day"
+			})
+
+			It("responds with an invalid request error", func() {
+				Expect(controller.DesireScheduledTaskCallCount()).To(Equal(0))
+				Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+			})
+		})
+
+		Context("when the schedule already exists", func() {
+			BeforeEach(func() {
+				controller.DesireScheduledTaskReturns(nil, models.ErrResourceExists)
+			})
+
+			It("responds with the error", func() {
+				Expect(parseResponse().Error).To(Equal(models.ErrResourceExists))
+			})
+		})
+	})
+
+	Describe("UpdateScheduledTask", func() {
+		It("updates the schedule", func() {
+			update := &models.ScheduledTaskUpdate{}
+			update.SetCronExpression("0 3 * * *")
+			controller.UpdateScheduledTaskReturns(schedule, nil)
+
+			handler.UpdateScheduledTask(logger, responseRecorder, newTestRequest(&models.UpdateScheduledTaskRequest{ScheduleGuid: "nightly", Update: update}))
+
+			_, _, scheduleGuid, actualUpdate := controller.UpdateScheduledTaskArgsForCall(0)
+			Expect(scheduleGuid).To(Equal("nightly"))
+			Expect(actualUpdate).To(Equal(update))
+			Expect(parseResponse().ScheduledTask).To(Equal(schedule))
+		})
+
+		It("rejects requests without an update", func() {
+			handler.UpdateScheduledTask(logger, responseRecorder, newTestRequest(&models.UpdateScheduledTaskRequest{ScheduleGuid: "nightly"}))
+
+			Expect(controller.UpdateScheduledTaskCallCount()).To(Equal(0))
+			Expect(parseResponse().Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+	})
+
+	Describe("SuspendScheduledTask", func() {
+		It("suspends the schedule", func() {
+			controller.SuspendScheduledTaskReturns(schedule, nil)
+			handler.SuspendScheduledTask(logger, responseRecorder, newTestRequest(&models.SuspendScheduledTaskRequest{ScheduleGuid: "nightly", Suspended: true}))
+
+			_, _, scheduleGuid, suspended := controller.SuspendScheduledTaskArgsForCall(0)
+			Expect(scheduleGuid).To(Equal("nightly"))
+			Expect(suspended).To(BeTrue())
+			Expect(parseResponse().ScheduledTask).To(Equal(schedule))
+		})
+	})
+
+	Describe("DeleteScheduledTask", func() {
+		It("deletes the schedule", func() {
+			handler.DeleteScheduledTask(logger, responseRecorder, newTestRequest(&models.DeleteScheduledTaskRequest{ScheduleGuid: "nightly"}))
+
+			_, _, scheduleGuid := controller.DeleteScheduledTaskArgsForCall(0)
+			Expect(scheduleGuid).To(Equal("nightly"))
+
+			response := &models.ScheduledTaskLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+		})
+
+		It("responds with not found for unknown schedules", func() {
+			controller.DeleteScheduledTaskReturns(models.ErrResourceNotFound)
+			handler.DeleteScheduledTask(logger, responseRecorder, newTestRequest(&models.DeleteScheduledTaskRequest{ScheduleGuid: "unknown"}))
+
+			response := &models.ScheduledTaskLifecycleResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
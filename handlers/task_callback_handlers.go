@@ -0,0 +1,72 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+type TaskCallbackHandler struct {
+	db       db.TaskCallbackDB
+	exitChan chan<- struct{}
+}
+
+func NewTaskCallbackHandler(db db.TaskCallbackDB, exitChan chan<- struct{}) *TaskCallbackHandler {
+	return &TaskCallbackHandler{
+		db:       db,
+		exitChan: exitChan,
+	}
+}
+
+func (h *TaskCallbackHandler) TaskCallbacks(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("task-callbacks").WithTraceInfo(req)
+
+	request := &models.TaskCallbacksRequest{}
+	response := &models.TaskCallbacksResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.taskCallbacks(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *TaskCallbackHandler) taskCallbacks(ctx context.Context, logger lager.Logger, request *models.TaskCallbacksRequest, response *models.TaskCallbacksResponse) error {
+	var err error
+	response.TaskCallbacks, err = h.db.TaskCallbacks(ctx, logger, request.DeadLettered)
+	return err
+}
+
+func (h *TaskCallbackHandler) ReplayTaskCallback(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
+	logger = logger.Session("replay-task-callback").WithTraceInfo(req)
+
+	request := &models.ReplayTaskCallbackRequest{}
+	response := &models.ReplayTaskCallbackResponse{}
+
+	defer func() { exitIfUnrecoverable(logger, h.exitChan, response.Error) }()
+	defer func() { writeResponse(w, response) }()
+
+	err := parseRequest(logger, req, request)
+	if err != nil {
+		response.Error = models.ConvertError(err)
+		return
+	}
+
+	err = h.replayTaskCallback(req.Context(), logger, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+func (h *TaskCallbackHandler) replayTaskCallback(ctx context.Context, logger lager.Logger, request *models.ReplayTaskCallbackRequest, response *models.ReplayTaskCallbackResponse) error {
+	var err error
+	response.TaskCallback, err = h.db.ReplayTaskCallback(ctx, logger, request.TaskGuid)
+	return err
+}
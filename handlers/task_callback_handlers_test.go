@@ -0,0 +1,98 @@
+package handlers_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs/db/dbfakes"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskCallback Handlers", func() {
+	var (
+		logger     *lagertest.TestLogger
+		callbackDB *dbfakes.FakeTaskCallbackDB
+
+		responseRecorder *httptest.ResponseRecorder
+		handler          *handlers.TaskCallbackHandler
+		exitCh           chan struct{}
+
+		callback *models.TaskCallback
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		responseRecorder = httptest.NewRecorder()
+		exitCh = make(chan struct{}, 1)
+		callbackDB = new(dbfakes.FakeTaskCallbackDB)
+		handler = handlers.NewTaskCallbackHandler(callbackDB, exitCh)
+
+		callback = &models.TaskCallback{
+			TaskGuid:       "some-task",
+			State:          models.TaskCallback_DeadLettered,
+			Attempts:       10,
+			LastStatusCode: http.StatusServiceUnavailable,
+		}
+	})
+
+	Describe("TaskCallbacks", func() {
+		It("lists the dead-lettered callbacks", func() {
+			callbackDB.TaskCallbacksReturns([]*models.TaskCallback{callback}, nil)
+			handler.TaskCallbacks(logger, responseRecorder, newTestRequest(&models.TaskCallbacksRequest{DeadLettered: true}))
+
+			_, _, deadLettered := callbackDB.TaskCallbacksArgsForCall(0)
+			Expect(deadLettered).To(BeTrue())
+
+			Expect(responseRecorder.Code).To(Equal(http.StatusOK))
+			response := &models.TaskCallbacksResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.TaskCallbacks).To(Equal([]*models.TaskCallback{callback}))
+		})
+
+		It("responds with an unrecoverable error and exits", func() {
+			callbackDB.TaskCallbacksReturns(nil, models.NewUnrecoverableError(nil))
+			handler.TaskCallbacks(logger, responseRecorder, newTestRequest(&models.TaskCallbacksRequest{}))
+
+			Eventually(exitCh).Should(Receive())
+		})
+	})
+
+	Describe("ReplayTaskCallback", func() {
+		It("replays the callback", func() {
+			replayed := &models.TaskCallback{TaskGuid: "some-task", State: models.TaskCallback_Pending}
+			callbackDB.ReplayTaskCallbackReturns(replayed, nil)
+			handler.ReplayTaskCallback(logger, responseRecorder, newTestRequest(&models.ReplayTaskCallbackRequest{TaskGuid: "some-task"}))
+
+			_, _, taskGuid := callbackDB.ReplayTaskCallbackArgsForCall(0)
+			Expect(taskGuid).To(Equal("some-task"))
+
+			response := &models.ReplayTaskCallbackResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(BeNil())
+			Expect(response.TaskCallback).To(Equal(replayed))
+		})
+
+		It("rejects requests without a task guid", func() {
+			handler.ReplayTaskCallback(logger, responseRecorder, newTestRequest(&models.ReplayTaskCallbackRequest{}))
+
+			Expect(callbackDB.ReplayTaskCallbackCallCount()).To(Equal(0))
+			response := &models.ReplayTaskCallbackResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error.Type).To(Equal(models.Error_InvalidRequest))
+		})
+
+		It("responds with not found for unknown callbacks", func() {
+			callbackDB.ReplayTaskCallbackReturns(nil, models.ErrResourceNotFound)
+			handler.ReplayTaskCallback(logger, responseRecorder, newTestRequest(&models.ReplayTaskCallbackRequest{TaskGuid: "unknown"}))
+
+			response := &models.ReplayTaskCallbackResponse{}
+			Expect(response.Unmarshal(responseRecorder.Body.Bytes())).To(Succeed())
+			Expect(response.Error).To(Equal(models.ErrResourceNotFound))
+		})
+	})
+})
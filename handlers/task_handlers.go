@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/trace"
@@ -14,7 +15,8 @@ import (
 //counterfeiter:generate -o fake_controllers/fake_task_controller.go . TaskController
 
 type TaskController interface {
-	Tasks(ctx context.Context, logger lager.Logger, domain, cellId string) ([]*models.Task, error)
+	ListTasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, db.ListMetadata, error)
+	Tasks(ctx context.Context, logger lager.Logger, filter models.TaskFilter) ([]*models.Task, error)
 	TaskByGuid(ctx context.Context, logger lager.Logger, taskGuid string) (*models.Task, error)
 	DesireTask(ctx context.Context, logger lager.Logger, taskDefinition *models.TaskDefinition, taskGuid, domain string) error
 	StartTask(ctx context.Context, logger lager.Logger, taskGuid, cellId string) (shouldStart bool, err error)
@@ -59,14 +61,30 @@ func (h *TaskHandler) commonTasks(logger lager.Logger, targetVersion format.Vers
 		return
 	}
 
-	tasks, err := h.controller.Tasks(req.Context(), logger, request.Domain, request.CellId)
+	err = h.listTasks(req.Context(), logger, targetVersion, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// listTasks sets the tasks of the page the request asks for on the
+// response, in the target version.
+func (h *TaskHandler) listTasks(ctx context.Context, logger lager.Logger, targetVersion format.Version, request *models.TasksRequest, response *models.TasksResponse) error {
+	filter := models.TaskFilter{
+		Domain:        request.Domain,
+		CellID:        request.CellId,
+		PageSize:      request.PageSize,
+		PageToken:     request.PageToken,
+		LabelSelector: request.LabelSelector,
+	}
+	tasks, metadata, err := h.controller.ListTasks(ctx, logger, filter)
+	response.ResourceVersion = metadata.ResourceVersion
+	response.NextPageToken = metadata.NextPageToken
 
 	downgradedTasks := []*models.Task{}
 	for _, t := range tasks {
 		downgradedTasks = append(downgradedTasks, t.VersionDownTo(targetVersion))
 	}
 	response.Tasks = downgradedTasks
-	response.Error = models.ConvertError(err)
+	return err
 }
 
 func (h *TaskHandler) Tasks_r2(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -94,14 +112,20 @@ func (h *TaskHandler) commonTaskByGuid(logger lager.Logger, targetVersion format
 		return
 	}
 
-	var task *models.Task
-	task, err = h.controller.TaskByGuid(req.Context(), logger, request.TaskGuid)
+	err = h.taskByGuid(req.Context(), logger, targetVersion, request, response)
+	response.Error = models.ConvertError(err)
+}
+
+// taskByGuid sets the task of the request on the response, in the target
+// version.
+func (h *TaskHandler) taskByGuid(ctx context.Context, logger lager.Logger, targetVersion format.Version, request *models.TaskByGuidRequest, response *models.TaskResponse) error {
+	task, err := h.controller.TaskByGuid(ctx, logger, request.TaskGuid)
 	if task != nil {
 		task = task.VersionDownTo(targetVersion)
 	}
 
 	response.Task = task
-	response.Error = models.ConvertError(err)
+	return err
 }
 
 func (h *TaskHandler) TaskByGuid_r2(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
@@ -129,10 +153,14 @@ func (h *TaskHandler) DesireTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	err = h.controller.DesireTask(req.Context(), logger, request.TaskDefinition, request.TaskGuid, request.Domain)
+	err = h.desireTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) desireTask(ctx context.Context, logger lager.Logger, request *models.DesireTaskRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.DesireTask(ctx, logger, request.TaskDefinition, request.TaskGuid, request.Domain)
+}
+
 func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("start-task").WithTraceInfo(req)
@@ -150,10 +178,16 @@ func (h *TaskHandler) StartTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	response.ShouldStart, err = h.controller.StartTask(req.Context(), logger, request.TaskGuid, request.CellId)
+	err = h.startTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) startTask(ctx context.Context, logger lager.Logger, request *models.StartTaskRequest, response *models.StartTaskResponse) error {
+	var err error
+	response.ShouldStart, err = h.controller.StartTask(ctx, logger, request.TaskGuid, request.CellId)
+	return err
+}
+
 func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	logger = logger.Session("cancel-task").WithTraceInfo(req)
 
@@ -170,10 +204,14 @@ func (h *TaskHandler) CancelTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	err = h.controller.CancelTask(trace.ContextWithRequestId(req), logger, request.TaskGuid)
+	err = h.cancelTask(trace.ContextWithRequestId(req), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) cancelTask(ctx context.Context, logger lager.Logger, request *models.TaskGuidRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.CancelTask(ctx, logger, request.TaskGuid)
+}
+
 // Deprecated: do not use
 func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
@@ -192,10 +230,14 @@ func (h *TaskHandler) FailTask(logger lager.Logger, w http.ResponseWriter, req *
 		return
 	}
 
-	err = h.controller.FailTask(req.Context(), logger, request.TaskGuid, request.FailureReason)
+	err = h.failTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) failTask(ctx context.Context, logger lager.Logger, request *models.FailTaskRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.FailTask(ctx, logger, request.TaskGuid, request.FailureReason)
+}
+
 func (h *TaskHandler) RejectTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("reject-task").WithTraceInfo(req)
@@ -213,10 +255,14 @@ func (h *TaskHandler) RejectTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	err = h.controller.RejectTask(req.Context(), logger, request.TaskGuid, request.RejectionReason)
+	err = h.rejectTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) rejectTask(ctx context.Context, logger lager.Logger, request *models.RejectTaskRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.RejectTask(ctx, logger, request.TaskGuid, request.RejectionReason)
+}
+
 func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("complete-task").WithTraceInfo(req)
@@ -234,10 +280,14 @@ func (h *TaskHandler) CompleteTask(logger lager.Logger, w http.ResponseWriter, r
 		return
 	}
 
-	err = h.controller.CompleteTask(req.Context(), logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
+	err = h.completeTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) completeTask(ctx context.Context, logger lager.Logger, request *models.CompleteTaskRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.CompleteTask(ctx, logger, request.TaskGuid, request.CellId, request.Failed, request.FailureReason, request.Result)
+}
+
 func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("resolving-task").WithTraceInfo(req)
@@ -255,10 +305,14 @@ func (h *TaskHandler) ResolvingTask(logger lager.Logger, w http.ResponseWriter,
 		return
 	}
 
-	err = h.controller.ResolvingTask(req.Context(), logger, request.TaskGuid)
+	err = h.resolvingTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
 
+func (h *TaskHandler) resolvingTask(ctx context.Context, logger lager.Logger, request *models.TaskGuidRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.ResolvingTask(ctx, logger, request.TaskGuid)
+}
+
 func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req *http.Request) {
 	var err error
 	logger = logger.Session("delete-task").WithTraceInfo(req)
@@ -276,6 +330,10 @@ func (h *TaskHandler) DeleteTask(logger lager.Logger, w http.ResponseWriter, req
 		return
 	}
 
-	err = h.controller.DeleteTask(req.Context(), logger, request.TaskGuid)
+	err = h.deleteTask(req.Context(), logger, request, response)
 	response.Error = models.ConvertError(err)
 }
+
+func (h *TaskHandler) deleteTask(ctx context.Context, logger lager.Logger, request *models.TaskGuidRequest, response *models.TaskLifecycleResponse) error {
+	return h.controller.DeleteTask(ctx, logger, request.TaskGuid)
+}
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"strings"
 
+	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/bbs/handlers"
 	"code.cloudfoundry.org/bbs/handlers/fake_controllers"
@@ -83,7 +84,7 @@ var _ = Describe("Task Handlers", func() {
 			BeforeEach(func() {
 				tasks = []*models.Task{&task1, &task2}
 
-				controller.TasksReturns(tasks, nil)
+				controller.ListTasksReturns(tasks, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of tasks", func() {
@@ -98,10 +99,10 @@ var _ = Describe("Task Handlers", func() {
 			})
 
 			It("calls the controller with no filter", func() {
-				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				Expect(controller.ListTasksCallCount()).To(Equal(1))
+				_, _, filter := controller.ListTasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(domain))
+				Expect(filter.CellID).To(Equal(cellId))
 			})
 
 			Context("when the tasks include image layers", func() {
@@ -112,7 +113,7 @@ var _ = Describe("Task Handlers", func() {
 						&models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}},
 						&models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}},
 					}
-					controller.TasksReturns(tasksWithImageLayers, nil)
+					controller.ListTasksReturns(tasksWithImageLayers, db.ListMetadata{}, nil)
 
 					for _, t := range tasksWithImageLayers {
 						task := t.VersionDownTo(format.V2)
@@ -137,10 +138,10 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a domain filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.ListTasksCallCount()).To(Equal(1))
+					_, _, filter := controller.ListTasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 
@@ -150,17 +151,17 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a cell filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.ListTasksCallCount()).To(Equal(1))
+					_, _, filter := controller.ListTasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 		})
 
 		Context("when the controller returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.ListTasksReturns(nil, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -172,7 +173,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the controller errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.ListTasksReturns(nil, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -191,19 +192,25 @@ var _ = Describe("Task Handlers", func() {
 			task1          models.Task
 			task2          models.Task
 			cellId, domain string
+			pageSize       int32
+			pageToken      string
 		)
 
 		BeforeEach(func() {
-			task1 = models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
-			task2 = models.Task{TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
+			task1 = models.Task{TaskGuid: "task-guid-1", TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
+			task2 = models.Task{TaskGuid: "task-guid-2", TaskDefinition: &models.TaskDefinition{ImageLayers: []*models.ImageLayer{{LayerType: models.LayerTypeExclusive}, {LayerType: models.LayerTypeShared}}}}
+			pageSize = 0
+			pageToken = ""
 
 			requestBody = &models.TasksRequest{}
 		})
 
 		JustBeforeEach(func() {
 			requestBody = &models.TasksRequest{
-				Domain: domain,
-				CellId: cellId,
+				Domain:    domain,
+				CellId:    cellId,
+				PageSize:  pageSize,
+				PageToken: pageToken,
 			}
 			request = newTestRequest(requestBody)
 			request.Header.Set(lager.RequestIdHeader, requestIdHeader)
@@ -216,7 +223,7 @@ var _ = Describe("Task Handlers", func() {
 			BeforeEach(func() {
 				tasks = []*models.Task{task1.Copy(), task2.Copy()}
 
-				controller.TasksReturns(tasks, nil)
+				controller.ListTasksReturns(tasks, db.ListMetadata{}, nil)
 			})
 
 			It("returns a list of tasks", func() {
@@ -231,10 +238,44 @@ var _ = Describe("Task Handlers", func() {
 			})
 
 			It("calls the controller with no filter", func() {
-				Expect(controller.TasksCallCount()).To(Equal(1))
-				_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-				Expect(actualDomain).To(Equal(domain))
-				Expect(actualCellId).To(Equal(cellId))
+				Expect(controller.ListTasksCallCount()).To(Equal(1))
+				_, _, filter := controller.ListTasksArgsForCall(0)
+				Expect(filter.Domain).To(Equal(domain))
+				Expect(filter.CellID).To(Equal(cellId))
+			})
+
+			It("does not return a next page token", func() {
+				response := models.TasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.NextPageToken).To(BeEmpty())
+			})
+
+			Context("and paginating", func() {
+				BeforeEach(func() {
+					pageSize = 2
+					pageToken = models.PageToken{TaskGuid: "task-guid-0"}.Encode()
+				})
+
+				It("calls the controller with the page size and token", func() {
+					Expect(controller.ListTasksCallCount()).To(Equal(1))
+					_, _, filter := controller.ListTasksArgsForCall(0)
+					Expect(filter.PageSize).To(BeEquivalentTo(2))
+					Expect(filter.PageToken).To(Equal(pageToken))
+				})
+
+				Context("when more tasks follow the page", func() {
+					BeforeEach(func() {
+						controller.ListTasksReturns(tasks, db.ListMetadata{NextPageToken: "next-page-token"}, nil)
+					})
+
+					It("returns the next page token of the list", func() {
+						response := models.TasksResponse{}
+						err := response.Unmarshal(responseRecorder.Body.Bytes())
+						Expect(err).NotTo(HaveOccurred())
+						Expect(response.NextPageToken).To(Equal("next-page-token"))
+					})
+				})
 			})
 
 			Context("and filtering by domain", func() {
@@ -243,10 +284,10 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a domain filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.ListTasksCallCount()).To(Equal(1))
+					_, _, filter := controller.ListTasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 
@@ -256,17 +297,17 @@ var _ = Describe("Task Handlers", func() {
 				})
 
 				It("calls the controller with a cell filter", func() {
-					Expect(controller.TasksCallCount()).To(Equal(1))
-					_, _, actualDomain, actualCellId := controller.TasksArgsForCall(0)
-					Expect(actualDomain).To(Equal(domain))
-					Expect(actualCellId).To(Equal(cellId))
+					Expect(controller.ListTasksCallCount()).To(Equal(1))
+					_, _, filter := controller.ListTasksArgsForCall(0)
+					Expect(filter.Domain).To(Equal(domain))
+					Expect(filter.CellID).To(Equal(cellId))
 				})
 			})
 		})
 
 		Context("when the controller returns an unrecoverable error", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.NewUnrecoverableError(nil))
+				controller.ListTasksReturns(nil, db.ListMetadata{}, models.NewUnrecoverableError(nil))
 			})
 
 			It("logs and writes to the exit channel", func() {
@@ -278,7 +319,7 @@ var _ = Describe("Task Handlers", func() {
 
 		Context("when the controller errors out", func() {
 			BeforeEach(func() {
-				controller.TasksReturns(nil, models.ErrUnknownError)
+				controller.ListTasksReturns(nil, db.ListMetadata{}, models.ErrUnknownError)
 			})
 
 			It("provides relevant error information", func() {
@@ -290,6 +331,22 @@ var _ = Describe("Task Handlers", func() {
 				Expect(response.Error).To(Equal(models.ErrUnknownError))
 			})
 		})
+
+		Context("when the controller has a resource version", func() {
+			BeforeEach(func() {
+				controller.ListTasksReturns(nil, db.ListMetadata{ResourceVersion: 42}, nil)
+			})
+
+			It("returns it along with the tasks", func() {
+				response := models.TasksResponse{}
+				err := response.Unmarshal(responseRecorder.Body.Bytes())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(response.Error).To(BeNil())
+				Expect(response.ResourceVersion).To(BeEquivalentTo(42))
+			})
+		})
+
 	})
 
 	Describe("TaskByGuid_r2", func() {
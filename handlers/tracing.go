@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/trace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// TracingWrap serves the requests of the route within a span named after the
+// route, a child of the span of the W3C traceparent header when the caller
+// sends one. The spans of the controllers, queries and outbound calls of the
+// request are children of it.
+func TracingWrap(route string, handler http.Handler) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := trace.ExtractHeader(r.Context(), r.Header)
+		ctx, span := trace.StartServerSpan(ctx, route,
+			attribute.String("http.request.method", r.Method),
+			attribute.String("url.path", r.URL.Path),
+			attribute.String("bbs.request_id", trace.RequestIdFromRequest(r)),
+		)
+		defer span.End()
+
+		recorder := &statusResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(attribute.Int("http.response.status_code", recorder.status))
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	}
+}
+
+// TracingInterceptor serves the calls to the gRPC API within a span named
+// after their route, as TracingWrap does the requests to the HTTP API, with
+// the traceparent and request ID of their metadata.
+func TracingInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		route, _ := bbs.GRPCRoute(info.FullMethod)
+		md, _ := metadata.FromIncomingContext(ctx)
+		requestId := metadataValue(md, trace.RequestIdHeader)
+
+		ctx = trace.ExtractMap(ctx, map[string]string{
+			trace.TraceparentHeader: metadataValue(md, trace.TraceparentHeader),
+			trace.TracestateHeader:  metadataValue(md, trace.TracestateHeader),
+		})
+		ctx, span := trace.StartServerSpan(ctx, route,
+			attribute.String("rpc.system", "grpc"),
+			attribute.String("bbs.request_id", requestId),
+		)
+		defer span.End()
+
+		response, err := handler(trace.WithRequestId(ctx, requestId), request)
+
+		code := status.Code(err)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(code)))
+		if code == grpccodes.Unavailable || code == grpccodes.Internal {
+			span.SetStatus(codes.Error, code.String())
+		}
+		return response, err
+	}
+}
+
+type statusResponseWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusResponseWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
@@ -0,0 +1,87 @@
+package handlers_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/handlers"
+	"code.cloudfoundry.org/bbs/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TracingWrap", func() {
+	var (
+		recorder    *tracetest.SpanRecorder
+		request     *http.Request
+		status      int
+		handlerSpan oteltrace.SpanContext
+	)
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+		status = http.StatusOK
+		request = httptest.NewRequest("POST", "/v1/tasks/list.r3", nil)
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+	})
+
+	JustBeforeEach(func() {
+		handler := handlers.TracingWrap(bbs.TasksRoute_r3, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handlerSpan = oteltrace.SpanContextFromContext(r.Context())
+			w.WriteHeader(status)
+		}))
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+	})
+
+	It("serves the request within a span named after the route", func() {
+		Expect(recorder.Ended()).To(HaveLen(1))
+		span := recorder.Ended()[0]
+		Expect(span.Name()).To(Equal(bbs.TasksRoute_r3))
+		Expect(span.SpanKind()).To(Equal(oteltrace.SpanKindServer))
+		Expect(span.Attributes()).To(ContainElement(attribute.Int("http.response.status_code", http.StatusOK)))
+		Expect(handlerSpan.SpanID()).To(Equal(span.SpanContext().SpanID()))
+	})
+
+	Context("when the caller sends a traceparent header", func() {
+		var parent oteltrace.Span
+
+		BeforeEach(func() {
+			var ctx context.Context
+			ctx, parent = trace.StartClientSpan(context.Background(), "client")
+			trace.InjectHeader(ctx, request.Header)
+		})
+
+		It("starts the span as a child of the span of the caller", func() {
+			span := recorder.Ended()[0]
+			Expect(span.SpanContext().TraceID()).To(Equal(parent.SpanContext().TraceID()))
+			Expect(span.Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		})
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			status = http.StatusInternalServerError
+		})
+
+		It("marks the span as failed", func() {
+			span := recorder.Ended()[0]
+			Expect(span.Status().Code).To(Equal(codes.Error))
+			Expect(span.Attributes()).To(ContainElement(attribute.Int("http.response.status_code", http.StatusInternalServerError)))
+		})
+	})
+})
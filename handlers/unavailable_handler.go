@@ -1,8 +1,11 @@
 package handlers
 
 import (
+	"context"
 	"net/http"
 	"sync"
+
+	"google.golang.org/grpc"
 )
 
 type UnavailableHandler struct {
@@ -11,34 +14,18 @@ type UnavailableHandler struct {
 }
 
 func NewUnavailableHandler(handler http.Handler, serviceReadyChan ...<-chan struct{}) *UnavailableHandler {
-	wg := sync.WaitGroup{}
-	for _, ch := range serviceReadyChan {
-		wg.Add(1)
-		go func(ch <-chan struct{}) {
-			defer wg.Done()
-			<-ch
-		}(ch)
-	}
-
-	waitCh := make(chan struct{})
-	go func() {
-		wg.Wait()
-		close(waitCh)
-	}()
-
 	u := &UnavailableHandler{
 		handler: handler,
-		waitCh:  waitCh,
+		waitCh:  waitAll(serviceReadyChan...),
 	}
 
 	return u
 }
 
 func (u *UnavailableHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	select {
-	case <-u.waitCh:
+	if closed(u.waitCh) {
 		u.handler.ServeHTTP(w, r)
-	default:
+	} else {
 		w.WriteHeader(http.StatusServiceUnavailable)
 	}
 }
@@ -50,3 +37,45 @@ func UnavailableWrap(handler http.Handler, serviceReady ...<-chan struct{}) http
 		handler.ServeHTTP(w, r)
 	}
 }
+
+// UnavailableInterceptor serves the calls to the gRPC API once the services
+// are ready, as UnavailableHandler does the requests to the HTTP API, and
+// fails them with Unavailable until then.
+func UnavailableInterceptor(serviceReady ...<-chan struct{}) grpc.UnaryServerInterceptor {
+	waitCh := waitAll(serviceReady...)
+
+	return func(ctx context.Context, request any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !closed(waitCh) {
+			return nil, errNotReady
+		}
+		return handler(ctx, request)
+	}
+}
+
+// waitAll returns a channel closed once all the given channels are.
+func waitAll(chans ...<-chan struct{}) <-chan struct{} {
+	wg := sync.WaitGroup{}
+	for _, ch := range chans {
+		wg.Add(1)
+		go func(ch <-chan struct{}) {
+			defer wg.Done()
+			<-ch
+		}(ch)
+	}
+
+	waitCh := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(waitCh)
+	}()
+	return waitCh
+}
+
+func closed(ch <-chan struct{}) bool {
+	select {
+	case <-ch:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,41 @@
+package bbs
+
+import (
+	"context"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context making the calls of a ContextClient
+// carry the given idempotency key. The BBS serves the mutating calls made
+// with a key at most once within its idempotency key window, answering the
+// calls that reuse the key with the response of the first one.
+//
+// Calls made without a key are given a random one, shared by their retries
+// only.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// IdempotencyKeyFromContext returns the idempotency key calls made with the
+// context carry, if any.
+func IdempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// withCallIdempotencyKey gives the context a random idempotency key unless it
+// already carries one, so that all the attempts of a call carry the same key.
+func withCallIdempotencyKey(ctx context.Context) context.Context {
+	if IdempotencyKeyFromContext(ctx) != "" {
+		return ctx
+	}
+
+	key, err := uuid.NewV4()
+	if err != nil {
+		return ctx
+	}
+	return WithIdempotencyKey(ctx, key.String())
+}
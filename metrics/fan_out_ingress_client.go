@@ -0,0 +1,67 @@
+package metrics
+
+import (
+	"errors"
+	"time"
+
+	loggingclient "code.cloudfoundry.org/diego-logging-client"
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
+)
+
+type fanOutIngressClient struct {
+	clients []loggingclient.IngressClient
+}
+
+// NewFanOutIngressClient returns an ingress client that sends every metric
+// and log to each of the clients, such as Loggregator and a PrometheusSink.
+func NewFanOutIngressClient(clients ...loggingclient.IngressClient) loggingclient.IngressClient {
+	return &fanOutIngressClient{clients: clients}
+}
+
+func (f *fanOutIngressClient) each(send func(loggingclient.IngressClient) error) error {
+	var errs []error
+	for _, client := range f.clients {
+		errs = append(errs, send(client))
+	}
+	return errors.Join(errs...)
+}
+
+func (f *fanOutIngressClient) SendDuration(name string, value time.Duration, opts ...loggregator.EmitGaugeOption) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendDuration(name, value, opts...) })
+}
+
+func (f *fanOutIngressClient) SendMebiBytes(name string, value int, opts ...loggregator.EmitGaugeOption) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendMebiBytes(name, value, opts...) })
+}
+
+func (f *fanOutIngressClient) SendMetric(name string, value int, opts ...loggregator.EmitGaugeOption) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendMetric(name, value, opts...) })
+}
+
+func (f *fanOutIngressClient) SendBytesPerSecond(name string, value float64) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendBytesPerSecond(name, value) })
+}
+
+func (f *fanOutIngressClient) SendRequestsPerSecond(name string, value float64) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendRequestsPerSecond(name, value) })
+}
+
+func (f *fanOutIngressClient) IncrementCounter(name string) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.IncrementCounter(name) })
+}
+
+func (f *fanOutIngressClient) IncrementCounterWithDelta(name string, value uint64) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.IncrementCounterWithDelta(name, value) })
+}
+
+func (f *fanOutIngressClient) SendAppLog(message, sourceType string, tags map[string]string) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendAppLog(message, sourceType, tags) })
+}
+
+func (f *fanOutIngressClient) SendAppErrorLog(message, sourceType string, tags map[string]string) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendAppErrorLog(message, sourceType, tags) })
+}
+
+func (f *fanOutIngressClient) SendComponentMetric(name string, value float64, unit string) error {
+	return f.each(func(c loggingclient.IngressClient) error { return c.SendComponentMetric(name, value, unit) })
+}
@@ -0,0 +1,53 @@
+package metrics_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FanOutIngressClient", func() {
+	var (
+		first, second *mfakes.FakeIngressClient
+	)
+
+	BeforeEach(func() {
+		first = new(mfakes.FakeIngressClient)
+		second = new(mfakes.FakeIngressClient)
+	})
+
+	It("sends each metric to every client", func() {
+		client := metrics.NewFanOutIngressClient(first, second)
+		opt := loggregator.WithEnvelopeTag("cell-id", "cell-1")
+
+		Expect(client.SendDuration("ConvergenceLRPDuration", time.Second, opt)).To(Succeed())
+		Expect(client.IncrementCounterWithDelta("RequestCount", 3)).To(Succeed())
+
+		for _, fake := range []*mfakes.FakeIngressClient{first, second} {
+			Expect(fake.SendDurationCallCount()).To(Equal(1))
+			name, value, opts := fake.SendDurationArgsForCall(0)
+			Expect(name).To(Equal("ConvergenceLRPDuration"))
+			Expect(value).To(Equal(time.Second))
+			Expect(opts).To(HaveLen(1))
+
+			Expect(fake.IncrementCounterWithDeltaCallCount()).To(Equal(1))
+			name, delta := fake.IncrementCounterWithDeltaArgsForCall(0)
+			Expect(name).To(Equal("RequestCount"))
+			Expect(delta).To(Equal(uint64(3)))
+		}
+	})
+
+	It("sends to the other clients when one fails, and returns its error", func() {
+		first.SendMetricReturns(errors.New("boom"))
+		client := metrics.NewFanOutIngressClient(first, second)
+
+		Expect(client.SendMetric("LRPsDesired", 5)).To(MatchError(ContainSubstring("boom")))
+		Expect(second.SendMetricCallCount()).To(Equal(1))
+	})
+})
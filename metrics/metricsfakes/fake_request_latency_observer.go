@@ -0,0 +1,79 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package metricsfakes
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/metrics"
+)
+
+type FakeRequestLatencyObserver struct {
+	ObserveRequestLatencyStub        func(time.Duration, string)
+	observeRequestLatencyMutex       sync.RWMutex
+	observeRequestLatencyArgsForCall []struct {
+		arg1 time.Duration
+		arg2 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRequestLatencyObserver) ObserveRequestLatency(arg1 time.Duration, arg2 string) {
+	fake.observeRequestLatencyMutex.Lock()
+	fake.observeRequestLatencyArgsForCall = append(fake.observeRequestLatencyArgsForCall, struct {
+		arg1 time.Duration
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ObserveRequestLatencyStub
+	fake.recordInvocation("ObserveRequestLatency", []interface{}{arg1, arg2})
+	fake.observeRequestLatencyMutex.Unlock()
+	if stub != nil {
+		fake.ObserveRequestLatencyStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeRequestLatencyObserver) ObserveRequestLatencyCallCount() int {
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
+	return len(fake.observeRequestLatencyArgsForCall)
+}
+
+func (fake *FakeRequestLatencyObserver) ObserveRequestLatencyCalls(stub func(time.Duration, string)) {
+	fake.observeRequestLatencyMutex.Lock()
+	defer fake.observeRequestLatencyMutex.Unlock()
+	fake.ObserveRequestLatencyStub = stub
+}
+
+func (fake *FakeRequestLatencyObserver) ObserveRequestLatencyArgsForCall(i int) (time.Duration, string) {
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
+	argsForCall := fake.observeRequestLatencyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeRequestLatencyObserver) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.observeRequestLatencyMutex.RLock()
+	defer fake.observeRequestLatencyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRequestLatencyObserver) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ metrics.RequestLatencyObserver = new(FakeRequestLatencyObserver)
@@ -0,0 +1,292 @@
+package metrics
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
+	"code.cloudfoundry.org/go-loggregator/v9/rpc/loggregator_v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	prometheusNamespace = "bbs"
+	routeLabel          = "route"
+
+	requestDurationMetric = "bbs_request_duration_seconds"
+)
+
+var durationBuckets = prometheus.ExponentialBuckets(0.005, 2, 14)
+
+// PrometheusSink exposes the metrics sent to it in the Prometheus and
+// OpenMetrics formats. It is a Loggregator ingress client, so that the
+// notifiers can send their metrics to both Loggregator and Prometheus with
+// NewFanOutIngressClient.
+//
+// Metric names are converted to snake case and prefixed with bbs_. Durations
+// are reported in seconds with a _seconds suffix and counters with a _total
+// suffix. A metric sent for a route, such as RequestCount.DesireTask_r0, is
+// reported as bbs_request_count_by_route_total{route="DesireTask_r0"}, and
+// envelope tags are reported as labels.
+type PrometheusSink struct {
+	registry         *prometheus.Registry
+	histogramMetrics []string
+	requestDuration  *prometheus.HistogramVec
+
+	lock       sync.Mutex
+	gauges     map[string]*prometheus.GaugeVec
+	counters   map[string]*prometheus.CounterVec
+	histograms map[string]*prometheus.HistogramVec
+	labels     map[string][]string
+}
+
+// NewPrometheusSink returns a sink that reports the durations sent with the
+// names of histogramMetrics as histograms rather than gauges.
+func NewPrometheusSink(histogramMetrics ...string) *PrometheusSink {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+
+	requestDuration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    requestDurationMetric,
+		Help:    "Duration of the requests served by the BBS.",
+		Buckets: durationBuckets,
+	}, []string{routeLabel})
+	registry.MustRegister(requestDuration)
+
+	return &PrometheusSink{
+		registry:         registry,
+		histogramMetrics: histogramMetrics,
+		requestDuration:  requestDuration,
+		gauges:           make(map[string]*prometheus.GaugeVec),
+		counters:         make(map[string]*prometheus.CounterVec),
+		histograms:       make(map[string]*prometheus.HistogramVec),
+		labels:           make(map[string][]string),
+	}
+}
+
+// Handler serves the metrics, in the OpenMetrics format to the scrapers that
+// accept it.
+func (s *PrometheusSink) Handler() http.Handler {
+	return promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{EnableOpenMetrics: true})
+}
+
+// ObserveRequestLatency records the duration of a request to the route in the
+// request duration histogram.
+func (s *PrometheusSink) ObserveRequestLatency(latency time.Duration, route string) {
+	s.requestDuration.WithLabelValues(route).Observe(latency.Seconds())
+}
+
+func (s *PrometheusSink) SendDuration(name string, value time.Duration, opts ...loggregator.EmitGaugeOption) error {
+	if slices.Contains(s.histogramMetrics, name) {
+		return s.observe(name, "_seconds", value.Seconds(), opts)
+	}
+	return s.setGauge(name, "_seconds", value.Seconds(), opts)
+}
+
+func (s *PrometheusSink) SendMebiBytes(name string, value int, opts ...loggregator.EmitGaugeOption) error {
+	return s.setGauge(name, "_mebibytes", float64(value), opts)
+}
+
+func (s *PrometheusSink) SendMetric(name string, value int, opts ...loggregator.EmitGaugeOption) error {
+	return s.setGauge(name, "", float64(value), opts)
+}
+
+func (s *PrometheusSink) SendBytesPerSecond(name string, value float64) error {
+	return s.setGauge(name, "_bytes_per_second", value, nil)
+}
+
+func (s *PrometheusSink) SendRequestsPerSecond(name string, value float64) error {
+	return s.setGauge(name, "_requests_per_second", value, nil)
+}
+
+func (s *PrometheusSink) SendComponentMetric(name string, value float64, unit string) error {
+	return s.setGauge(name, "", value, nil)
+}
+
+func (s *PrometheusSink) IncrementCounter(name string) error {
+	return s.IncrementCounterWithDelta(name, 1)
+}
+
+func (s *PrometheusSink) IncrementCounterWithDelta(name string, value uint64) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	family, labels := s.describe(name, "_total", nil)
+	counter, ok := s.counters[family]
+	if !ok {
+		counter = prometheus.NewCounterVec(prometheus.CounterOpts{Name: family, Help: helpFor(name)}, labelNames(labels))
+		if err := s.register(family, counter, labels); err != nil {
+			return err
+		}
+		s.counters[family] = counter
+	}
+
+	metric, err := with(s, family, labels, counter.GetMetricWith)
+	if err != nil {
+		return err
+	}
+	metric.Add(float64(value))
+	return nil
+}
+
+// The Prometheus sink takes no logs.
+func (s *PrometheusSink) SendAppLog(message, sourceType string, tags map[string]string) error {
+	return nil
+}
+
+func (s *PrometheusSink) SendAppErrorLog(message, sourceType string, tags map[string]string) error {
+	return nil
+}
+
+func (s *PrometheusSink) setGauge(name, unit string, value float64, opts []loggregator.EmitGaugeOption) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	family, labels := s.describe(name, unit, opts)
+	gauge, ok := s.gauges[family]
+	if !ok {
+		gauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: family, Help: helpFor(name)}, labelNames(labels))
+		if err := s.register(family, gauge, labels); err != nil {
+			return err
+		}
+		s.gauges[family] = gauge
+	}
+
+	metric, err := with(s, family, labels, gauge.GetMetricWith)
+	if err != nil {
+		return err
+	}
+	metric.Set(value)
+	return nil
+}
+
+func (s *PrometheusSink) observe(name, unit string, value float64, opts []loggregator.EmitGaugeOption) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	family, labels := s.describe(name, unit, opts)
+	histogram, ok := s.histograms[family]
+	if !ok {
+		histogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: family, Help: helpFor(name), Buckets: durationBuckets}, labelNames(labels))
+		if err := s.register(family, histogram, labels); err != nil {
+			return err
+		}
+		s.histograms[family] = histogram
+	}
+
+	metric, err := with(s, family, labels, histogram.GetMetricWith)
+	if err != nil {
+		return err
+	}
+	metric.Observe(value)
+	return nil
+}
+
+// describe returns the name of the metric family of the metric, and its
+// labels, which are its route and the tags set by opts.
+func (s *PrometheusSink) describe(name, unit string, opts []loggregator.EmitGaugeOption) (string, prometheus.Labels) {
+	envelope := &loggregator_v2.Envelope{Tags: map[string]string{}}
+	for _, opt := range opts {
+		opt(envelope)
+	}
+
+	labels := prometheus.Labels{}
+	for tag, value := range envelope.Tags {
+		labels[sanitizeName(tag)] = value
+	}
+
+	if base, route, ok := strings.Cut(name, "."); ok {
+		name = base + "ByRoute"
+		labels[routeLabel] = route
+	}
+
+	family := snakeCase(name)
+	if !strings.HasPrefix(family, prometheusNamespace+"_") {
+		family = prometheusNamespace + "_" + family
+	}
+	return family + unit, labels
+}
+
+func (s *PrometheusSink) register(family string, collector prometheus.Collector, labels prometheus.Labels) error {
+	if _, ok := s.labels[family]; ok {
+		return fmt.Errorf("metric %s is already registered with another type", family)
+	}
+	if err := s.registry.Register(collector); err != nil {
+		return err
+	}
+	s.labels[family] = labelNames(labels)
+	return nil
+}
+
+// with returns the metric of the family with the labels, which must be the
+// labels the family was first sent with.
+func with[M any](s *PrometheusSink, family string, labels prometheus.Labels, get func(prometheus.Labels) (M, error)) (M, error) {
+	if !slices.Equal(s.labels[family], labelNames(labels)) {
+		var zero M
+		return zero, fmt.Errorf("metric %s sent with labels %v, but registered with %v", family, labelNames(labels), s.labels[family])
+	}
+	return get(labels)
+}
+
+func labelNames(labels prometheus.Labels) []string {
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func helpFor(name string) string {
+	return "The " + name + " metric of the BBS."
+}
+
+// snakeCase converts a metric name such as ConvergenceLRPDuration or
+// LRPsDesired to convergence_lrp_duration or lrps_desired.
+func snakeCase(name string) string {
+	runes := []rune(sanitizeName(name))
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && startsWord(runes, i) && runes[i-1] != '_' {
+			b.WriteRune('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
+
+// startsWord reports whether the upper case rune at i starts a word: it
+// follows a lower case letter or a digit, or ends an acronym followed by a
+// lower case word. The s of plural acronyms such as LRPs belongs to the
+// acronym.
+func startsWord(runes []rune, i int) bool {
+	previous := runes[i-1]
+	if unicode.IsLower(previous) || unicode.IsDigit(previous) {
+		return true
+	}
+	if i+1 >= len(runes) || !unicode.IsLower(runes[i+1]) {
+		return false
+	}
+	pluralAcronym := runes[i+1] == 's' && (i+2 == len(runes) || !unicode.IsLower(runes[i+2]))
+	return !pluralAcronym
+}
+
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return r
+		}
+		return '_'
+	}, name)
+}
@@ -0,0 +1,104 @@
+package metrics_test
+
+import (
+	"io"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/bbs/metrics"
+	loggregator "code.cloudfoundry.org/go-loggregator/v9"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PrometheusSink", func() {
+	var sink *metrics.PrometheusSink
+
+	BeforeEach(func() {
+		sink = metrics.NewPrometheusSink("ConvergenceLRPPhaseDuration")
+	})
+
+	scrape := func() string {
+		recorder := httptest.NewRecorder()
+		sink.Handler().ServeHTTP(recorder, httptest.NewRequest("GET", "/metrics", nil))
+		body, err := io.ReadAll(recorder.Body)
+		Expect(err).NotTo(HaveOccurred())
+		return string(body)
+	}
+
+	It("reports gauges in snake case", func() {
+		Expect(sink.SendMetric("LRPsDesired", 5)).To(Succeed())
+		Expect(sink.SendMetric("DBOpenConnections", 3)).To(Succeed())
+		Expect(sink.SendMetric("BBSMasterElected", 1)).To(Succeed())
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("bbs_lrps_desired 5\n"))
+		Expect(body).To(ContainSubstring("bbs_db_open_connections 3\n"))
+		Expect(body).To(ContainSubstring("bbs_master_elected 1\n"))
+	})
+
+	It("reports the last value of a gauge", func() {
+		Expect(sink.SendMetric("LRPsDesired", 5)).To(Succeed())
+		Expect(sink.SendMetric("LRPsDesired", 2)).To(Succeed())
+
+		Expect(scrape()).To(ContainSubstring("bbs_lrps_desired 2\n"))
+	})
+
+	It("reports durations in seconds", func() {
+		Expect(sink.SendDuration("ConvergenceLRPDuration", 1500*time.Millisecond)).To(Succeed())
+
+		Expect(scrape()).To(ContainSubstring("bbs_convergence_lrp_duration_seconds 1.5\n"))
+	})
+
+	It("adds up counters", func() {
+		Expect(sink.IncrementCounterWithDelta("RequestCount", 3)).To(Succeed())
+		Expect(sink.IncrementCounter("RequestCount")).To(Succeed())
+
+		Expect(scrape()).To(ContainSubstring("bbs_request_count_total 4\n"))
+	})
+
+	It("reports the metrics of a route with a route label", func() {
+		Expect(sink.IncrementCounterWithDelta("RequestCount", 3)).To(Succeed())
+		Expect(sink.IncrementCounterWithDelta("RequestCount.DesireTask_r3", 2)).To(Succeed())
+
+		body := scrape()
+		Expect(body).To(ContainSubstring("bbs_request_count_total 3\n"))
+		Expect(body).To(ContainSubstring(`bbs_request_count_by_route_total{route="DesireTask_r3"} 2`))
+	})
+
+	It("reports envelope tags as labels", func() {
+		Expect(sink.SendMetric("TasksStarted", 4, loggregator.WithEnvelopeTag("cell-id", "cell-1"))).To(Succeed())
+
+		Expect(scrape()).To(ContainSubstring(`bbs_tasks_started{cell_id="cell-1"} 4`))
+	})
+
+	It("rejects a metric sent with other labels than before", func() {
+		Expect(sink.SendMetric("TasksStarted", 4, loggregator.WithEnvelopeTag("cell-id", "cell-1"))).To(Succeed())
+
+		Expect(sink.SendMetric("TasksStarted", 4)).NotTo(Succeed())
+	})
+
+	It("reports the durations of the histogram metrics as histograms", func() {
+		Expect(sink.SendDuration("ConvergenceLRPPhaseDuration", 20*time.Millisecond, loggregator.WithEnvelopeTag("phase", "prune-domains"))).To(Succeed())
+		Expect(sink.SendDuration("ConvergenceLRPPhaseDuration", 30*time.Millisecond, loggregator.WithEnvelopeTag("phase", "prune-domains"))).To(Succeed())
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`bbs_convergence_lrp_phase_duration_seconds_count{phase="prune-domains"} 2`))
+		Expect(body).To(ContainSubstring(`bbs_convergence_lrp_phase_duration_seconds_bucket{phase="prune-domains",le="0.04"} 2`))
+	})
+
+	It("reports the latency of the requests of each route as a histogram", func() {
+		sink.ObserveRequestLatency(30*time.Millisecond, "DesireTask_r3")
+
+		body := scrape()
+		Expect(body).To(ContainSubstring(`bbs_request_duration_seconds_count{route="DesireTask_r3"} 1`))
+		Expect(body).To(ContainSubstring(`bbs_request_duration_seconds_bucket{route="DesireTask_r3",le="0.02"} 0`))
+		Expect(body).To(ContainSubstring(`bbs_request_duration_seconds_bucket{route="DesireTask_r3",le="0.04"} 1`))
+	})
+
+	It("takes no logs", func() {
+		Expect(sink.SendAppLog("some-message", "some-source", nil)).To(Succeed())
+		Expect(scrape()).NotTo(ContainSubstring("some-message"))
+	})
+})
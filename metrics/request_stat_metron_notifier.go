@@ -13,13 +13,24 @@ import (
 )
 
 const (
-	requestCounter         = "RequestCount"
-	requestLatencyDuration = "RequestLatency"
+	requestCounter          = "RequestCount"
+	requestLatencyDuration  = "RequestLatency"
+	throttledRequestCounter = "ThrottledRequestCount"
 )
 
 type requestMetrics struct {
-	requestCount      uint64
-	maxRequestLatency time.Duration
+	requestCount          uint64
+	maxRequestLatency     time.Duration
+	throttledRequestCount uint64
+}
+
+//counterfeiter:generate . RequestLatencyObserver
+
+// RequestLatencyObserver records the latency of requests to the routes of
+// advanced metrics, such as in the request duration histogram of a
+// PrometheusSink. Latencies are dropped when the notifier has no observer.
+type RequestLatencyObserver interface {
+	ObserveRequestLatency(latency time.Duration, route string)
 }
 
 type RequestStatMetronNotifier struct {
@@ -30,13 +41,15 @@ type RequestStatMetronNotifier struct {
 	lock                   sync.Mutex
 	metronClient           loggingclient.IngressClient
 	advancedMetricsConfig  config.AdvancedMetrics
+	latencyObserver        RequestLatencyObserver
 }
 
 func NewRequestStatMetronNotifier(
 	logger lager.Logger,
 	ticker clock.Ticker,
 	metronClient loggingclient.IngressClient,
-	advancedMetricsConfig config.AdvancedMetrics) *RequestStatMetronNotifier {
+	advancedMetricsConfig config.AdvancedMetrics,
+	latencyObserver RequestLatencyObserver) *RequestStatMetronNotifier {
 
 	requestMetricsPerRoute := make(map[string]*requestMetrics)
 
@@ -50,6 +63,7 @@ func NewRequestStatMetronNotifier(
 		metronClient:           metronClient,
 		requestMetricsPerRoute: requestMetricsPerRoute,
 		advancedMetricsConfig:  advancedMetricsConfig,
+		latencyObserver:        latencyObserver,
 	}
 }
 
@@ -77,6 +91,19 @@ func (notifier *RequestStatMetronNotifier) IncrementRequestCounter(delta int, ro
 	notifier.requestMetricsAll.requestCount += uint64(delta)
 }
 
+func (notifier *RequestStatMetronNotifier) IncrementThrottledRequestCounter(delta int, route string) {
+	notifier.lock.Lock()
+	defer notifier.lock.Unlock()
+
+	if route != "" {
+		notifier.requestMetricsPerRoute[route].throttledRequestCount += uint64(delta)
+
+		return
+	}
+
+	notifier.requestMetricsAll.throttledRequestCount += uint64(delta)
+}
+
 func (notifier *RequestStatMetronNotifier) UpdateLatency(latency time.Duration, route string) {
 	notifier.lock.Lock()
 	defer notifier.lock.Unlock()
@@ -96,6 +123,13 @@ func (notifier *RequestStatMetronNotifier) UpdateLatency(latency time.Duration,
 	updateLatency(&notifier.requestMetricsAll)
 }
 
+func (notifier *RequestStatMetronNotifier) ObserveRequestLatency(latency time.Duration, route string) {
+	if notifier.latencyObserver == nil {
+		return
+	}
+	notifier.latencyObserver.ObserveRequestLatency(latency, route)
+}
+
 func readAndResetMetric[MetricType uint64 | time.Duration](metric *MetricType) MetricType {
 	currentMetricValue := *metric
 	*metric = 0
@@ -131,6 +165,9 @@ func (notifier *RequestStatMetronNotifier) emitMetrics(logger lager.Logger) {
 	requestLatencyMetricValue := readAndResetMetric(&notifier.requestMetricsAll.maxRequestLatency)
 	notifier.emitRequestLatency("", requestLatencyMetricValue, logger)
 
+	throttledRequestCountMetricValue := readAndResetMetric(&notifier.requestMetricsAll.throttledRequestCount)
+	notifier.emitThrottledRequestCount("", throttledRequestCountMetricValue, logger)
+
 	// Emit Route Specific/Advanced Metrics
 	if !notifier.advancedMetricsConfig.Enabled {
 		return
@@ -139,6 +176,9 @@ func (notifier *RequestStatMetronNotifier) emitMetrics(logger lager.Logger) {
 	for _, route := range notifier.advancedMetricsConfig.RouteConfig.RequestCountRoutes {
 		requestCountMetricValue := readAndResetMetric(&notifier.requestMetricsPerRoute[route].requestCount)
 		notifier.emitRequestCount("."+route, requestCountMetricValue, logger)
+
+		throttledRequestCountMetricValue := readAndResetMetric(&notifier.requestMetricsPerRoute[route].throttledRequestCount)
+		notifier.emitThrottledRequestCount("."+route, throttledRequestCountMetricValue, logger)
 	}
 
 	for _, route := range notifier.advancedMetricsConfig.RouteConfig.RequestLatencyRoutes {
@@ -170,3 +210,15 @@ func (notifier *RequestStatMetronNotifier) emitRequestCount(
 		logger.Debug("failed-to-emit-request-counter", lager.Data{"error": metricErr})
 	}
 }
+
+func (notifier *RequestStatMetronNotifier) emitThrottledRequestCount(
+	postfix string,
+	throttledRequestCountMetricValue uint64,
+	logger lager.Logger) {
+
+	logger.Debug("adding-throttled-counter", lager.Data{"add": throttledRequestCountMetricValue})
+	metricErr := notifier.metronClient.IncrementCounterWithDelta(throttledRequestCounter+postfix, throttledRequestCountMetricValue)
+	if metricErr != nil {
+		logger.Debug("failed-to-emit-throttled-request-counter", lager.Data{"error": metricErr})
+	}
+}
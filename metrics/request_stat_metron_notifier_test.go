@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/bbs/cmd/bbs/config"
 	"code.cloudfoundry.org/bbs/metrics"
+	"code.cloudfoundry.org/bbs/metrics/metricsfakes"
 	"code.cloudfoundry.org/clock/fakeclock"
 	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
 	loggregator "code.cloudfoundry.org/go-loggregator/v9"
@@ -19,10 +20,11 @@ import (
 
 var _ = Describe("PeriodicMetronNotifier", func() {
 	var (
-		fakeMetronClient *mfakes.FakeIngressClient
-		counterMap       map[string]uint64
-		durationMap      map[string]time.Duration
-		metricsLock      sync.Mutex
+		fakeMetronClient    *mfakes.FakeIngressClient
+		fakeLatencyObserver *metricsfakes.FakeRequestLatencyObserver
+		counterMap          map[string]uint64
+		durationMap         map[string]time.Duration
+		metricsLock         sync.Mutex
 
 		reportInterval time.Duration
 		fakeClock      *fakeclock.FakeClock
@@ -36,6 +38,7 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 		counterMap = make(map[string]uint64)
 		durationMap = make(map[string]time.Duration)
 		fakeMetronClient = new(mfakes.FakeIngressClient)
+		fakeLatencyObserver = new(metricsfakes.FakeRequestLatencyObserver)
 		fakeMetronClient.IncrementCounterWithDeltaStub = func(name string, delta uint64) error {
 			metricsLock.Lock()
 			defer metricsLock.Unlock()
@@ -57,7 +60,7 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 
 	JustBeforeEach(func() {
 		ticker := fakeClock.NewTicker(reportInterval)
-		mn = metrics.NewRequestStatMetronNotifier(lagertest.NewTestLogger("test"), ticker, fakeMetronClient, advancedMetricsConfig)
+		mn = metrics.NewRequestStatMetronNotifier(lagertest.NewTestLogger("test"), ticker, fakeMetronClient, advancedMetricsConfig, fakeLatencyObserver)
 		mnp = ifrit.Invoke(mn)
 	})
 
@@ -103,6 +106,32 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 		}).Should(Equal(3 * time.Second))
 	})
 
+	It("passes the latency of each request to the latency observer", func() {
+		mn.ObserveRequestLatency(5*time.Second, "DesireTask_r3")
+
+		Expect(fakeLatencyObserver.ObserveRequestLatencyCallCount()).To(Equal(1))
+		latency, route := fakeLatencyObserver.ObserveRequestLatencyArgsForCall(0)
+		Expect(latency).To(Equal(5 * time.Second))
+		Expect(route).To(Equal("DesireTask_r3"))
+	})
+
+	It("drops the latency of requests without a latency observer", func() {
+		notifier := metrics.NewRequestStatMetronNotifier(lagertest.NewTestLogger("test"), fakeClock.NewTicker(reportInterval), fakeMetronClient, advancedMetricsConfig, nil)
+		Expect(func() { notifier.ObserveRequestLatency(5*time.Second, "DesireTask_r3") }).NotTo(Panic())
+	})
+
+	It("should emit a throttled request count event periodically", func() {
+		mn.IncrementThrottledRequestCounter(1, "")
+		mn.IncrementThrottledRequestCounter(1, "")
+		fakeClock.WaitForWatcherAndIncrement(reportInterval)
+
+		Eventually(func() uint64 {
+			metricsLock.Lock()
+			defer metricsLock.Unlock()
+			return counterMap["ThrottledRequestCount"]
+		}).Should(Equal(uint64(2)))
+	})
+
 	Context("Advanced Metrics", func() {
 		When("Advanced Metrics are disabled", func() {
 			BeforeEach(func() {
@@ -179,6 +208,17 @@ var _ = Describe("PeriodicMetronNotifier", func() {
 				}).Should(Equal(uint64(1)))
 			})
 
+			It("should emit throttled request counts for the request count routes", func() {
+				mn.IncrementThrottledRequestCounter(1, "TEST_ROUTE")
+				fakeClock.WaitForWatcherAndIncrement(reportInterval)
+
+				Eventually(func() uint64 {
+					metricsLock.Lock()
+					defer metricsLock.Unlock()
+					return counterMap["ThrottledRequestCount.TEST_ROUTE"]
+				}).Should(Equal(uint64(1)))
+			})
+
 			It("should not emit advanced metrics for routes not in the config", func() {
 				mn.IncrementRequestCounter(1, "TEST_ROUTE_2")
 				mn.UpdateLatency(5*time.Second, "TEST_ROUTE_2")
@@ -38,6 +38,8 @@ type ActualLRPFilter struct {
 	CellID      string
 	ProcessGuid string
 	Index       *int32
+	PageSize    int32
+	PageToken   string
 }
 
 type ActualLRPsByProcessGuidsFilter struct {
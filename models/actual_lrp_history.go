@@ -0,0 +1,47 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (t *ActualLRPHistoryRecord_Transition) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := ActualLRPHistoryRecord_Transition_value[name]; found {
+		*t = ActualLRPHistoryRecord_Transition(v)
+		return nil
+	}
+	return fmt.Errorf("invalid actual lrp history transition: %s", name)
+}
+
+func (t ActualLRPHistoryRecord_Transition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(t.String())
+}
+
+// NewActualLRPHistoryRecord returns the record of the transition of the
+// instance of the ActualLRP. after is the ActualLRP after the transition, or
+// nil when the instance was removed.
+func NewActualLRPHistoryRecord(transition ActualLRPHistoryRecord_Transition, key *ActualLRPKey, instanceKey *ActualLRPInstanceKey, after *ActualLRP, reason string) *ActualLRPHistoryRecord {
+	record := &ActualLRPHistoryRecord{
+		ProcessGuid: key.ProcessGuid,
+		Index:       key.Index,
+		Transition:  transition,
+		Reason:      reason,
+	}
+
+	if instanceKey != nil {
+		record.InstanceGuid = instanceKey.InstanceGuid
+		record.CellId = instanceKey.CellId
+	}
+
+	if after != nil {
+		record.State = after.State
+		record.CrashCount = after.CrashCount
+	}
+
+	return record
+}
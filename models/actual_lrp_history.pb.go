@@ -0,0 +1,926 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: actual_lrp_history.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ActualLRPHistoryRecord_Transition int32
+
+const (
+	ActualLRPHistoryRecord_Claimed   ActualLRPHistoryRecord_Transition = 0
+	ActualLRPHistoryRecord_Started   ActualLRPHistoryRecord_Transition = 1
+	ActualLRPHistoryRecord_Crashed   ActualLRPHistoryRecord_Transition = 2
+	ActualLRPHistoryRecord_Failed    ActualLRPHistoryRecord_Transition = 3
+	ActualLRPHistoryRecord_Evacuated ActualLRPHistoryRecord_Transition = 4
+	ActualLRPHistoryRecord_Removed   ActualLRPHistoryRecord_Transition = 5
+)
+
+var ActualLRPHistoryRecord_Transition_name = map[int32]string{
+	0: "Claimed",
+	1: "Started",
+	2: "Crashed",
+	3: "Failed",
+	4: "Evacuated",
+	5: "Removed",
+}
+
+var ActualLRPHistoryRecord_Transition_value = map[string]int32{
+	"Claimed":   0,
+	"Started":   1,
+	"Crashed":   2,
+	"Failed":    3,
+	"Evacuated": 4,
+	"Removed":   5,
+}
+
+func (ActualLRPHistoryRecord_Transition) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_cc4bd16005901eb4, []int{0, 0}
+}
+
+// ActualLRPHistoryRecord is a transition of an instance of an ActualLRP,
+// recorded by the lifecycle and evacuation controllers once it is made.
+// state is the state of the ActualLRP after the transition, empty when the
+// instance was removed.
+type ActualLRPHistoryRecord struct {
+	Id           int64                             `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	ProcessGuid  string                            `protobuf:"bytes,2,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index        int32                             `protobuf:"varint,3,opt,name=index,proto3" json:"index"`
+	InstanceGuid string                            `protobuf:"bytes,4,opt,name=instance_guid,json=instanceGuid,proto3" json:"instance_guid,omitempty"`
+	CellId       string                            `protobuf:"bytes,5,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Transition   ActualLRPHistoryRecord_Transition `protobuf:"varint,6,opt,name=transition,proto3,enum=models.ActualLRPHistoryRecord_Transition" json:"transition"`
+	State        string                            `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Reason       string                            `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+	CrashCount   int32                             `protobuf:"varint,9,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
+	CreatedAt    int64                             `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	TraceId      string                            `protobuf:"bytes,11,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+}
+
+func (m *ActualLRPHistoryRecord) Reset()      { *m = ActualLRPHistoryRecord{} }
+func (*ActualLRPHistoryRecord) ProtoMessage() {}
+func (*ActualLRPHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4bd16005901eb4, []int{0}
+}
+func (m *ActualLRPHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPHistoryRecord.Merge(m, src)
+}
+func (m *ActualLRPHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPHistoryRecord proto.InternalMessageInfo
+
+func (m *ActualLRPHistoryRecord) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ActualLRPHistoryRecord) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRecord) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActualLRPHistoryRecord) GetInstanceGuid() string {
+	if m != nil {
+		return m.InstanceGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRecord) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRecord) GetTransition() ActualLRPHistoryRecord_Transition {
+	if m != nil {
+		return m.Transition
+	}
+	return ActualLRPHistoryRecord_Claimed
+}
+
+func (m *ActualLRPHistoryRecord) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRecord) GetCrashCount() int32 {
+	if m != nil {
+		return m.CrashCount
+	}
+	return 0
+}
+
+func (m *ActualLRPHistoryRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ActualLRPHistoryRecord) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("models.ActualLRPHistoryRecord_Transition", ActualLRPHistoryRecord_Transition_name, ActualLRPHistoryRecord_Transition_value)
+	proto.RegisterType((*ActualLRPHistoryRecord)(nil), "models.ActualLRPHistoryRecord")
+}
+
+func init() { proto.RegisterFile("actual_lrp_history.proto", fileDescriptor_cc4bd16005901eb4) }
+
+var fileDescriptor_cc4bd16005901eb4 = []byte{
+	// 531 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0xbd, 0x69, 0xed, 0x34, 0x93, 0xfe, 0x31, 0x0b, 0x04, 0x0b, 0xa4, 0x75, 0xd4, 0x53,
+	0x2a, 0xb5, 0x2e, 0x50, 0x1e, 0x80, 0xa6, 0xe2, 0x4f, 0x25, 0x0e, 0xc8, 0x70, 0x41, 0x1c, 0xac,
+	0x8d, 0x77, 0x49, 0x56, 0xb2, 0xbd, 0x91, 0xbd, 0xae, 0xe8, 0x8d, 0x47, 0xe0, 0x05, 0xb8, 0xf3,
+	0x28, 0x1c, 0x73, 0xec, 0xc9, 0x22, 0xce, 0x05, 0xf9, 0xd4, 0x47, 0x40, 0x5e, 0xa7, 0xd4, 0x95,
+	0x38, 0x79, 0xe6, 0x37, 0x33, 0x9f, 0x3f, 0x7d, 0x36, 0x38, 0x34, 0x54, 0x39, 0x8d, 0x82, 0x28,
+	0x9d, 0x07, 0x33, 0x91, 0x29, 0x99, 0x5e, 0x7a, 0xf3, 0x54, 0x2a, 0x89, 0xad, 0x58, 0x32, 0x1e,
+	0x65, 0x8f, 0x8f, 0xa6, 0x42, 0xcd, 0xf2, 0x89, 0x17, 0xca, 0xf8, 0x78, 0x2a, 0xa7, 0xf2, 0x58,
+	0x8f, 0x27, 0xf9, 0x17, 0xdd, 0xe9, 0x46, 0x57, 0xcd, 0xd9, 0xfe, 0x0f, 0x13, 0x06, 0xa7, 0x5a,
+	0xf3, 0x9d, 0xff, 0xfe, 0x6d, 0xa3, 0xe8, 0xf3, 0x50, 0xa6, 0x0c, 0x0f, 0xa0, 0x23, 0x98, 0x83,
+	0x86, 0x68, 0xb4, 0x31, 0xb6, 0xaa, 0xc2, 0xed, 0x08, 0xe6, 0x77, 0x04, 0xc3, 0x27, 0xb0, 0x3d,
+	0x4f, 0x65, 0xc8, 0xb3, 0x2c, 0x98, 0xe6, 0x82, 0x39, 0x9d, 0x21, 0x1a, 0xf5, 0xc6, 0x76, 0x55,
+	0xb8, 0x77, 0xb8, 0xdf, 0x5f, 0x77, 0x6f, 0x72, 0xc1, 0xb0, 0x0b, 0xa6, 0x48, 0x18, 0xff, 0xea,
+	0x6c, 0x0c, 0xd1, 0xc8, 0x1c, 0xf7, 0xaa, 0xc2, 0x6d, 0x80, 0xdf, 0x3c, 0xf0, 0x4b, 0xd8, 0x11,
+	0x49, 0xa6, 0x68, 0x12, 0xf2, 0x46, 0x76, 0x53, 0xcb, 0x3e, 0xa9, 0x0a, 0xf7, 0xd1, 0x9d, 0xc1,
+	0xa1, 0x8c, 0x85, 0xe2, 0xf1, 0x5c, 0x5d, 0xfa, 0xdb, 0x37, 0x03, 0xfd, 0x0a, 0x0f, 0xba, 0x21,
+	0x8f, 0xa2, 0x40, 0x30, 0xc7, 0xd4, 0xb7, 0x0f, 0xab, 0xc2, 0xbd, 0xb7, 0x46, 0xad, 0x2b, 0xab,
+	0x46, 0xe7, 0x0c, 0x7f, 0x02, 0x50, 0x29, 0x4d, 0x32, 0xa1, 0x84, 0x4c, 0x1c, 0x6b, 0x88, 0x46,
+	0xbb, 0xcf, 0x0f, 0xbc, 0x26, 0x46, 0xef, 0xff, 0x99, 0x78, 0x1f, 0xff, 0x1d, 0x8c, 0x77, 0xab,
+	0xc2, 0x6d, 0x09, 0xf8, 0xad, 0x1a, 0x1f, 0x80, 0x99, 0x29, 0xaa, 0xb8, 0xd3, 0xd5, 0x46, 0xee,
+	0x57, 0x85, 0xbb, 0xa7, 0x41, 0xcb, 0x46, 0xb3, 0x81, 0x0f, 0xc1, 0x4a, 0x39, 0xcd, 0x64, 0xe2,
+	0x6c, 0xe9, 0xdd, 0x07, 0x55, 0xe1, 0xda, 0x0d, 0x69, 0x7b, 0x6e, 0x08, 0x7e, 0x0a, 0xfd, 0x30,
+	0xa5, 0xd9, 0x2c, 0x08, 0x65, 0x9e, 0x28, 0xa7, 0xa7, 0xc3, 0xdc, 0xab, 0x0a, 0xb7, 0x8d, 0x7d,
+	0xd0, 0xcd, 0x59, 0x5d, 0xe3, 0x23, 0x80, 0x30, 0xe5, 0x54, 0x71, 0x16, 0x50, 0xe5, 0x80, 0xfe,
+	0x9a, 0xda, 0xfa, 0x2d, 0xf5, 0x7b, 0xeb, 0xfa, 0x54, 0xe1, 0x67, 0xb0, 0xa5, 0x52, 0x1a, 0xf2,
+	0x3a, 0xc5, 0xbe, 0x36, 0x34, 0xa8, 0x0a, 0x17, 0xdf, 0xb0, 0x96, 0xa5, 0xae, 0x66, 0xe7, 0x6c,
+	0xff, 0x33, 0xc0, 0x6d, 0x2c, 0xb8, 0x0f, 0xdd, 0xb3, 0x88, 0x8a, 0x98, 0x33, 0xdb, 0xa8, 0x9b,
+	0x0f, 0x8a, 0xa6, 0x8a, 0x33, 0x1b, 0xe9, 0x49, 0xed, 0x8b, 0x33, 0xbb, 0x83, 0x01, 0xac, 0xd7,
+	0x54, 0x44, 0x9c, 0xd9, 0x1b, 0x78, 0x07, 0x7a, 0xaf, 0x2e, 0x68, 0x98, 0xd7, 0x16, 0xec, 0xcd,
+	0x7a, 0xcf, 0xe7, 0xb1, 0xbc, 0xe0, 0xcc, 0x36, 0xc7, 0x2f, 0x16, 0x4b, 0x82, 0xae, 0x96, 0xc4,
+	0xb8, 0x5e, 0x12, 0xf4, 0xad, 0x24, 0xe8, 0x67, 0x49, 0xd0, 0xaf, 0x92, 0xa0, 0x45, 0x49, 0xd0,
+	0xef, 0x92, 0xa0, 0x3f, 0x25, 0x31, 0xae, 0x4b, 0x82, 0xbe, 0xaf, 0x88, 0xb1, 0x58, 0x11, 0xe3,
+	0x6a, 0x45, 0x8c, 0x89, 0xa5, 0x7f, 0xee, 0x93, 0xbf, 0x03, 0x00, 0x29, 0xf3, 0xe1, 0x9e, 0x2f,
+	0x03, 0x00, 0x00,
+}
+
+func (x ActualLRPHistoryRecord_Transition) String() string {
+	s, ok := ActualLRPHistoryRecord_Transition_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ActualLRPHistoryRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPHistoryRecord)
+	if !ok {
+		that2, ok := that.(ActualLRPHistoryRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.InstanceGuid != that1.InstanceGuid {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.Transition != that1.Transition {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if this.CrashCount != that1.CrashCount {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPHistoryRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 15)
+	s = append(s, "&models.ActualLRPHistoryRecord{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "InstanceGuid: "+fmt.Sprintf("%#v", this.InstanceGuid)+",\n")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Transition: "+fmt.Sprintf("%#v", this.Transition)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
+	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringActualLrpHistory(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ActualLRPHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CrashCount != 0 {
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(m.CrashCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Transition != 0 {
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(m.Transition))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InstanceGuid) > 0 {
+		i -= len(m.InstanceGuid)
+		copy(dAtA[i:], m.InstanceGuid)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.InstanceGuid)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintActualLrpHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActualLrpHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActualLrpHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActualLRPHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovActualLrpHistory(uint64(m.Id))
+	}
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpHistory(uint64(m.Index))
+	}
+	l = len(m.InstanceGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	if m.Transition != 0 {
+		n += 1 + sovActualLrpHistory(uint64(m.Transition))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	if m.CrashCount != 0 {
+		n += 1 + sovActualLrpHistory(uint64(m.CrashCount))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovActualLrpHistory(uint64(m.CreatedAt))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistory(uint64(l))
+	}
+	return n
+}
+
+func sovActualLrpHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozActualLrpHistory(x uint64) (n int) {
+	return sovActualLrpHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ActualLRPHistoryRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPHistoryRecord{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`InstanceGuid:` + fmt.Sprintf("%v", this.InstanceGuid) + `,`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Transition:` + fmt.Sprintf("%v", this.Transition) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`CrashCount:` + fmt.Sprintf("%v", this.CrashCount) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringActualLrpHistory(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			m.Transition = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Transition |= ActualLRPHistoryRecord_Transition(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipActualLrpHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowActualLrpHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthActualLrpHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupActualLrpHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthActualLrpHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthActualLrpHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowActualLrpHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupActualLrpHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,19 @@
+package models
+
+func (request *ActualLRPHistoryRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.Index < 0 {
+		validationError = validationError.Append(ErrInvalidField{"index"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
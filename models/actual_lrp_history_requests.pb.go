@@ -0,0 +1,731 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: actual_lrp_history_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ActualLRPHistoryRequest lists the history of the instances at the index of
+// the process, oldest first.
+type ActualLRPHistoryRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Index       int32  `protobuf:"varint,2,opt,name=index,proto3" json:"index"`
+}
+
+func (m *ActualLRPHistoryRequest) Reset()      { *m = ActualLRPHistoryRequest{} }
+func (*ActualLRPHistoryRequest) ProtoMessage() {}
+func (*ActualLRPHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97cf4413c360eb1b, []int{0}
+}
+func (m *ActualLRPHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPHistoryRequest.Merge(m, src)
+}
+func (m *ActualLRPHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPHistoryRequest proto.InternalMessageInfo
+
+func (m *ActualLRPHistoryRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *ActualLRPHistoryRequest) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+type ActualLRPHistoryResponse struct {
+	Error   *Error                    `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Records []*ActualLRPHistoryRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+}
+
+func (m *ActualLRPHistoryResponse) Reset()      { *m = ActualLRPHistoryResponse{} }
+func (*ActualLRPHistoryResponse) ProtoMessage() {}
+func (*ActualLRPHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_97cf4413c360eb1b, []int{1}
+}
+func (m *ActualLRPHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActualLRPHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActualLRPHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActualLRPHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActualLRPHistoryResponse.Merge(m, src)
+}
+func (m *ActualLRPHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActualLRPHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActualLRPHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActualLRPHistoryResponse proto.InternalMessageInfo
+
+func (m *ActualLRPHistoryResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ActualLRPHistoryResponse) GetRecords() []*ActualLRPHistoryRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ActualLRPHistoryRequest)(nil), "models.ActualLRPHistoryRequest")
+	proto.RegisterType((*ActualLRPHistoryResponse)(nil), "models.ActualLRPHistoryResponse")
+}
+
+func init() { proto.RegisterFile("actual_lrp_history_requests.proto", fileDescriptor_97cf4413c360eb1b) }
+
+var fileDescriptor_97cf4413c360eb1b = []byte{
+	// 306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4e, 0x02, 0x31,
+	0x1c, 0xc6, 0x5b, 0x0c, 0x18, 0x7a, 0x9a, 0x98, 0x5b, 0xbc, 0x30, 0xfc, 0x41, 0x5c, 0x58, 0x3c,
+	0x12, 0x70, 0x70, 0x95, 0xc4, 0xe8, 0xe0, 0x60, 0xfa, 0x02, 0x17, 0xb8, 0xab, 0xc7, 0x25, 0xc0,
+	0xff, 0x6c, 0xaf, 0x89, 0x6c, 0x3e, 0x82, 0x8f, 0xe1, 0xa3, 0x38, 0x32, 0x32, 0x11, 0x29, 0x8b,
+	0x61, 0xe2, 0x11, 0x0c, 0x2d, 0x0c, 0xe6, 0xa6, 0xf6, 0xfb, 0x7e, 0x5f, 0xfb, 0xb5, 0x7f, 0x76,
+	0x35, 0x8c, 0x0b, 0x3d, 0x9c, 0x44, 0x13, 0x99, 0x47, 0xe3, 0x4c, 0x15, 0x28, 0xe7, 0x91, 0x14,
+	0x6f, 0x5a, 0xa8, 0x42, 0x85, 0xb9, 0xc4, 0x02, 0xfd, 0xda, 0x14, 0x13, 0x31, 0x51, 0x8d, 0x9b,
+	0x34, 0x2b, 0xc6, 0x7a, 0x14, 0xc6, 0x38, 0xed, 0xa6, 0x98, 0x62, 0xd7, 0xe2, 0x91, 0x7e, 0xb5,
+	0xca, 0x0a, 0xbb, 0x73, 0xc7, 0x1a, 0x9e, 0x90, 0x12, 0xe5, 0x41, 0x04, 0xe5, 0x1a, 0x47, 0xda,
+	0xc8, 0x2e, 0xef, 0x2d, 0x7b, 0xe6, 0x2f, 0x4f, 0x8e, 0x70, 0xd7, 0xef, 0xf7, 0xd9, 0x59, 0x2e,
+	0x31, 0x16, 0x4a, 0x45, 0xa9, 0xce, 0x92, 0x80, 0xb6, 0x68, 0xa7, 0x3e, 0xb8, 0xd8, 0xae, 0x9a,
+	0xff, 0x7c, 0xee, 0x1d, 0xd4, 0xa3, 0xce, 0x12, 0xbf, 0xc9, 0xaa, 0xd9, 0x2c, 0x11, 0xef, 0x41,
+	0xa5, 0x45, 0x3b, 0xd5, 0x41, 0x7d, 0xbb, 0x6a, 0x3a, 0x83, 0xbb, 0xa5, 0x3d, 0x67, 0x41, 0xb9,
+	0x50, 0xe5, 0x38, 0x53, 0xc2, 0xbf, 0x66, 0x55, 0xfb, 0x6a, 0x5b, 0xe5, 0xf5, 0xce, 0x43, 0xf7,
+	0xf5, 0xf0, 0x61, 0x6f, 0x72, 0xc7, 0xfc, 0x3b, 0x76, 0x2a, 0x45, 0x8c, 0x32, 0x51, 0x41, 0xa5,
+	0x75, 0xd2, 0xf1, 0x7a, 0x70, 0x8c, 0x95, 0xef, 0xdd, 0xc7, 0xf8, 0x31, 0x3e, 0xb8, 0x5d, 0xac,
+	0x81, 0x2c, 0xd7, 0x40, 0x76, 0x6b, 0xa0, 0x1f, 0x06, 0xe8, 0x97, 0x01, 0xfa, 0x6d, 0x80, 0x2e,
+	0x0c, 0xd0, 0x1f, 0x03, 0xf4, 0xd7, 0x00, 0xd9, 0x19, 0xa0, 0x9f, 0x1b, 0x20, 0x8b, 0x0d, 0x90,
+	0xe5, 0x06, 0xc8, 0xa8, 0x66, 0x07, 0xd5, 0xff, 0x1b, 0x00, 0x02, 0x05, 0xb7, 0x3d, 0xab, 0x01,
+	0x00, 0x00,
+}
+
+func (this *ActualLRPHistoryRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPHistoryRequest)
+	if !ok {
+		that2, ok := that.(ActualLRPHistoryRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	return true
+}
+func (this *ActualLRPHistoryResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ActualLRPHistoryResponse)
+	if !ok {
+		that2, ok := that.(ActualLRPHistoryResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Records) != len(that1.Records) {
+		return false
+	}
+	for i := range this.Records {
+		if !this.Records[i].Equal(that1.Records[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ActualLRPHistoryRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPHistoryRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ActualLRPHistoryResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ActualLRPHistoryResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Records != nil {
+		s = append(s, "Records: "+fmt.Sprintf("%#v", this.Records)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringActualLrpHistoryRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ActualLRPHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintActualLrpHistoryRequests(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintActualLrpHistoryRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActualLRPHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActualLRPHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActualLRPHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintActualLrpHistoryRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintActualLrpHistoryRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintActualLrpHistoryRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovActualLrpHistoryRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ActualLRPHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovActualLrpHistoryRequests(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovActualLrpHistoryRequests(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *ActualLRPHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovActualLrpHistoryRequests(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovActualLrpHistoryRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovActualLrpHistoryRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozActualLrpHistoryRequests(x uint64) (n int) {
+	return sovActualLrpHistoryRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ActualLRPHistoryRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ActualLRPHistoryRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ActualLRPHistoryResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecords := "[]*ActualLRPHistoryRecord{"
+	for _, f := range this.Records {
+		repeatedStringForRecords += strings.Replace(fmt.Sprintf("%v", f), "ActualLRPHistoryRecord", "ActualLRPHistoryRecord", 1) + ","
+	}
+	repeatedStringForRecords += "}"
+	s := strings.Join([]string{`&ActualLRPHistoryResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Records:` + repeatedStringForRecords + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringActualLrpHistoryRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ActualLRPHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpHistoryRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpHistoryRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ActualLRPHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowActualLrpHistoryRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ActualLRPHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ActualLRPHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, &ActualLRPHistoryRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipActualLrpHistoryRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthActualLrpHistoryRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipActualLrpHistoryRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowActualLrpHistoryRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowActualLrpHistoryRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthActualLrpHistoryRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupActualLrpHistoryRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthActualLrpHistoryRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthActualLrpHistoryRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowActualLrpHistoryRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupActualLrpHistoryRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -3,7 +3,7 @@ package models
 import "encoding/json"
 
 func (request *ActualLRPsRequest) Validate() error {
-	return nil
+	return validatePagination(request.PageSize, request.PageToken).ToError()
 }
 
 func (request *ActualLRPsRequest) SetIndex(index int32) {
@@ -20,6 +20,8 @@ type internalActualLRPsRequest struct {
 	CellId      string `json:"cell_id"`
 	ProcessGuid string `json:"process_guid"`
 	Index       *int32 `json:"index,omitempty"`
+	PageSize    int32  `json:"page_size,omitempty"`
+	PageToken   string `json:"page_token,omitempty"`
 }
 
 func (request *ActualLRPsRequest) UnmarshalJSON(data []byte) error {
@@ -31,6 +33,8 @@ func (request *ActualLRPsRequest) UnmarshalJSON(data []byte) error {
 	request.Domain = internalRequest.Domain
 	request.CellId = internalRequest.CellId
 	request.ProcessGuid = internalRequest.ProcessGuid
+	request.PageSize = internalRequest.PageSize
+	request.PageToken = internalRequest.PageToken
 	if internalRequest.Index != nil {
 		request.SetIndex(*internalRequest.Index)
 	}
@@ -43,6 +47,8 @@ func (request ActualLRPsRequest) MarshalJSON() ([]byte, error) {
 		Domain:      request.Domain,
 		CellId:      request.CellId,
 		ProcessGuid: request.ProcessGuid,
+		PageSize:    request.PageSize,
+		PageToken:   request.PageToken,
 	}
 
 	if request.IndexExists() {
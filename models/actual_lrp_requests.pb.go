@@ -715,8 +715,10 @@ func (m *RemoveActualLRPRequest) GetActualLrpInstanceKey() *ActualLRPInstanceKey
 }
 
 type ActualLRPsResponse struct {
-	Error      *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	ActualLrps []*ActualLRP `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	Error           *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ActualLrps      []*ActualLRP `protobuf:"bytes,2,rep,name=actual_lrps,json=actualLrps,proto3" json:"actual_lrps,omitempty"`
+	NextPageToken   string       `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ResourceVersion uint64       `protobuf:"varint,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPsResponse) Reset()      { *m = ActualLRPsResponse{} }
@@ -765,6 +767,20 @@ func (m *ActualLRPsResponse) GetActualLrps() []*ActualLRP {
 	return nil
 }
 
+func (m *ActualLRPsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ActualLRPsResponse) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type ActualLRPsRequest struct {
 	Domain      string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
 	CellId      string `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
@@ -772,6 +788,8 @@ type ActualLRPsRequest struct {
 	// Types that are valid to be assigned to OptionalIndex:
 	//	*ActualLRPsRequest_Index
 	OptionalIndex isActualLRPsRequest_OptionalIndex `protobuf_oneof:"optional_index"`
+	PageSize      int32                             `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                            `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 }
 
 func (m *ActualLRPsRequest) Reset()      { *m = ActualLRPsRequest{} }
@@ -854,6 +872,20 @@ func (m *ActualLRPsRequest) GetIndex() int32 {
 	return 0
 }
 
+func (m *ActualLRPsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ActualLRPsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ActualLRPsRequest) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -978,63 +1010,68 @@ func init() {
 func init() { proto.RegisterFile("actual_lrp_requests.proto", fileDescriptor_a7753fd8557db809) }
 
 var fileDescriptor_a7753fd8557db809 = []byte{
-	// 888 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xce, 0x24, 0x9b, 0xb4, 0x79, 0xd9, 0xdd, 0x26, 0xee, 0xfe, 0x30, 0x51, 0xe5, 0x04, 0x97,
-	0xc3, 0x0a, 0xa9, 0xa9, 0xb4, 0x45, 0x15, 0x5a, 0x09, 0x89, 0x35, 0x2a, 0xdb, 0x55, 0xb7, 0x55,
-	0x35, 0xed, 0x05, 0x90, 0xb0, 0x9c, 0x64, 0x92, 0x8e, 0xb0, 0x3d, 0xc1, 0x33, 0x5e, 0x11, 0xb8,
-	0x20, 0x21, 0xf5, 0xc0, 0x89, 0x3f, 0x83, 0xbf, 0x03, 0x0e, 0x70, 0xdc, 0x03, 0x87, 0x9e, 0xa2,
-	0x6e, 0xf6, 0x82, 0x72, 0xea, 0x9f, 0x80, 0x3c, 0x13, 0x3b, 0x76, 0xdc, 0xa2, 0x5d, 0x58, 0x10,
-	0x9c, 0xec, 0xf7, 0xcd, 0x9b, 0xef, 0xfb, 0xde, 0xf3, 0x1b, 0xdb, 0xf0, 0x96, 0xd3, 0x13, 0xa1,
-	0xe3, 0xda, 0x6e, 0x30, 0xb2, 0x03, 0xf2, 0x65, 0x48, 0xb8, 0xe0, 0x9d, 0x51, 0xc0, 0x04, 0xd3,
-	0x2a, 0x1e, 0xeb, 0x13, 0x97, 0x37, 0x6f, 0x0d, 0xa9, 0x78, 0x16, 0x76, 0x3b, 0x3d, 0xe6, 0xdd,
-	0x1e, 0xb2, 0x21, 0xbb, 0x2d, 0x97, 0xbb, 0xe1, 0x40, 0x46, 0x32, 0x90, 0x77, 0x6a, 0x5b, 0xb3,
-	0xbe, 0x60, 0x9c, 0x23, 0x35, 0x12, 0x04, 0x2c, 0x50, 0x81, 0xb9, 0x0f, 0xcd, 0x7d, 0x99, 0x70,
-	0x84, 0x1f, 0x1f, 0xd1, 0x01, 0xe9, 0x8d, 0x7b, 0x2e, 0xc1, 0x84, 0x8f, 0x98, 0xcf, 0x89, 0x76,
-	0x13, 0xca, 0x32, 0x59, 0x47, 0x6d, 0xb4, 0x53, 0xdb, 0x5d, 0xeb, 0x28, 0x0f, 0x9d, 0x7b, 0x11,
-	0x88, 0xd5, 0x9a, 0xf9, 0x1c, 0xc1, 0x76, 0xc2, 0x71, 0x10, 0xb0, 0x70, 0xc4, 0x2f, 0x44, 0xa0,
-	0x59, 0xd0, 0x48, 0x95, 0x3d, 0x94, 0x0c, 0x7a, 0xb1, 0x5d, 0xda, 0xa9, 0xed, 0x6e, 0xc5, 0x1b,
-	0xb2, 0x02, 0xf8, 0x9a, 0xda, 0x70, 0x14, 0x8c, 0x94, 0xe0, 0x5e, 0x51, 0x47, 0xe6, 0x77, 0x08,
-	0xb6, 0x96, 0xf2, 0x2e, 0xe4, 0xe3, 0x43, 0xa8, 0x2f, 0xfb, 0xd0, 0x8b, 0x32, 0xff, 0x4d, 0x36,
-	0xd6, 0xb3, 0x36, 0xa4, 0x8b, 0xc1, 0xb2, 0x09, 0x8e, 0xd5, 0x83, 0xd4, 0x4c, 0xa8, 0xf4, 0x99,
-	0xe7, 0x50, 0x5f, 0xba, 0xa8, 0x5a, 0x30, 0x9b, 0xb4, 0xe6, 0x08, 0x9e, 0x5f, 0xb5, 0x77, 0xe0,
-	0x4a, 0x8f, 0xb8, 0xae, 0x4d, 0xfb, 0x52, 0xba, 0x6a, 0xd5, 0x66, 0x93, 0x56, 0x0c, 0xe1, 0x4a,
-	0x74, 0x73, 0xd8, 0x97, 0x3a, 0x9f, 0xc3, 0xcd, 0x25, 0x1d, 0x6b, 0xfc, 0x38, 0x60, 0x3d, 0xc2,
-	0xf9, 0x41, 0x48, 0xfb, 0xb1, 0xe8, 0x1d, 0x58, 0x1d, 0x29, 0xd4, 0x1e, 0x86, 0xb4, 0x3f, 0x97,
-	0xae, 0xcf, 0x26, 0xad, 0x0c, 0x8e, 0x6b, 0xa3, 0xc5, 0x5e, 0xc9, 0xff, 0x1c, 0xc1, 0xbb, 0x59,
-	0x81, 0x0c, 0xff, 0xbe, 0xdf, 0x3f, 0xf4, 0xfb, 0xe4, 0xab, 0xbf, 0xa3, 0xa3, 0xb5, 0xa0, 0x4c,
-	0x23, 0x12, 0x59, 0x6b, 0xd9, 0xaa, 0xce, 0x26, 0x2d, 0x05, 0x60, 0x75, 0x91, 0x46, 0x7e, 0x42,
-	0xb0, 0xf9, 0x91, 0xeb, 0x50, 0x2f, 0x71, 0xf3, 0x8f, 0x6a, 0x6a, 0x4f, 0x60, 0x3b, 0x35, 0x06,
-	0xd4, 0xe7, 0xc2, 0xf1, 0x7b, 0xc4, 0xfe, 0x82, 0x8c, 0xf5, 0x92, 0x9c, 0x86, 0x1b, 0xb9, 0x69,
-	0x38, 0x9c, 0x27, 0x3d, 0x20, 0x63, 0xbc, 0x91, 0xcc, 0x44, 0x0a, 0x35, 0x7f, 0x5b, 0x81, 0xcd,
-	0x27, 0xc2, 0x09, 0x44, 0xae, 0x88, 0x3d, 0x58, 0x4f, 0xc9, 0x45, 0x2a, 0x6a, 0x46, 0x37, 0x72,
-	0x2a, 0x11, 0xfb, 0x6a, 0xc2, 0xfe, 0x80, 0x8c, 0xff, 0xcc, 0x6a, 0xf1, 0xaf, 0x5a, 0xd5, 0x0e,
-	0xe0, 0x7a, 0x8a, 0xd4, 0x27, 0xc2, 0xa6, 0xfe, 0x80, 0xcd, 0x6b, 0xd7, 0x73, 0x84, 0x8f, 0x88,
-	0x38, 0xf4, 0x07, 0x0c, 0xd7, 0x13, 0xb2, 0x39, 0xa2, 0x7d, 0x06, 0xcd, 0x8c, 0x3b, 0x41, 0x02,
-	0xdf, 0x71, 0xed, 0x80, 0x85, 0x82, 0x70, 0x7d, 0x45, 0x1e, 0x70, 0xe3, 0x35, 0x06, 0x55, 0x1e,
-	0x8e, 0xd2, 0xf0, 0x76, 0xca, 0x62, 0x0a, 0xe7, 0xda, 0x23, 0xa8, 0x79, 0x44, 0x04, 0xb4, 0x67,
-	0x0b, 0x67, 0xc8, 0xf5, 0xb2, 0x64, 0xbb, 0x15, 0xb3, 0xbd, 0xb6, 0xd5, 0x9d, 0x87, 0x72, 0xc3,
-	0x53, 0x67, 0xc8, 0xef, 0xf9, 0x22, 0x18, 0x63, 0xf0, 0x12, 0x40, 0xbb, 0x01, 0x57, 0x23, 0x66,
-	0xa7, 0xeb, 0x12, 0xbd, 0xd2, 0x46, 0x3b, 0x57, 0xef, 0x17, 0x70, 0x82, 0xc8, 0x57, 0xd4, 0xb1,
-	0x43, 0x5d, 0xa7, 0x4b, 0x5d, 0x2a, 0xc6, 0xf6, 0xd7, 0xcc, 0x27, 0xfa, 0x15, 0x39, 0x6e, 0x9b,
-	0xb3, 0x49, 0x2b, 0xbf, 0x88, 0xeb, 0x69, 0xe8, 0x53, 0xe6, 0x93, 0xe6, 0x07, 0x70, 0x6d, 0xc9,
-	0x80, 0x56, 0x87, 0x52, 0xfc, 0xc0, 0xab, 0x38, 0xba, 0xd5, 0x36, 0xa0, 0x7c, 0xec, 0xb8, 0x21,
-	0x51, 0xa7, 0x1f, 0xab, 0x60, 0xaf, 0xf8, 0x3e, 0xb2, 0xae, 0x43, 0x83, 0x8d, 0x04, 0x65, 0x71,
-	0x0b, 0x23, 0x5f, 0xe6, 0xcb, 0xe8, 0x6c, 0x04, 0x0e, 0x7f, 0xf6, 0xdf, 0x1f, 0xab, 0xbb, 0xb0,
-	0x26, 0x5f, 0xb3, 0xb6, 0x47, 0x38, 0x77, 0x86, 0x44, 0x0e, 0x54, 0xd5, 0x6a, 0xcc, 0x26, 0xad,
-	0xec, 0x02, 0x5e, 0x95, 0xe1, 0x43, 0x15, 0x99, 0xdf, 0x23, 0xd8, 0xf8, 0xd8, 0xa1, 0xee, 0xa5,
-	0x56, 0x98, 0x33, 0x53, 0x3c, 0x9f, 0x99, 0xa7, 0xb0, 0x85, 0x89, 0xa0, 0x01, 0xb9, 0x4c, 0x37,
-	0xe6, 0xcf, 0x28, 0xa2, 0xf5, 0xd8, 0x31, 0xf9, 0x3f, 0xbf, 0xe2, 0x3c, 0xd0, 0x92, 0xec, 0x0b,
-	0xfe, 0x01, 0xec, 0x42, 0x6d, 0xe1, 0x27, 0xfe, 0xf6, 0x37, 0x72, 0x1e, 0x30, 0x24, 0xc2, 0xdc,
-	0xfc, 0x05, 0x41, 0x23, 0xad, 0x77, 0xc9, 0xdf, 0xd8, 0x5c, 0xe7, 0x4b, 0xe7, 0xe9, 0xfc, 0xdb,
-	0x71, 0xe7, 0x57, 0x96, 0x3a, 0x7f, 0xbf, 0x30, 0xef, 0xbd, 0x55, 0x87, 0xf5, 0xe4, 0x1c, 0x4b,
-	0xc4, 0xfc, 0x06, 0xda, 0x8b, 0x42, 0x32, 0x1f, 0xd9, 0x7f, 0xa1, 0x8d, 0x9f, 0x40, 0xeb, 0xcd,
-	0xe2, 0xaa, 0xa7, 0x77, 0x61, 0x2d, 0x5d, 0x31, 0xd7, 0x51, 0xbb, 0x14, 0x1f, 0x96, 0xcc, 0x02,
-	0x5e, 0x4d, 0xf5, 0x82, 0x5b, 0xef, 0x9d, 0x9c, 0x1a, 0x85, 0x17, 0xa7, 0x46, 0xe1, 0xd5, 0xa9,
-	0x81, 0xbe, 0x9d, 0x1a, 0xe8, 0xc7, 0xa9, 0x81, 0x7e, 0x9d, 0x1a, 0xe8, 0x64, 0x6a, 0xa0, 0x97,
-	0x53, 0x03, 0xfd, 0x3e, 0x35, 0x0a, 0xaf, 0xa6, 0x06, 0xfa, 0xe1, 0xcc, 0x28, 0x9c, 0x9c, 0x19,
-	0x85, 0x17, 0x67, 0x46, 0xa1, 0x5b, 0x91, 0x3f, 0xa6, 0x77, 0xfe, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0xa7, 0xd6, 0xe4, 0x0b, 0x0b, 0x00, 0x00,
+	// 971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0xd5, 0xca, 0x92, 0x62, 0x8d, 0xfc, 0x21, 0x31, 0xfe, 0x60, 0xd5, 0x94, 0x52, 0x99, 0xa2,
+	0x70, 0x0b, 0x44, 0x01, 0x9c, 0x22, 0x28, 0x0c, 0x14, 0xa8, 0x55, 0xa4, 0x8e, 0x11, 0x27, 0x30,
+	0xd6, 0x46, 0x81, 0xb6, 0x40, 0x09, 0x4a, 0x1a, 0x31, 0x8b, 0x50, 0x5c, 0x96, 0x4b, 0x1a, 0x91,
+	0x7b, 0x29, 0x10, 0x20, 0x87, 0x9e, 0xfa, 0x33, 0xfa, 0x33, 0x8a, 0xf6, 0xd2, 0xa3, 0x0f, 0x3d,
+	0xe4, 0x24, 0xc4, 0xf2, 0xa5, 0xd0, 0x29, 0x3f, 0xa1, 0xe0, 0x52, 0xa4, 0x29, 0x29, 0x0d, 0xe2,
+	0xd6, 0x01, 0x9a, 0x13, 0xb9, 0x6f, 0x67, 0xdf, 0x7b, 0x3b, 0x33, 0xbb, 0x24, 0xbc, 0x63, 0xb6,
+	0xfd, 0xc0, 0xb4, 0x0d, 0xdb, 0x73, 0x0d, 0x0f, 0xbf, 0x0f, 0x50, 0xf8, 0xa2, 0xe1, 0x7a, 0xdc,
+	0xe7, 0x4a, 0xa1, 0xc7, 0x3b, 0x68, 0x8b, 0xea, 0x0d, 0x8b, 0xf9, 0x0f, 0x83, 0x56, 0xa3, 0xcd,
+	0x7b, 0x37, 0x2d, 0x6e, 0xf1, 0x9b, 0x72, 0xba, 0x15, 0x74, 0xe5, 0x48, 0x0e, 0xe4, 0x5b, 0xb4,
+	0xac, 0x5a, 0x3e, 0x67, 0x1c, 0x23, 0x25, 0xf4, 0x3c, 0xee, 0x45, 0x03, 0x7d, 0x1b, 0xaa, 0xdb,
+	0x32, 0x60, 0x8f, 0xee, 0xef, 0xb1, 0x2e, 0xb6, 0xfb, 0x6d, 0x1b, 0x29, 0x0a, 0x97, 0x3b, 0x02,
+	0x95, 0xeb, 0x90, 0x97, 0xc1, 0x2a, 0xa9, 0x93, 0x8d, 0xd2, 0xe6, 0x62, 0x23, 0xf2, 0xd0, 0xb8,
+	0x13, 0x82, 0x34, 0x9a, 0xd3, 0x9f, 0x12, 0x58, 0x4f, 0x38, 0x76, 0x3c, 0x1e, 0xb8, 0xe2, 0x42,
+	0x04, 0x4a, 0x13, 0x2a, 0xa9, 0x6d, 0x5b, 0x92, 0x41, 0xcd, 0xd6, 0xe7, 0x36, 0x4a, 0x9b, 0x6b,
+	0xf1, 0x82, 0x49, 0x01, 0xba, 0x1c, 0x2d, 0xd8, 0xf3, 0xdc, 0x48, 0x70, 0x2b, 0xab, 0x12, 0xfd,
+	0x09, 0x81, 0xb5, 0xa9, 0xb8, 0x0b, 0xf9, 0xf8, 0x1c, 0xca, 0xd3, 0x3e, 0xd4, 0x6c, 0x9d, 0xbc,
+	0xc2, 0xc6, 0xd2, 0xa4, 0x0d, 0xe9, 0xa2, 0x3b, 0x6d, 0x42, 0xd0, 0xa8, 0x90, 0x8a, 0x0e, 0x85,
+	0x0e, 0xef, 0x99, 0xcc, 0x91, 0x2e, 0x8a, 0x4d, 0x18, 0x0d, 0x6a, 0x63, 0x84, 0x8e, 0x9f, 0xca,
+	0x07, 0x70, 0xa5, 0x8d, 0xb6, 0x6d, 0xb0, 0x8e, 0x94, 0x2e, 0x36, 0x4b, 0xa3, 0x41, 0x2d, 0x86,
+	0x68, 0x21, 0x7c, 0xd9, 0xed, 0x48, 0x9d, 0xef, 0xe0, 0xfa, 0x94, 0x4e, 0xb3, 0xbf, 0xef, 0xf1,
+	0x36, 0x0a, 0xb1, 0x13, 0xb0, 0x4e, 0x2c, 0x7a, 0x0b, 0x16, 0xdc, 0x08, 0x35, 0xac, 0x80, 0x75,
+	0xc6, 0xd2, 0xe5, 0xd1, 0xa0, 0x36, 0x81, 0xd3, 0x92, 0x7b, 0xbe, 0x56, 0xf2, 0x3f, 0x25, 0xf0,
+	0xf1, 0xa4, 0xc0, 0x04, 0xff, 0xb6, 0xd3, 0xd9, 0x75, 0x3a, 0xf8, 0xf8, 0xbf, 0xe8, 0x28, 0x35,
+	0xc8, 0xb3, 0x90, 0x44, 0xee, 0x35, 0xdf, 0x2c, 0x8e, 0x06, 0xb5, 0x08, 0xa0, 0xd1, 0x43, 0x1a,
+	0xf9, 0x8d, 0xc0, 0xea, 0x17, 0xb6, 0xc9, 0x7a, 0x89, 0x9b, 0x37, 0xaa, 0xa9, 0x1c, 0xc0, 0x7a,
+	0xaa, 0x0d, 0x98, 0x23, 0x7c, 0xd3, 0x69, 0xa3, 0xf1, 0x08, 0xfb, 0xea, 0x9c, 0xec, 0x86, 0x6b,
+	0x33, 0xdd, 0xb0, 0x3b, 0x0e, 0xba, 0x87, 0x7d, 0xba, 0x92, 0xf4, 0x44, 0x0a, 0xd5, 0xff, 0xcc,
+	0xc1, 0xea, 0x81, 0x6f, 0x7a, 0xfe, 0xcc, 0x26, 0xb6, 0x60, 0x29, 0x25, 0x17, 0xaa, 0x44, 0x3d,
+	0xba, 0x32, 0xa3, 0x12, 0xb2, 0x2f, 0x24, 0xec, 0xf7, 0xb0, 0xff, 0x2a, 0xab, 0xd9, 0x7f, 0x6b,
+	0x55, 0xd9, 0x81, 0xab, 0x29, 0x52, 0x07, 0x7d, 0x83, 0x39, 0x5d, 0x3e, 0xde, 0xbb, 0x3a, 0x43,
+	0xf8, 0x00, 0xfd, 0x5d, 0xa7, 0xcb, 0x69, 0x39, 0x21, 0x1b, 0x23, 0xca, 0xb7, 0x50, 0x9d, 0x70,
+	0xe7, 0xa3, 0xe7, 0x98, 0xb6, 0xe1, 0xf1, 0xc0, 0x47, 0xa1, 0xe6, 0xe4, 0x01, 0xd7, 0x5e, 0x62,
+	0x30, 0x8a, 0xa3, 0x61, 0x18, 0x5d, 0x4f, 0x59, 0x4c, 0xe1, 0x42, 0x79, 0x00, 0xa5, 0x1e, 0xfa,
+	0x1e, 0x6b, 0x1b, 0xbe, 0x69, 0x09, 0x35, 0x2f, 0xd9, 0x6e, 0xc4, 0x6c, 0x2f, 0x4d, 0x75, 0xe3,
+	0xbe, 0x5c, 0x70, 0x68, 0x5a, 0xe2, 0x8e, 0xe3, 0x7b, 0x7d, 0x0a, 0xbd, 0x04, 0x50, 0xae, 0xc1,
+	0x7c, 0xc8, 0x6c, 0xb6, 0x6c, 0x54, 0x0b, 0x75, 0xb2, 0x31, 0x7f, 0x37, 0x43, 0x13, 0x44, 0x5e,
+	0x51, 0x47, 0x26, 0xb3, 0xcd, 0x16, 0xb3, 0x99, 0xdf, 0x37, 0x8e, 0xb9, 0x83, 0xea, 0x15, 0xd9,
+	0x6e, 0xab, 0xa3, 0x41, 0x6d, 0x76, 0x92, 0x96, 0xd3, 0xd0, 0x37, 0xdc, 0xc1, 0xea, 0x67, 0xb0,
+	0x3c, 0x65, 0x40, 0x29, 0xc3, 0x5c, 0x5c, 0xf0, 0x22, 0x0d, 0x5f, 0x95, 0x15, 0xc8, 0x1f, 0x99,
+	0x76, 0x80, 0xd1, 0xe9, 0xa7, 0xd1, 0x60, 0x2b, 0xfb, 0x29, 0x69, 0x5e, 0x85, 0x0a, 0x77, 0x7d,
+	0xc6, 0xe3, 0x14, 0x86, 0xbe, 0xf4, 0xe7, 0xe1, 0xd9, 0xf0, 0x4c, 0xf1, 0xf0, 0xff, 0xdf, 0x56,
+	0xb7, 0x61, 0x51, 0x5e, 0xb3, 0x46, 0x0f, 0x85, 0x30, 0x2d, 0x94, 0x0d, 0x55, 0x6c, 0x56, 0x46,
+	0x83, 0xda, 0xe4, 0x04, 0x5d, 0x90, 0xc3, 0xfb, 0xd1, 0x48, 0xff, 0x89, 0xc0, 0xca, 0x97, 0x26,
+	0xb3, 0x2f, 0x75, 0x87, 0x33, 0x66, 0xb2, 0xaf, 0x67, 0xe6, 0x10, 0xd6, 0x28, 0xfa, 0xcc, 0xc3,
+	0xcb, 0x74, 0xa3, 0xff, 0x4e, 0x42, 0xda, 0x1e, 0x3f, 0xc2, 0xb7, 0xf9, 0x8a, 0xfb, 0x95, 0x80,
+	0x92, 0x84, 0x5f, 0xf0, 0x17, 0x60, 0x13, 0x4a, 0xe7, 0x86, 0xe2, 0x8f, 0x7f, 0x65, 0xc6, 0x04,
+	0x85, 0x44, 0x59, 0x28, 0x1f, 0xc2, 0xb2, 0x83, 0x8f, 0x7d, 0xc3, 0x35, 0x2d, 0x34, 0x7c, 0xfe,
+	0x08, 0x9d, 0xa8, 0xa5, 0xe8, 0x62, 0x08, 0xef, 0x9b, 0x16, 0x1e, 0x86, 0xa0, 0xf2, 0x11, 0x94,
+	0x3d, 0x14, 0x3c, 0xf0, 0xda, 0x68, 0x1c, 0xa1, 0x27, 0x18, 0x77, 0xd4, 0x5c, 0x9d, 0x6c, 0xe4,
+	0xe8, 0x72, 0x8c, 0x7f, 0x15, 0xc1, 0xfa, 0x93, 0x2c, 0x54, 0xd2, 0x5b, 0xb8, 0xe4, 0xef, 0xf6,
+	0x4c, 0x35, 0xe7, 0x5e, 0xa7, 0x9a, 0xef, 0xc7, 0xd5, 0xcc, 0x4d, 0x55, 0xf3, 0x6e, 0x26, 0xae,
+	0xe7, 0xbb, 0x50, 0x94, 0x59, 0x10, 0xec, 0x18, 0xd5, 0x7c, 0x18, 0x46, 0xe7, 0x43, 0xe0, 0x80,
+	0x1d, 0xa3, 0xf2, 0x1e, 0x40, 0x2a, 0x45, 0x05, 0x99, 0xa2, 0xa2, 0x1b, 0xa7, 0xa7, 0x59, 0x86,
+	0xa5, 0xe4, 0x5e, 0x91, 0x6c, 0xfa, 0x0f, 0x50, 0x3f, 0x4f, 0xc2, 0xc4, 0x47, 0xff, 0xcd, 0x57,
+	0x55, 0xff, 0x1a, 0x6a, 0xff, 0x2c, 0x1e, 0xd5, 0xe3, 0x36, 0x2c, 0xa6, 0xb3, 0x25, 0x54, 0x52,
+	0x9f, 0x8b, 0x0f, 0xef, 0xc4, 0x04, 0x5d, 0x48, 0xe5, 0x51, 0x34, 0x3f, 0x39, 0x39, 0xd5, 0x32,
+	0xcf, 0x4e, 0xb5, 0xcc, 0x8b, 0x53, 0x8d, 0xfc, 0x38, 0xd4, 0xc8, 0x2f, 0x43, 0x8d, 0xfc, 0x31,
+	0xd4, 0xc8, 0xc9, 0x50, 0x23, 0xcf, 0x87, 0x1a, 0xf9, 0x6b, 0xa8, 0x65, 0x5e, 0x0c, 0x35, 0xf2,
+	0xf3, 0x99, 0x96, 0x39, 0x39, 0xd3, 0x32, 0xcf, 0xce, 0xb4, 0x4c, 0xab, 0x20, 0x7f, 0x94, 0x6f,
+	0xfd, 0x3d, 0x00, 0xea, 0xf3, 0x51, 0x15, 0x9b, 0x0b, 0x00, 0x00,
 }
 
 func (this *ActualLRPLifecycleResponse) Equal(that interface{}) bool {
@@ -1451,6 +1488,12 @@ func (this *ActualLRPsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPsRequest) Equal(that interface{}) bool {
@@ -1490,6 +1533,12 @@ func (this *ActualLRPsRequest) Equal(that interface{}) bool {
 	} else if !this.OptionalIndex.Equal(that1.OptionalIndex) {
 		return false
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPsRequest_Index) Equal(that interface{}) bool {
@@ -1770,7 +1819,7 @@ func (this *ActualLRPsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.ActualLRPsResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -1778,6 +1827,8 @@ func (this *ActualLRPsResponse) GoString() string {
 	if this.ActualLrps != nil {
 		s = append(s, "ActualLrps: "+fmt.Sprintf("%#v", this.ActualLrps)+",\n")
 	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1785,7 +1836,7 @@ func (this *ActualLRPsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 10)
 	s = append(s, "&models.ActualLRPsRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
@@ -1793,6 +1844,8 @@ func (this *ActualLRPsRequest) GoString() string {
 	if this.OptionalIndex != nil {
 		s = append(s, "OptionalIndex: "+fmt.Sprintf("%#v", this.OptionalIndex)+",\n")
 	}
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2440,6 +2493,18 @@ func (m *ActualLRPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ActualLrps) > 0 {
 		for iNdEx := len(m.ActualLrps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2489,6 +2554,18 @@ func (m *ActualLRPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintActualLrpRequests(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.OptionalIndex != nil {
 		{
 			size := m.OptionalIndex.Size()
@@ -2879,6 +2956,13 @@ func (m *ActualLRPsResponse) Size() (n int) {
 			n += 1 + l + sovActualLrpRequests(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovActualLrpRequests(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2903,6 +2987,13 @@ func (m *ActualLRPsRequest) Size() (n int) {
 	if m.OptionalIndex != nil {
 		n += m.OptionalIndex.Size()
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovActualLrpRequests(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovActualLrpRequests(uint64(l))
+	}
 	return n
 }
 
@@ -3134,6 +3225,8 @@ func (this *ActualLRPsResponse) String() string {
 	s := strings.Join([]string{`&ActualLRPsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`ActualLrps:` + repeatedStringForActualLrps + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3147,6 +3240,8 @@ func (this *ActualLRPsRequest) String() string {
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
 		`OptionalIndex:` + fmt.Sprintf("%v", this.OptionalIndex) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4923,6 +5018,57 @@ func (m *ActualLRPsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
@@ -5089,6 +5235,57 @@ func (m *ActualLRPsRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.OptionalIndex = &ActualLRPsRequest_Index{v}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowActualLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthActualLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipActualLrpRequests(dAtA[iNdEx:])
@@ -23,6 +23,26 @@ var _ = Describe("ActualLRP Requests", func() {
 					Expect(request.Validate()).To(BeNil())
 				})
 			})
+
+			Context("when the page size is negative", func() {
+				BeforeEach(func() {
+					request.PageSize = -1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_size"}))
+				})
+			})
+
+			Context("when the page token cannot be decoded", func() {
+				BeforeEach(func() {
+					request.PageToken = "not a token"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"page_token"}))
+				})
+			})
 		})
 
 		Describe("serialization", func() {
@@ -37,12 +57,16 @@ var _ = Describe("ActualLRP Requests", func() {
 					ProcessGuid: "def456",
 				}
 				request.SetIndex(3)
+				request.PageSize = 10
+				request.PageToken = "some-token"
 
 				expectedJSON = `{
 					"domain": "cfapps",
 					"cell_id": "abc123",
 					"process_guid": "def456",
-					"index": 3
+					"index": 3,
+					"page_size": 10,
+					"page_token": "some-token"
 				}`
 			})
 
@@ -142,6 +142,46 @@ var _ = Describe("RestartCalculator", func() {
 		})
 	})
 
+	Describe("NewRestartCalculatorFromPolicy", func() {
+		It("returns the default calculator without a policy", func() {
+			Expect(models.NewRestartCalculatorFromPolicy(nil)).To(Equal(models.NewDefaultRestartCalculator()))
+		})
+
+		It("falls back to the defaults for unset limits", func() {
+			calc := models.NewRestartCalculatorFromPolicy(&models.RestartPolicy{ImmediateRestarts: 1})
+			Expect(calc.ImmediateRestarts).To(BeEquivalentTo(1))
+			Expect(calc.MinBackoffDuration).To(Equal(models.CrashBackoffMinDuration))
+			Expect(calc.MaxBackoffDuration).To(Equal(models.DefaultMaxBackoffDuration))
+			Expect(calc.MaxRestartAttempts).To(BeEquivalentTo(models.DefaultMaxRestarts))
+		})
+
+		It("backs off from the policy's min backoff up to its max backoff", func() {
+			calc := models.NewRestartCalculatorFromPolicy(&models.RestartPolicy{
+				ImmediateRestarts: 1,
+				MinBackoffMs:      1000,
+				MaxBackoffMs:      4000,
+				MaxRestarts:       5,
+			})
+			second := time.Second.Nanoseconds()
+
+			Expect(calc.ShouldRestart(0, 0, 0)).To(BeTrue())
+			Expect(calc.ShouldRestart(0, 0, 1)).To(BeFalse())
+			Expect(calc.ShouldRestart(second, 0, 1)).To(BeTrue())
+			Expect(calc.ShouldRestart(second, 0, 2)).To(BeFalse())
+			Expect(calc.ShouldRestart(2*second, 0, 2)).To(BeTrue())
+			Expect(calc.ShouldRestart(3*second, 0, 3)).To(BeFalse())
+			Expect(calc.ShouldRestart(4*second, 0, 3)).To(BeTrue())
+			Expect(calc.ShouldRestart(4*second, 0, 4)).To(BeTrue())
+			Expect(calc.ShouldRestart(time.Hour.Nanoseconds(), 0, 5)).To(BeFalse())
+		})
+
+		It("never restarts when the policy says so", func() {
+			calc := models.NewRestartCalculatorFromPolicy(&models.RestartPolicy{NeverRestart: true, ImmediateRestarts: 3})
+			Expect(calc.ShouldRestart(0, 0, 0)).To(BeFalse())
+			Expect(calc.ShouldRestart(time.Hour.Nanoseconds(), 0, 1)).To(BeFalse())
+		})
+	})
+
 	Describe("Validate", func() {
 		It("the default values are valid", func() {
 			calc := models.NewDefaultRestartCalculator()
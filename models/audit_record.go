@@ -0,0 +1,50 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AuditRecordFilter selects audit records, see AuditRecordsRequest.
+type AuditRecordFilter struct {
+	Guid      string
+	Actor     string
+	Since     int64
+	Until     int64
+	PageSize  int32
+	PageToken string
+}
+
+func (k *AuditRecord_Kind) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := AuditRecord_Kind_value[name]; found {
+		*k = AuditRecord_Kind(v)
+		return nil
+	}
+	return fmt.Errorf("invalid audit record kind: %s", name)
+}
+
+func (k AuditRecord_Kind) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+func (a *AuditRecord_Action) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := AuditRecord_Action_value[name]; found {
+		*a = AuditRecord_Action(v)
+		return nil
+	}
+	return fmt.Errorf("invalid audit record action: %s", name)
+}
+
+func (a AuditRecord_Action) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
@@ -0,0 +1,1552 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: audit_record.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuditRecord_Kind int32
+
+const (
+	AuditRecord_DesiredLRP AuditRecord_Kind = 0
+	AuditRecord_ActualLRP  AuditRecord_Kind = 1
+	AuditRecord_Task       AuditRecord_Kind = 2
+	AuditRecord_Domain     AuditRecord_Kind = 3
+	AuditRecord_Call       AuditRecord_Kind = 4
+)
+
+var AuditRecord_Kind_name = map[int32]string{
+	0: "DesiredLRP",
+	1: "ActualLRP",
+	2: "Task",
+	3: "Domain",
+	4: "Call",
+}
+
+var AuditRecord_Kind_value = map[string]int32{
+	"DesiredLRP": 0,
+	"ActualLRP":  1,
+	"Task":       2,
+	"Domain":     3,
+	"Call":       4,
+}
+
+func (AuditRecord_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c0ef424f70eabbb, []int{0, 0}
+}
+
+type AuditRecord_Action int32
+
+const (
+	AuditRecord_Create AuditRecord_Action = 0
+	AuditRecord_Update AuditRecord_Action = 1
+	AuditRecord_Remove AuditRecord_Action = 2
+	AuditRecord_Deny   AuditRecord_Action = 3
+)
+
+var AuditRecord_Action_name = map[int32]string{
+	0: "Create",
+	1: "Update",
+	2: "Remove",
+	3: "Deny",
+}
+
+var AuditRecord_Action_value = map[string]int32{
+	"Create": 0,
+	"Update": 1,
+	"Remove": 2,
+	"Deny":   3,
+}
+
+func (AuditRecord_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2c0ef424f70eabbb, []int{0, 1}
+}
+
+// AuditRecord is a change of a resource made by an API call, recorded in the
+// same transaction as the change, or an API call that was denied.
+type AuditRecord struct {
+	Id                       int64               `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	CreatedAt                int64               `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	Route                    string              `protobuf:"bytes,3,opt,name=route,proto3" json:"route"`
+	TraceId                  string              `protobuf:"bytes,4,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ActorCommonName          string              `protobuf:"bytes,5,opt,name=actor_common_name,json=actorCommonName,proto3" json:"actor_common_name"`
+	ActorOrganizationalUnits []string            `protobuf:"bytes,6,rep,name=actor_organizational_units,json=actorOrganizationalUnits,proto3" json:"actor_organizational_units,omitempty"`
+	RemoteAddr               string              `protobuf:"bytes,7,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr"`
+	Kind                     AuditRecord_Kind    `protobuf:"varint,8,opt,name=kind,proto3,enum=models.AuditRecord_Kind" json:"kind"`
+	Action                   AuditRecord_Action  `protobuf:"varint,9,opt,name=action,proto3,enum=models.AuditRecord_Action" json:"action"`
+	Domain                   string              `protobuf:"bytes,10,opt,name=domain,proto3" json:"domain"`
+	ProcessGuid              string              `protobuf:"bytes,11,opt,name=process_guid,json=processGuid,proto3" json:"process_guid,omitempty"`
+	Index                    int32               `protobuf:"varint,12,opt,name=index,proto3" json:"index,omitempty"`
+	InstanceGuid             string              `protobuf:"bytes,13,opt,name=instance_guid,json=instanceGuid,proto3" json:"instance_guid,omitempty"`
+	TaskGuid                 string              `protobuf:"bytes,14,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid,omitempty"`
+	Changes                  []*AuditFieldChange `protobuf:"bytes,15,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (m *AuditRecord) Reset()      { *m = AuditRecord{} }
+func (*AuditRecord) ProtoMessage() {}
+func (*AuditRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c0ef424f70eabbb, []int{0}
+}
+func (m *AuditRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecord.Merge(m, src)
+}
+func (m *AuditRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecord proto.InternalMessageInfo
+
+func (m *AuditRecord) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetRoute() string {
+	if m != nil {
+		return m.Route
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetActorCommonName() string {
+	if m != nil {
+		return m.ActorCommonName
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetActorOrganizationalUnits() []string {
+	if m != nil {
+		return m.ActorOrganizationalUnits
+	}
+	return nil
+}
+
+func (m *AuditRecord) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetKind() AuditRecord_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return AuditRecord_DesiredLRP
+}
+
+func (m *AuditRecord) GetAction() AuditRecord_Action {
+	if m != nil {
+		return m.Action
+	}
+	return AuditRecord_Create
+}
+
+func (m *AuditRecord) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *AuditRecord) GetInstanceGuid() string {
+	if m != nil {
+		return m.InstanceGuid
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *AuditRecord) GetChanges() []*AuditFieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// AuditFieldChange is the change of a field of the resource, identified by
+// its dotted JSON path. before and after are the JSON encoded values, empty
+// when the field is absent.
+type AuditFieldChange struct {
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field"`
+	Before string `protobuf:"bytes,2,opt,name=before,proto3" json:"before,omitempty"`
+	After  string `protobuf:"bytes,3,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (m *AuditFieldChange) Reset()      { *m = AuditFieldChange{} }
+func (*AuditFieldChange) ProtoMessage() {}
+func (*AuditFieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c0ef424f70eabbb, []int{1}
+}
+func (m *AuditFieldChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditFieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditFieldChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditFieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditFieldChange.Merge(m, src)
+}
+func (m *AuditFieldChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditFieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditFieldChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditFieldChange proto.InternalMessageInfo
+
+func (m *AuditFieldChange) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *AuditFieldChange) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditFieldChange) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("models.AuditRecord_Kind", AuditRecord_Kind_name, AuditRecord_Kind_value)
+	proto.RegisterEnum("models.AuditRecord_Action", AuditRecord_Action_name, AuditRecord_Action_value)
+	proto.RegisterType((*AuditRecord)(nil), "models.AuditRecord")
+	proto.RegisterType((*AuditFieldChange)(nil), "models.AuditFieldChange")
+}
+
+func init() { proto.RegisterFile("audit_record.proto", fileDescriptor_2c0ef424f70eabbb) }
+
+var fileDescriptor_2c0ef424f70eabbb = []byte{
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x6e, 0xeb, 0x44,
+	0x14, 0xc6, 0x33, 0xf9, 0xe3, 0xc4, 0x93, 0x34, 0x31, 0x73, 0xe1, 0xde, 0x51, 0x90, 0xec, 0x28,
+	0x42, 0x22, 0x48, 0x6d, 0x8a, 0xa0, 0xea, 0x0e, 0x44, 0x9c, 0x02, 0xaa, 0x40, 0x80, 0x2c, 0xba,
+	0xb6, 0x26, 0x9e, 0x49, 0x3a, 0x6a, 0xec, 0x89, 0xec, 0x31, 0xa2, 0xac, 0x78, 0x00, 0x16, 0x3c,
+	0x06, 0xef, 0xc1, 0x86, 0x65, 0x97, 0x5d, 0x59, 0x34, 0xdd, 0x20, 0xaf, 0xfa, 0x08, 0xc8, 0xc7,
+	0x6e, 0x71, 0x0b, 0x6c, 0x32, 0xe7, 0xfc, 0xce, 0xf7, 0xcd, 0x4c, 0xe6, 0xe4, 0x04, 0x13, 0x96,
+	0x72, 0xa9, 0xfd, 0x58, 0x04, 0x2a, 0xe6, 0xf3, 0x5d, 0xac, 0xb4, 0x22, 0x46, 0xa8, 0xb8, 0xd8,
+	0x26, 0xe3, 0xa3, 0x8d, 0xd4, 0x97, 0xe9, 0x6a, 0x1e, 0xa8, 0xf0, 0x78, 0xa3, 0x36, 0xea, 0x18,
+	0xca, 0xab, 0x74, 0x0d, 0x19, 0x24, 0x10, 0x95, 0xb6, 0xe9, 0xef, 0x5d, 0xdc, 0x5f, 0x14, 0xbb,
+	0x79, 0xb0, 0x19, 0x79, 0x8d, 0x9b, 0x92, 0x53, 0x34, 0x41, 0xb3, 0x96, 0x6b, 0xe4, 0x99, 0xd3,
+	0x94, 0xdc, 0x6b, 0x4a, 0x4e, 0x8e, 0x30, 0x0e, 0x62, 0xc1, 0xb4, 0xe0, 0x3e, 0xd3, 0xb4, 0x09,
+	0xf5, 0x61, 0x9e, 0x39, 0x35, 0xea, 0x99, 0x55, 0xbc, 0xd0, 0xc4, 0xc1, 0x9d, 0x58, 0xa5, 0x5a,
+	0xd0, 0xd6, 0x04, 0xcd, 0x4c, 0xd7, 0xcc, 0x33, 0xa7, 0x04, 0x5e, 0xb9, 0x90, 0xf7, 0x71, 0x4f,
+	0xc7, 0x2c, 0x10, 0xbe, 0xe4, 0xb4, 0x0d, 0x9a, 0x41, 0x9e, 0x39, 0x4f, 0xcc, 0xeb, 0x42, 0x74,
+	0xce, 0xc9, 0x02, 0xbf, 0xc5, 0x02, 0xad, 0x62, 0x3f, 0x50, 0x61, 0xa8, 0x22, 0x3f, 0x62, 0xa1,
+	0xa0, 0x1d, 0x70, 0xbc, 0x93, 0x67, 0xce, 0xbf, 0x8b, 0xde, 0x08, 0xd0, 0x12, 0xc8, 0x37, 0x2c,
+	0x14, 0x64, 0x8d, 0xc7, 0xa5, 0x4a, 0xc5, 0x1b, 0x16, 0xc9, 0x9f, 0x98, 0x96, 0x2a, 0x62, 0x5b,
+	0x3f, 0x8d, 0xa4, 0x4e, 0xa8, 0x31, 0x69, 0xcd, 0x4c, 0x77, 0x96, 0x67, 0xce, 0x7b, 0xff, 0xaf,
+	0x3a, 0x54, 0xa1, 0xd4, 0x22, 0xdc, 0xe9, 0x6b, 0x8f, 0x82, 0xea, 0xdb, 0x67, 0xa2, 0x8b, 0x42,
+	0x43, 0x3e, 0xc4, 0xfd, 0x58, 0x84, 0x4a, 0x0b, 0x9f, 0x71, 0x1e, 0xd3, 0x2e, 0x5c, 0x72, 0x94,
+	0x67, 0x4e, 0x1d, 0x7b, 0xb8, 0x4c, 0x16, 0x9c, 0xc7, 0xe4, 0x14, 0xb7, 0xaf, 0x64, 0xc4, 0x69,
+	0x6f, 0x82, 0x66, 0xc3, 0x8f, 0xe8, 0xbc, 0xec, 0xe1, 0xbc, 0xd6, 0x90, 0xf9, 0x57, 0x32, 0xe2,
+	0x6e, 0x2f, 0xcf, 0x1c, 0x50, 0x7a, 0xf0, 0x49, 0x3e, 0xc5, 0x06, 0x0b, 0x8a, 0xa3, 0xa9, 0x09,
+	0xce, 0xf1, 0x7f, 0x39, 0x17, 0xa0, 0x70, 0x71, 0x9e, 0x39, 0x95, 0xda, 0xab, 0x56, 0x32, 0xc5,
+	0x06, 0x57, 0x21, 0x93, 0x11, 0xc5, 0x70, 0x49, 0xd0, 0x94, 0xc4, 0xab, 0x56, 0xf2, 0x09, 0x1e,
+	0xec, 0x62, 0x15, 0x88, 0x24, 0xf1, 0x37, 0xa9, 0xe4, 0xb4, 0x0f, 0xca, 0x71, 0x9e, 0x39, 0xaf,
+	0xeb, 0xbc, 0xf6, 0x32, 0xfd, 0x8a, 0x7f, 0x99, 0x4a, 0x4e, 0x3e, 0xc0, 0x1d, 0x19, 0x71, 0xf1,
+	0x23, 0x1d, 0x4c, 0xd0, 0xac, 0xe3, 0xbe, 0xca, 0x33, 0x67, 0x04, 0xa0, 0x66, 0x28, 0x15, 0xe4,
+	0x33, 0x7c, 0x20, 0xa3, 0x44, 0xb3, 0x28, 0x10, 0xe5, 0x51, 0x07, 0x70, 0xd4, 0xbb, 0x79, 0xe6,
+	0xbc, 0x79, 0x56, 0xa8, 0x59, 0x07, 0x8f, 0x05, 0x38, 0xec, 0x04, 0x9b, 0x9a, 0x25, 0x57, 0xa5,
+	0x7b, 0x08, 0xee, 0x37, 0x79, 0xe6, 0xbc, 0x7a, 0x82, 0x35, 0x67, 0xaf, 0x80, 0xe0, 0x3a, 0xc7,
+	0xdd, 0xe0, 0x92, 0x45, 0x1b, 0x91, 0xd0, 0xd1, 0xa4, 0x35, 0xeb, 0xbf, 0x68, 0xc0, 0x17, 0x52,
+	0x6c, 0xf9, 0x12, 0x04, 0xe5, 0x4f, 0xad, 0x12, 0xd7, 0xf6, 0x7a, 0xf4, 0x4f, 0x3f, 0xc7, 0xed,
+	0xa2, 0x51, 0x64, 0x88, 0xf1, 0x99, 0x48, 0x64, 0x2c, 0xf8, 0xd7, 0xde, 0x77, 0x56, 0x83, 0x1c,
+	0x60, 0x73, 0x11, 0xe8, 0x94, 0x6d, 0x8b, 0x14, 0x91, 0x1e, 0x6e, 0x7f, 0xcf, 0x92, 0x2b, 0xab,
+	0x49, 0x30, 0x36, 0xce, 0xe0, 0x9d, 0xad, 0x56, 0x41, 0x97, 0x6c, 0xbb, 0xb5, 0xda, 0xd3, 0x53,
+	0x6c, 0x94, 0x5d, 0x2b, 0xea, 0x4b, 0x98, 0x26, 0xab, 0x51, 0xc4, 0x17, 0x3b, 0x5e, 0xc4, 0xa8,
+	0x88, 0x3d, 0x11, 0xaa, 0x1f, 0x84, 0xd5, 0x2c, 0x7c, 0x67, 0x22, 0xba, 0xb6, 0x5a, 0xd3, 0x5f,
+	0x10, 0xb6, 0x5e, 0xde, 0xb9, 0x98, 0xc1, 0x75, 0x91, 0x52, 0xf4, 0xcf, 0x0c, 0x02, 0xf0, 0xca,
+	0x85, 0x1c, 0x62, 0x63, 0x25, 0xd6, 0x2a, 0x16, 0x30, 0xcf, 0xa6, 0xfb, 0x76, 0x9e, 0x39, 0x56,
+	0x49, 0x6a, 0xdf, 0xb1, 0xd2, 0x14, 0x0d, 0x65, 0x6b, 0x2d, 0xe2, 0x6a, 0xa4, 0xa1, 0xa1, 0x00,
+	0xea, 0x0d, 0x05, 0xe0, 0x9e, 0xdc, 0xdc, 0xd9, 0xe8, 0xf6, 0xce, 0x6e, 0x3c, 0xdc, 0xd9, 0xe8,
+	0xe7, 0xbd, 0x8d, 0x7e, 0xdb, 0xdb, 0xe8, 0x8f, 0xbd, 0x8d, 0x6e, 0xf6, 0x36, 0xfa, 0x73, 0x6f,
+	0xa3, 0xbf, 0xf6, 0x76, 0xe3, 0x61, 0x6f, 0xa3, 0x5f, 0xef, 0xed, 0xc6, 0xcd, 0xbd, 0xdd, 0xb8,
+	0xbd, 0xb7, 0x1b, 0x2b, 0x03, 0xfe, 0x91, 0x3e, 0xfe, 0x7b, 0x00, 0xbc, 0x6e, 0x32, 0x69, 0xde,
+	0x04, 0x00, 0x00,
+}
+
+func (x AuditRecord_Kind) String() string {
+	s, ok := AuditRecord_Kind_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (x AuditRecord_Action) String() string {
+	s, ok := AuditRecord_Action_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *AuditRecord) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditRecord)
+	if !ok {
+		that2, ok := that.(AuditRecord)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.Route != that1.Route {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	if this.ActorCommonName != that1.ActorCommonName {
+		return false
+	}
+	if len(this.ActorOrganizationalUnits) != len(that1.ActorOrganizationalUnits) {
+		return false
+	}
+	for i := range this.ActorOrganizationalUnits {
+		if this.ActorOrganizationalUnits[i] != that1.ActorOrganizationalUnits[i] {
+			return false
+		}
+	}
+	if this.RemoteAddr != that1.RemoteAddr {
+		return false
+	}
+	if this.Kind != that1.Kind {
+		return false
+	}
+	if this.Action != that1.Action {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.InstanceGuid != that1.InstanceGuid {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if len(this.Changes) != len(that1.Changes) {
+		return false
+	}
+	for i := range this.Changes {
+		if !this.Changes[i].Equal(that1.Changes[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *AuditFieldChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditFieldChange)
+	if !ok {
+		that2, ok := that.(AuditFieldChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Field != that1.Field {
+		return false
+	}
+	if this.Before != that1.Before {
+		return false
+	}
+	if this.After != that1.After {
+		return false
+	}
+	return true
+}
+func (this *AuditRecord) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 19)
+	s = append(s, "&models.AuditRecord{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "Route: "+fmt.Sprintf("%#v", this.Route)+",\n")
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ActorCommonName: "+fmt.Sprintf("%#v", this.ActorCommonName)+",\n")
+	s = append(s, "ActorOrganizationalUnits: "+fmt.Sprintf("%#v", this.ActorOrganizationalUnits)+",\n")
+	s = append(s, "RemoteAddr: "+fmt.Sprintf("%#v", this.RemoteAddr)+",\n")
+	s = append(s, "Kind: "+fmt.Sprintf("%#v", this.Kind)+",\n")
+	s = append(s, "Action: "+fmt.Sprintf("%#v", this.Action)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Index: "+fmt.Sprintf("%#v", this.Index)+",\n")
+	s = append(s, "InstanceGuid: "+fmt.Sprintf("%#v", this.InstanceGuid)+",\n")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	if this.Changes != nil {
+		s = append(s, "Changes: "+fmt.Sprintf("%#v", this.Changes)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuditFieldChange) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.AuditFieldChange{")
+	s = append(s, "Field: "+fmt.Sprintf("%#v", this.Field)+",\n")
+	s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
+	s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAuditRecord(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AuditRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuditRecord(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.InstanceGuid) > 0 {
+		i -= len(m.InstanceGuid)
+		copy(dAtA[i:], m.InstanceGuid)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.InstanceGuid)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Index != 0 {
+		i = encodeVarintAuditRecord(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Action != 0 {
+		i = encodeVarintAuditRecord(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Kind != 0 {
+		i = encodeVarintAuditRecord(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ActorOrganizationalUnits) > 0 {
+		for iNdEx := len(m.ActorOrganizationalUnits) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActorOrganizationalUnits[iNdEx])
+			copy(dAtA[i:], m.ActorOrganizationalUnits[iNdEx])
+			i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.ActorOrganizationalUnits[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ActorCommonName) > 0 {
+		i -= len(m.ActorCommonName)
+		copy(dAtA[i:], m.ActorCommonName)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.ActorCommonName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Route) > 0 {
+		i -= len(m.Route)
+		copy(dAtA[i:], m.Route)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.Route)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintAuditRecord(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuditRecord(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditFieldChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditFieldChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditFieldChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintAuditRecord(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditRecord(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditRecord(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAuditRecord(uint64(m.Id))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovAuditRecord(uint64(m.CreatedAt))
+	}
+	l = len(m.Route)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.ActorCommonName)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	if len(m.ActorOrganizationalUnits) > 0 {
+		for _, s := range m.ActorOrganizationalUnits {
+			l = len(s)
+			n += 1 + l + sovAuditRecord(uint64(l))
+		}
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovAuditRecord(uint64(m.Kind))
+	}
+	if m.Action != 0 {
+		n += 1 + sovAuditRecord(uint64(m.Action))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovAuditRecord(uint64(m.Index))
+	}
+	l = len(m.InstanceGuid)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovAuditRecord(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AuditFieldChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovAuditRecord(uint64(l))
+	}
+	return n
+}
+
+func sovAuditRecord(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditRecord(x uint64) (n int) {
+	return sovAuditRecord(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AuditRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForChanges := "[]*AuditFieldChange{"
+	for _, f := range this.Changes {
+		repeatedStringForChanges += strings.Replace(f.String(), "AuditFieldChange", "AuditFieldChange", 1) + ","
+	}
+	repeatedStringForChanges += "}"
+	s := strings.Join([]string{`&AuditRecord{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`Route:` + fmt.Sprintf("%v", this.Route) + `,`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ActorCommonName:` + fmt.Sprintf("%v", this.ActorCommonName) + `,`,
+		`ActorOrganizationalUnits:` + fmt.Sprintf("%v", this.ActorOrganizationalUnits) + `,`,
+		`RemoteAddr:` + fmt.Sprintf("%v", this.RemoteAddr) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`InstanceGuid:` + fmt.Sprintf("%v", this.InstanceGuid) + `,`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`Changes:` + repeatedStringForChanges + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditFieldChange) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditFieldChange{`,
+		`Field:` + fmt.Sprintf("%v", this.Field) + `,`,
+		`Before:` + fmt.Sprintf("%v", this.Before) + `,`,
+		`After:` + fmt.Sprintf("%v", this.After) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAuditRecord(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuditRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Route = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorCommonName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorCommonName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorOrganizationalUnits", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorOrganizationalUnits = append(m.ActorOrganizationalUnits, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= AuditRecord_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= AuditRecord_Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstanceGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, &AuditFieldChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditFieldChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditFieldChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditFieldChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Field = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditRecord(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditRecord
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditRecord
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditRecord
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditRecord
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditRecord
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditRecord        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditRecord          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditRecord = fmt.Errorf("proto: unexpected end of group")
+)
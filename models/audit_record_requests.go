@@ -0,0 +1,19 @@
+package models
+
+func (request *AuditRecordsRequest) Validate() error {
+	validationError := validatePagination(request.PageSize, request.PageToken)
+
+	if request.Since < 0 {
+		validationError = validationError.Append(ErrInvalidField{"since"})
+	}
+
+	if request.Until < 0 || (request.Until > 0 && request.Until < request.Since) {
+		validationError = validationError.Append(ErrInvalidField{"until"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
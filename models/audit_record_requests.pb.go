@@ -0,0 +1,984 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: audit_record_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AuditRecordsRequest lists the audit records oldest first. guid matches the
+// process, instance or task guid of the record; since and until bound its
+// creation time in nanoseconds, inclusively, when set.
+type AuditRecordsRequest struct {
+	Guid      string `protobuf:"bytes,1,opt,name=guid,proto3" json:"guid"`
+	Actor     string `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor"`
+	Since     int64  `protobuf:"varint,3,opt,name=since,proto3" json:"since"`
+	Until     int64  `protobuf:"varint,4,opt,name=until,proto3" json:"until"`
+	PageSize  int32  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (m *AuditRecordsRequest) Reset()      { *m = AuditRecordsRequest{} }
+func (*AuditRecordsRequest) ProtoMessage() {}
+func (*AuditRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9f0e22fbe91975, []int{0}
+}
+func (m *AuditRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecordsRequest.Merge(m, src)
+}
+func (m *AuditRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecordsRequest proto.InternalMessageInfo
+
+func (m *AuditRecordsRequest) GetGuid() string {
+	if m != nil {
+		return m.Guid
+	}
+	return ""
+}
+
+func (m *AuditRecordsRequest) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditRecordsRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *AuditRecordsRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+func (m *AuditRecordsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *AuditRecordsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type AuditRecordsResponse struct {
+	Error         *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	AuditRecords  []*AuditRecord `protobuf:"bytes,2,rep,name=audit_records,json=auditRecords,proto3" json:"audit_records,omitempty"`
+	NextPageToken string         `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (m *AuditRecordsResponse) Reset()      { *m = AuditRecordsResponse{} }
+func (*AuditRecordsResponse) ProtoMessage() {}
+func (*AuditRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d9f0e22fbe91975, []int{1}
+}
+func (m *AuditRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuditRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuditRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuditRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditRecordsResponse.Merge(m, src)
+}
+func (m *AuditRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuditRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditRecordsResponse proto.InternalMessageInfo
+
+func (m *AuditRecordsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *AuditRecordsResponse) GetAuditRecords() []*AuditRecord {
+	if m != nil {
+		return m.AuditRecords
+	}
+	return nil
+}
+
+func (m *AuditRecordsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AuditRecordsRequest)(nil), "models.AuditRecordsRequest")
+	proto.RegisterType((*AuditRecordsResponse)(nil), "models.AuditRecordsResponse")
+}
+
+func init() { proto.RegisterFile("audit_record_requests.proto", fileDescriptor_7d9f0e22fbe91975) }
+
+var fileDescriptor_7d9f0e22fbe91975 = []byte{
+	// 376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x91, 0xb1, 0xae, 0xda, 0x30,
+	0x14, 0x86, 0x63, 0x42, 0x10, 0x98, 0xa2, 0x4a, 0xa6, 0x43, 0x04, 0xad, 0x89, 0xa8, 0x54, 0x65,
+	0x69, 0x90, 0x68, 0x87, 0xae, 0x45, 0xea, 0x5e, 0xb9, 0xdd, 0xa3, 0x90, 0xb8, 0x69, 0x54, 0x88,
+	0xa9, 0xed, 0x48, 0x15, 0x53, 0x1f, 0xa1, 0x2f, 0xd0, 0xbd, 0x8f, 0xd2, 0x11, 0xdd, 0x89, 0x09,
+	0x5d, 0xc2, 0x72, 0xc5, 0xc4, 0x23, 0x5c, 0xf9, 0xf8, 0xa2, 0x9b, 0xbb, 0xe4, 0xe4, 0x7c, 0xff,
+	0x9f, 0x9c, 0xdf, 0xc7, 0x78, 0x9c, 0x54, 0x59, 0xa1, 0x63, 0xc9, 0x53, 0x21, 0xb3, 0x58, 0xf2,
+	0x9f, 0x15, 0x57, 0x5a, 0x45, 0x1b, 0x29, 0xb4, 0x20, 0x9d, 0xb5, 0xc8, 0xf8, 0x4a, 0x8d, 0xde,
+	0xe6, 0x85, 0xfe, 0x5e, 0x2d, 0xa3, 0x54, 0xac, 0x67, 0xb9, 0xc8, 0xc5, 0x0c, 0xe4, 0x65, 0xf5,
+	0x0d, 0x3a, 0x68, 0xe0, 0xcd, 0x7e, 0x36, 0xea, 0x73, 0x29, 0x85, 0x7c, 0x68, 0x48, 0x73, 0x80,
+	0x65, 0xd3, 0x1b, 0x84, 0x87, 0x1f, 0x0d, 0x66, 0x40, 0x15, 0xb3, 0x63, 0xc9, 0x4b, 0xdc, 0xce,
+	0xab, 0x22, 0xf3, 0x51, 0x80, 0xc2, 0xde, 0xa2, 0x7b, 0x3e, 0x4c, 0xa0, 0x67, 0xf0, 0x24, 0x13,
+	0xec, 0x25, 0xa9, 0x16, 0xd2, 0x6f, 0x81, 0xdc, 0x3b, 0x1f, 0x26, 0x16, 0x30, 0x5b, 0x8c, 0x41,
+	0x15, 0x65, 0xca, 0x7d, 0x37, 0x40, 0xa1, 0x6b, 0x0d, 0x00, 0x98, 0x2d, 0xc6, 0x50, 0x95, 0xba,
+	0x58, 0xf9, 0xed, 0x47, 0x03, 0x00, 0x66, 0x0b, 0x19, 0xe3, 0xde, 0x26, 0xc9, 0x79, 0xac, 0x8a,
+	0x2d, 0xf7, 0xbd, 0x00, 0x85, 0x1e, 0xeb, 0x1a, 0xf0, 0xa5, 0xd8, 0x72, 0xf2, 0x0a, 0x63, 0x10,
+	0xb5, 0xf8, 0xc1, 0x4b, 0xbf, 0x63, 0x42, 0x30, 0xb0, 0x7f, 0x35, 0x60, 0xfa, 0x17, 0xe1, 0x17,
+	0x4f, 0x0f, 0xa5, 0x36, 0xa2, 0x54, 0x9c, 0xbc, 0xc6, 0x1e, 0x2c, 0x04, 0x8e, 0xd5, 0x9f, 0x0f,
+	0x22, 0xbb, 0xd5, 0xe8, 0x93, 0x81, 0xcc, 0x6a, 0xe4, 0x03, 0x1e, 0x34, 0x17, 0xa5, 0xfc, 0x56,
+	0xe0, 0x86, 0xfd, 0xf9, 0xf0, 0x6a, 0x6e, 0xfc, 0x99, 0x3d, 0x4b, 0x1a, 0x63, 0xc8, 0x1b, 0xfc,
+	0xbc, 0xe4, 0xbf, 0x74, 0xdc, 0xc8, 0xe6, 0x42, 0xb6, 0x81, 0xc1, 0x9f, 0xaf, 0xf9, 0x16, 0xef,
+	0x77, 0x47, 0xea, 0xec, 0x8f, 0xd4, 0xb9, 0x1c, 0x29, 0xfa, 0x5d, 0x53, 0xf4, 0xaf, 0xa6, 0xe8,
+	0x7f, 0x4d, 0xd1, 0xae, 0xa6, 0xe8, 0xb6, 0xa6, 0xe8, 0xae, 0xa6, 0xce, 0xa5, 0xa6, 0xe8, 0xcf,
+	0x89, 0x3a, 0xbb, 0x13, 0x75, 0xf6, 0x27, 0xea, 0x2c, 0x3b, 0x70, 0x63, 0xef, 0xee, 0x07, 0x00,
+	0xb2, 0x46, 0xf4, 0xce, 0x28, 0x02, 0x00, 0x00,
+}
+
+func (this *AuditRecordsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditRecordsRequest)
+	if !ok {
+		that2, ok := that.(AuditRecordsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Guid != that1.Guid {
+		return false
+	}
+	if this.Actor != that1.Actor {
+		return false
+	}
+	if this.Since != that1.Since {
+		return false
+	}
+	if this.Until != that1.Until {
+		return false
+	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
+	return true
+}
+func (this *AuditRecordsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AuditRecordsResponse)
+	if !ok {
+		that2, ok := that.(AuditRecordsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.AuditRecords) != len(that1.AuditRecords) {
+		return false
+	}
+	for i := range this.AuditRecords {
+		if !this.AuditRecords[i].Equal(that1.AuditRecords[i]) {
+			return false
+		}
+	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	return true
+}
+func (this *AuditRecordsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.AuditRecordsRequest{")
+	s = append(s, "Guid: "+fmt.Sprintf("%#v", this.Guid)+",\n")
+	s = append(s, "Actor: "+fmt.Sprintf("%#v", this.Actor)+",\n")
+	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "Until: "+fmt.Sprintf("%#v", this.Until)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *AuditRecordsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.AuditRecordsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.AuditRecords != nil {
+		s = append(s, "AuditRecords: "+fmt.Sprintf("%#v", this.AuditRecords)+",\n")
+	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringAuditRecordRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *AuditRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Until != 0 {
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(m.Until))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Since != 0 {
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Guid) > 0 {
+		i -= len(m.Guid)
+		copy(dAtA[i:], m.Guid)
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(len(m.Guid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuditRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuditRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuditRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintAuditRecordRequests(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AuditRecords) > 0 {
+		for iNdEx := len(m.AuditRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuditRecordRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuditRecordRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuditRecordRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuditRecordRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuditRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guid)
+	if l > 0 {
+		n += 1 + l + sovAuditRecordRequests(uint64(l))
+	}
+	l = len(m.Actor)
+	if l > 0 {
+		n += 1 + l + sovAuditRecordRequests(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovAuditRecordRequests(uint64(m.Since))
+	}
+	if m.Until != 0 {
+		n += 1 + sovAuditRecordRequests(uint64(m.Until))
+	}
+	if m.PageSize != 0 {
+		n += 1 + sovAuditRecordRequests(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovAuditRecordRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *AuditRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovAuditRecordRequests(uint64(l))
+	}
+	if len(m.AuditRecords) > 0 {
+		for _, e := range m.AuditRecords {
+			l = e.Size()
+			n += 1 + l + sovAuditRecordRequests(uint64(l))
+		}
+	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovAuditRecordRequests(uint64(l))
+	}
+	return n
+}
+
+func sovAuditRecordRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuditRecordRequests(x uint64) (n int) {
+	return sovAuditRecordRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AuditRecordsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AuditRecordsRequest{`,
+		`Guid:` + fmt.Sprintf("%v", this.Guid) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`Until:` + fmt.Sprintf("%v", this.Until) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AuditRecordsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAuditRecords := "[]*AuditRecord{"
+	for _, f := range this.AuditRecords {
+		repeatedStringForAuditRecords += strings.Replace(fmt.Sprintf("%v", f), "AuditRecord", "AuditRecord", 1) + ","
+	}
+	repeatedStringForAuditRecords += "}"
+	s := strings.Join([]string{`&AuditRecordsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`AuditRecords:` + repeatedStringForAuditRecords + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringAuditRecordRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AuditRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditRecordRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Until", wireType)
+			}
+			m.Until = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Until |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditRecordRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AuditRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuditRecordRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuditRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuditRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditRecords = append(m.AuditRecords, &AuditRecord{})
+			if err := m.AuditRecords[len(m.AuditRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuditRecordRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuditRecordRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuditRecordRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuditRecordRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuditRecordRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuditRecordRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuditRecordRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuditRecordRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuditRecordRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuditRecordRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuditRecordRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,2626 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: bbs.proto
+
+package models
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+func init() { proto.RegisterFile("bbs.proto", fileDescriptor_39c36b381f192811) }
+
+var fileDescriptor_39c36b381f192811 = []byte{
+	// 1402 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0xcf, 0x8f, 0xd3, 0xc6,
+	0x17, 0x4f, 0xbe, 0x7c, 0xa1, 0xe5, 0xb1, 0x64, 0x17, 0x2f, 0x85, 0x4d, 0x58, 0xcc, 0xaf, 0x42,
+	0xa1, 0x95, 0x10, 0xa5, 0xf4, 0x52, 0xa9, 0x52, 0x49, 0x02, 0x74, 0xab, 0x45, 0x2c, 0x71, 0x57,
+	0xad, 0x5a, 0x55, 0xd1, 0xac, 0x3d, 0x04, 0x17, 0xc7, 0x36, 0x9e, 0xf1, 0xaa, 0xb9, 0x54, 0x3d,
+	0x55, 0x3d, 0xf6, 0xcf, 0xe8, 0x9f, 0xd2, 0x23, 0x47, 0x8e, 0x25, 0x5c, 0x7a, 0xe4, 0xd6, 0x6b,
+	0x65, 0xcf, 0x8c, 0x67, 0xc6, 0x33, 0x59, 0x9c, 0xed, 0x2d, 0xfe, 0x7c, 0xde, 0xfb, 0xbc, 0x99,
+	0xf1, 0xcb, 0x7b, 0xe3, 0x07, 0xc7, 0xf7, 0xf6, 0xc8, 0xcd, 0x34, 0x4b, 0x68, 0xe2, 0x1c, 0x9b,
+	0x26, 0x01, 0x8e, 0x48, 0xef, 0x12, 0xf2, 0x69, 0x8e, 0xa2, 0x71, 0x94, 0xa5, 0xe3, 0xa7, 0x21,
+	0xa1, 0x49, 0x36, 0x1b, 0x67, 0xf8, 0x79, 0x8e, 0x09, 0xe5, 0xa6, 0xbd, 0xae, 0x62, 0x52, 0xa3,
+	0xce, 0xa1, 0x3c, 0x08, 0xe9, 0x38, 0xc3, 0x7e, 0x92, 0x05, 0x75, 0x72, 0xcd, 0xc7, 0x51, 0x34,
+	0x0e, 0x32, 0x14, 0xc6, 0x1c, 0x39, 0x51, 0x20, 0x82, 0x3e, 0xe5, 0x67, 0x88, 0x3c, 0x1d, 0x17,
+	0x21, 0xa7, 0x22, 0x52, 0x80, 0xd3, 0x28, 0x99, 0x4d, 0x71, 0x4c, 0xeb, 0x62, 0xbd, 0x00, 0x93,
+	0x30, 0xc3, 0x81, 0x6d, 0x15, 0x2b, 0x41, 0x32, 0x95, 0x41, 0xce, 0xb1, 0xa7, 0xf1, 0xf3, 0x3c,
+	0xa1, 0xc8, 0x58, 0x13, 0xde, 0x47, 0x7e, 0x8e, 0x68, 0x98, 0x08, 0xf3, 0x15, 0xbc, 0x8f, 0xe3,
+	0x8a, 0xef, 0x24, 0xfb, 0x38, 0x8b, 0x12, 0x14, 0xf0, 0x67, 0x48, 0xc3, 0x78, 0xc2, 0x7f, 0x9f,
+	0x27, 0xfe, 0x53, 0x1c, 0xe4, 0x11, 0x0e, 0xc6, 0x14, 0x91, 0x67, 0x75, 0xe9, 0xcd, 0x12, 0xf4,
+	0x51, 0x14, 0xed, 0x21, 0xdf, 0x60, 0xd7, 0x2d, 0x2e, 0xb7, 0xff, 0xf9, 0x10, 0x8e, 0xf4, 0xfb,
+	0x9e, 0xf3, 0x31, 0xfc, 0x7f, 0x27, 0x8c, 0x27, 0xce, 0xfa, 0x4d, 0xf6, 0x56, 0x6e, 0x16, 0x4f,
+	0x23, 0x66, 0xdb, 0x3b, 0xad, 0x83, 0x24, 0x4d, 0x62, 0x82, 0x9d, 0xcf, 0xe0, 0x9d, 0x61, 0xb9,
+	0x4f, 0xe2, 0x9c, 0x11, 0x06, 0x1c, 0x10, 0x8e, 0x67, 0x0d, 0x9c, 0xfb, 0x6e, 0xc1, 0xca, 0x6e,
+	0x4a, 0x70, 0x46, 0x19, 0xe1, 0x9c, 0x13, 0x86, 0x2a, 0x2a, 0x54, 0x36, 0xed, 0xa4, 0x94, 0x62,
+	0xc8, 0xe3, 0xe2, 0xb4, 0x89, 0x94, 0x52, 0x51, 0x43, 0x4a, 0x27, 0xb9, 0xd4, 0x36, 0x74, 0x3c,
+	0x4c, 0x15, 0xca, 0x39, 0x2f, 0xec, 0x75, 0x5c, 0xc8, 0xd9, 0x62, 0x55, 0x6a, 0xdf, 0xc3, 0xa9,
+	0x11, 0x9e, 0x26, 0xfb, 0x58, 0x15, 0xbc, 0x28, 0x3c, 0x0c, 0x4a, 0x68, 0xbe, 0x6f, 0xd1, 0xdc,
+	0x0e, 0x9f, 0x60, 0x7f, 0xe6, 0x47, 0xb8, 0x12, 0xbf, 0x0f, 0x27, 0x18, 0xbf, 0x4b, 0xd0, 0x04,
+	0x3b, 0x3d, 0xdd, 0xa9, 0x04, 0x17, 0x2c, 0x92, 0x73, 0x5c, 0x67, 0x00, 0x70, 0xb7, 0xfc, 0x6f,
+	0x6d, 0x8f, 0x76, 0x88, 0xd3, 0x15, 0xa6, 0x12, 0x13, 0x2a, 0x3d, 0x1b, 0xc5, 0x45, 0xa6, 0xb0,
+	0x21, 0xd1, 0xfe, 0x6c, 0x27, 0x4b, 0x7c, 0x4c, 0xc8, 0x83, 0x3c, 0x0c, 0x88, 0xf3, 0x81, 0xe9,
+	0xa7, 0x5b, 0x88, 0x00, 0xd7, 0xdf, 0x6e, 0xc8, 0xc3, 0x7d, 0x03, 0xab, 0x95, 0xcd, 0x83, 0x2c,
+	0xc9, 0x53, 0xe2, 0xb8, 0x86, 0x33, 0x23, 0x84, 0xf8, 0x85, 0x85, 0x3c, 0xd3, 0xbc, 0x7c, 0xe4,
+	0xb7, 0xff, 0xb5, 0x9d, 0xe7, 0xb0, 0x59, 0xe3, 0xb5, 0x15, 0x38, 0x1f, 0x2d, 0x50, 0xd1, 0xac,
+	0x96, 0x0b, 0xf9, 0x33, 0x5c, 0xd1, 0x79, 0x4d, 0xeb, 0x6e, 0x1c, 0x6c, 0xc5, 0x01, 0xfe, 0xc9,
+	0xb9, 0x6d, 0x17, 0xb3, 0x1a, 0x8b, 0x05, 0x2c, 0x38, 0x13, 0x3d, 0xfe, 0x2e, 0xac, 0x55, 0xf4,
+	0x97, 0xac, 0xf8, 0x3a, 0xe6, 0xca, 0x39, 0x23, 0x94, 0x2f, 0x2e, 0x36, 0xe0, 0xaf, 0xc8, 0x83,
+	0xce, 0x20, 0x42, 0xe1, 0xb4, 0x32, 0x90, 0xff, 0x24, 0x1d, 0x17, 0x92, 0x97, 0x0d, 0x49, 0x33,
+	0xe7, 0x3d, 0xe8, 0x78, 0x14, 0x65, 0xd4, 0x22, 0xaa, 0xe3, 0x4b, 0x8a, 0x0e, 0x8a, 0x2e, 0x60,
+	0x5b, 0xa9, 0x86, 0x2f, 0x23, 0xfa, 0x18, 0x4e, 0xde, 0x47, 0x61, 0x24, 0x35, 0xab, 0xba, 0xa3,
+	0xc1, 0xcb, 0x48, 0xee, 0xc2, 0x2a, 0x2b, 0x19, 0x52, 0xd4, 0xd5, 0x6b, 0xc9, 0xe1, 0x65, 0x69,
+	0x98, 0xd9, 0x65, 0x35, 0x62, 0x19, 0xd9, 0x14, 0xba, 0x6c, 0x51, 0xf7, 0x78, 0xb3, 0x8b, 0x27,
+	0x32, 0xc0, 0x75, 0x7d, 0xdd, 0x16, 0x13, 0x11, 0xea, 0x46, 0x03, 0x4b, 0x1e, 0x71, 0x0c, 0x1b,
+	0x9c, 0xc6, 0x65, 0x86, 0xe1, 0x40, 0x06, 0xac, 0x6a, 0xd0, 0x22, 0x0b, 0xa3, 0xc8, 0xdd, 0xab,
+	0x7a, 0xb4, 0x35, 0x40, 0x91, 0x18, 0x07, 0x07, 0xa8, 0x59, 0x2c, 0x19, 0xc0, 0xa3, 0x49, 0x9a,
+	0x1e, 0x18, 0xa0, 0x6e, 0xb1, 0x64, 0x80, 0x51, 0x1e, 0xc7, 0xda, 0x3b, 0x31, 0x02, 0xd4, 0x2d,
+	0x9a, 0x04, 0x28, 0x9a, 0x12, 0xbb, 0x23, 0x95, 0xdd, 0x44, 0x36, 0x25, 0x09, 0x9a, 0x4d, 0x49,
+	0xe5, 0xb8, 0xce, 0x0f, 0x70, 0x56, 0xc2, 0x7a, 0x09, 0xbe, 0x66, 0xfa, 0x59, 0xab, 0xaf, 0x25,
+	0x76, 0x25, 0xbf, 0x07, 0x5d, 0x89, 0x7a, 0xec, 0x46, 0x15, 0xc6, 0x93, 0xad, 0xf8, 0x49, 0x72,
+	0xf0, 0xa2, 0x6f, 0x98, 0x5c, 0xcd, 0xbd, 0x8a, 0xf1, 0x6b, 0x1b, 0xae, 0x2e, 0xb2, 0x3a, 0xdc,
+	0x8e, 0x3e, 0x7d, 0x5b, 0xf0, 0x9a, 0x57, 0x55, 0x8a, 0xce, 0x28, 0x47, 0x90, 0xe4, 0xb4, 0xd1,
+	0x4e, 0x0f, 0x7c, 0x3d, 0x8f, 0x61, 0x8d, 0xc1, 0x92, 0x74, 0x36, 0x74, 0x07, 0x25, 0x61, 0xae,
+	0x98, 0x52, 0x66, 0xbd, 0xf8, 0x16, 0xd6, 0x76, 0xd3, 0x00, 0x51, 0x55, 0xf2, 0x82, 0xbc, 0xf6,
+	0xe9, 0xcc, 0xb2, 0xca, 0xfc, 0xaa, 0x65, 0x51, 0xae, 0x33, 0x4b, 0x29, 0x3f, 0x84, 0xd5, 0xb2,
+	0xed, 0x0c, 0xab, 0x2f, 0x06, 0x59, 0x3a, 0x6b, 0x84, 0x25, 0x2b, 0x25, 0xa5, 0x26, 0xbd, 0x40,
+	0x17, 0xa6, 0x88, 0xd5, 0xa0, 0x89, 0xfc, 0x43, 0x58, 0xdd, 0x41, 0x39, 0xc1, 0xb6, 0xd5, 0xd6,
+	0x88, 0x26, 0x72, 0x8f, 0x8a, 0x63, 0x25, 0xf9, 0x54, 0xd5, 0x53, 0x8e, 0x55, 0x67, 0x9a, 0x08,
+	0x7a, 0xe0, 0x8c, 0x12, 0xf6, 0xe1, 0xa2, 0x48, 0x5e, 0xaa, 0x24, 0x0d, 0xae, 0x89, 0xe8, 0x1d,
+	0x38, 0xfa, 0x35, 0x22, 0xcf, 0x88, 0x53, 0x7d, 0xc1, 0x94, 0x8f, 0xc2, 0xf5, 0xbd, 0x1a, 0xca,
+	0xbd, 0x3e, 0x07, 0x28, 0x80, 0xfe, 0xac, 0x3c, 0xfc, 0xae, 0x6a, 0xc4, 0x30, 0xe3, 0xbb, 0xa8,
+	0xa0, 0x94, 0x2a, 0x08, 0x2c, 0x6d, 0x0a, 0x54, 0xba, 0x4b, 0x4c, 0xb8, 0x9f, 0x57, 0xdd, 0xcd,
+	0xfc, 0xfa, 0x02, 0x8e, 0x97, 0x69, 0x54, 0xca, 0x6c, 0x68, 0x99, 0xa5, 0xaa, 0x74, 0x2d, 0x0c,
+	0x57, 0x18, 0x02, 0x0c, 0x50, 0xec, 0xe3, 0xa8, 0x94, 0x38, 0xab, 0x86, 0x53, 0xb7, 0xf1, 0x96,
+	0x75, 0x3c, 0x80, 0x77, 0x8b, 0x5b, 0x8b, 0xae, 0x21, 0x90, 0x66, 0x1a, 0xec, 0xae, 0x79, 0x1f,
+	0x60, 0x84, 0x7f, 0xc4, 0x3e, 0xd5, 0x0f, 0x46, 0x62, 0x0d, 0x17, 0xf4, 0x15, 0xac, 0x0c, 0x92,
+	0x69, 0x1a, 0x61, 0xca, 0x8e, 0xb8, 0x2a, 0x56, 0x2a, 0xda, 0x78, 0x73, 0x27, 0x47, 0x98, 0x24,
+	0xd1, 0x7e, 0x18, 0x4f, 0xfe, 0xd3, 0x29, 0x0d, 0x8b, 0xb7, 0x5e, 0x2d, 0xe9, 0xb0, 0x2a, 0x8f,
+	0xa0, 0xe3, 0x89, 0x4f, 0x7c, 0x96, 0xb9, 0xf2, 0x8a, 0xab, 0xe1, 0xc6, 0x25, 0xbf, 0x4e, 0x57,
+	0xe5, 0x6f, 0x9d, 0x25, 0x9e, 0xc6, 0x3b, 0x97, 0xf5, 0xac, 0xd4, 0x48, 0x63, 0xa9, 0x35, 0x56,
+	0x2a, 0xb3, 0xc2, 0xbc, 0x40, 0xd9, 0x42, 0x36, 0x54, 0xfe, 0x0e, 0x4e, 0x7b, 0x39, 0x49, 0x71,
+	0x1c, 0xe8, 0xd2, 0x55, 0x55, 0xb6, 0xb1, 0x0d, 0xb5, 0x11, 0xac, 0xb3, 0xd7, 0xb4, 0xf0, 0x3c,
+	0x0c, 0x52, 0x28, 0x5f, 0xb3, 0x2a, 0x9b, 0xef, 0x70, 0x1b, 0x4e, 0x16, 0xc4, 0x80, 0x8f, 0x61,
+	0x88, 0xbc, 0xfc, 0x6b, 0xb0, 0x35, 0x23, 0x14, 0xb6, 0x9a, 0x22, 0x38, 0x23, 0x9c, 0x46, 0x68,
+	0xa6, 0xd2, 0x4a, 0x5d, 0x34, 0x38, 0xe3, 0x9a, 0x6e, 0x33, 0x91, 0xb3, 0x93, 0xbb, 0xc5, 0xf8,
+	0x6c, 0x54, 0x4e, 0xcf, 0x94, 0xd9, 0x89, 0x8a, 0x1a, 0xb3, 0x13, 0x9d, 0x94, 0x99, 0xfb, 0x88,
+	0x0f, 0xae, 0x3c, 0x8a, 0x68, 0xae, 0x64, 0xae, 0x8e, 0x1b, 0x99, 0x5b, 0xa7, 0xe5, 0xc6, 0xcb,
+	0x5b, 0xb4, 0x57, 0x4c, 0xe7, 0xfa, 0x19, 0x46, 0xcf, 0x70, 0x46, 0xe4, 0xc6, 0x4d, 0xce, 0xd8,
+	0xb8, 0xcd, 0x84, 0x8b, 0x4f, 0xe0, 0xcc, 0x08, 0x13, 0x4c, 0x0d, 0x13, 0xe7, 0xaa, 0x3c, 0x36,
+	0x1b, 0x6f, 0x24, 0xc3, 0x22, 0xb3, 0xaa, 0x19, 0x74, 0xc4, 0x87, 0xf7, 0xbd, 0x72, 0xca, 0x27,
+	0x67, 0x65, 0xec, 0xb9, 0x3f, 0x1b, 0xe0, 0x28, 0xda, 0x0a, 0x64, 0x33, 0xf2, 0x68, 0x86, 0xd1,
+	0x14, 0x07, 0x25, 0x5f, 0x56, 0xce, 0x5b, 0x6d, 0x67, 0x08, 0xa7, 0xb6, 0x47, 0x3b, 0x5b, 0x31,
+	0xa1, 0x45, 0x41, 0x3f, 0x94, 0xd4, 0xad, 0xb6, 0xe8, 0x6c, 0x87, 0x75, 0xbf, 0x03, 0x47, 0x0b,
+	0x13, 0xa5, 0x9d, 0x96, 0x8f, 0x46, 0x3b, 0xe5, 0xa8, 0x1c, 0x31, 0x0d, 0x92, 0x2c, 0x48, 0xe2,
+	0x02, 0x96, 0x65, 0x5f, 0x62, 0x46, 0x27, 0x57, 0x29, 0x65, 0x60, 0x18, 0xfb, 0x52, 0x46, 0x0e,
+	0x0c, 0x63, 0xdf, 0x10, 0xda, 0xb4, 0x93, 0xb2, 0xaf, 0x0e, 0x8b, 0x89, 0x70, 0xa9, 0x23, 0xef,
+	0xad, 0x02, 0x32, 0xfa, 0xaa, 0xc2, 0x28, 0x3b, 0xc2, 0x51, 0x54, 0x12, 0xca, 0xd0, 0x4c, 0x62,
+	0xe6, 0x8e, 0x14, 0x8a, 0x89, 0xf4, 0xef, 0xbc, 0x78, 0xe5, 0xb6, 0x5e, 0xbe, 0x72, 0x5b, 0x6f,
+	0x5e, 0xb9, 0xed, 0x5f, 0xe6, 0x6e, 0xfb, 0x8f, 0xb9, 0xdb, 0xfe, 0x73, 0xee, 0xb6, 0x5f, 0xcc,
+	0xdd, 0xf6, 0x5f, 0x73, 0xb7, 0xfd, 0xf7, 0xdc, 0x6d, 0xbd, 0x99, 0xbb, 0xed, 0xdf, 0x5f, 0xbb,
+	0xad, 0x17, 0xaf, 0xdd, 0xd6, 0xcb, 0xd7, 0x6e, 0x6b, 0xef, 0x58, 0x39, 0xb6, 0xfd, 0xe4, 0xdf,
+	0x01, 0x00, 0x2c, 0xd8, 0x3b, 0xfd, 0x48, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BBSClient is the client API for BBS service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BBSClient interface {
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error)
+	Domains(ctx context.Context, in *DomainsRequest, opts ...grpc.CallOption) (*DomainsResponse, error)
+	UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error)
+	DomainQuotas(ctx context.Context, in *DomainQuotasRequest, opts ...grpc.CallOption) (*DomainQuotasResponse, error)
+	SetDomainQuota(ctx context.Context, in *SetDomainQuotaRequest, opts ...grpc.CallOption) (*DomainQuotaResponse, error)
+	RemoveDomainQuota(ctx context.Context, in *RemoveDomainQuotaRequest, opts ...grpc.CallOption) (*DomainQuotaLifecycleResponse, error)
+	DomainUsage(ctx context.Context, in *DomainUsageRequest, opts ...grpc.CallOption) (*DomainUsageResponse, error)
+	ActualLRPs(ctx context.Context, in *ActualLRPsRequest, opts ...grpc.CallOption) (*ActualLRPsResponse, error)
+	ActualLRPsByProcessGuids(ctx context.Context, in *ActualLRPsByProcessGuidsRequest, opts ...grpc.CallOption) (*ActualLRPsByProcessGuidsResponse, error)
+	ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error)
+	ActualLRPHistory(ctx context.Context, in *ActualLRPHistoryRequest, opts ...grpc.CallOption) (*ActualLRPHistoryResponse, error)
+	ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error)
+	RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error)
+	EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error)
+	DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error)
+	DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfoByProcessGuidResponse, error)
+	DesiredLRPRoutingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error)
+	DesireDesiredLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error)
+	StartDeployment(ctx context.Context, in *StartDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error)
+	DeploymentByProcessGuid(ctx context.Context, in *DeploymentByProcessGuidRequest, opts ...grpc.CallOption) (*DeploymentResponse, error)
+	PauseDeployment(ctx context.Context, in *PauseDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error)
+	ResumeDeployment(ctx context.Context, in *ResumeDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error)
+	RollbackDeployment(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error)
+	Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error)
+	TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error)
+	DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error)
+	CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	RejectTask(ctx context.Context, in *RejectTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error)
+	ScheduledTasks(ctx context.Context, in *ScheduledTasksRequest, opts ...grpc.CallOption) (*ScheduledTasksResponse, error)
+	DesireScheduledTask(ctx context.Context, in *DesireScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error)
+	UpdateScheduledTask(ctx context.Context, in *UpdateScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error)
+	SuspendScheduledTask(ctx context.Context, in *SuspendScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error)
+	DeleteScheduledTask(ctx context.Context, in *DeleteScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskLifecycleResponse, error)
+	TaskCallbacks(ctx context.Context, in *TaskCallbacksRequest, opts ...grpc.CallOption) (*TaskCallbacksResponse, error)
+	ReplayTaskCallback(ctx context.Context, in *ReplayTaskCallbackRequest, opts ...grpc.CallOption) (*ReplayTaskCallbackResponse, error)
+	AuditRecords(ctx context.Context, in *AuditRecordsRequest, opts ...grpc.CallOption) (*AuditRecordsResponse, error)
+	OverloadStatus(ctx context.Context, in *OverloadStatusRequest, opts ...grpc.CallOption) (*OverloadStatusResponse, error)
+	CrashStormBreakers(ctx context.Context, in *CrashStormBreakersRequest, opts ...grpc.CallOption) (*CrashStormBreakersResponse, error)
+	ResetCrashStormBreaker(ctx context.Context, in *ResetCrashStormBreakerRequest, opts ...grpc.CallOption) (*ResetCrashStormBreakerResponse, error)
+	LRPGroupEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_LRPGroupEventsClient, error)
+	LRPInstanceEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_LRPInstanceEventsClient, error)
+	TaskEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_TaskEventsClient, error)
+	Cells(ctx context.Context, in *CellsRequest, opts ...grpc.CallOption) (*CellsResponse, error)
+	CordonCell(ctx context.Context, in *CordonCellRequest, opts ...grpc.CallOption) (*CordonCellResponse, error)
+	UncordonCell(ctx context.Context, in *UncordonCellRequest, opts ...grpc.CallOption) (*UncordonCellResponse, error)
+	DrainCell(ctx context.Context, in *DrainCellRequest, opts ...grpc.CallOption) (*DrainCellResponse, error)
+	CellDrains(ctx context.Context, in *CellDrainsRequest, opts ...grpc.CallOption) (*CellDrainsResponse, error)
+}
+
+type bBSClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewBBSClient(cc *grpc.ClientConn) BBSClient {
+	return &bBSClient{cc}
+}
+
+func (c *bBSClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PingResponse, error) {
+	out := new(PingResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Domains(ctx context.Context, in *DomainsRequest, opts ...grpc.CallOption) (*DomainsResponse, error) {
+	out := new(DomainsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Domains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpsertDomain(ctx context.Context, in *UpsertDomainRequest, opts ...grpc.CallOption) (*UpsertDomainResponse, error) {
+	out := new(UpsertDomainResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UpsertDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DomainQuotas(ctx context.Context, in *DomainQuotasRequest, opts ...grpc.CallOption) (*DomainQuotasResponse, error) {
+	out := new(DomainQuotasResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DomainQuotas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) SetDomainQuota(ctx context.Context, in *SetDomainQuotaRequest, opts ...grpc.CallOption) (*DomainQuotaResponse, error) {
+	out := new(DomainQuotaResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/SetDomainQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveDomainQuota(ctx context.Context, in *RemoveDomainQuotaRequest, opts ...grpc.CallOption) (*DomainQuotaLifecycleResponse, error) {
+	out := new(DomainQuotaLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveDomainQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DomainUsage(ctx context.Context, in *DomainUsageRequest, opts ...grpc.CallOption) (*DomainUsageResponse, error) {
+	out := new(DomainUsageResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DomainUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPs(ctx context.Context, in *ActualLRPsRequest, opts ...grpc.CallOption) (*ActualLRPsResponse, error) {
+	out := new(ActualLRPsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPsByProcessGuids(ctx context.Context, in *ActualLRPsByProcessGuidsRequest, opts ...grpc.CallOption) (*ActualLRPsByProcessGuidsResponse, error) {
+	out := new(ActualLRPsByProcessGuidsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPsByProcessGuids", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroups(ctx context.Context, in *ActualLRPGroupsRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroupsByProcessGuid(ctx context.Context, in *ActualLRPGroupsByProcessGuidRequest, opts ...grpc.CallOption) (*ActualLRPGroupsResponse, error) {
+	out := new(ActualLRPGroupsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroupsByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, in *ActualLRPGroupByProcessGuidAndIndexRequest, opts ...grpc.CallOption) (*ActualLRPGroupResponse, error) {
+	out := new(ActualLRPGroupResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPGroupByProcessGuidAndIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ActualLRPHistory(ctx context.Context, in *ActualLRPHistoryRequest, opts ...grpc.CallOption) (*ActualLRPHistoryResponse, error) {
+	out := new(ActualLRPHistoryResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ActualLRPHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ClaimActualLRP(ctx context.Context, in *ClaimActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ClaimActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) StartActualLRP(ctx context.Context, in *StartActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/StartActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CrashActualLRP(ctx context.Context, in *CrashActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CrashActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) FailActualLRP(ctx context.Context, in *FailActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/FailActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveActualLRP(ctx context.Context, in *RemoveActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RetireActualLRP(ctx context.Context, in *RetireActualLRPRequest, opts ...grpc.CallOption) (*ActualLRPLifecycleResponse, error) {
+	out := new(ActualLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RetireActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveEvacuatingActualLRP(ctx context.Context, in *RemoveEvacuatingActualLRPRequest, opts ...grpc.CallOption) (*RemoveEvacuatingActualLRPResponse, error) {
+	out := new(RemoveEvacuatingActualLRPResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveEvacuatingActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateClaimedActualLRP(ctx context.Context, in *EvacuateClaimedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateClaimedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateCrashedActualLRP(ctx context.Context, in *EvacuateCrashedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateCrashedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateStoppedActualLRP(ctx context.Context, in *EvacuateStoppedActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateStoppedActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) EvacuateRunningActualLRP(ctx context.Context, in *EvacuateRunningActualLRPRequest, opts ...grpc.CallOption) (*EvacuationResponse, error) {
+	out := new(EvacuationResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/EvacuateRunningActualLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPs(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error) {
+	out := new(DesiredLRPsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPResponse, error) {
+	out := new(DesiredLRPResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPSchedulingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfosResponse, error) {
+	out := new(DesiredLRPSchedulingInfosResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPSchedulingInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, in *DesiredLRPByProcessGuidRequest, opts ...grpc.CallOption) (*DesiredLRPSchedulingInfoByProcessGuidResponse, error) {
+	out := new(DesiredLRPSchedulingInfoByProcessGuidResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPSchedulingInfoByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesiredLRPRoutingInfos(ctx context.Context, in *DesiredLRPsRequest, opts ...grpc.CallOption) (*DesiredLRPsResponse, error) {
+	out := new(DesiredLRPsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesiredLRPRoutingInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireDesiredLRP(ctx context.Context, in *DesireLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesireDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpdateDesiredLRP(ctx context.Context, in *UpdateDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UpdateDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RemoveDesiredLRP(ctx context.Context, in *RemoveDesiredLRPRequest, opts ...grpc.CallOption) (*DesiredLRPLifecycleResponse, error) {
+	out := new(DesiredLRPLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RemoveDesiredLRP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) StartDeployment(ctx context.Context, in *StartDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error) {
+	out := new(DeploymentResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/StartDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeploymentByProcessGuid(ctx context.Context, in *DeploymentByProcessGuidRequest, opts ...grpc.CallOption) (*DeploymentResponse, error) {
+	out := new(DeploymentResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DeploymentByProcessGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) PauseDeployment(ctx context.Context, in *PauseDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error) {
+	out := new(DeploymentResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/PauseDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ResumeDeployment(ctx context.Context, in *ResumeDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error) {
+	out := new(DeploymentResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ResumeDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RollbackDeployment(ctx context.Context, in *RollbackDeploymentRequest, opts ...grpc.CallOption) (*DeploymentResponse, error) {
+	out := new(DeploymentResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RollbackDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) Tasks(ctx context.Context, in *TasksRequest, opts ...grpc.CallOption) (*TasksResponse, error) {
+	out := new(TasksResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) TaskByGuid(ctx context.Context, in *TaskByGuidRequest, opts ...grpc.CallOption) (*TaskResponse, error) {
+	out := new(TaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/TaskByGuid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireTask(ctx context.Context, in *DesireTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesireTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) StartTask(ctx context.Context, in *StartTaskRequest, opts ...grpc.CallOption) (*StartTaskResponse, error) {
+	out := new(StartTaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/StartTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CancelTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CancelTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) FailTask(ctx context.Context, in *FailTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/FailTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) RejectTask(ctx context.Context, in *RejectTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/RejectTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CompleteTask(ctx context.Context, in *CompleteTaskRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CompleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ResolvingTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ResolvingTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeleteTask(ctx context.Context, in *TaskGuidRequest, opts ...grpc.CallOption) (*TaskLifecycleResponse, error) {
+	out := new(TaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DeleteTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ScheduledTasks(ctx context.Context, in *ScheduledTasksRequest, opts ...grpc.CallOption) (*ScheduledTasksResponse, error) {
+	out := new(ScheduledTasksResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ScheduledTasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DesireScheduledTask(ctx context.Context, in *DesireScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error) {
+	out := new(ScheduledTaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DesireScheduledTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UpdateScheduledTask(ctx context.Context, in *UpdateScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error) {
+	out := new(ScheduledTaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UpdateScheduledTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) SuspendScheduledTask(ctx context.Context, in *SuspendScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskResponse, error) {
+	out := new(ScheduledTaskResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/SuspendScheduledTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DeleteScheduledTask(ctx context.Context, in *DeleteScheduledTaskRequest, opts ...grpc.CallOption) (*ScheduledTaskLifecycleResponse, error) {
+	out := new(ScheduledTaskLifecycleResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DeleteScheduledTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) TaskCallbacks(ctx context.Context, in *TaskCallbacksRequest, opts ...grpc.CallOption) (*TaskCallbacksResponse, error) {
+	out := new(TaskCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/TaskCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ReplayTaskCallback(ctx context.Context, in *ReplayTaskCallbackRequest, opts ...grpc.CallOption) (*ReplayTaskCallbackResponse, error) {
+	out := new(ReplayTaskCallbackResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ReplayTaskCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) AuditRecords(ctx context.Context, in *AuditRecordsRequest, opts ...grpc.CallOption) (*AuditRecordsResponse, error) {
+	out := new(AuditRecordsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/AuditRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) OverloadStatus(ctx context.Context, in *OverloadStatusRequest, opts ...grpc.CallOption) (*OverloadStatusResponse, error) {
+	out := new(OverloadStatusResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/OverloadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CrashStormBreakers(ctx context.Context, in *CrashStormBreakersRequest, opts ...grpc.CallOption) (*CrashStormBreakersResponse, error) {
+	out := new(CrashStormBreakersResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CrashStormBreakers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) ResetCrashStormBreaker(ctx context.Context, in *ResetCrashStormBreakerRequest, opts ...grpc.CallOption) (*ResetCrashStormBreakerResponse, error) {
+	out := new(ResetCrashStormBreakerResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/ResetCrashStormBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *bBSClient) LRPGroupEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_LRPGroupEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[0], "/models.BBS/LRPGroupEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSLRPGroupEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_LRPGroupEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSLRPGroupEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSLRPGroupEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) LRPInstanceEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_LRPInstanceEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[1], "/models.BBS/LRPInstanceEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSLRPInstanceEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_LRPInstanceEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSLRPInstanceEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSLRPInstanceEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) TaskEvents(ctx context.Context, in *EventsByCellId, opts ...grpc.CallOption) (BBS_TaskEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_BBS_serviceDesc.Streams[2], "/models.BBS/TaskEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bBSTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BBS_TaskEventsClient interface {
+	Recv() (*StreamedEvent, error)
+	grpc.ClientStream
+}
+
+type bBSTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *bBSTaskEventsClient) Recv() (*StreamedEvent, error) {
+	m := new(StreamedEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *bBSClient) Cells(ctx context.Context, in *CellsRequest, opts ...grpc.CallOption) (*CellsResponse, error) {
+	out := new(CellsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/Cells", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CordonCell(ctx context.Context, in *CordonCellRequest, opts ...grpc.CallOption) (*CordonCellResponse, error) {
+	out := new(CordonCellResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CordonCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) UncordonCell(ctx context.Context, in *UncordonCellRequest, opts ...grpc.CallOption) (*UncordonCellResponse, error) {
+	out := new(UncordonCellResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/UncordonCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) DrainCell(ctx context.Context, in *DrainCellRequest, opts ...grpc.CallOption) (*DrainCellResponse, error) {
+	out := new(DrainCellResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/DrainCell", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bBSClient) CellDrains(ctx context.Context, in *CellDrainsRequest, opts ...grpc.CallOption) (*CellDrainsResponse, error) {
+	out := new(CellDrainsResponse)
+	err := c.cc.Invoke(ctx, "/models.BBS/CellDrains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BBSServer is the server API for BBS service.
+type BBSServer interface {
+	Ping(context.Context, *PingRequest) (*PingResponse, error)
+	Domains(context.Context, *DomainsRequest) (*DomainsResponse, error)
+	UpsertDomain(context.Context, *UpsertDomainRequest) (*UpsertDomainResponse, error)
+	DomainQuotas(context.Context, *DomainQuotasRequest) (*DomainQuotasResponse, error)
+	SetDomainQuota(context.Context, *SetDomainQuotaRequest) (*DomainQuotaResponse, error)
+	RemoveDomainQuota(context.Context, *RemoveDomainQuotaRequest) (*DomainQuotaLifecycleResponse, error)
+	DomainUsage(context.Context, *DomainUsageRequest) (*DomainUsageResponse, error)
+	ActualLRPs(context.Context, *ActualLRPsRequest) (*ActualLRPsResponse, error)
+	ActualLRPsByProcessGuids(context.Context, *ActualLRPsByProcessGuidsRequest) (*ActualLRPsByProcessGuidsResponse, error)
+	ActualLRPGroups(context.Context, *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupsByProcessGuid(context.Context, *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error)
+	ActualLRPGroupByProcessGuidAndIndex(context.Context, *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error)
+	ActualLRPHistory(context.Context, *ActualLRPHistoryRequest) (*ActualLRPHistoryResponse, error)
+	ClaimActualLRP(context.Context, *ClaimActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	StartActualLRP(context.Context, *StartActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	CrashActualLRP(context.Context, *CrashActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	FailActualLRP(context.Context, *FailActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RemoveActualLRP(context.Context, *RemoveActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RetireActualLRP(context.Context, *RetireActualLRPRequest) (*ActualLRPLifecycleResponse, error)
+	RemoveEvacuatingActualLRP(context.Context, *RemoveEvacuatingActualLRPRequest) (*RemoveEvacuatingActualLRPResponse, error)
+	EvacuateClaimedActualLRP(context.Context, *EvacuateClaimedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateCrashedActualLRP(context.Context, *EvacuateCrashedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateStoppedActualLRP(context.Context, *EvacuateStoppedActualLRPRequest) (*EvacuationResponse, error)
+	EvacuateRunningActualLRP(context.Context, *EvacuateRunningActualLRPRequest) (*EvacuationResponse, error)
+	DesiredLRPs(context.Context, *DesiredLRPsRequest) (*DesiredLRPsResponse, error)
+	DesiredLRPByProcessGuid(context.Context, *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error)
+	DesiredLRPSchedulingInfos(context.Context, *DesiredLRPsRequest) (*DesiredLRPSchedulingInfosResponse, error)
+	DesiredLRPSchedulingInfoByProcessGuid(context.Context, *DesiredLRPByProcessGuidRequest) (*DesiredLRPSchedulingInfoByProcessGuidResponse, error)
+	DesiredLRPRoutingInfos(context.Context, *DesiredLRPsRequest) (*DesiredLRPsResponse, error)
+	DesireDesiredLRP(context.Context, *DesireLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	UpdateDesiredLRP(context.Context, *UpdateDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	RemoveDesiredLRP(context.Context, *RemoveDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error)
+	StartDeployment(context.Context, *StartDeploymentRequest) (*DeploymentResponse, error)
+	DeploymentByProcessGuid(context.Context, *DeploymentByProcessGuidRequest) (*DeploymentResponse, error)
+	PauseDeployment(context.Context, *PauseDeploymentRequest) (*DeploymentResponse, error)
+	ResumeDeployment(context.Context, *ResumeDeploymentRequest) (*DeploymentResponse, error)
+	RollbackDeployment(context.Context, *RollbackDeploymentRequest) (*DeploymentResponse, error)
+	Tasks(context.Context, *TasksRequest) (*TasksResponse, error)
+	TaskByGuid(context.Context, *TaskByGuidRequest) (*TaskResponse, error)
+	DesireTask(context.Context, *DesireTaskRequest) (*TaskLifecycleResponse, error)
+	StartTask(context.Context, *StartTaskRequest) (*StartTaskResponse, error)
+	CancelTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	FailTask(context.Context, *FailTaskRequest) (*TaskLifecycleResponse, error)
+	RejectTask(context.Context, *RejectTaskRequest) (*TaskLifecycleResponse, error)
+	CompleteTask(context.Context, *CompleteTaskRequest) (*TaskLifecycleResponse, error)
+	ResolvingTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	DeleteTask(context.Context, *TaskGuidRequest) (*TaskLifecycleResponse, error)
+	ScheduledTasks(context.Context, *ScheduledTasksRequest) (*ScheduledTasksResponse, error)
+	DesireScheduledTask(context.Context, *DesireScheduledTaskRequest) (*ScheduledTaskResponse, error)
+	UpdateScheduledTask(context.Context, *UpdateScheduledTaskRequest) (*ScheduledTaskResponse, error)
+	SuspendScheduledTask(context.Context, *SuspendScheduledTaskRequest) (*ScheduledTaskResponse, error)
+	DeleteScheduledTask(context.Context, *DeleteScheduledTaskRequest) (*ScheduledTaskLifecycleResponse, error)
+	TaskCallbacks(context.Context, *TaskCallbacksRequest) (*TaskCallbacksResponse, error)
+	ReplayTaskCallback(context.Context, *ReplayTaskCallbackRequest) (*ReplayTaskCallbackResponse, error)
+	AuditRecords(context.Context, *AuditRecordsRequest) (*AuditRecordsResponse, error)
+	OverloadStatus(context.Context, *OverloadStatusRequest) (*OverloadStatusResponse, error)
+	CrashStormBreakers(context.Context, *CrashStormBreakersRequest) (*CrashStormBreakersResponse, error)
+	ResetCrashStormBreaker(context.Context, *ResetCrashStormBreakerRequest) (*ResetCrashStormBreakerResponse, error)
+	LRPGroupEvents(*EventsByCellId, BBS_LRPGroupEventsServer) error
+	LRPInstanceEvents(*EventsByCellId, BBS_LRPInstanceEventsServer) error
+	TaskEvents(*EventsByCellId, BBS_TaskEventsServer) error
+	Cells(context.Context, *CellsRequest) (*CellsResponse, error)
+	CordonCell(context.Context, *CordonCellRequest) (*CordonCellResponse, error)
+	UncordonCell(context.Context, *UncordonCellRequest) (*UncordonCellResponse, error)
+	DrainCell(context.Context, *DrainCellRequest) (*DrainCellResponse, error)
+	CellDrains(context.Context, *CellDrainsRequest) (*CellDrainsResponse, error)
+}
+
+// UnimplementedBBSServer can be embedded to have forward compatible implementations.
+type UnimplementedBBSServer struct {
+}
+
+func (*UnimplementedBBSServer) Ping(ctx context.Context, req *PingRequest) (*PingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (*UnimplementedBBSServer) Domains(ctx context.Context, req *DomainsRequest) (*DomainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Domains not implemented")
+}
+func (*UnimplementedBBSServer) UpsertDomain(ctx context.Context, req *UpsertDomainRequest) (*UpsertDomainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDomain not implemented")
+}
+func (*UnimplementedBBSServer) DomainQuotas(ctx context.Context, req *DomainQuotasRequest) (*DomainQuotasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainQuotas not implemented")
+}
+func (*UnimplementedBBSServer) SetDomainQuota(ctx context.Context, req *SetDomainQuotaRequest) (*DomainQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDomainQuota not implemented")
+}
+func (*UnimplementedBBSServer) RemoveDomainQuota(ctx context.Context, req *RemoveDomainQuotaRequest) (*DomainQuotaLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDomainQuota not implemented")
+}
+func (*UnimplementedBBSServer) DomainUsage(ctx context.Context, req *DomainUsageRequest) (*DomainUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DomainUsage not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPs(ctx context.Context, req *ActualLRPsRequest) (*ActualLRPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPs not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPsByProcessGuids(ctx context.Context, req *ActualLRPsByProcessGuidsRequest) (*ActualLRPsByProcessGuidsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPsByProcessGuids not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroups(ctx context.Context, req *ActualLRPGroupsRequest) (*ActualLRPGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroups not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroupsByProcessGuid(ctx context.Context, req *ActualLRPGroupsByProcessGuidRequest) (*ActualLRPGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroupsByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPGroupByProcessGuidAndIndex(ctx context.Context, req *ActualLRPGroupByProcessGuidAndIndexRequest) (*ActualLRPGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPGroupByProcessGuidAndIndex not implemented")
+}
+func (*UnimplementedBBSServer) ActualLRPHistory(ctx context.Context, req *ActualLRPHistoryRequest) (*ActualLRPHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActualLRPHistory not implemented")
+}
+func (*UnimplementedBBSServer) ClaimActualLRP(ctx context.Context, req *ClaimActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) StartActualLRP(ctx context.Context, req *StartActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) CrashActualLRP(ctx context.Context, req *CrashActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) FailActualLRP(ctx context.Context, req *FailActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveActualLRP(ctx context.Context, req *RemoveActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RetireActualLRP(ctx context.Context, req *RetireActualLRPRequest) (*ActualLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveEvacuatingActualLRP(ctx context.Context, req *RemoveEvacuatingActualLRPRequest) (*RemoveEvacuatingActualLRPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvacuatingActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateClaimedActualLRP(ctx context.Context, req *EvacuateClaimedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateClaimedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateCrashedActualLRP(ctx context.Context, req *EvacuateCrashedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateCrashedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateStoppedActualLRP(ctx context.Context, req *EvacuateStoppedActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateStoppedActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) EvacuateRunningActualLRP(ctx context.Context, req *EvacuateRunningActualLRPRequest) (*EvacuationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EvacuateRunningActualLRP not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPs(ctx context.Context, req *DesiredLRPsRequest) (*DesiredLRPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPs not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPByProcessGuid(ctx context.Context, req *DesiredLRPByProcessGuidRequest) (*DesiredLRPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPSchedulingInfos(ctx context.Context, req *DesiredLRPsRequest) (*DesiredLRPSchedulingInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPSchedulingInfos not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPSchedulingInfoByProcessGuid(ctx context.Context, req *DesiredLRPByProcessGuidRequest) (*DesiredLRPSchedulingInfoByProcessGuidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPSchedulingInfoByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) DesiredLRPRoutingInfos(ctx context.Context, req *DesiredLRPsRequest) (*DesiredLRPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesiredLRPRoutingInfos not implemented")
+}
+func (*UnimplementedBBSServer) DesireDesiredLRP(ctx context.Context, req *DesireLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesireDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) UpdateDesiredLRP(ctx context.Context, req *UpdateDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) RemoveDesiredLRP(ctx context.Context, req *RemoveDesiredLRPRequest) (*DesiredLRPLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDesiredLRP not implemented")
+}
+func (*UnimplementedBBSServer) StartDeployment(ctx context.Context, req *StartDeploymentRequest) (*DeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartDeployment not implemented")
+}
+func (*UnimplementedBBSServer) DeploymentByProcessGuid(ctx context.Context, req *DeploymentByProcessGuidRequest) (*DeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeploymentByProcessGuid not implemented")
+}
+func (*UnimplementedBBSServer) PauseDeployment(ctx context.Context, req *PauseDeploymentRequest) (*DeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseDeployment not implemented")
+}
+func (*UnimplementedBBSServer) ResumeDeployment(ctx context.Context, req *ResumeDeploymentRequest) (*DeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeDeployment not implemented")
+}
+func (*UnimplementedBBSServer) RollbackDeployment(ctx context.Context, req *RollbackDeploymentRequest) (*DeploymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackDeployment not implemented")
+}
+func (*UnimplementedBBSServer) Tasks(ctx context.Context, req *TasksRequest) (*TasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tasks not implemented")
+}
+func (*UnimplementedBBSServer) TaskByGuid(ctx context.Context, req *TaskByGuidRequest) (*TaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskByGuid not implemented")
+}
+func (*UnimplementedBBSServer) DesireTask(ctx context.Context, req *DesireTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesireTask not implemented")
+}
+func (*UnimplementedBBSServer) StartTask(ctx context.Context, req *StartTaskRequest) (*StartTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartTask not implemented")
+}
+func (*UnimplementedBBSServer) CancelTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (*UnimplementedBBSServer) FailTask(ctx context.Context, req *FailTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailTask not implemented")
+}
+func (*UnimplementedBBSServer) RejectTask(ctx context.Context, req *RejectTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectTask not implemented")
+}
+func (*UnimplementedBBSServer) CompleteTask(ctx context.Context, req *CompleteTaskRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteTask not implemented")
+}
+func (*UnimplementedBBSServer) ResolvingTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvingTask not implemented")
+}
+func (*UnimplementedBBSServer) DeleteTask(ctx context.Context, req *TaskGuidRequest) (*TaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTask not implemented")
+}
+func (*UnimplementedBBSServer) ScheduledTasks(ctx context.Context, req *ScheduledTasksRequest) (*ScheduledTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduledTasks not implemented")
+}
+func (*UnimplementedBBSServer) DesireScheduledTask(ctx context.Context, req *DesireScheduledTaskRequest) (*ScheduledTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DesireScheduledTask not implemented")
+}
+func (*UnimplementedBBSServer) UpdateScheduledTask(ctx context.Context, req *UpdateScheduledTaskRequest) (*ScheduledTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduledTask not implemented")
+}
+func (*UnimplementedBBSServer) SuspendScheduledTask(ctx context.Context, req *SuspendScheduledTaskRequest) (*ScheduledTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendScheduledTask not implemented")
+}
+func (*UnimplementedBBSServer) DeleteScheduledTask(ctx context.Context, req *DeleteScheduledTaskRequest) (*ScheduledTaskLifecycleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledTask not implemented")
+}
+func (*UnimplementedBBSServer) TaskCallbacks(ctx context.Context, req *TaskCallbacksRequest) (*TaskCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskCallbacks not implemented")
+}
+func (*UnimplementedBBSServer) ReplayTaskCallback(ctx context.Context, req *ReplayTaskCallbackRequest) (*ReplayTaskCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayTaskCallback not implemented")
+}
+func (*UnimplementedBBSServer) AuditRecords(ctx context.Context, req *AuditRecordsRequest) (*AuditRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditRecords not implemented")
+}
+func (*UnimplementedBBSServer) OverloadStatus(ctx context.Context, req *OverloadStatusRequest) (*OverloadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OverloadStatus not implemented")
+}
+func (*UnimplementedBBSServer) CrashStormBreakers(ctx context.Context, req *CrashStormBreakersRequest) (*CrashStormBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashStormBreakers not implemented")
+}
+func (*UnimplementedBBSServer) ResetCrashStormBreaker(ctx context.Context, req *ResetCrashStormBreakerRequest) (*ResetCrashStormBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCrashStormBreaker not implemented")
+}
+func (*UnimplementedBBSServer) LRPGroupEvents(req *EventsByCellId, srv BBS_LRPGroupEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method LRPGroupEvents not implemented")
+}
+func (*UnimplementedBBSServer) LRPInstanceEvents(req *EventsByCellId, srv BBS_LRPInstanceEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method LRPInstanceEvents not implemented")
+}
+func (*UnimplementedBBSServer) TaskEvents(req *EventsByCellId, srv BBS_TaskEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method TaskEvents not implemented")
+}
+func (*UnimplementedBBSServer) Cells(ctx context.Context, req *CellsRequest) (*CellsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cells not implemented")
+}
+func (*UnimplementedBBSServer) CordonCell(ctx context.Context, req *CordonCellRequest) (*CordonCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonCell not implemented")
+}
+func (*UnimplementedBBSServer) UncordonCell(ctx context.Context, req *UncordonCellRequest) (*UncordonCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonCell not implemented")
+}
+func (*UnimplementedBBSServer) DrainCell(ctx context.Context, req *DrainCellRequest) (*DrainCellResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainCell not implemented")
+}
+func (*UnimplementedBBSServer) CellDrains(ctx context.Context, req *CellDrainsRequest) (*CellDrainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CellDrains not implemented")
+}
+
+func RegisterBBSServer(s *grpc.Server, srv BBSServer) {
+	s.RegisterService(&_BBS_serviceDesc, srv)
+}
+
+func _BBS_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Domains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Domains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Domains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Domains(ctx, req.(*DomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpsertDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpsertDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpsertDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpsertDomain(ctx, req.(*UpsertDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DomainQuotas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainQuotasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DomainQuotas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DomainQuotas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DomainQuotas(ctx, req.(*DomainQuotasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SetDomainQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDomainQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).SetDomainQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/SetDomainQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).SetDomainQuota(ctx, req.(*SetDomainQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveDomainQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDomainQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveDomainQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveDomainQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveDomainQuota(ctx, req.(*RemoveDomainQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DomainUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DomainUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DomainUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DomainUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DomainUsage(ctx, req.(*DomainUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPs(ctx, req.(*ActualLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPsByProcessGuids_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPsByProcessGuidsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPsByProcessGuids(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPsByProcessGuids",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPsByProcessGuids(ctx, req.(*ActualLRPsByProcessGuidsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroups(ctx, req.(*ActualLRPGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupsByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupsByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupsByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupsByProcessGuid(ctx, req.(*ActualLRPGroupsByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPGroupByProcessGuidAndIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPGroupByProcessGuidAndIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPGroupByProcessGuidAndIndex(ctx, req.(*ActualLRPGroupByProcessGuidAndIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ActualLRPHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ActualLRPHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ActualLRPHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ActualLRPHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ActualLRPHistory(ctx, req.(*ActualLRPHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ClaimActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ClaimActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ClaimActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ClaimActualLRP(ctx, req.(*ClaimActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_StartActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).StartActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/StartActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).StartActualLRP(ctx, req.(*StartActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CrashActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CrashActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CrashActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CrashActualLRP(ctx, req.(*CrashActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_FailActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).FailActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/FailActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).FailActualLRP(ctx, req.(*FailActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveActualLRP(ctx, req.(*RemoveActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RetireActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetireActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RetireActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RetireActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RetireActualLRP(ctx, req.(*RetireActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveEvacuatingActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEvacuatingActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveEvacuatingActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveEvacuatingActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveEvacuatingActualLRP(ctx, req.(*RemoveEvacuatingActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateClaimedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateClaimedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateClaimedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateClaimedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateClaimedActualLRP(ctx, req.(*EvacuateClaimedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateCrashedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateCrashedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateCrashedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateCrashedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateCrashedActualLRP(ctx, req.(*EvacuateCrashedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateStoppedActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateStoppedActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateStoppedActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateStoppedActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateStoppedActualLRP(ctx, req.(*EvacuateStoppedActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_EvacuateRunningActualLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EvacuateRunningActualLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).EvacuateRunningActualLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/EvacuateRunningActualLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).EvacuateRunningActualLRP(ctx, req.(*EvacuateRunningActualLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPs(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPByProcessGuid(ctx, req.(*DesiredLRPByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPSchedulingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPSchedulingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPSchedulingInfos(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPSchedulingInfoByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPSchedulingInfoByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPSchedulingInfoByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPSchedulingInfoByProcessGuid(ctx, req.(*DesiredLRPByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesiredLRPRoutingInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesiredLRPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesiredLRPRoutingInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesiredLRPRoutingInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesiredLRPRoutingInfos(ctx, req.(*DesiredLRPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireDesiredLRP(ctx, req.(*DesireLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpdateDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpdateDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpdateDesiredLRP(ctx, req.(*UpdateDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RemoveDesiredLRP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDesiredLRPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RemoveDesiredLRP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RemoveDesiredLRP(ctx, req.(*RemoveDesiredLRPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_StartDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).StartDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/StartDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).StartDeployment(ctx, req.(*StartDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeploymentByProcessGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeploymentByProcessGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeploymentByProcessGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeploymentByProcessGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeploymentByProcessGuid(ctx, req.(*DeploymentByProcessGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_PauseDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).PauseDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/PauseDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).PauseDeployment(ctx, req.(*PauseDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ResumeDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ResumeDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ResumeDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ResumeDeployment(ctx, req.(*ResumeDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RollbackDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RollbackDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RollbackDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RollbackDeployment(ctx, req.(*RollbackDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_Tasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Tasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Tasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Tasks(ctx, req.(*TasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_TaskByGuid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskByGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).TaskByGuid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/TaskByGuid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).TaskByGuid(ctx, req.(*TaskByGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireTask(ctx, req.(*DesireTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_StartTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).StartTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/StartTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).StartTask(ctx, req.(*StartTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CancelTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_FailTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).FailTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/FailTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).FailTask(ctx, req.(*FailTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_RejectTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).RejectTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/RejectTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).RejectTask(ctx, req.(*RejectTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CompleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CompleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CompleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CompleteTask(ctx, req.(*CompleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ResolvingTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ResolvingTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ResolvingTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ResolvingTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskGuidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeleteTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeleteTask(ctx, req.(*TaskGuidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ScheduledTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduledTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ScheduledTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ScheduledTasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ScheduledTasks(ctx, req.(*ScheduledTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DesireScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DesireScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DesireScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DesireScheduledTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DesireScheduledTask(ctx, req.(*DesireScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UpdateScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UpdateScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UpdateScheduledTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UpdateScheduledTask(ctx, req.(*UpdateScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_SuspendScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).SuspendScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/SuspendScheduledTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).SuspendScheduledTask(ctx, req.(*SuspendScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DeleteScheduledTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DeleteScheduledTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DeleteScheduledTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DeleteScheduledTask(ctx, req.(*DeleteScheduledTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_TaskCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TaskCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).TaskCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/TaskCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).TaskCallbacks(ctx, req.(*TaskCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ReplayTaskCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayTaskCallbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ReplayTaskCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ReplayTaskCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ReplayTaskCallback(ctx, req.(*ReplayTaskCallbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_AuditRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).AuditRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/AuditRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).AuditRecords(ctx, req.(*AuditRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_OverloadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OverloadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).OverloadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/OverloadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).OverloadStatus(ctx, req.(*OverloadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CrashStormBreakers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashStormBreakersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CrashStormBreakers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CrashStormBreakers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CrashStormBreakers(ctx, req.(*CrashStormBreakersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_ResetCrashStormBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetCrashStormBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).ResetCrashStormBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/ResetCrashStormBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).ResetCrashStormBreaker(ctx, req.(*ResetCrashStormBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_LRPGroupEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsByCellId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).LRPGroupEvents(m, &bBSLRPGroupEventsServer{stream})
+}
+
+type BBS_LRPGroupEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSLRPGroupEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSLRPGroupEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_LRPInstanceEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsByCellId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).LRPInstanceEvents(m, &bBSLRPInstanceEventsServer{stream})
+}
+
+type BBS_LRPInstanceEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSLRPInstanceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSLRPInstanceEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_TaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsByCellId)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BBSServer).TaskEvents(m, &bBSTaskEventsServer{stream})
+}
+
+type BBS_TaskEventsServer interface {
+	Send(*StreamedEvent) error
+	grpc.ServerStream
+}
+
+type bBSTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *bBSTaskEventsServer) Send(m *StreamedEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BBS_Cells_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CellsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).Cells(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/Cells",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).Cells(ctx, req.(*CellsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CordonCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CordonCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CordonCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CordonCell(ctx, req.(*CordonCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_UncordonCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).UncordonCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/UncordonCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).UncordonCell(ctx, req.(*UncordonCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_DrainCell_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainCellRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).DrainCell(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/DrainCell",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).DrainCell(ctx, req.(*DrainCellRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BBS_CellDrains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CellDrainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BBSServer).CellDrains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.BBS/CellDrains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BBSServer).CellDrains(ctx, req.(*CellDrainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BBS_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "models.BBS",
+	HandlerType: (*BBSServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Ping",
+			Handler:    _BBS_Ping_Handler,
+		},
+		{
+			MethodName: "Domains",
+			Handler:    _BBS_Domains_Handler,
+		},
+		{
+			MethodName: "UpsertDomain",
+			Handler:    _BBS_UpsertDomain_Handler,
+		},
+		{
+			MethodName: "DomainQuotas",
+			Handler:    _BBS_DomainQuotas_Handler,
+		},
+		{
+			MethodName: "SetDomainQuota",
+			Handler:    _BBS_SetDomainQuota_Handler,
+		},
+		{
+			MethodName: "RemoveDomainQuota",
+			Handler:    _BBS_RemoveDomainQuota_Handler,
+		},
+		{
+			MethodName: "DomainUsage",
+			Handler:    _BBS_DomainUsage_Handler,
+		},
+		{
+			MethodName: "ActualLRPs",
+			Handler:    _BBS_ActualLRPs_Handler,
+		},
+		{
+			MethodName: "ActualLRPsByProcessGuids",
+			Handler:    _BBS_ActualLRPsByProcessGuids_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroups",
+			Handler:    _BBS_ActualLRPGroups_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupsByProcessGuid",
+			Handler:    _BBS_ActualLRPGroupsByProcessGuid_Handler,
+		},
+		{
+			MethodName: "ActualLRPGroupByProcessGuidAndIndex",
+			Handler:    _BBS_ActualLRPGroupByProcessGuidAndIndex_Handler,
+		},
+		{
+			MethodName: "ActualLRPHistory",
+			Handler:    _BBS_ActualLRPHistory_Handler,
+		},
+		{
+			MethodName: "ClaimActualLRP",
+			Handler:    _BBS_ClaimActualLRP_Handler,
+		},
+		{
+			MethodName: "StartActualLRP",
+			Handler:    _BBS_StartActualLRP_Handler,
+		},
+		{
+			MethodName: "CrashActualLRP",
+			Handler:    _BBS_CrashActualLRP_Handler,
+		},
+		{
+			MethodName: "FailActualLRP",
+			Handler:    _BBS_FailActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveActualLRP",
+			Handler:    _BBS_RemoveActualLRP_Handler,
+		},
+		{
+			MethodName: "RetireActualLRP",
+			Handler:    _BBS_RetireActualLRP_Handler,
+		},
+		{
+			MethodName: "RemoveEvacuatingActualLRP",
+			Handler:    _BBS_RemoveEvacuatingActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateClaimedActualLRP",
+			Handler:    _BBS_EvacuateClaimedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateCrashedActualLRP",
+			Handler:    _BBS_EvacuateCrashedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateStoppedActualLRP",
+			Handler:    _BBS_EvacuateStoppedActualLRP_Handler,
+		},
+		{
+			MethodName: "EvacuateRunningActualLRP",
+			Handler:    _BBS_EvacuateRunningActualLRP_Handler,
+		},
+		{
+			MethodName: "DesiredLRPs",
+			Handler:    _BBS_DesiredLRPs_Handler,
+		},
+		{
+			MethodName: "DesiredLRPByProcessGuid",
+			Handler:    _BBS_DesiredLRPByProcessGuid_Handler,
+		},
+		{
+			MethodName: "DesiredLRPSchedulingInfos",
+			Handler:    _BBS_DesiredLRPSchedulingInfos_Handler,
+		},
+		{
+			MethodName: "DesiredLRPSchedulingInfoByProcessGuid",
+			Handler:    _BBS_DesiredLRPSchedulingInfoByProcessGuid_Handler,
+		},
+		{
+			MethodName: "DesiredLRPRoutingInfos",
+			Handler:    _BBS_DesiredLRPRoutingInfos_Handler,
+		},
+		{
+			MethodName: "DesireDesiredLRP",
+			Handler:    _BBS_DesireDesiredLRP_Handler,
+		},
+		{
+			MethodName: "UpdateDesiredLRP",
+			Handler:    _BBS_UpdateDesiredLRP_Handler,
+		},
+		{
+			MethodName: "RemoveDesiredLRP",
+			Handler:    _BBS_RemoveDesiredLRP_Handler,
+		},
+		{
+			MethodName: "StartDeployment",
+			Handler:    _BBS_StartDeployment_Handler,
+		},
+		{
+			MethodName: "DeploymentByProcessGuid",
+			Handler:    _BBS_DeploymentByProcessGuid_Handler,
+		},
+		{
+			MethodName: "PauseDeployment",
+			Handler:    _BBS_PauseDeployment_Handler,
+		},
+		{
+			MethodName: "ResumeDeployment",
+			Handler:    _BBS_ResumeDeployment_Handler,
+		},
+		{
+			MethodName: "RollbackDeployment",
+			Handler:    _BBS_RollbackDeployment_Handler,
+		},
+		{
+			MethodName: "Tasks",
+			Handler:    _BBS_Tasks_Handler,
+		},
+		{
+			MethodName: "TaskByGuid",
+			Handler:    _BBS_TaskByGuid_Handler,
+		},
+		{
+			MethodName: "DesireTask",
+			Handler:    _BBS_DesireTask_Handler,
+		},
+		{
+			MethodName: "StartTask",
+			Handler:    _BBS_StartTask_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _BBS_CancelTask_Handler,
+		},
+		{
+			MethodName: "FailTask",
+			Handler:    _BBS_FailTask_Handler,
+		},
+		{
+			MethodName: "RejectTask",
+			Handler:    _BBS_RejectTask_Handler,
+		},
+		{
+			MethodName: "CompleteTask",
+			Handler:    _BBS_CompleteTask_Handler,
+		},
+		{
+			MethodName: "ResolvingTask",
+			Handler:    _BBS_ResolvingTask_Handler,
+		},
+		{
+			MethodName: "DeleteTask",
+			Handler:    _BBS_DeleteTask_Handler,
+		},
+		{
+			MethodName: "ScheduledTasks",
+			Handler:    _BBS_ScheduledTasks_Handler,
+		},
+		{
+			MethodName: "DesireScheduledTask",
+			Handler:    _BBS_DesireScheduledTask_Handler,
+		},
+		{
+			MethodName: "UpdateScheduledTask",
+			Handler:    _BBS_UpdateScheduledTask_Handler,
+		},
+		{
+			MethodName: "SuspendScheduledTask",
+			Handler:    _BBS_SuspendScheduledTask_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledTask",
+			Handler:    _BBS_DeleteScheduledTask_Handler,
+		},
+		{
+			MethodName: "TaskCallbacks",
+			Handler:    _BBS_TaskCallbacks_Handler,
+		},
+		{
+			MethodName: "ReplayTaskCallback",
+			Handler:    _BBS_ReplayTaskCallback_Handler,
+		},
+		{
+			MethodName: "AuditRecords",
+			Handler:    _BBS_AuditRecords_Handler,
+		},
+		{
+			MethodName: "OverloadStatus",
+			Handler:    _BBS_OverloadStatus_Handler,
+		},
+		{
+			MethodName: "CrashStormBreakers",
+			Handler:    _BBS_CrashStormBreakers_Handler,
+		},
+		{
+			MethodName: "ResetCrashStormBreaker",
+			Handler:    _BBS_ResetCrashStormBreaker_Handler,
+		},
+		{
+			MethodName: "Cells",
+			Handler:    _BBS_Cells_Handler,
+		},
+		{
+			MethodName: "CordonCell",
+			Handler:    _BBS_CordonCell_Handler,
+		},
+		{
+			MethodName: "UncordonCell",
+			Handler:    _BBS_UncordonCell_Handler,
+		},
+		{
+			MethodName: "DrainCell",
+			Handler:    _BBS_DrainCell_Handler,
+		},
+		{
+			MethodName: "CellDrains",
+			Handler:    _BBS_CellDrains_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "LRPGroupEvents",
+			Handler:       _BBS_LRPGroupEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "LRPInstanceEvents",
+			Handler:       _BBS_LRPInstanceEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TaskEvents",
+			Handler:       _BBS_TaskEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "bbs.proto",
+}
@@ -0,0 +1,46 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *CellDrain_State) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := CellDrain_State_value[name]; found {
+		*s = CellDrain_State(v)
+		return nil
+	}
+	return fmt.Errorf("invalid cell drain state: %s", name)
+}
+
+func (s CellDrain_State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+func (request *CordonCellRequest) Validate() error {
+	return validateCellId(request.CellId)
+}
+
+func (request *UncordonCellRequest) Validate() error {
+	return validateCellId(request.CellId)
+}
+
+func (request *DrainCellRequest) Validate() error {
+	return validateCellId(request.CellId)
+}
+
+func (request *CellDrainsRequest) Validate() error {
+	return nil
+}
+
+func validateCellId(cellId string) error {
+	if cellId == "" {
+		return ErrInvalidField{"cell_id"}
+	}
+	return nil
+}
@@ -0,0 +1,2530 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: cell_drain.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CellDrain_State int32
+
+const (
+	CellDrain_Draining CellDrain_State = 0
+	CellDrain_Drained  CellDrain_State = 1
+	CellDrain_Failed   CellDrain_State = 2
+)
+
+var CellDrain_State_name = map[int32]string{
+	0: "Draining",
+	1: "Drained",
+	2: "Failed",
+}
+
+var CellDrain_State_value = map[string]int32{
+	"Draining": 0,
+	"Drained":  1,
+	"Failed":   2,
+}
+
+func (CellDrain_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{0, 0}
+}
+
+// CellDrain tracks the evacuation of a cell requested through the API.
+// initial_instances is the number of ActualLRPs on the cell when the drain
+// started and remaining_instances the number still on it when the drain was
+// last updated. error is why the rep could not be asked to evacuate.
+type CellDrain struct {
+	CellId             string          `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	State              CellDrain_State `protobuf:"varint,2,opt,name=state,proto3,enum=models.CellDrain_State" json:"state"`
+	InitialInstances   int32           `protobuf:"varint,3,opt,name=initial_instances,json=initialInstances,proto3" json:"initial_instances"`
+	RemainingInstances int32           `protobuf:"varint,4,opt,name=remaining_instances,json=remainingInstances,proto3" json:"remaining_instances"`
+	Error              string          `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	StartedAt          int64           `protobuf:"varint,6,opt,name=started_at,json=startedAt,proto3" json:"started_at"`
+	UpdatedAt          int64           `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *CellDrain) Reset()      { *m = CellDrain{} }
+func (*CellDrain) ProtoMessage() {}
+func (*CellDrain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{0}
+}
+func (m *CellDrain) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellDrain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellDrain.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellDrain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellDrain.Merge(m, src)
+}
+func (m *CellDrain) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellDrain) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellDrain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellDrain proto.InternalMessageInfo
+
+func (m *CellDrain) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *CellDrain) GetState() CellDrain_State {
+	if m != nil {
+		return m.State
+	}
+	return CellDrain_Draining
+}
+
+func (m *CellDrain) GetInitialInstances() int32 {
+	if m != nil {
+		return m.InitialInstances
+	}
+	return 0
+}
+
+func (m *CellDrain) GetRemainingInstances() int32 {
+	if m != nil {
+		return m.RemainingInstances
+	}
+	return 0
+}
+
+func (m *CellDrain) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CellDrain) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *CellDrain) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type CordonCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+}
+
+func (m *CordonCellRequest) Reset()      { *m = CordonCellRequest{} }
+func (*CordonCellRequest) ProtoMessage() {}
+func (*CordonCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{1}
+}
+func (m *CordonCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CordonCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonCellRequest.Merge(m, src)
+}
+func (m *CordonCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonCellRequest proto.InternalMessageInfo
+
+func (m *CordonCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type CordonCellResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *CordonCellResponse) Reset()      { *m = CordonCellResponse{} }
+func (*CordonCellResponse) ProtoMessage() {}
+func (*CordonCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{2}
+}
+func (m *CordonCellResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CordonCellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CordonCellResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CordonCellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CordonCellResponse.Merge(m, src)
+}
+func (m *CordonCellResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CordonCellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CordonCellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CordonCellResponse proto.InternalMessageInfo
+
+func (m *CordonCellResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type UncordonCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+}
+
+func (m *UncordonCellRequest) Reset()      { *m = UncordonCellRequest{} }
+func (*UncordonCellRequest) ProtoMessage() {}
+func (*UncordonCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{3}
+}
+func (m *UncordonCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UncordonCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UncordonCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UncordonCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncordonCellRequest.Merge(m, src)
+}
+func (m *UncordonCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UncordonCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncordonCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncordonCellRequest proto.InternalMessageInfo
+
+func (m *UncordonCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type UncordonCellResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *UncordonCellResponse) Reset()      { *m = UncordonCellResponse{} }
+func (*UncordonCellResponse) ProtoMessage() {}
+func (*UncordonCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{4}
+}
+func (m *UncordonCellResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UncordonCellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UncordonCellResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UncordonCellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UncordonCellResponse.Merge(m, src)
+}
+func (m *UncordonCellResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UncordonCellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UncordonCellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UncordonCellResponse proto.InternalMessageInfo
+
+func (m *UncordonCellResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type DrainCellRequest struct {
+	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+}
+
+func (m *DrainCellRequest) Reset()      { *m = DrainCellRequest{} }
+func (*DrainCellRequest) ProtoMessage() {}
+func (*DrainCellRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{5}
+}
+func (m *DrainCellRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainCellRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainCellRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainCellRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainCellRequest.Merge(m, src)
+}
+func (m *DrainCellRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainCellRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainCellRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainCellRequest proto.InternalMessageInfo
+
+func (m *DrainCellRequest) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+type DrainCellResponse struct {
+	Error *Error     `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Drain *CellDrain `protobuf:"bytes,2,opt,name=drain,proto3" json:"drain,omitempty"`
+}
+
+func (m *DrainCellResponse) Reset()      { *m = DrainCellResponse{} }
+func (*DrainCellResponse) ProtoMessage() {}
+func (*DrainCellResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{6}
+}
+func (m *DrainCellResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainCellResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainCellResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainCellResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainCellResponse.Merge(m, src)
+}
+func (m *DrainCellResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainCellResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainCellResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainCellResponse proto.InternalMessageInfo
+
+func (m *DrainCellResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DrainCellResponse) GetDrain() *CellDrain {
+	if m != nil {
+		return m.Drain
+	}
+	return nil
+}
+
+type CellDrainsRequest struct {
+}
+
+func (m *CellDrainsRequest) Reset()      { *m = CellDrainsRequest{} }
+func (*CellDrainsRequest) ProtoMessage() {}
+func (*CellDrainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{7}
+}
+func (m *CellDrainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellDrainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellDrainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellDrainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellDrainsRequest.Merge(m, src)
+}
+func (m *CellDrainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellDrainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellDrainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellDrainsRequest proto.InternalMessageInfo
+
+type CellDrainsResponse struct {
+	Error  *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Drains []*CellDrain `protobuf:"bytes,2,rep,name=drains,proto3" json:"drains,omitempty"`
+}
+
+func (m *CellDrainsResponse) Reset()      { *m = CellDrainsResponse{} }
+func (*CellDrainsResponse) ProtoMessage() {}
+func (*CellDrainsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6542154ebede9d43, []int{8}
+}
+func (m *CellDrainsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellDrainsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellDrainsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellDrainsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellDrainsResponse.Merge(m, src)
+}
+func (m *CellDrainsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellDrainsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellDrainsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellDrainsResponse proto.InternalMessageInfo
+
+func (m *CellDrainsResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CellDrainsResponse) GetDrains() []*CellDrain {
+	if m != nil {
+		return m.Drains
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("models.CellDrain_State", CellDrain_State_name, CellDrain_State_value)
+	proto.RegisterType((*CellDrain)(nil), "models.CellDrain")
+	proto.RegisterType((*CordonCellRequest)(nil), "models.CordonCellRequest")
+	proto.RegisterType((*CordonCellResponse)(nil), "models.CordonCellResponse")
+	proto.RegisterType((*UncordonCellRequest)(nil), "models.UncordonCellRequest")
+	proto.RegisterType((*UncordonCellResponse)(nil), "models.UncordonCellResponse")
+	proto.RegisterType((*DrainCellRequest)(nil), "models.DrainCellRequest")
+	proto.RegisterType((*DrainCellResponse)(nil), "models.DrainCellResponse")
+	proto.RegisterType((*CellDrainsRequest)(nil), "models.CellDrainsRequest")
+	proto.RegisterType((*CellDrainsResponse)(nil), "models.CellDrainsResponse")
+}
+
+func init() { proto.RegisterFile("cell_drain.proto", fileDescriptor_6542154ebede9d43) }
+
+var fileDescriptor_6542154ebede9d43 = []byte{
+	// 519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x35, 0xd8, 0x21, 0x2f, 0x50, 0x9c, 0x0b, 0xa8, 0x56, 0x87, 0x73, 0x64, 0x90, 0x48,
+	0x25, 0xea, 0x4a, 0x81, 0xa1, 0x55, 0xa7, 0xba, 0x80, 0xe8, 0x6a, 0xc4, 0x1c, 0x39, 0xf1, 0x11,
+	0x4e, 0x72, 0x7c, 0xc1, 0x77, 0x19, 0xd8, 0xf8, 0x09, 0xfc, 0x0c, 0x66, 0x7e, 0x05, 0x63, 0xc6,
+	0x4e, 0x16, 0x71, 0x16, 0xe4, 0xa9, 0x3f, 0x01, 0xf9, 0xec, 0x24, 0x46, 0xed, 0x40, 0x98, 0xf2,
+	0xde, 0xf7, 0xbe, 0xf7, 0x7d, 0xce, 0x77, 0x77, 0x60, 0x8e, 0x69, 0x14, 0x0d, 0xc3, 0x24, 0x60,
+	0xb1, 0x3b, 0x4b, 0xb8, 0xe4, 0xd8, 0x98, 0xf2, 0x90, 0x46, 0xe2, 0xf0, 0x78, 0xc2, 0xe4, 0xa7,
+	0xf9, 0xc8, 0x1d, 0xf3, 0xe9, 0xc9, 0x84, 0x4f, 0xf8, 0x89, 0x1a, 0x8f, 0xe6, 0x1f, 0x55, 0xa7,
+	0x1a, 0x55, 0x95, 0x6b, 0x87, 0x6d, 0x9a, 0x24, 0x3c, 0x29, 0x1b, 0xe7, 0x47, 0x03, 0x5a, 0x97,
+	0x34, 0x8a, 0x5e, 0x17, 0xba, 0xf8, 0x19, 0x34, 0x95, 0x0b, 0x0b, 0x2d, 0xd4, 0x43, 0xfd, 0x96,
+	0xd7, 0xce, 0x53, 0x7b, 0x0d, 0xf9, 0x46, 0x51, 0x5c, 0x85, 0xf8, 0x14, 0x74, 0x21, 0x03, 0x49,
+	0xad, 0xbd, 0x1e, 0xea, 0xef, 0x0f, 0x0e, 0xdc, 0xf2, 0x3b, 0xdc, 0x8d, 0x8e, 0xfb, 0xbe, 0x18,
+	0x7b, 0xad, 0x3c, 0xb5, 0x4b, 0xa6, 0x5f, 0xfe, 0x60, 0x0f, 0x3a, 0x2c, 0x66, 0x92, 0x05, 0xd1,
+	0x90, 0xc5, 0x42, 0x06, 0xf1, 0x98, 0x0a, 0xab, 0xd1, 0x43, 0x7d, 0xdd, 0x7b, 0x92, 0xa7, 0xf6,
+	0xed, 0xa1, 0x6f, 0x56, 0xd0, 0xd5, 0x1a, 0xc1, 0xef, 0xa0, 0x9b, 0xd0, 0x69, 0xc0, 0x62, 0x16,
+	0x4f, 0x6a, 0x2a, 0xf7, 0x94, 0xca, 0x41, 0x9e, 0xda, 0x77, 0x8d, 0x7d, 0xbc, 0x01, 0xb7, 0x4a,
+	0x47, 0xa0, 0xab, 0x28, 0x2c, 0x5d, 0xfd, 0xd7, 0x6e, 0x9e, 0xda, 0x8f, 0x14, 0xf0, 0x82, 0x4f,
+	0x99, 0xa4, 0xd3, 0x99, 0xfc, 0xe2, 0x97, 0x0c, 0x7c, 0x0c, 0x20, 0x64, 0x90, 0x48, 0x1a, 0x0e,
+	0x03, 0x69, 0x19, 0x3d, 0xd4, 0x6f, 0x78, 0xfb, 0x79, 0x6a, 0xd7, 0x50, 0xbf, 0x55, 0xd5, 0x17,
+	0xb2, 0xa0, 0xcf, 0x67, 0x61, 0x50, 0xd1, 0x9b, 0x5b, 0xfa, 0x16, 0xf5, 0x5b, 0x55, 0x7d, 0x21,
+	0x1d, 0x17, 0x74, 0x95, 0x18, 0x7e, 0x00, 0xf7, 0x55, 0x80, 0x2c, 0x9e, 0x98, 0x1a, 0x6e, 0x43,
+	0x53, 0x75, 0x34, 0x34, 0x11, 0x06, 0x30, 0xde, 0x06, 0x2c, 0xa2, 0xa1, 0xb9, 0xe7, 0x9c, 0x41,
+	0xe7, 0x92, 0x27, 0x21, 0x8f, 0x8b, 0xc4, 0x7d, 0xfa, 0x79, 0x4e, 0x85, 0xfc, 0xb7, 0xb3, 0x73,
+	0xce, 0x00, 0xd7, 0x57, 0xc5, 0x8c, 0xc7, 0x82, 0xe2, 0xa7, 0xeb, 0x24, 0x8a, 0xcd, 0xf6, 0xe0,
+	0xe1, 0xfa, 0x44, 0xdf, 0x14, 0x60, 0x95, 0x81, 0x73, 0x0e, 0xdd, 0x0f, 0xf1, 0xf8, 0x3f, 0x7d,
+	0xcf, 0xe1, 0xf1, 0xdf, 0xcb, 0xbb, 0x38, 0x9f, 0x82, 0xa9, 0x82, 0xd8, 0xdd, 0x36, 0x80, 0x4e,
+	0x6d, 0x73, 0x07, 0x4f, 0xfc, 0x1c, 0x74, 0xf5, 0xd6, 0xd4, 0x25, 0x6f, 0x0f, 0x3a, 0xb7, 0x2e,
+	0xb9, 0x5f, 0xce, 0x9d, 0x2e, 0x74, 0x36, 0x98, 0xa8, 0xbe, 0xce, 0x09, 0x01, 0xd7, 0xc1, 0x5d,
+	0x8c, 0x8f, 0xc0, 0x50, 0xc2, 0xc2, 0xda, 0xeb, 0x35, 0xee, 0x76, 0xae, 0x08, 0xde, 0xab, 0xc5,
+	0x92, 0xa0, 0xeb, 0x25, 0xd1, 0x6e, 0x96, 0x04, 0x7d, 0xcd, 0x08, 0xfa, 0x9e, 0x11, 0xf4, 0x33,
+	0x23, 0x68, 0x91, 0x11, 0xf4, 0x2b, 0x23, 0xe8, 0x77, 0x46, 0xb4, 0x9b, 0x8c, 0xa0, 0x6f, 0x2b,
+	0xa2, 0x2d, 0x56, 0x44, 0xbb, 0x5e, 0x11, 0x6d, 0x64, 0xa8, 0x97, 0xff, 0xf2, 0xcf, 0x00, 0x57,
+	0x61, 0xe6, 0x3c, 0x51, 0x04, 0x00, 0x00,
+}
+
+func (x CellDrain_State) String() string {
+	s, ok := CellDrain_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *CellDrain) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellDrain)
+	if !ok {
+		that2, ok := that.(CellDrain)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.InitialInstances != that1.InitialInstances {
+		return false
+	}
+	if this.RemainingInstances != that1.RemainingInstances {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	if this.StartedAt != that1.StartedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+func (this *CordonCellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CordonCellRequest)
+	if !ok {
+		that2, ok := that.(CordonCellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	return true
+}
+func (this *CordonCellResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CordonCellResponse)
+	if !ok {
+		that2, ok := that.(CordonCellResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *UncordonCellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UncordonCellRequest)
+	if !ok {
+		that2, ok := that.(UncordonCellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	return true
+}
+func (this *UncordonCellResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UncordonCellResponse)
+	if !ok {
+		that2, ok := that.(UncordonCellResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *DrainCellRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainCellRequest)
+	if !ok {
+		that2, ok := that.(DrainCellRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	return true
+}
+func (this *DrainCellResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DrainCellResponse)
+	if !ok {
+		that2, ok := that.(DrainCellResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Drain.Equal(that1.Drain) {
+		return false
+	}
+	return true
+}
+func (this *CellDrainsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellDrainsRequest)
+	if !ok {
+		that2, ok := that.(CellDrainsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *CellDrainsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellDrainsResponse)
+	if !ok {
+		that2, ok := that.(CellDrainsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Drains) != len(that1.Drains) {
+		return false
+	}
+	for i := range this.Drains {
+		if !this.Drains[i].Equal(that1.Drains[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CellDrain) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.CellDrain{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "InitialInstances: "+fmt.Sprintf("%#v", this.InitialInstances)+",\n")
+	s = append(s, "RemainingInstances: "+fmt.Sprintf("%#v", this.RemainingInstances)+",\n")
+	s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	s = append(s, "StartedAt: "+fmt.Sprintf("%#v", this.StartedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CordonCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.CordonCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CordonCellResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.CordonCellResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UncordonCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.UncordonCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UncordonCellResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.UncordonCellResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainCellRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DrainCellRequest{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DrainCellResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DrainCellResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Drain != nil {
+		s = append(s, "Drain: "+fmt.Sprintf("%#v", this.Drain)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellDrainsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.CellDrainsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellDrainsResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellDrainsResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Drains != nil {
+		s = append(s, "Drains: "+fmt.Sprintf("%#v", this.Drains)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCellDrain(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CellDrain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellDrain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellDrain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintCellDrain(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintCellDrain(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintCellDrain(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.RemainingInstances != 0 {
+		i = encodeVarintCellDrain(dAtA, i, uint64(m.RemainingInstances))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialInstances != 0 {
+		i = encodeVarintCellDrain(dAtA, i, uint64(m.InitialInstances))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintCellDrain(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellDrain(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellDrain(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CordonCellResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CordonCellResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CordonCellResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCellDrain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UncordonCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UncordonCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UncordonCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellDrain(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UncordonCellResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UncordonCellResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UncordonCellResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCellDrain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainCellRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainCellRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainCellRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintCellDrain(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainCellResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainCellResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainCellResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Drain != nil {
+		{
+			size, err := m.Drain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCellDrain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCellDrain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellDrainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellDrainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellDrainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CellDrainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellDrainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellDrainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Drains) > 0 {
+		for iNdEx := len(m.Drains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Drains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCellDrain(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCellDrain(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCellDrain(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCellDrain(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CellDrain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovCellDrain(uint64(m.State))
+	}
+	if m.InitialInstances != 0 {
+		n += 1 + sovCellDrain(uint64(m.InitialInstances))
+	}
+	if m.RemainingInstances != 0 {
+		n += 1 + sovCellDrain(uint64(m.RemainingInstances))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovCellDrain(uint64(m.StartedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovCellDrain(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *CordonCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *CordonCellResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *UncordonCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *UncordonCellResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainCellRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *DrainCellResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	if m.Drain != nil {
+		l = m.Drain.Size()
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	return n
+}
+
+func (m *CellDrainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CellDrainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCellDrain(uint64(l))
+	}
+	if len(m.Drains) > 0 {
+		for _, e := range m.Drains {
+			l = e.Size()
+			n += 1 + l + sovCellDrain(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovCellDrain(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCellDrain(x uint64) (n int) {
+	return sovCellDrain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CellDrain) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellDrain{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`InitialInstances:` + fmt.Sprintf("%v", this.InitialInstances) + `,`,
+		`RemainingInstances:` + fmt.Sprintf("%v", this.RemainingInstances) + `,`,
+		`Error:` + fmt.Sprintf("%v", this.Error) + `,`,
+		`StartedAt:` + fmt.Sprintf("%v", this.StartedAt) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CordonCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CordonCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CordonCellResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CordonCellResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UncordonCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UncordonCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UncordonCellResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UncordonCellResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainCellRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainCellRequest{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DrainCellResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DrainCellResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Drain:` + strings.Replace(this.Drain.String(), "CellDrain", "CellDrain", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellDrainsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellDrainsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellDrainsResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDrains := "[]*CellDrain{"
+	for _, f := range this.Drains {
+		repeatedStringForDrains += strings.Replace(f.String(), "CellDrain", "CellDrain", 1) + ","
+	}
+	repeatedStringForDrains += "}"
+	s := strings.Join([]string{`&CellDrainsResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Drains:` + repeatedStringForDrains + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCellDrain(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CellDrain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellDrain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellDrain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= CellDrain_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInstances", wireType)
+			}
+			m.InitialInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInstances", wireType)
+			}
+			m.RemainingInstances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingInstances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+			}
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CordonCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CordonCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CordonCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CordonCellResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CordonCellResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CordonCellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UncordonCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UncordonCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UncordonCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UncordonCellResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UncordonCellResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UncordonCellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainCellRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainCellRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainCellRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainCellResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainCellResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainCellResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Drain == nil {
+				m.Drain = &CellDrain{}
+			}
+			if err := m.Drain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellDrainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellDrainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellDrainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CellDrainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellDrainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellDrainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Drains = append(m.Drains, &CellDrain{})
+			if err := m.Drains[len(m.Drains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCellDrain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCellDrain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCellDrain(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCellDrain
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCellDrain
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCellDrain
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCellDrain
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCellDrain
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCellDrain        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCellDrain          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCellDrain = fmt.Errorf("proto: unexpected end of group")
+)
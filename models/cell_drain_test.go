@@ -0,0 +1,43 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CellDrain", func() {
+	Describe("serialization", func() {
+		It("serializes the state by name", func() {
+			drain := &models.CellDrain{CellId: "cell-1", State: models.CellDrain_Drained}
+
+			payload, err := json.Marshal(drain)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(payload)).To(ContainSubstring(`"state":"Drained"`))
+
+			decoded := &models.CellDrain{}
+			Expect(json.Unmarshal(payload, decoded)).To(Succeed())
+			Expect(decoded).To(Equal(drain))
+		})
+
+		It("rejects unknown states", func() {
+			decoded := &models.CellDrain{}
+			err := json.Unmarshal([]byte(`{"state":"Cordoned"}`), decoded)
+			Expect(err).To(MatchError("invalid cell drain state: Cordoned"))
+		})
+	})
+
+	Describe("requests", func() {
+		It("requires the cell id of the cell to cordon, uncordon or drain", func() {
+			Expect((&models.CordonCellRequest{CellId: "cell-1"}).Validate()).To(Succeed())
+			Expect((&models.UncordonCellRequest{CellId: "cell-1"}).Validate()).To(Succeed())
+			Expect((&models.DrainCellRequest{CellId: "cell-1"}).Validate()).To(Succeed())
+
+			Expect((&models.CordonCellRequest{}).Validate()).To(MatchError(models.ErrInvalidField{"cell_id"}))
+			Expect((&models.UncordonCellRequest{}).Validate()).To(MatchError(models.ErrInvalidField{"cell_id"}))
+			Expect((&models.DrainCellRequest{}).Validate()).To(MatchError(models.ErrInvalidField{"cell_id"}))
+		})
+	})
+})
@@ -95,6 +95,7 @@ type CellPresence struct {
 	OptionalPlacementTags []string          `protobuf:"bytes,7,rep,name=optional_placement_tags,json=optionalPlacementTags,proto3" json:"optional_placement_tags,omitempty"`
 	RepUrl                string            `protobuf:"bytes,8,opt,name=rep_url,json=repUrl,proto3" json:"rep_url"`
 	Annotations           map[string]string `protobuf:"bytes,9,rep,name=annotations,proto3" json:"annotations" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cordoned              bool              `protobuf:"varint,10,opt,name=cordoned,proto3" json:"cordoned,omitempty"`
 }
 
 func (m *CellPresence) Reset()      { *m = CellPresence{} }
@@ -192,6 +193,13 @@ func (m *CellPresence) GetAnnotations() map[string]string {
 	return nil
 }
 
+func (m *CellPresence) GetCordoned() bool {
+	if m != nil {
+		return m.Cordoned
+	}
+	return false
+}
+
 type Provider struct {
 	Name       string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
 	Properties []string `protobuf:"bytes,2,rep,name=properties,proto3" json:"properties,omitempty"`
@@ -243,6 +251,41 @@ func (m *Provider) GetProperties() []string {
 	return nil
 }
 
+type CellsRequest struct {
+}
+
+func (m *CellsRequest) Reset()      { *m = CellsRequest{} }
+func (*CellsRequest) ProtoMessage() {}
+func (*CellsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_842e821272d22ff7, []int{3}
+}
+func (m *CellsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellsRequest.Merge(m, src)
+}
+func (m *CellsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellsRequest proto.InternalMessageInfo
+
 type CellsResponse struct {
 	Error *Error          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Cells []*CellPresence `protobuf:"bytes,2,rep,name=cells,proto3" json:"cells,omitempty"`
@@ -251,7 +294,7 @@ type CellsResponse struct {
 func (m *CellsResponse) Reset()      { *m = CellsResponse{} }
 func (*CellsResponse) ProtoMessage() {}
 func (*CellsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_842e821272d22ff7, []int{3}
+	return fileDescriptor_842e821272d22ff7, []int{4}
 }
 func (m *CellsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -299,52 +342,55 @@ func init() {
 	proto.RegisterType((*CellPresence)(nil), "models.CellPresence")
 	proto.RegisterMapType((map[string]string)(nil), "models.CellPresence.AnnotationsEntry")
 	proto.RegisterType((*Provider)(nil), "models.Provider")
+	proto.RegisterType((*CellsRequest)(nil), "models.CellsRequest")
 	proto.RegisterType((*CellsResponse)(nil), "models.CellsResponse")
 }
 
 func init() { proto.RegisterFile("cells.proto", fileDescriptor_842e821272d22ff7) }
 
 var fileDescriptor_842e821272d22ff7 = []byte{
-	// 617 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0xc1, 0x6a, 0xdb, 0x4c,
-	0x10, 0xb6, 0xe2, 0xd8, 0xb1, 0x57, 0xbf, 0x13, 0xb3, 0xe4, 0xa7, 0x26, 0x94, 0xb5, 0x71, 0x13,
-	0x30, 0xa1, 0x75, 0x42, 0x5a, 0x4a, 0xe9, 0xa1, 0x10, 0x87, 0x40, 0x7b, 0x08, 0x84, 0xa5, 0x85,
-	0xde, 0x54, 0x59, 0x9a, 0xb8, 0x22, 0x92, 0x76, 0xd9, 0x5d, 0x07, 0xdc, 0x53, 0x1f, 0x21, 0x8f,
-	0xd1, 0x27, 0xe8, 0x33, 0xf4, 0x98, 0x63, 0x4e, 0xa6, 0x51, 0x2e, 0x45, 0xa7, 0x3c, 0x42, 0xd9,
-	0x5d, 0x2b, 0x56, 0x7d, 0x11, 0xf3, 0x7d, 0xf3, 0xcd, 0x6a, 0xe6, 0x9b, 0x5d, 0xe4, 0x06, 0x10,
-	0xc7, 0x72, 0xc8, 0x05, 0x53, 0x0c, 0xd7, 0x13, 0x16, 0x42, 0x2c, 0x77, 0x5e, 0x4c, 0x22, 0xf5,
-	0x75, 0x3a, 0x1e, 0x06, 0x2c, 0x39, 0x98, 0xb0, 0x09, 0x3b, 0x30, 0xe9, 0xf1, 0xf4, 0xc2, 0x20,
-	0x03, 0x4c, 0x64, 0xcb, 0x76, 0x5c, 0x10, 0x82, 0x09, 0x0b, 0xfa, 0xd7, 0x0e, 0xfa, 0xef, 0x04,
-	0xe2, 0xf8, 0xc4, 0xe7, 0x7e, 0x10, 0xa9, 0x19, 0xde, 0x47, 0xcd, 0x04, 0x12, 0x26, 0x66, 0x5e,
-	0x32, 0xee, 0x38, 0x3d, 0x67, 0x50, 0x1b, 0xb5, 0xf2, 0x79, 0x77, 0x49, 0xd2, 0x86, 0x0d, 0xcf,
-	0xc6, 0x78, 0x17, 0x6d, 0x84, 0x91, 0xbc, 0xd4, 0xca, 0x35, 0xa3, 0x74, 0xf3, 0x79, 0xb7, 0xa0,
-	0x68, 0x5d, 0x07, 0x67, 0x63, 0x3c, 0x44, 0x28, 0x60, 0xa9, 0xf2, 0xa3, 0x14, 0x84, 0xec, 0x54,
-	0x8d, 0x70, 0x33, 0x9f, 0x77, 0x4b, 0x2c, 0x2d, 0xc5, 0xfd, 0x9f, 0xeb, 0xb6, 0xa5, 0x73, 0x01,
-	0x12, 0xd2, 0x00, 0xf4, 0x6f, 0xf4, 0xd8, 0x5e, 0x14, 0x9a, 0x86, 0x9a, 0xf6, 0x37, 0x0b, 0x8a,
-	0xd6, 0x75, 0xf0, 0x21, 0xc4, 0x87, 0xc8, 0x15, 0xc0, 0x3d, 0x3f, 0x0c, 0x05, 0x48, 0x69, 0x1a,
-	0x6a, 0x8e, 0xb6, 0xf2, 0x79, 0xb7, 0x4c, 0x53, 0x24, 0x80, 0x1f, 0xdb, 0x18, 0x3f, 0x45, 0xeb,
-	0xdf, 0x58, 0x0a, 0xa6, 0xa5, 0xe6, 0xa8, 0x91, 0xcf, 0xbb, 0x06, 0x53, 0xf3, 0xc5, 0x87, 0xa8,
-	0x11, 0x2c, 0x4c, 0xe9, 0xac, 0xf7, 0x9c, 0x81, 0x7b, 0xb4, 0x3d, 0xb4, 0x86, 0x0f, 0xcb, 0x86,
-	0xd1, 0x47, 0x15, 0xf6, 0x50, 0x5b, 0x30, 0xa6, 0x2e, 0xa4, 0xc7, 0x05, 0xbb, 0x8a, 0x42, 0x3d,
-	0x6e, 0xad, 0x57, 0x1d, 0xb8, 0x47, 0xed, 0xa2, 0xf2, 0x7c, 0x91, 0x18, 0xf5, 0xf3, 0x79, 0x97,
-	0xac, 0xa8, 0xbd, 0x38, 0x92, 0xea, 0x39, 0x4b, 0x22, 0x05, 0x09, 0x57, 0x33, 0xba, 0x65, 0xf3,
-	0x45, 0x8d, 0xc4, 0x7b, 0x68, 0x93, 0xc7, 0x7e, 0x00, 0x09, 0xa4, 0xca, 0x53, 0xfe, 0x44, 0x76,
-	0xea, 0xbd, 0xea, 0xa0, 0x49, 0x5b, 0x8f, 0xec, 0x47, 0x7f, 0x22, 0xf1, 0x6b, 0xf4, 0x84, 0x71,
-	0x15, 0xb1, 0xd4, 0x8f, 0xbd, 0x15, 0xfd, 0x86, 0xd1, 0xff, 0x5f, 0xa4, 0xcf, 0xff, 0xa9, 0xdb,
-	0x45, 0x1b, 0xda, 0xaa, 0xa9, 0x88, 0x3b, 0x8d, 0xa5, 0xcf, 0x0b, 0x8a, 0xd6, 0x05, 0xf0, 0x4f,
-	0x22, 0xc6, 0x9f, 0x91, 0xeb, 0xa7, 0x29, 0x53, 0xbe, 0x3e, 0x42, 0x76, 0x9a, 0x66, 0xc0, 0xbd,
-	0xb2, 0x35, 0xc5, 0xe2, 0x86, 0xc7, 0x4b, 0xdd, 0x69, 0xaa, 0xc4, 0xcc, 0xae, 0xa3, 0x54, 0x4d,
-	0xcb, 0x60, 0xe7, 0x1d, 0x6a, 0xaf, 0x56, 0xe0, 0x36, 0xaa, 0x5e, 0xc2, 0xcc, 0xee, 0x9d, 0xea,
-	0x10, 0x6f, 0xa3, 0xda, 0x95, 0x1f, 0x4f, 0xc1, 0x6e, 0x98, 0x5a, 0xf0, 0x76, 0xed, 0x8d, 0xd3,
-	0x7f, 0x8f, 0x1a, 0x85, 0x57, 0x7a, 0xb7, 0xa9, 0x9f, 0xc0, 0xe2, 0xc2, 0x98, 0xdd, 0x6a, 0x4c,
-	0xcd, 0x17, 0x13, 0x84, 0xb8, 0x60, 0x1c, 0x84, 0x8a, 0x40, 0x5f, 0x15, 0x6d, 0x4a, 0x89, 0xe9,
-	0x7f, 0x41, 0x2d, 0x3d, 0x88, 0xa4, 0x20, 0x39, 0x4b, 0x25, 0xe0, 0x67, 0xa8, 0x66, 0x5e, 0x8d,
-	0x39, 0xcf, 0x3d, 0x6a, 0x15, 0xe3, 0x9e, 0x6a, 0x92, 0xda, 0x1c, 0xde, 0x47, 0x35, 0xf3, 0x3c,
-	0xcd, 0x81, 0x2b, 0xd7, 0xa5, 0xf0, 0x84, 0x5a, 0xc9, 0xe8, 0xd5, 0xcd, 0x1d, 0xa9, 0xdc, 0xde,
-	0x91, 0xca, 0xc3, 0x1d, 0x71, 0xbe, 0x67, 0xc4, 0xf9, 0x91, 0x11, 0xe7, 0x57, 0x46, 0x9c, 0x9b,
-	0x8c, 0x38, 0xbf, 0x33, 0xe2, 0xfc, 0xc9, 0x48, 0xe5, 0x21, 0x23, 0xce, 0xf5, 0x3d, 0xa9, 0xdc,
-	0xdc, 0x93, 0xca, 0xed, 0x3d, 0xa9, 0x8c, 0xeb, 0xe6, 0xd1, 0xbe, 0xfc, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x33, 0xf0, 0xd9, 0x22, 0x07, 0x04, 0x00, 0x00,
+	// 642 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x53, 0x41, 0x6b, 0xdb, 0x30,
+	0x14, 0x8e, 0x9a, 0x26, 0x4d, 0xe4, 0xa5, 0x0d, 0xa2, 0xdb, 0x4c, 0x19, 0x72, 0xc8, 0x5a, 0x08,
+	0x65, 0x4b, 0x4b, 0x36, 0xc6, 0xd8, 0x61, 0xd0, 0x94, 0xc2, 0x76, 0x28, 0x14, 0xb1, 0xc1, 0x6e,
+	0x9e, 0x63, 0xab, 0x99, 0xa9, 0x6d, 0x79, 0x92, 0x52, 0xc8, 0x4e, 0xfb, 0x09, 0xfd, 0x19, 0xfb,
+	0x29, 0x3b, 0xf6, 0xd8, 0x93, 0x59, 0xdd, 0xcb, 0xf0, 0xa9, 0x3f, 0x61, 0x48, 0x8a, 0x1b, 0x2f,
+	0x17, 0xf3, 0xbe, 0xef, 0x7d, 0xcf, 0xef, 0xe9, 0xd3, 0x13, 0xb4, 0x7c, 0x1a, 0x45, 0x62, 0x98,
+	0x72, 0x26, 0x19, 0x6a, 0xc6, 0x2c, 0xa0, 0x91, 0xd8, 0x79, 0x39, 0x0d, 0xe5, 0xb7, 0xd9, 0x64,
+	0xe8, 0xb3, 0xf8, 0x60, 0xca, 0xa6, 0xec, 0x40, 0xa7, 0x27, 0xb3, 0x73, 0x8d, 0x34, 0xd0, 0x91,
+	0x29, 0xdb, 0xb1, 0x28, 0xe7, 0x8c, 0x1b, 0xd0, 0xbf, 0x02, 0xf0, 0xd1, 0x31, 0x8d, 0xa2, 0x63,
+	0x2f, 0xf5, 0xfc, 0x50, 0xce, 0xd1, 0x3e, 0x6c, 0xc7, 0x34, 0x66, 0x7c, 0xee, 0xc6, 0x13, 0x1b,
+	0xf4, 0xc0, 0xa0, 0x31, 0xee, 0x14, 0x99, 0xb3, 0x24, 0x49, 0xcb, 0x84, 0xa7, 0x13, 0xb4, 0x0b,
+	0x37, 0x82, 0x50, 0x5c, 0x28, 0xe5, 0x9a, 0x56, 0x5a, 0x45, 0xe6, 0x94, 0x14, 0x69, 0xaa, 0xe0,
+	0x74, 0x82, 0x86, 0x10, 0xfa, 0x2c, 0x91, 0x5e, 0x98, 0x50, 0x2e, 0xec, 0xba, 0x16, 0x6e, 0x16,
+	0x99, 0x53, 0x61, 0x49, 0x25, 0xee, 0x17, 0xeb, 0x66, 0xa4, 0x33, 0x4e, 0x05, 0x4d, 0x7c, 0xaa,
+	0xda, 0xa8, 0x63, 0xbb, 0x61, 0xa0, 0x07, 0x6a, 0x9b, 0x36, 0x0b, 0x8a, 0x34, 0x55, 0xf0, 0x31,
+	0x40, 0x87, 0xd0, 0xe2, 0x34, 0x75, 0xbd, 0x20, 0xe0, 0x54, 0x08, 0x3d, 0x50, 0x7b, 0xbc, 0x55,
+	0x64, 0x4e, 0x95, 0x26, 0x90, 0xd3, 0xf4, 0xc8, 0xc4, 0xe8, 0x19, 0x5c, 0xff, 0xc1, 0x12, 0xaa,
+	0x47, 0x6a, 0x8f, 0x5b, 0x45, 0xe6, 0x68, 0x4c, 0xf4, 0x17, 0x1d, 0xc2, 0x96, 0xbf, 0x30, 0xc5,
+	0x5e, 0xef, 0x81, 0x81, 0x35, 0xda, 0x1e, 0x1a, 0xc3, 0x87, 0x55, 0xc3, 0xc8, 0x83, 0x0a, 0xb9,
+	0xb0, 0xcb, 0x19, 0x93, 0xe7, 0xc2, 0x4d, 0x39, 0xbb, 0x0c, 0x03, 0x75, 0xdc, 0x46, 0xaf, 0x3e,
+	0xb0, 0x46, 0xdd, 0xb2, 0xf2, 0x6c, 0x91, 0x18, 0xf7, 0x8b, 0xcc, 0xc1, 0x2b, 0x6a, 0x37, 0x0a,
+	0x85, 0x7c, 0xc1, 0xe2, 0x50, 0xd2, 0x38, 0x95, 0x73, 0xb2, 0x65, 0xf2, 0x65, 0x8d, 0x40, 0x7b,
+	0x70, 0x33, 0x8d, 0x3c, 0x9f, 0xc6, 0x34, 0x91, 0xae, 0xf4, 0xa6, 0xc2, 0x6e, 0xf6, 0xea, 0x83,
+	0x36, 0xe9, 0x3c, 0xb0, 0x9f, 0xbc, 0xa9, 0x40, 0x6f, 0xe0, 0x53, 0x96, 0xca, 0x90, 0x25, 0x5e,
+	0xe4, 0xae, 0xe8, 0x37, 0xb4, 0xfe, 0x71, 0x99, 0x3e, 0xfb, 0xaf, 0x6e, 0x17, 0x6e, 0x28, 0xab,
+	0x66, 0x3c, 0xb2, 0x5b, 0x4b, 0x9f, 0x17, 0x14, 0x69, 0x72, 0x9a, 0x7e, 0xe6, 0x11, 0xfa, 0x02,
+	0x2d, 0x2f, 0x49, 0x98, 0xf4, 0xd4, 0x2f, 0x84, 0xdd, 0xd6, 0x07, 0xdc, 0xab, 0x5a, 0x53, 0x5e,
+	0xdc, 0xf0, 0x68, 0xa9, 0x3b, 0x49, 0x24, 0x9f, 0x9b, 0xeb, 0xa8, 0x54, 0x93, 0x2a, 0x40, 0x23,
+	0xd8, 0xf2, 0x19, 0x0f, 0x58, 0x42, 0x03, 0x1b, 0xf6, 0xc0, 0xa0, 0x35, 0x7e, 0x52, 0x64, 0x0e,
+	0x2a, 0xb9, 0x8a, 0x33, 0x0f, 0xba, 0x9d, 0xf7, 0xb0, 0xbb, 0xda, 0x05, 0x75, 0x61, 0xfd, 0x82,
+	0xce, 0xcd, 0xae, 0x10, 0x15, 0xa2, 0x6d, 0xd8, 0xb8, 0xf4, 0xa2, 0x19, 0x35, 0x5b, 0x41, 0x0c,
+	0x78, 0xb7, 0xf6, 0x16, 0xf4, 0x3f, 0xc0, 0x56, 0xe9, 0xaf, 0xda, 0x87, 0xc4, 0x8b, 0xa9, 0x0d,
+	0x96, 0xfb, 0xa0, 0x30, 0xd1, 0x5f, 0x84, 0x21, 0x4c, 0x39, 0x4b, 0x29, 0x97, 0x21, 0x55, 0xeb,
+	0xa5, 0x8c, 0xac, 0x30, 0xfd, 0x4d, 0xb3, 0xb5, 0x82, 0xd0, 0xef, 0x33, 0x2a, 0x64, 0xff, 0x2b,
+	0xec, 0x2c, 0xb0, 0x48, 0x59, 0x22, 0x28, 0x7a, 0x0e, 0x1b, 0xfa, 0xe5, 0xe9, 0xff, 0x5b, 0xa3,
+	0x4e, 0x69, 0xd9, 0x89, 0x22, 0x89, 0xc9, 0xa1, 0x7d, 0xd8, 0xd0, 0x4f, 0x5c, 0x37, 0x58, 0x59,
+	0xb9, 0xd2, 0x57, 0x62, 0x24, 0xe3, 0xd7, 0xd7, 0xb7, 0xb8, 0x76, 0x73, 0x8b, 0x6b, 0xf7, 0xb7,
+	0x18, 0xfc, 0xcc, 0x31, 0xf8, 0x95, 0x63, 0xf0, 0x3b, 0xc7, 0xe0, 0x3a, 0xc7, 0xe0, 0x4f, 0x8e,
+	0xc1, 0xdf, 0x1c, 0xd7, 0xee, 0x73, 0x0c, 0xae, 0xee, 0x70, 0xed, 0xfa, 0x0e, 0xd7, 0x6e, 0xee,
+	0x70, 0x6d, 0xd2, 0xd4, 0x0f, 0xff, 0xd5, 0xbf, 0x01, 0x00, 0x2b, 0xc2, 0xae, 0xb0, 0x4b, 0x04,
+	0x00, 0x00,
 }
 
 func (this *CellCapacity) Equal(that interface{}) bool {
@@ -443,6 +489,9 @@ func (this *CellPresence) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.Cordoned != that1.Cordoned {
+		return false
+	}
 	return true
 }
 func (this *Provider) Equal(that interface{}) bool {
@@ -477,6 +526,27 @@ func (this *Provider) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *CellsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellsRequest)
+	if !ok {
+		that2, ok := that.(CellsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *CellsResponse) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -525,7 +595,7 @@ func (this *CellPresence) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 13)
+	s := make([]string, 0, 14)
 	s = append(s, "&models.CellPresence{")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
 	s = append(s, "RepAddress: "+fmt.Sprintf("%#v", this.RepAddress)+",\n")
@@ -552,6 +622,7 @@ func (this *CellPresence) GoString() string {
 	if this.Annotations != nil {
 		s = append(s, "Annotations: "+mapStringForAnnotations+",\n")
 	}
+	s = append(s, "Cordoned: "+fmt.Sprintf("%#v", this.Cordoned)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -566,6 +637,15 @@ func (this *Provider) GoString() string {
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
+func (this *CellsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.CellsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
 func (this *CellsResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -647,6 +727,16 @@ func (m *CellPresence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Cordoned {
+		i--
+		if m.Cordoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
 	if len(m.Annotations) > 0 {
 		for k := range m.Annotations {
 			v := m.Annotations[k]
@@ -780,6 +870,29 @@ func (m *Provider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CellsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *CellsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -910,6 +1023,9 @@ func (m *CellPresence) Size() (n int) {
 			n += mapEntrySize + 1 + sovCells(uint64(mapEntrySize))
 		}
 	}
+	if m.Cordoned {
+		n += 2
+	}
 	return n
 }
 
@@ -932,6 +1048,15 @@ func (m *Provider) Size() (n int) {
 	return n
 }
 
+func (m *CellsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *CellsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -998,6 +1123,7 @@ func (this *CellPresence) String() string {
 		`OptionalPlacementTags:` + fmt.Sprintf("%v", this.OptionalPlacementTags) + `,`,
 		`RepUrl:` + fmt.Sprintf("%v", this.RepUrl) + `,`,
 		`Annotations:` + mapStringForAnnotations + `,`,
+		`Cordoned:` + fmt.Sprintf("%v", this.Cordoned) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1013,6 +1139,15 @@ func (this *Provider) String() string {
 	}, "")
 	return s
 }
+func (this *CellsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *CellsResponse) String() string {
 	if this == nil {
 		return "nil"
@@ -1562,6 +1697,26 @@ func (m *CellPresence) Unmarshal(dAtA []byte) error {
 			}
 			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCells
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Cordoned = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCells(dAtA[iNdEx:])
@@ -1697,6 +1852,56 @@ func (m *Provider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CellsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCells
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CellsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CellsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCells(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCells
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *CellsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
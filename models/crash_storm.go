@@ -0,0 +1,56 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *CrashStormBreaker_Scope) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := CrashStormBreaker_Scope_value[name]; found {
+		*s = CrashStormBreaker_Scope(v)
+		return nil
+	}
+	return fmt.Errorf("invalid crash storm breaker scope: %s", name)
+}
+
+func (s CrashStormBreaker_Scope) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
+
+// IsOpen reports whether the breaker pauses crash restarts at the time, in
+// nanoseconds.
+func (b *CrashStormBreaker) IsOpen(now int64) bool {
+	return b.ClosedAt == 0 && now < b.ClosesAt
+}
+
+func (request *CrashStormBreakersRequest) Validate() error {
+	return nil
+}
+
+func (request *ResetCrashStormBreakerRequest) Validate() error {
+	var validationError ValidationError
+
+	switch request.Scope {
+	case CrashStormBreaker_Global:
+		if request.Key != "" {
+			validationError = validationError.Append(ErrInvalidField{"key"})
+		}
+	case CrashStormBreaker_Domain, CrashStormBreaker_ProcessGuid:
+		if request.Key == "" {
+			validationError = validationError.Append(ErrInvalidField{"key"})
+		}
+	default:
+		validationError = validationError.Append(ErrInvalidField{"scope"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
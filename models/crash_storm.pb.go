@@ -0,0 +1,1639 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: crash_storm.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type CrashStormBreaker_Scope int32
+
+const (
+	CrashStormBreaker_Global      CrashStormBreaker_Scope = 0
+	CrashStormBreaker_Domain      CrashStormBreaker_Scope = 1
+	CrashStormBreaker_ProcessGuid CrashStormBreaker_Scope = 2
+)
+
+var CrashStormBreaker_Scope_name = map[int32]string{
+	0: "Global",
+	1: "Domain",
+	2: "ProcessGuid",
+}
+
+var CrashStormBreaker_Scope_value = map[string]int32{
+	"Global":      0,
+	"Domain":      1,
+	"ProcessGuid": 2,
+}
+
+func (CrashStormBreaker_Scope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{0, 0}
+}
+
+// CrashStormBreaker pauses the crash restarts of the ActualLRPs in its scope
+// after crash_count of them crashed within the detection window. key is the
+// domain or process guid of the scope, empty for the global scope. The
+// breaker closes by itself at closes_at unless it is reset before; closed_at
+// is when it closed, 0 while it is open.
+type CrashStormBreaker struct {
+	Scope      CrashStormBreaker_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=models.CrashStormBreaker_Scope" json:"scope"`
+	Key        string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	CrashCount int32                   `protobuf:"varint,3,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
+	TrippedAt  int64                   `protobuf:"varint,4,opt,name=tripped_at,json=trippedAt,proto3" json:"tripped_at"`
+	ClosesAt   int64                   `protobuf:"varint,5,opt,name=closes_at,json=closesAt,proto3" json:"closes_at"`
+	ClosedAt   int64                   `protobuf:"varint,6,opt,name=closed_at,json=closedAt,proto3" json:"closed_at,omitempty"`
+}
+
+func (m *CrashStormBreaker) Reset()      { *m = CrashStormBreaker{} }
+func (*CrashStormBreaker) ProtoMessage() {}
+func (*CrashStormBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{0}
+}
+func (m *CrashStormBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrashStormBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrashStormBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrashStormBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashStormBreaker.Merge(m, src)
+}
+func (m *CrashStormBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrashStormBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashStormBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrashStormBreaker proto.InternalMessageInfo
+
+func (m *CrashStormBreaker) GetScope() CrashStormBreaker_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return CrashStormBreaker_Global
+}
+
+func (m *CrashStormBreaker) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CrashStormBreaker) GetCrashCount() int32 {
+	if m != nil {
+		return m.CrashCount
+	}
+	return 0
+}
+
+func (m *CrashStormBreaker) GetTrippedAt() int64 {
+	if m != nil {
+		return m.TrippedAt
+	}
+	return 0
+}
+
+func (m *CrashStormBreaker) GetClosesAt() int64 {
+	if m != nil {
+		return m.ClosesAt
+	}
+	return 0
+}
+
+func (m *CrashStormBreaker) GetClosedAt() int64 {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return 0
+}
+
+type CrashStormBreakersRequest struct {
+}
+
+func (m *CrashStormBreakersRequest) Reset()      { *m = CrashStormBreakersRequest{} }
+func (*CrashStormBreakersRequest) ProtoMessage() {}
+func (*CrashStormBreakersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{1}
+}
+func (m *CrashStormBreakersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrashStormBreakersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrashStormBreakersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrashStormBreakersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashStormBreakersRequest.Merge(m, src)
+}
+func (m *CrashStormBreakersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrashStormBreakersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashStormBreakersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrashStormBreakersRequest proto.InternalMessageInfo
+
+type CrashStormBreakersResponse struct {
+	Error    *Error               `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Breakers []*CrashStormBreaker `protobuf:"bytes,2,rep,name=breakers,proto3" json:"breakers,omitempty"`
+}
+
+func (m *CrashStormBreakersResponse) Reset()      { *m = CrashStormBreakersResponse{} }
+func (*CrashStormBreakersResponse) ProtoMessage() {}
+func (*CrashStormBreakersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{2}
+}
+func (m *CrashStormBreakersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrashStormBreakersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrashStormBreakersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrashStormBreakersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashStormBreakersResponse.Merge(m, src)
+}
+func (m *CrashStormBreakersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrashStormBreakersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashStormBreakersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrashStormBreakersResponse proto.InternalMessageInfo
+
+func (m *CrashStormBreakersResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *CrashStormBreakersResponse) GetBreakers() []*CrashStormBreaker {
+	if m != nil {
+		return m.Breakers
+	}
+	return nil
+}
+
+type ResetCrashStormBreakerRequest struct {
+	Scope CrashStormBreaker_Scope `protobuf:"varint,1,opt,name=scope,proto3,enum=models.CrashStormBreaker_Scope" json:"scope"`
+	Key   string                  `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *ResetCrashStormBreakerRequest) Reset()      { *m = ResetCrashStormBreakerRequest{} }
+func (*ResetCrashStormBreakerRequest) ProtoMessage() {}
+func (*ResetCrashStormBreakerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{3}
+}
+func (m *ResetCrashStormBreakerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetCrashStormBreakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetCrashStormBreakerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetCrashStormBreakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetCrashStormBreakerRequest.Merge(m, src)
+}
+func (m *ResetCrashStormBreakerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetCrashStormBreakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetCrashStormBreakerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetCrashStormBreakerRequest proto.InternalMessageInfo
+
+func (m *ResetCrashStormBreakerRequest) GetScope() CrashStormBreaker_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return CrashStormBreaker_Global
+}
+
+func (m *ResetCrashStormBreakerRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ResetCrashStormBreakerResponse struct {
+	Error   *Error             `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Breaker *CrashStormBreaker `protobuf:"bytes,2,opt,name=breaker,proto3" json:"breaker,omitempty"`
+}
+
+func (m *ResetCrashStormBreakerResponse) Reset()      { *m = ResetCrashStormBreakerResponse{} }
+func (*ResetCrashStormBreakerResponse) ProtoMessage() {}
+func (*ResetCrashStormBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bac96aaeb5f5a02f, []int{4}
+}
+func (m *ResetCrashStormBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResetCrashStormBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResetCrashStormBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResetCrashStormBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetCrashStormBreakerResponse.Merge(m, src)
+}
+func (m *ResetCrashStormBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResetCrashStormBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetCrashStormBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetCrashStormBreakerResponse proto.InternalMessageInfo
+
+func (m *ResetCrashStormBreakerResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ResetCrashStormBreakerResponse) GetBreaker() *CrashStormBreaker {
+	if m != nil {
+		return m.Breaker
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("models.CrashStormBreaker_Scope", CrashStormBreaker_Scope_name, CrashStormBreaker_Scope_value)
+	proto.RegisterType((*CrashStormBreaker)(nil), "models.CrashStormBreaker")
+	proto.RegisterType((*CrashStormBreakersRequest)(nil), "models.CrashStormBreakersRequest")
+	proto.RegisterType((*CrashStormBreakersResponse)(nil), "models.CrashStormBreakersResponse")
+	proto.RegisterType((*ResetCrashStormBreakerRequest)(nil), "models.ResetCrashStormBreakerRequest")
+	proto.RegisterType((*ResetCrashStormBreakerResponse)(nil), "models.ResetCrashStormBreakerResponse")
+}
+
+func init() { proto.RegisterFile("crash_storm.proto", fileDescriptor_bac96aaeb5f5a02f) }
+
+var fileDescriptor_bac96aaeb5f5a02f = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xf6, 0x25, 0x38, 0x34, 0x67, 0xa5, 0x4d, 0x8e, 0x01, 0x37, 0x88, 0xb3, 0x65, 0x16, 0x0b,
+	0x51, 0xb7, 0x4a, 0xcb, 0x4e, 0x5c, 0x50, 0x57, 0xe4, 0xfe, 0x80, 0xca, 0x76, 0x8e, 0xd4, 0x4a,
+	0x9c, 0x33, 0x77, 0x67, 0x89, 0x30, 0x31, 0x31, 0xf3, 0x33, 0xf8, 0x29, 0x8c, 0x19, 0x3b, 0x59,
+	0xc4, 0x59, 0x90, 0x17, 0xfa, 0x13, 0x90, 0xef, 0xd2, 0x12, 0x29, 0x04, 0x89, 0x85, 0xc9, 0xdf,
+	0xfb, 0xde, 0xf7, 0xde, 0xbd, 0xfb, 0x9e, 0x0f, 0xf6, 0x62, 0x16, 0xf2, 0xeb, 0x2b, 0x2e, 0x28,
+	0x4b, 0xbd, 0x8c, 0x51, 0x41, 0x51, 0x2b, 0xa5, 0x23, 0x32, 0xe5, 0xfd, 0xa3, 0x71, 0x22, 0xae,
+	0xf3, 0xc8, 0x8b, 0x69, 0x7a, 0x3c, 0xa6, 0x63, 0x7a, 0x2c, 0xd3, 0x51, 0xfe, 0x4e, 0x46, 0x32,
+	0x90, 0x48, 0x95, 0xf5, 0x0d, 0xc2, 0x18, 0x65, 0x2a, 0x70, 0x7e, 0x36, 0x60, 0xef, 0xbc, 0xee,
+	0x7c, 0x59, 0x37, 0xf6, 0x19, 0x09, 0x27, 0x84, 0xa1, 0x57, 0x50, 0xe7, 0x31, 0xcd, 0x88, 0x09,
+	0x6c, 0xe0, 0xee, 0x0f, 0x2c, 0x4f, 0x9d, 0xe4, 0x6d, 0x29, 0xbd, 0xcb, 0x5a, 0xe6, 0xb7, 0xab,
+	0xc2, 0x52, 0x15, 0x81, 0xfa, 0xa0, 0x67, 0xb0, 0x39, 0x21, 0x73, 0xb3, 0x61, 0x03, 0xb7, 0xed,
+	0xf7, 0xaa, 0xc2, 0xea, 0x4c, 0xc8, 0xfc, 0x05, 0x4d, 0x13, 0x41, 0xd2, 0x4c, 0xcc, 0x83, 0x3a,
+	0x8b, 0x4e, 0xa0, 0xa1, 0x6e, 0x15, 0xd3, 0x7c, 0x26, 0xcc, 0xa6, 0x0d, 0x5c, 0xdd, 0x3f, 0xa8,
+	0x0a, 0x6b, 0x93, 0x0e, 0xa0, 0x0c, 0xce, 0x6b, 0x8c, 0x8e, 0x20, 0x14, 0x2c, 0xc9, 0x32, 0x32,
+	0xba, 0x0a, 0x85, 0xf9, 0xc0, 0x06, 0x6e, 0xd3, 0xdf, 0xaf, 0x0a, 0x6b, 0x83, 0x0d, 0xda, 0x6b,
+	0x3c, 0x14, 0xe8, 0x39, 0x6c, 0xc7, 0x53, 0xca, 0x09, 0xaf, 0xd5, 0xba, 0x54, 0x77, 0xaa, 0xc2,
+	0xfa, 0x4d, 0x06, 0x7b, 0x0a, 0x0e, 0x05, 0x3a, 0x5b, 0x6b, 0x65, 0xe7, 0x96, 0xd4, 0x3e, 0xae,
+	0x0a, 0xeb, 0xd1, 0x3d, 0xb9, 0x31, 0xbd, 0xaa, 0x1a, 0x0d, 0x85, 0x73, 0x02, 0x75, 0x69, 0x01,
+	0x82, 0xb0, 0x75, 0x31, 0xa5, 0x51, 0x38, 0xed, 0x6a, 0x35, 0x7e, 0x4d, 0xd3, 0x30, 0x99, 0x75,
+	0x01, 0x3a, 0x80, 0xc6, 0x5b, 0x46, 0x63, 0xc2, 0xf9, 0x45, 0x9e, 0x8c, 0xba, 0x0d, 0xe7, 0x09,
+	0x3c, 0xdc, 0xb2, 0x91, 0x07, 0xe4, 0x7d, 0x4e, 0xb8, 0x70, 0x3e, 0xc0, 0xfe, 0x9f, 0x92, 0x3c,
+	0xa3, 0x33, 0x5e, 0x9b, 0xaa, 0xcb, 0xdd, 0xc9, 0xb5, 0x18, 0x83, 0xce, 0xdd, 0x5a, 0xde, 0xd4,
+	0x64, 0xa0, 0x72, 0xe8, 0x25, 0xdc, 0x8b, 0xd6, 0x85, 0x66, 0xc3, 0x6e, 0xba, 0xc6, 0xe0, 0x70,
+	0xe7, 0xfa, 0x82, 0x7b, 0xa9, 0xf3, 0x19, 0xc0, 0xa7, 0x01, 0xe1, 0x44, 0x6c, 0x8b, 0xd4, 0x6c,
+	0xff, 0xe9, 0xa7, 0x70, 0x3e, 0x42, 0xbc, 0x6b, 0x8e, 0x7f, 0xb1, 0xe1, 0x14, 0x3e, 0x5c, 0xdf,
+	0x4d, 0x9e, 0xf7, 0x57, 0x17, 0xee, 0x94, 0xfe, 0xd9, 0x62, 0x89, 0xc1, 0xcd, 0x12, 0x6b, 0xb7,
+	0x4b, 0x0c, 0x3e, 0x95, 0x18, 0x7c, 0x2d, 0x31, 0xf8, 0x56, 0x62, 0xb0, 0x28, 0x31, 0xf8, 0x5e,
+	0x62, 0xf0, 0xa3, 0xc4, 0xda, 0x6d, 0x89, 0xc1, 0x97, 0x15, 0xd6, 0x16, 0x2b, 0xac, 0xdd, 0xac,
+	0xb0, 0x16, 0xb5, 0xe4, 0x53, 0x3a, 0xfd, 0x35, 0x00, 0x2b, 0xeb, 0xe9, 0x99, 0xa3, 0x03, 0x00,
+	0x00,
+}
+
+func (x CrashStormBreaker_Scope) String() string {
+	s, ok := CrashStormBreaker_Scope_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *CrashStormBreaker) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrashStormBreaker)
+	if !ok {
+		that2, ok := that.(CrashStormBreaker)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.CrashCount != that1.CrashCount {
+		return false
+	}
+	if this.TrippedAt != that1.TrippedAt {
+		return false
+	}
+	if this.ClosesAt != that1.ClosesAt {
+		return false
+	}
+	if this.ClosedAt != that1.ClosedAt {
+		return false
+	}
+	return true
+}
+func (this *CrashStormBreakersRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrashStormBreakersRequest)
+	if !ok {
+		that2, ok := that.(CrashStormBreakersRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *CrashStormBreakersResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrashStormBreakersResponse)
+	if !ok {
+		that2, ok := that.(CrashStormBreakersResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.Breakers) != len(that1.Breakers) {
+		return false
+	}
+	for i := range this.Breakers {
+		if !this.Breakers[i].Equal(that1.Breakers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ResetCrashStormBreakerRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetCrashStormBreakerRequest)
+	if !ok {
+		that2, ok := that.(ResetCrashStormBreakerRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Scope != that1.Scope {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	return true
+}
+func (this *ResetCrashStormBreakerResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResetCrashStormBreakerResponse)
+	if !ok {
+		that2, ok := that.(ResetCrashStormBreakerResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Breaker.Equal(that1.Breaker) {
+		return false
+	}
+	return true
+}
+func (this *CrashStormBreaker) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.CrashStormBreaker{")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
+	s = append(s, "TrippedAt: "+fmt.Sprintf("%#v", this.TrippedAt)+",\n")
+	s = append(s, "ClosesAt: "+fmt.Sprintf("%#v", this.ClosesAt)+",\n")
+	s = append(s, "ClosedAt: "+fmt.Sprintf("%#v", this.ClosedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CrashStormBreakersRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.CrashStormBreakersRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CrashStormBreakersResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CrashStormBreakersResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Breakers != nil {
+		s = append(s, "Breakers: "+fmt.Sprintf("%#v", this.Breakers)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetCrashStormBreakerRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ResetCrashStormBreakerRequest{")
+	s = append(s, "Scope: "+fmt.Sprintf("%#v", this.Scope)+",\n")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResetCrashStormBreakerResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ResetCrashStormBreakerResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Breaker != nil {
+		s = append(s, "Breaker: "+fmt.Sprintf("%#v", this.Breaker)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringCrashStorm(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *CrashStormBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashStormBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashStormBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClosedAt != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.ClosedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClosesAt != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.ClosesAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrippedAt != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.TrippedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CrashCount != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.CrashCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCrashStorm(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Scope != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrashStormBreakersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashStormBreakersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashStormBreakersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CrashStormBreakersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashStormBreakersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashStormBreakersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Breakers) > 0 {
+		for iNdEx := len(m.Breakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Breakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCrashStorm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrashStorm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetCrashStormBreakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetCrashStormBreakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetCrashStormBreakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCrashStorm(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Scope != 0 {
+		i = encodeVarintCrashStorm(dAtA, i, uint64(m.Scope))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResetCrashStormBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResetCrashStormBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResetCrashStormBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Breaker != nil {
+		{
+			size, err := m.Breaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrashStorm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCrashStorm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCrashStorm(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCrashStorm(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CrashStormBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		n += 1 + sovCrashStorm(uint64(m.Scope))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCrashStorm(uint64(l))
+	}
+	if m.CrashCount != 0 {
+		n += 1 + sovCrashStorm(uint64(m.CrashCount))
+	}
+	if m.TrippedAt != 0 {
+		n += 1 + sovCrashStorm(uint64(m.TrippedAt))
+	}
+	if m.ClosesAt != 0 {
+		n += 1 + sovCrashStorm(uint64(m.ClosesAt))
+	}
+	if m.ClosedAt != 0 {
+		n += 1 + sovCrashStorm(uint64(m.ClosedAt))
+	}
+	return n
+}
+
+func (m *CrashStormBreakersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CrashStormBreakersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCrashStorm(uint64(l))
+	}
+	if len(m.Breakers) > 0 {
+		for _, e := range m.Breakers {
+			l = e.Size()
+			n += 1 + l + sovCrashStorm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ResetCrashStormBreakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Scope != 0 {
+		n += 1 + sovCrashStorm(uint64(m.Scope))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCrashStorm(uint64(l))
+	}
+	return n
+}
+
+func (m *ResetCrashStormBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovCrashStorm(uint64(l))
+	}
+	if m.Breaker != nil {
+		l = m.Breaker.Size()
+		n += 1 + l + sovCrashStorm(uint64(l))
+	}
+	return n
+}
+
+func sovCrashStorm(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCrashStorm(x uint64) (n int) {
+	return sovCrashStorm(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *CrashStormBreaker) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrashStormBreaker{`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`CrashCount:` + fmt.Sprintf("%v", this.CrashCount) + `,`,
+		`TrippedAt:` + fmt.Sprintf("%v", this.TrippedAt) + `,`,
+		`ClosesAt:` + fmt.Sprintf("%v", this.ClosesAt) + `,`,
+		`ClosedAt:` + fmt.Sprintf("%v", this.ClosedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrashStormBreakersRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrashStormBreakersRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrashStormBreakersResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForBreakers := "[]*CrashStormBreaker{"
+	for _, f := range this.Breakers {
+		repeatedStringForBreakers += strings.Replace(f.String(), "CrashStormBreaker", "CrashStormBreaker", 1) + ","
+	}
+	repeatedStringForBreakers += "}"
+	s := strings.Join([]string{`&CrashStormBreakersResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Breakers:` + repeatedStringForBreakers + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetCrashStormBreakerRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetCrashStormBreakerRequest{`,
+		`Scope:` + fmt.Sprintf("%v", this.Scope) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResetCrashStormBreakerResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResetCrashStormBreakerResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Breaker:` + strings.Replace(this.Breaker.String(), "CrashStormBreaker", "CrashStormBreaker", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringCrashStorm(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *CrashStormBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrashStormBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrashStormBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= CrashStormBreaker_Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedAt", wireType)
+			}
+			m.TrippedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosesAt", wireType)
+			}
+			m.ClosesAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosesAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedAt", wireType)
+			}
+			m.ClosedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrashStorm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrashStormBreakersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrashStormBreakersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrashStormBreakersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrashStorm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrashStormBreakersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrashStormBreakersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrashStormBreakersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Breakers = append(m.Breakers, &CrashStormBreaker{})
+			if err := m.Breakers[len(m.Breakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrashStorm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetCrashStormBreakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetCrashStormBreakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetCrashStormBreakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			m.Scope = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Scope |= CrashStormBreaker_Scope(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrashStorm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResetCrashStormBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResetCrashStormBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResetCrashStormBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Breaker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Breaker == nil {
+				m.Breaker = &CrashStormBreaker{}
+			}
+			if err := m.Breaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCrashStorm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCrashStorm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCrashStorm(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCrashStorm
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCrashStorm
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCrashStorm
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCrashStorm
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCrashStorm
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCrashStorm        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCrashStorm          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCrashStorm = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,73 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CronSchedule", func() {
+	var start time.Time
+
+	BeforeEach(func() {
+		// a Sunday
+		start = time.Date(2026, 3, 1, 12, 0, 30, 0, time.UTC)
+	})
+
+	next := func(expression string, t time.Time) time.Time {
+		schedule, err := models.ParseCronExpression(expression)
+		Expect(err).NotTo(HaveOccurred())
+		return schedule.Next(t)
+	}
+
+	DescribeTable("Next",
+		func(expression string, expected time.Time) {
+			Expect(next(expression, start)).To(Equal(expected))
+		},
+		Entry("every minute", "* * * * *", time.Date(2026, 3, 1, 12, 1, 0, 0, time.UTC)),
+		Entry("steps", "*/15 * * * *", time.Date(2026, 3, 1, 12, 15, 0, 0, time.UTC)),
+		Entry("lists", "5,45 * * * *", time.Date(2026, 3, 1, 12, 5, 0, 0, time.UTC)),
+		Entry("ranges", "0 14-16 * * *", time.Date(2026, 3, 1, 14, 0, 0, 0, time.UTC)),
+		Entry("stepped ranges", "0 0-23/6 * * *", time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)),
+		Entry("day names", "0 9 * * mon-fri", time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)),
+		Entry("month names", "0 0 1 jun *", time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("Sunday as 7", "0 13 * * 7", time.Date(2026, 3, 1, 13, 0, 0, 0, time.UTC)),
+		Entry("macros", "@monthly", time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)),
+		Entry("either restricted day field", "0 0 15 * fri", time.Date(2026, 3, 6, 0, 0, 0, 0, time.UTC)),
+		Entry("stepped day field as unrestricted", "0 0 */2 * 1", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)),
+		Entry("leap days", "0 0 29 2 *", time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)),
+	)
+
+	It("returns the zero time for schedules that never fire", func() {
+		Expect(next("0 0 31 2 *", start)).To(BeZero())
+	})
+
+	It("evaluates the schedule in the location of the time", func() {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(next("0 2 * * *", start.In(berlin))).To(BeTemporally("==", time.Date(2026, 3, 2, 1, 0, 0, 0, time.UTC)))
+	})
+
+	It("skips times that do not exist when clocks go forward", func() {
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(next("30 2 * * *", time.Date(2026, 3, 28, 12, 0, 0, 0, berlin)).Day()).To(Equal(30))
+	})
+
+	DescribeTable("invalid expressions",
+		func(expression string) {
+			_, err := models.ParseCronExpression(expression)
+			Expect(err).To(HaveOccurred())
+		},
+		Entry("too few fields", "* * * *"),
+		Entry("out of range values", "60 * * * *"),
+		Entry("zero steps", "*/0 * * * *"),
+		Entry("backwards ranges", "0 5-1 * * *"),
+		Entry("unknown names", "0 0 * * someday"),
+		Entry("unknown macros", "@fortnightly"),
+	)
+})
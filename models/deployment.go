@@ -0,0 +1,49 @@
+package models
+
+// IsActive reports whether the deployment is still replacing instances,
+// including while it is paused.
+func (d *Deployment) IsActive() bool {
+	return d.GetState() == Deployment_InProgress || d.GetState() == Deployment_RollingBack
+}
+
+// Replaced reports whether every instance the deployment set out to replace
+// has been replaced.
+func (d *Deployment) Replaced() bool {
+	return len(d.GetPendingIndices()) == 0 && len(d.GetRetiringInstances()) == 0
+}
+
+// Retire moves index from the pending indices to the retiring instances,
+// recording the guid of the instance that is being retired.
+func (d *Deployment) Retire(index int32, instanceGuid string) {
+	for i, pending := range d.PendingIndices {
+		if pending == index {
+			d.PendingIndices = append(d.PendingIndices[:i], d.PendingIndices[i+1:]...)
+			break
+		}
+	}
+
+	if d.RetiringInstances == nil {
+		d.RetiringInstances = map[int32]string{}
+	}
+	d.RetiringInstances[index] = instanceGuid
+}
+
+// Rollback reverses the deployment: the indices that were replaced, or are
+// being replaced, become the pending indices.
+func (d *Deployment) Rollback() {
+	pending := map[int32]struct{}{}
+	for _, index := range d.PendingIndices {
+		pending[index] = struct{}{}
+	}
+
+	rollback := []int32{}
+	for index := int32(0); index < d.Instances; index++ {
+		if _, ok := pending[index]; !ok {
+			rollback = append(rollback, index)
+		}
+	}
+
+	d.State = Deployment_RollingBack
+	d.PendingIndices = rollback
+	d.RetiringInstances = nil
+}
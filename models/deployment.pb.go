@@ -0,0 +1,1141 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: deployment.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Deployment_State int32
+
+const (
+	Deployment_InProgress  Deployment_State = 0
+	Deployment_RollingBack Deployment_State = 1
+	Deployment_Succeeded   Deployment_State = 2
+	Deployment_RolledBack  Deployment_State = 3
+)
+
+var Deployment_State_name = map[int32]string{
+	0: "InProgress",
+	1: "RollingBack",
+	2: "Succeeded",
+	3: "RolledBack",
+}
+
+var Deployment_State_value = map[string]int32{
+	"InProgress":  0,
+	"RollingBack": 1,
+	"Succeeded":   2,
+	"RolledBack":  3,
+}
+
+func (Deployment_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fac0ec10f8e4d7ff, []int{0, 0}
+}
+
+type Deployment struct {
+	DeploymentGuid string           `protobuf:"bytes,1,opt,name=deployment_guid,json=deploymentGuid,proto3" json:"deployment_guid"`
+	ProcessGuid    string           `protobuf:"bytes,2,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	Domain         string           `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain"`
+	State          Deployment_State `protobuf:"varint,4,opt,name=state,proto3,enum=models.Deployment_State" json:"state"`
+	Paused         bool             `protobuf:"varint,5,opt,name=paused,proto3" json:"paused"`
+	MaxSurge       int32            `protobuf:"varint,6,opt,name=max_surge,json=maxSurge,proto3" json:"max_surge"`
+	MaxUnavailable int32            `protobuf:"varint,7,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable"`
+	// instances is the number of instances of the DesiredLRP without the
+	// surge instances added for the deployment.
+	Instances int32 `protobuf:"varint,8,opt,name=instances,proto3" json:"instances"`
+	// pending_indices are the indices whose instances still run the run info
+	// the deployment replaces.
+	PendingIndices []int32 `protobuf:"varint,9,rep,packed,name=pending_indices,json=pendingIndices,proto3" json:"pending_indices,omitempty"`
+	// retiring_instances maps the indices being replaced to the guid of the
+	// instance that was retired.
+	RetiringInstances map[int32]string `protobuf:"bytes,10,rep,name=retiring_instances,json=retiringInstances,proto3" json:"retiring_instances,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CreatedAt         int64            `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt         int64            `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *Deployment) Reset()      { *m = Deployment{} }
+func (*Deployment) ProtoMessage() {}
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fac0ec10f8e4d7ff, []int{0}
+}
+func (m *Deployment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deployment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Deployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deployment.Merge(m, src)
+}
+func (m *Deployment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deployment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Deployment proto.InternalMessageInfo
+
+func (m *Deployment) GetDeploymentGuid() string {
+	if m != nil {
+		return m.DeploymentGuid
+	}
+	return ""
+}
+
+func (m *Deployment) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *Deployment) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *Deployment) GetState() Deployment_State {
+	if m != nil {
+		return m.State
+	}
+	return Deployment_InProgress
+}
+
+func (m *Deployment) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Deployment) GetMaxSurge() int32 {
+	if m != nil {
+		return m.MaxSurge
+	}
+	return 0
+}
+
+func (m *Deployment) GetMaxUnavailable() int32 {
+	if m != nil {
+		return m.MaxUnavailable
+	}
+	return 0
+}
+
+func (m *Deployment) GetInstances() int32 {
+	if m != nil {
+		return m.Instances
+	}
+	return 0
+}
+
+func (m *Deployment) GetPendingIndices() []int32 {
+	if m != nil {
+		return m.PendingIndices
+	}
+	return nil
+}
+
+func (m *Deployment) GetRetiringInstances() map[int32]string {
+	if m != nil {
+		return m.RetiringInstances
+	}
+	return nil
+}
+
+func (m *Deployment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Deployment) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("models.Deployment_State", Deployment_State_name, Deployment_State_value)
+	proto.RegisterType((*Deployment)(nil), "models.Deployment")
+	proto.RegisterMapType((map[int32]string)(nil), "models.Deployment.RetiringInstancesEntry")
+}
+
+func init() { proto.RegisterFile("deployment.proto", fileDescriptor_fac0ec10f8e4d7ff) }
+
+var fileDescriptor_fac0ec10f8e4d7ff = []byte{
+	// 550 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0x4f, 0x6f, 0x94, 0x4e,
+	0x18, 0xc7, 0x99, 0xf2, 0x63, 0x7f, 0x65, 0xb6, 0xa5, 0x38, 0x1a, 0x43, 0x7a, 0x18, 0x48, 0x2f,
+	0xa2, 0xa6, 0xdb, 0xa4, 0xf5, 0xa0, 0xc6, 0x4b, 0x49, 0x4d, 0xd3, 0x9b, 0x99, 0xc6, 0xc4, 0xdb,
+	0x66, 0x96, 0x19, 0x91, 0x94, 0x7f, 0x81, 0xa1, 0xe9, 0xde, 0xbc, 0x7a, 0xf3, 0x65, 0xf8, 0x52,
+	0x3c, 0xee, 0xb1, 0x27, 0xe2, 0xb2, 0x17, 0xc3, 0xa9, 0x2f, 0xc1, 0x30, 0xb0, 0x45, 0x37, 0x3d,
+	0xcd, 0xf3, 0x7c, 0xbe, 0xdf, 0xef, 0xc3, 0x30, 0x0c, 0xd0, 0x64, 0x3c, 0x8b, 0xd2, 0x79, 0xcc,
+	0x13, 0x31, 0xc9, 0xf2, 0x54, 0xa4, 0x68, 0x14, 0xa7, 0x8c, 0x47, 0xc5, 0xfe, 0x61, 0x10, 0x8a,
+	0x2f, 0xe5, 0x6c, 0xe2, 0xa7, 0xf1, 0x51, 0x90, 0x06, 0xe9, 0x91, 0x94, 0x67, 0xe5, 0x67, 0xd9,
+	0xc9, 0x46, 0x56, 0x5d, 0xec, 0xe0, 0xdb, 0x08, 0xc2, 0xb3, 0xfb, 0x59, 0xe8, 0x1d, 0xdc, 0x1b,
+	0x26, 0x4f, 0x83, 0x32, 0x64, 0x16, 0x70, 0x80, 0xab, 0x7b, 0x8f, 0x9b, 0xca, 0xde, 0x94, 0x88,
+	0x31, 0x80, 0xf3, 0x32, 0x64, 0xe8, 0x04, 0xee, 0x64, 0x79, 0xea, 0xf3, 0xa2, 0xe8, 0xa2, 0x5b,
+	0x32, 0x6a, 0x36, 0x95, 0xfd, 0x0f, 0x27, 0xe3, 0xbe, 0x93, 0xa1, 0x03, 0x38, 0x62, 0x69, 0x4c,
+	0xc3, 0xc4, 0x52, 0xa5, 0x1d, 0x36, 0x95, 0xdd, 0x13, 0xd2, 0xaf, 0xe8, 0x0d, 0xd4, 0x0a, 0x41,
+	0x05, 0xb7, 0xfe, 0x73, 0x80, 0x6b, 0x1c, 0x5b, 0x93, 0xee, 0x65, 0x27, 0xc3, 0xce, 0x27, 0x97,
+	0xad, 0xee, 0xe9, 0x4d, 0x65, 0x77, 0x56, 0xd2, 0x2d, 0xed, 0xf8, 0x8c, 0x96, 0x05, 0x67, 0x96,
+	0xe6, 0x00, 0x77, 0xbb, 0x1b, 0xdf, 0x11, 0xd2, 0xaf, 0xe8, 0x05, 0xd4, 0x63, 0x7a, 0x33, 0x2d,
+	0xca, 0x3c, 0xe0, 0xd6, 0xc8, 0x01, 0xae, 0xe6, 0xed, 0x36, 0x95, 0x3d, 0x40, 0xb2, 0x1d, 0xd3,
+	0x9b, 0xcb, 0xb6, 0x6a, 0x4f, 0xa8, 0xc5, 0x65, 0x42, 0xaf, 0x69, 0x18, 0xd1, 0x59, 0xc4, 0xad,
+	0xff, 0x65, 0x42, 0x9e, 0xd0, 0x86, 0x44, 0x8c, 0x98, 0xde, 0x7c, 0x1c, 0x7a, 0xf4, 0x12, 0xea,
+	0x61, 0x52, 0x08, 0x9a, 0xf8, 0xbc, 0xb0, 0xb6, 0x87, 0x27, 0xdd, 0x43, 0x32, 0x94, 0xe8, 0x19,
+	0xdc, 0xcb, 0x78, 0xc2, 0xc2, 0x24, 0x98, 0x86, 0x09, 0x0b, 0xdb, 0x88, 0xee, 0xa8, 0xae, 0x46,
+	0x8c, 0x1e, 0x5f, 0x74, 0x14, 0x7d, 0x82, 0x28, 0xe7, 0x22, 0xcc, 0x3b, 0xe7, 0x7a, 0x3c, 0x74,
+	0x54, 0x77, 0x7c, 0xfc, 0xfc, 0x81, 0xb3, 0x22, 0xbd, 0xf9, 0x62, 0xed, 0x7d, 0x9f, 0x88, 0x7c,
+	0x4e, 0x1e, 0xe5, 0x9b, 0x1c, 0x1d, 0x42, 0xe8, 0xe7, 0x9c, 0x0a, 0xce, 0xa6, 0x54, 0x58, 0x63,
+	0x07, 0xb8, 0xaa, 0x67, 0x34, 0x95, 0xfd, 0x17, 0x25, 0x7a, 0x5f, 0x9f, 0x8a, 0xd6, 0x5e, 0x66,
+	0x6c, 0x6d, 0xdf, 0x19, 0xec, 0x03, 0x25, 0x7a, 0x5f, 0x9f, 0x8a, 0xfd, 0x33, 0xf8, 0xf4, 0xe1,
+	0xad, 0x20, 0x13, 0xaa, 0x57, 0x7c, 0x2e, 0xef, 0x9e, 0x46, 0xda, 0x12, 0x3d, 0x81, 0xda, 0x35,
+	0x8d, 0x4a, 0xde, 0x5d, 0x2a, 0xd2, 0x35, 0x6f, 0xb7, 0x5e, 0x83, 0x83, 0x73, 0xa8, 0xc9, 0x8f,
+	0x8f, 0x0c, 0x08, 0x2f, 0x92, 0x0f, 0x79, 0x1a, 0xe4, 0xbc, 0x28, 0x4c, 0x05, 0xed, 0xc1, 0x31,
+	0x49, 0xa3, 0x28, 0x4c, 0x02, 0x8f, 0xfa, 0x57, 0x26, 0x40, 0xbb, 0x50, 0xbf, 0x2c, 0x7d, 0x9f,
+	0x73, 0xc6, 0x99, 0xb9, 0xd5, 0xfa, 0x5b, 0x9d, 0x33, 0x29, 0xab, 0xde, 0xab, 0xc5, 0x12, 0x83,
+	0xdb, 0x25, 0x56, 0xee, 0x96, 0x18, 0x7c, 0xad, 0x31, 0xf8, 0x51, 0x63, 0xf0, 0xb3, 0xc6, 0x60,
+	0x51, 0x63, 0xf0, 0xab, 0xc6, 0xe0, 0x77, 0x8d, 0x95, 0xbb, 0x1a, 0x83, 0xef, 0x2b, 0xac, 0x2c,
+	0x56, 0x58, 0xb9, 0x5d, 0x61, 0x65, 0x36, 0x92, 0x3f, 0xd2, 0xc9, 0x9f, 0x01, 0x00, 0xbd, 0x21,
+	0x41, 0x97, 0x93, 0x03, 0x00, 0x00,
+}
+
+func (x Deployment_State) String() string {
+	s, ok := Deployment_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *Deployment) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Deployment)
+	if !ok {
+		that2, ok := that.(Deployment)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeploymentGuid != that1.DeploymentGuid {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Paused != that1.Paused {
+		return false
+	}
+	if this.MaxSurge != that1.MaxSurge {
+		return false
+	}
+	if this.MaxUnavailable != that1.MaxUnavailable {
+		return false
+	}
+	if this.Instances != that1.Instances {
+		return false
+	}
+	if len(this.PendingIndices) != len(that1.PendingIndices) {
+		return false
+	}
+	for i := range this.PendingIndices {
+		if this.PendingIndices[i] != that1.PendingIndices[i] {
+			return false
+		}
+	}
+	if len(this.RetiringInstances) != len(that1.RetiringInstances) {
+		return false
+	}
+	for i := range this.RetiringInstances {
+		if this.RetiringInstances[i] != that1.RetiringInstances[i] {
+			return false
+		}
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+func (this *Deployment) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&models.Deployment{")
+	s = append(s, "DeploymentGuid: "+fmt.Sprintf("%#v", this.DeploymentGuid)+",\n")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Paused: "+fmt.Sprintf("%#v", this.Paused)+",\n")
+	s = append(s, "MaxSurge: "+fmt.Sprintf("%#v", this.MaxSurge)+",\n")
+	s = append(s, "MaxUnavailable: "+fmt.Sprintf("%#v", this.MaxUnavailable)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "PendingIndices: "+fmt.Sprintf("%#v", this.PendingIndices)+",\n")
+	keysForRetiringInstances := make([]int32, 0, len(this.RetiringInstances))
+	for k, _ := range this.RetiringInstances {
+		keysForRetiringInstances = append(keysForRetiringInstances, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForRetiringInstances)
+	mapStringForRetiringInstances := "map[int32]string{"
+	for _, k := range keysForRetiringInstances {
+		mapStringForRetiringInstances += fmt.Sprintf("%#v: %#v,", k, this.RetiringInstances[k])
+	}
+	mapStringForRetiringInstances += "}"
+	if this.RetiringInstances != nil {
+		s = append(s, "RetiringInstances: "+mapStringForRetiringInstances+",\n")
+	}
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDeployment(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *Deployment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deployment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deployment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RetiringInstances) > 0 {
+		for k := range m.RetiringInstances {
+			v := m.RetiringInstances[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDeployment(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintDeployment(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintDeployment(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PendingIndices) > 0 {
+		dAtA2 := make([]byte, len(m.PendingIndices)*10)
+		var j1 int
+		for _, num1 := range m.PendingIndices {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintDeployment(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Instances != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.Instances))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxUnavailable != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.MaxUnavailable))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxSurge != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.MaxSurge))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.State != 0 {
+		i = encodeVarintDeployment(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDeployment(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeployment(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DeploymentGuid) > 0 {
+		i -= len(m.DeploymentGuid)
+		copy(dAtA[i:], m.DeploymentGuid)
+		i = encodeVarintDeployment(dAtA, i, uint64(len(m.DeploymentGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeployment(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeployment(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Deployment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeploymentGuid)
+	if l > 0 {
+		n += 1 + l + sovDeployment(uint64(l))
+	}
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeployment(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDeployment(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovDeployment(uint64(m.State))
+	}
+	if m.Paused {
+		n += 2
+	}
+	if m.MaxSurge != 0 {
+		n += 1 + sovDeployment(uint64(m.MaxSurge))
+	}
+	if m.MaxUnavailable != 0 {
+		n += 1 + sovDeployment(uint64(m.MaxUnavailable))
+	}
+	if m.Instances != 0 {
+		n += 1 + sovDeployment(uint64(m.Instances))
+	}
+	if len(m.PendingIndices) > 0 {
+		l = 0
+		for _, e := range m.PendingIndices {
+			l += sovDeployment(uint64(e))
+		}
+		n += 1 + sovDeployment(uint64(l)) + l
+	}
+	if len(m.RetiringInstances) > 0 {
+		for k, v := range m.RetiringInstances {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovDeployment(uint64(k)) + 1 + len(v) + sovDeployment(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDeployment(uint64(mapEntrySize))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovDeployment(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovDeployment(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovDeployment(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeployment(x uint64) (n int) {
+	return sovDeployment(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *Deployment) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForRetiringInstances := make([]int32, 0, len(this.RetiringInstances))
+	for k, _ := range this.RetiringInstances {
+		keysForRetiringInstances = append(keysForRetiringInstances, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForRetiringInstances)
+	mapStringForRetiringInstances := "map[int32]string{"
+	for _, k := range keysForRetiringInstances {
+		mapStringForRetiringInstances += fmt.Sprintf("%v: %v,", k, this.RetiringInstances[k])
+	}
+	mapStringForRetiringInstances += "}"
+	s := strings.Join([]string{`&Deployment{`,
+		`DeploymentGuid:` + fmt.Sprintf("%v", this.DeploymentGuid) + `,`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Paused:` + fmt.Sprintf("%v", this.Paused) + `,`,
+		`MaxSurge:` + fmt.Sprintf("%v", this.MaxSurge) + `,`,
+		`MaxUnavailable:` + fmt.Sprintf("%v", this.MaxUnavailable) + `,`,
+		`Instances:` + fmt.Sprintf("%v", this.Instances) + `,`,
+		`PendingIndices:` + fmt.Sprintf("%v", this.PendingIndices) + `,`,
+		`RetiringInstances:` + mapStringForRetiringInstances + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDeployment(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *Deployment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeployment
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Deployment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deployment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeploymentGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= Deployment_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+			}
+			m.MaxSurge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSurge |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			m.MaxUnavailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnavailable |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType == 0 {
+				var v int32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDeployment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PendingIndices = append(m.PendingIndices, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDeployment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthDeployment
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthDeployment
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PendingIndices) == 0 {
+					m.PendingIndices = make([]int32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDeployment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PendingIndices = append(m.PendingIndices, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIndices", wireType)
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiringInstances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetiringInstances == nil {
+				m.RetiringInstances = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDeployment
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDeployment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDeployment
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDeployment
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDeployment
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDeployment(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDeployment
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RetiringInstances[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeployment(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeployment
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeployment(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeployment
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeployment
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeployment
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeployment
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeployment
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeployment        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeployment          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeployment = fmt.Errorf("proto: unexpected end of group")
+)
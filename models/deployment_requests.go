@@ -0,0 +1,70 @@
+package models
+
+import "errors"
+
+func (request *StartDeploymentRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ProcessGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if request.RunInfo == nil {
+		validationError = validationError.Append(ErrInvalidField{"run_info"})
+	} else {
+		if request.RunInfo.ProcessGuid != request.ProcessGuid {
+			validationError = validationError.Append(ErrInvalidField{"run_info.process_guid"})
+		}
+		if err := request.RunInfo.Validate(); err != nil {
+			validationError = validationError.Append(err)
+		}
+	}
+
+	if request.MaxSurge < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_surge"})
+	}
+
+	if request.MaxUnavailable < 0 {
+		validationError = validationError.Append(ErrInvalidField{"max_unavailable"})
+	}
+
+	if request.MaxSurge == 0 && request.MaxUnavailable == 0 {
+		validationError = validationError.Append(errors.New("max_surge and max_unavailable cannot both be zero"))
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *DeploymentByProcessGuidRequest) Validate() error {
+	return validateDeploymentProcessGuid(request.ProcessGuid)
+}
+
+func (request *PauseDeploymentRequest) Validate() error {
+	return validateDeploymentProcessGuid(request.ProcessGuid)
+}
+
+func (request *ResumeDeploymentRequest) Validate() error {
+	return validateDeploymentProcessGuid(request.ProcessGuid)
+}
+
+func (request *RollbackDeploymentRequest) Validate() error {
+	return validateDeploymentProcessGuid(request.ProcessGuid)
+}
+
+func validateDeploymentProcessGuid(processGuid string) error {
+	var validationError ValidationError
+
+	if processGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"process_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,1683 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: deployment_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DeploymentResponse struct {
+	Error      *Error      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Deployment *Deployment `protobuf:"bytes,2,opt,name=deployment,proto3" json:"deployment,omitempty"`
+}
+
+func (m *DeploymentResponse) Reset()      { *m = DeploymentResponse{} }
+func (*DeploymentResponse) ProtoMessage() {}
+func (*DeploymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{0}
+}
+func (m *DeploymentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentResponse.Merge(m, src)
+}
+func (m *DeploymentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentResponse proto.InternalMessageInfo
+
+func (m *DeploymentResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DeploymentResponse) GetDeployment() *Deployment {
+	if m != nil {
+		return m.Deployment
+	}
+	return nil
+}
+
+type StartDeploymentRequest struct {
+	ProcessGuid    string             `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+	RunInfo        *DesiredLRPRunInfo `protobuf:"bytes,2,opt,name=run_info,json=runInfo,proto3" json:"run_info,omitempty"`
+	MaxSurge       int32              `protobuf:"varint,3,opt,name=max_surge,json=maxSurge,proto3" json:"max_surge"`
+	MaxUnavailable int32              `protobuf:"varint,4,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable"`
+}
+
+func (m *StartDeploymentRequest) Reset()      { *m = StartDeploymentRequest{} }
+func (*StartDeploymentRequest) ProtoMessage() {}
+func (*StartDeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{1}
+}
+func (m *StartDeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StartDeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StartDeploymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StartDeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartDeploymentRequest.Merge(m, src)
+}
+func (m *StartDeploymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StartDeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartDeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartDeploymentRequest proto.InternalMessageInfo
+
+func (m *StartDeploymentRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func (m *StartDeploymentRequest) GetRunInfo() *DesiredLRPRunInfo {
+	if m != nil {
+		return m.RunInfo
+	}
+	return nil
+}
+
+func (m *StartDeploymentRequest) GetMaxSurge() int32 {
+	if m != nil {
+		return m.MaxSurge
+	}
+	return 0
+}
+
+func (m *StartDeploymentRequest) GetMaxUnavailable() int32 {
+	if m != nil {
+		return m.MaxUnavailable
+	}
+	return 0
+}
+
+type DeploymentByProcessGuidRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *DeploymentByProcessGuidRequest) Reset()      { *m = DeploymentByProcessGuidRequest{} }
+func (*DeploymentByProcessGuidRequest) ProtoMessage() {}
+func (*DeploymentByProcessGuidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{2}
+}
+func (m *DeploymentByProcessGuidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentByProcessGuidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentByProcessGuidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentByProcessGuidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentByProcessGuidRequest.Merge(m, src)
+}
+func (m *DeploymentByProcessGuidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentByProcessGuidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentByProcessGuidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentByProcessGuidRequest proto.InternalMessageInfo
+
+func (m *DeploymentByProcessGuidRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type PauseDeploymentRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *PauseDeploymentRequest) Reset()      { *m = PauseDeploymentRequest{} }
+func (*PauseDeploymentRequest) ProtoMessage() {}
+func (*PauseDeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{3}
+}
+func (m *PauseDeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseDeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseDeploymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseDeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseDeploymentRequest.Merge(m, src)
+}
+func (m *PauseDeploymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseDeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseDeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseDeploymentRequest proto.InternalMessageInfo
+
+func (m *PauseDeploymentRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type ResumeDeploymentRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *ResumeDeploymentRequest) Reset()      { *m = ResumeDeploymentRequest{} }
+func (*ResumeDeploymentRequest) ProtoMessage() {}
+func (*ResumeDeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{4}
+}
+func (m *ResumeDeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResumeDeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResumeDeploymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResumeDeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeDeploymentRequest.Merge(m, src)
+}
+func (m *ResumeDeploymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResumeDeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeDeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeDeploymentRequest proto.InternalMessageInfo
+
+func (m *ResumeDeploymentRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+type RollbackDeploymentRequest struct {
+	ProcessGuid string `protobuf:"bytes,1,opt,name=process_guid,json=processGuid,proto3" json:"process_guid"`
+}
+
+func (m *RollbackDeploymentRequest) Reset()      { *m = RollbackDeploymentRequest{} }
+func (*RollbackDeploymentRequest) ProtoMessage() {}
+func (*RollbackDeploymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6f488e48af624aa5, []int{5}
+}
+func (m *RollbackDeploymentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollbackDeploymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollbackDeploymentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollbackDeploymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollbackDeploymentRequest.Merge(m, src)
+}
+func (m *RollbackDeploymentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollbackDeploymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollbackDeploymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollbackDeploymentRequest proto.InternalMessageInfo
+
+func (m *RollbackDeploymentRequest) GetProcessGuid() string {
+	if m != nil {
+		return m.ProcessGuid
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DeploymentResponse)(nil), "models.DeploymentResponse")
+	proto.RegisterType((*StartDeploymentRequest)(nil), "models.StartDeploymentRequest")
+	proto.RegisterType((*DeploymentByProcessGuidRequest)(nil), "models.DeploymentByProcessGuidRequest")
+	proto.RegisterType((*PauseDeploymentRequest)(nil), "models.PauseDeploymentRequest")
+	proto.RegisterType((*ResumeDeploymentRequest)(nil), "models.ResumeDeploymentRequest")
+	proto.RegisterType((*RollbackDeploymentRequest)(nil), "models.RollbackDeploymentRequest")
+}
+
+func init() { proto.RegisterFile("deployment_requests.proto", fileDescriptor_6f488e48af624aa5) }
+
+var fileDescriptor_6f488e48af624aa5 = []byte{
+	// 421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x8d, 0x81, 0x5d, 0x76, 0x5d, 0x16, 0x16, 0x23, 0x2d, 0xe9, 0x1e, 0xdc, 0x55, 0xb8, 0x54,
+	0x48, 0xa4, 0x52, 0xdb, 0x23, 0xa7, 0x08, 0x84, 0x90, 0x00, 0x45, 0xae, 0x7a, 0x8e, 0x9c, 0xc6,
+	0x0d, 0x11, 0x71, 0x1c, 0xec, 0x18, 0xb5, 0x37, 0x3e, 0x81, 0xcf, 0xe0, 0x53, 0x38, 0xf6, 0xd8,
+	0x53, 0x45, 0xd3, 0x0b, 0xaa, 0x84, 0xd4, 0x4f, 0x40, 0x75, 0x02, 0x29, 0x5c, 0xe9, 0x6d, 0xde,
+	0x9b, 0x99, 0x37, 0xef, 0x59, 0x86, 0xed, 0x88, 0xe5, 0xa9, 0x98, 0x73, 0x96, 0x15, 0x81, 0x64,
+	0x1f, 0x35, 0x53, 0x85, 0x72, 0x73, 0x29, 0x0a, 0x81, 0x4e, 0xb9, 0x88, 0x58, 0xaa, 0xae, 0x9f,
+	0xc5, 0x49, 0xf1, 0x5e, 0x87, 0xee, 0x44, 0xf0, 0x5e, 0x2c, 0x62, 0xd1, 0x33, 0xed, 0x50, 0x4f,
+	0x0d, 0x32, 0xc0, 0x54, 0xd5, 0xda, 0xf5, 0x65, 0xa3, 0x58, 0x33, 0x0f, 0x23, 0xa6, 0x12, 0xc9,
+	0xa2, 0x20, 0x95, 0x79, 0x4d, 0xb5, 0x98, 0x94, 0x42, 0x56, 0xc0, 0xe1, 0x10, 0xbd, 0xf8, 0xb3,
+	0x43, 0x98, 0xca, 0x45, 0xa6, 0x18, 0x7a, 0x02, 0x4f, 0xcc, 0x90, 0x0d, 0x6e, 0x40, 0xb7, 0xd5,
+	0xbf, 0x70, 0x2b, 0x3b, 0xee, 0xcb, 0x3d, 0x49, 0xaa, 0x1e, 0xea, 0x43, 0xd8, 0x9c, 0xb3, 0x6f,
+	0x99, 0x49, 0xf4, 0x7b, 0xf2, 0x40, 0xf4, 0x60, 0xca, 0xf9, 0x09, 0xe0, 0xd5, 0xa8, 0xa0, 0xb2,
+	0x38, 0x3c, 0x6a, 0x92, 0xa3, 0x01, 0xbc, 0x97, 0x4b, 0x31, 0x61, 0x4a, 0x05, 0xb1, 0x4e, 0x22,
+	0x73, 0xfa, 0xdc, 0xbb, 0xdc, 0xae, 0x3a, 0x7f, 0xf1, 0xa4, 0x55, 0xa3, 0x57, 0x3a, 0x89, 0xd0,
+	0x10, 0x9e, 0x49, 0x9d, 0x05, 0x49, 0x36, 0x15, 0xb5, 0x83, 0x76, 0xe3, 0xc0, 0x04, 0x7f, 0x43,
+	0x7c, 0xa2, 0xb3, 0xd7, 0xd9, 0x54, 0x90, 0xbb, 0xb2, 0x2a, 0xd0, 0x53, 0x78, 0xce, 0xe9, 0x2c,
+	0x50, 0x5a, 0xc6, 0xcc, 0xbe, 0x7d, 0x03, 0xba, 0x27, 0xde, 0xc5, 0x76, 0xd5, 0x69, 0x48, 0x72,
+	0xc6, 0xe9, 0x6c, 0xb4, 0xaf, 0xd0, 0x73, 0xf8, 0x60, 0x4f, 0xeb, 0x8c, 0x7e, 0xa2, 0x49, 0x4a,
+	0xc3, 0x94, 0xd9, 0x77, 0xcc, 0xc6, 0xa3, 0xed, 0xaa, 0xf3, 0x6f, 0x8b, 0xdc, 0xe7, 0x74, 0x36,
+	0x6e, 0xb0, 0x33, 0x86, 0xb8, 0x49, 0xea, 0xcd, 0xfd, 0xc6, 0xfa, 0xff, 0xc4, 0x76, 0xde, 0xc2,
+	0x2b, 0x9f, 0x6a, 0xc5, 0x8e, 0xf3, 0x8a, 0xce, 0x3b, 0xf8, 0x98, 0x30, 0xa5, 0xf9, 0xb1, 0xf4,
+	0x7c, 0xd8, 0x26, 0x22, 0x4d, 0x43, 0x3a, 0xf9, 0x70, 0x1c, 0x45, 0x6f, 0xb8, 0x58, 0x63, 0x6b,
+	0xb9, 0xc6, 0xd6, 0x6e, 0x8d, 0xc1, 0xe7, 0x12, 0x83, 0xaf, 0x25, 0x06, 0xdf, 0x4a, 0x0c, 0x16,
+	0x25, 0x06, 0xdf, 0x4b, 0x0c, 0x7e, 0x94, 0xd8, 0xda, 0x95, 0x18, 0x7c, 0xd9, 0x60, 0x6b, 0xb1,
+	0xc1, 0xd6, 0x72, 0x83, 0xad, 0xf0, 0xd4, 0xfc, 0xf1, 0xc1, 0xaf, 0x01, 0x00, 0x7a, 0x58, 0x49,
+	0x42, 0x69, 0x03, 0x00, 0x00,
+}
+
+func (this *DeploymentResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeploymentResponse)
+	if !ok {
+		that2, ok := that.(DeploymentResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Deployment.Equal(that1.Deployment) {
+		return false
+	}
+	return true
+}
+func (this *StartDeploymentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StartDeploymentRequest)
+	if !ok {
+		that2, ok := that.(StartDeploymentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	if !this.RunInfo.Equal(that1.RunInfo) {
+		return false
+	}
+	if this.MaxSurge != that1.MaxSurge {
+		return false
+	}
+	if this.MaxUnavailable != that1.MaxUnavailable {
+		return false
+	}
+	return true
+}
+func (this *DeploymentByProcessGuidRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeploymentByProcessGuidRequest)
+	if !ok {
+		that2, ok := that.(DeploymentByProcessGuidRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *PauseDeploymentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PauseDeploymentRequest)
+	if !ok {
+		that2, ok := that.(PauseDeploymentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *ResumeDeploymentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResumeDeploymentRequest)
+	if !ok {
+		that2, ok := that.(ResumeDeploymentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *RollbackDeploymentRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RollbackDeploymentRequest)
+	if !ok {
+		that2, ok := that.(RollbackDeploymentRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProcessGuid != that1.ProcessGuid {
+		return false
+	}
+	return true
+}
+func (this *DeploymentResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DeploymentResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Deployment != nil {
+		s = append(s, "Deployment: "+fmt.Sprintf("%#v", this.Deployment)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *StartDeploymentRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.StartDeploymentRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	if this.RunInfo != nil {
+		s = append(s, "RunInfo: "+fmt.Sprintf("%#v", this.RunInfo)+",\n")
+	}
+	s = append(s, "MaxSurge: "+fmt.Sprintf("%#v", this.MaxSurge)+",\n")
+	s = append(s, "MaxUnavailable: "+fmt.Sprintf("%#v", this.MaxUnavailable)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeploymentByProcessGuidRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DeploymentByProcessGuidRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *PauseDeploymentRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.PauseDeploymentRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResumeDeploymentRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ResumeDeploymentRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RollbackDeploymentRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RollbackDeploymentRequest{")
+	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDeploymentRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DeploymentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deployment != nil {
+		{
+			size, err := m.Deployment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDeploymentRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDeploymentRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StartDeploymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StartDeploymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StartDeploymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnavailable != 0 {
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(m.MaxUnavailable))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxSurge != 0 {
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(m.MaxSurge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RunInfo != nil {
+		{
+			size, err := m.RunInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDeploymentRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeploymentByProcessGuidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentByProcessGuidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentByProcessGuidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauseDeploymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseDeploymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseDeploymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResumeDeploymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResumeDeploymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResumeDeploymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RollbackDeploymentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollbackDeploymentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollbackDeploymentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProcessGuid) > 0 {
+		i -= len(m.ProcessGuid)
+		copy(dAtA[i:], m.ProcessGuid)
+		i = encodeVarintDeploymentRequests(dAtA, i, uint64(len(m.ProcessGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDeploymentRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDeploymentRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DeploymentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *StartDeploymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	if m.RunInfo != nil {
+		l = m.RunInfo.Size()
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	if m.MaxSurge != 0 {
+		n += 1 + sovDeploymentRequests(uint64(m.MaxSurge))
+	}
+	if m.MaxUnavailable != 0 {
+		n += 1 + sovDeploymentRequests(uint64(m.MaxUnavailable))
+	}
+	return n
+}
+
+func (m *DeploymentByProcessGuidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *PauseDeploymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ResumeDeploymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *RollbackDeploymentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProcessGuid)
+	if l > 0 {
+		n += 1 + l + sovDeploymentRequests(uint64(l))
+	}
+	return n
+}
+
+func sovDeploymentRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDeploymentRequests(x uint64) (n int) {
+	return sovDeploymentRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DeploymentResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploymentResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Deployment:` + strings.Replace(fmt.Sprintf("%v", this.Deployment), "Deployment", "Deployment", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StartDeploymentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StartDeploymentRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`RunInfo:` + strings.Replace(fmt.Sprintf("%v", this.RunInfo), "DesiredLRPRunInfo", "DesiredLRPRunInfo", 1) + `,`,
+		`MaxSurge:` + fmt.Sprintf("%v", this.MaxSurge) + `,`,
+		`MaxUnavailable:` + fmt.Sprintf("%v", this.MaxUnavailable) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploymentByProcessGuidRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploymentByProcessGuidRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PauseDeploymentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PauseDeploymentRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResumeDeploymentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResumeDeploymentRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RollbackDeploymentRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RollbackDeploymentRequest{`,
+		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDeploymentRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DeploymentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deployment == nil {
+				m.Deployment = &Deployment{}
+			}
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StartDeploymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StartDeploymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StartDeploymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunInfo == nil {
+				m.RunInfo = &DesiredLRPRunInfo{}
+			}
+			if err := m.RunInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+			}
+			m.MaxSurge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSurge |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			m.MaxUnavailable = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUnavailable |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeploymentByProcessGuidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeploymentByProcessGuidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeploymentByProcessGuidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauseDeploymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseDeploymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseDeploymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResumeDeploymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResumeDeploymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResumeDeploymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RollbackDeploymentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollbackDeploymentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollbackDeploymentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeploymentRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeploymentRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDeploymentRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDeploymentRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDeploymentRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDeploymentRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDeploymentRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDeploymentRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDeploymentRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDeploymentRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDeploymentRequests = fmt.Errorf("proto: unexpected end of group")
+)
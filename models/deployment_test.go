@@ -0,0 +1,152 @@
+package models_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment", func() {
+	var deployment *models.Deployment
+
+	BeforeEach(func() {
+		deployment = &models.Deployment{
+			State:          models.Deployment_InProgress,
+			Instances:      4,
+			PendingIndices: []int32{1, 2, 3},
+		}
+	})
+
+	Describe("IsActive", func() {
+		It("is true while the deployment is in progress or rolling back", func() {
+			Expect(deployment.IsActive()).To(BeTrue())
+			deployment.State = models.Deployment_RollingBack
+			Expect(deployment.IsActive()).To(BeTrue())
+		})
+
+		It("is false once the deployment has finished", func() {
+			deployment.State = models.Deployment_Succeeded
+			Expect(deployment.IsActive()).To(BeFalse())
+			deployment.State = models.Deployment_RolledBack
+			Expect(deployment.IsActive()).To(BeFalse())
+		})
+	})
+
+	Describe("Retire", func() {
+		It("moves the index from pending to retiring", func() {
+			deployment.Retire(2, "instance-guid")
+			Expect(deployment.PendingIndices).To(Equal([]int32{1, 3}))
+			Expect(deployment.RetiringInstances).To(Equal(map[int32]string{2: "instance-guid"}))
+			Expect(deployment.Replaced()).To(BeFalse())
+		})
+	})
+
+	Describe("Replaced", func() {
+		It("is true when nothing is pending or retiring", func() {
+			deployment.PendingIndices = nil
+			Expect(deployment.Replaced()).To(BeTrue())
+		})
+	})
+
+	Describe("Rollback", func() {
+		It("makes the replaced and retiring indices pending", func() {
+			deployment.Retire(1, "instance-guid")
+			deployment.Rollback()
+
+			Expect(deployment.State).To(Equal(models.Deployment_RollingBack))
+			Expect(deployment.PendingIndices).To(Equal([]int32{0, 1}))
+			Expect(deployment.RetiringInstances).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("Deployment Requests", func() {
+	Describe("StartDeploymentRequest", func() {
+		Describe("Validate", func() {
+			var request models.StartDeploymentRequest
+
+			BeforeEach(func() {
+				desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+				runInfo := desiredLRP.DesiredLRPRunInfo(time.Now())
+				request = models.StartDeploymentRequest{
+					ProcessGuid:    "some-guid",
+					RunInfo:        &runInfo,
+					MaxSurge:       1,
+					MaxUnavailable: 0,
+				}
+			})
+
+			Context("when valid", func() {
+				It("returns nil", func() {
+					Expect(request.Validate()).To(BeNil())
+				})
+			})
+
+			Context("when the ProcessGuid is blank", func() {
+				BeforeEach(func() {
+					request.ProcessGuid = ""
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ContainElement(models.ErrInvalidField{"process_guid"}))
+				})
+			})
+
+			Context("when the run info is missing", func() {
+				BeforeEach(func() {
+					request.RunInfo = nil
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"run_info"}))
+				})
+			})
+
+			Context("when the run info belongs to another process", func() {
+				BeforeEach(func() {
+					request.RunInfo.ProcessGuid = "other-guid"
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"run_info.process_guid"}))
+				})
+			})
+
+			Context("when max_surge is negative", func() {
+				BeforeEach(func() {
+					request.MaxSurge = -1
+					request.MaxUnavailable = 1
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"max_surge"}))
+				})
+			})
+
+			Context("when max_surge and max_unavailable are both zero", func() {
+				BeforeEach(func() {
+					request.MaxSurge = 0
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(HaveOccurred())
+				})
+			})
+		})
+	})
+
+	Describe("RollbackDeploymentRequest", func() {
+		Describe("Validate", func() {
+			It("requires a process guid", func() {
+				request := models.RollbackDeploymentRequest{}
+				Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"process_guid"}))
+
+				request.ProcessGuid = "some-guid"
+				Expect(request.Validate()).To(BeNil())
+			})
+		})
+	})
+})
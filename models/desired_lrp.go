@@ -28,6 +28,11 @@ type DesiredLRPFilter struct {
 	Domain       string
 	ProcessGuids []string
 	AppGuids     []string
+	PageSize     int32
+	PageToken    string
+	// LabelSelector has the same type as the DesiredLRPsRequest field so that
+	// the two structs remain convertible.
+	LabelSelector []*LabelSelectorRequirement
 }
 
 func PreloadedRootFS(stack string) string {
@@ -90,6 +95,8 @@ func NewDesiredLRP(schedInfo DesiredLRPSchedulingInfo, runInfo DesiredLRPRunInfo
 		LogRateLimit:                  runInfo.LogRateLimit,
 		VolumeMountedFiles:            volumeMountedFiles,
 		UpdateStrategy:                updateStrategy,
+		Labels:                        schedInfo.Labels,
+		RestartPolicy:                 schedInfo.RestartPolicy,
 	}
 }
 
@@ -242,7 +249,7 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		volumePlacement.DriverNames = append(volumePlacement.DriverNames, mount.Driver)
 	}
 
-	return NewDesiredLRPSchedulingInfo(
+	schedulingInfo := NewDesiredLRPSchedulingInfo(
 		d.DesiredLRPKey(),
 		d.Annotation,
 		d.Instances,
@@ -252,6 +259,10 @@ func (d *DesiredLRP) DesiredLRPSchedulingInfo() DesiredLRPSchedulingInfo {
 		&volumePlacement,
 		d.PlacementTags,
 	)
+	schedulingInfo.Labels = d.Labels
+	schedulingInfo.RestartPolicy = d.RestartPolicy
+
+	return schedulingInfo
 }
 
 func (d *DesiredLRP) DesiredLRPRoutingInfo() DesiredLRP {
@@ -393,6 +404,9 @@ func (desired DesiredLRP) Validate() error {
 		}
 	}
 
+	validationError = validationError.Append(validateLabels(desired.Labels))
+	validationError = validationError.Append(desired.RestartPolicy.validate())
+
 	runInfoErrors := desired.DesiredLRPRunInfo(time.Now()).Validate()
 	if runInfoErrors != nil {
 		validationError = validationError.Append(runInfoErrors)
@@ -692,6 +706,8 @@ func (s DesiredLRPSchedulingInfo) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"annotation"})
 	}
 
+	validationError = validationError.Append(s.RestartPolicy.validate())
+
 	return validationError.ToError()
 }
 
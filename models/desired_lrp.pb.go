@@ -56,8 +56,10 @@ type DesiredLRPSchedulingInfo struct {
 	DesiredLRPResource `protobuf:"bytes,4,opt,name=desired_lrp_resource,json=desiredLrpResource,proto3,embedded=desired_lrp_resource" json:""`
 	Routes             Routes `protobuf:"bytes,5,opt,name=routes,proto3,customtype=Routes" json:"routes"`
 	ModificationTag    `protobuf:"bytes,6,opt,name=modification_tag,json=modificationTag,proto3,embedded=modification_tag" json:""`
-	VolumePlacement    *VolumePlacement `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement,proto3" json:"volume_placement,omitempty"`
-	PlacementTags      []string         `protobuf:"bytes,8,rep,name=PlacementTags,proto3" json:"placement_tags,omitempty"`
+	VolumePlacement    *VolumePlacement  `protobuf:"bytes,7,opt,name=volume_placement,json=volumePlacement,proto3" json:"volume_placement,omitempty"`
+	PlacementTags      []string          `protobuf:"bytes,8,rep,name=PlacementTags,proto3" json:"placement_tags,omitempty"`
+	Labels             map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RestartPolicy      *RestartPolicy    `protobuf:"bytes,10,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfo) Reset()      { *m = DesiredLRPSchedulingInfo{} }
@@ -120,6 +122,20 @@ func (m *DesiredLRPSchedulingInfo) GetPlacementTags() []string {
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *DesiredLRPSchedulingInfo) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
 type DesiredLRPRunInfo struct {
 	DesiredLRPKey                 `protobuf:"bytes,1,opt,name=desired_lrp_key,json=desiredLrpKey,proto3,embedded=desired_lrp_key" json:""`
 	EnvironmentVariables          []EnvironmentVariable      `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env"`
@@ -422,21 +438,17 @@ func (m *ProtoRoutes) GetRoutes() map[string][]byte {
 
 type DesiredLRPUpdate struct {
 	// Types that are valid to be assigned to OptionalInstances:
-	//
 	//	*DesiredLRPUpdate_Instances
 	OptionalInstances isDesiredLRPUpdate_OptionalInstances `protobuf_oneof:"optional_instances"`
 	Routes            *Routes                              `protobuf:"bytes,2,opt,name=routes,proto3,customtype=Routes" json:"routes,omitempty"`
 	// Types that are valid to be assigned to OptionalAnnotation:
-	//
 	//	*DesiredLRPUpdate_Annotation
 	OptionalAnnotation isDesiredLRPUpdate_OptionalAnnotation `protobuf_oneof:"optional_annotation"`
 	MetricTags         map[string]*MetricTagValue            `protobuf:"bytes,4,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	// Types that are valid to be assigned to OptionalImageUsername:
-	//
 	//	*DesiredLRPUpdate_ImageUsername
 	OptionalImageUsername isDesiredLRPUpdate_OptionalImageUsername `protobuf_oneof:"optional_image_username"`
 	// Types that are valid to be assigned to OptionalImagePassword:
-	//
 	//	*DesiredLRPUpdate_ImagePassword
 	OptionalImagePassword isDesiredLRPUpdate_OptionalImagePassword `protobuf_oneof:"optional_image_password"`
 }
@@ -752,6 +764,8 @@ type DesiredLRP struct {
 	LogRateLimit                  *LogRateLimit              `protobuf:"bytes,37,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
 	VolumeMountedFiles            []*File                    `protobuf:"bytes,38,rep,name=volume_mounted_files,json=volumeMountedFiles,proto3" json:"volume_mounted_files"`
 	UpdateStrategy                DesiredLRP_UpdateStrategy  `protobuf:"varint,39,opt,name=update_strategy,json=updateStrategy,proto3,enum=models.DesiredLRP_UpdateStrategy" json:"update_strategy"`
+	Labels                        map[string]string          `protobuf:"bytes,40,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RestartPolicy                 *RestartPolicy             `protobuf:"bytes,41,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
 }
 
 func (m *DesiredLRP) Reset()      { *m = DesiredLRP{} }
@@ -1055,9 +1069,99 @@ func (m *DesiredLRP) GetUpdateStrategy() DesiredLRP_UpdateStrategy {
 	return DesiredLRP_UpdateStrategyRolling
 }
 
+func (m *DesiredLRP) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *DesiredLRP) GetRestartPolicy() *RestartPolicy {
+	if m != nil {
+		return m.RestartPolicy
+	}
+	return nil
+}
+
+type RestartPolicy struct {
+	ImmediateRestarts int32 `protobuf:"varint,1,opt,name=immediate_restarts,json=immediateRestarts,proto3" json:"immediate_restarts"`
+	MinBackoffMs      int64 `protobuf:"varint,2,opt,name=min_backoff_ms,json=minBackoffMs,proto3" json:"min_backoff_ms,omitempty"`
+	MaxBackoffMs      int64 `protobuf:"varint,3,opt,name=max_backoff_ms,json=maxBackoffMs,proto3" json:"max_backoff_ms,omitempty"`
+	MaxRestarts       int32 `protobuf:"varint,4,opt,name=max_restarts,json=maxRestarts,proto3" json:"max_restarts,omitempty"`
+	NeverRestart      bool  `protobuf:"varint,5,opt,name=never_restart,json=neverRestart,proto3" json:"never_restart,omitempty"`
+}
+
+func (m *RestartPolicy) Reset()      { *m = RestartPolicy{} }
+func (*RestartPolicy) ProtoMessage() {}
+func (*RestartPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f592e9299b63d68c, []int{7}
+}
+func (m *RestartPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RestartPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RestartPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartPolicy.Merge(m, src)
+}
+func (m *RestartPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartPolicy proto.InternalMessageInfo
+
+func (m *RestartPolicy) GetImmediateRestarts() int32 {
+	if m != nil {
+		return m.ImmediateRestarts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMinBackoffMs() int64 {
+	if m != nil {
+		return m.MinBackoffMs
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxBackoffMs() int64 {
+	if m != nil {
+		return m.MaxBackoffMs
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetMaxRestarts() int32 {
+	if m != nil {
+		return m.MaxRestarts
+	}
+	return 0
+}
+
+func (m *RestartPolicy) GetNeverRestart() bool {
+	if m != nil {
+		return m.NeverRestart
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterEnum("models.DesiredLRP_UpdateStrategy", DesiredLRP_UpdateStrategy_name, DesiredLRP_UpdateStrategy_value)
 	proto.RegisterType((*DesiredLRPSchedulingInfo)(nil), "models.DesiredLRPSchedulingInfo")
+	proto.RegisterMapType((map[string]string)(nil), "models.DesiredLRPSchedulingInfo.LabelsEntry")
 	proto.RegisterType((*DesiredLRPRunInfo)(nil), "models.DesiredLRPRunInfo")
 	proto.RegisterMapType((map[string]*MetricTagValue)(nil), "models.DesiredLRPRunInfo.MetricTagsEntry")
 	proto.RegisterType((*ProtoRoutes)(nil), "models.ProtoRoutes")
@@ -1067,137 +1171,152 @@ func init() {
 	proto.RegisterType((*DesiredLRPKey)(nil), "models.DesiredLRPKey")
 	proto.RegisterType((*DesiredLRPResource)(nil), "models.DesiredLRPResource")
 	proto.RegisterType((*DesiredLRP)(nil), "models.DesiredLRP")
+	proto.RegisterMapType((map[string]string)(nil), "models.DesiredLRP.LabelsEntry")
 	proto.RegisterMapType((map[string]*MetricTagValue)(nil), "models.DesiredLRP.MetricTagsEntry")
+	proto.RegisterType((*RestartPolicy)(nil), "models.RestartPolicy")
 }
 
 func init() { proto.RegisterFile("desired_lrp.proto", fileDescriptor_f592e9299b63d68c) }
 
 var fileDescriptor_f592e9299b63d68c = []byte{
-	// 1975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdd, 0x6e, 0x1b, 0xc7,
-	0x15, 0xe6, 0xea, 0x87, 0x14, 0x87, 0x3f, 0xa2, 0x46, 0x94, 0x34, 0xa2, 0x6d, 0x2e, 0xa3, 0xf8,
-	0x87, 0x69, 0x12, 0x05, 0x50, 0x52, 0x34, 0x4d, 0x8b, 0x02, 0x5e, 0x59, 0x71, 0x04, 0x4b, 0xae,
-	0x30, 0xb2, 0xdd, 0x36, 0x40, 0xb1, 0x58, 0xed, 0x8e, 0x56, 0x0b, 0xef, 0xee, 0x2c, 0x76, 0x76,
-	0xe5, 0xf0, 0xae, 0xbd, 0xcd, 0x55, 0xfb, 0x00, 0xb9, 0xef, 0x03, 0x14, 0xe8, 0x2b, 0xe4, 0xd2,
-	0x97, 0x41, 0x51, 0x10, 0xb5, 0x7c, 0x13, 0xf0, 0x2a, 0x8f, 0x10, 0xcc, 0xec, 0x3f, 0x49, 0x53,
-	0x72, 0x6c, 0x5f, 0x71, 0xe6, 0x9c, 0x6f, 0xce, 0x9e, 0x99, 0x39, 0x73, 0xce, 0x77, 0x08, 0x56,
-	0x0c, 0xc2, 0x2c, 0x9f, 0x18, 0xaa, 0xed, 0x7b, 0xdb, 0x9e, 0x4f, 0x03, 0x0a, 0xcb, 0x0e, 0x35,
-	0x88, 0xcd, 0x3a, 0x1f, 0x9b, 0x56, 0x70, 0x16, 0x9e, 0x6c, 0xeb, 0xd4, 0xf9, 0xc4, 0xa4, 0x26,
-	0xfd, 0x44, 0xa8, 0x4f, 0xc2, 0x53, 0x31, 0x13, 0x13, 0x31, 0x8a, 0x96, 0x75, 0x1a, 0x9a, 0x1e,
-	0x58, 0xd4, 0x65, 0xf1, 0x74, 0x43, 0xd7, 0xf4, 0x33, 0x62, 0xa8, 0x06, 0xf1, 0x88, 0x6b, 0x10,
-	0x57, 0x1f, 0xc4, 0x8a, 0xeb, 0x3a, 0xf1, 0x03, 0xeb, 0xd4, 0xd2, 0xb5, 0x80, 0xa8, 0x9e, 0x4f,
-	0x3d, 0x3e, 0x25, 0xc9, 0xb2, 0x6b, 0xc4, 0x3d, 0xb7, 0x7c, 0xea, 0x3a, 0xc4, 0x0d, 0xd4, 0x73,
-	0xcd, 0xb7, 0xb4, 0x13, 0x3b, 0x55, 0xae, 0x3b, 0xd4, 0x88, 0x56, 0x5a, 0xd4, 0x55, 0x03, 0xcd,
-	0x4c, 0x3e, 0xed, 0x92, 0xe0, 0x19, 0xf5, 0x9f, 0xc6, 0xd3, 0x36, 0x23, 0x7a, 0xe8, 0x5b, 0xc1,
-	0x40, 0x35, 0x7d, 0x1a, 0xc6, 0xdb, 0xea, 0xc0, 0x73, 0x6a, 0x87, 0x0e, 0x51, 0x1d, 0x1a, 0xba,
-	0x41, 0x62, 0x50, 0x3f, 0x23, 0xfa, 0x53, 0xd5, 0x20, 0xa7, 0x96, 0x6b, 0x71, 0xa3, 0xb1, 0x7c,
-	0xc5, 0x72, 0x34, 0x93, 0xa8, 0xb6, 0x36, 0x20, 0x7e, 0x22, 0x72, 0x48, 0xe0, 0x5b, 0x3a, 0xff,
-	0x6a, 0xe2, 0x4e, 0x83, 0x59, 0x06, 0xd1, 0xb5, 0x04, 0xd1, 0xb6, 0xa9, 0xa9, 0xfa, 0x7c, 0x57,
-	0xb6, 0xe5, 0x58, 0xc9, 0x27, 0xc0, 0xa9, 0x65, 0x93, 0x68, 0xbc, 0xf5, 0x9f, 0x05, 0x80, 0xee,
-	0x45, 0xe7, 0x7d, 0x80, 0x8f, 0x8e, 0xf9, 0xf9, 0x84, 0xb6, 0xe5, 0x9a, 0xfb, 0xee, 0x29, 0x85,
-	0x0f, 0xc0, 0x72, 0xee, 0x2e, 0xd4, 0xa7, 0x64, 0x80, 0xa4, 0x9e, 0xd4, 0xaf, 0xed, 0xac, 0x6d,
-	0x47, 0x17, 0xb2, 0x9d, 0x2d, 0x7d, 0x40, 0x06, 0x4a, 0xfd, 0xfb, 0xa1, 0x5c, 0x7a, 0x3e, 0x94,
-	0xa5, 0xd1, 0x50, 0x2e, 0xe1, 0x46, 0xbc, 0xf6, 0xc0, 0xf7, 0x1e, 0x90, 0x01, 0xdc, 0x06, 0x40,
-	0x73, 0x5d, 0x1a, 0x88, 0x93, 0x42, 0x73, 0x3d, 0xa9, 0x5f, 0x55, 0x9a, 0xa3, 0xa1, 0x9c, 0x93,
-	0xe2, 0xdc, 0x18, 0x7e, 0x08, 0xaa, 0x96, 0xcb, 0x02, 0xcd, 0xd5, 0x09, 0x43, 0xf3, 0x3d, 0xa9,
-	0xbf, 0xa8, 0x34, 0x46, 0x43, 0x39, 0x13, 0xe2, 0x6c, 0x08, 0xbf, 0x06, 0xed, 0xbc, 0xa7, 0x3e,
-	0x61, 0x34, 0xf4, 0x75, 0x82, 0x16, 0x84, 0xbb, 0x9d, 0x49, 0x77, 0x71, 0x8c, 0x18, 0xf3, 0x19,
-	0x66, 0x3e, 0x27, 0x08, 0xf8, 0x3b, 0x50, 0xf6, 0x69, 0x18, 0x10, 0x86, 0x16, 0x85, 0xb5, 0xd5,
-	0xc4, 0xda, 0x11, 0x3f, 0x41, 0x2c, 0x54, 0x4a, 0x93, 0x9b, 0xf9, 0xef, 0x50, 0x2e, 0x47, 0x73,
-	0x1c, 0x2f, 0x81, 0x47, 0xa0, 0x35, 0x1e, 0x21, 0xa8, 0x2c, 0xcc, 0x6c, 0x24, 0x66, 0x0e, 0x73,
-	0xfa, 0x47, 0x9a, 0x39, 0xe6, 0xd1, 0xb2, 0x53, 0x54, 0x43, 0x05, 0xb4, 0xe2, 0xb0, 0xf1, 0x6c,
-	0x4d, 0x27, 0x3c, 0x2a, 0x51, 0xa5, 0x68, 0xf1, 0x89, 0xd0, 0x1f, 0x25, 0x6a, 0xbc, 0x7c, 0x5e,
-	0x14, 0x40, 0x05, 0x34, 0xd2, 0xc9, 0x23, 0xcd, 0x64, 0x68, 0xa9, 0x37, 0xdf, 0xaf, 0x2a, 0xd7,
-	0x47, 0x43, 0x19, 0xa5, 0x56, 0x45, 0x5c, 0x7d, 0x44, 0x1d, 0x2b, 0x20, 0x8e, 0x17, 0x0c, 0x70,
-	0x71, 0xc9, 0xd6, 0xcb, 0x06, 0x58, 0xc9, 0x9d, 0x67, 0xe8, 0xbe, 0xfd, 0x90, 0xf9, 0x2b, 0x58,
-	0x9b, 0xfa, 0xf6, 0xd0, 0x5c, 0x6f, 0xbe, 0x5f, 0xdb, 0xb9, 0x96, 0x98, 0xdc, 0xcb, 0x40, 0x4f,
-	0x62, 0x8c, 0x52, 0xe3, 0x86, 0x47, 0x43, 0x79, 0x9e, 0xb8, 0xe7, 0xb8, 0x4d, 0x26, 0x11, 0x0c,
-	0xde, 0x04, 0x8b, 0x8c, 0x04, 0xa1, 0x27, 0xa2, 0xab, 0xb6, 0xd3, 0x4c, 0xcc, 0xdd, 0x15, 0x59,
-	0x03, 0x47, 0x4a, 0x78, 0x1b, 0x94, 0xa3, 0x34, 0x12, 0x07, 0xd3, 0x38, 0x2c, 0xd6, 0xc2, 0x3e,
-	0xa8, 0x38, 0xd4, 0xb5, 0x02, 0xea, 0xc7, 0x71, 0x32, 0x0e, 0x4c, 0xd4, 0xf0, 0x6b, 0xd0, 0x31,
-	0x88, 0xe7, 0x13, 0x9e, 0x6e, 0x0c, 0x95, 0x05, 0x9a, 0x1f, 0xa8, 0x81, 0xe5, 0x10, 0x1a, 0x06,
-	0x2a, 0x13, 0xd1, 0xd1, 0x50, 0x6e, 0x8c, 0x86, 0xf2, 0x46, 0x41, 0x95, 0xdd, 0x04, 0x92, 0xf0,
-	0x46, 0x66, 0xe0, 0x98, 0x83, 0x1e, 0x45, 0x98, 0x63, 0xfe, 0xca, 0x3c, 0xdf, 0x3a, 0xb7, 0x6c,
-	0x62, 0x12, 0x43, 0xc4, 0xc5, 0x52, 0xf4, 0xca, 0x32, 0x29, 0xce, 0x8d, 0xe1, 0xc7, 0x00, 0xe8,
-	0x5e, 0xa8, 0x3e, 0x23, 0x96, 0x79, 0x16, 0xa0, 0x25, 0xf1, 0x6d, 0x81, 0xcf, 0xa4, 0xb8, 0xaa,
-	0x7b, 0xe1, 0x9f, 0xc4, 0x10, 0x22, 0xb0, 0xe8, 0x51, 0x3f, 0x60, 0xa8, 0xda, 0x9b, 0xef, 0x37,
-	0x94, 0xb9, 0x56, 0x09, 0x47, 0x02, 0xa8, 0x80, 0x3a, 0x31, 0x7d, 0xc2, 0x98, 0xea, 0x87, 0xfc,
-	0x8a, 0x80, 0xb8, 0xa2, 0xcd, 0xe4, 0x0c, 0x8e, 0xe3, 0xfc, 0x77, 0x9f, 0xa7, 0x3f, 0x1c, 0xda,
-	0x44, 0x59, 0xe0, 0x17, 0x84, 0x6b, 0xd1, 0x22, 0x2e, 0x61, 0xdc, 0x19, 0x9e, 0xb0, 0xe2, 0xb7,
-	0x5b, 0xcb, 0x52, 0x44, 0x26, 0xc5, 0x55, 0x9b, 0x9a, 0xc7, 0xd1, 0xc3, 0xfc, 0x35, 0xa8, 0x47,
-	0x19, 0x90, 0xa9, 0x66, 0x68, 0x19, 0xa8, 0x2e, 0x16, 0xc0, 0xd1, 0x50, 0x2e, 0xca, 0x25, 0x5c,
-	0x8b, 0xe7, 0xf7, 0x43, 0x2b, 0xda, 0xb2, 0x4f, 0xc4, 0xd9, 0x6b, 0x01, 0x6a, 0xf4, 0xa4, 0xfe,
-	0x7c, 0xbc, 0xe5, 0x54, 0x8a, 0xab, 0xf1, 0xf8, 0x6e, 0x00, 0xf7, 0xc1, 0xea, 0x78, 0xdd, 0xb0,
-	0x08, 0x43, 0x4d, 0xb1, 0x3f, 0x94, 0xec, 0x6f, 0x57, 0x40, 0xee, 0xa5, 0x95, 0x05, 0x43, 0xbd,
-	0x28, 0xb1, 0x08, 0x83, 0x9f, 0x81, 0xb6, 0x4d, 0x4c, 0x4d, 0x1f, 0xa8, 0x06, 0x7d, 0xe6, 0xda,
-	0x54, 0x33, 0xd4, 0x90, 0x11, 0x1f, 0x2d, 0x0b, 0xc7, 0xe7, 0x90, 0x84, 0x61, 0xa4, 0xbf, 0x17,
-	0xab, 0x1f, 0x33, 0xe2, 0xc3, 0xfb, 0xa0, 0x17, 0xf8, 0x21, 0x13, 0xb1, 0x32, 0x60, 0x01, 0x71,
-	0xd4, 0x5c, 0xb9, 0x62, 0xaa, 0xa7, 0x05, 0x67, 0xa8, 0xc5, 0x2d, 0xe0, 0x1b, 0x31, 0xee, 0x58,
-	0xc0, 0x76, 0x73, 0xa8, 0x23, 0x2d, 0x38, 0x83, 0x9f, 0x83, 0x46, 0xbe, 0xe0, 0x30, 0xb4, 0x22,
-	0xf6, 0xb0, 0x5a, 0x4c, 0x1b, 0x87, 0x5c, 0x87, 0xeb, 0xe7, 0xd9, 0x84, 0xc1, 0x0f, 0x40, 0x25,
-	0xae, 0x67, 0x08, 0x8a, 0xd8, 0x5e, 0x4e, 0xd6, 0x3c, 0x8c, 0xc4, 0x38, 0xd1, 0xc3, 0x3f, 0x80,
-	0x56, 0x31, 0xa2, 0x1d, 0x86, 0x56, 0xc5, 0x19, 0xb7, 0x47, 0x43, 0x79, 0x42, 0x87, 0x9b, 0x2c,
-	0x17, 0xbf, 0x87, 0x3c, 0x93, 0xaf, 0x4f, 0xaf, 0xc6, 0xa8, 0x2d, 0xbe, 0x7c, 0x23, 0x3d, 0xf1,
-	0x0c, 0x75, 0x94, 0x82, 0x44, 0x54, 0x49, 0x78, 0x4d, 0x9f, 0xa6, 0x84, 0xb7, 0x40, 0x33, 0xaa,
-	0xa2, 0xfc, 0xd4, 0x5d, 0xcd, 0x21, 0x68, 0x4d, 0x9c, 0x5b, 0x43, 0x48, 0x1f, 0xc7, 0xc2, 0x0c,
-	0xe6, 0x69, 0x8c, 0x3d, 0xa3, 0xbe, 0x81, 0xd6, 0x73, 0xb0, 0xa3, 0x58, 0xc8, 0x13, 0xf1, 0x78,
-	0xad, 0x46, 0x1b, 0xc5, 0x44, 0xbc, 0xcb, 0xf5, 0xf7, 0x52, 0x35, 0x5e, 0xd6, 0x8b, 0x02, 0x1e,
-	0xc2, 0xb9, 0xba, 0xce, 0x10, 0x12, 0x37, 0x02, 0x93, 0xf5, 0xfb, 0x5c, 0x77, 0xc0, 0x55, 0xb8,
-	0x66, 0xa5, 0x63, 0x06, 0x1f, 0x82, 0x5a, 0xae, 0xf6, 0xa3, 0x4d, 0xb1, 0xea, 0x83, 0x29, 0x55,
-	0x2e, 0xca, 0xca, 0xdb, 0x87, 0x02, 0xcc, 0xd3, 0xf6, 0x9e, 0x1b, 0xf8, 0x03, 0x11, 0x6a, 0xc0,
-	0x49, 0x85, 0xf0, 0x43, 0xb0, 0x14, 0x13, 0x07, 0x86, 0x3a, 0xc2, 0x58, 0x7a, 0xc1, 0xc7, 0x91,
-	0x1c, 0xa7, 0x00, 0xf8, 0x05, 0x68, 0x16, 0x69, 0x05, 0xba, 0x26, 0x76, 0xdd, 0x4e, 0x96, 0x1c,
-	0x50, 0x13, 0x6b, 0x01, 0x39, 0xe0, 0x3a, 0x5c, 0xb7, 0x73, 0x33, 0xf8, 0x67, 0xd0, 0xce, 0x87,
-	0x20, 0x31, 0x54, 0xce, 0x45, 0x18, 0xba, 0x2e, 0x3e, 0x5a, 0x4f, 0x2c, 0x7c, 0x69, 0xd9, 0x44,
-	0x41, 0xa3, 0xa1, 0x3c, 0x15, 0x8d, 0x61, 0x2e, 0x38, 0x89, 0xc1, 0xc1, 0xac, 0xf3, 0x18, 0x2c,
-	0x8f, 0xed, 0x12, 0xb6, 0xc0, 0x7c, 0x52, 0x7f, 0xaa, 0x98, 0x0f, 0xe1, 0x47, 0x60, 0xf1, 0x5c,
-	0xb3, 0x43, 0x22, 0xe8, 0x47, 0x6d, 0x67, 0x3d, 0x2d, 0xc1, 0xc9, 0xca, 0x27, 0x5c, 0x8b, 0x23,
-	0xd0, 0x17, 0x73, 0x9f, 0x4b, 0x5b, 0x7f, 0x97, 0x40, 0x2d, 0x57, 0xe7, 0xe1, 0x6f, 0x52, 0x32,
-	0x20, 0x09, 0x97, 0xe5, 0x29, 0x64, 0x60, 0x3b, 0xfa, 0x11, 0x4e, 0x24, 0x44, 0xa0, 0xf3, 0x5b,
-	0x50, 0xcb, 0x89, 0xa7, 0xf8, 0xd6, 0xce, 0xfb, 0x56, 0xcf, 0xfb, 0xf0, 0xe3, 0x3c, 0x68, 0x65,
-	0x77, 0xfa, 0xd8, 0x33, 0xb4, 0x80, 0xc0, 0x6e, 0x9e, 0x1e, 0x71, 0x33, 0x8b, 0x5f, 0x95, 0xf2,
-	0x8c, 0x28, 0x63, 0x2d, 0x73, 0xb3, 0x59, 0x8b, 0x34, 0x85, 0xb5, 0xf4, 0x0a, 0x5c, 0x8d, 0x97,
-	0xc7, 0xea, 0x57, 0x52, 0x81, 0x9d, 0xed, 0x17, 0x23, 0x70, 0x41, 0x1c, 0x46, 0x7f, 0x32, 0x02,
-	0x23, 0x6f, 0xc7, 0x03, 0xb0, 0x10, 0x7c, 0x77, 0x26, 0x5e, 0xe5, 0xa2, 0xf8, 0xe0, 0xdc, 0xf8,
-	0xbb, 0xbc, 0x33, 0xf1, 0x2e, 0xcb, 0x02, 0x38, 0x3f, 0xf6, 0x32, 0xdf, 0x51, 0x2c, 0x28, 0x6d,
-	0x00, 0xa9, 0xc7, 0x77, 0xaf, 0xd9, 0x6a, 0x7a, 0xd0, 0xca, 0x1a, 0x58, 0x4d, 0xa5, 0xd9, 0x01,
-	0x29, 0x9b, 0x60, 0x23, 0x03, 0x17, 0xb6, 0x37, 0x45, 0x95, 0x6c, 0x68, 0xeb, 0x9f, 0x12, 0x68,
-	0x14, 0x08, 0x12, 0xfc, 0x14, 0xd4, 0x3d, 0x9f, 0xea, 0xbc, 0xb0, 0x46, 0xc5, 0x4c, 0xd4, 0x8a,
-	0x16, 0x2f, 0x72, 0x79, 0x39, 0xae, 0xc5, 0x33, 0x51, 0xe2, 0xb6, 0x40, 0xd9, 0xa0, 0x8e, 0x66,
-	0x25, 0x3c, 0x1b, 0x8c, 0x86, 0x72, 0x2c, 0xc1, 0xf1, 0x2f, 0xbc, 0x03, 0x96, 0xf8, 0x33, 0x16,
-	0x46, 0xc5, 0x0d, 0x2b, 0xf5, 0xd1, 0x50, 0x4e, 0x65, 0xb8, 0x62, 0x53, 0x93, 0x1b, 0xdb, 0xfa,
-	0xb7, 0x04, 0xe0, 0x24, 0x71, 0x86, 0xbf, 0x02, 0x55, 0x87, 0x38, 0xd4, 0x1f, 0xa8, 0xce, 0x49,
-	0x14, 0x80, 0x11, 0x3f, 0x4f, 0x85, 0x78, 0x29, 0x1a, 0x1e, 0x9e, 0xc0, 0x9b, 0xa0, 0x62, 0x58,
-	0xec, 0x29, 0x47, 0xce, 0x09, 0x64, 0x6d, 0x34, 0x94, 0x13, 0x11, 0x2e, 0xf3, 0xc1, 0xe1, 0x09,
-	0x7c, 0x1f, 0x54, 0x7c, 0x4a, 0x03, 0xf5, 0x94, 0xc5, 0x0e, 0x09, 0xb7, 0xb9, 0xe8, 0x54, 0x84,
-	0x26, 0x0d, 0xbe, 0xe4, 0xd1, 0xb2, 0xe4, 0x68, 0xdf, 0xa8, 0x9e, 0x65, 0x30, 0x41, 0xc8, 0x16,
-	0x23, 0xb7, 0x13, 0x19, 0xae, 0x38, 0xda, 0x37, 0x47, 0x96, 0xc1, 0xb6, 0xfe, 0x07, 0x01, 0xc8,
-	0xdc, 0x7e, 0x77, 0xe7, 0x78, 0x25, 0xaf, 0x0b, 0xcd, 0xcc, 0xc2, 0x25, 0xcd, 0xcc, 0x5f, 0x5e,
-	0x45, 0x7b, 0x17, 0x2f, 0xa7, 0xbd, 0x95, 0x2b, 0x52, 0xde, 0xf2, 0xd5, 0x28, 0x6f, 0x65, 0x26,
-	0xe5, 0x9d, 0x56, 0xeb, 0xaf, 0xbd, 0x46, 0xad, 0x3f, 0x99, 0x49, 0x84, 0x23, 0x32, 0x7a, 0x6b,
-	0x34, 0x94, 0xe5, 0x1c, 0x2a, 0xd1, 0xbb, 0xec, 0x6a, 0x84, 0x38, 0x47, 0xcb, 0xab, 0xb3, 0x69,
-	0x79, 0x2e, 0x48, 0xc1, 0xab, 0x83, 0xb4, 0x10, 0xf6, 0xb5, 0xd9, 0x61, 0x5f, 0x24, 0xd7, 0xf5,
-	0xcb, 0xc8, 0x75, 0x91, 0xbb, 0x37, 0x2e, 0xe5, 0xee, 0x29, 0x19, 0x6f, 0x8e, 0x93, 0xf1, 0x2c,
-	0xf9, 0x2f, 0xbf, 0x7e, 0xf2, 0x2f, 0xb2, 0xf0, 0xd6, 0x65, 0x2c, 0x3c, 0x9f, 0x47, 0x56, 0x66,
-	0xe4, 0x91, 0x09, 0xba, 0x0e, 0xaf, 0x46, 0xd7, 0x8b, 0xff, 0x1b, 0xac, 0x5e, 0xfa, 0xbf, 0xc1,
-	0xef, 0xc7, 0x1a, 0x91, 0xf6, 0x25, 0x8d, 0x48, 0xb1, 0x05, 0x51, 0xa6, 0xf4, 0xeb, 0x6b, 0x33,
-	0xfb, 0xf5, 0xc9, 0x0e, 0xfd, 0x15, 0x1d, 0xc3, 0xfa, 0x5b, 0xec, 0x18, 0x36, 0xde, 0xb8, 0x63,
-	0x40, 0xbf, 0xa8, 0x63, 0xd8, 0xfc, 0x05, 0x1d, 0x43, 0xe7, 0x92, 0x8e, 0x61, 0xe2, 0xcf, 0x88,
-	0xeb, 0xaf, 0xfd, 0x67, 0x44, 0xa1, 0x2a, 0xdc, 0x98, 0x51, 0x15, 0x66, 0xb4, 0x17, 0xdd, 0x77,
-	0xd0, 0x5e, 0xc8, 0x57, 0x6b, 0x2f, 0x7a, 0x57, 0x6d, 0x2f, 0xde, 0x7b, 0xc3, 0xf6, 0x62, 0xeb,
-	0x6a, 0xed, 0xc5, 0x6e, 0x91, 0xdc, 0xbd, 0x2f, 0x56, 0x6d, 0x4d, 0x92, 0xbb, 0x99, 0xb4, 0x2e,
-	0xdf, 0x53, 0xdc, 0x7c, 0xfd, 0x9e, 0xe2, 0xd6, 0x1b, 0xf7, 0x14, 0xb7, 0xdf, 0xb4, 0xa7, 0x80,
-	0x2a, 0x58, 0x0e, 0x05, 0x7f, 0x55, 0x59, 0xc0, 0x5d, 0x33, 0x07, 0xe8, 0x4e, 0x4f, 0xea, 0x37,
-	0x77, 0xde, 0x9b, 0x72, 0x16, 0x11, 0xd3, 0x3d, 0x8e, 0x81, 0xca, 0xea, 0x68, 0x28, 0x8f, 0xaf,
-	0xc6, 0xcd, 0xb0, 0x00, 0x7a, 0x57, 0x4d, 0xcb, 0x09, 0x68, 0x16, 0xbd, 0x81, 0xb7, 0x41, 0x05,
-	0xff, 0xf1, 0xe0, 0x60, 0xff, 0xe1, 0xfd, 0x56, 0xa9, 0xb3, 0xf9, 0xed, 0x77, 0xbd, 0xb5, 0x22,
-	0x00, 0x53, 0xdb, 0xb6, 0x5c, 0x13, 0xf6, 0xc1, 0x12, 0xde, 0xdb, 0xc5, 0x7b, 0x77, 0x1f, 0xed,
-	0xb5, 0xa4, 0x4e, 0xe7, 0xdb, 0xef, 0x7a, 0xeb, 0x63, 0x40, 0x12, 0xfd, 0x33, 0xa2, 0x7c, 0xf6,
-	0xfc, 0x45, 0x57, 0xfa, 0xe1, 0x45, 0xb7, 0xf4, 0xd3, 0x8b, 0xae, 0xf4, 0xb7, 0x8b, 0xae, 0xf4,
-	0xaf, 0x8b, 0xae, 0xf4, 0xfd, 0x45, 0x57, 0x7a, 0x7e, 0xd1, 0x95, 0xfe, 0x7f, 0xd1, 0x95, 0x7e,
-	0xbc, 0xe8, 0x96, 0x7e, 0xba, 0xe8, 0x4a, 0xff, 0x78, 0xd9, 0x2d, 0x3d, 0x7f, 0xd9, 0x2d, 0xfd,
-	0xf0, 0xb2, 0x5b, 0x3a, 0x29, 0x8b, 0x7f, 0x9d, 0x3f, 0xfd, 0x39, 0x00, 0x00, 0xff, 0xff, 0xa7,
-	0xe2, 0x0e, 0x29, 0xe4, 0x17, 0x00, 0x00,
+	// 2189 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x73, 0xdb, 0xc6,
+	0x15, 0x17, 0x44, 0x8b, 0x12, 0x97, 0x1f, 0xa2, 0x56, 0x94, 0xb4, 0xa6, 0x6d, 0x82, 0x51, 0x3e,
+	0x4c, 0x37, 0x8e, 0x32, 0xe3, 0xa4, 0xd3, 0x34, 0xfd, 0x98, 0x1a, 0xb6, 0xe2, 0x78, 0x2c, 0xb9,
+	0x9a, 0x95, 0xed, 0xb6, 0x9e, 0xe9, 0x60, 0x40, 0x60, 0x45, 0x61, 0x0c, 0x60, 0x31, 0x58, 0x40,
+	0x16, 0x6f, 0xed, 0x35, 0xa7, 0xf6, 0x0f, 0xc8, 0xbd, 0xd3, 0x73, 0xff, 0x81, 0xde, 0x72, 0xf4,
+	0x31, 0xd3, 0x03, 0x5b, 0xcb, 0x97, 0x0c, 0x4f, 0xf9, 0x13, 0x3a, 0xbb, 0xf8, 0x5a, 0x90, 0xb4,
+	0x24, 0x47, 0xf6, 0x89, 0xbb, 0xef, 0xbd, 0x7d, 0x78, 0xfb, 0xf6, 0xed, 0x7b, 0xbf, 0xb7, 0x04,
+	0x2b, 0x16, 0x61, 0x76, 0x40, 0x2c, 0xdd, 0x09, 0xfc, 0x2d, 0x3f, 0xa0, 0x21, 0x85, 0x65, 0x97,
+	0x5a, 0xc4, 0x61, 0xed, 0x4f, 0x06, 0x76, 0x78, 0x18, 0xf5, 0xb7, 0x4c, 0xea, 0x7e, 0x3a, 0xa0,
+	0x03, 0xfa, 0xa9, 0x60, 0xf7, 0xa3, 0x03, 0x31, 0x13, 0x13, 0x31, 0x8a, 0x97, 0xb5, 0xeb, 0x86,
+	0x19, 0xda, 0xd4, 0x63, 0xc9, 0x74, 0xc3, 0x34, 0xcc, 0x43, 0x62, 0xe9, 0x16, 0xf1, 0x89, 0x67,
+	0x11, 0xcf, 0x1c, 0x26, 0x8c, 0xab, 0x26, 0x09, 0x42, 0xfb, 0xc0, 0x36, 0x8d, 0x90, 0xe8, 0x7e,
+	0x40, 0x7d, 0x3e, 0x25, 0xe9, 0xb2, 0x2b, 0xc4, 0x3b, 0xb2, 0x03, 0xea, 0xb9, 0xc4, 0x0b, 0xf5,
+	0x23, 0x23, 0xb0, 0x8d, 0xbe, 0x93, 0x31, 0xd7, 0x5d, 0x6a, 0xc5, 0x2b, 0x6d, 0xea, 0xe9, 0xa1,
+	0x31, 0x48, 0x3f, 0xed, 0x91, 0xf0, 0x39, 0x0d, 0x9e, 0x25, 0xd3, 0x16, 0x23, 0x66, 0x14, 0xd8,
+	0xe1, 0x50, 0x1f, 0x04, 0x34, 0x4a, 0xb6, 0xd5, 0x86, 0x47, 0xd4, 0x89, 0x5c, 0xa2, 0xbb, 0x34,
+	0xf2, 0xc2, 0x54, 0xa1, 0x79, 0x48, 0xcc, 0x67, 0xba, 0x45, 0x0e, 0x6c, 0xcf, 0xe6, 0x4a, 0x13,
+	0xfa, 0x8a, 0xed, 0x1a, 0x03, 0xa2, 0x3b, 0xc6, 0x90, 0x04, 0x29, 0xc9, 0x25, 0x61, 0x60, 0x9b,
+	0xfc, 0xab, 0xa9, 0x39, 0x75, 0x66, 0x5b, 0xc4, 0x34, 0x52, 0x89, 0x96, 0x43, 0x07, 0x7a, 0xc0,
+	0x77, 0xe5, 0xd8, 0xae, 0x9d, 0x7e, 0x02, 0x1c, 0xd8, 0x0e, 0x89, 0xc7, 0x9b, 0xff, 0x2c, 0x03,
+	0x74, 0x37, 0xf6, 0xf7, 0x0e, 0xde, 0xdb, 0xe7, 0xfe, 0x89, 0x1c, 0xdb, 0x1b, 0xdc, 0xf7, 0x0e,
+	0x28, 0x7c, 0x00, 0x96, 0xa5, 0xb3, 0xd0, 0x9f, 0x91, 0x21, 0x52, 0xba, 0x4a, 0xaf, 0x7a, 0x6b,
+	0x6d, 0x2b, 0x3e, 0x90, 0xad, 0x7c, 0xe9, 0x03, 0x32, 0xd4, 0x6a, 0xdf, 0x8d, 0xd4, 0xb9, 0x17,
+	0x23, 0x55, 0x19, 0x8f, 0xd4, 0x39, 0x5c, 0x4f, 0xd6, 0xee, 0x04, 0xfe, 0x03, 0x32, 0x84, 0x5b,
+	0x00, 0x18, 0x9e, 0x47, 0x43, 0xe1, 0x29, 0x34, 0xdf, 0x55, 0x7a, 0x15, 0xad, 0x31, 0x1e, 0xa9,
+	0x12, 0x15, 0x4b, 0x63, 0xf8, 0x31, 0xa8, 0xd8, 0x1e, 0x0b, 0x0d, 0xcf, 0x24, 0x0c, 0x95, 0xba,
+	0x4a, 0x6f, 0x41, 0xab, 0x8f, 0x47, 0x6a, 0x4e, 0xc4, 0xf9, 0x10, 0x3e, 0x05, 0x2d, 0xd9, 0xd2,
+	0x80, 0x30, 0x1a, 0x05, 0x26, 0x41, 0x97, 0x84, 0xb9, 0xed, 0x69, 0x73, 0x71, 0x22, 0x31, 0x61,
+	0x33, 0xcc, 0x6d, 0x4e, 0x25, 0xe0, 0xaf, 0x40, 0x39, 0xa0, 0x51, 0x48, 0x18, 0x5a, 0x10, 0xda,
+	0x56, 0x53, 0x6d, 0x7b, 0xdc, 0x83, 0x58, 0xb0, 0xb4, 0x06, 0x57, 0xf3, 0x9f, 0x91, 0x5a, 0x8e,
+	0xe7, 0x38, 0x59, 0x02, 0xf7, 0x40, 0x73, 0x32, 0x42, 0x50, 0x59, 0xa8, 0xd9, 0x48, 0xd5, 0xec,
+	0x4a, 0xfc, 0x47, 0xc6, 0x60, 0xc2, 0xa2, 0x65, 0xb7, 0xc8, 0x86, 0x1a, 0x68, 0x26, 0x61, 0xe3,
+	0x3b, 0x86, 0x49, 0x78, 0x54, 0xa2, 0xc5, 0xa2, 0xc6, 0x27, 0x82, 0xbf, 0x97, 0xb2, 0xf1, 0xf2,
+	0x51, 0x91, 0x00, 0x35, 0x50, 0xcf, 0x26, 0x8f, 0x8c, 0x01, 0x43, 0x4b, 0xdd, 0x52, 0xaf, 0xa2,
+	0x5d, 0x1d, 0x8f, 0x54, 0x94, 0x69, 0x15, 0x71, 0x75, 0x93, 0xba, 0x76, 0x48, 0x5c, 0x3f, 0x1c,
+	0xe2, 0xe2, 0x12, 0xf8, 0x14, 0x94, 0x1d, 0xa3, 0x4f, 0x1c, 0x86, 0x2a, 0xdd, 0x52, 0xaf, 0x7a,
+	0xeb, 0xe6, 0xb4, 0x93, 0x8b, 0xe1, 0xb4, 0xb5, 0x23, 0xc4, 0xb7, 0xbd, 0x30, 0x18, 0x6a, 0xad,
+	0xf1, 0x48, 0x6d, 0xc6, 0xeb, 0xa5, 0x4f, 0x24, 0x1a, 0xe1, 0x53, 0xd0, 0x08, 0x08, 0x0b, 0x8d,
+	0x20, 0xd4, 0x7d, 0xea, 0xd8, 0xe6, 0x10, 0x81, 0x62, 0xdc, 0xe1, 0x98, 0xbb, 0x27, 0x98, 0xb1,
+	0xdd, 0xc5, 0x05, 0xb2, 0xdd, 0x81, 0x2c, 0xdc, 0xfe, 0x25, 0xa8, 0x4a, 0x86, 0xc0, 0x26, 0x28,
+	0xa5, 0x71, 0x5d, 0xc1, 0x7c, 0x08, 0x5b, 0x60, 0xe1, 0xc8, 0x70, 0x22, 0x12, 0xc7, 0x28, 0x8e,
+	0x27, 0x5f, 0xce, 0x7f, 0xa1, 0x6c, 0xbe, 0xaa, 0x83, 0x15, 0x29, 0x84, 0x22, 0xef, 0xed, 0xdf,
+	0x92, 0x3f, 0x83, 0xb5, 0x99, 0xe9, 0x06, 0xcd, 0x0b, 0x27, 0x5f, 0x49, 0x55, 0x6e, 0xe7, 0x42,
+	0x4f, 0x12, 0x19, 0xad, 0xca, 0x15, 0x8f, 0x47, 0x6a, 0x89, 0x78, 0x47, 0xb8, 0x45, 0xa6, 0x25,
+	0x18, 0xfc, 0x00, 0x2c, 0x30, 0x12, 0x46, 0xbe, 0xb8, 0x50, 0xd5, 0x5b, 0x8d, 0x54, 0xdd, 0x6d,
+	0x91, 0x28, 0x71, 0xcc, 0x84, 0x1f, 0x81, 0x72, 0x9c, 0x39, 0xd1, 0xa5, 0x99, 0x62, 0x09, 0x17,
+	0xf6, 0xc0, 0xa2, 0x4b, 0x3d, 0x3b, 0xa4, 0x01, 0x5a, 0x98, 0x29, 0x98, 0xb2, 0xe1, 0x53, 0xd0,
+	0xb6, 0x88, 0x1f, 0x10, 0x9e, 0x61, 0x2d, 0x3d, 0x3e, 0xa8, 0xd0, 0x76, 0x09, 0x8d, 0x42, 0x9d,
+	0x89, 0x0b, 0x51, 0xd7, 0xae, 0x8d, 0x47, 0xea, 0x46, 0x81, 0x95, 0x1f, 0x22, 0x52, 0xf0, 0x46,
+	0xae, 0x60, 0x9f, 0x0b, 0x3d, 0x8a, 0x65, 0xf6, 0x79, 0x62, 0xf1, 0x03, 0xfb, 0xc8, 0x76, 0xc8,
+	0x80, 0x58, 0xe2, 0x2a, 0x2c, 0xc5, 0x89, 0x25, 0xa7, 0x62, 0x69, 0x0c, 0x3f, 0x01, 0xc0, 0xf4,
+	0x23, 0xfd, 0x39, 0xb1, 0x07, 0x87, 0x21, 0x5a, 0x12, 0xdf, 0x16, 0xf2, 0x39, 0x15, 0x57, 0x4c,
+	0x3f, 0xfa, 0x83, 0x18, 0x42, 0x04, 0x16, 0x7c, 0x1a, 0x84, 0x71, 0x98, 0xd7, 0xb5, 0xf9, 0xe6,
+	0x1c, 0x8e, 0x09, 0x50, 0x03, 0x35, 0x32, 0x08, 0x08, 0x63, 0x7a, 0x10, 0xf1, 0x23, 0x02, 0xe2,
+	0x88, 0x2e, 0xa7, 0x3e, 0xd8, 0x4f, 0x52, 0xfe, 0x3d, 0x9e, 0xf1, 0x71, 0xe4, 0x10, 0xed, 0x12,
+	0x3f, 0x20, 0x5c, 0x8d, 0x17, 0x71, 0x0a, 0xe3, 0xc6, 0xf0, 0x1c, 0x9d, 0xa4, 0xab, 0x6a, 0x9e,
+	0x15, 0x73, 0x2a, 0xae, 0x38, 0x74, 0xb0, 0x2f, 0x86, 0xf0, 0xe7, 0xa0, 0x16, 0x27, 0x7d, 0xa6,
+	0x0f, 0x22, 0xdb, 0x42, 0x35, 0xb1, 0x00, 0x8e, 0x47, 0x6a, 0x91, 0xae, 0xe0, 0x6a, 0x32, 0xbf,
+	0x17, 0xd9, 0xf1, 0x96, 0x03, 0x22, 0x7c, 0x6f, 0x84, 0xa8, 0xde, 0x55, 0x7a, 0xa5, 0x64, 0xcb,
+	0x19, 0x15, 0x57, 0x92, 0xf1, 0xed, 0x10, 0xde, 0x07, 0xab, 0x93, 0xa5, 0xd2, 0x26, 0x0c, 0x35,
+	0xc4, 0xfe, 0x50, 0xba, 0xbf, 0x3b, 0x42, 0xe4, 0x6e, 0x56, 0x4c, 0x31, 0x34, 0x8b, 0x14, 0x9b,
+	0x30, 0xf8, 0x39, 0x68, 0x39, 0x64, 0x60, 0x98, 0x43, 0xdd, 0xa2, 0xcf, 0x3d, 0x87, 0x1a, 0x96,
+	0x1e, 0x31, 0x12, 0xa0, 0x65, 0x61, 0xf8, 0x3c, 0x52, 0x30, 0x8c, 0xf9, 0x77, 0x13, 0xf6, 0x63,
+	0x46, 0x02, 0x78, 0x0f, 0x74, 0xc3, 0x20, 0x62, 0x22, 0x56, 0x86, 0x2c, 0x24, 0xae, 0x2e, 0x55,
+	0x68, 0xa6, 0xfb, 0x46, 0x78, 0x88, 0x9a, 0xe2, 0x76, 0x5e, 0x4b, 0xe4, 0xf6, 0x85, 0xd8, 0x1d,
+	0x49, 0x6a, 0xcf, 0x08, 0x0f, 0xe1, 0x17, 0xa0, 0x2e, 0xd7, 0x58, 0x86, 0x56, 0xba, 0x25, 0x39,
+	0x85, 0xc7, 0x99, 0x72, 0x97, 0xf3, 0x70, 0xed, 0x28, 0x9f, 0x30, 0x78, 0x03, 0x2c, 0x26, 0x25,
+	0x1c, 0x41, 0x11, 0xdb, 0xcb, 0xe9, 0x9a, 0x87, 0x31, 0x19, 0xa7, 0x7c, 0xf8, 0x5b, 0xd0, 0x2c,
+	0x46, 0xb4, 0xcb, 0xd0, 0xaa, 0xf0, 0xb1, 0xc8, 0x72, 0x93, 0x3c, 0xdc, 0x60, 0x52, 0xfc, 0xee,
+	0xf2, 0x6c, 0xb7, 0x3e, 0x1b, 0x80, 0xa0, 0x96, 0xf8, 0xf2, 0xb5, 0xcc, 0xe3, 0xb9, 0xd4, 0x5e,
+	0x26, 0x24, 0xa2, 0x4a, 0xc1, 0x6b, 0xe6, 0x2c, 0x26, 0xfc, 0x10, 0x34, 0x62, 0xe0, 0xc0, 0xbd,
+	0xee, 0x19, 0x2e, 0x41, 0x6b, 0xc2, 0x6f, 0x75, 0x41, 0x7d, 0x9c, 0x10, 0x73, 0x31, 0xdf, 0x60,
+	0xec, 0x39, 0x0d, 0x2c, 0xb4, 0x2e, 0x89, 0xed, 0x25, 0x44, 0x5e, 0x7b, 0x26, 0xe1, 0x09, 0xda,
+	0x28, 0xd6, 0x9e, 0x3b, 0x9c, 0x7f, 0x37, 0x63, 0xe3, 0x65, 0xb3, 0x48, 0xe0, 0x21, 0x2c, 0x41,
+	0x19, 0x86, 0x90, 0x38, 0x11, 0x98, 0xae, 0xbf, 0xcf, 0x79, 0x3b, 0x9c, 0x85, 0xab, 0x76, 0x36,
+	0x66, 0xf0, 0x21, 0xa8, 0x4a, 0x70, 0x07, 0x5d, 0x16, 0xab, 0x6e, 0xcc, 0x28, 0xec, 0x71, 0x56,
+	0xde, 0xda, 0x15, 0xc2, 0xbc, 0x52, 0xc5, 0x05, 0x87, 0x87, 0x1a, 0x70, 0x33, 0x22, 0xfc, 0x18,
+	0x2c, 0x25, 0x58, 0x89, 0xa1, 0x76, 0xb7, 0x24, 0x1f, 0xf0, 0x7e, 0x4c, 0xc7, 0x99, 0x00, 0xfc,
+	0x12, 0x34, 0x8a, 0x48, 0x0a, 0x5d, 0x11, 0xbb, 0x6e, 0xa5, 0x4b, 0x76, 0xe8, 0x00, 0x1b, 0x21,
+	0xd9, 0xe1, 0x3c, 0x5c, 0x73, 0xa4, 0x19, 0xfc, 0x23, 0x68, 0xc9, 0x21, 0x48, 0x2c, 0x9d, 0xc3,
+	0x2f, 0x86, 0xae, 0x8a, 0x8f, 0xd6, 0x52, 0x0d, 0x5f, 0xd9, 0x0e, 0xd1, 0xd0, 0x78, 0xa4, 0xce,
+	0x94, 0xc6, 0x50, 0x0a, 0x4e, 0x62, 0x71, 0x61, 0xd6, 0x7e, 0x0c, 0x96, 0x27, 0x76, 0x39, 0xa3,
+	0x9a, 0xdd, 0x94, 0xab, 0x59, 0xf5, 0xd6, 0x7a, 0x86, 0x3a, 0xd2, 0x95, 0x4f, 0x38, 0x57, 0xae,
+	0x72, 0x7f, 0x55, 0x40, 0x55, 0x82, 0x36, 0xf0, 0x17, 0x19, 0xfe, 0x51, 0x84, 0xc9, 0xea, 0x0c,
+	0xfc, 0xb3, 0x15, 0xff, 0x08, 0x23, 0x52, 0xec, 0xc3, 0x2b, 0xad, 0x44, 0x3e, 0xab, 0xd2, 0xd6,
+	0x64, 0x1b, 0x7e, 0x28, 0x81, 0x66, 0x7e, 0xa6, 0x8f, 0x7d, 0xcb, 0x08, 0x09, 0xec, 0xc8, 0x88,
+	0x90, 0xab, 0x59, 0xf8, 0x7a, 0x4e, 0x06, 0x81, 0x39, 0x50, 0x9b, 0x3f, 0x1d, 0xa8, 0x29, 0x33,
+	0x80, 0x5a, 0xb7, 0x00, 0x4f, 0x79, 0x79, 0xac, 0x7c, 0xad, 0x14, 0x00, 0xe9, 0xfd, 0x62, 0x04,
+	0x5e, 0x12, 0xce, 0xe8, 0x4d, 0x47, 0x60, 0x6c, 0xed, 0x64, 0x00, 0x16, 0x82, 0xef, 0xfa, 0xd4,
+	0xad, 0x5c, 0x10, 0x1f, 0x9c, 0x9f, 0xbc, 0x97, 0xd7, 0xa7, 0xee, 0x65, 0x59, 0x08, 0x96, 0x26,
+	0x6e, 0xe6, 0x3b, 0x8a, 0x05, 0xad, 0x05, 0x20, 0xf5, 0xf9, 0xee, 0x0d, 0x47, 0xcf, 0x1c, 0xad,
+	0xad, 0x81, 0xd5, 0x8c, 0x9a, 0x3b, 0x48, 0xbb, 0x0c, 0x36, 0x72, 0xe1, 0xc2, 0xf6, 0x66, 0xb0,
+	0xd2, 0x0d, 0x6d, 0xfe, 0x5d, 0x01, 0xf5, 0x02, 0x40, 0x82, 0x9f, 0x81, 0x9a, 0x1f, 0x50, 0x93,
+	0xb0, 0xb4, 0x98, 0x89, 0x5a, 0xd1, 0xe4, 0x45, 0x4e, 0xa6, 0xe3, 0x6a, 0x32, 0x13, 0x25, 0x6e,
+	0x13, 0x94, 0x2d, 0xea, 0x1a, 0x76, 0xda, 0x5a, 0x80, 0xf1, 0x48, 0x4d, 0x28, 0x38, 0xf9, 0x85,
+	0xd7, 0xc1, 0x12, 0xbf, 0xc6, 0x42, 0xa9, 0x38, 0x61, 0xad, 0x36, 0x1e, 0xa9, 0x19, 0x0d, 0x2f,
+	0x3a, 0x74, 0xc0, 0x95, 0x6d, 0xfe, 0x4b, 0x01, 0x70, 0xba, 0x57, 0x80, 0x3f, 0x03, 0x15, 0x97,
+	0xb8, 0x34, 0x18, 0xea, 0x6e, 0x1f, 0x29, 0x79, 0x4b, 0x92, 0x11, 0xf1, 0x52, 0x3c, 0xdc, 0xed,
+	0xc3, 0x0f, 0xc0, 0xa2, 0x65, 0xb3, 0x67, 0x5c, 0x72, 0x5e, 0x48, 0x56, 0xc7, 0x23, 0x35, 0x25,
+	0xe1, 0x32, 0x1f, 0xec, 0xf6, 0xe1, 0xfb, 0x60, 0x31, 0xa0, 0x34, 0xd4, 0x0f, 0x18, 0x2a, 0xe5,
+	0x66, 0x73, 0xd2, 0x81, 0x08, 0x4d, 0x1a, 0x7e, 0xc5, 0xa3, 0x65, 0xc9, 0x35, 0x8e, 0x75, 0xdf,
+	0xb6, 0x98, 0x00, 0x64, 0x0b, 0xb1, 0xd9, 0x29, 0x0d, 0x2f, 0xba, 0xc6, 0xf1, 0x9e, 0x6d, 0xb1,
+	0xcd, 0x7f, 0xb7, 0x00, 0xc8, 0xcd, 0x7e, 0x77, 0x7e, 0x3c, 0x97, 0xd5, 0x85, 0xfe, 0xed, 0xd2,
+	0x19, 0xfd, 0xdb, 0x9f, 0x5e, 0x07, 0x7b, 0x17, 0xce, 0x86, 0xbd, 0x8b, 0xe7, 0x84, 0xbc, 0xe5,
+	0xf3, 0x41, 0xde, 0xc5, 0x53, 0x21, 0xef, 0xac, 0x5a, 0x7f, 0xe5, 0x0d, 0x6a, 0x7d, 0xff, 0x54,
+	0x20, 0x1c, 0x83, 0xd1, 0x0f, 0xc7, 0x23, 0x55, 0x95, 0xa4, 0x52, 0xbe, 0xc7, 0xce, 0x07, 0x88,
+	0x25, 0x58, 0x5e, 0x39, 0x1d, 0x96, 0x4b, 0x41, 0x0a, 0x5e, 0x1f, 0xa4, 0x85, 0xb0, 0xaf, 0x9e,
+	0x1e, 0xf6, 0x45, 0x70, 0x5d, 0x3b, 0x0b, 0x5c, 0x17, 0xb1, 0x7b, 0xfd, 0x4c, 0xec, 0x9e, 0x81,
+	0xf1, 0xc6, 0x24, 0x18, 0xcf, 0x93, 0xff, 0xf2, 0x9b, 0x27, 0xff, 0x22, 0x0a, 0x6f, 0x9e, 0x85,
+	0xc2, 0xe5, 0x3c, 0xb2, 0x72, 0x4a, 0x1e, 0x99, 0x82, 0xeb, 0xf0, 0x7c, 0x70, 0xbd, 0xf8, 0x54,
+	0xb2, 0x7a, 0xe6, 0x53, 0xc9, 0xaf, 0x27, 0x1a, 0x91, 0xd6, 0x19, 0x8d, 0x48, 0xb1, 0x05, 0xd1,
+	0x66, 0x3c, 0x51, 0xac, 0x9d, 0xfa, 0x44, 0x31, 0xfd, 0x28, 0xf1, 0x9a, 0x8e, 0x61, 0xfd, 0x2d,
+	0x76, 0x0c, 0x1b, 0x17, 0xee, 0x18, 0xd0, 0x4f, 0xea, 0x18, 0x2e, 0xff, 0x84, 0x8e, 0xa1, 0x7d,
+	0x46, 0xc7, 0x30, 0xf5, 0xfe, 0x72, 0xf5, 0xcd, 0xdf, 0x5f, 0xe4, 0xaa, 0x70, 0xed, 0x94, 0xaa,
+	0x70, 0x4a, 0x7b, 0xd1, 0x79, 0x07, 0xed, 0x85, 0x7a, 0xbe, 0xf6, 0xa2, 0x7b, 0xde, 0xf6, 0xe2,
+	0xbd, 0x0b, 0xb6, 0x17, 0x9b, 0xe7, 0x6b, 0x2f, 0xee, 0x14, 0xc1, 0xdd, 0xfb, 0x62, 0xd5, 0xe6,
+	0x34, 0xb8, 0x3b, 0x15, 0xd6, 0xc9, 0x3d, 0xc5, 0x07, 0x6f, 0xde, 0x53, 0x7c, 0x78, 0xe1, 0x9e,
+	0xe2, 0xa3, 0x8b, 0xf6, 0x14, 0x50, 0x07, 0xcb, 0x91, 0xc0, 0xaf, 0x3a, 0x0b, 0xb9, 0x69, 0x83,
+	0x21, 0xba, 0xde, 0x55, 0x7a, 0x8d, 0x5b, 0xef, 0xcd, 0xf0, 0x45, 0x8c, 0x74, 0xf7, 0x13, 0x41,
+	0x6d, 0x75, 0x3c, 0x52, 0x27, 0x57, 0xe3, 0x46, 0x54, 0x10, 0x82, 0x3b, 0xd9, 0xb3, 0x61, 0x4f,
+	0x18, 0xdb, 0x99, 0xa1, 0xf7, 0x22, 0x0f, 0x85, 0x37, 0xde, 0xda, 0x43, 0xe1, 0xbb, 0x81, 0xd4,
+	0x17, 0x79, 0x7f, 0xec, 0x83, 0x46, 0xd1, 0xe5, 0xf0, 0x23, 0xb0, 0x88, 0x7f, 0xbf, 0xb3, 0x73,
+	0xff, 0xe1, 0xbd, 0xe6, 0x5c, 0xfb, 0xf2, 0x37, 0xdf, 0x76, 0xd7, 0x8a, 0x02, 0x98, 0x3a, 0xfc,
+	0xf9, 0x15, 0xf6, 0xc0, 0x12, 0xde, 0xbe, 0x83, 0xb7, 0x6f, 0x3f, 0xda, 0x6e, 0x2a, 0xed, 0xf6,
+	0x37, 0xdf, 0x76, 0xd7, 0x27, 0x04, 0x49, 0xfc, 0xfc, 0xb3, 0xf9, 0xdf, 0x79, 0x50, 0x2f, 0x38,
+	0x0d, 0x6e, 0x03, 0x68, 0xbb, 0x2e, 0xb1, 0x6c, 0x7e, 0xae, 0x89, 0x8b, 0x92, 0xfe, 0x4b, 0x5b,
+	0x1f, 0x8f, 0xd4, 0x19, 0x5c, 0xbc, 0x92, 0xd1, 0x12, 0x5d, 0xbc, 0xcc, 0x34, 0x5c, 0xdb, 0xd3,
+	0xfb, 0x86, 0xf9, 0x8c, 0x1e, 0x1c, 0x70, 0xdc, 0x34, 0x2f, 0x70, 0x93, 0x38, 0x93, 0x22, 0x47,
+	0x3a, 0x93, 0x9a, 0x6b, 0x7b, 0x5a, 0xcc, 0xd8, 0x8d, 0x75, 0x18, 0xc7, 0xb2, 0x8e, 0x92, 0xa4,
+	0xc3, 0x38, 0x7e, 0x9d, 0x0e, 0xe3, 0x38, 0xd7, 0xf1, 0x1b, 0xc0, 0xe7, 0xf9, 0x46, 0x62, 0x68,
+	0xda, 0x1e, 0x8f, 0xd4, 0x75, 0x99, 0x2e, 0xad, 0xaf, 0xba, 0xc6, 0x71, 0xb6, 0x8d, 0xdf, 0x81,
+	0xba, 0x47, 0x8e, 0x48, 0x90, 0x0a, 0x8a, 0xce, 0x6d, 0x49, 0xbb, 0xc2, 0x1f, 0x2f, 0x0b, 0x0c,
+	0xd9, 0x00, 0xc1, 0x48, 0x54, 0x68, 0x9f, 0xbf, 0x78, 0xd9, 0x51, 0xbe, 0x7f, 0xd9, 0x99, 0xfb,
+	0xf1, 0x65, 0x47, 0xf9, 0xcb, 0x49, 0x47, 0xf9, 0xc7, 0x49, 0x47, 0xf9, 0xee, 0xa4, 0xa3, 0xbc,
+	0x38, 0xe9, 0x28, 0xff, 0x3b, 0xe9, 0x28, 0x3f, 0x9c, 0x74, 0xe6, 0x7e, 0x3c, 0xe9, 0x28, 0x7f,
+	0x7b, 0xd5, 0x99, 0x7b, 0xf1, 0xaa, 0x33, 0xf7, 0xfd, 0xab, 0xce, 0x5c, 0xbf, 0x2c, 0xfe, 0xaf,
+	0xf9, 0xec, 0xff, 0x03, 0x00, 0xeb, 0x90, 0xb1, 0xe8, 0x1e, 0x1b, 0x00, 0x00,
 }
 
 func (x DesiredLRP_UpdateStrategy) String() string {
@@ -1255,6 +1374,17 @@ func (this *DesiredLRPSchedulingInfo) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPRunInfo) Equal(that interface{}) bool {
@@ -1857,13 +1987,60 @@ func (this *DesiredLRP) Equal(that interface{}) bool {
 	if this.UpdateStrategy != that1.UpdateStrategy {
 		return false
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if !this.RestartPolicy.Equal(that1.RestartPolicy) {
+		return false
+	}
+	return true
+}
+func (this *RestartPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RestartPolicy)
+	if !ok {
+		that2, ok := that.(RestartPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ImmediateRestarts != that1.ImmediateRestarts {
+		return false
+	}
+	if this.MinBackoffMs != that1.MinBackoffMs {
+		return false
+	}
+	if this.MaxBackoffMs != that1.MaxBackoffMs {
+		return false
+	}
+	if this.MaxRestarts != that1.MaxRestarts {
+		return false
+	}
+	if this.NeverRestart != that1.NeverRestart {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPSchedulingInfo) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 12)
+	s := make([]string, 0, 14)
 	s = append(s, "&models.DesiredLRPSchedulingInfo{")
 	s = append(s, "DesiredLRPKey: "+strings.Replace(this.DesiredLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "Annotation: "+fmt.Sprintf("%#v", this.Annotation)+",\n")
@@ -1875,6 +2052,22 @@ func (this *DesiredLRPSchedulingInfo) GoString() string {
 		s = append(s, "VolumePlacement: "+fmt.Sprintf("%#v", this.VolumePlacement)+",\n")
 	}
 	s = append(s, "PlacementTags: "+fmt.Sprintf("%#v", this.PlacementTags)+",\n")
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2081,7 +2274,7 @@ func (this *DesiredLRP) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 43)
+	s := make([]string, 0, 45)
 	s = append(s, "&models.DesiredLRP{")
 	s = append(s, "ProcessGuid: "+fmt.Sprintf("%#v", this.ProcessGuid)+",\n")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
@@ -2164,6 +2357,36 @@ func (this *DesiredLRP) GoString() string {
 		s = append(s, "VolumeMountedFiles: "+fmt.Sprintf("%#v", this.VolumeMountedFiles)+",\n")
 	}
 	s = append(s, "UpdateStrategy: "+fmt.Sprintf("%#v", this.UpdateStrategy)+",\n")
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	}
+	if this.RestartPolicy != nil {
+		s = append(s, "RestartPolicy: "+fmt.Sprintf("%#v", this.RestartPolicy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RestartPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.RestartPolicy{")
+	s = append(s, "ImmediateRestarts: "+fmt.Sprintf("%#v", this.ImmediateRestarts)+",\n")
+	s = append(s, "MinBackoffMs: "+fmt.Sprintf("%#v", this.MinBackoffMs)+",\n")
+	s = append(s, "MaxBackoffMs: "+fmt.Sprintf("%#v", this.MaxBackoffMs)+",\n")
+	s = append(s, "MaxRestarts: "+fmt.Sprintf("%#v", this.MaxRestarts)+",\n")
+	s = append(s, "NeverRestart: "+fmt.Sprintf("%#v", this.NeverRestart)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2195,6 +2418,37 @@ func (m *DesiredLRPSchedulingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.PlacementTags) > 0 {
 		for iNdEx := len(m.PlacementTags) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.PlacementTags[iNdEx])
@@ -2921,6 +3175,41 @@ func (m *DesiredLRP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RestartPolicy != nil {
+		{
+			size, err := m.RestartPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0xca
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintDesiredLrp(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
 	if m.UpdateStrategy != 0 {
 		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.UpdateStrategy))
 		i--
@@ -3347,6 +3636,59 @@ func (m *DesiredLRP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RestartPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NeverRestart {
+		i--
+		if m.NeverRestart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRestarts != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.MaxRestarts))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxBackoffMs != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.MaxBackoffMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinBackoffMs != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.MinBackoffMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ImmediateRestarts != 0 {
+		i = encodeVarintDesiredLrp(dAtA, i, uint64(m.ImmediateRestarts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDesiredLrp(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDesiredLrp(v)
 	base := offset
@@ -3389,6 +3731,18 @@ func (m *DesiredLRPSchedulingInfo) Size() (n int) {
 			n += 1 + l + sovDesiredLrp(uint64(l))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			n += mapEntrySize + 1 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 1 + l + sovDesiredLrp(uint64(l))
+	}
 	return n
 }
 
@@ -3850,6 +4204,42 @@ func (m *DesiredLRP) Size() (n int) {
 	if m.UpdateStrategy != 0 {
 		n += 2 + sovDesiredLrp(uint64(m.UpdateStrategy))
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovDesiredLrp(uint64(len(k))) + 1 + len(v) + sovDesiredLrp(uint64(len(v)))
+			n += mapEntrySize + 2 + sovDesiredLrp(uint64(mapEntrySize))
+		}
+	}
+	if m.RestartPolicy != nil {
+		l = m.RestartPolicy.Size()
+		n += 2 + l + sovDesiredLrp(uint64(l))
+	}
+	return n
+}
+
+func (m *RestartPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ImmediateRestarts != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.ImmediateRestarts))
+	}
+	if m.MinBackoffMs != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.MinBackoffMs))
+	}
+	if m.MaxBackoffMs != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.MaxBackoffMs))
+	}
+	if m.MaxRestarts != 0 {
+		n += 1 + sovDesiredLrp(uint64(m.MaxRestarts))
+	}
+	if m.NeverRestart {
+		n += 2
+	}
 	return n
 }
 
@@ -3863,6 +4253,16 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 	if this == nil {
 		return "nil"
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&DesiredLRPSchedulingInfo{`,
 		`DesiredLRPKey:` + strings.Replace(strings.Replace(this.DesiredLRPKey.String(), "DesiredLRPKey", "DesiredLRPKey", 1), `&`, ``, 1) + `,`,
 		`Annotation:` + fmt.Sprintf("%v", this.Annotation) + `,`,
@@ -3872,6 +4272,8 @@ func (this *DesiredLRPSchedulingInfo) String() string {
 		`ModificationTag:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ModificationTag), "ModificationTag", "ModificationTag", 1), `&`, ``, 1) + `,`,
 		`VolumePlacement:` + strings.Replace(fmt.Sprintf("%v", this.VolumePlacement), "VolumePlacement", "VolumePlacement", 1) + `,`,
 		`PlacementTags:` + fmt.Sprintf("%v", this.PlacementTags) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`RestartPolicy:` + strings.Replace(this.RestartPolicy.String(), "RestartPolicy", "RestartPolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4117,6 +4519,16 @@ func (this *DesiredLRP) String() string {
 		mapStringForMetricTags += fmt.Sprintf("%v: %v,", k, this.MetricTags[k])
 	}
 	mapStringForMetricTags += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&DesiredLRP{`,
 		`ProcessGuid:` + fmt.Sprintf("%v", this.ProcessGuid) + `,`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
@@ -4157,6 +4569,22 @@ func (this *DesiredLRP) String() string {
 		`LogRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.LogRateLimit), "LogRateLimit", "LogRateLimit", 1) + `,`,
 		`VolumeMountedFiles:` + repeatedStringForVolumeMountedFiles + `,`,
 		`UpdateStrategy:` + fmt.Sprintf("%v", this.UpdateStrategy) + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`RestartPolicy:` + strings.Replace(this.RestartPolicy.String(), "RestartPolicy", "RestartPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RestartPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartPolicy{`,
+		`ImmediateRestarts:` + fmt.Sprintf("%v", this.ImmediateRestarts) + `,`,
+		`MinBackoffMs:` + fmt.Sprintf("%v", this.MinBackoffMs) + `,`,
+		`MaxBackoffMs:` + fmt.Sprintf("%v", this.MaxBackoffMs) + `,`,
+		`MaxRestarts:` + fmt.Sprintf("%v", this.MaxRestarts) + `,`,
+		`NeverRestart:` + fmt.Sprintf("%v", this.NeverRestart) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4449,14 +4877,177 @@ func (m *DesiredLRPSchedulingInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.PlacementTags = append(m.PlacementTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthDesiredLrp
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDesiredLrp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDesiredLrp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDesiredLrp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrp
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -7682,6 +8273,315 @@ func (m *DesiredLRP) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 40:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowDesiredLrp
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDesiredLrp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowDesiredLrp
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipDesiredLrp(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthDesiredLrp
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 41:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartPolicy == nil {
+				m.RestartPolicy = &RestartPolicy{}
+			}
+			if err := m.RestartPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDesiredLrp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RestartPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDesiredLrp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RestartPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RestartPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateRestarts", wireType)
+			}
+			m.ImmediateRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImmediateRestarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBackoffMs", wireType)
+			}
+			m.MinBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffMs", wireType)
+			}
+			m.MaxBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestarts", wireType)
+			}
+			m.MaxRestarts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRestarts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeverRestart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NeverRestart = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrp(dAtA[iNdEx:])
@@ -1,7 +1,8 @@
 package models
 
 func (request *DesiredLRPsRequest) Validate() error {
-	return nil
+	validationError := validatePagination(request.PageSize, request.PageToken)
+	return validationError.Check(LabelSelector(request.LabelSelector)).ToError()
 }
 
 func (request *DesiredLRPByProcessGuidRequest) Validate() error {
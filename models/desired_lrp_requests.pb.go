@@ -69,8 +69,10 @@ func (m *DesiredLRPLifecycleResponse) GetError() *Error {
 }
 
 type DesiredLRPsResponse struct {
-	Error       *Error        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	DesiredLrps []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps,proto3" json:"desired_lrps,omitempty"`
+	Error           *Error        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	DesiredLrps     []*DesiredLRP `protobuf:"bytes,2,rep,name=desired_lrps,json=desiredLrps,proto3" json:"desired_lrps,omitempty"`
+	NextPageToken   string        `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ResourceVersion uint64        `protobuf:"varint,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *DesiredLRPsResponse) Reset()      { *m = DesiredLRPsResponse{} }
@@ -119,10 +121,27 @@ func (m *DesiredLRPsResponse) GetDesiredLrps() []*DesiredLRP {
 	return nil
 }
 
+func (m *DesiredLRPsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *DesiredLRPsResponse) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type DesiredLRPsRequest struct {
-	Domain       string   `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
-	ProcessGuids []string `protobuf:"bytes,2,rep,name=process_guids,json=processGuids,proto3" json:"process_guids,omitempty"`
-	AppGuids     []string `protobuf:"bytes,3,rep,name=app_guids,json=appGuids,proto3" json:"app_guids,omitempty"`
+	Domain        string                      `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	ProcessGuids  []string                    `protobuf:"bytes,2,rep,name=process_guids,json=processGuids,proto3" json:"process_guids,omitempty"`
+	AppGuids      []string                    `protobuf:"bytes,3,rep,name=app_guids,json=appGuids,proto3" json:"app_guids,omitempty"`
+	PageSize      int32                       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                      `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	LabelSelector []*LabelSelectorRequirement `protobuf:"bytes,6,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
 }
 
 func (m *DesiredLRPsRequest) Reset()      { *m = DesiredLRPsRequest{} }
@@ -178,6 +197,27 @@ func (m *DesiredLRPsRequest) GetAppGuids() []string {
 	return nil
 }
 
+func (m *DesiredLRPsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *DesiredLRPsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *DesiredLRPsRequest) GetLabelSelector() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
 type DesiredLRPResponse struct {
 	Error      *Error      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	DesiredLrp *DesiredLRP `protobuf:"bytes,2,opt,name=desired_lrp,json=desiredLrp,proto3" json:"desired_lrp,omitempty"`
@@ -232,6 +272,7 @@ func (m *DesiredLRPResponse) GetDesiredLrp() *DesiredLRP {
 type DesiredLRPSchedulingInfosResponse struct {
 	Error                     *Error                      `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	DesiredLrpSchedulingInfos []*DesiredLRPSchedulingInfo `protobuf:"bytes,2,rep,name=desired_lrp_scheduling_infos,json=desiredLrpSchedulingInfos,proto3" json:"desired_lrp_scheduling_infos,omitempty"`
+	ResourceVersion           uint64                      `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *DesiredLRPSchedulingInfosResponse) Reset()      { *m = DesiredLRPSchedulingInfosResponse{} }
@@ -280,6 +321,13 @@ func (m *DesiredLRPSchedulingInfosResponse) GetDesiredLrpSchedulingInfos() []*De
 	return nil
 }
 
+func (m *DesiredLRPSchedulingInfosResponse) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type DesiredLRPSchedulingInfoByProcessGuidResponse struct {
 	Error                    *Error                    `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	DesiredLrpSchedulingInfo *DesiredLRPSchedulingInfo `protobuf:"bytes,2,opt,name=desired_lrp_scheduling_info,json=desiredLrpSchedulingInfo,proto3" json:"desired_lrp_scheduling_info,omitempty"`
@@ -529,39 +577,48 @@ func init() {
 func init() { proto.RegisterFile("desired_lrp_requests.proto", fileDescriptor_7235cc1a84e38c85) }
 
 var fileDescriptor_7235cc1a84e38c85 = []byte{
-	// 507 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xf5, 0xb6, 0x22, 0x22, 0xe3, 0x54, 0x2a, 0xe6, 0x50, 0x93, 0xa0, 0x6d, 0xd8, 0x5e, 0x7a,
-	0x69, 0x8a, 0x1a, 0xf8, 0x81, 0x08, 0x54, 0x21, 0x45, 0xa8, 0x5a, 0xd4, 0xb3, 0xe5, 0xd8, 0x1b,
-	0xd7, 0x52, 0xe2, 0xdd, 0xee, 0xc6, 0x48, 0xe5, 0xd4, 0x4f, 0xe0, 0x33, 0x90, 0x38, 0xf3, 0x0f,
-	0x1c, 0x73, 0xec, 0xa9, 0x22, 0xce, 0x05, 0xf5, 0xd4, 0x4f, 0x40, 0xd9, 0xdd, 0x62, 0xa7, 0xa5,
-	0x40, 0x10, 0xa7, 0x64, 0x67, 0xde, 0xbc, 0x79, 0xf3, 0xf4, 0x64, 0x68, 0xc6, 0x4c, 0xa5, 0x92,
-	0xc5, 0xc1, 0x48, 0x8a, 0x40, 0xb2, 0xd3, 0x9c, 0xa9, 0x89, 0xea, 0x08, 0xc9, 0x27, 0xdc, 0xab,
-	0x8d, 0x79, 0xcc, 0x46, 0xaa, 0xb9, 0x97, 0xa4, 0x93, 0x93, 0x7c, 0xd0, 0x89, 0xf8, 0x78, 0x3f,
-	0xe1, 0x09, 0xdf, 0xd7, 0xed, 0x41, 0x3e, 0xd4, 0x2f, 0xfd, 0xd0, 0xff, 0xcc, 0x58, 0xf3, 0x51,
-	0x85, 0xd2, 0x96, 0x5c, 0x26, 0x25, 0x97, 0xe6, 0x41, 0x7a, 0xd0, 0x7a, 0x65, 0x10, 0x7d, 0x7a,
-	0xd4, 0x4f, 0x87, 0x2c, 0x3a, 0x8b, 0x46, 0x8c, 0x32, 0x25, 0x78, 0xa6, 0x98, 0xb7, 0x03, 0x0f,
-	0x34, 0xda, 0x47, 0x6d, 0xb4, 0xeb, 0x1e, 0x6c, 0x74, 0x8c, 0x8a, 0xce, 0xeb, 0x45, 0x91, 0x9a,
-	0x1e, 0x39, 0x85, 0xc7, 0x25, 0x87, 0x5a, 0x69, 0xd6, 0x7b, 0x09, 0x8d, 0x8a, 0x42, 0xe5, 0xaf,
-	0xb5, 0xd7, 0x77, 0xdd, 0x03, 0xef, 0x06, 0x5b, 0xf2, 0x52, 0xd7, 0xe2, 0xfa, 0x52, 0x28, 0xf2,
-	0x01, 0xbc, 0xa5, 0x95, 0xda, 0x2a, 0x8f, 0x40, 0x2d, 0xe6, 0xe3, 0x30, 0xcd, 0xf4, 0xca, 0x7a,
-	0x0f, 0xae, 0x2e, 0xb7, 0x6d, 0x85, 0xda, 0x5f, 0x6f, 0x07, 0x36, 0x84, 0xe4, 0x11, 0x53, 0x2a,
-	0x48, 0xf2, 0x34, 0x36, 0x1b, 0xeb, 0xb4, 0x61, 0x8b, 0x87, 0x8b, 0x9a, 0xd7, 0x82, 0x7a, 0x28,
-	0x84, 0x05, 0xac, 0x6b, 0xc0, 0xc3, 0x50, 0x08, 0xdd, 0x24, 0x59, 0x75, 0xf7, 0x6a, 0xd7, 0x76,
-	0xc1, 0xad, 0x5c, 0xeb, 0xaf, 0x69, 0xe8, 0xaf, 0x8e, 0x85, 0xf2, 0x58, 0xf2, 0x19, 0xc1, 0xb3,
-	0xb2, 0xf5, 0x2e, 0x3a, 0x61, 0x71, 0x3e, 0x4a, 0xb3, 0xe4, 0x4d, 0x36, 0xe4, 0x2b, 0xba, 0x1d,
-	0xc2, 0xd3, 0x6a, 0xc4, 0xd4, 0x4f, 0xae, 0x20, 0x5d, 0x90, 0x59, 0xf7, 0xdb, 0x77, 0x05, 0x2d,
-	0x6f, 0xa5, 0x4f, 0x4a, 0x79, 0xb7, 0xf4, 0x90, 0x2f, 0x08, 0xf6, 0xee, 0x9b, 0xeb, 0x9d, 0x1d,
-	0x95, 0x2e, 0xaf, 0xa6, 0x3c, 0x80, 0xd6, 0x6f, 0x94, 0x5b, 0x27, 0xff, 0x2c, 0xdc, 0xbf, 0x4f,
-	0x38, 0x39, 0x06, 0x5c, 0x4e, 0xdd, 0x12, 0x6a, 0xd2, 0xd5, 0x85, 0x46, 0x35, 0x39, 0x36, 0x63,
-	0x9b, 0x57, 0x97, 0xdb, 0x4b, 0x75, 0xea, 0x56, 0xa2, 0x44, 0x0e, 0x61, 0xd3, 0xd0, 0xea, 0xac,
-	0xdc, 0x10, 0x2d, 0xa5, 0x00, 0xfd, 0x55, 0x0a, 0xce, 0x11, 0x6c, 0x1d, 0x8b, 0x38, 0x9c, 0xb0,
-	0x6a, 0xf8, 0xfe, 0x5d, 0x99, 0xf7, 0x1c, 0x6a, 0xb9, 0xe6, 0xb3, 0xe6, 0xf9, 0x77, 0x05, 0x98,
-	0x7d, 0xd4, 0xe2, 0xc8, 0x5b, 0xd8, 0xa2, 0x6c, 0xcc, 0xdf, 0xff, 0x27, 0x05, 0xbd, 0x17, 0xd3,
-	0x19, 0x76, 0x2e, 0x66, 0xd8, 0xb9, 0x9e, 0x61, 0x74, 0x5e, 0x60, 0xf4, 0xa9, 0xc0, 0xe8, 0x6b,
-	0x81, 0xd1, 0xb4, 0xc0, 0xe8, 0x5b, 0x81, 0xd1, 0xf7, 0x02, 0x3b, 0xd7, 0x05, 0x46, 0x1f, 0xe7,
-	0xd8, 0x99, 0xce, 0xb1, 0x73, 0x31, 0xc7, 0xce, 0xa0, 0xa6, 0x3f, 0x5c, 0xdd, 0x1f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x46, 0x98, 0xcb, 0x90, 0x2d, 0x05, 0x00, 0x00,
+	// 641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x4e, 0xdb, 0x4a,
+	0x14, 0xcd, 0x10, 0x88, 0xc8, 0x4d, 0xf2, 0xe0, 0xf9, 0x2d, 0xf0, 0x83, 0xf7, 0x86, 0xd4, 0x48,
+	0x55, 0xba, 0x20, 0x54, 0xd0, 0xfe, 0x40, 0xd4, 0x0a, 0x55, 0x8a, 0x2a, 0x34, 0x94, 0x6e, 0x2d,
+	0xc7, 0xbe, 0x18, 0xab, 0x8e, 0xc7, 0x9d, 0xb1, 0x51, 0x61, 0xc5, 0x27, 0xf4, 0x33, 0xfa, 0x03,
+	0xfd, 0x83, 0x2e, 0xba, 0x64, 0x55, 0xb1, 0x42, 0xc5, 0x6c, 0x2a, 0x56, 0xfc, 0x41, 0x2b, 0x8f,
+	0x1d, 0xec, 0x50, 0xd2, 0x36, 0x6a, 0x57, 0xc9, 0x9c, 0x7b, 0xe7, 0xde, 0x73, 0xee, 0x3d, 0x63,
+	0x58, 0x76, 0x50, 0x7a, 0x02, 0x1d, 0xd3, 0x17, 0xa1, 0x29, 0xf0, 0x75, 0x8c, 0x32, 0x92, 0xdd,
+	0x50, 0xf0, 0x88, 0x6b, 0xb5, 0x21, 0x77, 0xd0, 0x97, 0xcb, 0xeb, 0xae, 0x17, 0x1d, 0xc4, 0x83,
+	0xae, 0xcd, 0x87, 0x1b, 0x2e, 0x77, 0xf9, 0x86, 0x0a, 0x0f, 0xe2, 0x7d, 0x75, 0x52, 0x07, 0xf5,
+	0x2f, 0xbb, 0xb6, 0xfc, 0x77, 0xa9, 0x64, 0x0e, 0x35, 0x50, 0x08, 0x2e, 0xf2, 0x43, 0xd3, 0xb7,
+	0x06, 0xe8, 0xe7, 0x4d, 0x8c, 0x1e, 0xac, 0x3c, 0xc9, 0xf2, 0xfb, 0x6c, 0xa7, 0xef, 0xed, 0xa3,
+	0x7d, 0x64, 0xfb, 0xc8, 0x50, 0x86, 0x3c, 0x90, 0xa8, 0xad, 0xc1, 0x9c, 0xba, 0xab, 0x93, 0x36,
+	0xe9, 0x34, 0x36, 0x5b, 0xdd, 0x8c, 0x53, 0xf7, 0x69, 0x0a, 0xb2, 0x2c, 0x66, 0x7c, 0x20, 0xf0,
+	0x4f, 0x51, 0x44, 0x4e, 0x75, 0x59, 0x7b, 0x0c, 0xcd, 0x12, 0x61, 0xa9, 0xcf, 0xb4, 0xab, 0x9d,
+	0xc6, 0xa6, 0x36, 0xca, 0x2d, 0xea, 0xb2, 0x46, 0x9e, 0xd7, 0x17, 0xa1, 0xd4, 0xee, 0xc3, 0x42,
+	0x80, 0x6f, 0x22, 0x33, 0xb4, 0x5c, 0x34, 0x23, 0xfe, 0x0a, 0x03, 0xbd, 0xda, 0x26, 0x9d, 0x3a,
+	0x6b, 0xa5, 0xf0, 0x8e, 0xe5, 0xe2, 0x8b, 0x14, 0xd4, 0x1e, 0xc0, 0xa2, 0x40, 0xc9, 0x63, 0x61,
+	0xa3, 0x79, 0x88, 0x42, 0x7a, 0x3c, 0xd0, 0x67, 0xdb, 0xa4, 0x33, 0xcb, 0x16, 0x46, 0xf8, 0xcb,
+	0x0c, 0x36, 0xbe, 0x12, 0xd0, 0xc6, 0x64, 0xa8, 0x6d, 0x68, 0x06, 0xd4, 0x1c, 0x3e, 0xb4, 0xbc,
+	0x40, 0xc9, 0xa8, 0xf7, 0xe0, 0xea, 0x7c, 0x35, 0x47, 0x58, 0xfe, 0xab, 0xad, 0x41, 0x2b, 0x14,
+	0xdc, 0x46, 0x29, 0x4d, 0x37, 0xf6, 0x9c, 0x4c, 0x45, 0x9d, 0x35, 0x73, 0x70, 0x3b, 0xc5, 0xb4,
+	0x15, 0xa8, 0x5b, 0x61, 0x98, 0x27, 0x54, 0x55, 0xc2, 0xbc, 0x15, 0x86, 0x37, 0x41, 0x25, 0x45,
+	0x7a, 0xc7, 0xa8, 0x08, 0xce, 0xb1, 0xf9, 0x14, 0xd8, 0xf5, 0x8e, 0x51, 0xfb, 0x1f, 0xa0, 0xa4,
+	0x73, 0x4e, 0xe9, 0xac, 0x87, 0x37, 0x1a, 0xb7, 0xe1, 0x2f, 0xb5, 0x53, 0x53, 0xa2, 0x8f, 0x76,
+	0xc4, 0x85, 0x5e, 0x53, 0x43, 0x6c, 0x8f, 0x86, 0xd8, 0x4f, 0xa3, 0xbb, 0x79, 0x30, 0xd5, 0xe5,
+	0x09, 0x1c, 0x62, 0x10, 0xb1, 0x96, 0x5f, 0x8e, 0x18, 0x41, 0x79, 0x00, 0xd3, 0xad, 0x71, 0x0b,
+	0x1a, 0xa5, 0x35, 0xea, 0x33, 0x6d, 0x32, 0x61, 0x8b, 0x50, 0x6c, 0xd1, 0xf8, 0x44, 0xe0, 0x5e,
+	0x11, 0xda, 0xb5, 0x0f, 0xd0, 0x89, 0x7d, 0x2f, 0x70, 0x9f, 0x05, 0xfb, 0x7c, 0x4a, 0x1b, 0x59,
+	0xf0, 0x5f, 0xf9, 0x29, 0xc9, 0x9b, 0x5a, 0xa6, 0x97, 0x16, 0xd3, 0x67, 0xc6, 0x27, 0x32, 0xa9,
+	0x2b, 0xfb, 0xb7, 0xa0, 0x77, 0x8b, 0xcf, 0x9d, 0x56, 0xaa, 0xde, 0x6d, 0xa5, 0xf7, 0x04, 0xd6,
+	0x27, 0xb5, 0xe8, 0x1d, 0xed, 0x14, 0xae, 0x98, 0x4e, 0xa4, 0x09, 0x2b, 0x3f, 0x10, 0x99, 0x0f,
+	0xfd, 0xe7, 0x1a, 0xf5, 0x49, 0x1a, 0x8d, 0x3d, 0xa0, 0xc5, 0xad, 0x5b, 0x44, 0xb3, 0xd7, 0xb0,
+	0x05, 0xcd, 0xb2, 0xd3, 0xf3, 0x37, 0xb1, 0x78, 0x75, 0xbe, 0x3a, 0x86, 0xb3, 0x46, 0xc9, 0xfa,
+	0xc6, 0x36, 0x2c, 0x66, 0x65, 0x95, 0xad, 0x46, 0x85, 0xc6, 0x0c, 0x43, 0x7e, 0xc9, 0x30, 0x27,
+	0x04, 0x96, 0xf6, 0x42, 0xc7, 0x8a, 0xb0, 0x94, 0xf0, 0x1b, 0xcc, 0xb4, 0x87, 0x50, 0x8b, 0x55,
+	0xbd, 0x7c, 0x78, 0xfa, 0xf7, 0x04, 0xb2, 0x7e, 0x2c, 0xcf, 0x33, 0x9e, 0xc3, 0x12, 0xc3, 0x21,
+	0x3f, 0xfc, 0x43, 0x0c, 0x7a, 0x8f, 0x4e, 0x2f, 0x68, 0xe5, 0xec, 0x82, 0x56, 0xae, 0x2f, 0x28,
+	0x39, 0x49, 0x28, 0x79, 0x97, 0x50, 0xf2, 0x31, 0xa1, 0xe4, 0x34, 0xa1, 0xe4, 0x73, 0x42, 0xc9,
+	0x97, 0x84, 0x56, 0xae, 0x13, 0x4a, 0xde, 0x5e, 0xd2, 0xca, 0xe9, 0x25, 0xad, 0x9c, 0x5d, 0xd2,
+	0xca, 0xa0, 0xa6, 0xbe, 0xde, 0x5b, 0xdf, 0x06, 0x00, 0xae, 0xf3, 0x40, 0x45, 0x40, 0x06, 0x00,
+	0x00,
 }
 
 func (this *DesiredLRPLifecycleResponse) Equal(that interface{}) bool {
@@ -618,6 +675,12 @@ func (this *DesiredLRPsResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
@@ -658,6 +721,20 @@ func (this *DesiredLRPsRequest) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
+	if len(this.LabelSelector) != len(that1.LabelSelector) {
+		return false
+	}
+	for i := range this.LabelSelector {
+		if !this.LabelSelector[i].Equal(that1.LabelSelector[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *DesiredLRPResponse) Equal(that interface{}) bool {
@@ -717,6 +794,9 @@ func (this *DesiredLRPSchedulingInfosResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPSchedulingInfoByProcessGuidResponse) Equal(that interface{}) bool {
@@ -861,7 +941,7 @@ func (this *DesiredLRPsResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.DesiredLRPsResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -869,6 +949,8 @@ func (this *DesiredLRPsResponse) GoString() string {
 	if this.DesiredLrps != nil {
 		s = append(s, "DesiredLrps: "+fmt.Sprintf("%#v", this.DesiredLrps)+",\n")
 	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -876,11 +958,16 @@ func (this *DesiredLRPsRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 10)
 	s = append(s, "&models.DesiredLRPsRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
 	s = append(s, "AppGuids: "+fmt.Sprintf("%#v", this.AppGuids)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	if this.LabelSelector != nil {
+		s = append(s, "LabelSelector: "+fmt.Sprintf("%#v", this.LabelSelector)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -903,7 +990,7 @@ func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.DesiredLRPSchedulingInfosResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -911,6 +998,7 @@ func (this *DesiredLRPSchedulingInfosResponse) GoString() string {
 	if this.DesiredLrpSchedulingInfos != nil {
 		s = append(s, "DesiredLrpSchedulingInfos: "+fmt.Sprintf("%#v", this.DesiredLrpSchedulingInfos)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1037,6 +1125,18 @@ func (m *DesiredLRPsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.DesiredLrps) > 0 {
 		for iNdEx := len(m.DesiredLrps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1086,6 +1186,32 @@ func (m *DesiredLRPsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LabelSelector) > 0 {
+		for iNdEx := len(m.LabelSelector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelSelector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.AppGuids) > 0 {
 		for iNdEx := len(m.AppGuids) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AppGuids[iNdEx])
@@ -1181,6 +1307,11 @@ func (m *DesiredLRPSchedulingInfosResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintDesiredLrpRequests(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.DesiredLrpSchedulingInfos) > 0 {
 		for iNdEx := len(m.DesiredLrpSchedulingInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1434,6 +1565,13 @@ func (m *DesiredLRPsResponse) Size() (n int) {
 			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovDesiredLrpRequests(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -1459,6 +1597,19 @@ func (m *DesiredLRPsRequest) Size() (n int) {
 			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovDesiredLrpRequests(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovDesiredLrpRequests(uint64(l))
+	}
+	if len(m.LabelSelector) > 0 {
+		for _, e := range m.LabelSelector {
+			l = e.Size()
+			n += 1 + l + sovDesiredLrpRequests(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1495,6 +1646,9 @@ func (m *DesiredLRPSchedulingInfosResponse) Size() (n int) {
 			n += 1 + l + sovDesiredLrpRequests(uint64(l))
 		}
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovDesiredLrpRequests(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -1599,6 +1753,8 @@ func (this *DesiredLRPsResponse) String() string {
 	s := strings.Join([]string{`&DesiredLRPsResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`DesiredLrps:` + repeatedStringForDesiredLrps + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1607,10 +1763,18 @@ func (this *DesiredLRPsRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForLabelSelector := "[]*LabelSelectorRequirement{"
+	for _, f := range this.LabelSelector {
+		repeatedStringForLabelSelector += strings.Replace(fmt.Sprintf("%v", f), "LabelSelectorRequirement", "LabelSelectorRequirement", 1) + ","
+	}
+	repeatedStringForLabelSelector += "}"
 	s := strings.Join([]string{`&DesiredLRPsRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
 		`AppGuids:` + fmt.Sprintf("%v", this.AppGuids) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
+		`LabelSelector:` + repeatedStringForLabelSelector + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1638,6 +1802,7 @@ func (this *DesiredLRPSchedulingInfosResponse) String() string {
 	s := strings.Join([]string{`&DesiredLRPSchedulingInfosResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`DesiredLrpSchedulingInfos:` + repeatedStringForDesiredLrpSchedulingInfos + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1887,6 +2052,57 @@ func (m *DesiredLRPsResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
@@ -2033,6 +2249,91 @@ func (m *DesiredLRPsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.AppGuids = append(m.AppGuids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDesiredLrpRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = append(m.LabelSelector, &LabelSelectorRequirement{})
+			if err := m.LabelSelector[len(m.LabelSelector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
@@ -2275,6 +2576,25 @@ func (m *DesiredLRPSchedulingInfosResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDesiredLrpRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDesiredLrpRequests(dAtA[iNdEx:])
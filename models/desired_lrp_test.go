@@ -1054,6 +1054,41 @@ var _ = Describe("DesiredLRP", func() {
 			assertDesiredLRPValidationFailsWithMessage(desiredLRP, "domain")
 		})
 
+		Context("restart_policy", func() {
+			It("is optional", func() {
+				desiredLRP.RestartPolicy = nil
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("is valid with immediate restarts and backoff bounds", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: 5, MinBackoffMs: 1000, MaxBackoffMs: 60000, MaxRestarts: 10}
+				Expect(desiredLRP.Validate()).To(Succeed())
+			})
+
+			It("requires non-negative immediate restarts", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{ImmediateRestarts: -1}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy.immediate_restarts")
+			})
+
+			It("requires a non-negative min backoff", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{MinBackoffMs: -1}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy.min_backoff_ms")
+			})
+
+			It("requires the max backoff to be at least the min backoff", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{MinBackoffMs: 2000, MaxBackoffMs: 1000}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy.max_backoff_ms")
+
+				desiredLRP.RestartPolicy = &models.RestartPolicy{MaxBackoffMs: 1000}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy.max_backoff_ms")
+			})
+
+			It("requires non-negative max restarts", func() {
+				desiredLRP.RestartPolicy = &models.RestartPolicy{MaxRestarts: -1}
+				assertDesiredLRPValidationFailsWithMessage(desiredLRP, "restart_policy.max_restarts")
+			})
+		})
+
 		It("requires a rootfs", func() {
 			desiredLRP.RootFs = ""
 			assertDesiredLRPValidationFailsWithMessage(desiredLRP, "rootfs")
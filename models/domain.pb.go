@@ -25,6 +25,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type DomainsRequest struct {
+}
+
+func (m *DomainsRequest) Reset()      { *m = DomainsRequest{} }
+func (*DomainsRequest) ProtoMessage() {}
+func (*DomainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73e6234e76dbdb84, []int{0}
+}
+func (m *DomainsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainsRequest.Merge(m, src)
+}
+func (m *DomainsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainsRequest proto.InternalMessageInfo
+
 type DomainsResponse struct {
 	Error   *Error   `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
 	Domains []string `protobuf:"bytes,2,rep,name=domains,proto3" json:"domains,omitempty"`
@@ -33,7 +68,7 @@ type DomainsResponse struct {
 func (m *DomainsResponse) Reset()      { *m = DomainsResponse{} }
 func (*DomainsResponse) ProtoMessage() {}
 func (*DomainsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73e6234e76dbdb84, []int{0}
+	return fileDescriptor_73e6234e76dbdb84, []int{1}
 }
 func (m *DomainsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,7 +118,7 @@ type UpsertDomainResponse struct {
 func (m *UpsertDomainResponse) Reset()      { *m = UpsertDomainResponse{} }
 func (*UpsertDomainResponse) ProtoMessage() {}
 func (*UpsertDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73e6234e76dbdb84, []int{1}
+	return fileDescriptor_73e6234e76dbdb84, []int{2}
 }
 func (m *UpsertDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -127,7 +162,7 @@ type UpsertDomainRequest struct {
 func (m *UpsertDomainRequest) Reset()      { *m = UpsertDomainRequest{} }
 func (*UpsertDomainRequest) ProtoMessage() {}
 func (*UpsertDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_73e6234e76dbdb84, []int{2}
+	return fileDescriptor_73e6234e76dbdb84, []int{3}
 }
 func (m *UpsertDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -171,6 +206,7 @@ func (m *UpsertDomainRequest) GetTtl() uint32 {
 }
 
 func init() {
+	proto.RegisterType((*DomainsRequest)(nil), "models.DomainsRequest")
 	proto.RegisterType((*DomainsResponse)(nil), "models.DomainsResponse")
 	proto.RegisterType((*UpsertDomainResponse)(nil), "models.UpsertDomainResponse")
 	proto.RegisterType((*UpsertDomainRequest)(nil), "models.UpsertDomainRequest")
@@ -179,26 +215,36 @@ func init() {
 func init() { proto.RegisterFile("domain.proto", fileDescriptor_73e6234e76dbdb84) }
 
 var fileDescriptor_73e6234e76dbdb84 = []byte{
-	// 271 bytes of a gzipped FileDescriptorProto
+	// 276 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xc9, 0xcf, 0x4d,
 	0xcc, 0xcc, 0xd3, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcb, 0xcd, 0x4f, 0x49, 0xcd, 0x29,
 	0x96, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xcf, 0x4f,
 	0xcf, 0xd7, 0x07, 0x4b, 0x27, 0x95, 0xa6, 0x81, 0x79, 0x60, 0x0e, 0x98, 0x05, 0xd1, 0x26, 0xc5,
-	0x9d, 0x5a, 0x54, 0x94, 0x5f, 0x04, 0xe1, 0x28, 0x05, 0x70, 0xf1, 0xbb, 0x80, 0xcd, 0x2c, 0x0e,
-	0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x52, 0xe6, 0x62, 0x05, 0xab, 0x90, 0x60, 0x54,
-	0x60, 0xd4, 0xe0, 0x36, 0xe2, 0xd5, 0x83, 0x58, 0xa3, 0xe7, 0x0a, 0x12, 0x0c, 0x82, 0xc8, 0x09,
-	0x49, 0x70, 0xb1, 0x43, 0xdc, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x19, 0x04, 0xe3, 0x2a,
-	0x59, 0x73, 0x89, 0x84, 0x16, 0x14, 0xa7, 0x16, 0x95, 0x40, 0xcc, 0x25, 0xc9, 0x58, 0xa5, 0x10,
-	0x2e, 0x61, 0x54, 0xcd, 0x85, 0xa5, 0xa9, 0xc5, 0x25, 0x42, 0x4a, 0x5c, 0x6c, 0x10, 0xe3, 0xc1,
-	0x9a, 0x39, 0x9d, 0xb8, 0x5e, 0xdd, 0x93, 0x87, 0x8a, 0x04, 0x41, 0x69, 0x21, 0x49, 0x2e, 0xe6,
-	0x92, 0x92, 0x1c, 0x09, 0x26, 0x05, 0x46, 0x0d, 0x5e, 0x27, 0xf6, 0x57, 0xf7, 0xe4, 0x41, 0xdc,
-	0x20, 0x10, 0xe1, 0x64, 0x72, 0xe1, 0xa1, 0x1c, 0xc3, 0x8d, 0x87, 0x72, 0x0c, 0x1f, 0x1e, 0xca,
-	0x31, 0x36, 0x3c, 0x92, 0x63, 0x5c, 0xf1, 0x48, 0x8e, 0xe1, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x7c, 0xf1, 0x48, 0x8e, 0xe1, 0xc3, 0x23, 0x39, 0xc6, 0x09,
-	0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x89, 0x0d, 0x1c, 0x42,
-	0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2a, 0xd1, 0x20, 0xc4, 0x75, 0x01, 0x00, 0x00,
+	0x9d, 0x5a, 0x54, 0x94, 0x5f, 0x04, 0xe1, 0x28, 0x09, 0x70, 0xf1, 0xb9, 0x80, 0xcd, 0x2c, 0x0e,
+	0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x0a, 0xe0, 0xe2, 0x87, 0x8b, 0x14, 0x17, 0xe4, 0xe7,
+	0x15, 0xa7, 0x0a, 0x29, 0x73, 0xb1, 0x82, 0xf5, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0xf1,
+	0xea, 0x41, 0x2c, 0xd6, 0x73, 0x05, 0x09, 0x06, 0x41, 0xe4, 0x84, 0x24, 0xb8, 0xd8, 0x21, 0xae,
+	0x2b, 0x96, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0x71, 0x95, 0xac, 0xb9, 0x44, 0x42, 0x0b,
+	0x8a, 0x53, 0x8b, 0x4a, 0x20, 0xe6, 0x92, 0x64, 0xac, 0x52, 0x08, 0x97, 0x30, 0xaa, 0x66, 0xb0,
+	0x2b, 0x85, 0x94, 0xb8, 0xd8, 0x20, 0xc6, 0x83, 0x35, 0x73, 0x3a, 0x71, 0xbd, 0xba, 0x27, 0x0f,
+	0x15, 0x09, 0x82, 0xd2, 0x42, 0x92, 0x5c, 0xcc, 0x25, 0x25, 0x39, 0x12, 0x4c, 0x0a, 0x8c, 0x1a,
+	0xbc, 0x4e, 0xec, 0xaf, 0xee, 0xc9, 0x83, 0xb8, 0x41, 0x20, 0xc2, 0xc9, 0xe4, 0xc2, 0x43, 0x39,
+	0x86, 0x1b, 0x0f, 0xe5, 0x18, 0x3e, 0x3c, 0x94, 0x63, 0x6c, 0x78, 0x24, 0xc7, 0xb8, 0xe2, 0x91,
+	0x1c, 0xc3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0xf8, 0xe2,
+	0x91, 0x1c, 0xc3, 0x87, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70,
+	0xe3, 0xb1, 0x1c, 0x43, 0x12, 0x1b, 0x38, 0xcc, 0x8c, 0x01, 0x03, 0x00, 0x6b, 0x2c, 0xa2, 0xb8,
+	0x87, 0x01, 0x00, 0x00,
+}
+
+func (this *DomainsRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.DomainsRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
 }
-
 func (this *DomainsResponse) GoString() string {
 	if this == nil {
 		return "nil"
@@ -243,6 +289,29 @@ func valueToGoStringDomain(v interface{}, typ string) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
 }
+func (m *DomainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *DomainsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -368,6 +437,15 @@ func encodeVarintDomain(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *DomainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *DomainsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -422,6 +500,15 @@ func sovDomain(x uint64) (n int) {
 func sozDomain(x uint64) (n int) {
 	return sovDomain(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *DomainsRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainsRequest{`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *DomainsResponse) String() string {
 	if this == nil {
 		return "nil"
@@ -462,6 +549,56 @@ func valueToStringDomain(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *DomainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomain
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomain(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomain
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *DomainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,98 @@
+package models
+
+import "fmt"
+
+func (q *DomainQuota) Validate() error {
+	var validationError ValidationError
+
+	if q.GetDomain() == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if q.GetMemoryMb() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"memory_mb"})
+	}
+
+	if q.GetDiskMb() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"disk_mb"})
+	}
+
+	if q.GetInstances() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"instances"})
+	}
+
+	if q.GetRunningTasks() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"running_tasks"})
+	}
+
+	if q.GetLogRateBytesPerSecond() < 0 {
+		validationError = validationError.Append(ErrInvalidField{"log_rate_bytes_per_second"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Admit returns a QuotaExceeded error if adding requested to the usage of
+// the domain exceeds any of its limits. Only the resources requested are
+// checked, so that workloads can still shrink in a domain over its quota.
+func (q *DomainQuota) Admit(usage, requested *DomainUsage) error {
+	if q == nil {
+		return nil
+	}
+
+	if q.LogRateBytesPerSecond > 0 && requested.UnlimitedLogRate {
+		return NewError(Error_QuotaExceeded, fmt.Sprintf("log rate quota of domain %s requires a log rate limit", q.Domain))
+	}
+
+	checks := []struct {
+		resource               string
+		limit, used, requested int64
+	}{
+		{"memory", q.MemoryMb, usage.MemoryMb, requested.MemoryMb},
+		{"disk", q.DiskMb, usage.DiskMb, requested.DiskMb},
+		{"instances", int64(q.Instances), int64(usage.Instances), int64(requested.Instances)},
+		{"running tasks", int64(q.RunningTasks), int64(usage.RunningTasks), int64(requested.RunningTasks)},
+		{"log rate", q.LogRateBytesPerSecond, usage.LogRateBytesPerSecond, requested.LogRateBytesPerSecond},
+	}
+
+	for _, check := range checks {
+		if check.limit > 0 && check.requested > 0 && check.used+check.requested > check.limit {
+			return NewQuotaExceededError(q.Domain, check.resource, check.limit, check.used, check.requested)
+		}
+	}
+
+	return nil
+}
+
+// AddDesiredLRP adds the resources of the given number of instances of the
+// desired LRP to the usage.
+func (u *DomainUsage) AddDesiredLRP(lrp *DesiredLRP, instances int32) {
+	u.Instances += instances
+	u.MemoryMb += int64(lrp.MemoryMb) * int64(instances)
+	u.DiskMb += int64(lrp.DiskMb) * int64(instances)
+	u.addLogRate(lrp.LogRateLimit, int64(instances))
+}
+
+// AddTask adds the resources of a task with the given definition to the
+// usage.
+func (u *DomainUsage) AddTask(def *TaskDefinition) {
+	u.RunningTasks++
+	u.MemoryMb += int64(def.MemoryMb)
+	u.DiskMb += int64(def.DiskMb)
+	u.addLogRate(def.LogRateLimit, 1)
+}
+
+func (u *DomainUsage) addLogRate(limit *LogRateLimit, count int64) {
+	if count == 0 {
+		return
+	}
+	if limit == nil || limit.BytesPerSecond < 0 {
+		u.UnlimitedLogRate = true
+		return
+	}
+	u.LogRateBytesPerSecond += limit.BytesPerSecond * count
+}
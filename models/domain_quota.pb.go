@@ -0,0 +1,1055 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: domain_quota.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DomainQuota limits the resources desired by the LRPs and tasks of a
+// domain. A limit of 0 leaves the resource unlimited.
+type DomainQuota struct {
+	Domain                string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	MemoryMb              int64  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	DiskMb                int64  `protobuf:"varint,3,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	Instances             int32  `protobuf:"varint,4,opt,name=instances,proto3" json:"instances"`
+	RunningTasks          int32  `protobuf:"varint,5,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks"`
+	LogRateBytesPerSecond int64  `protobuf:"varint,6,opt,name=log_rate_bytes_per_second,json=logRateBytesPerSecond,proto3" json:"log_rate_bytes_per_second"`
+}
+
+func (m *DomainQuota) Reset()      { *m = DomainQuota{} }
+func (*DomainQuota) ProtoMessage() {}
+func (*DomainQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed87ae78f2652eeb, []int{0}
+}
+func (m *DomainQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuota.Merge(m, src)
+}
+func (m *DomainQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuota proto.InternalMessageInfo
+
+func (m *DomainQuota) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DomainQuota) GetMemoryMb() int64 {
+	if m != nil {
+		return m.MemoryMb
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetDiskMb() int64 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetInstances() int32 {
+	if m != nil {
+		return m.Instances
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetRunningTasks() int32 {
+	if m != nil {
+		return m.RunningTasks
+	}
+	return 0
+}
+
+func (m *DomainQuota) GetLogRateBytesPerSecond() int64 {
+	if m != nil {
+		return m.LogRateBytesPerSecond
+	}
+	return 0
+}
+
+// DomainUsage is the sum of the resources desired by the LRP instances and
+// the active tasks of a domain. unlimited_log_rate is set when some of them
+// have no log rate limit, and so are not included in the log rate.
+type DomainUsage struct {
+	Domain                string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	MemoryMb              int64  `protobuf:"varint,2,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	DiskMb                int64  `protobuf:"varint,3,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	Instances             int32  `protobuf:"varint,4,opt,name=instances,proto3" json:"instances"`
+	RunningTasks          int32  `protobuf:"varint,5,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks"`
+	LogRateBytesPerSecond int64  `protobuf:"varint,6,opt,name=log_rate_bytes_per_second,json=logRateBytesPerSecond,proto3" json:"log_rate_bytes_per_second"`
+	UnlimitedLogRate      bool   `protobuf:"varint,7,opt,name=unlimited_log_rate,json=unlimitedLogRate,proto3" json:"unlimited_log_rate,omitempty"`
+}
+
+func (m *DomainUsage) Reset()      { *m = DomainUsage{} }
+func (*DomainUsage) ProtoMessage() {}
+func (*DomainUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ed87ae78f2652eeb, []int{1}
+}
+func (m *DomainUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainUsage.Merge(m, src)
+}
+func (m *DomainUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainUsage proto.InternalMessageInfo
+
+func (m *DomainUsage) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DomainUsage) GetMemoryMb() int64 {
+	if m != nil {
+		return m.MemoryMb
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetDiskMb() int64 {
+	if m != nil {
+		return m.DiskMb
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetInstances() int32 {
+	if m != nil {
+		return m.Instances
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetRunningTasks() int32 {
+	if m != nil {
+		return m.RunningTasks
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetLogRateBytesPerSecond() int64 {
+	if m != nil {
+		return m.LogRateBytesPerSecond
+	}
+	return 0
+}
+
+func (m *DomainUsage) GetUnlimitedLogRate() bool {
+	if m != nil {
+		return m.UnlimitedLogRate
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*DomainQuota)(nil), "models.DomainQuota")
+	proto.RegisterType((*DomainUsage)(nil), "models.DomainUsage")
+}
+
+func init() { proto.RegisterFile("domain_quota.proto", fileDescriptor_ed87ae78f2652eeb) }
+
+var fileDescriptor_ed87ae78f2652eeb = []byte{
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x53, 0xbd, 0xae, 0xd3, 0x30,
+	0x18, 0x8d, 0x6f, 0xb9, 0x69, 0xeb, 0x52, 0x09, 0x2c, 0x21, 0x05, 0x04, 0x4e, 0x54, 0x31, 0x44,
+	0xfc, 0xb4, 0x03, 0x88, 0x07, 0x88, 0x18, 0x29, 0x02, 0x03, 0x62, 0xb4, 0x92, 0xc6, 0x84, 0xa8,
+	0xb1, 0x5d, 0x62, 0x67, 0xe8, 0xc6, 0x23, 0xc0, 0x5b, 0xf0, 0x1a, 0x6c, 0x8c, 0x1d, 0x3b, 0x45,
+	0x34, 0x5d, 0x50, 0xa6, 0x3e, 0x02, 0x8a, 0x93, 0xb6, 0x42, 0x88, 0x81, 0xfd, 0x4e, 0x39, 0x3f,
+	0xdf, 0x39, 0x8a, 0x8e, 0x64, 0x88, 0x62, 0xc9, 0xc3, 0x54, 0xd0, 0x4f, 0x85, 0xd4, 0xe1, 0x74,
+	0x95, 0x4b, 0x2d, 0x91, 0xcd, 0x65, 0xcc, 0x32, 0x75, 0xe7, 0x71, 0x92, 0xea, 0x8f, 0x45, 0x34,
+	0x5d, 0x48, 0x3e, 0x4b, 0x64, 0x22, 0x67, 0xc6, 0x8e, 0x8a, 0x0f, 0x86, 0x19, 0x62, 0x50, 0x1b,
+	0x9b, 0x7c, 0xbf, 0x80, 0xa3, 0xe7, 0xa6, 0xed, 0x75, 0x53, 0x86, 0x26, 0xd0, 0x6e, 0xcb, 0x1d,
+	0xe0, 0x01, 0x7f, 0x18, 0xc0, 0xba, 0x74, 0x3b, 0x85, 0x74, 0x5f, 0xf4, 0x00, 0x0e, 0x39, 0xe3,
+	0x32, 0x5f, 0x53, 0x1e, 0x39, 0x17, 0x1e, 0xf0, 0x7b, 0xc1, 0xb8, 0x2e, 0xdd, 0xb3, 0x48, 0x06,
+	0x2d, 0x9c, 0x47, 0xe8, 0x3e, 0xec, 0xc7, 0xa9, 0x5a, 0x36, 0x97, 0x3d, 0x73, 0x39, 0xaa, 0x4b,
+	0xf7, 0x28, 0x11, 0xbb, 0x01, 0xf3, 0x08, 0x3d, 0x84, 0xc3, 0x54, 0x28, 0x1d, 0x8a, 0x05, 0x53,
+	0xce, 0x35, 0x0f, 0xf8, 0x97, 0x6d, 0xe3, 0x49, 0x24, 0x67, 0x88, 0x9e, 0xc1, 0x71, 0x5e, 0x08,
+	0x91, 0x8a, 0x84, 0xea, 0x50, 0x2d, 0x95, 0x73, 0x69, 0x02, 0x37, 0xeb, 0xd2, 0xfd, 0xd3, 0x20,
+	0xd7, 0x3b, 0xfa, 0xb6, 0x61, 0xe8, 0x3d, 0xbc, 0x9d, 0xc9, 0x84, 0xe6, 0xa1, 0x66, 0x34, 0x5a,
+	0x6b, 0xa6, 0xe8, 0x8a, 0xe5, 0x54, 0xb1, 0x85, 0x14, 0xb1, 0x63, 0x9b, 0x9f, 0xbb, 0x57, 0x97,
+	0xee, 0xbf, 0x8f, 0xc8, 0xad, 0x4c, 0x26, 0x24, 0xd4, 0x2c, 0x68, 0x8c, 0x57, 0x2c, 0x7f, 0x63,
+	0xe4, 0xc9, 0xd7, 0xde, 0x71, 0xc3, 0x77, 0x2a, 0x4c, 0xd8, 0xd5, 0x86, 0xff, 0xbf, 0x21, 0x7a,
+	0x09, 0x51, 0x21, 0xb2, 0x94, 0xa7, 0x9a, 0xc5, 0xf4, 0x98, 0x76, 0xfa, 0x1e, 0xf0, 0x07, 0x81,
+	0x57, 0x97, 0xee, 0xdd, 0xbf, 0xdd, 0x47, 0xb2, 0xe1, 0x7c, 0xa5, 0xd7, 0xe4, 0xc6, 0xc9, 0x7d,
+	0xd1, 0xb5, 0x3f, 0xdd, 0xec, 0xb0, 0xb5, 0xdd, 0x61, 0xeb, 0xb0, 0xc3, 0xe0, 0x73, 0x85, 0xc1,
+	0xb7, 0x0a, 0x83, 0x1f, 0x15, 0x06, 0x9b, 0x0a, 0x83, 0x9f, 0x15, 0x06, 0xbf, 0x2a, 0x6c, 0x1d,
+	0x2a, 0x0c, 0xbe, 0xec, 0xb1, 0xb5, 0xd9, 0x63, 0x6b, 0xbb, 0xc7, 0x56, 0x64, 0x9b, 0x47, 0xf1,
+	0xe4, 0xf7, 0x00, 0x9d, 0x71, 0xec, 0xfa, 0x61, 0x03, 0x00, 0x00,
+}
+
+func (this *DomainQuota) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuota)
+	if !ok {
+		that2, ok := that.(DomainQuota)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.DiskMb != that1.DiskMb {
+		return false
+	}
+	if this.Instances != that1.Instances {
+		return false
+	}
+	if this.RunningTasks != that1.RunningTasks {
+		return false
+	}
+	if this.LogRateBytesPerSecond != that1.LogRateBytesPerSecond {
+		return false
+	}
+	return true
+}
+func (this *DomainUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainUsage)
+	if !ok {
+		that2, ok := that.(DomainUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.MemoryMb != that1.MemoryMb {
+		return false
+	}
+	if this.DiskMb != that1.DiskMb {
+		return false
+	}
+	if this.Instances != that1.Instances {
+		return false
+	}
+	if this.RunningTasks != that1.RunningTasks {
+		return false
+	}
+	if this.LogRateBytesPerSecond != that1.LogRateBytesPerSecond {
+		return false
+	}
+	if this.UnlimitedLogRate != that1.UnlimitedLogRate {
+		return false
+	}
+	return true
+}
+func (this *DomainQuota) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 10)
+	s = append(s, "&models.DomainQuota{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "RunningTasks: "+fmt.Sprintf("%#v", this.RunningTasks)+",\n")
+	s = append(s, "LogRateBytesPerSecond: "+fmt.Sprintf("%#v", this.LogRateBytesPerSecond)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainUsage) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.DomainUsage{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "MemoryMb: "+fmt.Sprintf("%#v", this.MemoryMb)+",\n")
+	s = append(s, "DiskMb: "+fmt.Sprintf("%#v", this.DiskMb)+",\n")
+	s = append(s, "Instances: "+fmt.Sprintf("%#v", this.Instances)+",\n")
+	s = append(s, "RunningTasks: "+fmt.Sprintf("%#v", this.RunningTasks)+",\n")
+	s = append(s, "LogRateBytesPerSecond: "+fmt.Sprintf("%#v", this.LogRateBytesPerSecond)+",\n")
+	s = append(s, "UnlimitedLogRate: "+fmt.Sprintf("%#v", this.UnlimitedLogRate)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDomainQuota(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DomainQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LogRateBytesPerSecond != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.LogRateBytesPerSecond))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RunningTasks != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.RunningTasks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Instances != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.Instances))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DiskMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemoryMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuota(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlimitedLogRate {
+		i--
+		if m.UnlimitedLogRate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LogRateBytesPerSecond != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.LogRateBytesPerSecond))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RunningTasks != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.RunningTasks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Instances != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.Instances))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DiskMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.DiskMb))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MemoryMb != 0 {
+		i = encodeVarintDomainQuota(dAtA, i, uint64(m.MemoryMb))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuota(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomainQuota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomainQuota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DomainQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuota(uint64(l))
+	}
+	if m.MemoryMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MemoryMb))
+	}
+	if m.DiskMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.DiskMb))
+	}
+	if m.Instances != 0 {
+		n += 1 + sovDomainQuota(uint64(m.Instances))
+	}
+	if m.RunningTasks != 0 {
+		n += 1 + sovDomainQuota(uint64(m.RunningTasks))
+	}
+	if m.LogRateBytesPerSecond != 0 {
+		n += 1 + sovDomainQuota(uint64(m.LogRateBytesPerSecond))
+	}
+	return n
+}
+
+func (m *DomainUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuota(uint64(l))
+	}
+	if m.MemoryMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.MemoryMb))
+	}
+	if m.DiskMb != 0 {
+		n += 1 + sovDomainQuota(uint64(m.DiskMb))
+	}
+	if m.Instances != 0 {
+		n += 1 + sovDomainQuota(uint64(m.Instances))
+	}
+	if m.RunningTasks != 0 {
+		n += 1 + sovDomainQuota(uint64(m.RunningTasks))
+	}
+	if m.LogRateBytesPerSecond != 0 {
+		n += 1 + sovDomainQuota(uint64(m.LogRateBytesPerSecond))
+	}
+	if m.UnlimitedLogRate {
+		n += 2
+	}
+	return n
+}
+
+func sovDomainQuota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomainQuota(x uint64) (n int) {
+	return sovDomainQuota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DomainQuota) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainQuota{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
+		`Instances:` + fmt.Sprintf("%v", this.Instances) + `,`,
+		`RunningTasks:` + fmt.Sprintf("%v", this.RunningTasks) + `,`,
+		`LogRateBytesPerSecond:` + fmt.Sprintf("%v", this.LogRateBytesPerSecond) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainUsage{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`MemoryMb:` + fmt.Sprintf("%v", this.MemoryMb) + `,`,
+		`DiskMb:` + fmt.Sprintf("%v", this.DiskMb) + `,`,
+		`Instances:` + fmt.Sprintf("%v", this.Instances) + `,`,
+		`RunningTasks:` + fmt.Sprintf("%v", this.RunningTasks) + `,`,
+		`LogRateBytesPerSecond:` + fmt.Sprintf("%v", this.LogRateBytesPerSecond) + `,`,
+		`UnlimitedLogRate:` + fmt.Sprintf("%v", this.UnlimitedLogRate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDomainQuota(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DomainQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+			}
+			m.RunningTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogRateBytesPerSecond", wireType)
+			}
+			m.LogRateBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogRateBytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMb", wireType)
+			}
+			m.MemoryMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MemoryMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiskMb", wireType)
+			}
+			m.DiskMb = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiskMb |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Instances", wireType)
+			}
+			m.Instances = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Instances |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
+			}
+			m.RunningTasks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RunningTasks |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogRateBytesPerSecond", wireType)
+			}
+			m.LogRateBytesPerSecond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LogRateBytesPerSecond |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlimitedLogRate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnlimitedLogRate = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomainQuota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomainQuota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomainQuota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomainQuota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomainQuota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomainQuota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomainQuota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomainQuota = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,49 @@
+package models
+
+func (request *DomainQuotasRequest) Validate() error {
+	return nil
+}
+
+func (request *SetDomainQuotaRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.DomainQuota == nil {
+		validationError = validationError.Append(ErrInvalidField{"domain_quota"})
+	} else if err := request.DomainQuota.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *RemoveDomainQuotaRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *DomainUsageRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.Domain == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,2159 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: domain_quota_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DomainQuotaResponse struct {
+	Error       *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	DomainQuota *DomainQuota `protobuf:"bytes,2,opt,name=domain_quota,json=domainQuota,proto3" json:"domain_quota,omitempty"`
+}
+
+func (m *DomainQuotaResponse) Reset()      { *m = DomainQuotaResponse{} }
+func (*DomainQuotaResponse) ProtoMessage() {}
+func (*DomainQuotaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{0}
+}
+func (m *DomainQuotaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuotaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuotaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuotaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuotaResponse.Merge(m, src)
+}
+func (m *DomainQuotaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuotaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuotaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuotaResponse proto.InternalMessageInfo
+
+func (m *DomainQuotaResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DomainQuotaResponse) GetDomainQuota() *DomainQuota {
+	if m != nil {
+		return m.DomainQuota
+	}
+	return nil
+}
+
+type DomainQuotasResponse struct {
+	Error        *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	DomainQuotas []*DomainQuota `protobuf:"bytes,2,rep,name=domain_quotas,json=domainQuotas,proto3" json:"domain_quotas,omitempty"`
+}
+
+func (m *DomainQuotasResponse) Reset()      { *m = DomainQuotasResponse{} }
+func (*DomainQuotasResponse) ProtoMessage() {}
+func (*DomainQuotasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{1}
+}
+func (m *DomainQuotasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuotasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuotasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuotasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuotasResponse.Merge(m, src)
+}
+func (m *DomainQuotasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuotasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuotasResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuotasResponse proto.InternalMessageInfo
+
+func (m *DomainQuotasResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DomainQuotasResponse) GetDomainQuotas() []*DomainQuota {
+	if m != nil {
+		return m.DomainQuotas
+	}
+	return nil
+}
+
+type DomainQuotaLifecycleResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *DomainQuotaLifecycleResponse) Reset()      { *m = DomainQuotaLifecycleResponse{} }
+func (*DomainQuotaLifecycleResponse) ProtoMessage() {}
+func (*DomainQuotaLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{2}
+}
+func (m *DomainQuotaLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuotaLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuotaLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuotaLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuotaLifecycleResponse.Merge(m, src)
+}
+func (m *DomainQuotaLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuotaLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuotaLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuotaLifecycleResponse proto.InternalMessageInfo
+
+func (m *DomainQuotaLifecycleResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type DomainQuotasRequest struct {
+}
+
+func (m *DomainQuotasRequest) Reset()      { *m = DomainQuotasRequest{} }
+func (*DomainQuotasRequest) ProtoMessage() {}
+func (*DomainQuotasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{3}
+}
+func (m *DomainQuotasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainQuotasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainQuotasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainQuotasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainQuotasRequest.Merge(m, src)
+}
+func (m *DomainQuotasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainQuotasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainQuotasRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainQuotasRequest proto.InternalMessageInfo
+
+type SetDomainQuotaRequest struct {
+	DomainQuota *DomainQuota `protobuf:"bytes,1,opt,name=domain_quota,json=domainQuota,proto3" json:"domain_quota,omitempty"`
+}
+
+func (m *SetDomainQuotaRequest) Reset()      { *m = SetDomainQuotaRequest{} }
+func (*SetDomainQuotaRequest) ProtoMessage() {}
+func (*SetDomainQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{4}
+}
+func (m *SetDomainQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetDomainQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetDomainQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetDomainQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDomainQuotaRequest.Merge(m, src)
+}
+func (m *SetDomainQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetDomainQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDomainQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDomainQuotaRequest proto.InternalMessageInfo
+
+func (m *SetDomainQuotaRequest) GetDomainQuota() *DomainQuota {
+	if m != nil {
+		return m.DomainQuota
+	}
+	return nil
+}
+
+type RemoveDomainQuotaRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *RemoveDomainQuotaRequest) Reset()      { *m = RemoveDomainQuotaRequest{} }
+func (*RemoveDomainQuotaRequest) ProtoMessage() {}
+func (*RemoveDomainQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{5}
+}
+func (m *RemoveDomainQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveDomainQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveDomainQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveDomainQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDomainQuotaRequest.Merge(m, src)
+}
+func (m *RemoveDomainQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveDomainQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDomainQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDomainQuotaRequest proto.InternalMessageInfo
+
+func (m *RemoveDomainQuotaRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DomainUsageRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *DomainUsageRequest) Reset()      { *m = DomainUsageRequest{} }
+func (*DomainUsageRequest) ProtoMessage() {}
+func (*DomainUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{6}
+}
+func (m *DomainUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainUsageRequest.Merge(m, src)
+}
+func (m *DomainUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainUsageRequest proto.InternalMessageInfo
+
+func (m *DomainUsageRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DomainUsageResponse struct {
+	Error       *Error       `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Usage       *DomainUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	DomainQuota *DomainQuota `protobuf:"bytes,3,opt,name=domain_quota,json=domainQuota,proto3" json:"domain_quota,omitempty"`
+}
+
+func (m *DomainUsageResponse) Reset()      { *m = DomainUsageResponse{} }
+func (*DomainUsageResponse) ProtoMessage() {}
+func (*DomainUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_38672cc54f16c0fa, []int{7}
+}
+func (m *DomainUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DomainUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DomainUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DomainUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DomainUsageResponse.Merge(m, src)
+}
+func (m *DomainUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DomainUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DomainUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DomainUsageResponse proto.InternalMessageInfo
+
+func (m *DomainUsageResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *DomainUsageResponse) GetUsage() *DomainUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+func (m *DomainUsageResponse) GetDomainQuota() *DomainQuota {
+	if m != nil {
+		return m.DomainQuota
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DomainQuotaResponse)(nil), "models.DomainQuotaResponse")
+	proto.RegisterType((*DomainQuotasResponse)(nil), "models.DomainQuotasResponse")
+	proto.RegisterType((*DomainQuotaLifecycleResponse)(nil), "models.DomainQuotaLifecycleResponse")
+	proto.RegisterType((*DomainQuotasRequest)(nil), "models.DomainQuotasRequest")
+	proto.RegisterType((*SetDomainQuotaRequest)(nil), "models.SetDomainQuotaRequest")
+	proto.RegisterType((*RemoveDomainQuotaRequest)(nil), "models.RemoveDomainQuotaRequest")
+	proto.RegisterType((*DomainUsageRequest)(nil), "models.DomainUsageRequest")
+	proto.RegisterType((*DomainUsageResponse)(nil), "models.DomainUsageResponse")
+}
+
+func init() { proto.RegisterFile("domain_quota_requests.proto", fileDescriptor_38672cc54f16c0fa) }
+
+var fileDescriptor_38672cc54f16c0fa = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xbd, 0x4e, 0x32, 0x41,
+	0x14, 0xdd, 0x81, 0x40, 0xf2, 0x5d, 0xa0, 0x59, 0x3e, 0x92, 0x0d, 0xdf, 0x97, 0x2b, 0x19, 0x1b,
+	0x2d, 0x5c, 0x12, 0x35, 0x86, 0xca, 0x02, 0xb5, 0x33, 0x31, 0xae, 0xb1, 0x26, 0xfc, 0x0c, 0x2b,
+	0x09, 0xcb, 0xc0, 0xce, 0xae, 0x89, 0x9d, 0x8f, 0xe0, 0x0b, 0xd8, 0xfb, 0x28, 0x96, 0x94, 0x54,
+	0x46, 0x86, 0xc6, 0x58, 0xf1, 0x08, 0x86, 0x19, 0x8c, 0x43, 0xc0, 0x84, 0xad, 0x76, 0xef, 0x39,
+	0xf7, 0x9c, 0x93, 0x9c, 0xb9, 0xf0, 0xaf, 0xc3, 0x83, 0x66, 0x6f, 0xd0, 0x18, 0xc5, 0x3c, 0x6a,
+	0x36, 0x42, 0x36, 0x8a, 0x99, 0x88, 0x84, 0x3b, 0x0c, 0x79, 0xc4, 0xed, 0x6c, 0xc0, 0x3b, 0xac,
+	0x2f, 0xca, 0x07, 0x7e, 0x2f, 0xba, 0x8b, 0x5b, 0x6e, 0x9b, 0x07, 0x55, 0x9f, 0xfb, 0xbc, 0xaa,
+	0xe8, 0x56, 0xdc, 0x55, 0x93, 0x1a, 0xd4, 0x9f, 0x96, 0x95, 0x73, 0x2c, 0x0c, 0x79, 0xb8, 0x1c,
+	0x6c, 0x33, 0x40, 0x63, 0x34, 0x84, 0xe2, 0xb9, 0x42, 0xaf, 0x17, 0xa0, 0xc7, 0xc4, 0x90, 0x0f,
+	0x04, 0xb3, 0x77, 0x21, 0xa3, 0x94, 0x0e, 0xa9, 0x90, 0xbd, 0xdc, 0x61, 0xc1, 0xd5, 0xf1, 0xee,
+	0xc5, 0x02, 0xf4, 0x34, 0x67, 0x9f, 0x40, 0xde, 0x74, 0x74, 0x52, 0x6a, 0xb7, 0xf8, 0xbd, 0x6b,
+	0xfa, 0xe6, 0x3a, 0x3f, 0x03, 0x8d, 0xe1, 0xaf, 0xc1, 0x89, 0x64, 0xa1, 0x35, 0x28, 0x98, 0xa1,
+	0xc2, 0x49, 0x55, 0xd2, 0xbf, 0xa5, 0xe6, 0x8d, 0x54, 0x41, 0xcf, 0xe0, 0xbf, 0x41, 0x5e, 0xf6,
+	0xba, 0xac, 0xfd, 0xd0, 0xee, 0xb3, 0x44, 0xf1, 0xb4, 0xb4, 0xd2, 0x97, 0xf0, 0xf4, 0x2b, 0xd1,
+	0x2b, 0x28, 0xdd, 0xb0, 0x68, 0xa5, 0x49, 0x45, 0xac, 0x75, 0x44, 0xb6, 0xec, 0xe8, 0x14, 0x1c,
+	0x8f, 0x05, 0xfc, 0x9e, 0x6d, 0xf0, 0xa4, 0x90, 0xd5, 0xab, 0xca, 0xed, 0x4f, 0x1d, 0x3e, 0xdf,
+	0x76, 0x96, 0x88, 0xb7, 0xfc, 0xd2, 0x1a, 0xd8, 0x5a, 0x79, 0x2b, 0x9a, 0x3e, 0x4b, 0xa2, 0x7c,
+	0x26, 0x50, 0x5c, 0x91, 0x26, 0x79, 0x9d, 0x7d, 0xc8, 0xc4, 0x0b, 0xd5, 0xe6, 0x5b, 0xd0, 0x86,
+	0x7a, 0x63, 0xad, 0x99, 0xf4, 0x76, 0xcd, 0xd4, 0x8f, 0xc7, 0x53, 0xb4, 0x26, 0x53, 0xb4, 0xe6,
+	0x53, 0x24, 0x8f, 0x12, 0xc9, 0x8b, 0x44, 0xf2, 0x2a, 0x91, 0x8c, 0x25, 0x92, 0x77, 0x89, 0xe4,
+	0x43, 0xa2, 0x35, 0x97, 0x48, 0x9e, 0x66, 0x68, 0x8d, 0x67, 0x68, 0x4d, 0x66, 0x68, 0xb5, 0xb2,
+	0xea, 0xdc, 0x8f, 0xbe, 0x06, 0x00, 0x73, 0xb7, 0x4c, 0x4c, 0x65, 0x03, 0x00, 0x00,
+}
+
+func (this *DomainQuotaResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuotaResponse)
+	if !ok {
+		that2, ok := that.(DomainQuotaResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.DomainQuota.Equal(that1.DomainQuota) {
+		return false
+	}
+	return true
+}
+func (this *DomainQuotasResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuotasResponse)
+	if !ok {
+		that2, ok := that.(DomainQuotasResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.DomainQuotas) != len(that1.DomainQuotas) {
+		return false
+	}
+	for i := range this.DomainQuotas {
+		if !this.DomainQuotas[i].Equal(that1.DomainQuotas[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *DomainQuotaLifecycleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuotaLifecycleResponse)
+	if !ok {
+		that2, ok := that.(DomainQuotaLifecycleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *DomainQuotasRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainQuotasRequest)
+	if !ok {
+		that2, ok := that.(DomainQuotasRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *SetDomainQuotaRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetDomainQuotaRequest)
+	if !ok {
+		that2, ok := that.(SetDomainQuotaRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.DomainQuota.Equal(that1.DomainQuota) {
+		return false
+	}
+	return true
+}
+func (this *RemoveDomainQuotaRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveDomainQuotaRequest)
+	if !ok {
+		that2, ok := that.(RemoveDomainQuotaRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *DomainUsageRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainUsageRequest)
+	if !ok {
+		that2, ok := that.(DomainUsageRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *DomainUsageResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DomainUsageResponse)
+	if !ok {
+		that2, ok := that.(DomainUsageResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Usage.Equal(that1.Usage) {
+		return false
+	}
+	if !this.DomainQuota.Equal(that1.DomainQuota) {
+		return false
+	}
+	return true
+}
+func (this *DomainQuotaResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DomainQuotaResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.DomainQuota != nil {
+		s = append(s, "DomainQuota: "+fmt.Sprintf("%#v", this.DomainQuota)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainQuotasResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DomainQuotasResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.DomainQuotas != nil {
+		s = append(s, "DomainQuotas: "+fmt.Sprintf("%#v", this.DomainQuotas)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainQuotaLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DomainQuotaLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainQuotasRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.DomainQuotasRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SetDomainQuotaRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.SetDomainQuotaRequest{")
+	if this.DomainQuota != nil {
+		s = append(s, "DomainQuota: "+fmt.Sprintf("%#v", this.DomainQuota)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *RemoveDomainQuotaRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.RemoveDomainQuotaRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainUsageRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DomainUsageRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DomainUsageResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.DomainUsageResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Usage != nil {
+		s = append(s, "Usage: "+fmt.Sprintf("%#v", this.Usage)+",\n")
+	}
+	if this.DomainQuota != nil {
+		s = append(s, "DomainQuota: "+fmt.Sprintf("%#v", this.DomainQuota)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringDomainQuotaRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *DomainQuotaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuotaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuotaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainQuota != nil {
+		{
+			size, err := m.DomainQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainQuotasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuotasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuotasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DomainQuotas) > 0 {
+		for iNdEx := len(m.DomainQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DomainQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainQuotaLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuotaLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuotaLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainQuotasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainQuotasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainQuotasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *SetDomainQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetDomainQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetDomainQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainQuota != nil {
+		{
+			size, err := m.DomainQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveDomainQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveDomainQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveDomainQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DomainUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DomainUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DomainUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DomainQuota != nil {
+		{
+			size, err := m.DomainQuota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDomainQuotaRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDomainQuotaRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDomainQuotaRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DomainQuotaResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	if m.DomainQuota != nil {
+		l = m.DomainQuota.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainQuotasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	if len(m.DomainQuotas) > 0 {
+		for _, e := range m.DomainQuotas {
+			l = e.Size()
+			n += 1 + l + sovDomainQuotaRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DomainQuotaLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainQuotasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *SetDomainQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DomainQuota != nil {
+		l = m.DomainQuota.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveDomainQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DomainUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	if m.DomainQuota != nil {
+		l = m.DomainQuota.Size()
+		n += 1 + l + sovDomainQuotaRequests(uint64(l))
+	}
+	return n
+}
+
+func sovDomainQuotaRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDomainQuotaRequests(x uint64) (n int) {
+	return sovDomainQuotaRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *DomainQuotaResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainQuotaResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`DomainQuota:` + strings.Replace(fmt.Sprintf("%v", this.DomainQuota), "DomainQuota", "DomainQuota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainQuotasResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForDomainQuotas := "[]*DomainQuota{"
+	for _, f := range this.DomainQuotas {
+		repeatedStringForDomainQuotas += strings.Replace(fmt.Sprintf("%v", f), "DomainQuota", "DomainQuota", 1) + ","
+	}
+	repeatedStringForDomainQuotas += "}"
+	s := strings.Join([]string{`&DomainQuotasResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`DomainQuotas:` + repeatedStringForDomainQuotas + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainQuotaLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainQuotaLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainQuotasRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainQuotasRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SetDomainQuotaRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SetDomainQuotaRequest{`,
+		`DomainQuota:` + strings.Replace(fmt.Sprintf("%v", this.DomainQuota), "DomainQuota", "DomainQuota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RemoveDomainQuotaRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RemoveDomainQuotaRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainUsageRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainUsageRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DomainUsageResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DomainUsageResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Usage:` + strings.Replace(fmt.Sprintf("%v", this.Usage), "DomainUsage", "DomainUsage", 1) + `,`,
+		`DomainQuota:` + strings.Replace(fmt.Sprintf("%v", this.DomainQuota), "DomainQuota", "DomainQuota", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringDomainQuotaRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *DomainQuotaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuotaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuotaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DomainQuota == nil {
+				m.DomainQuota = &DomainQuota{}
+			}
+			if err := m.DomainQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainQuotasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuotasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuotasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DomainQuotas = append(m.DomainQuotas, &DomainQuota{})
+			if err := m.DomainQuotas[len(m.DomainQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainQuotaLifecycleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuotaLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuotaLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainQuotasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainQuotasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainQuotasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetDomainQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetDomainQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetDomainQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DomainQuota == nil {
+				m.DomainQuota = &DomainQuota{}
+			}
+			if err := m.DomainQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveDomainQuotaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveDomainQuotaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveDomainQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DomainUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DomainUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DomainUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Usage == nil {
+				m.Usage = &DomainUsage{}
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainQuota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DomainQuota == nil {
+				m.DomainQuota = &DomainQuota{}
+			}
+			if err := m.DomainQuota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDomainQuotaRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDomainQuotaRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDomainQuotaRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDomainQuotaRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDomainQuotaRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDomainQuotaRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDomainQuotaRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDomainQuotaRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDomainQuotaRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDomainQuotaRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDomainQuotaRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,142 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DomainQuota", func() {
+	var quota *models.DomainQuota
+
+	BeforeEach(func() {
+		quota = &models.DomainQuota{
+			Domain:                "some-domain",
+			MemoryMb:              1024,
+			DiskMb:                4096,
+			Instances:             4,
+			RunningTasks:          2,
+			LogRateBytesPerSecond: 1000,
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a quota", func() {
+			Expect(quota.Validate()).To(Succeed())
+		})
+
+		It("accepts a quota without limits", func() {
+			Expect((&models.DomainQuota{Domain: "some-domain"}).Validate()).To(Succeed())
+		})
+
+		It("requires a domain", func() {
+			quota.Domain = ""
+			Expect(quota.Validate()).To(ConsistOf(models.ErrInvalidField{"domain"}))
+		})
+
+		It("rejects negative limits", func() {
+			quota.MemoryMb = -1
+			quota.RunningTasks = -1
+			Expect(quota.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"memory_mb"},
+				models.ErrInvalidField{"running_tasks"},
+			))
+		})
+	})
+
+	Describe("DomainUsage", func() {
+		It("sums the resources of every instance of a desired LRP", func() {
+			usage := &models.DomainUsage{}
+			usage.AddDesiredLRP(&models.DesiredLRP{
+				MemoryMb:     256,
+				DiskMb:       1024,
+				LogRateLimit: &models.LogRateLimit{BytesPerSecond: 100},
+			}, 3)
+
+			Expect(usage.Instances).To(BeEquivalentTo(3))
+			Expect(usage.MemoryMb).To(BeEquivalentTo(768))
+			Expect(usage.DiskMb).To(BeEquivalentTo(3072))
+			Expect(usage.LogRateBytesPerSecond).To(BeEquivalentTo(300))
+			Expect(usage.UnlimitedLogRate).To(BeFalse())
+		})
+
+		It("counts tasks as running tasks", func() {
+			usage := &models.DomainUsage{}
+			usage.AddTask(&models.TaskDefinition{MemoryMb: 128, DiskMb: 256})
+
+			Expect(usage.RunningTasks).To(BeEquivalentTo(1))
+			Expect(usage.Instances).To(BeZero())
+			Expect(usage.MemoryMb).To(BeEquivalentTo(128))
+			Expect(usage.DiskMb).To(BeEquivalentTo(256))
+		})
+
+		It("flags workloads without a log rate limit", func() {
+			usage := &models.DomainUsage{}
+			usage.AddTask(&models.TaskDefinition{LogRateLimit: &models.LogRateLimit{BytesPerSecond: 10}})
+			usage.AddTask(&models.TaskDefinition{LogRateLimit: &models.LogRateLimit{BytesPerSecond: -1}})
+
+			Expect(usage.LogRateBytesPerSecond).To(BeEquivalentTo(10))
+			Expect(usage.UnlimitedLogRate).To(BeTrue())
+		})
+	})
+
+	Describe("Admit", func() {
+		var usage *models.DomainUsage
+
+		BeforeEach(func() {
+			usage = &models.DomainUsage{
+				Domain:                "some-domain",
+				MemoryMb:              512,
+				Instances:             2,
+				RunningTasks:          1,
+				LogRateBytesPerSecond: 500,
+			}
+		})
+
+		It("admits requests within the quota", func() {
+			requested := &models.DomainUsage{MemoryMb: 512, Instances: 2, LogRateBytesPerSecond: 500}
+			Expect(quota.Admit(usage, requested)).To(Succeed())
+		})
+
+		It("rejects requests over any of the limits", func() {
+			requested := &models.DomainUsage{Instances: 1, MemoryMb: 513}
+
+			err := quota.Admit(usage, requested)
+			Expect(err).To(HaveOccurred())
+			modelErr := models.ConvertError(err)
+			Expect(modelErr.Type).To(Equal(models.Error_QuotaExceeded))
+			Expect(modelErr.Message).To(Equal("memory quota of domain some-domain exceeded: 513 requested, 512 of 1024 in use"))
+		})
+
+		It("rejects tasks over the running tasks limit", func() {
+			requested := &models.DomainUsage{RunningTasks: 2}
+			Expect(models.ConvertError(quota.Admit(usage, requested)).Type).To(Equal(models.Error_QuotaExceeded))
+		})
+
+		It("ignores the limits of resources that are not requested", func() {
+			usage.MemoryMb = 2048
+			requested := &models.DomainUsage{Instances: 1}
+			Expect(quota.Admit(usage, requested)).To(Succeed())
+		})
+
+		It("ignores limits of 0", func() {
+			quota.Instances = 0
+			requested := &models.DomainUsage{Instances: 100}
+			Expect(quota.Admit(usage, requested)).To(Succeed())
+		})
+
+		It("requires a log rate limit when the log rate is limited", func() {
+			requested := &models.DomainUsage{Instances: 1, UnlimitedLogRate: true}
+			Expect(models.ConvertError(quota.Admit(usage, requested)).Type).To(Equal(models.Error_QuotaExceeded))
+
+			quota.LogRateBytesPerSecond = 0
+			Expect(quota.Admit(usage, requested)).To(Succeed())
+		})
+
+		It("admits everything without a quota", func() {
+			var noQuota *models.DomainQuota
+			Expect(noQuota.Admit(usage, &models.DomainUsage{Instances: 100})).To(Succeed())
+		})
+	})
+})
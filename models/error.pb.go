@@ -54,6 +54,13 @@ const (
 	Error_Unrecoverable              Error_Type = 29
 	Error_LockCollision              Error_Type = 30
 	Error_Timeout                    Error_Type = 31
+	Error_QuotaExceeded              Error_Type = 32
+	Error_AdmissionDenied            Error_Type = 33
+	Error_Forbidden                  Error_Type = 34
+	Error_Throttled                  Error_Type = 35
+	Error_Overloaded                 Error_Type = 36
+	Error_IdempotencyKeyMismatch     Error_Type = 37
+	Error_IdempotencyKeyInUse        Error_Type = 38
 )
 
 var Error_Type_name = map[int32]string{
@@ -82,6 +89,13 @@ var Error_Type_name = map[int32]string{
 	29: "Unrecoverable",
 	30: "LockCollision",
 	31: "Timeout",
+	32: "QuotaExceeded",
+	33: "AdmissionDenied",
+	34: "Forbidden",
+	35: "Throttled",
+	36: "Overloaded",
+	37: "IdempotencyKeyMismatch",
+	38: "IdempotencyKeyInUse",
 }
 
 var Error_Type_value = map[string]int32{
@@ -110,6 +124,13 @@ var Error_Type_value = map[string]int32{
 	"Unrecoverable":              29,
 	"LockCollision":              30,
 	"Timeout":                    31,
+	"QuotaExceeded":              32,
+	"AdmissionDenied":            33,
+	"Forbidden":                  34,
+	"Throttled":                  35,
+	"Overloaded":                 36,
+	"IdempotencyKeyMismatch":     37,
+	"IdempotencyKeyInUse":        38,
 }
 
 func (Error_Type) EnumDescriptor() ([]byte, []int) {
@@ -119,6 +140,9 @@ func (Error_Type) EnumDescriptor() ([]byte, []int) {
 type Error struct {
 	Type    Error_Type `protobuf:"varint,1,opt,name=type,proto3,enum=models.Error_Type" json:"type"`
 	Message string     `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// How long the client should wait before retrying a throttled request, one
+	// shed by an overloaded BBS, or one whose idempotency key is in use.
+	RetryAfterMs int64 `protobuf:"varint,3,opt,name=retry_after_ms,json=retryAfterMs,proto3" json:"retry_after_ms,omitempty"`
 }
 
 func (m *Error) Reset()      { *m = Error{} }
@@ -167,6 +191,13 @@ func (m *Error) GetMessage() string {
 	return ""
 }
 
+func (m *Error) GetRetryAfterMs() int64 {
+	if m != nil {
+		return m.RetryAfterMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("models.Error_Type", Error_Type_name, Error_Type_value)
 	proto.RegisterType((*Error)(nil), "models.Error")
@@ -175,44 +206,52 @@ func init() {
 func init() { proto.RegisterFile("error.proto", fileDescriptor_0579b252106fcf4a) }
 
 var fileDescriptor_0579b252106fcf4a = []byte{
-	// 585 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xcd, 0x4e, 0xdb, 0x4c,
-	0x14, 0xb5, 0xf9, 0x0c, 0x98, 0x09, 0x3f, 0x97, 0x21, 0x1f, 0x84, 0x40, 0x07, 0x64, 0xa9, 0x12,
-	0x9b, 0x86, 0xaa, 0xed, 0x0b, 0x34, 0x09, 0x20, 0x2a, 0x0a, 0xc8, 0x24, 0x0f, 0x30, 0xb1, 0x6f,
-	0xc2, 0x88, 0xc9, 0x4c, 0x3a, 0x1e, 0xa7, 0xa5, 0xab, 0x3e, 0x42, 0x1f, 0xa3, 0x8f, 0xd2, 0x45,
-	0x17, 0x2c, 0x59, 0xa1, 0x62, 0x36, 0x2d, 0x2b, 0x1e, 0xa1, 0xb2, 0x13, 0x10, 0x12, 0x6c, 0xac,
-	0x7b, 0xcf, 0xb9, 0xe7, 0xf8, 0x9e, 0x6b, 0x99, 0x94, 0xd0, 0x18, 0x6d, 0x6a, 0x03, 0xa3, 0xad,
-	0xa6, 0x53, 0x7d, 0x1d, 0xa3, 0x4c, 0xaa, 0xaf, 0x7a, 0xc2, 0x9e, 0xa6, 0x9d, 0x5a, 0xa4, 0xfb,
-	0xdb, 0x3d, 0xdd, 0xd3, 0xdb, 0x05, 0xdd, 0x49, 0xbb, 0x45, 0x57, 0x34, 0x45, 0x35, 0x92, 0x05,
-	0xbf, 0x26, 0xc9, 0xe4, 0x4e, 0x6e, 0x43, 0x5f, 0x13, 0xcf, 0x9e, 0x0f, 0xb0, 0xe2, 0x6e, 0xba,
-	0x5b, 0xf3, 0x6f, 0x68, 0x6d, 0xe4, 0x57, 0x2b, 0xc8, 0x5a, 0xeb, 0x7c, 0x80, 0x75, 0xff, 0xf6,
-	0x6a, 0xa3, 0x98, 0x09, 0x8b, 0x27, 0x7d, 0x49, 0xa6, 0xfb, 0x98, 0x24, 0xbc, 0x87, 0x95, 0x89,
-	0x4d, 0x77, 0x6b, 0xa6, 0x5e, 0xba, 0xbd, 0xda, 0xb8, 0x87, 0xc2, 0xfb, 0x22, 0xf8, 0xeb, 0x11,
-	0x2f, 0xd7, 0x53, 0x20, 0xb3, 0x6d, 0x75, 0xa6, 0xf4, 0x67, 0x55, 0x98, 0x82, 0x43, 0x17, 0xc9,
-	0xdc, 0xbe, 0x1a, 0x72, 0x29, 0xe2, 0x10, 0x23, 0x6d, 0x62, 0xf8, 0x8f, 0x52, 0x32, 0xff, 0x00,
-	0x7d, 0x4a, 0x31, 0xb1, 0xe0, 0xd1, 0x25, 0xb2, 0xf0, 0x80, 0x25, 0x03, 0xad, 0x12, 0x84, 0x49,
-	0x5a, 0x25, 0xcb, 0x63, 0xf0, 0x78, 0x9c, 0xf0, 0xe3, 0xe8, 0x85, 0x30, 0x45, 0x17, 0x48, 0x69,
-	0xcc, 0x7d, 0x38, 0x39, 0x3a, 0x84, 0x69, 0x5a, 0x21, 0xe5, 0x5d, 0x2e, 0x24, 0xc6, 0x2d, 0x7d,
-	0x34, 0x40, 0xb5, 0xa3, 0x86, 0x28, 0xf5, 0x00, 0xc1, 0x7f, 0x64, 0x73, 0x62, 0xb9, 0xc5, 0x96,
-	0xe1, 0x2a, 0x11, 0x56, 0x68, 0x05, 0x33, 0xb4, 0x4c, 0x20, 0xc4, 0x44, 0xa7, 0x26, 0xc2, 0x86,
-	0x56, 0x5d, 0x29, 0x22, 0x0b, 0xa5, 0x7c, 0xc3, 0x7b, 0x74, 0xe7, 0x8b, 0x48, 0x6c, 0x02, 0xb3,
-	0x8f, 0x27, 0x0f, 0xb5, 0xdd, 0xd5, 0xa9, 0x8a, 0x61, 0x2e, 0x5f, 0x23, 0xd4, 0xa9, 0x45, 0x33,
-	0xca, 0x3b, 0x4f, 0xd7, 0x49, 0xe5, 0x7d, 0x64, 0x53, 0x2e, 0x0f, 0xc2, 0xe3, 0x06, 0x57, 0x4a,
-	0xdb, 0x3a, 0x36, 0x24, 0x17, 0x7d, 0x8c, 0x61, 0xe1, 0x59, 0xf6, 0xc4, 0x72, 0x63, 0x31, 0x06,
-	0x78, 0x5e, 0x6b, 0x78, 0x72, 0x8a, 0x31, 0x2c, 0xd2, 0x35, 0xb2, 0xf2, 0x84, 0x1d, 0x25, 0x06,
-	0xfa, 0xac, 0x34, 0xc4, 0xbe, 0x1e, 0x62, 0x0c, 0x4b, 0x94, 0x91, 0xea, 0x13, 0xb6, 0xad, 0xa2,
-	0xf1, 0x5a, 0xff, 0xe7, 0x17, 0x0a, 0x53, 0xa5, 0x84, 0xea, 0x1d, 0xa9, 0xa6, 0xe8, 0x76, 0xd1,
-	0xa0, 0xb2, 0x0d, 0x94, 0x12, 0x2a, 0xf9, 0x2d, 0xf6, 0xda, 0xfb, 0xcd, 0x3d, 0x54, 0x68, 0x78,
-	0x71, 0xb5, 0x6a, 0x9e, 0xba, 0x89, 0x09, 0x1a, 0xc1, 0xa5, 0xf8, 0x8a, 0xb0, 0x46, 0x67, 0x89,
-	0xdf, 0x44, 0x1e, 0x4b, 0x1d, 0x9d, 0xc1, 0x7a, 0xfe, 0xcd, 0xdb, 0xca, 0x60, 0xa4, 0x87, 0x68,
-	0x78, 0x47, 0x22, 0xbc, 0xc8, 0xa1, 0x03, 0x1d, 0x9d, 0x35, 0xb4, 0x94, 0x22, 0xc9, 0x4d, 0x18,
-	0x2d, 0x91, 0xe9, 0x96, 0xe8, 0xa3, 0x4e, 0x2d, 0x6c, 0x04, 0x9e, 0xef, 0x82, 0x1b, 0x78, 0xfe,
-	0x04, 0x4c, 0x04, 0x9e, 0x4f, 0x80, 0x04, 0x9e, 0x5f, 0x86, 0x72, 0xe0, 0xf9, 0xcb, 0xb0, 0x1c,
-	0x78, 0xfe, 0x0a, 0xac, 0x04, 0x9e, 0xbf, 0x0a, 0xab, 0xf5, 0x77, 0x17, 0xd7, 0xcc, 0xbd, 0xbc,
-	0x66, 0xce, 0xdd, 0x35, 0x73, 0xbf, 0x65, 0xcc, 0xfd, 0x91, 0x31, 0xe7, 0x67, 0xc6, 0xdc, 0x8b,
-	0x8c, 0xb9, 0xbf, 0x33, 0xe6, 0xfe, 0xc9, 0x98, 0x73, 0x97, 0x31, 0xf7, 0xfb, 0x0d, 0x73, 0x2e,
-	0x6e, 0x98, 0x73, 0x79, 0xc3, 0x9c, 0xce, 0x54, 0xf1, 0x2f, 0xbc, 0xfd, 0x17, 0x00, 0x00, 0xff,
-	0xff, 0xf2, 0xb4, 0xa0, 0xf2, 0x51, 0x03, 0x00, 0x00,
+	// 719 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x52, 0x1b, 0x47,
+	0x10, 0x86, 0xb5, 0xb0, 0xc0, 0x32, 0x02, 0xd1, 0x1e, 0x08, 0xc8, 0x98, 0x2c, 0x64, 0x13, 0xa7,
+	0x38, 0x24, 0x72, 0x2a, 0xc9, 0x0b, 0x20, 0x09, 0x5c, 0x24, 0xc6, 0x72, 0x16, 0xe9, 0xec, 0x1a,
+	0xed, 0xb4, 0xa4, 0x29, 0x76, 0x67, 0x36, 0x33, 0xb3, 0x8a, 0x95, 0x53, 0x1e, 0x21, 0xf7, 0xbc,
+	0x40, 0x1e, 0x25, 0x47, 0x8e, 0x3e, 0x51, 0x41, 0x5c, 0x52, 0x9c, 0x7c, 0xc8, 0x03, 0xb8, 0x66,
+	0x25, 0x5c, 0x76, 0xc1, 0x65, 0x6b, 0xfa, 0xff, 0xba, 0xbb, 0xfe, 0xee, 0xd9, 0x21, 0x55, 0xd4,
+	0x5a, 0xe9, 0x46, 0xae, 0x95, 0x55, 0x74, 0x39, 0x53, 0x1c, 0x53, 0xb3, 0xfb, 0xed, 0x50, 0xd8,
+	0x51, 0xd1, 0x6f, 0x24, 0x2a, 0x7b, 0x36, 0x54, 0x43, 0xf5, 0xac, 0xc4, 0xfd, 0x62, 0x50, 0x46,
+	0x65, 0x50, 0x9e, 0x66, 0x65, 0xd1, 0x5f, 0x2b, 0x64, 0xe9, 0xd8, 0xb5, 0xa1, 0xdf, 0x11, 0xdf,
+	0x4e, 0x72, 0xac, 0x7b, 0x07, 0xde, 0x61, 0xed, 0x7b, 0xda, 0x98, 0xf5, 0x6b, 0x94, 0xb0, 0xd1,
+	0x9d, 0xe4, 0xd8, 0x0c, 0x6e, 0xaf, 0xf6, 0xcb, 0x9c, 0xb8, 0xfc, 0xd2, 0xa7, 0x64, 0x25, 0x43,
+	0x63, 0xd8, 0x10, 0xeb, 0x0b, 0x07, 0xde, 0xe1, 0x6a, 0xb3, 0x7a, 0x7b, 0xb5, 0x7f, 0x27, 0xc5,
+	0x77, 0x07, 0xda, 0x24, 0x35, 0x8d, 0x56, 0x4f, 0x5e, 0xb3, 0x81, 0x45, 0xfd, 0x3a, 0x33, 0xf5,
+	0xc5, 0x03, 0xef, 0x70, 0xb1, 0xb9, 0x77, 0x7b, 0xb5, 0x5f, 0xff, 0x94, 0x7c, 0xa3, 0x32, 0x61,
+	0x31, 0xcb, 0xed, 0x24, 0x5e, 0x2b, 0xc9, 0x91, 0x03, 0x67, 0x26, 0xfa, 0x7f, 0x89, 0xf8, 0xce,
+	0x03, 0x05, 0xb2, 0xd6, 0x93, 0x17, 0x52, 0xfd, 0x26, 0x4b, 0x63, 0x50, 0xa1, 0x8f, 0xc8, 0xfa,
+	0xa9, 0x1c, 0xb3, 0x54, 0xf0, 0x18, 0x13, 0xa5, 0x39, 0x2c, 0x52, 0x4a, 0x6a, 0x1f, 0xa4, 0x5f,
+	0x0b, 0x34, 0x16, 0x7c, 0xba, 0x49, 0x36, 0x3e, 0x68, 0x26, 0x57, 0xd2, 0x20, 0x2c, 0xd1, 0x5d,
+	0xb2, 0x3d, 0x17, 0x5f, 0xcd, 0xb7, 0x74, 0x36, 0x33, 0x0d, 0xcb, 0x74, 0x83, 0x54, 0xe7, 0xec,
+	0xa7, 0xf3, 0xce, 0x4b, 0x58, 0xa1, 0x75, 0xb2, 0x75, 0xc2, 0x44, 0x8a, 0xbc, 0xab, 0x3a, 0x39,
+	0xca, 0x63, 0x39, 0xc6, 0x54, 0xe5, 0x08, 0xc1, 0x47, 0x6d, 0xce, 0x2d, 0xb3, 0xd8, 0xd5, 0x4c,
+	0x1a, 0x61, 0x85, 0x92, 0xb0, 0x4a, 0xb7, 0x08, 0xc4, 0x68, 0x54, 0xa1, 0x13, 0x6c, 0x29, 0x39,
+	0x48, 0x45, 0x62, 0xa1, 0xea, 0x1c, 0xde, 0xa9, 0xc7, 0x6f, 0x84, 0xb1, 0x06, 0xd6, 0x3e, 0xce,
+	0x7c, 0xa9, 0xec, 0x89, 0x2a, 0x24, 0x87, 0x75, 0x67, 0x23, 0x56, 0x85, 0x45, 0x3d, 0x9b, 0xb7,
+	0x46, 0xf7, 0x48, 0xfd, 0x28, 0xb1, 0x05, 0x4b, 0x5f, 0xc4, 0xaf, 0x5a, 0x4c, 0x4a, 0x65, 0x9b,
+	0xd8, 0x4a, 0x99, 0xc8, 0x90, 0xc3, 0xc6, 0x83, 0xf4, 0xdc, 0x32, 0x6d, 0x91, 0x03, 0x3c, 0x5c,
+	0xab, 0x99, 0x19, 0x21, 0x87, 0x47, 0xf4, 0x09, 0xd9, 0xb9, 0x47, 0x67, 0x13, 0x03, 0x7d, 0xb0,
+	0x34, 0xc6, 0x4c, 0x8d, 0x91, 0xc3, 0x26, 0x0d, 0xc9, 0xee, 0x3d, 0xda, 0x93, 0xc9, 0xdc, 0xd6,
+	0x67, 0x6e, 0x43, 0x71, 0x21, 0xa5, 0x90, 0xc3, 0x8e, 0x6c, 0x8b, 0xc1, 0x00, 0x35, 0x4a, 0xdb,
+	0xc2, 0x34, 0x85, 0xba, 0xdb, 0xc5, 0xf3, 0xde, 0x69, 0xfb, 0x39, 0x4a, 0xd4, 0xac, 0xdc, 0xda,
+	0xae, 0x9b, 0xba, 0x8d, 0x06, 0xb5, 0x60, 0xa9, 0xf8, 0x1d, 0xe1, 0x09, 0x5d, 0x23, 0x41, 0x1b,
+	0x19, 0x4f, 0x55, 0x72, 0x01, 0x7b, 0xee, 0xce, 0x7b, 0x52, 0x63, 0xa2, 0xc6, 0xa8, 0x59, 0x3f,
+	0x45, 0xf8, 0xdc, 0x49, 0x2f, 0x54, 0x72, 0xd1, 0x52, 0x69, 0x2a, 0x8c, 0x6b, 0x12, 0xd2, 0x2a,
+	0x59, 0xe9, 0x8a, 0x0c, 0x55, 0x61, 0x61, 0xdf, 0xf1, 0x5f, 0x0a, 0x65, 0xd9, 0xf1, 0x9b, 0x04,
+	0x91, 0x23, 0x87, 0x03, 0xf7, 0x4b, 0x1c, 0xf1, 0x4c, 0x18, 0x97, 0xde, 0x46, 0x29, 0x90, 0xc3,
+	0x17, 0x74, 0x9d, 0xac, 0x9e, 0x28, 0xdd, 0x17, 0x9c, 0xa3, 0x84, 0xc8, 0x85, 0xdd, 0x91, 0x56,
+	0xd6, 0xba, 0x2d, 0x7c, 0x49, 0x6b, 0x84, 0x74, 0xc6, 0xa8, 0x53, 0xc5, 0x5c, 0x8b, 0xaf, 0xca,
+	0x9b, 0xe7, 0x98, 0xe5, 0xca, 0xa2, 0x4c, 0x26, 0x3f, 0xe3, 0xe4, 0x4c, 0x98, 0x8c, 0xd9, 0x64,
+	0x04, 0x4f, 0xe9, 0x0e, 0xd9, 0xfc, 0x94, 0x9d, 0xca, 0x9e, 0x41, 0xf8, 0x3a, 0xf2, 0x03, 0x0f,
+	0xbc, 0xc8, 0x0f, 0x16, 0x60, 0x21, 0xf2, 0x03, 0x02, 0x24, 0xf2, 0x83, 0x2d, 0xd8, 0x8a, 0xfc,
+	0x60, 0x1b, 0xb6, 0x23, 0x3f, 0xd8, 0x81, 0x9d, 0xc8, 0x0f, 0x1e, 0xc3, 0xe3, 0xe6, 0x8f, 0x97,
+	0xd7, 0xa1, 0xf7, 0xf6, 0x3a, 0xac, 0xbc, 0xbb, 0x0e, 0xbd, 0x3f, 0xa6, 0xa1, 0xf7, 0xf7, 0x34,
+	0xac, 0xfc, 0x33, 0x0d, 0xbd, 0xcb, 0x69, 0xe8, 0xfd, 0x3b, 0x0d, 0xbd, 0xff, 0xa6, 0x61, 0xe5,
+	0xdd, 0x34, 0xf4, 0xfe, 0xbc, 0x09, 0x2b, 0x97, 0x37, 0x61, 0xe5, 0xed, 0x4d, 0x58, 0xe9, 0x2f,
+	0x97, 0x4f, 0xfb, 0x87, 0xf7, 0x03, 0x00, 0x77, 0x67, 0x64, 0x27, 0x20, 0x04, 0x00, 0x00,
 }
 
 func (x Error_Type) String() string {
@@ -226,10 +265,11 @@ func (this *Error) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.Error{")
 	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
 	s = append(s, "Message: "+fmt.Sprintf("%#v", this.Message)+",\n")
+	s = append(s, "RetryAfterMs: "+fmt.Sprintf("%#v", this.RetryAfterMs)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -261,6 +301,11 @@ func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryAfterMs != 0 {
+		i = encodeVarintError(dAtA, i, uint64(m.RetryAfterMs))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Message) > 0 {
 		i -= len(m.Message)
 		copy(dAtA[i:], m.Message)
@@ -300,6 +345,9 @@ func (m *Error) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovError(uint64(l))
 	}
+	if m.RetryAfterMs != 0 {
+		n += 1 + sovError(uint64(m.RetryAfterMs))
+	}
 	return n
 }
 
@@ -316,6 +364,7 @@ func (this *Error) String() string {
 	s := strings.Join([]string{`&Error{`,
 		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`RetryAfterMs:` + fmt.Sprintf("%v", this.RetryAfterMs) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -408,6 +457,25 @@ func (m *Error) Unmarshal(dAtA []byte) error {
 			}
 			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAfterMs", wireType)
+			}
+			m.RetryAfterMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowError
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAfterMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipError(dAtA[iNdEx:])
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 )
 
 func NewError(errType Error_Type, msg string) *Error {
@@ -13,6 +14,37 @@ func NewError(errType Error_Type, msg string) *Error {
 	}
 }
 
+// NewThrottledError returns the error of a request throttled by the rate
+// limits of the BBS, hinting how long the client should wait before retrying.
+func NewThrottledError(retryAfter time.Duration) *Error {
+	return &Error{
+		Type:         Error_Throttled,
+		Message:      fmt.Sprintf("the client is throttled, retry after %s", retryAfter),
+		RetryAfterMs: retryAfter.Milliseconds(),
+	}
+}
+
+// NewOverloadedError returns the error of a request shed by an overloaded
+// BBS, hinting how long the client should wait before retrying.
+func NewOverloadedError(retryAfter time.Duration) *Error {
+	return &Error{
+		Type:         Error_Overloaded,
+		Message:      fmt.Sprintf("the BBS is overloaded, retry after %s", retryAfter),
+		RetryAfterMs: retryAfter.Milliseconds(),
+	}
+}
+
+// NewIdempotencyKeyInUseError returns the error of a request whose
+// idempotency key is used by a call that is still being served, hinting how
+// long the client should wait before retrying.
+func NewIdempotencyKeyInUseError(retryAfter time.Duration) *Error {
+	return &Error{
+		Type:         Error_IdempotencyKeyInUse,
+		Message:      fmt.Sprintf("the idempotency key is in use, retry after %s", retryAfter),
+		RetryAfterMs: retryAfter.Milliseconds(),
+	}
+}
+
 func ConvertError(err error) *Error {
 	if err == nil {
 		return nil
@@ -42,6 +74,33 @@ func (err *Error) Equal(other error) bool {
 	return false
 }
 
+// Is reports whether target is a models.Error of the same type, so that
+// errors.Is(err, ErrResourceNotFound) holds for every not found error,
+// however it was wrapped, rather than only for that very value.
+func (err *Error) Is(target error) bool {
+	e, ok := target.(*Error)
+	if !ok || e == nil {
+		return false
+	}
+	return e.GetType() == err.GetType()
+}
+
+// RetryAfter returns how long the client should wait before retrying a
+// throttled or shed request.
+func (err *Error) RetryAfter() time.Duration {
+	return time.Duration(err.GetRetryAfterMs()) * time.Millisecond
+}
+
+// Retryable reports whether the request was turned away without being served,
+// so that it may succeed when retried after RetryAfter.
+func (err *Error) Retryable() bool {
+	switch err.GetType() {
+	case Error_Throttled, Error_Overloaded, Error_IdempotencyKeyInUse:
+		return true
+	}
+	return false
+}
+
 func (err *Error) Error() string {
 	return err.GetMessage()
 }
@@ -139,6 +198,21 @@ var (
 		Message: "cannot generate random guid",
 	}
 
+	ErrForbidden = &Error{
+		Type:    Error_Forbidden,
+		Message: "the client is not authorized to call this route",
+	}
+
+	ErrIdempotencyKeyMismatch = &Error{
+		Type:    Error_IdempotencyKeyMismatch,
+		Message: "the idempotency key was used with a different request",
+	}
+
+	ErrInvalidPageToken = &Error{
+		Type:    Error_InvalidRequest,
+		Message: "the page token is invalid",
+	}
+
 	ErrLockCollision = &Error{
 		Type:    Error_LockCollision,
 		Message: "lock already exists",
@@ -171,6 +245,13 @@ func NewTaskTransitionError(from, to Task_State) *Error {
 	}
 }
 
+func NewDeploymentTransitionError(state Deployment_State, action string) *Error {
+	return &Error{
+		Type:    Error_InvalidStateTransition,
+		Message: fmt.Sprintf("Cannot %s a deployment that is %s", action, state.String()),
+	}
+}
+
 func NewRunningOnDifferentCellError(expectedCellId, actualCellId string) *Error {
 	return &Error{
 		Type:    Error_RunningOnDifferentCell,
@@ -178,6 +259,20 @@ func NewRunningOnDifferentCellError(expectedCellId, actualCellId string) *Error
 	}
 }
 
+func NewQuotaExceededError(domain, resource string, limit, used, requested int64) *Error {
+	return &Error{
+		Type:    Error_QuotaExceeded,
+		Message: fmt.Sprintf("%s quota of domain %s exceeded: %d requested, %d of %d in use", resource, domain, requested, used, limit),
+	}
+}
+
+func NewAdmissionDeniedError(webhook, reason string) *Error {
+	return &Error{
+		Type:    Error_AdmissionDenied,
+		Message: fmt.Sprintf("denied by admission webhook %s: %s", webhook, reason),
+	}
+}
+
 func NewUnrecoverableError(err error) *Error {
 	return &Error{
 		Type:    Error_Unrecoverable,
@@ -3,6 +3,8 @@ package models_test
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"time"
 
 	. "code.cloudfoundry.org/bbs/models"
 
@@ -34,6 +36,42 @@ var _ = ginkgo.Describe("Errors", func() {
 		})
 	})
 
+	ginkgo.Describe("NewThrottledError", func() {
+		ginkgo.It("hints how long to wait before retrying", func() {
+			err := NewThrottledError(1500 * time.Millisecond)
+			Expect(err.Type).To(Equal(Error_Throttled))
+			Expect(err.RetryAfter()).To(Equal(1500 * time.Millisecond))
+			Expect(err.Error()).To(ContainSubstring("retry after 1.5s"))
+			Expect(err.Retryable()).To(BeTrue())
+		})
+	})
+
+	ginkgo.Describe("NewOverloadedError", func() {
+		ginkgo.It("hints how long to wait before retrying", func() {
+			err := NewOverloadedError(5 * time.Second)
+			Expect(err.Type).To(Equal(Error_Overloaded))
+			Expect(err.RetryAfter()).To(Equal(5 * time.Second))
+			Expect(err.Error()).To(ContainSubstring("retry after 5s"))
+			Expect(err.Retryable()).To(BeTrue())
+		})
+	})
+
+	ginkgo.Describe("NewIdempotencyKeyInUseError", func() {
+		ginkgo.It("hints how long to wait before retrying", func() {
+			err := NewIdempotencyKeyInUseError(time.Second)
+			Expect(err.Type).To(Equal(Error_IdempotencyKeyInUse))
+			Expect(err.RetryAfter()).To(Equal(time.Second))
+			Expect(err.Error()).To(ContainSubstring("retry after 1s"))
+			Expect(err.Retryable()).To(BeTrue())
+		})
+	})
+
+	ginkgo.Describe("Retryable", func() {
+		ginkgo.It("is false for requests that were served", func() {
+			Expect(NewError(Error_ResourceConflict, "conflict").Retryable()).To(BeFalse())
+		})
+	})
+
 	ginkgo.Describe("Equal", func() {
 		ginkgo.It("is true when the types are the same", func() {
 			err1 := &Error{Type: 0, Message: "some-message"}
@@ -60,6 +98,22 @@ var _ = ginkgo.Describe("Errors", func() {
 		})
 	})
 
+	ginkgo.Describe("Is", func() {
+		ginkgo.It("matches errors of the same type, however they are wrapped", func() {
+			err := fmt.Errorf("fetching: %w", NewError(Error_ResourceNotFound, "no such lrp"))
+			Expect(errors.Is(err, ErrResourceNotFound)).To(BeTrue())
+		})
+
+		ginkgo.It("does not match errors of another type", func() {
+			err := fmt.Errorf("fetching: %w", NewError(Error_ResourceConflict, "conflict"))
+			Expect(errors.Is(err, ErrResourceNotFound)).To(BeFalse())
+		})
+
+		ginkgo.It("does not match other errors", func() {
+			Expect(errors.Is(ErrResourceNotFound, errors.New("the requested resource could not be found"))).To(BeFalse())
+		})
+	})
+
 	ginkgo.Describe("Type", func() {
 		ginkgo.Describe("serialization", func() {
 			ginkgo.DescribeTable("marshals and unmarshals between the value and the expected JSON output",
@@ -94,6 +148,10 @@ var _ = ginkgo.Describe("Errors", func() {
 				ginkgo.Entry("Unrecoverable", Error_Unrecoverable, `"Unrecoverable"`),
 				ginkgo.Entry("LockCollision", Error_LockCollision, `"LockCollision"`),
 				ginkgo.Entry("Timeout", Error_Timeout, `"Timeout"`),
+				ginkgo.Entry("Throttled", Error_Throttled, `"Throttled"`),
+				ginkgo.Entry("Overloaded", Error_Overloaded, `"Overloaded"`),
+				ginkgo.Entry("IdempotencyKeyMismatch", Error_IdempotencyKeyMismatch, `"IdempotencyKeyMismatch"`),
+				ginkgo.Entry("IdempotencyKeyInUse", Error_IdempotencyKeyInUse, `"IdempotencyKeyInUse"`),
 			)
 		})
 	})
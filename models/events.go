@@ -11,6 +11,14 @@ type Event interface {
 	proto.Message
 }
 
+// VersionedEvent is an event of a write of a DesiredLRP, ActualLRP or Task.
+// It carries the resource version of the write, or zero when it is unknown.
+type VersionedEvent interface {
+	Event
+	GetResourceVersion() uint64
+	SetResourceVersion(resourceVersion uint64)
+}
+
 const (
 	EventTypeInvalid = ""
 
@@ -33,6 +41,14 @@ const (
 	EventTypeTaskCreated = "task_created"
 	EventTypeTaskChanged = "task_changed"
 	EventTypeTaskRemoved = "task_removed"
+
+	EventTypeDeploymentChanged = "deployment_changed"
+
+	EventTypeCrashStormBreakerChanged = "crash_storm_breaker_changed"
+
+	EventTypeCellDrainChanged = "cell_drain_changed"
+
+	EventTypeResyncRequired = "resync_required"
 )
 
 // Downgrade the DesiredLRPEvent payload (i.e. DesiredLRP(s)) to the given
@@ -40,15 +56,21 @@ const (
 func VersionDesiredLRPsTo(event Event, target format.Version) Event {
 	switch event := event.(type) {
 	case *DesiredLRPCreatedEvent:
-		return NewDesiredLRPCreatedEvent(event.DesiredLrp.VersionDownTo(target), event.TraceId)
+		versioned := NewDesiredLRPCreatedEvent(event.DesiredLrp.VersionDownTo(target), event.TraceId)
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	case *DesiredLRPRemovedEvent:
-		return NewDesiredLRPRemovedEvent(event.DesiredLrp.VersionDownTo(target), event.TraceId)
+		versioned := NewDesiredLRPRemovedEvent(event.DesiredLrp.VersionDownTo(target), event.TraceId)
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	case *DesiredLRPChangedEvent:
-		return NewDesiredLRPChangedEvent(
+		versioned := NewDesiredLRPChangedEvent(
 			event.Before.VersionDownTo(target),
 			event.After.VersionDownTo(target),
 			event.TraceId,
 		)
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	default:
 		return event
 	}
@@ -58,11 +80,17 @@ func VersionDesiredLRPsTo(event Event, target format.Version) Event {
 func VersionTaskDefinitionsTo(event Event, target format.Version) Event {
 	switch event := event.(type) {
 	case *TaskCreatedEvent:
-		return NewTaskCreatedEvent(event.Task.VersionDownTo(target))
+		versioned := NewTaskCreatedEvent(event.Task.VersionDownTo(target))
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	case *TaskRemovedEvent:
-		return NewTaskRemovedEvent(event.Task.VersionDownTo(target))
+		versioned := NewTaskRemovedEvent(event.Task.VersionDownTo(target))
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	case *TaskChangedEvent:
-		return NewTaskChangedEvent(event.Before.VersionDownTo(target), event.After.VersionDownTo(target))
+		versioned := NewTaskChangedEvent(event.Before.VersionDownTo(target), event.After.VersionDownTo(target))
+		versioned.ResourceVersion = event.ResourceVersion
+		return versioned
 	default:
 		return event
 	}
@@ -271,7 +299,47 @@ func (event *ActualLRPInstanceCreatedEvent) Key() string {
 }
 
 func (request *EventsByCellId) Validate() error {
-	return nil
+	return LabelSelector(request.LabelSelector).Validate()
+}
+
+// EventFilter selects the events a subscriber receives. Empty fields match
+// every event; an event must match all of the non-empty ones.
+//
+// A non-zero SinceResourceVersion starts the subscription with the events
+// emitted after that resource version, as returned by a list call, rather
+// than with the events emitted after it is made.
+type EventFilter struct {
+	CellID               string
+	Domain               string
+	ProcessGuids         []string
+	TaskGuids            []string
+	EventTypes           []string
+	LabelSelector        []*LabelSelectorRequirement
+	SinceResourceVersion uint64
+}
+
+func NewEventsByCellId(filter EventFilter) *EventsByCellId {
+	return &EventsByCellId{
+		CellId:          filter.CellID,
+		Domain:          filter.Domain,
+		ProcessGuids:    filter.ProcessGuids,
+		TaskGuids:       filter.TaskGuids,
+		EventTypes:      filter.EventTypes,
+		LabelSelector:   filter.LabelSelector,
+		ResourceVersion: filter.SinceResourceVersion,
+	}
+}
+
+func (request *EventsByCellId) EventFilter() EventFilter {
+	return EventFilter{
+		CellID:               request.GetCellId(),
+		Domain:               request.GetDomain(),
+		ProcessGuids:         request.GetProcessGuids(),
+		TaskGuids:            request.GetTaskGuids(),
+		EventTypes:           request.GetEventTypes(),
+		LabelSelector:        request.GetLabelSelector(),
+		SinceResourceVersion: request.GetResourceVersion(),
+	}
 }
 
 func NewTaskCreatedEvent(task *Task) *TaskCreatedEvent {
@@ -317,6 +385,65 @@ func (event TaskRemovedEvent) Key() string {
 	return event.Task.GetTaskGuid()
 }
 
+func NewDeploymentChangedEvent(deployment *Deployment, traceId string) *DeploymentChangedEvent {
+	return &DeploymentChangedEvent{
+		Deployment: deployment,
+		TraceId:    traceId,
+	}
+}
+
+func (event *DeploymentChangedEvent) EventType() string {
+	return EventTypeDeploymentChanged
+}
+
+func (event *DeploymentChangedEvent) Key() string {
+	return event.Deployment.GetProcessGuid()
+}
+
+func NewCrashStormBreakerChangedEvent(breaker *CrashStormBreaker, traceId string) *CrashStormBreakerChangedEvent {
+	return &CrashStormBreakerChangedEvent{
+		Breaker: breaker,
+		TraceId: traceId,
+	}
+}
+
+func (event *CrashStormBreakerChangedEvent) EventType() string {
+	return EventTypeCrashStormBreakerChanged
+}
+
+func (event *CrashStormBreakerChangedEvent) Key() string {
+	return event.Breaker.GetKey()
+}
+
+func NewCellDrainChangedEvent(drain *CellDrain, traceId string) *CellDrainChangedEvent {
+	return &CellDrainChangedEvent{
+		Drain:   drain,
+		TraceId: traceId,
+	}
+}
+
+func (event *CellDrainChangedEvent) EventType() string {
+	return EventTypeCellDrainChanged
+}
+
+func (event *CellDrainChangedEvent) Key() string {
+	return event.Drain.GetCellId()
+}
+
+func NewResyncRequiredEvent(reason string) *ResyncRequiredEvent {
+	return &ResyncRequiredEvent{
+		Reason: reason,
+	}
+}
+
+func (event *ResyncRequiredEvent) EventType() string {
+	return EventTypeResyncRequired
+}
+
+func (event *ResyncRequiredEvent) Key() string {
+	return ""
+}
+
 func (info *ActualLRPInfo) SetRoutable(routable bool) {
 	info.OptionalRoutable = &ActualLRPInfo_Routable{
 		Routable: routable,
@@ -327,3 +454,55 @@ func (info *ActualLRPInfo) RoutableExists() bool {
 	_, ok := info.GetOptionalRoutable().(*ActualLRPInfo_Routable)
 	return ok
 }
+
+func (event *DesiredLRPCreatedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *DesiredLRPChangedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *DesiredLRPRemovedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPCreatedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPChangedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPRemovedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPCrashedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPInstanceCreatedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPInstanceChangedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *ActualLRPInstanceRemovedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *TaskCreatedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *TaskChangedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
+
+func (event *TaskRemovedEvent) SetResourceVersion(resourceVersion uint64) {
+	event.ResourceVersion = resourceVersion
+}
@@ -4,6 +4,7 @@
 package models
 
 import (
+	bytes "bytes"
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -27,7 +28,8 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Deprecated: Do not use.
 type ActualLRPCreatedEvent struct {
-	ActualLrpGroup *ActualLRPGroup `protobuf:"bytes,1,opt,name=actual_lrp_group,json=actualLrpGroup,proto3" json:"actual_lrp_group,omitempty"`
+	ActualLrpGroup  *ActualLRPGroup `protobuf:"bytes,1,opt,name=actual_lrp_group,json=actualLrpGroup,proto3" json:"actual_lrp_group,omitempty"`
+	ResourceVersion uint64          `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPCreatedEvent) Reset()      { *m = ActualLRPCreatedEvent{} }
@@ -69,10 +71,18 @@ func (m *ActualLRPCreatedEvent) GetActualLrpGroup() *ActualLRPGroup {
 	return nil
 }
 
+func (m *ActualLRPCreatedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 // Deprecated: Do not use.
 type ActualLRPChangedEvent struct {
-	Before *ActualLRPGroup `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
-	After  *ActualLRPGroup `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Before          *ActualLRPGroup `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After           *ActualLRPGroup `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	ResourceVersion uint64          `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPChangedEvent) Reset()      { *m = ActualLRPChangedEvent{} }
@@ -121,9 +131,17 @@ func (m *ActualLRPChangedEvent) GetAfter() *ActualLRPGroup {
 	return nil
 }
 
+func (m *ActualLRPChangedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 // Deprecated: Do not use.
 type ActualLRPRemovedEvent struct {
-	ActualLrpGroup *ActualLRPGroup `protobuf:"bytes,1,opt,name=actual_lrp_group,json=actualLrpGroup,proto3" json:"actual_lrp_group,omitempty"`
+	ActualLrpGroup  *ActualLRPGroup `protobuf:"bytes,1,opt,name=actual_lrp_group,json=actualLrpGroup,proto3" json:"actual_lrp_group,omitempty"`
+	ResourceVersion uint64          `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPRemovedEvent) Reset()      { *m = ActualLRPRemovedEvent{} }
@@ -165,9 +183,17 @@ func (m *ActualLRPRemovedEvent) GetActualLrpGroup() *ActualLRPGroup {
 	return nil
 }
 
+func (m *ActualLRPRemovedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type ActualLRPInstanceCreatedEvent struct {
-	ActualLrp *ActualLRP `protobuf:"bytes,1,opt,name=actual_lrp,json=actualLrp,proto3" json:"actual_lrp,omitempty"`
-	TraceId   string     `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ActualLrp       *ActualLRP `protobuf:"bytes,1,opt,name=actual_lrp,json=actualLrp,proto3" json:"actual_lrp,omitempty"`
+	TraceId         string     `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion uint64     `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPInstanceCreatedEvent) Reset()      { *m = ActualLRPInstanceCreatedEvent{} }
@@ -216,6 +242,13 @@ func (m *ActualLRPInstanceCreatedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *ActualLRPInstanceCreatedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type ActualLRPInfo struct {
 	ActualLRPNetInfo `protobuf:"bytes,3,opt,name=actual_lrp_net_info,json=actualLrpNetInfo,proto3,embedded=actual_lrp_net_info" json:""`
 	CrashCount       int32              `protobuf:"varint,4,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
@@ -359,6 +392,7 @@ type ActualLRPInstanceChangedEvent struct {
 	Before               *ActualLRPInfo `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
 	After                *ActualLRPInfo `protobuf:"bytes,4,opt,name=after,proto3" json:"after,omitempty"`
 	TraceId              string         `protobuf:"bytes,5,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion      uint64         `protobuf:"varint,6,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPInstanceChangedEvent) Reset()      { *m = ActualLRPInstanceChangedEvent{} }
@@ -414,9 +448,17 @@ func (m *ActualLRPInstanceChangedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *ActualLRPInstanceChangedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type ActualLRPInstanceRemovedEvent struct {
-	ActualLrp *ActualLRP `protobuf:"bytes,1,opt,name=actual_lrp,json=actualLrp,proto3" json:"actual_lrp,omitempty"`
-	TraceId   string     `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ActualLrp       *ActualLRP `protobuf:"bytes,1,opt,name=actual_lrp,json=actualLrp,proto3" json:"actual_lrp,omitempty"`
+	TraceId         string     `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion uint64     `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPInstanceRemovedEvent) Reset()      { *m = ActualLRPInstanceRemovedEvent{} }
@@ -465,9 +507,17 @@ func (m *ActualLRPInstanceRemovedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *ActualLRPInstanceRemovedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type DesiredLRPCreatedEvent struct {
-	DesiredLrp *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp,proto3" json:"desired_lrp,omitempty"`
-	TraceId    string      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	DesiredLrp      *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp,proto3" json:"desired_lrp,omitempty"`
+	TraceId         string      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion uint64      `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *DesiredLRPCreatedEvent) Reset()      { *m = DesiredLRPCreatedEvent{} }
@@ -516,10 +566,18 @@ func (m *DesiredLRPCreatedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *DesiredLRPCreatedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type DesiredLRPChangedEvent struct {
-	Before  *DesiredLRP `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
-	After   *DesiredLRP `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
-	TraceId string      `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	Before          *DesiredLRP `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After           *DesiredLRP `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	TraceId         string      `protobuf:"bytes,3,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion uint64      `protobuf:"varint,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *DesiredLRPChangedEvent) Reset()      { *m = DesiredLRPChangedEvent{} }
@@ -575,9 +633,17 @@ func (m *DesiredLRPChangedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *DesiredLRPChangedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type DesiredLRPRemovedEvent struct {
-	DesiredLrp *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp,proto3" json:"desired_lrp,omitempty"`
-	TraceId    string      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	DesiredLrp      *DesiredLRP `protobuf:"bytes,1,opt,name=desired_lrp,json=desiredLrp,proto3" json:"desired_lrp,omitempty"`
+	TraceId         string      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+	ResourceVersion uint64      `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *DesiredLRPRemovedEvent) Reset()      { *m = DesiredLRPRemovedEvent{} }
@@ -626,18 +692,179 @@ func (m *DesiredLRPRemovedEvent) GetTraceId() string {
 	return ""
 }
 
+func (m *DesiredLRPRemovedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
+type DeploymentChangedEvent struct {
+	Deployment *Deployment `protobuf:"bytes,1,opt,name=deployment,proto3" json:"deployment,omitempty"`
+	TraceId    string      `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+}
+
+func (m *DeploymentChangedEvent) Reset()      { *m = DeploymentChangedEvent{} }
+func (*DeploymentChangedEvent) ProtoMessage() {}
+func (*DeploymentChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{10}
+}
+func (m *DeploymentChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeploymentChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeploymentChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeploymentChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeploymentChangedEvent.Merge(m, src)
+}
+func (m *DeploymentChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeploymentChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeploymentChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeploymentChangedEvent proto.InternalMessageInfo
+
+func (m *DeploymentChangedEvent) GetDeployment() *Deployment {
+	if m != nil {
+		return m.Deployment
+	}
+	return nil
+}
+
+func (m *DeploymentChangedEvent) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+type CrashStormBreakerChangedEvent struct {
+	Breaker *CrashStormBreaker `protobuf:"bytes,1,opt,name=breaker,proto3" json:"breaker,omitempty"`
+	TraceId string             `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+}
+
+func (m *CrashStormBreakerChangedEvent) Reset()      { *m = CrashStormBreakerChangedEvent{} }
+func (*CrashStormBreakerChangedEvent) ProtoMessage() {}
+func (*CrashStormBreakerChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{11}
+}
+func (m *CrashStormBreakerChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrashStormBreakerChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrashStormBreakerChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrashStormBreakerChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrashStormBreakerChangedEvent.Merge(m, src)
+}
+func (m *CrashStormBreakerChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrashStormBreakerChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrashStormBreakerChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrashStormBreakerChangedEvent proto.InternalMessageInfo
+
+func (m *CrashStormBreakerChangedEvent) GetBreaker() *CrashStormBreaker {
+	if m != nil {
+		return m.Breaker
+	}
+	return nil
+}
+
+func (m *CrashStormBreakerChangedEvent) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
+type CellDrainChangedEvent struct {
+	Drain   *CellDrain `protobuf:"bytes,1,opt,name=drain,proto3" json:"drain,omitempty"`
+	TraceId string     `protobuf:"bytes,2,opt,name=trace_id,json=traceId,proto3" json:"trace_id"`
+}
+
+func (m *CellDrainChangedEvent) Reset()      { *m = CellDrainChangedEvent{} }
+func (*CellDrainChangedEvent) ProtoMessage() {}
+func (*CellDrainChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{12}
+}
+func (m *CellDrainChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CellDrainChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CellDrainChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CellDrainChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CellDrainChangedEvent.Merge(m, src)
+}
+func (m *CellDrainChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CellDrainChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CellDrainChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CellDrainChangedEvent proto.InternalMessageInfo
+
+func (m *CellDrainChangedEvent) GetDrain() *CellDrain {
+	if m != nil {
+		return m.Drain
+	}
+	return nil
+}
+
+func (m *CellDrainChangedEvent) GetTraceId() string {
+	if m != nil {
+		return m.TraceId
+	}
+	return ""
+}
+
 type ActualLRPCrashedEvent struct {
 	ActualLRPKey         `protobuf:"bytes,1,opt,name=actual_lrp_key,json=actualLrpKey,proto3,embedded=actual_lrp_key" json:""`
 	ActualLRPInstanceKey `protobuf:"bytes,2,opt,name=actual_lrp_instance_key,json=actualLrpInstanceKey,proto3,embedded=actual_lrp_instance_key" json:""`
 	CrashCount           int32  `protobuf:"varint,3,opt,name=crash_count,json=crashCount,proto3" json:"crash_count"`
 	CrashReason          string `protobuf:"bytes,4,opt,name=crash_reason,json=crashReason,proto3" json:"crash_reason,omitempty"`
 	Since                int64  `protobuf:"varint,5,opt,name=since,proto3" json:"since"`
+	ResourceVersion      uint64 `protobuf:"varint,6,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *ActualLRPCrashedEvent) Reset()      { *m = ActualLRPCrashedEvent{} }
 func (*ActualLRPCrashedEvent) ProtoMessage() {}
 func (*ActualLRPCrashedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{10}
+	return fileDescriptor_8f22242cb04491f9, []int{13}
 }
 func (m *ActualLRPCrashedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -687,14 +914,27 @@ func (m *ActualLRPCrashedEvent) GetSince() int64 {
 	return 0
 }
 
+func (m *ActualLRPCrashedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type EventsByCellId struct {
-	CellId string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	CellId          string                      `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Domain          string                      `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	ProcessGuids    []string                    `protobuf:"bytes,3,rep,name=process_guids,json=processGuids,proto3" json:"process_guids,omitempty"`
+	TaskGuids       []string                    `protobuf:"bytes,4,rep,name=task_guids,json=taskGuids,proto3" json:"task_guids,omitempty"`
+	EventTypes      []string                    `protobuf:"bytes,5,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"`
+	LabelSelector   []*LabelSelectorRequirement `protobuf:"bytes,6,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
+	ResourceVersion uint64                      `protobuf:"varint,7,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *EventsByCellId) Reset()      { *m = EventsByCellId{} }
 func (*EventsByCellId) ProtoMessage() {}
 func (*EventsByCellId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{11}
+	return fileDescriptor_8f22242cb04491f9, []int{14}
 }
 func (m *EventsByCellId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,14 +970,119 @@ func (m *EventsByCellId) GetCellId() string {
 	return ""
 }
 
+func (m *EventsByCellId) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *EventsByCellId) GetProcessGuids() []string {
+	if m != nil {
+		return m.ProcessGuids
+	}
+	return nil
+}
+
+func (m *EventsByCellId) GetTaskGuids() []string {
+	if m != nil {
+		return m.TaskGuids
+	}
+	return nil
+}
+
+func (m *EventsByCellId) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
+}
+
+func (m *EventsByCellId) GetLabelSelector() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
+func (m *EventsByCellId) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
+// StreamedEvent carries an event on the gRPC event streams. The id resumes
+// the stream like the ID of a server-sent event, and the payload is the
+// protobuf encoding of the event of the given type.
+type StreamedEvent struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type    string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *StreamedEvent) Reset()      { *m = StreamedEvent{} }
+func (*StreamedEvent) ProtoMessage() {}
+func (*StreamedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{15}
+}
+func (m *StreamedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamedEvent.Merge(m, src)
+}
+func (m *StreamedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamedEvent proto.InternalMessageInfo
+
+func (m *StreamedEvent) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *StreamedEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *StreamedEvent) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
 type TaskCreatedEvent struct {
-	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Task            *Task  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ResourceVersion uint64 `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *TaskCreatedEvent) Reset()      { *m = TaskCreatedEvent{} }
 func (*TaskCreatedEvent) ProtoMessage() {}
 func (*TaskCreatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{12}
+	return fileDescriptor_8f22242cb04491f9, []int{16}
 }
 func (m *TaskCreatedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -773,15 +1118,23 @@ func (m *TaskCreatedEvent) GetTask() *Task {
 	return nil
 }
 
+func (m *TaskCreatedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type TaskChangedEvent struct {
-	Before *Task `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
-	After  *Task `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	Before          *Task  `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After           *Task  `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	ResourceVersion uint64 `protobuf:"varint,3,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *TaskChangedEvent) Reset()      { *m = TaskChangedEvent{} }
 func (*TaskChangedEvent) ProtoMessage() {}
 func (*TaskChangedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{13}
+	return fileDescriptor_8f22242cb04491f9, []int{17}
 }
 func (m *TaskChangedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -824,14 +1177,22 @@ func (m *TaskChangedEvent) GetAfter() *Task {
 	return nil
 }
 
+func (m *TaskChangedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type TaskRemovedEvent struct {
-	Task *Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	Task            *Task  `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
+	ResourceVersion uint64 `protobuf:"varint,2,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *TaskRemovedEvent) Reset()      { *m = TaskRemovedEvent{} }
 func (*TaskRemovedEvent) ProtoMessage() {}
 func (*TaskRemovedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8f22242cb04491f9, []int{14}
+	return fileDescriptor_8f22242cb04491f9, []int{18}
 }
 func (m *TaskRemovedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -867,86 +1228,162 @@ func (m *TaskRemovedEvent) GetTask() *Task {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ActualLRPCreatedEvent)(nil), "models.ActualLRPCreatedEvent")
-	proto.RegisterType((*ActualLRPChangedEvent)(nil), "models.ActualLRPChangedEvent")
-	proto.RegisterType((*ActualLRPRemovedEvent)(nil), "models.ActualLRPRemovedEvent")
-	proto.RegisterType((*ActualLRPInstanceCreatedEvent)(nil), "models.ActualLRPInstanceCreatedEvent")
-	proto.RegisterType((*ActualLRPInfo)(nil), "models.ActualLRPInfo")
-	proto.RegisterType((*ActualLRPInstanceChangedEvent)(nil), "models.ActualLRPInstanceChangedEvent")
-	proto.RegisterType((*ActualLRPInstanceRemovedEvent)(nil), "models.ActualLRPInstanceRemovedEvent")
-	proto.RegisterType((*DesiredLRPCreatedEvent)(nil), "models.DesiredLRPCreatedEvent")
-	proto.RegisterType((*DesiredLRPChangedEvent)(nil), "models.DesiredLRPChangedEvent")
-	proto.RegisterType((*DesiredLRPRemovedEvent)(nil), "models.DesiredLRPRemovedEvent")
-	proto.RegisterType((*ActualLRPCrashedEvent)(nil), "models.ActualLRPCrashedEvent")
-	proto.RegisterType((*EventsByCellId)(nil), "models.EventsByCellId")
-	proto.RegisterType((*TaskCreatedEvent)(nil), "models.TaskCreatedEvent")
-	proto.RegisterType((*TaskChangedEvent)(nil), "models.TaskChangedEvent")
-	proto.RegisterType((*TaskRemovedEvent)(nil), "models.TaskRemovedEvent")
+func (m *TaskRemovedEvent) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9) }
+type ResyncRequiredEvent struct {
+	Reason string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason"`
+}
+
+func (m *ResyncRequiredEvent) Reset()      { *m = ResyncRequiredEvent{} }
+func (*ResyncRequiredEvent) ProtoMessage() {}
+func (*ResyncRequiredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8f22242cb04491f9, []int{19}
+}
+func (m *ResyncRequiredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResyncRequiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResyncRequiredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ResyncRequiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResyncRequiredEvent.Merge(m, src)
+}
+func (m *ResyncRequiredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResyncRequiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResyncRequiredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResyncRequiredEvent proto.InternalMessageInfo
+
+func (m *ResyncRequiredEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ActualLRPCreatedEvent)(nil), "models.ActualLRPCreatedEvent")
+	proto.RegisterType((*ActualLRPChangedEvent)(nil), "models.ActualLRPChangedEvent")
+	proto.RegisterType((*ActualLRPRemovedEvent)(nil), "models.ActualLRPRemovedEvent")
+	proto.RegisterType((*ActualLRPInstanceCreatedEvent)(nil), "models.ActualLRPInstanceCreatedEvent")
+	proto.RegisterType((*ActualLRPInfo)(nil), "models.ActualLRPInfo")
+	proto.RegisterType((*ActualLRPInstanceChangedEvent)(nil), "models.ActualLRPInstanceChangedEvent")
+	proto.RegisterType((*ActualLRPInstanceRemovedEvent)(nil), "models.ActualLRPInstanceRemovedEvent")
+	proto.RegisterType((*DesiredLRPCreatedEvent)(nil), "models.DesiredLRPCreatedEvent")
+	proto.RegisterType((*DesiredLRPChangedEvent)(nil), "models.DesiredLRPChangedEvent")
+	proto.RegisterType((*DesiredLRPRemovedEvent)(nil), "models.DesiredLRPRemovedEvent")
+	proto.RegisterType((*DeploymentChangedEvent)(nil), "models.DeploymentChangedEvent")
+	proto.RegisterType((*CrashStormBreakerChangedEvent)(nil), "models.CrashStormBreakerChangedEvent")
+	proto.RegisterType((*CellDrainChangedEvent)(nil), "models.CellDrainChangedEvent")
+	proto.RegisterType((*ActualLRPCrashedEvent)(nil), "models.ActualLRPCrashedEvent")
+	proto.RegisterType((*EventsByCellId)(nil), "models.EventsByCellId")
+	proto.RegisterType((*StreamedEvent)(nil), "models.StreamedEvent")
+	proto.RegisterType((*TaskCreatedEvent)(nil), "models.TaskCreatedEvent")
+	proto.RegisterType((*TaskChangedEvent)(nil), "models.TaskChangedEvent")
+	proto.RegisterType((*TaskRemovedEvent)(nil), "models.TaskRemovedEvent")
+	proto.RegisterType((*ResyncRequiredEvent)(nil), "models.ResyncRequiredEvent")
+}
+
+func init() { proto.RegisterFile("events.proto", fileDescriptor_8f22242cb04491f9) }
 
 var fileDescriptor_8f22242cb04491f9 = []byte{
-	// 913 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
-	0x14, 0x17, 0x13, 0xdb, 0x71, 0x9e, 0x3d, 0xc7, 0x61, 0x9b, 0x54, 0x08, 0x3a, 0xc9, 0x33, 0x0a,
-	0xd4, 0xd8, 0x56, 0xb7, 0x68, 0x8b, 0x1d, 0x76, 0xda, 0x9c, 0x16, 0x6b, 0xd0, 0x6e, 0x28, 0x88,
-	0xee, 0x32, 0x74, 0x10, 0x68, 0x99, 0x76, 0x84, 0xc8, 0xa2, 0x21, 0xd1, 0x01, 0xdc, 0xd3, 0x3e,
-	0xc2, 0x6e, 0xfb, 0x0a, 0xfb, 0x0c, 0xbb, 0xed, 0xd6, 0x63, 0x76, 0xeb, 0x49, 0x58, 0x9c, 0xcb,
-	0xe0, 0x53, 0x3f, 0xc2, 0x20, 0x52, 0x52, 0x29, 0xdb, 0x48, 0x57, 0x60, 0x39, 0xf4, 0x64, 0xf2,
-	0xbd, 0x1f, 0x7f, 0xef, 0x0f, 0x1f, 0x7f, 0x32, 0xd4, 0xd9, 0x29, 0x0b, 0x44, 0xd4, 0x9d, 0x84,
-	0x5c, 0x70, 0x5c, 0x19, 0xf3, 0x01, 0xf3, 0xa3, 0x83, 0x3b, 0x23, 0x4f, 0x1c, 0x4f, 0xfb, 0x5d,
-	0x97, 0x8f, 0xef, 0x8e, 0xf8, 0x88, 0xdf, 0x95, 0xee, 0xfe, 0x74, 0x28, 0x77, 0x72, 0x23, 0x57,
-	0xea, 0xd8, 0x41, 0x93, 0xba, 0x62, 0x4a, 0x7d, 0xc7, 0x0f, 0x27, 0xa9, 0x65, 0x77, 0xc0, 0x22,
-	0x2f, 0x64, 0x03, 0xcd, 0x04, 0x82, 0x46, 0x27, 0xe9, 0x7a, 0x7f, 0xcc, 0x07, 0xde, 0xd0, 0x73,
-	0xa9, 0xf0, 0x78, 0xe0, 0x08, 0x3a, 0x52, 0xf6, 0xf6, 0xcf, 0xb0, 0xf7, 0xad, 0xa4, 0x7a, 0x46,
-	0x9e, 0x1f, 0x86, 0x8c, 0x0a, 0x36, 0x78, 0x9c, 0xe4, 0x87, 0xbf, 0x01, 0x2d, 0x86, 0x33, 0x0a,
-	0xf9, 0x74, 0x62, 0xa2, 0x16, 0xea, 0xd4, 0xee, 0xef, 0x77, 0x55, 0xce, 0xdd, 0xfc, 0xe0, 0x77,
-	0x89, 0x97, 0x34, 0x14, 0xfe, 0x59, 0x38, 0x91, 0xfb, 0xaf, 0x37, 0x4c, 0xd4, 0x9e, 0xe9, 0xf4,
-	0xc7, 0x34, 0x18, 0x65, 0xf4, 0x5d, 0xa8, 0xf4, 0xd9, 0x90, 0x87, 0xec, 0x3d, 0xa4, 0x29, 0x0a,
-	0x7f, 0x09, 0x65, 0x3a, 0x14, 0x2c, 0x34, 0x37, 0x2e, 0x85, 0x2b, 0x90, 0x0c, 0xad, 0x57, 0x46,
-	0xd8, 0x98, 0x9f, 0xfe, 0xbf, 0x95, 0xbd, 0x82, 0x4f, 0x73, 0xd4, 0x51, 0x10, 0x09, 0x1a, 0xb8,
-	0xac, 0xd0, 0xc0, 0x7b, 0x00, 0xef, 0xc2, 0xa4, 0x01, 0x76, 0x57, 0x02, 0x90, 0xed, 0x9c, 0x1b,
-	0xdf, 0x86, 0xaa, 0x08, 0xa9, 0xcb, 0x1c, 0x6f, 0x20, 0xcb, 0xdc, 0xee, 0xd5, 0x17, 0xb1, 0x9d,
-	0xdb, 0xc8, 0x96, 0x5c, 0x1d, 0x0d, 0xda, 0x7f, 0x96, 0xe0, 0x13, 0x2d, 0xf8, 0x90, 0xe3, 0x1f,
-	0xe1, 0x9a, 0x56, 0x53, 0xc0, 0x84, 0xe3, 0x05, 0x43, 0x6e, 0x6e, 0xca, 0xa8, 0xe6, 0x4a, 0xd4,
-	0x1f, 0x98, 0x48, 0x8e, 0xf5, 0xea, 0xaf, 0x63, 0xdb, 0x38, 0x8b, 0x6d, 0xb4, 0x88, 0x6d, 0x83,
-	0x34, 0xf3, 0x54, 0x52, 0x3f, 0xbe, 0x07, 0x35, 0x37, 0xa4, 0xd1, 0xb1, 0xe3, 0xf2, 0x69, 0x20,
-	0xcc, 0x52, 0x0b, 0x75, 0xca, 0xbd, 0x9d, 0x45, 0x6c, 0xeb, 0x66, 0x02, 0x72, 0x73, 0x98, 0xac,
-	0xf1, 0x67, 0x50, 0x57, 0xae, 0x90, 0xd1, 0x88, 0x07, 0x66, 0x39, 0xa9, 0x83, 0x28, 0x38, 0x91,
-	0x26, 0x6c, 0x43, 0x39, 0x12, 0x54, 0x30, 0xb3, 0x22, 0x6b, 0xdc, 0x5e, 0xc4, 0xb6, 0x32, 0x10,
-	0xf5, 0x83, 0x6f, 0xc3, 0xce, 0xc4, 0xa7, 0x2e, 0x1b, 0xb3, 0x40, 0x38, 0x2c, 0x0c, 0x79, 0x68,
-	0x6e, 0x49, 0x9a, 0x46, 0x6e, 0x7e, 0x9c, 0x58, 0x25, 0x93, 0x17, 0xb8, 0xcc, 0xac, 0xb6, 0x50,
-	0x67, 0x33, 0x65, 0x4a, 0x0c, 0x44, 0xfd, 0xe0, 0x97, 0xd0, 0x5c, 0x9e, 0x7b, 0x73, 0x5b, 0xf6,
-	0xe4, 0x46, 0xd6, 0x93, 0xef, 0x35, 0xff, 0x0b, 0x3a, 0xea, 0x99, 0x49, 0x4b, 0x16, 0xb1, 0xbd,
-	0x72, 0x90, 0xec, 0x8c, 0x8b, 0x50, 0xfc, 0x08, 0xaa, 0x93, 0x90, 0x45, 0x2c, 0xc9, 0x00, 0x5a,
-	0xa8, 0xd3, 0xb8, 0x7f, 0xb0, 0xd2, 0xe9, 0xee, 0xf3, 0x14, 0xa1, 0xee, 0x32, 0xc3, 0x93, 0x7c,
-	0x85, 0x6f, 0x42, 0x95, 0xf0, 0xa9, 0xa0, 0x7d, 0x9f, 0x99, 0xb5, 0x16, 0xea, 0x54, 0x9f, 0x18,
-	0x24, 0xb7, 0xe0, 0x1e, 0xec, 0xd2, 0x53, 0xea, 0xf9, 0xb4, 0xef, 0xf9, 0x9e, 0x98, 0x39, 0xaf,
-	0x78, 0xc0, 0xcc, 0xba, 0x6c, 0xdc, 0xde, 0x22, 0xb6, 0x57, 0x9d, 0xa4, 0xa9, 0x9b, 0x7e, 0xe2,
-	0x01, 0xeb, 0x5d, 0x83, 0x5d, 0x3e, 0x49, 0x92, 0xa6, 0xbe, 0x13, 0xa6, 0xc4, 0xed, 0xbf, 0x36,
-	0xd6, 0x0d, 0xb0, 0xfe, 0x44, 0x9f, 0x40, 0x43, 0x9b, 0xa9, 0x13, 0x36, 0x4b, 0x87, 0xf8, 0xfa,
-	0x4a, 0x91, 0x4f, 0xd9, 0x6c, 0x69, 0x94, 0xea, 0xf9, 0x28, 0x3d, 0x65, 0x33, 0x4c, 0xe1, 0x86,
-	0xc6, 0xe4, 0xa5, 0xc1, 0x24, 0xa5, 0x7a, 0xce, 0x37, 0x57, 0x28, 0xb3, 0x8c, 0x56, 0xa9, 0xaf,
-	0xe7, 0xd4, 0x1a, 0x06, 0xdf, 0xc9, 0xf5, 0x44, 0xcd, 0xfc, 0xde, 0x1a, 0xc6, 0x21, 0xcf, 0xe5,
-	0xe4, 0x8b, 0x4c, 0x4e, 0x4a, 0x97, 0xa1, 0x15, 0xa6, 0xf0, 0x2e, 0xcb, 0x97, 0xbd, 0xcb, 0x75,
-	0x9a, 0x50, 0x90, 0x9e, 0x2b, 0xd4, 0x84, 0x53, 0xd8, 0x7f, 0xa4, 0xbe, 0x00, 0xcb, 0x4a, 0xfe,
-	0x00, 0x6a, 0xda, 0xb7, 0x21, 0x8d, 0x8a, 0xb3, 0xa8, 0xef, 0x0e, 0x11, 0x48, 0x61, 0x1f, 0x14,
-	0xf7, 0x37, 0x54, 0x08, 0xac, 0x0f, 0xd0, 0xe7, 0x4b, 0x1a, 0xbf, 0x2e, 0x66, 0x76, 0x21, 0x9d,
-	0xa2, 0xbe, 0xaf, 0x83, 0xae, 0xb9, 0x8d, 0xcd, 0xff, 0xdc, 0x91, 0xc2, 0x35, 0x5c, 0x6d, 0x47,
-	0xfe, 0xd8, 0x28, 0x7c, 0x53, 0x69, 0x74, 0xfc, 0x51, 0xbe, 0xa8, 0x25, 0xed, 0xdf, 0xfc, 0x70,
-	0xed, 0x2f, 0xad, 0xd7, 0x7e, 0xa9, 0xd8, 0xe5, 0xf5, 0x8a, 0xdd, 0xfe, 0x0a, 0x1a, 0xb2, 0x57,
-	0x51, 0x6f, 0x76, 0xc8, 0x7c, 0xff, 0x68, 0x80, 0x6f, 0xc1, 0x96, 0xcb, 0x7c, 0x3f, 0x69, 0x3b,
-	0x92, 0x6d, 0xaf, 0x2d, 0x62, 0x3b, 0x33, 0x91, 0x8a, 0x2b, 0x51, 0xed, 0x87, 0xd0, 0x7c, 0x41,
-	0xa3, 0x93, 0xc2, 0xe0, 0xb7, 0xa0, 0x94, 0xfc, 0x03, 0x4a, 0x9b, 0x5c, 0xcf, 0x3a, 0x92, 0xe0,
-	0x88, 0xf4, 0xb4, 0x5f, 0xa6, 0xa7, 0xf4, 0xa9, 0xbd, 0xb5, 0x34, 0xb5, 0xc5, 0x73, 0xd9, 0xbc,
-	0xb6, 0x8b, 0xf3, 0x5a, 0x04, 0x29, 0x57, 0x96, 0x53, 0x61, 0xf4, 0xde, 0x9b, 0x53, 0xef, 0xe1,
-	0xd9, 0xb9, 0x65, 0xbc, 0x39, 0xb7, 0x8c, 0xb7, 0xe7, 0x16, 0xfa, 0x65, 0x6e, 0xa1, 0xdf, 0xe7,
-	0x16, 0x7a, 0x3d, 0xb7, 0xd0, 0xd9, 0xdc, 0x42, 0x7f, 0xcf, 0x2d, 0xf4, 0xcf, 0xdc, 0x32, 0xde,
-	0xce, 0x2d, 0xf4, 0xeb, 0x85, 0x65, 0x9c, 0x5d, 0x58, 0xc6, 0x9b, 0x0b, 0xcb, 0xe8, 0x57, 0xe4,
-	0xdf, 0xb9, 0x07, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x15, 0xfc, 0xcc, 0x44, 0x5e, 0x0a, 0x00,
-	0x00,
+	// 1258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x8f, 0xdb, 0xc4,
+	0x17, 0x8f, 0xf3, 0x6b, 0x37, 0x2f, 0xd9, 0x6c, 0x76, 0xda, 0xdd, 0xfa, 0x5b, 0xb5, 0x76, 0xbe,
+	0xa1, 0x52, 0xc3, 0x8f, 0xa6, 0xd5, 0x96, 0x0b, 0x9c, 0x20, 0xdb, 0xaa, 0xad, 0xda, 0xa2, 0x6a,
+	0x5a, 0x38, 0x20, 0x24, 0x6b, 0x62, 0x4f, 0xb2, 0xd6, 0x3a, 0x9e, 0x30, 0x76, 0x56, 0x0a, 0x12,
+	0x12, 0x37, 0x38, 0xf2, 0x2f, 0x20, 0x81, 0xe0, 0xdf, 0x80, 0x53, 0x8f, 0x7b, 0xec, 0x29, 0xa2,
+	0xa9, 0x90, 0x50, 0x4e, 0xfd, 0x03, 0x38, 0xa0, 0x19, 0x8f, 0x5d, 0x3b, 0x49, 0xcb, 0x46, 0x02,
+	0x51, 0x4e, 0x9e, 0xf9, 0xbc, 0x37, 0xef, 0x7d, 0xe6, 0xcd, 0x9b, 0x37, 0xcf, 0x50, 0xa3, 0xc7,
+	0xd4, 0x0f, 0x83, 0xce, 0x88, 0xb3, 0x90, 0xa1, 0xf2, 0x90, 0x39, 0xd4, 0x0b, 0xce, 0x5f, 0x19,
+	0xb8, 0xe1, 0xe1, 0xb8, 0xd7, 0xb1, 0xd9, 0xf0, 0xea, 0x80, 0x0d, 0xd8, 0x55, 0x29, 0xee, 0x8d,
+	0xfb, 0x72, 0x26, 0x27, 0x72, 0x14, 0x2d, 0x3b, 0xdf, 0x20, 0x76, 0x38, 0x26, 0x9e, 0xe5, 0xf1,
+	0x91, 0x42, 0x76, 0x1c, 0x1a, 0xb8, 0x9c, 0x3a, 0x29, 0x08, 0x42, 0x12, 0x1c, 0xa9, 0xf1, 0xde,
+	0x90, 0x39, 0x6e, 0xdf, 0xb5, 0x49, 0xe8, 0x32, 0xdf, 0x0a, 0xc9, 0x40, 0xe1, 0x35, 0x8f, 0xf4,
+	0xa8, 0x17, 0xc4, 0x66, 0x1d, 0x3a, 0xf2, 0xd8, 0x64, 0x48, 0xfd, 0x30, 0x36, 0x6b, 0x73, 0x12,
+	0x1c, 0x5a, 0x41, 0xc8, 0xf8, 0x30, 0x56, 0xb2, 0xa9, 0xe7, 0x59, 0x0e, 0x27, 0xae, 0x1f, 0x21,
+	0xad, 0x6f, 0x34, 0xd8, 0xfd, 0x50, 0x12, 0xba, 0x87, 0x1f, 0x1c, 0x70, 0x4a, 0x42, 0xea, 0xdc,
+	0x14, 0xbb, 0x44, 0x1f, 0x40, 0x8a, 0xa9, 0x35, 0xe0, 0x6c, 0x3c, 0xd2, 0xb5, 0xa6, 0xd6, 0xae,
+	0xee, 0xef, 0x75, 0xa2, 0x9d, 0x77, 0x92, 0x85, 0xb7, 0x84, 0x14, 0xd7, 0x23, 0xfd, 0x7b, 0x7c,
+	0x24, 0xe7, 0xe8, 0x4d, 0x68, 0x70, 0x1a, 0xb0, 0x31, 0xb7, 0xa9, 0x75, 0x4c, 0x79, 0xe0, 0x32,
+	0x5f, 0xcf, 0x37, 0xb5, 0x76, 0x11, 0x6f, 0xc7, 0xf8, 0x27, 0x11, 0xfc, 0x7e, 0x5e, 0xd7, 0x5a,
+	0xdf, 0x67, 0xa8, 0x1c, 0x12, 0x7f, 0x10, 0x53, 0xe9, 0x40, 0xb9, 0x47, 0xfb, 0x8c, 0xd3, 0xbf,
+	0x20, 0xa0, 0xb4, 0xd0, 0x3b, 0x50, 0x22, 0xfd, 0x90, 0x72, 0x3d, 0xff, 0x4a, 0xf5, 0x48, 0x69,
+	0x25, 0xcd, 0xc2, 0xcb, 0x69, 0x66, 0x22, 0x86, 0xe9, 0x90, 0x1d, 0xff, 0x6b, 0x11, 0xfb, 0x41,
+	0x83, 0x8b, 0x89, 0xc5, 0x3b, 0x7e, 0x10, 0x12, 0xdf, 0xa6, 0x99, 0x43, 0xbc, 0x06, 0xf0, 0x82,
+	0x92, 0x22, 0xb3, 0xb3, 0x44, 0x06, 0x57, 0x12, 0x1e, 0xe8, 0x32, 0x6c, 0x86, 0x9c, 0xd8, 0xd4,
+	0x72, 0x1d, 0xe9, 0xba, 0xd2, 0xad, 0xcd, 0xa7, 0x66, 0x82, 0xe1, 0x0d, 0x39, 0xba, 0xe3, 0xac,
+	0x11, 0xb6, 0xd6, 0xcf, 0x45, 0xd8, 0x4a, 0xf1, 0xec, 0x33, 0xf4, 0x31, 0x9c, 0x49, 0x85, 0xca,
+	0xa7, 0xa1, 0xe5, 0xfa, 0x7d, 0x26, 0xd7, 0x57, 0xf7, 0xf5, 0x25, 0x82, 0x1f, 0xd1, 0x50, 0x2c,
+	0xeb, 0xd6, 0x1e, 0x4f, 0xcd, 0xdc, 0xc9, 0xd4, 0xd4, 0xe6, 0x53, 0x33, 0x87, 0x1b, 0x09, 0x6b,
+	0x25, 0x47, 0xd7, 0xa0, 0x1a, 0x25, 0xbd, 0xcd, 0xc6, 0x7e, 0xa8, 0x17, 0x9b, 0x5a, 0xbb, 0xd4,
+	0xdd, 0x9e, 0x4f, 0xcd, 0x34, 0x8c, 0x41, 0x4e, 0x0e, 0xc4, 0x18, 0xfd, 0x1f, 0x6a, 0x91, 0x88,
+	0x53, 0x12, 0x30, 0x5f, 0x2f, 0x89, 0x2d, 0xe3, 0x48, 0x1d, 0x4b, 0x08, 0x99, 0x50, 0x0a, 0x42,
+	0x12, 0x52, 0xbd, 0x2c, 0xc3, 0x51, 0x99, 0x4f, 0xcd, 0x08, 0xc0, 0xd1, 0x07, 0x5d, 0x86, 0xed,
+	0x91, 0x47, 0x6c, 0x2a, 0xee, 0x9e, 0x45, 0x39, 0x67, 0x5c, 0xdf, 0x90, 0x66, 0xea, 0x09, 0x7c,
+	0x53, 0xa0, 0xd2, 0x92, 0xeb, 0xdb, 0x54, 0xdf, 0x6c, 0x6a, 0xed, 0x82, 0xb2, 0x24, 0x00, 0x1c,
+	0x7d, 0xd0, 0x67, 0xd0, 0x58, 0xbc, 0xec, 0x7a, 0x45, 0xc6, 0xe4, 0x5c, 0x1c, 0x93, 0xfb, 0x29,
+	0xf9, 0x23, 0x32, 0xe8, 0xea, 0x22, 0x24, 0xf3, 0xa9, 0xb9, 0xb4, 0x10, 0x6f, 0x0f, 0xb3, 0xaa,
+	0xe8, 0x06, 0x6c, 0x8e, 0x38, 0x0d, 0xa8, 0x60, 0x00, 0x4d, 0xad, 0x5d, 0xdf, 0x3f, 0xbf, 0x14,
+	0xe9, 0xce, 0x03, 0xa5, 0x11, 0x1d, 0x7b, 0xac, 0x8f, 0x93, 0x11, 0xba, 0x00, 0x9b, 0x98, 0x8d,
+	0x43, 0xd2, 0xf3, 0xa8, 0x5e, 0x6d, 0x6a, 0xed, 0xcd, 0xdb, 0x39, 0x9c, 0x20, 0xa8, 0x0b, 0x3b,
+	0xe4, 0x98, 0xb8, 0x1e, 0xe9, 0xb9, 0x9e, 0x1b, 0x4e, 0xac, 0x2f, 0x98, 0x4f, 0xf5, 0x9a, 0x0c,
+	0xdc, 0xee, 0x7c, 0x6a, 0x2e, 0x0b, 0x71, 0x23, 0x0d, 0x7d, 0xca, 0x7c, 0xda, 0x3d, 0x03, 0x3b,
+	0x6c, 0x24, 0x48, 0x13, 0xcf, 0xe2, 0xca, 0x70, 0xeb, 0x8f, 0xfc, 0xaa, 0x5c, 0x4f, 0x57, 0x89,
+	0xdb, 0x50, 0x4f, 0xe5, 0xd4, 0x11, 0x9d, 0xa8, 0x7c, 0x3f, 0xbb, 0xb4, 0xc9, 0xbb, 0x74, 0xb2,
+	0x90, 0x4a, 0xb5, 0x24, 0x95, 0xee, 0xd2, 0x09, 0x22, 0x70, 0x2e, 0x65, 0xc9, 0x55, 0xce, 0xa4,
+	0xc9, 0xa8, 0xa2, 0x5c, 0x58, 0x32, 0x19, 0x33, 0x5a, 0x36, 0x7d, 0x36, 0x31, 0x9d, 0xd2, 0x41,
+	0x57, 0x92, 0x92, 0x16, 0xe5, 0xfc, 0xee, 0x0a, 0x8b, 0x7d, 0x96, 0x54, 0xb4, 0xb7, 0xe3, 0x8a,
+	0x56, 0x7c, 0x95, 0xb6, 0x2a, 0x68, 0xe9, 0x2b, 0x5c, 0x5a, 0xf7, 0x0a, 0x97, 0x57, 0x5f, 0xe1,
+	0x95, 0xa5, 0x26, 0x53, 0xfd, 0x5e, 0x8f, 0x52, 0xf3, 0x9d, 0x06, 0x7b, 0x37, 0xa2, 0xe7, 0x74,
+	0xf1, 0x41, 0xbb, 0x0e, 0xd5, 0xd4, 0x43, 0xab, 0x18, 0xa2, 0x98, 0xe1, 0x8b, 0x45, 0x18, 0x94,
+	0xda, 0x3f, 0xc5, 0xf1, 0x97, 0x2c, 0xc7, 0x74, 0x0e, 0xbf, 0xb5, 0xf0, 0xd2, 0xad, 0xa2, 0x17,
+	0xe7, 0x44, 0x3b, 0xfb, 0xca, 0xad, 0x52, 0x5d, 0x91, 0x10, 0x85, 0x75, 0x37, 0x51, 0x3c, 0x4d,
+	0xa0, 0x33, 0x99, 0xf0, 0xda, 0x04, 0x7a, 0x2c, 0x28, 0xc6, 0x5d, 0x51, 0x26, 0xce, 0xfb, 0x00,
+	0x2f, 0xfa, 0xa5, 0x65, 0x86, 0xb1, 0x04, 0xa7, 0xb4, 0x4e, 0xcd, 0xb0, 0xf5, 0x25, 0x5c, 0x3c,
+	0x10, 0xef, 0xc7, 0x43, 0xd1, 0x79, 0x75, 0x39, 0x25, 0x47, 0x94, 0x67, 0xbc, 0x5f, 0x87, 0x8d,
+	0x5e, 0x04, 0x2b, 0xd7, 0xff, 0x8b, 0x5d, 0x2f, 0xad, 0xc3, 0xb1, 0xe6, 0xe9, 0xdd, 0xbb, 0xb0,
+	0x7b, 0x40, 0x3d, 0xef, 0x86, 0xe8, 0xf2, 0x32, 0x6e, 0x2f, 0x43, 0x49, 0xb6, 0x7e, 0x8b, 0x97,
+	0x33, 0xd1, 0xc6, 0x91, 0xfc, 0xf4, 0xae, 0x7e, 0xcb, 0x67, 0xba, 0x47, 0x12, 0x1c, 0xfe, 0x27,
+	0x8b, 0xf1, 0x42, 0xdb, 0x50, 0x58, 0xbf, 0x6d, 0x28, 0xae, 0x6e, 0x1b, 0xe4, 0x63, 0x5f, 0x7a,
+	0xc9, 0x63, 0xbf, 0x46, 0xf5, 0xfd, 0x31, 0x0f, 0x75, 0x19, 0xd7, 0xa0, 0x3b, 0x11, 0xa7, 0x75,
+	0xc7, 0x41, 0x97, 0x60, 0x43, 0x36, 0xf3, 0xae, 0x23, 0x23, 0x5b, 0xe9, 0x56, 0xe7, 0x53, 0x33,
+	0x86, 0x70, 0xd9, 0x8e, 0xb4, 0xf6, 0xa0, 0xec, 0xb0, 0xa1, 0x38, 0x73, 0x79, 0x8e, 0x58, 0xcd,
+	0xd0, 0x1b, 0xb0, 0x35, 0xe2, 0xcc, 0xa6, 0x41, 0x60, 0x0d, 0xc6, 0xae, 0x13, 0xe8, 0x85, 0x66,
+	0xa1, 0x5d, 0xc1, 0x35, 0x05, 0xde, 0x12, 0x18, 0xba, 0x08, 0xf2, 0x37, 0x44, 0x69, 0x14, 0xa5,
+	0x46, 0x45, 0x20, 0x91, 0xd8, 0x84, 0xaa, 0xfc, 0x1f, 0xb2, 0xc2, 0xc9, 0x88, 0x06, 0x7a, 0x49,
+	0xca, 0x41, 0x42, 0x8f, 0x04, 0x82, 0x6e, 0x41, 0x5d, 0xfe, 0xa2, 0x58, 0x01, 0xf5, 0xa8, 0x1d,
+	0x32, 0xae, 0x97, 0x9b, 0x85, 0x76, 0x75, 0xbf, 0x19, 0x1f, 0xd8, 0x3d, 0x21, 0x7d, 0xa8, 0x84,
+	0x98, 0x7e, 0x3e, 0x76, 0xb9, 0xec, 0x96, 0xf0, 0x96, 0x97, 0x96, 0xac, 0x8c, 0xd4, 0xc6, 0xea,
+	0x48, 0xdd, 0x87, 0xad, 0x87, 0x21, 0xa7, 0x64, 0x18, 0x27, 0x62, 0x1d, 0xf2, 0x71, 0x88, 0x70,
+	0xde, 0x75, 0x10, 0x82, 0xa2, 0xe0, 0xab, 0xe2, 0x21, 0xc7, 0x48, 0x87, 0x8d, 0x11, 0x99, 0x78,
+	0x8c, 0x44, 0xe5, 0xb1, 0x86, 0xe3, 0x69, 0xcb, 0x82, 0xc6, 0x23, 0x12, 0x1c, 0x65, 0xde, 0x91,
+	0x26, 0x14, 0x45, 0x10, 0x54, 0x42, 0xd7, 0xe2, 0xcd, 0x08, 0x3d, 0x2c, 0x25, 0x6b, 0xb4, 0xf1,
+	0xad, 0xaf, 0x35, 0xe5, 0x21, 0x7d, 0x51, 0x2f, 0x2d, 0xbc, 0x02, 0x59, 0x1f, 0x4a, 0x86, 0x5a,
+	0xd9, 0xfa, 0x9f, 0x55, 0x5a, 0xfb, 0xdf, 0x26, 0xde, 0x6a, 0xa6, 0x92, 0xff, 0xad, 0x5b, 0x7d,
+	0x0f, 0xce, 0x60, 0x1a, 0x4c, 0x7c, 0x5b, 0x9d, 0xb4, 0xf2, 0xd1, 0x82, 0xb2, 0xba, 0x44, 0x51,
+	0x1e, 0xc3, 0x7c, 0x6a, 0x2a, 0x04, 0xab, 0x6f, 0xf7, 0xdd, 0x93, 0xa7, 0x46, 0xee, 0xc9, 0x53,
+	0x23, 0xf7, 0xfc, 0xa9, 0xa1, 0x7d, 0x35, 0x33, 0xb4, 0x9f, 0x66, 0x86, 0xf6, 0x78, 0x66, 0x68,
+	0x27, 0x33, 0x43, 0xfb, 0x75, 0x66, 0x68, 0xbf, 0xcf, 0x8c, 0xdc, 0xf3, 0x99, 0xa1, 0x7d, 0xfb,
+	0xcc, 0xc8, 0x9d, 0x3c, 0x33, 0x72, 0x4f, 0x9e, 0x19, 0xb9, 0x5e, 0x59, 0xfe, 0xe2, 0x5e, 0xff,
+	0x73, 0x00, 0xb7, 0xb3, 0x38, 0xc0, 0xb7, 0x0f, 0x00, 0x00,
 }
 
 func (this *ActualLRPCreatedEvent) Equal(that interface{}) bool {
@@ -971,6 +1408,9 @@ func (this *ActualLRPCreatedEvent) Equal(that interface{}) bool {
 	if !this.ActualLrpGroup.Equal(that1.ActualLrpGroup) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPChangedEvent) Equal(that interface{}) bool {
@@ -998,6 +1438,9 @@ func (this *ActualLRPChangedEvent) Equal(that interface{}) bool {
 	if !this.After.Equal(that1.After) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPRemovedEvent) Equal(that interface{}) bool {
@@ -1022,6 +1465,9 @@ func (this *ActualLRPRemovedEvent) Equal(that interface{}) bool {
 	if !this.ActualLrpGroup.Equal(that1.ActualLrpGroup) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPInstanceCreatedEvent) Equal(that interface{}) bool {
@@ -1049,6 +1495,9 @@ func (this *ActualLRPInstanceCreatedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPInfo) Equal(that interface{}) bool {
@@ -1166,6 +1615,9 @@ func (this *ActualLRPInstanceChangedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPInstanceRemovedEvent) Equal(that interface{}) bool {
@@ -1193,6 +1645,9 @@ func (this *ActualLRPInstanceRemovedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPCreatedEvent) Equal(that interface{}) bool {
@@ -1220,6 +1675,9 @@ func (this *DesiredLRPCreatedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPChangedEvent) Equal(that interface{}) bool {
@@ -1250,6 +1708,9 @@ func (this *DesiredLRPChangedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *DesiredLRPRemovedEvent) Equal(that interface{}) bool {
@@ -1277,6 +1738,90 @@ func (this *DesiredLRPRemovedEvent) Equal(that interface{}) bool {
 	if this.TraceId != that1.TraceId {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
+	return true
+}
+func (this *DeploymentChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeploymentChangedEvent)
+	if !ok {
+		that2, ok := that.(DeploymentChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Deployment.Equal(that1.Deployment) {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	return true
+}
+func (this *CrashStormBreakerChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrashStormBreakerChangedEvent)
+	if !ok {
+		that2, ok := that.(CrashStormBreakerChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Breaker.Equal(that1.Breaker) {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
+	return true
+}
+func (this *CellDrainChangedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CellDrainChangedEvent)
+	if !ok {
+		that2, ok := that.(CellDrainChangedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Drain.Equal(that1.Drain) {
+		return false
+	}
+	if this.TraceId != that1.TraceId {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPCrashedEvent) Equal(that interface{}) bool {
@@ -1313,6 +1858,9 @@ func (this *ActualLRPCrashedEvent) Equal(that interface{}) bool {
 	if this.Since != that1.Since {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *EventsByCellId) Equal(that interface{}) bool {
@@ -1337,6 +1885,74 @@ func (this *EventsByCellId) Equal(that interface{}) bool {
 	if this.CellId != that1.CellId {
 		return false
 	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if len(this.ProcessGuids) != len(that1.ProcessGuids) {
+		return false
+	}
+	for i := range this.ProcessGuids {
+		if this.ProcessGuids[i] != that1.ProcessGuids[i] {
+			return false
+		}
+	}
+	if len(this.TaskGuids) != len(that1.TaskGuids) {
+		return false
+	}
+	for i := range this.TaskGuids {
+		if this.TaskGuids[i] != that1.TaskGuids[i] {
+			return false
+		}
+	}
+	if len(this.EventTypes) != len(that1.EventTypes) {
+		return false
+	}
+	for i := range this.EventTypes {
+		if this.EventTypes[i] != that1.EventTypes[i] {
+			return false
+		}
+	}
+	if len(this.LabelSelector) != len(that1.LabelSelector) {
+		return false
+	}
+	for i := range this.LabelSelector {
+		if !this.LabelSelector[i].Equal(that1.LabelSelector[i]) {
+			return false
+		}
+	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
+	return true
+}
+func (this *StreamedEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StreamedEvent)
+	if !ok {
+		that2, ok := that.(StreamedEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if !bytes.Equal(this.Payload, that1.Payload) {
+		return false
+	}
 	return true
 }
 func (this *TaskCreatedEvent) Equal(that interface{}) bool {
@@ -1361,6 +1977,9 @@ func (this *TaskCreatedEvent) Equal(that interface{}) bool {
 	if !this.Task.Equal(that1.Task) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *TaskChangedEvent) Equal(that interface{}) bool {
@@ -1388,6 +2007,9 @@ func (this *TaskChangedEvent) Equal(that interface{}) bool {
 	if !this.After.Equal(that1.After) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *TaskRemovedEvent) Equal(that interface{}) bool {
@@ -1412,17 +2034,45 @@ func (this *TaskRemovedEvent) Equal(that interface{}) bool {
 	if !this.Task.Equal(that1.Task) {
 		return false
 	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
+	return true
+}
+func (this *ResyncRequiredEvent) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResyncRequiredEvent)
+	if !ok {
+		that2, ok := that.(ResyncRequiredEvent)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
 	return true
 }
 func (this *ActualLRPCreatedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.ActualLRPCreatedEvent{")
 	if this.ActualLrpGroup != nil {
 		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1430,7 +2080,7 @@ func (this *ActualLRPChangedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.ActualLRPChangedEvent{")
 	if this.Before != nil {
 		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
@@ -1438,6 +2088,7 @@ func (this *ActualLRPChangedEvent) GoString() string {
 	if this.After != nil {
 		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1445,11 +2096,12 @@ func (this *ActualLRPRemovedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.ActualLRPRemovedEvent{")
 	if this.ActualLrpGroup != nil {
 		s = append(s, "ActualLrpGroup: "+fmt.Sprintf("%#v", this.ActualLrpGroup)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1457,12 +2109,13 @@ func (this *ActualLRPInstanceCreatedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.ActualLRPInstanceCreatedEvent{")
 	if this.ActualLrp != nil {
 		s = append(s, "ActualLrp: "+fmt.Sprintf("%#v", this.ActualLrp)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1499,7 +2152,7 @@ func (this *ActualLRPInstanceChangedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&models.ActualLRPInstanceChangedEvent{")
 	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
@@ -1510,6 +2163,7 @@ func (this *ActualLRPInstanceChangedEvent) GoString() string {
 		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1517,12 +2171,13 @@ func (this *ActualLRPInstanceRemovedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.ActualLRPInstanceRemovedEvent{")
 	if this.ActualLrp != nil {
 		s = append(s, "ActualLrp: "+fmt.Sprintf("%#v", this.ActualLrp)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1530,12 +2185,13 @@ func (this *DesiredLRPCreatedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.DesiredLRPCreatedEvent{")
 	if this.DesiredLrp != nil {
 		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1543,7 +2199,7 @@ func (this *DesiredLRPChangedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 7)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.DesiredLRPChangedEvent{")
 	if this.Before != nil {
 		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
@@ -1552,6 +2208,7 @@ func (this *DesiredLRPChangedEvent) GoString() string {
 		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1559,12 +2216,52 @@ func (this *DesiredLRPRemovedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 7)
 	s = append(s, "&models.DesiredLRPRemovedEvent{")
 	if this.DesiredLrp != nil {
 		s = append(s, "DesiredLrp: "+fmt.Sprintf("%#v", this.DesiredLrp)+",\n")
 	}
 	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeploymentChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.DeploymentChangedEvent{")
+	if this.Deployment != nil {
+		s = append(s, "Deployment: "+fmt.Sprintf("%#v", this.Deployment)+",\n")
+	}
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CrashStormBreakerChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CrashStormBreakerChangedEvent{")
+	if this.Breaker != nil {
+		s = append(s, "Breaker: "+fmt.Sprintf("%#v", this.Breaker)+",\n")
+	}
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *CellDrainChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.CellDrainChangedEvent{")
+	if this.Drain != nil {
+		s = append(s, "Drain: "+fmt.Sprintf("%#v", this.Drain)+",\n")
+	}
+	s = append(s, "TraceId: "+fmt.Sprintf("%#v", this.TraceId)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1572,13 +2269,14 @@ func (this *ActualLRPCrashedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 9)
+	s := make([]string, 0, 10)
 	s = append(s, "&models.ActualLRPCrashedEvent{")
 	s = append(s, "ActualLRPKey: "+strings.Replace(this.ActualLRPKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "ActualLRPInstanceKey: "+strings.Replace(this.ActualLRPInstanceKey.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "CrashCount: "+fmt.Sprintf("%#v", this.CrashCount)+",\n")
 	s = append(s, "CrashReason: "+fmt.Sprintf("%#v", this.CrashReason)+",\n")
 	s = append(s, "Since: "+fmt.Sprintf("%#v", this.Since)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1586,29 +2284,50 @@ func (this *EventsByCellId) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 11)
 	s = append(s, "&models.EventsByCellId{")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "ProcessGuids: "+fmt.Sprintf("%#v", this.ProcessGuids)+",\n")
+	s = append(s, "TaskGuids: "+fmt.Sprintf("%#v", this.TaskGuids)+",\n")
+	s = append(s, "EventTypes: "+fmt.Sprintf("%#v", this.EventTypes)+",\n")
+	if this.LabelSelector != nil {
+		s = append(s, "LabelSelector: "+fmt.Sprintf("%#v", this.LabelSelector)+",\n")
+	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TaskCreatedEvent) GoString() string {
+func (this *StreamedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
-	s = append(s, "&models.TaskCreatedEvent{")
-	if this.Task != nil {
-		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
-	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.StreamedEvent{")
+	s = append(s, "Id: "+fmt.Sprintf("%#v", this.Id)+",\n")
+	s = append(s, "Type: "+fmt.Sprintf("%#v", this.Type)+",\n")
+	s = append(s, "Payload: "+fmt.Sprintf("%#v", this.Payload)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
-func (this *TaskChangedEvent) GoString() string {
+func (this *TaskCreatedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
 	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskCreatedEvent{")
+	if this.Task != nil {
+		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
+	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskChangedEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
 	s = append(s, "&models.TaskChangedEvent{")
 	if this.Before != nil {
 		s = append(s, "Before: "+fmt.Sprintf("%#v", this.Before)+",\n")
@@ -1616,6 +2335,7 @@ func (this *TaskChangedEvent) GoString() string {
 	if this.After != nil {
 		s = append(s, "After: "+fmt.Sprintf("%#v", this.After)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1623,11 +2343,22 @@ func (this *TaskRemovedEvent) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 5)
+	s := make([]string, 0, 6)
 	s = append(s, "&models.TaskRemovedEvent{")
 	if this.Task != nil {
 		s = append(s, "Task: "+fmt.Sprintf("%#v", this.Task)+",\n")
 	}
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ResyncRequiredEvent) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ResyncRequiredEvent{")
+	s = append(s, "Reason: "+fmt.Sprintf("%#v", this.Reason)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1659,6 +2390,11 @@ func (m *ActualLRPCreatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ActualLrpGroup != nil {
 		{
 			size, err := m.ActualLrpGroup.MarshalToSizedBuffer(dAtA[:i])
@@ -1694,6 +2430,11 @@ func (m *ActualLRPChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.After != nil {
 		{
 			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
@@ -1741,6 +2482,11 @@ func (m *ActualLRPRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ActualLrpGroup != nil {
 		{
 			size, err := m.ActualLrpGroup.MarshalToSizedBuffer(dAtA[:i])
@@ -1776,6 +2522,11 @@ func (m *ActualLRPInstanceCreatedEvent) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -1930,6 +2681,11 @@ func (m *ActualLRPInstanceChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -2004,6 +2760,11 @@ func (m *ActualLRPInstanceRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -2046,6 +2807,11 @@ func (m *DesiredLRPCreatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -2088,6 +2854,11 @@ func (m *DesiredLRPChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -2142,6 +2913,11 @@ func (m *DesiredLRPRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.TraceId) > 0 {
 		i -= len(m.TraceId)
 		copy(dAtA[i:], m.TraceId)
@@ -2164,6 +2940,132 @@ func (m *DesiredLRPRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *DeploymentChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeploymentChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeploymentChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Deployment != nil {
+		{
+			size, err := m.Deployment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CrashStormBreakerChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrashStormBreakerChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrashStormBreakerChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Breaker != nil {
+		{
+			size, err := m.Breaker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CellDrainChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CellDrainChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CellDrainChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TraceId) > 0 {
+		i -= len(m.TraceId)
+		copy(dAtA[i:], m.TraceId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TraceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Drain != nil {
+		{
+			size, err := m.Drain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ActualLRPCrashedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2184,6 +3086,11 @@ func (m *ActualLRPCrashedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Since != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Since))
 		i--
@@ -2244,6 +3151,59 @@ func (m *EventsByCellId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LabelSelector) > 0 {
+		for iNdEx := len(m.LabelSelector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelSelector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TaskGuids) > 0 {
+		for iNdEx := len(m.TaskGuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskGuids[iNdEx])
+			copy(dAtA[i:], m.TaskGuids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.TaskGuids[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProcessGuids) > 0 {
+		for iNdEx := len(m.ProcessGuids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ProcessGuids[iNdEx])
+			copy(dAtA[i:], m.ProcessGuids[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.ProcessGuids[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.CellId) > 0 {
 		i -= len(m.CellId)
 		copy(dAtA[i:], m.CellId)
@@ -2254,6 +3214,50 @@ func (m *EventsByCellId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TaskCreatedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2274,6 +3278,11 @@ func (m *TaskCreatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Task != nil {
 		{
 			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
@@ -2309,6 +3318,11 @@ func (m *TaskChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.After != nil {
 		{
 			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
@@ -2356,6 +3370,11 @@ func (m *TaskRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Task != nil {
 		{
 			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
@@ -2371,6 +3390,36 @@ func (m *TaskRemovedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ResyncRequiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResyncRequiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResyncRequiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -2392,6 +3441,9 @@ func (m *ActualLRPCreatedEvent) Size() (n int) {
 		l = m.ActualLrpGroup.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2409,6 +3461,9 @@ func (m *ActualLRPChangedEvent) Size() (n int) {
 		l = m.After.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2422,6 +3477,9 @@ func (m *ActualLRPRemovedEvent) Size() (n int) {
 		l = m.ActualLrpGroup.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2439,6 +3497,9 @@ func (m *ActualLRPInstanceCreatedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2514,6 +3575,9 @@ func (m *ActualLRPInstanceChangedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2531,6 +3595,9 @@ func (m *ActualLRPInstanceRemovedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2548,6 +3615,9 @@ func (m *DesiredLRPCreatedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2569,6 +3639,9 @@ func (m *DesiredLRPChangedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2586,29 +3659,86 @@ func (m *DesiredLRPRemovedEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
-func (m *ActualLRPCrashedEvent) Size() (n int) {
+func (m *DeploymentChangedEvent) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ActualLRPKey.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	l = m.ActualLRPInstanceKey.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if m.CrashCount != 0 {
-		n += 1 + sovEvents(uint64(m.CrashCount))
+	if m.Deployment != nil {
+		l = m.Deployment.Size()
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.CrashReason)
+	l = len(m.TraceId)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	if m.Since != 0 {
-		n += 1 + sovEvents(uint64(m.Since))
-	}
+	return n
+}
+
+func (m *CrashStormBreakerChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Breaker != nil {
+		l = m.Breaker.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CellDrainChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Drain != nil {
+		l = m.Drain.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.TraceId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ActualLRPCrashedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ActualLRPKey.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.ActualLRPInstanceKey.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.CrashCount != 0 {
+		n += 1 + sovEvents(uint64(m.CrashCount))
+	}
+	l = len(m.CrashReason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Since != 0 {
+		n += 1 + sovEvents(uint64(m.Since))
+	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2622,6 +3752,58 @@ func (m *EventsByCellId) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ProcessGuids) > 0 {
+		for _, s := range m.ProcessGuids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.TaskGuids) > 0 {
+		for _, s := range m.TaskGuids {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.LabelSelector) > 0 {
+		for _, e := range m.LabelSelector {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
+	return n
+}
+
+func (m *StreamedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2635,6 +3817,9 @@ func (m *TaskCreatedEvent) Size() (n int) {
 		l = m.Task.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2652,6 +3837,9 @@ func (m *TaskChangedEvent) Size() (n int) {
 		l = m.After.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2665,6 +3853,22 @@ func (m *TaskRemovedEvent) Size() (n int) {
 		l = m.Task.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovEvents(uint64(m.ResourceVersion))
+	}
+	return n
+}
+
+func (m *ResyncRequiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -2680,6 +3884,7 @@ func (this *ActualLRPCreatedEvent) String() string {
 	}
 	s := strings.Join([]string{`&ActualLRPCreatedEvent{`,
 		`ActualLrpGroup:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroup), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2691,6 +3896,7 @@ func (this *ActualLRPChangedEvent) String() string {
 	s := strings.Join([]string{`&ActualLRPChangedEvent{`,
 		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
 		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2701,6 +3907,7 @@ func (this *ActualLRPRemovedEvent) String() string {
 	}
 	s := strings.Join([]string{`&ActualLRPRemovedEvent{`,
 		`ActualLrpGroup:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrpGroup), "ActualLRPGroup", "ActualLRPGroup", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2712,6 +3919,7 @@ func (this *ActualLRPInstanceCreatedEvent) String() string {
 	s := strings.Join([]string{`&ActualLRPInstanceCreatedEvent{`,
 		`ActualLrp:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrp), "ActualLRP", "ActualLRP", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2755,6 +3963,7 @@ func (this *ActualLRPInstanceChangedEvent) String() string {
 		`Before:` + strings.Replace(this.Before.String(), "ActualLRPInfo", "ActualLRPInfo", 1) + `,`,
 		`After:` + strings.Replace(this.After.String(), "ActualLRPInfo", "ActualLRPInfo", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2766,6 +3975,7 @@ func (this *ActualLRPInstanceRemovedEvent) String() string {
 	s := strings.Join([]string{`&ActualLRPInstanceRemovedEvent{`,
 		`ActualLrp:` + strings.Replace(fmt.Sprintf("%v", this.ActualLrp), "ActualLRP", "ActualLRP", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2777,6 +3987,7 @@ func (this *DesiredLRPCreatedEvent) String() string {
 	s := strings.Join([]string{`&DesiredLRPCreatedEvent{`,
 		`DesiredLrp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrp), "DesiredLRP", "DesiredLRP", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2789,6 +4000,7 @@ func (this *DesiredLRPChangedEvent) String() string {
 		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "DesiredLRP", "DesiredLRP", 1) + `,`,
 		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "DesiredLRP", "DesiredLRP", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2800,6 +4012,40 @@ func (this *DesiredLRPRemovedEvent) String() string {
 	s := strings.Join([]string{`&DesiredLRPRemovedEvent{`,
 		`DesiredLrp:` + strings.Replace(fmt.Sprintf("%v", this.DesiredLrp), "DesiredLRP", "DesiredLRP", 1) + `,`,
 		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeploymentChangedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeploymentChangedEvent{`,
+		`Deployment:` + strings.Replace(fmt.Sprintf("%v", this.Deployment), "Deployment", "Deployment", 1) + `,`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CrashStormBreakerChangedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CrashStormBreakerChangedEvent{`,
+		`Breaker:` + strings.Replace(fmt.Sprintf("%v", this.Breaker), "CrashStormBreaker", "CrashStormBreaker", 1) + `,`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CellDrainChangedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CellDrainChangedEvent{`,
+		`Drain:` + strings.Replace(fmt.Sprintf("%v", this.Drain), "CellDrain", "CellDrain", 1) + `,`,
+		`TraceId:` + fmt.Sprintf("%v", this.TraceId) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2814,6 +4060,7 @@ func (this *ActualLRPCrashedEvent) String() string {
 		`CrashCount:` + fmt.Sprintf("%v", this.CrashCount) + `,`,
 		`CrashReason:` + fmt.Sprintf("%v", this.CrashReason) + `,`,
 		`Since:` + fmt.Sprintf("%v", this.Since) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2822,8 +4069,31 @@ func (this *EventsByCellId) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForLabelSelector := "[]*LabelSelectorRequirement{"
+	for _, f := range this.LabelSelector {
+		repeatedStringForLabelSelector += strings.Replace(fmt.Sprintf("%v", f), "LabelSelectorRequirement", "LabelSelectorRequirement", 1) + ","
+	}
+	repeatedStringForLabelSelector += "}"
 	s := strings.Join([]string{`&EventsByCellId{`,
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`ProcessGuids:` + fmt.Sprintf("%v", this.ProcessGuids) + `,`,
+		`TaskGuids:` + fmt.Sprintf("%v", this.TaskGuids) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`LabelSelector:` + repeatedStringForLabelSelector + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StreamedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StreamedEvent{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`Payload:` + fmt.Sprintf("%v", this.Payload) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2834,6 +4104,7 @@ func (this *TaskCreatedEvent) String() string {
 	}
 	s := strings.Join([]string{`&TaskCreatedEvent{`,
 		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2845,6 +4116,7 @@ func (this *TaskChangedEvent) String() string {
 	s := strings.Join([]string{`&TaskChangedEvent{`,
 		`Before:` + strings.Replace(fmt.Sprintf("%v", this.Before), "Task", "Task", 1) + `,`,
 		`After:` + strings.Replace(fmt.Sprintf("%v", this.After), "Task", "Task", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2855,6 +4127,17 @@ func (this *TaskRemovedEvent) String() string {
 	}
 	s := strings.Join([]string{`&TaskRemovedEvent{`,
 		`Task:` + strings.Replace(fmt.Sprintf("%v", this.Task), "Task", "Task", 1) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ResyncRequiredEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ResyncRequiredEvent{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2932,6 +4215,25 @@ func (m *ActualLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3054,6 +4356,25 @@ func (m *ActualLRPChangedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3140,6 +4461,25 @@ func (m *ActualLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3258,6 +4598,25 @@ func (m *ActualLRPInstanceCreatedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3800,6 +5159,25 @@ func (m *ActualLRPInstanceChangedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3918,6 +5296,25 @@ func (m *ActualLRPInstanceRemovedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4036,6 +5433,25 @@ func (m *DesiredLRPCreatedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4190,14 +5606,33 @@ func (m *DesiredLRPChangedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4308,6 +5743,25 @@ func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4329,7 +5783,7 @@ func (m *DesiredLRPRemovedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
+func (m *DeploymentChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4352,15 +5806,15 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActualLRPCrashedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeploymentChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActualLRPCrashedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeploymentChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4387,65 +5841,16 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ActualLRPKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPInstanceKey", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Deployment == nil {
+				m.Deployment = &Deployment{}
 			}
-			if err := m.ActualLRPInstanceKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
-			}
-			m.CrashCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CrashCount |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4473,27 +5878,8 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CrashReason = string(dAtA[iNdEx:postIndex])
+			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
-			}
-			m.Since = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Since |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4515,7 +5901,7 @@ func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
+func (m *CrashStormBreakerChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4538,17 +5924,17 @@ func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventsByCellId: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrashStormBreakerChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventsByCellId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrashStormBreakerChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Breaker", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4558,79 +5944,33 @@ func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CellId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskCreatedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.Breaker == nil {
+				m.Breaker = &CrashStormBreaker{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Breaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskCreatedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4640,27 +5980,23 @@ func (m *TaskCreatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &Task{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4683,7 +6019,7 @@ func (m *TaskCreatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *CellDrainChangedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4706,15 +6042,15 @@ func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellDrainChangedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellDrainChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4741,18 +6077,18 @@ func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Before == nil {
-				m.Before = &Task{}
+			if m.Drain == nil {
+				m.Drain = &CellDrain{}
 			}
-			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Drain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraceId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4762,27 +6098,23 @@ func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.After == nil {
-				m.After = &Task{}
-			}
-			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4805,7 +6137,7 @@ func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
+func (m *ActualLRPCrashedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4828,15 +6160,15 @@ func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskRemovedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActualLRPCrashedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActualLRPCrashedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPKey", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4863,13 +6195,976 @@ func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &Task{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ActualLRPKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActualLRPInstanceKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActualLRPInstanceKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashCount", wireType)
+			}
+			m.CrashCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CrashCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrashReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventsByCellId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventsByCellId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventsByCellId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessGuids = append(m.ProcessGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuids", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuids = append(m.TaskGuids, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = append(m.LabelSelector, &LabelSelectorRequirement{})
+			if err := m.LabelSelector[len(m.LabelSelector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskCreatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCreatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCreatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Before == nil {
+				m.Before = &Task{}
+			}
+			if err := m.Before.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.After == nil {
+				m.After = &Task{}
+			}
+			if err := m.After.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRemovedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRemovedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRemovedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Task == nil {
+				m.Task = &Task{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResyncRequiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResyncRequiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResyncRequiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
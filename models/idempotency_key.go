@@ -0,0 +1,14 @@
+package models
+
+// IdempotencyKey records a call made with an idempotency key, so that the
+// calls reusing the key are answered with the response of the first one
+// instead of being served again.
+type IdempotencyKey struct {
+	Key string
+	// RequestHash identifies the route, client and body of the call.
+	RequestHash string
+	CreatedAt   int64
+	// Response is the serialized response of the call, nil while the call is
+	// being served.
+	Response []byte
+}
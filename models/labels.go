@@ -0,0 +1,120 @@
+package models
+
+import (
+	"encoding/json"
+	"regexp"
+)
+
+const maxLabelLength = 255
+
+var labelKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+
+// LabelSelector matches the labels of a DesiredLRP or Task. A set of labels
+// matches when it satisfies every requirement of the selector; an empty
+// selector matches everything.
+type LabelSelector []*LabelSelectorRequirement
+
+func (selector LabelSelector) Validate() error {
+	var validationError ValidationError
+
+	for _, requirement := range selector {
+		if requirement == nil {
+			validationError = validationError.Append(ErrInvalidField{"label_selector"})
+			continue
+		}
+
+		err := requirement.Validate()
+		if err != nil {
+			validationError = validationError.Append(err)
+		}
+	}
+
+	return validationError.ToError()
+}
+
+func (selector LabelSelector) Matches(labels map[string]string) bool {
+	for _, requirement := range selector {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *LabelSelectorRequirement) Validate() error {
+	var validationError ValidationError
+
+	if !labelKeyPattern.MatchString(r.Key) || len(r.Key) > maxLabelLength {
+		validationError = validationError.Append(ErrInvalidField{"label_selector.key"})
+	}
+
+	switch r.Operator {
+	case LabelSelectorOperatorEquals, LabelSelectorOperatorNotEquals:
+		if len(r.Values) != 1 {
+			validationError = validationError.Append(ErrInvalidField{"label_selector.values"})
+		}
+	case LabelSelectorOperatorIn, LabelSelectorOperatorNotIn:
+		if len(r.Values) == 0 {
+			validationError = validationError.Append(ErrInvalidField{"label_selector.values"})
+		}
+	case LabelSelectorOperatorExists, LabelSelectorOperatorDoesNotExist:
+		if len(r.Values) != 0 {
+			validationError = validationError.Append(ErrInvalidField{"label_selector.values"})
+		}
+	default:
+		validationError = validationError.Append(ErrInvalidField{"label_selector.operator"})
+	}
+
+	return validationError.ToError()
+}
+
+// Matches reports whether the labels satisfy the requirement. Labels without
+// the key satisfy NotEquals and NotIn, as they do in the database queries.
+func (r *LabelSelectorRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case LabelSelectorOperatorEquals, LabelSelectorOperatorIn:
+		return ok && contains(r.Values, value)
+	case LabelSelectorOperatorNotEquals, LabelSelectorOperatorNotIn:
+		return !ok || !contains(r.Values, value)
+	case LabelSelectorOperatorExists:
+		return ok
+	case LabelSelectorOperatorDoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (v *LabelSelectorRequirement_Operator) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	*v = LabelSelectorRequirement_Operator(LabelSelectorRequirement_Operator_value[name])
+
+	return nil
+}
+
+func (v LabelSelectorRequirement_Operator) MarshalJSON() ([]byte, error) {
+	return json.Marshal(LabelSelectorRequirement_Operator_name[int32(v)])
+}
+
+func validateLabels(labels map[string]string) ValidationError {
+	var validationError ValidationError
+
+	for key, value := range labels {
+		if !labelKeyPattern.MatchString(key) || len(key) > maxLabelLength {
+			validationError = validationError.Append(ErrInvalidField{"labels"})
+			break
+		}
+		if len(value) > maxLabelLength {
+			validationError = validationError.Append(ErrInvalidField{"labels"})
+			break
+		}
+	}
+
+	return validationError
+}
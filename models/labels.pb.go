@@ -0,0 +1,538 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: labels.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type LabelSelectorRequirement_Operator int32
+
+const (
+	LabelSelectorOperatorEquals       LabelSelectorRequirement_Operator = 0
+	LabelSelectorOperatorNotEquals    LabelSelectorRequirement_Operator = 1
+	LabelSelectorOperatorIn           LabelSelectorRequirement_Operator = 2
+	LabelSelectorOperatorNotIn        LabelSelectorRequirement_Operator = 3
+	LabelSelectorOperatorExists       LabelSelectorRequirement_Operator = 4
+	LabelSelectorOperatorDoesNotExist LabelSelectorRequirement_Operator = 5
+)
+
+var LabelSelectorRequirement_Operator_name = map[int32]string{
+	0: "EQUALS",
+	1: "NOT_EQUALS",
+	2: "IN",
+	3: "NOT_IN",
+	4: "EXISTS",
+	5: "DOES_NOT_EXIST",
+}
+
+var LabelSelectorRequirement_Operator_value = map[string]int32{
+	"EQUALS":         0,
+	"NOT_EQUALS":     1,
+	"IN":             2,
+	"NOT_IN":         3,
+	"EXISTS":         4,
+	"DOES_NOT_EXIST": 5,
+}
+
+func (LabelSelectorRequirement_Operator) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1847ea10607e5294, []int{0, 0}
+}
+
+type LabelSelectorRequirement struct {
+	Key      string                            `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+	Operator LabelSelectorRequirement_Operator `protobuf:"varint,2,opt,name=operator,proto3,enum=models.LabelSelectorRequirement_Operator" json:"operator"`
+	Values   []string                          `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (m *LabelSelectorRequirement) Reset()      { *m = LabelSelectorRequirement{} }
+func (*LabelSelectorRequirement) ProtoMessage() {}
+func (*LabelSelectorRequirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1847ea10607e5294, []int{0}
+}
+func (m *LabelSelectorRequirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LabelSelectorRequirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LabelSelectorRequirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LabelSelectorRequirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelSelectorRequirement.Merge(m, src)
+}
+func (m *LabelSelectorRequirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *LabelSelectorRequirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelSelectorRequirement.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelSelectorRequirement proto.InternalMessageInfo
+
+func (m *LabelSelectorRequirement) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LabelSelectorRequirement) GetOperator() LabelSelectorRequirement_Operator {
+	if m != nil {
+		return m.Operator
+	}
+	return LabelSelectorOperatorEquals
+}
+
+func (m *LabelSelectorRequirement) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("models.LabelSelectorRequirement_Operator", LabelSelectorRequirement_Operator_name, LabelSelectorRequirement_Operator_value)
+	proto.RegisterType((*LabelSelectorRequirement)(nil), "models.LabelSelectorRequirement")
+}
+
+func init() { proto.RegisterFile("labels.proto", fileDescriptor_1847ea10607e5294) }
+
+var fileDescriptor_1847ea10607e5294 = []byte{
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x3f, 0x6f, 0xda, 0x40,
+	0x18, 0xc6, 0x7d, 0x76, 0xeb, 0xc2, 0x09, 0x21, 0xeb, 0x86, 0xd6, 0x35, 0xd2, 0x71, 0xa5, 0xaa,
+	0x44, 0x5b, 0xd5, 0x48, 0xb4, 0x4b, 0xc7, 0x22, 0x18, 0x2c, 0x21, 0xa3, 0xda, 0x54, 0xea, 0x86,
+	0x6c, 0x72, 0x21, 0x28, 0x86, 0x03, 0xff, 0x89, 0x92, 0x2d, 0xb3, 0xa7, 0x7c, 0x01, 0x6f, 0x19,
+	0xf2, 0x51, 0x92, 0x8d, 0x91, 0x09, 0x05, 0xb3, 0x44, 0x4c, 0x7c, 0x84, 0xc8, 0xc6, 0x20, 0x45,
+	0x82, 0x2c, 0xa7, 0xf7, 0xd1, 0xbd, 0xbf, 0xe7, 0x7e, 0xc3, 0xc1, 0x82, 0x63, 0xd9, 0xd4, 0xf1,
+	0xd4, 0x89, 0xcb, 0x7c, 0x86, 0xc4, 0x11, 0x3b, 0xa1, 0x8e, 0xa7, 0xfc, 0x18, 0x0c, 0xfd, 0xb3,
+	0xc0, 0x56, 0xfb, 0x6c, 0x54, 0x1b, 0xb0, 0x01, 0xab, 0xa5, 0xd7, 0x76, 0x70, 0x9a, 0xa6, 0x34,
+	0xa4, 0xd3, 0x16, 0xab, 0x3c, 0x08, 0x50, 0x6e, 0x27, 0x3d, 0x26, 0x75, 0x68, 0xdf, 0x67, 0xae,
+	0x41, 0xa7, 0xc1, 0xd0, 0xa5, 0x23, 0x3a, 0xf6, 0xd1, 0x47, 0x28, 0x9c, 0xd3, 0x2b, 0x19, 0x10,
+	0x50, 0xcd, 0x37, 0xde, 0xad, 0x17, 0xe5, 0x24, 0x1a, 0xc9, 0x81, 0x4c, 0x98, 0x63, 0x13, 0xea,
+	0x5a, 0x3e, 0x73, 0x65, 0x9e, 0x80, 0x6a, 0xb1, 0xfe, 0x55, 0xdd, 0x1a, 0xa8, 0xc7, 0xea, 0xd4,
+	0x4e, 0x06, 0x34, 0x0a, 0xeb, 0x45, 0x79, 0x8f, 0x1b, 0xfb, 0x09, 0xbd, 0x87, 0xe2, 0x85, 0xe5,
+	0x04, 0xd4, 0x93, 0x05, 0x22, 0x54, 0xf3, 0x46, 0x96, 0x2a, 0xb7, 0x3c, 0xcc, 0xed, 0x60, 0xf4,
+	0x1d, 0x8a, 0xad, 0xbf, 0xff, 0xfe, 0xb4, 0x4d, 0x89, 0x53, 0xca, 0x61, 0x44, 0x4a, 0x2f, 0xde,
+	0xdb, 0xad, 0xb5, 0xa6, 0x81, 0xe5, 0x78, 0xa8, 0x0e, 0xa1, 0xde, 0xe9, 0xf6, 0x32, 0x00, 0x28,
+	0x95, 0x30, 0x22, 0xf8, 0x20, 0xa0, 0x33, 0x3f, 0x63, 0x3e, 0x43, 0x5e, 0xd3, 0x25, 0x5e, 0x29,
+	0x85, 0x11, 0xf9, 0x70, 0x70, 0x57, 0x1b, 0xa3, 0x6f, 0x50, 0x4c, 0x8a, 0x35, 0x5d, 0x12, 0x14,
+	0x1c, 0x46, 0x44, 0x39, 0x56, 0xaa, 0x8d, 0x53, 0xe3, 0xff, 0x9a, 0xd9, 0x35, 0xa5, 0x37, 0xaf,
+	0x19, 0x5f, 0x0e, 0x3d, 0xdf, 0x43, 0xbf, 0x61, 0xb1, 0xd9, 0x69, 0x99, 0xbd, 0x54, 0x3b, 0xa1,
+	0xa4, 0xb7, 0xca, 0x97, 0x30, 0x22, 0x9f, 0x0e, 0x42, 0x4d, 0x46, 0xbd, 0xc4, 0x3c, 0x61, 0x1b,
+	0xbf, 0x66, 0x4b, 0xcc, 0xcd, 0x97, 0x98, 0xdb, 0x2c, 0x31, 0xb8, 0x8e, 0x31, 0xb8, 0x8b, 0x31,
+	0xb8, 0x8f, 0x31, 0x98, 0xc5, 0x18, 0x3c, 0xc6, 0x18, 0x3c, 0xc5, 0x98, 0xdb, 0xc4, 0x18, 0xdc,
+	0xac, 0x30, 0x37, 0x5b, 0x61, 0x6e, 0xbe, 0xc2, 0x9c, 0x2d, 0xa6, 0x1f, 0xe1, 0xe7, 0xf3, 0x00,
+	0xb8, 0xb6, 0x62, 0xb3, 0x4f, 0x02, 0x00, 0x00,
+}
+
+func (x LabelSelectorRequirement_Operator) String() string {
+	s, ok := LabelSelectorRequirement_Operator_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *LabelSelectorRequirement) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LabelSelectorRequirement)
+	if !ok {
+		that2, ok := that.(LabelSelectorRequirement)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Operator != that1.Operator {
+		return false
+	}
+	if len(this.Values) != len(that1.Values) {
+		return false
+	}
+	for i := range this.Values {
+		if this.Values[i] != that1.Values[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *LabelSelectorRequirement) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.LabelSelectorRequirement{")
+	s = append(s, "Key: "+fmt.Sprintf("%#v", this.Key)+",\n")
+	s = append(s, "Operator: "+fmt.Sprintf("%#v", this.Operator)+",\n")
+	s = append(s, "Values: "+fmt.Sprintf("%#v", this.Values)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringLabels(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *LabelSelectorRequirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LabelSelectorRequirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LabelSelectorRequirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintLabels(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Operator != 0 {
+		i = encodeVarintLabels(dAtA, i, uint64(m.Operator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintLabels(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLabels(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLabels(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LabelSelectorRequirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovLabels(uint64(l))
+	}
+	if m.Operator != 0 {
+		n += 1 + sovLabels(uint64(m.Operator))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovLabels(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovLabels(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLabels(x uint64) (n int) {
+	return sovLabels(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *LabelSelectorRequirement) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&LabelSelectorRequirement{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Operator:` + fmt.Sprintf("%v", this.Operator) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringLabels(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *LabelSelectorRequirement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLabels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LabelSelectorRequirement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LabelSelectorRequirement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLabels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLabels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLabels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			m.Operator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLabels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Operator |= LabelSelectorRequirement_Operator(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLabels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLabels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLabels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLabels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLabels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLabels(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLabels
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLabels
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLabels
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLabels
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLabels
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLabels
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLabels        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLabels          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLabels = fmt.Errorf("proto: unexpected end of group")
+)
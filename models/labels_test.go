@@ -0,0 +1,128 @@
+package models_test
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelSelector", func() {
+	Describe("Validate", func() {
+		It("is valid when every requirement is valid", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+				{Key: "example.com/tier", Operator: models.LabelSelectorOperatorNotIn, Values: []string{"a", "b"}},
+				{Key: "canary", Operator: models.LabelSelectorOperatorDoesNotExist},
+			}
+			Expect(selector.Validate()).To(Succeed())
+		})
+
+		It("is not valid when a key is malformed", func() {
+			selector := models.LabelSelector{
+				{Key: "-team", Operator: models.LabelSelectorOperatorExists},
+			}
+			Expect(selector.Validate()).To(MatchError(ContainSubstring("label_selector.key")))
+		})
+
+		It("is not valid when an equality requirement does not have exactly one value", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: models.LabelSelectorOperatorNotEquals, Values: []string{"a", "b"}},
+			}
+			Expect(selector.Validate()).To(MatchError(ContainSubstring("label_selector.values")))
+		})
+
+		It("is not valid when a set requirement has no values", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: models.LabelSelectorOperatorIn},
+			}
+			Expect(selector.Validate()).To(MatchError(ContainSubstring("label_selector.values")))
+		})
+
+		It("is not valid when an existence requirement has values", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: models.LabelSelectorOperatorExists, Values: []string{"a"}},
+			}
+			Expect(selector.Validate()).To(MatchError(ContainSubstring("label_selector.values")))
+		})
+
+		It("is not valid when the operator is unknown", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: 100},
+			}
+			Expect(selector.Validate()).To(MatchError(ContainSubstring("label_selector.operator")))
+		})
+	})
+
+	Describe("Matches", func() {
+		var labels map[string]string
+
+		BeforeEach(func() {
+			labels = map[string]string{"team": "a", "tier": "frontend"}
+		})
+
+		DescribeTable("requirements",
+			func(requirement *models.LabelSelectorRequirement, expected bool) {
+				Expect(models.LabelSelector{requirement}.Matches(labels)).To(Equal(expected))
+			},
+			Entry("equal", &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}}, true),
+			Entry("not equal", &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"b"}}, false),
+			Entry("not equals on a different value", &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorNotEquals, Values: []string{"b"}}, true),
+			Entry("not equals on a missing key", &models.LabelSelectorRequirement{Key: "zone", Operator: models.LabelSelectorOperatorNotEquals, Values: []string{"b"}}, true),
+			Entry("in", &models.LabelSelectorRequirement{Key: "tier", Operator: models.LabelSelectorOperatorIn, Values: []string{"backend", "frontend"}}, true),
+			Entry("in on a missing key", &models.LabelSelectorRequirement{Key: "zone", Operator: models.LabelSelectorOperatorIn, Values: []string{"z1"}}, false),
+			Entry("not in", &models.LabelSelectorRequirement{Key: "tier", Operator: models.LabelSelectorOperatorNotIn, Values: []string{"frontend"}}, false),
+			Entry("exists", &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorExists}, true),
+			Entry("does not exist", &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorDoesNotExist}, false),
+		)
+
+		It("matches everything when empty", func() {
+			Expect(models.LabelSelector{}.Matches(nil)).To(BeTrue())
+		})
+
+		It("requires every requirement to match", func() {
+			selector := models.LabelSelector{
+				{Key: "team", Operator: models.LabelSelectorOperatorEquals, Values: []string{"a"}},
+				{Key: "tier", Operator: models.LabelSelectorOperatorEquals, Values: []string{"backend"}},
+			}
+			Expect(selector.Matches(labels)).To(BeFalse())
+		})
+	})
+
+	Describe("JSON", func() {
+		It("serializes the operator by name", func() {
+			requirement := &models.LabelSelectorRequirement{Key: "team", Operator: models.LabelSelectorOperatorNotIn, Values: []string{"a"}}
+			data, err := json.Marshal(requirement)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"key":"team","operator":"NOT_IN","values":["a"]}`))
+
+			var decoded models.LabelSelectorRequirement
+			Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+			Expect(&decoded).To(Equal(requirement))
+		})
+	})
+})
+
+var _ = Describe("Labels", func() {
+	It("are valid on desired lrps and tasks", func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+		desiredLRP.Labels = map[string]string{"team": "a"}
+		Expect(desiredLRP.Validate()).To(Succeed())
+
+		taskDef := model_helpers.NewValidTaskDefinition()
+		taskDef.Labels = map[string]string{"team": "a"}
+		Expect(taskDef.Validate()).To(Succeed())
+	})
+
+	It("are not valid with a malformed key", func() {
+		desiredLRP := model_helpers.NewValidDesiredLRP("some-guid")
+		desiredLRP.Labels = map[string]string{"team a": "a"}
+		Expect(desiredLRP.Validate()).To(MatchError(ContainSubstring("labels")))
+
+		taskDef := model_helpers.NewValidTaskDefinition()
+		taskDef.Labels = map[string]string{"": "a"}
+		Expect(taskDef.Validate()).To(MatchError(ContainSubstring("labels")))
+	})
+})
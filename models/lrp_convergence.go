@@ -3,4 +3,7 @@ package models
 type ActualLRPKeyWithSchedulingInfo struct {
 	Key            *ActualLRPKey
 	SchedulingInfo *DesiredLRPSchedulingInfo
+	// Crashed is set when the ActualLRP is restarted after crashing, rather
+	// than started again after staying unclaimed.
+	Crashed bool
 }
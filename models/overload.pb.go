@@ -0,0 +1,1000 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: overload.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OverloadStatus is the shedding level of the BBS, from 0 when it sheds
+// nothing, with the name of the last calls it sheds, and the average pool
+// wait and query latency, in nanoseconds, it was last set from.
+type OverloadStatus struct {
+	Level        int32  `protobuf:"varint,1,opt,name=level,proto3" json:"level"`
+	LevelName    string `protobuf:"bytes,2,opt,name=level_name,json=levelName,proto3" json:"level_name"`
+	PoolWait     int64  `protobuf:"varint,3,opt,name=pool_wait,json=poolWait,proto3" json:"pool_wait"`
+	QueryLatency int64  `protobuf:"varint,4,opt,name=query_latency,json=queryLatency,proto3" json:"query_latency"`
+	UpdatedAt    int64  `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *OverloadStatus) Reset()      { *m = OverloadStatus{} }
+func (*OverloadStatus) ProtoMessage() {}
+func (*OverloadStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e43c41facf239c03, []int{0}
+}
+func (m *OverloadStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverloadStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OverloadStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OverloadStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverloadStatus.Merge(m, src)
+}
+func (m *OverloadStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverloadStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverloadStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverloadStatus proto.InternalMessageInfo
+
+func (m *OverloadStatus) GetLevel() int32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *OverloadStatus) GetLevelName() string {
+	if m != nil {
+		return m.LevelName
+	}
+	return ""
+}
+
+func (m *OverloadStatus) GetPoolWait() int64 {
+	if m != nil {
+		return m.PoolWait
+	}
+	return 0
+}
+
+func (m *OverloadStatus) GetQueryLatency() int64 {
+	if m != nil {
+		return m.QueryLatency
+	}
+	return 0
+}
+
+func (m *OverloadStatus) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type OverloadStatusRequest struct {
+}
+
+func (m *OverloadStatusRequest) Reset()      { *m = OverloadStatusRequest{} }
+func (*OverloadStatusRequest) ProtoMessage() {}
+func (*OverloadStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e43c41facf239c03, []int{1}
+}
+func (m *OverloadStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverloadStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OverloadStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OverloadStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverloadStatusRequest.Merge(m, src)
+}
+func (m *OverloadStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverloadStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverloadStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverloadStatusRequest proto.InternalMessageInfo
+
+type OverloadStatusResponse struct {
+	Error  *Error          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Status *OverloadStatus `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (m *OverloadStatusResponse) Reset()      { *m = OverloadStatusResponse{} }
+func (*OverloadStatusResponse) ProtoMessage() {}
+func (*OverloadStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e43c41facf239c03, []int{2}
+}
+func (m *OverloadStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OverloadStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OverloadStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OverloadStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverloadStatusResponse.Merge(m, src)
+}
+func (m *OverloadStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *OverloadStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverloadStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverloadStatusResponse proto.InternalMessageInfo
+
+func (m *OverloadStatusResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *OverloadStatusResponse) GetStatus() *OverloadStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*OverloadStatus)(nil), "models.OverloadStatus")
+	proto.RegisterType((*OverloadStatusRequest)(nil), "models.OverloadStatusRequest")
+	proto.RegisterType((*OverloadStatusResponse)(nil), "models.OverloadStatusResponse")
+}
+
+func init() { proto.RegisterFile("overload.proto", fileDescriptor_e43c41facf239c03) }
+
+var fileDescriptor_e43c41facf239c03 = []byte{
+	// 364 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcd, 0x6a, 0xea, 0x40,
+	0x14, 0xce, 0x5c, 0x6f, 0xe4, 0x66, 0xbc, 0x0a, 0x0d, 0xd4, 0x06, 0x17, 0x13, 0xb1, 0x1b, 0x29,
+	0x18, 0xc1, 0x96, 0xee, 0x1b, 0xe8, 0xae, 0xb4, 0x90, 0x2e, 0xba, 0x0c, 0xa3, 0x99, 0x5a, 0x21,
+	0x71, 0x62, 0x32, 0xb1, 0xb8, 0xeb, 0x23, 0xf4, 0x31, 0xfa, 0x28, 0x5d, 0xba, 0x74, 0x15, 0xea,
+	0xb8, 0x29, 0x59, 0x09, 0x7d, 0x81, 0xe2, 0x19, 0x8b, 0xd5, 0x55, 0xbe, 0x9f, 0xf3, 0x1d, 0x4e,
+	0xbe, 0xc1, 0x35, 0x3e, 0x65, 0x49, 0xc8, 0x69, 0xe0, 0xc4, 0x09, 0x17, 0xdc, 0x2c, 0x47, 0x3c,
+	0x60, 0x61, 0xda, 0xe8, 0x0c, 0x47, 0xe2, 0x29, 0xeb, 0x3b, 0x03, 0x1e, 0x75, 0x87, 0x7c, 0xc8,
+	0xbb, 0x60, 0xf7, 0xb3, 0x47, 0x60, 0x40, 0x00, 0xa9, 0x58, 0xa3, 0xc2, 0x92, 0x84, 0x27, 0x8a,
+	0xb4, 0xbe, 0x10, 0xae, 0xdd, 0x6d, 0xd7, 0xde, 0x0b, 0x2a, 0xb2, 0xd4, 0xb4, 0xb1, 0x1e, 0xb2,
+	0x29, 0x0b, 0x2d, 0xd4, 0x44, 0x6d, 0xdd, 0x35, 0x8a, 0xdc, 0x56, 0x82, 0xa7, 0x3e, 0x66, 0x07,
+	0x63, 0x00, 0xfe, 0x98, 0x46, 0xcc, 0xfa, 0xd3, 0x44, 0x6d, 0xc3, 0xad, 0x15, 0xb9, 0xfd, 0x4b,
+	0xf5, 0x0c, 0xc0, 0xb7, 0x34, 0x62, 0xe6, 0x19, 0x36, 0x62, 0xce, 0x43, 0xff, 0x99, 0x8e, 0x84,
+	0x55, 0x6a, 0xa2, 0x76, 0xc9, 0xad, 0x16, 0xb9, 0xbd, 0x13, 0xbd, 0x7f, 0x1b, 0xf8, 0x40, 0x47,
+	0xc2, 0xbc, 0xc4, 0xd5, 0x49, 0xc6, 0x92, 0x99, 0x1f, 0x52, 0xc1, 0xc6, 0x83, 0x99, 0xf5, 0x17,
+	0xe6, 0x8f, 0x8a, 0xdc, 0xde, 0x37, 0xbc, 0xff, 0x40, 0x6f, 0x14, 0xdb, 0x9c, 0x94, 0xc5, 0x01,
+	0x15, 0x2c, 0xf0, 0xa9, 0xb0, 0x74, 0x08, 0xc1, 0x49, 0x3b, 0xd5, 0x33, 0xb6, 0xf8, 0x4a, 0xb4,
+	0x4e, 0xf0, 0xf1, 0xfe, 0x4f, 0x7b, 0x6c, 0x92, 0xb1, 0x54, 0xb4, 0x22, 0x5c, 0x3f, 0x34, 0xd2,
+	0x98, 0x8f, 0x53, 0x66, 0x9e, 0x62, 0x1d, 0x7a, 0x83, 0x56, 0x2a, 0xbd, 0xaa, 0xa3, 0xca, 0x77,
+	0xae, 0x37, 0xa2, 0xa7, 0x3c, 0xd3, 0xc1, 0xe5, 0x14, 0x62, 0xd0, 0x4a, 0xa5, 0x57, 0xff, 0x99,
+	0x3a, 0x58, 0xba, 0x9d, 0x72, 0x2f, 0xe6, 0x4b, 0xa2, 0x2d, 0x96, 0x44, 0x5b, 0x2f, 0x09, 0x7a,
+	0x91, 0x04, 0xbd, 0x49, 0x82, 0xde, 0x25, 0x41, 0x73, 0x49, 0xd0, 0x87, 0x24, 0xe8, 0x53, 0x12,
+	0x6d, 0x2d, 0x09, 0x7a, 0x5d, 0x11, 0x6d, 0xbe, 0x22, 0xda, 0x62, 0x45, 0xb4, 0x7e, 0x19, 0x9e,
+	0xee, 0xfc, 0x7b, 0x00, 0x9c, 0xe2, 0x72, 0x18, 0x10, 0x02, 0x00, 0x00,
+}
+
+func (this *OverloadStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OverloadStatus)
+	if !ok {
+		that2, ok := that.(OverloadStatus)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Level != that1.Level {
+		return false
+	}
+	if this.LevelName != that1.LevelName {
+		return false
+	}
+	if this.PoolWait != that1.PoolWait {
+		return false
+	}
+	if this.QueryLatency != that1.QueryLatency {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+func (this *OverloadStatusRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OverloadStatusRequest)
+	if !ok {
+		that2, ok := that.(OverloadStatusRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *OverloadStatusResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OverloadStatusResponse)
+	if !ok {
+		that2, ok := that.(OverloadStatusResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.Status.Equal(that1.Status) {
+		return false
+	}
+	return true
+}
+func (this *OverloadStatus) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.OverloadStatus{")
+	s = append(s, "Level: "+fmt.Sprintf("%#v", this.Level)+",\n")
+	s = append(s, "LevelName: "+fmt.Sprintf("%#v", this.LevelName)+",\n")
+	s = append(s, "PoolWait: "+fmt.Sprintf("%#v", this.PoolWait)+",\n")
+	s = append(s, "QueryLatency: "+fmt.Sprintf("%#v", this.QueryLatency)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OverloadStatusRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 4)
+	s = append(s, "&models.OverloadStatusRequest{")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *OverloadStatusResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.OverloadStatusResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.Status != nil {
+		s = append(s, "Status: "+fmt.Sprintf("%#v", this.Status)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringOverload(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *OverloadStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverloadStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverloadStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintOverload(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QueryLatency != 0 {
+		i = encodeVarintOverload(dAtA, i, uint64(m.QueryLatency))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PoolWait != 0 {
+		i = encodeVarintOverload(dAtA, i, uint64(m.PoolWait))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LevelName) > 0 {
+		i -= len(m.LevelName)
+		copy(dAtA[i:], m.LevelName)
+		i = encodeVarintOverload(dAtA, i, uint64(len(m.LevelName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Level != 0 {
+		i = encodeVarintOverload(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OverloadStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverloadStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverloadStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *OverloadStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OverloadStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OverloadStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOverload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintOverload(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOverload(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOverload(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OverloadStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Level != 0 {
+		n += 1 + sovOverload(uint64(m.Level))
+	}
+	l = len(m.LevelName)
+	if l > 0 {
+		n += 1 + l + sovOverload(uint64(l))
+	}
+	if m.PoolWait != 0 {
+		n += 1 + sovOverload(uint64(m.PoolWait))
+	}
+	if m.QueryLatency != 0 {
+		n += 1 + sovOverload(uint64(m.QueryLatency))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovOverload(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *OverloadStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *OverloadStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovOverload(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovOverload(uint64(l))
+	}
+	return n
+}
+
+func sovOverload(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOverload(x uint64) (n int) {
+	return sovOverload(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *OverloadStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverloadStatus{`,
+		`Level:` + fmt.Sprintf("%v", this.Level) + `,`,
+		`LevelName:` + fmt.Sprintf("%v", this.LevelName) + `,`,
+		`PoolWait:` + fmt.Sprintf("%v", this.PoolWait) + `,`,
+		`QueryLatency:` + fmt.Sprintf("%v", this.QueryLatency) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OverloadStatusRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverloadStatusRequest{`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OverloadStatusResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OverloadStatusResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "OverloadStatus", "OverloadStatus", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringOverload(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *OverloadStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOverload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverloadStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverloadStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LevelName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOverload
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LevelName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWait", wireType)
+			}
+			m.PoolWait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolWait |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryLatency", wireType)
+			}
+			m.QueryLatency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryLatency |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOverload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverloadStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOverload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverloadStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverloadStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOverload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OverloadStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOverload
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OverloadStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OverloadStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOverload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOverload
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &OverloadStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOverload(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOverload
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOverload(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOverload
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOverload
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOverload
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOverload
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOverload
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOverload        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOverload          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOverload = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// PageToken identifies the last record returned in a page of a paginated
+// list request. Clients only ever see its opaque encoded form; the next
+// request resumes strictly after the identified record, so pages stay stable
+// while records are inserted or removed.
+type PageToken struct {
+	ProcessGuid   string             `json:"process_guid,omitempty"`
+	Index         int32              `json:"index,omitempty"`
+	Presence      ActualLRP_Presence `json:"presence,omitempty"`
+	TaskGuid      string             `json:"task_guid,omitempty"`
+	AuditRecordId int64              `json:"audit_record_id,omitempty"`
+}
+
+func NewDesiredLRPPageToken(desiredLRP *DesiredLRP) PageToken {
+	return PageToken{ProcessGuid: desiredLRP.ProcessGuid}
+}
+
+func NewActualLRPPageToken(actualLRP *ActualLRP) PageToken {
+	return PageToken{
+		ProcessGuid: actualLRP.ProcessGuid,
+		Index:       actualLRP.Index,
+		Presence:    actualLRP.Presence,
+	}
+}
+
+func NewTaskPageToken(task *Task) PageToken {
+	return PageToken{TaskGuid: task.TaskGuid}
+}
+
+func NewAuditRecordPageToken(record *AuditRecord) PageToken {
+	return PageToken{AuditRecordId: record.Id}
+}
+
+func (t PageToken) Encode() string {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func DecodePageToken(token string) (PageToken, error) {
+	var pageToken PageToken
+
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	err = json.Unmarshal(data, &pageToken)
+	if err != nil {
+		return pageToken, ErrInvalidPageToken
+	}
+
+	return pageToken, nil
+}
+
+func validatePagination(pageSize int32, pageToken string) ValidationError {
+	var validationError ValidationError
+
+	if pageSize < 0 {
+		validationError = validationError.Append(ErrInvalidField{"page_size"})
+	}
+
+	if pageToken != "" {
+		if _, err := DecodePageToken(pageToken); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"page_token"})
+		}
+	}
+
+	return validationError
+}
@@ -0,0 +1,104 @@
+package models_test
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pagination", func() {
+	Describe("PageToken", func() {
+		It("round trips through its encoded form", func() {
+			token := models.PageToken{
+				ProcessGuid: "process-guid",
+				Index:       3,
+				Presence:    models.ActualLRP_Evacuating,
+			}
+
+			decoded, err := models.DecodePageToken(token.Encode())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decoded).To(Equal(token))
+		})
+
+		It("builds tokens from the last record of a page", func() {
+			desiredLRP := &models.DesiredLRP{ProcessGuid: "process-guid"}
+			Expect(models.NewDesiredLRPPageToken(desiredLRP)).To(Equal(models.PageToken{ProcessGuid: "process-guid"}))
+
+			actualLRP := &models.ActualLRP{
+				ActualLRPKey: models.NewActualLRPKey("process-guid", 2, "domain"),
+				Presence:     models.ActualLRP_Suspect,
+			}
+			Expect(models.NewActualLRPPageToken(actualLRP)).To(Equal(models.PageToken{
+				ProcessGuid: "process-guid",
+				Index:       2,
+				Presence:    models.ActualLRP_Suspect,
+			}))
+
+			task := &models.Task{TaskGuid: "task-guid"}
+			Expect(models.NewTaskPageToken(task)).To(Equal(models.PageToken{TaskGuid: "task-guid"}))
+
+			record := &models.AuditRecord{Id: 42}
+			Expect(models.NewAuditRecordPageToken(record)).To(Equal(models.PageToken{AuditRecordId: 42}))
+		})
+
+		It("fails to decode a token that is not base64", func() {
+			_, err := models.DecodePageToken("not a token!")
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+		})
+
+		It("fails to decode a token that is not JSON", func() {
+			_, err := models.DecodePageToken("bm90LWpzb24")
+			Expect(err).To(Equal(models.ErrInvalidPageToken))
+		})
+	})
+
+	Describe("DesiredLRPsRequest", func() {
+		It("is valid with a page size and token", func() {
+			request := models.DesiredLRPsRequest{PageSize: 10, PageToken: models.PageToken{ProcessGuid: "p"}.Encode()}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("rejects a negative page size and an invalid token", func() {
+			request := models.DesiredLRPsRequest{PageSize: -1, PageToken: "not a token"}
+			Expect(request.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"page_size"},
+				models.ErrInvalidField{"page_token"},
+			))
+		})
+	})
+
+	Describe("TasksRequest", func() {
+		It("is valid with a page size and token", func() {
+			request := models.TasksRequest{PageSize: 10, PageToken: models.PageToken{TaskGuid: "t"}.Encode()}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("rejects a negative page size and an invalid token", func() {
+			request := models.TasksRequest{PageSize: -1, PageToken: "not a token"}
+			Expect(request.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"page_size"},
+				models.ErrInvalidField{"page_token"},
+			))
+		})
+	})
+	Describe("AuditRecordsRequest", func() {
+		It("is valid with a time range, page size and token", func() {
+			request := models.AuditRecordsRequest{Since: 10, Until: 20, PageSize: 10, PageToken: models.PageToken{AuditRecordId: 1}.Encode()}
+			Expect(request.Validate()).To(Succeed())
+		})
+
+		It("rejects a time range that ends before it starts", func() {
+			request := models.AuditRecordsRequest{Since: 20, Until: 10}
+			Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"until"}))
+		})
+
+		It("rejects negative times and page sizes", func() {
+			request := models.AuditRecordsRequest{Since: -1, PageSize: -1}
+			Expect(request.Validate()).To(ConsistOf(
+				models.ErrInvalidField{"page_size"},
+				models.ErrInvalidField{"since"},
+			))
+		})
+	})
+})
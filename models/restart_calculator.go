@@ -12,11 +12,11 @@ const DefaultMaxRestarts = 200
 
 const CrashBackoffMinDuration = 30 * time.Second
 
-func exponentialBackoff(exponent, max int32) time.Duration {
+func exponentialBackoff(minDuration time.Duration, exponent, max int32) time.Duration {
 	if exponent > max {
 		exponent = max
 	}
-	return CrashBackoffMinDuration * time.Duration(powerOfTwo(exponent))
+	return minDuration * time.Duration(powerOfTwo(exponent))
 }
 
 func powerOfTwo(pow int32) int32 {
@@ -26,16 +26,21 @@ func powerOfTwo(pow int32) int32 {
 	return 1 << uint(pow)
 }
 
-func calculateMaxBackoffCount(maxDuration time.Duration) int32 {
-	total := math.Ceil(float64(maxDuration) / float64(CrashBackoffMinDuration))
+func calculateMaxBackoffCount(minDuration, maxDuration time.Duration) int32 {
+	if minDuration <= 0 || maxDuration < minDuration {
+		return 0
+	}
+	total := math.Ceil(float64(maxDuration) / float64(minDuration))
 	return int32(math.Logb(total))
 }
 
 type RestartCalculator struct {
 	ImmediateRestarts  int32         `json:"immediate_restarts"`
 	MaxBackoffCount    int32         `json:"max_backoff_count"`
+	MinBackoffDuration time.Duration `json:"min_backoff_duration"`
 	MaxBackoffDuration time.Duration `json:"max_backoff_duration"`
 	MaxRestartAttempts int32         `json:"max_restart_attempts"`
+	NeverRestart       bool          `json:"never_restart"`
 }
 
 func NewDefaultRestartCalculator() RestartCalculator {
@@ -43,18 +48,80 @@ func NewDefaultRestartCalculator() RestartCalculator {
 }
 
 func NewRestartCalculator(immediateRestarts int32, maxBackoffDuration time.Duration, maxRestarts int32) RestartCalculator {
+	return newRestartCalculator(immediateRestarts, CrashBackoffMinDuration, maxBackoffDuration, maxRestarts)
+}
+
+// NewRestartCalculatorFromPolicy builds the calculator for a desired LRP's
+// restart policy. A nil policy yields the default calculator, and unset
+// backoff or restart limits fall back to their defaults.
+func NewRestartCalculatorFromPolicy(policy *RestartPolicy) RestartCalculator {
+	if policy == nil {
+		return NewDefaultRestartCalculator()
+	}
+
+	minBackoffDuration := CrashBackoffMinDuration
+	if policy.MinBackoffMs > 0 {
+		minBackoffDuration = time.Duration(policy.MinBackoffMs) * time.Millisecond
+	}
+
+	maxBackoffDuration := DefaultMaxBackoffDuration
+	if policy.MaxBackoffMs > 0 {
+		maxBackoffDuration = time.Duration(policy.MaxBackoffMs) * time.Millisecond
+	}
+
+	maxRestarts := int32(DefaultMaxRestarts)
+	if policy.MaxRestarts > 0 {
+		maxRestarts = policy.MaxRestarts
+	}
+
+	calc := newRestartCalculator(policy.ImmediateRestarts, minBackoffDuration, maxBackoffDuration, maxRestarts)
+	calc.NeverRestart = policy.NeverRestart
+	return calc
+}
+
+func (p *RestartPolicy) validate() ValidationError {
+	var validationError ValidationError
+	if p == nil {
+		return validationError
+	}
+
+	if p.ImmediateRestarts < 0 {
+		validationError = validationError.Append(ErrInvalidField{"restart_policy.immediate_restarts"})
+	}
+
+	if p.MinBackoffMs < 0 {
+		validationError = validationError.Append(ErrInvalidField{"restart_policy.min_backoff_ms"})
+	}
+
+	minBackoffMs := p.MinBackoffMs
+	if minBackoffMs <= 0 {
+		minBackoffMs = CrashBackoffMinDuration.Milliseconds()
+	}
+	if p.MaxBackoffMs < 0 || (p.MaxBackoffMs > 0 && p.MaxBackoffMs < minBackoffMs) {
+		validationError = validationError.Append(ErrInvalidField{"restart_policy.max_backoff_ms"})
+	}
+
+	if p.MaxRestarts < 0 {
+		validationError = validationError.Append(ErrInvalidField{"restart_policy.max_restarts"})
+	}
+
+	return validationError
+}
+
+func newRestartCalculator(immediateRestarts int32, minBackoffDuration, maxBackoffDuration time.Duration, maxRestarts int32) RestartCalculator {
 	return RestartCalculator{
 		ImmediateRestarts:  immediateRestarts,
+		MinBackoffDuration: minBackoffDuration,
 		MaxBackoffDuration: maxBackoffDuration,
-		MaxBackoffCount:    calculateMaxBackoffCount(maxBackoffDuration),
+		MaxBackoffCount:    calculateMaxBackoffCount(minBackoffDuration, maxBackoffDuration),
 		MaxRestartAttempts: maxRestarts,
 	}
 }
 
 func (r RestartCalculator) Validate() error {
 	var validationError ValidationError
-	if r.MaxBackoffDuration < CrashBackoffMinDuration {
-		err := fmt.Errorf("MaxBackoffDuration '%s' must be larger than CrashBackoffMinDuration '%s'", r.MaxBackoffDuration, CrashBackoffMinDuration)
+	if r.MaxBackoffDuration < r.MinBackoffDuration {
+		err := fmt.Errorf("MaxBackoffDuration '%s' must be larger than MinBackoffDuration '%s'", r.MaxBackoffDuration, r.MinBackoffDuration)
 		validationError = validationError.Append(err)
 	}
 
@@ -67,11 +134,14 @@ func (r RestartCalculator) Validate() error {
 
 func (r RestartCalculator) ShouldRestart(now, crashedAt int64, crashCount int32) bool {
 	switch {
+	case r.NeverRestart:
+		return false
+
 	case crashCount < r.ImmediateRestarts:
 		return true
 
 	case crashCount < r.MaxRestartAttempts:
-		backoffDuration := exponentialBackoff(crashCount-r.ImmediateRestarts, r.MaxBackoffCount)
+		backoffDuration := exponentialBackoff(r.MinBackoffDuration, crashCount-r.ImmediateRestarts, r.MaxBackoffCount)
 		if backoffDuration > r.MaxBackoffDuration {
 			backoffDuration = r.MaxBackoffDuration
 		}
@@ -0,0 +1,293 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+const (
+	DefaultScheduledTaskHistoryLimit = 10
+	MaximumScheduledTaskHistoryLimit = 100
+
+	// the guids of the tasks run by a schedule are the schedule guid with
+	// the time of the run appended, and have to fit in the tasks table
+	maximumScheduleGuidLength = 200
+)
+
+// GetEffectiveHistoryLimit returns the number of runs the schedule
+// remembers, applying the default when no limit was given.
+func (s *ScheduledTask) GetEffectiveHistoryLimit() int32 {
+	if s.GetHistoryLimit() == 0 {
+		return DefaultScheduledTaskHistoryLimit
+	}
+	return s.GetHistoryLimit()
+}
+
+// NextRunAfter returns the first time after t at which the schedule fires,
+// evaluated in the time zone of the schedule.
+func (s *ScheduledTask) NextRunAfter(t time.Time) (time.Time, error) {
+	cron, err := ParseCronExpression(s.CronExpression)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := LoadScheduleLocation(s.TimeZone)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cron.Next(t.In(loc)), nil
+}
+
+// TaskGuidForRun derives the guid of the task run at scheduledAt, so that
+// every BBS that materialises the same run desires the same task.
+func (s *ScheduledTask) TaskGuidForRun(scheduledAt int64) string {
+	return fmt.Sprintf("%s-%d", s.ScheduleGuid, time.Unix(0, scheduledAt).Unix())
+}
+
+// RecordRun adds run to the front of the recent runs, dropping the runs
+// beyond the history limit.
+func (s *ScheduledTask) RecordRun(run *ScheduledTaskRun) {
+	s.RecentRuns = append([]*ScheduledTaskRun{run}, s.RecentRuns...)
+	if limit := int(s.GetEffectiveHistoryLimit()); len(s.RecentRuns) > limit {
+		s.RecentRuns = s.RecentRuns[:limit]
+	}
+}
+
+func (s *ScheduledTask) Validate() error {
+	var validationError ValidationError
+
+	if !taskGuidPattern.MatchString(s.GetScheduleGuid()) || len(s.GetScheduleGuid()) > maximumScheduleGuidLength {
+		validationError = validationError.Append(ErrInvalidField{"schedule_guid"})
+	}
+
+	if s.GetDomain() == "" {
+		validationError = validationError.Append(ErrInvalidField{"domain"})
+	}
+
+	validationError = validationError.Append(validateSchedule(s.GetCronExpression(), s.GetTimeZone(), s.GetConcurrencyPolicy(), s.GetHistoryLimit()))
+
+	if s.TaskDefinition == nil {
+		validationError = validationError.Append(ErrInvalidField{"task_definition"})
+	} else {
+		validationError = validationError.Append(validateScheduledTaskDefinition(s.TaskDefinition))
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func validateSchedule(cronExpression, timeZone string, policy ScheduledTask_ConcurrencyPolicy, historyLimit int32) ValidationError {
+	var validationError ValidationError
+
+	if _, err := ParseCronExpression(cronExpression); err != nil {
+		validationError = validationError.Append(ErrInvalidField{"cron_expression"})
+	}
+
+	if _, err := LoadScheduleLocation(timeZone); err != nil {
+		validationError = validationError.Append(ErrInvalidField{"time_zone"})
+	}
+
+	if _, ok := ScheduledTask_ConcurrencyPolicy_name[int32(policy)]; !ok {
+		validationError = validationError.Append(ErrInvalidField{"concurrency_policy"})
+	}
+
+	if historyLimit < 0 || historyLimit > MaximumScheduledTaskHistoryLimit {
+		validationError = validationError.Append(ErrInvalidField{"history_limit"})
+	}
+
+	return validationError
+}
+
+// validateScheduledTaskDefinition rejects dependencies, since the parents of
+// a run would have to exist every time the schedule fires.
+func validateScheduledTaskDefinition(def *TaskDefinition) ValidationError {
+	var validationError ValidationError
+
+	if err := def.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if def.HasDependencies() {
+		validationError = validationError.Append(ErrInvalidField{"task_definition.depends_on"})
+	}
+
+	return validationError
+}
+
+func (s *ScheduledTaskUpdate) Validate() error {
+	var validationError ValidationError
+
+	if s.CronExpressionExists() {
+		if _, err := ParseCronExpression(s.GetCronExpression()); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"cron_expression"})
+		}
+	}
+
+	if s.TimeZoneExists() {
+		if _, err := LoadScheduleLocation(s.GetTimeZone()); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"time_zone"})
+		}
+	}
+
+	if s.ConcurrencyPolicyExists() {
+		if _, ok := ScheduledTask_ConcurrencyPolicy_name[int32(s.GetConcurrencyPolicy())]; !ok {
+			validationError = validationError.Append(ErrInvalidField{"concurrency_policy"})
+		}
+	}
+
+	if s.HistoryLimitExists() {
+		if s.GetHistoryLimit() < 0 || s.GetHistoryLimit() > MaximumScheduledTaskHistoryLimit {
+			validationError = validationError.Append(ErrInvalidField{"history_limit"})
+		}
+	}
+
+	if s.TaskDefinition != nil {
+		validationError = validationError.Append(validateScheduledTaskDefinition(s.TaskDefinition))
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+// Apply changes the schedule according to the update. It reports whether
+// the time of the next run has to be recomputed.
+func (s *ScheduledTaskUpdate) Apply(schedule *ScheduledTask) bool {
+	rescheduled := false
+
+	if s.CronExpressionExists() {
+		schedule.CronExpression = s.GetCronExpression()
+		rescheduled = true
+	}
+	if s.TimeZoneExists() {
+		schedule.TimeZone = s.GetTimeZone()
+		rescheduled = true
+	}
+	if s.ConcurrencyPolicyExists() {
+		schedule.ConcurrencyPolicy = s.GetConcurrencyPolicy()
+	}
+	if s.HistoryLimitExists() {
+		schedule.HistoryLimit = s.GetHistoryLimit()
+		if limit := int(schedule.GetEffectiveHistoryLimit()); len(schedule.RecentRuns) > limit {
+			schedule.RecentRuns = schedule.RecentRuns[:limit]
+		}
+	}
+	if s.TaskDefinition != nil {
+		schedule.TaskDefinition = s.TaskDefinition
+	}
+
+	return rescheduled
+}
+
+func (s *ScheduledTaskUpdate) SetCronExpression(cronExpression string) {
+	s.OptionalCronExpression = &ScheduledTaskUpdate_CronExpression{CronExpression: cronExpression}
+}
+
+func (s ScheduledTaskUpdate) CronExpressionExists() bool {
+	_, ok := s.GetOptionalCronExpression().(*ScheduledTaskUpdate_CronExpression)
+	return ok
+}
+
+func (s *ScheduledTaskUpdate) SetTimeZone(timeZone string) {
+	s.OptionalTimeZone = &ScheduledTaskUpdate_TimeZone{TimeZone: timeZone}
+}
+
+func (s ScheduledTaskUpdate) TimeZoneExists() bool {
+	_, ok := s.GetOptionalTimeZone().(*ScheduledTaskUpdate_TimeZone)
+	return ok
+}
+
+func (s *ScheduledTaskUpdate) SetConcurrencyPolicy(policy ScheduledTask_ConcurrencyPolicy) {
+	s.OptionalConcurrencyPolicy = &ScheduledTaskUpdate_ConcurrencyPolicy{ConcurrencyPolicy: policy}
+}
+
+func (s ScheduledTaskUpdate) ConcurrencyPolicyExists() bool {
+	_, ok := s.GetOptionalConcurrencyPolicy().(*ScheduledTaskUpdate_ConcurrencyPolicy)
+	return ok
+}
+
+func (s *ScheduledTaskUpdate) SetHistoryLimit(historyLimit int32) {
+	s.OptionalHistoryLimit = &ScheduledTaskUpdate_HistoryLimit{HistoryLimit: historyLimit}
+}
+
+func (s ScheduledTaskUpdate) HistoryLimitExists() bool {
+	_, ok := s.GetOptionalHistoryLimit().(*ScheduledTaskUpdate_HistoryLimit)
+	return ok
+}
+
+type internalScheduledTaskUpdate struct {
+	CronExpression    *string                          `json:"cron_expression,omitempty"`
+	TimeZone          *string                          `json:"time_zone,omitempty"`
+	ConcurrencyPolicy *ScheduledTask_ConcurrencyPolicy `json:"concurrency_policy,omitempty"`
+	HistoryLimit      *int32                           `json:"history_limit,omitempty"`
+	TaskDefinition    *TaskDefinition                  `json:"task_definition,omitempty"`
+}
+
+func (s *ScheduledTaskUpdate) UnmarshalJSON(data []byte) error {
+	var update internalScheduledTaskUpdate
+	if err := json.Unmarshal(data, &update); err != nil {
+		return err
+	}
+
+	if update.CronExpression != nil {
+		s.SetCronExpression(*update.CronExpression)
+	}
+	if update.TimeZone != nil {
+		s.SetTimeZone(*update.TimeZone)
+	}
+	if update.ConcurrencyPolicy != nil {
+		s.SetConcurrencyPolicy(*update.ConcurrencyPolicy)
+	}
+	if update.HistoryLimit != nil {
+		s.SetHistoryLimit(*update.HistoryLimit)
+	}
+	s.TaskDefinition = update.TaskDefinition
+
+	return nil
+}
+
+func (s ScheduledTaskUpdate) MarshalJSON() ([]byte, error) {
+	var update internalScheduledTaskUpdate
+	if s.CronExpressionExists() {
+		cronExpression := s.GetCronExpression()
+		update.CronExpression = &cronExpression
+	}
+	if s.TimeZoneExists() {
+		timeZone := s.GetTimeZone()
+		update.TimeZone = &timeZone
+	}
+	if s.ConcurrencyPolicyExists() {
+		policy := s.GetConcurrencyPolicy()
+		update.ConcurrencyPolicy = &policy
+	}
+	if s.HistoryLimitExists() {
+		historyLimit := s.GetHistoryLimit()
+		update.HistoryLimit = &historyLimit
+	}
+	update.TaskDefinition = s.TaskDefinition
+	return json.Marshal(update)
+}
+
+func (p *ScheduledTask_ConcurrencyPolicy) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := ScheduledTask_ConcurrencyPolicy_value[name]; found {
+		*p = ScheduledTask_ConcurrencyPolicy(v)
+		return nil
+	}
+	return fmt.Errorf("invalid concurrency policy: %s", name)
+}
+
+func (p ScheduledTask_ConcurrencyPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
@@ -0,0 +1,2110 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduled_task.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledTask_ConcurrencyPolicy int32
+
+const (
+	ScheduledTask_Allow   ScheduledTask_ConcurrencyPolicy = 0
+	ScheduledTask_Forbid  ScheduledTask_ConcurrencyPolicy = 1
+	ScheduledTask_Replace ScheduledTask_ConcurrencyPolicy = 2
+)
+
+var ScheduledTask_ConcurrencyPolicy_name = map[int32]string{
+	0: "Allow",
+	1: "Forbid",
+	2: "Replace",
+}
+
+var ScheduledTask_ConcurrencyPolicy_value = map[string]int32{
+	"Allow":   0,
+	"Forbid":  1,
+	"Replace": 2,
+}
+
+func (ScheduledTask_ConcurrencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{0, 0}
+}
+
+type ScheduledTask struct {
+	ScheduleGuid      string                          `protobuf:"bytes,1,opt,name=schedule_guid,json=scheduleGuid,proto3" json:"schedule_guid"`
+	Domain            string                          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	CronExpression    string                          `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression"`
+	TimeZone          string                          `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	ConcurrencyPolicy ScheduledTask_ConcurrencyPolicy `protobuf:"varint,5,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=models.ScheduledTask_ConcurrencyPolicy" json:"concurrency_policy"`
+	HistoryLimit      int32                           `protobuf:"varint,6,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit"`
+	Suspended         bool                            `protobuf:"varint,7,opt,name=suspended,proto3" json:"suspended"`
+	TaskDefinition    *TaskDefinition                 `protobuf:"bytes,8,opt,name=task_definition,json=taskDefinition,proto3" json:"task_definition,omitempty"`
+	// recent_runs are the last history_limit runs, most recent first.
+	RecentRuns []*ScheduledTaskRun `protobuf:"bytes,9,rep,name=recent_runs,json=recentRuns,proto3" json:"recent_runs,omitempty"`
+	NextRunAt  int64               `protobuf:"varint,10,opt,name=next_run_at,json=nextRunAt,proto3" json:"next_run_at"`
+	CreatedAt  int64               `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt  int64               `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *ScheduledTask) Reset()      { *m = ScheduledTask{} }
+func (*ScheduledTask) ProtoMessage() {}
+func (*ScheduledTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{0}
+}
+func (m *ScheduledTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTask.Merge(m, src)
+}
+func (m *ScheduledTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTask proto.InternalMessageInfo
+
+func (m *ScheduledTask) GetScheduleGuid() string {
+	if m != nil {
+		return m.ScheduleGuid
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *ScheduledTask) GetConcurrencyPolicy() ScheduledTask_ConcurrencyPolicy {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ScheduledTask_Allow
+}
+
+func (m *ScheduledTask) GetHistoryLimit() int32 {
+	if m != nil {
+		return m.HistoryLimit
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+func (m *ScheduledTask) GetTaskDefinition() *TaskDefinition {
+	if m != nil {
+		return m.TaskDefinition
+	}
+	return nil
+}
+
+func (m *ScheduledTask) GetRecentRuns() []*ScheduledTaskRun {
+	if m != nil {
+		return m.RecentRuns
+	}
+	return nil
+}
+
+func (m *ScheduledTask) GetNextRunAt() int64 {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ScheduledTask) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type ScheduledTaskRun struct {
+	TaskGuid    string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	ScheduledAt int64  `protobuf:"varint,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at"`
+	Skipped     bool   `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (m *ScheduledTaskRun) Reset()      { *m = ScheduledTaskRun{} }
+func (*ScheduledTaskRun) ProtoMessage() {}
+func (*ScheduledTaskRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{1}
+}
+func (m *ScheduledTaskRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskRun.Merge(m, src)
+}
+func (m *ScheduledTaskRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskRun.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskRun proto.InternalMessageInfo
+
+func (m *ScheduledTaskRun) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *ScheduledTaskRun) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *ScheduledTaskRun) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+type ScheduledTaskUpdate struct {
+	// Types that are valid to be assigned to OptionalCronExpression:
+	//	*ScheduledTaskUpdate_CronExpression
+	OptionalCronExpression isScheduledTaskUpdate_OptionalCronExpression `protobuf_oneof:"optional_cron_expression"`
+	// Types that are valid to be assigned to OptionalTimeZone:
+	//	*ScheduledTaskUpdate_TimeZone
+	OptionalTimeZone isScheduledTaskUpdate_OptionalTimeZone `protobuf_oneof:"optional_time_zone"`
+	// Types that are valid to be assigned to OptionalConcurrencyPolicy:
+	//	*ScheduledTaskUpdate_ConcurrencyPolicy
+	OptionalConcurrencyPolicy isScheduledTaskUpdate_OptionalConcurrencyPolicy `protobuf_oneof:"optional_concurrency_policy"`
+	// Types that are valid to be assigned to OptionalHistoryLimit:
+	//	*ScheduledTaskUpdate_HistoryLimit
+	OptionalHistoryLimit isScheduledTaskUpdate_OptionalHistoryLimit `protobuf_oneof:"optional_history_limit"`
+	TaskDefinition       *TaskDefinition                            `protobuf:"bytes,5,opt,name=task_definition,json=taskDefinition,proto3" json:"task_definition,omitempty"`
+}
+
+func (m *ScheduledTaskUpdate) Reset()      { *m = ScheduledTaskUpdate{} }
+func (*ScheduledTaskUpdate) ProtoMessage() {}
+func (*ScheduledTaskUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_493ff2cd7c97a5a3, []int{2}
+}
+func (m *ScheduledTaskUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskUpdate.Merge(m, src)
+}
+func (m *ScheduledTaskUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskUpdate proto.InternalMessageInfo
+
+type isScheduledTaskUpdate_OptionalCronExpression interface {
+	isScheduledTaskUpdate_OptionalCronExpression()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScheduledTaskUpdate_OptionalTimeZone interface {
+	isScheduledTaskUpdate_OptionalTimeZone()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScheduledTaskUpdate_OptionalConcurrencyPolicy interface {
+	isScheduledTaskUpdate_OptionalConcurrencyPolicy()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+type isScheduledTaskUpdate_OptionalHistoryLimit interface {
+	isScheduledTaskUpdate_OptionalHistoryLimit()
+	Equal(interface{}) bool
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type ScheduledTaskUpdate_CronExpression struct {
+	CronExpression string `protobuf:"bytes,1,opt,name=cron_expression,json=cronExpression,proto3,oneof" json:"cron_expression,omitempty"`
+}
+type ScheduledTaskUpdate_TimeZone struct {
+	TimeZone string `protobuf:"bytes,2,opt,name=time_zone,json=timeZone,proto3,oneof" json:"time_zone,omitempty"`
+}
+type ScheduledTaskUpdate_ConcurrencyPolicy struct {
+	ConcurrencyPolicy ScheduledTask_ConcurrencyPolicy `protobuf:"varint,3,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=models.ScheduledTask_ConcurrencyPolicy,oneof" json:"concurrency_policy,omitempty"`
+}
+type ScheduledTaskUpdate_HistoryLimit struct {
+	HistoryLimit int32 `protobuf:"varint,4,opt,name=history_limit,json=historyLimit,proto3,oneof" json:"history_limit,omitempty"`
+}
+
+func (*ScheduledTaskUpdate_CronExpression) isScheduledTaskUpdate_OptionalCronExpression()       {}
+func (*ScheduledTaskUpdate_TimeZone) isScheduledTaskUpdate_OptionalTimeZone()                   {}
+func (*ScheduledTaskUpdate_ConcurrencyPolicy) isScheduledTaskUpdate_OptionalConcurrencyPolicy() {}
+func (*ScheduledTaskUpdate_HistoryLimit) isScheduledTaskUpdate_OptionalHistoryLimit()           {}
+
+func (m *ScheduledTaskUpdate) GetOptionalCronExpression() isScheduledTaskUpdate_OptionalCronExpression {
+	if m != nil {
+		return m.OptionalCronExpression
+	}
+	return nil
+}
+func (m *ScheduledTaskUpdate) GetOptionalTimeZone() isScheduledTaskUpdate_OptionalTimeZone {
+	if m != nil {
+		return m.OptionalTimeZone
+	}
+	return nil
+}
+func (m *ScheduledTaskUpdate) GetOptionalConcurrencyPolicy() isScheduledTaskUpdate_OptionalConcurrencyPolicy {
+	if m != nil {
+		return m.OptionalConcurrencyPolicy
+	}
+	return nil
+}
+func (m *ScheduledTaskUpdate) GetOptionalHistoryLimit() isScheduledTaskUpdate_OptionalHistoryLimit {
+	if m != nil {
+		return m.OptionalHistoryLimit
+	}
+	return nil
+}
+
+func (m *ScheduledTaskUpdate) GetCronExpression() string {
+	if x, ok := m.GetOptionalCronExpression().(*ScheduledTaskUpdate_CronExpression); ok {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (m *ScheduledTaskUpdate) GetTimeZone() string {
+	if x, ok := m.GetOptionalTimeZone().(*ScheduledTaskUpdate_TimeZone); ok {
+		return x.TimeZone
+	}
+	return ""
+}
+
+func (m *ScheduledTaskUpdate) GetConcurrencyPolicy() ScheduledTask_ConcurrencyPolicy {
+	if x, ok := m.GetOptionalConcurrencyPolicy().(*ScheduledTaskUpdate_ConcurrencyPolicy); ok {
+		return x.ConcurrencyPolicy
+	}
+	return ScheduledTask_Allow
+}
+
+func (m *ScheduledTaskUpdate) GetHistoryLimit() int32 {
+	if x, ok := m.GetOptionalHistoryLimit().(*ScheduledTaskUpdate_HistoryLimit); ok {
+		return x.HistoryLimit
+	}
+	return 0
+}
+
+func (m *ScheduledTaskUpdate) GetTaskDefinition() *TaskDefinition {
+	if m != nil {
+		return m.TaskDefinition
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ScheduledTaskUpdate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ScheduledTaskUpdate_CronExpression)(nil),
+		(*ScheduledTaskUpdate_TimeZone)(nil),
+		(*ScheduledTaskUpdate_ConcurrencyPolicy)(nil),
+		(*ScheduledTaskUpdate_HistoryLimit)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterEnum("models.ScheduledTask_ConcurrencyPolicy", ScheduledTask_ConcurrencyPolicy_name, ScheduledTask_ConcurrencyPolicy_value)
+	proto.RegisterType((*ScheduledTask)(nil), "models.ScheduledTask")
+	proto.RegisterType((*ScheduledTaskRun)(nil), "models.ScheduledTaskRun")
+	proto.RegisterType((*ScheduledTaskUpdate)(nil), "models.ScheduledTaskUpdate")
+}
+
+func init() { proto.RegisterFile("scheduled_task.proto", fileDescriptor_493ff2cd7c97a5a3) }
+
+var fileDescriptor_493ff2cd7c97a5a3 = []byte{
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0xe3, 0x46,
+	0x10, 0xd5, 0x5a, 0x91, 0x2c, 0x8e, 0x64, 0x59, 0x5a, 0x3b, 0x0e, 0xe1, 0xc0, 0xa4, 0x20, 0x20,
+	0x88, 0xf2, 0x61, 0x09, 0xb0, 0x8d, 0x04, 0x01, 0x02, 0x04, 0x62, 0xbe, 0x54, 0xa4, 0x08, 0x36,
+	0x09, 0x10, 0x5c, 0x43, 0x50, 0xe4, 0x5a, 0x26, 0x4c, 0xed, 0x12, 0xe4, 0x12, 0x67, 0x5d, 0x75,
+	0x3f, 0xe0, 0x8a, 0xfb, 0x11, 0x57, 0xdc, 0x4f, 0xb9, 0xd2, 0xa5, 0x2b, 0xe2, 0x2c, 0x37, 0x07,
+	0x56, 0x2e, 0xaf, 0x3c, 0x70, 0x25, 0x91, 0xfa, 0x6a, 0x5c, 0x69, 0xe6, 0xcd, 0x9b, 0xd5, 0xce,
+	0xdb, 0x79, 0x84, 0xc3, 0xd0, 0xbe, 0xa2, 0x4e, 0xe4, 0x51, 0xc7, 0x14, 0x56, 0x78, 0xdd, 0xf5,
+	0x03, 0x2e, 0x38, 0x2e, 0x8f, 0xb9, 0x43, 0xbd, 0xf0, 0xf8, 0x74, 0xe4, 0x8a, 0xab, 0x68, 0xd8,
+	0xb5, 0xf9, 0xb8, 0x37, 0xe2, 0x23, 0xde, 0x93, 0xe5, 0x61, 0x74, 0x29, 0x33, 0x99, 0xc8, 0x68,
+	0xd6, 0x76, 0x0c, 0xf9, 0x11, 0xed, 0x8f, 0x25, 0xd8, 0xfb, 0x67, 0x71, 0xf6, 0xbf, 0x56, 0x78,
+	0x8d, 0x7f, 0x80, 0xbd, 0xc5, 0x9f, 0x99, 0xa3, 0xc8, 0x75, 0x54, 0xd4, 0x42, 0x1d, 0xc5, 0x68,
+	0x26, 0xb1, 0xbe, 0x5a, 0x20, 0xb5, 0x45, 0xfa, 0x67, 0xe4, 0x3a, 0xb8, 0x0d, 0x65, 0x87, 0x8f,
+	0x2d, 0x97, 0xa9, 0x3b, 0xb2, 0x01, 0x92, 0x58, 0x9f, 0x23, 0x64, 0xfe, 0x8b, 0x7f, 0x86, 0x7d,
+	0x3b, 0xe0, 0xcc, 0xa4, 0x37, 0x7e, 0x40, 0xc3, 0xd0, 0xe5, 0x4c, 0x2d, 0x4a, 0xf2, 0x41, 0x12,
+	0xeb, 0xeb, 0x25, 0x52, 0x4f, 0x81, 0xdf, 0xb3, 0x1c, 0x5f, 0x80, 0x22, 0xdc, 0x31, 0x35, 0x5f,
+	0x70, 0x46, 0xd5, 0xcf, 0x64, 0xdf, 0x17, 0x49, 0xac, 0x1f, 0x64, 0xe0, 0xf7, 0x7c, 0xec, 0x0a,
+	0x3a, 0xf6, 0xc5, 0x84, 0x54, 0x52, 0xf0, 0x19, 0x67, 0x14, 0x33, 0xc0, 0x36, 0x67, 0x76, 0x14,
+	0x04, 0x94, 0xd9, 0x13, 0xd3, 0xe7, 0x9e, 0x6b, 0x4f, 0xd4, 0x52, 0x0b, 0x75, 0xea, 0x67, 0x5f,
+	0x77, 0x67, 0x0a, 0x76, 0x57, 0x24, 0xe8, 0xfe, 0x9a, 0xf3, 0xff, 0x96, 0x74, 0xe3, 0x28, 0x89,
+	0xf5, 0x2d, 0xc7, 0x90, 0xa6, 0xbd, 0x4e, 0x4d, 0xf5, 0xbb, 0x72, 0x43, 0xc1, 0x83, 0x89, 0xe9,
+	0xb9, 0x63, 0x57, 0xa8, 0xe5, 0x16, 0xea, 0x94, 0x66, 0xfa, 0xad, 0x14, 0x48, 0x6d, 0x9e, 0xfe,
+	0x95, 0x66, 0xf8, 0x3b, 0x50, 0xc2, 0x28, 0xf4, 0x29, 0x73, 0xa8, 0xa3, 0xee, 0xb6, 0x50, 0xa7,
+	0x62, 0xec, 0x25, 0xb1, 0x9e, 0x83, 0x24, 0x0f, 0xf1, 0x2f, 0xb0, 0x9f, 0x3e, 0xa2, 0xe9, 0xd0,
+	0x4b, 0x97, 0xb9, 0x22, 0x15, 0xb2, 0xd2, 0x42, 0x9d, 0xea, 0xd9, 0xd1, 0x62, 0xa2, 0x74, 0x90,
+	0xdf, 0xb2, 0x2a, 0xa9, 0x8b, 0x95, 0x1c, 0xff, 0x04, 0xd5, 0x80, 0xda, 0x94, 0x09, 0x33, 0x88,
+	0x58, 0xa8, 0x2a, 0xad, 0x62, 0xa7, 0x7a, 0xa6, 0x6e, 0x95, 0x83, 0x44, 0x8c, 0xc0, 0x8c, 0x4c,
+	0x22, 0x16, 0xe2, 0x1e, 0x54, 0x19, 0xbd, 0x91, 0x8d, 0xa6, 0x25, 0x54, 0x68, 0xa1, 0x4e, 0xd1,
+	0xd8, 0x4f, 0x62, 0x7d, 0x19, 0x26, 0x4a, 0x9a, 0x90, 0x88, 0xf5, 0x05, 0x3e, 0x05, 0xb0, 0x03,
+	0x6a, 0x09, 0xea, 0xa4, 0xfc, 0xaa, 0xe4, 0xd7, 0x93, 0x58, 0x5f, 0x42, 0x89, 0x32, 0x8f, 0x67,
+	0xf4, 0xc8, 0x77, 0x16, 0xf4, 0x5a, 0x4e, 0xcf, 0x51, 0xa2, 0xcc, 0xe3, 0xbe, 0x68, 0xff, 0x08,
+	0xcd, 0x8d, 0xf7, 0xc2, 0x0a, 0x94, 0xfa, 0x9e, 0xc7, 0x9f, 0x37, 0x0a, 0x18, 0xa0, 0xfc, 0x07,
+	0x0f, 0x86, 0xae, 0xd3, 0x40, 0xb8, 0x0a, 0xbb, 0x84, 0xfa, 0x9e, 0x65, 0xd3, 0xc6, 0x4e, 0xfb,
+	0x0d, 0x82, 0xc6, 0xfa, 0xa0, 0xf8, 0x5b, 0x50, 0xa4, 0xb0, 0x4b, 0x9b, 0x2f, 0x5f, 0x21, 0x03,
+	0x49, 0x25, 0x0d, 0xe5, 0xc6, 0x9f, 0x43, 0x2d, 0xb7, 0xa5, 0x25, 0xe4, 0xde, 0x17, 0x8d, 0x46,
+	0x12, 0xeb, 0x2b, 0x38, 0xa9, 0x66, 0x59, 0x5f, 0xe0, 0x1e, 0xec, 0x86, 0xd7, 0xae, 0xef, 0x53,
+	0x47, 0xae, 0x7e, 0xc5, 0xf8, 0x3c, 0x89, 0xf5, 0xe6, 0x1c, 0x5a, 0x5a, 0xe0, 0x05, 0xab, 0xfd,
+	0xaa, 0x08, 0x07, 0x2b, 0xd7, 0xfc, 0x4f, 0x8e, 0x8e, 0xbf, 0xd9, 0xf4, 0x92, 0xbc, 0xef, 0xa0,
+	0xb0, 0x61, 0x9c, 0x93, 0x65, 0xe3, 0x48, 0x77, 0x0e, 0xd0, 0x92, 0x43, 0xfe, 0xdf, 0xea, 0x90,
+	0xe2, 0x93, 0x1c, 0x32, 0xd8, 0xd9, 0xe6, 0x85, 0xaf, 0xd6, 0xbd, 0x90, 0xba, 0xb6, 0x34, 0x28,
+	0xae, 0xad, 0xfe, 0x96, 0x6d, 0x2e, 0x3d, 0x65, 0x9b, 0x8d, 0x63, 0x50, 0xb9, 0x9f, 0x46, 0x96,
+	0x67, 0xae, 0x89, 0x62, 0x1c, 0x02, 0xce, 0x6a, 0x99, 0x0a, 0xc6, 0x09, 0x7c, 0x99, 0x77, 0x6c,
+	0x0c, 0x6f, 0xa8, 0x70, 0x94, 0x95, 0x57, 0x26, 0x30, 0x2e, 0x6e, 0xef, 0x35, 0x74, 0x77, 0xaf,
+	0x15, 0x1e, 0xef, 0x35, 0xf4, 0x72, 0xaa, 0xa1, 0xb7, 0x53, 0x0d, 0xbd, 0x9b, 0x6a, 0xe8, 0x76,
+	0xaa, 0xa1, 0xf7, 0x53, 0x0d, 0x7d, 0x98, 0x6a, 0x85, 0xc7, 0xa9, 0x86, 0x5e, 0x3f, 0x68, 0x85,
+	0xdb, 0x07, 0xad, 0x70, 0xf7, 0xa0, 0x15, 0x86, 0x65, 0xf9, 0xb5, 0x3d, 0xff, 0x34, 0x00, 0x97,
+	0x49, 0xed, 0xda, 0xc8, 0x05, 0x00, 0x00,
+}
+
+func (x ScheduledTask_ConcurrencyPolicy) String() string {
+	s, ok := ScheduledTask_ConcurrencyPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *ScheduledTask) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTask)
+	if !ok {
+		that2, ok := that.(ScheduledTask)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleGuid != that1.ScheduleGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.CronExpression != that1.CronExpression {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return false
+	}
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
+		return false
+	}
+	if len(this.RecentRuns) != len(that1.RecentRuns) {
+		return false
+	}
+	for i := range this.RecentRuns {
+		if !this.RecentRuns[i].Equal(that1.RecentRuns[i]) {
+			return false
+		}
+	}
+	if this.NextRunAt != that1.NextRunAt {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskRun) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskRun)
+	if !ok {
+		that2, ok := that.(ScheduledTaskRun)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if this.ScheduledAt != that1.ScheduledAt {
+		return false
+	}
+	if this.Skipped != that1.Skipped {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskUpdate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskUpdate)
+	if !ok {
+		that2, ok := that.(ScheduledTaskUpdate)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if that1.OptionalCronExpression == nil {
+		if this.OptionalCronExpression != nil {
+			return false
+		}
+	} else if this.OptionalCronExpression == nil {
+		return false
+	} else if !this.OptionalCronExpression.Equal(that1.OptionalCronExpression) {
+		return false
+	}
+	if that1.OptionalTimeZone == nil {
+		if this.OptionalTimeZone != nil {
+			return false
+		}
+	} else if this.OptionalTimeZone == nil {
+		return false
+	} else if !this.OptionalTimeZone.Equal(that1.OptionalTimeZone) {
+		return false
+	}
+	if that1.OptionalConcurrencyPolicy == nil {
+		if this.OptionalConcurrencyPolicy != nil {
+			return false
+		}
+	} else if this.OptionalConcurrencyPolicy == nil {
+		return false
+	} else if !this.OptionalConcurrencyPolicy.Equal(that1.OptionalConcurrencyPolicy) {
+		return false
+	}
+	if that1.OptionalHistoryLimit == nil {
+		if this.OptionalHistoryLimit != nil {
+			return false
+		}
+	} else if this.OptionalHistoryLimit == nil {
+		return false
+	} else if !this.OptionalHistoryLimit.Equal(that1.OptionalHistoryLimit) {
+		return false
+	}
+	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskUpdate_CronExpression) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskUpdate_CronExpression)
+	if !ok {
+		that2, ok := that.(ScheduledTaskUpdate_CronExpression)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CronExpression != that1.CronExpression {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskUpdate_TimeZone) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskUpdate_TimeZone)
+	if !ok {
+		that2, ok := that.(ScheduledTaskUpdate_TimeZone)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskUpdate_ConcurrencyPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskUpdate_ConcurrencyPolicy)
+	if !ok {
+		that2, ok := that.(ScheduledTaskUpdate_ConcurrencyPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskUpdate_HistoryLimit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskUpdate_HistoryLimit)
+	if !ok {
+		that2, ok := that.(ScheduledTaskUpdate_HistoryLimit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTask) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 16)
+	s = append(s, "&models.ScheduledTask{")
+	s = append(s, "ScheduleGuid: "+fmt.Sprintf("%#v", this.ScheduleGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "CronExpression: "+fmt.Sprintf("%#v", this.CronExpression)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "ConcurrencyPolicy: "+fmt.Sprintf("%#v", this.ConcurrencyPolicy)+",\n")
+	s = append(s, "HistoryLimit: "+fmt.Sprintf("%#v", this.HistoryLimit)+",\n")
+	s = append(s, "Suspended: "+fmt.Sprintf("%#v", this.Suspended)+",\n")
+	if this.TaskDefinition != nil {
+		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	}
+	if this.RecentRuns != nil {
+		s = append(s, "RecentRuns: "+fmt.Sprintf("%#v", this.RecentRuns)+",\n")
+	}
+	s = append(s, "NextRunAt: "+fmt.Sprintf("%#v", this.NextRunAt)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskRun) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.ScheduledTaskRun{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "ScheduledAt: "+fmt.Sprintf("%#v", this.ScheduledAt)+",\n")
+	s = append(s, "Skipped: "+fmt.Sprintf("%#v", this.Skipped)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskUpdate) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 9)
+	s = append(s, "&models.ScheduledTaskUpdate{")
+	if this.OptionalCronExpression != nil {
+		s = append(s, "OptionalCronExpression: "+fmt.Sprintf("%#v", this.OptionalCronExpression)+",\n")
+	}
+	if this.OptionalTimeZone != nil {
+		s = append(s, "OptionalTimeZone: "+fmt.Sprintf("%#v", this.OptionalTimeZone)+",\n")
+	}
+	if this.OptionalConcurrencyPolicy != nil {
+		s = append(s, "OptionalConcurrencyPolicy: "+fmt.Sprintf("%#v", this.OptionalConcurrencyPolicy)+",\n")
+	}
+	if this.OptionalHistoryLimit != nil {
+		s = append(s, "OptionalHistoryLimit: "+fmt.Sprintf("%#v", this.OptionalHistoryLimit)+",\n")
+	}
+	if this.TaskDefinition != nil {
+		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskUpdate_CronExpression) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.ScheduledTaskUpdate_CronExpression{` +
+		`CronExpression:` + fmt.Sprintf("%#v", this.CronExpression) + `}`}, ", ")
+	return s
+}
+func (this *ScheduledTaskUpdate_TimeZone) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.ScheduledTaskUpdate_TimeZone{` +
+		`TimeZone:` + fmt.Sprintf("%#v", this.TimeZone) + `}`}, ", ")
+	return s
+}
+func (this *ScheduledTaskUpdate_ConcurrencyPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.ScheduledTaskUpdate_ConcurrencyPolicy{` +
+		`ConcurrencyPolicy:` + fmt.Sprintf("%#v", this.ConcurrencyPolicy) + `}`}, ", ")
+	return s
+}
+func (this *ScheduledTaskUpdate_HistoryLimit) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&models.ScheduledTaskUpdate_HistoryLimit{` +
+		`HistoryLimit:` + fmt.Sprintf("%#v", this.HistoryLimit) + `}`}, ", ")
+	return s
+}
+func valueToGoStringScheduledTask(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.NextRunAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.NextRunAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RecentRuns) > 0 {
+		for iNdEx := len(m.RecentRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduledTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TaskDefinition != nil {
+		{
+			size, err := m.TaskDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HistoryLimit != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.HistoryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConcurrencyPolicy != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.ConcurrencyPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleGuid) > 0 {
+		i -= len(m.ScheduleGuid)
+		copy(dAtA[i:], m.ScheduleGuid)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.ScheduleGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Skipped {
+		i--
+		if m.Skipped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintScheduledTask(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskDefinition != nil {
+		{
+			size, err := m.TaskDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.OptionalHistoryLimit != nil {
+		{
+			size := m.OptionalHistoryLimit.Size()
+			i -= size
+			if _, err := m.OptionalHistoryLimit.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.OptionalConcurrencyPolicy != nil {
+		{
+			size := m.OptionalConcurrencyPolicy.Size()
+			i -= size
+			if _, err := m.OptionalConcurrencyPolicy.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.OptionalTimeZone != nil {
+		{
+			size := m.OptionalTimeZone.Size()
+			i -= size
+			if _, err := m.OptionalTimeZone.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.OptionalCronExpression != nil {
+		{
+			size := m.OptionalCronExpression.Size()
+			i -= size
+			if _, err := m.OptionalCronExpression.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskUpdate_CronExpression) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskUpdate_CronExpression) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.CronExpression)
+	copy(dAtA[i:], m.CronExpression)
+	i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.CronExpression)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *ScheduledTaskUpdate_TimeZone) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskUpdate_TimeZone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintScheduledTask(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *ScheduledTaskUpdate_ConcurrencyPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskUpdate_ConcurrencyPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintScheduledTask(dAtA, i, uint64(m.ConcurrencyPolicy))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *ScheduledTaskUpdate_HistoryLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskUpdate_HistoryLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintScheduledTask(dAtA, i, uint64(m.HistoryLimit))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
+}
+func encodeVarintScheduledTask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTask(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if m.ConcurrencyPolicy != 0 {
+		n += 1 + sovScheduledTask(uint64(m.ConcurrencyPolicy))
+	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovScheduledTask(uint64(m.HistoryLimit))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if len(m.RecentRuns) > 0 {
+		for _, e := range m.RecentRuns {
+			l = e.Size()
+			n += 1 + l + sovScheduledTask(uint64(l))
+		}
+	}
+	if m.NextRunAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.NextRunAt))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func (m *ScheduledTaskRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovScheduledTask(uint64(m.ScheduledAt))
+	}
+	if m.Skipped {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScheduledTaskUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OptionalCronExpression != nil {
+		n += m.OptionalCronExpression.Size()
+	}
+	if m.OptionalTimeZone != nil {
+		n += m.OptionalTimeZone.Size()
+	}
+	if m.OptionalConcurrencyPolicy != nil {
+		n += m.OptionalConcurrencyPolicy.Size()
+	}
+	if m.OptionalHistoryLimit != nil {
+		n += m.OptionalHistoryLimit.Size()
+	}
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovScheduledTask(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTaskUpdate_CronExpression) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CronExpression)
+	n += 1 + l + sovScheduledTask(uint64(l))
+	return n
+}
+func (m *ScheduledTaskUpdate_TimeZone) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TimeZone)
+	n += 1 + l + sovScheduledTask(uint64(l))
+	return n
+}
+func (m *ScheduledTaskUpdate_ConcurrencyPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovScheduledTask(uint64(m.ConcurrencyPolicy))
+	return n
+}
+func (m *ScheduledTaskUpdate_HistoryLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovScheduledTask(uint64(m.HistoryLimit))
+	return n
+}
+
+func sovScheduledTask(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTask(x uint64) (n int) {
+	return sovScheduledTask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRecentRuns := "[]*ScheduledTaskRun{"
+	for _, f := range this.RecentRuns {
+		repeatedStringForRecentRuns += strings.Replace(f.String(), "ScheduledTaskRun", "ScheduledTaskRun", 1) + ","
+	}
+	repeatedStringForRecentRuns += "}"
+	s := strings.Join([]string{`&ScheduledTask{`,
+		`ScheduleGuid:` + fmt.Sprintf("%v", this.ScheduleGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`CronExpression:` + fmt.Sprintf("%v", this.CronExpression) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`HistoryLimit:` + fmt.Sprintf("%v", this.HistoryLimit) + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
+		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
+		`RecentRuns:` + repeatedStringForRecentRuns + `,`,
+		`NextRunAt:` + fmt.Sprintf("%v", this.NextRunAt) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskRun) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskRun{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`ScheduledAt:` + fmt.Sprintf("%v", this.ScheduledAt) + `,`,
+		`Skipped:` + fmt.Sprintf("%v", this.Skipped) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskUpdate{`,
+		`OptionalCronExpression:` + fmt.Sprintf("%v", this.OptionalCronExpression) + `,`,
+		`OptionalTimeZone:` + fmt.Sprintf("%v", this.OptionalTimeZone) + `,`,
+		`OptionalConcurrencyPolicy:` + fmt.Sprintf("%v", this.OptionalConcurrencyPolicy) + `,`,
+		`OptionalHistoryLimit:` + fmt.Sprintf("%v", this.OptionalHistoryLimit) + `,`,
+		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskUpdate_CronExpression) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskUpdate_CronExpression{`,
+		`CronExpression:` + fmt.Sprintf("%v", this.CronExpression) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskUpdate_TimeZone) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskUpdate_TimeZone{`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskUpdate_ConcurrencyPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskUpdate_ConcurrencyPolicy{`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskUpdate_HistoryLimit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskUpdate_HistoryLimit{`,
+		`HistoryLimit:` + fmt.Sprintf("%v", this.HistoryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTask(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			m.ConcurrencyPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrencyPolicy |= ScheduledTask_ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			m.HistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentRuns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentRuns = append(m.RecentRuns, &ScheduledTaskRun{})
+			if err := m.RecentRuns[len(m.RecentRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunAt", wireType)
+			}
+			m.NextRunAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skipped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skipped = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalCronExpression = &ScheduledTaskUpdate_CronExpression{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OptionalTimeZone = &ScheduledTaskUpdate_TimeZone{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			var v ScheduledTask_ConcurrencyPolicy
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= ScheduledTask_ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalConcurrencyPolicy = &ScheduledTaskUpdate_ConcurrencyPolicy{v}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptionalHistoryLimit = &ScheduledTaskUpdate_HistoryLimit{v}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTask(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTask
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTask
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTask
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTask
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTask        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTask          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTask = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,64 @@
+package models
+
+func (request *ScheduledTasksRequest) Validate() error {
+	return nil
+}
+
+// ScheduledTask returns the schedule the request desires.
+func (request *DesireScheduledTaskRequest) ScheduledTask() *ScheduledTask {
+	return &ScheduledTask{
+		ScheduleGuid:      request.ScheduleGuid,
+		Domain:            request.Domain,
+		CronExpression:    request.CronExpression,
+		TimeZone:          request.TimeZone,
+		ConcurrencyPolicy: request.ConcurrencyPolicy,
+		HistoryLimit:      request.HistoryLimit,
+		TaskDefinition:    request.TaskDefinition,
+	}
+}
+
+func (request *DesireScheduledTaskRequest) Validate() error {
+	return request.ScheduledTask().Validate()
+}
+
+func (request *UpdateScheduledTaskRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.ScheduleGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"schedule_guid"})
+	}
+
+	if request.Update == nil {
+		validationError = validationError.Append(ErrInvalidField{"update"})
+	} else if err := request.Update.Validate(); err != nil {
+		validationError = validationError.Append(err)
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
+
+func (request *SuspendScheduledTaskRequest) Validate() error {
+	return validateScheduleGuid(request.ScheduleGuid)
+}
+
+func (request *DeleteScheduledTaskRequest) Validate() error {
+	return validateScheduleGuid(request.ScheduleGuid)
+}
+
+func validateScheduleGuid(scheduleGuid string) error {
+	var validationError ValidationError
+
+	if scheduleGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"schedule_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
@@ -0,0 +1,2502 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: scheduled_task_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ScheduledTaskResponse struct {
+	Error         *Error         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ScheduledTask *ScheduledTask `protobuf:"bytes,2,opt,name=scheduled_task,json=scheduledTask,proto3" json:"scheduled_task,omitempty"`
+}
+
+func (m *ScheduledTaskResponse) Reset()      { *m = ScheduledTaskResponse{} }
+func (*ScheduledTaskResponse) ProtoMessage() {}
+func (*ScheduledTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{0}
+}
+func (m *ScheduledTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskResponse.Merge(m, src)
+}
+func (m *ScheduledTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskResponse proto.InternalMessageInfo
+
+func (m *ScheduledTaskResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ScheduledTaskResponse) GetScheduledTask() *ScheduledTask {
+	if m != nil {
+		return m.ScheduledTask
+	}
+	return nil
+}
+
+type ScheduledTasksResponse struct {
+	Error          *Error           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	ScheduledTasks []*ScheduledTask `protobuf:"bytes,2,rep,name=scheduled_tasks,json=scheduledTasks,proto3" json:"scheduled_tasks,omitempty"`
+}
+
+func (m *ScheduledTasksResponse) Reset()      { *m = ScheduledTasksResponse{} }
+func (*ScheduledTasksResponse) ProtoMessage() {}
+func (*ScheduledTasksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{1}
+}
+func (m *ScheduledTasksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTasksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTasksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTasksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTasksResponse.Merge(m, src)
+}
+func (m *ScheduledTasksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTasksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTasksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTasksResponse proto.InternalMessageInfo
+
+func (m *ScheduledTasksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ScheduledTasksResponse) GetScheduledTasks() []*ScheduledTask {
+	if m != nil {
+		return m.ScheduledTasks
+	}
+	return nil
+}
+
+type ScheduledTaskLifecycleResponse struct {
+	Error *Error `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduledTaskLifecycleResponse) Reset()      { *m = ScheduledTaskLifecycleResponse{} }
+func (*ScheduledTaskLifecycleResponse) ProtoMessage() {}
+func (*ScheduledTaskLifecycleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{2}
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTaskLifecycleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTaskLifecycleResponse.Merge(m, src)
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTaskLifecycleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTaskLifecycleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTaskLifecycleResponse proto.InternalMessageInfo
+
+func (m *ScheduledTaskLifecycleResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+type ScheduledTasksRequest struct {
+	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+}
+
+func (m *ScheduledTasksRequest) Reset()      { *m = ScheduledTasksRequest{} }
+func (*ScheduledTasksRequest) ProtoMessage() {}
+func (*ScheduledTasksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{3}
+}
+func (m *ScheduledTasksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledTasksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledTasksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledTasksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledTasksRequest.Merge(m, src)
+}
+func (m *ScheduledTasksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledTasksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledTasksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledTasksRequest proto.InternalMessageInfo
+
+func (m *ScheduledTasksRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type DesireScheduledTaskRequest struct {
+	ScheduleGuid      string                          `protobuf:"bytes,1,opt,name=schedule_guid,json=scheduleGuid,proto3" json:"schedule_guid"`
+	Domain            string                          `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain"`
+	CronExpression    string                          `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression"`
+	TimeZone          string                          `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+	ConcurrencyPolicy ScheduledTask_ConcurrencyPolicy `protobuf:"varint,5,opt,name=concurrency_policy,json=concurrencyPolicy,proto3,enum=models.ScheduledTask_ConcurrencyPolicy" json:"concurrency_policy"`
+	HistoryLimit      int32                           `protobuf:"varint,6,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit"`
+	TaskDefinition    *TaskDefinition                 `protobuf:"bytes,7,opt,name=task_definition,json=taskDefinition,proto3" json:"task_definition,omitempty"`
+}
+
+func (m *DesireScheduledTaskRequest) Reset()      { *m = DesireScheduledTaskRequest{} }
+func (*DesireScheduledTaskRequest) ProtoMessage() {}
+func (*DesireScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{4}
+}
+func (m *DesireScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DesireScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DesireScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DesireScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesireScheduledTaskRequest.Merge(m, src)
+}
+func (m *DesireScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DesireScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesireScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesireScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *DesireScheduledTaskRequest) GetScheduleGuid() string {
+	if m != nil {
+		return m.ScheduleGuid
+	}
+	return ""
+}
+
+func (m *DesireScheduledTaskRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *DesireScheduledTaskRequest) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *DesireScheduledTaskRequest) GetTimeZone() string {
+	if m != nil {
+		return m.TimeZone
+	}
+	return ""
+}
+
+func (m *DesireScheduledTaskRequest) GetConcurrencyPolicy() ScheduledTask_ConcurrencyPolicy {
+	if m != nil {
+		return m.ConcurrencyPolicy
+	}
+	return ScheduledTask_Allow
+}
+
+func (m *DesireScheduledTaskRequest) GetHistoryLimit() int32 {
+	if m != nil {
+		return m.HistoryLimit
+	}
+	return 0
+}
+
+func (m *DesireScheduledTaskRequest) GetTaskDefinition() *TaskDefinition {
+	if m != nil {
+		return m.TaskDefinition
+	}
+	return nil
+}
+
+type UpdateScheduledTaskRequest struct {
+	ScheduleGuid string               `protobuf:"bytes,1,opt,name=schedule_guid,json=scheduleGuid,proto3" json:"schedule_guid"`
+	Update       *ScheduledTaskUpdate `protobuf:"bytes,2,opt,name=update,proto3" json:"update,omitempty"`
+}
+
+func (m *UpdateScheduledTaskRequest) Reset()      { *m = UpdateScheduledTaskRequest{} }
+func (*UpdateScheduledTaskRequest) ProtoMessage() {}
+func (*UpdateScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{5}
+}
+func (m *UpdateScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScheduledTaskRequest.Merge(m, src)
+}
+func (m *UpdateScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *UpdateScheduledTaskRequest) GetScheduleGuid() string {
+	if m != nil {
+		return m.ScheduleGuid
+	}
+	return ""
+}
+
+func (m *UpdateScheduledTaskRequest) GetUpdate() *ScheduledTaskUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+type SuspendScheduledTaskRequest struct {
+	ScheduleGuid string `protobuf:"bytes,1,opt,name=schedule_guid,json=scheduleGuid,proto3" json:"schedule_guid"`
+	Suspended    bool   `protobuf:"varint,2,opt,name=suspended,proto3" json:"suspended"`
+}
+
+func (m *SuspendScheduledTaskRequest) Reset()      { *m = SuspendScheduledTaskRequest{} }
+func (*SuspendScheduledTaskRequest) ProtoMessage() {}
+func (*SuspendScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{6}
+}
+func (m *SuspendScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SuspendScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SuspendScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SuspendScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SuspendScheduledTaskRequest.Merge(m, src)
+}
+func (m *SuspendScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SuspendScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SuspendScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SuspendScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *SuspendScheduledTaskRequest) GetScheduleGuid() string {
+	if m != nil {
+		return m.ScheduleGuid
+	}
+	return ""
+}
+
+func (m *SuspendScheduledTaskRequest) GetSuspended() bool {
+	if m != nil {
+		return m.Suspended
+	}
+	return false
+}
+
+type DeleteScheduledTaskRequest struct {
+	ScheduleGuid string `protobuf:"bytes,1,opt,name=schedule_guid,json=scheduleGuid,proto3" json:"schedule_guid"`
+}
+
+func (m *DeleteScheduledTaskRequest) Reset()      { *m = DeleteScheduledTaskRequest{} }
+func (*DeleteScheduledTaskRequest) ProtoMessage() {}
+func (*DeleteScheduledTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_58b4307d74d26a9f, []int{7}
+}
+func (m *DeleteScheduledTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteScheduledTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteScheduledTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteScheduledTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScheduledTaskRequest.Merge(m, src)
+}
+func (m *DeleteScheduledTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteScheduledTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScheduledTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScheduledTaskRequest proto.InternalMessageInfo
+
+func (m *DeleteScheduledTaskRequest) GetScheduleGuid() string {
+	if m != nil {
+		return m.ScheduleGuid
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ScheduledTaskResponse)(nil), "models.ScheduledTaskResponse")
+	proto.RegisterType((*ScheduledTasksResponse)(nil), "models.ScheduledTasksResponse")
+	proto.RegisterType((*ScheduledTaskLifecycleResponse)(nil), "models.ScheduledTaskLifecycleResponse")
+	proto.RegisterType((*ScheduledTasksRequest)(nil), "models.ScheduledTasksRequest")
+	proto.RegisterType((*DesireScheduledTaskRequest)(nil), "models.DesireScheduledTaskRequest")
+	proto.RegisterType((*UpdateScheduledTaskRequest)(nil), "models.UpdateScheduledTaskRequest")
+	proto.RegisterType((*SuspendScheduledTaskRequest)(nil), "models.SuspendScheduledTaskRequest")
+	proto.RegisterType((*DeleteScheduledTaskRequest)(nil), "models.DeleteScheduledTaskRequest")
+}
+
+func init() { proto.RegisterFile("scheduled_task_requests.proto", fileDescriptor_58b4307d74d26a9f) }
+
+var fileDescriptor_58b4307d74d26a9f = []byte{
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xf5, 0xb5, 0x34, 0xb4, 0xd7, 0x36, 0x55, 0x5d, 0x5a, 0xac, 0x54, 0x9c, 0x23, 0x33, 0x10,
+	0x09, 0x48, 0xa5, 0xb6, 0x62, 0xa1, 0x02, 0x29, 0x34, 0x62, 0xe9, 0x80, 0xae, 0x65, 0x61, 0xb1,
+	0x12, 0xfb, 0x92, 0x9c, 0x6a, 0xfb, 0x8c, 0xef, 0x2c, 0x91, 0x4a, 0x48, 0x88, 0x8d, 0x8d, 0x9f,
+	0xc1, 0x4f, 0x61, 0xcc, 0x82, 0xd4, 0xc9, 0x22, 0xce, 0x82, 0x3c, 0xf5, 0x27, 0x20, 0x9f, 0x9d,
+	0xa4, 0x8e, 0xd2, 0xa1, 0x52, 0xa6, 0xdc, 0xfb, 0xbe, 0x77, 0xef, 0x7d, 0x7a, 0x9f, 0x2f, 0xf0,
+	0x09, 0xb7, 0x7a, 0xc4, 0x0e, 0x1d, 0x62, 0x9b, 0xa2, 0xc5, 0x2f, 0xcd, 0x80, 0x7c, 0x0e, 0x09,
+	0x17, 0xbc, 0xee, 0x07, 0x4c, 0x30, 0xb5, 0xe4, 0x32, 0x9b, 0x38, 0xbc, 0xf2, 0xb2, 0x4b, 0x45,
+	0x2f, 0x6c, 0xd7, 0x2d, 0xe6, 0x1e, 0x74, 0x59, 0x97, 0x1d, 0xc8, 0x76, 0x3b, 0xec, 0x48, 0x24,
+	0x81, 0x3c, 0x65, 0xd7, 0x2a, 0xeb, 0x24, 0x08, 0x58, 0x90, 0x83, 0x47, 0x45, 0x8b, 0xbc, 0x0a,
+	0xa7, 0x67, 0xe3, 0x0a, 0xee, 0x9e, 0x8f, 0x39, 0x17, 0x2d, 0x7e, 0x89, 0x09, 0xf7, 0x99, 0xc7,
+	0x89, 0xfa, 0x14, 0xae, 0x48, 0x25, 0x0d, 0x54, 0x41, 0x6d, 0xfd, 0x70, 0xb3, 0x9e, 0x8d, 0x53,
+	0x6f, 0xa6, 0x45, 0x9c, 0xf5, 0xd4, 0x13, 0x58, 0x2e, 0x3a, 0x68, 0x4b, 0x92, 0xbd, 0x3b, 0x66,
+	0x17, 0xb5, 0x37, 0xf9, 0x6d, 0x68, 0x7c, 0x85, 0x7b, 0x85, 0x3e, 0xbf, 0x9f, 0xf9, 0x1b, 0xb8,
+	0x55, 0x34, 0xe7, 0xda, 0x52, 0x75, 0xf9, 0x6e, 0xf7, 0x72, 0xc1, 0x9d, 0x1b, 0x4d, 0x88, 0x0a,
+	0x84, 0x33, 0xda, 0x21, 0x56, 0xdf, 0x72, 0xc8, 0xbd, 0xc6, 0x30, 0x5e, 0xcf, 0x24, 0xc8, 0x71,
+	0xb6, 0x47, 0xd5, 0x80, 0x25, 0x9b, 0xb9, 0x2d, 0xea, 0xc9, 0xeb, 0x6b, 0x0d, 0x98, 0x44, 0x7a,
+	0x5e, 0xc1, 0xf9, 0xaf, 0xf1, 0x67, 0x19, 0x56, 0x4e, 0x09, 0xa7, 0x01, 0x99, 0xd9, 0x42, 0x26,
+	0xf1, 0x0a, 0x4e, 0x22, 0x33, 0xbb, 0x21, 0xb5, 0x73, 0xa5, 0xed, 0x24, 0xd2, 0x8b, 0x0d, 0xbc,
+	0x31, 0x86, 0xef, 0x43, 0x6a, 0xdf, 0xb2, 0x5e, 0xba, 0xcb, 0x5a, 0x3d, 0x81, 0x5b, 0x56, 0xc0,
+	0x3c, 0x93, 0x7c, 0xf1, 0x03, 0xc2, 0x39, 0x65, 0x9e, 0xb6, 0x2c, 0xc9, 0x3b, 0x49, 0xa4, 0xcf,
+	0xb6, 0x70, 0x39, 0x2d, 0x34, 0x27, 0x58, 0x3d, 0x86, 0x6b, 0x82, 0xba, 0xc4, 0xbc, 0x62, 0x1e,
+	0xd1, 0x1e, 0xc8, 0x7b, 0x8f, 0x93, 0x48, 0xdf, 0x99, 0x14, 0x5f, 0x30, 0x97, 0x0a, 0xe2, 0xfa,
+	0xa2, 0x8f, 0x57, 0xd3, 0xe2, 0x27, 0xe6, 0x11, 0xd5, 0x83, 0xaa, 0xc5, 0x3c, 0x2b, 0x0c, 0x02,
+	0xe2, 0x59, 0x7d, 0xd3, 0x67, 0x0e, 0xb5, 0xfa, 0xda, 0x4a, 0x15, 0xd4, 0xca, 0x87, 0xcf, 0xe6,
+	0x6e, 0xad, 0xfe, 0x6e, 0xca, 0xff, 0x20, 0xe9, 0x8d, 0xbd, 0x24, 0xd2, 0xe7, 0xc8, 0xe0, 0x6d,
+	0x6b, 0x96, 0x9a, 0xe6, 0xd7, 0xa3, 0x5c, 0xb0, 0xa0, 0x6f, 0x3a, 0xd4, 0xa5, 0x42, 0x2b, 0x55,
+	0x41, 0x6d, 0x25, 0xcb, 0xaf, 0xd0, 0xc0, 0x1b, 0x39, 0x3c, 0x4b, 0x91, 0xfa, 0x16, 0x6e, 0xc9,
+	0x27, 0x69, 0x93, 0x0e, 0xf5, 0xa8, 0x48, 0xb3, 0x79, 0x28, 0x3f, 0x81, 0xbd, 0xf1, 0x90, 0xe9,
+	0x6c, 0xa7, 0x93, 0x2e, 0x2e, 0x8b, 0x02, 0x36, 0x7e, 0x00, 0x58, 0xf9, 0xe8, 0xdb, 0x2d, 0xb1,
+	0xd8, 0xbd, 0x1e, 0xc1, 0x52, 0x28, 0x55, 0xf3, 0x77, 0xb6, 0x3f, 0x37, 0xb3, 0xcc, 0x18, 0xe7,
+	0x54, 0xe3, 0x3b, 0x80, 0xfb, 0xe7, 0x21, 0xf7, 0x89, 0x67, 0x2f, 0x74, 0x98, 0xe7, 0x70, 0x8d,
+	0x67, 0xb2, 0xc4, 0x96, 0xf3, 0xac, 0x36, 0x36, 0x93, 0x48, 0x9f, 0x16, 0xf1, 0xf4, 0x68, 0x5c,
+	0xa4, 0xdf, 0xb9, 0x43, 0x16, 0x9b, 0x47, 0xe3, 0x78, 0x30, 0x44, 0xca, 0xf5, 0x10, 0x29, 0x37,
+	0x43, 0x04, 0xbe, 0xc5, 0x08, 0xfc, 0x8a, 0x11, 0xf8, 0x1d, 0x23, 0x30, 0x88, 0x11, 0xf8, 0x1b,
+	0x23, 0xf0, 0x2f, 0x46, 0xca, 0x4d, 0x8c, 0xc0, 0xcf, 0x11, 0x52, 0x06, 0x23, 0xa4, 0x5c, 0x8f,
+	0x90, 0xd2, 0x2e, 0xc9, 0xbf, 0xbe, 0xa3, 0xff, 0x03, 0x00, 0xf4, 0xea, 0x53, 0x9a, 0x81, 0x05,
+	0x00, 0x00,
+}
+
+func (this *ScheduledTaskResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTaskResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.ScheduledTask.Equal(that1.ScheduledTask) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTasksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTasksResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTasksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.ScheduledTasks) != len(that1.ScheduledTasks) {
+		return false
+	}
+	for i := range this.ScheduledTasks {
+		if !this.ScheduledTasks[i].Equal(that1.ScheduledTasks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ScheduledTaskLifecycleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTaskLifecycleResponse)
+	if !ok {
+		that2, ok := that.(ScheduledTaskLifecycleResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTasksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledTasksRequest)
+	if !ok {
+		that2, ok := that.(ScheduledTasksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	return true
+}
+func (this *DesireScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DesireScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(DesireScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleGuid != that1.ScheduleGuid {
+		return false
+	}
+	if this.Domain != that1.Domain {
+		return false
+	}
+	if this.CronExpression != that1.CronExpression {
+		return false
+	}
+	if this.TimeZone != that1.TimeZone {
+		return false
+	}
+	if this.ConcurrencyPolicy != that1.ConcurrencyPolicy {
+		return false
+	}
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
+	}
+	if !this.TaskDefinition.Equal(that1.TaskDefinition) {
+		return false
+	}
+	return true
+}
+func (this *UpdateScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpdateScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(UpdateScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleGuid != that1.ScheduleGuid {
+		return false
+	}
+	if !this.Update.Equal(that1.Update) {
+		return false
+	}
+	return true
+}
+func (this *SuspendScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SuspendScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(SuspendScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleGuid != that1.ScheduleGuid {
+		return false
+	}
+	if this.Suspended != that1.Suspended {
+		return false
+	}
+	return true
+}
+func (this *DeleteScheduledTaskRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DeleteScheduledTaskRequest)
+	if !ok {
+		that2, ok := that.(DeleteScheduledTaskRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ScheduleGuid != that1.ScheduleGuid {
+		return false
+	}
+	return true
+}
+func (this *ScheduledTaskResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ScheduledTaskResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ScheduledTask != nil {
+		s = append(s, "ScheduledTask: "+fmt.Sprintf("%#v", this.ScheduledTask)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTasksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ScheduledTasksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.ScheduledTasks != nil {
+		s = append(s, "ScheduledTasks: "+fmt.Sprintf("%#v", this.ScheduledTasks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTaskLifecycleResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ScheduledTaskLifecycleResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ScheduledTasksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ScheduledTasksRequest{")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DesireScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 11)
+	s = append(s, "&models.DesireScheduledTaskRequest{")
+	s = append(s, "ScheduleGuid: "+fmt.Sprintf("%#v", this.ScheduleGuid)+",\n")
+	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
+	s = append(s, "CronExpression: "+fmt.Sprintf("%#v", this.CronExpression)+",\n")
+	s = append(s, "TimeZone: "+fmt.Sprintf("%#v", this.TimeZone)+",\n")
+	s = append(s, "ConcurrencyPolicy: "+fmt.Sprintf("%#v", this.ConcurrencyPolicy)+",\n")
+	s = append(s, "HistoryLimit: "+fmt.Sprintf("%#v", this.HistoryLimit)+",\n")
+	if this.TaskDefinition != nil {
+		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *UpdateScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.UpdateScheduledTaskRequest{")
+	s = append(s, "ScheduleGuid: "+fmt.Sprintf("%#v", this.ScheduleGuid)+",\n")
+	if this.Update != nil {
+		s = append(s, "Update: "+fmt.Sprintf("%#v", this.Update)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *SuspendScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.SuspendScheduledTaskRequest{")
+	s = append(s, "ScheduleGuid: "+fmt.Sprintf("%#v", this.ScheduleGuid)+",\n")
+	s = append(s, "Suspended: "+fmt.Sprintf("%#v", this.Suspended)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *DeleteScheduledTaskRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.DeleteScheduledTaskRequest{")
+	s = append(s, "ScheduleGuid: "+fmt.Sprintf("%#v", this.ScheduleGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringScheduledTaskRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *ScheduledTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScheduledTask != nil {
+		{
+			size, err := m.ScheduledTask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTasksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTasksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduledTasks) > 0 {
+		for iNdEx := len(m.ScheduledTasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledTasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTaskLifecycleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTaskLifecycleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTaskLifecycleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledTasksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledTasksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledTasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DesireScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DesireScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DesireScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskDefinition != nil {
+		{
+			size, err := m.TaskDefinition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HistoryLimit != 0 {
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(m.HistoryLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConcurrencyPolicy != 0 {
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(m.ConcurrencyPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TimeZone) > 0 {
+		i -= len(m.TimeZone)
+		copy(dAtA[i:], m.TimeZone)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.TimeZone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Domain) > 0 {
+		i -= len(m.Domain)
+		copy(dAtA[i:], m.Domain)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.Domain)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleGuid) > 0 {
+		i -= len(m.ScheduleGuid)
+		copy(dAtA[i:], m.ScheduleGuid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.ScheduleGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Update != nil {
+		{
+			size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ScheduleGuid) > 0 {
+		i -= len(m.ScheduleGuid)
+		copy(dAtA[i:], m.ScheduleGuid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.ScheduleGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SuspendScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SuspendScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SuspendScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Suspended {
+		i--
+		if m.Suspended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ScheduleGuid) > 0 {
+		i -= len(m.ScheduleGuid)
+		copy(dAtA[i:], m.ScheduleGuid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.ScheduleGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeleteScheduledTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeleteScheduledTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeleteScheduledTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ScheduleGuid) > 0 {
+		i -= len(m.ScheduleGuid)
+		copy(dAtA[i:], m.ScheduleGuid)
+		i = encodeVarintScheduledTaskRequests(dAtA, i, uint64(len(m.ScheduleGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduledTaskRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduledTaskRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if m.ScheduledTask != nil {
+		l = m.ScheduledTask.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTasksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if len(m.ScheduledTasks) > 0 {
+		for _, e := range m.ScheduledTasks {
+			l = e.Size()
+			n += 1 + l + sovScheduledTaskRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduledTaskLifecycleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledTasksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *DesireScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	l = len(m.Domain)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	l = len(m.TimeZone)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if m.ConcurrencyPolicy != 0 {
+		n += 1 + sovScheduledTaskRequests(uint64(m.ConcurrencyPolicy))
+	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovScheduledTaskRequests(uint64(m.HistoryLimit))
+	}
+	if m.TaskDefinition != nil {
+		l = m.TaskDefinition.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if m.Update != nil {
+		l = m.Update.Size()
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *SuspendScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	if m.Suspended {
+		n += 2
+	}
+	return n
+}
+
+func (m *DeleteScheduledTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ScheduleGuid)
+	if l > 0 {
+		n += 1 + l + sovScheduledTaskRequests(uint64(l))
+	}
+	return n
+}
+
+func sovScheduledTaskRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduledTaskRequests(x uint64) (n int) {
+	return sovScheduledTaskRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *ScheduledTaskResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ScheduledTask:` + strings.Replace(fmt.Sprintf("%v", this.ScheduledTask), "ScheduledTask", "ScheduledTask", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTasksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForScheduledTasks := "[]*ScheduledTask{"
+	for _, f := range this.ScheduledTasks {
+		repeatedStringForScheduledTasks += strings.Replace(fmt.Sprintf("%v", f), "ScheduledTask", "ScheduledTask", 1) + ","
+	}
+	repeatedStringForScheduledTasks += "}"
+	s := strings.Join([]string{`&ScheduledTasksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`ScheduledTasks:` + repeatedStringForScheduledTasks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTaskLifecycleResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTaskLifecycleResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ScheduledTasksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ScheduledTasksRequest{`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DesireScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DesireScheduledTaskRequest{`,
+		`ScheduleGuid:` + fmt.Sprintf("%v", this.ScheduleGuid) + `,`,
+		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
+		`CronExpression:` + fmt.Sprintf("%v", this.CronExpression) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`ConcurrencyPolicy:` + fmt.Sprintf("%v", this.ConcurrencyPolicy) + `,`,
+		`HistoryLimit:` + fmt.Sprintf("%v", this.HistoryLimit) + `,`,
+		`TaskDefinition:` + strings.Replace(fmt.Sprintf("%v", this.TaskDefinition), "TaskDefinition", "TaskDefinition", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *UpdateScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&UpdateScheduledTaskRequest{`,
+		`ScheduleGuid:` + fmt.Sprintf("%v", this.ScheduleGuid) + `,`,
+		`Update:` + strings.Replace(fmt.Sprintf("%v", this.Update), "ScheduledTaskUpdate", "ScheduledTaskUpdate", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SuspendScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SuspendScheduledTaskRequest{`,
+		`ScheduleGuid:` + fmt.Sprintf("%v", this.ScheduleGuid) + `,`,
+		`Suspended:` + fmt.Sprintf("%v", this.Suspended) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DeleteScheduledTaskRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DeleteScheduledTaskRequest{`,
+		`ScheduleGuid:` + fmt.Sprintf("%v", this.ScheduleGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringScheduledTaskRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *ScheduledTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScheduledTask == nil {
+				m.ScheduledTask = &ScheduledTask{}
+			}
+			if err := m.ScheduledTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTasksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTasksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTasksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledTasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledTasks = append(m.ScheduledTasks, &ScheduledTask{})
+			if err := m.ScheduledTasks[len(m.ScheduledTasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTaskLifecycleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTaskLifecycleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTaskLifecycleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledTasksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledTasksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledTasksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DesireScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DesireScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DesireScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Domain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencyPolicy", wireType)
+			}
+			m.ConcurrencyPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConcurrencyPolicy |= ScheduledTask_ConcurrencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			m.HistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Update == nil {
+				m.Update = &ScheduledTaskUpdate{}
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SuspendScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SuspendScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SuspendScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Suspended = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteScheduledTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteScheduledTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteScheduledTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduleGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduledTaskRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduledTaskRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduledTaskRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduledTaskRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduledTaskRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduledTaskRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduledTaskRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduledTaskRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduledTaskRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduledTaskRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduledTaskRequests = fmt.Errorf("proto: unexpected end of group")
+)
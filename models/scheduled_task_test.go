@@ -0,0 +1,143 @@
+package models_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/models/test/model_helpers"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduledTask", func() {
+	var schedule *models.ScheduledTask
+
+	BeforeEach(func() {
+		schedule = &models.ScheduledTask{
+			ScheduleGuid:   "nightly",
+			Domain:         "some-domain",
+			CronExpression: "0 2 * * *",
+			TaskDefinition: model_helpers.NewValidTaskDefinition(),
+		}
+	})
+
+	Describe("Validate", func() {
+		It("is valid", func() {
+			Expect(schedule.Validate()).To(Succeed())
+		})
+
+		DescribeTable("invalid fields",
+			func(modify func(), field string) {
+				modify()
+				err := schedule.Validate()
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(field))
+			},
+			Entry("schedule guid", func() { schedule.ScheduleGuid = "not/valid" }, "schedule_guid"),
+			Entry("domain", func() { schedule.Domain = "" }, "domain"),
+			Entry("cron expression", func() { schedule.CronExpression = "0 25 * * *" }, "cron_expression"),
+			Entry("time zone", func() { schedule.TimeZone = "Mars/Olympus_Mons" }, "time_zone"),
+			Entry("concurrency policy", func() { schedule.ConcurrencyPolicy = 7 }, "concurrency_policy"),
+			Entry("history limit", func() { schedule.HistoryLimit = models.MaximumScheduledTaskHistoryLimit + 1 }, "history_limit"),
+			Entry("task definition", func() { schedule.TaskDefinition = nil }, "task_definition"),
+			Entry("dependencies", func() { schedule.TaskDefinition.DependsOn = []string{"parent"} }, "task_definition.depends_on"),
+		)
+	})
+
+	Describe("NextRunAfter", func() {
+		It("evaluates the cron expression in the time zone of the schedule", func() {
+			schedule.TimeZone = "America/New_York"
+
+			next, err := schedule.NextRunAfter(time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2026, 7, 2, 6, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("TaskGuidForRun", func() {
+		It("appends the unix time of the run to the schedule guid", func() {
+			scheduledAt := time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC).UnixNano()
+			Expect(schedule.TaskGuidForRun(scheduledAt)).To(Equal("nightly-1772330400"))
+		})
+	})
+
+	Describe("RecordRun", func() {
+		It("keeps the most recent runs up to the history limit", func() {
+			schedule.HistoryLimit = 2
+			for i := int64(1); i <= 3; i++ {
+				schedule.RecordRun(&models.ScheduledTaskRun{TaskGuid: "run", ScheduledAt: i})
+			}
+
+			Expect(schedule.RecentRuns).To(HaveLen(2))
+			Expect(schedule.RecentRuns[0].ScheduledAt).To(BeEquivalentTo(3))
+			Expect(schedule.RecentRuns[1].ScheduledAt).To(BeEquivalentTo(2))
+		})
+
+		It("applies the default history limit", func() {
+			for i := 0; i < models.DefaultScheduledTaskHistoryLimit+5; i++ {
+				schedule.RecordRun(&models.ScheduledTaskRun{TaskGuid: "run"})
+			}
+			Expect(schedule.RecentRuns).To(HaveLen(models.DefaultScheduledTaskHistoryLimit))
+		})
+	})
+})
+
+var _ = Describe("ScheduledTaskUpdate", func() {
+	var schedule *models.ScheduledTask
+
+	BeforeEach(func() {
+		schedule = &models.ScheduledTask{
+			ScheduleGuid:   "nightly",
+			CronExpression: "0 2 * * *",
+		}
+	})
+
+	Describe("Apply", func() {
+		It("reports a reschedule when the cron expression or time zone change", func() {
+			update := &models.ScheduledTaskUpdate{}
+			update.SetTimeZone("Europe/Berlin")
+			Expect(update.Apply(schedule)).To(BeTrue())
+			Expect(schedule.TimeZone).To(Equal("Europe/Berlin"))
+		})
+
+		It("does not report a reschedule for other changes", func() {
+			update := &models.ScheduledTaskUpdate{}
+			update.SetConcurrencyPolicy(models.ScheduledTask_Replace)
+			Expect(update.Apply(schedule)).To(BeFalse())
+			Expect(schedule.ConcurrencyPolicy).To(Equal(models.ScheduledTask_Replace))
+		})
+
+		It("trims the recent runs to a lowered history limit", func() {
+			schedule.RecentRuns = []*models.ScheduledTaskRun{{TaskGuid: "a"}, {TaskGuid: "b"}, {TaskGuid: "c"}}
+			update := &models.ScheduledTaskUpdate{}
+			update.SetHistoryLimit(1)
+			update.Apply(schedule)
+			Expect(schedule.RecentRuns).To(Equal([]*models.ScheduledTaskRun{{TaskGuid: "a"}}))
+		})
+	})
+
+	Describe("Validate", func() {
+		It("rejects invalid cron expressions", func() {
+			update := &models.ScheduledTaskUpdate{}
+			update.SetCronExpression("whenever")
+			Expect(update.Validate()).To(MatchError(ContainSubstring("cron_expression")))
+		})
+	})
+
+	Describe("JSON", func() {
+		It("round trips the fields that are set", func() {
+			update := &models.ScheduledTaskUpdate{}
+			update.SetCronExpression("@hourly")
+			update.SetConcurrencyPolicy(models.ScheduledTask_Forbid)
+
+			data, err := json.Marshal(update)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(data).To(MatchJSON(`{"cron_expression":"@hourly","concurrency_policy":"Forbid"}`))
+
+			decoded := &models.ScheduledTaskUpdate{}
+			Expect(json.Unmarshal(data, decoded)).To(Succeed())
+			Expect(decoded).To(Equal(update))
+		})
+	})
+})
@@ -3,8 +3,11 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"math"
+	"math/rand"
 	"net/url"
 	"regexp"
+	"time"
 
 	"code.cloudfoundry.org/bbs/format"
 	"code.cloudfoundry.org/lager/v3"
@@ -12,14 +15,21 @@ import (
 
 var taskGuidPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 
+// MaximumTaskRetryAttempts bounds the attempts of a retry policy, which also
+// bounds the failed attempts recorded on a task.
+const MaximumTaskRetryAttempts = 100
+
 type TaskChange struct {
 	Before *Task
 	After  *Task
 }
 
 type TaskFilter struct {
-	Domain string
-	CellID string
+	Domain        string
+	CellID        string
+	PageSize      int32
+	PageToken     string
+	LabelSelector []*LabelSelectorRequirement
 }
 
 func (t *Task) LagerData() lager.Data {
@@ -147,6 +157,10 @@ func (def *TaskDefinition) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"image_password"})
 	}
 
+	validationError = validationError.Append(validateLabels(def.Labels))
+	validationError = validationError.Append(validateDependsOn(def.DependsOn))
+	validationError = validationError.Append(def.RetryPolicy.validate())
+
 	err := validateCachedDependencies(def.CachedDependencies)
 	if err != nil {
 		validationError = validationError.Append(err)
@@ -164,6 +178,124 @@ func (def *TaskDefinition) Validate() error {
 	return nil
 }
 
+func validateDependsOn(parentGuids []string) ValidationError {
+	var validationError ValidationError
+
+	seen := map[string]struct{}{}
+	for _, guid := range parentGuids {
+		if _, duplicate := seen[guid]; duplicate || !taskGuidPattern.MatchString(guid) {
+			validationError = validationError.Append(ErrInvalidField{"depends_on"})
+			break
+		}
+		seen[guid] = struct{}{}
+	}
+
+	return validationError
+}
+
+// HasDependencies reports whether the task has to wait for parent tasks to
+// complete before it can be auctioned.
+func (t *TaskDefinition) HasDependencies() bool {
+	return t != nil && len(t.DependsOn) > 0
+}
+
+func (p *TaskRetryPolicy) validate() ValidationError {
+	var validationError ValidationError
+	if p == nil {
+		return validationError
+	}
+
+	if p.MaxAttempts < 1 || p.MaxAttempts > MaximumTaskRetryAttempts {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.max_attempts"})
+	}
+
+	if p.InitialBackoffMs < 0 {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.initial_backoff_ms"})
+	}
+
+	if p.MaxBackoffMs < 0 || (p.MaxBackoffMs > 0 && p.MaxBackoffMs < p.InitialBackoffMs) {
+		validationError = validationError.Append(ErrInvalidField{"retry_policy.max_backoff_ms"})
+	}
+
+	for _, pattern := range p.RetryableFailureReasons {
+		if _, err := regexp.Compile(pattern); err != nil {
+			validationError = validationError.Append(ErrInvalidField{"retry_policy.retryable_failure_reasons"})
+			break
+		}
+	}
+
+	return validationError
+}
+
+// retryable reports whether a failure with the given reason may be retried.
+// Without patterns every failure may be retried.
+func (p *TaskRetryPolicy) retryable(failureReason string) bool {
+	if len(p.RetryableFailureReasons) == 0 {
+		return true
+	}
+
+	for _, pattern := range p.RetryableFailureReasons {
+		matched, err := regexp.MatchString(pattern, failureReason)
+		if err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns how long to wait before the given attempt, doubling the
+// initial backoff for every attempt after the second, and spreads it over its
+// upper half so that tasks failing together, such as those of a cell that
+// disappeared, are not retried together.
+func (p *TaskRetryPolicy) backoff(attempt int) time.Duration {
+	backoff := time.Duration(p.InitialBackoffMs) * time.Millisecond
+	maxBackoff := time.Duration(p.MaxBackoffMs) * time.Millisecond
+	for i := 2; i < attempt; i++ {
+		if (maxBackoff > 0 && backoff >= maxBackoff) || backoff > math.MaxInt64/2 {
+			break
+		}
+		backoff *= 2
+	}
+
+	if maxBackoff > 0 && backoff > maxBackoff {
+		backoff = maxBackoff
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// RetryBackoff reports whether the retry policy of the task allows another
+// attempt after the current one failed with failureReason, and how long to
+// wait before that attempt.
+func (t *Task) RetryBackoff(failureReason string) (time.Duration, bool) {
+	policy := t.TaskDefinition.GetRetryPolicy()
+	if policy == nil {
+		return 0, false
+	}
+
+	nextAttempt := len(t.FailedAttempts) + 2
+	if nextAttempt > int(policy.MaxAttempts) || !policy.retryable(failureReason) {
+		return 0, false
+	}
+
+	return policy.backoff(nextAttempt), true
+}
+
+// Retry records the failure of the current attempt and puts the task back to
+// waiting until retryAt, when convergence makes it pending again.
+func (t *Task) Retry(failureReason string, now, retryAt int64) {
+	t.FailedAttempts = append(t.FailedAttempts, &TaskAttempt{
+		CellId:        t.CellId,
+		FailureReason: failureReason,
+		FailedAt:      now,
+	})
+	t.State = Task_Waiting
+	t.RetryAt = retryAt
+	t.CellId = ""
+	t.UpdatedAt = now
+}
+
 func downgradeTaskDefinitionV3ToV2(t *TaskDefinition) *TaskDefinition {
 	layers := ImageLayers(t.ImageLayers)
 
@@ -204,3 +336,20 @@ func (s *Task_State) UnmarshalJSON(data []byte) error {
 func (s Task_State) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.String())
 }
+
+func (p *TaskDefinition_DependencyPolicy) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := TaskDefinition_DependencyPolicy_value[name]; found {
+		*p = TaskDefinition_DependencyPolicy(v)
+		return nil
+	}
+	return fmt.Errorf("invalid dependency policy: %s", name)
+}
+
+func (p TaskDefinition_DependencyPolicy) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
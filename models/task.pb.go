@@ -27,6 +27,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type TaskDefinition_DependencyPolicy int32
+
+const (
+	TaskDefinition_RequireSuccess    TaskDefinition_DependencyPolicy = 0
+	TaskDefinition_RequireCompletion TaskDefinition_DependencyPolicy = 1
+)
+
+var TaskDefinition_DependencyPolicy_name = map[int32]string{
+	0: "RequireSuccess",
+	1: "RequireCompletion",
+}
+
+var TaskDefinition_DependencyPolicy_value = map[string]int32{
+	"RequireSuccess":    0,
+	"RequireCompletion": 1,
+}
+
+func (TaskDefinition_DependencyPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{0, 0}
+}
+
 type Task_State int32
 
 const (
@@ -35,6 +56,7 @@ const (
 	Task_Running   Task_State = 2
 	Task_Completed Task_State = 3
 	Task_Resolving Task_State = 4
+	Task_Waiting   Task_State = 5
 )
 
 var Task_State_name = map[int32]string{
@@ -43,6 +65,7 @@ var Task_State_name = map[int32]string{
 	2: "Running",
 	3: "Completed",
 	4: "Resolving",
+	5: "Waiting",
 }
 
 var Task_State_value = map[string]int32{
@@ -51,41 +74,46 @@ var Task_State_value = map[string]int32{
 	"Running":   2,
 	"Completed": 3,
 	"Resolving": 4,
+	"Waiting":   5,
 }
 
 func (Task_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{1, 0}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{3, 0}
 }
 
 type TaskDefinition struct {
-	RootFs                        string                     `protobuf:"bytes,1,opt,name=root_fs,json=rootFs,proto3" json:"rootfs"`
-	EnvironmentVariables          []*EnvironmentVariable     `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env,omitempty"`
-	Action                        *Action                    `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
-	DiskMb                        int32                      `protobuf:"varint,4,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
-	MemoryMb                      int32                      `protobuf:"varint,5,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
-	CpuWeight                     uint32                     `protobuf:"varint,6,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight"`
-	Privileged                    bool                       `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged"`
-	LogSource                     string                     `protobuf:"bytes,8,opt,name=log_source,json=logSource,proto3" json:"log_source"`
-	LogGuid                       string                     `protobuf:"bytes,9,opt,name=log_guid,json=logGuid,proto3" json:"log_guid"`
-	MetricsGuid                   string                     `protobuf:"bytes,10,opt,name=metrics_guid,json=metricsGuid,proto3" json:"metrics_guid"`
-	ResultFile                    string                     `protobuf:"bytes,11,opt,name=result_file,json=resultFile,proto3" json:"result_file"`
-	CompletionCallbackUrl         string                     `protobuf:"bytes,12,opt,name=completion_callback_url,json=completionCallbackUrl,proto3" json:"completion_callback_url,omitempty"`
-	Annotation                    string                     `protobuf:"bytes,13,opt,name=annotation,proto3" json:"annotation,omitempty"`
-	EgressRules                   []*SecurityGroupRule       `protobuf:"bytes,14,rep,name=egress_rules,json=egressRules,proto3" json:"egress_rules,omitempty"`
-	CachedDependencies            []*CachedDependency        `protobuf:"bytes,15,rep,name=cached_dependencies,json=cachedDependencies,proto3" json:"cached_dependencies,omitempty"`
-	LegacyDownloadUser            string                     `protobuf:"bytes,16,opt,name=legacy_download_user,json=legacyDownloadUser,proto3" json:"legacy_download_user,omitempty"` // Deprecated: Do not use.
-	TrustedSystemCertificatesPath string                     `protobuf:"bytes,17,opt,name=trusted_system_certificates_path,json=trustedSystemCertificatesPath,proto3" json:"trusted_system_certificates_path,omitempty"`
-	VolumeMounts                  []*VolumeMount             `protobuf:"bytes,18,rep,name=volume_mounts,json=volumeMounts,proto3" json:"volume_mounts,omitempty"`
-	Network                       *Network                   `protobuf:"bytes,19,opt,name=network,proto3" json:"network,omitempty"`
-	PlacementTags                 []string                   `protobuf:"bytes,20,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
-	MaxPids                       int32                      `protobuf:"varint,21,opt,name=max_pids,json=maxPids,proto3" json:"max_pids"`
-	CertificateProperties         *CertificateProperties     `protobuf:"bytes,22,opt,name=certificate_properties,json=certificateProperties,proto3" json:"certificate_properties,omitempty"`
-	ImageUsername                 string                     `protobuf:"bytes,23,opt,name=image_username,json=imageUsername,proto3" json:"image_username"`
-	ImagePassword                 string                     `protobuf:"bytes,24,opt,name=image_password,json=imagePassword,proto3" json:"image_password"`
-	ImageLayers                   []*ImageLayer              `protobuf:"bytes,25,rep,name=image_layers,json=imageLayers,proto3" json:"image_layers,omitempty"`
-	LogRateLimit                  *LogRateLimit              `protobuf:"bytes,26,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
-	MetricTags                    map[string]*MetricTagValue `protobuf:"bytes,27,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	VolumeMountedFiles            []*File                    `protobuf:"bytes,28,rep,name=volume_mounted_files,json=volumeMountedFiles,proto3" json:"volume_mounted_files"`
+	RootFs                        string                          `protobuf:"bytes,1,opt,name=root_fs,json=rootFs,proto3" json:"rootfs"`
+	EnvironmentVariables          []*EnvironmentVariable          `protobuf:"bytes,2,rep,name=environment_variables,json=environmentVariables,proto3" json:"env,omitempty"`
+	Action                        *Action                         `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	DiskMb                        int32                           `protobuf:"varint,4,opt,name=disk_mb,json=diskMb,proto3" json:"disk_mb"`
+	MemoryMb                      int32                           `protobuf:"varint,5,opt,name=memory_mb,json=memoryMb,proto3" json:"memory_mb"`
+	CpuWeight                     uint32                          `protobuf:"varint,6,opt,name=cpu_weight,json=cpuWeight,proto3" json:"cpu_weight"`
+	Privileged                    bool                            `protobuf:"varint,7,opt,name=privileged,proto3" json:"privileged"`
+	LogSource                     string                          `protobuf:"bytes,8,opt,name=log_source,json=logSource,proto3" json:"log_source"`
+	LogGuid                       string                          `protobuf:"bytes,9,opt,name=log_guid,json=logGuid,proto3" json:"log_guid"`
+	MetricsGuid                   string                          `protobuf:"bytes,10,opt,name=metrics_guid,json=metricsGuid,proto3" json:"metrics_guid"`
+	ResultFile                    string                          `protobuf:"bytes,11,opt,name=result_file,json=resultFile,proto3" json:"result_file"`
+	CompletionCallbackUrl         string                          `protobuf:"bytes,12,opt,name=completion_callback_url,json=completionCallbackUrl,proto3" json:"completion_callback_url,omitempty"`
+	Annotation                    string                          `protobuf:"bytes,13,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	EgressRules                   []*SecurityGroupRule            `protobuf:"bytes,14,rep,name=egress_rules,json=egressRules,proto3" json:"egress_rules,omitempty"`
+	CachedDependencies            []*CachedDependency             `protobuf:"bytes,15,rep,name=cached_dependencies,json=cachedDependencies,proto3" json:"cached_dependencies,omitempty"`
+	LegacyDownloadUser            string                          `protobuf:"bytes,16,opt,name=legacy_download_user,json=legacyDownloadUser,proto3" json:"legacy_download_user,omitempty"` // Deprecated: Do not use.
+	TrustedSystemCertificatesPath string                          `protobuf:"bytes,17,opt,name=trusted_system_certificates_path,json=trustedSystemCertificatesPath,proto3" json:"trusted_system_certificates_path,omitempty"`
+	VolumeMounts                  []*VolumeMount                  `protobuf:"bytes,18,rep,name=volume_mounts,json=volumeMounts,proto3" json:"volume_mounts,omitempty"`
+	Network                       *Network                        `protobuf:"bytes,19,opt,name=network,proto3" json:"network,omitempty"`
+	PlacementTags                 []string                        `protobuf:"bytes,20,rep,name=placement_tags,json=placementTags,proto3" json:"placement_tags,omitempty"`
+	MaxPids                       int32                           `protobuf:"varint,21,opt,name=max_pids,json=maxPids,proto3" json:"max_pids"`
+	CertificateProperties         *CertificateProperties          `protobuf:"bytes,22,opt,name=certificate_properties,json=certificateProperties,proto3" json:"certificate_properties,omitempty"`
+	ImageUsername                 string                          `protobuf:"bytes,23,opt,name=image_username,json=imageUsername,proto3" json:"image_username"`
+	ImagePassword                 string                          `protobuf:"bytes,24,opt,name=image_password,json=imagePassword,proto3" json:"image_password"`
+	ImageLayers                   []*ImageLayer                   `protobuf:"bytes,25,rep,name=image_layers,json=imageLayers,proto3" json:"image_layers,omitempty"`
+	LogRateLimit                  *LogRateLimit                   `protobuf:"bytes,26,opt,name=log_rate_limit,json=logRateLimit,proto3" json:"log_rate_limit,omitempty"`
+	MetricTags                    map[string]*MetricTagValue      `protobuf:"bytes,27,rep,name=metric_tags,json=metricTags,proto3" json:"metric_tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	VolumeMountedFiles            []*File                         `protobuf:"bytes,28,rep,name=volume_mounted_files,json=volumeMountedFiles,proto3" json:"volume_mounted_files"`
+	Labels                        map[string]string               `protobuf:"bytes,29,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DependsOn                     []string                        `protobuf:"bytes,30,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	DependencyPolicy              TaskDefinition_DependencyPolicy `protobuf:"varint,31,opt,name=dependency_policy,json=dependencyPolicy,proto3,enum=models.TaskDefinition_DependencyPolicy" json:"dependency_policy,omitempty"`
+	RetryPolicy                   *TaskRetryPolicy                `protobuf:"bytes,32,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
 }
 
 func (m *TaskDefinition) Reset()      { *m = TaskDefinition{} }
@@ -317,26 +345,182 @@ func (m *TaskDefinition) GetVolumeMountedFiles() []*File {
 	return nil
 }
 
+func (m *TaskDefinition) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *TaskDefinition) GetDependsOn() []string {
+	if m != nil {
+		return m.DependsOn
+	}
+	return nil
+}
+
+func (m *TaskDefinition) GetDependencyPolicy() TaskDefinition_DependencyPolicy {
+	if m != nil {
+		return m.DependencyPolicy
+	}
+	return TaskDefinition_RequireSuccess
+}
+
+func (m *TaskDefinition) GetRetryPolicy() *TaskRetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+type TaskRetryPolicy struct {
+	MaxAttempts             int32    `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts"`
+	InitialBackoffMs        int64    `protobuf:"varint,2,opt,name=initial_backoff_ms,json=initialBackoffMs,proto3" json:"initial_backoff_ms"`
+	MaxBackoffMs            int64    `protobuf:"varint,3,opt,name=max_backoff_ms,json=maxBackoffMs,proto3" json:"max_backoff_ms,omitempty"`
+	RetryableFailureReasons []string `protobuf:"bytes,4,rep,name=retryable_failure_reasons,json=retryableFailureReasons,proto3" json:"retryable_failure_reasons,omitempty"`
+}
+
+func (m *TaskRetryPolicy) Reset()      { *m = TaskRetryPolicy{} }
+func (*TaskRetryPolicy) ProtoMessage() {}
+func (*TaskRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{1}
+}
+func (m *TaskRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRetryPolicy.Merge(m, src)
+}
+func (m *TaskRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskRetryPolicy proto.InternalMessageInfo
+
+func (m *TaskRetryPolicy) GetMaxAttempts() int32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetInitialBackoffMs() int64 {
+	if m != nil {
+		return m.InitialBackoffMs
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetMaxBackoffMs() int64 {
+	if m != nil {
+		return m.MaxBackoffMs
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetRetryableFailureReasons() []string {
+	if m != nil {
+		return m.RetryableFailureReasons
+	}
+	return nil
+}
+
+type TaskAttempt struct {
+	CellId        string `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	FailureReason string `protobuf:"bytes,2,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
+	FailedAt      int64  `protobuf:"varint,3,opt,name=failed_at,json=failedAt,proto3" json:"failed_at"`
+}
+
+func (m *TaskAttempt) Reset()      { *m = TaskAttempt{} }
+func (*TaskAttempt) ProtoMessage() {}
+func (*TaskAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{2}
+}
+func (m *TaskAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskAttempt.Merge(m, src)
+}
+func (m *TaskAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskAttempt proto.InternalMessageInfo
+
+func (m *TaskAttempt) GetCellId() string {
+	if m != nil {
+		return m.CellId
+	}
+	return ""
+}
+
+func (m *TaskAttempt) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+func (m *TaskAttempt) GetFailedAt() int64 {
+	if m != nil {
+		return m.FailedAt
+	}
+	return 0
+}
+
 type Task struct {
 	*TaskDefinition  `protobuf:"bytes,1,opt,name=task_definition,json=taskDefinition,proto3,embedded=task_definition" json:""`
-	TaskGuid         string     `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
-	Domain           string     `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain"`
-	CreatedAt        int64      `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
-	UpdatedAt        int64      `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
-	FirstCompletedAt int64      `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt,proto3" json:"first_completed_at"`
-	State            Task_State `protobuf:"varint,7,opt,name=state,proto3,enum=models.Task_State" json:"state"`
-	CellId           string     `protobuf:"bytes,8,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
-	Result           string     `protobuf:"bytes,9,opt,name=result,proto3" json:"result"`
-	Failed           bool       `protobuf:"varint,10,opt,name=failed,proto3" json:"failed"`
-	FailureReason    string     `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
-	RejectionCount   int32      `protobuf:"varint,12,opt,name=rejection_count,json=rejectionCount,proto3" json:"rejection_count"`
-	RejectionReason  string     `protobuf:"bytes,13,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason"`
+	TaskGuid         string         `protobuf:"bytes,2,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	Domain           string         `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain"`
+	CreatedAt        int64          `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt        int64          `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	FirstCompletedAt int64          `protobuf:"varint,6,opt,name=first_completed_at,json=firstCompletedAt,proto3" json:"first_completed_at"`
+	State            Task_State     `protobuf:"varint,7,opt,name=state,proto3,enum=models.Task_State" json:"state"`
+	CellId           string         `protobuf:"bytes,8,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Result           string         `protobuf:"bytes,9,opt,name=result,proto3" json:"result"`
+	Failed           bool           `protobuf:"varint,10,opt,name=failed,proto3" json:"failed"`
+	FailureReason    string         `protobuf:"bytes,11,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason"`
+	RejectionCount   int32          `protobuf:"varint,12,opt,name=rejection_count,json=rejectionCount,proto3" json:"rejection_count"`
+	RejectionReason  string         `protobuf:"bytes,13,opt,name=rejection_reason,json=rejectionReason,proto3" json:"rejection_reason"`
+	FailedAttempts   []*TaskAttempt `protobuf:"bytes,14,rep,name=failed_attempts,json=failedAttempts,proto3" json:"failed_attempts,omitempty"`
+	RetryAt          int64          `protobuf:"varint,15,opt,name=retry_at,json=retryAt,proto3" json:"retry_at,omitempty"`
 }
 
 func (m *Task) Reset()      { *m = Task{} }
 func (*Task) ProtoMessage() {}
 func (*Task) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ce5d8dd45b4a91ff, []int{1}
+	return fileDescriptor_ce5d8dd45b4a91ff, []int{3}
 }
 func (m *Task) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,106 +633,155 @@ func (m *Task) GetRejectionReason() string {
 	return ""
 }
 
+func (m *Task) GetFailedAttempts() []*TaskAttempt {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return nil
+}
+
+func (m *Task) GetRetryAt() int64 {
+	if m != nil {
+		return m.RetryAt
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("models.TaskDefinition_DependencyPolicy", TaskDefinition_DependencyPolicy_name, TaskDefinition_DependencyPolicy_value)
 	proto.RegisterEnum("models.Task_State", Task_State_name, Task_State_value)
 	proto.RegisterType((*TaskDefinition)(nil), "models.TaskDefinition")
+	proto.RegisterMapType((map[string]string)(nil), "models.TaskDefinition.LabelsEntry")
 	proto.RegisterMapType((map[string]*MetricTagValue)(nil), "models.TaskDefinition.MetricTagsEntry")
+	proto.RegisterType((*TaskRetryPolicy)(nil), "models.TaskRetryPolicy")
+	proto.RegisterType((*TaskAttempt)(nil), "models.TaskAttempt")
 	proto.RegisterType((*Task)(nil), "models.Task")
 }
 
 func init() { proto.RegisterFile("task.proto", fileDescriptor_ce5d8dd45b4a91ff) }
 
 var fileDescriptor_ce5d8dd45b4a91ff = []byte{
-	// 1386 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x56, 0xdd, 0x6e, 0xdb, 0x36,
-	0x14, 0x8e, 0x92, 0xda, 0x89, 0xe9, 0x9f, 0x38, 0xcc, 0x4f, 0xd5, 0xb4, 0xb5, 0x8c, 0x6c, 0xeb,
-	0xb2, 0xa2, 0x4d, 0x87, 0xb6, 0x1b, 0xba, 0xa2, 0xc0, 0x10, 0x27, 0x6d, 0x10, 0x20, 0x19, 0x02,
-	0xa6, 0xe9, 0x76, 0x27, 0xd0, 0x12, 0xad, 0x70, 0x91, 0x44, 0x83, 0xa4, 0x9c, 0xfa, 0xae, 0x8f,
-	0xb0, 0xc7, 0xd8, 0xa3, 0xec, 0x32, 0x97, 0xbd, 0x12, 0xd6, 0xf4, 0x66, 0xf0, 0x55, 0x1f, 0x61,
-	0xe0, 0x91, 0x64, 0x3b, 0x69, 0xae, 0x74, 0xce, 0xf7, 0x7d, 0x87, 0x34, 0x0f, 0x79, 0xce, 0x31,
-	0x42, 0x9a, 0xaa, 0xb3, 0xad, 0xbe, 0x14, 0x5a, 0xe0, 0x72, 0x24, 0x7c, 0x16, 0xaa, 0xf5, 0xc7,
-	0x01, 0xd7, 0xa7, 0x49, 0x77, 0xcb, 0x13, 0xd1, 0x93, 0x40, 0x04, 0xe2, 0x09, 0xd0, 0xdd, 0xa4,
-	0x07, 0x1e, 0x38, 0x60, 0x65, 0x61, 0xeb, 0x75, 0xea, 0x69, 0x2e, 0x62, 0x95, 0xbb, 0x77, 0x59,
-	0x3c, 0xe0, 0x52, 0xc4, 0x11, 0x8b, 0xb5, 0x3b, 0xa0, 0x92, 0xd3, 0x6e, 0xc8, 0x0a, 0x72, 0x45,
-	0x31, 0x2f, 0x91, 0x5c, 0x0f, 0xdd, 0x40, 0x8a, 0xa4, 0x9f, 0xa3, 0xb7, 0x3d, 0xea, 0x9d, 0x32,
-	0xdf, 0xf5, 0x59, 0x9f, 0xc5, 0x3e, 0x8b, 0xbd, 0x61, 0x4e, 0xe0, 0x81, 0x08, 0x93, 0x88, 0xb9,
-	0x91, 0x48, 0x62, 0x5d, 0x6c, 0x17, 0x33, 0x7d, 0x2e, 0x64, 0xfe, 0xa3, 0xd7, 0xef, 0x79, 0x4c,
-	0x6a, 0xde, 0xe3, 0x1e, 0xd5, 0xcc, 0xed, 0x4b, 0xd1, 0x37, 0xee, 0x78, 0xbf, 0x25, 0x1e, 0xd1,
-	0x80, 0xb9, 0x21, 0x1d, 0x32, 0x59, 0xfc, 0x84, 0x50, 0x04, 0xae, 0x34, 0xea, 0x90, 0x47, 0xbc,
-	0x58, 0x75, 0x29, 0x62, 0x5a, 0x72, 0xcf, 0xd5, 0x34, 0x28, 0x62, 0x51, 0x8f, 0x87, 0x2c, 0xb3,
-	0x37, 0x3e, 0xd4, 0x51, 0xe3, 0x2d, 0x55, 0x67, 0xbb, 0xac, 0xc7, 0x63, 0x6e, 0x8e, 0x8b, 0xbf,
-	0x41, 0xf3, 0x52, 0x08, 0xed, 0xf6, 0x94, 0x6d, 0xb5, 0xad, 0xcd, 0x4a, 0x07, 0x8d, 0x52, 0xa7,
-	0x6c, 0xa0, 0x9e, 0x22, 0xf0, 0x7d, 0xa3, 0xb0, 0x87, 0x56, 0x6f, 0x4c, 0x87, 0x3d, 0xdb, 0x9e,
-	0xdb, 0xac, 0x3e, 0xbd, 0xbb, 0x95, 0xa5, 0x7c, 0xeb, 0xf5, 0x44, 0xf4, 0x2e, 0xd7, 0x74, 0x96,
-	0x46, 0xa9, 0x53, 0x67, 0xf1, 0xe0, 0x91, 0x88, 0xb8, 0x66, 0x51, 0x5f, 0x0f, 0xc9, 0x0a, 0xfb,
-	0x5a, 0xa7, 0xf0, 0x03, 0x54, 0xce, 0xae, 0xc0, 0x9e, 0x6b, 0x5b, 0x9b, 0xd5, 0xa7, 0x8d, 0x62,
-	0xd5, 0x6d, 0x40, 0x49, 0xce, 0xe2, 0x6f, 0xd1, 0xbc, 0xcf, 0xd5, 0x99, 0x1b, 0x75, 0xed, 0x5b,
-	0x6d, 0x6b, 0xb3, 0xd4, 0xa9, 0x8e, 0x52, 0xa7, 0x80, 0x48, 0xd9, 0x18, 0x87, 0x5d, 0xfc, 0x10,
-	0x55, 0x22, 0x16, 0x09, 0x39, 0x34, 0xba, 0x12, 0xe8, 0xea, 0xa3, 0xd4, 0x99, 0x80, 0x64, 0x21,
-	0x33, 0x0f, 0xbb, 0xf8, 0x31, 0x42, 0x5e, 0x3f, 0x71, 0xcf, 0x19, 0x0f, 0x4e, 0xb5, 0x5d, 0x6e,
-	0x5b, 0x9b, 0xf5, 0x4e, 0x63, 0x94, 0x3a, 0x53, 0x28, 0xa9, 0x78, 0xfd, 0xe4, 0x77, 0x30, 0xf1,
-	0x16, 0x42, 0x7d, 0xc9, 0x07, 0x3c, 0x64, 0x01, 0xf3, 0xed, 0xf9, 0xb6, 0xb5, 0xb9, 0x90, 0xc9,
-	0x27, 0x28, 0x99, 0xb2, 0xcd, 0xf2, 0xe6, 0xb2, 0x94, 0x48, 0xa4, 0xc7, 0xec, 0x05, 0xc8, 0x32,
-	0xe8, 0x27, 0x28, 0xa9, 0x84, 0x22, 0x38, 0x06, 0x13, 0x7f, 0x8f, 0x16, 0x0c, 0x11, 0x24, 0xdc,
-	0xb7, 0x2b, 0x20, 0xae, 0x8d, 0x52, 0x67, 0x8c, 0x91, 0xf9, 0x50, 0x04, 0x7b, 0x09, 0xf7, 0xf1,
-	0x33, 0x54, 0xcb, 0xae, 0x5b, 0x65, 0x62, 0x04, 0xe2, 0xe6, 0x28, 0x75, 0xae, 0xe0, 0xa4, 0x9a,
-	0x7b, 0x10, 0xf4, 0x23, 0xaa, 0x4a, 0xa6, 0x92, 0x50, 0xbb, 0xe6, 0x5d, 0xd8, 0x55, 0x88, 0x59,
-	0x1c, 0xa5, 0xce, 0x34, 0x4c, 0x50, 0xe6, 0xbc, 0xe1, 0x21, 0xc3, 0x3f, 0xa3, 0xdb, 0x9e, 0x88,
-	0xfa, 0x21, 0x33, 0xd9, 0x77, 0x3d, 0x1a, 0x86, 0x5d, 0xea, 0x9d, 0xb9, 0x89, 0x0c, 0xed, 0x9a,
-	0x89, 0x26, 0xab, 0x13, 0x7a, 0x27, 0x67, 0x4f, 0x64, 0x88, 0x5b, 0x08, 0xd1, 0x38, 0x16, 0x9a,
-	0xc2, 0x9d, 0xd6, 0x41, 0x3a, 0x85, 0xe0, 0x57, 0xa8, 0xc6, 0x02, 0xc9, 0x94, 0x72, 0x65, 0x62,
-	0xde, 0x52, 0x03, 0xde, 0xd2, 0x9d, 0xe2, 0xd6, 0x8f, 0xf3, 0x12, 0xdb, 0x33, 0x15, 0x46, 0x92,
-	0x90, 0x91, 0x6a, 0x26, 0x37, 0xb6, 0xc2, 0xfb, 0x68, 0xf9, 0x7a, 0xb9, 0x71, 0xa6, 0xec, 0x45,
-	0x58, 0xc4, 0x2e, 0x16, 0xd9, 0x01, 0xc9, 0xee, 0xb8, 0x20, 0x09, 0xf6, 0xae, 0x22, 0x9c, 0x29,
-	0xfc, 0x1c, 0xad, 0x84, 0x2c, 0xa0, 0xde, 0xd0, 0xf5, 0xc5, 0x79, 0x1c, 0x0a, 0xea, 0xbb, 0x89,
-	0x62, 0xd2, 0x6e, 0x42, 0x6e, 0x66, 0x6d, 0x8b, 0xe0, 0x8c, 0xdf, 0xcd, 0xe9, 0x13, 0xc5, 0x24,
-	0xde, 0x43, 0x6d, 0x2d, 0x13, 0xa5, 0x99, 0xef, 0xaa, 0xa1, 0xd2, 0x2c, 0x72, 0xa7, 0x4a, 0x58,
-	0xb9, 0x7d, 0xaa, 0x4f, 0xed, 0x25, 0x38, 0xf4, 0xfd, 0x5c, 0x77, 0x0c, 0xb2, 0x9d, 0x29, 0xd5,
-	0x11, 0xd5, 0xa7, 0xf8, 0x05, 0xaa, 0x4f, 0xf7, 0x07, 0x65, 0x63, 0x38, 0xc3, 0x72, 0x71, 0x86,
-	0x77, 0x40, 0x1e, 0x1a, 0x8e, 0xd4, 0x06, 0x13, 0x47, 0xe1, 0x1f, 0xd0, 0x7c, 0xde, 0x45, 0xec,
-	0x65, 0x28, 0x99, 0xc5, 0x22, 0xe6, 0xb7, 0x0c, 0x26, 0x05, 0x8f, 0xbf, 0x43, 0x8d, 0x7e, 0x48,
-	0x3d, 0x06, 0xf5, 0x6b, 0xba, 0x83, 0xbd, 0xd2, 0x9e, 0xdb, 0xac, 0x90, 0xfa, 0x18, 0x7d, 0x4b,
-	0x03, 0x65, 0xde, 0x5e, 0x44, 0xdf, 0xbb, 0x7d, 0xee, 0x2b, 0x7b, 0x15, 0x8a, 0x06, 0xde, 0x5e,
-	0x81, 0x91, 0xf9, 0x88, 0xbe, 0x3f, 0xe2, 0xbe, 0xc2, 0x6f, 0xd1, 0xda, 0xcd, 0x1d, 0xcb, 0x5e,
-	0x83, 0x5f, 0x72, 0x7f, 0x7c, 0x03, 0x13, 0xd5, 0xd1, 0x58, 0x44, 0x56, 0xbd, 0x9b, 0x60, 0xfc,
-	0x0b, 0x6a, 0x64, 0x9d, 0xce, 0xe4, 0x3f, 0xa6, 0x11, 0xb3, 0x6f, 0xc3, 0x1d, 0xe0, 0x51, 0xea,
-	0x5c, 0x63, 0x48, 0x1d, 0xfc, 0x93, 0xdc, 0x9d, 0x84, 0xf6, 0xa9, 0x52, 0xe7, 0x42, 0xfa, 0xb6,
-	0x7d, 0x3d, 0xb4, 0x60, 0xf2, 0xd0, 0xa3, 0xdc, 0xc5, 0x3f, 0xa1, 0xda, 0x54, 0x7f, 0x55, 0xf6,
-	0x1d, 0xc8, 0x3f, 0x2e, 0x4e, 0xb0, 0x6f, 0xb8, 0x03, 0x43, 0x91, 0x2a, 0x1f, 0xdb, 0x0a, 0xbf,
-	0x44, 0x8d, 0xab, 0x3d, 0xd8, 0x5e, 0x87, 0xa3, 0xaf, 0x14, 0x81, 0x07, 0x22, 0x20, 0x54, 0xb3,
-	0x03, 0xc3, 0x91, 0x5a, 0x38, 0xe5, 0xe1, 0x3d, 0x54, 0x9d, 0xea, 0xd4, 0xf6, 0x5d, 0xd8, 0xf1,
-	0x41, 0x11, 0x78, 0xb5, 0x45, 0x6f, 0x1d, 0x82, 0xd2, 0xdc, 0xcf, 0xeb, 0x58, 0xcb, 0x21, 0x41,
-	0xd1, 0x18, 0xc0, 0x7f, 0xa0, 0x95, 0xe9, 0xc7, 0xc3, 0x7c, 0xa8, 0x5f, 0x65, 0xdf, 0x83, 0x15,
-	0x6b, 0xc5, 0x8a, 0xa6, 0x90, 0x3b, 0xf6, 0x28, 0x75, 0x6e, 0x54, 0x13, 0x3c, 0xf5, 0xac, 0x98,
-	0x6f, 0xc4, 0x6a, 0xfd, 0x04, 0x2d, 0x5e, 0xdb, 0x18, 0x37, 0xd1, 0xdc, 0x19, 0x1b, 0x66, 0x73,
-	0x82, 0x18, 0x13, 0x3f, 0x42, 0xa5, 0x01, 0x0d, 0x13, 0x66, 0xcf, 0xc2, 0xd1, 0xd7, 0x8a, 0xfd,
-	0xc6, 0x91, 0xef, 0x0c, 0x4b, 0x32, 0xd1, 0xcb, 0xd9, 0x17, 0xd6, 0xc6, 0x97, 0x12, 0xba, 0x65,
-	0xce, 0x87, 0xf7, 0xd1, 0xa2, 0x19, 0xda, 0xae, 0x3f, 0x3e, 0x28, 0x2c, 0x3c, 0xb5, 0xc8, 0xd5,
-	0x34, 0x74, 0x16, 0x2e, 0x52, 0xc7, 0x1a, 0xa5, 0xce, 0x0c, 0x69, 0xe8, 0xab, 0x33, 0xec, 0x21,
-	0xaa, 0xc0, 0x52, 0xd0, 0x05, 0x67, 0xe1, 0xda, 0xa1, 0xd7, 0x8f, 0x41, 0xb2, 0x60, 0x4c, 0xe8,
-	0x7f, 0x1b, 0xa8, 0xec, 0x8b, 0x88, 0xf2, 0x6c, 0xca, 0xe4, 0xe3, 0x2e, 0x43, 0x48, 0xfe, 0x85,
-	0x79, 0x20, 0x19, 0x35, 0xf9, 0xa1, 0x1a, 0x86, 0xcc, 0x5c, 0x3e, 0x0f, 0xc6, 0x28, 0xa9, 0xe4,
-	0xf6, 0xb6, 0x36, 0xf2, 0xa4, 0xef, 0x17, 0xf2, 0xd2, 0x44, 0x3e, 0x41, 0x49, 0x25, 0xb7, 0xb7,
-	0x35, 0xde, 0x45, 0xb8, 0xc7, 0xa5, 0xd2, 0x6e, 0xde, 0x36, 0xb3, 0xb0, 0x32, 0x84, 0xad, 0x8d,
-	0x52, 0xe7, 0x06, 0x96, 0x34, 0x01, 0xdb, 0x29, 0xa0, 0x6d, 0x8d, 0x9f, 0xa1, 0x92, 0xd2, 0x54,
-	0x33, 0x98, 0x3f, 0x8d, 0xc9, 0x6b, 0x35, 0x49, 0xdb, 0x3a, 0x36, 0x4c, 0xa7, 0x32, 0x4a, 0x9d,
-	0x4c, 0x44, 0xb2, 0x8f, 0x19, 0x9d, 0x1e, 0x0b, 0x43, 0x97, 0xfb, 0xf9, 0x18, 0x82, 0xd1, 0x99,
-	0x43, 0xa4, 0x6c, 0x8c, 0x7d, 0x48, 0x51, 0xd6, 0xfe, 0xf3, 0xf1, 0x93, 0xfd, 0x23, 0x00, 0x84,
-	0xe4, 0x5f, 0xa3, 0xe9, 0x51, 0x1e, 0xb2, 0x6c, 0xea, 0x2c, 0x64, 0x9a, 0x0c, 0x21, 0xf9, 0xd7,
-	0x94, 0xa4, 0xb1, 0x12, 0xc9, 0x5c, 0xc9, 0xa8, 0x12, 0x71, 0x3e, 0x6d, 0xa0, 0x24, 0xaf, 0x32,
-	0xa4, 0x9e, 0xfb, 0x04, 0x5c, 0xfc, 0x0a, 0x2d, 0x4a, 0xf6, 0x27, 0xf3, 0xb2, 0x91, 0x63, 0x9e,
-	0x25, 0xcc, 0x9a, 0x52, 0x67, 0x79, 0x94, 0x3a, 0xd7, 0x29, 0xd2, 0x18, 0x03, 0x3b, 0xc6, 0xc7,
-	0xbf, 0xa2, 0xe6, 0x44, 0x92, 0x6f, 0x0d, 0xf3, 0xa7, 0xb3, 0x32, 0x4a, 0x9d, 0xaf, 0x38, 0x32,
-	0x59, 0x30, 0xdb, 0x7e, 0xe3, 0x00, 0x95, 0x20, 0x85, 0xb8, 0x8a, 0xe6, 0xf7, 0xe3, 0x01, 0x0d,
-	0xb9, 0xdf, 0x9c, 0x31, 0xce, 0x11, 0x8b, 0x7d, 0x1e, 0x07, 0x4d, 0xcb, 0x38, 0x24, 0x89, 0x63,
-	0xe3, 0xcc, 0xe2, 0x3a, 0xaa, 0x8c, 0xef, 0xa6, 0x39, 0x67, 0x5c, 0xc2, 0x94, 0x08, 0x07, 0x86,
-	0xbd, 0xd5, 0x79, 0x7e, 0xf1, 0xa9, 0x65, 0x7d, 0xfc, 0xd4, 0x9a, 0xf9, 0xf2, 0xa9, 0x65, 0x7d,
-	0xb8, 0x6c, 0x59, 0x7f, 0x5f, 0xb6, 0xac, 0x7f, 0x2e, 0x5b, 0xd6, 0xc5, 0x65, 0xcb, 0xfa, 0xf7,
-	0xb2, 0x65, 0xfd, 0x77, 0xd9, 0x9a, 0xf9, 0x72, 0xd9, 0xb2, 0xfe, 0xfa, 0xdc, 0x9a, 0xb9, 0xf8,
-	0xdc, 0x9a, 0xf9, 0xf8, 0xb9, 0x35, 0xd3, 0x2d, 0xc3, 0x5f, 0xb6, 0x67, 0xff, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x19, 0x37, 0xa2, 0x26, 0xdb, 0x0a, 0x00, 0x00,
+	// 1762 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x57, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0xe8, 0x41, 0x91, 0xcd, 0xa7, 0xda, 0x7a, 0xb4, 0xe5, 0x35, 0x87, 0xe0, 0x26, 0x6b,
+	0x25, 0xd8, 0xd5, 0x26, 0xf6, 0x26, 0xd9, 0x5d, 0x6c, 0x10, 0x88, 0xf2, 0xda, 0x10, 0x60, 0x25,
+	0x42, 0xcb, 0xf6, 0x26, 0xc8, 0x61, 0xd0, 0x9c, 0x69, 0x52, 0x1d, 0xcd, 0x83, 0x99, 0xee, 0xa1,
+	0xcd, 0x5b, 0xfe, 0x40, 0x80, 0x9c, 0xf3, 0x0b, 0xf2, 0x07, 0xf2, 0x1f, 0x72, 0xf4, 0x21, 0x87,
+	0x3d, 0x0d, 0x62, 0xf9, 0x12, 0xcc, 0x69, 0x7f, 0x42, 0xd0, 0x8f, 0x79, 0x90, 0x96, 0x81, 0x9c,
+	0xd8, 0xf5, 0xd5, 0x57, 0x55, 0xdd, 0x5d, 0x55, 0x3d, 0x45, 0x00, 0x04, 0xe1, 0xd7, 0xc7, 0xb3,
+	0x38, 0x12, 0x11, 0xac, 0x05, 0x91, 0x47, 0x7d, 0x7e, 0xf8, 0xd9, 0x94, 0x89, 0xab, 0x64, 0x7c,
+	0xec, 0x46, 0xc1, 0xe7, 0xd3, 0x68, 0x1a, 0x7d, 0xae, 0xd4, 0xe3, 0x64, 0xa2, 0x24, 0x25, 0xa8,
+	0x95, 0x36, 0x3b, 0x6c, 0x13, 0x57, 0xb0, 0x28, 0xe4, 0x46, 0xbc, 0x47, 0xc3, 0x39, 0x8b, 0xa3,
+	0x30, 0xa0, 0xa1, 0x70, 0xe6, 0x24, 0x66, 0x64, 0xec, 0xd3, 0x5c, 0xb9, 0xcb, 0xa9, 0x9b, 0xc4,
+	0x4c, 0x2c, 0x9c, 0x69, 0x1c, 0x25, 0x33, 0x83, 0x1e, 0xb8, 0xc4, 0xbd, 0xa2, 0x9e, 0xe3, 0xd1,
+	0x19, 0x0d, 0x3d, 0x1a, 0xba, 0x0b, 0xa3, 0x80, 0xf3, 0xc8, 0x4f, 0x02, 0xea, 0x04, 0x51, 0x12,
+	0x8a, 0x3c, 0x5c, 0x48, 0xc5, 0xab, 0x28, 0x36, 0x9b, 0x3e, 0xfc, 0xc8, 0xa5, 0xb1, 0x60, 0x13,
+	0xe6, 0x12, 0x41, 0x9d, 0x59, 0x1c, 0xcd, 0xa4, 0x58, 0xc4, 0xdb, 0x61, 0x01, 0x99, 0x52, 0xc7,
+	0x27, 0x0b, 0x1a, 0xe7, 0x5b, 0xf0, 0xa3, 0xa9, 0x13, 0x4b, 0xb6, 0xcf, 0x02, 0x96, 0x7b, 0xdd,
+	0x09, 0xa8, 0x88, 0x99, 0xeb, 0x08, 0x32, 0xcd, 0x6d, 0xc1, 0x84, 0xf9, 0x54, 0xaf, 0x87, 0xff,
+	0xee, 0x81, 0xce, 0x73, 0xc2, 0xaf, 0x1f, 0xd3, 0x09, 0x0b, 0x99, 0x3c, 0x2e, 0xfc, 0x18, 0x6c,
+	0xc7, 0x51, 0x24, 0x9c, 0x09, 0x47, 0xd6, 0xc0, 0x3a, 0x6a, 0x8c, 0x40, 0x96, 0xda, 0x35, 0x09,
+	0x4d, 0x38, 0x56, 0xbf, 0x4f, 0x38, 0x74, 0xc1, 0xde, 0xad, 0xd7, 0x81, 0xd6, 0x07, 0x1b, 0x47,
+	0xcd, 0x87, 0xf7, 0x8e, 0xf5, 0x95, 0x1f, 0x7f, 0x5b, 0x92, 0x5e, 0x1a, 0xce, 0x68, 0x27, 0x4b,
+	0xed, 0x36, 0x0d, 0xe7, 0x9f, 0x46, 0x01, 0x13, 0x34, 0x98, 0x89, 0x05, 0xde, 0xa5, 0xef, 0xf3,
+	0x38, 0xfc, 0x04, 0xd4, 0x74, 0x0a, 0xd0, 0xc6, 0xc0, 0x3a, 0x6a, 0x3e, 0xec, 0xe4, 0x5e, 0x4f,
+	0x14, 0x8a, 0x8d, 0x16, 0xfe, 0x08, 0x6c, 0x7b, 0x8c, 0x5f, 0x3b, 0xc1, 0x18, 0x6d, 0x0e, 0xac,
+	0xa3, 0xad, 0x51, 0x33, 0x4b, 0xed, 0x1c, 0xc2, 0x35, 0xb9, 0x38, 0x1f, 0xc3, 0x9f, 0x82, 0x46,
+	0x40, 0x83, 0x28, 0x5e, 0x48, 0xde, 0x96, 0xe2, 0xb5, 0xb3, 0xd4, 0x2e, 0x41, 0x5c, 0xd7, 0xcb,
+	0xf3, 0x31, 0xfc, 0x0c, 0x00, 0x77, 0x96, 0x38, 0xaf, 0x28, 0x9b, 0x5e, 0x09, 0x54, 0x1b, 0x58,
+	0x47, 0xed, 0x51, 0x27, 0x4b, 0xed, 0x0a, 0x8a, 0x1b, 0xee, 0x2c, 0xf9, 0x4e, 0x2d, 0xe1, 0x31,
+	0x00, 0xb3, 0x98, 0xcd, 0x99, 0x4f, 0xa7, 0xd4, 0x43, 0xdb, 0x03, 0xeb, 0xa8, 0xae, 0xe9, 0x25,
+	0x8a, 0x2b, 0x6b, 0xe9, 0x5e, 0x26, 0x8b, 0x47, 0x49, 0xec, 0x52, 0x54, 0x57, 0xb7, 0xac, 0xf8,
+	0x25, 0x8a, 0x1b, 0x7e, 0x34, 0xbd, 0x54, 0x4b, 0xf8, 0x00, 0xd4, 0xa5, 0x62, 0x9a, 0x30, 0x0f,
+	0x35, 0x14, 0xb9, 0x95, 0xa5, 0x76, 0x81, 0xe1, 0x6d, 0x3f, 0x9a, 0x3e, 0x4d, 0x98, 0x07, 0x1f,
+	0x81, 0x96, 0x4e, 0x37, 0xd7, 0x64, 0xa0, 0xc8, 0xbd, 0x2c, 0xb5, 0x97, 0x70, 0xdc, 0x34, 0x92,
+	0x32, 0xfa, 0x19, 0x68, 0xc6, 0x94, 0x27, 0xbe, 0x70, 0x64, 0x5d, 0xa0, 0xa6, 0xb2, 0xe9, 0x66,
+	0xa9, 0x5d, 0x85, 0x31, 0xd0, 0xc2, 0x13, 0xe6, 0x53, 0xf8, 0x4b, 0x70, 0xe0, 0x46, 0xc1, 0xcc,
+	0xa7, 0xf2, 0xf6, 0x1d, 0x97, 0xf8, 0xfe, 0x98, 0xb8, 0xd7, 0x4e, 0x12, 0xfb, 0xa8, 0x25, 0xad,
+	0xf1, 0x5e, 0xa9, 0x3e, 0x35, 0xda, 0x17, 0xb1, 0x0f, 0xfb, 0x00, 0x90, 0x30, 0x8c, 0x04, 0x51,
+	0x39, 0x6d, 0x2b, 0x6a, 0x05, 0x81, 0xdf, 0x80, 0x16, 0x9d, 0xc6, 0x94, 0x73, 0x27, 0x4e, 0x64,
+	0x2d, 0x75, 0x54, 0x2d, 0xdd, 0xcd, 0xb3, 0x7e, 0x69, 0x5a, 0xec, 0xa9, 0xec, 0x30, 0x9c, 0xf8,
+	0x14, 0x37, 0x35, 0x5d, 0xae, 0x39, 0x3c, 0x03, 0x77, 0x56, 0xdb, 0x8d, 0x51, 0x8e, 0xba, 0xca,
+	0x09, 0xca, 0x9d, 0x9c, 0x2a, 0xca, 0xe3, 0xa2, 0x21, 0x31, 0x74, 0x97, 0x11, 0x46, 0x39, 0xfc,
+	0x02, 0xec, 0xfa, 0x74, 0x4a, 0xdc, 0x85, 0xe3, 0x45, 0xaf, 0x42, 0x3f, 0x22, 0x9e, 0x93, 0x70,
+	0x1a, 0xa3, 0x9e, 0xba, 0x9b, 0x75, 0x64, 0x61, 0xa8, 0xf5, 0x8f, 0x8d, 0xfa, 0x05, 0xa7, 0x31,
+	0x7c, 0x0a, 0x06, 0x22, 0x4e, 0xb8, 0xa0, 0x9e, 0xc3, 0x17, 0x5c, 0xd0, 0xc0, 0xa9, 0xb4, 0x30,
+	0x77, 0x66, 0x44, 0x5c, 0xa1, 0x1d, 0x75, 0xe8, 0xfb, 0x86, 0x77, 0xa9, 0x68, 0xa7, 0x15, 0xd6,
+	0x05, 0x11, 0x57, 0xf0, 0x4b, 0xd0, 0xae, 0xbe, 0x0f, 0x1c, 0x41, 0x75, 0x86, 0x3b, 0xf9, 0x19,
+	0x5e, 0x2a, 0xe5, 0xb9, 0xd4, 0xe1, 0xd6, 0xbc, 0x14, 0x38, 0xfc, 0x09, 0xd8, 0x36, 0xaf, 0x08,
+	0xba, 0xa3, 0x5a, 0xa6, 0x9b, 0xdb, 0xfc, 0x56, 0xc3, 0x38, 0xd7, 0xc3, 0x1f, 0x83, 0xce, 0xcc,
+	0x27, 0x2e, 0x55, 0xfd, 0x2b, 0x5f, 0x07, 0xb4, 0x3b, 0xd8, 0x38, 0x6a, 0xe0, 0x76, 0x81, 0x3e,
+	0x27, 0x53, 0x2e, 0x6b, 0x2f, 0x20, 0xaf, 0x9d, 0x19, 0xf3, 0x38, 0xda, 0x53, 0x4d, 0xa3, 0x6a,
+	0x2f, 0xc7, 0xf0, 0x76, 0x40, 0x5e, 0x5f, 0x30, 0x8f, 0xc3, 0xe7, 0x60, 0xff, 0xf6, 0x17, 0x0b,
+	0xed, 0xab, 0x9d, 0xdc, 0x2f, 0x32, 0x50, 0xb2, 0x2e, 0x0a, 0x12, 0xde, 0x73, 0x6f, 0x83, 0xe1,
+	0x57, 0xa0, 0xa3, 0x5f, 0x3a, 0x79, 0xff, 0x21, 0x09, 0x28, 0x3a, 0x50, 0x39, 0x80, 0x59, 0x6a,
+	0xaf, 0x68, 0x70, 0x5b, 0xc9, 0x2f, 0x8c, 0x58, 0x9a, 0xce, 0x08, 0xe7, 0xaf, 0xa2, 0xd8, 0x43,
+	0x68, 0xd5, 0x34, 0xd7, 0x18, 0xd3, 0x0b, 0x23, 0xc2, 0x5f, 0x80, 0x56, 0xe5, 0x7d, 0xe5, 0xe8,
+	0xae, 0xba, 0x7f, 0x98, 0x9f, 0xe0, 0x4c, 0xea, 0x9e, 0x49, 0x15, 0x6e, 0xb2, 0x62, 0xcd, 0xe1,
+	0xd7, 0xa0, 0xb3, 0xfc, 0x06, 0xa3, 0x43, 0x75, 0xf4, 0xdd, 0xdc, 0xf0, 0x59, 0x34, 0xc5, 0x44,
+	0xd0, 0x67, 0x52, 0x87, 0x5b, 0x7e, 0x45, 0x82, 0x4f, 0x41, 0xb3, 0xf2, 0x52, 0xa3, 0x7b, 0x2a,
+	0xe2, 0x27, 0xb9, 0xe1, 0xf2, 0x13, 0x7d, 0x7c, 0xae, 0x98, 0x32, 0x3f, 0xdf, 0x86, 0x22, 0x5e,
+	0x60, 0x10, 0x14, 0x00, 0xfc, 0x3d, 0xd8, 0xad, 0x16, 0x0f, 0xf5, 0x54, 0xff, 0x72, 0xf4, 0x91,
+	0xf2, 0xd8, 0xca, 0x3d, 0xca, 0x46, 0x1e, 0xa1, 0x2c, 0xb5, 0x6f, 0x65, 0x63, 0x58, 0x29, 0x2b,
+	0xea, 0x49, 0x32, 0x87, 0x17, 0xa0, 0xe6, 0x93, 0x31, 0xf5, 0x39, 0xba, 0xaf, 0x7c, 0x0d, 0x3f,
+	0xb0, 0xbb, 0x67, 0x8a, 0xa4, 0x76, 0x36, 0xda, 0xcd, 0x52, 0xbb, 0xa7, 0xad, 0x2a, 0xcf, 0xbd,
+	0xf1, 0x03, 0x7f, 0x05, 0x80, 0xee, 0x55, 0xee, 0x44, 0x21, 0xea, 0xcb, 0xfa, 0xd3, 0x7b, 0x2a,
+	0xd1, 0x8a, 0x55, 0xc3, 0xa0, 0xbf, 0x0b, 0x61, 0x02, 0x76, 0xca, 0x6f, 0xaa, 0x33, 0x8b, 0x7c,
+	0xe6, 0x2e, 0x90, 0x3d, 0xb0, 0x8e, 0x3a, 0x0f, 0x1f, 0x7c, 0x60, 0x57, 0x65, 0xcb, 0x5f, 0x28,
+	0xfa, 0xc8, 0xce, 0x52, 0xfb, 0xde, 0x7b, 0x5e, 0x2a, 0xf1, 0x7a, 0xde, 0x8a, 0x09, 0x7c, 0x09,
+	0x5a, 0x31, 0x15, 0x71, 0x11, 0x71, 0xa0, 0xd2, 0x7b, 0x50, 0x8d, 0x88, 0xa5, 0xde, 0x44, 0x38,
+	0xcc, 0x52, 0x7b, 0xbf, 0x6a, 0x50, 0x71, 0xde, 0x8c, 0x4b, 0xe2, 0xe1, 0x0b, 0xd0, 0x5d, 0x49,
+	0x29, 0xec, 0x81, 0x8d, 0x6b, 0xba, 0xd0, 0x5f, 0x60, 0x2c, 0x97, 0xf0, 0x53, 0xb0, 0x35, 0x27,
+	0x7e, 0x42, 0xd1, 0xba, 0x8a, 0xba, 0x9f, 0x47, 0x2d, 0x2c, 0x5f, 0x4a, 0x2d, 0xd6, 0xa4, 0xaf,
+	0xd7, 0xbf, 0xb4, 0x0e, 0xbf, 0x02, 0xcd, 0x4a, 0x2e, 0x6e, 0x71, 0xb9, 0x5b, 0x75, 0xd9, 0xa8,
+	0x98, 0x0e, 0x7f, 0x0d, 0x7a, 0xab, 0x17, 0x06, 0x21, 0xe8, 0x60, 0xfa, 0xe7, 0x84, 0xc5, 0xf4,
+	0x32, 0x71, 0x5d, 0xca, 0x79, 0x6f, 0x0d, 0xee, 0x81, 0x1d, 0x83, 0x9d, 0x16, 0x4f, 0x7e, 0xcf,
+	0x1a, 0xfe, 0x73, 0x1d, 0x74, 0x57, 0x6e, 0x43, 0x7d, 0x9c, 0xc8, 0x6b, 0x87, 0x08, 0x75, 0x03,
+	0x7a, 0xb8, 0xd8, 0x32, 0x1f, 0xa7, 0x0a, 0x8e, 0x9b, 0x01, 0x79, 0x7d, 0x62, 0x04, 0xf8, 0x18,
+	0x40, 0x95, 0x40, 0xe2, 0x3b, 0xf2, 0x2b, 0x12, 0x4d, 0x26, 0x4e, 0xc0, 0xd5, 0x76, 0x37, 0x46,
+	0xfb, 0x59, 0x6a, 0xdf, 0xa2, 0xc5, 0x3d, 0x83, 0x8d, 0x34, 0x74, 0xce, 0xe1, 0x08, 0x74, 0x64,
+	0x88, 0x8a, 0x87, 0x0d, 0xe5, 0xe1, 0xa3, 0x2c, 0xb5, 0xd1, 0xb2, 0xa6, 0x92, 0x22, 0xb9, 0xad,
+	0xd2, 0x87, 0x0b, 0xee, 0xaa, 0x94, 0xc9, 0xd1, 0xc4, 0x99, 0x10, 0xe6, 0x27, 0x31, 0x75, 0x62,
+	0x4a, 0x78, 0x14, 0x72, 0xb4, 0xa9, 0x4a, 0xf7, 0x41, 0x96, 0xda, 0x1f, 0x7f, 0x90, 0x54, 0xf1,
+	0x7c, 0x50, 0x90, 0x9e, 0x68, 0x0e, 0xd6, 0x94, 0xe1, 0xdf, 0x2d, 0xd0, 0x94, 0xf7, 0x66, 0xce,
+	0x2f, 0x27, 0x1b, 0x97, 0xfa, 0xbe, 0xc3, 0x3c, 0x33, 0x8b, 0xa9, 0xc9, 0xc6, 0x40, 0xb8, 0x26,
+	0x17, 0x67, 0x9e, 0x7c, 0xe9, 0x96, 0x63, 0xa1, 0xf5, 0xf2, 0xa5, 0x5b, 0xd6, 0xe0, 0xf6, 0xa4,
+	0x1a, 0x51, 0x0e, 0x45, 0x12, 0xa0, 0x9e, 0x43, 0x84, 0xb9, 0x14, 0x35, 0x14, 0x15, 0x20, 0xae,
+	0xeb, 0xe5, 0x89, 0x18, 0xfe, 0x75, 0x1b, 0x6c, 0xca, 0xcd, 0xc1, 0x33, 0xd0, 0x95, 0xd3, 0xb5,
+	0xe3, 0x15, 0xdd, 0x85, 0xac, 0xe5, 0x9a, 0x5c, 0xee, 0xbd, 0x51, 0xfd, 0x4d, 0x6a, 0x5b, 0x59,
+	0x6a, 0xaf, 0xe1, 0x8e, 0x58, 0xd2, 0xc8, 0xf8, 0xca, 0x95, 0x1a, 0x57, 0xf4, 0xae, 0x55, 0xfc,
+	0x02, 0xc4, 0x75, 0xb9, 0x54, 0x83, 0xca, 0x10, 0xd4, 0xbc, 0x28, 0x20, 0x4c, 0x8f, 0x83, 0x66,
+	0x2e, 0xd5, 0x08, 0x36, 0xbf, 0x6a, 0x70, 0x8b, 0x29, 0x11, 0xfa, 0x40, 0x9b, 0xea, 0x40, 0x7a,
+	0x70, 0x2b, 0x50, 0xdc, 0x30, 0xeb, 0x13, 0x21, 0xe9, 0xc9, 0xcc, 0xcb, 0xe9, 0x5b, 0x25, 0xbd,
+	0x44, 0x71, 0xc3, 0xac, 0x4f, 0x84, 0xac, 0xc6, 0x09, 0x8b, 0xb9, 0x70, 0xcc, 0x7c, 0xa3, 0xcd,
+	0x6a, 0x65, 0x35, 0xbe, 0xaf, 0xc5, 0x3d, 0x85, 0x9d, 0xe6, 0xd0, 0x89, 0x80, 0x8f, 0xc0, 0x16,
+	0x17, 0x44, 0x50, 0x35, 0x28, 0x76, 0x1e, 0xc2, 0xea, 0xa5, 0x1d, 0x5f, 0x4a, 0xcd, 0xa8, 0x91,
+	0xa5, 0xb6, 0x26, 0x61, 0xfd, 0x53, 0xad, 0x84, 0xfa, 0x87, 0x2b, 0x61, 0x08, 0x6a, 0x7a, 0x4e,
+	0x43, 0x8d, 0xf2, 0x8a, 0x34, 0x82, 0xcd, 0xaf, 0xe4, 0xe8, 0x94, 0xaa, 0xf1, 0xb0, 0xae, 0x39,
+	0x1a, 0xc1, 0xe6, 0xf7, 0x96, 0x8a, 0x6a, 0xfe, 0xbf, 0x15, 0xf5, 0x0d, 0xe8, 0xc6, 0xf4, 0x4f,
+	0xd4, 0xd5, 0xb3, 0xa1, 0xfc, 0x7e, 0xa8, 0xa1, 0x70, 0x6b, 0x74, 0x27, 0x4b, 0xed, 0x55, 0x15,
+	0xee, 0x14, 0xc0, 0xa9, 0x94, 0xe1, 0x6f, 0x40, 0xaf, 0xa4, 0x98, 0xd0, 0x6a, 0x50, 0xd4, 0x5f,
+	0x92, 0x55, 0x1d, 0x2e, 0x1d, 0x9a, 0xf0, 0x7f, 0x04, 0xdd, 0xa2, 0x76, 0xcd, 0x43, 0xd3, 0x59,
+	0x9e, 0x9e, 0x2a, 0xfd, 0x35, 0xba, 0x9f, 0xa5, 0xf6, 0xdd, 0x15, 0x7e, 0xa5, 0x4f, 0x3b, 0x79,
+	0xed, 0x6b, 0x0d, 0xfc, 0x39, 0xa8, 0xeb, 0xe7, 0x9c, 0x08, 0xd4, 0x2d, 0xb3, 0x9e, 0x63, 0x15,
+	0xcb, 0x6d, 0x85, 0x9d, 0x88, 0xe1, 0x1f, 0xc0, 0x96, 0x4a, 0x29, 0x6c, 0x82, 0xed, 0xb3, 0x70,
+	0x4e, 0x7c, 0xe6, 0xf5, 0xd6, 0xa4, 0x70, 0x41, 0x43, 0x8f, 0x85, 0xd3, 0x9e, 0x25, 0x05, 0x9c,
+	0x84, 0xa1, 0x14, 0xd6, 0x61, 0x1b, 0x34, 0x8a, 0x5a, 0xe9, 0x6d, 0x48, 0x11, 0x53, 0x1e, 0xf9,
+	0x73, 0xa9, 0xdd, 0x94, 0xd4, 0xef, 0x08, 0x13, 0x52, 0xd8, 0x1a, 0x7d, 0xf1, 0xe6, 0x6d, 0xdf,
+	0xfa, 0xfe, 0x6d, 0x7f, 0xed, 0x87, 0xb7, 0x7d, 0xeb, 0x2f, 0x37, 0x7d, 0xeb, 0x1f, 0x37, 0x7d,
+	0xeb, 0x5f, 0x37, 0x7d, 0xeb, 0xcd, 0x4d, 0xdf, 0xfa, 0xcf, 0x4d, 0xdf, 0xfa, 0xef, 0x4d, 0x7f,
+	0xed, 0x87, 0x9b, 0xbe, 0xf5, 0xb7, 0x77, 0xfd, 0xb5, 0x37, 0xef, 0xfa, 0x6b, 0xdf, 0xbf, 0xeb,
+	0xaf, 0x8d, 0x6b, 0xea, 0x8f, 0xdf, 0xa3, 0xff, 0x0d, 0x00, 0x41, 0xa3, 0x9e, 0x03, 0x21, 0x0f,
+	0x00, 0x00,
 }
 
+func (x TaskDefinition_DependencyPolicy) String() string {
+	s, ok := TaskDefinition_DependencyPolicy_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
 func (x Task_State) String() string {
 	s, ok := Task_State_name[int32(x)]
 	if ok {
@@ -699,6 +932,96 @@ func (this *TaskDefinition) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.Labels) != len(that1.Labels) {
+		return false
+	}
+	for i := range this.Labels {
+		if this.Labels[i] != that1.Labels[i] {
+			return false
+		}
+	}
+	if len(this.DependsOn) != len(that1.DependsOn) {
+		return false
+	}
+	for i := range this.DependsOn {
+		if this.DependsOn[i] != that1.DependsOn[i] {
+			return false
+		}
+	}
+	if this.DependencyPolicy != that1.DependencyPolicy {
+		return false
+	}
+	if !this.RetryPolicy.Equal(that1.RetryPolicy) {
+		return false
+	}
+	return true
+}
+func (this *TaskRetryPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskRetryPolicy)
+	if !ok {
+		that2, ok := that.(TaskRetryPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MaxAttempts != that1.MaxAttempts {
+		return false
+	}
+	if this.InitialBackoffMs != that1.InitialBackoffMs {
+		return false
+	}
+	if this.MaxBackoffMs != that1.MaxBackoffMs {
+		return false
+	}
+	if len(this.RetryableFailureReasons) != len(that1.RetryableFailureReasons) {
+		return false
+	}
+	for i := range this.RetryableFailureReasons {
+		if this.RetryableFailureReasons[i] != that1.RetryableFailureReasons[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *TaskAttempt) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskAttempt)
+	if !ok {
+		that2, ok := that.(TaskAttempt)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.CellId != that1.CellId {
+		return false
+	}
+	if this.FailureReason != that1.FailureReason {
+		return false
+	}
+	if this.FailedAt != that1.FailedAt {
+		return false
+	}
 	return true
 }
 func (this *Task) Equal(that interface{}) bool {
@@ -759,13 +1082,24 @@ func (this *Task) Equal(that interface{}) bool {
 	if this.RejectionReason != that1.RejectionReason {
 		return false
 	}
+	if len(this.FailedAttempts) != len(that1.FailedAttempts) {
+		return false
+	}
+	for i := range this.FailedAttempts {
+		if !this.FailedAttempts[i].Equal(that1.FailedAttempts[i]) {
+			return false
+		}
+	}
+	if this.RetryAt != that1.RetryAt {
+		return false
+	}
 	return true
 }
 func (this *TaskDefinition) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 32)
+	s := make([]string, 0, 36)
 	s = append(s, "&models.TaskDefinition{")
 	s = append(s, "RootFs: "+fmt.Sprintf("%#v", this.RootFs)+",\n")
 	if this.EnvironmentVariables != nil {
@@ -827,6 +1161,49 @@ func (this *TaskDefinition) GoString() string {
 	if this.VolumeMountedFiles != nil {
 		s = append(s, "VolumeMountedFiles: "+fmt.Sprintf("%#v", this.VolumeMountedFiles)+",\n")
 	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%#v: %#v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	if this.Labels != nil {
+		s = append(s, "Labels: "+mapStringForLabels+",\n")
+	}
+	s = append(s, "DependsOn: "+fmt.Sprintf("%#v", this.DependsOn)+",\n")
+	s = append(s, "DependencyPolicy: "+fmt.Sprintf("%#v", this.DependencyPolicy)+",\n")
+	if this.RetryPolicy != nil {
+		s = append(s, "RetryPolicy: "+fmt.Sprintf("%#v", this.RetryPolicy)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskRetryPolicy) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 8)
+	s = append(s, "&models.TaskRetryPolicy{")
+	s = append(s, "MaxAttempts: "+fmt.Sprintf("%#v", this.MaxAttempts)+",\n")
+	s = append(s, "InitialBackoffMs: "+fmt.Sprintf("%#v", this.InitialBackoffMs)+",\n")
+	s = append(s, "MaxBackoffMs: "+fmt.Sprintf("%#v", this.MaxBackoffMs)+",\n")
+	s = append(s, "RetryableFailureReasons: "+fmt.Sprintf("%#v", this.RetryableFailureReasons)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskAttempt) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 7)
+	s = append(s, "&models.TaskAttempt{")
+	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
+	s = append(s, "FailedAt: "+fmt.Sprintf("%#v", this.FailedAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -834,7 +1211,7 @@ func (this *Task) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 17)
+	s := make([]string, 0, 19)
 	s = append(s, "&models.Task{")
 	if this.TaskDefinition != nil {
 		s = append(s, "TaskDefinition: "+fmt.Sprintf("%#v", this.TaskDefinition)+",\n")
@@ -851,6 +1228,10 @@ func (this *Task) GoString() string {
 	s = append(s, "FailureReason: "+fmt.Sprintf("%#v", this.FailureReason)+",\n")
 	s = append(s, "RejectionCount: "+fmt.Sprintf("%#v", this.RejectionCount)+",\n")
 	s = append(s, "RejectionReason: "+fmt.Sprintf("%#v", this.RejectionReason)+",\n")
+	if this.FailedAttempts != nil {
+		s = append(s, "FailedAttempts: "+fmt.Sprintf("%#v", this.FailedAttempts)+",\n")
+	}
+	s = append(s, "RetryAt: "+fmt.Sprintf("%#v", this.RetryAt)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -882,6 +1263,59 @@ func (m *TaskDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.DependencyPolicy != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.DependencyPolicy))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xf2
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintTask(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintTask(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintTask(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
 	if len(m.VolumeMountedFiles) > 0 {
 		for iNdEx := len(m.VolumeMountedFiles) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1185,7 +1619,7 @@ func (m *TaskDefinition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Task) Marshal() (dAtA []byte, err error) {
+func (m *TaskRetryPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1195,7 +1629,96 @@ func (m *Task) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetryableFailureReasons) > 0 {
+		for iNdEx := len(m.RetryableFailureReasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetryableFailureReasons[iNdEx])
+			copy(dAtA[i:], m.RetryableFailureReasons[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.RetryableFailureReasons[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxBackoffMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxBackoffMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InitialBackoffMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.InitialBackoffMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.FailedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FailureReason) > 0 {
+		i -= len(m.FailureReason)
+		copy(dAtA[i:], m.FailureReason)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.FailureReason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CellId) > 0 {
+		i -= len(m.CellId)
+		copy(dAtA[i:], m.CellId)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.CellId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -1205,6 +1728,25 @@ func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RetryAt != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.RetryAt))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.FailedAttempts) > 0 {
+		for iNdEx := len(m.FailedAttempts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedAttempts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.RejectionReason) > 0 {
 		i -= len(m.RejectionReason)
 		copy(dAtA[i:], m.RejectionReason)
@@ -1444,6 +1986,71 @@ func (m *TaskDefinition) Size() (n int) {
 			n += 2 + l + sovTask(uint64(l))
 		}
 	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovTask(uint64(len(k))) + 1 + len(v) + sovTask(uint64(len(v)))
+			n += mapEntrySize + 2 + sovTask(uint64(mapEntrySize))
+		}
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 2 + l + sovTask(uint64(l))
+		}
+	}
+	if m.DependencyPolicy != 0 {
+		n += 2 + sovTask(uint64(m.DependencyPolicy))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovTask(uint64(l))
+	}
+	return n
+}
+
+func (m *TaskRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTask(uint64(m.MaxAttempts))
+	}
+	if m.InitialBackoffMs != 0 {
+		n += 1 + sovTask(uint64(m.InitialBackoffMs))
+	}
+	if m.MaxBackoffMs != 0 {
+		n += 1 + sovTask(uint64(m.MaxBackoffMs))
+	}
+	if len(m.RetryableFailureReasons) > 0 {
+		for _, s := range m.RetryableFailureReasons {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CellId)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.FailureReason)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.FailedAt != 0 {
+		n += 1 + sovTask(uint64(m.FailedAt))
+	}
 	return n
 }
 
@@ -1499,6 +2106,15 @@ func (m *Task) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTask(uint64(l))
 	}
+	if len(m.FailedAttempts) > 0 {
+		for _, e := range m.FailedAttempts {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.RetryAt != 0 {
+		n += 1 + sovTask(uint64(m.RetryAt))
+	}
 	return n
 }
 
@@ -1552,6 +2168,16 @@ func (this *TaskDefinition) String() string {
 		mapStringForMetricTags += fmt.Sprintf("%v: %v,", k, this.MetricTags[k])
 	}
 	mapStringForMetricTags += "}"
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k, _ := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
 	s := strings.Join([]string{`&TaskDefinition{`,
 		`RootFs:` + fmt.Sprintf("%v", this.RootFs) + `,`,
 		`EnvironmentVariables:` + repeatedStringForEnvironmentVariables + `,`,
@@ -1581,6 +2207,35 @@ func (this *TaskDefinition) String() string {
 		`LogRateLimit:` + strings.Replace(fmt.Sprintf("%v", this.LogRateLimit), "LogRateLimit", "LogRateLimit", 1) + `,`,
 		`MetricTags:` + mapStringForMetricTags + `,`,
 		`VolumeMountedFiles:` + repeatedStringForVolumeMountedFiles + `,`,
+		`Labels:` + mapStringForLabels + `,`,
+		`DependsOn:` + fmt.Sprintf("%v", this.DependsOn) + `,`,
+		`DependencyPolicy:` + fmt.Sprintf("%v", this.DependencyPolicy) + `,`,
+		`RetryPolicy:` + strings.Replace(this.RetryPolicy.String(), "TaskRetryPolicy", "TaskRetryPolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskRetryPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskRetryPolicy{`,
+		`MaxAttempts:` + fmt.Sprintf("%v", this.MaxAttempts) + `,`,
+		`InitialBackoffMs:` + fmt.Sprintf("%v", this.InitialBackoffMs) + `,`,
+		`MaxBackoffMs:` + fmt.Sprintf("%v", this.MaxBackoffMs) + `,`,
+		`RetryableFailureReasons:` + fmt.Sprintf("%v", this.RetryableFailureReasons) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskAttempt) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskAttempt{`,
+		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
+		`FailedAt:` + fmt.Sprintf("%v", this.FailedAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -1589,6 +2244,11 @@ func (this *Task) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForFailedAttempts := "[]*TaskAttempt{"
+	for _, f := range this.FailedAttempts {
+		repeatedStringForFailedAttempts += strings.Replace(f.String(), "TaskAttempt", "TaskAttempt", 1) + ","
+	}
+	repeatedStringForFailedAttempts += "}"
 	s := strings.Join([]string{`&Task{`,
 		`TaskDefinition:` + strings.Replace(this.TaskDefinition.String(), "TaskDefinition", "TaskDefinition", 1) + `,`,
 		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
@@ -1603,6 +2263,8 @@ func (this *Task) String() string {
 		`FailureReason:` + fmt.Sprintf("%v", this.FailureReason) + `,`,
 		`RejectionCount:` + fmt.Sprintf("%v", this.RejectionCount) + `,`,
 		`RejectionReason:` + fmt.Sprintf("%v", this.RejectionReason) + `,`,
+		`FailedAttempts:` + repeatedStringForFailedAttempts + `,`,
+		`RetryAt:` + fmt.Sprintf("%v", this.RetryAt) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2601,59 +3263,9 @@ func (m *TaskDefinition) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTask(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTask
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Task) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTask
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Task: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2680,47 +3292,583 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TaskDefinition == nil {
-				m.TaskDefinition = &TaskDefinition{}
-			}
-			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTask
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTask
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTask
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTask
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskGuid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthTask
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthTask
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTask
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthTask
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthTask
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipTask(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthTask
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependencyPolicy", wireType)
+			}
+			m.DependencyPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DependencyPolicy |= TaskDefinition_DependencyPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &TaskRetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialBackoffMs", wireType)
+			}
+			m.InitialBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialBackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackoffMs", wireType)
+			}
+			m.MaxBackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackoffMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryableFailureReasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryableFailureReasons = append(m.RetryableFailureReasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailureReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAt", wireType)
+			}
+			m.FailedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskDefinition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskDefinition == nil {
+				m.TaskDefinition = &TaskDefinition{}
+			}
+			if err := m.TaskDefinition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
 			}
 			var stringLen uint64
@@ -2994,6 +4142,59 @@ func (m *Task) Unmarshal(dAtA []byte) error {
 			}
 			m.RejectionReason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedAttempts = append(m.FailedAttempts, &TaskAttempt{})
+			if err := m.FailedAttempts[len(m.FailedAttempts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryAt", wireType)
+			}
+			m.RetryAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
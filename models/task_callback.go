@@ -0,0 +1,23 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (s *TaskCallback_State) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		return err
+	}
+
+	if v, found := TaskCallback_State_value[name]; found {
+		*s = TaskCallback_State(v)
+		return nil
+	}
+	return fmt.Errorf("invalid task callback state: %s", name)
+}
+
+func (s TaskCallback_State) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.String())
+}
@@ -0,0 +1,721 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: task_callback.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TaskCallback_State int32
+
+const (
+	TaskCallback_Pending      TaskCallback_State = 0
+	TaskCallback_DeadLettered TaskCallback_State = 1
+)
+
+var TaskCallback_State_name = map[int32]string{
+	0: "Pending",
+	1: "DeadLettered",
+}
+
+var TaskCallback_State_value = map[string]int32{
+	"Pending":      0,
+	"DeadLettered": 1,
+}
+
+func (TaskCallback_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_467b133a5f2fb9f1, []int{0, 0}
+}
+
+// TaskCallback is the delivery of the completion callback of a task, kept
+// until the callback succeeds or the task is deleted.
+type TaskCallback struct {
+	TaskGuid       string             `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+	State          TaskCallback_State `protobuf:"varint,2,opt,name=state,proto3,enum=models.TaskCallback_State" json:"state"`
+	Attempts       int32              `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts"`
+	NextAttemptAt  int64              `protobuf:"varint,4,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at"`
+	LastStatusCode int32              `protobuf:"varint,5,opt,name=last_status_code,json=lastStatusCode,proto3" json:"last_status_code,omitempty"`
+	LastError      string             `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	CreatedAt      int64              `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	UpdatedAt      int64              `protobuf:"varint,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+}
+
+func (m *TaskCallback) Reset()      { *m = TaskCallback{} }
+func (*TaskCallback) ProtoMessage() {}
+func (*TaskCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_467b133a5f2fb9f1, []int{0}
+}
+func (m *TaskCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCallback.Merge(m, src)
+}
+func (m *TaskCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCallback proto.InternalMessageInfo
+
+func (m *TaskCallback) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+func (m *TaskCallback) GetState() TaskCallback_State {
+	if m != nil {
+		return m.State
+	}
+	return TaskCallback_Pending
+}
+
+func (m *TaskCallback) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *TaskCallback) GetNextAttemptAt() int64 {
+	if m != nil {
+		return m.NextAttemptAt
+	}
+	return 0
+}
+
+func (m *TaskCallback) GetLastStatusCode() int32 {
+	if m != nil {
+		return m.LastStatusCode
+	}
+	return 0
+}
+
+func (m *TaskCallback) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *TaskCallback) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *TaskCallback) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("models.TaskCallback_State", TaskCallback_State_name, TaskCallback_State_value)
+	proto.RegisterType((*TaskCallback)(nil), "models.TaskCallback")
+}
+
+func init() { proto.RegisterFile("task_callback.proto", fileDescriptor_467b133a5f2fb9f1) }
+
+var fileDescriptor_467b133a5f2fb9f1 = []byte{
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x84, 0xe4, 0xe2, 0x25, 0x17, 0xa2, 0x3d, 0x0a, 0x2b, 0xc5, 0x3a, 0xba, 0x02,
+	0x59, 0x88, 0xf3, 0x49, 0x80, 0x44, 0x71, 0x55, 0x7c, 0x20, 0x28, 0x28, 0x90, 0x8f, 0xde, 0x5a,
+	0x7b, 0x17, 0x63, 0xc5, 0xce, 0x46, 0xf6, 0x58, 0x82, 0x8e, 0x47, 0xe0, 0x31, 0x78, 0x14, 0xca,
+	0x94, 0x57, 0x59, 0xc4, 0x29, 0x40, 0xae, 0xee, 0x11, 0xd0, 0xae, 0xcd, 0x39, 0x4a, 0xe5, 0x7f,
+	0xbe, 0xff, 0x9f, 0xd9, 0xd1, 0xc8, 0xf8, 0x0c, 0x58, 0xb1, 0x0a, 0x22, 0x96, 0xa6, 0x21, 0x8b,
+	0x56, 0xee, 0x26, 0x97, 0x20, 0xc9, 0x28, 0x93, 0x5c, 0xa4, 0xc5, 0xfc, 0x22, 0x4e, 0xe0, 0x4b,
+	0x19, 0xba, 0x91, 0xcc, 0x2e, 0x63, 0x19, 0xcb, 0x4b, 0x6d, 0x87, 0xe5, 0x67, 0x5d, 0xe9, 0x42,
+	0xab, 0xb6, 0xed, 0xfc, 0xcf, 0x00, 0x4f, 0x3e, 0xb1, 0x62, 0x75, 0xdd, 0x4d, 0x23, 0xcf, 0xb0,
+	0xa9, 0xc7, 0xc7, 0x65, 0xc2, 0x2d, 0xb4, 0x40, 0x8e, 0xe9, 0x9d, 0x36, 0x95, 0xdd, 0x43, 0x7f,
+	0xac, 0xe4, 0xbb, 0x32, 0xe1, 0xe4, 0x0a, 0x0f, 0x0b, 0x60, 0x20, 0xac, 0x07, 0x0b, 0xe4, 0x4c,
+	0x5f, 0xcc, 0xdd, 0x76, 0x07, 0xf7, 0x70, 0xa0, 0x7b, 0xa3, 0x12, 0x9e, 0xd9, 0x54, 0x76, 0x1b,
+	0xf6, 0xdb, 0x0f, 0x71, 0xf0, 0x98, 0x01, 0x88, 0x6c, 0x03, 0x85, 0x35, 0x58, 0x20, 0x67, 0xe8,
+	0x4d, 0x9a, 0xca, 0xbe, 0x67, 0xfe, 0xbd, 0x22, 0x57, 0xf8, 0xf1, 0x5a, 0x7c, 0x85, 0xa0, 0x03,
+	0x01, 0x03, 0xeb, 0xe1, 0x02, 0x39, 0x03, 0xef, 0xac, 0xa9, 0xec, 0x63, 0xcb, 0x3f, 0x55, 0x60,
+	0xd9, 0xd6, 0x4b, 0x20, 0xef, 0xf1, 0x2c, 0x65, 0x05, 0x04, 0xea, 0xd1, 0xb2, 0x08, 0x22, 0xc9,
+	0x85, 0x35, 0xd4, 0xcf, 0xd1, 0xa6, 0xb2, 0xe7, 0xc7, 0xde, 0x73, 0x99, 0x25, 0xba, 0xf5, 0x9b,
+	0x3f, 0x55, 0xde, 0x8d, 0xb6, 0xae, 0x25, 0x17, 0xe4, 0x35, 0xc6, 0x3a, 0x2d, 0xf2, 0x5c, 0xe6,
+	0xd6, 0x48, 0x9f, 0xc6, 0x6a, 0x2a, 0xfb, 0x49, 0x4f, 0x0f, 0xba, 0x4d, 0x45, 0xdf, 0x2a, 0x48,
+	0x2e, 0x30, 0x8e, 0x72, 0xc1, 0x40, 0x70, 0xb5, 0xfa, 0x89, 0x5e, 0x7d, 0xda, 0x54, 0xf6, 0x01,
+	0xf5, 0xcd, 0x4e, 0x2f, 0x41, 0xc5, 0xcb, 0x0d, 0xff, 0x1f, 0x1f, 0xf7, 0xf1, 0x9e, 0xfa, 0x66,
+	0xa7, 0x97, 0x70, 0xfe, 0x14, 0x0f, 0xf5, 0x89, 0xc9, 0x23, 0x7c, 0xf2, 0x51, 0xac, 0x79, 0xb2,
+	0x8e, 0x67, 0x06, 0x99, 0xe1, 0xc9, 0x1b, 0xc1, 0xf8, 0x07, 0x01, 0x20, 0x72, 0xc1, 0x67, 0xc8,
+	0x7b, 0xb5, 0xdd, 0x51, 0x74, 0xbb, 0xa3, 0xc6, 0xdd, 0x8e, 0xa2, 0xef, 0x35, 0x45, 0x3f, 0x6b,
+	0x8a, 0x7e, 0xd5, 0x14, 0x6d, 0x6b, 0x8a, 0x7e, 0xd7, 0x14, 0xfd, 0xad, 0xa9, 0x71, 0x57, 0x53,
+	0xf4, 0x63, 0x4f, 0x8d, 0xed, 0x9e, 0x1a, 0xb7, 0x7b, 0x6a, 0x84, 0x23, 0xfd, 0x9b, 0xbc, 0xfc,
+	0x37, 0x00, 0xd7, 0xec, 0xb8, 0x1e, 0x74, 0x02, 0x00, 0x00,
+}
+
+func (x TaskCallback_State) String() string {
+	s, ok := TaskCallback_State_name[int32(x)]
+	if ok {
+		return s
+	}
+	return strconv.Itoa(int(x))
+}
+func (this *TaskCallback) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskCallback)
+	if !ok {
+		that2, ok := that.(TaskCallback)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	if this.State != that1.State {
+		return false
+	}
+	if this.Attempts != that1.Attempts {
+		return false
+	}
+	if this.NextAttemptAt != that1.NextAttemptAt {
+		return false
+	}
+	if this.LastStatusCode != that1.LastStatusCode {
+		return false
+	}
+	if this.LastError != that1.LastError {
+		return false
+	}
+	if this.CreatedAt != that1.CreatedAt {
+		return false
+	}
+	if this.UpdatedAt != that1.UpdatedAt {
+		return false
+	}
+	return true
+}
+func (this *TaskCallback) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 12)
+	s = append(s, "&models.TaskCallback{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "State: "+fmt.Sprintf("%#v", this.State)+",\n")
+	s = append(s, "Attempts: "+fmt.Sprintf("%#v", this.Attempts)+",\n")
+	s = append(s, "NextAttemptAt: "+fmt.Sprintf("%#v", this.NextAttemptAt)+",\n")
+	s = append(s, "LastStatusCode: "+fmt.Sprintf("%#v", this.LastStatusCode)+",\n")
+	s = append(s, "LastError: "+fmt.Sprintf("%#v", this.LastError)+",\n")
+	s = append(s, "CreatedAt: "+fmt.Sprintf("%#v", this.CreatedAt)+",\n")
+	s = append(s, "UpdatedAt: "+fmt.Sprintf("%#v", this.UpdatedAt)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTaskCallback(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TaskCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintTaskCallback(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LastStatusCode != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.LastStatusCode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextAttemptAt != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.NextAttemptAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attempts != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.State != 0 {
+		i = encodeVarintTaskCallback(dAtA, i, uint64(m.State))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintTaskCallback(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTaskCallback(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTaskCallback(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovTaskCallback(uint64(l))
+	}
+	if m.State != 0 {
+		n += 1 + sovTaskCallback(uint64(m.State))
+	}
+	if m.Attempts != 0 {
+		n += 1 + sovTaskCallback(uint64(m.Attempts))
+	}
+	if m.NextAttemptAt != 0 {
+		n += 1 + sovTaskCallback(uint64(m.NextAttemptAt))
+	}
+	if m.LastStatusCode != 0 {
+		n += 1 + sovTaskCallback(uint64(m.LastStatusCode))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovTaskCallback(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovTaskCallback(uint64(m.CreatedAt))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovTaskCallback(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovTaskCallback(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTaskCallback(x uint64) (n int) {
+	return sovTaskCallback(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TaskCallback) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskCallback{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`State:` + fmt.Sprintf("%v", this.State) + `,`,
+		`Attempts:` + fmt.Sprintf("%v", this.Attempts) + `,`,
+		`NextAttemptAt:` + fmt.Sprintf("%v", this.NextAttemptAt) + `,`,
+		`LastStatusCode:` + fmt.Sprintf("%v", this.LastStatusCode) + `,`,
+		`LastError:` + fmt.Sprintf("%v", this.LastError) + `,`,
+		`CreatedAt:` + fmt.Sprintf("%v", this.CreatedAt) + `,`,
+		`UpdatedAt:` + fmt.Sprintf("%v", this.UpdatedAt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskCallback(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TaskCallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskCallback
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			m.State = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.State |= TaskCallback_State(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAttemptAt", wireType)
+			}
+			m.NextAttemptAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextAttemptAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStatusCode", wireType)
+			}
+			m.LastStatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastStatusCode |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskCallback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskCallback(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskCallback
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTaskCallback(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTaskCallback
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskCallback
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTaskCallback
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTaskCallback
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTaskCallback
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTaskCallback        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTaskCallback          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTaskCallback = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,19 @@
+package models
+
+func (request *TaskCallbacksRequest) Validate() error {
+	return nil
+}
+
+func (request *ReplayTaskCallbackRequest) Validate() error {
+	var validationError ValidationError
+
+	if request.TaskGuid == "" {
+		validationError = validationError.Append(ErrInvalidField{"task_guid"})
+	}
+
+	if !validationError.Empty() {
+		return validationError
+	}
+
+	return nil
+}
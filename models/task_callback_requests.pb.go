@@ -0,0 +1,1185 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: task_callback_requests.proto
+
+package models
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type TaskCallbacksRequest struct {
+	DeadLettered bool `protobuf:"varint,1,opt,name=dead_lettered,json=deadLettered,proto3" json:"dead_lettered"`
+}
+
+func (m *TaskCallbacksRequest) Reset()      { *m = TaskCallbacksRequest{} }
+func (*TaskCallbacksRequest) ProtoMessage() {}
+func (*TaskCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3751e1b26244693a, []int{0}
+}
+func (m *TaskCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCallbacksRequest.Merge(m, src)
+}
+func (m *TaskCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCallbacksRequest proto.InternalMessageInfo
+
+func (m *TaskCallbacksRequest) GetDeadLettered() bool {
+	if m != nil {
+		return m.DeadLettered
+	}
+	return false
+}
+
+type TaskCallbacksResponse struct {
+	Error         *Error          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	TaskCallbacks []*TaskCallback `protobuf:"bytes,2,rep,name=task_callbacks,json=taskCallbacks,proto3" json:"task_callbacks,omitempty"`
+}
+
+func (m *TaskCallbacksResponse) Reset()      { *m = TaskCallbacksResponse{} }
+func (*TaskCallbacksResponse) ProtoMessage() {}
+func (*TaskCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3751e1b26244693a, []int{1}
+}
+func (m *TaskCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCallbacksResponse.Merge(m, src)
+}
+func (m *TaskCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCallbacksResponse proto.InternalMessageInfo
+
+func (m *TaskCallbacksResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *TaskCallbacksResponse) GetTaskCallbacks() []*TaskCallback {
+	if m != nil {
+		return m.TaskCallbacks
+	}
+	return nil
+}
+
+type ReplayTaskCallbackRequest struct {
+	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
+}
+
+func (m *ReplayTaskCallbackRequest) Reset()      { *m = ReplayTaskCallbackRequest{} }
+func (*ReplayTaskCallbackRequest) ProtoMessage() {}
+func (*ReplayTaskCallbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3751e1b26244693a, []int{2}
+}
+func (m *ReplayTaskCallbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayTaskCallbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayTaskCallbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayTaskCallbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayTaskCallbackRequest.Merge(m, src)
+}
+func (m *ReplayTaskCallbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayTaskCallbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayTaskCallbackRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayTaskCallbackRequest proto.InternalMessageInfo
+
+func (m *ReplayTaskCallbackRequest) GetTaskGuid() string {
+	if m != nil {
+		return m.TaskGuid
+	}
+	return ""
+}
+
+type ReplayTaskCallbackResponse struct {
+	Error        *Error        `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	TaskCallback *TaskCallback `protobuf:"bytes,2,opt,name=task_callback,json=taskCallback,proto3" json:"task_callback,omitempty"`
+}
+
+func (m *ReplayTaskCallbackResponse) Reset()      { *m = ReplayTaskCallbackResponse{} }
+func (*ReplayTaskCallbackResponse) ProtoMessage() {}
+func (*ReplayTaskCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3751e1b26244693a, []int{3}
+}
+func (m *ReplayTaskCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReplayTaskCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReplayTaskCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReplayTaskCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplayTaskCallbackResponse.Merge(m, src)
+}
+func (m *ReplayTaskCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReplayTaskCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplayTaskCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplayTaskCallbackResponse proto.InternalMessageInfo
+
+func (m *ReplayTaskCallbackResponse) GetError() *Error {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+func (m *ReplayTaskCallbackResponse) GetTaskCallback() *TaskCallback {
+	if m != nil {
+		return m.TaskCallback
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TaskCallbacksRequest)(nil), "models.TaskCallbacksRequest")
+	proto.RegisterType((*TaskCallbacksResponse)(nil), "models.TaskCallbacksResponse")
+	proto.RegisterType((*ReplayTaskCallbackRequest)(nil), "models.ReplayTaskCallbackRequest")
+	proto.RegisterType((*ReplayTaskCallbackResponse)(nil), "models.ReplayTaskCallbackResponse")
+}
+
+func init() { proto.RegisterFile("task_callback_requests.proto", fileDescriptor_3751e1b26244693a) }
+
+var fileDescriptor_3751e1b26244693a = []byte{
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0x41, 0x4b, 0x3a, 0x41,
+	0x18, 0xc6, 0x77, 0xfc, 0xf3, 0x17, 0x1d, 0xdd, 0xa0, 0xcd, 0xc0, 0x24, 0x5e, 0x65, 0xbb, 0x48,
+	0xd0, 0x0a, 0x16, 0x41, 0x74, 0x33, 0xc2, 0x4b, 0x74, 0x58, 0xba, 0xcb, 0xae, 0x3b, 0x6d, 0xe2,
+	0xda, 0xd8, 0xcc, 0xec, 0x41, 0xe8, 0xd0, 0x47, 0xe8, 0x63, 0xf4, 0x51, 0x3a, 0x7a, 0xf4, 0x24,
+	0x39, 0x5e, 0xc2, 0x93, 0x1f, 0x21, 0x9c, 0xd9, 0x68, 0x37, 0xea, 0xd0, 0xed, 0x7d, 0x9e, 0xf7,
+	0x9d, 0xe7, 0x7d, 0x7f, 0x0c, 0xde, 0x17, 0x1e, 0x1f, 0xf6, 0xfa, 0x5e, 0x14, 0xf9, 0x5e, 0x7f,
+	0xd8, 0x63, 0xe4, 0x21, 0x26, 0x5c, 0x70, 0x67, 0xcc, 0xa8, 0xa0, 0x56, 0x7e, 0x44, 0x03, 0x12,
+	0xf1, 0xda, 0x51, 0x38, 0x10, 0x77, 0xb1, 0xef, 0xf4, 0xe9, 0xa8, 0x15, 0xd2, 0x90, 0xb6, 0x54,
+	0xdb, 0x8f, 0x6f, 0x95, 0x52, 0x42, 0x55, 0xfa, 0x59, 0xad, 0x44, 0x18, 0xa3, 0x2c, 0x11, 0x3b,
+	0x99, 0x0d, 0xda, 0xb4, 0xaf, 0x71, 0xe5, 0xc6, 0xe3, 0xc3, 0x8b, 0xc4, 0xe5, 0xae, 0xde, 0x6b,
+	0x9d, 0x62, 0x33, 0x20, 0x5e, 0xd0, 0x8b, 0x88, 0x10, 0x84, 0x91, 0xa0, 0x8a, 0x1a, 0xa8, 0x59,
+	0xe8, 0x6c, 0xaf, 0xe6, 0xf5, 0x6c, 0xc3, 0x2d, 0x6f, 0xe4, 0x55, 0xa2, 0xec, 0x09, 0xde, 0xfd,
+	0x96, 0xc7, 0xc7, 0xf4, 0x9e, 0x13, 0xeb, 0x00, 0xff, 0x57, 0xc7, 0xa8, 0xa0, 0x52, 0xdb, 0x74,
+	0x34, 0x91, 0x73, 0xb9, 0x31, 0x5d, 0xdd, 0xb3, 0xce, 0xf1, 0x56, 0xe6, 0x48, 0x5e, 0xcd, 0x35,
+	0xfe, 0x35, 0x4b, 0xed, 0xca, 0xe7, 0x74, 0x3a, 0xdb, 0x35, 0x45, 0x7a, 0x93, 0xdd, 0xc5, 0x7b,
+	0x2e, 0x19, 0x47, 0xde, 0x24, 0x33, 0x94, 0xf0, 0x1c, 0xe2, 0xa2, 0x4a, 0x0e, 0xe3, 0x81, 0x66,
+	0x29, 0x76, 0xcc, 0xd5, 0xbc, 0xfe, 0x65, 0xba, 0x85, 0x4d, 0xd9, 0x8d, 0x07, 0x81, 0xfd, 0x88,
+	0x6b, 0x3f, 0x05, 0xfd, 0x05, 0xe4, 0x0c, 0x9b, 0x19, 0x90, 0x6a, 0xae, 0x81, 0x7e, 0xe5, 0x28,
+	0xa7, 0x39, 0x3a, 0x27, 0xd3, 0x05, 0x18, 0xb3, 0x05, 0x18, 0xeb, 0x05, 0xa0, 0x27, 0x09, 0xe8,
+	0x45, 0x02, 0x7a, 0x95, 0x80, 0xa6, 0x12, 0xd0, 0x9b, 0x04, 0xf4, 0x2e, 0xc1, 0x58, 0x4b, 0x40,
+	0xcf, 0x4b, 0x30, 0xa6, 0x4b, 0x30, 0x66, 0x4b, 0x30, 0xfc, 0xbc, 0xfa, 0xce, 0xe3, 0x8f, 0x01,
+	0x00, 0x19, 0x42, 0x7e, 0x92, 0x47, 0x02, 0x00, 0x00,
+}
+
+func (this *TaskCallbacksRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskCallbacksRequest)
+	if !ok {
+		that2, ok := that.(TaskCallbacksRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.DeadLettered != that1.DeadLettered {
+		return false
+	}
+	return true
+}
+func (this *TaskCallbacksResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TaskCallbacksResponse)
+	if !ok {
+		that2, ok := that.(TaskCallbacksResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if len(this.TaskCallbacks) != len(that1.TaskCallbacks) {
+		return false
+	}
+	for i := range this.TaskCallbacks {
+		if !this.TaskCallbacks[i].Equal(that1.TaskCallbacks[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ReplayTaskCallbackRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayTaskCallbackRequest)
+	if !ok {
+		that2, ok := that.(ReplayTaskCallbackRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.TaskGuid != that1.TaskGuid {
+		return false
+	}
+	return true
+}
+func (this *ReplayTaskCallbackResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ReplayTaskCallbackResponse)
+	if !ok {
+		that2, ok := that.(ReplayTaskCallbackResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Error.Equal(that1.Error) {
+		return false
+	}
+	if !this.TaskCallback.Equal(that1.TaskCallback) {
+		return false
+	}
+	return true
+}
+func (this *TaskCallbacksRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.TaskCallbacksRequest{")
+	s = append(s, "DeadLettered: "+fmt.Sprintf("%#v", this.DeadLettered)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *TaskCallbacksResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.TaskCallbacksResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.TaskCallbacks != nil {
+		s = append(s, "TaskCallbacks: "+fmt.Sprintf("%#v", this.TaskCallbacks)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplayTaskCallbackRequest) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 5)
+	s = append(s, "&models.ReplayTaskCallbackRequest{")
+	s = append(s, "TaskGuid: "+fmt.Sprintf("%#v", this.TaskGuid)+",\n")
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func (this *ReplayTaskCallbackResponse) GoString() string {
+	if this == nil {
+		return "nil"
+	}
+	s := make([]string, 0, 6)
+	s = append(s, "&models.ReplayTaskCallbackResponse{")
+	if this.Error != nil {
+		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
+	}
+	if this.TaskCallback != nil {
+		s = append(s, "TaskCallback: "+fmt.Sprintf("%#v", this.TaskCallback)+",\n")
+	}
+	s = append(s, "}")
+	return strings.Join(s, "")
+}
+func valueToGoStringTaskCallbackRequests(v interface{}, typ string) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("func(v %v) *%v { return &v } ( %#v )", typ, typ, pv)
+}
+func (m *TaskCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeadLettered {
+		i--
+		if m.DeadLettered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskCallbacks) > 0 {
+		for iNdEx := len(m.TaskCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskCallbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskCallbackRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskCallbackRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayTaskCallbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayTaskCallbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayTaskCallbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskGuid) > 0 {
+		i -= len(m.TaskGuid)
+		copy(dAtA[i:], m.TaskGuid)
+		i = encodeVarintTaskCallbackRequests(dAtA, i, uint64(len(m.TaskGuid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReplayTaskCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReplayTaskCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReplayTaskCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TaskCallback != nil {
+		{
+			size, err := m.TaskCallback.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskCallbackRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Error != nil {
+		{
+			size, err := m.Error.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskCallbackRequests(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTaskCallbackRequests(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTaskCallbackRequests(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DeadLettered {
+		n += 2
+	}
+	return n
+}
+
+func (m *TaskCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskCallbackRequests(uint64(l))
+	}
+	if len(m.TaskCallbacks) > 0 {
+		for _, e := range m.TaskCallbacks {
+			l = e.Size()
+			n += 1 + l + sovTaskCallbackRequests(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReplayTaskCallbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskGuid)
+	if l > 0 {
+		n += 1 + l + sovTaskCallbackRequests(uint64(l))
+	}
+	return n
+}
+
+func (m *ReplayTaskCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Error != nil {
+		l = m.Error.Size()
+		n += 1 + l + sovTaskCallbackRequests(uint64(l))
+	}
+	if m.TaskCallback != nil {
+		l = m.TaskCallback.Size()
+		n += 1 + l + sovTaskCallbackRequests(uint64(l))
+	}
+	return n
+}
+
+func sovTaskCallbackRequests(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTaskCallbackRequests(x uint64) (n int) {
+	return sovTaskCallbackRequests(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *TaskCallbacksRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&TaskCallbacksRequest{`,
+		`DeadLettered:` + fmt.Sprintf("%v", this.DeadLettered) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *TaskCallbacksResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForTaskCallbacks := "[]*TaskCallback{"
+	for _, f := range this.TaskCallbacks {
+		repeatedStringForTaskCallbacks += strings.Replace(fmt.Sprintf("%v", f), "TaskCallback", "TaskCallback", 1) + ","
+	}
+	repeatedStringForTaskCallbacks += "}"
+	s := strings.Join([]string{`&TaskCallbacksResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`TaskCallbacks:` + repeatedStringForTaskCallbacks + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayTaskCallbackRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayTaskCallbackRequest{`,
+		`TaskGuid:` + fmt.Sprintf("%v", this.TaskGuid) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ReplayTaskCallbackResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ReplayTaskCallbackResponse{`,
+		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
+		`TaskCallback:` + strings.Replace(fmt.Sprintf("%v", this.TaskCallback), "TaskCallback", "TaskCallback", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringTaskCallbackRequests(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *TaskCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskCallbackRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadLettered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeadLettered = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskCallbackRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskCallbackRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCallbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskCallbacks = append(m.TaskCallbacks, &TaskCallback{})
+			if err := m.TaskCallbacks[len(m.TaskCallbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskCallbackRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayTaskCallbackRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskCallbackRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayTaskCallbackRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayTaskCallbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskGuid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskGuid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskCallbackRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReplayTaskCallbackResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskCallbackRequests
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReplayTaskCallbackResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReplayTaskCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Error == nil {
+				m.Error = &Error{}
+			}
+			if err := m.Error.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskCallback", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TaskCallback == nil {
+				m.TaskCallback = &TaskCallback{}
+			}
+			if err := m.TaskCallback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskCallbackRequests(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskCallbackRequests
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTaskCallbackRequests(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTaskCallbackRequests
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTaskCallbackRequests
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTaskCallbackRequests
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTaskCallbackRequests
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTaskCallbackRequests
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTaskCallbackRequests        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTaskCallbackRequests          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTaskCallbackRequests = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,6 +15,13 @@ func (req *DesireTaskRequest) Validate() error {
 		validationError = validationError.Append(ErrInvalidField{"task_definition"})
 	} else if defErr := req.TaskDefinition.Validate(); defErr != nil {
 		validationError = validationError.Append(defErr)
+	} else {
+		for _, parentGuid := range req.TaskDefinition.DependsOn {
+			if parentGuid == req.TaskGuid {
+				validationError = validationError.Append(ErrInvalidField{"depends_on"})
+				break
+			}
+		}
 	}
 
 	if !validationError.Empty() {
@@ -93,7 +100,8 @@ func (req *RejectTaskRequest) Validate() error {
 }
 
 func (req *TasksRequest) Validate() error {
-	return nil
+	validationError := validatePagination(req.PageSize, req.PageToken)
+	return validationError.Check(LabelSelector(req.LabelSelector)).ToError()
 }
 
 func (request *TaskByGuidRequest) Validate() error {
@@ -534,8 +534,11 @@ func (m *TaskCallbackResponse) GetCreatedAt() int64 {
 }
 
 type TasksRequest struct {
-	Domain string `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
-	CellId string `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	Domain        string                      `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain"`
+	CellId        string                      `protobuf:"bytes,2,opt,name=cell_id,json=cellId,proto3" json:"cell_id"`
+	PageSize      int32                       `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                      `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	LabelSelector []*LabelSelectorRequirement `protobuf:"bytes,5,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty"`
 }
 
 func (m *TasksRequest) Reset()      { *m = TasksRequest{} }
@@ -584,9 +587,32 @@ func (m *TasksRequest) GetCellId() string {
 	return ""
 }
 
+func (m *TasksRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *TasksRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *TasksRequest) GetLabelSelector() []*LabelSelectorRequirement {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return nil
+}
+
 type TasksResponse struct {
-	Error *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	Tasks []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	Error           *Error  `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	Tasks           []*Task `protobuf:"bytes,2,rep,name=tasks,proto3" json:"tasks,omitempty"`
+	NextPageToken   string  `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	ResourceVersion uint64  `protobuf:"varint,4,opt,name=resource_version,json=resourceVersion,proto3" json:"resource_version,omitempty"`
 }
 
 func (m *TasksResponse) Reset()      { *m = TasksResponse{} }
@@ -635,6 +661,20 @@ func (m *TasksResponse) GetTasks() []*Task {
 	return nil
 }
 
+func (m *TasksResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *TasksResponse) GetResourceVersion() uint64 {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return 0
+}
+
 type TaskByGuidRequest struct {
 	TaskGuid string `protobuf:"bytes,1,opt,name=task_guid,json=taskGuid,proto3" json:"task_guid"`
 }
@@ -748,49 +788,56 @@ func init() {
 func init() { proto.RegisterFile("task_requests.proto", fileDescriptor_13f778b8a0251259) }
 
 var fileDescriptor_13f778b8a0251259 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x26, 0x6d, 0x68, 0x27, 0x4d, 0xd3, 0xb8, 0x05, 0x59, 0x3d, 0xac, 0x23, 0xc3, 0x21,
-	0x42, 0x6a, 0x2a, 0xb5, 0x5c, 0x40, 0xa0, 0x8a, 0xb4, 0x80, 0x90, 0x38, 0x2d, 0x45, 0xea, 0x2d,
-	0xda, 0xd8, 0x9b, 0xd4, 0xd4, 0xf1, 0x16, 0xef, 0xfa, 0x50, 0x89, 0x43, 0x1f, 0x81, 0x03, 0x0f,
-	0xc1, 0x2b, 0xf0, 0x06, 0x1c, 0x7b, 0xec, 0xc9, 0xa2, 0xee, 0x05, 0xf9, 0xd4, 0x47, 0x40, 0xbb,
-	0x76, 0x9b, 0x1f, 0x28, 0x6a, 0x22, 0x71, 0xda, 0x9d, 0x6f, 0xc6, 0xdf, 0xcc, 0x37, 0x3b, 0x99,
-	0xc0, 0xaa, 0xa4, 0xe2, 0xa8, 0x13, 0xb2, 0x4f, 0x11, 0x13, 0x52, 0xb4, 0x8e, 0x43, 0x2e, 0xb9,
-	0x51, 0x1e, 0x70, 0x97, 0xf9, 0x62, 0x7d, 0xa3, 0xef, 0xc9, 0xc3, 0xa8, 0xdb, 0x72, 0xf8, 0x60,
-	0xb3, 0xcf, 0xfb, 0x7c, 0x53, 0xbb, 0xbb, 0x51, 0x4f, 0x5b, 0xda, 0xd0, 0xb7, 0xec, 0xb3, 0x75,
-	0x50, 0x5c, 0xf9, 0xbd, 0xc2, 0xc2, 0x90, 0x87, 0x99, 0x61, 0x3f, 0x87, 0xfb, 0xfb, 0x54, 0x1c,
-	0xbd, 0xf3, 0x7a, 0xcc, 0x39, 0x71, 0x7c, 0x46, 0x98, 0x38, 0xe6, 0x81, 0x60, 0xc6, 0x43, 0x98,
-	0xd7, 0x71, 0x26, 0x6a, 0xa0, 0x66, 0x65, 0xab, 0xda, 0xca, 0x12, 0xb7, 0x5e, 0x29, 0x90, 0x64,
-	0x3e, 0xfb, 0x3b, 0x82, 0xfa, 0x1e, 0x13, 0x5e, 0xc8, 0x14, 0x09, 0xc9, 0x4a, 0x35, 0xf6, 0xa1,
-	0xa6, 0x4b, 0x77, 0x59, 0xcf, 0x0b, 0x3c, 0xe9, 0xf1, 0x20, 0x27, 0x79, 0x70, 0x4d, 0xa2, 0xa2,
-	0xf7, 0x6e, 0xbc, 0xed, 0xd5, 0x34, 0xb6, 0x26, 0x3f, 0x21, 0xcb, 0x72, 0x2c, 0xc8, 0x78, 0x0c,
-	0x8b, 0x3a, 0xa4, 0x1f, 0x79, 0xae, 0x59, 0x6c, 0xa0, 0xe6, 0x62, 0xbb, 0x9a, 0xc6, 0xd6, 0x10,
-	0x24, 0x0b, 0xea, 0xfa, 0x26, 0xf2, 0x5c, 0xc3, 0x86, 0xb2, 0xcb, 0x07, 0xd4, 0x0b, 0xcc, 0x92,
-	0x0e, 0x84, 0x34, 0xb6, 0x72, 0x84, 0xe4, 0xa7, 0xed, 0xc2, 0xca, 0x7b, 0x49, 0x43, 0x39, 0x5a,
-	0xf9, 0x58, 0x0e, 0xf4, 0xef, 0x1c, 0x8f, 0xe0, 0x9e, 0xc3, 0x7c, 0xbf, 0x73, 0x53, 0x4d, 0x25,
-	0x8d, 0xad, 0x6b, 0x88, 0x94, 0xd5, 0xe5, 0xad, 0x6b, 0x0f, 0xa0, 0x3e, 0x92, 0x65, 0x8a, 0xde,
-	0x1a, 0xdb, 0xb0, 0x24, 0x0e, 0x79, 0xe4, 0xbb, 0x1d, 0xa1, 0x08, 0x74, 0x92, 0x85, 0xf6, 0x4a,
-	0x1a, 0x5b, 0x63, 0x38, 0xa9, 0x64, 0x96, 0xce, 0x62, 0x7f, 0x86, 0xda, 0x6b, 0xea, 0xf9, 0xb3,
-	0x6a, 0x7a, 0x0a, 0xcb, 0x3d, 0xea, 0xf9, 0x51, 0xc8, 0x3a, 0x21, 0xa3, 0x82, 0x07, 0xb9, 0x34,
-	0x23, 0x8d, 0xad, 0x09, 0x0f, 0xa9, 0xe6, 0x36, 0xd1, 0xe6, 0xb3, 0xa2, 0x89, 0xec, 0x53, 0x04,
-	0x75, 0xc2, 0x3e, 0x32, 0x67, 0xe6, 0xa6, 0xee, 0xc0, 0x4a, 0xa8, 0x09, 0x3c, 0x1e, 0x8c, 0x97,
-	0xb0, 0x96, 0xc6, 0xd6, 0x1f, 0x3e, 0x52, 0xbb, 0x41, 0xb2, 0x32, 0xec, 0x17, 0x50, 0xdb, 0xcf,
-	0xc9, 0x66, 0xc8, 0x6f, 0xa7, 0x08, 0x56, 0x77, 0xf9, 0xe0, 0xd8, 0x67, 0x92, 0xfd, 0xd7, 0xc1,
-	0x50, 0x23, 0xaa, 0x1a, 0xc8, 0x5c, 0x3d, 0xa2, 0x0b, 0xd9, 0x88, 0x66, 0x08, 0xc9, 0xcf, 0xbf,
-	0x3c, 0xc7, 0xdc, 0x1d, 0x9f, 0x43, 0xd1, 0x87, 0x4c, 0x44, 0xbe, 0x34, 0xe7, 0x87, 0xbf, 0x80,
-	0x0c, 0x21, 0xf9, 0x69, 0x7f, 0x2d, 0xc2, 0x9a, 0x12, 0xb9, 0x4b, 0x7d, 0xbf, 0x4b, 0x9d, 0xe1,
-	0x7c, 0x4e, 0xa3, 0x76, 0xa8, 0xa3, 0x38, 0x85, 0x8e, 0xd2, 0xf4, 0x3a, 0xe6, 0x6e, 0xd3, 0x61,
-	0x60, 0x00, 0x1a, 0x04, 0x5c, 0x52, 0xbd, 0x6a, 0xb4, 0x5e, 0x32, 0x82, 0x18, 0x1b, 0x00, 0x4e,
-	0xc8, 0xa8, 0x64, 0x6e, 0x87, 0x4a, 0xb3, 0xdc, 0x40, 0xcd, 0x52, 0x7b, 0x39, 0x8d, 0xad, 0x11,
-	0x94, 0x2c, 0xe6, 0xf7, 0x97, 0xd2, 0x3e, 0x80, 0x25, 0xd5, 0x15, 0x71, 0xfd, 0xf6, 0xc3, 0x65,
-	0x82, 0x6e, 0x5b, 0x26, 0x77, 0x5c, 0x06, 0x07, 0x50, 0xcd, 0x99, 0xa7, 0x59, 0x04, 0x36, 0xcc,
-	0xab, 0x6e, 0x0b, 0xb3, 0xd8, 0x28, 0x35, 0x2b, 0x5b, 0x4b, 0xa3, 0x4b, 0x94, 0x64, 0x2e, 0x7b,
-	0x07, 0xea, 0xca, 0x6c, 0x9f, 0xcc, 0x3a, 0xf8, 0x1f, 0x32, 0xd1, 0xd3, 0x55, 0xd6, 0x80, 0x39,
-	0x45, 0xa0, 0x25, 0x4f, 0x16, 0xa6, 0x3d, 0xed, 0x27, 0x67, 0x17, 0xb8, 0x70, 0x7e, 0x81, 0x0b,
-	0x57, 0x17, 0x18, 0x9d, 0x26, 0x18, 0x7d, 0x4b, 0x30, 0xfa, 0x91, 0x60, 0x74, 0x96, 0x60, 0xf4,
-	0x33, 0xc1, 0xe8, 0x57, 0x82, 0x0b, 0x57, 0x09, 0x46, 0x5f, 0x2e, 0x71, 0xe1, 0xec, 0x12, 0x17,
-	0xce, 0x2f, 0x71, 0xa1, 0x5b, 0xd6, 0xff, 0x4d, 0xdb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x42,
-	0xbf, 0x2a, 0x4c, 0x02, 0x07, 0x00, 0x00,
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcd, 0x8e, 0x1b, 0x45,
+	0x10, 0x76, 0x7b, 0xd7, 0x66, 0xb7, 0xbc, 0x5e, 0xef, 0xce, 0x06, 0x34, 0x0a, 0x62, 0xc6, 0x1a,
+	0x10, 0x5a, 0x90, 0xe2, 0x48, 0x09, 0x17, 0x10, 0x28, 0xc2, 0x09, 0x44, 0x48, 0x39, 0xa0, 0x5e,
+	0xc3, 0x75, 0x34, 0x9e, 0x29, 0x3b, 0xcd, 0x8e, 0xa7, 0x4d, 0x77, 0x0f, 0x22, 0x11, 0x87, 0x3c,
+	0x02, 0x07, 0x1e, 0x02, 0x89, 0x27, 0xe0, 0x0d, 0x38, 0xee, 0x31, 0x17, 0x46, 0xac, 0xf7, 0x82,
+	0xe6, 0x94, 0x47, 0x40, 0xdd, 0xd3, 0xfe, 0x5b, 0x08, 0x8a, 0x2d, 0xe5, 0x34, 0x5d, 0xdf, 0xd7,
+	0x5d, 0x55, 0x5f, 0x57, 0x75, 0x0d, 0x9c, 0xa8, 0x48, 0x9e, 0x87, 0x02, 0xbf, 0xcf, 0x51, 0x2a,
+	0xd9, 0x9b, 0x0a, 0xae, 0xb8, 0xd3, 0x9c, 0xf0, 0x04, 0x53, 0x79, 0xf3, 0xd6, 0x98, 0xa9, 0xc7,
+	0xf9, 0xb0, 0x17, 0xf3, 0xc9, 0xed, 0x31, 0x1f, 0xf3, 0xdb, 0x86, 0x1e, 0xe6, 0x23, 0x63, 0x19,
+	0xc3, 0xac, 0xaa, 0x63, 0x37, 0x41, 0xfb, 0xb2, 0xeb, 0x16, 0x0a, 0xc1, 0x85, 0x35, 0x0e, 0xd2,
+	0x68, 0x88, 0xa9, 0xf5, 0x1e, 0x7c, 0x0a, 0x6f, 0x0e, 0x22, 0x79, 0xfe, 0x88, 0x8d, 0x30, 0x7e,
+	0x12, 0xa7, 0x48, 0x51, 0x4e, 0x79, 0x26, 0xd1, 0x79, 0x17, 0x1a, 0xe6, 0x94, 0x4b, 0xba, 0xe4,
+	0xb4, 0x75, 0xa7, 0xdd, 0xab, 0xd2, 0xe8, 0x7d, 0xa1, 0x41, 0x5a, 0x71, 0xc1, 0xef, 0x04, 0x8e,
+	0x1f, 0xa0, 0x64, 0x02, 0xb5, 0x13, 0x5a, 0x25, 0xee, 0x0c, 0xa0, 0x63, 0x84, 0x24, 0x38, 0x62,
+	0x19, 0x53, 0x8c, 0x67, 0xd6, 0xc9, 0x5b, 0x73, 0x27, 0x7a, 0xf7, 0x83, 0x05, 0xdb, 0x3f, 0x29,
+	0x0b, 0xff, 0xfa, 0x11, 0x7a, 0xa8, 0xd6, 0x36, 0x39, 0x1f, 0xc2, 0xbe, 0xd9, 0x32, 0xce, 0x59,
+	0xe2, 0xd6, 0xbb, 0xe4, 0x74, 0xbf, 0xdf, 0x2e, 0x0b, 0x7f, 0x09, 0xd2, 0x3d, 0xbd, 0x7c, 0x98,
+	0xb3, 0xc4, 0x09, 0xa0, 0x99, 0xf0, 0x49, 0xc4, 0x32, 0x77, 0xc7, 0x6c, 0x84, 0xb2, 0xf0, 0x2d,
+	0x42, 0xed, 0x37, 0x48, 0xe0, 0xe8, 0x4c, 0x45, 0x42, 0xad, 0x66, 0xbe, 0x16, 0x83, 0xfc, 0x7f,
+	0x8c, 0xf7, 0xe0, 0x8d, 0x18, 0xd3, 0x34, 0x5c, 0x64, 0xd3, 0x2a, 0x0b, 0x7f, 0x0e, 0xd1, 0xa6,
+	0x5e, 0x7c, 0x95, 0x04, 0x13, 0x38, 0x5e, 0x89, 0xb2, 0xc1, 0xdd, 0x3a, 0x77, 0xe1, 0x40, 0x3e,
+	0xe6, 0x79, 0x9a, 0x84, 0x52, 0x3b, 0x30, 0x41, 0xf6, 0xfa, 0x47, 0x65, 0xe1, 0xaf, 0xe1, 0xb4,
+	0x55, 0x59, 0x26, 0x4a, 0xf0, 0x13, 0x74, 0xbe, 0x8c, 0x58, 0xba, 0xad, 0xa6, 0x8f, 0xe1, 0x70,
+	0x14, 0xb1, 0x34, 0x17, 0x18, 0x0a, 0x8c, 0x24, 0xcf, 0xac, 0x34, 0xa7, 0x2c, 0xfc, 0x6b, 0x0c,
+	0x6d, 0x5b, 0x9b, 0x1a, 0xf3, 0x93, 0xba, 0x4b, 0x82, 0x67, 0x04, 0x8e, 0x29, 0x7e, 0x87, 0xf1,
+	0xd6, 0x97, 0x7a, 0x0f, 0x8e, 0x84, 0x71, 0xc0, 0x78, 0xb6, 0x9e, 0xc2, 0x8d, 0xb2, 0xf0, 0xff,
+	0xc5, 0xd1, 0xce, 0x02, 0xa9, 0xd2, 0x08, 0x3e, 0x83, 0xce, 0xc0, 0x3a, 0xdb, 0x22, 0x7e, 0x50,
+	0x12, 0x38, 0xb9, 0xcf, 0x27, 0xd3, 0x14, 0x15, 0xbe, 0xd6, 0xc6, 0xd0, 0x2d, 0xaa, 0x2f, 0x10,
+	0x13, 0xd3, 0xa2, 0x7b, 0x55, 0x8b, 0x56, 0x08, 0xb5, 0xdf, 0xff, 0x28, 0xc7, 0xee, 0x2b, 0x96,
+	0x43, 0xbb, 0x17, 0x28, 0xf3, 0x54, 0xb9, 0x8d, 0xe5, 0x0b, 0xa8, 0x10, 0x6a, 0xbf, 0xc1, 0x2f,
+	0x75, 0xb8, 0xa1, 0x45, 0xde, 0x8f, 0xd2, 0x74, 0x18, 0xc5, 0xcb, 0xfe, 0xdc, 0x44, 0xed, 0x52,
+	0x47, 0x7d, 0x03, 0x1d, 0x3b, 0x9b, 0xeb, 0xd8, 0x7d, 0x99, 0x0e, 0xc7, 0x03, 0x88, 0xb2, 0x8c,
+	0xab, 0xc8, 0x8c, 0x1a, 0xa3, 0x97, 0xae, 0x20, 0xce, 0x2d, 0x80, 0x58, 0x60, 0xa4, 0x30, 0x09,
+	0x23, 0xe5, 0x36, 0xbb, 0xe4, 0x74, 0xa7, 0x7f, 0x58, 0x16, 0xfe, 0x0a, 0x4a, 0xf7, 0xed, 0xfa,
+	0x73, 0x15, 0xfc, 0x49, 0xe0, 0x40, 0x5f, 0x8b, 0x9c, 0x17, 0x7f, 0x39, 0x4d, 0xc8, 0xcb, 0xa6,
+	0xc9, 0x2b, 0x16, 0xfd, 0x6d, 0xd8, 0x9f, 0x46, 0x63, 0x0c, 0x25, 0x7b, 0x8a, 0xe6, 0x0e, 0x1a,
+	0x74, 0x4f, 0x03, 0x67, 0xec, 0x29, 0x3a, 0xef, 0x00, 0x18, 0x52, 0xf1, 0x73, 0xb4, 0x95, 0xa6,
+	0x66, 0xfb, 0x40, 0x03, 0xce, 0x43, 0x38, 0x34, 0x93, 0x3b, 0x94, 0x98, 0x62, 0xac, 0xb8, 0x70,
+	0x1b, 0xdd, 0x9d, 0xd3, 0xd6, 0x9d, 0xee, 0x7c, 0x7a, 0x3c, 0xd2, 0xec, 0x99, 0x25, 0x75, 0xee,
+	0x4c, 0xe0, 0x04, 0x33, 0x45, 0xdb, 0xe9, 0x2a, 0x13, 0xfc, 0x46, 0xa0, 0x6d, 0xf5, 0x6d, 0x32,
+	0x8f, 0x02, 0x68, 0xe8, 0xa2, 0x4b, 0xb7, 0x6e, 0xc2, 0x1e, 0xac, 0xce, 0x72, 0x5a, 0x51, 0xce,
+	0xfb, 0xd0, 0xc9, 0xf0, 0x47, 0x15, 0xae, 0xe8, 0x30, 0x95, 0xa6, 0x6d, 0x0d, 0x7f, 0xbd, 0xd0,
+	0xf2, 0x81, 0x7e, 0xe6, 0x92, 0xe7, 0x22, 0xc6, 0xf0, 0x07, 0x14, 0x92, 0xd9, 0xd6, 0xde, 0xa5,
+	0x9d, 0x39, 0xfe, 0x6d, 0x05, 0x07, 0xf7, 0xe0, 0x58, 0x47, 0xe8, 0x3f, 0xd9, 0xf6, 0x49, 0x7f,
+	0x53, 0x55, 0x73, 0x33, 0xb1, 0x5d, 0xd8, 0xd5, 0x0e, 0x4c, 0x2d, 0xaf, 0x6b, 0x35, 0x4c, 0xff,
+	0xa3, 0x8b, 0x4b, 0xaf, 0xf6, 0xfc, 0xd2, 0xab, 0xbd, 0xb8, 0xf4, 0xc8, 0xb3, 0x99, 0x47, 0x7e,
+	0x9d, 0x79, 0xe4, 0x8f, 0x99, 0x47, 0x2e, 0x66, 0x1e, 0xf9, 0x6b, 0xe6, 0x91, 0xbf, 0x67, 0x5e,
+	0xed, 0xc5, 0xcc, 0x23, 0x3f, 0x5f, 0x79, 0xb5, 0x8b, 0x2b, 0xaf, 0xf6, 0xfc, 0xca, 0xab, 0x0d,
+	0x9b, 0xe6, 0xaf, 0x7b, 0xf7, 0x9f, 0x01, 0x00, 0x8e, 0x13, 0x4d, 0xca, 0xea, 0x07, 0x00, 0x00,
 }
 
 func (this *TaskLifecycleResponse) Equal(that interface{}) bool {
@@ -1079,6 +1126,20 @@ func (this *TasksRequest) Equal(that interface{}) bool {
 	if this.CellId != that1.CellId {
 		return false
 	}
+	if this.PageSize != that1.PageSize {
+		return false
+	}
+	if this.PageToken != that1.PageToken {
+		return false
+	}
+	if len(this.LabelSelector) != len(that1.LabelSelector) {
+		return false
+	}
+	for i := range this.LabelSelector {
+		if !this.LabelSelector[i].Equal(that1.LabelSelector[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (this *TasksResponse) Equal(that interface{}) bool {
@@ -1111,6 +1172,12 @@ func (this *TasksResponse) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if this.NextPageToken != that1.NextPageToken {
+		return false
+	}
+	if this.ResourceVersion != that1.ResourceVersion {
+		return false
+	}
 	return true
 }
 func (this *TaskByGuidRequest) Equal(that interface{}) bool {
@@ -1279,10 +1346,15 @@ func (this *TasksRequest) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 9)
 	s = append(s, "&models.TasksRequest{")
 	s = append(s, "Domain: "+fmt.Sprintf("%#v", this.Domain)+",\n")
 	s = append(s, "CellId: "+fmt.Sprintf("%#v", this.CellId)+",\n")
+	s = append(s, "PageSize: "+fmt.Sprintf("%#v", this.PageSize)+",\n")
+	s = append(s, "PageToken: "+fmt.Sprintf("%#v", this.PageToken)+",\n")
+	if this.LabelSelector != nil {
+		s = append(s, "LabelSelector: "+fmt.Sprintf("%#v", this.LabelSelector)+",\n")
+	}
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1290,7 +1362,7 @@ func (this *TasksResponse) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 6)
+	s := make([]string, 0, 8)
 	s = append(s, "&models.TasksResponse{")
 	if this.Error != nil {
 		s = append(s, "Error: "+fmt.Sprintf("%#v", this.Error)+",\n")
@@ -1298,6 +1370,8 @@ func (this *TasksResponse) GoString() string {
 	if this.Tasks != nil {
 		s = append(s, "Tasks: "+fmt.Sprintf("%#v", this.Tasks)+",\n")
 	}
+	s = append(s, "NextPageToken: "+fmt.Sprintf("%#v", this.NextPageToken)+",\n")
+	s = append(s, "ResourceVersion: "+fmt.Sprintf("%#v", this.ResourceVersion)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1751,6 +1825,32 @@ func (m *TasksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LabelSelector) > 0 {
+		for iNdEx := len(m.LabelSelector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LabelSelector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRequests(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PageToken) > 0 {
+		i -= len(m.PageToken)
+		copy(dAtA[i:], m.PageToken)
+		i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.PageToken)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PageSize != 0 {
+		i = encodeVarintTaskRequests(dAtA, i, uint64(m.PageSize))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.CellId) > 0 {
 		i -= len(m.CellId)
 		copy(dAtA[i:], m.CellId)
@@ -1788,6 +1888,18 @@ func (m *TasksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResourceVersion != 0 {
+		i = encodeVarintTaskRequests(dAtA, i, uint64(m.ResourceVersion))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NextPageToken) > 0 {
+		i -= len(m.NextPageToken)
+		copy(dAtA[i:], m.NextPageToken)
+		i = encodeVarintTaskRequests(dAtA, i, uint64(len(m.NextPageToken)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Tasks) > 0 {
 		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2092,6 +2204,19 @@ func (m *TasksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTaskRequests(uint64(l))
 	}
+	if m.PageSize != 0 {
+		n += 1 + sovTaskRequests(uint64(m.PageSize))
+	}
+	l = len(m.PageToken)
+	if l > 0 {
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if len(m.LabelSelector) > 0 {
+		for _, e := range m.LabelSelector {
+			l = e.Size()
+			n += 1 + l + sovTaskRequests(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2111,6 +2236,13 @@ func (m *TasksResponse) Size() (n int) {
 			n += 1 + l + sovTaskRequests(uint64(l))
 		}
 	}
+	l = len(m.NextPageToken)
+	if l > 0 {
+		n += 1 + l + sovTaskRequests(uint64(l))
+	}
+	if m.ResourceVersion != 0 {
+		n += 1 + sovTaskRequests(uint64(m.ResourceVersion))
+	}
 	return n
 }
 
@@ -2259,9 +2391,17 @@ func (this *TasksRequest) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForLabelSelector := "[]*LabelSelectorRequirement{"
+	for _, f := range this.LabelSelector {
+		repeatedStringForLabelSelector += strings.Replace(fmt.Sprintf("%v", f), "LabelSelectorRequirement", "LabelSelectorRequirement", 1) + ","
+	}
+	repeatedStringForLabelSelector += "}"
 	s := strings.Join([]string{`&TasksRequest{`,
 		`Domain:` + fmt.Sprintf("%v", this.Domain) + `,`,
 		`CellId:` + fmt.Sprintf("%v", this.CellId) + `,`,
+		`PageSize:` + fmt.Sprintf("%v", this.PageSize) + `,`,
+		`PageToken:` + fmt.Sprintf("%v", this.PageToken) + `,`,
+		`LabelSelector:` + repeatedStringForLabelSelector + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2278,6 +2418,8 @@ func (this *TasksResponse) String() string {
 	s := strings.Join([]string{`&TasksResponse{`,
 		`Error:` + strings.Replace(fmt.Sprintf("%v", this.Error), "Error", "Error", 1) + `,`,
 		`Tasks:` + repeatedStringForTasks + `,`,
+		`NextPageToken:` + fmt.Sprintf("%v", this.NextPageToken) + `,`,
+		`ResourceVersion:` + fmt.Sprintf("%v", this.ResourceVersion) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3585,6 +3727,91 @@ func (m *TasksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.CellId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageSize", wireType)
+			}
+			m.PageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PageSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LabelSelector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LabelSelector = append(m.LabelSelector, &LabelSelectorRequirement{})
+			if err := m.LabelSelector[len(m.LabelSelector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(dAtA[iNdEx:])
@@ -3705,6 +3932,57 @@ func (m *TasksResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPageToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRequests
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPageToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceVersion", wireType)
+			}
+			m.ResourceVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRequests
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResourceVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRequests(dAtA[iNdEx:])
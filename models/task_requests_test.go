@@ -93,6 +93,16 @@ var _ = Describe("Task requests", func() {
 					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"rootfs"}))
 				})
 			})
+
+			Context("when the task depends on itself", func() {
+				BeforeEach(func() {
+					request.TaskDefinition.DependsOn = []string{"parent-guid", "t-guid"}
+				})
+
+				It("returns a validation error", func() {
+					Expect(request.Validate()).To(ConsistOf(models.ErrInvalidField{"depends_on"}))
+				})
+			})
 		})
 	})
 
@@ -424,6 +424,81 @@ var _ = Describe("Task", func() {
 					},
 				},
 			},
+			{
+				"depends_on",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						DependsOn: []string{"parent-guid", "parent-guid"},
+					},
+				},
+			},
+			{
+				"depends_on",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						DependsOn: []string{""},
+					},
+				},
+			},
+			{
+				"retry_policy.max_attempts",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.TaskRetryPolicy{MaxAttempts: 0},
+					},
+				},
+			},
+			{
+				"retry_policy.max_backoff_ms",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.TaskRetryPolicy{MaxAttempts: 3, InitialBackoffMs: 1000, MaxBackoffMs: 500},
+					},
+				},
+			},
+			{
+				"retry_policy.retryable_failure_reasons",
+				&models.Task{
+					Domain:   "some-domain",
+					TaskGuid: "task-guid",
+					TaskDefinition: &models.TaskDefinition{
+						RootFs: "some:rootfs",
+						Action: models.WrapAction(&models.RunAction{
+							Path: "ls",
+							User: "me",
+						}),
+						RetryPolicy: &models.TaskRetryPolicy{MaxAttempts: 3, RetryableFailureReasons: []string{"("}},
+					},
+				},
+			},
 			{
 				"image_layer",
 				&models.Task{
@@ -662,6 +737,91 @@ var _ = Describe("Task", func() {
 				Entry("running", models.Task_Running, `"Running"`),
 				Entry("completed", models.Task_Completed, `"Completed"`),
 				Entry("resolving", models.Task_Resolving, `"Resolving"`),
+				Entry("waiting", models.Task_Waiting, `"Waiting"`),
+			)
+		})
+	})
+
+	Describe("RetryBackoff", func() {
+		BeforeEach(func() {
+			task.RetryPolicy = &models.TaskRetryPolicy{
+				MaxAttempts:             4,
+				InitialBackoffMs:        1000,
+				MaxBackoffMs:            3000,
+				RetryableFailureReasons: []string{"^cell disappeared", "exit status 7"},
+			}
+		})
+
+		It("doubles the backoff for every attempt up to the maximum, spread over its upper half", func() {
+			var backoffs []time.Duration
+			for {
+				backoff, retry := task.RetryBackoff("exit status 7")
+				if !retry {
+					break
+				}
+				backoffs = append(backoffs, backoff)
+				task.Retry("exit status 7", 1, 2)
+			}
+
+			Expect(backoffs).To(HaveLen(3))
+			Expect(backoffs[0]).To(BeNumerically("~", 750*time.Millisecond, 250*time.Millisecond))
+			Expect(backoffs[1]).To(BeNumerically("~", 1500*time.Millisecond, 500*time.Millisecond))
+			Expect(backoffs[2]).To(BeNumerically("~", 2250*time.Millisecond, 750*time.Millisecond))
+			Expect(task.FailedAttempts).To(HaveLen(3))
+		})
+
+		It("does not retry tasks failing together at the same time", func() {
+			backoffs := map[time.Duration]bool{}
+			for i := 0; i < 10; i++ {
+				backoff, _ := task.RetryBackoff("exit status 7")
+				backoffs[backoff] = true
+			}
+			Expect(len(backoffs)).To(BeNumerically(">", 1))
+		})
+
+		It("does not retry failures that match no pattern", func() {
+			_, retry := task.RetryBackoff("out of memory")
+			Expect(retry).To(BeFalse())
+		})
+
+		It("retries every failure without patterns", func() {
+			task.RetryPolicy.RetryableFailureReasons = nil
+			_, retry := task.RetryBackoff("out of memory")
+			Expect(retry).To(BeTrue())
+		})
+
+		It("does not retry tasks without a retry policy", func() {
+			task.RetryPolicy = nil
+			_, retry := task.RetryBackoff("exit status 7")
+			Expect(retry).To(BeFalse())
+		})
+	})
+
+	Describe("Retry", func() {
+		It("records the failed attempt and waits for the retry", func() {
+			task.Retry("exit status 7", 10, 20)
+
+			Expect(task.FailedAttempts).To(Equal([]*models.TaskAttempt{
+				{CellId: "cell", FailureReason: "exit status 7", FailedAt: 10},
+			}))
+			Expect(task.State).To(Equal(models.Task_Waiting))
+			Expect(task.RetryAt).To(BeEquivalentTo(20))
+			Expect(task.CellId).To(BeEmpty())
+			Expect(task.UpdatedAt).To(BeEquivalentTo(10))
+		})
+	})
+
+	Describe("DependencyPolicy", func() {
+		Describe("MarshalJSON", func() {
+			DescribeTable("marshals and unmarshals between the value and the expected JSON output",
+				func(v models.TaskDefinition_DependencyPolicy, expectedJSON string) {
+					Expect(json.Marshal(v)).To(MatchJSON(expectedJSON))
+					var testV models.TaskDefinition_DependencyPolicy
+					Expect(json.Unmarshal([]byte(expectedJSON), &testV)).To(Succeed())
+					Expect(testV).To(Equal(v))
+				},
+				Entry("require success", models.TaskDefinition_RequireSuccess, `"RequireSuccess"`),
+				Entry("require completion", models.TaskDefinition_RequireCompletion, `"RequireCompletion"`),
 			)
 		})
 	})
@@ -0,0 +1,45 @@
+package overload
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/durationjson"
+)
+
+const (
+	DefaultPollInterval    = 5 * time.Second
+	DefaultMaxPoolWait     = 50 * time.Millisecond
+	DefaultMaxQueryLatency = 250 * time.Millisecond
+)
+
+// Config sets when the BBS sheds requests to relieve its database. Unless it
+// is enabled no request is shed.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// PollInterval is how often the shedding level is adjusted, and how long
+	// shed clients are asked to wait before retrying, DefaultPollInterval if
+	// unset.
+	PollInterval durationjson.Duration `json:"poll_interval,omitempty"`
+	// MaxPoolWait is the average time the queries that waited for a database
+	// connection may have waited before the BBS is overloaded,
+	// DefaultMaxPoolWait if unset.
+	MaxPoolWait durationjson.Duration `json:"max_pool_wait,omitempty"`
+	// MaxQueryLatency is the average time queries may have taken before the
+	// BBS is overloaded, DefaultMaxQueryLatency if unset.
+	MaxQueryLatency durationjson.Duration `json:"max_query_latency,omitempty"`
+}
+
+func (c Config) Validate() error {
+	if c.PollInterval < 0 || c.MaxPoolWait < 0 || c.MaxQueryLatency < 0 {
+		return errors.New("overload: negative poll_interval, max_pool_wait or max_query_latency")
+	}
+	return nil
+}
+
+func durationOrDefault(d durationjson.Duration, defaultDuration time.Duration) time.Duration {
+	if d == 0 {
+		return defaultDuration
+	}
+	return time.Duration(d)
+}
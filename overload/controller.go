@@ -0,0 +1,179 @@
+package overload
+
+import (
+	"os"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/clock"
+	logging "code.cloudfoundry.org/diego-logging-client"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const overloadLevelMetric = "OverloadLevel"
+
+// DBStats are the statistics of the database connection pool.
+type DBStats interface {
+	WaitDuration() time.Duration
+	WaitCount() int64
+}
+
+// Controller sheds requests while the database is overloaded. Every poll
+// interval it raises the shedding level by one while the average pool wait or
+// query latency is above its maximum, and lowers it by one once both are
+// back under half of their maximum.
+type Controller struct {
+	logger          lager.Logger
+	clock           clock.Clock
+	pollInterval    time.Duration
+	maxPoolWait     time.Duration
+	maxQueryLatency time.Duration
+	dbStats         DBStats
+	queries         monitor.Monitor
+	metronClient    logging.IngressClient
+
+	lock   sync.RWMutex
+	status models.OverloadStatus
+}
+
+func NewController(
+	logger lager.Logger,
+	clock clock.Clock,
+	config Config,
+	dbStats DBStats,
+	queries monitor.Monitor,
+	metronClient logging.IngressClient,
+) (*Controller, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Controller{
+		logger:          logger.Session("overload-controller"),
+		clock:           clock,
+		pollInterval:    durationOrDefault(config.PollInterval, DefaultPollInterval),
+		maxPoolWait:     durationOrDefault(config.MaxPoolWait, DefaultMaxPoolWait),
+		maxQueryLatency: durationOrDefault(config.MaxQueryLatency, DefaultMaxQueryLatency),
+		dbStats:         dbStats,
+		queries:         queries,
+		metronClient:    metronClient,
+		status:          models.OverloadStatus{LevelName: LevelNone.String()},
+	}, nil
+}
+
+type sample struct {
+	waitDuration  time.Duration
+	waitCount     int64
+	queries       int64
+	queryDuration time.Duration
+}
+
+func (c *Controller) sample() sample {
+	return sample{
+		waitDuration:  c.dbStats.WaitDuration(),
+		waitCount:     c.dbStats.WaitCount(),
+		queries:       c.queries.Total(),
+		queryDuration: c.queries.TotalDuration(),
+	}
+}
+
+func (c *Controller) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := c.logger
+	logger.Info("starting", lager.Data{
+		"poll_interval":     c.pollInterval,
+		"max_pool_wait":     c.maxPoolWait,
+		"max_query_latency": c.maxQueryLatency,
+	})
+	defer logger.Info("completed")
+
+	last := c.sample()
+	ticker := c.clock.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+		case <-ticker.C():
+			next := c.sample()
+			c.adjust(logger, last, next)
+			last = next
+		}
+	}
+}
+
+// adjust sets the shedding level from the pool wait and query latency
+// averaged over the queries made between the two samples.
+func (c *Controller) adjust(logger lager.Logger, last, next sample) {
+	var poolWait, queryLatency time.Duration
+	if waits := next.waitCount - last.waitCount; waits > 0 {
+		poolWait = (next.waitDuration - last.waitDuration) / time.Duration(waits)
+	}
+	if queries := next.queries - last.queries; queries > 0 {
+		queryLatency = (next.queryDuration - last.queryDuration) / time.Duration(queries)
+	}
+
+	c.lock.Lock()
+	level := Level(c.status.Level)
+	newLevel := level
+	switch {
+	case poolWait > c.maxPoolWait || queryLatency > c.maxQueryLatency:
+		if level < MaxLevel {
+			newLevel++
+		}
+	case poolWait <= c.maxPoolWait/2 && queryLatency <= c.maxQueryLatency/2:
+		if level > LevelNone {
+			newLevel--
+		}
+	}
+	c.status = models.OverloadStatus{
+		Level:        int32(newLevel),
+		LevelName:    newLevel.String(),
+		PoolWait:     int64(poolWait),
+		QueryLatency: int64(queryLatency),
+		UpdatedAt:    c.clock.Now().UnixNano(),
+	}
+	c.lock.Unlock()
+
+	if newLevel != level {
+		logger.Info("shedding-level-changed", lager.Data{
+			"from":          level.String(),
+			"to":            newLevel.String(),
+			"pool_wait":     poolWait,
+			"query_latency": queryLatency,
+		})
+	}
+
+	err := c.metronClient.SendMetric(overloadLevelMetric, int(newLevel))
+	if err != nil {
+		logger.Error("failed-sending-overload-level", err)
+	}
+}
+
+func (c *Controller) Level() Level {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return Level(c.status.Level)
+}
+
+func (c *Controller) Status() *models.OverloadStatus {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	status := c.status
+	return &status
+}
+
+// Shed reports whether calls of the route are shed at the current level and,
+// if so, how long the client should wait before retrying: until the level
+// may next be lowered.
+func (c *Controller) Shed(route string) (time.Duration, bool) {
+	routeLevel := LevelOf(route)
+	if routeLevel == LevelNone || c.Level() < routeLevel {
+		return 0, false
+	}
+	return c.pollInterval, true
+}
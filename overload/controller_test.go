@@ -0,0 +1,166 @@
+package overload_test
+
+import (
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/db/sqldb/helpers/monitor/monitorfakes"
+	"code.cloudfoundry.org/bbs/metrics/metricsfakes"
+	"code.cloudfoundry.org/bbs/overload"
+	"code.cloudfoundry.org/clock/fakeclock"
+	mfakes "code.cloudfoundry.org/diego-logging-client/testhelpers"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+var _ = Describe("Controller", func() {
+	var (
+		logger           *lagertest.TestLogger
+		fakeClock        *fakeclock.FakeClock
+		fakeDBStats      *metricsfakes.FakeDBStats
+		fakeMonitor      *monitorfakes.FakeMonitor
+		fakeMetronClient *mfakes.FakeIngressClient
+
+		statsLock     sync.Mutex
+		waitDuration  time.Duration
+		waitCount     int64
+		queries       int64
+		queryDuration time.Duration
+
+		controller *overload.Controller
+		process    ifrit.Process
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		fakeMetronClient = new(mfakes.FakeIngressClient)
+
+		waitDuration, waitCount, queries, queryDuration = 0, 0, 0, 0
+
+		fakeDBStats = new(metricsfakes.FakeDBStats)
+		fakeDBStats.WaitDurationStub = func() time.Duration {
+			statsLock.Lock()
+			defer statsLock.Unlock()
+			return waitDuration
+		}
+		fakeDBStats.WaitCountStub = func() int64 {
+			statsLock.Lock()
+			defer statsLock.Unlock()
+			return waitCount
+		}
+
+		fakeMonitor = new(monitorfakes.FakeMonitor)
+		fakeMonitor.TotalStub = func() int64 {
+			statsLock.Lock()
+			defer statsLock.Unlock()
+			return queries
+		}
+		fakeMonitor.TotalDurationStub = func() time.Duration {
+			statsLock.Lock()
+			defer statsLock.Unlock()
+			return queryDuration
+		}
+
+		var err error
+		controller, err = overload.NewController(logger, fakeClock, overload.Config{Enabled: true}, fakeDBStats, fakeMonitor, fakeMetronClient)
+		Expect(err).NotTo(HaveOccurred())
+
+		process = ifrit.Background(controller)
+		Eventually(process.Ready()).Should(BeClosed())
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	// poll makes the given queries and pool waits, then lets the controller
+	// adjust its level to them.
+	poll := func(newQueries int64, latency time.Duration, newWaits int64, wait time.Duration) {
+		statsLock.Lock()
+		queries += newQueries
+		queryDuration += time.Duration(newQueries) * latency
+		waitCount += newWaits
+		waitDuration += time.Duration(newWaits) * wait
+		statsLock.Unlock()
+
+		adjustments := fakeMetronClient.SendMetricCallCount()
+		fakeClock.WaitForWatcherAndIncrement(overload.DefaultPollInterval)
+		Eventually(fakeMetronClient.SendMetricCallCount).Should(Equal(adjustments + 1))
+	}
+
+	It("sheds nothing while the database keeps up", func() {
+		poll(100, 10*time.Millisecond, 5, time.Millisecond)
+
+		Expect(controller.Level()).To(Equal(overload.LevelNone))
+		_, shed := controller.Shed(bbs.DesiredLRPsRoute_r3)
+		Expect(shed).To(BeFalse())
+	})
+
+	It("sheds one more level of calls each poll while queries are slow", func() {
+		poll(100, time.Second, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelListings))
+
+		retryAfter, shed := controller.Shed(bbs.DesiredLRPsRoute_r3)
+		Expect(shed).To(BeTrue())
+		Expect(retryAfter).To(Equal(overload.DefaultPollInterval))
+		_, shed = controller.Shed(bbs.DesireTaskRoute_r2)
+		Expect(shed).To(BeFalse())
+
+		poll(100, time.Second, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelTaskDesires))
+		_, shed = controller.Shed(bbs.DesireTaskRoute_r2)
+		Expect(shed).To(BeTrue())
+	})
+
+	It("raises the level while queries wait long for a connection", func() {
+		poll(100, time.Millisecond, 10, time.Second)
+		Expect(controller.Level()).To(Equal(overload.LevelListings))
+	})
+
+	It("sheds the cell lifecycle calls last and never sheds ping", func() {
+		for i := 0; i < 10; i++ {
+			poll(100, time.Second, 0, 0)
+		}
+		Expect(controller.Level()).To(Equal(overload.MaxLevel))
+
+		_, shed := controller.Shed(bbs.StartActualLRPRoute_r1)
+		Expect(shed).To(BeTrue())
+		_, shed = controller.Shed(bbs.PingRoute_r0)
+		Expect(shed).To(BeFalse())
+	})
+
+	It("lowers the level once the database has recovered", func() {
+		poll(100, time.Second, 0, 0)
+		poll(100, time.Second, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelTaskDesires))
+
+		By("holding it while latency is between half and all of its maximum")
+		poll(100, 200*time.Millisecond, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelTaskDesires))
+
+		poll(100, 10*time.Millisecond, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelListings))
+		poll(0, 0, 0, 0)
+		Expect(controller.Level()).To(Equal(overload.LevelNone))
+	})
+
+	It("reports its status and emits its level", func() {
+		poll(100, time.Second, 4, 100*time.Millisecond)
+
+		status := controller.Status()
+		Expect(status.Level).To(BeEquivalentTo(overload.LevelListings))
+		Expect(status.LevelName).To(Equal("listings"))
+		Expect(status.QueryLatency).To(Equal(int64(time.Second)))
+		Expect(status.PoolWait).To(Equal(int64(100 * time.Millisecond)))
+		Expect(status.UpdatedAt).To(Equal(fakeClock.Now().UnixNano()))
+
+		name, value, _ := fakeMetronClient.SendMetricArgsForCall(0)
+		Expect(name).To(Equal("OverloadLevel"))
+		Expect(value).To(Equal(1))
+	})
+})
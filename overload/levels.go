@@ -0,0 +1,63 @@
+package overload
+
+import (
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/ratelimit"
+)
+
+// Level is how much of the API the BBS sheds. Each level sheds the calls of
+// the levels below it too.
+type Level int32
+
+const (
+	LevelNone Level = iota
+	// LevelListings sheds the calls that list and get resources.
+	LevelListings
+	// LevelTaskDesires sheds the calls that desire tasks.
+	LevelTaskDesires
+	// LevelEventSubscriptions sheds new subscriptions to the event streams.
+	LevelEventSubscriptions
+	// LevelWrites sheds the other calls that change resources, such as those
+	// that desire, update and remove LRPs.
+	LevelWrites
+	// LevelCellLifecycle sheds the calls with which cells report the
+	// lifecycle of their actual LRPs and tasks.
+	LevelCellLifecycle
+
+	MaxLevel = LevelCellLifecycle
+)
+
+var levelNames = map[Level]string{
+	LevelNone:               "none",
+	LevelListings:           "listings",
+	LevelTaskDesires:        "task-desires",
+	LevelEventSubscriptions: "event-subscriptions",
+	LevelWrites:             "writes",
+	LevelCellLifecycle:      "cell-lifecycle",
+}
+
+func (l Level) String() string {
+	return levelNames[l]
+}
+
+// LevelOf returns the level from which calls of the route are shed, or
+// LevelNone if they never are, as for Ping and the overload status.
+func LevelOf(route string) Level {
+	switch route {
+	case bbs.PingRoute_r0, bbs.OverloadStatusRoute_r0:
+		return LevelNone
+	case bbs.DesireTaskRoute_r2:
+		return LevelTaskDesires
+	}
+
+	switch ratelimit.ClassOf(route) {
+	case ratelimit.ClassRead:
+		return LevelListings
+	case ratelimit.ClassEvents:
+		return LevelEventSubscriptions
+	case ratelimit.ClassCell:
+		return LevelCellLifecycle
+	default:
+		return LevelWrites
+	}
+}
@@ -0,0 +1,34 @@
+package overload_test
+
+import (
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/overload"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LevelOf", func() {
+	DescribeTable("returns the level from which calls of the route are shed",
+		func(route string, level overload.Level) {
+			Expect(overload.LevelOf(route)).To(Equal(level))
+		},
+		Entry("ping", bbs.PingRoute_r0, overload.LevelNone),
+		Entry("overload status", bbs.OverloadStatusRoute_r0, overload.LevelNone),
+		Entry("listings", bbs.DesiredLRPsRoute_r3, overload.LevelListings),
+		Entry("gets", bbs.TaskByGuidRoute_r3, overload.LevelListings),
+		Entry("task desires", bbs.DesireTaskRoute_r2, overload.LevelTaskDesires),
+		Entry("event subscriptions", bbs.LRPInstanceEventStreamRoute_r1, overload.LevelEventSubscriptions),
+		Entry("writes", bbs.DesireDesiredLRPRoute_r2, overload.LevelWrites),
+		Entry("cell lifecycle", bbs.StartActualLRPRoute_r1, overload.LevelCellLifecycle),
+	)
+})
+
+var _ = Describe("Config", func() {
+	It("accepts the zero config", func() {
+		Expect(overload.Config{}.Validate()).To(Succeed())
+	})
+
+	It("rejects negative durations", func() {
+		Expect(overload.Config{MaxPoolWait: -1}.Validate()).To(MatchError(ContainSubstring("negative")))
+	})
+})
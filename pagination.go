@@ -0,0 +1,160 @@
+package bbs
+
+import (
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+// DefaultPageSize is the page size used by the iterators when the filter they
+// are given does not specify one.
+const DefaultPageSize = 500
+
+/*
+DesiredLRPIterator walks every DesiredLRP matching a filter one page at a time.
+
+	it := bbs.NewDesiredLRPIterator(client, logger, traceID, filter)
+	for it.Next() {
+		for _, lrp := range it.Page() {
+			...
+		}
+	}
+	if err := it.Err(); err != nil {
+		...
+	}
+*/
+type DesiredLRPIterator struct {
+	client  ExternalDesiredLRPClient
+	logger  lager.Logger
+	traceID string
+	filter  models.DesiredLRPFilter
+	page    []*models.DesiredLRP
+	done    bool
+	err     error
+}
+
+func NewDesiredLRPIterator(client ExternalDesiredLRPClient, logger lager.Logger, traceID string, filter models.DesiredLRPFilter) *DesiredLRPIterator {
+	if filter.PageSize == 0 {
+		filter.PageSize = DefaultPageSize
+	}
+	return &DesiredLRPIterator{client: client, logger: logger, traceID: traceID, filter: filter}
+}
+
+// Next fetches the next page, returning false once all pages have been read
+// or an error occurred.
+func (it *DesiredLRPIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	page, nextPageToken, err := it.client.DesiredLRPsPage(it.logger, it.traceID, it.filter)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	it.page = page
+	it.filter.PageToken = nextPageToken
+	it.done = nextPageToken == ""
+	return len(page) > 0 || !it.done
+}
+
+// Page returns the DesiredLRPs fetched by the last call to Next.
+func (it *DesiredLRPIterator) Page() []*models.DesiredLRP {
+	return it.page
+}
+
+// Err returns the first error encountered while fetching pages.
+func (it *DesiredLRPIterator) Err() error {
+	return it.err
+}
+
+// ActualLRPIterator walks every ActualLRP matching a filter one page at a
+// time. It is used in the same way as DesiredLRPIterator.
+type ActualLRPIterator struct {
+	client  ExternalActualLRPClient
+	logger  lager.Logger
+	traceID string
+	filter  models.ActualLRPFilter
+	page    []*models.ActualLRP
+	done    bool
+	err     error
+}
+
+func NewActualLRPIterator(client ExternalActualLRPClient, logger lager.Logger, traceID string, filter models.ActualLRPFilter) *ActualLRPIterator {
+	if filter.PageSize == 0 {
+		filter.PageSize = DefaultPageSize
+	}
+	return &ActualLRPIterator{client: client, logger: logger, traceID: traceID, filter: filter}
+}
+
+func (it *ActualLRPIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	page, nextPageToken, err := it.client.ActualLRPsPage(it.logger, it.traceID, it.filter)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	it.page = page
+	it.filter.PageToken = nextPageToken
+	it.done = nextPageToken == ""
+	return len(page) > 0 || !it.done
+}
+
+func (it *ActualLRPIterator) Page() []*models.ActualLRP {
+	return it.page
+}
+
+func (it *ActualLRPIterator) Err() error {
+	return it.err
+}
+
+// TaskIterator walks every Task matching a filter one page at a time. It is
+// used in the same way as DesiredLRPIterator.
+type TaskIterator struct {
+	client  ExternalTaskClient
+	logger  lager.Logger
+	traceID string
+	filter  models.TaskFilter
+	page    []*models.Task
+	done    bool
+	err     error
+}
+
+func NewTaskIterator(client ExternalTaskClient, logger lager.Logger, traceID string, filter models.TaskFilter) *TaskIterator {
+	if filter.PageSize == 0 {
+		filter.PageSize = DefaultPageSize
+	}
+	return &TaskIterator{client: client, logger: logger, traceID: traceID, filter: filter}
+}
+
+func (it *TaskIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	page, nextPageToken, err := it.client.TasksPage(it.logger, it.traceID, it.filter)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	it.page = page
+	it.filter.PageToken = nextPageToken
+	it.done = nextPageToken == ""
+	return len(page) > 0 || !it.done
+}
+
+func (it *TaskIterator) Page() []*models.Task {
+	return it.page
+}
+
+func (it *TaskIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,151 @@
+package bbs_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/fake_bbs"
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Pagination", func() {
+	var logger lager.Logger
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("bbs-client")
+	})
+
+	Describe("page requests", func() {
+		var (
+			bbsServer *ghttp.Server
+			client    bbs.Client
+		)
+
+		BeforeEach(func() {
+			bbsServer = ghttp.NewServer()
+
+			var err error
+			client, err = bbs.NewClientWithConfig(bbs.ClientConfig{
+				URL:           bbsServer.URL(),
+				Retries:       1,
+				RetryInterval: time.Millisecond,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			bbsServer.Close()
+		})
+
+		It("sends the page size and token for desired lrps and returns the next token", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/desired_lrps/list.r3"),
+					ghttp.VerifyProtoRepresenting(&models.DesiredLRPsRequest{Domain: "domain", PageSize: 1, PageToken: "token-1"}),
+					ghttp.RespondWithProto(200, &models.DesiredLRPsResponse{
+						DesiredLrps:   []*models.DesiredLRP{{ProcessGuid: "process-guid"}},
+						NextPageToken: "token-2",
+					}),
+				),
+			)
+
+			desiredLRPs, next, err := client.DesiredLRPsPage(logger, "some-trace-id", models.DesiredLRPFilter{Domain: "domain", PageSize: 1, PageToken: "token-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(desiredLRPs).To(HaveLen(1))
+			Expect(next).To(Equal("token-2"))
+		})
+
+		It("sends the page size and token for actual lrps and returns the next token", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/actual_lrps/list"),
+					ghttp.VerifyProtoRepresenting(&models.ActualLRPsRequest{CellId: "cell", PageSize: 1, PageToken: "token-1"}),
+					ghttp.RespondWithProto(200, &models.ActualLRPsResponse{
+						ActualLrps:    []*models.ActualLRP{{ActualLRPKey: models.NewActualLRPKey("process-guid", 0, "domain")}},
+						NextPageToken: "token-2",
+					}),
+				),
+			)
+
+			actualLRPs, next, err := client.ActualLRPsPage(logger, "some-trace-id", models.ActualLRPFilter{CellID: "cell", PageSize: 1, PageToken: "token-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualLRPs).To(HaveLen(1))
+			Expect(next).To(Equal("token-2"))
+		})
+
+		It("sends the page size and token for tasks and returns the next token", func() {
+			bbsServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/tasks/list.r3"),
+					ghttp.VerifyProtoRepresenting(&models.TasksRequest{Domain: "domain", PageSize: 1, PageToken: "token-1"}),
+					ghttp.RespondWithProto(200, &models.TasksResponse{
+						Tasks:         []*models.Task{{TaskGuid: "task-guid"}},
+						NextPageToken: "token-2",
+					}),
+				),
+			)
+
+			tasks, next, err := client.TasksPage(logger, "some-trace-id", models.TaskFilter{Domain: "domain", PageSize: 1, PageToken: "token-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks).To(HaveLen(1))
+			Expect(next).To(Equal("token-2"))
+		})
+	})
+
+	Describe("iterators", func() {
+		var fakeClient *fake_bbs.FakeClient
+
+		BeforeEach(func() {
+			fakeClient = new(fake_bbs.FakeClient)
+		})
+
+		It("walks every page of desired lrps", func() {
+			fakeClient.DesiredLRPsPageReturnsOnCall(0, []*models.DesiredLRP{{ProcessGuid: "a"}, {ProcessGuid: "b"}}, "token-1", nil)
+			fakeClient.DesiredLRPsPageReturnsOnCall(1, []*models.DesiredLRP{{ProcessGuid: "c"}}, "", nil)
+
+			it := bbs.NewDesiredLRPIterator(fakeClient, logger, "some-trace-id", models.DesiredLRPFilter{Domain: "domain", PageSize: 2})
+			guids := []string{}
+			for it.Next() {
+				for _, lrp := range it.Page() {
+					guids = append(guids, lrp.ProcessGuid)
+				}
+			}
+			Expect(it.Err()).NotTo(HaveOccurred())
+			Expect(guids).To(Equal([]string{"a", "b", "c"}))
+
+			Expect(fakeClient.DesiredLRPsPageCallCount()).To(Equal(2))
+			_, _, filter := fakeClient.DesiredLRPsPageArgsForCall(0)
+			Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "domain", PageSize: 2}))
+			_, _, filter = fakeClient.DesiredLRPsPageArgsForCall(1)
+			Expect(filter).To(Equal(models.DesiredLRPFilter{Domain: "domain", PageSize: 2, PageToken: "token-1"}))
+		})
+
+		It("uses the default page size when none is given", func() {
+			it := bbs.NewActualLRPIterator(fakeClient, logger, "some-trace-id", models.ActualLRPFilter{})
+			Expect(it.Next()).To(BeFalse())
+
+			_, _, filter := fakeClient.ActualLRPsPageArgsForCall(0)
+			Expect(filter.PageSize).To(BeEquivalentTo(bbs.DefaultPageSize))
+		})
+
+		It("stops and reports the error when a page fails", func() {
+			fakeClient.TasksPageReturnsOnCall(0, []*models.Task{{TaskGuid: "a"}}, "token-1", nil)
+			fakeClient.TasksPageReturnsOnCall(1, nil, "", errors.New("boom"))
+
+			it := bbs.NewTaskIterator(fakeClient, logger, "some-trace-id", models.TaskFilter{PageSize: 1})
+			Expect(it.Next()).To(BeTrue())
+			Expect(it.Page()).To(HaveLen(1))
+			Expect(it.Next()).To(BeFalse())
+			Expect(it.Err()).To(MatchError("boom"))
+			Expect(it.Next()).To(BeFalse())
+			Expect(fakeClient.TasksPageCallCount()).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+)
+
+const (
+	// ClassCell are the routes the rep calls to report the lifecycle of
+	// actual LRPs and tasks. They are served in the priority lane.
+	ClassCell = "cell"
+	// ClassEvents are the event streams. Streams are long lived, so they are
+	// only limited in rate.
+	ClassEvents = "events"
+	// ClassRead are the routes that list and get resources.
+	ClassRead = "read"
+	// ClassWrite are all other routes.
+	ClassWrite = "write"
+)
+
+var eventRoutes = map[string]bool{
+	bbs.LRPGroupEventStreamRoute_r1:    true,
+	bbs.TaskEventStreamRoute_r1:        true,
+	bbs.LRPInstanceEventStreamRoute_r1: true,
+	//lint:ignore SA1019 - limiting deprecated routes until they are removed
+	bbs.EventStreamRoute_r0: true,
+	//lint:ignore SA1019 - limiting deprecated routes until they are removed
+	bbs.TaskEventStreamRoute_r0: true,
+	//lint:ignore SA1019 - limiting deprecated routes until they are removed
+	bbs.LrpInstanceEventStreamRoute_r0: true,
+}
+
+// ClassOf returns the route class of the route. The cell and read classes
+// are the routes of the cell and read-only roles, other than Ping, which is
+// a read.
+func ClassOf(route string) string {
+	switch {
+	case eventRoutes[route]:
+		return ClassEvents
+	case route != bbs.PingRoute_r0 && authorization.RoleAllows(authorization.RoleCell, route):
+		return ClassCell
+	case authorization.RoleAllows(authorization.RoleReadOnly, route):
+		return ClassRead
+	default:
+		return ClassWrite
+	}
+}
+
+func knownClass(class string) bool {
+	switch class {
+	case ClassCell, ClassEvents, ClassRead, ClassWrite:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package ratelimit
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"time"
+
+	"code.cloudfoundry.org/durationjson"
+)
+
+const DefaultQueueTimeout = 5 * time.Second
+
+// Config limits the rate and concurrency of the requests of each client, and
+// the number of requests the BBS serves at once. Unless it is enabled no
+// request is throttled.
+type Config struct {
+	Enabled bool `json:"enabled"`
+	// MaxInFlight bounds the requests served at once, other than those of
+	// the cell class and the event streams. Requests beyond it queue, and
+	// the queued clients are served in turn. 0 for unbounded.
+	MaxInFlight int `json:"max_in_flight,omitempty"`
+	// PriorityMaxInFlight bounds the requests of the cell class served at
+	// once, in a lane of their own. 0 for unbounded.
+	PriorityMaxInFlight int `json:"priority_max_in_flight,omitempty"`
+	// QueueTimeout is how long a request waits to be served before it is
+	// throttled, DefaultQueueTimeout if unset.
+	QueueTimeout durationjson.Duration `json:"queue_timeout,omitempty"`
+	Rules        []Rule                `json:"rules,omitempty"`
+}
+
+func (c Config) Validate() error {
+	if c.MaxInFlight < 0 || c.PriorityMaxInFlight < 0 || c.QueueTimeout < 0 {
+		return errors.New("rate limiting: negative max_in_flight, priority_max_in_flight or queue_timeout")
+	}
+
+	for _, rule := range c.Rules {
+		err := rule.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Rule limits the requests of each client whose certificate matches its
+// patterns on the routes of its route classes. Patterns use the syntax of
+// path.Match, and an empty pattern matches any certificate. The limits apply
+// to each client and route class separately.
+type Rule struct {
+	CommonName         string `json:"common_name,omitempty"`
+	OrganizationalUnit string `json:"organizational_unit,omitempty"`
+	// RouteClasses lists the route classes the rule applies to, every class
+	// if it is empty.
+	RouteClasses []string `json:"route_classes,omitempty"`
+	// RequestsPerSecond is the rate at which the tokens of the bucket of the
+	// client refill, 0 for unlimited.
+	RequestsPerSecond float64 `json:"requests_per_second,omitempty"`
+	// Burst is the size of the bucket, RequestsPerSecond rounded up if unset.
+	Burst int `json:"burst,omitempty"`
+	// MaxInFlight bounds the requests of the client served at once, 0 for
+	// unbounded.
+	MaxInFlight int `json:"max_in_flight,omitempty"`
+}
+
+func (r Rule) Validate() error {
+	for _, pattern := range []string{r.CommonName, r.OrganizationalUnit} {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("rate limiting rule: invalid pattern %q", pattern)
+		}
+	}
+
+	for _, class := range r.RouteClasses {
+		if !knownClass(class) {
+			return fmt.Errorf("rate limiting rule %s: unknown route class %q", r, class)
+		}
+	}
+
+	if r.RequestsPerSecond < 0 || r.Burst < 0 || r.MaxInFlight < 0 {
+		return fmt.Errorf("rate limiting rule %s: negative limit", r)
+	}
+
+	if r.RequestsPerSecond == 0 && r.MaxInFlight == 0 {
+		return fmt.Errorf("rate limiting rule %s: no requests_per_second or max_in_flight", r)
+	}
+
+	return nil
+}
+
+func (r Rule) String() string {
+	return fmt.Sprintf("{common_name: %q, organizational_unit: %q}", r.CommonName, r.OrganizationalUnit)
+}
+
+func (r Rule) appliesTo(class string) bool {
+	if len(r.RouteClasses) == 0 {
+		return true
+	}
+
+	for _, c := range r.RouteClasses {
+		if c == class {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package ratelimit_test
+
+import (
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var config ratelimit.Config
+
+	BeforeEach(func() {
+		config = ratelimit.Config{
+			Enabled:     true,
+			MaxInFlight: 10,
+			Rules: []ratelimit.Rule{{
+				CommonName:        "route_emitter",
+				RouteClasses:      []string{ratelimit.ClassRead},
+				RequestsPerSecond: 5,
+			}},
+		}
+	})
+
+	It("accepts a valid config", func() {
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("accepts rules matching every client", func() {
+		config.Rules[0].CommonName = ""
+		Expect(config.Validate()).To(Succeed())
+	})
+
+	It("rejects negative limits", func() {
+		config.PriorityMaxInFlight = -1
+		Expect(config.Validate()).To(MatchError(ContainSubstring("negative")))
+
+		config.PriorityMaxInFlight = 0
+		config.Rules[0].Burst = -1
+		Expect(config.Validate()).To(MatchError(ContainSubstring("negative limit")))
+	})
+
+	It("rejects invalid patterns", func() {
+		config.Rules[0].OrganizationalUnit = "app:["
+		Expect(config.Validate()).To(MatchError(ContainSubstring("invalid pattern")))
+	})
+
+	It("rejects unknown route classes", func() {
+		config.Rules[0].RouteClasses = []string{"everything"}
+		Expect(config.Validate()).To(MatchError(ContainSubstring(`unknown route class "everything"`)))
+	})
+
+	It("requires a limit", func() {
+		config.Rules[0].RequestsPerSecond = 0
+		Expect(config.Validate()).To(MatchError(ContainSubstring("no requests_per_second or max_in_flight")))
+	})
+})
+
+var _ = Describe("ClassOf", func() {
+	It("classifies the routes", func() {
+		Expect(ratelimit.ClassOf(bbs.StartActualLRPRoute_r1)).To(Equal(ratelimit.ClassCell))
+		Expect(ratelimit.ClassOf(bbs.CompleteTaskRoute_r0)).To(Equal(ratelimit.ClassCell))
+		Expect(ratelimit.ClassOf(bbs.DesiredLRPsRoute_r3)).To(Equal(ratelimit.ClassRead))
+		Expect(ratelimit.ClassOf(bbs.PingRoute_r0)).To(Equal(ratelimit.ClassRead))
+		Expect(ratelimit.ClassOf(bbs.LRPInstanceEventStreamRoute_r1)).To(Equal(ratelimit.ClassEvents))
+		Expect(ratelimit.ClassOf(bbs.DesireTaskRoute_r2)).To(Equal(ratelimit.ClassWrite))
+		Expect(ratelimit.ClassOf(bbs.SetDomainQuotaRoute_r0)).To(Equal(ratelimit.ClassWrite))
+	})
+})
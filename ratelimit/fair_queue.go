@@ -0,0 +1,130 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+)
+
+// fairQueue bounds the requests served at once. Once it is full, requests
+// queue per client, and the clients with queued requests are served in turn,
+// so that a client with many queued requests does not delay the others.
+type fairQueue struct {
+	clock    clock.Clock
+	capacity int
+
+	lock     sync.Mutex
+	inFlight int
+	waiting  map[string][]*waiter
+	// turns lists the clients with queued requests in the order they are
+	// served.
+	turns []string
+}
+
+type waiter struct {
+	ready   chan struct{}
+	granted bool
+}
+
+func newFairQueue(clock clock.Clock, capacity int) *fairQueue {
+	return &fairQueue{
+		clock:    clock,
+		capacity: capacity,
+		waiting:  map[string][]*waiter{},
+	}
+}
+
+// acquire waits up to the timeout for the request of the client to be
+// served, and returns whether it may be. A request that may be served must
+// be released once it is.
+func (q *fairQueue) acquire(ctx context.Context, client string, timeout time.Duration) bool {
+	if q.capacity == 0 {
+		return true
+	}
+
+	q.lock.Lock()
+	if q.inFlight < q.capacity && len(q.turns) == 0 {
+		q.inFlight++
+		q.lock.Unlock()
+		return true
+	}
+
+	w := &waiter{ready: make(chan struct{})}
+	if len(q.waiting[client]) == 0 {
+		q.turns = append(q.turns, client)
+	}
+	q.waiting[client] = append(q.waiting[client], w)
+	q.lock.Unlock()
+
+	timer := q.clock.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case <-w.ready:
+		return true
+	case <-timer.C():
+	case <-ctx.Done():
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	if w.granted {
+		return true
+	}
+	q.remove(client, w)
+	return false
+}
+
+func (q *fairQueue) release() {
+	if q.capacity == 0 {
+		return
+	}
+
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	q.inFlight--
+	for q.inFlight < q.capacity && len(q.turns) > 0 {
+		client := q.turns[0]
+		q.turns = q.turns[1:]
+
+		waiters := q.waiting[client]
+		w := waiters[0]
+		if len(waiters) > 1 {
+			q.waiting[client] = waiters[1:]
+			q.turns = append(q.turns, client)
+		} else {
+			delete(q.waiting, client)
+		}
+
+		w.granted = true
+		close(w.ready)
+		q.inFlight++
+	}
+}
+
+func (q *fairQueue) remove(client string, w *waiter) {
+	waiters := q.waiting[client]
+	for i, queued := range waiters {
+		if queued == w {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+
+	if len(waiters) > 0 {
+		q.waiting[client] = waiters
+		return
+	}
+
+	delete(q.waiting, client)
+	for i, turn := range q.turns {
+		if turn == client {
+			q.turns = append(q.turns[:i], q.turns[i+1:]...)
+			break
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package ratelimit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/clock"
+)
+
+// DefaultRetryAfter is the hint given to clients throttled by a concurrency
+// limit, which, unlike a rate limit, does not tell when a request would be
+// served.
+const DefaultRetryAfter = time.Second
+
+type Limiter struct {
+	clock        clock.Clock
+	rules        []Rule
+	queueTimeout time.Duration
+	queue        *fairQueue
+	priority     *fairQueue
+
+	lock     sync.Mutex
+	buckets  map[string]*tokenBucket
+	inFlight map[string]int
+}
+
+func NewLimiter(clock clock.Clock, config Config) (*Limiter, error) {
+	err := config.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	queueTimeout := time.Duration(config.QueueTimeout)
+	if queueTimeout == 0 {
+		queueTimeout = DefaultQueueTimeout
+	}
+
+	return &Limiter{
+		clock:        clock,
+		rules:        config.Rules,
+		queueTimeout: queueTimeout,
+		queue:        newFairQueue(clock, config.MaxInFlight),
+		priority:     newFairQueue(clock, config.PriorityMaxInFlight),
+		buckets:      map[string]*tokenBucket{},
+		inFlight:     map[string]int{},
+	}, nil
+}
+
+// Acquire admits a request of the client on the route, limited by the first
+// rule matching the client and the class of the route. It returns a function
+// to call once the request has been served, or, if the request is
+// throttled, how long the client should wait before retrying.
+func (l *Limiter) Acquire(ctx context.Context, identity authorization.Identity, route string) (func(), time.Duration, bool) {
+	class := ClassOf(route)
+	client := clientKey(identity)
+	key := client + "/" + class
+
+	rule, limited := l.rule(identity, class)
+	if limited {
+		retryAfter, ok := l.acquireClient(rule, key)
+		if !ok {
+			return nil, retryAfter, false
+		}
+	}
+
+	queue := l.queue
+	switch class {
+	case ClassCell:
+		queue = l.priority
+	case ClassEvents:
+		queue = nil
+	}
+
+	if queue != nil && !queue.acquire(ctx, client, l.queueTimeout) {
+		if limited {
+			l.releaseClient(rule, key)
+		}
+		return nil, DefaultRetryAfter, false
+	}
+
+	return func() {
+		if queue != nil {
+			queue.release()
+		}
+		if limited {
+			l.releaseClient(rule, key)
+		}
+	}, 0, true
+}
+
+func (l *Limiter) rule(identity authorization.Identity, class string) (Rule, bool) {
+	for _, rule := range l.rules {
+		if rule.appliesTo(class) && identity.Matches(rule.CommonName, rule.OrganizationalUnit) {
+			return rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+func (l *Limiter) acquireClient(rule Rule, key string) (time.Duration, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if rule.MaxInFlight > 0 && l.inFlight[key] >= rule.MaxInFlight {
+		return DefaultRetryAfter, false
+	}
+
+	if rule.RequestsPerSecond > 0 {
+		now := l.clock.Now()
+		bucket, ok := l.buckets[key]
+		if !ok {
+			bucket = newTokenBucket(rule.RequestsPerSecond, rule.Burst, now)
+			l.buckets[key] = bucket
+		}
+
+		retryAfter, ok := bucket.take(now)
+		if !ok {
+			return retryAfter, false
+		}
+	}
+
+	if rule.MaxInFlight > 0 {
+		l.inFlight[key]++
+	}
+	return 0, true
+}
+
+func (l *Limiter) releaseClient(rule Rule, key string) {
+	if rule.MaxInFlight == 0 {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.inFlight[key]--
+	if l.inFlight[key] == 0 {
+		delete(l.inFlight, key)
+	}
+}
+
+// clientKey identifies the client by the subject of its certificate.
+func clientKey(identity authorization.Identity) string {
+	return identity.CommonName + "|" + strings.Join(identity.OrganizationalUnits, ",")
+}
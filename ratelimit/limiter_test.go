@@ -0,0 +1,220 @@
+package ratelimit_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/bbs"
+	"code.cloudfoundry.org/bbs/authorization"
+	"code.cloudfoundry.org/bbs/ratelimit"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/durationjson"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Limiter", func() {
+	var (
+		fakeClock *fakeclock.FakeClock
+		config    ratelimit.Config
+		limiter   *ratelimit.Limiter
+
+		routeEmitter = authorization.Identity{CommonName: "route_emitter"}
+		cc           = authorization.Identity{CommonName: "cc"}
+		cell         = authorization.Identity{CommonName: "cell-z1-0", OrganizationalUnits: []string{"cell:z1"}}
+	)
+
+	type result struct {
+		name    string
+		release func()
+		ok      bool
+	}
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 456))
+		config = ratelimit.Config{Enabled: true}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		limiter, err = ratelimit.NewLimiter(fakeClock, config)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	acquire := func(identity authorization.Identity, route string) (func(), time.Duration, bool) {
+		return limiter.Acquire(context.Background(), identity, route)
+	}
+
+	acquireInBackground := func(name string, identity authorization.Identity, route string, results chan<- result) {
+		watchers := fakeClock.WatcherCount()
+		go func() {
+			defer GinkgoRecover()
+			release, _, ok := acquire(identity, route)
+			results <- result{name: name, release: release, ok: ok}
+		}()
+		Eventually(fakeClock.WatcherCount).Should(Equal(watchers + 1))
+	}
+
+	It("rejects invalid configs", func() {
+		config.MaxInFlight = -1
+		_, err := ratelimit.NewLimiter(fakeClock, config)
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("with a rate limit", func() {
+		BeforeEach(func() {
+			config.Rules = []ratelimit.Rule{{
+				CommonName:        "route_emitter",
+				RouteClasses:      []string{ratelimit.ClassRead},
+				RequestsPerSecond: 2,
+			}}
+		})
+
+		It("admits a burst of requests and throttles the rest until the bucket refills", func() {
+			for i := 0; i < 2; i++ {
+				_, _, ok := acquire(routeEmitter, bbs.DesiredLRPsRoute_r3)
+				Expect(ok).To(BeTrue())
+			}
+
+			_, retryAfter, ok := acquire(routeEmitter, bbs.DesiredLRPsRoute_r3)
+			Expect(ok).To(BeFalse())
+			Expect(retryAfter).To(Equal(500 * time.Millisecond))
+
+			fakeClock.Increment(500 * time.Millisecond)
+			_, _, ok = acquire(routeEmitter, bbs.DesiredLRPsRoute_r3)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("only limits the clients and route classes of the rule", func() {
+			for i := 0; i < 2; i++ {
+				_, _, ok := acquire(routeEmitter, bbs.DesiredLRPsRoute_r3)
+				Expect(ok).To(BeTrue())
+			}
+
+			_, _, ok := acquire(routeEmitter, bbs.DesireTaskRoute_r2)
+			Expect(ok).To(BeTrue())
+
+			for i := 0; i < 5; i++ {
+				_, _, ok := acquire(cc, bbs.DesiredLRPsRoute_r3)
+				Expect(ok).To(BeTrue())
+			}
+		})
+
+		It("gives every matching client a bucket of its own", func() {
+			config.Rules[0].CommonName = "*"
+			limiter, _ = ratelimit.NewLimiter(fakeClock, config)
+
+			for i := 0; i < 2; i++ {
+				_, _, ok := acquire(routeEmitter, bbs.DesiredLRPsRoute_r3)
+				Expect(ok).To(BeTrue())
+			}
+
+			_, _, ok := acquire(cc, bbs.DesiredLRPsRoute_r3)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Context("with a concurrency limit per client", func() {
+		BeforeEach(func() {
+			config.Rules = []ratelimit.Rule{{
+				OrganizationalUnit: "cell:*",
+				MaxInFlight:        2,
+			}}
+		})
+
+		It("throttles the requests beyond it until one is served", func() {
+			release, _, ok := acquire(cell, bbs.StartActualLRPRoute_r1)
+			Expect(ok).To(BeTrue())
+			_, _, ok = acquire(cell, bbs.StartActualLRPRoute_r1)
+			Expect(ok).To(BeTrue())
+
+			_, retryAfter, ok := acquire(cell, bbs.StartActualLRPRoute_r1)
+			Expect(ok).To(BeFalse())
+			Expect(retryAfter).To(Equal(ratelimit.DefaultRetryAfter))
+
+			release()
+			_, _, ok = acquire(cell, bbs.StartActualLRPRoute_r1)
+			Expect(ok).To(BeTrue())
+		})
+	})
+
+	Context("with a limit on the requests served at once", func() {
+		BeforeEach(func() {
+			config.MaxInFlight = 1
+			config.QueueTimeout = durationjson.Duration(time.Second)
+		})
+
+		It("serves the queued clients in turn", func() {
+			release, _, ok := acquire(cc, bbs.DesireTaskRoute_r2)
+			Expect(ok).To(BeTrue())
+
+			results := make(chan result, 3)
+			acquireInBackground("route-emitter-1", routeEmitter, bbs.DesiredLRPsRoute_r3, results)
+			acquireInBackground("route-emitter-2", routeEmitter, bbs.DesiredLRPsRoute_r3, results)
+			acquireInBackground("cc", cc, bbs.DesireTaskRoute_r2, results)
+			Consistently(results).ShouldNot(Receive())
+
+			served := []string{}
+			for i := 0; i < 3; i++ {
+				release()
+
+				var r result
+				Eventually(results).Should(Receive(&r))
+				Expect(r.ok).To(BeTrue())
+				served = append(served, r.name)
+				release = r.release
+			}
+
+			Expect(served).To(Equal([]string{"route-emitter-1", "cc", "route-emitter-2"}))
+		})
+
+		It("throttles requests that wait longer than the queue timeout", func() {
+			_, _, ok := acquire(cc, bbs.DesireTaskRoute_r2)
+			Expect(ok).To(BeTrue())
+
+			results := make(chan result, 1)
+			acquireInBackground("route-emitter", routeEmitter, bbs.DesiredLRPsRoute_r3, results)
+
+			fakeClock.Increment(time.Second)
+			var r result
+			Eventually(results).Should(Receive(&r))
+			Expect(r.ok).To(BeFalse())
+		})
+
+		It("serves the cell class in a lane of its own", func() {
+			_, _, ok := acquire(cc, bbs.DesireTaskRoute_r2)
+			Expect(ok).To(BeTrue())
+
+			_, _, ok = acquire(cell, bbs.StartActualLRPRoute_r1)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("does not hold the event streams to it", func() {
+			_, _, ok := acquire(cc, bbs.DesireTaskRoute_r2)
+			Expect(ok).To(BeTrue())
+
+			_, _, ok = acquire(routeEmitter, bbs.LRPInstanceEventStreamRoute_r1)
+			Expect(ok).To(BeTrue())
+		})
+
+		Context("and a limit on the cell class", func() {
+			BeforeEach(func() {
+				config.PriorityMaxInFlight = 1
+			})
+
+			It("queues the cell class beyond it", func() {
+				_, _, ok := acquire(cell, bbs.StartActualLRPRoute_r1)
+				Expect(ok).To(BeTrue())
+
+				results := make(chan result, 1)
+				acquireInBackground("cell", cell, bbs.CrashActualLRPRoute_r0, results)
+				Consistently(results).ShouldNot(Receive())
+
+				fakeClock.Increment(time.Second)
+				var r result
+				Eventually(results).Should(Receive(&r))
+				Expect(r.ok).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket returns a full bucket.
+func newTokenBucket(rate float64, burst int, now time.Time) *tokenBucket {
+	size := float64(burst)
+	if size == 0 {
+		size = math.Ceil(rate)
+	}
+
+	return &tokenBucket{
+		rate:   rate,
+		burst:  size,
+		tokens: size,
+		last:   now,
+	}
+}
+
+// take takes a token from the bucket. If it is empty, take returns how long
+// until the bucket holds a token again.
+func (b *tokenBucket) take(now time.Time) (time.Duration, bool) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.burst, b.tokens+elapsed.Seconds()*b.rate)
+		b.last = now
+	}
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0, true
+	}
+
+	return time.Duration(math.Ceil((1 - b.tokens) / b.rate * float64(time.Second))), false
+}
@@ -0,0 +1,106 @@
+package repadmin
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/trace"
+	"code.cloudfoundry.org/lager/v3"
+	"code.cloudfoundry.org/rep"
+	"github.com/tedsuo/rata"
+)
+
+//go:generate counterfeiter -generate
+
+// ErrAdminPortNotConfigured is returned when the BBS is not configured with
+// the port of the rep admin listener.
+var ErrAdminPortNotConfigured = errors.New("rep admin port is not configured")
+
+//counterfeiter:generate . Client
+
+// Client calls the admin listener of a rep, which the rep otherwise serves to
+// its own drain script.
+type Client interface {
+	Evacuate(logger lager.Logger, traceId string, cell *models.CellPresence) error
+}
+
+type client struct {
+	httpClient *http.Client
+	adminPort  int
+}
+
+// NewClient returns a client of the rep admin listeners on adminPort of the
+// cells. A client with no admin port fails every call with
+// ErrAdminPortNotConfigured.
+func NewClient(httpClient *http.Client, adminPort int) Client {
+	return &client{
+		httpClient: httpClient,
+		adminPort:  adminPort,
+	}
+}
+
+// Evacuate asks the rep of the cell to evacuate it. It returns once the rep
+// has accepted the request; the rep evacuates the cell in the background.
+func (c *client) Evacuate(logger lager.Logger, traceId string, cell *models.CellPresence) error {
+	logger = logger.Session("evacuate", lager.Data{"cell_id": cell.CellId})
+
+	if c.adminPort == 0 {
+		return ErrAdminPortNotConfigured
+	}
+
+	host, err := cellHost(cell)
+	if err != nil {
+		logger.Error("failed-resolving-cell-host", err)
+		return err
+	}
+
+	adminURL := "http://" + net.JoinHostPort(host, strconv.Itoa(c.adminPort))
+	req, err := rata.NewRequestGenerator(adminURL, rep.RoutesLocalhostOnly).CreateRequest(rep.EvacuateRoute, nil, nil)
+	if err != nil {
+		return err
+	}
+	if traceId != "" {
+		req.Header.Set(trace.RequestIdHeader, traceId)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		logger.Error("failed-requesting-evacuation", err)
+		return err
+	}
+	defer resp.Body.Close()
+	// #nosec G104 - draining the body only allows the connection to be reused
+	io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode != http.StatusAccepted {
+		err := fmt.Errorf("rep responded to evacuation with status %d", resp.StatusCode)
+		logger.Error("evacuation-rejected", err)
+		return err
+	}
+
+	return nil
+}
+
+// cellHost returns the host the cell registered its rep on, preferring the
+// address over the url.
+func cellHost(cell *models.CellPresence) (string, error) {
+	for _, address := range []string{cell.RepAddress, cell.RepUrl} {
+		if address == "" {
+			continue
+		}
+		u, err := url.Parse(address)
+		if err != nil {
+			return "", err
+		}
+		if u.Hostname() != "" {
+			return u.Hostname(), nil
+		}
+	}
+	return "", fmt.Errorf("cell %s has no rep address", cell.CellId)
+}
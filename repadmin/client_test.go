@@ -0,0 +1,108 @@
+package repadmin_test
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/repadmin"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		logger    *lagertest.TestLogger
+		repServer *ghttp.Server
+		adminPort int
+		cell      *models.CellPresence
+		client    repadmin.Client
+	)
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+		repServer = ghttp.NewServer()
+
+		serverURL, err := url.Parse(repServer.URL())
+		Expect(err).NotTo(HaveOccurred())
+		adminPort, err = strconv.Atoi(serverURL.Port())
+		Expect(err).NotTo(HaveOccurred())
+
+		cell = &models.CellPresence{
+			CellId:     "cell-id",
+			RepAddress: "http://" + serverURL.Hostname() + ":1801",
+			RepUrl:     "https://cell-id.cell.service.cf.internal:1801",
+		}
+	})
+
+	JustBeforeEach(func() {
+		client = repadmin.NewClient(http.DefaultClient, adminPort)
+	})
+
+	AfterEach(func() {
+		repServer.Close()
+	})
+
+	Describe("Evacuate", func() {
+		Context("when the rep accepts the evacuation", func() {
+			BeforeEach(func() {
+				repServer.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/evacuate"),
+					ghttp.VerifyHeader(http.Header{"X-Vcap-Request-Id": []string{"some-trace-id"}}),
+					ghttp.RespondWith(http.StatusAccepted, `{"ping_path":"/ping"}`),
+				))
+			})
+
+			It("posts to the admin listener on the host of the rep address", func() {
+				Expect(client.Evacuate(logger, "some-trace-id", cell)).To(Succeed())
+				Expect(repServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the rep rejects the evacuation", func() {
+			BeforeEach(func() {
+				repServer.AppendHandlers(ghttp.RespondWith(http.StatusNotFound, nil))
+			})
+
+			It("returns an error", func() {
+				Expect(client.Evacuate(logger, "", cell)).To(MatchError(ContainSubstring("404")))
+			})
+		})
+
+		Context("when the rep cannot be reached", func() {
+			BeforeEach(func() {
+				repServer.Close()
+			})
+
+			It("returns an error", func() {
+				Expect(client.Evacuate(logger, "", cell)).NotTo(Succeed())
+			})
+		})
+
+		Context("when the cell registered no address", func() {
+			BeforeEach(func() {
+				cell.RepAddress = ""
+				cell.RepUrl = ""
+			})
+
+			It("returns an error without calling the rep", func() {
+				Expect(client.Evacuate(logger, "", cell)).To(MatchError(ContainSubstring("no rep address")))
+				Expect(repServer.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+
+		Context("when the admin port is not configured", func() {
+			BeforeEach(func() {
+				adminPort = 0
+			})
+
+			It("fails without calling the rep", func() {
+				Expect(client.Evacuate(logger, "", cell)).To(Equal(repadmin.ErrAdminPortNotConfigured))
+				Expect(repServer.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1 @@
+package repadmin // import "code.cloudfoundry.org/bbs/repadmin"
@@ -0,0 +1,13 @@
+package repadmin_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRepadmin(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Repadmin Suite")
+}
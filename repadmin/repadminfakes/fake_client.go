@@ -0,0 +1,117 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package repadminfakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/bbs/models"
+	"code.cloudfoundry.org/bbs/repadmin"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeClient struct {
+	EvacuateStub        func(lager.Logger, string, *models.CellPresence) error
+	evacuateMutex       sync.RWMutex
+	evacuateArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.CellPresence
+	}
+	evacuateReturns struct {
+		result1 error
+	}
+	evacuateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) Evacuate(arg1 lager.Logger, arg2 string, arg3 *models.CellPresence) error {
+	fake.evacuateMutex.Lock()
+	ret, specificReturn := fake.evacuateReturnsOnCall[len(fake.evacuateArgsForCall)]
+	fake.evacuateArgsForCall = append(fake.evacuateArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 *models.CellPresence
+	}{arg1, arg2, arg3})
+	stub := fake.EvacuateStub
+	fakeReturns := fake.evacuateReturns
+	fake.recordInvocation("Evacuate", []interface{}{arg1, arg2, arg3})
+	fake.evacuateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) EvacuateCallCount() int {
+	fake.evacuateMutex.RLock()
+	defer fake.evacuateMutex.RUnlock()
+	return len(fake.evacuateArgsForCall)
+}
+
+func (fake *FakeClient) EvacuateCalls(stub func(lager.Logger, string, *models.CellPresence) error) {
+	fake.evacuateMutex.Lock()
+	defer fake.evacuateMutex.Unlock()
+	fake.EvacuateStub = stub
+}
+
+func (fake *FakeClient) EvacuateArgsForCall(i int) (lager.Logger, string, *models.CellPresence) {
+	fake.evacuateMutex.RLock()
+	defer fake.evacuateMutex.RUnlock()
+	argsForCall := fake.evacuateArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) EvacuateReturns(result1 error) {
+	fake.evacuateMutex.Lock()
+	defer fake.evacuateMutex.Unlock()
+	fake.EvacuateStub = nil
+	fake.evacuateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) EvacuateReturnsOnCall(i int, result1 error) {
+	fake.evacuateMutex.Lock()
+	defer fake.evacuateMutex.Unlock()
+	fake.EvacuateStub = nil
+	if fake.evacuateReturnsOnCall == nil {
+		fake.evacuateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.evacuateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.evacuateMutex.RLock()
+	defer fake.evacuateMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ repadmin.Client = new(FakeClient)
@@ -0,0 +1 @@
+package repadminfakes // import "code.cloudfoundry.org/bbs/repadmin/repadminfakes"
@@ -10,6 +10,12 @@ const (
 	DomainsRoute_r0      = "Domains"
 	UpsertDomainRoute_r0 = "UpsertDomain"
 
+	// Domain Quotas
+	DomainQuotasRoute_r0      = "DomainQuotas"
+	SetDomainQuotaRoute_r0    = "SetDomainQuota"
+	RemoveDomainQuotaRoute_r0 = "RemoveDomainQuota"
+	DomainUsageRoute_r0       = "DomainUsage"
+
 	// Actual LRPs
 	ActualLRPsRoute_r0 = "ActualLRPs"
 	// Multiple actual LRPs by process GUID
@@ -20,6 +26,8 @@ const (
 	ActualLRPGroupsByProcessGuidRoute_r0 = "ActualLRPGroupsByProcessGuid"
 	// Deprecated: use the ActualLRPInstances API instead
 	ActualLRPGroupByProcessGuidAndIndexRoute_r0 = "ActualLRPGroupsByProcessGuidAndIndex"
+	// Transitions of the instances at an index of an LRP
+	ActualLRPHistoryRoute_r0 = "ActualLRPHistory"
 
 	// Actual LRP Lifecycle
 	ClaimActualLRPRoute_r0 = "ClaimActualLRP"
@@ -56,6 +64,13 @@ const (
 	UpdateDesiredLRPRoute_r0 = "UpdateDesireLRP"
 	RemoveDesiredLRPRoute_r0 = "RemoveDesiredLRP"
 
+	// Deployments
+	StartDeploymentRoute_r0         = "StartDeployment"
+	DeploymentByProcessGuidRoute_r0 = "DeploymentByProcessGuid"
+	PauseDeploymentRoute_r0         = "PauseDeployment"
+	ResumeDeploymentRoute_r0        = "ResumeDeployment"
+	RollbackDeploymentRoute_r0      = "RollbackDeployment"
+
 	// Tasks
 	TasksRoute_r3      = "Tasks"
 	TaskByGuidRoute_r3 = "TaskByGuid"
@@ -73,6 +88,27 @@ const (
 	// Deprecated: use TaskByGuid_r3 instead
 	TaskByGuidRoute_r2 = "TaskByGuid_r2"
 
+	// Scheduled Tasks
+	ScheduledTasksRoute_r0       = "ScheduledTasks"
+	DesireScheduledTaskRoute_r0  = "DesireScheduledTask"
+	UpdateScheduledTaskRoute_r0  = "UpdateScheduledTask"
+	SuspendScheduledTaskRoute_r0 = "SuspendScheduledTask"
+	DeleteScheduledTaskRoute_r0  = "DeleteScheduledTask"
+
+	// Task Callbacks
+	TaskCallbacksRoute_r0      = "TaskCallbacks"
+	ReplayTaskCallbackRoute_r0 = "ReplayTaskCallback"
+
+	// Audit Records
+	AuditRecordsRoute_r0 = "AuditRecords"
+
+	// Overload
+	OverloadStatusRoute_r0 = "OverloadStatus"
+
+	// Crash Storm Breakers
+	CrashStormBreakersRoute_r0     = "CrashStormBreakers"
+	ResetCrashStormBreakerRoute_r0 = "ResetCrashStormBreaker"
+
 	// Event Streaming
 	// Deprecated: use LRPInstanceEventStreamRoute_1 instead
 	LRPGroupEventStreamRoute_r1    = "EventStream"
@@ -86,7 +122,11 @@ const (
 	LrpInstanceEventStreamRoute_r0 = "LrpInstanceEventStream_r0"
 
 	// Cell Presence
-	CellsRoute_r0 = "Cells"
+	CellsRoute_r0        = "Cells"
+	CordonCellRoute_r0   = "CordonCell"
+	UncordonCellRoute_r0 = "UncordonCell"
+	DrainCellRoute_r0    = "DrainCell"
+	CellDrainsRoute_r0   = "CellDrains"
 )
 
 var Routes = rata.Routes{
@@ -97,12 +137,19 @@ var Routes = rata.Routes{
 	{Path: "/v1/domains/list", Method: "POST", Name: DomainsRoute_r0},
 	{Path: "/v1/domains/upsert", Method: "POST", Name: UpsertDomainRoute_r0},
 
+	// Domain Quotas
+	{Path: "/v1/domain_quotas/list", Method: "POST", Name: DomainQuotasRoute_r0},
+	{Path: "/v1/domain_quotas/set", Method: "POST", Name: SetDomainQuotaRoute_r0},
+	{Path: "/v1/domain_quotas/remove", Method: "POST", Name: RemoveDomainQuotaRoute_r0},
+	{Path: "/v1/domain_quotas/usage", Method: "POST", Name: DomainUsageRoute_r0},
+
 	// Actual LRPs
 	{Path: "/v1/actual_lrps/list", Method: "POST", Name: ActualLRPsRoute_r0},
 	{Path: "/v1/actual_lrps/list_by_process_guids", Method: "POST", Name: ActualLRPsByProcessGuidsRoute_r0},
 	{Path: "/v1/actual_lrp_groups/list", Method: "POST", Name: ActualLRPGroupsRoute_r0},                                              // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/list_by_process_guid", Method: "POST", Name: ActualLRPGroupsByProcessGuidRoute_r0},                 // DEPRECATED
 	{Path: "/v1/actual_lrp_groups/get_by_process_guid_and_index", Method: "POST", Name: ActualLRPGroupByProcessGuidAndIndexRoute_r0}, // DEPRECATED
+	{Path: "/v1/actual_lrps/history", Method: "POST", Name: ActualLRPHistoryRoute_r0},
 
 	// Actual LRP Lifecycle
 	{Path: "/v1/actual_lrps/claim", Method: "POST", Name: ClaimActualLRPRoute_r0},
@@ -136,6 +183,13 @@ var Routes = rata.Routes{
 	{Path: "/v1/desired_lrp/update", Method: "POST", Name: UpdateDesiredLRPRoute_r0},
 	{Path: "/v1/desired_lrp/remove", Method: "POST", Name: RemoveDesiredLRPRoute_r0},
 
+	// Deployments
+	{Path: "/v1/deployments/start", Method: "POST", Name: StartDeploymentRoute_r0},
+	{Path: "/v1/deployments/get_by_process_guid", Method: "POST", Name: DeploymentByProcessGuidRoute_r0},
+	{Path: "/v1/deployments/pause", Method: "POST", Name: PauseDeploymentRoute_r0},
+	{Path: "/v1/deployments/resume", Method: "POST", Name: ResumeDeploymentRoute_r0},
+	{Path: "/v1/deployments/rollback", Method: "POST", Name: RollbackDeploymentRoute_r0},
+
 	// Tasks
 	{Path: "/v1/tasks/list.r3", Method: "POST", Name: TasksRoute_r3},
 	{Path: "/v1/tasks/get_by_task_guid.r3", Method: "POST", Name: TaskByGuidRoute_r3},
@@ -152,6 +206,27 @@ var Routes = rata.Routes{
 	{Path: "/v1/tasks/resolving", Method: "POST", Name: ResolvingTaskRoute_r0},
 	{Path: "/v1/tasks/delete", Method: "POST", Name: DeleteTaskRoute_r0},
 
+	// Scheduled Tasks
+	{Path: "/v1/scheduled_tasks/list", Method: "POST", Name: ScheduledTasksRoute_r0},
+	{Path: "/v1/scheduled_tasks/desire", Method: "POST", Name: DesireScheduledTaskRoute_r0},
+	{Path: "/v1/scheduled_tasks/update", Method: "POST", Name: UpdateScheduledTaskRoute_r0},
+	{Path: "/v1/scheduled_tasks/suspend", Method: "POST", Name: SuspendScheduledTaskRoute_r0},
+	{Path: "/v1/scheduled_tasks/delete", Method: "POST", Name: DeleteScheduledTaskRoute_r0},
+
+	// Task Callbacks
+	{Path: "/v1/task_callbacks/list", Method: "POST", Name: TaskCallbacksRoute_r0},
+	{Path: "/v1/task_callbacks/replay", Method: "POST", Name: ReplayTaskCallbackRoute_r0},
+
+	// Audit Records
+	{Path: "/v1/audit_records/list", Method: "POST", Name: AuditRecordsRoute_r0},
+
+	// Overload
+	{Path: "/v1/overload/status", Method: "POST", Name: OverloadStatusRoute_r0},
+
+	// Crash Storm Breakers
+	{Path: "/v1/crash_storm_breakers/list", Method: "POST", Name: CrashStormBreakersRoute_r0},
+	{Path: "/v1/crash_storm_breakers/reset", Method: "POST", Name: ResetCrashStormBreakerRoute_r0},
+
 	// Event Streaming
 	{Path: "/v1/events.r1", Method: "GET", Name: LRPGroupEventStreamRoute_r1}, // DEPRECATED
 	{Path: "/v1/events/tasks.r1", Method: "POST", Name: TaskEventStreamRoute_r1},
@@ -162,4 +237,8 @@ var Routes = rata.Routes{
 
 	// Cells
 	{Path: "/v1/cells/list.r1", Method: "POST", Name: CellsRoute_r0},
+	{Path: "/v1/cells/cordon", Method: "POST", Name: CordonCellRoute_r0},
+	{Path: "/v1/cells/uncordon", Method: "POST", Name: UncordonCellRoute_r0},
+	{Path: "/v1/cells/drain", Method: "POST", Name: DrainCellRoute_r0},
+	{Path: "/v1/cells/drains/list", Method: "POST", Name: CellDrainsRoute_r0},
 }
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package fake_controllers
+
+import (
+	"context"
+	"sync"
+
+	"code.cloudfoundry.org/bbs/scheduler"
+	lager "code.cloudfoundry.org/lager/v3"
+)
+
+type FakeScheduledTaskController struct {
+	ScheduleTasksStub        func(context.Context, lager.Logger) error
+	scheduleTasksMutex       sync.RWMutex
+	scheduleTasksArgsForCall []struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}
+	scheduleTasksReturns struct {
+		result1 error
+	}
+	scheduleTasksReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasks(arg1 context.Context, arg2 lager.Logger) error {
+	fake.scheduleTasksMutex.Lock()
+	ret, specificReturn := fake.scheduleTasksReturnsOnCall[len(fake.scheduleTasksArgsForCall)]
+	fake.scheduleTasksArgsForCall = append(fake.scheduleTasksArgsForCall, struct {
+		arg1 context.Context
+		arg2 lager.Logger
+	}{arg1, arg2})
+	stub := fake.ScheduleTasksStub
+	fakeReturns := fake.scheduleTasksReturns
+	fake.recordInvocation("ScheduleTasks", []interface{}{arg1, arg2})
+	fake.scheduleTasksMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasksCallCount() int {
+	fake.scheduleTasksMutex.RLock()
+	defer fake.scheduleTasksMutex.RUnlock()
+	return len(fake.scheduleTasksArgsForCall)
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasksCalls(stub func(context.Context, lager.Logger) error) {
+	fake.scheduleTasksMutex.Lock()
+	defer fake.scheduleTasksMutex.Unlock()
+	fake.ScheduleTasksStub = stub
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasksArgsForCall(i int) (context.Context, lager.Logger) {
+	fake.scheduleTasksMutex.RLock()
+	defer fake.scheduleTasksMutex.RUnlock()
+	argsForCall := fake.scheduleTasksArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasksReturns(result1 error) {
+	fake.scheduleTasksMutex.Lock()
+	defer fake.scheduleTasksMutex.Unlock()
+	fake.ScheduleTasksStub = nil
+	fake.scheduleTasksReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskController) ScheduleTasksReturnsOnCall(i int, result1 error) {
+	fake.scheduleTasksMutex.Lock()
+	defer fake.scheduleTasksMutex.Unlock()
+	fake.ScheduleTasksStub = nil
+	if fake.scheduleTasksReturnsOnCall == nil {
+		fake.scheduleTasksReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.scheduleTasksReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeScheduledTaskController) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.scheduleTasksMutex.RLock()
+	defer fake.scheduleTasksMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeScheduledTaskController) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ scheduler.ScheduledTaskController = new(FakeScheduledTaskController)
@@ -0,0 +1 @@
+package scheduler // import "code.cloudfoundry.org/bbs/scheduler"
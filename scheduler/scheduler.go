@@ -0,0 +1,69 @@
+package scheduler
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+//go:generate counterfeiter -generate
+
+//counterfeiter:generate -o fake_controllers/fake_scheduled_task_controller.go . ScheduledTaskController
+type ScheduledTaskController interface {
+	ScheduleTasks(ctx context.Context, logger lager.Logger) error
+}
+
+// DEFAULT_SCHEDULE_INTERVAL matches the precision of the task guids of the
+// runs, which carry their scheduled time in seconds.
+const DEFAULT_SCHEDULE_INTERVAL = time.Second
+
+// Scheduler desires the tasks of the schedules that are due at a fixed
+// interval, independently of convergence, so that a run starts at most one
+// interval after its scheduled time.
+type Scheduler struct {
+	logger                  lager.Logger
+	clock                   clock.Clock
+	scheduledTaskController ScheduledTaskController
+	scheduleInterval        time.Duration
+}
+
+func New(
+	logger lager.Logger,
+	clock clock.Clock,
+	scheduledTaskController ScheduledTaskController,
+	scheduleInterval time.Duration,
+) *Scheduler {
+	return &Scheduler{
+		logger:                  logger,
+		clock:                   clock,
+		scheduledTaskController: scheduledTaskController,
+		scheduleInterval:        scheduleInterval,
+	}
+}
+
+func (s *Scheduler) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	logger := s.logger.Session("scheduler")
+	logger.Info("started")
+	defer logger.Info("done")
+
+	ticker := s.clock.NewTicker(s.scheduleInterval)
+	defer ticker.Stop()
+
+	close(ready)
+
+	for {
+		select {
+		case <-signals:
+			return nil
+
+		case <-ticker.C():
+			err := s.scheduledTaskController.ScheduleTasks(context.Background(), logger)
+			if err != nil {
+				logger.Error("failed-to-schedule-tasks", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package scheduler_test
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/bbs/scheduler"
+	"code.cloudfoundry.org/bbs/scheduler/fake_controllers"
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/v3/lagertest"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+	ginkgomon "github.com/tedsuo/ifrit/ginkgomon_v2"
+)
+
+var _ = Describe("Scheduler", func() {
+	const scheduleInterval = time.Second
+
+	var (
+		fakeClock      *fakeclock.FakeClock
+		fakeController *fake_controllers.FakeScheduledTaskController
+		process        ifrit.Process
+	)
+
+	BeforeEach(func() {
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		fakeController = new(fake_controllers.FakeScheduledTaskController)
+	})
+
+	JustBeforeEach(func() {
+		runner := scheduler.New(lagertest.NewTestLogger("test"), fakeClock, fakeController, scheduleInterval)
+		process = ginkgomon.Invoke(runner)
+	})
+
+	AfterEach(func() {
+		ginkgomon.Interrupt(process)
+	})
+
+	It("schedules the due tasks every interval", func() {
+		Consistently(fakeController.ScheduleTasksCallCount).Should(Equal(0))
+
+		fakeClock.WaitForWatcherAndIncrement(scheduleInterval)
+		Eventually(fakeController.ScheduleTasksCallCount).Should(Equal(1))
+
+		fakeClock.WaitForWatcherAndIncrement(scheduleInterval)
+		Eventually(fakeController.ScheduleTasksCallCount).Should(Equal(2))
+	})
+
+	Context("when scheduling tasks fails", func() {
+		BeforeEach(func() {
+			fakeController.ScheduleTasksReturns(errors.New("boom"))
+		})
+
+		It("tries again on the next interval", func() {
+			fakeClock.WaitForWatcherAndIncrement(scheduleInterval)
+			Eventually(fakeController.ScheduleTasksCallCount).Should(Equal(1))
+
+			fakeClock.WaitForWatcherAndIncrement(scheduleInterval)
+			Eventually(fakeController.ScheduleTasksCallCount).Should(Equal(2))
+		})
+	})
+
+	It("exits when signaled", func() {
+		process.Signal(os.Interrupt)
+		Eventually(process.Wait()).Should(Receive(BeNil()))
+	})
+})
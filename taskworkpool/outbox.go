@@ -0,0 +1,192 @@
+package taskworkpool
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/bbs/db"
+	"code.cloudfoundry.org/bbs/events"
+	"code.cloudfoundry.org/bbs/models"
+	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager/v3"
+)
+
+const (
+	DEFAULT_CB_MAX_ATTEMPTS      = 10
+	DEFAULT_CB_INITIAL_BACKOFF   = time.Second
+	DEFAULT_CB_MAX_BACKOFF       = 5 * time.Minute
+	DEFAULT_CB_DISPATCH_INTERVAL = 5 * time.Second
+
+	TimestampHeader = "X-Bbs-Timestamp"
+	SignatureHeader = "X-Bbs-Signature"
+)
+
+// CallbackPolicy decides how often and how far apart failed completion
+// callbacks are retried before they are dead-lettered.
+type CallbackPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// SigningSecret, when set, signs every callback with HMAC-SHA256.
+	SigningSecret string
+}
+
+// CallbackOutbox delivers completion callbacks and records the failed
+// deliveries in the task callbacks table, from which they are retried.
+type CallbackOutbox struct {
+	httpClient    *http.Client
+	callbackDB    db.TaskCallbackDB
+	clock         clock.Clock
+	policy        CallbackPolicy
+	claimDuration time.Duration
+}
+
+func NewCallbackOutbox(callbackDB db.TaskCallbackDB, clock clock.Clock, policy CallbackPolicy, tlsConfig *tls.Config, requestTimeout time.Duration) *CallbackOutbox {
+	if policy.MaxAttempts <= 0 {
+		policy.MaxAttempts = DEFAULT_CB_MAX_ATTEMPTS
+	}
+	if policy.InitialBackoff <= 0 {
+		policy.InitialBackoff = DEFAULT_CB_INITIAL_BACKOFF
+	}
+	if policy.MaxBackoff <= 0 {
+		policy.MaxBackoff = DEFAULT_CB_MAX_BACKOFF
+	}
+
+	httpClient := cfhttp.NewClient(
+		cfhttp.WithTLSConfig(tlsConfig),
+		cfhttp.WithRequestTimeout(requestTimeout),
+	)
+
+	return &CallbackOutbox{
+		httpClient: httpClient,
+		callbackDB: callbackDB,
+		clock:      clock,
+		policy:     policy,
+		// a claimed callback is left alone for a minute longer than its
+		// request may take, so that only a crashed delivery is taken over
+		claimDuration: time.Minute + requestTimeout,
+	}
+}
+
+// Enqueue records the callback of the task, claimed by the caller.
+func (o *CallbackOutbox) Enqueue(logger lager.Logger, taskGuid string) (*models.TaskCallback, error) {
+	return o.callbackDB.EnqueueTaskCallback(context.Background(), logger, taskGuid, o.clock.Now().Add(o.claimDuration))
+}
+
+// ClaimDue claims at most limit callbacks whose next attempt is due.
+func (o *CallbackOutbox) ClaimDue(logger lager.Logger, limit int) ([]*models.TaskCallback, error) {
+	now := o.clock.Now()
+	return o.callbackDB.ClaimDueTaskCallbacks(context.Background(), logger, now, now.Add(o.claimDuration), limit)
+}
+
+// Deliver POSTs the callback of the task. The task is deleted when the
+// callback is answered, otherwise the failed attempt is recorded.
+func (o *CallbackOutbox) Deliver(logger lager.Logger, taskDB db.TaskDB, taskHub events.Hub, task *models.Task, callback *models.TaskCallback) {
+	logger = logger.WithData(lager.Data{"callback_url": task.CompletionCallbackUrl})
+
+	statusCode, err := o.post(task)
+	if err == nil && shouldResolve(statusCode) {
+		ctx, writeVersion := db.WithWriteVersion(context.Background())
+		deletedTask, modelErr := taskDB.DeleteTask(ctx, logger, task.TaskGuid)
+		if modelErr != nil {
+			logger.Error("delete-task-failed", modelErr)
+			return
+		}
+		go taskHub.Emit(writeVersion.Versioned(models.NewTaskRemovedEvent(deletedTask)))
+		return
+	}
+
+	lastError := ""
+	if err != nil {
+		lastError = err.Error()
+	}
+
+	attempts := int(callback.Attempts) + 1
+	deadLetter := attempts >= o.policy.MaxAttempts
+	nextAttemptAt := o.clock.Now().Add(o.backoff(attempts))
+
+	logger.Info("callback-failed", lager.Data{"status_code": statusCode, "error": lastError, "attempts": attempts, "dead_lettered": deadLetter})
+	_, err = o.callbackDB.RecordTaskCallbackAttempt(context.Background(), logger, task.TaskGuid, int32(statusCode), lastError, nextAttemptAt, deadLetter)
+	if err != nil {
+		logger.Error("recording-callback-attempt-failed", err)
+	}
+}
+
+func (o *CallbackOutbox) post(task *models.Task) (int, error) {
+	payload, err := json.Marshal(&models.TaskCallbackResponse{
+		TaskGuid:      task.TaskGuid,
+		Failed:        task.Failed,
+		FailureReason: task.FailureReason,
+		Result:        task.Result,
+		Annotation:    task.Annotation,
+		CreatedAt:     task.CreatedAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	request, err := http.NewRequest("POST", task.CompletionCallbackUrl, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+	if o.policy.SigningSecret != "" {
+		timestamp := strconv.FormatInt(o.clock.Now().Unix(), 10)
+		request.Header.Set(TimestampHeader, timestamp)
+		request.Header.Set(SignatureHeader, Sign(o.policy.SigningSecret, timestamp, payload))
+	}
+
+	response, err := o.httpClient.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	response.Body.Close()
+
+	return response.StatusCode, nil
+}
+
+// backoff doubles the initial backoff for every attempt up to the maximum,
+// and spreads the result over its upper half so that callbacks failing
+// together are not retried together.
+func (o *CallbackOutbox) backoff(attempts int) time.Duration {
+	backoff := o.policy.InitialBackoff
+	for i := 1; i < attempts && backoff < o.policy.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > o.policy.MaxBackoff {
+		backoff = o.policy.MaxBackoff
+	}
+
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(backoff-half)+1))
+}
+
+// Sign returns the signature of a callback sent at timestamp, the
+// hex-encoded HMAC-SHA256 of the timestamp, a dot and the payload.
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func shouldResolve(status int) bool {
+	switch status {
+	case http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return false
+	default:
+		return true
+	}
+}
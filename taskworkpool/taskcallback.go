@@ -1,30 +1,22 @@
 package taskworkpool
 
 import (
-	"bytes"
 	"context"
-	"crypto/tls"
-	"encoding/json"
-	"net/http"
 	"os"
-	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/bbs/db"
 	"code.cloudfoundry.org/bbs/events"
 	"code.cloudfoundry.org/bbs/models"
-	cfhttp "code.cloudfoundry.org/cfhttp/v2"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/workpool"
 )
 
-const MAX_CB_RETRIES = 3
-
 //go:generate counterfeiter -generate
 
 //counterfeiter:generate . TaskCompletionClient
 
-type CompletedTaskHandler func(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
+type CompletedTaskHandler func(logger lager.Logger, outbox *CallbackOutbox, taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
 
 type TaskCompletionClient interface {
 	Submit(taskDB db.TaskDB, taskHub events.Hub, task *models.Task)
@@ -35,24 +27,28 @@ type TaskCompletionWorkPool struct {
 	maxWorkers       int
 	callbackHandler  CompletedTaskHandler
 	callbackWorkPool *workpool.WorkPool
-	httpClient       *http.Client
+	outbox           *CallbackOutbox
+	taskDB           db.TaskDB
+	taskHub          events.Hub
+	dispatchInterval time.Duration
 }
 
-func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, tlsConfig *tls.Config, requestTimeout time.Duration) *TaskCompletionWorkPool {
+// New returns a work pool that delivers completion callbacks. Every
+// dispatchInterval it also delivers the callbacks of the outbox whose next
+// attempt is due.
+func New(logger lager.Logger, maxWorkers int, cbHandler CompletedTaskHandler, outbox *CallbackOutbox, taskDB db.TaskDB, taskHub events.Hub, dispatchInterval time.Duration) *TaskCompletionWorkPool {
 	if cbHandler == nil {
 		panic("callbackHandler cannot be nil")
 	}
 
-	httpClient := cfhttp.NewClient(
-		cfhttp.WithTLSConfig(tlsConfig),
-		cfhttp.WithRequestTimeout(requestTimeout),
-	)
-
 	return &TaskCompletionWorkPool{
-		logger:          logger.Session("task-completion-workpool"),
-		maxWorkers:      maxWorkers,
-		callbackHandler: cbHandler,
-		httpClient:      httpClient,
+		logger:           logger.Session("task-completion-workpool"),
+		maxWorkers:       maxWorkers,
+		callbackHandler:  cbHandler,
+		outbox:           outbox,
+		taskDB:           taskDB,
+		taskHub:          taskHub,
+		dispatchInterval: dispatchInterval,
 	}
 }
 
@@ -70,10 +66,18 @@ func (twp *TaskCompletionWorkPool) Run(signals <-chan os.Signal, ready chan<- st
 	logger.Info("started")
 	defer logger.Info("finished")
 
-	<-signals
-	twp.callbackWorkPool.Stop()
+	ticker := twp.outbox.clock.NewTicker(twp.dispatchInterval)
+	defer ticker.Stop()
 
-	return nil
+	for {
+		select {
+		case <-signals:
+			twp.callbackWorkPool.Stop()
+			return nil
+		case <-ticker.C():
+			twp.dispatch(logger)
+		}
+	}
 }
 
 func (twp *TaskCompletionWorkPool) Submit(taskDB db.TaskDB, taskHub events.Hub, task *models.Task) {
@@ -82,77 +86,72 @@ func (twp *TaskCompletionWorkPool) Submit(taskDB db.TaskDB, taskHub events.Hub,
 	}
 	logger := twp.logger
 	twp.callbackWorkPool.Submit(func() {
-		twp.callbackHandler(logger, twp.httpClient, taskDB, taskHub, task)
+		twp.callbackHandler(logger, twp.outbox, taskDB, taskHub, task)
 	})
 }
 
-func HandleCompletedTask(logger lager.Logger, httpClient *http.Client, taskDB db.TaskDB, taskHub events.Hub, task *models.Task) {
+// dispatch delivers the callbacks of the outbox whose next attempt is due,
+// claiming no more than the workers can take on at once.
+func (twp *TaskCompletionWorkPool) dispatch(logger lager.Logger) {
+	logger = logger.Session("dispatch-task-callbacks")
+
+	callbacks, err := twp.outbox.ClaimDue(logger, twp.maxWorkers)
+	if err != nil {
+		logger.Error("failed-claiming-task-callbacks", err)
+		return
+	}
+
+	for _, callback := range callbacks {
+		callback := callback
+		twp.callbackWorkPool.Submit(func() {
+			redeliverTaskCallback(logger, twp.outbox, twp.taskDB, twp.taskHub, callback)
+		})
+	}
+}
+
+func HandleCompletedTask(logger lager.Logger, outbox *CallbackOutbox, taskDB db.TaskDB, taskHub events.Hub, task *models.Task) {
 	logger = logger.Session("handle-completed-task", lager.Data{"task_guid": task.TaskGuid})
 
 	if task.CompletionCallbackUrl != "" {
-		before, after, modelErr := taskDB.ResolvingTask(context.Background(), logger, task.TaskGuid)
+		ctx, writeVersion := db.WithWriteVersion(context.Background())
+		before, after, modelErr := taskDB.ResolvingTask(ctx, logger, task.TaskGuid)
 		if modelErr != nil {
 			logger.Error("marking-task-as-resolving-failed", modelErr)
 			return
 		}
-		go taskHub.Emit(models.NewTaskChangedEvent(before, after))
+		go taskHub.Emit(writeVersion.Versioned(models.NewTaskChangedEvent(before, after)))
 
-		logger = logger.WithData(lager.Data{"callback_url": task.CompletionCallbackUrl})
-
-		json, err := json.Marshal(&models.TaskCallbackResponse{
-			TaskGuid:      task.TaskGuid,
-			Failed:        task.Failed,
-			FailureReason: task.FailureReason,
-			Result:        task.Result,
-			Annotation:    task.Annotation,
-			CreatedAt:     task.CreatedAt,
-		})
-		if err != nil {
-			logger.Error("marshalling-task-failed", err)
+		callback, err := outbox.Enqueue(logger, task.TaskGuid)
+		if err == models.ErrResourceExists {
+			logger.Info("callback-already-enqueued")
 			return
 		}
-
-		var statusCode int
-
-		retriableErrRegexp := regexp.MustCompile("Client.Timeout|use of closed network connection")
-		for i := 0; i < MAX_CB_RETRIES; i++ {
-			request, err := http.NewRequest("POST", task.CompletionCallbackUrl, bytes.NewReader(json))
-			if err != nil {
-				logger.Error("building-request-failed", err)
-				return
-			}
-
-			request.Header.Set("Content-Type", "application/json")
-			response, err := httpClient.Do(request)
-			if err != nil {
-				if retriableErrRegexp.MatchString(err.Error()) {
-					continue
-				}
-				logger.Error("doing-request-failed", err)
-				return
-			}
-			defer response.Body.Close()
-
-			statusCode = response.StatusCode
-			if shouldResolve(statusCode) {
-				deletedTask, modelErr := taskDB.DeleteTask(context.Background(), logger, task.TaskGuid)
-				if modelErr != nil {
-					logger.Error("delete-task-failed", modelErr)
-				}
-				go taskHub.Emit(models.NewTaskRemovedEvent(deletedTask))
-				return
-			}
+		if err != nil {
+			// deliver anyway, convergence kicks the task if the callback fails
+			logger.Error("enqueueing-callback-failed", err)
+			callback = &models.TaskCallback{TaskGuid: task.TaskGuid}
 		}
 
-		logger.Info("callback-failed", lager.Data{"status_code": statusCode})
+		outbox.Deliver(logger, taskDB, taskHub, task, callback)
 	}
 }
 
-func shouldResolve(status int) bool {
-	switch status {
-	case http.StatusServiceUnavailable, http.StatusGatewayTimeout:
-		return false
-	default:
-		return true
+func redeliverTaskCallback(logger lager.Logger, outbox *CallbackOutbox, taskDB db.TaskDB, taskHub events.Hub, callback *models.TaskCallback) {
+	logger = logger.Session("redeliver-task-callback", lager.Data{"task_guid": callback.TaskGuid, "attempts": callback.Attempts})
+
+	task, err := taskDB.TaskByGuid(context.Background(), logger, callback.TaskGuid)
+	if err == models.ErrResourceNotFound {
+		logger.Info("task-already-deleted")
+		err = outbox.callbackDB.DeleteTaskCallback(context.Background(), logger, callback.TaskGuid)
+		if err != nil {
+			logger.Error("deleting-callback-failed", err)
+		}
+		return
 	}
+	if err != nil {
+		logger.Error("fetching-task-failed", err)
+		return
+	}
+
+	outbox.Deliver(logger, taskDB, taskHub, task, callback)
 }
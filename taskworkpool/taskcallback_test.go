@@ -6,6 +6,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/bbs/db/dbfakes"
@@ -13,7 +14,7 @@ import (
 	"code.cloudfoundry.org/bbs/models"
 	"code.cloudfoundry.org/bbs/models/test/model_helpers"
 	"code.cloudfoundry.org/bbs/taskworkpool"
-	cfhttp "code.cloudfoundry.org/cfhttp/v2"
+	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/v3"
 	"code.cloudfoundry.org/lager/v3/lagertest"
 	"github.com/tedsuo/ifrit"
@@ -29,11 +30,21 @@ var _ = Describe("TaskWorker", func() {
 		fakeServer *ghttp.Server
 		logger     *lagertest.TestLogger
 		timeout    time.Duration
+		fakeClock  *fakeclock.FakeClock
+		callbackDB *dbfakes.FakeTaskCallbackDB
+		policy     taskworkpool.CallbackPolicy
 	)
 
 	BeforeEach(func() {
 		timeout = 1 * time.Second
 		fakeServer = ghttp.NewServer()
+		fakeClock = fakeclock.NewFakeClock(time.Now())
+		callbackDB = new(dbfakes.FakeTaskCallbackDB)
+		policy = taskworkpool.CallbackPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Second,
+			MaxBackoff:     time.Minute,
+		}
 
 		logger = lagertest.NewTestLogger("test")
 		logger.RegisterSink(lager.NewWriterSink(GinkgoWriter, lager.INFO))
@@ -51,14 +62,9 @@ var _ = Describe("TaskWorker", func() {
 			task          *models.Task
 			before, after models.Task
 			taskHub       *eventfakes.FakeHub
-
-			httpClient *http.Client
 		)
 
 		BeforeEach(func() {
-			httpClient = cfhttp.NewClient(
-				cfhttp.WithRequestTimeout(timeout),
-			)
 			statusCodes = make(chan int)
 			taskHub = &eventfakes.FakeHub{}
 
@@ -78,13 +84,16 @@ var _ = Describe("TaskWorker", func() {
 			taskDB.DeleteTaskStub = func(_ context.Context, _ lager.Logger, taskGuid string) (*models.Task, error) {
 				return task, nil
 			}
+
+			callbackDB.EnqueueTaskCallbackReturns(&models.TaskCallback{TaskGuid: "the-task-guid"}, nil)
 		})
 
 		simulateTaskCompleting := func(signals <-chan os.Signal, ready chan<- struct{}) error {
 			close(ready)
 			task = model_helpers.NewValidTask("the-task-guid")
 			task.CompletionCallbackUrl = callbackURL
-			taskworkpool.HandleCompletedTask(logger, httpClient, taskDB, taskHub, task)
+			outbox := taskworkpool.NewCallbackOutbox(callbackDB, fakeClock, policy, nil, timeout)
+			taskworkpool.HandleCompletedTask(logger, outbox, taskDB, taskHub, task)
 			return nil
 		}
 
@@ -112,9 +121,7 @@ var _ = Describe("TaskWorker", func() {
 
 			It("emits a TaskChangedEvent to the hub", func() {
 				// Do not emit TaskRemovedEvent in callback handler
-				for i := 0; i < taskworkpool.MAX_CB_RETRIES; i++ {
-					statusCodes <- http.StatusServiceUnavailable
-				}
+				statusCodes <- http.StatusServiceUnavailable
 
 				Eventually(taskHub.EmitCallCount).Should(Equal(1))
 				event := taskHub.EmitArgsForCall(0)
@@ -124,6 +131,15 @@ var _ = Describe("TaskWorker", func() {
 				Expect(changed.After).To(BeEquivalentTo(&after))
 			})
 
+			It("enqueues the callback claimed for the duration of the request", func() {
+				statusCodes <- 200
+
+				Eventually(callbackDB.EnqueueTaskCallbackCallCount).Should(Equal(1))
+				_, _, taskGuid, claimedUntil := callbackDB.EnqueueTaskCallbackArgsForCall(0)
+				Expect(taskGuid).To(Equal("the-task-guid"))
+				Expect(claimedUntil).To(BeTemporally(">", fakeClock.Now().Add(timeout)))
+			})
+
 			Context("when marking the task as resolving fails", func() {
 				BeforeEach(func() {
 					taskDB.ResolvingTaskReturns(nil, nil, models.NewError(models.Error_UnknownError, "failed to resolve task"))
@@ -134,6 +150,28 @@ var _ = Describe("TaskWorker", func() {
 				})
 			})
 
+			Context("when the callback is already in the outbox", func() {
+				BeforeEach(func() {
+					callbackDB.EnqueueTaskCallbackReturns(nil, models.ErrResourceExists)
+				})
+
+				It("leaves the delivery to the outbox", func() {
+					Consistently(fakeServer.ReceivedRequests, 0.25).Should(BeEmpty())
+				})
+			})
+
+			Context("when enqueueing the callback fails", func() {
+				BeforeEach(func() {
+					callbackDB.EnqueueTaskCallbackReturns(nil, models.ErrUnknownError)
+				})
+
+				It("still POSTs to the task's callback URL", func() {
+					statusCodes <- 200
+					Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
+					Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+				})
+			})
+
 			Context("when marking the task as resolving succeeds", func() {
 				It("POSTs to the task's callback URL", func() {
 					statusCodes <- 200
@@ -174,6 +212,49 @@ var _ = Describe("TaskWorker", func() {
 						Expect(ok).To(BeTrue())
 						Expect(removed.Task).To(BeEquivalentTo(task))
 					})
+
+					It("does not sign the request", func() {
+						statusCodes <- 200
+
+						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
+						Expect(fakeServer.ReceivedRequests()[0].Header.Get(taskworkpool.SignatureHeader)).To(BeEmpty())
+					})
+				})
+
+				Context("when a signing secret is configured", func() {
+					var (
+						timestamps chan string
+						signatures chan string
+						payloads   chan []byte
+					)
+
+					BeforeEach(func() {
+						policy.SigningSecret = "callback-secret"
+						timestamps = make(chan string, 1)
+						signatures = make(chan string, 1)
+						payloads = make(chan []byte, 1)
+						fakeServer.RouteToHandler("POST", "/the-callback/url", func(w http.ResponseWriter, req *http.Request) {
+							data, err := io.ReadAll(req.Body)
+							Expect(err).NotTo(HaveOccurred())
+							timestamps <- req.Header.Get(taskworkpool.TimestampHeader)
+							signatures <- req.Header.Get(taskworkpool.SignatureHeader)
+							payloads <- data
+							w.WriteHeader(200)
+						})
+					})
+
+					It("signs the timestamp and payload with HMAC-SHA256", func() {
+						var timestamp, signature string
+						var payload []byte
+						Eventually(timestamps).Should(Receive(&timestamp))
+						Eventually(signatures).Should(Receive(&signature))
+						Eventually(payloads).Should(Receive(&payload))
+
+						Expect(timestamp).To(Equal(strconv.FormatInt(fakeClock.Now().Unix(), 10)))
+						Expect(signature).To(HavePrefix("sha256="))
+						Expect(signature).To(Equal(taskworkpool.Sign("callback-secret", timestamp, payload)))
+						Expect(signature).NotTo(Equal(taskworkpool.Sign("other-secret", timestamp, payload)))
+					})
 				})
 
 				Context("when the request fails with a 4xx response code", func() {
@@ -217,44 +298,49 @@ var _ = Describe("TaskWorker", func() {
 				})
 
 				Context("when the request fails with a 503 or 504 response code", func() {
-					It("retries the request 2 more times", func() {
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
-
+					It("records the failed attempt to be retried after the backoff", func() {
 						statusCodes <- 503
 
-						Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(2))
-
-						statusCodes <- 504
+						Eventually(callbackDB.RecordTaskCallbackAttemptCallCount).Should(Equal(1))
+						_, _, taskGuid, statusCode, lastError, nextAttemptAt, deadLetter := callbackDB.RecordTaskCallbackAttemptArgsForCall(0)
+						Expect(taskGuid).To(Equal("the-task-guid"))
+						Expect(statusCode).To(BeEquivalentTo(503))
+						Expect(lastError).To(BeEmpty())
+						Expect(nextAttemptAt).To(BeTemporally(">=", fakeClock.Now().Add(500*time.Millisecond)))
+						Expect(nextAttemptAt).To(BeTemporally("<=", fakeClock.Now().Add(time.Second)))
+						Expect(deadLetter).To(BeFalse())
 
 						Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(3))
-
-						statusCodes <- 200
-
-						Eventually(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(1))
-						_, _, actualGuid := taskDB.DeleteTaskArgsForCall(0)
-						Expect(actualGuid).To(Equal("the-task-guid"))
+						Expect(fakeServer.ReceivedRequests()).To(HaveLen(1))
 					})
 
-					Context("when the request fails every time", func() {
-						It("does not resolve the task", func() {
-							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
-
-							statusCodes <- 503
-
-							Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(2))
+					Context("when the callback already failed before", func() {
+						BeforeEach(func() {
+							callbackDB.EnqueueTaskCallbackReturns(&models.TaskCallback{TaskGuid: "the-task-guid", Attempts: 1}, nil)
+						})
 
+						It("doubles the backoff", func() {
 							statusCodes <- 504
 
-							Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(3))
+							Eventually(callbackDB.RecordTaskCallbackAttemptCallCount).Should(Equal(1))
+							_, _, _, _, _, nextAttemptAt, deadLetter := callbackDB.RecordTaskCallbackAttemptArgsForCall(0)
+							Expect(nextAttemptAt).To(BeTemporally(">=", fakeClock.Now().Add(time.Second)))
+							Expect(nextAttemptAt).To(BeTemporally("<=", fakeClock.Now().Add(2*time.Second)))
+							Expect(deadLetter).To(BeFalse())
+						})
+					})
 
+					Context("when the callback runs out of attempts", func() {
+						BeforeEach(func() {
+							callbackDB.EnqueueTaskCallbackReturns(&models.TaskCallback{TaskGuid: "the-task-guid", Attempts: 2}, nil)
+						})
+
+						It("dead-letters the callback", func() {
 							statusCodes <- 503
 
-							Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-							Consistently(fakeServer.ReceivedRequests, 0.25).Should(HaveLen(3))
+							Eventually(callbackDB.RecordTaskCallbackAttemptCallCount).Should(Equal(1))
+							_, _, _, _, _, _, deadLetter := callbackDB.RecordTaskCallbackAttemptArgsForCall(0)
+							Expect(deadLetter).To(BeTrue())
 						})
 					})
 				})
@@ -284,35 +370,14 @@ var _ = Describe("TaskWorker", func() {
 						})
 					})
 
-					It("retries the request 2 more times", func() {
+					It("records the error of the failed attempt", func() {
 						sleepCh <- timeout + 100*time.Millisecond
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
-
-						sleepCh <- timeout + 100*time.Millisecond
-						Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(2))
-
-						sleepCh <- timeout + 100*time.Millisecond
-						Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-						Eventually(fakeServer.ReceivedRequests).Should(HaveLen(3))
-
-						Eventually(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-					})
-
-					Context("when the request fails with timeout once and then succeeds", func() {
-						It("deletes the task", func() {
-							sleepCh <- (timeout + 100*time.Millisecond)
-
-							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
-							Consistently(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(0))
-
-							sleepCh <- 0
-							Eventually(fakeServer.ReceivedRequests).Should(HaveLen(2))
-							Eventually(taskDB.DeleteTaskCallCount, 0.25).Should(Equal(1))
 
-							_, _, resolvedTaskGuid := taskDB.DeleteTaskArgsForCall(0)
-							Expect(resolvedTaskGuid).To(Equal("the-task-guid"))
-						})
+						Eventually(callbackDB.RecordTaskCallbackAttemptCallCount, 2*timeout).Should(Equal(1))
+						_, _, _, statusCode, lastError, _, _ := callbackDB.RecordTaskCallbackAttemptArgsForCall(0)
+						Expect(statusCode).To(BeZero())
+						Expect(lastError).To(ContainSubstring("Client.Timeout"))
+						Expect(taskDB.DeleteTaskCallCount()).To(Equal(0))
 					})
 				})
 			})
@@ -328,4 +393,79 @@ var _ = Describe("TaskWorker", func() {
 			})
 		})
 	})
+
+	Describe("TaskCompletionWorkPool", func() {
+		var (
+			taskDB   *dbfakes.FakeTaskDB
+			taskHub  *eventfakes.FakeHub
+			task     *models.Task
+			process  ifrit.Process
+			interval time.Duration
+		)
+
+		BeforeEach(func() {
+			interval = 5 * time.Second
+			taskDB = new(dbfakes.FakeTaskDB)
+			taskHub = &eventfakes.FakeHub{}
+
+			fakeServer.RouteToHandler("POST", "/the-callback/url", ghttp.RespondWith(http.StatusOK, nil))
+
+			task = model_helpers.NewValidTask("the-task-guid")
+			task.State = models.Task_Resolving
+			task.CompletionCallbackUrl = fakeServer.URL() + "/the-callback/url"
+			taskDB.TaskByGuidReturns(task, nil)
+			taskDB.DeleteTaskReturns(task, nil)
+
+			callbackDB.ClaimDueTaskCallbacksReturnsOnCall(0, []*models.TaskCallback{{TaskGuid: "the-task-guid", Attempts: 4}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			outbox := taskworkpool.NewCallbackOutbox(callbackDB, fakeClock, policy, nil, timeout)
+			workPool := taskworkpool.New(logger, 2, taskworkpool.HandleCompletedTask, outbox, taskDB, taskHub, interval)
+			process = ginkgomon.Invoke(workPool)
+		})
+
+		AfterEach(func() {
+			ginkgomon.Interrupt(process)
+		})
+
+		It("claims the due callbacks every dispatch interval", func() {
+			Consistently(callbackDB.ClaimDueTaskCallbacksCallCount).Should(Equal(0))
+
+			fakeClock.WaitForWatcherAndIncrement(interval)
+			Eventually(callbackDB.ClaimDueTaskCallbacksCallCount).Should(Equal(1))
+			_, _, now, claimedUntil, limit := callbackDB.ClaimDueTaskCallbacksArgsForCall(0)
+			Expect(now).To(Equal(fakeClock.Now()))
+			Expect(claimedUntil).To(BeTemporally(">", now.Add(timeout)))
+			Expect(limit).To(Equal(2))
+
+			fakeClock.Increment(interval)
+			Eventually(callbackDB.ClaimDueTaskCallbacksCallCount).Should(Equal(2))
+		})
+
+		It("delivers the claimed callbacks", func() {
+			fakeClock.WaitForWatcherAndIncrement(interval)
+
+			Eventually(fakeServer.ReceivedRequests).Should(HaveLen(1))
+			Eventually(taskDB.DeleteTaskCallCount).Should(Equal(1))
+			_, _, taskGuid := taskDB.DeleteTaskArgsForCall(0)
+			Expect(taskGuid).To(Equal("the-task-guid"))
+			Expect(taskDB.ResolvingTaskCallCount()).To(Equal(0))
+		})
+
+		Context("when the task no longer exists", func() {
+			BeforeEach(func() {
+				taskDB.TaskByGuidReturns(nil, models.ErrResourceNotFound)
+			})
+
+			It("removes the callback from the outbox", func() {
+				fakeClock.WaitForWatcherAndIncrement(interval)
+
+				Eventually(callbackDB.DeleteTaskCallbackCallCount).Should(Equal(1))
+				_, _, taskGuid := callbackDB.DeleteTaskCallbackArgsForCall(0)
+				Expect(taskGuid).To(Equal("the-task-guid"))
+				Expect(fakeServer.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
 })
@@ -0,0 +1,118 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+const (
+	ExporterOTLP = "otlp"
+	ExporterFile = "file"
+
+	DefaultServiceName = "bbs"
+)
+
+// Config sets where the spans of the BBS are exported to. Unless an exporter
+// is set no span is recorded.
+type Config struct {
+	// Exporter is "otlp" to send spans to an OpenTelemetry collector over
+	// gRPC, or "file" to write them to a file as JSON.
+	Exporter string `json:"exporter,omitempty"`
+	// OTLPEndpoint is the host:port of the collector.
+	OTLPEndpoint string `json:"otlp_endpoint,omitempty"`
+	// OTLPInsecure disables TLS to the collector.
+	OTLPInsecure bool `json:"otlp_insecure,omitempty"`
+	// FilePath is the file spans are appended to.
+	FilePath string `json:"file_path,omitempty"`
+	// SampleRatio is the fraction of the traces started by the BBS that are
+	// recorded, 1 if unset. Traces started by callers are recorded when the
+	// caller records them.
+	SampleRatio float64 `json:"sample_ratio,omitempty"`
+	// ServiceName is the service.name of the spans, DefaultServiceName if
+	// unset.
+	ServiceName string `json:"service_name,omitempty"`
+}
+
+func (c Config) Validate() error {
+	switch c.Exporter {
+	case "":
+	case ExporterOTLP:
+		if c.OTLPEndpoint == "" {
+			return errors.New("tracing: otlp_endpoint is required with the otlp exporter")
+		}
+	case ExporterFile:
+		if c.FilePath == "" {
+			return errors.New("tracing: file_path is required with the file exporter")
+		}
+	default:
+		return fmt.Errorf("tracing: unknown exporter %q", c.Exporter)
+	}
+
+	if c.SampleRatio < 0 || c.SampleRatio > 1 {
+		return errors.New("tracing: sample_ratio must be between 0 and 1")
+	}
+	return nil
+}
+
+// NewTracerProvider sets up the exporter of the config and installs a tracer
+// provider exporting to it as the global one, along with the W3C trace
+// context propagator. It returns nil if the config has no exporter. The
+// provider must be shut down to flush the spans it holds.
+func NewTracerProvider(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	err := cfg.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter sdktrace.SpanExporter
+	switch cfg.Exporter {
+	case "":
+		return nil, nil
+	case ExporterOTLP:
+		options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			options = append(options, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, options...)
+	case ExporterFile:
+		var file *os.File
+		file, err = os.OpenFile(cfg.FilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0640)
+		if err != nil {
+			return nil, err
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+
+	sampleRatio := cfg.SampleRatio
+	if sampleRatio == 0 {
+		sampleRatio = 1
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	return provider, nil
+}
@@ -0,0 +1,72 @@
+package trace_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/bbs/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	DescribeTable("Validate",
+		func(cfg trace.Config, valid bool) {
+			if valid {
+				Expect(cfg.Validate()).To(Succeed())
+			} else {
+				Expect(cfg.Validate()).NotTo(Succeed())
+			}
+		},
+		Entry("without an exporter", trace.Config{}, true),
+		Entry("with the otlp exporter", trace.Config{Exporter: trace.ExporterOTLP, OTLPEndpoint: "collector:4317"}, true),
+		Entry("with the otlp exporter and no endpoint", trace.Config{Exporter: trace.ExporterOTLP}, false),
+		Entry("with the file exporter", trace.Config{Exporter: trace.ExporterFile, FilePath: "/tmp/spans"}, true),
+		Entry("with the file exporter and no path", trace.Config{Exporter: trace.ExporterFile}, false),
+		Entry("with an unknown exporter", trace.Config{Exporter: "zipkin"}, false),
+		Entry("with a sample ratio above 1", trace.Config{SampleRatio: 1.5}, false),
+	)
+})
+
+var _ = Describe("NewTracerProvider", func() {
+	AfterEach(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+	})
+
+	It("returns nil without an exporter", func() {
+		provider, err := trace.NewTracerProvider(context.Background(), trace.Config{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(provider).To(BeNil())
+	})
+
+	It("rejects an invalid config", func() {
+		_, err := trace.NewTracerProvider(context.Background(), trace.Config{Exporter: "zipkin"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	Context("with the file exporter", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "spans.json")
+		})
+
+		It("writes the spans to the file once shut down", func() {
+			provider, err := trace.NewTracerProvider(context.Background(), trace.Config{Exporter: trace.ExporterFile, FilePath: path, ServiceName: "some-bbs"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, span := trace.StartSpan(context.Background(), "some-span")
+			span.End()
+			Expect(provider.Shutdown(context.Background())).To(Succeed())
+
+			contents, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(ContainSubstring(`"Name":"some-span"`))
+			Expect(string(contents)).To(ContainSubstring("some-bbs"))
+		})
+	})
+})
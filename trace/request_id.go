@@ -22,6 +22,12 @@ func ContextWithRequestId(req *http.Request) context.Context {
 	return context.WithValue(req.Context(), RequestIdHeaderCtxKey, RequestIdFromRequest(req))
 }
 
+// WithRequestId returns a copy of ctx carrying the request id, which the BBS
+// client sends along with the calls made with it.
+func WithRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, RequestIdHeaderCtxKey, requestId)
+}
+
 func RequestIdFromContext(ctx context.Context) string {
 	if val, ok := ctx.Value(RequestIdHeaderCtxKey).(string); ok {
 		return val
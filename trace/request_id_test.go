@@ -34,6 +34,13 @@ var _ = Describe("RequestId", func() {
 		})
 	})
 
+	Describe("WithRequestId", func() {
+		It("returns context with the request id", func() {
+			ctx := trace.WithRequestId(context.Background(), "some-request-id")
+			Expect(trace.RequestIdFromContext(ctx)).To(Equal("some-request-id"))
+		})
+	})
+
 	Describe("RequestIdFromContext", func() {
 		It("returns empty request id from context", func() {
 			ctx := context.Background()
@@ -0,0 +1,86 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the instrumentation scope of the spans of the BBS.
+	TracerName = "code.cloudfoundry.org/bbs"
+
+	TraceparentHeader = "traceparent"
+	TracestateHeader  = "tracestate"
+)
+
+var propagator = propagation.TraceContext{}
+
+// StartSpan starts a span as a child of the span of ctx. Spans are only
+// recorded once a tracer provider is set up with NewTracerProvider.
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	return start(ctx, name, oteltrace.WithAttributes(attributes...))
+}
+
+// StartClientSpan starts a span for a call to another service.
+func StartClientSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	return start(ctx, name, oteltrace.WithAttributes(attributes...), oteltrace.WithSpanKind(oteltrace.SpanKindClient))
+}
+
+// StartServerSpan starts a span for a call served by the BBS, as a child of
+// the span of the caller.
+func StartServerSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, oteltrace.Span) {
+	return start(ctx, name, oteltrace.WithAttributes(attributes...), oteltrace.WithSpanKind(oteltrace.SpanKindServer))
+}
+
+// start returns ctx itself along with the span when the span adds nothing to
+// it, as when tracing is not set up, so that callers are given the context
+// they passed in.
+func start(ctx context.Context, name string, options ...oteltrace.SpanStartOption) (context.Context, oteltrace.Span) {
+	spanCtx, span := otel.Tracer(TracerName).Start(ctx, name, options...)
+	if !span.IsRecording() && span.SpanContext().Equal(oteltrace.SpanContextFromContext(ctx)) {
+		return ctx, span
+	}
+	return spanCtx, span
+}
+
+// EndSpan ends the span, marking it as failed if err is not nil.
+func EndSpan(span oteltrace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// InjectHeader sets the W3C traceparent and tracestate headers of the span
+// of ctx.
+func InjectHeader(ctx context.Context, header http.Header) {
+	propagator.Inject(ctx, propagation.HeaderCarrier(header))
+}
+
+// ExtractHeader returns a copy of ctx carrying the span described by the W3C
+// traceparent and tracestate headers, which the spans started with it are
+// children of.
+func ExtractHeader(ctx context.Context, header http.Header) context.Context {
+	return propagator.Extract(ctx, propagation.HeaderCarrier(header))
+}
+
+// InjectMap returns the W3C traceparent and tracestate of the span of ctx,
+// to be sent as gRPC metadata.
+func InjectMap(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+// ExtractMap returns a copy of ctx carrying the span described by the W3C
+// traceparent and tracestate received as gRPC metadata.
+func ExtractMap(ctx context.Context, carrier map[string]string) context.Context {
+	return propagator.Extract(ctx, propagation.MapCarrier(carrier))
+}
@@ -0,0 +1,99 @@
+package trace_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/bbs/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Spans", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(noop.NewTracerProvider())
+	})
+
+	Describe("EndSpan", func() {
+		It("ends the span", func() {
+			_, span := trace.StartSpan(context.Background(), "some-span")
+			trace.EndSpan(span, nil)
+
+			Expect(recorder.Ended()).To(HaveLen(1))
+			Expect(recorder.Ended()[0].Name()).To(Equal("some-span"))
+			Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Unset))
+		})
+
+		It("marks the span as failed with the error", func() {
+			_, span := trace.StartSpan(context.Background(), "some-span")
+			trace.EndSpan(span, errors.New("boom"))
+
+			Expect(recorder.Ended()).To(HaveLen(1))
+			Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Error))
+			Expect(recorder.Ended()[0].Status().Description).To(Equal("boom"))
+		})
+	})
+
+	Describe("InjectHeader and ExtractHeader", func() {
+		It("carries the span across the headers", func() {
+			ctx, parent := trace.StartClientSpan(context.Background(), "client")
+			header := http.Header{}
+			trace.InjectHeader(ctx, header)
+			Expect(header.Get(trace.TraceparentHeader)).NotTo(BeEmpty())
+
+			serverCtx := trace.ExtractHeader(context.Background(), header)
+			_, child := trace.StartServerSpan(serverCtx, "server")
+			child.End()
+			parent.End()
+
+			ended := recorder.Ended()
+			Expect(ended).To(HaveLen(2))
+			Expect(ended[0].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+			Expect(ended[0].SpanContext().TraceID()).To(Equal(parent.SpanContext().TraceID()))
+			Expect(ended[0].SpanKind()).To(Equal(oteltrace.SpanKindServer))
+		})
+
+		It("sends no header without a span", func() {
+			header := http.Header{}
+			trace.InjectHeader(context.Background(), header)
+			Expect(header.Get(trace.TraceparentHeader)).To(BeEmpty())
+		})
+	})
+
+	Describe("InjectMap and ExtractMap", func() {
+		It("returns the traceparent of the span", func() {
+			ctx, span := trace.StartSpan(context.Background(), "some-span")
+			defer span.End()
+
+			Expect(trace.InjectMap(ctx)).To(HaveKeyWithValue(trace.TraceparentHeader, ContainSubstring(span.SpanContext().TraceID().String())))
+		})
+
+		It("carries the span across the map", func() {
+			ctx, parent := trace.StartClientSpan(context.Background(), "client")
+
+			serverCtx := trace.ExtractMap(context.Background(), trace.InjectMap(ctx))
+			_, child := trace.StartServerSpan(serverCtx, "server")
+			child.End()
+			parent.End()
+
+			ended := recorder.Ended()
+			Expect(ended).To(HaveLen(2))
+			Expect(ended[0].Parent().SpanID()).To(Equal(parent.SpanContext().SpanID()))
+		})
+	})
+})